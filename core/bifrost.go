@@ -29,6 +29,7 @@ import (
 	"github.com/capsohq/bifrost/core/providers/glm"
 	"github.com/capsohq/bifrost/core/providers/groq"
 	"github.com/capsohq/bifrost/core/providers/huggingface"
+	"github.com/capsohq/bifrost/core/providers/hunyuan"
 	"github.com/capsohq/bifrost/core/providers/minimax"
 	"github.com/capsohq/bifrost/core/providers/mistral"
 	"github.com/capsohq/bifrost/core/providers/moonshot"
@@ -42,12 +43,16 @@ import (
 	"github.com/capsohq/bifrost/core/providers/replicate"
 	"github.com/capsohq/bifrost/core/providers/runway"
 	"github.com/capsohq/bifrost/core/providers/sgl"
+	"github.com/capsohq/bifrost/core/providers/spark"
+	"github.com/capsohq/bifrost/core/providers/stepfun"
 	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
 	"github.com/capsohq/bifrost/core/providers/vertex"
 	"github.com/capsohq/bifrost/core/providers/vllm"
 	"github.com/capsohq/bifrost/core/providers/volcengine"
 	"github.com/capsohq/bifrost/core/providers/xai"
+	"github.com/capsohq/bifrost/core/providers/yi"
 	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/core/tokenizer"
 	"github.com/valyala/fasthttp"
 )
 
@@ -87,6 +92,43 @@ type Bifrost struct {
 	mcpInitOnce         sync.Once                           // Ensures MCP manager is initialized only once
 	dropExcessRequests  atomic.Bool                         // If true, in cases where the queue is full, requests will not wait for the queue to be empty and will be dropped instead.
 	keySelector         schemas.KeySelector                 // Custom key selector function
+	parameterPresets    atomic.Pointer[presetIndex]         // parameter presets indexed by name and alias
+	// load shedding config (nil = disabled)
+	loadShedding atomic.Pointer[schemas.LoadSheddingConfig]
+	// circuit breaker config (nil = disabled) and circuit states keyed by provider/model
+	circuitBreaker  atomic.Pointer[schemas.CircuitBreakerConfig]
+	circuitBreakers sync.Map
+	// latency-routed model aliases (nil = none) and recent latencies keyed by provider/model
+	latencyRouting  atomic.Pointer[schemas.LatencyRoutingConfig]
+	latencyTrackers sync.Map
+	// cost-routed model aliases (nil = none) and the prices they are ranked by
+	costRouting  atomic.Pointer[schemas.CostRoutingConfig]
+	modelPricing schemas.ModelPricing
+	// prices responses into their extra fields (nil = no cost)
+	costCalculator schemas.ResponseCostCalculator
+	// virtual models indexed by name
+	virtualModels atomic.Pointer[virtualModelIndex]
+	// traffic splits indexed by logical model name
+	trafficSplits atomic.Pointer[trafficSplitIndex]
+	// single-flight config (nil = disabled) and the upstream calls identical requests can wait for
+	singleFlight      atomic.Pointer[schemas.SingleFlightConfig]
+	singleFlightCalls singleFlightGroup
+	// tools executed by bifrost for requests that opt in (nil = none)
+	toolExecution atomic.Pointer[schemas.ToolExecutionConfig]
+	// usage estimation for streams that end without it (nil = disabled)
+	streamUsage atomic.Pointer[schemas.StreamUsageConfig]
+	// headers forwarded, stripped and injected on provider requests (nil = x-bf-eh-* headers only)
+	headerPolicy atomic.Pointer[schemas.HeaderPolicyConfig]
+	// default timeout of each plugin hook call (0 = no timeout)
+	pluginHookTimeout atomic.Int64
+	// called for every schema drift found in a provider response
+	schemaDriftObserver func(schemas.SchemaDrift)
+	// called whenever a circuit opens or closes
+	circuitBreakerObserver func(schemas.CircuitBreakerEvent)
+	// called with every batch retrieved
+	batchObserver func(*schemas.BifrostBatchRetrieveResponse)
+	// called with every batch created
+	batchCreateObserver func(*schemas.BifrostBatchCreateResponse)
 }
 
 // ProviderQueue wraps a provider's request channel with lifecycle management
@@ -98,6 +140,9 @@ type ProviderQueue struct {
 	closing    uint32               // atomic: 0 = open, 1 = closing
 	signalOnce sync.Once
 	closeOnce  sync.Once
+
+	serviceRate serviceRateTracker // completed requests per second, used for Retry-After estimates when shedding load
+	scheduler   *providerScheduler // limits in-flight requests when the provider has a scheduler config, nil otherwise
 }
 
 // signalClosing signals the closing of the provider queue.
@@ -124,11 +169,14 @@ func (pq *ProviderQueue) isClosing() bool {
 }
 
 // PluginPipeline encapsulates the execution of plugin PreHooks and PostHooks, tracks how many plugins ran, and manages short-circuiting and error aggregation.
+// Each hook call is isolated from the pipeline: panics and calls running longer than the plugin's hook timeout are handled like errors returned by the hook.
 type PluginPipeline struct {
 	llmPlugins []schemas.LLMPlugin
 	mcpPlugins []schemas.MCPPlugin
 	logger     schemas.Logger
 	tracer     schemas.Tracer
+	// Default timeout of each hook call, for plugins without their own (0 = no timeout)
+	hookTimeout time.Duration
 
 	// Number of PreHooks that were executed (used to determine which PostHooks to run in reverse order)
 	executedPreHooks int
@@ -206,6 +254,69 @@ func Init(ctx context.Context, config schemas.BifrostConfig) (*Bifrost, error) {
 
 	bifrost.dropExcessRequests.Store(config.DropExcessRequests)
 
+	if err := bifrost.UpdateParameterPresets(config.ParameterPresets); err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := config.LoadShedding.Validate(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("invalid load shedding config: %w", err)
+	}
+	bifrost.loadShedding.Store(config.LoadShedding)
+	if err := config.CircuitBreaker.Validate(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("invalid circuit breaker config: %w", err)
+	}
+	bifrost.circuitBreaker.Store(config.CircuitBreaker)
+	if err := config.LatencyRouting.Validate(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("invalid latency routing config: %w", err)
+	}
+	bifrost.latencyRouting.Store(config.LatencyRouting)
+	if err := config.CostRouting.Validate(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("invalid cost routing config: %w", err)
+	}
+	bifrost.costRouting.Store(config.CostRouting)
+	bifrost.modelPricing = config.ModelPricing
+	bifrost.costCalculator = config.CostCalculator
+	if err := bifrost.UpdateVirtualModels(config.VirtualModels); err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := bifrost.UpdateTrafficSplits(config.TrafficSplits); err != nil {
+		cancel()
+		return nil, err
+	}
+	if err := config.SingleFlight.Validate(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("invalid single flight config: %w", err)
+	}
+	bifrost.singleFlight.Store(config.SingleFlight)
+	if err := config.ToolExecution.Validate(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("invalid tool execution config: %w", err)
+	}
+	bifrost.toolExecution.Store(config.ToolExecution)
+	bifrost.streamUsage.Store(config.StreamUsage)
+	if err := config.HeaderPolicy.Validate(); err != nil {
+		cancel()
+		return nil, fmt.Errorf("invalid header policy config: %w", err)
+	}
+	bifrost.headerPolicy.Store(config.HeaderPolicy)
+	if err := bifrost.UpdatePluginHookTimeout(config.PluginHookTimeout); err != nil {
+		cancel()
+		return nil, err
+	}
+	bifrost.schemaDriftObserver = config.SchemaDriftObserver
+	bifrost.circuitBreakerObserver = config.CircuitBreakerObserver
+	bifrost.batchObserver = config.BatchObserver
+	bifrost.batchCreateObserver = config.BatchCreateObserver
+	if err := bifrost.UpdateSchemaDriftConfig(config.SchemaDrift); err != nil {
+		cancel()
+		return nil, fmt.Errorf("invalid schema drift config: %w", err)
+	}
+
 	if bifrost.keySelector == nil {
 		bifrost.keySelector = WeightedRandomKeySelector
 	}
@@ -341,10 +452,58 @@ func (bifrost *Bifrost) getTracer() schemas.Tracer {
 }
 
 // ReloadConfig reloads the config from DB
-// Currently we update account, drop excess requests, and plugin lists
+// Currently we update account, drop excess requests, load shedding, circuit breakers, latency and cost routing, virtual models, traffic splits, single-flight, tool execution, header policy, schema drift detection, plugin hook timeout, and plugin lists
 // We will keep on adding other aspects as required
 func (bifrost *Bifrost) ReloadConfig(config schemas.BifrostConfig) error {
 	bifrost.dropExcessRequests.Store(config.DropExcessRequests)
+	if err := bifrost.UpdateLoadSheddingConfig(config.LoadShedding); err != nil {
+		return err
+	}
+	if err := bifrost.UpdateCircuitBreakerConfig(config.CircuitBreaker); err != nil {
+		return err
+	}
+	if err := bifrost.UpdateLatencyRoutingConfig(config.LatencyRouting); err != nil {
+		return err
+	}
+	if err := bifrost.UpdateCostRoutingConfig(config.CostRouting); err != nil {
+		return err
+	}
+	if err := bifrost.UpdateVirtualModels(config.VirtualModels); err != nil {
+		return err
+	}
+	if err := bifrost.UpdateTrafficSplits(config.TrafficSplits); err != nil {
+		return err
+	}
+	if err := bifrost.UpdateSingleFlightConfig(config.SingleFlight); err != nil {
+		return err
+	}
+	if err := bifrost.UpdateToolExecutionConfig(config.ToolExecution); err != nil {
+		return err
+	}
+	bifrost.UpdateStreamUsageConfig(config.StreamUsage)
+	if err := bifrost.UpdateHeaderPolicyConfig(config.HeaderPolicy); err != nil {
+		return err
+	}
+	if err := bifrost.UpdatePluginHookTimeout(config.PluginHookTimeout); err != nil {
+		return err
+	}
+	return bifrost.UpdateSchemaDriftConfig(config.SchemaDrift)
+}
+
+// UpdateSchemaDriftConfig updates the checks of provider responses against their expected shapes at runtime.
+// A nil or disabled config turns the checks off. The detector, and the field presence it has learned,
+// is kept when the config is unchanged.
+func (bifrost *Bifrost) UpdateSchemaDriftConfig(config *schemas.SchemaDriftConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	if current := providerUtils.GetSchemaDriftDetector(); current != nil && config != nil && current.Config() == *config {
+		return nil
+	}
+	providerUtils.SetSchemaDriftDetector(providerUtils.NewSchemaDriftDetector(config, bifrost.schemaDriftObserver))
+	if config != nil {
+		bifrost.logger.Info("schema_drift updated: enabled=%v, sample_rate=%v", config.Enabled, config.EffectiveSampleRate())
+	}
 	return nil
 }
 
@@ -679,9 +838,15 @@ func (bifrost *Bifrost) ChatCompletionRequest(ctx *schemas.BifrostContext, req *
 		return nil, err
 	}
 
+	// Execute the calls of registered tools if the request opted in
+	response, err = bifrost.executeToolCalls(ctx, req, response)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if we should enter agent mode
 	if bifrost.MCPManager != nil {
-		return bifrost.MCPManager.CheckAndExecuteAgentForChatRequest(
+		response, err = bifrost.MCPManager.CheckAndExecuteAgentForChatRequest(
 			ctx,
 			req,
 			response,
@@ -690,7 +855,11 @@ func (bifrost *Bifrost) ChatCompletionRequest(ctx *schemas.BifrostContext, req *
 		)
 	}
 
-	return response, nil
+	// Reasoning is kept until here, as tool and agent rounds may have to send it back to the provider
+	if shouldStripReasoning(ctx) {
+		response = response.WithoutReasoning()
+	}
+	return response, err
 }
 
 // ChatCompletionStreamRequest sends a chat completion stream request to the specified provider.
@@ -967,6 +1136,59 @@ func (bifrost *Bifrost) RerankRequest(ctx *schemas.BifrostContext, req *schemas.
 	return response.RerankResponse, nil
 }
 
+// ModerationRequest sends a content moderation request to the specified provider.
+func (bifrost *Bifrost) ModerationRequest(ctx *schemas.BifrostContext, req *schemas.BifrostModerationRequest) (*schemas.BifrostModerationResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "moderation request is nil",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType: schemas.ModerationRequest,
+			},
+		}
+	}
+	if req.Input.IsEmpty() {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "input not provided for moderation request",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    schemas.ModerationRequest,
+				Provider:       req.Provider,
+				ModelRequested: req.Model,
+			},
+		}
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.ModerationRequest
+	bifrostReq.ModerationRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || response.ModerationResponse == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "received nil response from provider",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    schemas.ModerationRequest,
+				Provider:       req.Provider,
+				ModelRequested: req.Model,
+			},
+		}
+	}
+
+	return response.ModerationResponse, nil
+}
+
 // SpeechRequest sends a speech request to the specified provider.
 func (bifrost *Bifrost) SpeechRequest(ctx *schemas.BifrostContext, req *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
 	if req == nil {
@@ -1113,6 +1335,60 @@ func (bifrost *Bifrost) TranscriptionStreamRequest(ctx *schemas.BifrostContext,
 	return bifrost.handleStreamRequest(ctx, bifrostReq)
 }
 
+// TranslationRequest sends an audio translation request to the specified provider.
+// The audio is transcribed and translated into English text.
+func (bifrost *Bifrost) TranslationRequest(ctx *schemas.BifrostContext, req *schemas.BifrostTranslationRequest) (*schemas.BifrostTranslationResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "translation request is nil",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType: schemas.TranslationRequest,
+			},
+		}
+	}
+	if req.Input == nil || req.Input.File == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "audio input not provided for translation request",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    schemas.TranslationRequest,
+				Provider:       req.Provider,
+				ModelRequested: req.Model,
+			},
+		}
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.TranslationRequest
+	bifrostReq.TranslationRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || response.TranslationResponse == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "received nil response from provider",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    schemas.TranslationRequest,
+				Provider:       req.Provider,
+				ModelRequested: req.Model,
+			},
+		}
+	}
+
+	return response.TranslationResponse, nil
+}
+
 // ImageGenerationRequest sends an image generation request to the specified provider.
 func (bifrost *Bifrost) ImageGenerationRequest(ctx *schemas.BifrostContext,
 	req *schemas.BifrostImageGenerationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
@@ -1373,6 +1649,113 @@ func (bifrost *Bifrost) ImageVariationRequest(ctx *schemas.BifrostContext, req *
 	return response.ImageGenerationResponse, nil
 }
 
+// MusicGenerationRequest sends a music generation request to the specified provider.
+func (bifrost *Bifrost) MusicGenerationRequest(ctx *schemas.BifrostContext, req *schemas.BifrostMusicGenerationRequest) (*schemas.BifrostMusicGenerationResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "music generation request is nil",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType: schemas.MusicGenerationRequest,
+			},
+		}
+	}
+	if req.Input == nil || req.Input.Prompt == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "prompt not provided for music generation request",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    schemas.MusicGenerationRequest,
+				Provider:       req.Provider,
+				ModelRequested: req.Model,
+			},
+		}
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.MusicGenerationRequest
+	bifrostReq.MusicGenerationRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || response.MusicGenerationResponse == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "received nil response from provider",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    schemas.MusicGenerationRequest,
+				Provider:       req.Provider,
+				ModelRequested: req.Model,
+			},
+		}
+	}
+
+	return response.MusicGenerationResponse, nil
+}
+
+// ContextCacheCreateRequest caches a message prefix on the specified provider. The returned ID can be
+// passed as context_id in later chat requests to the same provider and model.
+func (bifrost *Bifrost) ContextCacheCreateRequest(ctx *schemas.BifrostContext, req *schemas.BifrostContextCacheCreateRequest) (*schemas.BifrostContextCacheCreateResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "context cache create request is nil",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType: schemas.ContextCacheCreateRequest,
+			},
+		}
+	}
+	if len(req.Input) == 0 {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "messages not provided for context cache create request",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    schemas.ContextCacheCreateRequest,
+				Provider:       req.Provider,
+				ModelRequested: req.Model,
+			},
+		}
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.ContextCacheCreateRequest
+	bifrostReq.ContextCacheCreateRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || response.ContextCacheCreateResponse == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "received nil response from provider",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    schemas.ContextCacheCreateRequest,
+				Provider:       req.Provider,
+				ModelRequested: req.Model,
+			},
+		}
+	}
+
+	return response.ContextCacheCreateResponse, nil
+}
+
 // VideoGenerationRequest sends a video generation request to the specified provider.
 func (bifrost *Bifrost) VideoGenerationRequest(ctx *schemas.BifrostContext,
 	req *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
@@ -1734,6 +2117,9 @@ func (bifrost *Bifrost) BatchCreateRequest(ctx *schemas.BifrostContext, req *sch
 	if err != nil {
 		return nil, err
 	}
+	if bifrost.batchCreateObserver != nil && response.BatchCreateResponse != nil {
+		bifrost.batchCreateObserver(response.BatchCreateResponse)
+	}
 	return response.BatchCreateResponse, nil
 }
 
@@ -1808,6 +2194,9 @@ func (bifrost *Bifrost) BatchRetrieveRequest(ctx *schemas.BifrostContext, req *s
 	if err != nil {
 		return nil, err
 	}
+	if bifrost.batchObserver != nil && response.BatchRetrieveResponse != nil {
+		bifrost.batchObserver(response.BatchRetrieveResponse)
+	}
 	return response.BatchRetrieveResponse, nil
 }
 
@@ -2432,16 +2821,483 @@ func (bifrost *Bifrost) ContainerFileCreateRequest(ctx *schemas.BifrostContext,
 	if err != nil {
 		return nil, err
 	}
-	return response.ContainerFileCreateResponse, nil
+	return response.ContainerFileCreateResponse, nil
+}
+
+// ContainerFileListRequest lists files in a container.
+func (bifrost *Bifrost) ContainerFileListRequest(ctx *schemas.BifrostContext, req *schemas.BifrostContainerFileListRequest) (*schemas.BifrostContainerFileListResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "container file list request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for container file list request",
+			},
+		}
+	}
+	if req.ContainerID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "container_id is required for container file list request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.ContainerFileListRequest
+	bifrostReq.ContainerFileListRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.ContainerFileListResponse, nil
+}
+
+// ContainerFileRetrieveRequest retrieves a file from a container.
+func (bifrost *Bifrost) ContainerFileRetrieveRequest(ctx *schemas.BifrostContext, req *schemas.BifrostContainerFileRetrieveRequest) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "container file retrieve request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for container file retrieve request",
+			},
+		}
+	}
+	if req.ContainerID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "container_id is required for container file retrieve request",
+			},
+		}
+	}
+	if req.FileID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "file_id is required for container file retrieve request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.ContainerFileRetrieveRequest
+	bifrostReq.ContainerFileRetrieveRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.ContainerFileRetrieveResponse, nil
+}
+
+// ContainerFileContentRequest retrieves the content of a file from a container.
+func (bifrost *Bifrost) ContainerFileContentRequest(ctx *schemas.BifrostContext, req *schemas.BifrostContainerFileContentRequest) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "container file content request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for container file content request",
+			},
+		}
+	}
+	if req.ContainerID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "container_id is required for container file content request",
+			},
+		}
+	}
+	if req.FileID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "file_id is required for container file content request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.ContainerFileContentRequest
+	bifrostReq.ContainerFileContentRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.ContainerFileContentResponse, nil
+}
+
+// ContainerFileDeleteRequest deletes a file from a container.
+func (bifrost *Bifrost) ContainerFileDeleteRequest(ctx *schemas.BifrostContext, req *schemas.BifrostContainerFileDeleteRequest) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "container file delete request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for container file delete request",
+			},
+		}
+	}
+	if req.ContainerID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "container_id is required for container file delete request",
+			},
+		}
+	}
+	if req.FileID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "file_id is required for container file delete request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.ContainerFileDeleteRequest
+	bifrostReq.ContainerFileDeleteRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.ContainerFileDeleteResponse, nil
+}
+
+// FineTuningJobCreateRequest creates a fine-tuning job of a base model.
+func (bifrost *Bifrost) FineTuningJobCreateRequest(ctx *schemas.BifrostContext, req *schemas.BifrostFineTuningJobCreateRequest) (*schemas.BifrostFineTuningJobCreateResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "fine-tuning job create request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for fine-tuning job create request",
+			},
+		}
+	}
+	if req.Model == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "model is required for fine-tuning job create request",
+			},
+		}
+	}
+	if req.TrainingFile == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "training_file is required for fine-tuning job create request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.FineTuningJobCreateRequest
+	bifrostReq.FineTuningJobCreateRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.FineTuningJobCreateResponse, nil
+}
+
+// FineTuningJobListRequest lists fine-tuning jobs.
+func (bifrost *Bifrost) FineTuningJobListRequest(ctx *schemas.BifrostContext, req *schemas.BifrostFineTuningJobListRequest) (*schemas.BifrostFineTuningJobListResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "fine-tuning job list request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for fine-tuning job list request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.FineTuningJobListRequest
+	bifrostReq.FineTuningJobListRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.FineTuningJobListResponse, nil
+}
+
+// FineTuningJobRetrieveRequest retrieves a specific fine-tuning job.
+func (bifrost *Bifrost) FineTuningJobRetrieveRequest(ctx *schemas.BifrostContext, req *schemas.BifrostFineTuningJobRetrieveRequest) (*schemas.BifrostFineTuningJobRetrieveResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "fine-tuning job retrieve request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for fine-tuning job retrieve request",
+			},
+		}
+	}
+	if req.JobID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "fine_tuning_job_id is required for fine-tuning job retrieve request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.FineTuningJobRetrieveRequest
+	bifrostReq.FineTuningJobRetrieveRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.FineTuningJobRetrieveResponse, nil
+}
+
+// FineTuningJobCancelRequest cancels a fine-tuning job.
+func (bifrost *Bifrost) FineTuningJobCancelRequest(ctx *schemas.BifrostContext, req *schemas.BifrostFineTuningJobCancelRequest) (*schemas.BifrostFineTuningJobCancelResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "fine-tuning job cancel request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for fine-tuning job cancel request",
+			},
+		}
+	}
+	if req.JobID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "fine_tuning_job_id is required for fine-tuning job cancel request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.FineTuningJobCancelRequest
+	bifrostReq.FineTuningJobCancelRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.FineTuningJobCancelResponse, nil
+}
+
+// FineTuningJobEventsRequest lists the events of a fine-tuning job.
+func (bifrost *Bifrost) FineTuningJobEventsRequest(ctx *schemas.BifrostContext, req *schemas.BifrostFineTuningJobEventsRequest) (*schemas.BifrostFineTuningJobEventsResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "fine-tuning job events request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for fine-tuning job events request",
+			},
+		}
+	}
+	if req.JobID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "fine_tuning_job_id is required for fine-tuning job events request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.FineTuningJobEventsRequest
+	bifrostReq.FineTuningJobEventsRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.FineTuningJobEventsResponse, nil
+}
+
+// FineTuningJobCheckpointsRequest lists the checkpoints of a fine-tuning job.
+func (bifrost *Bifrost) FineTuningJobCheckpointsRequest(ctx *schemas.BifrostContext, req *schemas.BifrostFineTuningJobCheckpointsRequest) (*schemas.BifrostFineTuningJobCheckpointsResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "fine-tuning job checkpoints request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for fine-tuning job checkpoints request",
+			},
+		}
+	}
+	if req.JobID == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "fine_tuning_job_id is required for fine-tuning job checkpoints request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.FineTuningJobCheckpointsRequest
+	bifrostReq.FineTuningJobCheckpointsRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.FineTuningJobCheckpointsResponse, nil
+}
+
+// VectorStoreCreateRequest creates a vector store.
+func (bifrost *Bifrost) VectorStoreCreateRequest(ctx *schemas.BifrostContext, req *schemas.BifrostVectorStoreCreateRequest) (*schemas.BifrostVectorStoreCreateResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "vector store create request is nil",
+			},
+		}
+	}
+	if req.Provider == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "provider is required for vector store create request",
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+
+	bifrostReq := bifrost.getBifrostRequest()
+	bifrostReq.RequestType = schemas.VectorStoreCreateRequest
+	bifrostReq.VectorStoreCreateRequest = req
+
+	response, err := bifrost.handleRequest(ctx, bifrostReq)
+	if err != nil {
+		return nil, err
+	}
+	return response.VectorStoreCreateResponse, nil
 }
 
-// ContainerFileListRequest lists files in a container.
-func (bifrost *Bifrost) ContainerFileListRequest(ctx *schemas.BifrostContext, req *schemas.BifrostContainerFileListRequest) (*schemas.BifrostContainerFileListResponse, *schemas.BifrostError) {
+// VectorStoreListRequest lists vector stores.
+func (bifrost *Bifrost) VectorStoreListRequest(ctx *schemas.BifrostContext, req *schemas.BifrostVectorStoreListRequest) (*schemas.BifrostVectorStoreListResponse, *schemas.BifrostError) {
 	if req == nil {
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "container file list request is nil",
+				Message: "vector store list request is nil",
 			},
 		}
 	}
@@ -2449,15 +3305,7 @@ func (bifrost *Bifrost) ContainerFileListRequest(ctx *schemas.BifrostContext, re
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "provider is required for container file list request",
-			},
-		}
-	}
-	if req.ContainerID == "" {
-		return nil, &schemas.BifrostError{
-			IsBifrostError: false,
-			Error: &schemas.ErrorField{
-				Message: "container_id is required for container file list request",
+				Message: "provider is required for vector store list request",
 			},
 		}
 	}
@@ -2466,23 +3314,23 @@ func (bifrost *Bifrost) ContainerFileListRequest(ctx *schemas.BifrostContext, re
 	}
 
 	bifrostReq := bifrost.getBifrostRequest()
-	bifrostReq.RequestType = schemas.ContainerFileListRequest
-	bifrostReq.ContainerFileListRequest = req
+	bifrostReq.RequestType = schemas.VectorStoreListRequest
+	bifrostReq.VectorStoreListRequest = req
 
 	response, err := bifrost.handleRequest(ctx, bifrostReq)
 	if err != nil {
 		return nil, err
 	}
-	return response.ContainerFileListResponse, nil
+	return response.VectorStoreListResponse, nil
 }
 
-// ContainerFileRetrieveRequest retrieves a file from a container.
-func (bifrost *Bifrost) ContainerFileRetrieveRequest(ctx *schemas.BifrostContext, req *schemas.BifrostContainerFileRetrieveRequest) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
+// VectorStoreSearchRequest searches the chunks of a vector store.
+func (bifrost *Bifrost) VectorStoreSearchRequest(ctx *schemas.BifrostContext, req *schemas.BifrostVectorStoreSearchRequest) (*schemas.BifrostVectorStoreSearchResponse, *schemas.BifrostError) {
 	if req == nil {
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "container file retrieve request is nil",
+				Message: "vector store search request is nil",
 			},
 		}
 	}
@@ -2490,23 +3338,23 @@ func (bifrost *Bifrost) ContainerFileRetrieveRequest(ctx *schemas.BifrostContext
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "provider is required for container file retrieve request",
+				Message: "provider is required for vector store search request",
 			},
 		}
 	}
-	if req.ContainerID == "" {
+	if req.VectorStoreID == "" {
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "container_id is required for container file retrieve request",
+				Message: "vector_store_id is required for vector store search request",
 			},
 		}
 	}
-	if req.FileID == "" {
+	if req.Query == nil {
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "file_id is required for container file retrieve request",
+				Message: "query is required for vector store search request",
 			},
 		}
 	}
@@ -2515,23 +3363,23 @@ func (bifrost *Bifrost) ContainerFileRetrieveRequest(ctx *schemas.BifrostContext
 	}
 
 	bifrostReq := bifrost.getBifrostRequest()
-	bifrostReq.RequestType = schemas.ContainerFileRetrieveRequest
-	bifrostReq.ContainerFileRetrieveRequest = req
+	bifrostReq.RequestType = schemas.VectorStoreSearchRequest
+	bifrostReq.VectorStoreSearchRequest = req
 
 	response, err := bifrost.handleRequest(ctx, bifrostReq)
 	if err != nil {
 		return nil, err
 	}
-	return response.ContainerFileRetrieveResponse, nil
+	return response.VectorStoreSearchResponse, nil
 }
 
-// ContainerFileContentRequest retrieves the content of a file from a container.
-func (bifrost *Bifrost) ContainerFileContentRequest(ctx *schemas.BifrostContext, req *schemas.BifrostContainerFileContentRequest) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
+// VectorStoreFileCreateRequest adds an uploaded file to a vector store.
+func (bifrost *Bifrost) VectorStoreFileCreateRequest(ctx *schemas.BifrostContext, req *schemas.BifrostVectorStoreFileCreateRequest) (*schemas.BifrostVectorStoreFileCreateResponse, *schemas.BifrostError) {
 	if req == nil {
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "container file content request is nil",
+				Message: "vector store file create request is nil",
 			},
 		}
 	}
@@ -2539,15 +3387,15 @@ func (bifrost *Bifrost) ContainerFileContentRequest(ctx *schemas.BifrostContext,
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "provider is required for container file content request",
+				Message: "provider is required for vector store file create request",
 			},
 		}
 	}
-	if req.ContainerID == "" {
+	if req.VectorStoreID == "" {
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "container_id is required for container file content request",
+				Message: "vector_store_id is required for vector store file create request",
 			},
 		}
 	}
@@ -2555,7 +3403,7 @@ func (bifrost *Bifrost) ContainerFileContentRequest(ctx *schemas.BifrostContext,
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "file_id is required for container file content request",
+				Message: "file_id is required for vector store file create request",
 			},
 		}
 	}
@@ -2564,23 +3412,23 @@ func (bifrost *Bifrost) ContainerFileContentRequest(ctx *schemas.BifrostContext,
 	}
 
 	bifrostReq := bifrost.getBifrostRequest()
-	bifrostReq.RequestType = schemas.ContainerFileContentRequest
-	bifrostReq.ContainerFileContentRequest = req
+	bifrostReq.RequestType = schemas.VectorStoreFileCreateRequest
+	bifrostReq.VectorStoreFileCreateRequest = req
 
 	response, err := bifrost.handleRequest(ctx, bifrostReq)
 	if err != nil {
 		return nil, err
 	}
-	return response.ContainerFileContentResponse, nil
+	return response.VectorStoreFileCreateResponse, nil
 }
 
-// ContainerFileDeleteRequest deletes a file from a container.
-func (bifrost *Bifrost) ContainerFileDeleteRequest(ctx *schemas.BifrostContext, req *schemas.BifrostContainerFileDeleteRequest) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
+// VectorStoreFileListRequest lists the files of a vector store.
+func (bifrost *Bifrost) VectorStoreFileListRequest(ctx *schemas.BifrostContext, req *schemas.BifrostVectorStoreFileListRequest) (*schemas.BifrostVectorStoreFileListResponse, *schemas.BifrostError) {
 	if req == nil {
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "container file delete request is nil",
+				Message: "vector store file list request is nil",
 			},
 		}
 	}
@@ -2588,23 +3436,15 @@ func (bifrost *Bifrost) ContainerFileDeleteRequest(ctx *schemas.BifrostContext,
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "provider is required for container file delete request",
-			},
-		}
-	}
-	if req.ContainerID == "" {
-		return nil, &schemas.BifrostError{
-			IsBifrostError: false,
-			Error: &schemas.ErrorField{
-				Message: "container_id is required for container file delete request",
+				Message: "provider is required for vector store file list request",
 			},
 		}
 	}
-	if req.FileID == "" {
+	if req.VectorStoreID == "" {
 		return nil, &schemas.BifrostError{
 			IsBifrostError: false,
 			Error: &schemas.ErrorField{
-				Message: "file_id is required for container file delete request",
+				Message: "vector_store_id is required for vector store file list request",
 			},
 		}
 	}
@@ -2613,14 +3453,14 @@ func (bifrost *Bifrost) ContainerFileDeleteRequest(ctx *schemas.BifrostContext,
 	}
 
 	bifrostReq := bifrost.getBifrostRequest()
-	bifrostReq.RequestType = schemas.ContainerFileDeleteRequest
-	bifrostReq.ContainerFileDeleteRequest = req
+	bifrostReq.RequestType = schemas.VectorStoreFileListRequest
+	bifrostReq.VectorStoreFileListRequest = req
 
 	response, err := bifrost.handleRequest(ctx, bifrostReq)
 	if err != nil {
 		return nil, err
 	}
-	return response.ContainerFileDeleteResponse, nil
+	return response.VectorStoreFileListResponse, nil
 }
 
 // RemovePlugin removes a plugin from the server.
@@ -2983,6 +3823,7 @@ func (bifrost *Bifrost) UpdateProvider(providerKey schemas.ModelProvider) error
 		done:       make(chan struct{}),
 		signalOnce: sync.Once{},
 		closeOnce:  sync.Once{},
+		scheduler:  newProviderScheduler(providerConfig.ConcurrencyAndBufferSize.Scheduler),
 	}
 
 	// Step 2: Atomically replace the queue FIRST (new producers immediately get the new queue)
@@ -3130,6 +3971,7 @@ transferComplete:
 		currentWaitGroup.Add(1)
 		go bifrost.requestWorker(provider, providerConfig, newPq)
 	}
+	bifrost.startConnectionHealthChecks(provider, providerConfig, newPq)
 
 	bifrost.logger.Info("successfully updated provider configuration for provider %s", providerKey)
 	return nil
@@ -3147,6 +3989,59 @@ func (bifrost *Bifrost) UpdateDropExcessRequests(value bool) {
 	bifrost.logger.Info("drop_excess_requests updated to: %v", value)
 }
 
+// presetIndex maps parameter preset names and aliases to their preset.
+type presetIndex map[string]*schemas.ParameterPreset
+
+// UpdateParameterPresets replaces the configured parameter presets at runtime.
+// The built-in presets are always available; a configured preset with the same name replaces one.
+func (bifrost *Bifrost) UpdateParameterPresets(presets []schemas.ParameterPreset) error {
+	index := make(presetIndex)
+	for _, preset := range append(schemas.BuiltinParameterPresets(), presets...) {
+		if err := preset.Validate(); err != nil {
+			return fmt.Errorf("invalid parameter preset: %w", err)
+		}
+		index[preset.Name] = &preset
+	}
+	// Aliases are indexed after names so an alias never shadows a preset's own name
+	for _, preset := range presets {
+		for _, alias := range preset.Aliases {
+			if _, exists := index[alias]; exists {
+				return fmt.Errorf("invalid parameter preset: alias %s of preset %s conflicts with an existing preset name or alias", alias, preset.Name)
+			}
+			index[alias] = index[preset.Name]
+		}
+	}
+	bifrost.parameterPresets.Store(&index)
+	return nil
+}
+
+// GetParameterPreset returns the parameter preset registered under name (a preset name or alias).
+func (bifrost *Bifrost) GetParameterPreset(name string) (*schemas.ParameterPreset, bool) {
+	index := bifrost.parameterPresets.Load()
+	if index == nil {
+		return nil, false
+	}
+	preset, ok := (*index)[name]
+	return preset, ok
+}
+
+// applyParameterPreset expands the preset named in the context into the request params.
+// It runs before plugins and provider translation, so both see the expanded parameters.
+func (bifrost *Bifrost) applyParameterPreset(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) *schemas.BifrostError {
+	name, ok := ctx.Value(schemas.BifrostContextKeyParameterPreset).(string)
+	if !ok || name == "" {
+		return nil
+	}
+	preset, ok := bifrost.GetParameterPreset(name)
+	if !ok {
+		return newBifrostErrorFromMsg(fmt.Sprintf("unknown parameter preset: %s", name))
+	}
+	if err := preset.ApplyTo(req); err != nil {
+		return newBifrostError(err)
+	}
+	return nil
+}
+
 // getProviderMutex gets or creates a mutex for the given provider
 func (bifrost *Bifrost) getProviderMutex(providerKey schemas.ModelProvider) *sync.RWMutex {
 	mutexValue, _ := bifrost.providerMutexes.LoadOrStore(providerKey, &sync.RWMutex{})
@@ -3461,6 +4356,14 @@ func (bifrost *Bifrost) createBaseProvider(providerKey schemas.ModelProvider, co
 		return volcengine.NewModelArkProvider(config, bifrost.logger)
 	case schemas.Volcengine:
 		return volcengine.NewVolcengineProvider(config, bifrost.logger)
+	case schemas.Hunyuan:
+		return hunyuan.NewHunyuanProvider(config, bifrost.logger)
+	case schemas.StepFun:
+		return stepfun.NewStepFunProvider(config, bifrost.logger)
+	case schemas.Yi:
+		return yi.NewYiProvider(config, bifrost.logger)
+	case schemas.Spark:
+		return spark.NewSparkProvider(config, bifrost.logger)
 	default:
 		return nil, fmt.Errorf("unsupported provider: %s", targetProviderKey)
 	}
@@ -3476,6 +4379,7 @@ func (bifrost *Bifrost) prepareProvider(providerKey schemas.ModelProvider, confi
 		done:       make(chan struct{}),
 		signalOnce: sync.Once{},
 		closeOnce:  sync.Once{},
+		scheduler:  newProviderScheduler(config.ConcurrencyAndBufferSize.Scheduler),
 	}
 
 	bifrost.requestQueues.Store(providerKey, pq)
@@ -3508,6 +4412,8 @@ func (bifrost *Bifrost) prepareProvider(providerKey schemas.ModelProvider, confi
 
 	schemas.RegisterKnownProvider(providerKey)
 
+	// Start health checks before the workers so the connection pool is tracked from the first request
+	bifrost.startConnectionHealthChecks(provider, config, pq)
 	for range config.ConcurrencyAndBufferSize.Concurrency {
 		currentWaitGroup.Add(1)
 		go bifrost.requestWorker(provider, config, pq)
@@ -3516,6 +4422,41 @@ func (bifrost *Bifrost) prepareProvider(providerKey schemas.ModelProvider, confi
 	return nil
 }
 
+// startConnectionHealthChecks starts a background loop that periodically refreshes the provider's
+// pooled connections (see providerUtils.ConnectionPool.Refresh), if the provider sends requests
+// through a pooled fasthttp client and NetworkConfig.HealthCheckIntervalInSeconds is set.
+// The loop stops when the provider queue is closed (provider removed or updated) or when
+// Bifrost shuts down.
+func (bifrost *Bifrost) startConnectionHealthChecks(provider schemas.Provider, config *schemas.ProviderConfig, pq *ProviderQueue) {
+	if config.NetworkConfig.HealthCheckIntervalInSeconds <= 0 {
+		return
+	}
+	pooled, ok := provider.(providerUtils.PooledClientProvider)
+	if !ok || pooled.HTTPClient() == nil {
+		return
+	}
+	pool := providerUtils.WatchConnectionPool(pooled.HTTPClient())
+	interval := time.Duration(config.NetworkConfig.HealthCheckIntervalInSeconds) * time.Second
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-pq.done:
+				return
+			case <-bifrost.ctx.Done():
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(bifrost.ctx, interval)
+				if err := pool.Refresh(ctx); err != nil {
+					bifrost.logger.Debug("connection health check failed for provider %s: %v", provider.GetProviderKey(), err)
+				}
+				cancel()
+			}
+		}
+	}()
+}
+
 // getProviderQueue returns the ProviderQueue for a given provider key.
 // If the queue doesn't exist, it creates one at runtime and initializes the provider,
 // given the provider config is provided in the account interface implementation.
@@ -3661,6 +4602,10 @@ func (bifrost *Bifrost) prepareFallbackRequest(req *schemas.BifrostRequest, fall
 		bifrost.logger.Warn("config not found for provider %s, skipping fallback: %v", fallback.Provider, err)
 		return nil
 	}
+	if circuitErr := bifrost.checkCircuit(fallback.Provider, fallback.Model); circuitErr != nil {
+		bifrost.logger.Debug("circuit open for provider %s and model %s, skipping fallback", fallback.Provider, fallback.Model)
+		return nil
+	}
 
 	// Create a new request with the fallback provider and model
 	fallbackReq := *req
@@ -3705,6 +4650,12 @@ func (bifrost *Bifrost) prepareFallbackRequest(req *schemas.BifrostRequest, fall
 		tmp.Model = fallback.Model
 		fallbackReq.RerankRequest = &tmp
 	}
+	if req.ModerationRequest != nil {
+		tmp := *req.ModerationRequest
+		tmp.Provider = fallback.Provider
+		tmp.Model = fallback.Model
+		fallbackReq.ModerationRequest = &tmp
+	}
 
 	if req.SpeechRequest != nil {
 		tmp := *req.SpeechRequest
@@ -3719,12 +4670,30 @@ func (bifrost *Bifrost) prepareFallbackRequest(req *schemas.BifrostRequest, fall
 		tmp.Model = fallback.Model
 		fallbackReq.TranscriptionRequest = &tmp
 	}
+	if req.TranslationRequest != nil {
+		tmp := *req.TranslationRequest
+		tmp.Provider = fallback.Provider
+		tmp.Model = fallback.Model
+		fallbackReq.TranslationRequest = &tmp
+	}
 	if req.ImageGenerationRequest != nil {
 		tmp := *req.ImageGenerationRequest
 		tmp.Provider = fallback.Provider
 		tmp.Model = fallback.Model
 		fallbackReq.ImageGenerationRequest = &tmp
 	}
+	if req.MusicGenerationRequest != nil {
+		tmp := *req.MusicGenerationRequest
+		tmp.Provider = fallback.Provider
+		tmp.Model = fallback.Model
+		fallbackReq.MusicGenerationRequest = &tmp
+	}
+	if req.ContextCacheCreateRequest != nil {
+		tmp := *req.ContextCacheCreateRequest
+		tmp.Provider = fallback.Provider
+		tmp.Model = fallback.Model
+		fallbackReq.ContextCacheCreateRequest = &tmp
+	}
 	if req.VideoGenerationRequest != nil {
 		tmp := *req.VideoGenerationRequest
 		tmp.Provider = fallback.Provider
@@ -3756,6 +4725,9 @@ func (bifrost *Bifrost) shouldContinueWithFallbacks(fallback schemas.Fallback, f
 // It is the wrapper for all non-streaming public API methods.
 func (bifrost *Bifrost) handleRequest(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostResponse, *schemas.BifrostError) {
 	defer bifrost.releaseBifrostRequest(req)
+	bifrost.applyProviderOverride(ctx, req)
+	bifrost.routeModelAlias(ctx, req)
+	bifrost.applyFallbacksOverride(ctx, req)
 	provider, model, fallbacks := req.GetRequestFields()
 	if err := validateRequest(req); err != nil {
 		err.ExtraFields = schemas.BifrostErrorExtraFields{
@@ -3771,6 +4743,16 @@ func (bifrost *Bifrost) handleRequest(ctx *schemas.BifrostContext, req *schemas.
 		ctx = bifrost.ctx
 	}
 
+	if err := bifrost.applyParameterPreset(ctx, req); err != nil {
+		err.ExtraFields = schemas.BifrostErrorExtraFields{
+			RequestType:    req.RequestType,
+			Provider:       provider,
+			ModelRequested: model,
+		}
+		err.StatusCode = schemas.Ptr(fasthttp.StatusBadRequest)
+		return nil, err
+	}
+
 	bifrost.logger.Debug(fmt.Sprintf("primary provider %s with model %s and %d fallbacks", provider, model, len(fallbacks)))
 
 	// Try the primary provider first
@@ -3780,7 +4762,16 @@ func (bifrost *Bifrost) handleRequest(ctx *schemas.BifrostContext, req *schemas.
 		requestID := uuid.New().String()
 		ctx.SetValue(schemas.BifrostContextKeyRequestID, requestID)
 	}
-	primaryResult, primaryErr := bifrost.tryRequest(ctx, req)
+	// Skip providers that cannot serve the request type or whose circuit is open
+	tryPrimary, fallbacks, primaryErr := bifrost.routeRequest(req.RequestType, provider, model, fallbacks)
+	var primaryResult *schemas.BifrostResponse
+	if tryPrimary {
+		if delay := hedgeDelay(ctx); delay > 0 {
+			primaryResult, primaryErr, fallbacks = tryHedged(bifrost, ctx, req, fallbacks, delay, bifrost.tryRequest, nil)
+		} else {
+			primaryResult, primaryErr = bifrost.tryRequest(ctx, req)
+		}
+	}
 	if primaryErr != nil {
 		if primaryErr.Error != nil {
 			bifrost.logger.Debug(fmt.Sprintf("primary provider %s with model %s returned error: %s", provider, model, primaryErr.Error.Message))
@@ -3879,6 +4870,9 @@ func (bifrost *Bifrost) handleRequest(ctx *schemas.BifrostContext, req *schemas.
 // It is the wrapper for all streaming public API methods.
 func (bifrost *Bifrost) handleStreamRequest(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
 	defer bifrost.releaseBifrostRequest(req)
+	bifrost.applyProviderOverride(ctx, req)
+	bifrost.routeModelAlias(ctx, req)
+	bifrost.applyFallbacksOverride(ctx, req)
 
 	provider, model, fallbacks := req.GetRequestFields()
 
@@ -3897,6 +4891,16 @@ func (bifrost *Bifrost) handleStreamRequest(ctx *schemas.BifrostContext, req *sc
 		ctx = bifrost.ctx
 	}
 
+	if err := bifrost.applyParameterPreset(ctx, req); err != nil {
+		err.ExtraFields = schemas.BifrostErrorExtraFields{
+			RequestType:    req.RequestType,
+			Provider:       provider,
+			ModelRequested: model,
+		}
+		err.StatusCode = schemas.Ptr(fasthttp.StatusBadRequest)
+		return nil, err
+	}
+
 	// Try the primary provider first
 	ctx.SetValue(schemas.BifrostContextKeyFallbackIndex, 0)
 	// Ensure request ID is set in context before PreHooks
@@ -3904,7 +4908,16 @@ func (bifrost *Bifrost) handleStreamRequest(ctx *schemas.BifrostContext, req *sc
 		requestID := uuid.New().String()
 		ctx.SetValue(schemas.BifrostContextKeyRequestID, requestID)
 	}
-	primaryResult, primaryErr := bifrost.tryStreamRequest(ctx, req)
+	// Skip providers that cannot serve the request type or whose circuit is open
+	tryPrimary, fallbacks, primaryErr := bifrost.routeRequest(req.RequestType, provider, model, fallbacks)
+	var primaryResult chan *schemas.BifrostStreamChunk
+	if tryPrimary {
+		if delay := hedgeDelay(ctx); delay > 0 {
+			primaryResult, primaryErr, fallbacks = tryHedged(bifrost, ctx, req, fallbacks, delay, bifrost.tryStreamRequestToFirstChunk, drainStream)
+		} else {
+			primaryResult, primaryErr = bifrost.tryStreamRequest(ctx, req)
+		}
+	}
 
 	// Check if we should proceed with fallbacks
 	shouldTryFallbacks := bifrost.shouldTryFallbacks(req, primaryErr)
@@ -4000,6 +5013,11 @@ func (bifrost *Bifrost) tryRequest(ctx *schemas.BifrostContext, req *schemas.Bif
 		return nil, bifrostErr
 	}
 
+	// Shed low priority requests early while the provider is overloaded
+	if bifrostErr := bifrost.shedRequest(ctx, pq, req); bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
 	// Add MCP tools to request if MCP is configured and requested
 	if bifrost.MCPManager != nil {
 		req = bifrost.MCPManager.AddToolsToRequest(ctx, req)
@@ -4048,6 +5066,23 @@ func (bifrost *Bifrost) tryRequest(ctx *schemas.BifrostContext, req *schemas.Bif
 		return nil, bifrostErr
 	}
 
+	// Wait for an identical request already in flight instead of calling the provider again
+	flight, leader := bifrost.joinSingleFlight(ctx, preReq)
+	if flight != nil && !leader {
+		if result, bifrostErr, ok := waitSingleFlight(ctx, flight, req); ok {
+			return bifrost.runSharedPostHooks(ctx, pipeline, result, bifrostErr)
+		}
+		flight = nil
+	}
+	defer bifrost.abandonSingleFlight(flight)
+
+	// Wait for an in-flight slot when the provider has a scheduler
+	release, bifrostErr := bifrost.scheduleRequest(ctx, pq, req)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	defer release()
+
 	msg := bifrost.getChannelMessage(*preReq)
 	msg.Context = ctx
 
@@ -4138,6 +5173,9 @@ func (bifrost *Bifrost) tryRequest(ctx *schemas.BifrostContext, req *schemas.Bif
 	pluginCount := len(*bifrost.llmPlugins.Load())
 	select {
 	case result = <-msg.Response:
+		bifrost.attachResponseCost(result)
+		bifrost.shareSingleFlight(flight, result, nil)
+		tagTrafficSplit(msg.Context, result)
 		resp, bifrostErr := pipeline.RunPostLLMHooks(msg.Context, result, nil, pluginCount)
 		if bifrostErr != nil {
 			bifrost.releaseChannelMessage(msg)
@@ -4154,6 +5192,7 @@ func (bifrost *Bifrost) tryRequest(ctx *schemas.BifrostContext, req *schemas.Bif
 		return resp, nil
 	case bifrostErrVal := <-msg.Err:
 		bifrostErrPtr := &bifrostErrVal
+		bifrost.shareSingleFlight(flight, nil, bifrostErrPtr)
 		resp, bifrostErrPtr = pipeline.RunPostLLMHooks(msg.Context, nil, bifrostErrPtr, pluginCount)
 		bifrost.releaseChannelMessage(msg)
 		// Drop raw request/response on error path too
@@ -4173,7 +5212,8 @@ func (bifrost *Bifrost) tryRequest(ctx *schemas.BifrostContext, req *schemas.Bif
 		}
 		return resp, nil
 	case <-ctx.Done():
-		bifrost.releaseChannelMessage(msg)
+		// The worker still owns msg until it sends its result, so msg is left to the garbage
+		// collector instead of going back to the pool
 		provider, model, _ := req.GetRequestFields()
 		bifrostErr := &schemas.BifrostError{
 			IsBifrostError: true,
@@ -4207,6 +5247,11 @@ func (bifrost *Bifrost) tryStreamRequest(ctx *schemas.BifrostContext, req *schem
 		return nil, bifrostErr
 	}
 
+	// Shed low priority requests early while the provider is overloaded
+	if bifrostErr := bifrost.shedRequest(ctx, pq, req); bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
 	// Add MCP tools to request if MCP is configured and requested
 	if req.RequestType != schemas.SpeechStreamRequest && req.RequestType != schemas.TranscriptionStreamRequest && bifrost.MCPManager != nil {
 		req = bifrost.MCPManager.AddToolsToRequest(ctx, req)
@@ -4241,8 +5286,13 @@ func (bifrost *Bifrost) tryStreamRequest(ctx *schemas.BifrostContext, req *schem
 			outputStream := make(chan *schemas.BifrostStreamChunk)
 
 			// Create a post hook runner cause pipeline object is put back in the pool on defer
+			toolCalls := newToolCallStreamNormalizer()
+			usage := bifrost.newStreamUsageEstimator(req)
 			pipelinePostHookRunner := func(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, err *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError) {
-				return pipeline.RunPostLLMHooks(ctx, result, err, preCount)
+				toolCalls.normalize(result)
+				usage.observe(ctx, result)
+				resp, bifrostErr := pipeline.RunPostLLMHooks(ctx, result, err, preCount)
+				return stripStreamReasoning(ctx, resp), bifrostErr
 			}
 
 			go func() {
@@ -4317,6 +5367,18 @@ func (bifrost *Bifrost) tryStreamRequest(ctx *schemas.BifrostContext, req *schem
 		return nil, bifrostErr
 	}
 
+	// Wait for an in-flight slot when the provider has a scheduler
+	release, bifrostErr := bifrost.scheduleRequest(ctx, pq, req)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	streaming := false
+	defer func() {
+		if !streaming {
+			release()
+		}
+	}()
+
 	msg := bifrost.getChannelMessage(*preReq)
 	msg.Context = ctx
 
@@ -4405,6 +5467,11 @@ func (bifrost *Bifrost) tryStreamRequest(ctx *schemas.BifrostContext, req *schem
 	select {
 	case stream := <-msg.ResponseStream:
 		bifrost.releaseChannelMessage(msg)
+		if pq.scheduler != nil {
+			// The slot is held until the stream ends
+			streaming = true
+			return releaseWithStream(ctx, stream, release), nil
+		}
 		return stream, nil
 	case bifrostErrVal := <-msg.Err:
 		if bifrostErrVal.Error != nil {
@@ -4444,7 +5511,13 @@ func executeRequestWithRetries[T any](
 	var bifrostError *schemas.BifrostError
 	var attempts int
 
-	for attempts = 0; attempts <= config.NetworkConfig.MaxRetries; attempts++ {
+	// A retry policy retries non-streaming requests at the HTTP level instead, so attempts are not multiplied
+	maxRetries := config.NetworkConfig.MaxRetries
+	if config.NetworkConfig.RetryPolicy != nil && !IsStreamRequestType(requestType) {
+		maxRetries = 0
+	}
+
+	for attempts = 0; attempts <= maxRetries; attempts++ {
 		ctx.SetValue(schemas.BifrostContextKeyNumberOfRetries, attempts)
 		if attempts > 0 {
 			// Log retry attempt
@@ -4457,7 +5530,7 @@ func executeRequestWithRetries[T any](
 					retryMsg += ", type=" + *bifrostError.Type
 				}
 			}
-			logger.Debug("retrying request (attempt %d/%d) for model %s: %s", attempts, config.NetworkConfig.MaxRetries, model, retryMsg)
+			logger.Debug("retrying request (attempt %d/%d) for model %s: %s", attempts, maxRetries, model, retryMsg)
 
 			// Calculate and apply backoff
 			backoff := calculateBackoff(attempts-1, config)
@@ -4631,8 +5704,15 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 		}
 		req.Context.SetValue(schemas.BifrostContextKeyIsCustomProvider, !IsStandardProvider(baseProvider))
 
+		if bifrostError = bifrost.checkURLPathOverride(req, provider.GetProviderKey(), baseProvider, config); bifrostError != nil {
+			req.Err <- *bifrostError
+			continue
+		}
+		bifrost.applyHeaderPolicy(req.Context, provider.GetProviderKey())
+
 		key := schemas.Key{}
 		var keys []schemas.Key
+		keySelected := false // key was picked by key selection and may be swapped when it gets rate limited
 		if providerRequiresKey(baseProvider, config.CustomProviderConfig) {
 			// ListModels needs all enabled/supported keys so providers can aggregate
 			// and report per-key statuses (KeyStatuses).
@@ -4656,12 +5736,14 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 				}
 			} else {
 				// Determine if this is a multi-key batch/file/container operation
-				// BatchCreate, FileUpload, ContainerCreate, ContainerFileCreate use single key; other batch/file/container ops use multiple keys
+				// BatchCreate, FileUpload, ContainerCreate, ContainerFileCreate, FineTuningJobCreate, VectorStoreCreate use single key; other batch/file/container/fine-tuning/vector store ops use multiple keys
 				isMultiKeyBatchOp := isBatchRequestType(req.RequestType) && req.RequestType != schemas.BatchCreateRequest
 				isMultiKeyFileOp := isFileRequestType(req.RequestType) && req.RequestType != schemas.FileUploadRequest
 				isMultiKeyContainerOp := isContainerRequestType(req.RequestType) && req.RequestType != schemas.ContainerCreateRequest && req.RequestType != schemas.ContainerFileCreateRequest
+				isMultiKeyFineTuningOp := isFineTuningRequestType(req.RequestType) && req.RequestType != schemas.FineTuningJobCreateRequest
+				isMultiKeyVectorStoreOp := isVectorStoreRequestType(req.RequestType) && req.RequestType != schemas.VectorStoreCreateRequest
 
-				if isMultiKeyBatchOp || isMultiKeyFileOp || isMultiKeyContainerOp {
+				if isMultiKeyBatchOp || isMultiKeyFileOp || isMultiKeyContainerOp || isMultiKeyFineTuningOp || isMultiKeyVectorStoreOp {
 					var modelPtr *string
 					if model != "" {
 						modelPtr = &model
@@ -4717,6 +5799,7 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 					req.Context.SetValue(schemas.BifrostContextKeySpanID, keySpanCtx.Value(schemas.BifrostContextKeySpanID))
 					req.Context.SetValue(schemas.BifrostContextKeySelectedKeyID, key.ID)
 					req.Context.SetValue(schemas.BifrostContextKeySelectedKeyName, key.Name)
+					keySelected = true
 				}
 			}
 		}
@@ -4725,12 +5808,18 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 		var pipeline *PluginPipeline
 		if IsStreamRequestType(req.RequestType) {
 			pipeline = bifrost.getPluginPipeline()
+			toolCalls := newToolCallStreamNormalizer()
+			usage := bifrost.newStreamUsageEstimator(&req.BifrostRequest)
 			postHookRunner = func(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, err *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError) {
+				tagTrafficSplit(ctx, result)
+				toolCalls.normalize(result)
+				usage.observe(ctx, result)
+				bifrost.attachResponseCost(result)
 				resp, bifrostErr := pipeline.RunPostLLMHooks(ctx, result, err, len(*bifrost.llmPlugins.Load()))
 				if bifrostErr != nil {
 					return nil, bifrostErr
 				}
-				return resp, nil
+				return stripStreamReasoning(ctx, resp), nil
 			}
 			// Store a finalizer callback to create aggregated post-hook spans at stream end
 			// This closure captures the pipeline reference and releases it after finalization
@@ -4742,17 +5831,42 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 			req.Context.SetValue(schemas.BifrostContextKeyPostHookSpanFinalizer, postHookSpanFinalizer)
 		}
 
+		// A provider retry policy retries the HTTP calls of non-streaming requests in place
+		req.Context.SetValue(schemas.BifrostContextKeyRequestType, req.RequestType)
+		if config.NetworkConfig.RetryPolicy != nil && !IsStreamRequestType(req.RequestType) {
+			req.Context.SetValue(schemas.BifrostContextKeyRetryPolicy, config.NetworkConfig.RetryPolicy)
+		}
+
 		// Execute request with retries
+		// Each attempt reports the key's state; a retry after a rate limit moves to another key
 		if IsStreamRequestType(req.RequestType) {
 			stream, bifrostError = executeRequestWithRetries(req.Context, config, func() (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-				return bifrost.handleProviderStreamRequest(provider, req, key, postHookRunner)
+				// Headers of a previous attempt must not be attributed to this attempt's key
+				req.Context.SetValue(schemas.BifrostContextKeyProviderResponseHeaders, nil)
+				if keySelected {
+					key = bifrost.rotateRateLimitedKey(req, key, provider.GetProviderKey(), model, baseProvider)
+				}
+				attemptStream, attemptErr := bifrost.handleProviderStreamRequest(provider, req, key, postHookRunner)
+				bifrost.recordKeyState(req.Context, key, provider.GetProviderKey(), attemptErr)
+				return attemptStream, attemptErr
 			}, req.RequestType, provider.GetProviderKey(), model, &req.BifrostRequest, bifrost.logger)
 		} else {
 			result, bifrostError = executeRequestWithRetries(req.Context, config, func() (*schemas.BifrostResponse, *schemas.BifrostError) {
-				return bifrost.handleProviderRequest(provider, req, key, keys)
+				// Headers of a previous attempt must not be attributed to this attempt's key
+				req.Context.SetValue(schemas.BifrostContextKeyProviderResponseHeaders, nil)
+				if keySelected {
+					key = bifrost.rotateRateLimitedKey(req, key, provider.GetProviderKey(), model, baseProvider)
+				}
+				attemptResult, attemptErr := bifrost.handleProviderRequest(provider, req, key, keys)
+				bifrost.recordKeyState(req.Context, key, provider.GetProviderKey(), attemptErr)
+				return attemptResult, attemptErr
 			}, req.RequestType, provider.GetProviderKey(), model, &req.BifrostRequest, bifrost.logger)
 		}
 
+		pq.serviceRate.record(time.Now())
+		bifrost.recordCircuitOutcome(provider.GetProviderKey(), model, bifrostError)
+		bifrost.recordLatency(provider.GetProviderKey(), model, req.RequestType, result, bifrostError)
+
 		// Release pipeline immediately for non-streaming requests only
 		// For streaming, the pipeline is released in the postHookSpanFinalizer after streaming completes
 		// Exception: if streaming request has an error, release immediately since finalizer won't be called
@@ -4813,233 +5927,460 @@ func (bifrost *Bifrost) requestWorker(provider schemas.Provider, config *schemas
 	// bifrost.logger.Debug("worker for provider %s exiting...", provider.GetProviderKey())
 }
 
+// rotateRateLimitedKey returns the key for the next attempt: a key that is cooling down after a
+// rate limit is replaced by another available key for the model, if there is one.
+func (bifrost *Bifrost) rotateRateLimitedKey(req *ChannelMessage, key schemas.Key, providerKey schemas.ModelProvider, model string, baseProvider schemas.ModelProvider) schemas.Key {
+	if _, coolingDown := providerUtils.KeyCooldownRemaining(key.ID); !coolingDown {
+		return key
+	}
+	next, err := bifrost.selectKeyFromProviderForModel(req.Context, req.RequestType, providerKey, model, baseProvider)
+	if err != nil || next.ID == key.ID {
+		return key
+	}
+	bifrost.logger.Debug("key %s for provider %s is rate limited, switching to key %s", key.ID, providerKey, next.ID)
+	req.Context.SetValue(schemas.BifrostContextKeySelectedKeyID, next.ID)
+	req.Context.SetValue(schemas.BifrostContextKeySelectedKeyName, next.Name)
+	return next
+}
+
+// recordKeyState feeds a provider response into the key-state tracker: its rate limit headers may
+// cool the key down, a 401/403 quarantines the key and a success releases a quarantined key.
+// Errors without a status code never reached the provider and are ignored.
+func (bifrost *Bifrost) recordKeyState(ctx *schemas.BifrostContext, key schemas.Key, providerKey schemas.ModelProvider, bifrostError *schemas.BifrostError) {
+	statusCode := fasthttp.StatusOK
+	if bifrostError != nil {
+		if bifrostError.StatusCode == nil {
+			return
+		}
+		statusCode = *bifrostError.StatusCode
+	}
+	headers, _ := ctx.Value(schemas.BifrostContextKeyProviderResponseHeaders).(map[string]string)
+	providerUtils.RecordKeyRateLimit(key.ID, statusCode, headers)
+
+	switch {
+	case providerUtils.IsInvalidKeyStatus(statusCode):
+		if providerUtils.QuarantineKey(key.ID, statusCode, GetErrorMessage(bifrostError)) {
+			bifrost.logger.Warn("key %s (%s) for provider %s was rejected with status %d, quarantining it", key.ID, key.Name, providerKey, statusCode)
+		}
+	case bifrostError == nil:
+		if providerUtils.ReleaseKey(key.ID) {
+			bifrost.logger.Info("key %s (%s) for provider %s succeeded, releasing it from quarantine", key.ID, key.Name, providerKey)
+		}
+	}
+}
+
+// shouldStripReasoning reports whether the reasoning of chat responses must be removed before they are
+// returned, as requested via BifrostContextKeyStripReasoning.
+func shouldStripReasoning(ctx *schemas.BifrostContext) bool {
+	strip, ok := ctx.Value(schemas.BifrostContextKeyStripReasoning).(bool)
+	return ok && strip
+}
+
+// stripStreamReasoning removes the reasoning of a chat stream chunk when requested. It runs after the post
+// hooks, so plugins such as logging still record the reasoning; the chunk they saw is left unchanged.
+func stripStreamReasoning(ctx *schemas.BifrostContext, resp *schemas.BifrostResponse) *schemas.BifrostResponse {
+	if resp == nil || resp.ChatResponse == nil || !shouldStripReasoning(ctx) {
+		return resp
+	}
+	stripped := *resp
+	stripped.ChatResponse = resp.ChatResponse.WithoutReasoning()
+	return &stripped
+}
+
+// normalizeImageResponse brings an image result into the common Bifrost shape and, when requested
+// via BifrostContextKeyInlineImageURLs, replaces image URLs with downloaded base64 data.
+// A failed download leaves the URL in place so the caller still receives the image.
+func (bifrost *Bifrost) normalizeImageResponse(ctx *schemas.BifrostContext, resp *schemas.BifrostImageGenerationResponse) {
+	if resp == nil {
+		return
+	}
+	if inline, ok := ctx.Value(schemas.BifrostContextKeyInlineImageURLs).(bool); ok && inline {
+		if err := providerUtils.InlineImageURLs(ctx, resp); err != nil {
+			bifrost.logger.Warn("failed to inline image urls: %v", err)
+		}
+	}
+	resp.Normalize()
+}
+
+// checkURLPathOverride validates the per-request URL path override, if one is set, against the base
+// provider's allowlist and the provider's NetworkConfig.AllowedPathOverrides. Every applied or rejected
+// override is logged so the escape hatch can be audited.
+func (bifrost *Bifrost) checkURLPathOverride(req *ChannelMessage, provider, baseProvider schemas.ModelProvider, config *schemas.ProviderConfig) *schemas.BifrostError {
+	override, ok := req.Context.Value(schemas.BifrostContextKeyURLPath).(string)
+	if !ok {
+		return nil
+	}
+	_, model, _ := req.BifrostRequest.GetRequestFields()
+	requestID, _ := req.Context.Value(schemas.BifrostContextKeyRequestID).(string)
+	if err := providerUtils.CheckPathOverride(baseProvider, override, config.NetworkConfig.AllowedPathOverrides); err != nil {
+		bifrost.logger.Warn("rejected url path override %q for provider %s (request %s): %v", override, provider, requestID, err)
+		return &schemas.BifrostError{
+			IsBifrostError: true,
+			StatusCode:     schemas.Ptr(fasthttp.StatusBadRequest),
+			Error: &schemas.ErrorField{
+				Message: err.Error(),
+				Error:   err,
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    req.RequestType,
+				Provider:       provider,
+				ModelRequested: model,
+			},
+		}
+	}
+	bifrost.logger.Info("applying url path override %q for provider %s (request %s)", override, provider, requestID)
+	return nil
+}
+
+// isUnsupportedOperationError reports whether the provider rejected the request type itself,
+// as opposed to failing the request.
+func isUnsupportedOperationError(bifrostErr *schemas.BifrostError) bool {
+	return bifrostErr.Error != nil && bifrostErr.Error.Code != nil && *bifrostErr.Error.Code == "unsupported_operation"
+}
+
 // handleProviderRequest handles the request to the provider based on the request type
 // key is used for single-key operations, keys is used for batch/file operations that need multiple keys
 func (bifrost *Bifrost) handleProviderRequest(provider schemas.Provider, req *ChannelMessage, key schemas.Key, keys []schemas.Key) (*schemas.BifrostResponse, *schemas.BifrostError) {
+	// Providers only implement the capability interfaces their API supports
+	if !schemas.SupportsRequestType(provider, req.RequestType) {
+		// Token counts can be estimated locally for providers without a count tokens API
+		if req.RequestType == schemas.CountTokensRequest {
+			return &schemas.BifrostResponse{CountTokensResponse: tokenizer.DefaultRegistry.CountResponsesRequest(req.BifrostRequest.CountTokensRequest)}, nil
+		}
+		return nil, providerUtils.NewUnsupportedOperationError(req.RequestType, provider.GetProviderKey())
+	}
 	response := &schemas.BifrostResponse{}
 	switch req.RequestType {
 	case schemas.ListModelsRequest:
-		listModelsResponse, bifrostError := provider.ListModels(req.Context, keys, req.BifrostRequest.ListModelsRequest)
+		listModelsResponse, bifrostError := provider.(schemas.ListModelsProvider).ListModels(req.Context, keys, req.BifrostRequest.ListModelsRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.ListModelsResponse = listModelsResponse
 	case schemas.TextCompletionRequest:
-		textCompletionResponse, bifrostError := provider.TextCompletion(req.Context, key, req.BifrostRequest.TextCompletionRequest)
+		textCompletionResponse, bifrostError := provider.(schemas.TextCompletionProvider).TextCompletion(req.Context, key, req.BifrostRequest.TextCompletionRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.TextCompletionResponse = textCompletionResponse
 	case schemas.ChatCompletionRequest:
-		chatCompletionResponse, bifrostError := provider.ChatCompletion(req.Context, key, req.BifrostRequest.ChatRequest)
+		chatCompletionResponse, bifrostError := provider.(schemas.ChatProvider).ChatCompletion(req.Context, key, req.BifrostRequest.ChatRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.ChatResponse = chatCompletionResponse
 	case schemas.ResponsesRequest:
-		responsesResponse, bifrostError := provider.Responses(req.Context, key, req.BifrostRequest.ResponsesRequest)
+		responsesResponse, bifrostError := provider.(schemas.ResponsesProvider).Responses(req.Context, key, req.BifrostRequest.ResponsesRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.ResponsesResponse = responsesResponse
 	case schemas.CountTokensRequest:
-		countTokensResponse, bifrostError := provider.CountTokens(req.Context, key, req.BifrostRequest.CountTokensRequest)
+		countTokensResponse, bifrostError := provider.(schemas.CountTokensProvider).CountTokens(req.Context, key, req.BifrostRequest.CountTokensRequest)
 		if bifrostError != nil {
-			return nil, bifrostError
+			if !isUnsupportedOperationError(bifrostError) {
+				return nil, bifrostError
+			}
+			countTokensResponse = tokenizer.DefaultRegistry.CountResponsesRequest(req.BifrostRequest.CountTokensRequest)
 		}
 		response.CountTokensResponse = countTokensResponse
 	case schemas.EmbeddingRequest:
-		embeddingResponse, bifrostError := provider.Embedding(req.Context, key, req.BifrostRequest.EmbeddingRequest)
+		embeddingResponse, bifrostError := provider.(schemas.EmbeddingProvider).Embedding(req.Context, key, req.BifrostRequest.EmbeddingRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.EmbeddingResponse = embeddingResponse
 	case schemas.RerankRequest:
-		rerankResponse, bifrostError := provider.Rerank(req.Context, key, req.BifrostRequest.RerankRequest)
+		rerankResponse, bifrostError := provider.(schemas.RerankProvider).Rerank(req.Context, key, req.BifrostRequest.RerankRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.RerankResponse = rerankResponse
+	case schemas.ModerationRequest:
+		moderationResponse, bifrostError := provider.(schemas.ModerationProvider).Moderation(req.Context, key, req.BifrostRequest.ModerationRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.ModerationResponse = moderationResponse
 	case schemas.SpeechRequest:
-		speechResponse, bifrostError := provider.Speech(req.Context, key, req.BifrostRequest.SpeechRequest)
+		speechResponse, bifrostError := provider.(schemas.SpeechProvider).Speech(req.Context, key, req.BifrostRequest.SpeechRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.SpeechResponse = speechResponse
 	case schemas.TranscriptionRequest:
-		transcriptionResponse, bifrostError := provider.Transcription(req.Context, key, req.BifrostRequest.TranscriptionRequest)
+		transcriptionResponse, bifrostError := provider.(schemas.TranscriptionProvider).Transcription(req.Context, key, req.BifrostRequest.TranscriptionRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.TranscriptionResponse = transcriptionResponse
+	case schemas.TranslationRequest:
+		translationResponse, bifrostError := provider.(schemas.TranslationProvider).Translation(req.Context, key, req.BifrostRequest.TranslationRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.TranslationResponse = translationResponse
 	case schemas.ImageGenerationRequest:
-		imageResponse, bifrostError := provider.ImageGeneration(req.Context, key, req.BifrostRequest.ImageGenerationRequest)
+		imageResponse, bifrostError := provider.(schemas.ImageGenerationProvider).ImageGeneration(req.Context, key, req.BifrostRequest.ImageGenerationRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
+		bifrost.normalizeImageResponse(req.Context, imageResponse)
 		response.ImageGenerationResponse = imageResponse
 	case schemas.ImageEditRequest:
-		imageEditResponse, bifrostError := provider.ImageEdit(req.Context, key, req.BifrostRequest.ImageEditRequest)
+		imageEditResponse, bifrostError := provider.(schemas.ImageEditProvider).ImageEdit(req.Context, key, req.BifrostRequest.ImageEditRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
+		bifrost.normalizeImageResponse(req.Context, imageEditResponse)
 		response.ImageGenerationResponse = imageEditResponse
 	case schemas.ImageVariationRequest:
-		imageVariationResponse, bifrostError := provider.ImageVariation(req.Context, key, req.BifrostRequest.ImageVariationRequest)
+		imageVariationResponse, bifrostError := provider.(schemas.ImageVariationProvider).ImageVariation(req.Context, key, req.BifrostRequest.ImageVariationRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
+		bifrost.normalizeImageResponse(req.Context, imageVariationResponse)
 		response.ImageGenerationResponse = imageVariationResponse
+	case schemas.MusicGenerationRequest:
+		musicGenerationResponse, bifrostError := provider.(schemas.MusicGenerationProvider).MusicGeneration(req.Context, key, req.BifrostRequest.MusicGenerationRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.MusicGenerationResponse = musicGenerationResponse
+	case schemas.ContextCacheCreateRequest:
+		contextCacheCreateResponse, bifrostError := provider.(schemas.ContextCacheProvider).ContextCacheCreate(req.Context, key, req.BifrostRequest.ContextCacheCreateRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.ContextCacheCreateResponse = contextCacheCreateResponse
 	case schemas.VideoGenerationRequest:
-		videoGenerationResponse, bifrostError := provider.VideoGeneration(req.Context, key, req.BifrostRequest.VideoGenerationRequest)
+		videoGenerationResponse, bifrostError := provider.(schemas.VideoProvider).VideoGeneration(req.Context, key, req.BifrostRequest.VideoGenerationRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.VideoGenerationResponse = videoGenerationResponse
 	case schemas.VideoRetrieveRequest:
-		videoRetrieveResponse, bifrostError := provider.VideoRetrieve(req.Context, key, req.BifrostRequest.VideoRetrieveRequest)
+		videoRetrieveResponse, bifrostError := provider.(schemas.VideoProvider).VideoRetrieve(req.Context, key, req.BifrostRequest.VideoRetrieveRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.VideoGenerationResponse = videoRetrieveResponse
 	case schemas.VideoDownloadRequest:
-		videoDownloadResponse, bifrostError := provider.VideoDownload(req.Context, key, req.BifrostRequest.VideoDownloadRequest)
+		videoDownloadResponse, bifrostError := provider.(schemas.VideoProvider).VideoDownload(req.Context, key, req.BifrostRequest.VideoDownloadRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.VideoDownloadResponse = videoDownloadResponse
 	case schemas.VideoListRequest:
-		videoListResponse, bifrostError := provider.VideoList(req.Context, key, req.BifrostRequest.VideoListRequest)
+		videoListResponse, bifrostError := provider.(schemas.VideoProvider).VideoList(req.Context, key, req.BifrostRequest.VideoListRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.VideoListResponse = videoListResponse
 	case schemas.VideoDeleteRequest:
-		videoDeleteResponse, bifrostError := provider.VideoDelete(req.Context, key, req.BifrostRequest.VideoDeleteRequest)
+		videoDeleteResponse, bifrostError := provider.(schemas.VideoProvider).VideoDelete(req.Context, key, req.BifrostRequest.VideoDeleteRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.VideoDeleteResponse = videoDeleteResponse
 	case schemas.VideoRemixRequest:
-		videoRemixResponse, bifrostError := provider.VideoRemix(req.Context, key, req.BifrostRequest.VideoRemixRequest)
+		videoRemixResponse, bifrostError := provider.(schemas.VideoProvider).VideoRemix(req.Context, key, req.BifrostRequest.VideoRemixRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.VideoGenerationResponse = videoRemixResponse
 	case schemas.FileUploadRequest:
-		fileUploadResponse, bifrostError := provider.FileUpload(req.Context, key, req.BifrostRequest.FileUploadRequest)
+		fileUploadResponse, bifrostError := provider.(schemas.FileProvider).FileUpload(req.Context, key, req.BifrostRequest.FileUploadRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
+		// Remember the creating key so follow-up operations on this resource try it first
+		providerUtils.RecordResourceKey(provider.GetProviderKey(), fileUploadResponse.ID, key.ID)
 		response.FileUploadResponse = fileUploadResponse
 	case schemas.FileListRequest:
-		fileListResponse, bifrostError := provider.FileList(req.Context, keys, req.BifrostRequest.FileListRequest)
+		fileListResponse, bifrostError := provider.(schemas.FileProvider).FileList(req.Context, keys, req.BifrostRequest.FileListRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.FileListResponse = fileListResponse
 	case schemas.FileRetrieveRequest:
-		fileRetrieveResponse, bifrostError := provider.FileRetrieve(req.Context, keys, req.BifrostRequest.FileRetrieveRequest)
+		fileRetrieveResponse, bifrostError := provider.(schemas.FileProvider).FileRetrieve(req.Context, keys, req.BifrostRequest.FileRetrieveRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.FileRetrieveResponse = fileRetrieveResponse
 	case schemas.FileDeleteRequest:
-		fileDeleteResponse, bifrostError := provider.FileDelete(req.Context, keys, req.BifrostRequest.FileDeleteRequest)
+		fileDeleteResponse, bifrostError := provider.(schemas.FileProvider).FileDelete(req.Context, keys, req.BifrostRequest.FileDeleteRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
+		providerUtils.ForgetResourceKey(provider.GetProviderKey(), req.BifrostRequest.FileDeleteRequest.FileID)
 		response.FileDeleteResponse = fileDeleteResponse
 	case schemas.FileContentRequest:
-		fileContentResponse, bifrostError := provider.FileContent(req.Context, keys, req.BifrostRequest.FileContentRequest)
+		fileContentResponse, bifrostError := provider.(schemas.FileProvider).FileContent(req.Context, keys, req.BifrostRequest.FileContentRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.FileContentResponse = fileContentResponse
 	case schemas.BatchCreateRequest:
-		batchCreateResponse, bifrostError := provider.BatchCreate(req.Context, key, req.BifrostRequest.BatchCreateRequest)
+		batchCreateResponse, bifrostError := provider.(schemas.BatchProvider).BatchCreate(req.Context, key, req.BifrostRequest.BatchCreateRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
+		// Remember the creating key so follow-up operations on this resource try it first
+		providerUtils.RecordResourceKey(provider.GetProviderKey(), batchCreateResponse.ID, key.ID)
 		response.BatchCreateResponse = batchCreateResponse
 	case schemas.BatchListRequest:
-		batchListResponse, bifrostError := provider.BatchList(req.Context, keys, req.BifrostRequest.BatchListRequest)
+		batchListResponse, bifrostError := provider.(schemas.BatchProvider).BatchList(req.Context, keys, req.BifrostRequest.BatchListRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.BatchListResponse = batchListResponse
 	case schemas.BatchRetrieveRequest:
-		batchRetrieveResponse, bifrostError := provider.BatchRetrieve(req.Context, keys, req.BifrostRequest.BatchRetrieveRequest)
+		batchRetrieveResponse, bifrostError := provider.(schemas.BatchProvider).BatchRetrieve(req.Context, keys, req.BifrostRequest.BatchRetrieveRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.BatchRetrieveResponse = batchRetrieveResponse
 	case schemas.BatchCancelRequest:
-		batchCancelResponse, bifrostError := provider.BatchCancel(req.Context, keys, req.BifrostRequest.BatchCancelRequest)
+		batchCancelResponse, bifrostError := provider.(schemas.BatchProvider).BatchCancel(req.Context, keys, req.BifrostRequest.BatchCancelRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.BatchCancelResponse = batchCancelResponse
 	case schemas.BatchResultsRequest:
-		batchResultsResponse, bifrostError := provider.BatchResults(req.Context, keys, req.BifrostRequest.BatchResultsRequest)
+		batchResultsResponse, bifrostError := provider.(schemas.BatchProvider).BatchResults(req.Context, keys, req.BifrostRequest.BatchResultsRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.BatchResultsResponse = batchResultsResponse
 	case schemas.ContainerCreateRequest:
-		containerCreateResponse, bifrostError := provider.ContainerCreate(req.Context, key, req.BifrostRequest.ContainerCreateRequest)
+		containerCreateResponse, bifrostError := provider.(schemas.ContainerProvider).ContainerCreate(req.Context, key, req.BifrostRequest.ContainerCreateRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
+		// Remember the creating key so follow-up operations on this resource try it first
+		providerUtils.RecordResourceKey(provider.GetProviderKey(), containerCreateResponse.ID, key.ID)
 		response.ContainerCreateResponse = containerCreateResponse
 	case schemas.ContainerListRequest:
-		containerListResponse, bifrostError := provider.ContainerList(req.Context, keys, req.BifrostRequest.ContainerListRequest)
+		containerListResponse, bifrostError := provider.(schemas.ContainerProvider).ContainerList(req.Context, keys, req.BifrostRequest.ContainerListRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.ContainerListResponse = containerListResponse
 	case schemas.ContainerRetrieveRequest:
-		containerRetrieveResponse, bifrostError := provider.ContainerRetrieve(req.Context, keys, req.BifrostRequest.ContainerRetrieveRequest)
+		containerRetrieveResponse, bifrostError := provider.(schemas.ContainerProvider).ContainerRetrieve(req.Context, keys, req.BifrostRequest.ContainerRetrieveRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.ContainerRetrieveResponse = containerRetrieveResponse
 	case schemas.ContainerDeleteRequest:
-		containerDeleteResponse, bifrostError := provider.ContainerDelete(req.Context, keys, req.BifrostRequest.ContainerDeleteRequest)
+		containerDeleteResponse, bifrostError := provider.(schemas.ContainerProvider).ContainerDelete(req.Context, keys, req.BifrostRequest.ContainerDeleteRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
+		providerUtils.ForgetResourceKey(provider.GetProviderKey(), req.BifrostRequest.ContainerDeleteRequest.ContainerID)
 		response.ContainerDeleteResponse = containerDeleteResponse
 	case schemas.ContainerFileCreateRequest:
-		containerFileCreateResponse, bifrostError := provider.ContainerFileCreate(req.Context, key, req.BifrostRequest.ContainerFileCreateRequest)
+		containerFileCreateResponse, bifrostError := provider.(schemas.ContainerFileProvider).ContainerFileCreate(req.Context, key, req.BifrostRequest.ContainerFileCreateRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.ContainerFileCreateResponse = containerFileCreateResponse
 	case schemas.ContainerFileListRequest:
-		containerFileListResponse, bifrostError := provider.ContainerFileList(req.Context, keys, req.BifrostRequest.ContainerFileListRequest)
+		containerFileListResponse, bifrostError := provider.(schemas.ContainerFileProvider).ContainerFileList(req.Context, keys, req.BifrostRequest.ContainerFileListRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.ContainerFileListResponse = containerFileListResponse
 	case schemas.ContainerFileRetrieveRequest:
-		containerFileRetrieveResponse, bifrostError := provider.ContainerFileRetrieve(req.Context, keys, req.BifrostRequest.ContainerFileRetrieveRequest)
+		containerFileRetrieveResponse, bifrostError := provider.(schemas.ContainerFileProvider).ContainerFileRetrieve(req.Context, keys, req.BifrostRequest.ContainerFileRetrieveRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.ContainerFileRetrieveResponse = containerFileRetrieveResponse
 	case schemas.ContainerFileContentRequest:
-		containerFileContentResponse, bifrostError := provider.ContainerFileContent(req.Context, keys, req.BifrostRequest.ContainerFileContentRequest)
+		containerFileContentResponse, bifrostError := provider.(schemas.ContainerFileProvider).ContainerFileContent(req.Context, keys, req.BifrostRequest.ContainerFileContentRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.ContainerFileContentResponse = containerFileContentResponse
 	case schemas.ContainerFileDeleteRequest:
-		containerFileDeleteResponse, bifrostError := provider.ContainerFileDelete(req.Context, keys, req.BifrostRequest.ContainerFileDeleteRequest)
+		containerFileDeleteResponse, bifrostError := provider.(schemas.ContainerFileProvider).ContainerFileDelete(req.Context, keys, req.BifrostRequest.ContainerFileDeleteRequest)
 		if bifrostError != nil {
 			return nil, bifrostError
 		}
 		response.ContainerFileDeleteResponse = containerFileDeleteResponse
+	case schemas.FineTuningJobCreateRequest:
+		fineTuningJobCreateResponse, bifrostError := provider.(schemas.FineTuningProvider).FineTuningJobCreate(req.Context, key, req.BifrostRequest.FineTuningJobCreateRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		// Remember the creating key so follow-up operations on this job try it first
+		providerUtils.RecordResourceKey(provider.GetProviderKey(), fineTuningJobCreateResponse.ID, key.ID)
+		response.FineTuningJobCreateResponse = fineTuningJobCreateResponse
+	case schemas.FineTuningJobListRequest:
+		fineTuningJobListResponse, bifrostError := provider.(schemas.FineTuningProvider).FineTuningJobList(req.Context, keys, req.BifrostRequest.FineTuningJobListRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.FineTuningJobListResponse = fineTuningJobListResponse
+	case schemas.FineTuningJobRetrieveRequest:
+		fineTuningJobRetrieveResponse, bifrostError := provider.(schemas.FineTuningProvider).FineTuningJobRetrieve(req.Context, keys, req.BifrostRequest.FineTuningJobRetrieveRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.FineTuningJobRetrieveResponse = fineTuningJobRetrieveResponse
+	case schemas.FineTuningJobCancelRequest:
+		fineTuningJobCancelResponse, bifrostError := provider.(schemas.FineTuningProvider).FineTuningJobCancel(req.Context, keys, req.BifrostRequest.FineTuningJobCancelRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.FineTuningJobCancelResponse = fineTuningJobCancelResponse
+	case schemas.FineTuningJobEventsRequest:
+		fineTuningJobEventsResponse, bifrostError := provider.(schemas.FineTuningProvider).FineTuningJobEvents(req.Context, keys, req.BifrostRequest.FineTuningJobEventsRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.FineTuningJobEventsResponse = fineTuningJobEventsResponse
+	case schemas.FineTuningJobCheckpointsRequest:
+		fineTuningJobCheckpointsResponse, bifrostError := provider.(schemas.FineTuningProvider).FineTuningJobCheckpoints(req.Context, keys, req.BifrostRequest.FineTuningJobCheckpointsRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.FineTuningJobCheckpointsResponse = fineTuningJobCheckpointsResponse
+	case schemas.VectorStoreCreateRequest:
+		vectorStoreCreateResponse, bifrostError := provider.(schemas.VectorStoreProvider).VectorStoreCreate(req.Context, key, req.BifrostRequest.VectorStoreCreateRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		// Remember the creating key so that searches and file operations on this store try it first
+		providerUtils.RecordResourceKey(provider.GetProviderKey(), vectorStoreCreateResponse.ID, key.ID)
+		response.VectorStoreCreateResponse = vectorStoreCreateResponse
+	case schemas.VectorStoreListRequest:
+		vectorStoreListResponse, bifrostError := provider.(schemas.VectorStoreProvider).VectorStoreList(req.Context, keys, req.BifrostRequest.VectorStoreListRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.VectorStoreListResponse = vectorStoreListResponse
+	case schemas.VectorStoreSearchRequest:
+		vectorStoreSearchResponse, bifrostError := provider.(schemas.VectorStoreProvider).VectorStoreSearch(req.Context, keys, req.BifrostRequest.VectorStoreSearchRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.VectorStoreSearchResponse = vectorStoreSearchResponse
+	case schemas.VectorStoreFileCreateRequest:
+		vectorStoreFileCreateResponse, bifrostError := provider.(schemas.VectorStoreProvider).VectorStoreFileCreate(req.Context, keys, req.BifrostRequest.VectorStoreFileCreateRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.VectorStoreFileCreateResponse = vectorStoreFileCreateResponse
+	case schemas.VectorStoreFileListRequest:
+		vectorStoreFileListResponse, bifrostError := provider.(schemas.VectorStoreProvider).VectorStoreFileList(req.Context, keys, req.BifrostRequest.VectorStoreFileListRequest)
+		if bifrostError != nil {
+			return nil, bifrostError
+		}
+		response.VectorStoreFileListResponse = vectorStoreFileListResponse
 	default:
 		_, model, _ := req.BifrostRequest.GetRequestFields()
 		return nil, &schemas.BifrostError{
@@ -5059,21 +6400,24 @@ func (bifrost *Bifrost) handleProviderRequest(provider schemas.Provider, req *Ch
 
 // handleProviderStreamRequest handles the stream request to the provider based on the request type
 func (bifrost *Bifrost) handleProviderStreamRequest(provider schemas.Provider, req *ChannelMessage, key schemas.Key, postHookRunner schemas.PostHookRunner) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
+	if !schemas.SupportsRequestType(provider, req.RequestType) {
+		return nil, providerUtils.NewUnsupportedOperationError(req.RequestType, provider.GetProviderKey())
+	}
 	switch req.RequestType {
 	case schemas.TextCompletionStreamRequest:
-		return provider.TextCompletionStream(req.Context, postHookRunner, key, req.BifrostRequest.TextCompletionRequest)
+		return provider.(schemas.TextCompletionProvider).TextCompletionStream(req.Context, postHookRunner, key, req.BifrostRequest.TextCompletionRequest)
 	case schemas.ChatCompletionStreamRequest:
-		return provider.ChatCompletionStream(req.Context, postHookRunner, key, req.BifrostRequest.ChatRequest)
+		return provider.(schemas.ChatProvider).ChatCompletionStream(req.Context, postHookRunner, key, req.BifrostRequest.ChatRequest)
 	case schemas.ResponsesStreamRequest:
-		return provider.ResponsesStream(req.Context, postHookRunner, key, req.BifrostRequest.ResponsesRequest)
+		return provider.(schemas.ResponsesProvider).ResponsesStream(req.Context, postHookRunner, key, req.BifrostRequest.ResponsesRequest)
 	case schemas.SpeechStreamRequest:
-		return provider.SpeechStream(req.Context, postHookRunner, key, req.BifrostRequest.SpeechRequest)
+		return provider.(schemas.SpeechProvider).SpeechStream(req.Context, postHookRunner, key, req.BifrostRequest.SpeechRequest)
 	case schemas.TranscriptionStreamRequest:
-		return provider.TranscriptionStream(req.Context, postHookRunner, key, req.BifrostRequest.TranscriptionRequest)
+		return provider.(schemas.TranscriptionProvider).TranscriptionStream(req.Context, postHookRunner, key, req.BifrostRequest.TranscriptionRequest)
 	case schemas.ImageGenerationStreamRequest:
-		return provider.ImageGenerationStream(req.Context, postHookRunner, key, req.BifrostRequest.ImageGenerationRequest)
+		return provider.(schemas.ImageGenerationProvider).ImageGenerationStream(req.Context, postHookRunner, key, req.BifrostRequest.ImageGenerationRequest)
 	case schemas.ImageEditStreamRequest:
-		return provider.ImageEditStream(req.Context, postHookRunner, key, req.BifrostRequest.ImageEditRequest)
+		return provider.(schemas.ImageEditProvider).ImageEditStream(req.Context, postHookRunner, key, req.BifrostRequest.ImageEditRequest)
 	default:
 		_, model, _ := req.BifrostRequest.GetRequestFields()
 		return nil, &schemas.BifrostError{
@@ -5263,7 +6607,7 @@ func (p *PluginPipeline) RunLLMPreHooks(ctx *schemas.BifrostContext, req *schema
 			}
 		}
 
-		req, shortCircuit, err = plugin.PreLLMHook(ctx, req)
+		req, shortCircuit, err = p.runPreLLMHook(ctx, plugin, pluginName, req)
 
 		// End span with appropriate status
 		if err != nil {
@@ -5315,7 +6659,7 @@ func (p *PluginPipeline) RunPostLLMHooks(ctx *schemas.BifrostContext, resp *sche
 		if isStreaming {
 			// For streaming: accumulate timing, don't create individual spans per chunk
 			start := time.Now()
-			resp, bifrostErr, err = plugin.PostLLMHook(ctx, resp, bifrostErr)
+			resp, bifrostErr, err = p.runPostLLMHook(ctx, plugin, pluginName, resp, bifrostErr)
 			duration := time.Since(start)
 
 			p.accumulatePluginTiming(pluginName, duration, err != nil)
@@ -5332,7 +6676,7 @@ func (p *PluginPipeline) RunPostLLMHooks(ctx *schemas.BifrostContext, resp *sche
 					ctx.SetValue(schemas.BifrostContextKeySpanID, spanID)
 				}
 			}
-			resp, bifrostErr, err = plugin.PostLLMHook(ctx, resp, bifrostErr)
+			resp, bifrostErr, err = p.runPostLLMHook(ctx, plugin, pluginName, resp, bifrostErr)
 			// End span with appropriate status
 			if err != nil {
 				p.tracer.SetAttribute(handle, "error", err.Error())
@@ -5386,7 +6730,7 @@ func (p *PluginPipeline) RunMCPPreHooks(ctx *schemas.BifrostContext, req *schema
 			}
 		}
 
-		req, shortCircuit, err = plugin.PreMCPHook(ctx, req)
+		req, shortCircuit, err = p.runPreMCPHook(ctx, plugin, pluginName, req)
 
 		// End span with appropriate status
 		if err != nil {
@@ -5441,7 +6785,7 @@ func (p *PluginPipeline) RunMCPPostHooks(ctx *schemas.BifrostContext, mcpResp *s
 			}
 		}
 
-		mcpResp, bifrostErr, err = plugin.PostMCPHook(ctx, mcpResp, bifrostErr)
+		mcpResp, bifrostErr, err = p.runPostMCPHook(ctx, plugin, pluginName, mcpResp, bifrostErr)
 
 		// End span with appropriate status
 		if err != nil {
@@ -5566,6 +6910,7 @@ func (bifrost *Bifrost) getPluginPipeline() *PluginPipeline {
 	pipeline.mcpPlugins = *bifrost.mcpPlugins.Load()
 	pipeline.logger = bifrost.logger
 	pipeline.tracer = bifrost.getTracer()
+	pipeline.hookTimeout = time.Duration(bifrost.pluginHookTimeout.Load())
 	return pipeline
 }
 
@@ -5649,11 +6994,16 @@ func resetBifrostRequest(req *schemas.BifrostRequest) {
 	req.CountTokensRequest = nil
 	req.EmbeddingRequest = nil
 	req.RerankRequest = nil
+	req.ModerationRequest = nil
+	req.RealtimeRequest = nil
 	req.SpeechRequest = nil
 	req.TranscriptionRequest = nil
+	req.TranslationRequest = nil
 	req.ImageGenerationRequest = nil
 	req.ImageEditRequest = nil
 	req.ImageVariationRequest = nil
+	req.MusicGenerationRequest = nil
+	req.ContextCacheCreateRequest = nil
 	req.VideoGenerationRequest = nil
 	req.VideoRetrieveRequest = nil
 	req.VideoDownloadRequest = nil
@@ -5679,6 +7029,17 @@ func resetBifrostRequest(req *schemas.BifrostRequest) {
 	req.ContainerFileRetrieveRequest = nil
 	req.ContainerFileContentRequest = nil
 	req.ContainerFileDeleteRequest = nil
+	req.FineTuningJobCreateRequest = nil
+	req.FineTuningJobListRequest = nil
+	req.FineTuningJobRetrieveRequest = nil
+	req.FineTuningJobCancelRequest = nil
+	req.FineTuningJobEventsRequest = nil
+	req.FineTuningJobCheckpointsRequest = nil
+	req.VectorStoreCreateRequest = nil
+	req.VectorStoreListRequest = nil
+	req.VectorStoreSearchRequest = nil
+	req.VectorStoreFileCreateRequest = nil
+	req.VectorStoreFileListRequest = nil
 }
 
 // getBifrostRequest gets a BifrostRequest from the pool
@@ -5867,7 +7228,7 @@ func (bifrost *Bifrost) selectKeyFromProviderForModel(ctx *schemas.BifrostContex
 
 	// Skip model check conditions
 	// We can improve these conditions in the future
-	skipModelCheck := (model == "" && (isFileRequestType(requestType) || isBatchRequestType(requestType) || isContainerRequestType(requestType) || isModellessVideoRequestType(requestType))) || requestType == schemas.ListModelsRequest
+	skipModelCheck := (model == "" && (isFileRequestType(requestType) || isBatchRequestType(requestType) || isContainerRequestType(requestType) || isFineTuningRequestType(requestType) || isVectorStoreRequestType(requestType) || isModellessVideoRequestType(requestType))) || requestType == schemas.ListModelsRequest
 	if skipModelCheck {
 		// When skipping model check: just verify keys are enabled and have values
 		for _, k := range keys {
@@ -5929,13 +7290,26 @@ func (bifrost *Bifrost) selectKeyFromProviderForModel(ctx *schemas.BifrostContex
 		return schemas.Key{}, fmt.Errorf("no keys found that support model: %s", model)
 	}
 
-	var requestedKeyName string
+	var requestedKeyID, requestedKeyName string
 	if ctx != nil {
+		if keyID, ok := ctx.Value(schemas.BifrostContextKeyAPIKeyID).(string); ok {
+			requestedKeyID = strings.TrimSpace(keyID)
+		}
 		if keyName, ok := ctx.Value(schemas.BifrostContextKeyAPIKeyName).(string); ok {
 			requestedKeyName = strings.TrimSpace(keyName)
 		}
 	}
 
+	// A pinned key must be one of the supported keys, which only holds the keys governance allows
+	if requestedKeyID != "" {
+		for _, key := range supportedKeys {
+			if key.ID == requestedKeyID {
+				return key, nil
+			}
+		}
+		return schemas.Key{}, fmt.Errorf("no key found with id %q for provider: %v", requestedKeyID, providerKey)
+	}
+
 	if requestedKeyName != "" {
 		for _, key := range supportedKeys {
 			if key.Name == requestedKeyName {
@@ -5945,6 +7319,9 @@ func (bifrost *Bifrost) selectKeyFromProviderForModel(ctx *schemas.BifrostContex
 		return schemas.Key{}, fmt.Errorf("no key found with name %q for provider: %v", requestedKeyName, providerKey)
 	}
 
+	// Keys cooling down after a rate limit are only used when no other key is available
+	supportedKeys = providerUtils.AvailableKeys(supportedKeys)
+
 	if len(supportedKeys) == 1 {
 		return supportedKeys[0], nil
 	}
@@ -3,11 +3,15 @@ package bifrost
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
 	schemas "github.com/capsohq/bifrost/core/schemas"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -161,6 +165,25 @@ func TestExecuteRequestWithRetries_RetryLimits(t *testing.T) {
 			t.Errorf("Expected rate limit error, got %s", err.Error.Message)
 		}
 	})
+
+	t.Run("RetryPolicyOnlyReplacesNonStreamRetries", func(t *testing.T) {
+		policyConfig := createTestConfig(2, time.Millisecond, time.Millisecond)
+		policyConfig.NetworkConfig.RetryPolicy = &schemas.RetryPolicy{MaxAttempts: 3}
+		for requestType, wantCalls := range map[schemas.RequestType]int{
+			schemas.ChatCompletionRequest:       1,
+			schemas.ChatCompletionStreamRequest: 3,
+		} {
+			callCount := 0
+			handler := func() (string, *schemas.BifrostError) {
+				callCount++
+				return "", createBifrostError("service unavailable", Ptr(503), nil, false)
+			}
+			executeRequestWithRetries(ctx, policyConfig, handler, requestType, schemas.OpenAI, "gpt-4", nil, logger)
+			if callCount != wantCalls {
+				t.Errorf("%s: expected %d calls, got %d", requestType, wantCalls, callCount)
+			}
+		}
+	})
 }
 
 // Test executeRequestWithRetries - non-retryable errors
@@ -996,3 +1019,203 @@ func TestUpdateProvider_ProviderSliceIntegrity(t *testing.T) {
 		}
 	})
 }
+
+// TestUpdateParameterPresets verifies that configured presets are indexed by name and alias,
+// override built-ins, and that conflicting aliases are rejected.
+func TestUpdateParameterPresets(t *testing.T) {
+	bifrost := &Bifrost{}
+	err := bifrost.UpdateParameterPresets([]schemas.ParameterPreset{
+		{Name: "summaries", Aliases: []string{"tldr"}, Params: map[string]interface{}{"temperature": 0.2}},
+		{Name: schemas.ParameterPresetCreative, Params: map[string]interface{}{"temperature": 1.3}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateParameterPresets() error = %v", err)
+	}
+
+	if preset, ok := bifrost.GetParameterPreset("tldr"); !ok || preset.Name != "summaries" {
+		t.Errorf("alias lookup = %v, %v; want summaries", preset, ok)
+	}
+	if preset, ok := bifrost.GetParameterPreset(schemas.ParameterPresetCreative); !ok || preset.Params["temperature"] != 1.3 {
+		t.Errorf("configured preset should replace built-in, got %v", preset)
+	}
+	if _, ok := bifrost.GetParameterPreset(schemas.ParameterPresetDeterministic); !ok {
+		t.Error("built-in preset should still be available")
+	}
+
+	err = bifrost.UpdateParameterPresets([]schemas.ParameterPreset{
+		{Name: "alias-clash", Aliases: []string{schemas.ParameterPresetJSONStrict}, Params: map[string]interface{}{"temperature": 0}},
+	})
+	if err == nil {
+		t.Error("expected error for alias that shadows a built-in preset")
+	}
+	if _, ok := bifrost.GetParameterPreset("tldr"); !ok {
+		t.Error("failed update should keep the previous presets")
+	}
+}
+
+// chatOnlyProvider implements schemas.ChatProvider and no other capability.
+type chatOnlyProvider struct{}
+
+func (p *chatOnlyProvider) GetProviderKey() schemas.ModelProvider { return schemas.OpenAI }
+
+func (p *chatOnlyProvider) ChatCompletion(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
+	return &schemas.BifrostChatResponse{ID: "chat-1"}, nil
+}
+
+func (p *chatOnlyProvider) ChatCompletionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostChatRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
+	return make(chan *schemas.BifrostStreamChunk), nil
+}
+
+func TestHandleProviderRequest_UnsupportedCapability(t *testing.T) {
+	bifrost := &Bifrost{}
+	provider := &chatOnlyProvider{}
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+
+	response, bifrostErr := bifrost.handleProviderRequest(provider, &ChannelMessage{
+		BifrostRequest: schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{}},
+		Context:        ctx,
+	}, schemas.Key{}, nil)
+	if bifrostErr != nil || response.ChatResponse == nil || response.ChatResponse.ID != "chat-1" {
+		t.Fatalf("chat completion = %+v, %+v", response, bifrostErr)
+	}
+
+	for _, requestType := range []schemas.RequestType{schemas.EmbeddingRequest, schemas.FileUploadRequest, schemas.VideoGenerationRequest} {
+		_, bifrostErr = bifrost.handleProviderRequest(provider, &ChannelMessage{
+			BifrostRequest: schemas.BifrostRequest{RequestType: requestType},
+			Context:        ctx,
+		}, schemas.Key{}, nil)
+		if bifrostErr == nil || bifrostErr.Error.Code == nil || *bifrostErr.Error.Code != "unsupported_operation" {
+			t.Errorf("%s: expected unsupported operation error, got %+v", requestType, bifrostErr)
+		}
+	}
+
+	text := "hello world"
+	response, bifrostErr = bifrost.handleProviderRequest(provider, &ChannelMessage{
+		BifrostRequest: schemas.BifrostRequest{RequestType: schemas.CountTokensRequest, CountTokensRequest: &schemas.BifrostResponsesRequest{
+			Provider: schemas.Qwen,
+			Model:    "qwen-plus",
+			Input:    []schemas.ResponsesMessage{{Role: schemas.Ptr(schemas.ResponsesInputMessageRoleUser), Content: &schemas.ResponsesMessageContent{ContentStr: &text}}},
+		}},
+		Context: ctx,
+	}, schemas.Key{}, nil)
+	if bifrostErr != nil || response.CountTokensResponse == nil || !response.CountTokensResponse.ExtraFields.Approximate || response.CountTokensResponse.InputTokens == 0 {
+		t.Errorf("count tokens: expected a local estimate, got %+v, %+v", response, bifrostErr)
+	}
+
+	if _, bifrostErr = bifrost.handleProviderStreamRequest(provider, &ChannelMessage{
+		BifrostRequest: schemas.BifrostRequest{RequestType: schemas.ChatCompletionStreamRequest, ChatRequest: &schemas.BifrostChatRequest{}},
+		Context:        ctx,
+	}, schemas.Key{}, nil); bifrostErr != nil {
+		t.Errorf("chat completion stream error = %+v", bifrostErr)
+	}
+	if _, bifrostErr = bifrost.handleProviderStreamRequest(provider, &ChannelMessage{
+		BifrostRequest: schemas.BifrostRequest{RequestType: schemas.SpeechStreamRequest},
+		Context:        ctx,
+	}, schemas.Key{}, nil); bifrostErr == nil || bifrostErr.ExtraFields.RequestType != schemas.SpeechStreamRequest {
+		t.Errorf("speech stream: expected unsupported operation error, got %+v", bifrostErr)
+	}
+}
+
+func TestProviderCapabilities(t *testing.T) {
+	for _, provider := range []schemas.ModelProvider{schemas.OpenAI, schemas.Anthropic, schemas.Elevenlabs, schemas.ModelArk, schemas.Volcengine} {
+		if _, ok := schemas.GetProviderCapabilities(provider); !ok {
+			t.Errorf("%s: capabilities not registered", provider)
+		}
+	}
+	if !schemas.ProviderSupportsRequestType(schemas.OpenAI, schemas.ChatCompletionStreamRequest) {
+		t.Error("expected openai to support chat completion streams")
+	}
+	if schemas.ProviderSupportsRequestType(schemas.Elevenlabs, schemas.ChatCompletionRequest) {
+		t.Error("expected elevenlabs not to support chat completions")
+	}
+	if !schemas.ProviderSupportsRequestType("my-custom-provider", schemas.RerankRequest) {
+		t.Error("expected unregistered providers to be assumed capable")
+	}
+}
+
+func TestRateLimitedKeyCoolsDown(t *testing.T) {
+	var limitedCalls, okCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") == "Bearer sk-limited" {
+			limitedCalls.Add(1)
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"error":{"message":"Rate limit reached","type":"rate_limit_exceeded"}}`)
+			return
+		}
+		okCalls.Add(1)
+		w.Header().Set("X-Ratelimit-Remaining-Requests", "99")
+		fmt.Fprint(w, `{"id":"chatcmpl-1","object":"chat.completion","model":"gpt-4o","choices":[{"index":0,"message":{"role":"assistant","content":"hi"},"finish_reason":"stop"}]}`)
+	}))
+	defer server.Close()
+
+	account := NewMockAccount()
+	account.AddProviderWithBaseURL(schemas.OpenAI, 1, 10, server.URL)
+	account.configs[schemas.OpenAI].NetworkConfig.RetryBackoffInitial = time.Millisecond
+	account.configs[schemas.OpenAI].NetworkConfig.RetryBackoffMax = time.Millisecond
+	account.keys[schemas.OpenAI] = []schemas.Key{
+		{ID: "cooldown-test-limited", Value: *schemas.NewEnvVar("sk-limited"), Weight: 1},
+		{ID: "cooldown-test-ok", Value: *schemas.NewEnvVar("sk-ok"), Weight: 1},
+	}
+	defer providerUtils.ClearKeyCooldown("cooldown-test-limited")
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	bifrost, err := Init(ctx, schemas.BifrostConfig{Account: account, Logger: NewDefaultLogger(schemas.LogLevelError)})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	defer bifrost.Shutdown()
+
+	text := "hello"
+	for i := 0; i < 10; i++ {
+		_, bifrostErr := bifrost.ChatCompletionRequest(schemas.NewBifrostContext(context.Background(), schemas.NoDeadline), &schemas.BifrostChatRequest{
+			Provider: schemas.OpenAI,
+			Model:    "gpt-4o",
+			Input:    []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: &text}}},
+		})
+		if bifrostErr != nil {
+			t.Fatalf("request %d failed: %+v", i, bifrostErr.Error)
+		}
+	}
+	// The limited key is tried at most once: its retry and all later requests go to the other key
+	if limitedCalls.Load() > 1 || okCalls.Load() != 10 {
+		t.Errorf("limited key calls = %d, other key calls = %d", limitedCalls.Load(), okCalls.Load())
+	}
+}
+
+func TestCheckURLPathOverride(t *testing.T) {
+	bifrost := &Bifrost{logger: NewDefaultLogger(schemas.LogLevelError)}
+	newMessage := func(override string) *ChannelMessage {
+		ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+		if override != "" {
+			ctx.SetValue(schemas.BifrostContextKeyURLPath, override)
+		}
+		return &ChannelMessage{
+			BifrostRequest: schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{Provider: schemas.Anthropic, Model: "claude-sonnet-4"}},
+			Context:        ctx,
+		}
+	}
+	config := &schemas.ProviderConfig{}
+
+	if err := bifrost.checkURLPathOverride(newMessage(""), schemas.Anthropic, schemas.Anthropic, config); err != nil {
+		t.Errorf("request without override: unexpected error %v", err.Error.Message)
+	}
+	if err := bifrost.checkURLPathOverride(newMessage("/v1/messages?beta=true"), schemas.Anthropic, schemas.Anthropic, config); err != nil {
+		t.Errorf("allowed override: unexpected error %v", err.Error.Message)
+	}
+	// Custom providers are checked against their base provider's allowlist
+	if err := bifrost.checkURLPathOverride(newMessage("/v1/messages"), "my-anthropic", schemas.Anthropic, config); err != nil {
+		t.Errorf("custom provider override: unexpected error %v", err.Error.Message)
+	}
+
+	err := bifrost.checkURLPathOverride(newMessage("/v1/organizations/api_keys"), schemas.Anthropic, schemas.Anthropic, config)
+	if err == nil || err.StatusCode == nil || *err.StatusCode != 400 || err.ExtraFields.Provider != schemas.Anthropic {
+		t.Fatalf("disallowed override: expected a 400 error, got %+v", err)
+	}
+
+	config.NetworkConfig.AllowedPathOverrides = []string{"/v1/organizations/*"}
+	if err := bifrost.checkURLPathOverride(newMessage("/v1/organizations/api_keys"), schemas.Anthropic, schemas.Anthropic, config); err != nil {
+		t.Errorf("override allowed by network config: unexpected error %v", err.Error.Message)
+	}
+}
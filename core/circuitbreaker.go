@@ -0,0 +1,267 @@
+package bifrost
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// breakerState is the state of a provider and model's circuit breaker.
+type breakerState int
+
+const (
+	breakerClosed   breakerState = iota // Requests flow; outcomes are counted
+	breakerOpen                         // Requests are rejected until the probe interval passes
+	breakerHalfOpen                     // A single probe request decides whether the circuit closes
+)
+
+// String returns the state name used in logs.
+func (s breakerState) String() string {
+	switch s {
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half_open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBucketCount is the number of buckets the counting window is split into.
+const circuitBucketCount = 10
+
+type circuitBucket struct {
+	index     int64 // bucket number since the unix epoch; stale buckets are reset on use
+	successes int
+	failures  int
+}
+
+// circuitBreaker tracks the upstream outcomes of one provider and model.
+type circuitBreaker struct {
+	mu       sync.Mutex
+	state    breakerState
+	openedAt time.Time // when the circuit last opened
+	probeAt  time.Time // when the half-open probe was let through
+	buckets  [circuitBucketCount]circuitBucket
+}
+
+// allow reports whether a request may go to the upstream. An open circuit lets a single probe
+// through once the probe interval has passed; a probe that never reports back is replaced after
+// another interval. When the request is rejected, the time until the next probe is returned.
+func (cb *circuitBreaker) allow(config *schemas.CircuitBreakerConfig, now time.Time) (bool, time.Duration) {
+	interval := time.Duration(config.GetProbeIntervalSeconds()) * time.Second
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case breakerOpen:
+		if wait := interval - now.Sub(cb.openedAt); wait > 0 {
+			return false, wait
+		}
+		cb.state = breakerHalfOpen
+		cb.probeAt = now
+		return true, 0
+	case breakerHalfOpen:
+		if wait := interval - now.Sub(cb.probeAt); wait > 0 {
+			return false, wait
+		}
+		cb.probeAt = now
+		return true, 0
+	default:
+		return true, 0
+	}
+}
+
+// isOpen reports whether allow would reject a request right now, without claiming the probe.
+func (cb *circuitBreaker) isOpen(config *schemas.CircuitBreakerConfig, now time.Time) bool {
+	interval := time.Duration(config.GetProbeIntervalSeconds()) * time.Second
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case breakerOpen:
+		return now.Sub(cb.openedAt) < interval
+	case breakerHalfOpen:
+		return now.Sub(cb.probeAt) < interval
+	default:
+		return false
+	}
+}
+
+// record counts an upstream outcome and moves the circuit between states. It returns the new
+// state and whether it changed.
+func (cb *circuitBreaker) record(config *schemas.CircuitBreakerConfig, failed bool, now time.Time) (breakerState, bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	switch cb.state {
+	case breakerHalfOpen:
+		if failed {
+			cb.state = breakerOpen
+			cb.openedAt = now
+		} else {
+			cb.state = breakerClosed
+			cb.buckets = [circuitBucketCount]circuitBucket{}
+		}
+		return cb.state, true
+	case breakerOpen:
+		// Late results of requests admitted before the circuit opened
+		return cb.state, false
+	}
+
+	width := max(int64(config.GetWindowSeconds())*int64(time.Second)/circuitBucketCount, 1)
+	index := now.UnixNano() / width
+	bucket := &cb.buckets[index%circuitBucketCount]
+	if bucket.index != index {
+		*bucket = circuitBucket{index: index}
+	}
+	if failed {
+		bucket.failures++
+	} else {
+		bucket.successes++
+	}
+
+	var successes, failures int
+	for _, b := range cb.buckets {
+		if index-b.index < circuitBucketCount {
+			successes += b.successes
+			failures += b.failures
+		}
+	}
+	total := successes + failures
+	if total < config.GetMinRequests() || float64(failures)/float64(total) < config.GetErrorRateThreshold() {
+		return cb.state, false
+	}
+	cb.state = breakerOpen
+	cb.openedAt = now
+	cb.buckets = [circuitBucketCount]circuitBucket{}
+	return cb.state, true
+}
+
+func circuitKey(provider schemas.ModelProvider, model string) string {
+	return string(provider) + "/" + model
+}
+
+// GetCircuitBreakerConfig returns the current circuit breaker config, or nil if it is disabled.
+func (bifrost *Bifrost) GetCircuitBreakerConfig() *schemas.CircuitBreakerConfig {
+	return bifrost.circuitBreaker.Load()
+}
+
+// UpdateCircuitBreakerConfig updates the circuit breaker config at runtime. A nil or disabled
+// config closes all circuits.
+func (bifrost *Bifrost) UpdateCircuitBreakerConfig(config *schemas.CircuitBreakerConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	bifrost.circuitBreaker.Store(config)
+	if config == nil || !config.Enabled {
+		bifrost.circuitBreakers.Clear()
+	}
+	if config != nil {
+		bifrost.logger.Info("circuit_breaker updated: enabled=%v", config.Enabled)
+	}
+	return nil
+}
+
+// checkCircuit returns a 503 error when the circuit of the provider and model is open, or nil
+// to let the request through.
+func (bifrost *Bifrost) checkCircuit(provider schemas.ModelProvider, model string) *schemas.BifrostError {
+	config := bifrost.circuitBreaker.Load()
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	value, ok := bifrost.circuitBreakers.Load(circuitKey(provider, model))
+	if !ok {
+		return nil
+	}
+	allowed, wait := value.(*circuitBreaker).allow(config, time.Now())
+	if allowed {
+		return nil
+	}
+	retryAfter := max(1, int(math.Ceil(wait.Seconds())))
+	return &schemas.BifrostError{
+		IsBifrostError: true,
+		StatusCode:     schemas.Ptr(fasthttp.StatusServiceUnavailable),
+		RetryAfter:     schemas.Ptr(retryAfter),
+		Error: &schemas.ErrorField{
+			Code:    schemas.Ptr("circuit_open"),
+			Message: fmt.Sprintf("circuit breaker for provider %s and model %s is open after repeated upstream failures; retry after %d seconds", provider, model, retryAfter),
+		},
+		ExtraFields: schemas.BifrostErrorExtraFields{
+			Provider:       provider,
+			ModelRequested: model,
+		},
+	}
+}
+
+// circuitOpen reports whether the circuit of the provider and model currently rejects requests.
+// Unlike checkCircuit it never lets a probe through, so it is safe for ranking candidates.
+func (bifrost *Bifrost) circuitOpen(provider schemas.ModelProvider, model string) bool {
+	config := bifrost.circuitBreaker.Load()
+	if config == nil || !config.Enabled {
+		return false
+	}
+	value, ok := bifrost.circuitBreakers.Load(circuitKey(provider, model))
+	return ok && value.(*circuitBreaker).isOpen(config, time.Now())
+}
+
+// recordCircuitOutcome feeds the result of a request into the circuit of its provider and model.
+// Only upstream failures (5xx responses, network errors and timeouts) count against the circuit;
+// any other response from the provider counts as a success, and errors raised before the request
+// reached the provider are ignored.
+func (bifrost *Bifrost) recordCircuitOutcome(provider schemas.ModelProvider, model string, bifrostError *schemas.BifrostError) {
+	config := bifrost.circuitBreaker.Load()
+	if config == nil || !config.Enabled {
+		return
+	}
+	failed, counted := isUpstreamFailure(bifrostError)
+	if !counted {
+		return
+	}
+	key := circuitKey(provider, model)
+	value, ok := bifrost.circuitBreakers.Load(key)
+	if !ok {
+		if !failed {
+			// Healthy traffic to a provider and model without a circuit needs no tracking yet
+			return
+		}
+		value, _ = bifrost.circuitBreakers.LoadOrStore(key, &circuitBreaker{})
+	}
+	if state, changed := value.(*circuitBreaker).record(config, failed, time.Now()); changed {
+		switch state {
+		case breakerOpen:
+			bifrost.logger.Warn("circuit breaker opened for provider %s and model %s", provider, model)
+		case breakerClosed:
+			bifrost.logger.Info("circuit breaker closed for provider %s and model %s", provider, model)
+		}
+		if bifrost.circuitBreakerObserver != nil {
+			bifrost.circuitBreakerObserver(schemas.CircuitBreakerEvent{
+				Provider: provider,
+				Model:    model,
+				Open:     state == breakerOpen,
+			})
+		}
+	}
+}
+
+// isUpstreamFailure classifies a request error for the circuit breaker. It reports whether the
+// upstream failed and whether the outcome counts at all.
+func isUpstreamFailure(bifrostError *schemas.BifrostError) (failed bool, counted bool) {
+	if bifrostError == nil {
+		return false, true
+	}
+	if bifrostError.Error != nil && bifrostError.Error.Type != nil && *bifrostError.Error.Type == schemas.RequestCancelled {
+		return false, false
+	}
+	if bifrostError.StatusCode != nil {
+		return *bifrostError.StatusCode >= fasthttp.StatusInternalServerError, true
+	}
+	if bifrostError.Error != nil {
+		switch bifrostError.Error.Message {
+		case schemas.ErrProviderDoRequest, schemas.ErrProviderNetworkError, schemas.ErrProviderRequestTimedOut:
+			return true, true
+		}
+	}
+	return false, false
+}
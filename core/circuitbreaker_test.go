@@ -0,0 +1,149 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestCircuitBreakerStates(t *testing.T) {
+	config := &schemas.CircuitBreakerConfig{Enabled: true, ErrorRateThreshold: 0.5, MinRequests: 4, WindowSeconds: 10, ProbeIntervalSeconds: 5}
+	cb := &circuitBreaker{}
+	now := time.Unix(1_000_000, 0)
+
+	// Below the minimum request count the circuit stays closed
+	cb.record(config, true, now)
+	cb.record(config, true, now)
+	cb.record(config, false, now)
+	if cb.state != breakerClosed {
+		t.Fatalf("state = %s after 3 requests, want closed", cb.state)
+	}
+	if state, changed := cb.record(config, true, now); state != breakerOpen || !changed {
+		t.Fatalf("record() = %s, %v, want open after 3 of 4 requests failed", state, changed)
+	}
+
+	if allowed, wait := cb.allow(config, now.Add(2*time.Second)); allowed || wait != 3*time.Second {
+		t.Errorf("allow() = %v, %v while open, want rejected for 3s", allowed, wait)
+	}
+	// One probe is let through after the probe interval
+	if allowed, _ := cb.allow(config, now.Add(5*time.Second)); !allowed || cb.state != breakerHalfOpen {
+		t.Fatalf("allow() = %v in state %s, want a half-open probe", allowed, cb.state)
+	}
+	if allowed, _ := cb.allow(config, now.Add(6*time.Second)); allowed {
+		t.Error("expected a single probe while half-open")
+	}
+	// A failed probe opens the circuit again, a successful one closes it
+	cb.record(config, true, now.Add(6*time.Second))
+	if allowed, _ := cb.allow(config, now.Add(10*time.Second)); allowed || cb.state != breakerOpen {
+		t.Errorf("allow() = %v in state %s, want open after a failed probe", allowed, cb.state)
+	}
+	cb.allow(config, now.Add(11*time.Second))
+	if state, _ := cb.record(config, false, now.Add(11*time.Second)); state != breakerClosed {
+		t.Errorf("state = %s after a successful probe, want closed", state)
+	}
+}
+
+func TestCircuitBreakerWindow(t *testing.T) {
+	config := &schemas.CircuitBreakerConfig{Enabled: true, MinRequests: 4, WindowSeconds: 10}
+	cb := &circuitBreaker{}
+	now := time.Unix(1_000_000, 0)
+	for i := range 3 {
+		cb.record(config, true, now.Add(time.Duration(i)*time.Second))
+	}
+	// The earlier failures have left the window
+	cb.record(config, true, now.Add(15*time.Second))
+	if cb.state != breakerClosed {
+		t.Errorf("state = %s, want closed once old failures leave the window", cb.state)
+	}
+}
+
+func TestIsUpstreamFailure(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     *schemas.BifrostError
+		failed  bool
+		counted bool
+	}{
+		{"success", nil, false, true},
+		{"server error", &schemas.BifrostError{StatusCode: schemas.Ptr(502), Error: &schemas.ErrorField{Message: "bad gateway"}}, true, true},
+		{"client error", &schemas.BifrostError{StatusCode: schemas.Ptr(400), Error: &schemas.ErrorField{Message: "bad request"}}, false, true},
+		{"network error", &schemas.BifrostError{Error: &schemas.ErrorField{Message: schemas.ErrProviderNetworkError}}, true, true},
+		{"timeout", &schemas.BifrostError{IsBifrostError: true, Error: &schemas.ErrorField{Message: schemas.ErrProviderRequestTimedOut}}, true, true},
+		{"cancelled", &schemas.BifrostError{Error: &schemas.ErrorField{Type: schemas.Ptr(schemas.RequestCancelled), Message: "cancelled"}}, false, false},
+		{"bifrost error", &schemas.BifrostError{IsBifrostError: true, Error: &schemas.ErrorField{Message: "no keys found"}}, false, false},
+	}
+	for _, tt := range tests {
+		if failed, counted := isUpstreamFailure(tt.err); failed != tt.failed || counted != tt.counted {
+			t.Errorf("%s: isUpstreamFailure() = %v, %v, want %v, %v", tt.name, failed, counted, tt.failed, tt.counted)
+		}
+	}
+}
+
+func TestOpenCircuitRoutesToFallback(t *testing.T) {
+	var brokenCalls, okCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(string(body), "gpt-broken") {
+			brokenCalls.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprint(w, `{"error":{"message":"internal error","type":"server_error"}}`)
+			return
+		}
+		okCalls.Add(1)
+		fmt.Fprint(w, `{"id":"chatcmpl-1","object":"chat.completion","model":"gpt-4o","choices":[{"index":0,"message":{"role":"assistant","content":"hi"},"finish_reason":"stop"}]}`)
+	}))
+	defer server.Close()
+
+	account := NewMockAccount()
+	account.AddProviderWithBaseURL(schemas.OpenAI, 1, 10, server.URL)
+	account.configs[schemas.OpenAI].NetworkConfig.MaxRetries = 0
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	bifrost, err := Init(ctx, schemas.BifrostConfig{
+		Account:        account,
+		Logger:         NewDefaultLogger(schemas.LogLevelError),
+		CircuitBreaker: &schemas.CircuitBreakerConfig{Enabled: true, MinRequests: 2},
+	})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	defer bifrost.Shutdown()
+
+	text := "hello"
+	for i := 0; i < 5; i++ {
+		_, bifrostErr := bifrost.ChatCompletionRequest(schemas.NewBifrostContext(context.Background(), schemas.NoDeadline), &schemas.BifrostChatRequest{
+			Provider:  schemas.OpenAI,
+			Model:     "gpt-broken",
+			Input:     []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: &text}}},
+			Fallbacks: []schemas.Fallback{{Provider: schemas.OpenAI, Model: "gpt-4o"}},
+		})
+		if bifrostErr != nil {
+			t.Fatalf("request %d failed: %+v", i, bifrostErr.Error)
+		}
+	}
+	// Once the circuit opens, requests go straight to the fallback
+	if brokenCalls.Load() != 2 || okCalls.Load() != 5 {
+		t.Errorf("broken model calls = %d, fallback calls = %d", brokenCalls.Load(), okCalls.Load())
+	}
+
+	// Without a fallback the open circuit fails fast
+	_, bifrostErr := bifrost.ChatCompletionRequest(schemas.NewBifrostContext(context.Background(), schemas.NoDeadline), &schemas.BifrostChatRequest{
+		Provider: schemas.OpenAI,
+		Model:    "gpt-broken",
+		Input:    []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: &text}}},
+	})
+	if bifrostErr == nil || bifrostErr.Error.Code == nil || *bifrostErr.Error.Code != "circuit_open" || bifrostErr.RetryAfter == nil {
+		t.Fatalf("expected a circuit_open error, got %+v", bifrostErr)
+	}
+	if brokenCalls.Load() != 2 {
+		t.Errorf("broken model calls = %d after the circuit opened, want 2", brokenCalls.Load())
+	}
+}
@@ -0,0 +1,119 @@
+package bifrost
+
+import (
+	"sort"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/core/tokenizer"
+)
+
+// GetCostRoutingConfig returns the current cost routing config, or nil if no aliases are set.
+func (bifrost *Bifrost) GetCostRoutingConfig() *schemas.CostRoutingConfig {
+	return bifrost.costRouting.Load()
+}
+
+// UpdateCostRoutingConfig replaces the cost-routed model aliases at runtime.
+func (bifrost *Bifrost) UpdateCostRoutingConfig(config *schemas.CostRoutingConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	bifrost.costRouting.Store(config)
+	if config != nil {
+		bifrost.logger.Info("cost_routing updated: %d aliases, quality_floor=%v", len(config.Aliases), config.QualityFloor)
+	}
+	return nil
+}
+
+// EstimateCost returns the estimated cost of a request to a provider and model with the given
+// prompt and output tokens, using the configured model pricing. It returns false when the model
+// has no known pricing.
+func (bifrost *Bifrost) EstimateCost(provider schemas.ModelProvider, model string, requestType schemas.RequestType, promptTokens, outputTokens int) (float64, bool) {
+	if bifrost.modelPricing == nil {
+		return 0, false
+	}
+	inputCost, outputCost, ok := bifrost.modelPricing(provider, model, requestType)
+	if !ok {
+		return 0, false
+	}
+	return float64(promptTokens)*inputCost + float64(outputTokens)*outputCost, true
+}
+
+// routeCostAlias ranks the targets of a cost-routed alias that meet the quality floor, cheapest
+// healthy target first.
+func (bifrost *Bifrost) routeCostAlias(name string, requestType schemas.RequestType, promptTokens, maxOutputTokens int) ([]schemas.Fallback, bool) {
+	config := bifrost.costRouting.Load()
+	if config == nil {
+		return nil, false
+	}
+	targets, ok := config.Aliases[name]
+	if !ok {
+		return nil, false
+	}
+	outputTokens := maxOutputTokens
+	if outputTokens <= 0 {
+		outputTokens = config.GetExpectedOutputTokens()
+	}
+
+	const (
+		tierPriced    = iota // ranked by estimated cost
+		tierUnpriced         // no pricing known; kept in config order
+		tierUnhealthy        // unsupported, circuit open or mostly failing
+	)
+	type rankedTarget struct {
+		target schemas.Fallback
+		tier   int
+		cost   float64
+	}
+	ranked := make([]rankedTarget, 0, len(targets))
+	for _, target := range targets {
+		if target.Quality < config.QualityFloor {
+			continue
+		}
+		entry := rankedTarget{target: schemas.Fallback{Provider: target.Provider, Model: target.Model}}
+		cost, priced := bifrost.EstimateCost(target.Provider, target.Model, requestType, promptTokens, outputTokens)
+		switch {
+		case bifrost.isAliasTargetUnhealthy(target.Provider, target.Model, requestType, bifrost.GetLatencyStats(target.Provider, target.Model)):
+			entry.tier = tierUnhealthy
+		case !priced:
+			entry.tier = tierUnpriced
+		default:
+			entry.tier = tierPriced
+			entry.cost = cost
+		}
+		ranked = append(ranked, entry)
+	}
+	if len(ranked) == 0 {
+		return nil, false
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].tier != ranked[j].tier {
+			return ranked[i].tier < ranked[j].tier
+		}
+		return ranked[i].tier == tierPriced && ranked[i].cost < ranked[j].cost
+	})
+	routed := make([]schemas.Fallback, len(ranked))
+	for i, entry := range ranked {
+		routed[i] = entry.target
+	}
+	return routed, true
+}
+
+// estimateRequestTokens returns the estimated prompt tokens of a text, chat or responses request
+// and its output token limit (0 if it sets none). Other requests are estimated at 0 tokens.
+func estimateRequestTokens(req *schemas.BifrostRequest) (promptTokens, maxOutputTokens int) {
+	var responsesReq *schemas.BifrostResponsesRequest
+	switch {
+	case req.ResponsesRequest != nil:
+		responsesReq = req.ResponsesRequest
+	case req.ChatRequest != nil:
+		responsesReq = req.ChatRequest.ToResponsesRequest()
+	case req.TextCompletionRequest != nil:
+		responsesReq = req.TextCompletionRequest.ToBifrostChatRequest().ToResponsesRequest()
+	default:
+		return 0, 0
+	}
+	if responsesReq.Params != nil && responsesReq.Params.MaxOutputTokens != nil {
+		maxOutputTokens = *responsesReq.Params.MaxOutputTokens
+	}
+	return tokenizer.DefaultRegistry.CountResponsesRequest(responsesReq).InputTokens, maxOutputTokens
+}
@@ -0,0 +1,74 @@
+package bifrost
+
+import (
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestRouteCostAlias(t *testing.T) {
+	prices := map[string][2]float64{
+		"openai/gpt-4o":          {2.5e-6, 10e-6},
+		"openai/gpt-4o-mini":     {0.15e-6, 0.6e-6},
+		"groq/llama-3.1-8b":      {0.05e-6, 0.08e-6},
+		"anthropic/claude-haiku": {0.8e-6, 4e-6},
+	}
+	bifrost := &Bifrost{
+		account: NewMockAccount(),
+		logger:  NewDefaultLogger(schemas.LogLevelError),
+		modelPricing: func(provider schemas.ModelProvider, model string, _ schemas.RequestType) (float64, float64, bool) {
+			price, ok := prices[string(provider)+"/"+model]
+			return price[0], price[1], ok
+		},
+	}
+	if err := bifrost.UpdateCostRoutingConfig(&schemas.CostRoutingConfig{
+		Aliases: map[string][]schemas.CostRoutingTarget{"chat": {
+			{Provider: schemas.OpenAI, Model: "gpt-4o", Quality: 90},
+			{Provider: schemas.Mistral, Model: "unpriced", Quality: 80},
+			{Provider: schemas.Anthropic, Model: "claude-haiku", Quality: 75},
+			{Provider: schemas.OpenAI, Model: "gpt-4o-mini", Quality: 70},
+			{Provider: schemas.Groq, Model: "llama-3.1-8b", Quality: 40},
+		}},
+		QualityFloor: 60,
+	}); err != nil {
+		t.Fatalf("UpdateCostRoutingConfig() error = %v", err)
+	}
+
+	// The cheapest target meeting the quality floor comes first; unpriced targets come after the priced ones
+	routed, ok := bifrost.RouteModelAlias("chat", schemas.ChatCompletionRequest, 1000, 0)
+	want := []schemas.Fallback{
+		{Provider: schemas.OpenAI, Model: "gpt-4o-mini"},
+		{Provider: schemas.Anthropic, Model: "claude-haiku"},
+		{Provider: schemas.OpenAI, Model: "gpt-4o"},
+		{Provider: schemas.Mistral, Model: "unpriced"},
+	}
+	if !ok || len(routed) != len(want) {
+		t.Fatalf("RouteModelAlias() = %+v, want %+v", routed, want)
+	}
+	for i := range want {
+		if routed[i] != want[i] {
+			t.Errorf("RouteModelAlias()[%d] = %+v, want %+v", i, routed[i], want[i])
+		}
+	}
+
+	req := &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{
+		Model:  "chat",
+		Input:  []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("Hello there")}}},
+		Params: &schemas.ChatParameters{MaxCompletionTokens: schemas.Ptr(50)},
+	}}
+	if promptTokens, maxOutputTokens := estimateRequestTokens(req); promptTokens == 0 || maxOutputTokens != 50 {
+		t.Errorf("estimateRequestTokens() = %d, %d", promptTokens, maxOutputTokens)
+	}
+	bifrost.routeModelAlias(schemas.NewBifrostContext(nil, schemas.NoDeadline), req)
+	if provider, model, fallbacks := req.GetRequestFields(); provider != schemas.OpenAI || model != "gpt-4o-mini" || len(fallbacks) != 3 {
+		t.Errorf("routed request = %s/%s with fallbacks %+v", provider, model, fallbacks)
+	}
+
+	invalid := &schemas.CostRoutingConfig{
+		Aliases:      map[string][]schemas.CostRoutingTarget{"chat": {{Provider: schemas.Groq, Model: "llama-3.1-8b", Quality: 40}}},
+		QualityFloor: 60,
+	}
+	if invalid.Validate() == nil {
+		t.Error("expected an alias without a target meeting the quality floor to be invalid")
+	}
+}
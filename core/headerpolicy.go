@@ -0,0 +1,103 @@
+package bifrost
+
+import (
+	"slices"
+	"strings"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// GetHeaderPolicyConfig returns the current header policy, or nil if none is configured.
+func (bifrost *Bifrost) GetHeaderPolicyConfig() *schemas.HeaderPolicyConfig {
+	return bifrost.headerPolicy.Load()
+}
+
+// UpdateHeaderPolicyConfig updates the headers forwarded, stripped and injected on provider requests at runtime.
+// A nil config sends the x-bf-eh-* headers of requests only.
+func (bifrost *Bifrost) UpdateHeaderPolicyConfig(config *schemas.HeaderPolicyConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	bifrost.headerPolicy.Store(config)
+	if config != nil {
+		bifrost.logger.Info("header_policy updated: %d forwarded, %d stripped, %d injections", len(config.Forward), len(config.Strip), len(config.Inject))
+	}
+	return nil
+}
+
+// applyHeaderPolicy sets the extra headers of a request to provider from the header policy. The extra headers
+// the request came with are kept aside the first time, so a fallback to another provider starts from them again.
+func (bifrost *Bifrost) applyHeaderPolicy(ctx *schemas.BifrostContext, provider schemas.ModelProvider) {
+	policy := bifrost.headerPolicy.Load()
+	if policy == nil {
+		return
+	}
+	clientHeaders, ok := ctx.Value(schemas.BifrostContextKeyClientExtraHeaders).(map[string][]string)
+	if !ok {
+		extraHeaders, _ := ctx.Value(schemas.BifrostContextKeyExtraHeaders).(map[string][]string)
+		clientHeaders = make(map[string][]string, len(extraHeaders))
+		for name, values := range extraHeaders {
+			clientHeaders[name] = slices.Clone(values)
+		}
+		ctx.SetValue(schemas.BifrostContextKeyClientExtraHeaders, clientHeaders)
+	}
+	requestHeaders, _ := ctx.Value(schemas.BifrostContextKeyRequestHeaders).(map[string]string)
+	teamID, _ := ctx.Value(schemas.BifrostContextKeyGovernanceTeamID).(string)
+	ctx.SetValue(schemas.BifrostContextKeyExtraHeaders, resolveHeaderPolicy(policy, clientHeaders, requestHeaders, provider, teamID))
+}
+
+// resolveHeaderPolicy returns the extra headers of a request to provider for teamID: the extra headers of the
+// request and its request headers matching a forward rule, minus the stripped ones, then the matching injections.
+// Header names are lower-cased.
+func resolveHeaderPolicy(policy *schemas.HeaderPolicyConfig, extraHeaders map[string][]string, requestHeaders map[string]string, provider schemas.ModelProvider, teamID string) map[string][]string {
+	headers := make(map[string][]string, len(extraHeaders))
+	for name, values := range extraHeaders {
+		name = strings.ToLower(name)
+		if matchesAnyHeader(policy.Strip, name) {
+			continue
+		}
+		headers[name] = append(headers[name], values...)
+	}
+	if len(policy.Forward) > 0 {
+		for name, value := range requestHeaders {
+			name = strings.ToLower(name)
+			if _, ok := headers[name]; ok || schemas.IsProtectedHeader(name) {
+				continue
+			}
+			if matchesAnyHeader(policy.Forward, name) && !matchesAnyHeader(policy.Strip, name) {
+				headers[name] = []string{value}
+			}
+		}
+	}
+	// Headers sent by the client, which injections replace only when they override them
+	clientSent := make(map[string]bool, len(headers))
+	for name := range headers {
+		clientSent[name] = true
+	}
+	for _, injection := range policy.Inject {
+		if len(injection.Providers) > 0 && !slices.Contains(injection.Providers, provider) {
+			continue
+		}
+		if len(injection.Teams) > 0 && !slices.Contains(injection.Teams, teamID) {
+			continue
+		}
+		for name, value := range injection.Headers {
+			name = strings.ToLower(name)
+			if clientSent[name] && !injection.Override {
+				continue
+			}
+			headers[name] = []string{value}
+		}
+	}
+	return headers
+}
+
+// matchesAnyHeader reports whether a header name matches one of patterns.
+func matchesAnyHeader(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if schemas.MatchesHeader(pattern, name) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,125 @@
+package bifrost
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestResolveHeaderPolicy(t *testing.T) {
+	policy := &schemas.HeaderPolicyConfig{
+		Forward: []string{"x-request-id", "openai-*"},
+		Strip:   []string{"x-internal-*", "openai-project"},
+		Inject: []schemas.HeaderInjection{
+			{Providers: []schemas.ModelProvider{schemas.OpenAI}, Headers: map[string]string{"OpenAI-Organization": "org-default", "x-tier": "default"}},
+			{Teams: []string{"team-a"}, Headers: map[string]string{"x-tier": "gold"}},
+			{Providers: []schemas.ModelProvider{schemas.OpenAI}, Teams: []string{"team-a"}, Headers: map[string]string{"openai-organization": "org-a"}, Override: true},
+		},
+	}
+	extraHeaders := map[string][]string{"x-trace": {"t1"}, "x-internal-debug": {"1"}}
+	requestHeaders := map[string]string{
+		"x-request-id":        "req-1",
+		"openai-organization": "org-client",
+		"openai-project":      "proj-client",
+		"authorization":       "Bearer sk-client",
+		"user-agent":          "curl",
+	}
+
+	tests := []struct {
+		name     string
+		provider schemas.ModelProvider
+		teamID   string
+		want     map[string][]string
+	}{
+		{
+			name:     "client headers are kept over non-overriding injections",
+			provider: schemas.OpenAI,
+			want: map[string][]string{
+				"x-trace":             {"t1"},
+				"x-request-id":        {"req-1"},
+				"openai-organization": {"org-client"},
+				"x-tier":              {"default"},
+			},
+		},
+		{
+			name:     "later and overriding injections win",
+			provider: schemas.OpenAI,
+			teamID:   "team-a",
+			want: map[string][]string{
+				"x-trace":             {"t1"},
+				"x-request-id":        {"req-1"},
+				"openai-organization": {"org-a"},
+				"x-tier":              {"gold"},
+			},
+		},
+		{
+			name:     "provider injections only apply to their providers",
+			provider: schemas.Anthropic,
+			teamID:   "team-b",
+			want: map[string][]string{
+				"x-trace":             {"t1"},
+				"x-request-id":        {"req-1"},
+				"openai-organization": {"org-client"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveHeaderPolicy(policy, extraHeaders, requestHeaders, tt.provider, tt.teamID)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveHeaderPolicy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyHeaderPolicy_Fallbacks(t *testing.T) {
+	bifrost := &Bifrost{logger: NewDefaultLogger(schemas.LogLevelError)}
+	err := bifrost.UpdateHeaderPolicyConfig(&schemas.HeaderPolicyConfig{
+		Inject: []schemas.HeaderInjection{{Providers: []schemas.ModelProvider{schemas.OpenAI}, Headers: map[string]string{"openai-organization": "org-1"}}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateHeaderPolicyConfig() error = %v", err)
+	}
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyExtraHeaders, map[string][]string{"x-trace": {"t1"}})
+
+	bifrost.applyHeaderPolicy(ctx, schemas.OpenAI)
+	headers := ctx.Value(schemas.BifrostContextKeyExtraHeaders).(map[string][]string)
+	if headers["openai-organization"] == nil {
+		t.Fatalf("extra headers = %v, want the injected header", headers)
+	}
+	// A provider may add headers to the map it is given
+	headers["anthropic-beta"] = []string{"tools"}
+
+	// A fallback to another provider starts from the headers of the client
+	bifrost.applyHeaderPolicy(ctx, schemas.Anthropic)
+	want := map[string][]string{"x-trace": {"t1"}}
+	if got := ctx.Value(schemas.BifrostContextKeyExtraHeaders); !reflect.DeepEqual(got, want) {
+		t.Errorf("extra headers after fallback = %v, want %v", got, want)
+	}
+}
+
+func TestHeaderPolicyConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *schemas.HeaderPolicyConfig
+		wantErr bool
+	}{
+		{name: "nil", config: nil},
+		{name: "valid", config: &schemas.HeaderPolicyConfig{Forward: []string{"x-request-id", "openai-*"}, Strip: []string{"*"}}},
+		{name: "forwarded credentials", config: &schemas.HeaderPolicyConfig{Forward: []string{"Authorization"}}, wantErr: true},
+		{name: "injected bifrost header", config: &schemas.HeaderPolicyConfig{Inject: []schemas.HeaderInjection{{Headers: map[string]string{"x-bf-vk": "vk"}}}}, wantErr: true},
+		{name: "injection without headers", config: &schemas.HeaderPolicyConfig{Inject: []schemas.HeaderInjection{{Teams: []string{"team-a"}}}}, wantErr: true},
+		{name: "invalid name", config: &schemas.HeaderPolicyConfig{Strip: []string{"x request"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
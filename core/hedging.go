@@ -0,0 +1,197 @@
+package bifrost
+
+import (
+	"fmt"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/google/uuid"
+)
+
+// hedgeDelay returns how long a request waits for its first byte before it is hedged, or 0 when
+// the request did not opt in to hedging.
+func hedgeDelay(ctx *schemas.BifrostContext) time.Duration {
+	delay, ok := ctx.Value(schemas.BifrostContextKeyHedgeDelay).(time.Duration)
+	if !ok || delay <= 0 {
+		return 0
+	}
+	return delay
+}
+
+// hedgeRequest returns the request a hedge is sent as and the index of the fallback it uses: the
+// first fallback that can take the request, or a copy of the request itself (index -1), which goes
+// through key selection again.
+func (bifrost *Bifrost) hedgeRequest(req *schemas.BifrostRequest, fallbacks []schemas.Fallback) (*schemas.BifrostRequest, int) {
+	for i, fallback := range fallbacks {
+		if fallbackReq := bifrost.prepareFallbackRequest(req, fallback); fallbackReq != nil {
+			return fallbackReq, i
+		}
+	}
+	hedgeReq := *req
+	return &hedgeReq, -1
+}
+
+type hedgeResult[T any] struct {
+	value T
+	err   *schemas.BifrostError
+	ctx   *schemas.BifrostContext
+	hedge bool
+}
+
+// tryHedged sends the request to the primary provider and, when it has not answered within the
+// delay, sends the same request as a hedge to the first usable fallback (or again to the primary
+// provider). The first successful attempt wins and the other one is cancelled through its context;
+// discard, if set, releases the result of an attempt that succeeded too late. When the primary
+// fails before the delay no hedge is sent. It returns the fallbacks left after the hedge.
+func tryHedged[T any](
+	bifrost *Bifrost,
+	ctx *schemas.BifrostContext,
+	req *schemas.BifrostRequest,
+	fallbacks []schemas.Fallback,
+	delay time.Duration,
+	try func(*schemas.BifrostContext, *schemas.BifrostRequest) (T, *schemas.BifrostError),
+	discard func(T),
+) (T, *schemas.BifrostError, []schemas.Fallback) {
+	results := make(chan hedgeResult[T], 2)
+	start := func(attemptCtx *schemas.BifrostContext, attemptReq *schemas.BifrostRequest, hedge bool) {
+		go func() {
+			value, err := try(attemptCtx, attemptReq)
+			results <- hedgeResult[T]{value: value, err: err, ctx: attemptCtx, hedge: hedge}
+		}()
+	}
+
+	// Each attempt runs on its own copy of the request and in its own context, so the loser can be
+	// cancelled without touching the winner
+	primaryCtx, cancelPrimary := schemas.NewBifrostContextWithCancel(ctx)
+	primaryReq := *req
+	start(primaryCtx, &primaryReq, false)
+	pending := 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	hedgeTimer := timer.C
+	hedged := false
+	cancelHedge := func() {}
+	var primaryErr *schemas.BifrostError
+	var primaryErrCtx *schemas.BifrostContext
+	var zero T
+	for {
+		select {
+		case <-hedgeTimer:
+			hedgeTimer = nil
+			hedgeReq, index := bifrost.hedgeRequest(req, fallbacks)
+			var hedgeCtx *schemas.BifrostContext
+			hedgeCtx, cancelHedge = schemas.NewBifrostContextWithCancel(ctx)
+			hedgeCtx.SetValue(schemas.BifrostContextKeyFallbackRequestID, uuid.New().String())
+			if index >= 0 {
+				hedgeCtx.SetValue(schemas.BifrostContextKeyFallbackIndex, index+1)
+				fallbacks = fallbacks[index+1:]
+			}
+			provider, model, _ := hedgeReq.GetRequestFields()
+			bifrost.logger.Debug(fmt.Sprintf("no response after %v, hedging request with provider %s and model %s", delay, provider, model))
+			hedged = true
+			pending++
+			start(hedgeCtx, hedgeReq, true)
+		case result := <-results:
+			pending--
+			if result.err == nil {
+				if result.hedge {
+					cancelPrimary()
+				} else {
+					cancelHedge()
+				}
+				copyUserValues(ctx, result.ctx)
+				if pending > 0 && discard != nil {
+					go func() {
+						if late := <-results; late.err == nil {
+							discard(late.value)
+						}
+					}()
+				}
+				return result.value, nil, fallbacks
+			}
+			if !result.hedge {
+				primaryErr, primaryErrCtx = result.err, result.ctx
+			}
+			if !hedged {
+				// The primary failed on its own; regular fallbacks take over from here
+				copyUserValues(ctx, result.ctx)
+				return zero, result.err, fallbacks
+			}
+			if pending == 0 {
+				if primaryErr == nil {
+					primaryErr, primaryErrCtx = result.err, result.ctx
+				}
+				copyUserValues(ctx, primaryErrCtx)
+				return zero, primaryErr, fallbacks
+			}
+		}
+	}
+}
+
+// copyUserValues copies the values an attempt set on its context to the request context, so the
+// caller sees e.g. the selected key and provider response headers of the attempt that was used.
+func copyUserValues(dst, src *schemas.BifrostContext) {
+	for key, value := range src.GetUserValues() {
+		dst.SetValue(key, value)
+	}
+}
+
+// tryStreamRequestToFirstChunk is tryStreamRequest for hedging: a stream only counts as answered
+// once its first chunk arrives. A first chunk carrying an error fails the attempt. The returned
+// stream replays the first chunk before the rest.
+func (bifrost *Bifrost) tryStreamRequestToFirstChunk(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
+	stream, bifrostErr := bifrost.tryStreamRequest(ctx, req)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	var first *schemas.BifrostStreamChunk
+	select {
+	case chunk, ok := <-stream:
+		if !ok {
+			return stream, nil
+		}
+		first = chunk
+	case <-ctx.Done():
+		go drainStream(stream)
+		provider, model, _ := req.GetRequestFields()
+		return nil, &schemas.BifrostError{
+			IsBifrostError: true,
+			Error: &schemas.ErrorField{
+				Type:    schemas.Ptr(schemas.RequestCancelled),
+				Message: fmt.Sprintf("request cancelled waiting for the first stream chunk: %v", ctx.Err()),
+				Error:   ctx.Err(),
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    req.RequestType,
+				Provider:       provider,
+				ModelRequested: model,
+			},
+		}
+	}
+	if first != nil && first.BifrostError != nil {
+		go drainStream(stream)
+		return nil, first.BifrostError
+	}
+
+	output := make(chan *schemas.BifrostStreamChunk, max(cap(stream), 1))
+	output <- first
+	go func() {
+		defer close(output)
+		for chunk := range stream {
+			select {
+			case output <- chunk:
+			case <-ctx.Done():
+				drainStream(stream)
+				return
+			}
+		}
+	}()
+	return output, nil
+}
+
+// drainStream reads a stream to its end so the goroutine producing it can finish.
+func drainStream(stream chan *schemas.BifrostStreamChunk) {
+	for range stream {
+	}
+}
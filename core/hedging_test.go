@@ -0,0 +1,140 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// newHedgingTestServer serves OpenAI chat completions; requests for gpt-slow hang until release
+// is closed, while any other model answers right away.
+func newHedgingTestServer(t *testing.T, slowCalls, fastCalls *atomic.Int32) (*httptest.Server, chan struct{}) {
+	release := make(chan struct{})
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		stream := strings.Contains(string(body), `"stream":true`)
+		if stream {
+			w.Header().Set("Content-Type", "text/event-stream")
+		}
+		model := "gpt-4o"
+		if strings.Contains(string(body), "gpt-slow") {
+			slowCalls.Add(1)
+			model = "gpt-slow"
+			if stream {
+				w.WriteHeader(http.StatusOK)
+				w.(http.Flusher).Flush()
+			}
+			select {
+			case <-release:
+			case <-r.Context().Done():
+				return
+			}
+		} else {
+			fastCalls.Add(1)
+		}
+		if stream {
+			fmt.Fprintf(w, "data: {\"id\":\"chatcmpl-1\",\"object\":\"chat.completion.chunk\",\"model\":%q,\"choices\":[{\"index\":0,\"delta\":{\"content\":\"hi\"}}]}\n\n", model)
+			fmt.Fprint(w, "data: {\"id\":\"chatcmpl-1\",\"object\":\"chat.completion.chunk\",\"choices\":[{\"index\":0,\"delta\":{},\"finish_reason\":\"stop\"}]}\n\ndata: [DONE]\n\n")
+			return
+		}
+		writeMockChatCompletion(w, model, "hi")
+	})
+	t.Cleanup(func() { close(release) })
+	return server, release
+}
+
+func initHedgingTestBifrost(t *testing.T, baseURL string) *Bifrost {
+	return initMockProviderBifrost(t, baseURL, 2, func(providerConfig *schemas.ProviderConfig, _ *schemas.BifrostConfig) {
+		// A stream that has not started cannot be interrupted, so keep the losing attempt short
+		providerConfig.NetworkConfig.DefaultRequestTimeoutInSeconds = 2
+	})
+}
+
+func hedgingTestRequest(model string) *schemas.BifrostChatRequest {
+	req := mockChatRequest(model, "hello")
+	req.Fallbacks = []schemas.Fallback{{Provider: schemas.OpenAI, Model: "gpt-4o"}}
+	return req
+}
+
+func TestHedgedRequest(t *testing.T) {
+	var slowCalls, fastCalls atomic.Int32
+	server, _ := newHedgingTestServer(t, &slowCalls, &fastCalls)
+	bifrost := initHedgingTestBifrost(t, server.URL)
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyHedgeDelay, 50*time.Millisecond)
+	start := time.Now()
+	response, bifrostErr := bifrost.ChatCompletionRequest(ctx, hedgingTestRequest("gpt-slow"))
+	if bifrostErr != nil {
+		t.Fatalf("ChatCompletionRequest() error = %+v", bifrostErr.Error)
+	}
+	if response.Model != "gpt-4o" || time.Since(start) > 2*time.Second {
+		t.Errorf("expected the hedge to answer quickly, got model %s after %v", response.Model, time.Since(start))
+	}
+	if slowCalls.Load() != 1 || fastCalls.Load() != 1 {
+		t.Errorf("slow calls = %d, fast calls = %d", slowCalls.Load(), fastCalls.Load())
+	}
+
+	// A primary that answers within the delay is not hedged
+	response, bifrostErr = bifrost.ChatCompletionRequest(ctx, &schemas.BifrostChatRequest{
+		Provider: schemas.OpenAI,
+		Model:    "gpt-4o",
+		Input:    hedgingTestRequest("gpt-4o").Input,
+	})
+	if bifrostErr != nil {
+		t.Fatalf("ChatCompletionRequest() error = %+v", bifrostErr.Error)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if fastCalls.Load() != 2 {
+		t.Errorf("fast calls = %d, want no hedge for a quick primary", fastCalls.Load())
+	}
+}
+
+func TestHedgedStreamRequest(t *testing.T) {
+	var slowCalls, fastCalls atomic.Int32
+	server, _ := newHedgingTestServer(t, &slowCalls, &fastCalls)
+	bifrost := initHedgingTestBifrost(t, server.URL)
+
+	// The slow stream sends its headers right away but no chunk, so only the first chunk counts
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyHedgeDelay, 50*time.Millisecond)
+	stream, bifrostErr := bifrost.ChatCompletionStreamRequest(ctx, hedgingTestRequest("gpt-slow"))
+	if bifrostErr != nil {
+		t.Fatalf("ChatCompletionStreamRequest() error = %+v", bifrostErr.Error)
+	}
+	var chunks int
+	var model string
+	timeout := time.After(5 * time.Second)
+	for done := false; !done; {
+		select {
+		case chunk, ok := <-stream:
+			if !ok {
+				done = true
+				break
+			}
+			if chunk.BifrostError != nil {
+				t.Fatalf("stream error = %+v", chunk.BifrostError.Error)
+			}
+			if chunk.BifrostChatResponse != nil {
+				chunks++
+				model = chunk.BifrostChatResponse.ExtraFields.ModelRequested
+			}
+		case <-timeout:
+			t.Fatal("timed out reading the hedged stream")
+		}
+	}
+	if chunks == 0 || model != "gpt-4o" {
+		t.Errorf("got %d chunks from model %q, want the hedge's stream", chunks, model)
+	}
+	if slowCalls.Load() != 1 || fastCalls.Load() != 1 {
+		t.Errorf("slow calls = %d, fast calls = %d", slowCalls.Load(), fastCalls.Load())
+	}
+}
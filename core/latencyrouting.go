@@ -0,0 +1,295 @@
+package bifrost
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// latencySampleCount is the number of recent outcomes kept per provider and model.
+const latencySampleCount = 256
+
+type latencySample struct {
+	at      time.Time
+	latency int64 // milliseconds; unused for failures
+	failed  bool
+}
+
+// latencyTracker keeps the recent outcomes of one provider and model in a ring buffer.
+type latencyTracker struct {
+	mu      sync.Mutex
+	samples [latencySampleCount]latencySample
+	next    int
+	count   int
+}
+
+func (t *latencyTracker) record(sample latencySample) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.samples[t.next] = sample
+	t.next = (t.next + 1) % latencySampleCount
+	t.count = min(t.count+1, latencySampleCount)
+}
+
+// stats returns the number of latencies and failures recorded within the window, and the p50 and
+// p95 of those latencies.
+func (t *latencyTracker) stats(window time.Duration, now time.Time) (samples, failures int, p50, p95 int64) {
+	latencies := make([]int64, 0, latencySampleCount)
+	t.mu.Lock()
+	for i := 0; i < t.count; i++ {
+		sample := t.samples[i]
+		if now.Sub(sample.at) > window {
+			continue
+		}
+		if sample.failed {
+			failures++
+		} else {
+			latencies = append(latencies, sample.latency)
+		}
+	}
+	t.mu.Unlock()
+	if len(latencies) == 0 {
+		return 0, failures, 0, 0
+	}
+	slices.Sort(latencies)
+	return len(latencies), failures, latencyPercentile(latencies, 0.5), latencyPercentile(latencies, 0.95)
+}
+
+// latencyPercentile returns the nearest-rank percentile of sorted latencies.
+func latencyPercentile(sorted []int64, p float64) int64 {
+	rank := int(p*float64(len(sorted))+0.999999) - 1
+	return sorted[max(0, min(rank, len(sorted)-1))]
+}
+
+// recordLatency feeds the result of a request into the latency stats of its provider and model.
+// Successful non-streaming responses add their latency; upstream failures are counted so that
+// failing targets are not mistaken for fast ones. Other errors are ignored.
+func (bifrost *Bifrost) recordLatency(provider schemas.ModelProvider, model string, requestType schemas.RequestType, result *schemas.BifrostResponse, bifrostError *schemas.BifrostError) {
+	sample := latencySample{at: time.Now()}
+	if bifrostError != nil {
+		if failed, _ := isUpstreamFailure(bifrostError); !failed {
+			return
+		}
+		sample.failed = true
+	} else {
+		if result == nil || IsStreamRequestType(requestType) {
+			return
+		}
+		sample.latency = result.GetExtraFields().Latency
+	}
+	value, ok := bifrost.latencyTrackers.Load(circuitKey(provider, model))
+	if !ok {
+		value, _ = bifrost.latencyTrackers.LoadOrStore(circuitKey(provider, model), &latencyTracker{})
+	}
+	value.(*latencyTracker).record(sample)
+}
+
+// latencyWindow returns the window latency stats are computed over.
+func (bifrost *Bifrost) latencyWindow() time.Duration {
+	seconds := schemas.DefaultLatencyRoutingWindowSeconds
+	if config := bifrost.latencyRouting.Load(); config != nil {
+		seconds = config.GetWindowSeconds()
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// GetLatencyStats returns the recent latency stats of a provider and model.
+func (bifrost *Bifrost) GetLatencyStats(provider schemas.ModelProvider, model string) schemas.LatencyStats {
+	stats := schemas.LatencyStats{Provider: provider, Model: model}
+	if value, ok := bifrost.latencyTrackers.Load(circuitKey(provider, model)); ok {
+		stats.Samples, stats.Failures, stats.P50, stats.P95 = value.(*latencyTracker).stats(bifrost.latencyWindow(), time.Now())
+	}
+	return stats
+}
+
+// ListLatencyStats returns the recent latency stats of every provider and model that served a
+// request, sorted by provider and model.
+func (bifrost *Bifrost) ListLatencyStats() []schemas.LatencyStats {
+	var list []schemas.LatencyStats
+	bifrost.latencyTrackers.Range(func(key, _ any) bool {
+		provider, model, _ := strings.Cut(key.(string), "/")
+		list = append(list, bifrost.GetLatencyStats(schemas.ModelProvider(provider), model))
+		return true
+	})
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Provider != list[j].Provider {
+			return list[i].Provider < list[j].Provider
+		}
+		return list[i].Model < list[j].Model
+	})
+	return list
+}
+
+// GetLatencyRoutingConfig returns the current latency routing config, or nil if no aliases are set.
+func (bifrost *Bifrost) GetLatencyRoutingConfig() *schemas.LatencyRoutingConfig {
+	return bifrost.latencyRouting.Load()
+}
+
+// UpdateLatencyRoutingConfig replaces the latency-routed model aliases at runtime. Latency stats
+// are kept across updates.
+func (bifrost *Bifrost) UpdateLatencyRoutingConfig(config *schemas.LatencyRoutingConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	bifrost.latencyRouting.Store(config)
+	if config != nil {
+		bifrost.logger.Info("latency_routing updated: %d aliases", len(config.Aliases))
+	}
+	return nil
+}
+
+// HasModelAliases reports whether any latency-routed or cost-routed aliases, virtual models or
+// traffic splits are configured.
+func (bifrost *Bifrost) HasModelAliases() bool {
+	if index := bifrost.virtualModels.Load(); index != nil && len(*index) > 0 {
+		return true
+	}
+	if index := bifrost.trafficSplits.Load(); index != nil && len(*index) > 0 {
+		return true
+	}
+	return bifrost.latencyRouting.Load() != nil || bifrost.costRouting.Load() != nil
+}
+
+// IsModelAlias reports whether name is a latency-routed or cost-routed model alias, a virtual model
+// or a traffic-split logical model.
+func (bifrost *Bifrost) IsModelAlias(name string) bool {
+	if config := bifrost.latencyRouting.Load(); config != nil {
+		if _, ok := config.Aliases[name]; ok {
+			return true
+		}
+	}
+	if config := bifrost.costRouting.Load(); config != nil {
+		if _, ok := config.Aliases[name]; ok {
+			return true
+		}
+	}
+	if _, ok := bifrost.GetVirtualModel(name); ok {
+		return true
+	}
+	_, ok := bifrost.GetTrafficSplit(name)
+	return ok
+}
+
+// RouteModelAlias returns the targets of a model alias ranked for a request, best target first:
+// the fastest healthy one for latency-routed aliases (see schemas.LatencyRoutingConfig), the
+// cheapest one for cost-routed aliases (see schemas.CostRoutingConfig) and the first available one
+// for virtual models (see schemas.VirtualModel), in that order of precedence. Cost-routed aliases
+// price the estimated prompt tokens and the output token limit of the request (0 if it sets none).
+// It returns false if name is not an alias.
+func (bifrost *Bifrost) RouteModelAlias(name string, requestType schemas.RequestType, promptTokens, maxOutputTokens int) ([]schemas.Fallback, bool) {
+	if targets, ok := bifrost.routeLatencyAlias(name, requestType); ok {
+		return targets, true
+	}
+	if targets, ok := bifrost.routeCostAlias(name, requestType, promptTokens, maxOutputTokens); ok {
+		return targets, true
+	}
+	return bifrost.routeVirtualModel(name, requestType)
+}
+
+// isAliasTargetUnhealthy reports whether an alias target cannot serve the request type, has an
+// open circuit or failed more often than it succeeded recently.
+func (bifrost *Bifrost) isAliasTargetUnhealthy(provider schemas.ModelProvider, model string, requestType schemas.RequestType, stats schemas.LatencyStats) bool {
+	return !bifrost.SupportsOperation(provider, requestType) || bifrost.circuitOpen(provider, model) || stats.Failures > stats.Samples
+}
+
+// routeLatencyAlias ranks the targets of a latency-routed alias, fastest healthy target first.
+func (bifrost *Bifrost) routeLatencyAlias(name string, requestType schemas.RequestType) ([]schemas.Fallback, bool) {
+	config := bifrost.latencyRouting.Load()
+	if config == nil {
+		return nil, false
+	}
+	targets, ok := config.Aliases[name]
+	if !ok {
+		return nil, false
+	}
+
+	const (
+		tierUnmeasured = iota // too few latencies to rank; tried first so it gets measured
+		tierMeasured          // ranked by latency
+		tierUnhealthy         // unsupported, circuit open or mostly failing
+	)
+	type rankedTarget struct {
+		target  schemas.Fallback
+		tier    int
+		latency int64
+	}
+	ranked := make([]rankedTarget, 0, len(targets))
+	for _, target := range targets {
+		stats := bifrost.GetLatencyStats(target.Provider, target.Model)
+		entry := rankedTarget{target: target, latency: stats.Percentile(config.GetPercentile())}
+		switch {
+		case bifrost.isAliasTargetUnhealthy(target.Provider, target.Model, requestType, stats):
+			entry.tier = tierUnhealthy
+		case stats.Samples < config.GetMinSamples():
+			entry.tier = tierUnmeasured
+		default:
+			entry.tier = tierMeasured
+		}
+		ranked = append(ranked, entry)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].tier != ranked[j].tier {
+			return ranked[i].tier < ranked[j].tier
+		}
+		return ranked[i].tier == tierMeasured && ranked[i].latency < ranked[j].latency
+	})
+	routed := make([]schemas.Fallback, len(ranked))
+	for i, entry := range ranked {
+		routed[i] = entry.target
+	}
+	return routed, true
+}
+
+// routeModelAlias resolves a request for a model alias to its best target, or for a traffic-split
+// logical model to its assigned variant. The other targets become the request's fallbacks unless
+// it brought its own. Requests that name a provider are left alone.
+func (bifrost *Bifrost) routeModelAlias(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) {
+	if req == nil {
+		return
+	}
+	provider, model, fallbacks := req.GetRequestFields()
+	if provider != "" {
+		return
+	}
+	engine := schemas.RoutingEngineTrafficSplit
+	var splitKey string
+	if ctx != nil {
+		splitKey, _ = ctx.Value(schemas.BifrostContextKeyTrafficSplitKey).(string)
+	}
+	split, targets, ok := bifrost.SplitTraffic(model, splitKey)
+	if ok && ctx != nil {
+		ctx.SetValue(schemas.BifrostContextKeyTrafficSplit, split)
+	}
+	if !ok {
+		engine = schemas.RoutingEngineLatency
+		targets, ok = bifrost.routeLatencyAlias(model, req.RequestType)
+	}
+	if !ok {
+		if !bifrost.IsModelAlias(model) {
+			return
+		}
+		engine = schemas.RoutingEngineCost
+		promptTokens, maxOutputTokens := estimateRequestTokens(req)
+		if targets, ok = bifrost.routeCostAlias(model, req.RequestType, promptTokens, maxOutputTokens); !ok {
+			engine = schemas.RoutingEngineVirtualModel
+			if targets, ok = bifrost.routeVirtualModel(model, req.RequestType); !ok {
+				return
+			}
+		}
+	}
+	req.SetProvider(targets[0].Provider)
+	req.SetModel(targets[0].Model)
+	if len(fallbacks) == 0 {
+		req.SetFallbacks(targets[1:])
+	}
+	bifrost.logger.Debug("routing model alias %s to provider %s and model %s", model, targets[0].Provider, targets[0].Model)
+	if ctx != nil {
+		ctx.AppendRoutingEngineLog(engine, fmt.Sprintf("Routed alias %s to %s/%s (%d targets)", model, targets[0].Provider, targets[0].Model, len(targets)))
+		schemas.AppendToContextList(ctx, schemas.BifrostContextKeyRoutingEnginesUsed, engine)
+	}
+}
@@ -0,0 +1,114 @@
+package bifrost
+
+import (
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestLatencyTrackerStats(t *testing.T) {
+	tracker := &latencyTracker{}
+	now := time.Unix(1_000_000, 0)
+	// An old sample outside the window is ignored
+	tracker.record(latencySample{at: now.Add(-10 * time.Minute), latency: 5000})
+	for i := int64(1); i <= 100; i++ {
+		tracker.record(latencySample{at: now, latency: i * 10})
+	}
+	tracker.record(latencySample{at: now, failed: true})
+
+	samples, failures, p50, p95 := tracker.stats(5*time.Minute, now)
+	if samples != 100 || failures != 1 || p50 != 500 || p95 != 950 {
+		t.Errorf("stats() = %d, %d, %d, %d; want 100, 1, 500, 950", samples, failures, p50, p95)
+	}
+
+	// The ring keeps the most recent samples only
+	for range latencySampleCount {
+		tracker.record(latencySample{at: now, latency: 1})
+	}
+	if samples, _, p50, _ := tracker.stats(5*time.Minute, now); samples != latencySampleCount || p50 != 1 {
+		t.Errorf("stats() after wrapping = %d samples with p50 %d", samples, p50)
+	}
+}
+
+func TestRouteModelAlias(t *testing.T) {
+	bifrost := &Bifrost{account: NewMockAccount(), logger: NewDefaultLogger(schemas.LogLevelError)}
+	fast := schemas.Fallback{Provider: schemas.Groq, Model: "llama-3.3-70b"}
+	slow := schemas.Fallback{Provider: schemas.OpenAI, Model: "gpt-4o"}
+	failing := schemas.Fallback{Provider: schemas.Mistral, Model: "mistral-large"}
+	unmeasured := schemas.Fallback{Provider: schemas.Anthropic, Model: "claude-sonnet-4"}
+	if err := bifrost.UpdateLatencyRoutingConfig(&schemas.LatencyRoutingConfig{
+		Aliases:    map[string][]schemas.Fallback{"chat": {slow, failing, fast}},
+		MinSamples: 3,
+	}); err != nil {
+		t.Fatalf("UpdateLatencyRoutingConfig() error = %v", err)
+	}
+
+	serverError := &schemas.BifrostError{StatusCode: schemas.Ptr(500), Error: &schemas.ErrorField{Message: "internal error"}}
+	for i := range 5 {
+		bifrost.recordLatency(slow.Provider, slow.Model, schemas.ChatCompletionRequest, latencyResponse(800+int64(i)), nil)
+		bifrost.recordLatency(fast.Provider, fast.Model, schemas.ChatCompletionRequest, latencyResponse(200+int64(i)), nil)
+		bifrost.recordLatency(failing.Provider, failing.Model, schemas.ChatCompletionRequest, nil, serverError)
+	}
+	// Stream results carry no request latency and are not recorded
+	bifrost.recordLatency(fast.Provider, fast.Model, schemas.ChatCompletionStreamRequest, latencyResponse(5000), nil)
+	if stats := bifrost.GetLatencyStats(fast.Provider, fast.Model); stats.Samples != 5 || stats.P95 != 204 {
+		t.Errorf("fast stats = %+v", stats)
+	}
+
+	routed, ok := bifrost.RouteModelAlias("chat", schemas.ChatCompletionRequest, 0, 0)
+	if !ok || len(routed) != 3 || routed[0] != fast || routed[1] != slow || routed[2] != failing {
+		t.Errorf("RouteModelAlias() = %+v, want fast, slow, then failing", routed)
+	}
+	if _, ok := bifrost.RouteModelAlias("gpt-4o", schemas.ChatCompletionRequest, 0, 0); ok {
+		t.Error("expected gpt-4o not to be an alias")
+	}
+
+	// A target without enough latencies is tried first so it gets measured
+	bifrost.latencyRouting.Load().Aliases["chat"] = []schemas.Fallback{slow, fast, unmeasured}
+	req := &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{Model: "chat"}}
+	bifrost.routeModelAlias(schemas.NewBifrostContext(nil, schemas.NoDeadline), req)
+	provider, model, fallbacks := req.GetRequestFields()
+	if provider != unmeasured.Provider || model != unmeasured.Model || len(fallbacks) != 2 || fallbacks[0] != fast {
+		t.Errorf("routed request = %s/%s with fallbacks %+v", provider, model, fallbacks)
+	}
+
+	// Requests naming a provider are not aliases
+	req = &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{Provider: schemas.OpenAI, Model: "chat"}}
+	bifrost.routeModelAlias(nil, req)
+	if provider, model, _ := req.GetRequestFields(); provider != schemas.OpenAI || model != "chat" {
+		t.Errorf("request with a provider was rerouted to %s/%s", provider, model)
+	}
+}
+
+func latencyResponse(latency int64) *schemas.BifrostResponse {
+	return &schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{ExtraFields: schemas.BifrostResponseExtraFields{Latency: latency}}}
+}
+
+func TestRouteVirtualModel(t *testing.T) {
+	bifrost := &Bifrost{account: NewMockAccount(), logger: NewDefaultLogger(schemas.LogLevelError)}
+	primary := schemas.Fallback{Provider: schemas.OpenAI, Model: "text-embedding-3-small"}
+	chatOnly := schemas.Fallback{Provider: schemas.Groq, Model: "llama-3.3-70b"}
+	secondary := schemas.Fallback{Provider: schemas.Cohere, Model: "embed-english-v3.0"}
+	if err := bifrost.UpdateVirtualModels([]schemas.VirtualModel{{Name: "cheap-embeddings", Targets: []schemas.Fallback{chatOnly, primary, secondary}}}); err != nil {
+		t.Fatalf("UpdateVirtualModels() error = %v", err)
+	}
+
+	// Targets keep their priority, except those that cannot serve the request type
+	req := &schemas.BifrostRequest{RequestType: schemas.EmbeddingRequest, EmbeddingRequest: &schemas.BifrostEmbeddingRequest{Model: "cheap-embeddings"}}
+	bifrost.routeModelAlias(schemas.NewBifrostContext(nil, schemas.NoDeadline), req)
+	provider, model, fallbacks := req.GetRequestFields()
+	if provider != primary.Provider || model != primary.Model || len(fallbacks) != 2 || fallbacks[0] != secondary || fallbacks[1] != chatOnly {
+		t.Errorf("routed request = %s/%s with fallbacks %+v", provider, model, fallbacks)
+	}
+
+	for _, invalid := range [][]schemas.VirtualModel{
+		{{Name: "openai/fast", Targets: []schemas.Fallback{primary}}},
+		{{Name: "fast"}},
+		{{Name: "fast", Targets: []schemas.Fallback{primary}}, {Name: "fast", Targets: []schemas.Fallback{secondary}}},
+	} {
+		if bifrost.UpdateVirtualModels(invalid) == nil {
+			t.Errorf("UpdateVirtualModels(%+v) = nil, want an error", invalid)
+		}
+	}
+}
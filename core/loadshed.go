@@ -0,0 +1,136 @@
+package bifrost
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// serviceRateWindow is the number of one second buckets the service rate is averaged over.
+const serviceRateWindow = 10
+
+// serviceRateTracker measures how many requests a provider queue completes per second,
+// averaged over the last serviceRateWindow seconds.
+type serviceRateTracker struct {
+	mu      sync.Mutex
+	counts  [serviceRateWindow]int64
+	seconds [serviceRateWindow]int64 // unix second each bucket was last used for
+}
+
+// record counts a completed request.
+func (t *serviceRateTracker) record(now time.Time) {
+	second := now.Unix()
+	i := second % serviceRateWindow
+	t.mu.Lock()
+	if t.seconds[i] != second {
+		t.seconds[i] = second
+		t.counts[i] = 0
+	}
+	t.counts[i]++
+	t.mu.Unlock()
+}
+
+// rate returns the completed requests per second over the window ending at now.
+func (t *serviceRateTracker) rate(now time.Time) float64 {
+	second := now.Unix()
+	var total int64
+	t.mu.Lock()
+	for i := range t.counts {
+		if second-t.seconds[i] < serviceRateWindow {
+			total += t.counts[i]
+		}
+	}
+	t.mu.Unlock()
+	return float64(total) / serviceRateWindow
+}
+
+// GetLoadSheddingConfig returns the current load shedding config, or nil if it is disabled.
+func (bifrost *Bifrost) GetLoadSheddingConfig() *schemas.LoadSheddingConfig {
+	return bifrost.loadShedding.Load()
+}
+
+// UpdateLoadSheddingConfig updates the load shedding config at runtime. A nil config disables load shedding.
+func (bifrost *Bifrost) UpdateLoadSheddingConfig(config *schemas.LoadSheddingConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	bifrost.loadShedding.Store(config)
+	if config != nil {
+		bifrost.logger.Info("load_shedding updated: enabled=%v", config.Enabled)
+	}
+	return nil
+}
+
+// requestPriority returns the load shedding priority of the request, defaulting to normal.
+func requestPriority(ctx *schemas.BifrostContext) schemas.RequestPriority {
+	if priority, ok := ctx.Value(schemas.BifrostContextKeyRequestPriority).(schemas.RequestPriority); ok && priority != "" {
+		return priority
+	}
+	return schemas.RequestPriorityNormal
+}
+
+// shedRequest decides whether a request should be rejected before it is queued, so that
+// lower priority traffic backs off while there is still room in the queue for interactive
+// requests. It returns a 503 error with a Retry-After estimate, or nil to admit the request.
+func (bifrost *Bifrost) shedRequest(ctx *schemas.BifrostContext, pq *ProviderQueue, req *schemas.BifrostRequest) *schemas.BifrostError {
+	config := bifrost.loadShedding.Load()
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	var threshold float64
+	priority := requestPriority(ctx)
+	switch priority {
+	case schemas.RequestPriorityInteractive:
+		return nil
+	case schemas.RequestPriorityBackground:
+		threshold = config.GetBackgroundThreshold()
+	default:
+		threshold = config.GetNormalThreshold()
+	}
+
+	capacity := cap(pq.queue)
+	if capacity == 0 {
+		return nil
+	}
+	depth := len(pq.queue)
+	// Admit while the queue is below the priority's share of the buffer
+	admitted := int(math.Floor(threshold * float64(capacity)))
+	if depth < admitted {
+		return nil
+	}
+
+	retryAfter := estimateRetryAfter(depth-admitted+1, pq.serviceRate.rate(time.Now()), config.GetMaxRetryAfterSeconds())
+	provider, model, _ := req.GetRequestFields()
+	bifrost.logger.Debug("shedding %s request for provider %s: queue depth %d/%d, retry after %ds", priority, provider, depth, capacity, retryAfter)
+	return &schemas.BifrostError{
+		IsBifrostError: true,
+		StatusCode:     schemas.Ptr(fasthttp.StatusServiceUnavailable),
+		Type:           schemas.Ptr("overloaded_error"),
+		RetryAfter:     schemas.Ptr(retryAfter),
+		Error: &schemas.ErrorField{
+			Type:    schemas.Ptr("overloaded_error"),
+			Code:    schemas.Ptr("load_shed"),
+			Message: fmt.Sprintf("provider %s is overloaded, %s requests are being shed; retry after %d seconds", provider, priority, retryAfter),
+		},
+		ExtraFields: schemas.BifrostErrorExtraFields{
+			RequestType:    req.RequestType,
+			Provider:       provider,
+			ModelRequested: model,
+		},
+	}
+}
+
+// estimateRetryAfter returns the seconds needed to work off the given number of queued requests
+// at the observed service rate, clamped to [1, maxSeconds]. Without an observed rate the queue
+// is stalled, so the maximum is returned.
+func estimateRetryAfter(excess int, rate float64, maxSeconds int) int {
+	if rate <= 0 {
+		return maxSeconds
+	}
+	seconds := int(math.Ceil(float64(excess) / rate))
+	return max(1, min(seconds, maxSeconds))
+}
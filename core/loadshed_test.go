@@ -0,0 +1,114 @@
+package bifrost
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestServiceRateTracker(t *testing.T) {
+	tracker := &serviceRateTracker{}
+	now := time.Unix(1_000_000, 0)
+	for i := range 20 {
+		tracker.record(now.Add(time.Duration(i%10) * time.Second))
+	}
+	if got := tracker.rate(now.Add(9 * time.Second)); got != 2 {
+		t.Errorf("rate = %v, want 2 completions per second", got)
+	}
+	// Buckets older than the window no longer count
+	if got := tracker.rate(now.Add(14 * time.Second)); got != 1 {
+		t.Errorf("rate after 5 seconds idle = %v, want 1", got)
+	}
+	if got := tracker.rate(now.Add(time.Minute)); got != 0 {
+		t.Errorf("rate after a minute idle = %v, want 0", got)
+	}
+}
+
+func TestEstimateRetryAfter(t *testing.T) {
+	tests := []struct {
+		excess int
+		rate   float64
+		want   int
+	}{
+		{excess: 10, rate: 4, want: 3},
+		{excess: 1, rate: 50, want: 1},
+		{excess: 1000, rate: 2, want: 60},
+		{excess: 5, rate: 0, want: 60},
+	}
+	for _, tt := range tests {
+		if got := estimateRetryAfter(tt.excess, tt.rate, 60); got != tt.want {
+			t.Errorf("estimateRetryAfter(%d, %v) = %d, want %d", tt.excess, tt.rate, got, tt.want)
+		}
+	}
+}
+
+func TestShedRequest(t *testing.T) {
+	bifrost := &Bifrost{logger: NewDefaultLogger(schemas.LogLevelError)}
+	if err := bifrost.UpdateLoadSheddingConfig(&schemas.LoadSheddingConfig{Enabled: true, NormalThreshold: 0.8, BackgroundThreshold: 0.4}); err != nil {
+		t.Fatalf("UpdateLoadSheddingConfig() error = %v", err)
+	}
+
+	pq := &ProviderQueue{queue: make(chan *ChannelMessage, 10)}
+	for range 5 {
+		pq.queue <- &ChannelMessage{}
+	}
+	now := time.Now()
+	for range 20 {
+		pq.serviceRate.record(now)
+	}
+	req := &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{Provider: schemas.OpenAI, Model: "gpt-4o"}}
+
+	newCtx := func(priority schemas.RequestPriority) *schemas.BifrostContext {
+		ctx := schemas.NewBifrostContext(context.Background(), time.Now().Add(time.Minute))
+		if priority != "" {
+			ctx.SetValue(schemas.BifrostContextKeyRequestPriority, priority)
+		}
+		return ctx
+	}
+
+	// 5 of 10 slots are used: background (4 slots) is shed, normal (8 slots) is admitted.
+	bifrostErr := bifrost.shedRequest(newCtx(schemas.RequestPriorityBackground), pq, req)
+	if bifrostErr == nil {
+		t.Fatal("expected background request to be shed")
+	}
+	if *bifrostErr.StatusCode != 503 || bifrostErr.RetryAfter == nil || *bifrostErr.RetryAfter != 1 {
+		t.Errorf("shed error = status %d, retry after %v; want 503 and 1s for 2 excess requests at 2/s", *bifrostErr.StatusCode, bifrostErr.RetryAfter)
+	}
+	if bifrostErr.ExtraFields.Provider != schemas.OpenAI {
+		t.Errorf("shed error provider = %q", bifrostErr.ExtraFields.Provider)
+	}
+	if bifrostErr := bifrost.shedRequest(newCtx(""), pq, req); bifrostErr != nil {
+		t.Errorf("normal request should be admitted, got %v", bifrostErr.Error.Message)
+	}
+
+	for range 5 {
+		pq.queue <- &ChannelMessage{}
+	}
+	if bifrost.shedRequest(newCtx(schemas.RequestPriorityNormal), pq, req) == nil {
+		t.Error("expected normal request to be shed with a full queue")
+	}
+	if bifrostErr := bifrost.shedRequest(newCtx(schemas.RequestPriorityInteractive), pq, req); bifrostErr != nil {
+		t.Error("interactive requests must never be shed")
+	}
+
+	if err := bifrost.UpdateLoadSheddingConfig(nil); err != nil {
+		t.Fatalf("UpdateLoadSheddingConfig(nil) error = %v", err)
+	}
+	if bifrost.shedRequest(newCtx(schemas.RequestPriorityBackground), pq, req) != nil {
+		t.Error("nothing should be shed with load shedding disabled")
+	}
+}
+
+func TestLoadSheddingConfigValidate(t *testing.T) {
+	if err := (&schemas.LoadSheddingConfig{Enabled: true}).Validate(); err != nil {
+		t.Errorf("defaults should be valid, got %v", err)
+	}
+	if err := (&schemas.LoadSheddingConfig{NormalThreshold: 1.5}).Validate(); err == nil {
+		t.Error("expected an error for a threshold above 1")
+	}
+	if err := (&schemas.LoadSheddingConfig{NormalThreshold: 0.3}).Validate(); err == nil {
+		t.Error("expected an error when the default background threshold exceeds the normal threshold")
+	}
+}
@@ -0,0 +1,52 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// newMockProviderServer starts a server standing in for the OpenAI API, closed when the test ends.
+func newMockProviderServer(t *testing.T, handler http.HandlerFunc) *httptest.Server {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// writeMockChatCompletion writes an OpenAI chat completion answering content.
+func writeMockChatCompletion(w http.ResponseWriter, model string, content string) {
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"id":"chatcmpl-1","object":"chat.completion","model":%q,"choices":[{"index":0,"message":{"role":"assistant","content":%q},"finish_reason":"stop"}]}`, model, content)
+}
+
+// initMockProviderBifrost initializes Bifrost with OpenAI served from baseURL, without retries, and
+// shuts it down when the test ends. configure, when set, adjusts the provider and Bifrost configs
+// before Init.
+func initMockProviderBifrost(t *testing.T, baseURL string, concurrency int, configure func(*schemas.ProviderConfig, *schemas.BifrostConfig)) *Bifrost {
+	account := NewMockAccount()
+	account.AddProviderWithBaseURL(schemas.OpenAI, concurrency, 10, baseURL)
+	account.configs[schemas.OpenAI].NetworkConfig.MaxRetries = 0
+	config := schemas.BifrostConfig{Account: account, Logger: NewDefaultLogger(schemas.LogLevelError)}
+	if configure != nil {
+		configure(account.configs[schemas.OpenAI], &config)
+	}
+	bifrost, err := Init(schemas.NewBifrostContext(context.Background(), schemas.NoDeadline), config)
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(bifrost.Shutdown)
+	return bifrost
+}
+
+// mockChatRequest returns an OpenAI chat request with a single user message.
+func mockChatRequest(model string, text string) *schemas.BifrostChatRequest {
+	return &schemas.BifrostChatRequest{
+		Provider: schemas.OpenAI,
+		Model:    model,
+		Input:    []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: &text}}},
+	}
+}
@@ -0,0 +1,160 @@
+package bifrost
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+var (
+	// errPluginHookPanicked is wrapped by the error of a plugin hook that panicked
+	errPluginHookPanicked = errors.New("plugin hook panicked")
+	// errPluginHookTimedOut is wrapped by the error of a plugin hook that ran longer than its timeout
+	errPluginHookTimedOut = errors.New("plugin hook timed out")
+)
+
+// isPluginHookAborted reports whether err is the error of a hook that panicked or timed out,
+// whose results must be discarded.
+func isPluginHookAborted(err error) bool {
+	return errors.Is(err, errPluginHookPanicked) || errors.Is(err, errPluginHookTimedOut)
+}
+
+// UpdatePluginHookTimeout updates the default timeout of each plugin hook call at runtime (0 = no timeout).
+func (bifrost *Bifrost) UpdatePluginHookTimeout(timeout time.Duration) error {
+	if timeout < 0 {
+		return fmt.Errorf("plugin hook timeout cannot be negative")
+	}
+	bifrost.pluginHookTimeout.Store(int64(timeout))
+	return nil
+}
+
+// pluginHookTimeout returns the timeout of the hooks of plugin: its own HookTimeout if it has
+// one, otherwise the pipeline default. 0 means no timeout.
+func (p *PluginPipeline) pluginHookTimeout(plugin schemas.BasePlugin) time.Duration {
+	if withTimeout, ok := plugin.(schemas.PluginWithHookTimeout); ok {
+		if timeout := withTimeout.HookTimeout(); timeout != 0 {
+			return max(timeout, 0)
+		}
+	}
+	return p.hookTimeout
+}
+
+// runPluginHook calls hook, isolating the pipeline from its panics and, when timeout is positive,
+// from calls that run longer than timeout. A panic or a timeout is returned as an error wrapping
+// errPluginHookPanicked or errPluginHookTimedOut, along with the zero result.
+// A hook that times out is not interrupted; its results are discarded once it returns.
+func runPluginHook[T any](logger schemas.Logger, pluginName string, hookName string, timeout time.Duration, hook func() (T, error)) (T, error) {
+	if timeout <= 0 {
+		return callPluginHook(logger, pluginName, hookName, hook)
+	}
+	type outcome struct {
+		result T
+		err    error
+	}
+	done := make(chan outcome, 1)
+	go func() {
+		result, err := callPluginHook(logger, pluginName, hookName, hook)
+		done <- outcome{result: result, err: err}
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case o := <-done:
+		return o.result, o.err
+	case <-timer.C:
+		var zero T
+		return zero, fmt.Errorf("%w: %s of plugin %s did not return within %s", errPluginHookTimedOut, hookName, pluginName, timeout)
+	}
+}
+
+// callPluginHook calls hook, recovering from a panic.
+func callPluginHook[T any](logger schemas.Logger, pluginName string, hookName string, hook func() (T, error)) (result T, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			var zero T
+			result = zero
+			err = fmt.Errorf("%w: %s of plugin %s: %v", errPluginHookPanicked, hookName, pluginName, r)
+			logger.Debug("panic in %s of plugin %s: %v\n%s", hookName, pluginName, r, debug.Stack())
+		}
+	}()
+	return hook()
+}
+
+// preLLMHookResult holds the results of a PreLLMHook call
+type preLLMHookResult struct {
+	req          *schemas.BifrostRequest
+	shortCircuit *schemas.LLMPluginShortCircuit
+}
+
+// postLLMHookResult holds the results of a PostLLMHook call
+type postLLMHookResult struct {
+	resp       *schemas.BifrostResponse
+	bifrostErr *schemas.BifrostError
+}
+
+// preMCPHookResult holds the results of a PreMCPHook call
+type preMCPHookResult struct {
+	req          *schemas.BifrostMCPRequest
+	shortCircuit *schemas.MCPPluginShortCircuit
+}
+
+// postMCPHookResult holds the results of a PostMCPHook call
+type postMCPHookResult struct {
+	resp       *schemas.BifrostMCPResponse
+	bifrostErr *schemas.BifrostError
+}
+
+// runPreLLMHook calls the PreLLMHook of plugin in isolation. When the hook panics or times out,
+// req is returned unchanged, with the error.
+func (p *PluginPipeline) runPreLLMHook(ctx *schemas.BifrostContext, plugin schemas.LLMPlugin, pluginName string, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.LLMPluginShortCircuit, error) {
+	result, err := runPluginHook(p.logger, pluginName, "PreLLMHook", p.pluginHookTimeout(plugin), func() (preLLMHookResult, error) {
+		req, shortCircuit, err := plugin.PreLLMHook(ctx, req)
+		return preLLMHookResult{req: req, shortCircuit: shortCircuit}, err
+	})
+	if isPluginHookAborted(err) {
+		return req, nil, err
+	}
+	return result.req, result.shortCircuit, err
+}
+
+// runPostLLMHook calls the PostLLMHook of plugin in isolation. When the hook panics or times out,
+// resp and bifrostErr are returned unchanged, with the error.
+func (p *PluginPipeline) runPostLLMHook(ctx *schemas.BifrostContext, plugin schemas.LLMPlugin, pluginName string, resp *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	result, err := runPluginHook(p.logger, pluginName, "PostLLMHook", p.pluginHookTimeout(plugin), func() (postLLMHookResult, error) {
+		resp, bifrostErr, err := plugin.PostLLMHook(ctx, resp, bifrostErr)
+		return postLLMHookResult{resp: resp, bifrostErr: bifrostErr}, err
+	})
+	if isPluginHookAborted(err) {
+		return resp, bifrostErr, err
+	}
+	return result.resp, result.bifrostErr, err
+}
+
+// runPreMCPHook calls the PreMCPHook of plugin in isolation. When the hook panics or times out,
+// req is returned unchanged, with the error.
+func (p *PluginPipeline) runPreMCPHook(ctx *schemas.BifrostContext, plugin schemas.MCPPlugin, pluginName string, req *schemas.BifrostMCPRequest) (*schemas.BifrostMCPRequest, *schemas.MCPPluginShortCircuit, error) {
+	result, err := runPluginHook(p.logger, pluginName, "PreMCPHook", p.pluginHookTimeout(plugin), func() (preMCPHookResult, error) {
+		req, shortCircuit, err := plugin.PreMCPHook(ctx, req)
+		return preMCPHookResult{req: req, shortCircuit: shortCircuit}, err
+	})
+	if isPluginHookAborted(err) {
+		return req, nil, err
+	}
+	return result.req, result.shortCircuit, err
+}
+
+// runPostMCPHook calls the PostMCPHook of plugin in isolation. When the hook panics or times out,
+// resp and bifrostErr are returned unchanged, with the error.
+func (p *PluginPipeline) runPostMCPHook(ctx *schemas.BifrostContext, plugin schemas.MCPPlugin, pluginName string, resp *schemas.BifrostMCPResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostMCPResponse, *schemas.BifrostError, error) {
+	result, err := runPluginHook(p.logger, pluginName, "PostMCPHook", p.pluginHookTimeout(plugin), func() (postMCPHookResult, error) {
+		resp, bifrostErr, err := plugin.PostMCPHook(ctx, resp, bifrostErr)
+		return postMCPHookResult{resp: resp, bifrostErr: bifrostErr}, err
+	})
+	if isPluginHookAborted(err) {
+		return resp, bifrostErr, err
+	}
+	return result.resp, result.bifrostErr, err
+}
@@ -0,0 +1,176 @@
+package bifrost
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// hookTestPlugin is an LLM and MCP plugin whose hooks record their calls and can panic, block or short-circuit
+type hookTestPlugin struct {
+	mu           sync.Mutex
+	name         string
+	calls        *[]string
+	panics       bool
+	delay        time.Duration
+	timeout      time.Duration
+	shortCircuit bool
+}
+
+func (p *hookTestPlugin) GetName() string { return p.name }
+
+func (p *hookTestPlugin) Cleanup() error { return nil }
+
+func (p *hookTestPlugin) HookTimeout() time.Duration { return p.timeout }
+
+func (p *hookTestPlugin) run(hook string) {
+	p.mu.Lock()
+	*p.calls = append(*p.calls, p.name+"."+hook)
+	p.mu.Unlock()
+	if p.panics {
+		panic("boom")
+	}
+	time.Sleep(p.delay)
+}
+
+func (p *hookTestPlugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.LLMPluginShortCircuit, error) {
+	p.run("pre")
+	if p.shortCircuit {
+		return req, &schemas.LLMPluginShortCircuit{Response: &schemas.BifrostResponse{}}, nil
+	}
+	return &schemas.BifrostRequest{RequestType: schemas.EmbeddingRequest}, nil, nil
+}
+
+func (p *hookTestPlugin) PostLLMHook(ctx *schemas.BifrostContext, resp *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	p.run("post")
+	return nil, &schemas.BifrostError{Error: &schemas.ErrorField{Message: "rewritten by " + p.name}}, nil
+}
+
+func (p *hookTestPlugin) PreMCPHook(ctx *schemas.BifrostContext, req *schemas.BifrostMCPRequest) (*schemas.BifrostMCPRequest, *schemas.MCPPluginShortCircuit, error) {
+	p.run("mcp_pre")
+	return &schemas.BifrostMCPRequest{}, nil, nil
+}
+
+func (p *hookTestPlugin) PostMCPHook(ctx *schemas.BifrostContext, resp *schemas.BifrostMCPResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostMCPResponse, *schemas.BifrostError, error) {
+	p.run("mcp_post")
+	return nil, &schemas.BifrostError{Error: &schemas.ErrorField{Message: "rewritten by " + p.name}}, nil
+}
+
+func newHookTestPipeline(timeout time.Duration, plugins ...*hookTestPlugin) *PluginPipeline {
+	pipeline := &PluginPipeline{
+		logger:      NewDefaultLogger(schemas.LogLevelError),
+		tracer:      &schemas.NoOpTracer{},
+		hookTimeout: timeout,
+	}
+	for _, plugin := range plugins {
+		pipeline.llmPlugins = append(pipeline.llmPlugins, plugin)
+		pipeline.mcpPlugins = append(pipeline.mcpPlugins, plugin)
+	}
+	return pipeline
+}
+
+func newHookTestContext() *schemas.BifrostContext {
+	return schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+}
+
+func TestPluginHooks_OrderAndShortCircuit(t *testing.T) {
+	var calls []string
+	pipeline := newHookTestPipeline(0,
+		&hookTestPlugin{name: "a", calls: &calls},
+		&hookTestPlugin{name: "b", calls: &calls, shortCircuit: true},
+		&hookTestPlugin{name: "c", calls: &calls},
+	)
+	ctx := newHookTestContext()
+
+	_, shortCircuit, ran := pipeline.RunLLMPreHooks(ctx, &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest})
+	if shortCircuit == nil || ran != 2 {
+		t.Fatalf("RunLLMPreHooks() = %v, %d, want a short-circuit after 2 plugins", shortCircuit, ran)
+	}
+	_, bifrostErr := pipeline.RunPostLLMHooks(ctx, shortCircuit.Response, nil, ran)
+	if bifrostErr == nil || bifrostErr.Error.Message != "rewritten by a" {
+		t.Errorf("RunPostLLMHooks() error = %+v, want the one of the first plugin", bifrostErr)
+	}
+	if got := strings.Join(calls, ","); got != "a.pre,b.pre,b.post,a.post" {
+		t.Errorf("calls = %s, want a.pre,b.pre,b.post,a.post", got)
+	}
+}
+
+func TestPluginHooks_PanicIsIsolated(t *testing.T) {
+	var calls []string
+	pipeline := newHookTestPipeline(0,
+		&hookTestPlugin{name: "a", calls: &calls},
+		&hookTestPlugin{name: "b", calls: &calls, panics: true},
+	)
+	ctx := newHookTestContext()
+
+	req, shortCircuit, ran := pipeline.RunLLMPreHooks(ctx, &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest})
+	if shortCircuit != nil || ran != 2 {
+		t.Fatalf("RunLLMPreHooks() = %v, %d, want both plugins run", shortCircuit, ran)
+	}
+	if req.RequestType != schemas.EmbeddingRequest {
+		t.Errorf("request type = %s, want the request of the plugin before the panic", req.RequestType)
+	}
+	if len(pipeline.preHookErrors) != 1 || !errors.Is(pipeline.preHookErrors[0], errPluginHookPanicked) {
+		t.Errorf("pre-hook errors = %v, want the panic", pipeline.preHookErrors)
+	}
+
+	resp, bifrostErr := pipeline.RunPostLLMHooks(ctx, &schemas.BifrostResponse{}, nil, ran)
+	if resp != nil || bifrostErr == nil || bifrostErr.Error.Message != "rewritten by a" {
+		t.Errorf("RunPostLLMHooks() = %v, %+v, want the result of the plugin after the panic", resp, bifrostErr)
+	}
+	if len(pipeline.postHookErrors) != 1 || !errors.Is(pipeline.postHookErrors[0], errPluginHookPanicked) {
+		t.Errorf("post-hook errors = %v, want the panic", pipeline.postHookErrors)
+	}
+
+	mcpReq := &schemas.BifrostMCPRequest{}
+	pipeline.mcpPlugins = pipeline.mcpPlugins[1:]
+	if out, _, _ := pipeline.RunMCPPreHooks(ctx, mcpReq); out != mcpReq {
+		t.Error("RunMCPPreHooks() changed the request of a plugin that panicked")
+	}
+}
+
+func TestPluginHooks_Timeout(t *testing.T) {
+	var calls []string
+	slow := &hookTestPlugin{name: "slow", calls: &calls, delay: time.Second}
+	pipeline := newHookTestPipeline(20*time.Millisecond, slow)
+	ctx := newHookTestContext()
+
+	req := &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest}
+	start := time.Now()
+	out, _, ran := pipeline.RunLLMPreHooks(ctx, req)
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("RunLLMPreHooks() took %s, want the hook abandoned after its timeout", elapsed)
+	}
+	if out != req || ran != 1 {
+		t.Errorf("RunLLMPreHooks() = %v, %d, want the request unchanged", out, ran)
+	}
+	if len(pipeline.preHookErrors) != 1 || !errors.Is(pipeline.preHookErrors[0], errPluginHookTimedOut) {
+		t.Errorf("pre-hook errors = %v, want the timeout", pipeline.preHookErrors)
+	}
+
+	// The plugin's own timeout overrides the default
+	withOwnTimeout := &hookTestPlugin{name: "own", calls: &[]string{}, delay: 50 * time.Millisecond, timeout: time.Second}
+	pipeline = newHookTestPipeline(20*time.Millisecond, withOwnTimeout)
+	if _, bifrostErr := pipeline.RunPostLLMHooks(ctx, &schemas.BifrostResponse{}, nil, 1); bifrostErr == nil {
+		t.Error("RunPostLLMHooks() discarded the result of a hook within its own timeout")
+	}
+	withoutTimeout := &hookTestPlugin{name: "none", timeout: -1}
+	if got := pipeline.pluginHookTimeout(withoutTimeout); got != 0 {
+		t.Errorf("pluginHookTimeout() = %s, want no timeout for a negative HookTimeout", got)
+	}
+}
+
+func TestUpdatePluginHookTimeout(t *testing.T) {
+	bifrost := &Bifrost{}
+	if err := bifrost.UpdatePluginHookTimeout(-time.Second); err == nil {
+		t.Error("UpdatePluginHookTimeout() with a negative timeout succeeded, want error")
+	}
+	if err := bifrost.UpdatePluginHookTimeout(time.Second); err != nil || time.Duration(bifrost.pluginHookTimeout.Load()) != time.Second {
+		t.Errorf("UpdatePluginHookTimeout() = %v, timeout %s", err, time.Duration(bifrost.pluginHookTimeout.Load()))
+	}
+}
@@ -31,6 +31,21 @@ type AnthropicProvider struct {
 	customProviderConfig *schemas.CustomProviderConfig // Custom provider config
 }
 
+// Compile-time checks for the capabilities AnthropicProvider implements.
+var (
+	_ schemas.ListModelsProvider     = (*AnthropicProvider)(nil)
+	_ schemas.TextCompletionProvider = (*AnthropicProvider)(nil)
+	_ schemas.ChatProvider           = (*AnthropicProvider)(nil)
+	_ schemas.ResponsesProvider      = (*AnthropicProvider)(nil)
+	_ schemas.CountTokensProvider    = (*AnthropicProvider)(nil)
+	_ schemas.BatchProvider          = (*AnthropicProvider)(nil)
+	_ schemas.FileProvider           = (*AnthropicProvider)(nil)
+)
+
+func init() {
+	schemas.RegisterProviderCapabilities(schemas.Anthropic, schemas.CapabilitiesOf((*AnthropicProvider)(nil)))
+}
+
 // anthropicMessageResponsePool provides a pool for Anthropic chat response objects.
 var anthropicMessageResponsePool = sync.Pool{
 	New: func() interface{} {
@@ -118,6 +133,11 @@ func (provider *AnthropicProvider) GetProviderKey() schemas.ModelProvider {
 	return providerUtils.GetProviderName(schemas.Anthropic, provider.customProviderConfig)
 }
 
+// HTTPClient returns the pooled client used for Anthropic API requests.
+func (provider *AnthropicProvider) HTTPClient() *fasthttp.Client {
+	return provider.client
+}
+
 // buildRequestURL constructs the full request URL using the provider's configuration.
 func (provider *AnthropicProvider) buildRequestURL(ctx *schemas.BifrostContext, defaultPath string, requestType schemas.RequestType) string {
 	path, isCompleteURL := providerUtils.GetRequestPath(ctx, defaultPath, provider.customProviderConfig, requestType)
@@ -1211,9 +1231,17 @@ func (provider *AnthropicProvider) BatchCreate(ctx *schemas.BifrostContext, key
 	providerName := provider.GetProviderKey()
 
 	if len(request.Requests) == 0 {
+		if request.InputFileID != "" {
+			return nil, providerUtils.NewBifrostOperationError("input_file_id is not supported by Anthropic batch API, send the requests inline", nil, providerName)
+		}
 		return nil, providerUtils.NewBifrostOperationError("requests array is required for Anthropic batch API", nil, providerName)
 	}
 
+	items, err := ToAnthropicBatchRequestItems(ctx, request)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(err.Error(), nil, providerName)
+	}
+
 	// Create request
 	req := fasthttp.AcquireRequest()
 	resp := fasthttp.AcquireResponse()
@@ -1231,23 +1259,7 @@ func (provider *AnthropicProvider) BatchCreate(ctx *schemas.BifrostContext, key
 	}
 	req.Header.Set("anthropic-version", provider.apiVersion)
 
-	// Build request body
-	anthropicReq := &AnthropicBatchCreateRequest{
-		Requests: make([]AnthropicBatchRequestItem, len(request.Requests)),
-	}
-
-	for i, r := range request.Requests {
-		anthropicReq.Requests[i] = AnthropicBatchRequestItem{
-			CustomID: r.CustomID,
-			Params:   r.Params,
-		}
-		// Use Body if Params is empty
-		if anthropicReq.Requests[i].Params == nil && r.Body != nil {
-			anthropicReq.Requests[i].Params = r.Body
-		}
-	}
-
-	jsonData, err := sonic.Marshal(anthropicReq)
+	jsonData, err := sonic.Marshal(&AnthropicBatchCreateRequest{Requests: items})
 	if err != nil {
 		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
 	}
@@ -1416,8 +1428,7 @@ func (provider *AnthropicProvider) BatchRetrieve(ctx *schemas.BifrostContext, ke
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -1442,25 +1453,23 @@ func (provider *AnthropicProvider) BatchRetrieve(ctx *schemas.BifrostContext, ke
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = ParseAnthropicError(resp, schemas.BatchRetrieveRequest, providerName, "")
+			bifrostErr := ParseAnthropicError(resp, schemas.BatchRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var anthropicResp AnthropicBatchResponse
@@ -1468,8 +1477,7 @@ func (provider *AnthropicProvider) BatchRetrieve(ctx *schemas.BifrostContext, ke
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -1478,9 +1486,7 @@ func (provider *AnthropicProvider) BatchRetrieve(ctx *schemas.BifrostContext, ke
 		result := anthropicResp.ToBifrostBatchRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse)
 		result.ExtraFields.RequestType = schemas.BatchRetrieveRequest
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // BatchCancel cancels a batch job by trying each key until successful.
@@ -1498,15 +1504,14 @@ func (provider *AnthropicProvider) BatchCancel(ctx *schemas.BifrostContext, keys
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
 		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/messages/batches/" + request.BatchID + "/cancel")
+		req.SetRequestURI(provider.buildRequestURL(ctx, "/v1/messages/batches/"+url.PathEscape(request.BatchID)+"/cancel", schemas.BatchCancelRequest))
 		req.Header.SetMethod(http.MethodPost)
 		req.Header.SetContentType("application/json")
 
@@ -1520,25 +1525,23 @@ func (provider *AnthropicProvider) BatchCancel(ctx *schemas.BifrostContext, keys
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = ParseAnthropicError(resp, schemas.BatchCancelRequest, providerName, "")
+			bifrostErr := ParseAnthropicError(resp, schemas.BatchCancelRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var anthropicResp AnthropicBatchResponse
@@ -1546,8 +1549,7 @@ func (provider *AnthropicProvider) BatchCancel(ctx *schemas.BifrostContext, keys
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -1586,9 +1588,7 @@ func (provider *AnthropicProvider) BatchCancel(ctx *schemas.BifrostContext, keys
 		}
 
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // BatchResults retrieves batch results by trying each key until found.
@@ -1603,15 +1603,14 @@ func (provider *AnthropicProvider) BatchResults(ctx *schemas.BifrostContext, key
 
 	providerName := provider.GetProviderKey()
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
 
 		// Set headers
 		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + "/v1/messages/batches/" + request.BatchID + "/results")
+		req.SetRequestURI(provider.buildRequestURL(ctx, "/v1/messages/batches/"+url.PathEscape(request.BatchID)+"/results", schemas.BatchResultsRequest))
 		req.Header.SetMethod(http.MethodGet)
 
 		if key.Value.GetValue() != "" {
@@ -1624,25 +1623,23 @@ func (provider *AnthropicProvider) BatchResults(ctx *schemas.BifrostContext, key
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = ParseAnthropicError(resp, schemas.BatchResultsRequest, providerName, "")
+			bifrostErr := ParseAnthropicError(resp, schemas.BatchResultsRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -1668,10 +1665,7 @@ func (provider *AnthropicProvider) BatchResults(ctx *schemas.BifrostContext, key
 			}
 
 			if anthropicResult.Result.Error != nil {
-				resultItem.Error = &schemas.BatchResultError{
-					Code:    anthropicResult.Result.Error.Type,
-					Message: anthropicResult.Result.Error.Message,
-				}
+				resultItem.Error = anthropicResult.Result.Error.ToBifrostBatchResultError()
 			}
 
 			results = append(results, resultItem)
@@ -1693,9 +1687,7 @@ func (provider *AnthropicProvider) BatchResults(ctx *schemas.BifrostContext, key
 		}
 
 		return batchResultsResp, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // splitJSONL splits JSONL content into individual lines.
@@ -1716,61 +1708,6 @@ func splitJSONL(data []byte) [][]byte {
 	return lines
 }
 
-// Embedding is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) Embedding(ctx *schemas.BifrostContext, key schemas.Key, input *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.EmbeddingRequest, provider.GetProviderKey())
-}
-
-// Speech is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) Speech(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechRequest, provider.GetProviderKey())
-}
-
-// Rerank is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) Rerank(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRerankRequest) (*schemas.BifrostRerankResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.RerankRequest, provider.GetProviderKey())
-}
-
-// SpeechStream is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) SpeechStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechStreamRequest, provider.GetProviderKey())
-}
-
-// Transcription is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) Transcription(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionRequest, provider.GetProviderKey())
-}
-
-// TranscriptionStream is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) TranscriptionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionStreamRequest, provider.GetProviderKey())
-}
-
-// ImageGeneration is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ImageGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageGenerationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageGenerationRequest, provider.GetProviderKey())
-}
-
-// ImageGenerationStream is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ImageGenerationStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostImageGenerationRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageGenerationStreamRequest, provider.GetProviderKey())
-}
-
-// ImageEdit is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ImageEdit(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageEditRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditRequest, provider.GetProviderKey())
-}
-
-// ImageEditStream is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ImageEditStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostImageEditRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditStreamRequest, provider.GetProviderKey())
-}
-
-// ImageVariation is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ImageVariation(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageVariationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageVariationRequest, provider.GetProviderKey())
-}
-
 // FileUpload uploads a file to Anthropic's Files API.
 func (provider *AnthropicProvider) FileUpload(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
 	if err := providerUtils.CheckOperationAllowed(schemas.Anthropic, provider.customProviderConfig, schemas.FileUploadRequest); err != nil {
@@ -1992,8 +1929,7 @@ func (provider *AnthropicProvider) FileRetrieve(ctx *schemas.BifrostContext, key
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -2019,25 +1955,23 @@ func (provider *AnthropicProvider) FileRetrieve(ctx *schemas.BifrostContext, key
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = ParseAnthropicError(resp, schemas.FileRetrieveRequest, providerName, "")
+			bifrostErr := ParseAnthropicError(resp, schemas.FileRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var anthropicResp AnthropicFileResponse
@@ -2045,17 +1979,14 @@ func (provider *AnthropicProvider) FileRetrieve(ctx *schemas.BifrostContext, key
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
 		fasthttp.ReleaseResponse(resp)
 
 		return anthropicResp.ToBifrostFileRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // FileDelete deletes a file from Anthropic's Files API by trying each key until successful.
@@ -2073,8 +2004,7 @@ func (provider *AnthropicProvider) FileDelete(ctx *schemas.BifrostContext, keys
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -2096,17 +2026,16 @@ func (provider *AnthropicProvider) FileDelete(ctx *schemas.BifrostContext, keys
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK && resp.StatusCode() != fasthttp.StatusNoContent {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = ParseAnthropicError(resp, schemas.FileDeleteRequest, providerName, "")
+			bifrostErr := ParseAnthropicError(resp, schemas.FileDeleteRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		// For 204 No Content, return success without parsing body
@@ -2129,8 +2058,7 @@ func (provider *AnthropicProvider) FileDelete(ctx *schemas.BifrostContext, keys
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var anthropicResp AnthropicFileDeleteResponse
@@ -2138,8 +2066,7 @@ func (provider *AnthropicProvider) FileDelete(ctx *schemas.BifrostContext, keys
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -2165,9 +2092,7 @@ func (provider *AnthropicProvider) FileDelete(ctx *schemas.BifrostContext, keys
 		}
 
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // FileContent downloads file content from Anthropic's Files API by trying each key until found.
@@ -2183,8 +2108,7 @@ func (provider *AnthropicProvider) FileContent(ctx *schemas.BifrostContext, keys
 		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -2204,25 +2128,23 @@ func (provider *AnthropicProvider) FileContent(ctx *schemas.BifrostContext, keys
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = ParseAnthropicError(resp, schemas.FileContentRequest, providerName, "")
+			bifrostErr := ParseAnthropicError(resp, schemas.FileContentRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		// Get content type from response
@@ -2245,9 +2167,7 @@ func (provider *AnthropicProvider) FileContent(ctx *schemas.BifrostContext, keys
 				Latency:     latency.Milliseconds(),
 			},
 		}, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // CountTokens counts tokens for a given request using Anthropic's API.
@@ -2329,78 +2249,3 @@ func (provider *AnthropicProvider) CountTokens(ctx *schemas.BifrostContext, key
 
 	return response, nil
 }
-
-// VideoGeneration is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) VideoGeneration(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoGenerationRequest, provider.GetProviderKey())
-}
-
-// VideoRetrieve is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) VideoRetrieve(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoRetrieveRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRetrieveRequest, provider.GetProviderKey())
-}
-
-// VideoDownload is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) VideoDownload(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoDownloadRequest) (*schemas.BifrostVideoDownloadResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoDownloadRequest, provider.GetProviderKey())
-}
-
-// VideoDelete is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) VideoDelete(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoDeleteRequest) (*schemas.BifrostVideoDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoDeleteRequest, provider.GetProviderKey())
-}
-
-// VideoList is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) VideoList(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoListRequest) (*schemas.BifrostVideoListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoListRequest, provider.GetProviderKey())
-}
-
-// VideoRemix is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) VideoRemix(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoRemixRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRemixRequest, provider.GetProviderKey())
-}
-
-// ContainerCreate is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ContainerCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerCreateRequest) (*schemas.BifrostContainerCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerCreateRequest, provider.GetProviderKey())
-}
-
-// ContainerList is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ContainerList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerListRequest) (*schemas.BifrostContainerListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerListRequest, provider.GetProviderKey())
-}
-
-// ContainerRetrieve is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ContainerRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerRetrieveRequest) (*schemas.BifrostContainerRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerRetrieveRequest, provider.GetProviderKey())
-}
-
-// ContainerDelete is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ContainerDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerDeleteRequest) (*schemas.BifrostContainerDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerDeleteRequest, provider.GetProviderKey())
-}
-
-// ContainerFileCreate is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ContainerFileCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerFileCreateRequest) (*schemas.BifrostContainerFileCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileCreateRequest, provider.GetProviderKey())
-}
-
-// ContainerFileList is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ContainerFileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileListRequest) (*schemas.BifrostContainerFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileListRequest, provider.GetProviderKey())
-}
-
-// ContainerFileRetrieve is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ContainerFileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileRetrieveRequest) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileRetrieveRequest, provider.GetProviderKey())
-}
-
-// ContainerFileContent is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ContainerFileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileContentRequest) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileContentRequest, provider.GetProviderKey())
-}
-
-// ContainerFileDelete is not supported by the Anthropic provider.
-func (provider *AnthropicProvider) ContainerFileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileDeleteRequest) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileDeleteRequest, provider.GetProviderKey())
-}
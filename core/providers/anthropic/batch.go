@@ -1,8 +1,11 @@
 package anthropic
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/providers/openai"
 	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
 	"github.com/capsohq/bifrost/core/schemas"
 	"github.com/valyala/fasthttp"
@@ -86,10 +89,97 @@ type AnthropicBatchResultData struct {
 	Error   *AnthropicBatchError   `json:"error,omitempty"`
 }
 
-// AnthropicBatchError represents an error in batch results.
+// AnthropicBatchError represents an error in batch results. Errored results wrap the API error
+// in an envelope of type "error", e.g. {"type":"error","error":{"type":"invalid_request_error",...}}.
 type AnthropicBatchError struct {
-	Type    string `json:"type"`
-	Message string `json:"message"`
+	Type    string               `json:"type"`
+	Message string               `json:"message,omitempty"`
+	Error   *AnthropicBatchError `json:"error,omitempty"`
+}
+
+// ToBifrostBatchResultError converts an Anthropic batch result error, unwrapping the error envelope.
+func (e *AnthropicBatchError) ToBifrostBatchResultError() *schemas.BatchResultError {
+	if e == nil {
+		return nil
+	}
+	if e.Error != nil {
+		return e.Error.ToBifrostBatchResultError()
+	}
+	return &schemas.BatchResultError{
+		Code:    e.Type,
+		Message: e.Message,
+	}
+}
+
+// ToAnthropicBatchRequestItems converts Bifrost batch request items to Anthropic's requests[] format.
+// Items with Params, or with a Body for /v1/messages, are already Messages API requests and are passed
+// through. Items with an OpenAI-style Body for /v1/chat/completions are converted to Messages API requests.
+// An item's URL defaults to the batch endpoint; an item without either is treated as a Messages API request.
+func ToAnthropicBatchRequestItems(ctx *schemas.BifrostContext, request *schemas.BifrostBatchCreateRequest) ([]AnthropicBatchRequestItem, error) {
+	items := make([]AnthropicBatchRequestItem, len(request.Requests))
+	for i, r := range request.Requests {
+		if r.CustomID == "" {
+			return nil, fmt.Errorf("custom_id is required for request %d", i)
+		}
+		items[i] = AnthropicBatchRequestItem{CustomID: r.CustomID, Params: r.Params}
+		if r.Params != nil || r.Body == nil {
+			continue
+		}
+		endpoint := r.URL
+		if endpoint == "" {
+			endpoint = string(request.Endpoint)
+		}
+		switch schemas.BatchEndpoint(endpoint) {
+		case "", schemas.BatchEndpointMessages:
+			items[i].Params = r.Body
+		case schemas.BatchEndpointChatCompletions:
+			params, err := chatBodyToAnthropicBatchParams(ctx, r.Body)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert request %s: %w", r.CustomID, err)
+			}
+			items[i].Params = params
+		default:
+			return nil, fmt.Errorf("endpoint %s is not supported by Anthropic batches, use %s or %s", endpoint, schemas.BatchEndpointMessages, schemas.BatchEndpointChatCompletions)
+		}
+	}
+	return items, nil
+}
+
+// chatBodyToAnthropicBatchParams converts the body of an OpenAI-style chat completion request to the
+// params of an Anthropic Messages API request. Batched requests cannot stream, so stream is dropped.
+func chatBodyToAnthropicBatchParams(ctx *schemas.BifrostContext, body map[string]interface{}) (map[string]interface{}, error) {
+	data, err := sonic.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	var chatReq openai.OpenAIChatRequest
+	if err := sonic.Unmarshal(data, &chatReq); err != nil {
+		return nil, err
+	}
+	if chatReq.MaxCompletionTokens == nil && chatReq.MaxTokens != nil {
+		chatReq.MaxCompletionTokens = chatReq.MaxTokens
+	}
+	_, model := schemas.ParseModelString(chatReq.Model, schemas.Anthropic)
+	anthropicReq, err := ToAnthropicChatRequest(ctx, &schemas.BifrostChatRequest{
+		Provider: schemas.Anthropic,
+		Model:    model,
+		Input:    openai.ConvertOpenAIMessagesToBifrostMessages(chatReq.Messages),
+		Params:   &chatReq.ChatParameters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	anthropicReq.Stream = nil
+
+	data, err = sonic.Marshal(anthropicReq)
+	if err != nil {
+		return nil, err
+	}
+	var params map[string]interface{}
+	if err := sonic.Unmarshal(data, &params); err != nil {
+		return nil, err
+	}
+	return params, nil
 }
 
 // ToBifrostBatchStatus converts Anthropic processing_status to Bifrost status.
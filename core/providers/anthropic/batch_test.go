@@ -0,0 +1,87 @@
+package anthropic
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestToAnthropicBatchRequestItems(t *testing.T) {
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	messagesParams := map[string]interface{}{"model": "claude-sonnet-4-5", "max_tokens": 64, "messages": []interface{}{}}
+
+	items, err := ToAnthropicBatchRequestItems(ctx, &schemas.BifrostBatchCreateRequest{
+		Endpoint: schemas.BatchEndpointChatCompletions,
+		Requests: []schemas.BatchRequestItem{
+			{
+				CustomID: "chat",
+				Body: map[string]interface{}{
+					"model":      "anthropic/claude-sonnet-4-5",
+					"max_tokens": 128,
+					"stream":     true,
+					"messages": []interface{}{
+						map[string]interface{}{"role": "system", "content": "Be brief."},
+						map[string]interface{}{"role": "user", "content": "Hello"},
+					},
+				},
+			},
+			{CustomID: "params", Params: messagesParams},
+			{CustomID: "messages", URL: "/v1/messages", Body: messagesParams},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ToAnthropicBatchRequestItems() error = %v", err)
+	}
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %d", len(items))
+	}
+
+	var chat struct {
+		Model     string `json:"model"`
+		MaxTokens int    `json:"max_tokens"`
+		Stream    *bool  `json:"stream"`
+		System    any    `json:"system"`
+		Messages  []struct {
+			Role string `json:"role"`
+		} `json:"messages"`
+	}
+	data, _ := sonic.Marshal(items[0].Params)
+	if err := sonic.Unmarshal(data, &chat); err != nil {
+		t.Fatalf("failed to decode converted params: %v", err)
+	}
+	if items[0].CustomID != "chat" || chat.Model != "claude-sonnet-4-5" || chat.MaxTokens != 128 || chat.Stream != nil {
+		t.Errorf("chat request not converted: %s", data)
+	}
+	if chat.System == nil || len(chat.Messages) != 1 || chat.Messages[0].Role != "user" {
+		t.Errorf("expected the system message to move to system, got %s", data)
+	}
+	if items[1].Params["max_tokens"] != 64 || items[2].Params["max_tokens"] != 64 {
+		t.Errorf("expected Messages API requests to be passed through, got %+v", items[1:])
+	}
+
+	if _, err := ToAnthropicBatchRequestItems(ctx, &schemas.BifrostBatchCreateRequest{
+		Endpoint: schemas.BatchEndpointEmbeddings,
+		Requests: []schemas.BatchRequestItem{{CustomID: "embed", Body: map[string]interface{}{"input": "hi"}}},
+	}); err == nil {
+		t.Error("expected an error for the embeddings endpoint")
+	}
+	if _, err := ToAnthropicBatchRequestItems(ctx, &schemas.BifrostBatchCreateRequest{
+		Requests: []schemas.BatchRequestItem{{Params: messagesParams}},
+	}); err == nil {
+		t.Error("expected an error for a missing custom_id")
+	}
+}
+
+func TestAnthropicBatchResultItem_Error(t *testing.T) {
+	line := []byte(`{"custom_id":"req-1","result":{"type":"errored","error":{"type":"error","error":{"type":"invalid_request_error","message":"max_tokens: Field required"}}}}`)
+	var item AnthropicBatchResultItem
+	if err := sonic.Unmarshal(line, &item); err != nil {
+		t.Fatalf("failed to decode result: %v", err)
+	}
+	resultErr := item.Result.Error.ToBifrostBatchResultError()
+	if resultErr == nil || resultErr.Code != "invalid_request_error" || resultErr.Message != "max_tokens: Field required" {
+		t.Errorf("error = %+v", resultErr)
+	}
+}
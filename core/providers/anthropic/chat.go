@@ -348,6 +348,10 @@ func ToAnthropicChatRequest(ctx *schemas.BifrostContext, bifrostReq *schemas.Bif
 							content = append(content, ConvertToAnthropicImageBlock(block))
 						} else if block.File != nil {
 							content = append(content, ConvertToAnthropicDocumentBlock(block))
+						} else if block.Document != nil {
+							documentBlock := ConvertToAnthropicDocumentBlock(block.DocumentAsFile())
+							documentBlock.Title = block.Document.Title
+							content = append(content, documentBlock)
 						}
 					}
 				}
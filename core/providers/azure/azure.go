@@ -43,6 +43,26 @@ type AzureProvider struct {
 	sendBackRawResponse bool     // Whether to include raw response in BifrostResponse
 }
 
+// Compile-time checks for the capabilities AzureProvider implements.
+var (
+	_ schemas.ListModelsProvider      = (*AzureProvider)(nil)
+	_ schemas.TextCompletionProvider  = (*AzureProvider)(nil)
+	_ schemas.ChatProvider            = (*AzureProvider)(nil)
+	_ schemas.ResponsesProvider       = (*AzureProvider)(nil)
+	_ schemas.EmbeddingProvider       = (*AzureProvider)(nil)
+	_ schemas.SpeechProvider          = (*AzureProvider)(nil)
+	_ schemas.TranscriptionProvider   = (*AzureProvider)(nil)
+	_ schemas.ImageGenerationProvider = (*AzureProvider)(nil)
+	_ schemas.ImageEditProvider       = (*AzureProvider)(nil)
+	_ schemas.VideoProvider           = (*AzureProvider)(nil)
+	_ schemas.BatchProvider           = (*AzureProvider)(nil)
+	_ schemas.FileProvider            = (*AzureProvider)(nil)
+)
+
+func init() {
+	schemas.RegisterProviderCapabilities(schemas.Azure, schemas.CapabilitiesOf((*AzureProvider)(nil)))
+}
+
 func (p *AzureProvider) getOrCreateAuth(
 	tenantID, clientID, clientSecret string,
 ) (azcore.TokenCredential, error) {
@@ -193,6 +213,11 @@ func (provider *AzureProvider) GetProviderKey() schemas.ModelProvider {
 	return schemas.Azure
 }
 
+// HTTPClient returns the pooled client used for Azure API requests.
+func (provider *AzureProvider) HTTPClient() *fasthttp.Client {
+	return provider.client
+}
+
 // completeRequest sends a request to Azure's API and handles the response.
 // It constructs the API URL, sets up authentication, and processes the response.
 // Returns the response body, request latency, or an error if the request fails.
@@ -992,11 +1017,6 @@ func (provider *AzureProvider) Speech(ctx *schemas.BifrostContext, key schemas.K
 	return response, err
 }
 
-// Rerank is not supported by the Azure provider.
-func (provider *AzureProvider) Rerank(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRerankRequest) (*schemas.BifrostRerankResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.RerankRequest, provider.GetProviderKey())
-}
-
 // SpeechStream handles streaming for speech synthesis with Azure.
 // Azure sends raw binary audio bytes in SSE format, unlike OpenAI which sends JSON.
 func (provider *AzureProvider) SpeechStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
@@ -1554,11 +1574,6 @@ func (provider *AzureProvider) ImageEditStream(ctx *schemas.BifrostContext, post
 
 }
 
-// ImageVariation is not supported by the Azure provider.
-func (provider *AzureProvider) ImageVariation(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageVariationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageVariationRequest, provider.GetProviderKey())
-}
-
 // VideoGeneration creates a video using Azure's OpenAI-compatible Sora API.
 // This delegates to the OpenAI handler with Azure-specific URL and authentication.
 func (provider *AzureProvider) VideoGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
@@ -2094,11 +2109,9 @@ func (provider *AzureProvider) FileRetrieve(ctx *schemas.BifrostContext, keys []
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
 		if err := provider.validateKeyConfigForFiles(key); err != nil {
-			lastErr = err
-			continue
+			return nil, err
 		}
 
 		// Get API version
@@ -2127,8 +2140,7 @@ func (provider *AzureProvider) FileRetrieve(ctx *schemas.BifrostContext, keys []
 		if authErr := provider.setAzureAuth(ctx, req, key); authErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = authErr
-			continue
+			return nil, authErr
 		}
 
 		// Make request
@@ -2136,25 +2148,23 @@ func (provider *AzureProvider) FileRetrieve(ctx *schemas.BifrostContext, keys []
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = openai.ParseOpenAIError(resp, schemas.FileRetrieveRequest, providerName, "")
+			bifrostErr := openai.ParseOpenAIError(resp, schemas.FileRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var openAIResp openai.OpenAIFileResponse
@@ -2162,17 +2172,14 @@ func (provider *AzureProvider) FileRetrieve(ctx *schemas.BifrostContext, keys []
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
 		fasthttp.ReleaseResponse(resp)
 
 		return openAIResp.ToBifrostFileRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // FileDelete deletes a file from Azure OpenAI by trying each key until successful.
@@ -2190,11 +2197,9 @@ func (provider *AzureProvider) FileDelete(ctx *schemas.BifrostContext, keys []sc
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
 		if err := provider.validateKeyConfigForFiles(key); err != nil {
-			lastErr = err
-			continue
+			return nil, err
 		}
 
 		// Get API version
@@ -2223,8 +2228,7 @@ func (provider *AzureProvider) FileDelete(ctx *schemas.BifrostContext, keys []sc
 		if authErr := provider.setAzureAuth(ctx, req, key); authErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = authErr
-			continue
+			return nil, authErr
 		}
 
 		// Make request
@@ -2232,17 +2236,16 @@ func (provider *AzureProvider) FileDelete(ctx *schemas.BifrostContext, keys []sc
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK && resp.StatusCode() != fasthttp.StatusNoContent {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = openai.ParseOpenAIError(resp, schemas.FileDeleteRequest, providerName, "")
+			bifrostErr := openai.ParseOpenAIError(resp, schemas.FileDeleteRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		if resp.StatusCode() == fasthttp.StatusNoContent {
@@ -2264,8 +2267,7 @@ func (provider *AzureProvider) FileDelete(ctx *schemas.BifrostContext, keys []sc
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var openAIResp openai.OpenAIFileDeleteResponse
@@ -2273,8 +2275,7 @@ func (provider *AzureProvider) FileDelete(ctx *schemas.BifrostContext, keys []sc
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -2300,9 +2301,7 @@ func (provider *AzureProvider) FileDelete(ctx *schemas.BifrostContext, keys []sc
 		}
 
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // FileContent downloads file content from Azure OpenAI by trying each key until found.
@@ -2317,12 +2316,10 @@ func (provider *AzureProvider) FileContent(ctx *schemas.BifrostContext, keys []s
 		return nil, providerUtils.NewConfigurationError("no Azure keys available for file content operation", providerName)
 	}
 
-	var lastErr *schemas.BifrostError
 
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
 		if err := provider.validateKeyConfigForFiles(key); err != nil {
-			lastErr = err
-			continue
+			return nil, err
 		}
 
 		// Get API version
@@ -2350,8 +2347,7 @@ func (provider *AzureProvider) FileContent(ctx *schemas.BifrostContext, keys []s
 		if authErr := provider.setAzureAuth(ctx, req, key); authErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = authErr
-			continue
+			return nil, authErr
 		}
 
 		// Make request
@@ -2359,25 +2355,23 @@ func (provider *AzureProvider) FileContent(ctx *schemas.BifrostContext, keys []s
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = openai.ParseOpenAIError(resp, schemas.FileContentRequest, providerName, "")
+			bifrostErr := openai.ParseOpenAIError(resp, schemas.FileContentRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		// Get content type from response
@@ -2400,9 +2394,7 @@ func (provider *AzureProvider) FileContent(ctx *schemas.BifrostContext, keys []s
 				Latency:     latency.Milliseconds(),
 			},
 		}, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // BatchCreate creates a new batch job on Azure OpenAI.
@@ -2660,11 +2652,9 @@ func (provider *AzureProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys [
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
 		if err := provider.validateKeyConfigForFiles(key); err != nil {
-			lastErr = err
-			continue
+			return nil, err
 		}
 
 		// Get API version
@@ -2693,8 +2683,7 @@ func (provider *AzureProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys [
 		if authErr := provider.setAzureAuth(ctx, req, key); authErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = authErr
-			continue
+			return nil, authErr
 		}
 
 		// Make request
@@ -2702,25 +2691,23 @@ func (provider *AzureProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys [
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = openai.ParseOpenAIError(resp, schemas.BatchRetrieveRequest, providerName, "")
+			bifrostErr := openai.ParseOpenAIError(resp, schemas.BatchRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var openAIResp openai.OpenAIBatchResponse
@@ -2728,8 +2715,7 @@ func (provider *AzureProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys [
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -2738,9 +2724,7 @@ func (provider *AzureProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys [
 		result := openAIResp.ToBifrostBatchRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse)
 		result.ExtraFields.RequestType = schemas.BatchRetrieveRequest
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // BatchCancel cancels a batch job on Azure OpenAI by trying each key until successful.
@@ -2758,11 +2742,9 @@ func (provider *AzureProvider) BatchCancel(ctx *schemas.BifrostContext, keys []s
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
 		if err := provider.validateKeyConfigForFiles(key); err != nil {
-			lastErr = err
-			continue
+			return nil, err
 		}
 
 		// Get API version
@@ -2791,8 +2773,7 @@ func (provider *AzureProvider) BatchCancel(ctx *schemas.BifrostContext, keys []s
 		if authErr := provider.setAzureAuth(ctx, req, key); authErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = authErr
-			continue
+			return nil, authErr
 		}
 
 		// Make request
@@ -2800,25 +2781,23 @@ func (provider *AzureProvider) BatchCancel(ctx *schemas.BifrostContext, keys []s
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = openai.ParseOpenAIError(resp, schemas.BatchCancelRequest, providerName, "")
+			bifrostErr := openai.ParseOpenAIError(resp, schemas.BatchCancelRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var openAIResp openai.OpenAIBatchResponse
@@ -2826,8 +2805,7 @@ func (provider *AzureProvider) BatchCancel(ctx *schemas.BifrostContext, keys []s
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -2863,9 +2841,7 @@ func (provider *AzureProvider) BatchCancel(ctx *schemas.BifrostContext, keys []s
 		}
 
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // BatchResults retrieves batch results from Azure OpenAI by trying each key until successful.
@@ -2924,53 +2900,3 @@ func (provider *AzureProvider) BatchResults(ctx *schemas.BifrostContext, keys []
 
 	return batchResultsResp, nil
 }
-
-// CountTokens is not supported by the Azure provider.
-func (provider *AzureProvider) CountTokens(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostResponsesRequest) (*schemas.BifrostCountTokensResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.CountTokensRequest, provider.GetProviderKey())
-}
-
-// ContainerCreate is not supported by the Azure provider.
-func (provider *AzureProvider) ContainerCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerCreateRequest) (*schemas.BifrostContainerCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerCreateRequest, provider.GetProviderKey())
-}
-
-// ContainerList is not supported by the Azure provider.
-func (provider *AzureProvider) ContainerList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerListRequest) (*schemas.BifrostContainerListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerListRequest, provider.GetProviderKey())
-}
-
-// ContainerRetrieve is not supported by the Azure provider.
-func (provider *AzureProvider) ContainerRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerRetrieveRequest) (*schemas.BifrostContainerRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerRetrieveRequest, provider.GetProviderKey())
-}
-
-// ContainerDelete is not supported by the Azure provider.
-func (provider *AzureProvider) ContainerDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerDeleteRequest) (*schemas.BifrostContainerDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerDeleteRequest, provider.GetProviderKey())
-}
-
-// ContainerFileCreate is not supported by the Azure provider.
-func (provider *AzureProvider) ContainerFileCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerFileCreateRequest) (*schemas.BifrostContainerFileCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileCreateRequest, provider.GetProviderKey())
-}
-
-// ContainerFileList is not supported by the Azure provider.
-func (provider *AzureProvider) ContainerFileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileListRequest) (*schemas.BifrostContainerFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileListRequest, provider.GetProviderKey())
-}
-
-// ContainerFileRetrieve is not supported by the Azure provider.
-func (provider *AzureProvider) ContainerFileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileRetrieveRequest) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileRetrieveRequest, provider.GetProviderKey())
-}
-
-// ContainerFileContent is not supported by the Azure provider.
-func (provider *AzureProvider) ContainerFileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileContentRequest) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileContentRequest, provider.GetProviderKey())
-}
-
-// ContainerFileDelete is not supported by the Azure provider.
-func (provider *AzureProvider) ContainerFileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileDeleteRequest) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileDeleteRequest, provider.GetProviderKey())
-}
@@ -39,6 +39,26 @@ type BedrockProvider struct {
 	sendBackRawResponse  bool                          // Whether to include raw response in BifrostResponse
 }
 
+// Compile-time checks for the capabilities BedrockProvider implements.
+var (
+	_ schemas.ListModelsProvider      = (*BedrockProvider)(nil)
+	_ schemas.TextCompletionProvider  = (*BedrockProvider)(nil)
+	_ schemas.ChatProvider            = (*BedrockProvider)(nil)
+	_ schemas.ResponsesProvider       = (*BedrockProvider)(nil)
+	_ schemas.CountTokensProvider     = (*BedrockProvider)(nil)
+	_ schemas.EmbeddingProvider       = (*BedrockProvider)(nil)
+	_ schemas.RerankProvider          = (*BedrockProvider)(nil)
+	_ schemas.ImageGenerationProvider = (*BedrockProvider)(nil)
+	_ schemas.ImageEditProvider       = (*BedrockProvider)(nil)
+	_ schemas.ImageVariationProvider  = (*BedrockProvider)(nil)
+	_ schemas.BatchProvider           = (*BedrockProvider)(nil)
+	_ schemas.FileProvider            = (*BedrockProvider)(nil)
+)
+
+func init() {
+	schemas.RegisterProviderCapabilities(schemas.Bedrock, schemas.CapabilitiesOf((*BedrockProvider)(nil)))
+}
+
 // assumeRoleCredsCache caches *aws.CredentialsCache instances keyed by the
 // unique combination of role parameters so that STS AssumeRole is not called
 // on every request.
@@ -1708,11 +1728,6 @@ func (provider *BedrockProvider) Embedding(ctx *schemas.BifrostContext, key sche
 	return bifrostResponse, nil
 }
 
-// Speech is not supported by the Bedrock provider.
-func (provider *BedrockProvider) Speech(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechRequest, schemas.Bedrock)
-}
-
 // Rerank performs a rerank request using the Bedrock Agent Runtime /rerank API.
 func (provider *BedrockProvider) Rerank(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRerankRequest) (*schemas.BifrostRerankResponse, *schemas.BifrostError) {
 	if err := providerUtils.CheckOperationAllowed(schemas.Bedrock, provider.customProviderConfig, schemas.RerankRequest); err != nil {
@@ -1779,21 +1794,6 @@ func (provider *BedrockProvider) Rerank(ctx *schemas.BifrostContext, key schemas
 	return bifrostResponse, nil
 }
 
-// SpeechStream is not supported by the Bedrock provider.
-func (provider *BedrockProvider) SpeechStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechStreamRequest, schemas.Bedrock)
-}
-
-// Transcription is not supported by the Bedrock provider.
-func (provider *BedrockProvider) Transcription(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionRequest, schemas.Bedrock)
-}
-
-// TranscriptionStream is not supported by the Bedrock provider.
-func (provider *BedrockProvider) TranscriptionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionStreamRequest, schemas.Bedrock)
-}
-
 // ImageGeneration generates images using Amazon Bedrock.
 // Supports Titan Image Generator v1, Nova Canvas v1, and Titan Image Generator v2.
 // Returns a BifrostImageGenerationResponse containing the generated images and any error that occurred.
@@ -2022,36 +2022,6 @@ func (provider *BedrockProvider) ImageVariation(ctx *schemas.BifrostContext, key
 	return bifrostResponse, nil
 }
 
-// VideoGeneration is not supported by the Bedrock provider.
-func (provider *BedrockProvider) VideoGeneration(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoGenerationRequest, provider.GetProviderKey())
-}
-
-// VideoRetrieve is not supported by the Bedrock provider.
-func (provider *BedrockProvider) VideoRetrieve(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoRetrieveRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRetrieveRequest, provider.GetProviderKey())
-}
-
-// VideoDownload is not supported by the Bedrock provider.
-func (provider *BedrockProvider) VideoDownload(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoDownloadRequest) (*schemas.BifrostVideoDownloadResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoDownloadRequest, provider.GetProviderKey())
-}
-
-// VideoDelete is not supported by Bedrock provider.
-func (provider *BedrockProvider) VideoDelete(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoDeleteRequest) (*schemas.BifrostVideoDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoDeleteRequest, provider.GetProviderKey())
-}
-
-// VideoList is not supported by Bedrock provider.
-func (provider *BedrockProvider) VideoList(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoListRequest) (*schemas.BifrostVideoListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoListRequest, provider.GetProviderKey())
-}
-
-// VideoRemix is not supported by Bedrock provider.
-func (provider *BedrockProvider) VideoRemix(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoRemixRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRemixRequest, provider.GetProviderKey())
-}
-
 // FileUpload uploads a file to S3 for Bedrock batch processing.
 func (provider *BedrockProvider) FileUpload(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
 
@@ -2369,11 +2339,9 @@ func (provider *BedrockProvider) FileRetrieve(ctx *schemas.BifrostContext, keys
 		return nil, providerUtils.NewBifrostOperationError("invalid S3 URI format, expected s3://bucket/key", nil, providerName)
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
 		if key.BedrockKeyConfig == nil {
-			lastErr = providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
-			continue
+			return nil, providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
 		}
 
 		region := DefaultBedrockRegion
@@ -2387,14 +2355,12 @@ func (provider *BedrockProvider) FileRetrieve(ctx *schemas.BifrostContext, keys
 
 		httpReq, err := http.NewRequestWithContext(ctx, http.MethodHead, reqURL, nil)
 		if err != nil {
-			lastErr = providerUtils.NewBifrostOperationError("error creating request", err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError("error creating request", err, providerName)
 		}
 
 		// Sign request for S3
 		if err := signAWSRequest(ctx, httpReq, key.BedrockKeyConfig.AccessKey, key.BedrockKeyConfig.SecretKey, key.BedrockKeyConfig.SessionToken, key.BedrockKeyConfig.RoleARN, key.BedrockKeyConfig.ExternalID, key.BedrockKeyConfig.RoleSessionName, region, "s3", providerName); err != nil {
-			lastErr = err
-			continue
+			return nil, err
 		}
 
 		// Execute request
@@ -2412,14 +2378,12 @@ func (provider *BedrockProvider) FileRetrieve(ctx *schemas.BifrostContext, keys
 					},
 				}
 			}
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName)
 		}
 
 		if resp.StatusCode != http.StatusOK {
 			resp.Body.Close()
-			lastErr = providerUtils.NewProviderAPIError(fmt.Sprintf("S3 HEAD failed with status %d", resp.StatusCode), nil, resp.StatusCode, providerName, nil, nil)
-			continue
+			return nil, providerUtils.NewProviderAPIError(fmt.Sprintf("S3 HEAD failed with status %d", resp.StatusCode), nil, resp.StatusCode, providerName, nil, nil)
 		}
 
 		resp.Body.Close()
@@ -2453,9 +2417,7 @@ func (provider *BedrockProvider) FileRetrieve(ctx *schemas.BifrostContext, keys
 				Latency:     latency.Milliseconds(),
 			},
 		}, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // FileDelete deletes an S3 object used for Bedrock batch processing by trying each key until successful.
@@ -2476,11 +2438,9 @@ func (provider *BedrockProvider) FileDelete(ctx *schemas.BifrostContext, keys []
 		return nil, providerUtils.NewBifrostOperationError("invalid S3 URI format, expected s3://bucket/key", nil, providerName)
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
 		if key.BedrockKeyConfig == nil {
-			lastErr = providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
-			continue
+			return nil, providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
 		}
 
 		region := DefaultBedrockRegion
@@ -2494,14 +2454,12 @@ func (provider *BedrockProvider) FileDelete(ctx *schemas.BifrostContext, keys []
 
 		httpReq, err := http.NewRequestWithContext(ctx, http.MethodDelete, reqURL, nil)
 		if err != nil {
-			lastErr = providerUtils.NewBifrostOperationError("error creating request", err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError("error creating request", err, providerName)
 		}
 
 		// Sign request for S3
 		if err := signAWSRequest(ctx, httpReq, key.BedrockKeyConfig.AccessKey, key.BedrockKeyConfig.SecretKey, key.BedrockKeyConfig.SessionToken, key.BedrockKeyConfig.RoleARN, key.BedrockKeyConfig.ExternalID, key.BedrockKeyConfig.RoleSessionName, region, "s3", providerName); err != nil {
-			lastErr = err
-			continue
+			return nil, err
 		}
 
 		// Execute request
@@ -2519,16 +2477,14 @@ func (provider *BedrockProvider) FileDelete(ctx *schemas.BifrostContext, keys []
 					},
 				}
 			}
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName)
 		}
 
 		// S3 DELETE returns 204 No Content on success
 		if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
 			body, _ := io.ReadAll(resp.Body)
 			resp.Body.Close()
-			lastErr = providerUtils.NewProviderAPIError(fmt.Sprintf("S3 DELETE failed: %s", string(body)), nil, resp.StatusCode, providerName, nil, nil)
-			continue
+			return nil, providerUtils.NewProviderAPIError(fmt.Sprintf("S3 DELETE failed: %s", string(body)), nil, resp.StatusCode, providerName, nil, nil)
 		}
 
 		resp.Body.Close()
@@ -2543,9 +2499,7 @@ func (provider *BedrockProvider) FileDelete(ctx *schemas.BifrostContext, keys []
 				Latency:     latency.Milliseconds(),
 			},
 		}, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // FileContent downloads S3 object content for Bedrock batch processing by trying each key until found.
@@ -2566,11 +2520,9 @@ func (provider *BedrockProvider) FileContent(ctx *schemas.BifrostContext, keys [
 		return nil, providerUtils.NewBifrostOperationError("invalid S3 URI format, expected s3://bucket/key", nil, providerName)
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
 		if key.BedrockKeyConfig == nil {
-			lastErr = providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
-			continue
+			return nil, providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
 		}
 
 		region := DefaultBedrockRegion
@@ -2584,14 +2536,12 @@ func (provider *BedrockProvider) FileContent(ctx *schemas.BifrostContext, keys [
 
 		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 		if err != nil {
-			lastErr = providerUtils.NewBifrostOperationError("error creating request", err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError("error creating request", err, providerName)
 		}
 
 		// Sign request for S3
 		if err := signAWSRequest(ctx, httpReq, key.BedrockKeyConfig.AccessKey, key.BedrockKeyConfig.SecretKey, key.BedrockKeyConfig.SessionToken, key.BedrockKeyConfig.RoleARN, key.BedrockKeyConfig.ExternalID, key.BedrockKeyConfig.RoleSessionName, region, "s3", providerName); err != nil {
-			lastErr = err
-			continue
+			return nil, err
 		}
 
 		// Execute request
@@ -2609,22 +2559,19 @@ func (provider *BedrockProvider) FileContent(ctx *schemas.BifrostContext, keys [
 					},
 				}
 			}
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName)
 		}
 
 		if resp.StatusCode != http.StatusOK {
 			body, _ := io.ReadAll(resp.Body)
 			resp.Body.Close()
-			lastErr = providerUtils.NewProviderAPIError(fmt.Sprintf("S3 GET failed: %s", string(body)), nil, resp.StatusCode, providerName, nil, nil)
-			continue
+			return nil, providerUtils.NewProviderAPIError(fmt.Sprintf("S3 GET failed: %s", string(body)), nil, resp.StatusCode, providerName, nil, nil)
 		}
 
 		body, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
 		if err != nil {
-			lastErr = providerUtils.NewBifrostOperationError("error reading S3 object content", err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError("error reading S3 object content", err, providerName)
 		}
 
 		contentType := resp.Header.Get("Content-Type")
@@ -2642,9 +2589,7 @@ func (provider *BedrockProvider) FileContent(ctx *schemas.BifrostContext, keys [
 				Latency:     latency.Milliseconds(),
 			},
 		}, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // BatchCreate creates a new batch inference job on AWS Bedrock.
@@ -3121,11 +3066,9 @@ func (provider *BedrockProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys
 		return nil, providerUtils.NewBifrostOperationError("batch_id (job ARN) is required", nil, providerName)
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
 		if key.BedrockKeyConfig == nil {
-			lastErr = providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
-			continue
+			return nil, providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
 		}
 
 		region := DefaultBedrockRegion
@@ -3139,14 +3082,12 @@ func (provider *BedrockProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys
 
 		httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
 		if err != nil {
-			lastErr = providerUtils.NewBifrostOperationError("error creating request", err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError("error creating request", err, providerName)
 		}
 
 		// Sign request
 		if err := signAWSRequest(ctx, httpReq, key.BedrockKeyConfig.AccessKey, key.BedrockKeyConfig.SecretKey, key.BedrockKeyConfig.SessionToken, key.BedrockKeyConfig.RoleARN, key.BedrockKeyConfig.ExternalID, key.BedrockKeyConfig.RoleSessionName, region, "bedrock", providerName); err != nil {
-			lastErr = err
-			continue
+			return nil, err
 		}
 
 		// Execute request
@@ -3164,26 +3105,22 @@ func (provider *BedrockProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys
 					},
 				}
 			}
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName)
 		}
 
 		body, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
 		if err != nil {
-			lastErr = providerUtils.NewBifrostOperationError("error reading response", err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError("error reading response", err, providerName)
 		}
 
 		if resp.StatusCode != http.StatusOK {
-			lastErr = parseBedrockHTTPError(resp.StatusCode, resp.Header, body)
-			continue
+			return nil, parseBedrockHTTPError(resp.StatusCode, resp.Header, body)
 		}
 
 		var bedrockResp BedrockBatchJobResponse
 		if err := sonic.Unmarshal(body, &bedrockResp); err != nil {
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
 		}
 
 		// Store Bedrock-specific fields in Metadata for later conversion back to Bedrock format
@@ -3248,9 +3185,7 @@ func (provider *BedrockProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys
 		}
 
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // BatchCancel stops a batch inference job on AWS Bedrock by trying each key until successful.
@@ -3265,11 +3200,9 @@ func (provider *BedrockProvider) BatchCancel(ctx *schemas.BifrostContext, keys [
 		return nil, providerUtils.NewBifrostOperationError("batch_id (job ARN) is required", nil, providerName)
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
 		if key.BedrockKeyConfig == nil {
-			lastErr = providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
-			continue
+			return nil, providerUtils.NewConfigurationError("bedrock key config is not provided", providerName)
 		}
 
 		region := DefaultBedrockRegion
@@ -3283,14 +3216,12 @@ func (provider *BedrockProvider) BatchCancel(ctx *schemas.BifrostContext, keys [
 
 		httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, nil)
 		if err != nil {
-			lastErr = providerUtils.NewBifrostOperationError("error creating request", err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError("error creating request", err, providerName)
 		}
 
 		// Sign request
 		if err := signAWSRequest(ctx, httpReq, key.BedrockKeyConfig.AccessKey, key.BedrockKeyConfig.SecretKey, key.BedrockKeyConfig.SessionToken, key.BedrockKeyConfig.RoleARN, key.BedrockKeyConfig.ExternalID, key.BedrockKeyConfig.RoleSessionName, region, "bedrock", providerName); err != nil {
-			lastErr = err
-			continue
+			return nil, err
 		}
 
 		// Execute request
@@ -3308,20 +3239,17 @@ func (provider *BedrockProvider) BatchCancel(ctx *schemas.BifrostContext, keys [
 					},
 				}
 			}
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName)
 		}
 
 		body, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
 		if err != nil {
-			lastErr = providerUtils.NewBifrostOperationError("error reading response", err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError("error reading response", err, providerName)
 		}
 
 		if resp.StatusCode != http.StatusOK {
-			lastErr = parseBedrockHTTPError(resp.StatusCode, resp.Header, body)
-			continue
+			return nil, parseBedrockHTTPError(resp.StatusCode, resp.Header, body)
 		}
 
 		// After stopping, retrieve the job to get updated status
@@ -3355,9 +3283,7 @@ func (provider *BedrockProvider) BatchCancel(ctx *schemas.BifrostContext, keys [
 				Latency:     latency.Milliseconds(),
 			},
 		}, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // BatchResults retrieves batch results from AWS Bedrock by trying each key until successful.
@@ -3572,48 +3498,3 @@ func (provider *BedrockProvider) CountTokens(ctx *schemas.BifrostContext, key sc
 
 	return response, nil
 }
-
-// ContainerCreate is not supported by the Bedrock provider.
-func (provider *BedrockProvider) ContainerCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerCreateRequest) (*schemas.BifrostContainerCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerCreateRequest, provider.GetProviderKey())
-}
-
-// ContainerList is not supported by the Bedrock provider.
-func (provider *BedrockProvider) ContainerList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerListRequest) (*schemas.BifrostContainerListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerListRequest, provider.GetProviderKey())
-}
-
-// ContainerRetrieve is not supported by the Bedrock provider.
-func (provider *BedrockProvider) ContainerRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerRetrieveRequest) (*schemas.BifrostContainerRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerRetrieveRequest, provider.GetProviderKey())
-}
-
-// ContainerDelete is not supported by the Bedrock provider.
-func (provider *BedrockProvider) ContainerDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerDeleteRequest) (*schemas.BifrostContainerDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerDeleteRequest, provider.GetProviderKey())
-}
-
-// ContainerFileCreate is not supported by the Bedrock provider.
-func (provider *BedrockProvider) ContainerFileCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerFileCreateRequest) (*schemas.BifrostContainerFileCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileCreateRequest, provider.GetProviderKey())
-}
-
-// ContainerFileList is not supported by the Bedrock provider.
-func (provider *BedrockProvider) ContainerFileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileListRequest) (*schemas.BifrostContainerFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileListRequest, provider.GetProviderKey())
-}
-
-// ContainerFileRetrieve is not supported by the Bedrock provider.
-func (provider *BedrockProvider) ContainerFileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileRetrieveRequest) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileRetrieveRequest, provider.GetProviderKey())
-}
-
-// ContainerFileContent is not supported by the Bedrock provider.
-func (provider *BedrockProvider) ContainerFileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileContentRequest) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileContentRequest, provider.GetProviderKey())
-}
-
-// ContainerFileDelete is not supported by the Bedrock provider.
-func (provider *BedrockProvider) ContainerFileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileDeleteRequest) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileDeleteRequest, provider.GetProviderKey())
-}
@@ -753,6 +753,12 @@ func convertContentBlock(block schemas.ChatContentBlock) ([]BedrockContentBlock,
 				Document: documentSource,
 			},
 		}, nil
+	case schemas.ChatContentBlockTypeDocument:
+		if block.Document == nil {
+			return nil, fmt.Errorf("document block missing document field")
+		}
+		return convertContentBlock(block.DocumentAsFile())
+
 	case schemas.ChatContentBlockTypeInputAudio:
 		// Bedrock doesn't support audio input in Converse API
 		return nil, fmt.Errorf("audio input not supported in Bedrock Converse API")
@@ -101,6 +101,7 @@ func ToCohereChatCompletionRequest(bifrostReq *schemas.BifrostChatRequest) (*Coh
 		cohereReq.StopSequences = bifrostReq.Params.Stop
 		cohereReq.FrequencyPenalty = bifrostReq.Params.FrequencyPenalty
 		cohereReq.PresencePenalty = bifrostReq.Params.PresencePenalty
+		cohereReq.LogProbs = bifrostReq.Params.LogProbs
 
 		// Convert reasoning
 		if bifrostReq.Params.Reasoning != nil {
@@ -268,6 +269,9 @@ func (req *CohereChatRequest) ToBifrostChatRequest(ctx *schemas.BifrostContext)
 	if req.PresencePenalty != nil {
 		bifrostReq.Params.PresencePenalty = req.PresencePenalty
 	}
+	if req.LogProbs != nil {
+		bifrostReq.Params.LogProbs = req.LogProbs
+	}
 
 	// Convert reasoning
 	if req.Thinking != nil {
@@ -327,9 +331,6 @@ func (req *CohereChatRequest) ToBifrostChatRequest(ctx *schemas.BifrostContext)
 	if req.SafetyMode != nil {
 		extraParams["safety_mode"] = *req.SafetyMode
 	}
-	if req.LogProbs != nil {
-		extraParams["log_probs"] = *req.LogProbs
-	}
 	if req.StrictToolChoice != nil {
 		extraParams["strict_tool_choice"] = *req.StrictToolChoice
 	}
@@ -384,6 +385,9 @@ func (response *CohereChatResponse) ToBifrostChatResponse(model string) *schemas
 		bifrostResponse.Choices[0].FinishReason = schemas.Ptr(finishReason)
 	}
 
+	// Convert log probabilities
+	bifrostResponse.Choices[0].LogProbs = convertCohereLogProbsToBifrost(response.LogProbs)
+
 	// Convert usage information
 	if response.Usage != nil {
 		usage := &schemas.BifrostLLMUsage{}
@@ -437,12 +441,17 @@ func (chunk *CohereStreamEvent) ToBifrostChatCompletionStream() (*schemas.Bifros
 			chunk.Delta.Message.Content != nil &&
 			chunk.Delta.Message.Content.CohereStreamContentObject != nil {
 			if chunk.Delta.Message.Content.CohereStreamContentObject.Text != nil {
+				var logProbs *schemas.BifrostLogProbs
+				if chunk.LogProbs != nil {
+					logProbs = convertCohereLogProbsToBifrost([]CohereLogProb{*chunk.LogProbs})
+				}
 				// Try to cast content to CohereStreamContent
 				streamResponse := &schemas.BifrostChatResponse{
 					Object: "chat.completion.chunk",
 					Choices: []schemas.BifrostResponseChoice{
 						{
-							Index: 0,
+							Index:    0,
+							LogProbs: logProbs,
 							ChatStreamResponseChoice: &schemas.ChatStreamResponseChoice{
 								Delta: &schemas.ChatStreamResponseChoiceDelta{
 									Content: chunk.Delta.Message.Content.CohereStreamContentObject.Text,
@@ -713,3 +722,28 @@ func (cm *CohereMessage) ToBifrostChatMessage() *schemas.ChatMessage {
 	}
 	return bifrostMessage
 }
+
+// convertCohereLogProbsToBifrost converts Cohere's log probabilities to Bifrost's token logprobs.
+// Cohere reports them per text chunk rather than per token, so a chunk spanning several tokens
+// becomes a single entry whose log probability is the sum of its tokens'.
+func convertCohereLogProbsToBifrost(logProbs []CohereLogProb) *schemas.BifrostLogProbs {
+	if len(logProbs) == 0 {
+		return nil
+	}
+	content := make([]schemas.ContentLogProb, 0, len(logProbs))
+	for _, logProb := range logProbs {
+		entry := schemas.ContentLogProb{}
+		if logProb.Text != nil {
+			entry.Token = *logProb.Text
+			entry.Bytes = make([]int, len(entry.Token))
+			for i := 0; i < len(entry.Token); i++ {
+				entry.Bytes[i] = int(entry.Token[i])
+			}
+		}
+		for _, value := range logProb.LogProbs {
+			entry.LogProb += value
+		}
+		content = append(content, entry)
+	}
+	return &schemas.BifrostLogProbs{Content: content}
+}
@@ -0,0 +1,61 @@
+package cohere
+
+import (
+	"context"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCohereLogProbs(t *testing.T) {
+	t.Run("Request", func(t *testing.T) {
+		req, err := ToCohereChatCompletionRequest(&schemas.BifrostChatRequest{
+			Model:  "command-r",
+			Input:  []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("Hi")}}},
+			Params: &schemas.ChatParameters{LogProbs: schemas.Ptr(true)},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, req.LogProbs)
+		assert.True(t, *req.LogProbs)
+
+		bifrostReq := req.ToBifrostChatRequest(schemas.NewBifrostContext(context.Background(), schemas.NoDeadline))
+		require.NotNil(t, bifrostReq.Params.LogProbs)
+		assert.True(t, *bifrostReq.Params.LogProbs)
+	})
+
+	t.Run("Response", func(t *testing.T) {
+		response := (&CohereChatResponse{
+			ID: "chat-1",
+			LogProbs: []CohereLogProb{
+				{TokenIDs: []int{1}, Text: schemas.Ptr("Hi"), LogProbs: []float64{-0.5}},
+				{TokenIDs: []int{2, 3}, Text: schemas.Ptr(" there"), LogProbs: []float64{-0.25, -0.75}},
+			},
+		}).ToBifrostChatResponse("command-r")
+
+		logProbs := response.Choices[0].LogProbs
+		require.NotNil(t, logProbs)
+		require.Len(t, logProbs.Content, 2)
+		assert.Equal(t, "Hi", logProbs.Content[0].Token)
+		assert.Equal(t, -0.5, logProbs.Content[0].LogProb)
+		assert.Equal(t, " there", logProbs.Content[1].Token)
+		assert.Equal(t, -1.0, logProbs.Content[1].LogProb)
+		assert.Equal(t, []int{' ', 't', 'h', 'e', 'r', 'e'}, logProbs.Content[1].Bytes)
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		event := &CohereStreamEvent{}
+		err := schemas.Unmarshal([]byte(`{"type":"content-delta","index":0,"delta":{"message":{"content":{"text":"Hi"}}},"logprobs":{"token_ids":[1],"text":"Hi","logprobs":[-0.5]}}`), event)
+		require.NoError(t, err)
+
+		response, bifrostErr, _ := event.ToBifrostChatCompletionStream()
+		require.Nil(t, bifrostErr)
+		require.NotNil(t, response)
+		logProbs := response.Choices[0].LogProbs
+		require.NotNil(t, logProbs)
+		require.Len(t, logProbs.Content, 1)
+		assert.Equal(t, "Hi", logProbs.Content[0].Token)
+		assert.Equal(t, -0.5, logProbs.Content[0].LogProb)
+	})
+}
@@ -450,6 +450,8 @@ type CohereStreamEvent struct {
 	ID    *string               `json:"id,omitempty"`    // For message-start
 	Index *int                  `json:"index,omitempty"` // For indexed events
 	Delta *CohereStreamDelta    `json:"delta,omitempty"`
+	// LogProbs of the text of a content-delta event, if requested
+	LogProbs *CohereLogProb `json:"logprobs,omitempty"`
 }
 
 // CohereStreamDelta represents the delta content in streaming events
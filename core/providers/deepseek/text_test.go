@@ -0,0 +1,32 @@
+package deepseek
+
+import (
+	"context"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestTextCompletionURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		params  *schemas.TextCompletionParameters
+		want    string
+	}{
+		{name: "plain completion", baseURL: "https://api.deepseek.com", want: "https://api.deepseek.com/completions"},
+		{name: "fim completion", baseURL: "https://api.deepseek.com", params: &schemas.TextCompletionParameters{Suffix: schemas.Ptr("return a")}, want: "https://api.deepseek.com/beta/completions"},
+		{name: "fim completion with versioned base url", baseURL: "https://api.deepseek.com/v1", params: &schemas.TextCompletionParameters{Suffix: schemas.Ptr("")}, want: "https://api.deepseek.com/beta/completions"},
+		{name: "params without suffix", baseURL: "https://api.deepseek.com/v1", params: &schemas.TextCompletionParameters{MaxTokens: schemas.Ptr(16)}, want: "https://api.deepseek.com/v1/completions"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider := &DeepSeekProvider{networkConfig: schemas.NetworkConfig{BaseURL: tt.baseURL}}
+			ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+			got := provider.textCompletionURL(ctx, &schemas.BifrostTextCompletionRequest{Model: "deepseek-chat", Params: tt.params})
+			if got != tt.want {
+				t.Errorf("textCompletionURL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
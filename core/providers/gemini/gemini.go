@@ -31,6 +31,26 @@ type GeminiProvider struct {
 	customProviderConfig *schemas.CustomProviderConfig // Custom provider config
 }
 
+// Compile-time checks for the capabilities GeminiProvider implements.
+var (
+	_ schemas.ListModelsProvider      = (*GeminiProvider)(nil)
+	_ schemas.ChatProvider            = (*GeminiProvider)(nil)
+	_ schemas.ResponsesProvider       = (*GeminiProvider)(nil)
+	_ schemas.CountTokensProvider     = (*GeminiProvider)(nil)
+	_ schemas.EmbeddingProvider       = (*GeminiProvider)(nil)
+	_ schemas.SpeechProvider          = (*GeminiProvider)(nil)
+	_ schemas.TranscriptionProvider   = (*GeminiProvider)(nil)
+	_ schemas.ImageGenerationProvider = (*GeminiProvider)(nil)
+	_ schemas.ImageEditProvider       = (*GeminiProvider)(nil)
+	_ schemas.VideoProvider           = (*GeminiProvider)(nil)
+	_ schemas.BatchProvider           = (*GeminiProvider)(nil)
+	_ schemas.FileProvider            = (*GeminiProvider)(nil)
+)
+
+func init() {
+	schemas.RegisterProviderCapabilities(schemas.Gemini, schemas.CapabilitiesOf((*GeminiProvider)(nil)))
+}
+
 // NewGeminiProvider creates a new Gemini provider instance.
 // It initializes the HTTP client with the provided configuration.
 // The client is configured with timeouts, concurrency limits, and optional proxy settings.
@@ -70,6 +90,11 @@ func (provider *GeminiProvider) GetProviderKey() schemas.ModelProvider {
 	return providerUtils.GetProviderName(schemas.Gemini, provider.customProviderConfig)
 }
 
+// HTTPClient returns the pooled client used for Gemini API requests.
+func (provider *GeminiProvider) HTTPClient() *fasthttp.Client {
+	return provider.client
+}
+
 // completeRequest handles the common HTTP request pattern for Gemini API calls
 func (provider *GeminiProvider) completeRequest(ctx *schemas.BifrostContext, model string, key schemas.Key, jsonBody []byte, endpoint string, meta *providerUtils.RequestMetadata) (*GenerateContentResponse, interface{}, time.Duration, map[string]string, *schemas.BifrostError) {
 	providerName := provider.GetProviderKey()
@@ -214,18 +239,6 @@ func (provider *GeminiProvider) ListModels(ctx *schemas.BifrostContext, keys []s
 	)
 }
 
-// TextCompletion is not supported by the Gemini provider.
-func (provider *GeminiProvider) TextCompletion(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostTextCompletionRequest) (*schemas.BifrostTextCompletionResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TextCompletionRequest, provider.GetProviderKey())
-}
-
-// TextCompletionStream performs a streaming text completion request to Gemini's API.
-// It formats the request, sends it to Gemini, and processes the response.
-// Returns a channel of BifrostStreamChunk objects or an error if the request fails.
-func (provider *GeminiProvider) TextCompletionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTextCompletionRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TextCompletionStreamRequest, provider.GetProviderKey())
-}
-
 // ChatCompletion performs a chat completion request to the Gemini API.
 func (provider *GeminiProvider) ChatCompletion(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
 	// Check if chat completion is allowed for this provider
@@ -1113,11 +1126,6 @@ func (provider *GeminiProvider) Speech(ctx *schemas.BifrostContext, key schemas.
 	return response, nil
 }
 
-// Rerank is not supported by the Gemini provider.
-func (provider *GeminiProvider) Rerank(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRerankRequest) (*schemas.BifrostRerankResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.RerankRequest, provider.GetProviderKey())
-}
-
 // SpeechStream performs a streaming speech synthesis request to the Gemini API.
 func (provider *GeminiProvider) SpeechStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
 	// Check if speech stream is allowed for this provider
@@ -1975,11 +1983,6 @@ func (provider *GeminiProvider) ImageEditStream(ctx *schemas.BifrostContext, pos
 	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditStreamRequest, provider.GetProviderKey())
 }
 
-// ImageVariation is not supported by the Gemini provider.
-func (provider *GeminiProvider) ImageVariation(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageVariationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageVariationRequest, provider.GetProviderKey())
-}
-
 // VideoGeneration creates a video generation operation using Gemini's Veo models.
 // Uses the POST /models/{model}:predictLongRunning endpoint.
 func (provider *GeminiProvider) VideoGeneration(ctx *schemas.BifrostContext, key schemas.Key, bifrostReq *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
@@ -2701,17 +2704,9 @@ func (provider *GeminiProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys
 	}
 
 	// Try each key until we find the batch
-	var lastError *schemas.BifrostError
-	for _, key := range keys {
-		resp, err := provider.batchRetrieveByKey(ctx, key, request)
-		if err == nil {
-			return resp, nil
-		}
-		lastError = err
-	}
-
-	// All keys failed, return the last error
-	return nil, lastError
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
+		return provider.batchRetrieveByKey(ctx, key, request)
+	})
 }
 
 // batchCancelByKey cancels a batch job for Gemini for a single key.
@@ -2797,18 +2792,13 @@ func (provider *GeminiProvider) BatchCancel(ctx *schemas.BifrostContext, keys []
 	}
 
 	// Try each key until cancellation succeeds
-	var lastError *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
 		resp, err := provider.batchCancelByKey(ctx, key, request)
-		if err == nil {
-			return resp, nil
+		if err != nil {
+			provider.logger.Debug("BatchCancel failed for key %s: %v", key.Name, err.Error)
 		}
-		lastError = err
-		provider.logger.Debug("BatchCancel failed for key %s: %v", key.Name, err.Error)
-	}
-
-	// All keys failed, return the last error
-	return nil, lastError
+		return resp, err
+	})
 }
 
 // processGeminiStreamChunk processes a single chunk from Gemini streaming response
@@ -3006,18 +2996,13 @@ func (provider *GeminiProvider) BatchResults(ctx *schemas.BifrostContext, keys [
 	}
 
 	// Try each key until we get results
-	var lastError *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
 		resp, err := provider.batchResultsByKey(ctx, key, request)
-		if err == nil {
-			return resp, nil
+		if err != nil {
+			provider.logger.Debug("BatchResults failed for key %s: %v", key.Name, err.Error.Message)
 		}
-		lastError = err
-		provider.logger.Debug("BatchResults failed for key %s: %v", key.Name, err.Error.Message)
-	}
-
-	// All keys failed, return the last error
-	return nil, lastError
+		return resp, err
+	})
 }
 
 // FileUpload uploads a file to Gemini.
@@ -3436,18 +3421,13 @@ func (provider *GeminiProvider) FileRetrieve(ctx *schemas.BifrostContext, keys [
 	}
 
 	// Try each key until we find the file
-	var lastError *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
 		resp, err := provider.fileRetrieveByKey(ctx, key, request)
-		if err == nil {
-			return resp, nil
+		if err != nil {
+			provider.logger.Debug("FileRetrieve failed for key %s: %v", key.Name, err.Error)
 		}
-		lastError = err
-		provider.logger.Debug("FileRetrieve failed for key %s: %v", key.Name, err.Error)
-	}
-
-	// All keys failed, return the last error
-	return nil, lastError
+		return resp, err
+	})
 }
 
 // fileDeleteByKey deletes a file from Gemini for a single key.
@@ -3518,18 +3498,13 @@ func (provider *GeminiProvider) FileDelete(ctx *schemas.BifrostContext, keys []s
 	}
 
 	// Try each key until deletion succeeds
-	var lastError *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
 		resp, err := provider.fileDeleteByKey(ctx, key, request)
-		if err == nil {
-			return resp, nil
+		if err != nil {
+			provider.logger.Debug("FileDelete failed for key %s: %v", key.Name, err.Error)
 		}
-		lastError = err
-		provider.logger.Debug("FileDelete failed for key %s: %v", key.Name, err.Error)
-	}
-
-	// All keys failed, return the last error
-	return nil, lastError
+		return resp, err
+	})
 }
 
 // FileContent downloads file content from Gemini.
@@ -3654,48 +3629,3 @@ func (provider *GeminiProvider) CountTokens(ctx *schemas.BifrostContext, key sch
 
 	return response, nil
 }
-
-// ContainerCreate is not supported by the Gemini provider.
-func (provider *GeminiProvider) ContainerCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerCreateRequest) (*schemas.BifrostContainerCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerCreateRequest, provider.GetProviderKey())
-}
-
-// ContainerList is not supported by the Gemini provider.
-func (provider *GeminiProvider) ContainerList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerListRequest) (*schemas.BifrostContainerListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerListRequest, provider.GetProviderKey())
-}
-
-// ContainerRetrieve is not supported by the Gemini provider.
-func (provider *GeminiProvider) ContainerRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerRetrieveRequest) (*schemas.BifrostContainerRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerRetrieveRequest, provider.GetProviderKey())
-}
-
-// ContainerDelete is not supported by the Gemini provider.
-func (provider *GeminiProvider) ContainerDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerDeleteRequest) (*schemas.BifrostContainerDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerDeleteRequest, provider.GetProviderKey())
-}
-
-// ContainerFileCreate is not supported by the Gemini provider.
-func (provider *GeminiProvider) ContainerFileCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerFileCreateRequest) (*schemas.BifrostContainerFileCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileCreateRequest, provider.GetProviderKey())
-}
-
-// ContainerFileList is not supported by the Gemini provider.
-func (provider *GeminiProvider) ContainerFileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileListRequest) (*schemas.BifrostContainerFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileListRequest, provider.GetProviderKey())
-}
-
-// ContainerFileRetrieve is not supported by the Gemini provider.
-func (provider *GeminiProvider) ContainerFileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileRetrieveRequest) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileRetrieveRequest, provider.GetProviderKey())
-}
-
-// ContainerFileContent is not supported by the Gemini provider.
-func (provider *GeminiProvider) ContainerFileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileContentRequest) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileContentRequest, provider.GetProviderKey())
-}
-
-// ContainerFileDelete is not supported by the Gemini provider.
-func (provider *GeminiProvider) ContainerFileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileDeleteRequest) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileDeleteRequest, provider.GetProviderKey())
-}
@@ -2282,3 +2282,64 @@ func TestConvertGeminiUsageMetadataToResponsesUsage(t *testing.T) {
 		})
 	}
 }
+
+func TestLogProbsConversion(t *testing.T) {
+	t.Run("Request", func(t *testing.T) {
+		req := gemini.ToGeminiChatCompletionRequest(&schemas.BifrostChatRequest{
+			Model: "gemini-2.0-flash",
+			Input: []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("Hi")}}},
+			Params: &schemas.ChatParameters{
+				LogProbs:    schemas.Ptr(true),
+				TopLogProbs: schemas.Ptr(2),
+			},
+		})
+		require.NotNil(t, req)
+		assert.True(t, req.GenerationConfig.ResponseLogprobs)
+		require.NotNil(t, req.GenerationConfig.Logprobs)
+		assert.Equal(t, int32(2), *req.GenerationConfig.Logprobs)
+	})
+
+	logprobsResult := &gemini.LogprobsResult{
+		ChosenCandidates: []*gemini.LogprobsResultCandidate{
+			{Token: "Hi", LogProbability: -0.5},
+			{Token: "!", LogProbability: -1.5},
+		},
+		TopCandidates: []*gemini.LogprobsResultTopCandidates{
+			{Candidates: []*gemini.LogprobsResultCandidate{{Token: "Hi", LogProbability: -0.5}, {Token: "Hey", LogProbability: -2}}},
+			{Candidates: []*gemini.LogprobsResultCandidate{{Token: "!", LogProbability: -1.5}}},
+		},
+	}
+	response := &gemini.GenerateContentResponse{
+		Candidates: []*gemini.Candidate{{
+			Content:        &gemini.Content{Role: "model", Parts: []*gemini.Part{{Text: "Hi!"}}},
+			FinishReason:   gemini.FinishReasonStop,
+			LogprobsResult: logprobsResult,
+		}},
+		UsageMetadata: &gemini.GenerateContentResponseUsageMetadata{PromptTokenCount: 1, CandidatesTokenCount: 2, TotalTokenCount: 3},
+	}
+	assertLogProbs := func(t *testing.T, logProbs *schemas.BifrostLogProbs) {
+		require.NotNil(t, logProbs)
+		require.Len(t, logProbs.Content, 2)
+		assert.Equal(t, "Hi", logProbs.Content[0].Token)
+		assert.Equal(t, -0.5, logProbs.Content[0].LogProb)
+		assert.Equal(t, []int{'H', 'i'}, logProbs.Content[0].Bytes)
+		require.Len(t, logProbs.Content[0].TopLogProbs, 2)
+		assert.Equal(t, "Hey", logProbs.Content[0].TopLogProbs[1].Token)
+		assert.Equal(t, -2.0, logProbs.Content[0].TopLogProbs[1].LogProb)
+		assert.Equal(t, -1.5, logProbs.Content[1].LogProb)
+	}
+
+	t.Run("Response", func(t *testing.T) {
+		resp := response.ToBifrostChatResponse()
+		require.Len(t, resp.Choices, 1)
+		assertLogProbs(t, resp.Choices[0].LogProbs)
+	})
+
+	t.Run("Stream", func(t *testing.T) {
+		resp, bifrostErr, _ := response.ToBifrostChatCompletionStream()
+		require.Nil(t, bifrostErr)
+		require.NotNil(t, resp)
+		require.Len(t, resp.Choices, 1)
+		assertLogProbs(t, resp.Choices[0].LogProbs)
+	})
+}
@@ -345,8 +345,9 @@ func (response *GenerateContentResponse) ToBifrostImageGenerationResponse() (*sc
 				// Check that part is not nil before accessing its fields
 				if part != nil && part.InlineData != nil {
 					imageData = append(imageData, schemas.ImageData{
-						B64JSON: part.InlineData.Data,
-						Index:   idx,
+						B64JSON:  part.InlineData.Data,
+						MimeType: part.InlineData.MIMEType,
+						Index:    idx,
 					})
 					// Convert MIME type to file extension for OutputFormat
 					outputFormat := convertMimeTypeToExtension(part.InlineData.MIMEType)
@@ -686,8 +687,15 @@ func (response *GeminiImagenResponse) ToBifrostImageGenerationResponse() *schema
 	// Convert each prediction to ImageData
 	for i, prediction := range response.Predictions {
 		bifrostResp.Data[i] = schemas.ImageData{
-			B64JSON: prediction.BytesBase64Encoded,
-			Index:   i,
+			B64JSON:  prediction.BytesBase64Encoded,
+			MimeType: prediction.MimeType,
+			Index:    i,
+		}
+		if prediction.RaiFilteredReason != "" {
+			bifrostResp.Data[i].Safety = &schemas.ImageSafetyAnnotation{
+				Flagged: true,
+				Reason:  prediction.RaiFilteredReason,
+			}
 		}
 
 		// Set output format from MIME type if available
@@ -721,7 +729,9 @@ func ToGeminiImageGenerationResponse(ctx context.Context, bifrostResp *schemas.B
 			imageData := &bifrostResp.Data[i]
 			// Determine MIME type - convert file extension back to MIME type
 			mimeType := "image/png" // default
-			if bifrostResp.ImageGenerationResponseParameters != nil && bifrostResp.ImageGenerationResponseParameters.OutputFormat != "" {
+			if imageData.MimeType != "" {
+				mimeType = imageData.MimeType
+			} else if bifrostResp.ImageGenerationResponseParameters != nil && bifrostResp.ImageGenerationResponseParameters.OutputFormat != "" {
 				mimeType = convertOutputFormatToMimeType(bifrostResp.ImageGenerationResponseParameters.OutputFormat)
 				if mimeType == "" {
 					// Fallback: if conversion fails, assume PNG
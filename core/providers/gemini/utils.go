@@ -987,6 +987,12 @@ func convertParamsToGenerationConfig(params *schemas.ChatParameters, responseMod
 	}
 
 	// Handle response_format to response_schema conversion
+	if params.LogProbs != nil && *params.LogProbs {
+		config.ResponseLogprobs = true
+		if params.TopLogProbs != nil {
+			config.Logprobs = schemas.Ptr(int32(*params.TopLogProbs))
+		}
+	}
 	if params.ResponseFormat != nil {
 		formatMap, ok := (*params.ResponseFormat).(map[string]interface{})
 		if ok {
@@ -1562,6 +1568,7 @@ func convertBifrostMessagesToGemini(messages []schemas.ChatMessage) ([]Content,
 				})
 			} else if message.Content.ContentBlocks != nil {
 				for _, block := range message.Content.ContentBlocks {
+					block = block.DocumentAsFile()
 					if block.Text != nil {
 						parts = append(parts, &Part{
 							Text: *block.Text,
@@ -2361,3 +2368,45 @@ func downloadImageFromURL(ctx context.Context, imageURL string) (string, error)
 
 	return encodeBytesToBase64String(imageCopy), nil
 }
+
+// convertLogprobsResultToBifrost converts Gemini's logprobs of a candidate to Bifrost's token logprobs.
+// Top candidates are matched to the chosen token of the same decoding step.
+func convertLogprobsResultToBifrost(result *LogprobsResult) *schemas.BifrostLogProbs {
+	if result == nil || len(result.ChosenCandidates) == 0 {
+		return nil
+	}
+	content := make([]schemas.ContentLogProb, 0, len(result.ChosenCandidates))
+	for i, chosen := range result.ChosenCandidates {
+		if chosen == nil {
+			continue
+		}
+		logProb := schemas.ContentLogProb{
+			Bytes:   tokenBytes(chosen.Token),
+			LogProb: float64(chosen.LogProbability),
+			Token:   chosen.Token,
+		}
+		if i < len(result.TopCandidates) && result.TopCandidates[i] != nil {
+			for _, top := range result.TopCandidates[i].Candidates {
+				if top == nil {
+					continue
+				}
+				logProb.TopLogProbs = append(logProb.TopLogProbs, schemas.LogProb{
+					Bytes:   tokenBytes(top.Token),
+					LogProb: float64(top.LogProbability),
+					Token:   top.Token,
+				})
+			}
+		}
+		content = append(content, logProb)
+	}
+	return &schemas.BifrostLogProbs{Content: content}
+}
+
+// tokenBytes returns the UTF-8 bytes of a token, as OpenAI reports them.
+func tokenBytes(token string) []int {
+	bytes := make([]int, len(token))
+	for i := 0; i < len(token); i++ {
+		bytes[i] = int(token[i])
+	}
+	return bytes
+}
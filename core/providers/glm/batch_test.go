@@ -0,0 +1,146 @@
+package glm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+// newBatchTestServer serves the subset of GLM's files and batches API used by a batch lifecycle:
+// uploading the input file, creating and retrieving the batch and downloading its output file.
+func newBatchTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":{"message":"invalid api key","code":"1000"}}`)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == glmPathFiles:
+			file, header, err := r.FormFile("file")
+			if err != nil || r.FormValue("purpose") != "batch" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			content, _ := io.ReadAll(file)
+			// Inline requests get GLM's /v4 URL, defaulted from the batch endpoint when unset
+			if !strings.Contains(string(content), `"custom_id":"req-1"`) || !strings.Contains(string(content), `"url":"/v4/chat/completions"`) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprintf(w, `{"id":"file-in","object":"file","bytes":%d,"created_at":1,"filename":%q,"purpose":"batch"}`, len(content), header.Filename)
+		case r.Method == http.MethodPost && r.URL.Path == glmPathBatches:
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["input_file_id"] != "file-in" ||
+				body["endpoint"] != "/v4/chat/completions" || body["completion_window"] != "24h" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"id":"batch-1","object":"batch","endpoint":"/v4/chat/completions","input_file_id":"file-in","completion_window":"24h","status":"validating","created_at":2}`)
+		case r.Method == http.MethodGet && r.URL.Path == glmPathBatches+"/batch-1":
+			fmt.Fprint(w, `{"id":"batch-1","object":"batch","endpoint":"/v4/chat/completions","input_file_id":"file-in","completion_window":"24h","status":"completed","output_file_id":"file-out","created_at":2,"request_counts":{"total":1,"completed":1,"failed":0}}`)
+		case r.Method == http.MethodGet && r.URL.Path == glmPathFiles+"/file-out/content":
+			w.Header().Set("Content-Type", "application/jsonl")
+			fmt.Fprint(w, `{"custom_id":"req-1","response":{"status_code":200,"body":{"id":"chatcmpl-1"}}}`+"\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":{"message":"not found","code":"1001"}}`)
+		}
+	}))
+}
+
+func newTestGLMProvider(t *testing.T, serverURL string) *GLMProvider {
+	t.Helper()
+	provider, err := NewGLMProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			BaseURL:                        serverURL,
+			DefaultRequestTimeoutInSeconds: 10,
+		},
+	}, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewGLMProvider() error = %v", err)
+	}
+	return provider
+}
+
+func TestBatchLifecycle(t *testing.T) {
+	t.Parallel()
+
+	server := newBatchTestServer(t)
+	defer server.Close()
+	provider := newTestGLMProvider(t, server.URL)
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	key := schemas.Key{Value: schemas.EnvVar{Val: "test-key"}}
+
+	created, bifrostErr := provider.BatchCreate(ctx, key, &schemas.BifrostBatchCreateRequest{
+		Provider: schemas.GLM,
+		Endpoint: schemas.BatchEndpointChatCompletions,
+		Requests: []schemas.BatchRequestItem{{
+			CustomID: "req-1",
+			Body:     map[string]any{"model": "glm-4-plus", "messages": []any{map[string]any{"role": "user", "content": "hi"}}},
+		}},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("BatchCreate() error = %v", bifrostErr.Error.Message)
+	}
+	if created.ID != "batch-1" || created.InputFileID != "file-in" || created.ExtraFields.Provider != schemas.GLM {
+		t.Fatalf("created batch = %+v", created)
+	}
+
+	keys := []schemas.Key{key}
+	results, bifrostErr := provider.BatchResults(ctx, keys, &schemas.BifrostBatchResultsRequest{Provider: schemas.GLM, BatchID: "batch-1"})
+	if bifrostErr != nil {
+		t.Fatalf("BatchResults() error = %v", bifrostErr.Error.Message)
+	}
+	if len(results.Results) != 1 || results.Results[0].CustomID != "req-1" || results.ExtraFields.RequestType != schemas.BatchResultsRequest {
+		t.Errorf("results = %+v", results)
+	}
+
+	content, bifrostErr := provider.FileContent(ctx, keys, &schemas.BifrostFileContentRequest{Provider: schemas.GLM, FileID: "file-out"})
+	if bifrostErr != nil {
+		t.Fatalf("FileContent() error = %v", bifrostErr.Error.Message)
+	}
+	if content.ContentType != "application/jsonl" || !strings.Contains(string(content.Content), "chatcmpl-1") {
+		t.Errorf("content = %s (%s)", content.Content, content.ContentType)
+	}
+
+	_, bifrostErr = provider.FileRetrieve(ctx, []schemas.Key{{Value: schemas.EnvVar{Val: "wrong-key"}}}, &schemas.BifrostFileRetrieveRequest{Provider: schemas.GLM, FileID: "file-in"})
+	if bifrostErr == nil || bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected an unauthorized error for an invalid key, got %+v", bifrostErr)
+	}
+}
+
+func TestToGLMBatchEndpoint(t *testing.T) {
+	tests := map[string]string{
+		"/v1/chat/completions": "/v4/chat/completions",
+		"/v1/embeddings":       "/v4/embeddings",
+		"/v4/chat/completions": "/v4/chat/completions",
+	}
+	for endpoint, want := range tests {
+		if got := toGLMBatchEndpoint(endpoint); got != want {
+			t.Errorf("toGLMBatchEndpoint(%q) = %q, want %q", endpoint, got, want)
+		}
+	}
+}
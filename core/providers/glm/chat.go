@@ -0,0 +1,181 @@
+package glm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/providers/openai"
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// GLMChatRequest is an OpenAI-compatible chat request with GLM built-in tools appended to its tools.
+type GLMChatRequest struct {
+	*openai.OpenAIChatRequest
+	BuiltinTools []GLMBuiltinTool `json:"-"`
+}
+
+// MarshalJSON marshals the OpenAI-compatible request and appends the built-in tools to its tools array.
+func (r *GLMChatRequest) MarshalJSON() ([]byte, error) {
+	body, err := r.OpenAIChatRequest.MarshalJSON()
+	if err != nil || len(r.BuiltinTools) == 0 {
+		return body, err
+	}
+	var existing struct {
+		Tools []json.RawMessage `json:"tools"`
+	}
+	if err := sonic.Unmarshal(body, &existing); err != nil {
+		return nil, err
+	}
+	tools := existing.Tools
+	for _, tool := range r.BuiltinTools {
+		data, err := sonic.Marshal(tool)
+		if err != nil {
+			return nil, err
+		}
+		tools = append(tools, data)
+	}
+	return providerUtils.MergeExtraParamsIntoJSON(body, map[string]interface{}{"tools": tools})
+}
+
+// ToGLMChatRequest converts a Bifrost chat request to a GLM chat request. The built-in web_search
+// tool is added when enabled by the key configuration or the request's "web_search" extra param.
+func ToGLMChatRequest(ctx *schemas.BifrostContext, bifrostReq *schemas.BifrostChatRequest, keyConfig *schemas.GLMKeyConfig) (*GLMChatRequest, error) {
+	openaiReq := openai.ToOpenAIChatRequest(ctx, bifrostReq)
+	if openaiReq == nil {
+		return nil, fmt.Errorf("chat request input is not provided")
+	}
+
+	webSearchParam, ok := openaiReq.ExtraParams[GLMToolTypeWebSearch]
+	if ok {
+		// Drop the param so it isn't passed through as a top-level field
+		extraParams := make(map[string]interface{}, len(openaiReq.ExtraParams)-1)
+		for k, v := range openaiReq.ExtraParams {
+			if k != GLMToolTypeWebSearch {
+				extraParams[k] = v
+			}
+		}
+		openaiReq.ExtraParams = extraParams
+	}
+	webSearch, err := resolveWebSearchConfig(keyConfig, webSearchParam)
+	if err != nil {
+		return nil, err
+	}
+
+	glmReq := &GLMChatRequest{OpenAIChatRequest: openaiReq}
+	if webSearch != nil {
+		glmReq.BuiltinTools = append(glmReq.BuiltinTools, GLMBuiltinTool{
+			Type: GLMToolTypeWebSearch,
+			WebSearch: &GLMWebSearchTool{
+				Enable:              true,
+				SearchEngine:        webSearch.SearchEngine,
+				SearchResult:        true,
+				Count:               webSearch.Count,
+				SearchDomainFilter:  webSearch.SearchDomainFilter,
+				SearchRecencyFilter: webSearch.SearchRecencyFilter,
+				ContentSize:         webSearch.ContentSize,
+				SearchPrompt:        webSearch.SearchPrompt,
+			},
+		})
+	}
+	return glmReq, nil
+}
+
+// resolveWebSearchConfig merges the "web_search" extra param over the key's web search configuration.
+// The param may be a boolean toggling the key configuration or an object overriding its fields.
+// Returns nil if web search is disabled.
+func resolveWebSearchConfig(keyConfig *schemas.GLMKeyConfig, param interface{}) (*schemas.GLMWebSearchConfig, error) {
+	var config schemas.GLMWebSearchConfig
+	if keyConfig != nil && keyConfig.WebSearch != nil {
+		config = *keyConfig.WebSearch
+	}
+	switch value := param.(type) {
+	case nil:
+	case bool:
+		config.Enabled = value
+	case map[string]interface{}:
+		config.Enabled = true
+		data, err := sonic.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid web_search param: %w", err)
+		}
+		if err := sonic.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("invalid web_search param: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("web_search param must be a boolean or an object, got %T", param)
+	}
+	if !config.Enabled {
+		return nil, nil
+	}
+	return &config, nil
+}
+
+// handleGLMChatResponse decodes a chat completion response (or stream chunk) and normalizes
+// GLM's web_search results into the response's search results and citations.
+func handleGLMChatResponse(responseBody []byte, response *schemas.BifrostChatResponse, requestBody []byte, sendBackRawRequest bool, sendBackRawResponse bool) (interface{}, interface{}, *schemas.BifrostError) {
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, response, requestBody, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return rawRequest, rawResponse, bifrostErr
+	}
+	if bytes.Contains(responseBody, []byte(`"web_search"`)) {
+		var extras GLMChatResponseExtras
+		if err := sonic.Unmarshal(responseBody, &extras); err == nil {
+			for _, result := range extras.WebSearch {
+				response.SearchResults = append(response.SearchResults, result.toSearchResult())
+				if result.Link != "" {
+					response.Citations = append(response.Citations, result.Link)
+				}
+			}
+		}
+	}
+	return rawRequest, rawResponse, nil
+}
+
+// toSearchResult converts a GLM web search result to a Bifrost search result.
+func (result GLMWebSearchResult) toSearchResult() schemas.SearchResult {
+	searchResult := schemas.SearchResult{
+		Title: result.Title,
+		URL:   result.Link,
+	}
+	if result.Content != "" {
+		searchResult.Snippet = schemas.Ptr(result.Content)
+	}
+	if result.Media != "" {
+		searchResult.Source = schemas.Ptr(result.Media)
+	}
+	if result.PublishDate != "" {
+		searchResult.Date = schemas.Ptr(result.PublishDate)
+	}
+	return searchResult
+}
+
+// toWebSearchCallMessage returns a completed web_search_call output item whose sources are the search results.
+func toWebSearchCallMessage(responseID string, results []schemas.SearchResult) schemas.ResponsesMessage {
+	sources := make([]schemas.ResponsesWebSearchToolCallActionSearchSource, 0, len(results))
+	for _, result := range results {
+		source := schemas.ResponsesWebSearchToolCallActionSearchSource{
+			Type: "url",
+			URL:  result.URL,
+		}
+		if result.Title != "" {
+			source.Title = schemas.Ptr(result.Title)
+		}
+		sources = append(sources, source)
+	}
+	return schemas.ResponsesMessage{
+		ID:     schemas.Ptr("ws_" + responseID),
+		Type:   schemas.Ptr(schemas.ResponsesMessageTypeWebSearchCall),
+		Status: schemas.Ptr("completed"),
+		ResponsesToolMessage: &schemas.ResponsesToolMessage{
+			Action: &schemas.ResponsesToolMessageActionStruct{
+				ResponsesWebSearchToolCallAction: &schemas.ResponsesWebSearchToolCallAction{
+					Type:    "search",
+					Sources: sources,
+				},
+			},
+		},
+	}
+}
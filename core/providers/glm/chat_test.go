@@ -0,0 +1,129 @@
+package glm
+
+import (
+	"testing"
+
+	"github.com/bytedance/sonic"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestToGLMChatRequest_WebSearch(t *testing.T) {
+	newRequest := func(extraParams map[string]interface{}) *schemas.BifrostChatRequest {
+		return &schemas.BifrostChatRequest{
+			Provider: schemas.GLM,
+			Model:    "glm-4.6",
+			Input:    []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("latest news?")}}},
+			Params: &schemas.ChatParameters{
+				Tools: []schemas.ChatTool{{
+					Type:     schemas.ChatToolTypeFunction,
+					Function: &schemas.ChatToolFunction{Name: "get_weather"},
+				}},
+				ExtraParams: extraParams,
+			},
+		}
+	}
+	keyConfig := &schemas.GLMKeyConfig{WebSearch: &schemas.GLMWebSearchConfig{Enabled: true, SearchEngine: schemas.Ptr("search_std")}}
+	ctx := schemas.NewBifrostContext(nil, schemas.NoDeadline)
+
+	tests := []struct {
+		name         string
+		keyConfig    *schemas.GLMKeyConfig
+		extraParams  map[string]interface{}
+		wantEngine   string // empty to skip the search_engine check
+		wantCount    int
+		wantToolsLen int
+	}{
+		{name: "disabled", wantToolsLen: 1},
+		{name: "key config", keyConfig: keyConfig, wantEngine: "search_std", wantToolsLen: 2},
+		{name: "param disables key config", keyConfig: keyConfig, extraParams: map[string]interface{}{"web_search": false}, wantToolsLen: 1},
+		{name: "param enables", extraParams: map[string]interface{}{"web_search": true}, wantToolsLen: 2},
+		{
+			name:         "param overrides key config",
+			keyConfig:    keyConfig,
+			extraParams:  map[string]interface{}{"web_search": map[string]interface{}{"search_engine": "search_pro", "count": 5}},
+			wantEngine:   "search_pro",
+			wantCount:    5,
+			wantToolsLen: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := ToGLMChatRequest(ctx, newRequest(tt.extraParams), tt.keyConfig)
+			if err != nil {
+				t.Fatalf("ToGLMChatRequest() error = %v", err)
+			}
+			if _, ok := req.GetExtraParams()["web_search"]; ok {
+				t.Error("web_search extra param should not be passed through")
+			}
+			data, err := sonic.Marshal(req)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			var body struct {
+				Tools []GLMBuiltinTool `json:"tools"`
+			}
+			if err := sonic.Unmarshal(data, &body); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if len(body.Tools) != tt.wantToolsLen {
+				t.Fatalf("tools = %s, want %d entries", data, tt.wantToolsLen)
+			}
+			if body.Tools[0].Type != string(schemas.ChatToolTypeFunction) {
+				t.Errorf("first tool type = %s, want function", body.Tools[0].Type)
+			}
+			if tt.wantToolsLen == 1 {
+				return
+			}
+			webSearch := body.Tools[1].WebSearch
+			if body.Tools[1].Type != GLMToolTypeWebSearch || webSearch == nil || !webSearch.Enable || !webSearch.SearchResult {
+				t.Fatalf("web_search tool = %+v", body.Tools[1])
+			}
+			if tt.wantEngine != "" && (webSearch.SearchEngine == nil || *webSearch.SearchEngine != tt.wantEngine) {
+				t.Errorf("search_engine = %v, want %s", webSearch.SearchEngine, tt.wantEngine)
+			}
+			if tt.wantCount != 0 && (webSearch.Count == nil || *webSearch.Count != tt.wantCount) {
+				t.Errorf("count = %v, want %d", webSearch.Count, tt.wantCount)
+			}
+		})
+	}
+
+	if _, err := ToGLMChatRequest(ctx, newRequest(map[string]interface{}{"web_search": "yes"}), nil); err == nil {
+		t.Error("expected error for a non-boolean, non-object web_search param")
+	}
+}
+
+func TestHandleGLMChatResponse_WebSearchResults(t *testing.T) {
+	body := []byte(`{
+		"id": "chatcmpl-1",
+		"model": "glm-4.6",
+		"choices": [{"index": 0, "message": {"role": "assistant", "content": "Here is the news [ref_1]"}, "finish_reason": "stop"}],
+		"web_search": [
+			{"title": "Headline", "content": "Summary", "link": "https://example.com/a", "media": "Example", "refer": "ref_1", "publish_date": "2025-01-01"},
+			{"title": "Other", "link": "https://example.com/b"}
+		]
+	}`)
+
+	var response schemas.BifrostChatResponse
+	if _, _, bifrostErr := handleGLMChatResponse(body, &response, nil, false, false); bifrostErr != nil {
+		t.Fatalf("handleGLMChatResponse() error = %v", bifrostErr.Error)
+	}
+	if len(response.SearchResults) != 2 || len(response.Citations) != 2 {
+		t.Fatalf("search results = %+v, citations = %v", response.SearchResults, response.Citations)
+	}
+	first := response.SearchResults[0]
+	if first.URL != "https://example.com/a" || first.Snippet == nil || *first.Snippet != "Summary" || first.Source == nil || *first.Source != "Example" || first.Date == nil {
+		t.Errorf("first search result = %+v", first)
+	}
+	if response.SearchResults[1].Snippet != nil {
+		t.Errorf("empty content should leave snippet unset")
+	}
+
+	message := toWebSearchCallMessage(response.ID, response.SearchResults)
+	if message.Type == nil || *message.Type != schemas.ResponsesMessageTypeWebSearchCall {
+		t.Fatalf("message type = %v, want web_search_call", message.Type)
+	}
+	action := message.ResponsesToolMessage.Action.ResponsesWebSearchToolCallAction
+	if action == nil || len(action.Sources) != 2 || action.Sources[1].URL != "https://example.com/b" {
+		t.Errorf("web search action = %+v", action)
+	}
+}
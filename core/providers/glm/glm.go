@@ -3,9 +3,15 @@
 package glm
 
 import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
+	"github.com/bytedance/sonic"
 	"github.com/capsohq/bifrost/core/providers/openai"
 	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
 	schemas "github.com/capsohq/bifrost/core/schemas"
@@ -16,15 +22,43 @@ const (
 	glmPathListModels      = "/api/paas/v4/models"
 	glmPathCompletions     = "/api/paas/v4/completions"
 	glmPathChatCompletions = "/api/paas/v4/chat/completions"
+	glmPathImages          = "/api/paas/v4/images/generations"
+	glmPathVideos          = "/api/paas/v4/videos/generations"
+	glmPathAsyncResult     = "/api/paas/v4/async-result"
+	glmPathFiles           = "/api/paas/v4/files"
+	glmPathBatches         = "/api/paas/v4/batches"
 )
 
+// glmBaseURLs are GLM's endpoints: Z.ai (international, the default) and Zhipu BigModel (China).
+var glmBaseURLs = []providerUtils.RegionalBaseURL{
+	{Region: schemas.EndpointRegionInternational, BaseURL: "https://api.z.ai"},
+	{Region: schemas.EndpointRegionChina, BaseURL: "https://open.bigmodel.cn"},
+}
+
 // GLMProvider implements the Provider interface for GLM's API.
 type GLMProvider struct {
-	logger              schemas.Logger        // Logger for provider operations
-	client              *fasthttp.Client      // HTTP client for API requests
-	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
-	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
-	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
+	logger              schemas.Logger                         // Logger for provider operations
+	client              *fasthttp.Client                       // HTTP client for API requests
+	networkConfig       schemas.NetworkConfig                  // Network configuration including extra headers
+	baseURLs            *providerUtils.RegionalBaseURLResolver // Picks the international or China endpoint per key
+	sendBackRawRequest  bool                                   // Whether to include raw request in BifrostResponse
+	sendBackRawResponse bool                                   // Whether to include raw response in BifrostResponse
+}
+
+// Compile-time checks for the capabilities GLMProvider implements.
+var (
+	_ schemas.ListModelsProvider      = (*GLMProvider)(nil)
+	_ schemas.TextCompletionProvider  = (*GLMProvider)(nil)
+	_ schemas.ChatProvider            = (*GLMProvider)(nil)
+	_ schemas.ResponsesProvider       = (*GLMProvider)(nil)
+	_ schemas.ImageGenerationProvider = (*GLMProvider)(nil)
+	_ schemas.VideoProvider           = (*GLMProvider)(nil)
+	_ schemas.BatchProvider           = (*GLMProvider)(nil)
+	_ schemas.FileProvider            = (*GLMProvider)(nil)
+)
+
+func init() {
+	schemas.RegisterProviderCapabilities(schemas.GLM, schemas.CapabilitiesOf((*GLMProvider)(nil)))
 }
 
 // NewGLMProvider creates a new GLM provider instance.
@@ -44,16 +78,16 @@ func NewGLMProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*GLM
 	// Configure proxy and retry policy
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
 	client = providerUtils.ConfigureDialer(client)
-	// Set default BaseURL if not provided
-	if config.NetworkConfig.BaseURL == "" {
-		config.NetworkConfig.BaseURL = "https://api.z.ai"
+	baseURLs, err := providerUtils.NewRegionalBaseURLResolver(schemas.GLM, client, config.NetworkConfig, glmBaseURLs, glmPathListModels, logger)
+	if err != nil {
+		return nil, err
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
 
 	return &GLMProvider{
 		logger:              logger,
 		client:              client,
 		networkConfig:       config.NetworkConfig,
+		baseURLs:            baseURLs,
 		sendBackRawRequest:  config.SendBackRawRequest,
 		sendBackRawResponse: config.SendBackRawResponse,
 	}, nil
@@ -64,18 +98,75 @@ func (provider *GLMProvider) GetProviderKey() schemas.ModelProvider {
 	return schemas.GLM
 }
 
+// HTTPClient returns the pooled client used for GLM API requests.
+func (provider *GLMProvider) HTTPClient() *fasthttp.Client {
+	return provider.client
+}
+
+// doRequest sends a request to a GLM endpoint and returns the decoded body and the provider response headers.
+func (provider *GLMProvider) doRequest(ctx *schemas.BifrostContext, key schemas.Key, method, path string, jsonData []byte, requestType schemas.RequestType, model string) ([]byte, time.Duration, map[string]string, *schemas.BifrostError) {
+	contentType := ""
+	if jsonData != nil {
+		contentType = "application/json"
+	}
+	body, _, latency, providerResponseHeaders, bifrostErr := provider.doRawRequest(ctx, key, method, path, contentType, jsonData, requestType, model)
+	return body, latency, providerResponseHeaders, bifrostErr
+}
+
+// doRawRequest sends a request body of any content type to a GLM endpoint and returns the decoded body,
+// its content type and the provider response headers.
+func (provider *GLMProvider) doRawRequest(ctx *schemas.BifrostContext, key schemas.Key, method, path, contentType string, body []byte, requestType schemas.RequestType, model string) ([]byte, string, time.Duration, map[string]string, *schemas.BifrostError) {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, path))
+	req.Header.SetMethod(method)
+	if key.Value.GetValue() != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
+	}
+	if contentType != "" {
+		req.Header.SetContentType(contentType)
+	}
+	if body != nil {
+		req.SetBody(body)
+	}
+
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
+	if bifrostErr != nil {
+		return nil, "", latency, nil, bifrostErr
+	}
+	// Extract provider response headers before the status check so error responses also forward them
+	providerResponseHeaders := providerUtils.ExtractProviderResponseHeaders(resp)
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return nil, "", latency, providerResponseHeaders, openai.ParseOpenAIError(resp, requestType, provider.GetProviderKey(), model)
+	}
+
+	decoded, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, "", latency, providerResponseHeaders, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
+	}
+	// Copy the body since resp is released on return
+	return append([]byte(nil), decoded...), string(resp.Header.ContentType()), latency, providerResponseHeaders, nil
+}
+
 // ListModels performs a list models request to GLM's API.
 func (provider *GLMProvider) ListModels(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
-	return openai.HandleOpenAIListModelsRequest(
+	path := providerUtils.GetPathFromContext(ctx, glmPathListModels)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	if len(keys) == 0 {
+		return openai.ListModelsByKey(ctx, provider.client, provider.baseURLs.DefaultBaseURL()+path, schemas.Key{}, request.Unfiltered, provider.networkConfig.ExtraHeaders, schemas.GLM, sendBackRawRequest, sendBackRawResponse)
+	}
+	return providerUtils.HandleMultipleListModelsRequests(
 		ctx,
-		provider.client,
-		request,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, glmPathListModels),
 		keys,
-		provider.networkConfig.ExtraHeaders,
-		schemas.GLM,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		request,
+		func(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
+			return openai.ListModelsByKey(ctx, provider.client, provider.baseURLs.BaseURL(ctx, key)+path, key, request.Unfiltered, provider.networkConfig.ExtraHeaders, schemas.GLM, sendBackRawRequest, sendBackRawResponse)
+		},
 	)
 }
 
@@ -84,7 +175,7 @@ func (provider *GLMProvider) TextCompletion(ctx *schemas.BifrostContext, key sch
 	return openai.HandleOpenAITextCompletionRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, glmPathCompletions),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, glmPathCompletions),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -108,7 +199,7 @@ func (provider *GLMProvider) TextCompletionStream(ctx *schemas.BifrostContext, p
 	return openai.HandleOpenAITextCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, glmPathCompletions),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, glmPathCompletions),
 		request,
 		authHeader,
 		provider.networkConfig.ExtraHeaders,
@@ -124,21 +215,46 @@ func (provider *GLMProvider) TextCompletionStream(ctx *schemas.BifrostContext, p
 }
 
 // ChatCompletion performs a chat completion request to the GLM API.
+// GLM's built-in web_search tool is added when enabled, and its results are returned as search results.
 func (provider *GLMProvider) ChatCompletion(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
-	return openai.HandleOpenAIChatCompletionRequest(
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
 		ctx,
-		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, glmPathChatCompletions),
 		request,
-		key,
-		provider.networkConfig.ExtraHeaders,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
-		provider.GetProviderKey(),
-		nil,
-		nil,
-		provider.logger,
-	)
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToGLMChatRequest(ctx, request, key.GLMKeyConfig)
+		},
+		provider.GetProviderKey())
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	body, latency, providerResponseHeaders, bifrostErr := provider.doRequest(ctx, key, http.MethodPost, glmPathChatCompletions, jsonData, schemas.ChatCompletionRequest, request.Model)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	response := &schemas.BifrostChatResponse{}
+	rawRequest, rawResponse, bifrostErr := handleGLMChatResponse(body, response, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, body, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	response.ExtraFields.Provider = provider.GetProviderKey()
+	response.ExtraFields.ModelRequested = request.Model
+	response.ExtraFields.RequestType = schemas.ChatCompletionRequest
+	response.ExtraFields.Latency = latency.Milliseconds()
+	response.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
+
+	return response, nil
 }
 
 // ChatCompletionStream performs a streaming chat completion request to the GLM API.
@@ -150,11 +266,22 @@ func (provider *GLMProvider) ChatCompletionStream(ctx *schemas.BifrostContext, p
 	if key.Value.GetValue() != "" {
 		authHeader = map[string]string{"Authorization": "Bearer " + key.Value.GetValue()}
 	}
+	customRequestConverter := func(request *schemas.BifrostChatRequest) (providerUtils.RequestBodyWithExtraParams, error) {
+		reqBody, err := ToGLMChatRequest(ctx, request, key.GLMKeyConfig)
+		if err != nil {
+			return nil, err
+		}
+		reqBody.Stream = schemas.Ptr(true)
+		reqBody.StreamOptions = &schemas.ChatStreamOptions{
+			IncludeUsage: schemas.Ptr(true),
+		}
+		return reqBody, nil
+	}
 	// Use shared OpenAI-compatible streaming logic
 	return openai.HandleOpenAIChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, glmPathChatCompletions),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, glmPathChatCompletions),
 		request,
 		authHeader,
 		provider.networkConfig.ExtraHeaders,
@@ -162,8 +289,8 @@ func (provider *GLMProvider) ChatCompletionStream(ctx *schemas.BifrostContext, p
 		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
 		schemas.GLM,
 		postHookRunner,
-		nil,
-		nil,
+		customRequestConverter,
+		handleGLMChatResponse,
 		nil,
 		nil,
 		nil,
@@ -172,6 +299,7 @@ func (provider *GLMProvider) ChatCompletionStream(ctx *schemas.BifrostContext, p
 }
 
 // Responses performs a responses request to the GLM API.
+// Web search results are returned as a web_search_call output item ahead of the message.
 func (provider *GLMProvider) Responses(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostResponsesRequest) (*schemas.BifrostResponsesResponse, *schemas.BifrostError) {
 	chatResponse, err := provider.ChatCompletion(ctx, key, request.ToChatRequest())
 	if err != nil {
@@ -179,6 +307,9 @@ func (provider *GLMProvider) Responses(ctx *schemas.BifrostContext, key schemas.
 	}
 
 	response := chatResponse.ToBifrostResponsesResponse()
+	if len(chatResponse.SearchResults) > 0 {
+		response.Output = append([]schemas.ResponsesMessage{toWebSearchCallMessage(chatResponse.ID, chatResponse.SearchResults)}, response.Output...)
+	}
 	response.ExtraFields.RequestType = schemas.ResponsesRequest
 	response.ExtraFields.Provider = provider.GetProviderKey()
 	response.ExtraFields.ModelRequested = request.Model
@@ -197,39 +328,49 @@ func (provider *GLMProvider) ResponsesStream(ctx *schemas.BifrostContext, postHo
 	)
 }
 
-// Embedding is not supported by the GLM provider.
-func (provider *GLMProvider) Embedding(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.EmbeddingRequest, provider.GetProviderKey())
-}
+// ImageGeneration performs a CogView image generation request to the GLM API.
+func (provider *GLMProvider) ImageGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageGenerationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
 
-// Speech is not supported by the GLM provider.
-func (provider *GLMProvider) Speech(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechRequest, provider.GetProviderKey())
-}
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToGLMImageGenerationRequest(request)
+		},
+		providerName)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// SpeechStream is not supported by the GLM provider.
-func (provider *GLMProvider) SpeechStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechStreamRequest, provider.GetProviderKey())
-}
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-// Transcription is not supported by the GLM provider.
-func (provider *GLMProvider) Transcription(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionRequest, provider.GetProviderKey())
-}
+	body, latency, providerResponseHeaders, bifrostErr := provider.doRequest(ctx, key, http.MethodPost, glmPathImages, jsonData, schemas.ImageGenerationRequest, request.Model)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
 
-// TranscriptionStream is not supported by the GLM provider.
-func (provider *GLMProvider) TranscriptionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionStreamRequest, provider.GetProviderKey())
-}
+	var glmResp GLMImageGenerationResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &glmResp, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// Rerank is not supported by the GLM provider.
-func (provider *GLMProvider) Rerank(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRerankRequest) (*schemas.BifrostRerankResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.RerankRequest, provider.GetProviderKey())
-}
+	response := glmResp.ToBifrostImageGenerationResponse(request.Model)
+	response.ExtraFields.Provider = providerName
+	response.ExtraFields.ModelRequested = request.Model
+	response.ExtraFields.RequestType = schemas.ImageGenerationRequest
+	response.ExtraFields.Latency = latency.Milliseconds()
+	response.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
 
-// ImageGeneration is not supported by the GLM provider.
-func (provider *GLMProvider) ImageGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageGenerationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageGenerationRequest, provider.GetProviderKey())
+	return response, nil
 }
 
 // ImageGenerationStream is not supported by the GLM provider.
@@ -237,34 +378,158 @@ func (provider *GLMProvider) ImageGenerationStream(ctx *schemas.BifrostContext,
 	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageGenerationStreamRequest, provider.GetProviderKey())
 }
 
-// ImageEdit is not supported by the GLM provider.
-func (provider *GLMProvider) ImageEdit(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageEditRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditRequest, provider.GetProviderKey())
-}
+// VideoGeneration submits a CogVideoX video generation task to the GLM API.
+// The returned ID is the async task ID, which VideoRetrieve polls for the result.
+func (provider *GLMProvider) VideoGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
 
-// ImageEditStream is not supported by the GLM provider.
-func (provider *GLMProvider) ImageEditStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostImageEditRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditStreamRequest, provider.GetProviderKey())
-}
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToGLMVideoGenerationRequest(request)
+		},
+		providerName)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// ImageVariation is not supported by the GLM provider.
-func (provider *GLMProvider) ImageVariation(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageVariationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageVariationRequest, provider.GetProviderKey())
-}
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	body, latency, providerResponseHeaders, bifrostErr := provider.doRequest(ctx, key, http.MethodPost, glmPathVideos, jsonData, schemas.VideoGenerationRequest, request.Model)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	var taskResp GLMVideoTaskResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &taskResp, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	response := &schemas.BifrostVideoGenerationResponse{
+		ID:        providerUtils.AddVideoIDProviderSuffix(taskResp.ID, providerName),
+		Model:     request.Model,
+		Object:    "video",
+		CreatedAt: time.Now().Unix(),
+		Status:    toBifrostVideoStatus(taskResp.TaskStatus),
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			Latency:                 latency.Milliseconds(),
+			Provider:                providerName,
+			ModelRequested:          request.Model,
+			RequestType:             schemas.VideoGenerationRequest,
+			ProviderResponseHeaders: providerResponseHeaders,
+		},
+	}
+	if request.Input != nil {
+		response.Prompt = request.Input.Prompt
+	}
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
 
-// VideoGeneration is not supported by the GLM provider.
-func (provider *GLMProvider) VideoGeneration(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoGenerationRequest, provider.GetProviderKey())
+	return response, nil
 }
 
-// VideoRetrieve is not supported by the GLM provider.
-func (provider *GLMProvider) VideoRetrieve(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoRetrieveRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRetrieveRequest, provider.GetProviderKey())
+// VideoRetrieve polls the GLM async-result endpoint for a video generation task.
+func (provider *GLMProvider) VideoRetrieve(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostVideoRetrieveRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	taskID := providerUtils.StripVideoIDProviderSuffix(request.ID, providerName)
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	body, latency, providerResponseHeaders, bifrostErr := provider.doRequest(ctx, key, http.MethodGet, glmPathAsyncResult+"/"+taskID, nil, schemas.VideoRetrieveRequest, "")
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, nil, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	var resultResp GLMAsyncResultResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &resultResp, nil, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	response := resultResp.ToBifrostVideoGenerationResponse(providerUtils.AddVideoIDProviderSuffix(taskID, providerName))
+	response.ExtraFields.Latency = latency.Milliseconds()
+	response.ExtraFields.Provider = providerName
+	response.ExtraFields.RequestType = schemas.VideoRetrieveRequest
+	response.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
+
+	return response, nil
 }
 
-// VideoDownload is not supported by the GLM provider.
-func (provider *GLMProvider) VideoDownload(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoDownloadRequest) (*schemas.BifrostVideoDownloadResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoDownloadRequest, provider.GetProviderKey())
+// VideoDownload downloads the first video of a completed GLM video generation task.
+func (provider *GLMProvider) VideoDownload(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostVideoDownloadRequest) (*schemas.BifrostVideoDownloadResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	// Retrieve task status to get the video URL
+	taskDetails, bifrostErr := provider.VideoRetrieve(ctx, key, &schemas.BifrostVideoRetrieveRequest{
+		Provider: request.Provider,
+		ID:       request.ID,
+	})
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if taskDetails.Status != schemas.VideoStatusCompleted {
+		return nil, providerUtils.NewBifrostOperationError(
+			fmt.Sprintf("video not ready, current status: %s", taskDetails.Status),
+			nil,
+			providerName,
+		)
+	}
+	if len(taskDetails.Videos) == 0 || taskDetails.Videos[0].URL == nil || *taskDetails.Videos[0].URL == "" {
+		return nil, providerUtils.NewBifrostOperationError("video URL not available", nil, providerName)
+	}
+
+	// Result URLs are pre-signed, so no auth header is sent
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+	req.SetRequestURI(*taskDetails.Videos[0].URL)
+	req.Header.SetMethod(http.MethodGet)
+
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return nil, providerUtils.NewBifrostOperationError(
+			fmt.Sprintf("failed to download video: HTTP %d", resp.StatusCode()),
+			nil,
+			providerName,
+		)
+	}
+
+	body, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
+	}
+	contentType := string(resp.Header.ContentType())
+	if contentType == "" {
+		contentType = "video/mp4"
+	}
+
+	return &schemas.BifrostVideoDownloadResponse{
+		VideoID:     request.ID,
+		Content:     append([]byte(nil), body...),
+		ContentType: contentType,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.VideoDownloadRequest,
+			Provider:    providerName,
+			Latency:     latency.Milliseconds(),
+		},
+	}, nil
 }
 
 // VideoDelete is not supported by GLM provider.
@@ -282,102 +547,533 @@ func (provider *GLMProvider) VideoRemix(_ *schemas.BifrostContext, _ schemas.Key
 	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRemixRequest, provider.GetProviderKey())
 }
 
-// FileUpload is not supported by GLM provider.
-func (provider *GLMProvider) FileUpload(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileUploadRequest, provider.GetProviderKey())
-}
+// FileUpload uploads a file, e.g. a batch input JSONL file, to GLM's files API.
+func (provider *GLMProvider) FileUpload(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
 
-// FileList is not supported by GLM provider.
-func (provider *GLMProvider) FileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileListRequest) (*schemas.BifrostFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileListRequest, provider.GetProviderKey())
-}
+	if len(request.File) == 0 {
+		return nil, providerUtils.NewBifrostOperationError("file content is required", nil, providerName)
+	}
+	if request.Purpose == "" {
+		return nil, providerUtils.NewBifrostOperationError("purpose is required", nil, providerName)
+	}
 
-// FileRetrieve is not supported by GLM provider.
-func (provider *GLMProvider) FileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileRetrieveRequest, provider.GetProviderKey())
-}
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	if err := writer.WriteField("purpose", string(request.Purpose)); err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to write purpose field", err, providerName)
+	}
+	filename := request.Filename
+	if filename == "" {
+		filename = "file.jsonl"
+	}
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to create form file", err, providerName)
+	}
+	if _, err := part.Write(request.File); err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to write file content", err, providerName)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to close multipart writer", err, providerName)
+	}
 
-// FileDelete is not supported by GLM provider.
-func (provider *GLMProvider) FileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileDeleteRequest) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileDeleteRequest, provider.GetProviderKey())
-}
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-// FileContent is not supported by GLM provider.
-func (provider *GLMProvider) FileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileContentRequest) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileContentRequest, provider.GetProviderKey())
-}
+	body, _, latency, _, bifrostErr := provider.doRawRequest(ctx, key, http.MethodPost, glmPathFiles, writer.FormDataContentType(), buf.Bytes(), schemas.FileUploadRequest, "")
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// BatchCreate is not supported by GLM provider.
-func (provider *GLMProvider) BatchCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchCreateRequest, provider.GetProviderKey())
-}
+	var openAIResp openai.OpenAIFileResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// BatchList is not supported by GLM provider.
-func (provider *GLMProvider) BatchList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchListRequest, provider.GetProviderKey())
+	return openAIResp.ToBifrostFileUploadResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
 }
 
-// BatchRetrieve is not supported by GLM provider.
-func (provider *GLMProvider) BatchRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchRetrieveRequest) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchRetrieveRequest, provider.GetProviderKey())
-}
+// FileList lists files using serial pagination across keys.
+// Exhausts all pages from one key before moving to the next.
+func (provider *GLMProvider) FileList(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFileListRequest) (*schemas.BifrostFileListResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-// BatchCancel is not supported by GLM provider.
-func (provider *GLMProvider) BatchCancel(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchCancelRequest) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchCancelRequest, provider.GetProviderKey())
-}
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
+	}
 
-// BatchResults is not supported by GLM provider.
-func (provider *GLMProvider) BatchResults(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchResultsRequest) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchResultsRequest, provider.GetProviderKey())
-}
+	key, nativeCursor, ok := helper.GetCurrentKey()
+	if !ok {
+		// All keys exhausted
+		return &schemas.BifrostFileListResponse{
+			Object:  "list",
+			Data:    []schemas.FileObject{},
+			HasMore: false,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.FileListRequest,
+				Provider:    providerName,
+			},
+		}, nil
+	}
+
+	values := url.Values{}
+	if request.Purpose != "" {
+		values.Set("purpose", string(request.Purpose))
+	}
+	if request.Limit > 0 {
+		values.Set("limit", fmt.Sprintf("%d", request.Limit))
+	}
+	if nativeCursor != "" {
+		values.Set("after", nativeCursor)
+	}
+	if request.Order != nil && *request.Order != "" {
+		values.Set("order", *request.Order)
+	}
+	path := glmPathFiles
+	if encodedValues := values.Encode(); encodedValues != "" {
+		path += "?" + encodedValues
+	}
 
-// CountTokens is not supported by the GLM provider.
-func (provider *GLMProvider) CountTokens(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostResponsesRequest) (*schemas.BifrostCountTokensResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.CountTokensRequest, provider.GetProviderKey())
+	body, _, latency, _, bifrostErr := provider.doRawRequest(ctx, key, http.MethodGet, path, "", nil, schemas.FileListRequest, "")
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	var openAIResp openai.OpenAIFileListResponse
+	_, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	files := make([]schemas.FileObject, 0, len(openAIResp.Data))
+	var lastFileID string
+	for _, file := range openAIResp.Data {
+		files = append(files, schemas.FileObject{
+			ID:            file.ID,
+			Object:        file.Object,
+			Bytes:         file.Bytes,
+			CreatedAt:     file.CreatedAt,
+			Filename:      file.Filename,
+			Purpose:       schemas.FilePurpose(file.Purpose),
+			Status:        openai.ToBifrostFileStatus(file.Status),
+			StatusDetails: file.StatusDetails,
+		})
+		lastFileID = file.ID
+	}
+
+	nextCursor, hasMore := helper.BuildNextCursor(openAIResp.HasMore, lastFileID)
+	bifrostResp := &schemas.BifrostFileListResponse{
+		Object:  "list",
+		Data:    files,
+		HasMore: hasMore,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.FileListRequest,
+			Provider:    providerName,
+			Latency:     latency.Milliseconds(),
+		},
+	}
+	if nextCursor != "" {
+		bifrostResp.After = &nextCursor
+	}
+	if sendBackRawResponse {
+		bifrostResp.ExtraFields.RawResponse = rawResponse
+	}
+
+	return bifrostResp, nil
 }
 
-// ContainerCreate is not supported by the GLM provider.
-func (provider *GLMProvider) ContainerCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerCreateRequest) (*schemas.BifrostContainerCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerCreateRequest, provider.GetProviderKey())
+// FileRetrieve retrieves file metadata by trying each key until found.
+func (provider *GLMProvider) FileRetrieve(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.FileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
+		body, _, latency, _, bifrostErr := provider.doRawRequest(ctx, key, http.MethodGet, glmPathFiles+"/"+request.FileID, "", nil, schemas.FileRetrieveRequest, "")
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		var openAIResp openai.OpenAIFileResponse
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		return openAIResp.ToBifrostFileRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
+	})
 }
 
-// ContainerList is not supported by the GLM provider.
-func (provider *GLMProvider) ContainerList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerListRequest) (*schemas.BifrostContainerListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerListRequest, provider.GetProviderKey())
+// FileDelete deletes a file by trying each key until successful.
+func (provider *GLMProvider) FileDelete(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFileDeleteRequest) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.FileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
+		body, _, latency, _, bifrostErr := provider.doRawRequest(ctx, key, http.MethodDelete, glmPathFiles+"/"+request.FileID, "", nil, schemas.FileDeleteRequest, "")
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		var openAIResp openai.OpenAIFileDeleteResponse
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		result := &schemas.BifrostFileDeleteResponse{
+			ID:      openAIResp.ID,
+			Object:  openAIResp.Object,
+			Deleted: openAIResp.Deleted,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.FileDeleteRequest,
+				Provider:    providerName,
+				Latency:     latency.Milliseconds(),
+			},
+		}
+		if sendBackRawRequest {
+			result.ExtraFields.RawRequest = rawRequest
+		}
+		if sendBackRawResponse {
+			result.ExtraFields.RawResponse = rawResponse
+		}
+
+		return result, nil
+	})
 }
 
-// ContainerRetrieve is not supported by the GLM provider.
-func (provider *GLMProvider) ContainerRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerRetrieveRequest) (*schemas.BifrostContainerRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerRetrieveRequest, provider.GetProviderKey())
+// FileContent downloads file content by trying each key until found.
+func (provider *GLMProvider) FileContent(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFileContentRequest) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.FileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
+	}
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
+		body, contentType, latency, _, bifrostErr := provider.doRawRequest(ctx, key, http.MethodGet, glmPathFiles+"/"+request.FileID+"/content", "", nil, schemas.FileContentRequest, "")
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		return &schemas.BifrostFileContentResponse{
+			FileID:      request.FileID,
+			Content:     body,
+			ContentType: contentType,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.FileContentRequest,
+				Provider:    providerName,
+				Latency:     latency.Milliseconds(),
+			},
+		}, nil
+	})
 }
 
-// ContainerDelete is not supported by the GLM provider.
-func (provider *GLMProvider) ContainerDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerDeleteRequest) (*schemas.BifrostContainerDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerDeleteRequest, provider.GetProviderKey())
+// BatchCreate creates a batch job. Inline requests are first uploaded as a JSONL file with purpose "batch".
+// OpenAI-style endpoints ("/v1/chat/completions") are sent as GLM's "/v4/..." equivalents.
+func (provider *GLMProvider) BatchCreate(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchCreateResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.Endpoint == "" {
+		return nil, providerUtils.NewBifrostOperationError("endpoint is required for GLM batch API", nil, providerName)
+	}
+
+	inputFileID := request.InputFileID
+	if inputFileID == "" && len(request.Requests) > 0 {
+		requests := make([]schemas.BatchRequestItem, len(request.Requests))
+		for i, item := range request.Requests {
+			if item.URL == "" {
+				item.URL = string(request.Endpoint)
+			}
+			if item.Method == "" {
+				item.Method = http.MethodPost
+			}
+			item.URL = toGLMBatchEndpoint(item.URL)
+			requests[i] = item
+		}
+		jsonlData, err := openai.ConvertRequestsToJSONL(requests)
+		if err != nil {
+			return nil, providerUtils.NewBifrostOperationError("failed to convert requests to JSONL", err, providerName)
+		}
+		uploadResp, bifrostErr := provider.FileUpload(ctx, key, &schemas.BifrostFileUploadRequest{
+			Provider: providerName,
+			File:     jsonlData,
+			Filename: "batch_requests.jsonl",
+			Purpose:  schemas.FilePurposeBatch,
+		})
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		inputFileID = uploadResp.ID
+	}
+
+	if inputFileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("either input_file_id or requests array is required for GLM batch API", nil, providerName)
+	}
+
+	openAIReq := &openai.OpenAIBatchRequest{
+		InputFileID:        inputFileID,
+		Endpoint:           toGLMBatchEndpoint(string(request.Endpoint)),
+		CompletionWindow:   request.CompletionWindow,
+		Metadata:           request.Metadata,
+		OutputExpiresAfter: request.OutputExpiresAfter,
+	}
+	if openAIReq.CompletionWindow == "" {
+		openAIReq.CompletionWindow = "24h"
+	}
+	jsonData, err := sonic.Marshal(openAIReq)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	body, _, latency, _, bifrostErr := provider.doRawRequest(ctx, key, http.MethodPost, glmPathBatches, "application/json", jsonData, schemas.BatchCreateRequest, "")
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	var openAIResp openai.OpenAIBatchResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, body, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	return openAIResp.ToBifrostBatchCreateResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
 }
 
-// ContainerFileCreate is not supported by the GLM provider.
-func (provider *GLMProvider) ContainerFileCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerFileCreateRequest) (*schemas.BifrostContainerFileCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileCreateRequest, provider.GetProviderKey())
+// toGLMBatchEndpoint maps an OpenAI-style batch endpoint or request URL ("/v1/chat/completions")
+// to GLM's versioned path ("/v4/chat/completions"). Other values are returned unchanged.
+func toGLMBatchEndpoint(endpoint string) string {
+	if rest, ok := strings.CutPrefix(endpoint, "/v1/"); ok {
+		return "/v4/" + rest
+	}
+	return endpoint
 }
 
-// ContainerFileList is not supported by the GLM provider.
-func (provider *GLMProvider) ContainerFileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileListRequest) (*schemas.BifrostContainerFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileListRequest, provider.GetProviderKey())
+// BatchList lists batch jobs using serial pagination across keys.
+// Exhausts all pages from one key before moving to the next.
+func (provider *GLMProvider) BatchList(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
+	}
+
+	key, nativeCursor, ok := helper.GetCurrentKey()
+	if !ok {
+		// All keys exhausted
+		return &schemas.BifrostBatchListResponse{
+			Object:  "list",
+			Data:    []schemas.BifrostBatchRetrieveResponse{},
+			HasMore: false,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.BatchListRequest,
+				Provider:    providerName,
+			},
+		}, nil
+	}
+
+	values := url.Values{}
+	if request.Limit > 0 {
+		values.Set("limit", fmt.Sprintf("%d", request.Limit))
+	}
+	if nativeCursor != "" {
+		values.Set("after", nativeCursor)
+	}
+	path := glmPathBatches
+	if encodedValues := values.Encode(); encodedValues != "" {
+		path += "?" + encodedValues
+	}
+
+	body, _, latency, _, bifrostErr := provider.doRawRequest(ctx, key, http.MethodGet, path, "", nil, schemas.BatchListRequest, "")
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	var openAIResp openai.OpenAIBatchListResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	batches := make([]schemas.BifrostBatchRetrieveResponse, 0, len(openAIResp.Data))
+	var lastBatchID string
+	for _, batch := range openAIResp.Data {
+		batches = append(batches, *batch.ToBifrostBatchRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse))
+		lastBatchID = batch.ID
+	}
+
+	nextCursor, hasMore := helper.BuildNextCursor(openAIResp.HasMore, lastBatchID)
+	bifrostResp := &schemas.BifrostBatchListResponse{
+		Object:  "list",
+		Data:    batches,
+		HasMore: hasMore,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.BatchListRequest,
+			Provider:    providerName,
+			Latency:     latency.Milliseconds(),
+		},
+	}
+	if nextCursor != "" {
+		bifrostResp.NextCursor = &nextCursor
+	}
+
+	return bifrostResp, nil
 }
 
-// ContainerFileRetrieve is not supported by the GLM provider.
-func (provider *GLMProvider) ContainerFileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileRetrieveRequest) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileRetrieveRequest, provider.GetProviderKey())
+// BatchRetrieve retrieves a batch job by trying each key until found.
+func (provider *GLMProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostBatchRetrieveRequest) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.BatchID == "" {
+		return nil, providerUtils.NewBifrostOperationError("batch_id is required", nil, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
+		body, _, latency, _, bifrostErr := provider.doRawRequest(ctx, key, http.MethodGet, glmPathBatches+"/"+request.BatchID, "", nil, schemas.BatchRetrieveRequest, "")
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		var openAIResp openai.OpenAIBatchResponse
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		return openAIResp.ToBifrostBatchRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
+	})
 }
 
-// ContainerFileContent is not supported by the GLM provider.
-func (provider *GLMProvider) ContainerFileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileContentRequest) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileContentRequest, provider.GetProviderKey())
+// BatchCancel cancels a batch job by trying each key until successful.
+func (provider *GLMProvider) BatchCancel(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostBatchCancelRequest) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.BatchID == "" {
+		return nil, providerUtils.NewBifrostOperationError("batch_id is required", nil, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
+		body, _, latency, _, bifrostErr := provider.doRawRequest(ctx, key, http.MethodPost, glmPathBatches+"/"+request.BatchID+"/cancel", "application/json", nil, schemas.BatchCancelRequest, "")
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		var openAIResp openai.OpenAIBatchResponse
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		result := &schemas.BifrostBatchCancelResponse{
+			ID:           openAIResp.ID,
+			Object:       openAIResp.Object,
+			Status:       openai.ToBifrostBatchStatus(openAIResp.Status),
+			CancellingAt: openAIResp.CancellingAt,
+			CancelledAt:  openAIResp.CancelledAt,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.BatchCancelRequest,
+				Provider:    providerName,
+				Latency:     latency.Milliseconds(),
+			},
+		}
+		if openAIResp.RequestCounts != nil {
+			result.RequestCounts = schemas.BatchRequestCounts{
+				Total:     openAIResp.RequestCounts.Total,
+				Completed: openAIResp.RequestCounts.Completed,
+				Failed:    openAIResp.RequestCounts.Failed,
+			}
+		}
+		if sendBackRawRequest {
+			result.ExtraFields.RawRequest = rawRequest
+		}
+		if sendBackRawResponse {
+			result.ExtraFields.RawResponse = rawResponse
+		}
+
+		return result, nil
+	})
 }
 
-// ContainerFileDelete is not supported by the GLM provider.
-func (provider *GLMProvider) ContainerFileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileDeleteRequest) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileDeleteRequest, provider.GetProviderKey())
+// BatchResults retrieves a finished batch and downloads its output file, parsed as one result per JSONL line.
+func (provider *GLMProvider) BatchResults(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostBatchResultsRequest) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.BatchID == "" {
+		return nil, providerUtils.NewBifrostOperationError("batch_id is required", nil, providerName)
+	}
+
+	batchResp, bifrostErr := provider.BatchRetrieve(ctx, keys, &schemas.BifrostBatchRetrieveRequest{
+		Provider: request.Provider,
+		BatchID:  request.BatchID,
+	})
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if batchResp.OutputFileID == nil || *batchResp.OutputFileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("batch results not available: output_file_id is empty (batch may not be completed)", nil, providerName)
+	}
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
+		body, _, latency, _, bifrostErr := provider.doRawRequest(ctx, key, http.MethodGet, glmPathFiles+"/"+*batchResp.OutputFileID+"/content", "", nil, schemas.BatchResultsRequest, "")
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		var results []schemas.BatchResultItem
+		parseResult := providerUtils.ParseJSONL(body, func(line []byte) error {
+			var resultItem schemas.BatchResultItem
+			if err := sonic.Unmarshal(line, &resultItem); err != nil {
+				provider.logger.Warn(fmt.Sprintf("failed to parse batch result line: %v", err))
+				return err
+			}
+			results = append(results, resultItem)
+			return nil
+		})
+
+		batchResultsResp := &schemas.BifrostBatchResultsResponse{
+			BatchID: request.BatchID,
+			Results: results,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.BatchResultsRequest,
+				Provider:    providerName,
+				Latency:     latency.Milliseconds(),
+			},
+		}
+		if len(parseResult.Errors) > 0 {
+			batchResultsResp.ExtraFields.ParseErrors = parseResult.Errors
+		}
+
+		return batchResultsResp, nil
+	})
 }
@@ -30,9 +30,11 @@ func TestGLM(t *testing.T) {
 	defer cancel()
 
 	testConfig := llmtests.ComprehensiveTestConfig{
-		Provider:  schemas.GLM,
-		ChatModel: envOrDefault("GLM_CHAT_MODEL", "glm-5"),
-		TextModel: envOrDefault("GLM_TEXT_MODEL", "glm-4.7"),
+		Provider:             schemas.GLM,
+		ChatModel:            envOrDefault("GLM_CHAT_MODEL", "glm-5"),
+		TextModel:            envOrDefault("GLM_TEXT_MODEL", "glm-4.7"),
+		ImageGenerationModel: envOrDefault("GLM_IMAGE_MODEL", "cogview-4"),
+		VideoGenerationModel: envOrDefault("GLM_VIDEO_MODEL", "cogvideox-flash"),
 		Scenarios: llmtests.TestScenarios{
 			TextCompletion:        true,
 			TextCompletionStream:  true,
@@ -44,6 +46,21 @@ func TestGLM(t *testing.T) {
 			End2EndToolCalling:    true,
 			AutomaticFunctionCall: true,
 			ListModels:            true,
+			ImageGeneration:       true,
+			VideoGeneration:       true,
+			VideoRetrieve:         true,
+			VideoDownload:         true,
+			BatchCreate:           true,
+			BatchList:             true,
+			BatchRetrieve:         true,
+			BatchCancel:           true,
+			BatchResults:          true,
+			FileUpload:            true,
+			FileList:              true,
+			FileRetrieve:          true,
+			FileDelete:            true,
+			FileContent:           true,
+			FileBatchInput:        true,
 		},
 	}
 
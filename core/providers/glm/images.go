@@ -0,0 +1,76 @@
+package glm
+
+import (
+	"fmt"
+	"strings"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// ToGLMImageGenerationRequest converts a Bifrost image generation request to the CogView format.
+func ToGLMImageGenerationRequest(bifrostReq *schemas.BifrostImageGenerationRequest) (*GLMImageGenerationRequest, error) {
+	if bifrostReq == nil || bifrostReq.Input == nil {
+		return nil, fmt.Errorf("bifrost request is nil or input is nil")
+	}
+
+	req := &GLMImageGenerationRequest{
+		Model:  bifrostReq.Model,
+		Prompt: bifrostReq.Input.Prompt,
+	}
+
+	if bifrostReq.Params != nil {
+		if bifrostReq.Params.Quality != nil {
+			// CogView only distinguishes "standard" and "hd"
+			switch strings.ToLower(*bifrostReq.Params.Quality) {
+			case "hd", "high":
+				req.Quality = schemas.Ptr("hd")
+			case "standard", "medium", "low":
+				req.Quality = schemas.Ptr("standard")
+			}
+		}
+		if bifrostReq.Params.Size != nil && strings.ToLower(*bifrostReq.Params.Size) != "auto" {
+			req.Size = bifrostReq.Params.Size
+		}
+		if bifrostReq.Params.User != nil {
+			req.UserID = bifrostReq.Params.User
+		}
+		req.ExtraParams = bifrostReq.Params.ExtraParams
+	}
+
+	return req, nil
+}
+
+// ToBifrostImageGenerationResponse converts a CogView response to Bifrost format.
+// CogView only returns image URLs; content filter verdicts are attached to every image.
+func (response *GLMImageGenerationResponse) ToBifrostImageGenerationResponse(model string) *schemas.BifrostImageGenerationResponse {
+	if response == nil {
+		return nil
+	}
+
+	var safety *schemas.ImageSafetyAnnotation
+	for _, filter := range response.ContentFilter {
+		if filter.Role != "" && filter.Role != "assistant" {
+			continue
+		}
+		safety = &schemas.ImageSafetyAnnotation{
+			Flagged: true,
+			Reason:  fmt.Sprintf("content_filter_level_%d", filter.Level),
+		}
+		break
+	}
+
+	bifrostResp := &schemas.BifrostImageGenerationResponse{
+		Created: response.Created,
+		Model:   model,
+		Data:    make([]schemas.ImageData, len(response.Data)),
+	}
+	for i, image := range response.Data {
+		bifrostResp.Data[i] = schemas.ImageData{
+			URL:    image.URL,
+			Safety: safety,
+			Index:  i,
+		}
+	}
+
+	return bifrostResp
+}
@@ -0,0 +1,128 @@
+package glm
+
+// GLM async task statuses returned by the async-result endpoint.
+const (
+	GLMTaskStatusProcessing = "PROCESSING"
+	GLMTaskStatusSuccess    = "SUCCESS"
+	GLMTaskStatusFail       = "FAIL"
+)
+
+// ==================== IMAGE TYPES ====================
+
+// GLMImageGenerationRequest is the request body for CogView image generation.
+type GLMImageGenerationRequest struct {
+	Model       string                 `json:"model"`
+	Prompt      string                 `json:"prompt"`
+	Quality     *string                `json:"quality,omitempty"` // "standard" | "hd"
+	Size        *string                `json:"size,omitempty"`    // e.g. "1024x1024"
+	UserID      *string                `json:"user_id,omitempty"`
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface
+func (r *GLMImageGenerationRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+// GLMImageGenerationResponse is the response body for CogView image generation.
+type GLMImageGenerationResponse struct {
+	Created       int64              `json:"created"`
+	Data          []GLMImageData     `json:"data"`
+	ContentFilter []GLMContentFilter `json:"content_filter,omitempty"`
+}
+
+// GLMImageData is a single generated image.
+type GLMImageData struct {
+	URL string `json:"url"`
+}
+
+// GLMContentFilter describes a content safety verdict.
+// Level ranges from 0 (most severe) to 3 (least severe).
+type GLMContentFilter struct {
+	Role  string `json:"role,omitempty"` // "assistant" | "user" | "history"
+	Level int    `json:"level"`
+}
+
+// ==================== VIDEO TYPES ====================
+
+// GLMVideoGenerationRequest is the request body for CogVideoX video generation.
+type GLMVideoGenerationRequest struct {
+	Model       string                 `json:"model"`
+	Prompt      *string                `json:"prompt,omitempty"`
+	ImageURL    *string                `json:"image_url,omitempty"` // URL or base64 image for image-to-video
+	Quality     *string                `json:"quality,omitempty"`   // "speed" | "quality"
+	WithAudio   *bool                  `json:"with_audio,omitempty"`
+	Size        *string                `json:"size,omitempty"` // e.g. "1920x1080"
+	FPS         *int                   `json:"fps,omitempty"`
+	Duration    *int                   `json:"duration,omitempty"`
+	RequestID   *string                `json:"request_id,omitempty"`
+	UserID      *string                `json:"user_id,omitempty"`
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface
+func (r *GLMVideoGenerationRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+// GLMVideoTaskResponse is returned when a video generation task is submitted.
+type GLMVideoTaskResponse struct {
+	ID         string `json:"id"`
+	Model      string `json:"model"`
+	RequestID  string `json:"request_id,omitempty"`
+	TaskStatus string `json:"task_status"`
+}
+
+// GLMAsyncResultResponse is the async-result payload for a video generation task.
+type GLMAsyncResultResponse struct {
+	ID          string           `json:"id,omitempty"`
+	Model       string           `json:"model"`
+	RequestID   string           `json:"request_id,omitempty"`
+	TaskStatus  string           `json:"task_status"`
+	VideoResult []GLMVideoResult `json:"video_result,omitempty"`
+}
+
+// GLMVideoResult is a single generated video.
+type GLMVideoResult struct {
+	URL           string `json:"url"`
+	CoverImageURL string `json:"cover_image_url,omitempty"`
+}
+
+// ==================== CHAT TYPES ====================
+
+// GLMToolTypeWebSearch is the type of GLM's built-in web search tool.
+const GLMToolTypeWebSearch = "web_search"
+
+// GLMWebSearchTool is the configuration of GLM's built-in web_search tool.
+type GLMWebSearchTool struct {
+	Enable              bool    `json:"enable"`
+	SearchEngine        *string `json:"search_engine,omitempty"`
+	SearchResult        bool    `json:"search_result"` // Return the search results alongside the completion
+	Count               *int    `json:"count,omitempty"`
+	SearchDomainFilter  *string `json:"search_domain_filter,omitempty"`
+	SearchRecencyFilter *string `json:"search_recency_filter,omitempty"`
+	ContentSize         *string `json:"content_size,omitempty"`
+	SearchPrompt        *string `json:"search_prompt,omitempty"`
+}
+
+// GLMBuiltinTool is a GLM built-in tool entry in the tools array.
+type GLMBuiltinTool struct {
+	Type      string            `json:"type"`
+	WebSearch *GLMWebSearchTool `json:"web_search,omitempty"`
+}
+
+// GLMWebSearchResult is a single search result returned alongside a chat completion.
+type GLMWebSearchResult struct {
+	Title       string `json:"title"`
+	Content     string `json:"content,omitempty"`
+	Link        string `json:"link"`
+	Media       string `json:"media,omitempty"`
+	Icon        string `json:"icon,omitempty"`
+	Refer       string `json:"refer,omitempty"` // Citation marker, e.g. "ref_1"
+	PublishDate string `json:"publish_date,omitempty"`
+}
+
+// GLMChatResponseExtras holds the GLM-specific fields of a chat completion response or chunk.
+type GLMChatResponseExtras struct {
+	WebSearch []GLMWebSearchResult `json:"web_search,omitempty"`
+}
@@ -0,0 +1,104 @@
+package glm
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// ToGLMVideoGenerationRequest converts a Bifrost video generation request to the CogVideoX format.
+func ToGLMVideoGenerationRequest(bifrostReq *schemas.BifrostVideoGenerationRequest) (*GLMVideoGenerationRequest, error) {
+	if bifrostReq == nil || bifrostReq.Input == nil {
+		return nil, fmt.Errorf("input is required")
+	}
+	if bifrostReq.Input.Prompt == "" && bifrostReq.Input.InputReference == nil {
+		return nil, fmt.Errorf("either prompt or input_reference is required")
+	}
+
+	req := &GLMVideoGenerationRequest{
+		Model: bifrostReq.Model,
+	}
+	if bifrostReq.Input.Prompt != "" {
+		req.Prompt = schemas.Ptr(bifrostReq.Input.Prompt)
+	}
+	if bifrostReq.Input.InputReference != nil {
+		sanitizedURL, err := schemas.SanitizeImageURL(*bifrostReq.Input.InputReference)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input reference: %w", err)
+		}
+		req.ImageURL = schemas.Ptr(sanitizedURL)
+	}
+
+	if bifrostReq.Params != nil {
+		if bifrostReq.Params.Seconds != nil {
+			seconds, err := strconv.Atoi(*bifrostReq.Params.Seconds)
+			if err != nil {
+				return nil, fmt.Errorf("invalid seconds value: %w", err)
+			}
+			req.Duration = &seconds
+		}
+		if bifrostReq.Params.Size != "" {
+			req.Size = schemas.Ptr(bifrostReq.Params.Size)
+		}
+		if bifrostReq.Params.Audio != nil {
+			req.WithAudio = bifrostReq.Params.Audio
+		}
+		req.ExtraParams = bifrostReq.Params.ExtraParams
+	}
+
+	return req, nil
+}
+
+// ToBifrostVideoGenerationResponse converts a GLM async-result payload to Bifrost format.
+func (response *GLMAsyncResultResponse) ToBifrostVideoGenerationResponse(taskID string) *schemas.BifrostVideoGenerationResponse {
+	if response == nil {
+		return nil
+	}
+
+	bifrostResp := &schemas.BifrostVideoGenerationResponse{
+		ID:        taskID,
+		Model:     response.Model,
+		Object:    "video",
+		CreatedAt: time.Now().Unix(),
+		Status:    toBifrostVideoStatus(response.TaskStatus),
+	}
+
+	if bifrostResp.Status == schemas.VideoStatusFailed {
+		bifrostResp.Error = &schemas.VideoCreateError{
+			Code:    response.TaskStatus,
+			Message: "video generation task failed",
+		}
+	}
+
+	if len(response.VideoResult) > 0 {
+		bifrostResp.Videos = make([]schemas.VideoOutput, 0, len(response.VideoResult))
+		for _, video := range response.VideoResult {
+			if video.URL == "" {
+				continue
+			}
+			bifrostResp.Videos = append(bifrostResp.Videos, schemas.VideoOutput{
+				Type:        schemas.VideoOutputTypeURL,
+				URL:         schemas.Ptr(video.URL),
+				ContentType: "video/mp4",
+			})
+		}
+	}
+
+	return bifrostResp
+}
+
+// toBifrostVideoStatus maps a GLM task status to the Bifrost video lifecycle.
+func toBifrostVideoStatus(taskStatus string) schemas.VideoStatus {
+	switch taskStatus {
+	case GLMTaskStatusProcessing:
+		return schemas.VideoStatusInProgress
+	case GLMTaskStatusSuccess:
+		return schemas.VideoStatusCompleted
+	case GLMTaskStatusFail:
+		return schemas.VideoStatusFailed
+	default:
+		return schemas.VideoStatusQueued
+	}
+}
@@ -0,0 +1,77 @@
+package glm
+
+import (
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestToGLMVideoGenerationRequest(t *testing.T) {
+	req, err := ToGLMVideoGenerationRequest(&schemas.BifrostVideoGenerationRequest{
+		Model: "cogvideox-3",
+		Input: &schemas.VideoGenerationInput{Prompt: "a cat surfing"},
+		Params: &schemas.VideoGenerationParameters{
+			Seconds: schemas.Ptr("10"),
+			Size:    "1920x1080",
+			Audio:   schemas.Ptr(true),
+		},
+	})
+	if err != nil {
+		t.Fatalf("ToGLMVideoGenerationRequest() error = %v", err)
+	}
+	if req.Prompt == nil || *req.Prompt != "a cat surfing" {
+		t.Errorf("prompt = %v", req.Prompt)
+	}
+	if req.Duration == nil || *req.Duration != 10 {
+		t.Errorf("duration = %v, want 10", req.Duration)
+	}
+	if req.Size == nil || *req.Size != "1920x1080" || req.WithAudio == nil || !*req.WithAudio {
+		t.Errorf("size/with_audio not mapped: %+v", req)
+	}
+
+	if _, err := ToGLMVideoGenerationRequest(&schemas.BifrostVideoGenerationRequest{Model: "cogvideox-3", Input: &schemas.VideoGenerationInput{}}); err == nil {
+		t.Error("expected error when neither prompt nor input_reference is set")
+	}
+}
+
+func TestGLMAsyncResultResponse_ToBifrostVideoGenerationResponse(t *testing.T) {
+	tests := []struct {
+		taskStatus string
+		want       schemas.VideoStatus
+	}{
+		{GLMTaskStatusProcessing, schemas.VideoStatusInProgress},
+		{GLMTaskStatusSuccess, schemas.VideoStatusCompleted},
+		{GLMTaskStatusFail, schemas.VideoStatusFailed},
+	}
+	for _, tt := range tests {
+		resp := (&GLMAsyncResultResponse{
+			Model:       "cogvideox-3",
+			TaskStatus:  tt.taskStatus,
+			VideoResult: []GLMVideoResult{{URL: "https://example.com/video.mp4", CoverImageURL: "https://example.com/cover.png"}},
+		}).ToBifrostVideoGenerationResponse("task-1:glm")
+		if resp.Status != tt.want {
+			t.Errorf("status for %s = %s, want %s", tt.taskStatus, resp.Status, tt.want)
+		}
+		if (resp.Error != nil) != (tt.want == schemas.VideoStatusFailed) {
+			t.Errorf("error for %s = %v", tt.taskStatus, resp.Error)
+		}
+		if len(resp.Videos) != 1 || *resp.Videos[0].URL != "https://example.com/video.mp4" {
+			t.Errorf("videos = %+v", resp.Videos)
+		}
+	}
+}
+
+func TestGLMImageGenerationResponse_ToBifrostImageGenerationResponse(t *testing.T) {
+	resp := (&GLMImageGenerationResponse{
+		Created:       1700000000,
+		Data:          []GLMImageData{{URL: "https://example.com/a.png"}},
+		ContentFilter: []GLMContentFilter{{Role: "assistant", Level: 1}},
+	}).ToBifrostImageGenerationResponse("cogview-4")
+
+	if resp.Model != "cogview-4" || resp.Created != 1700000000 || len(resp.Data) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp.Data[0].Safety == nil || !resp.Data[0].Safety.Flagged {
+		t.Errorf("safety = %+v, want flagged", resp.Data[0].Safety)
+	}
+}
@@ -380,8 +380,9 @@ func UnmarshalHuggingFaceImageGenerationResponse(data []byte, model string) (*sc
 			Model: model,
 			Data: []schemas.ImageData{
 				{
-					B64JSON: b64Data,
-					Index:   0,
+					B64JSON:  b64Data,
+					MimeType: http.DetectContentType(data),
+					Index:    0,
 				},
 			},
 		}, nil
@@ -397,9 +398,17 @@ func UnmarshalHuggingFaceImageGenerationResponse(data []byte, model string) (*sc
 		for i, img := range falResponse.Images {
 			// Handle both URL and base64 responses
 			imageData[i] = schemas.ImageData{
-				URL:     img.URL,
-				B64JSON: img.B64JSON,
-				Index:   i,
+				URL:      img.URL,
+				B64JSON:  img.B64JSON,
+				MimeType: img.ContentType,
+				Seed:     falResponse.Seed,
+				Index:    i,
+			}
+			if i < len(falResponse.HasNSFWConcepts) {
+				imageData[i].Safety = &schemas.ImageSafetyAnnotation{Flagged: falResponse.HasNSFWConcepts[i]}
+				if falResponse.HasNSFWConcepts[i] {
+					imageData[i].Safety.Reason = "nsfw_concepts"
+				}
 			}
 		}
 
@@ -0,0 +1,234 @@
+// Package providers implements various LLM providers and their utility functions.
+// This file contains the Tencent Hunyuan provider implementation.
+package hunyuan
+
+import (
+	"strings"
+	"time"
+
+	"github.com/capsohq/bifrost/core/providers/openai"
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	hunyuanPathListModels       = "/v1/models"
+	hunyuanPathChatCompletions  = "/v1/chat/completions"
+	hunyuanPathEmbeddings       = "/v1/embeddings"
+	hunyuanPathImageGenerations = "/v1/images/generations"
+)
+
+// HunyuanProvider implements the Provider interface for Tencent Hunyuan.
+// API keys use the OpenAI-compatible API; keys with SecretId/SecretKey credentials
+// use the signed Tencent Cloud API (see tc3.go).
+type HunyuanProvider struct {
+	logger              schemas.Logger        // Logger for provider operations
+	client              *fasthttp.Client      // HTTP client for API requests
+	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
+	tc3Endpoint         string                // Tencent Cloud API endpoint for signed requests
+	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
+	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
+}
+
+// Compile-time checks for the capabilities HunyuanProvider implements.
+var (
+	_ schemas.ListModelsProvider      = (*HunyuanProvider)(nil)
+	_ schemas.ChatProvider            = (*HunyuanProvider)(nil)
+	_ schemas.ResponsesProvider       = (*HunyuanProvider)(nil)
+	_ schemas.EmbeddingProvider       = (*HunyuanProvider)(nil)
+	_ schemas.ImageGenerationProvider = (*HunyuanProvider)(nil)
+)
+
+func init() {
+	schemas.RegisterProviderCapabilities(schemas.Hunyuan, schemas.CapabilitiesOf((*HunyuanProvider)(nil)))
+}
+
+// NewHunyuanProvider creates a new Hunyuan provider instance.
+// It initializes the HTTP client with the provided configuration and sets up response pools.
+// The client is configured with timeouts, concurrency limits, and optional proxy settings.
+func NewHunyuanProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*HunyuanProvider, error) {
+	config.CheckAndSetDefaults()
+
+	client := &fasthttp.Client{
+		ReadTimeout:         time.Second * time.Duration(config.NetworkConfig.DefaultRequestTimeoutInSeconds),
+		WriteTimeout:        time.Second * time.Duration(config.NetworkConfig.DefaultRequestTimeoutInSeconds),
+		MaxConnsPerHost:     5000,
+		MaxIdleConnDuration: 30 * time.Second,
+		MaxConnWaitTimeout:  10 * time.Second,
+	}
+
+	// Configure proxy and retry policy
+	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureDialer(client)
+	// Set default BaseURL if not provided
+	if config.NetworkConfig.BaseURL == "" {
+		config.NetworkConfig.BaseURL = "https://api.hunyuan.cloud.tencent.com"
+	}
+	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+
+	return &HunyuanProvider{
+		logger:              logger,
+		client:              client,
+		networkConfig:       config.NetworkConfig,
+		tc3Endpoint:         tc3Endpoint,
+		sendBackRawRequest:  config.SendBackRawRequest,
+		sendBackRawResponse: config.SendBackRawResponse,
+	}, nil
+}
+
+// GetProviderKey returns the provider identifier for Hunyuan.
+func (provider *HunyuanProvider) GetProviderKey() schemas.ModelProvider {
+	return schemas.Hunyuan
+}
+
+// HTTPClient returns the pooled client used for Hunyuan API requests.
+func (provider *HunyuanProvider) HTTPClient() *fasthttp.Client {
+	return provider.client
+}
+
+// ListModels performs a list models request to Hunyuan's API.
+func (provider *HunyuanProvider) ListModels(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
+	url := provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, hunyuanPathListModels)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	if len(keys) == 0 {
+		return openai.ListModelsByKey(ctx, provider.client, url, schemas.Key{}, request.Unfiltered, provider.networkConfig.ExtraHeaders, schemas.Hunyuan, sendBackRawRequest, sendBackRawResponse)
+	}
+	return providerUtils.HandleMultipleListModelsRequests(
+		ctx,
+		keys,
+		request,
+		func(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
+			// The Tencent Cloud API has no model listing action
+			if hasSignatureCredentials(key) {
+				return nil, providerUtils.NewUnsupportedOperationError(schemas.ListModelsRequest, provider.GetProviderKey())
+			}
+			return openai.ListModelsByKey(ctx, provider.client, url, key, request.Unfiltered, provider.networkConfig.ExtraHeaders, schemas.Hunyuan, sendBackRawRequest, sendBackRawResponse)
+		},
+	)
+}
+
+// ChatCompletion performs a chat completion request to the Hunyuan API.
+func (provider *HunyuanProvider) ChatCompletion(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
+	if hasSignatureCredentials(key) {
+		return provider.tc3ChatCompletion(ctx, key, request)
+	}
+	return openai.HandleOpenAIChatCompletionRequest(
+		ctx,
+		provider.client,
+		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, hunyuanPathChatCompletions),
+		request,
+		key,
+		provider.networkConfig.ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.GetProviderKey(),
+		nil,
+		nil,
+		provider.logger,
+	)
+}
+
+// ChatCompletionStream performs a streaming chat completion request to the Hunyuan API.
+// It supports real-time streaming of responses using Server-Sent Events (SSE).
+// Uses Hunyuan's OpenAI-compatible streaming format for API keys.
+// Returns a channel containing BifrostStreamChunk objects representing the stream or an error if the request fails.
+func (provider *HunyuanProvider) ChatCompletionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostChatRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
+	if hasSignatureCredentials(key) {
+		return provider.tc3ChatCompletionStream(ctx, postHookRunner, key, request)
+	}
+	var authHeader map[string]string
+	if key.Value.GetValue() != "" {
+		authHeader = map[string]string{"Authorization": "Bearer " + key.Value.GetValue()}
+	}
+	// Use shared OpenAI-compatible streaming logic
+	return openai.HandleOpenAIChatCompletionStreaming(
+		ctx,
+		provider.client,
+		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, hunyuanPathChatCompletions),
+		request,
+		authHeader,
+		provider.networkConfig.ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		schemas.Hunyuan,
+		postHookRunner,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		provider.logger,
+	)
+}
+
+// Responses performs a responses request to the Hunyuan API.
+func (provider *HunyuanProvider) Responses(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostResponsesRequest) (*schemas.BifrostResponsesResponse, *schemas.BifrostError) {
+	chatResponse, err := provider.ChatCompletion(ctx, key, request.ToChatRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	response := chatResponse.ToBifrostResponsesResponse()
+	response.ExtraFields.RequestType = schemas.ResponsesRequest
+	response.ExtraFields.Provider = provider.GetProviderKey()
+	response.ExtraFields.ModelRequested = request.Model
+
+	return response, nil
+}
+
+// ResponsesStream performs a streaming responses request to the Hunyuan API.
+func (provider *HunyuanProvider) ResponsesStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostResponsesRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
+	ctx.SetValue(schemas.BifrostContextKeyIsResponsesToChatCompletionFallback, true)
+	return provider.ChatCompletionStream(
+		ctx,
+		postHookRunner,
+		key,
+		request.ToChatRequest(),
+	)
+}
+
+// Embedding performs an embedding request to the Hunyuan API.
+func (provider *HunyuanProvider) Embedding(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
+	if hasSignatureCredentials(key) {
+		return provider.tc3Embedding(ctx, key, request)
+	}
+	return openai.HandleOpenAIEmbeddingRequest(
+		ctx,
+		provider.client,
+		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, hunyuanPathEmbeddings),
+		request,
+		key,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		nil,
+		provider.logger,
+	)
+}
+
+// ImageGeneration performs an image generation request to the Hunyuan API.
+func (provider *HunyuanProvider) ImageGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageGenerationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
+	if hasSignatureCredentials(key) {
+		return provider.tc3ImageGeneration(ctx, key, request)
+	}
+	return openai.HandleOpenAIImageGenerationRequest(
+		ctx,
+		provider.client,
+		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, hunyuanPathImageGenerations),
+		request,
+		key,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.logger,
+	)
+}
+
+// ImageGenerationStream is not supported by the Hunyuan provider.
+func (provider *HunyuanProvider) ImageGenerationStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostImageGenerationRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
+	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageGenerationStreamRequest, provider.GetProviderKey())
+}
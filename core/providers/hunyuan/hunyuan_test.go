@@ -0,0 +1,57 @@
+package hunyuan_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/internal/llmtests"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func envOrDefault(key, fallback string) string {
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func TestHunyuan(t *testing.T) {
+	t.Parallel()
+
+	if strings.TrimSpace(os.Getenv("HUNYUAN_API_KEY")) == "" {
+		t.Skip("Skipping Hunyuan tests because HUNYUAN_API_KEY is not set")
+	}
+
+	client, ctx, cancel, err := llmtests.SetupTest()
+	if err != nil {
+		t.Fatalf("Error initializing test setup: %v", err)
+	}
+	defer cancel()
+
+	testConfig := llmtests.ComprehensiveTestConfig{
+		Provider:             schemas.Hunyuan,
+		ChatModel:            envOrDefault("HUNYUAN_CHAT_MODEL", "hunyuan-turbos-latest"),
+		VisionModel:          envOrDefault("HUNYUAN_VISION_MODEL", "hunyuan-vision"),
+		EmbeddingModel:       envOrDefault("HUNYUAN_EMBEDDING_MODEL", "hunyuan-embedding"),
+		ImageGenerationModel: envOrDefault("HUNYUAN_IMAGE_MODEL", "hunyuan-image"),
+		Scenarios: llmtests.TestScenarios{
+			SimpleChat:            true,
+			CompletionStream:      true,
+			MultiTurnConversation: true,
+			ToolCalls:             true,
+			MultipleToolCalls:     true,
+			End2EndToolCalling:    true,
+			AutomaticFunctionCall: true,
+			ImageURL:              true,
+			Embedding:             true,
+			ImageGeneration:       true,
+			ListModels:            true,
+		},
+	}
+
+	t.Run("HunyuanTests", func(t *testing.T) {
+		llmtests.RunAllComprehensiveTests(t, client, ctx, testConfig)
+	})
+	client.Shutdown()
+}
@@ -0,0 +1,101 @@
+package hunyuan
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	tc3Algorithm     = "TC3-HMAC-SHA256"
+	tc3Service       = "hunyuan"
+	tc3Endpoint      = "https://hunyuan.tencentcloudapi.com"
+	tc3Version       = "2023-09-01"
+	tc3SignedHeaders = "content-type;host"
+	tc3ContentType   = "application/json; charset=utf-8"
+)
+
+// hunyuanAction maps a Bifrost request type to the Tencent Cloud API action name
+// that is sent in the X-TC-Action header of signed requests.
+func hunyuanAction(requestType schemas.RequestType) string {
+	switch requestType {
+	case schemas.EmbeddingRequest:
+		return "GetEmbedding"
+	case schemas.ImageGenerationRequest:
+		return "TextToImageLite"
+	default:
+		return "ChatCompletions"
+	}
+}
+
+// hasSignatureCredentials reports whether the key carries SecretId/SecretKey credentials.
+func hasSignatureCredentials(key schemas.Key) bool {
+	return key.HunyuanKeyConfig != nil &&
+		key.HunyuanKeyConfig.SecretID.GetValue() != "" &&
+		key.HunyuanKeyConfig.SecretKey.GetValue() != ""
+}
+
+// signRequest builds the headers of a Tencent Cloud API request that invokes action on host
+// with the given JSON payload, signed with TC3-HMAC-SHA256.
+// The payload must be sent byte for byte as signed, since its SHA-256 is part of the signature.
+func signRequest(config *schemas.HunyuanKeyConfig, host, action string, payload []byte, now time.Time) map[string]string {
+	headers := map[string]string{
+		"Authorization":  tc3Authorization(config.SecretID.GetValue(), config.SecretKey.GetValue(), tc3Service, host, payload, now),
+		"Content-Type":   tc3ContentType,
+		"X-TC-Action":    action,
+		"X-TC-Timestamp": strconv.FormatInt(now.Unix(), 10),
+		"X-TC-Version":   tc3Version,
+	}
+	if config.Region != nil && config.Region.GetValue() != "" {
+		headers["X-TC-Region"] = config.Region.GetValue()
+	}
+	return headers
+}
+
+// tc3Authorization computes the Authorization header value for a POST of payload to the
+// root path of host, following Tencent Cloud's TC3-HMAC-SHA256 signature v3.
+func tc3Authorization(secretID, secretKey, service, host string, payload []byte, now time.Time) string {
+	date := now.UTC().Format("2006-01-02")
+	credentialScope := date + "/" + service + "/tc3_request"
+
+	canonicalRequest := strings.Join([]string{
+		"POST",
+		"/",
+		"",
+		"content-type:" + tc3ContentType + "\nhost:" + host + "\n",
+		tc3SignedHeaders,
+		sha256Hex(payload),
+	}, "\n")
+
+	stringToSign := strings.Join([]string{
+		tc3Algorithm,
+		strconv.FormatInt(now.Unix(), 10),
+		credentialScope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	secretDate := hmacSHA256([]byte("TC3"+secretKey), date)
+	secretService := hmacSHA256(secretDate, service)
+	secretSigning := hmacSHA256(secretService, "tc3_request")
+	signature := hex.EncodeToString(hmacSHA256(secretSigning, stringToSign))
+
+	return fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		tc3Algorithm, secretID, credentialScope, tc3SignedHeaders, signature)
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,80 @@
+package hunyuan
+
+import (
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// TestTC3Authorization checks the signer against the worked example in Tencent Cloud's
+// "Signature v3" documentation (CVM DescribeInstances).
+func TestTC3Authorization(t *testing.T) {
+	payload := []byte(`{"Limit": 1, "Filters": [{"Values": ["\u672a\u547d\u540d"], "Name": "instance-name"}]}`)
+	if got := sha256Hex(payload); got != "35e9c5b0e3ae67532d3c9f17ead6c90222632e5b1ff7f6e89887f1398934f064" {
+		t.Fatalf("unexpected hashed payload: %s", got)
+	}
+
+	got := tc3Authorization(
+		"AKIDz8krbsJ5yKBZQpn74WFkmLPx3EXAMPLE",
+		"Gu5t9xGARNpq86cd98joQYCN3EXAMPLE",
+		"cvm",
+		"cvm.tencentcloudapi.com",
+		payload,
+		time.Unix(1551113065, 0),
+	)
+	want := "TC3-HMAC-SHA256 Credential=AKIDz8krbsJ5yKBZQpn74WFkmLPx3EXAMPLE/2019-02-25/cvm/tc3_request, " +
+		"SignedHeaders=content-type;host, " +
+		"Signature=72e494ea809ad7a8c8f7a4507b9bddcbaa8e581f516e8da2f66e2c5a96525168"
+	if got != want {
+		t.Fatalf("unexpected Authorization header:\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestSignRequest(t *testing.T) {
+	config := &schemas.HunyuanKeyConfig{
+		SecretID:  *schemas.NewEnvVar("AKIDEXAMPLE"),
+		SecretKey: *schemas.NewEnvVar("secret"),
+		Region:    schemas.NewEnvVar("ap-guangzhou"),
+	}
+	now := time.Unix(1700000000, 0)
+	payload := []byte(`{"Model":"hunyuan-turbos-latest"}`)
+
+	headers := signRequest(config, "hunyuan.tencentcloudapi.com", "ChatCompletions", payload, now)
+
+	want := tc3Authorization("AKIDEXAMPLE", "secret", "hunyuan", "hunyuan.tencentcloudapi.com", payload, now)
+	if got := headers["Authorization"]; got != want {
+		t.Errorf("expected Authorization %s, got %s", want, got)
+	}
+	expected := map[string]string{
+		"Content-Type":   "application/json; charset=utf-8",
+		"X-TC-Action":    "ChatCompletions",
+		"X-TC-Timestamp": "1700000000",
+		"X-TC-Version":   "2023-09-01",
+		"X-TC-Region":    "ap-guangzhou",
+	}
+	for name, value := range expected {
+		if got := headers[name]; got != value {
+			t.Errorf("expected %s %s, got %s", name, value, got)
+		}
+	}
+
+	// The payload is part of the signature.
+	other := signRequest(config, "hunyuan.tencentcloudapi.com", "ChatCompletions", []byte(`{"Model":"hunyuan-lite"}`), now)
+	if other["Authorization"] == headers["Authorization"] {
+		t.Error("expected different signatures for different payloads")
+	}
+}
+
+func TestHasSignatureCredentials(t *testing.T) {
+	if hasSignatureCredentials(schemas.Key{Value: *schemas.NewEnvVar("sk-test")}) {
+		t.Error("plain API key should not use signature auth")
+	}
+	key := schemas.Key{HunyuanKeyConfig: &schemas.HunyuanKeyConfig{
+		SecretID:  *schemas.NewEnvVar("id"),
+		SecretKey: *schemas.NewEnvVar("secret"),
+	}}
+	if !hasSignatureCredentials(key) {
+		t.Error("expected signature auth when SecretID and SecretKey are set")
+	}
+}
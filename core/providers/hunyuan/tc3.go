@@ -0,0 +1,735 @@
+package hunyuan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// This file implements the Tencent Cloud API (TC3) transport used for keys with SecretId/SecretKey
+// credentials. The OpenAI-compatible endpoint only accepts API keys, so signed requests go to
+// hunyuan.tencentcloudapi.com in Tencent's native request and response format.
+
+// ToHunyuanChatRequest converts a Bifrost chat request to a ChatCompletions action request.
+func ToHunyuanChatRequest(bifrostReq *schemas.BifrostChatRequest) (*HunyuanChatRequest, error) {
+	if bifrostReq == nil {
+		return nil, fmt.Errorf("bifrost request is nil")
+	}
+
+	req := &HunyuanChatRequest{
+		Model:    bifrostReq.Model,
+		Messages: make([]HunyuanMessage, 0, len(bifrostReq.Input)),
+	}
+	for _, message := range bifrostReq.Input {
+		converted, err := toHunyuanMessage(message)
+		if err != nil {
+			return nil, err
+		}
+		req.Messages = append(req.Messages, converted)
+	}
+
+	if params := bifrostReq.Params; params != nil {
+		req.Temperature = params.Temperature
+		req.TopP = params.TopP
+		req.Seed = params.Seed
+		req.Stop = params.Stop
+		req.ExtraParams = params.ExtraParams
+		for _, tool := range params.Tools {
+			if tool.Type != schemas.ChatToolTypeFunction || tool.Function == nil {
+				continue
+			}
+			converted, err := toHunyuanTool(tool.Function)
+			if err != nil {
+				return nil, err
+			}
+			req.Tools = append(req.Tools, converted)
+		}
+		if choice := params.ToolChoice; choice != nil {
+			switch {
+			case choice.ChatToolChoiceStr != nil:
+				switch *choice.ChatToolChoiceStr {
+				case "none", "auto":
+					req.ToolChoice = choice.ChatToolChoiceStr
+				}
+			case choice.ChatToolChoiceStruct != nil && choice.ChatToolChoiceStruct.Type == schemas.ChatToolChoiceTypeFunction && choice.ChatToolChoiceStruct.Function != nil:
+				for i := range req.Tools {
+					if req.Tools[i].Function.Name == choice.ChatToolChoiceStruct.Function.Name {
+						req.ToolChoice = schemas.Ptr("custom")
+						req.CustomTool = &req.Tools[i]
+						break
+					}
+				}
+			}
+		}
+	}
+
+	return req, nil
+}
+
+// toHunyuanMessage converts a single chat message. Text-only content is flattened into Content;
+// content with images is sent as Contents.
+func toHunyuanMessage(message schemas.ChatMessage) (HunyuanMessage, error) {
+	role := string(message.Role)
+	if message.Role == schemas.ChatMessageRoleDeveloper {
+		role = string(schemas.ChatMessageRoleSystem)
+	}
+	converted := HunyuanMessage{Role: role}
+
+	if message.Content != nil {
+		if message.Content.ContentStr != nil {
+			converted.Content = *message.Content.ContentStr
+		} else {
+			var text strings.Builder
+			hasImage := false
+			for _, block := range message.Content.ContentBlocks {
+				switch block.Type {
+				case schemas.ChatContentBlockTypeText:
+					if block.Text != nil {
+						text.WriteString(*block.Text)
+						converted.Contents = append(converted.Contents, HunyuanContent{Type: "text", Text: *block.Text})
+					}
+				case schemas.ChatContentBlockTypeImage:
+					if block.ImageURLStruct != nil {
+						hasImage = true
+						converted.Contents = append(converted.Contents, HunyuanContent{Type: "image_url", ImageURL: &HunyuanImageURL{URL: block.ImageURLStruct.URL}})
+					}
+				default:
+					return HunyuanMessage{}, fmt.Errorf("content block type %q is not supported by the hunyuan tencent cloud api", block.Type)
+				}
+			}
+			if !hasImage {
+				converted.Content = text.String()
+				converted.Contents = nil
+			}
+		}
+	}
+
+	if message.ChatToolMessage != nil && message.ChatToolMessage.ToolCallID != nil {
+		converted.ToolCallID = *message.ChatToolMessage.ToolCallID
+	}
+	if message.ChatAssistantMessage != nil {
+		for _, toolCall := range message.ChatAssistantMessage.ToolCalls {
+			call := HunyuanToolCall{Type: "function", Function: HunyuanToolCallFunction{Arguments: toolCall.Function.Arguments}}
+			if toolCall.ID != nil {
+				call.ID = *toolCall.ID
+			}
+			if toolCall.Function.Name != nil {
+				call.Function.Name = *toolCall.Function.Name
+			}
+			converted.ToolCalls = append(converted.ToolCalls, call)
+		}
+	}
+	return converted, nil
+}
+
+// toHunyuanTool converts a function tool; the Tencent Cloud API takes the parameter schema as a JSON string.
+func toHunyuanTool(function *schemas.ChatToolFunction) (HunyuanTool, error) {
+	tool := HunyuanTool{Type: "function", Function: HunyuanToolFunction{Name: function.Name}}
+	if function.Description != nil {
+		tool.Function.Description = *function.Description
+	}
+	if function.Parameters != nil {
+		parameters, err := sonic.Marshal(function.Parameters)
+		if err != nil {
+			return HunyuanTool{}, fmt.Errorf("failed to marshal parameters of tool %s: %w", function.Name, err)
+		}
+		tool.Function.Parameters = string(parameters)
+	}
+	return tool, nil
+}
+
+// ToBifrostChatResponse converts a ChatCompletions response to Bifrost format.
+func (response *HunyuanChatResponse) ToBifrostChatResponse(model string) *schemas.BifrostChatResponse {
+	bifrostResp := &schemas.BifrostChatResponse{
+		ID:      response.ID,
+		Created: response.Created,
+		Model:   model,
+		Object:  "chat.completion",
+		Usage:   response.Usage.toBifrostUsage(),
+		Choices: make([]schemas.BifrostResponseChoice, 0, len(response.Choices)),
+	}
+	for _, choice := range response.Choices {
+		message := &schemas.ChatMessage{Role: schemas.ChatMessageRoleAssistant}
+		if choice.Message != nil {
+			message.Content = &schemas.ChatMessageContent{ContentStr: schemas.Ptr(choice.Message.Content)}
+			if toolCalls := toBifrostToolCalls(choice.Message.ToolCalls); len(toolCalls) > 0 {
+				message.ChatAssistantMessage = &schemas.ChatAssistantMessage{ToolCalls: toolCalls}
+			}
+		}
+		bifrostResp.Choices = append(bifrostResp.Choices, schemas.BifrostResponseChoice{
+			Index:                       choice.Index,
+			FinishReason:                toBifrostFinishReason(choice.FinishReason),
+			ChatNonStreamResponseChoice: &schemas.ChatNonStreamResponseChoice{Message: message},
+		})
+	}
+	return bifrostResp
+}
+
+// ToBifrostChatStreamResponse converts a ChatCompletions stream chunk to Bifrost format.
+func (response *HunyuanChatResponse) ToBifrostChatStreamResponse(model string) *schemas.BifrostChatResponse {
+	bifrostResp := &schemas.BifrostChatResponse{
+		ID:      response.ID,
+		Created: response.Created,
+		Model:   model,
+		Object:  "chat.completion.chunk",
+		Choices: make([]schemas.BifrostResponseChoice, 0, len(response.Choices)),
+	}
+	for _, choice := range response.Choices {
+		delta := &schemas.ChatStreamResponseChoiceDelta{}
+		if choice.Delta != nil {
+			if choice.Delta.Role != "" {
+				delta.Role = schemas.Ptr(choice.Delta.Role)
+			}
+			if choice.Delta.Content != "" {
+				delta.Content = schemas.Ptr(choice.Delta.Content)
+			}
+			delta.ToolCalls = toBifrostToolCalls(choice.Delta.ToolCalls)
+		}
+		bifrostResp.Choices = append(bifrostResp.Choices, schemas.BifrostResponseChoice{
+			Index:                    choice.Index,
+			FinishReason:             toBifrostFinishReason(choice.FinishReason),
+			ChatStreamResponseChoice: &schemas.ChatStreamResponseChoice{Delta: delta},
+		})
+	}
+	return bifrostResp
+}
+
+func toBifrostToolCalls(toolCalls []HunyuanToolCall) []schemas.ChatAssistantMessageToolCall {
+	if len(toolCalls) == 0 {
+		return nil
+	}
+	converted := make([]schemas.ChatAssistantMessageToolCall, 0, len(toolCalls))
+	for i, toolCall := range toolCalls {
+		index := i
+		if toolCall.Index != nil {
+			index = *toolCall.Index
+		}
+		call := schemas.ChatAssistantMessageToolCall{
+			Index: uint16(index),
+			Type:  schemas.Ptr("function"),
+			Function: schemas.ChatAssistantMessageToolCallFunction{
+				Arguments: toolCall.Function.Arguments,
+			},
+		}
+		if toolCall.ID != "" {
+			call.ID = schemas.Ptr(toolCall.ID)
+		}
+		if toolCall.Function.Name != "" {
+			call.Function.Name = schemas.Ptr(toolCall.Function.Name)
+		}
+		converted = append(converted, call)
+	}
+	return converted
+}
+
+// toBifrostFinishReason maps Hunyuan finish reasons; "sensitive" means the output was blocked by moderation.
+func toBifrostFinishReason(reason string) *string {
+	switch reason {
+	case "":
+		return nil
+	case "sensitive":
+		return schemas.Ptr("content_filter")
+	default:
+		return schemas.Ptr(reason)
+	}
+}
+
+func (usage *HunyuanUsage) toBifrostUsage() *schemas.BifrostLLMUsage {
+	if usage == nil {
+		return nil
+	}
+	return &schemas.BifrostLLMUsage{
+		PromptTokens:     usage.PromptTokens,
+		CompletionTokens: usage.CompletionTokens,
+		TotalTokens:      usage.TotalTokens,
+	}
+}
+
+// ToHunyuanEmbeddingRequest converts a Bifrost embedding request to a GetEmbedding action request.
+// The Tencent Cloud API serves a single embedding model, so the requested model is not sent.
+func ToHunyuanEmbeddingRequest(bifrostReq *schemas.BifrostEmbeddingRequest) (*HunyuanEmbeddingRequest, error) {
+	if bifrostReq == nil || bifrostReq.Input == nil {
+		return nil, fmt.Errorf("bifrost request is nil or input is nil")
+	}
+	req := &HunyuanEmbeddingRequest{}
+	switch {
+	case bifrostReq.Input.Text != nil:
+		req.Input = *bifrostReq.Input.Text
+	case len(bifrostReq.Input.Texts) > 0:
+		req.InputList = bifrostReq.Input.Texts
+	default:
+		return nil, fmt.Errorf("hunyuan tencent cloud api embeddings only support text input")
+	}
+	if bifrostReq.Params != nil {
+		req.ExtraParams = bifrostReq.Params.ExtraParams
+	}
+	return req, nil
+}
+
+// ToBifrostEmbeddingResponse converts a GetEmbedding response to Bifrost format.
+func (response *HunyuanEmbeddingResponse) ToBifrostEmbeddingResponse(model string) *schemas.BifrostEmbeddingResponse {
+	bifrostResp := &schemas.BifrostEmbeddingResponse{
+		Model:  model,
+		Object: "list",
+		Usage:  response.Usage.toBifrostUsage(),
+		Data:   make([]schemas.EmbeddingData, 0, len(response.Data)),
+	}
+	for _, data := range response.Data {
+		bifrostResp.Data = append(bifrostResp.Data, schemas.EmbeddingData{
+			Index:     data.Index,
+			Object:    "embedding",
+			Embedding: schemas.EmbeddingStruct{EmbeddingArray: data.Embedding},
+		})
+	}
+	return bifrostResp
+}
+
+// ToHunyuanImageRequest converts a Bifrost image generation request to a TextToImageLite action request.
+// Sizes such as "1024x1024" are sent as the "1024:1024" resolution format the API expects.
+func ToHunyuanImageRequest(bifrostReq *schemas.BifrostImageGenerationRequest) (*HunyuanImageRequest, error) {
+	if bifrostReq == nil || bifrostReq.Input == nil {
+		return nil, fmt.Errorf("bifrost request is nil or input is nil")
+	}
+	req := &HunyuanImageRequest{
+		Prompt:     bifrostReq.Input.Prompt,
+		RspImgType: "url",
+	}
+	if params := bifrostReq.Params; params != nil {
+		req.NegativePrompt = params.NegativePrompt
+		req.Style = params.Style
+		if params.Size != nil && strings.ToLower(*params.Size) != "auto" {
+			req.Resolution = schemas.Ptr(strings.Replace(*params.Size, "x", ":", 1))
+		}
+		if params.ResponseFormat != nil && *params.ResponseFormat == "b64_json" {
+			req.RspImgType = "base64"
+		}
+		req.ExtraParams = params.ExtraParams
+	}
+	return req, nil
+}
+
+// ToBifrostImageGenerationResponse converts a TextToImageLite response to Bifrost format.
+func (response *HunyuanImageResponse) ToBifrostImageGenerationResponse(model string, rspImgType string) *schemas.BifrostImageGenerationResponse {
+	image := schemas.ImageData{Index: 0}
+	if rspImgType == "base64" {
+		image.B64JSON = response.ResultImage
+	} else {
+		image.URL = response.ResultImage
+	}
+	return &schemas.BifrostImageGenerationResponse{
+		ID:      response.RequestID,
+		Created: time.Now().Unix(),
+		Model:   model,
+		Data:    []schemas.ImageData{image},
+	}
+}
+
+// toBifrostError converts a Tencent Cloud API error. The API reports errors with HTTP 200,
+// so a status code is derived from the error code family.
+func (apiErr *HunyuanError) toBifrostError(meta *providerUtils.RequestMetadata) *schemas.BifrostError {
+	statusCode := fasthttp.StatusBadRequest
+	switch {
+	case strings.HasPrefix(apiErr.Code, "AuthFailure"):
+		statusCode = fasthttp.StatusUnauthorized
+	case strings.HasPrefix(apiErr.Code, "RequestLimitExceeded"), strings.HasPrefix(apiErr.Code, "LimitExceeded"):
+		statusCode = fasthttp.StatusTooManyRequests
+	case strings.HasPrefix(apiErr.Code, "InternalError"):
+		statusCode = fasthttp.StatusInternalServerError
+	}
+	bifrostErr := providerUtils.NewProviderAPIError(apiErr.Message, nil, statusCode, meta.Provider, nil, nil)
+	bifrostErr.Error.Code = schemas.Ptr(apiErr.Code)
+	bifrostErr.ExtraFields.ModelRequested = meta.Model
+	bifrostErr.ExtraFields.RequestType = meta.RequestType
+	return bifrostErr
+}
+
+// prepareTC3Request fills req with a signed request invoking the action for requestType with jsonBody.
+func (provider *HunyuanProvider) prepareTC3Request(ctx *schemas.BifrostContext, req *fasthttp.Request, key schemas.Key, requestType schemas.RequestType, jsonBody []byte) {
+	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	req.SetRequestURI(provider.tc3Endpoint)
+	req.Header.SetMethod(http.MethodPost)
+	for name, value := range signRequest(key.HunyuanKeyConfig, string(req.URI().Host()), hunyuanAction(requestType), jsonBody, time.Now()) {
+		req.Header.Set(name, value)
+	}
+	req.SetBody(jsonBody)
+}
+
+// completeTC3Request sends a signed request and returns the response body, after unwrapping
+// errors reported inside the response envelope.
+func (provider *HunyuanProvider) completeTC3Request(ctx *schemas.BifrostContext, key schemas.Key, jsonBody []byte, meta *providerUtils.RequestMetadata) ([]byte, time.Duration, map[string]string, *schemas.BifrostError) {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	provider.prepareTC3Request(ctx, req, key, meta.RequestType, jsonBody)
+
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
+	if bifrostErr != nil {
+		return nil, latency, nil, bifrostErr
+	}
+
+	// Extract provider response headers before status check so error responses also forward them
+	providerResponseHeaders := providerUtils.ExtractProviderResponseHeaders(resp)
+
+	body, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, latency, providerResponseHeaders, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, meta.Provider)
+	}
+	if bifrostErr := parseTC3Error(resp.StatusCode(), body, meta); bifrostErr != nil {
+		return nil, latency, providerResponseHeaders, bifrostErr
+	}
+
+	// Copy the body before releasing the response, since it references fasthttp's internal buffer
+	return append([]byte(nil), body...), latency, providerResponseHeaders, nil
+}
+
+// parseTC3Error returns the error carried by a Tencent Cloud API response, if any.
+func parseTC3Error(statusCode int, body []byte, meta *providerUtils.RequestMetadata) *schemas.BifrostError {
+	var envelope hunyuanEnvelope[struct {
+		Error *HunyuanError `json:"Error"`
+	}]
+	if err := sonic.Unmarshal(body, &envelope); err == nil && envelope.Response.Error != nil {
+		return envelope.Response.Error.toBifrostError(meta)
+	}
+	if statusCode != fasthttp.StatusOK {
+		bifrostErr := providerUtils.NewProviderAPIError(string(body), nil, statusCode, meta.Provider, nil, nil)
+		bifrostErr.ExtraFields.ModelRequested = meta.Model
+		bifrostErr.ExtraFields.RequestType = meta.RequestType
+		return bifrostErr
+	}
+	return nil
+}
+
+// tc3ChatCompletion performs a non-streaming ChatCompletions request with a signed key.
+func (provider *HunyuanProvider) tc3ChatCompletion(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
+	jsonBody, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToHunyuanChatRequest(request)
+		},
+		provider.GetProviderKey())
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	responseBody, latency, providerResponseHeaders, bifrostErr := provider.completeTC3Request(ctx, key, jsonBody, &providerUtils.RequestMetadata{
+		Provider:    provider.GetProviderKey(),
+		Model:       request.Model,
+		RequestType: schemas.ChatCompletionRequest,
+	})
+	if providerResponseHeaders != nil {
+		ctx.SetValue(schemas.BifrostContextKeyProviderResponseHeaders, providerResponseHeaders)
+	}
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonBody, responseBody, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	var response hunyuanEnvelope[HunyuanChatResponse]
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, &response, jsonBody, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonBody, responseBody, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	bifrostResponse := response.Response.ToBifrostChatResponse(request.Model)
+	bifrostResponse.ExtraFields.Provider = provider.GetProviderKey()
+	bifrostResponse.ExtraFields.ModelRequested = request.Model
+	bifrostResponse.ExtraFields.RequestType = schemas.ChatCompletionRequest
+	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
+	bifrostResponse.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		bifrostResponse.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		bifrostResponse.ExtraFields.RawResponse = rawResponse
+	}
+	return bifrostResponse, nil
+}
+
+// tc3ChatCompletionStream performs a streaming ChatCompletions request with a signed key.
+// Chunks are SSE "data:" lines without the "Response" envelope; the stream ends when the
+// connection closes, after the chunk that carries a finish reason.
+func (provider *HunyuanProvider) tc3ChatCompletionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostChatRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	jsonBody, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			reqBody, err := ToHunyuanChatRequest(request)
+			if err != nil {
+				return nil, err
+			}
+			reqBody.Stream = true
+			return reqBody, nil
+		},
+		providerName)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	resp.StreamBody = true
+	defer fasthttp.ReleaseRequest(req)
+
+	provider.prepareTC3Request(ctx, req, key, schemas.ChatCompletionStreamRequest, jsonBody)
+	req.Header.Set("Accept", "text/event-stream")
+
+	if err := provider.client.Do(req, resp); err != nil {
+		defer providerUtils.ReleaseStreamingResponse(resp)
+		if errors.Is(err, context.Canceled) {
+			return nil, providerUtils.EnrichError(ctx, &schemas.BifrostError{
+				IsBifrostError: false,
+				Error: &schemas.ErrorField{
+					Type:    schemas.Ptr(schemas.RequestCancelled),
+					Message: schemas.ErrRequestCancelled,
+					Error:   err,
+				},
+			}, jsonBody, nil, sendBackRawRequest, sendBackRawResponse)
+		}
+		if errors.Is(err, fasthttp.ErrTimeout) || errors.Is(err, context.DeadlineExceeded) {
+			return nil, providerUtils.EnrichError(ctx, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestTimedOut, err, providerName), jsonBody, nil, sendBackRawRequest, sendBackRawResponse)
+		}
+		return nil, providerUtils.EnrichError(ctx, providerUtils.NewBifrostOperationError(schemas.ErrProviderDoRequest, err, providerName), jsonBody, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	// Extract provider response headers before status check so error responses also forward them
+	ctx.SetValue(schemas.BifrostContextKeyProviderResponseHeaders, providerUtils.ExtractProviderResponseHeaders(resp))
+
+	// Errors are returned as a regular JSON response instead of an event stream
+	if resp.StatusCode() != fasthttp.StatusOK || !strings.HasPrefix(string(resp.Header.ContentType()), "text/event-stream") {
+		defer providerUtils.ReleaseStreamingResponse(resp)
+		body, err := providerUtils.CheckAndDecodeBody(resp)
+		if err != nil {
+			return nil, providerUtils.EnrichError(ctx, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName), jsonBody, nil, sendBackRawRequest, sendBackRawResponse)
+		}
+		meta := &providerUtils.RequestMetadata{Provider: providerName, Model: request.Model, RequestType: schemas.ChatCompletionStreamRequest}
+		streamErr := parseTC3Error(resp.StatusCode(), body, meta)
+		if streamErr == nil {
+			streamErr = providerUtils.NewBifrostOperationError("unexpected non-stream response from hunyuan", nil, providerName)
+		}
+		return nil, providerUtils.EnrichError(ctx, streamErr, jsonBody, body, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	responseChan := make(chan *schemas.BifrostStreamChunk, schemas.DefaultStreamBufferSize)
+
+	go func() {
+		defer func() {
+			if ctx.Err() == context.Canceled {
+				providerUtils.HandleStreamCancellation(ctx, postHookRunner, responseChan, providerName, request.Model, schemas.ChatCompletionStreamRequest, provider.logger)
+			} else if ctx.Err() == context.DeadlineExceeded {
+				providerUtils.HandleStreamTimeout(ctx, postHookRunner, responseChan, providerName, request.Model, schemas.ChatCompletionStreamRequest, provider.logger)
+			}
+			close(responseChan)
+		}()
+		defer providerUtils.ReleaseStreamingResponse(resp)
+		// Decompress gzip-encoded streams transparently (no-op for non-gzip)
+		reader, releaseGzip := providerUtils.DecompressStreamBody(resp)
+		defer releaseGzip()
+
+		// Close the raw network stream on ctx cancellation to unblock in-progress reads
+		stopCancellation := providerUtils.SetupStreamCancellation(ctx, resp.BodyStream(), provider.logger)
+		defer stopCancellation()
+
+		scanner := providerUtils.NewSSEScanner(reader)
+		chunkIndex := 0
+		startTime := time.Now()
+		lastChunkTime := startTime
+
+		for scanner.Scan() {
+			// If context was cancelled/timed out, let defer handle it
+			if ctx.Err() != nil {
+				return
+			}
+			line := scanner.Text()
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			eventData := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if eventData == "" || eventData == "[DONE]" {
+				continue
+			}
+
+			var chunk HunyuanChatResponse
+			if err := sonic.Unmarshal([]byte(eventData), &chunk); err != nil {
+				provider.logger.Warn("Failed to parse hunyuan stream chunk: %v", err)
+				continue
+			}
+			if chunk.Error != nil {
+				ctx.SetValue(schemas.BifrostContextKeyStreamEndIndicator, true)
+				providerUtils.ProcessAndSendBifrostError(ctx, postHookRunner, chunk.Error.toBifrostError(&providerUtils.RequestMetadata{
+					Provider:    providerName,
+					Model:       request.Model,
+					RequestType: schemas.ChatCompletionStreamRequest,
+				}), responseChan, provider.logger)
+				return
+			}
+
+			response := chunk.ToBifrostChatStreamResponse(request.Model)
+			isLastChunk := false
+			for _, choice := range response.Choices {
+				if choice.FinishReason != nil {
+					isLastChunk = true
+				}
+			}
+			if isLastChunk {
+				response.Usage = chunk.Usage.toBifrostUsage()
+			}
+			response.ExtraFields = schemas.BifrostResponseExtraFields{
+				RequestType:    schemas.ChatCompletionStreamRequest,
+				Provider:       providerName,
+				ModelRequested: request.Model,
+				ChunkIndex:     chunkIndex,
+				Latency:        time.Since(lastChunkTime).Milliseconds(),
+			}
+			lastChunkTime = time.Now()
+			chunkIndex++
+
+			if sendBackRawResponse {
+				response.ExtraFields.RawResponse = eventData
+			}
+
+			if isLastChunk {
+				if sendBackRawRequest {
+					providerUtils.ParseAndSetRawRequest(&response.ExtraFields, jsonBody)
+				}
+				response.ExtraFields.Latency = time.Since(startTime).Milliseconds()
+				ctx.SetValue(schemas.BifrostContextKeyStreamEndIndicator, true)
+				providerUtils.ProcessAndSendResponse(ctx, postHookRunner, providerUtils.GetBifrostResponseForStreamResponse(nil, response, nil, nil, nil, nil), responseChan)
+				return
+			}
+			providerUtils.ProcessAndSendResponse(ctx, postHookRunner, providerUtils.GetBifrostResponseForStreamResponse(nil, response, nil, nil, nil, nil), responseChan)
+		}
+
+		if err := scanner.Err(); err != nil {
+			// If context was cancelled/timed out, let defer handle it
+			if ctx.Err() != nil {
+				return
+			}
+			ctx.SetValue(schemas.BifrostContextKeyStreamEndIndicator, true)
+			provider.logger.Warn("Error reading stream: %v", err)
+			providerUtils.ProcessAndSendError(ctx, postHookRunner, err, responseChan, schemas.ChatCompletionStreamRequest, providerName, request.Model, provider.logger)
+		}
+	}()
+
+	return responseChan, nil
+}
+
+// tc3Embedding performs a GetEmbedding request with a signed key.
+func (provider *HunyuanProvider) tc3Embedding(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
+	jsonBody, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToHunyuanEmbeddingRequest(request)
+		},
+		provider.GetProviderKey())
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	responseBody, latency, providerResponseHeaders, bifrostErr := provider.completeTC3Request(ctx, key, jsonBody, &providerUtils.RequestMetadata{
+		Provider:    provider.GetProviderKey(),
+		Model:       request.Model,
+		RequestType: schemas.EmbeddingRequest,
+	})
+	if providerResponseHeaders != nil {
+		ctx.SetValue(schemas.BifrostContextKeyProviderResponseHeaders, providerResponseHeaders)
+	}
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonBody, responseBody, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	var response hunyuanEnvelope[HunyuanEmbeddingResponse]
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, &response, jsonBody, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonBody, responseBody, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	bifrostResponse := response.Response.ToBifrostEmbeddingResponse(request.Model)
+	bifrostResponse.ExtraFields.Provider = provider.GetProviderKey()
+	bifrostResponse.ExtraFields.ModelRequested = request.Model
+	bifrostResponse.ExtraFields.RequestType = schemas.EmbeddingRequest
+	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
+	bifrostResponse.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		bifrostResponse.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		bifrostResponse.ExtraFields.RawResponse = rawResponse
+	}
+	return bifrostResponse, nil
+}
+
+// tc3ImageGeneration performs a TextToImageLite request with a signed key.
+func (provider *HunyuanProvider) tc3ImageGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageGenerationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
+	rspImgType := "url"
+	jsonBody, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			reqBody, err := ToHunyuanImageRequest(request)
+			if err != nil {
+				return nil, err
+			}
+			rspImgType = reqBody.RspImgType
+			return reqBody, nil
+		},
+		provider.GetProviderKey())
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	responseBody, latency, providerResponseHeaders, bifrostErr := provider.completeTC3Request(ctx, key, jsonBody, &providerUtils.RequestMetadata{
+		Provider:    provider.GetProviderKey(),
+		Model:       request.Model,
+		RequestType: schemas.ImageGenerationRequest,
+	})
+	if providerResponseHeaders != nil {
+		ctx.SetValue(schemas.BifrostContextKeyProviderResponseHeaders, providerResponseHeaders)
+	}
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonBody, responseBody, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	var response hunyuanEnvelope[HunyuanImageResponse]
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, &response, jsonBody, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonBody, responseBody, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	bifrostResponse := response.Response.ToBifrostImageGenerationResponse(request.Model, rspImgType)
+	bifrostResponse.ExtraFields.Provider = provider.GetProviderKey()
+	bifrostResponse.ExtraFields.ModelRequested = request.Model
+	bifrostResponse.ExtraFields.RequestType = schemas.ImageGenerationRequest
+	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
+	bifrostResponse.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		bifrostResponse.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		bifrostResponse.ExtraFields.RawResponse = rawResponse
+	}
+	return bifrostResponse, nil
+}
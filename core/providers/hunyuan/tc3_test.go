@@ -0,0 +1,135 @@
+package hunyuan
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+func newTestHunyuanProvider(t *testing.T, tc3URL string) *HunyuanProvider {
+	t.Helper()
+	provider, err := NewHunyuanProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{DefaultRequestTimeoutInSeconds: 10},
+	}, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewHunyuanProvider() error = %v", err)
+	}
+	provider.tc3Endpoint = tc3URL
+	return provider
+}
+
+func signedTestKey() schemas.Key {
+	return schemas.Key{HunyuanKeyConfig: &schemas.HunyuanKeyConfig{
+		SecretID:  *schemas.NewEnvVar("AKIDEXAMPLE"),
+		SecretKey: *schemas.NewEnvVar("secret"),
+	}}
+}
+
+// TestTC3ChatCompletion checks that signed keys call the Tencent Cloud API with a signature
+// over the exact request body, and that the native response is converted.
+func TestTC3ChatCompletion(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get("X-TC-Timestamp"), 10, 64)
+		want := tc3Authorization("AKIDEXAMPLE", "secret", tc3Service, r.Host, body, time.Unix(timestamp, 0))
+		if got := r.Header.Get("Authorization"); got != want {
+			t.Errorf("signature does not cover the request body:\n got: %s\nwant: %s", got, want)
+		}
+		if got := r.Header.Get("X-TC-Action"); got != "ChatCompletions" {
+			t.Errorf("expected X-TC-Action ChatCompletions, got %s", got)
+		}
+		if !strings.Contains(string(body), `"Messages"`) || !strings.Contains(string(body), `"Content": "hello"`) {
+			t.Errorf("expected a native ChatCompletions body, got %s", body)
+		}
+		fmt.Fprint(w, `{"Response":{"Id":"chat-1","Created":1700000000,"Choices":[{"FinishReason":"stop","Message":{"Role":"assistant","Content":"hi"}}],"Usage":{"PromptTokens":3,"CompletionTokens":1,"TotalTokens":4},"RequestId":"req-1"}}`)
+	}))
+	defer server.Close()
+
+	provider := newTestHunyuanProvider(t, server.URL)
+	ctx := schemas.NewBifrostContext(context.Background(), time.Now().Add(10*time.Second))
+	response, bifrostErr := provider.ChatCompletion(ctx, signedTestKey(), &schemas.BifrostChatRequest{
+		Model: "hunyuan-turbos-latest",
+		Input: []schemas.ChatMessage{{
+			Role:    schemas.ChatMessageRoleUser,
+			Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("hello")},
+		}},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("ChatCompletion() error = %v", bifrostErr.Error.Message)
+	}
+	if response.ID != "chat-1" || len(response.Choices) != 1 {
+		t.Fatalf("unexpected response: %+v", response)
+	}
+	if got := *response.Choices[0].Message.Content.ContentStr; got != "hi" {
+		t.Errorf("expected content hi, got %s", got)
+	}
+	if response.Usage == nil || response.Usage.TotalTokens != 4 {
+		t.Errorf("expected 4 total tokens, got %+v", response.Usage)
+	}
+}
+
+// TestTC3ErrorEnvelope checks that errors returned with HTTP 200 inside the response envelope are surfaced.
+func TestTC3ErrorEnvelope(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"Response":{"Error":{"Code":"AuthFailure.SignatureFailure","Message":"The provided credentials could not be validated."},"RequestId":"req-1"}}`)
+	}))
+	defer server.Close()
+
+	provider := newTestHunyuanProvider(t, server.URL)
+	ctx := schemas.NewBifrostContext(context.Background(), time.Now().Add(10*time.Second))
+	_, bifrostErr := provider.Embedding(ctx, signedTestKey(), &schemas.BifrostEmbeddingRequest{
+		Model: "hunyuan-embedding",
+		Input: &schemas.EmbeddingInput{Text: schemas.Ptr("hello")},
+	})
+	if bifrostErr == nil {
+		t.Fatal("expected an error")
+	}
+	if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected status 401, got %v", bifrostErr.StatusCode)
+	}
+	if bifrostErr.Error.Code == nil || *bifrostErr.Error.Code != "AuthFailure.SignatureFailure" {
+		t.Errorf("expected error code AuthFailure.SignatureFailure, got %v", bifrostErr.Error.Code)
+	}
+}
+
+func TestToHunyuanImageRequest(t *testing.T) {
+	req, err := ToHunyuanImageRequest(&schemas.BifrostImageGenerationRequest{
+		Model:  "hunyuan-image",
+		Input:  &schemas.ImageGenerationInput{Prompt: "a cat"},
+		Params: &schemas.ImageGenerationParameters{Size: schemas.Ptr("1024x768")},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if req.Resolution == nil || *req.Resolution != "1024:768" {
+		t.Errorf("expected resolution 1024:768, got %v", req.Resolution)
+	}
+	if req.RspImgType != "url" {
+		t.Errorf("expected url response type, got %s", req.RspImgType)
+	}
+}
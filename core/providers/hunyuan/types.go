@@ -0,0 +1,159 @@
+package hunyuan
+
+// Request and response shapes of the Tencent Cloud API (hunyuan.tencentcloudapi.com, version 2023-09-01),
+// which is used for keys configured with SecretId/SecretKey signature credentials.
+
+// HunyuanChatRequest is the ChatCompletions action request.
+type HunyuanChatRequest struct {
+	Model       string                 `json:"Model"`
+	Messages    []HunyuanMessage       `json:"Messages"`
+	Stream      bool                   `json:"Stream,omitempty"`
+	Temperature *float64               `json:"Temperature,omitempty"`
+	TopP        *float64               `json:"TopP,omitempty"`
+	Seed        *int                   `json:"Seed,omitempty"`
+	Stop        []string               `json:"Stop,omitempty"`
+	Tools       []HunyuanTool          `json:"Tools,omitempty"`
+	ToolChoice  *string                `json:"ToolChoice,omitempty"` // "none", "auto" or "custom"
+	CustomTool  *HunyuanTool           `json:"CustomTool,omitempty"` // Tool to call when ToolChoice is "custom"
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface.
+func (r *HunyuanChatRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+// HunyuanMessage is a single chat message.
+type HunyuanMessage struct {
+	Role       string            `json:"Role"`
+	Content    string            `json:"Content,omitempty"`
+	Contents   []HunyuanContent  `json:"Contents,omitempty"` // Multimodal content, mutually exclusive with Content
+	ToolCallID string            `json:"ToolCallId,omitempty"`
+	ToolCalls  []HunyuanToolCall `json:"ToolCalls,omitempty"`
+}
+
+// HunyuanContent is a multimodal content part.
+type HunyuanContent struct {
+	Type     string           `json:"Type"` // "text" or "image_url"
+	Text     string           `json:"Text,omitempty"`
+	ImageURL *HunyuanImageURL `json:"ImageUrl,omitempty"`
+}
+
+// HunyuanImageURL references an image in a multimodal content part.
+type HunyuanImageURL struct {
+	URL string `json:"Url"`
+}
+
+// HunyuanTool is a tool definition.
+type HunyuanTool struct {
+	Type     string              `json:"Type"`
+	Function HunyuanToolFunction `json:"Function"`
+}
+
+// HunyuanToolFunction describes a function tool; Parameters is the JSON schema as a string.
+type HunyuanToolFunction struct {
+	Name        string `json:"Name"`
+	Parameters  string `json:"Parameters,omitempty"`
+	Description string `json:"Description,omitempty"`
+}
+
+// HunyuanToolCall is a tool call made by the model.
+type HunyuanToolCall struct {
+	ID       string                  `json:"Id"`
+	Type     string                  `json:"Type"`
+	Function HunyuanToolCallFunction `json:"Function"`
+	Index    *int                    `json:"Index,omitempty"`
+}
+
+// HunyuanToolCallFunction is the function invoked by a tool call.
+type HunyuanToolCallFunction struct {
+	Name      string `json:"Name"`
+	Arguments string `json:"Arguments"`
+}
+
+// HunyuanUsage is the token usage of a request.
+type HunyuanUsage struct {
+	PromptTokens     int `json:"PromptTokens"`
+	CompletionTokens int `json:"CompletionTokens"`
+	TotalTokens      int `json:"TotalTokens"`
+}
+
+// HunyuanChatChoice is a chat completion choice. Message is set on non-streaming
+// responses and Delta on stream chunks.
+type HunyuanChatChoice struct {
+	Index        int             `json:"Index"`
+	FinishReason string          `json:"FinishReason"`
+	Message      *HunyuanMessage `json:"Message,omitempty"`
+	Delta        *HunyuanMessage `json:"Delta,omitempty"`
+}
+
+// HunyuanChatResponse is the ChatCompletions action response. Stream chunks share this shape
+// but are sent bare, without the "Response" envelope.
+type HunyuanChatResponse struct {
+	ID        string              `json:"Id"`
+	Created   int                 `json:"Created"`
+	Choices   []HunyuanChatChoice `json:"Choices"`
+	Usage     *HunyuanUsage       `json:"Usage,omitempty"`
+	RequestID string              `json:"RequestId"`
+	Error     *HunyuanError       `json:"Error,omitempty"`
+}
+
+// HunyuanEmbeddingRequest is the GetEmbedding action request.
+type HunyuanEmbeddingRequest struct {
+	Input       string                 `json:"Input,omitempty"`
+	InputList   []string               `json:"InputList,omitempty"`
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface.
+func (r *HunyuanEmbeddingRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+// HunyuanEmbeddingData is a single embedding vector.
+type HunyuanEmbeddingData struct {
+	Embedding []float32 `json:"Embedding"`
+	Index     int       `json:"Index"`
+}
+
+// HunyuanEmbeddingResponse is the GetEmbedding action response.
+type HunyuanEmbeddingResponse struct {
+	Data      []HunyuanEmbeddingData `json:"Data"`
+	Usage     *HunyuanUsage          `json:"Usage,omitempty"`
+	RequestID string                 `json:"RequestId"`
+	Error     *HunyuanError          `json:"Error,omitempty"`
+}
+
+// HunyuanImageRequest is the TextToImageLite action request.
+type HunyuanImageRequest struct {
+	Prompt         string                 `json:"Prompt"`
+	NegativePrompt *string                `json:"NegativePrompt,omitempty"`
+	Style          *string                `json:"Style,omitempty"`
+	Resolution     *string                `json:"Resolution,omitempty"` // "width:height", e.g. "1024:1024"
+	RspImgType     string                 `json:"RspImgType"`           // "url" or "base64"
+	ExtraParams    map[string]interface{} `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface.
+func (r *HunyuanImageRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+// HunyuanImageResponse is the TextToImageLite action response.
+type HunyuanImageResponse struct {
+	ResultImage string        `json:"ResultImage"`
+	RequestID   string        `json:"RequestId"`
+	Error       *HunyuanError `json:"Error,omitempty"`
+}
+
+// HunyuanError is the error returned by the Tencent Cloud API, which responds with
+// HTTP 200 and the error inside the response envelope.
+type HunyuanError struct {
+	Code    string `json:"Code"`
+	Message string `json:"Message"`
+}
+
+// hunyuanEnvelope wraps every non-streaming Tencent Cloud API response.
+type hunyuanEnvelope[T any] struct {
+	Response T `json:"Response"`
+}
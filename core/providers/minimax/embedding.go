@@ -0,0 +1,72 @@
+package minimax
+
+import (
+	"fmt"
+	"maps"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// ToMinimaxEmbeddingRequest converts a Bifrost embedding request to the MiniMax format.
+// The embedding type is read from the "type" extra param and defaults to "db".
+func ToMinimaxEmbeddingRequest(bifrostReq *schemas.BifrostEmbeddingRequest) (*MinimaxEmbeddingRequest, error) {
+	if bifrostReq == nil || bifrostReq.Input == nil {
+		return nil, fmt.Errorf("input is required")
+	}
+	var texts []string
+	switch {
+	case bifrostReq.Input.Text != nil:
+		texts = []string{*bifrostReq.Input.Text}
+	case len(bifrostReq.Input.Texts) > 0:
+		texts = bifrostReq.Input.Texts
+	default:
+		return nil, fmt.Errorf("minimax embeddings only support text input")
+	}
+
+	req := &MinimaxEmbeddingRequest{
+		Model: bifrostReq.Model,
+		Texts: texts,
+		Type:  MinimaxEmbeddingTypeDB,
+	}
+
+	if bifrostReq.Params != nil && bifrostReq.Params.ExtraParams != nil {
+		// Copy so that the request's params survive retries and fallbacks unchanged
+		req.ExtraParams = maps.Clone(bifrostReq.Params.ExtraParams)
+		if embeddingType, ok := schemas.SafeExtractString(req.ExtraParams["type"]); ok {
+			if embeddingType != MinimaxEmbeddingTypeDB && embeddingType != MinimaxEmbeddingTypeQuery {
+				return nil, fmt.Errorf("unsupported embedding type %q (expected db or query)", embeddingType)
+			}
+			delete(req.ExtraParams, "type")
+			req.Type = embeddingType
+		}
+	}
+
+	return req, nil
+}
+
+// ToBifrostEmbeddingResponse converts a MiniMax embedding response to Bifrost format.
+// MiniMax only reports a total token count, which is reported as prompt tokens.
+func (response *MinimaxEmbeddingResponse) ToBifrostEmbeddingResponse(model string) *schemas.BifrostEmbeddingResponse {
+	if response == nil {
+		return nil
+	}
+
+	data := make([]schemas.EmbeddingData, 0, len(response.Vectors))
+	for i, vector := range response.Vectors {
+		data = append(data, schemas.EmbeddingData{
+			Index:     i,
+			Object:    "embedding",
+			Embedding: schemas.EmbeddingStruct{EmbeddingArray: vector},
+		})
+	}
+
+	return &schemas.BifrostEmbeddingResponse{
+		Data:   data,
+		Model:  model,
+		Object: "list",
+		Usage: &schemas.BifrostLLMUsage{
+			PromptTokens: response.TotalTokens,
+			TotalTokens:  response.TotalTokens,
+		},
+	}
+}
@@ -0,0 +1,103 @@
+package minimax
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestToMinimaxEmbeddingRequest(t *testing.T) {
+	extraParams := map[string]interface{}{"type": "query", "other": true}
+	req, err := ToMinimaxEmbeddingRequest(&schemas.BifrostEmbeddingRequest{
+		Model:  "embo-01",
+		Input:  &schemas.EmbeddingInput{Texts: []string{"first", "second"}},
+		Params: &schemas.EmbeddingParameters{ExtraParams: extraParams},
+	})
+	if err != nil {
+		t.Fatalf("ToMinimaxEmbeddingRequest() error = %v", err)
+	}
+	if req.Type != MinimaxEmbeddingTypeQuery || len(req.Texts) != 2 {
+		t.Errorf("request = %+v, want type query and two texts", req)
+	}
+	if _, ok := req.ExtraParams["type"]; ok {
+		t.Error("type should not be forwarded as an extra param")
+	}
+	if _, ok := extraParams["type"]; !ok {
+		t.Error("the caller's extra params should be left untouched")
+	}
+
+	defaults, err := ToMinimaxEmbeddingRequest(&schemas.BifrostEmbeddingRequest{Model: "embo-01", Input: &schemas.EmbeddingInput{Text: schemas.Ptr("hello")}})
+	if err != nil || defaults.Type != MinimaxEmbeddingTypeDB || len(defaults.Texts) != 1 {
+		t.Errorf("defaults = %+v, %v; want type db", defaults, err)
+	}
+
+	if _, err := ToMinimaxEmbeddingRequest(&schemas.BifrostEmbeddingRequest{Model: "embo-01", Input: &schemas.EmbeddingInput{Text: schemas.Ptr("hello")}, Params: &schemas.EmbeddingParameters{ExtraParams: map[string]interface{}{"type": "passage"}}}); err == nil {
+		t.Error("expected error for an unknown embedding type")
+	}
+	if _, err := ToMinimaxEmbeddingRequest(&schemas.BifrostEmbeddingRequest{Model: "embo-01", Input: &schemas.EmbeddingInput{Embedding: []int{1, 2}}}); err == nil {
+		t.Error("expected error for token input")
+	}
+}
+
+func TestMinimaxEmbedding(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != minimaxPathEmbeddings {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		var body MinimaxEmbeddingRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if body.Texts[0] == "fail" {
+			w.Write([]byte(`{"vectors":null,"base_resp":{"status_code":1004,"status_msg":"login fail"}}`))
+			return
+		}
+		if body.Type != MinimaxEmbeddingTypeQuery {
+			t.Errorf("type = %q, want query", body.Type)
+		}
+		w.Write([]byte(`{"vectors":[[0.1,0.2],[0.3,0.4]],"total_tokens":7,"base_resp":{"status_code":0,"status_msg":"success"}}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewMinimaxProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			BaseURL:                        server.URL,
+			DefaultRequestTimeoutInSeconds: 10,
+		},
+	}, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewMinimaxProvider() error = %v", err)
+	}
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	key := schemas.Key{Value: *schemas.NewEnvVar("test-key")}
+
+	resp, bifrostErr := provider.Embedding(ctx, key, &schemas.BifrostEmbeddingRequest{
+		Provider: schemas.Minimax,
+		Model:    "embo-01",
+		Input:    &schemas.EmbeddingInput{Texts: []string{"first", "second"}},
+		Params:   &schemas.EmbeddingParameters{ExtraParams: map[string]interface{}{"type": "query"}},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("Embedding() error = %v", bifrostErr.Error)
+	}
+	if len(resp.Data) != 2 || resp.Data[1].Index != 1 || resp.Data[1].Embedding.EmbeddingArray[0] != 0.3 {
+		t.Errorf("data = %+v", resp.Data)
+	}
+	if resp.Usage == nil || resp.Usage.PromptTokens != 7 || resp.ExtraFields.RequestType != schemas.EmbeddingRequest {
+		t.Errorf("usage = %+v, extra fields = %+v", resp.Usage, resp.ExtraFields)
+	}
+
+	_, bifrostErr = provider.Embedding(ctx, key, &schemas.BifrostEmbeddingRequest{
+		Provider: schemas.Minimax,
+		Model:    "embo-01",
+		Input:    &schemas.EmbeddingInput{Text: schemas.Ptr("fail")},
+	})
+	if bifrostErr == nil || bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("Embedding() error = %+v, want a 401 from base_resp", bifrostErr)
+	}
+}
@@ -3,6 +3,7 @@
 package minimax
 
 import (
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
@@ -15,14 +16,45 @@ import (
 	"github.com/valyala/fasthttp"
 )
 
+// minimaxBaseURLs are MiniMax's endpoints; international (api.minimax.io) is the default.
+var minimaxBaseURLs = []providerUtils.RegionalBaseURL{
+	{Region: schemas.EndpointRegionInternational, BaseURL: "https://api.minimax.io"},
+	{Region: schemas.EndpointRegionChina, BaseURL: "https://api.minimaxi.com"},
+}
+
+// MiniMax native video, music and embedding endpoints.
+const (
+	minimaxPathVideoGeneration = "/v1/video_generation"
+	minimaxPathVideoQuery      = "/v1/query/video_generation"
+	minimaxPathFileRetrieve    = "/v1/files/retrieve"
+	minimaxPathMusicGeneration = "/v1/music_generation"
+	minimaxPathEmbeddings      = "/v1/embeddings"
+)
+
 // MinimaxProvider implements the Provider interface for Minimax's API.
 type MinimaxProvider struct {
-	logger              schemas.Logger        // Logger for provider operations
-	client              *fasthttp.Client      // HTTP client for API requests
-	baseOrigin          string                // API origin used for anthropic-compatible text generation endpoints
-	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
-	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
-	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
+	logger              schemas.Logger                         // Logger for provider operations
+	client              *fasthttp.Client                       // HTTP client for API requests
+	networkConfig       schemas.NetworkConfig                  // Network configuration including extra headers
+	baseURLs            *providerUtils.RegionalBaseURLResolver // Picks the international or China endpoint per key
+	sendBackRawRequest  bool                                   // Whether to include raw request in BifrostResponse
+	sendBackRawResponse bool                                   // Whether to include raw response in BifrostResponse
+}
+
+// Compile-time checks for the capabilities MinimaxProvider implements.
+var (
+	_ schemas.ListModelsProvider      = (*MinimaxProvider)(nil)
+	_ schemas.TextCompletionProvider  = (*MinimaxProvider)(nil)
+	_ schemas.ChatProvider            = (*MinimaxProvider)(nil)
+	_ schemas.ResponsesProvider       = (*MinimaxProvider)(nil)
+	_ schemas.EmbeddingProvider       = (*MinimaxProvider)(nil)
+	_ schemas.ImageGenerationProvider = (*MinimaxProvider)(nil)
+	_ schemas.MusicGenerationProvider = (*MinimaxProvider)(nil)
+	_ schemas.VideoProvider           = (*MinimaxProvider)(nil)
+)
+
+func init() {
+	schemas.RegisterProviderCapabilities(schemas.Minimax, schemas.CapabilitiesOf((*MinimaxProvider)(nil)))
 }
 
 // NewMinimaxProvider creates a new Minimax provider instance.
@@ -42,22 +74,16 @@ func NewMinimaxProvider(config *schemas.ProviderConfig, logger schemas.Logger) (
 	// Configure proxy and retry policy
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
 	client = providerUtils.ConfigureDialer(client)
-	// Set default BaseURL if not provided
-	if config.NetworkConfig.BaseURL == "" {
-		config.NetworkConfig.BaseURL = "https://api.minimax.io"
-	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
-
-	baseOrigin := config.NetworkConfig.BaseURL
-	if parsed, err := url.Parse(config.NetworkConfig.BaseURL); err == nil && parsed.Scheme != "" && parsed.Host != "" {
-		baseOrigin = parsed.Scheme + "://" + parsed.Host
+	baseURLs, err := providerUtils.NewRegionalBaseURLResolver(schemas.Minimax, client, config.NetworkConfig, minimaxBaseURLs, "/v1/models", logger)
+	if err != nil {
+		return nil, err
 	}
 
 	return &MinimaxProvider{
 		logger:              logger,
 		client:              client,
-		baseOrigin:          baseOrigin,
 		networkConfig:       config.NetworkConfig,
+		baseURLs:            baseURLs,
 		sendBackRawRequest:  config.SendBackRawRequest,
 		sendBackRawResponse: config.SendBackRawResponse,
 	}, nil
@@ -79,8 +105,51 @@ func parseMinimaxAnthropicError(resp *fasthttp.Response, requestType schemas.Req
 	return bifrostErr
 }
 
-func (provider *MinimaxProvider) buildTextGenerationURL(ctx *schemas.BifrostContext) string {
-	return provider.baseOrigin + providerUtils.GetPathFromContext(ctx, "/text/v1/messages")
+// buildTextGenerationURL returns the anthropic-compatible text generation URL, which lives at the API origin.
+func (provider *MinimaxProvider) buildTextGenerationURL(ctx *schemas.BifrostContext, key schemas.Key) string {
+	baseOrigin := provider.baseURLs.BaseURL(ctx, key)
+	if parsed, err := url.Parse(baseOrigin); err == nil && parsed.Scheme != "" && parsed.Host != "" {
+		baseOrigin = parsed.Scheme + "://" + parsed.Host
+	}
+	return baseOrigin + providerUtils.GetPathFromContext(ctx, "/text/v1/messages")
+}
+
+// doRequest sends a request to MiniMax's native API and returns the response body. path is not subject to
+// the per-request path override, so callers apply it where it makes sense. MiniMax reports most failures
+// with HTTP 200, so callers must also check the base_resp of the decoded body.
+func (provider *MinimaxProvider) doRequest(ctx *schemas.BifrostContext, key schemas.Key, method, path string, jsonData []byte, requestType schemas.RequestType, model string) ([]byte, time.Duration, map[string]string, *schemas.BifrostError) {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + path)
+	req.Header.SetMethod(method)
+	if key.Value.GetValue() != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
+	}
+	if jsonData != nil {
+		req.Header.SetContentType("application/json")
+		req.SetBody(jsonData)
+	}
+
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
+	if bifrostErr != nil {
+		return nil, latency, nil, bifrostErr
+	}
+	// Extract provider response headers before the status check so error responses also forward them
+	providerResponseHeaders := providerUtils.ExtractProviderResponseHeaders(resp)
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return nil, latency, providerResponseHeaders, openai.ParseOpenAIError(resp, requestType, provider.GetProviderKey(), model)
+	}
+
+	body, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, latency, providerResponseHeaders, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
+	}
+	// Copy the body since resp is released on return
+	return append([]byte(nil), body...), latency, providerResponseHeaders, nil
 }
 
 func (provider *MinimaxProvider) extractTextFromChatResponse(chatResp *schemas.BifrostChatResponse, requestType schemas.RequestType) *schemas.BifrostTextCompletionResponse {
@@ -148,18 +217,26 @@ func (provider *MinimaxProvider) GetProviderKey() schemas.ModelProvider {
 	return schemas.Minimax
 }
 
+// HTTPClient returns the pooled client used for Minimax API requests.
+func (provider *MinimaxProvider) HTTPClient() *fasthttp.Client {
+	return provider.client
+}
+
 // ListModels performs a list models request to Minimax's API.
 func (provider *MinimaxProvider) ListModels(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
-	return openai.HandleOpenAIListModelsRequest(
+	path := providerUtils.GetPathFromContext(ctx, "/v1/models")
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	if len(keys) == 0 {
+		return openai.ListModelsByKey(ctx, provider.client, provider.baseURLs.DefaultBaseURL()+path, schemas.Key{}, request.Unfiltered, provider.networkConfig.ExtraHeaders, schemas.Minimax, sendBackRawRequest, sendBackRawResponse)
+	}
+	return providerUtils.HandleMultipleListModelsRequests(
 		ctx,
-		provider.client,
-		request,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/models"),
 		keys,
-		provider.networkConfig.ExtraHeaders,
-		schemas.Minimax,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		request,
+		func(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
+			return openai.ListModelsByKey(ctx, provider.client, provider.baseURLs.BaseURL(ctx, key)+path, key, request.Unfiltered, provider.networkConfig.ExtraHeaders, schemas.Minimax, sendBackRawRequest, sendBackRawResponse)
+		},
 	)
 }
 
@@ -194,7 +271,7 @@ func (provider *MinimaxProvider) TextCompletion(ctx *schemas.BifrostContext, key
 	defer fasthttp.ReleaseResponse(resp)
 
 	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-	req.SetRequestURI(provider.buildTextGenerationURL(ctx))
+	req.SetRequestURI(provider.buildTextGenerationURL(ctx, key))
 	req.Header.SetMethod(http.MethodPost)
 	req.Header.SetContentType("application/json")
 	req.Header.Set("anthropic-version", "2023-06-01")
@@ -300,7 +377,7 @@ func (provider *MinimaxProvider) TextCompletionStream(ctx *schemas.BifrostContex
 	chatStream, streamErr := anthropic.HandleAnthropicChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.buildTextGenerationURL(ctx),
+		provider.buildTextGenerationURL(ctx, key),
 		jsonData,
 		headers,
 		provider.networkConfig.ExtraHeaders,
@@ -352,7 +429,7 @@ func (provider *MinimaxProvider) ChatCompletion(ctx *schemas.BifrostContext, key
 	return openai.HandleOpenAIChatCompletionRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/chat/completions"),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, "/v1/chat/completions"),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -378,7 +455,7 @@ func (provider *MinimaxProvider) ChatCompletionStream(ctx *schemas.BifrostContex
 	return openai.HandleOpenAIChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/chat/completions"),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, "/v1/chat/completions"),
 		request,
 		authHeader,
 		provider.networkConfig.ExtraHeaders,
@@ -421,42 +498,12 @@ func (provider *MinimaxProvider) ResponsesStream(ctx *schemas.BifrostContext, po
 	)
 }
 
-// Embedding is not supported by the Minimax provider.
-func (provider *MinimaxProvider) Embedding(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.EmbeddingRequest, provider.GetProviderKey())
-}
-
-// Speech is not supported by the Minimax provider.
-func (provider *MinimaxProvider) Speech(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechRequest, provider.GetProviderKey())
-}
-
-// SpeechStream is not supported by the Minimax provider.
-func (provider *MinimaxProvider) SpeechStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechStreamRequest, provider.GetProviderKey())
-}
-
-// Transcription is not supported by the Minimax provider.
-func (provider *MinimaxProvider) Transcription(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionRequest, provider.GetProviderKey())
-}
-
-// TranscriptionStream is not supported by the Minimax provider.
-func (provider *MinimaxProvider) TranscriptionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionStreamRequest, provider.GetProviderKey())
-}
-
-// Rerank is not supported by the Minimax provider.
-func (provider *MinimaxProvider) Rerank(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRerankRequest) (*schemas.BifrostRerankResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.RerankRequest, provider.GetProviderKey())
-}
-
 // ImageGeneration performs an image generation request to Minimax's image generation endpoint.
 func (provider *MinimaxProvider) ImageGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageGenerationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
 	return openai.HandleOpenAIImageGenerationRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/v1/image_generation"),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, "/v1/image_generation"),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -472,147 +519,319 @@ func (provider *MinimaxProvider) ImageGenerationStream(ctx *schemas.BifrostConte
 	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageGenerationStreamRequest, provider.GetProviderKey())
 }
 
-// ImageEdit is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ImageEdit(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageEditRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditRequest, provider.GetProviderKey())
-}
+// Embedding generates embeddings with MiniMax's embeddings endpoint (embo-01).
+// Set the "type" extra param to "query" when embedding search queries; documents default to "db".
+func (provider *MinimaxProvider) Embedding(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
 
-// ImageEditStream is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ImageEditStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostImageEditRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditStreamRequest, provider.GetProviderKey())
-}
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToMinimaxEmbeddingRequest(request)
+		},
+		providerName)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// ImageVariation is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ImageVariation(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageVariationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageVariationRequest, provider.GetProviderKey())
-}
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-// VideoGeneration is not supported by the Minimax provider.
-func (provider *MinimaxProvider) VideoGeneration(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoGenerationRequest, provider.GetProviderKey())
-}
+	body, latency, providerResponseHeaders, bifrostErr := provider.doRequest(ctx, key, http.MethodPost, providerUtils.GetPathFromContext(ctx, minimaxPathEmbeddings), jsonData, schemas.EmbeddingRequest, request.Model)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
 
-// VideoRetrieve is not supported by the Minimax provider.
-func (provider *MinimaxProvider) VideoRetrieve(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoRetrieveRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRetrieveRequest, provider.GetProviderKey())
-}
+	var embeddingResp MinimaxEmbeddingResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &embeddingResp, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if bifrostErr := embeddingResp.BaseResp.toBifrostError(providerName); bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, body, sendBackRawRequest, sendBackRawResponse)
+	}
 
-// VideoDownload is not supported by the Minimax provider.
-func (provider *MinimaxProvider) VideoDownload(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoDownloadRequest) (*schemas.BifrostVideoDownloadResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoDownloadRequest, provider.GetProviderKey())
-}
+	response := embeddingResp.ToBifrostEmbeddingResponse(request.Model)
+	response.ExtraFields.Provider = providerName
+	response.ExtraFields.ModelRequested = request.Model
+	response.ExtraFields.RequestType = schemas.EmbeddingRequest
+	response.ExtraFields.Latency = latency.Milliseconds()
+	response.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
 
-// VideoDelete is not supported by Minimax provider.
-func (provider *MinimaxProvider) VideoDelete(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoDeleteRequest) (*schemas.BifrostVideoDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoDeleteRequest, provider.GetProviderKey())
+	return response, nil
 }
 
-// VideoList is not supported by Minimax provider.
-func (provider *MinimaxProvider) VideoList(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoListRequest) (*schemas.BifrostVideoListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoListRequest, provider.GetProviderKey())
-}
+// MusicGeneration generates a track with MiniMax's music generation endpoint.
+func (provider *MinimaxProvider) MusicGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostMusicGenerationRequest) (*schemas.BifrostMusicGenerationResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
 
-// VideoRemix is not supported by Minimax provider.
-func (provider *MinimaxProvider) VideoRemix(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoRemixRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRemixRequest, provider.GetProviderKey())
-}
+	var minimaxReq *MinimaxMusicGenerationRequest
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			var err error
+			minimaxReq, err = ToMinimaxMusicGenerationRequest(request)
+			return minimaxReq, err
+		},
+		providerName)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// FileUpload is not supported by Minimax provider.
-func (provider *MinimaxProvider) FileUpload(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileUploadRequest, provider.GetProviderKey())
-}
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-// FileList is not supported by Minimax provider.
-func (provider *MinimaxProvider) FileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileListRequest) (*schemas.BifrostFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileListRequest, provider.GetProviderKey())
-}
+	body, latency, providerResponseHeaders, bifrostErr := provider.doRequest(ctx, key, http.MethodPost, providerUtils.GetPathFromContext(ctx, minimaxPathMusicGeneration), jsonData, schemas.MusicGenerationRequest, request.Model)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
 
-// FileRetrieve is not supported by Minimax provider.
-func (provider *MinimaxProvider) FileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileRetrieveRequest, provider.GetProviderKey())
-}
+	var musicResp MinimaxMusicGenerationResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &musicResp, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if bifrostErr := musicResp.BaseResp.toBifrostError(providerName); bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, body, sendBackRawRequest, sendBackRawResponse)
+	}
 
-// FileDelete is not supported by Minimax provider.
-func (provider *MinimaxProvider) FileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileDeleteRequest) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileDeleteRequest, provider.GetProviderKey())
-}
+	// The request body may come from the raw passthrough body, so fall back to MiniMax's default format
+	audioFormat := "mp3"
+	if minimaxReq != nil && minimaxReq.AudioSetting != nil && minimaxReq.AudioSetting.Format != nil {
+		audioFormat = *minimaxReq.AudioSetting.Format
+	}
+	response, err := musicResp.ToBifrostMusicGenerationResponse(request.Model, audioFormat)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
+	}
+	response.Created = time.Now().Unix()
+	response.ExtraFields.Provider = providerName
+	response.ExtraFields.ModelRequested = request.Model
+	response.ExtraFields.RequestType = schemas.MusicGenerationRequest
+	response.ExtraFields.Latency = latency.Milliseconds()
+	response.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
 
-// FileContent is not supported by Minimax provider.
-func (provider *MinimaxProvider) FileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileContentRequest) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileContentRequest, provider.GetProviderKey())
+	return response, nil
 }
 
-// BatchCreate is not supported by Minimax provider.
-func (provider *MinimaxProvider) BatchCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchCreateRequest, provider.GetProviderKey())
-}
+// VideoGeneration submits a Hailuo video generation task to MiniMax.
+// The returned ID is the async task ID, which VideoRetrieve polls for the result.
+func (provider *MinimaxProvider) VideoGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
 
-// BatchList is not supported by Minimax provider.
-func (provider *MinimaxProvider) BatchList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchListRequest, provider.GetProviderKey())
-}
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToMinimaxVideoGenerationRequest(request)
+		},
+		providerName)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// BatchRetrieve is not supported by Minimax provider.
-func (provider *MinimaxProvider) BatchRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchRetrieveRequest) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchRetrieveRequest, provider.GetProviderKey())
-}
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-// BatchCancel is not supported by Minimax provider.
-func (provider *MinimaxProvider) BatchCancel(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchCancelRequest) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchCancelRequest, provider.GetProviderKey())
-}
+	body, latency, providerResponseHeaders, bifrostErr := provider.doRequest(ctx, key, http.MethodPost, providerUtils.GetPathFromContext(ctx, minimaxPathVideoGeneration), jsonData, schemas.VideoGenerationRequest, request.Model)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
 
-// BatchResults is not supported by Minimax provider.
-func (provider *MinimaxProvider) BatchResults(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchResultsRequest) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchResultsRequest, provider.GetProviderKey())
-}
+	var taskResp MinimaxVideoTaskResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &taskResp, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if bifrostErr := taskResp.BaseResp.toBifrostError(providerName); bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, body, sendBackRawRequest, sendBackRawResponse)
+	}
 
-// CountTokens is not supported by the Minimax provider.
-func (provider *MinimaxProvider) CountTokens(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostResponsesRequest) (*schemas.BifrostCountTokensResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.CountTokensRequest, provider.GetProviderKey())
-}
+	response := &schemas.BifrostVideoGenerationResponse{
+		ID:        providerUtils.AddVideoIDProviderSuffix(taskResp.TaskID, providerName),
+		Model:     request.Model,
+		Object:    "video",
+		CreatedAt: time.Now().Unix(),
+		Status:    schemas.VideoStatusQueued,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			Latency:                 latency.Milliseconds(),
+			Provider:                providerName,
+			ModelRequested:          request.Model,
+			RequestType:             schemas.VideoGenerationRequest,
+			ProviderResponseHeaders: providerResponseHeaders,
+		},
+	}
+	if request.Input != nil {
+		response.Prompt = request.Input.Prompt
+	}
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
 
-// ContainerCreate is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ContainerCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerCreateRequest) (*schemas.BifrostContainerCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerCreateRequest, provider.GetProviderKey())
+	return response, nil
 }
 
-// ContainerList is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ContainerList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerListRequest) (*schemas.BifrostContainerListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerListRequest, provider.GetProviderKey())
-}
+// VideoRetrieve queries the status of a MiniMax video generation task. Once the task succeeds, its
+// file_id is resolved to a download URL through the files endpoint.
+func (provider *MinimaxProvider) VideoRetrieve(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostVideoRetrieveRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	taskID := providerUtils.StripVideoIDProviderSuffix(request.ID, providerName)
 
-// ContainerRetrieve is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ContainerRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerRetrieveRequest) (*schemas.BifrostContainerRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerRetrieveRequest, provider.GetProviderKey())
-}
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	path := providerUtils.GetPathFromContext(ctx, minimaxPathVideoQuery) + "?task_id=" + url.QueryEscape(taskID)
+	body, latency, providerResponseHeaders, bifrostErr := provider.doRequest(ctx, key, http.MethodGet, path, nil, schemas.VideoRetrieveRequest, "")
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, nil, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	var queryResp MinimaxVideoQueryResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &queryResp, nil, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if bifrostErr := queryResp.BaseResp.toBifrostError(providerName); bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, nil, body, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	var downloadURL string
+	if queryResp.Status == MinimaxVideoStatusSuccess && queryResp.FileID != "" {
+		downloadURL, bifrostErr = provider.retrieveFileDownloadURL(ctx, key, queryResp.FileID)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+	}
 
-// ContainerDelete is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ContainerDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerDeleteRequest) (*schemas.BifrostContainerDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerDeleteRequest, provider.GetProviderKey())
+	response := queryResp.ToBifrostVideoGenerationResponse(providerUtils.AddVideoIDProviderSuffix(taskID, providerName), downloadURL)
+	response.ExtraFields.Latency = latency.Milliseconds()
+	response.ExtraFields.Provider = providerName
+	response.ExtraFields.RequestType = schemas.VideoRetrieveRequest
+	response.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
+
+	return response, nil
 }
 
-// ContainerFileCreate is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ContainerFileCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerFileCreateRequest) (*schemas.BifrostContainerFileCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileCreateRequest, provider.GetProviderKey())
+// retrieveFileDownloadURL resolves a MiniMax file_id to its (time-limited) download URL.
+func (provider *MinimaxProvider) retrieveFileDownloadURL(ctx *schemas.BifrostContext, key schemas.Key, fileID string) (string, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	path := minimaxPathFileRetrieve + "?file_id=" + url.QueryEscape(fileID)
+	body, _, _, bifrostErr := provider.doRequest(ctx, key, http.MethodGet, path, nil, schemas.VideoRetrieveRequest, "")
+	if bifrostErr != nil {
+		return "", bifrostErr
+	}
+
+	var fileResp MinimaxFileRetrieveResponse
+	if _, _, bifrostErr := providerUtils.HandleProviderResponse(body, &fileResp, nil, false, false); bifrostErr != nil {
+		return "", bifrostErr
+	}
+	if bifrostErr := fileResp.BaseResp.toBifrostError(providerName); bifrostErr != nil {
+		return "", bifrostErr
+	}
+	if fileResp.File.DownloadURL == "" {
+		return "", providerUtils.NewBifrostOperationError(fmt.Sprintf("no download URL for file %s", fileID), nil, providerName)
+	}
+	return fileResp.File.DownloadURL, nil
 }
 
-// ContainerFileList is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ContainerFileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileListRequest) (*schemas.BifrostContainerFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileListRequest, provider.GetProviderKey())
+// VideoDownload downloads the video of a completed MiniMax video generation task.
+func (provider *MinimaxProvider) VideoDownload(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostVideoDownloadRequest) (*schemas.BifrostVideoDownloadResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	// Retrieve task status to resolve the video's download URL
+	taskDetails, bifrostErr := provider.VideoRetrieve(ctx, key, &schemas.BifrostVideoRetrieveRequest{
+		Provider: request.Provider,
+		ID:       request.ID,
+	})
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if taskDetails.Status != schemas.VideoStatusCompleted {
+		return nil, providerUtils.NewBifrostOperationError(
+			fmt.Sprintf("video not ready, current status: %s", taskDetails.Status),
+			nil,
+			providerName,
+		)
+	}
+	if len(taskDetails.Videos) == 0 || taskDetails.Videos[0].URL == nil || *taskDetails.Videos[0].URL == "" {
+		return nil, providerUtils.NewBifrostOperationError("video URL not available", nil, providerName)
+	}
+
+	// Download URLs are pre-signed, so no auth header is sent
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+	req.SetRequestURI(*taskDetails.Videos[0].URL)
+	req.Header.SetMethod(http.MethodGet)
+
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return nil, providerUtils.NewBifrostOperationError(
+			fmt.Sprintf("failed to download video: HTTP %d", resp.StatusCode()),
+			nil,
+			providerName,
+		)
+	}
+
+	body, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
+	}
+	contentType := string(resp.Header.ContentType())
+	if contentType == "" {
+		contentType = "video/mp4"
+	}
+
+	return &schemas.BifrostVideoDownloadResponse{
+		VideoID:     request.ID,
+		Content:     append([]byte(nil), body...),
+		ContentType: contentType,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.VideoDownloadRequest,
+			Provider:    providerName,
+			Latency:     latency.Milliseconds(),
+		},
+	}, nil
 }
 
-// ContainerFileRetrieve is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ContainerFileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileRetrieveRequest) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileRetrieveRequest, provider.GetProviderKey())
+// VideoDelete is not supported by Minimax provider.
+func (provider *MinimaxProvider) VideoDelete(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoDeleteRequest) (*schemas.BifrostVideoDeleteResponse, *schemas.BifrostError) {
+	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoDeleteRequest, provider.GetProviderKey())
 }
 
-// ContainerFileContent is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ContainerFileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileContentRequest) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileContentRequest, provider.GetProviderKey())
+// VideoList is not supported by Minimax provider.
+func (provider *MinimaxProvider) VideoList(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoListRequest) (*schemas.BifrostVideoListResponse, *schemas.BifrostError) {
+	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoListRequest, provider.GetProviderKey())
 }
 
-// ContainerFileDelete is not supported by the Minimax provider.
-func (provider *MinimaxProvider) ContainerFileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileDeleteRequest) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileDeleteRequest, provider.GetProviderKey())
+// VideoRemix is not supported by Minimax provider.
+func (provider *MinimaxProvider) VideoRemix(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoRemixRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
+	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRemixRequest, provider.GetProviderKey())
 }
@@ -35,6 +35,7 @@ func TestMinimax(t *testing.T) {
 		ChatModel:            envOrDefault("MINIMAX_CHAT_MODEL", "M2-her"),
 		PromptCachingModel:   envOrDefault("MINIMAX_PROMPT_CACHING_MODEL", "MiniMax-M2.5"),
 		ImageGenerationModel: envOrDefault("MINIMAX_IMAGE_MODEL", "image-01"),
+		EmbeddingModel:       envOrDefault("MINIMAX_EMBEDDING_MODEL", "embo-01"),
 		Scenarios: llmtests.TestScenarios{
 			TextCompletion:        true,
 			TextCompletionStream:  true,
@@ -49,6 +50,7 @@ func TestMinimax(t *testing.T) {
 			PromptCaching:         true,
 			ListModels:            true,
 			ImageGeneration:       true,
+			Embedding:             true,
 		},
 		DisableParallelFor: []string{"PromptCaching"},
 	}
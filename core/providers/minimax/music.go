@@ -0,0 +1,100 @@
+package minimax
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// MiniMax music output formats.
+const (
+	minimaxMusicOutputHex = "hex"
+	minimaxMusicOutputURL = "url"
+)
+
+// ToMinimaxMusicGenerationRequest converts a Bifrost music generation request to the MiniMax format.
+// MiniMax does not take a target duration, so Params.Duration is not forwarded.
+func ToMinimaxMusicGenerationRequest(bifrostReq *schemas.BifrostMusicGenerationRequest) (*MinimaxMusicGenerationRequest, error) {
+	if bifrostReq == nil || bifrostReq.Input == nil || bifrostReq.Input.Prompt == "" {
+		return nil, fmt.Errorf("prompt is required")
+	}
+	if bifrostReq.Input.ReferenceAudio != nil {
+		return nil, fmt.Errorf("reference_audio is not supported by minimax music generation")
+	}
+
+	req := &MinimaxMusicGenerationRequest{
+		Model:        bifrostReq.Model,
+		Prompt:       bifrostReq.Input.Prompt,
+		Lyrics:       bifrostReq.Input.Lyrics,
+		OutputFormat: schemas.Ptr(minimaxMusicOutputHex),
+	}
+
+	if bifrostReq.Params != nil {
+		if bifrostReq.Params.ResponseFormat != nil {
+			switch *bifrostReq.Params.ResponseFormat {
+			case "url":
+				req.OutputFormat = schemas.Ptr(minimaxMusicOutputURL)
+			case "b64_json":
+			default:
+				return nil, fmt.Errorf("unsupported response_format %q (expected url or b64_json)", *bifrostReq.Params.ResponseFormat)
+			}
+		}
+		if bifrostReq.Params.Format != nil || bifrostReq.Params.SampleRate != nil || bifrostReq.Params.Bitrate != nil {
+			req.AudioSetting = &MinimaxAudioSetting{
+				SampleRate: bifrostReq.Params.SampleRate,
+				Bitrate:    bifrostReq.Params.Bitrate,
+				Format:     bifrostReq.Params.Format,
+			}
+		}
+		req.ExtraParams = bifrostReq.Params.ExtraParams
+	}
+
+	return req, nil
+}
+
+// ToBifrostMusicGenerationResponse converts a MiniMax music generation response to Bifrost format.
+// URLs are returned as they are; hex encoded audio is re-encoded as base64 to match the b64_json
+// response format. The audio is inspected rather than the requested output_format, because extra
+// params or a raw request body can override it.
+func (response *MinimaxMusicGenerationResponse) ToBifrostMusicGenerationResponse(model string, audioFormat string) (*schemas.BifrostMusicGenerationResponse, error) {
+	if response == nil {
+		return nil, fmt.Errorf("empty music generation response")
+	}
+	if response.Data.Audio == "" {
+		return nil, fmt.Errorf("music generation response has no audio")
+	}
+
+	output := schemas.MusicOutput{Format: audioFormat}
+	if strings.HasPrefix(response.Data.Audio, "http://") || strings.HasPrefix(response.Data.Audio, "https://") {
+		output.URL = response.Data.Audio
+	} else {
+		audio, err := hex.DecodeString(strings.TrimSpace(response.Data.Audio))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode hex audio: %w", err)
+		}
+		output.B64JSON = base64.StdEncoding.EncodeToString(audio)
+	}
+	if info := response.ExtraInfo; info != nil {
+		if info.MusicDuration > 0 {
+			output.Duration = schemas.Ptr(float64(info.MusicDuration) / 1000)
+		}
+		if info.MusicSampleRate > 0 {
+			output.SampleRate = schemas.Ptr(info.MusicSampleRate)
+		}
+		if info.Bitrate > 0 {
+			output.Bitrate = schemas.Ptr(info.Bitrate)
+		}
+		if info.MusicSize > 0 {
+			output.Size = schemas.Ptr(info.MusicSize)
+		}
+	}
+
+	return &schemas.BifrostMusicGenerationResponse{
+		ID:    response.TraceID,
+		Model: model,
+		Data:  []schemas.MusicOutput{output},
+	}, nil
+}
@@ -0,0 +1,75 @@
+package minimax
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestToMinimaxMusicGenerationRequest(t *testing.T) {
+	req, err := ToMinimaxMusicGenerationRequest(&schemas.BifrostMusicGenerationRequest{
+		Model: "music-2.5",
+		Input: &schemas.MusicGenerationInput{Prompt: "indie folk, melancholic", Lyrics: schemas.Ptr("[verse]\nstreetlights flicker")},
+		Params: &schemas.MusicGenerationParameters{
+			Duration:       schemas.Ptr(30),
+			Format:         schemas.Ptr("wav"),
+			SampleRate:     schemas.Ptr(44100),
+			ResponseFormat: schemas.Ptr("url"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("ToMinimaxMusicGenerationRequest() error = %v", err)
+	}
+	if req.Prompt != "indie folk, melancholic" || req.Lyrics == nil {
+		t.Errorf("prompt/lyrics not mapped: %+v", req)
+	}
+	if req.OutputFormat == nil || *req.OutputFormat != minimaxMusicOutputURL {
+		t.Errorf("output_format = %v, want url", req.OutputFormat)
+	}
+	if req.AudioSetting == nil || *req.AudioSetting.Format != "wav" || *req.AudioSetting.SampleRate != 44100 || req.AudioSetting.Bitrate != nil {
+		t.Errorf("audio_setting = %+v", req.AudioSetting)
+	}
+
+	defaults, err := ToMinimaxMusicGenerationRequest(&schemas.BifrostMusicGenerationRequest{Model: "music-2.5", Input: &schemas.MusicGenerationInput{Prompt: "lo-fi beat"}})
+	if err != nil || *defaults.OutputFormat != minimaxMusicOutputHex || defaults.AudioSetting != nil {
+		t.Errorf("defaults = %+v, %v; want hex output and no audio_setting", defaults, err)
+	}
+
+	if _, err := ToMinimaxMusicGenerationRequest(&schemas.BifrostMusicGenerationRequest{Model: "music-2.5", Input: &schemas.MusicGenerationInput{Prompt: "lo-fi beat", ReferenceAudio: schemas.Ptr("https://example.com/a.mp3")}}); err == nil {
+		t.Error("expected error for reference_audio")
+	}
+	if _, err := ToMinimaxMusicGenerationRequest(&schemas.BifrostMusicGenerationRequest{Model: "music-2.5", Input: &schemas.MusicGenerationInput{Prompt: "lo-fi beat"}, Params: &schemas.MusicGenerationParameters{ResponseFormat: schemas.Ptr("hex")}}); err == nil {
+		t.Error("expected error for an unknown response_format")
+	}
+}
+
+func TestMinimaxMusicGenerationResponse_ToBifrostMusicGenerationResponse(t *testing.T) {
+	resp, err := (&MinimaxMusicGenerationResponse{
+		Data:      MinimaxMusicData{Audio: "494433", Status: 2},
+		TraceID:   "trace-1",
+		ExtraInfo: &MinimaxMusicExtraInfo{MusicDuration: 25364, MusicSampleRate: 44100, Bitrate: 256000, MusicSize: 3},
+	}).ToBifrostMusicGenerationResponse("music-2.5", "mp3")
+	if err != nil {
+		t.Fatalf("ToBifrostMusicGenerationResponse() error = %v", err)
+	}
+	if resp.ID != "trace-1" || len(resp.Data) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	track := resp.Data[0]
+	if track.B64JSON != base64.StdEncoding.EncodeToString([]byte("ID3")) || track.URL != "" || track.Format != "mp3" {
+		t.Errorf("track = %+v, want base64 of the hex audio", track)
+	}
+	if track.Duration == nil || *track.Duration != 25.364 || *track.SampleRate != 44100 || *track.Size != 3 {
+		t.Errorf("track metadata = %+v", track)
+	}
+
+	urlResp, err := (&MinimaxMusicGenerationResponse{Data: MinimaxMusicData{Audio: "https://cdn.example.com/music.mp3"}}).ToBifrostMusicGenerationResponse("music-2.5", "mp3")
+	if err != nil || urlResp.Data[0].URL != "https://cdn.example.com/music.mp3" || urlResp.Data[0].B64JSON != "" {
+		t.Errorf("url response = %+v, %v", urlResp, err)
+	}
+
+	if _, err := (&MinimaxMusicGenerationResponse{Data: MinimaxMusicData{Audio: "not-hex"}}).ToBifrostMusicGenerationResponse("music-2.5", "mp3"); err == nil {
+		t.Error("expected error for undecodable audio")
+	}
+}
@@ -0,0 +1,143 @@
+package minimax
+
+// MiniMax video task statuses returned by the query endpoint.
+const (
+	MinimaxVideoStatusPreparing  = "Preparing"
+	MinimaxVideoStatusQueueing   = "Queueing"
+	MinimaxVideoStatusProcessing = "Processing"
+	MinimaxVideoStatusSuccess    = "Success"
+	MinimaxVideoStatusFail       = "Fail"
+)
+
+// MinimaxBaseResponse is the status block MiniMax attaches to every native API response.
+// MiniMax reports most failures with HTTP 200 and a non-zero status code here.
+type MinimaxBaseResponse struct {
+	StatusCode int    `json:"status_code"`
+	StatusMsg  string `json:"status_msg"`
+}
+
+// ==================== VIDEO TYPES ====================
+
+// MinimaxVideoGenerationRequest is the request body for Hailuo video generation.
+type MinimaxVideoGenerationRequest struct {
+	Model           string                 `json:"model"`
+	Prompt          *string                `json:"prompt,omitempty"`
+	FirstFrameImage *string                `json:"first_frame_image,omitempty"` // URL or base64 image for image-to-video
+	Duration        *int                   `json:"duration,omitempty"`          // in seconds, e.g. 6 or 10
+	Resolution      *string                `json:"resolution,omitempty"`        // "512P" | "768P" | "1080P"
+	PromptOptimizer *bool                  `json:"prompt_optimizer,omitempty"`
+	CallbackURL     *string                `json:"callback_url,omitempty"`
+	ExtraParams     map[string]interface{} `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface
+func (r *MinimaxVideoGenerationRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+// MinimaxVideoTaskResponse is returned when a video generation task is submitted.
+type MinimaxVideoTaskResponse struct {
+	TaskID   string              `json:"task_id"`
+	BaseResp MinimaxBaseResponse `json:"base_resp"`
+}
+
+// MinimaxVideoQueryResponse is the query payload for a video generation task.
+// FileID is set once the task succeeds and resolves to a download URL via the files endpoint.
+type MinimaxVideoQueryResponse struct {
+	TaskID      string              `json:"task_id"`
+	Status      string              `json:"status"`
+	FileID      string              `json:"file_id,omitempty"`
+	VideoWidth  int                 `json:"video_width,omitempty"`
+	VideoHeight int                 `json:"video_height,omitempty"`
+	BaseResp    MinimaxBaseResponse `json:"base_resp"`
+}
+
+// MinimaxFileRetrieveResponse is the files/retrieve payload.
+type MinimaxFileRetrieveResponse struct {
+	File     MinimaxFile         `json:"file"`
+	BaseResp MinimaxBaseResponse `json:"base_resp"`
+}
+
+// MinimaxFile describes a file stored by MiniMax, such as a generated video.
+type MinimaxFile struct {
+	Bytes       int64  `json:"bytes,omitempty"`
+	CreatedAt   int64  `json:"created_at,omitempty"`
+	Filename    string `json:"filename,omitempty"`
+	Purpose     string `json:"purpose,omitempty"`
+	DownloadURL string `json:"download_url"`
+}
+
+// ==================== MUSIC TYPES ====================
+
+// MinimaxMusicGenerationRequest is the request body for music generation.
+type MinimaxMusicGenerationRequest struct {
+	Model        string                 `json:"model"`
+	Prompt       string                 `json:"prompt"`
+	Lyrics       *string                `json:"lyrics,omitempty"`
+	OutputFormat *string                `json:"output_format,omitempty"` // "hex" | "url"
+	AudioSetting *MinimaxAudioSetting   `json:"audio_setting,omitempty"`
+	ExtraParams  map[string]interface{} `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface
+func (r *MinimaxMusicGenerationRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+// MinimaxAudioSetting controls the encoding of generated audio.
+type MinimaxAudioSetting struct {
+	SampleRate *int    `json:"sample_rate,omitempty"`
+	Bitrate    *int    `json:"bitrate,omitempty"`
+	Format     *string `json:"format,omitempty"` // "mp3" | "wav" | "pcm"
+}
+
+// MinimaxMusicGenerationResponse is the response body for music generation.
+type MinimaxMusicGenerationResponse struct {
+	Data      MinimaxMusicData       `json:"data"`
+	TraceID   string                 `json:"trace_id,omitempty"`
+	ExtraInfo *MinimaxMusicExtraInfo `json:"extra_info,omitempty"`
+	BaseResp  MinimaxBaseResponse    `json:"base_resp"`
+}
+
+// MinimaxMusicData carries the generated audio, hex encoded or as a URL depending on output_format.
+type MinimaxMusicData struct {
+	Audio  string `json:"audio"`
+	Status int    `json:"status"` // 1: in progress, 2: completed
+}
+
+// MinimaxMusicExtraInfo describes the generated audio.
+type MinimaxMusicExtraInfo struct {
+	MusicDuration   int64 `json:"music_duration"` // in milliseconds
+	MusicSampleRate int   `json:"music_sample_rate"`
+	MusicChannel    int   `json:"music_channel"`
+	Bitrate         int   `json:"bitrate"`
+	MusicSize       int64 `json:"music_size"` // in bytes
+}
+
+// ==================== EMBEDDING TYPES ====================
+
+// MiniMax embedding types: documents stored for retrieval are embedded as "db", search queries as "query".
+const (
+	MinimaxEmbeddingTypeDB    = "db"
+	MinimaxEmbeddingTypeQuery = "query"
+)
+
+// MinimaxEmbeddingRequest is the request body for embeddings.
+type MinimaxEmbeddingRequest struct {
+	Model       string                 `json:"model"`
+	Texts       []string               `json:"texts"`
+	Type        string                 `json:"type"` // "db" | "query"
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface
+func (r *MinimaxEmbeddingRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+// MinimaxEmbeddingResponse is the response body for embeddings.
+type MinimaxEmbeddingResponse struct {
+	Vectors     [][]float32         `json:"vectors"`
+	TotalTokens int                 `json:"total_tokens"`
+	BaseResp    MinimaxBaseResponse `json:"base_resp"`
+}
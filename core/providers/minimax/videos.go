@@ -0,0 +1,148 @@
+package minimax
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// ToMinimaxVideoGenerationRequest converts a Bifrost video generation request to the MiniMax format.
+func ToMinimaxVideoGenerationRequest(bifrostReq *schemas.BifrostVideoGenerationRequest) (*MinimaxVideoGenerationRequest, error) {
+	if bifrostReq == nil || bifrostReq.Input == nil {
+		return nil, fmt.Errorf("input is required")
+	}
+	if bifrostReq.Input.Prompt == "" && bifrostReq.Input.InputReference == nil {
+		return nil, fmt.Errorf("either prompt or input_reference is required")
+	}
+
+	req := &MinimaxVideoGenerationRequest{
+		Model: bifrostReq.Model,
+	}
+	if bifrostReq.Input.Prompt != "" {
+		req.Prompt = schemas.Ptr(bifrostReq.Input.Prompt)
+	}
+	if bifrostReq.Input.InputReference != nil {
+		sanitizedURL, err := schemas.SanitizeImageURL(*bifrostReq.Input.InputReference)
+		if err != nil {
+			return nil, fmt.Errorf("invalid input reference: %w", err)
+		}
+		req.FirstFrameImage = schemas.Ptr(sanitizedURL)
+	}
+
+	if bifrostReq.Params != nil {
+		if bifrostReq.Params.Seconds != nil {
+			seconds, err := strconv.Atoi(*bifrostReq.Params.Seconds)
+			if err != nil {
+				return nil, fmt.Errorf("invalid seconds value: %w", err)
+			}
+			req.Duration = &seconds
+		}
+		if bifrostReq.Params.Size != "" {
+			resolution, err := toMinimaxResolution(bifrostReq.Params.Size)
+			if err != nil {
+				return nil, err
+			}
+			req.Resolution = &resolution
+		}
+		req.ExtraParams = bifrostReq.Params.ExtraParams
+	}
+
+	return req, nil
+}
+
+// toMinimaxResolution maps a Bifrost size to a MiniMax resolution tier. MiniMax names tiers after the
+// short side of the frame, so "1920x1080" becomes "1080P"; tier names such as "768P" pass through.
+func toMinimaxResolution(size string) (string, error) {
+	size = strings.TrimSpace(size)
+	if strings.HasSuffix(strings.ToUpper(size), "P") {
+		return strings.ToUpper(size), nil
+	}
+	width, height, found := strings.Cut(strings.ToLower(size), "x")
+	if !found {
+		return "", fmt.Errorf("invalid size %q: expected WIDTHxHEIGHT or a resolution such as 768P", size)
+	}
+	w, errW := strconv.Atoi(width)
+	h, errH := strconv.Atoi(height)
+	if errW != nil || errH != nil || w <= 0 || h <= 0 {
+		return "", fmt.Errorf("invalid size %q: expected WIDTHxHEIGHT or a resolution such as 768P", size)
+	}
+	return fmt.Sprintf("%dP", min(w, h)), nil
+}
+
+// ToBifrostVideoGenerationResponse converts a MiniMax query payload to Bifrost format. downloadURL is the
+// resolved URL of the task's file_id and is empty until the task succeeds.
+func (response *MinimaxVideoQueryResponse) ToBifrostVideoGenerationResponse(taskID string, downloadURL string) *schemas.BifrostVideoGenerationResponse {
+	if response == nil {
+		return nil
+	}
+
+	bifrostResp := &schemas.BifrostVideoGenerationResponse{
+		ID:        taskID,
+		Object:    "video",
+		CreatedAt: time.Now().Unix(),
+		Status:    toBifrostVideoStatus(response.Status),
+	}
+	if response.VideoWidth > 0 && response.VideoHeight > 0 {
+		bifrostResp.Size = fmt.Sprintf("%dx%d", response.VideoWidth, response.VideoHeight)
+	}
+
+	if bifrostResp.Status == schemas.VideoStatusFailed {
+		bifrostResp.Error = &schemas.VideoCreateError{
+			Code:    response.Status,
+			Message: "video generation task failed",
+		}
+	}
+
+	if downloadURL != "" {
+		bifrostResp.Videos = []schemas.VideoOutput{{
+			Type:        schemas.VideoOutputTypeURL,
+			URL:         schemas.Ptr(downloadURL),
+			ContentType: "video/mp4",
+		}}
+	}
+
+	return bifrostResp
+}
+
+// toBifrostVideoStatus maps a MiniMax task status to the Bifrost video lifecycle.
+func toBifrostVideoStatus(status string) schemas.VideoStatus {
+	switch status {
+	case MinimaxVideoStatusProcessing:
+		return schemas.VideoStatusInProgress
+	case MinimaxVideoStatusSuccess:
+		return schemas.VideoStatusCompleted
+	case MinimaxVideoStatusFail:
+		return schemas.VideoStatusFailed
+	default:
+		return schemas.VideoStatusQueued
+	}
+}
+
+// toBifrostError returns the error carried by a non-zero MiniMax status code, or nil on success.
+func (base MinimaxBaseResponse) toBifrostError(providerName schemas.ModelProvider) *schemas.BifrostError {
+	if base.StatusCode == 0 {
+		return nil
+	}
+	statusCode := http.StatusBadRequest
+	switch base.StatusCode {
+	case 1002: // rate limit
+		statusCode = http.StatusTooManyRequests
+	case 1004: // authentication failure
+		statusCode = http.StatusUnauthorized
+	case 1000, 1013: // internal errors
+		statusCode = http.StatusInternalServerError
+	}
+	return providerUtils.NewProviderAPIError(
+		fmt.Sprintf("%s (status_code %d)", base.StatusMsg, base.StatusCode),
+		nil,
+		statusCode,
+		providerName,
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,156 @@
+package minimax
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+func TestToMinimaxVideoGenerationRequest(t *testing.T) {
+	req, err := ToMinimaxVideoGenerationRequest(&schemas.BifrostVideoGenerationRequest{
+		Model: "MiniMax-Hailuo-02",
+		Input: &schemas.VideoGenerationInput{Prompt: "a cat surfing"},
+		Params: &schemas.VideoGenerationParameters{
+			Seconds: schemas.Ptr("6"),
+			Size:    "1920x1080",
+		},
+	})
+	if err != nil {
+		t.Fatalf("ToMinimaxVideoGenerationRequest() error = %v", err)
+	}
+	if req.Prompt == nil || *req.Prompt != "a cat surfing" {
+		t.Errorf("prompt = %v", req.Prompt)
+	}
+	if req.Duration == nil || *req.Duration != 6 {
+		t.Errorf("duration = %v, want 6", req.Duration)
+	}
+	if req.Resolution == nil || *req.Resolution != "1080P" {
+		t.Errorf("resolution = %v, want 1080P", req.Resolution)
+	}
+
+	if _, err := ToMinimaxVideoGenerationRequest(&schemas.BifrostVideoGenerationRequest{Model: "MiniMax-Hailuo-02", Input: &schemas.VideoGenerationInput{}}); err == nil {
+		t.Error("expected error when neither prompt nor input_reference is set")
+	}
+}
+
+func TestToMinimaxResolution(t *testing.T) {
+	tests := map[string]string{
+		"1920x1080": "1080P",
+		"768x1024":  "768P",
+		"768p":      "768P",
+		"512P":      "512P",
+	}
+	for size, want := range tests {
+		if got, err := toMinimaxResolution(size); err != nil || got != want {
+			t.Errorf("toMinimaxResolution(%q) = %q, %v; want %q", size, got, err, want)
+		}
+	}
+	for _, size := range []string{"1080", "axb", "0x720"} {
+		if _, err := toMinimaxResolution(size); err == nil {
+			t.Errorf("toMinimaxResolution(%q) expected an error", size)
+		}
+	}
+}
+
+func TestMinimaxVideoLifecycle(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case minimaxPathVideoGeneration:
+			w.Write([]byte(`{"task_id":"106916112212032","base_resp":{"status_code":0,"status_msg":"success"}}`))
+		case minimaxPathVideoQuery:
+			switch r.URL.Query().Get("task_id") {
+			case "106916112212032":
+				w.Write([]byte(`{"task_id":"106916112212032","status":"Success","file_id":"205258526306433","video_width":1920,"video_height":1080,"base_resp":{"status_code":0,"status_msg":"success"}}`))
+			case "pending":
+				w.Write([]byte(`{"task_id":"pending","status":"Queueing","base_resp":{"status_code":0,"status_msg":"success"}}`))
+			default:
+				w.Write([]byte(`{"base_resp":{"status_code":2013,"status_msg":"invalid params, task not found"}}`))
+			}
+		case minimaxPathFileRetrieve:
+			if r.URL.Query().Get("file_id") != "205258526306433" {
+				t.Errorf("file_id = %q", r.URL.Query().Get("file_id"))
+			}
+			w.Write([]byte(`{"file":{"bytes":5896337,"filename":"output.mp4","purpose":"video_generation","download_url":"` + server.URL + `/download/output.mp4"},"base_resp":{"status_code":0,"status_msg":"success"}}`))
+		case "/download/output.mp4":
+			if r.Header.Get("Authorization") != "" {
+				t.Error("download request should not send the API key")
+			}
+			w.Header().Set("Content-Type", "video/mp4")
+			w.Write([]byte("mp4-bytes"))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider, err := NewMinimaxProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			BaseURL:                        server.URL,
+			DefaultRequestTimeoutInSeconds: 10,
+		},
+	}, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewMinimaxProvider() error = %v", err)
+	}
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	key := schemas.Key{Value: *schemas.NewEnvVar("test-key")}
+
+	created, bifrostErr := provider.VideoGeneration(ctx, key, &schemas.BifrostVideoGenerationRequest{
+		Provider: schemas.Minimax,
+		Model:    "MiniMax-Hailuo-02",
+		Input:    &schemas.VideoGenerationInput{Prompt: "a cat surfing"},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("VideoGeneration() error = %v", bifrostErr.Error)
+	}
+	if created.ID != "106916112212032:minimax" || created.Status != schemas.VideoStatusQueued {
+		t.Errorf("VideoGeneration() = %s, %s", created.ID, created.Status)
+	}
+
+	retrieved, bifrostErr := provider.VideoRetrieve(ctx, key, &schemas.BifrostVideoRetrieveRequest{Provider: schemas.Minimax, ID: created.ID})
+	if bifrostErr != nil {
+		t.Fatalf("VideoRetrieve() error = %v", bifrostErr.Error)
+	}
+	if retrieved.Status != schemas.VideoStatusCompleted || retrieved.Size != "1920x1080" {
+		t.Errorf("VideoRetrieve() = %s, %s", retrieved.Status, retrieved.Size)
+	}
+	if len(retrieved.Videos) != 1 || *retrieved.Videos[0].URL != server.URL+"/download/output.mp4" {
+		t.Errorf("videos = %+v, want the resolved download URL", retrieved.Videos)
+	}
+
+	pending, bifrostErr := provider.VideoRetrieve(ctx, key, &schemas.BifrostVideoRetrieveRequest{Provider: schemas.Minimax, ID: "pending:minimax"})
+	if bifrostErr != nil || pending.Status != schemas.VideoStatusQueued || len(pending.Videos) != 0 {
+		t.Errorf("VideoRetrieve() of a queued task = %+v, %v", pending, bifrostErr)
+	}
+
+	if _, bifrostErr := provider.VideoRetrieve(ctx, key, &schemas.BifrostVideoRetrieveRequest{Provider: schemas.Minimax, ID: "missing:minimax"}); bifrostErr == nil || bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("VideoRetrieve() of a missing task error = %+v, want a 400 from base_resp", bifrostErr)
+	}
+
+	downloaded, bifrostErr := provider.VideoDownload(ctx, key, &schemas.BifrostVideoDownloadRequest{Provider: schemas.Minimax, ID: created.ID})
+	if bifrostErr != nil {
+		t.Fatalf("VideoDownload() error = %v", bifrostErr.Error)
+	}
+	if string(downloaded.Content) != "mp4-bytes" || downloaded.ContentType != "video/mp4" {
+		t.Errorf("VideoDownload() = %q, %s", downloaded.Content, downloaded.ContentType)
+	}
+}
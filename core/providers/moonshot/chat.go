@@ -0,0 +1,102 @@
+package moonshot
+
+import (
+	"fmt"
+
+	"github.com/capsohq/bifrost/core/providers/openai"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	// MoonshotToolTypeBuiltinFunction is the tool (and tool call) type of Kimi's built-in functions.
+	MoonshotToolTypeBuiltinFunction = "builtin_function"
+	// MoonshotBuiltinWebSearch is the name of Kimi's built-in web search function.
+	MoonshotBuiltinWebSearch = "$web_search"
+)
+
+// ToMoonshotChatRequest converts a Bifrost chat request to a Moonshot chat request.
+// web_search_options enable the built-in $web_search tool, and the $web_search calls in the
+// conversation are completed with the tool result Moonshot expects (see applyWebSearchProtocol).
+func ToMoonshotChatRequest(ctx *schemas.BifrostContext, bifrostReq *schemas.BifrostChatRequest) (*openai.OpenAIChatRequest, error) {
+	openaiReq := openai.ToOpenAIChatRequest(ctx, bifrostReq)
+	if openaiReq == nil {
+		return nil, fmt.Errorf("chat request input is not provided")
+	}
+
+	if bifrostReq.Params != nil && bifrostReq.Params.WebSearchOptions != nil && !hasWebSearchTool(openaiReq.Tools) {
+		// Copy the tools so the caller's slice is not appended to
+		tools := make([]schemas.ChatTool, len(openaiReq.Tools), len(openaiReq.Tools)+1)
+		copy(tools, openaiReq.Tools)
+		openaiReq.Tools = append(tools, schemas.ChatTool{
+			Type:     MoonshotToolTypeBuiltinFunction,
+			Function: &schemas.ChatToolFunction{Name: MoonshotBuiltinWebSearch},
+		})
+	}
+	applyWebSearchProtocol(openaiReq.Messages)
+	return openaiReq, nil
+}
+
+// hasWebSearchTool reports whether the tools already declare the built-in $web_search function.
+func hasWebSearchTool(tools []schemas.ChatTool) bool {
+	for _, tool := range tools {
+		if tool.Function != nil && tool.Function.Name == MoonshotBuiltinWebSearch {
+			return true
+		}
+	}
+	return false
+}
+
+// applyWebSearchProtocol makes the $web_search round trip match Moonshot's protocol: the assistant's
+// $web_search calls keep the builtin_function type, and the tool messages answering them are named
+// $web_search and echo the call arguments (which carry the search_result) when they have no content.
+// Messages are updated with copies, so the request's messages are left unchanged.
+func applyWebSearchProtocol(messages []openai.OpenAIMessage) {
+	arguments := make(map[string]string)
+	for i := range messages {
+		message := &messages[i]
+		if message.OpenAIChatAssistantMessage == nil || len(message.OpenAIChatAssistantMessage.ToolCalls) == 0 {
+			continue
+		}
+		var toolCalls []schemas.ChatAssistantMessageToolCall
+		for j, toolCall := range message.OpenAIChatAssistantMessage.ToolCalls {
+			if toolCall.Function.Name == nil || *toolCall.Function.Name != MoonshotBuiltinWebSearch {
+				continue
+			}
+			if toolCall.ID != nil {
+				arguments[*toolCall.ID] = toolCall.Function.Arguments
+			}
+			if toolCall.Type != nil && *toolCall.Type == MoonshotToolTypeBuiltinFunction {
+				continue
+			}
+			if toolCalls == nil {
+				toolCalls = make([]schemas.ChatAssistantMessageToolCall, len(message.OpenAIChatAssistantMessage.ToolCalls))
+				copy(toolCalls, message.OpenAIChatAssistantMessage.ToolCalls)
+			}
+			toolCalls[j].Type = schemas.Ptr(MoonshotToolTypeBuiltinFunction)
+		}
+		if toolCalls != nil {
+			assistantMessage := *message.OpenAIChatAssistantMessage
+			assistantMessage.ToolCalls = toolCalls
+			message.OpenAIChatAssistantMessage = &assistantMessage
+		}
+	}
+	if len(arguments) == 0 {
+		return
+	}
+
+	for i := range messages {
+		message := &messages[i]
+		if message.Role != schemas.ChatMessageRoleTool || message.ChatToolMessage == nil || message.ChatToolMessage.ToolCallID == nil {
+			continue
+		}
+		args, ok := arguments[*message.ChatToolMessage.ToolCallID]
+		if !ok {
+			continue
+		}
+		message.Name = schemas.Ptr(MoonshotBuiltinWebSearch)
+		if message.Content == nil || (message.Content.ContentStr == nil && len(message.Content.ContentBlocks) == 0) ||
+			(message.Content.ContentStr != nil && *message.Content.ContentStr == "") {
+			message.Content = &schemas.ChatMessageContent{ContentStr: schemas.Ptr(args)}
+		}
+	}
+}
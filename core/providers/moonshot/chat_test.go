@@ -0,0 +1,220 @@
+package moonshot
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestChatCompletion_WebSearch(t *testing.T) {
+	t.Parallel()
+
+	const arguments = `{"search_result":{"search_id":"s-1"},"usage":{"total_tokens":1024}}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" {
+			t.Errorf("expected path /v1/chat/completions, got %s", r.URL.Path)
+		}
+		var requestBody struct {
+			Tools []struct {
+				Type     string `json:"type"`
+				Function struct {
+					Name string `json:"name"`
+				} `json:"function"`
+			} `json:"tools"`
+			Messages []struct {
+				Role       string  `json:"role"`
+				Name       *string `json:"name"`
+				Content    *string `json:"content"`
+				ToolCallID *string `json:"tool_call_id"`
+				ToolCalls  []struct {
+					Type string `json:"type"`
+				} `json:"tool_calls"`
+			} `json:"messages"`
+			WebSearchOptions json.RawMessage `json:"web_search_options"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if len(requestBody.Tools) != 1 || requestBody.Tools[0].Type != "builtin_function" || requestBody.Tools[0].Function.Name != "$web_search" {
+			t.Errorf("expected the $web_search builtin tool, got %+v", requestBody.Tools)
+		}
+		if requestBody.WebSearchOptions != nil {
+			t.Errorf("expected web_search_options to be dropped, got %s", requestBody.WebSearchOptions)
+		}
+		if len(requestBody.Messages) != 3 {
+			t.Fatalf("expected 3 messages, got %d", len(requestBody.Messages))
+		}
+		if calls := requestBody.Messages[1].ToolCalls; len(calls) != 1 || calls[0].Type != "builtin_function" {
+			t.Errorf("expected the assistant $web_search call to be a builtin_function, got %+v", calls)
+		}
+		toolMessage := requestBody.Messages[2]
+		if toolMessage.Name == nil || *toolMessage.Name != "$web_search" {
+			t.Errorf("expected tool message named $web_search, got %v", toolMessage.Name)
+		}
+		if toolMessage.Content == nil || *toolMessage.Content != arguments {
+			t.Errorf("expected tool message to echo the arguments, got %v", toolMessage.Content)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"id":"chatcmpl-1","object":"chat.completion","created":1,"model":"kimi-k2-0905-preview","choices":[{"index":0,"finish_reason":"tool_calls","message":{"role":"assistant","content":"","tool_calls":[{"index":0,"id":"call-2","type":"builtin_function","function":{"name":"$web_search","arguments":%q}}]}}]}`, arguments)
+	}))
+	defer server.Close()
+
+	provider, err := NewMoonshotProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{BaseURL: server.URL},
+	}, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewMoonshotProvider returned error: %v", err)
+	}
+
+	// The earlier $web_search call comes back typed as a function and the tool result has no content,
+	// as clients replaying the conversation commonly send it
+	request := &schemas.BifrostChatRequest{
+		Provider: schemas.Moonshot,
+		Model:    "kimi-k2-0905-preview",
+		Input: []schemas.ChatMessage{
+			{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("What's new today?")}},
+			{
+				Role: schemas.ChatMessageRoleAssistant,
+				ChatAssistantMessage: &schemas.ChatAssistantMessage{
+					ToolCalls: []schemas.ChatAssistantMessageToolCall{{
+						ID:       schemas.Ptr("call-1"),
+						Type:     schemas.Ptr("function"),
+						Function: schemas.ChatAssistantMessageToolCallFunction{Name: schemas.Ptr("$web_search"), Arguments: arguments},
+					}},
+				},
+			},
+			{Role: schemas.ChatMessageRoleTool, ChatToolMessage: &schemas.ChatToolMessage{ToolCallID: schemas.Ptr("call-1")}},
+		},
+		Params: &schemas.ChatParameters{WebSearchOptions: &schemas.ChatWebSearchOptions{}},
+	}
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	resp, bifrostErr := provider.ChatCompletion(ctx, schemas.Key{Value: schemas.EnvVar{Val: "test-key"}}, request)
+	if bifrostErr != nil {
+		t.Fatalf("ChatCompletion returned error: %v", bifrostErr.Error)
+	}
+	if len(resp.Choices) != 1 || resp.Choices[0].ChatNonStreamResponseChoice == nil {
+		t.Fatalf("expected one choice, got %+v", resp.Choices)
+	}
+	toolCalls := resp.Choices[0].Message.ToolCalls
+	if len(toolCalls) != 1 || toolCalls[0].Type == nil || *toolCalls[0].Type != "builtin_function" || toolCalls[0].Function.Arguments != arguments {
+		t.Fatalf("expected the builtin $web_search call to be preserved, got %+v", toolCalls)
+	}
+
+	// The request's messages are left unchanged
+	if *request.Input[1].ToolCalls[0].Type != "function" || request.Input[2].Name != nil || request.Input[2].Content != nil {
+		t.Errorf("expected the request messages to be unchanged, got %+v", request.Input)
+	}
+}
+
+func TestChatCompletion_Documents(t *testing.T) {
+	t.Parallel()
+
+	const extracted = `{"content":"Refunds are issued within 14 days.","file_type":"application/pdf","filename":"policy.pdf","title":"","type":"file"}`
+
+	var deleted atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/files":
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Errorf("failed to parse multipart form: %v", err)
+				return
+			}
+			if r.FormValue("purpose") != "file-extract" {
+				t.Errorf("expected purpose file-extract, got %q", r.FormValue("purpose"))
+			}
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("expected a file field: %v", err)
+				return
+			}
+			defer file.Close()
+			data, _ := io.ReadAll(file)
+			if header.Filename != "policy.pdf" || string(data) != "%PDF-1.4" {
+				t.Errorf("unexpected upload %q: %q", header.Filename, data)
+			}
+			w.Write([]byte(`{"id":"file-1","object":"file","purpose":"file-extract"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/files/file-1/content":
+			w.Write([]byte(extracted))
+		case r.Method == http.MethodDelete && r.URL.Path == "/v1/files/file-1":
+			deleted.Store(true)
+			w.Write([]byte(`{"id":"file-1","deleted":true}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/chat/completions":
+			var requestBody struct {
+				Messages []struct {
+					Role    string          `json:"role"`
+					Content json.RawMessage `json:"content"`
+				} `json:"messages"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+				return
+			}
+			if len(requestBody.Messages) != 2 {
+				t.Errorf("expected 2 messages, got %d", len(requestBody.Messages))
+				return
+			}
+			var systemContent string
+			if err := json.Unmarshal(requestBody.Messages[0].Content, &systemContent); err != nil || requestBody.Messages[0].Role != "system" || systemContent != extracted {
+				t.Errorf("expected the extracted content as a system message, got %s: %s", requestBody.Messages[0].Role, requestBody.Messages[0].Content)
+			}
+			if strings.Contains(string(requestBody.Messages[1].Content), "document") {
+				t.Errorf("expected the document block to be removed, got %s", requestBody.Messages[1].Content)
+			}
+			w.Write([]byte(`{"id":"chatcmpl-1","object":"chat.completion","created":1,"model":"kimi-k2-0905-preview","choices":[{"index":0,"finish_reason":"stop","message":{"role":"assistant","content":"14 days."}}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider, err := NewMoonshotProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{BaseURL: server.URL},
+	}, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewMoonshotProvider returned error: %v", err)
+	}
+
+	request := &schemas.BifrostChatRequest{
+		Provider: schemas.Moonshot,
+		Model:    "kimi-k2-0905-preview",
+		Input: []schemas.ChatMessage{{
+			Role: schemas.ChatMessageRoleUser,
+			Content: &schemas.ChatMessageContent{ContentBlocks: []schemas.ChatContentBlock{
+				{Type: schemas.ChatContentBlockTypeDocument, Document: &schemas.ChatInputDocument{
+					Data:  schemas.Ptr("data:application/pdf;base64," + base64.StdEncoding.EncodeToString([]byte("%PDF-1.4"))),
+					Title: schemas.Ptr("policy.pdf"),
+				}},
+				{Type: schemas.ChatContentBlockTypeText, Text: schemas.Ptr("How long do refunds take?")},
+			}},
+		}},
+	}
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	resp, bifrostErr := provider.ChatCompletion(ctx, schemas.Key{Value: schemas.EnvVar{Val: "test-key"}}, request)
+	if bifrostErr != nil {
+		t.Fatalf("ChatCompletion returned error: %v", bifrostErr.Error)
+	}
+	if len(resp.Choices) != 1 || resp.Choices[0].Message.Content == nil || *resp.Choices[0].Message.Content.ContentStr != "14 days." {
+		t.Fatalf("unexpected response: %+v", resp.Choices)
+	}
+	if !deleted.Load() {
+		t.Errorf("expected the uploaded file to be deleted")
+	}
+	if len(request.Input) != 1 || len(request.Input[0].Content.ContentBlocks) != 2 {
+		t.Errorf("expected the request messages to be unchanged, got %+v", request.Input)
+	}
+}
@@ -0,0 +1,127 @@
+package moonshot
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/capsohq/bifrost/core/providers/openai"
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+const moonshotPathEstimateTokenCount = "/v1/tokenizers/estimate-token-count"
+
+// moonshotEstimateTokenCountRequest is the body of POST /v1/tokenizers/estimate-token-count,
+// which takes the model and messages of a chat completion request.
+type moonshotEstimateTokenCountRequest struct {
+	Model       string                 `json:"model"`
+	Messages    []openai.OpenAIMessage `json:"messages"`
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface
+func (req *moonshotEstimateTokenCountRequest) GetExtraParams() map[string]interface{} {
+	return req.ExtraParams
+}
+
+type moonshotEstimateTokenCountResponse struct {
+	Data struct {
+		TotalTokens int `json:"total_tokens"`
+	} `json:"data"`
+}
+
+// toMoonshotEstimateTokenCountRequest converts a responses request to chat messages, with the
+// instructions as a leading system message.
+func toMoonshotEstimateTokenCountRequest(request *schemas.BifrostResponsesRequest) *moonshotEstimateTokenCountRequest {
+	messages := schemas.ToChatMessages(request.Input)
+	nativeReq := &moonshotEstimateTokenCountRequest{Model: request.Model}
+	if request.Params != nil {
+		if request.Params.Instructions != nil && *request.Params.Instructions != "" {
+			messages = append([]schemas.ChatMessage{{
+				Role:    schemas.ChatMessageRoleSystem,
+				Content: &schemas.ChatMessageContent{ContentStr: request.Params.Instructions},
+			}}, messages...)
+		}
+		nativeReq.ExtraParams = request.Params.ExtraParams
+	}
+	nativeReq.Messages = openai.ConvertBifrostMessagesToOpenAIMessages(messages)
+	return nativeReq
+}
+
+// CountTokens counts the input tokens of a request with Moonshot's token estimation API.
+func (provider *MoonshotProvider) CountTokens(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostResponsesRequest) (*schemas.BifrostCountTokensResponse, *schemas.BifrostError) {
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, moonshotPathEstimateTokenCount))
+	req.Header.SetMethod(http.MethodPost)
+	req.Header.SetContentType("application/json")
+	if key.Value.GetValue() != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
+	}
+
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return toMoonshotEstimateTokenCountRequest(request), nil
+		},
+		provider.GetProviderKey())
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	req.SetBody(jsonData)
+
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+	providerResponseHeaders := providerUtils.ExtractProviderResponseHeaders(resp)
+	ctx.SetValue(schemas.BifrostContextKeyProviderResponseHeaders, providerResponseHeaders)
+
+	if resp.StatusCode() != fasthttp.StatusOK {
+		provider.logger.Debug(fmt.Sprintf("error from moonshot token estimation: %s", string(resp.Body())))
+		return nil, providerUtils.EnrichError(ctx, openai.ParseOpenAIError(resp, schemas.CountTokensRequest, provider.GetProviderKey(), request.Model), jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	body, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
+	}
+
+	nativeResp := &moonshotEstimateTokenCountResponse{}
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, nativeResp, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	inputTokens := nativeResp.Data.TotalTokens
+	response := &schemas.BifrostCountTokensResponse{
+		Object:      "response.input_tokens",
+		Model:       request.Model,
+		InputTokens: inputTokens,
+		TotalTokens: &inputTokens,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType:             schemas.CountTokensRequest,
+			Provider:                provider.GetProviderKey(),
+			ModelRequested:          request.Model,
+			Latency:                 latency.Milliseconds(),
+			ProviderResponseHeaders: providerResponseHeaders,
+		},
+	}
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
+
+	return response, nil
+}
@@ -0,0 +1,95 @@
+package moonshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestCountTokens(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/tokenizers/estimate-token-count" {
+			t.Errorf("expected path /v1/tokenizers/estimate-token-count, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("expected Authorization Bearer test-key, got %s", r.Header.Get("Authorization"))
+		}
+		var requestBody struct {
+			Model    string `json:"model"`
+			Messages []struct {
+				Role    string `json:"role"`
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if requestBody.Model != "kimi-k2-0905-preview" {
+			t.Errorf("expected model kimi-k2-0905-preview, got %s", requestBody.Model)
+		}
+		if len(requestBody.Messages) != 2 || requestBody.Messages[0].Role != "system" || requestBody.Messages[0].Content != "You are Kimi." ||
+			requestBody.Messages[1].Role != "user" || requestBody.Messages[1].Content != "Hello" {
+			t.Errorf("unexpected messages: %+v", requestBody.Messages)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"data": {"total_tokens": 19}}`)
+	}))
+	defer server.Close()
+
+	provider, err := NewMoonshotProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{BaseURL: server.URL},
+	}, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewMoonshotProvider returned error: %v", err)
+	}
+
+	request := &schemas.BifrostResponsesRequest{
+		Provider: schemas.Moonshot,
+		Model:    "kimi-k2-0905-preview",
+		Input: []schemas.ResponsesMessage{{
+			Role:    schemas.Ptr(schemas.ResponsesInputMessageRoleUser),
+			Content: &schemas.ResponsesMessageContent{ContentStr: schemas.Ptr("Hello")},
+		}},
+		Params: &schemas.ResponsesParameters{Instructions: schemas.Ptr("You are Kimi.")},
+	}
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	resp, bifrostErr := provider.CountTokens(ctx, schemas.Key{Value: schemas.EnvVar{Val: "test-key"}}, request)
+	if bifrostErr != nil {
+		t.Fatalf("CountTokens returned error: %v", bifrostErr.Error)
+	}
+	if resp.InputTokens != 19 || resp.TotalTokens == nil || *resp.TotalTokens != 19 {
+		t.Fatalf("expected 19 input tokens, got %+v", resp)
+	}
+	if resp.ExtraFields.RequestType != schemas.CountTokensRequest || resp.ExtraFields.Provider != schemas.Moonshot {
+		t.Fatalf("unexpected extra fields: %+v", resp.ExtraFields)
+	}
+}
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return &noopLogEventBuilder{}
+}
+
+type noopLogEventBuilder struct{}
+
+func (b *noopLogEventBuilder) Str(key, val string) schemas.LogEventBuilder         { return b }
+func (b *noopLogEventBuilder) Int(key string, val int) schemas.LogEventBuilder     { return b }
+func (b *noopLogEventBuilder) Int64(key string, val int64) schemas.LogEventBuilder { return b }
+func (b *noopLogEventBuilder) Send()                                               {}
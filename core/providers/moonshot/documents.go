@@ -0,0 +1,179 @@
+package moonshot
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/providers/openai"
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// moonshotPathFiles is the path of Moonshot's files API, used to extract the text of documents.
+const moonshotPathFiles = "/v1/files"
+
+// moonshotFilePurposeExtract asks Moonshot to extract the text of an uploaded file.
+const moonshotFilePurposeExtract = "file-extract"
+
+// extractDocuments replaces the document blocks of request with the text Moonshot extracts from them.
+// Moonshot's chat API doesn't accept documents: each one is uploaded with purpose file-extract and its
+// content is sent as a system message placed before the message that referenced it, as Moonshot documents.
+// Uploaded files are deleted once read. Requests without documents or sent raw are returned unchanged.
+func (provider *MoonshotProvider) extractDocuments(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatRequest, *schemas.BifrostError) {
+	if _, ok := providerUtils.CheckAndGetRawRequestBody(ctx, request); ok {
+		return request, nil
+	}
+	hasDocuments := false
+	for _, msg := range request.Input {
+		if msg.Content == nil {
+			continue
+		}
+		for _, block := range msg.Content.ContentBlocks {
+			if block.Document != nil {
+				hasDocuments = true
+			}
+		}
+	}
+	if !hasDocuments {
+		return request, nil
+	}
+
+	input := make([]schemas.ChatMessage, 0, len(request.Input))
+	for _, msg := range request.Input {
+		if msg.Content == nil || len(msg.Content.ContentBlocks) == 0 {
+			input = append(input, msg)
+			continue
+		}
+		var blocks []schemas.ChatContentBlock
+		for _, block := range msg.Content.ContentBlocks {
+			if block.Document == nil {
+				blocks = append(blocks, block)
+				continue
+			}
+			content, bifrostErr := provider.extractDocument(ctx, key, request.Model, block.Document)
+			if bifrostErr != nil {
+				return nil, bifrostErr
+			}
+			input = append(input, schemas.ChatMessage{
+				Role:    schemas.ChatMessageRoleSystem,
+				Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(content)},
+			})
+		}
+		// A message holding only documents is fully replaced by their content
+		if len(blocks) == 0 {
+			continue
+		}
+		contentCopy := *msg.Content
+		contentCopy.ContentBlocks = blocks
+		msg.Content = &contentCopy
+		input = append(input, msg)
+	}
+
+	requestCopy := *request
+	requestCopy.Input = input
+	return &requestCopy, nil
+}
+
+// extractDocument uploads a document to Moonshot and returns its extracted content.
+func (provider *MoonshotProvider) extractDocument(ctx *schemas.BifrostContext, key schemas.Key, model string, document *schemas.ChatInputDocument) (string, *schemas.BifrostError) {
+	var data []byte
+	switch {
+	case document.Data != nil && *document.Data != "":
+		encoded := *document.Data
+		if strings.HasPrefix(encoded, "data:") {
+			_, encoded, _ = strings.Cut(encoded, ",")
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return "", providerUtils.NewBifrostOperationError("failed to decode document data", err, provider.GetProviderKey())
+		}
+		data = decoded
+	case document.URL != nil && *document.URL != "":
+		downloaded, _, err := providerUtils.DownloadDocument(ctx, *document.URL)
+		if err != nil {
+			return "", providerUtils.NewBifrostOperationError(err.Error(), err, provider.GetProviderKey())
+		}
+		data = downloaded
+	default:
+		return "", providerUtils.NewBifrostOperationError("document must have data or a url", nil, provider.GetProviderKey())
+	}
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.WriteField("purpose", moonshotFilePurposeExtract); err != nil {
+		return "", providerUtils.NewBifrostOperationError("failed to write purpose field", err, provider.GetProviderKey())
+	}
+	part, err := writer.CreateFormFile("file", document.GetFilename())
+	if err != nil {
+		return "", providerUtils.NewBifrostOperationError("failed to create file field", err, provider.GetProviderKey())
+	}
+	if _, err := part.Write(data); err != nil {
+		return "", providerUtils.NewBifrostOperationError("failed to write file data", err, provider.GetProviderKey())
+	}
+	if err := writer.Close(); err != nil {
+		return "", providerUtils.NewBifrostOperationError("failed to close multipart writer", err, provider.GetProviderKey())
+	}
+
+	filesURL := provider.baseURLs.BaseURL(ctx, key) + moonshotPathFiles
+	uploadBody, bifrostErr := provider.doFileRequest(ctx, key, model, http.MethodPost, filesURL, writer.FormDataContentType(), body.Bytes())
+	if bifrostErr != nil {
+		return "", bifrostErr
+	}
+	var file struct {
+		ID string `json:"id"`
+	}
+	if err := sonic.Unmarshal(uploadBody, &file); err != nil || file.ID == "" {
+		return "", providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, provider.GetProviderKey())
+	}
+	defer func() {
+		if _, bifrostErr := provider.doFileRequest(ctx, key, model, http.MethodDelete, filesURL+"/"+file.ID, "", nil); bifrostErr != nil && bifrostErr.Error != nil {
+			provider.logger.Warn(fmt.Sprintf("failed to delete moonshot file %s: %s", file.ID, bifrostErr.Error.Message))
+		}
+	}()
+
+	content, bifrostErr := provider.doFileRequest(ctx, key, model, http.MethodGet, filesURL+"/"+file.ID+"/content", "", nil)
+	if bifrostErr != nil {
+		return "", bifrostErr
+	}
+	return string(content), nil
+}
+
+// doFileRequest sends a request to Moonshot's files API and returns a copy of the response body.
+func (provider *MoonshotProvider) doFileRequest(ctx *schemas.BifrostContext, key schemas.Key, model, method, url, contentType string, body []byte) ([]byte, *schemas.BifrostError) {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	req.SetRequestURI(url)
+	req.Header.SetMethod(method)
+	if contentType != "" {
+		req.Header.SetContentType(contentType)
+	}
+	if key.Value.GetValue() != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
+	}
+	if body != nil {
+		req.SetBody(body)
+	}
+
+	if _, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp); bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if resp.StatusCode() != fasthttp.StatusOK {
+		provider.logger.Debug(fmt.Sprintf("error from moonshot files api: %s", string(resp.Body())))
+		return nil, openai.ParseOpenAIError(resp, schemas.ChatCompletionRequest, provider.GetProviderKey(), model)
+	}
+	responseBody, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
+	}
+	return append([]byte(nil), responseBody...), nil
+}
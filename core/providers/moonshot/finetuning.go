@@ -0,0 +1,109 @@
+package moonshot
+
+import (
+	"github.com/capsohq/bifrost/core/providers/openai"
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// moonshotPathFineTuningJobs is the path of Moonshot's OpenAI-compatible fine-tuning jobs API.
+const moonshotPathFineTuningJobs = "/v1/fine_tuning/jobs"
+
+// FineTuningJobCreate creates a fine-tuning job via Moonshot's API.
+func (provider *MoonshotProvider) FineTuningJobCreate(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostFineTuningJobCreateRequest) (*schemas.BifrostFineTuningJobCreateResponse, *schemas.BifrostError) {
+	return openai.HandleOpenAIFineTuningJobCreateRequest(
+		ctx,
+		provider.client,
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, moonshotPathFineTuningJobs),
+		request,
+		key,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+	)
+}
+
+// FineTuningJobList lists fine-tuning jobs via Moonshot's API.
+func (provider *MoonshotProvider) FineTuningJobList(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFineTuningJobListRequest) (*schemas.BifrostFineTuningJobListResponse, *schemas.BifrostError) {
+	return openai.HandleOpenAIFineTuningJobListRequest(
+		ctx,
+		provider.client,
+		provider.fineTuningURLBuilder(ctx),
+		keys,
+		request,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.logger,
+	)
+}
+
+// FineTuningJobRetrieve retrieves a specific fine-tuning job via Moonshot's API.
+func (provider *MoonshotProvider) FineTuningJobRetrieve(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFineTuningJobRetrieveRequest) (*schemas.BifrostFineTuningJobRetrieveResponse, *schemas.BifrostError) {
+	return openai.HandleOpenAIFineTuningJobRetrieveRequest(
+		ctx,
+		provider.client,
+		provider.fineTuningURLBuilder(ctx),
+		keys,
+		request,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+	)
+}
+
+// FineTuningJobCancel cancels a fine-tuning job via Moonshot's API.
+func (provider *MoonshotProvider) FineTuningJobCancel(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFineTuningJobCancelRequest) (*schemas.BifrostFineTuningJobCancelResponse, *schemas.BifrostError) {
+	return openai.HandleOpenAIFineTuningJobCancelRequest(
+		ctx,
+		provider.client,
+		provider.fineTuningURLBuilder(ctx),
+		keys,
+		request,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+	)
+}
+
+// FineTuningJobEvents lists the events of a fine-tuning job via Moonshot's API.
+func (provider *MoonshotProvider) FineTuningJobEvents(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFineTuningJobEventsRequest) (*schemas.BifrostFineTuningJobEventsResponse, *schemas.BifrostError) {
+	return openai.HandleOpenAIFineTuningJobEventsRequest(
+		ctx,
+		provider.client,
+		provider.fineTuningURLBuilder(ctx),
+		keys,
+		request,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+	)
+}
+
+// FineTuningJobCheckpoints lists the checkpoints of a fine-tuning job via Moonshot's API.
+func (provider *MoonshotProvider) FineTuningJobCheckpoints(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFineTuningJobCheckpointsRequest) (*schemas.BifrostFineTuningJobCheckpointsResponse, *schemas.BifrostError) {
+	return openai.HandleOpenAIFineTuningJobCheckpointsRequest(
+		ctx,
+		provider.client,
+		provider.fineTuningURLBuilder(ctx),
+		keys,
+		request,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+	)
+}
+
+// fineTuningURLBuilder builds fine-tuning URLs on the endpoint of each key, which can be the
+// international or the China endpoint.
+func (provider *MoonshotProvider) fineTuningURLBuilder(ctx *schemas.BifrostContext) openai.FineTuningURLBuilder {
+	return func(key schemas.Key, path string, _ schemas.RequestType) string {
+		return provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, path)
+	}
+}
@@ -44,6 +44,7 @@ func TestMoonshot(t *testing.T) {
 			End2EndToolCalling:    true,
 			AutomaticFunctionCall: true,
 			ListModels:            true,
+			CountTokens:           true,
 		},
 	}
 
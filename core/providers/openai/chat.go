@@ -1,6 +1,8 @@
 package openai
 
 import (
+	"context"
+	"encoding/base64"
 	"strings"
 
 	"github.com/capsohq/bifrost/core/providers/utils"
@@ -30,6 +32,7 @@ func ToOpenAIChatRequest(ctx *schemas.BifrostContext, bifrostReq *schemas.Bifros
 		Model:    bifrostReq.Model,
 		Messages: ConvertBifrostMessagesToOpenAIMessages(bifrostReq.Input),
 	}
+	openaiReq.Messages = convertDocumentsToFiles(ctx, openaiReq.Messages)
 
 	if bifrostReq.Params != nil {
 		openaiReq.ChatParameters = *bifrostReq.Params
@@ -42,6 +45,8 @@ func ToOpenAIChatRequest(ctx *schemas.BifrostContext, bifrostReq *schemas.Bifros
 	}
 	switch bifrostReq.Provider {
 	case schemas.OpenAI, schemas.Azure:
+		openaiReq.ContextID = nil
+		openaiReq.Messages = normalizeInputAudio(openaiReq.Messages, false)
 		return openaiReq
 	case schemas.XAI:
 		openaiReq.filterOpenAISpecificParameters()
@@ -75,6 +80,13 @@ func ToOpenAIChatRequest(ctx *schemas.BifrostContext, bifrostReq *schemas.Bifros
 	case schemas.Qwen:
 		openaiReq.filterOpenAISpecificParametersPreserveReasoning()
 		openaiReq.applyQwenCompatibility()
+		openaiReq.Messages = normalizeInputAudio(openaiReq.Messages, true)
+		return openaiReq
+	case schemas.Volcengine, schemas.ModelArk:
+		// context_id is sent to Volcengine's context chat endpoint
+		contextID := openaiReq.ContextID
+		openaiReq.filterOpenAISpecificParameters()
+		openaiReq.ContextID = contextID
 		return openaiReq
 	default:
 		// Check if provider is a custom provider
@@ -140,6 +152,121 @@ func (req *OpenAIChatRequest) filterOpenAISpecificParametersInternal(normalizeRe
 	if req.ChatParameters.WebSearchOptions != nil {
 		req.ChatParameters.WebSearchOptions = nil
 	}
+	if req.ChatParameters.ContextID != nil {
+		req.ChatParameters.ContextID = nil
+	}
+	// Audio IDs of previous responses can only be referenced on OpenAI
+	for i := range req.Messages {
+		if req.Messages[i].OpenAIChatAssistantMessage != nil {
+			req.Messages[i].OpenAIChatAssistantMessage.Audio = nil
+		}
+	}
+}
+
+// inputAudioFormats maps audio media types of data URLs to the input_audio format names.
+var inputAudioFormats = map[string]string{
+	"audio/wav":   "wav",
+	"audio/x-wav": "wav",
+	"audio/wave":  "wav",
+	"audio/mpeg":  "mp3",
+	"audio/mp3":   "mp3",
+	"audio/aac":   "aac",
+	"audio/flac":  "flac",
+	"audio/ogg":   "ogg",
+	"audio/webm":  "webm",
+	"audio/mp4":   "m4a",
+}
+
+// normalizeInputAudio converts input_audio blocks to the encoding expected by the provider.
+// OpenAI only accepts raw base64 data, so data URLs are unwrapped and their media type is used as the
+// format when none is set. Qwen-Omni expects a data URL or an http(s) URL, so raw base64 data is wrapped.
+// Messages whose content is modified are copied, leaving the request's messages untouched.
+func normalizeInputAudio(messages []OpenAIMessage, asDataURL bool) []OpenAIMessage {
+	for i, msg := range messages {
+		if msg.Content == nil {
+			continue
+		}
+		var blocks []schemas.ChatContentBlock
+		for j, block := range msg.Content.ContentBlocks {
+			if block.InputAudio == nil {
+				continue
+			}
+			audio := *block.InputAudio
+			isDataURL := strings.HasPrefix(audio.Data, "data:")
+			switch {
+			case asDataURL && !isDataURL && !strings.HasPrefix(audio.Data, "http://") && !strings.HasPrefix(audio.Data, "https://"):
+				audio.Data = "data:;base64," + audio.Data
+			case !asDataURL && isDataURL:
+				header, data, found := strings.Cut(audio.Data, ",")
+				if !found || !strings.HasSuffix(header, ";base64") {
+					continue
+				}
+				audio.Data = data
+				if audio.Format == nil {
+					mediaType := strings.TrimSuffix(strings.TrimPrefix(header, "data:"), ";base64")
+					if format, ok := inputAudioFormats[mediaType]; ok {
+						audio.Format = schemas.Ptr(format)
+					}
+				}
+			default:
+				continue
+			}
+			if blocks == nil {
+				blocks = append([]schemas.ChatContentBlock(nil), msg.Content.ContentBlocks...)
+			}
+			blocks[j].InputAudio = &audio
+		}
+		if blocks != nil {
+			contentCopy := *msg.Content
+			contentCopy.ContentBlocks = blocks
+			messages[i].Content = &contentCopy
+		}
+	}
+	return messages
+}
+
+// convertDocumentsToFiles converts document blocks to file inputs, the only document format accepted by
+// OpenAI compatible chat APIs. File inputs must carry the document data, so URL documents are downloaded.
+// A document that can't be downloaded is kept as a URL file input and rejected by the provider.
+// Messages whose content is modified are copied, leaving the request's messages untouched.
+func convertDocumentsToFiles(ctx *schemas.BifrostContext, messages []OpenAIMessage) []OpenAIMessage {
+	for i, msg := range messages {
+		if msg.Content == nil {
+			continue
+		}
+		var blocks []schemas.ChatContentBlock
+		for j, block := range msg.Content.ContentBlocks {
+			if block.Document == nil {
+				continue
+			}
+			if blocks == nil {
+				blocks = append([]schemas.ChatContentBlock(nil), msg.Content.ContentBlocks...)
+			}
+			blocks[j] = block.DocumentAsFile()
+			if block.Document.Data == nil && block.Document.URL != nil {
+				var downloadCtx context.Context = context.Background()
+				if ctx != nil {
+					downloadCtx = ctx
+				}
+				data, mimeType, err := utils.DownloadDocument(downloadCtx, *block.Document.URL)
+				if err != nil {
+					continue
+				}
+				if block.Document.MediaType != nil && *block.Document.MediaType != "" {
+					mimeType = *block.Document.MediaType
+				}
+				blocks[j].File.FileData = schemas.Ptr("data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(data))
+				blocks[j].File.FileType = schemas.Ptr(mimeType)
+				blocks[j].File.FileURL = nil
+			}
+		}
+		if blocks != nil {
+			contentCopy := *msg.Content
+			contentCopy.ContentBlocks = blocks
+			messages[i].Content = &contentCopy
+		}
+	}
+	return messages
 }
 
 func (req *OpenAIChatRequest) applyDeepseekCompatibility() {
@@ -1,6 +1,9 @@
 package openai
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/capsohq/bifrost/core/schemas"
@@ -465,3 +468,124 @@ func TestApplyGLMCompatibility(t *testing.T) {
 		}
 	})
 }
+
+func TestToOpenAIChatRequestAudio(t *testing.T) {
+	newRequest := func(provider schemas.ModelProvider, audioData string) *schemas.BifrostChatRequest {
+		return &schemas.BifrostChatRequest{
+			Provider: provider,
+			Model:    "audio-model",
+			Input: []schemas.ChatMessage{
+				{
+					Role: schemas.ChatMessageRoleUser,
+					Content: &schemas.ChatMessageContent{ContentBlocks: []schemas.ChatContentBlock{
+						{Type: schemas.ChatContentBlockTypeText, Text: schemas.Ptr("What is said here?")},
+						{Type: schemas.ChatContentBlockTypeInputAudio, InputAudio: &schemas.ChatInputAudio{Data: audioData}},
+					}},
+				},
+				{
+					Role:    schemas.ChatMessageRoleAssistant,
+					Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("Hello there.")},
+					ChatAssistantMessage: &schemas.ChatAssistantMessage{
+						Audio: &schemas.ChatAudioMessageAudio{ID: "audio_1", Data: "UklGRg==", Transcript: "Hello there."},
+					},
+				},
+			},
+			Params: &schemas.ChatParameters{
+				Modalities: []string{schemas.ChatModalityText, schemas.ChatModalityAudio},
+				Audio:      &schemas.ChatAudioParameters{Voice: "alloy", Format: "wav"},
+			},
+		}
+	}
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+
+	t.Run("OpenAI unwraps data URLs and references previous audio by ID", func(t *testing.T) {
+		bifrostReq := newRequest(schemas.OpenAI, "data:audio/mpeg;base64,SUQz")
+		req := ToOpenAIChatRequest(ctx, bifrostReq)
+
+		audio := req.Messages[0].Content.ContentBlocks[1].InputAudio
+		if audio.Data != "SUQz" || audio.Format == nil || *audio.Format != "mp3" {
+			t.Fatalf("expected raw base64 mp3 input audio, got %#v", audio)
+		}
+		if original := bifrostReq.Input[0].Content.ContentBlocks[1].InputAudio; original.Data != "data:audio/mpeg;base64,SUQz" || original.Format != nil {
+			t.Fatalf("expected the request's input audio to be left untouched, got %#v", original)
+		}
+		assistantAudio := req.Messages[1].OpenAIChatAssistantMessage.Audio
+		if assistantAudio == nil || assistantAudio.ID != "audio_1" || assistantAudio.Data != "" {
+			t.Fatalf("expected only the audio ID to be sent, got %#v", assistantAudio)
+		}
+		if req.Audio == nil || req.Audio.Voice != "alloy" || len(req.Modalities) != 2 {
+			t.Fatalf("expected audio output parameters to be kept, got %#v %v", req.Audio, req.Modalities)
+		}
+	})
+
+	t.Run("Qwen wraps raw base64 in a data URL", func(t *testing.T) {
+		req := ToOpenAIChatRequest(ctx, newRequest(schemas.Qwen, "SUQz"))
+
+		if data := req.Messages[0].Content.ContentBlocks[1].InputAudio.Data; data != "data:;base64,SUQz" {
+			t.Fatalf("expected a data URL, got %q", data)
+		}
+		if req.Messages[1].OpenAIChatAssistantMessage.Audio != nil {
+			t.Fatalf("expected previous audio IDs to be dropped for Qwen")
+		}
+		if req.Audio == nil || req.Audio.Format != "wav" {
+			t.Fatalf("expected audio output parameters to be kept, got %#v", req.Audio)
+		}
+	})
+
+	t.Run("Qwen keeps audio URLs", func(t *testing.T) {
+		req := ToOpenAIChatRequest(ctx, newRequest(schemas.Qwen, "https://example.com/clip.wav"))
+
+		if data := req.Messages[0].Content.ContentBlocks[1].InputAudio.Data; data != "https://example.com/clip.wav" {
+			t.Fatalf("expected the URL to be kept, got %q", data)
+		}
+	})
+}
+
+func TestToOpenAIChatRequestDocuments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("%PDF-1.4"))
+	}))
+	defer server.Close()
+
+	bifrostReq := &schemas.BifrostChatRequest{
+		Provider: schemas.OpenAI,
+		Model:    "gpt-4o",
+		Input: []schemas.ChatMessage{{
+			Role: schemas.ChatMessageRoleUser,
+			Content: &schemas.ChatMessageContent{ContentBlocks: []schemas.ChatContentBlock{
+				{Type: schemas.ChatContentBlockTypeDocument, Document: &schemas.ChatInputDocument{Data: schemas.Ptr("JVBERi0xLjQ=")}},
+				{Type: schemas.ChatContentBlockTypeDocument, Document: &schemas.ChatInputDocument{URL: schemas.Ptr(server.URL + "/policy.pdf"), Title: schemas.Ptr("policy.pdf")}},
+				{Type: schemas.ChatContentBlockTypeText, Text: schemas.Ptr("Summarize these documents.")},
+			}},
+		}},
+	}
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+
+	openaiReq := ToOpenAIChatRequest(ctx, bifrostReq)
+	blocks := openaiReq.Messages[0].Content.ContentBlocks
+	if len(blocks) != 3 {
+		t.Fatalf("expected 3 blocks, got %d", len(blocks))
+	}
+
+	inline := blocks[0]
+	if inline.Type != schemas.ChatContentBlockTypeFile || inline.Document != nil || inline.File == nil {
+		t.Fatalf("expected the inline document to become a file block, got %+v", inline)
+	}
+	if *inline.File.FileData != "data:application/pdf;base64,JVBERi0xLjQ=" || *inline.File.Filename != "document.pdf" {
+		t.Errorf("unexpected file input: data=%s filename=%s", *inline.File.FileData, *inline.File.Filename)
+	}
+
+	downloaded := blocks[1]
+	if downloaded.File == nil || downloaded.File.FileURL != nil || downloaded.File.FileData == nil {
+		t.Fatalf("expected the URL document to be downloaded, got %+v", downloaded.File)
+	}
+	if *downloaded.File.FileData != "data:application/pdf;base64,JVBERi0xLjQ=" || *downloaded.File.Filename != "policy.pdf" {
+		t.Errorf("unexpected file input: data=%s filename=%s", *downloaded.File.FileData, *downloaded.File.Filename)
+	}
+
+	// The request's blocks are left unchanged
+	if original := bifrostReq.Input[0].Content.ContentBlocks[1]; original.Type != schemas.ChatContentBlockTypeDocument || original.File != nil {
+		t.Errorf("expected the request blocks to be unchanged, got %+v", original)
+	}
+}
@@ -0,0 +1,324 @@
+package openai
+
+import (
+	"net/http"
+	"net/url"
+
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// FineTuningURLBuilder returns the URL of a fine-tuning API path for the key the request is sent
+// with, so that providers with per-key endpoints can share the OpenAI fine-tuning handlers.
+type FineTuningURLBuilder func(key schemas.Key, path string, requestType schemas.RequestType) string
+
+// fineTuningJobsPath is the path of the OpenAI fine-tuning jobs API.
+const fineTuningJobsPath = "/v1/fine_tuning/jobs"
+
+// OpenAI fine-tuning list responses
+type openAIFineTuningJobList struct {
+	Object  string                  `json:"object"`
+	Data    []schemas.FineTuningJob `json:"data"`
+	HasMore bool                    `json:"has_more"`
+}
+
+type openAIFineTuningJobEventList struct {
+	Object  string                       `json:"object"`
+	Data    []schemas.FineTuningJobEvent `json:"data"`
+	HasMore bool                         `json:"has_more"`
+}
+
+type openAIFineTuningJobCheckpointList struct {
+	Object  string                            `json:"object"`
+	Data    []schemas.FineTuningJobCheckpoint `json:"data"`
+	FirstID *string                           `json:"first_id"`
+	LastID  *string                           `json:"last_id"`
+	HasMore bool                              `json:"has_more"`
+}
+
+// HandleOpenAIFineTuningJobCreateRequest creates a fine-tuning job through an OpenAI-compatible API.
+func HandleOpenAIFineTuningJobCreateRequest(
+	ctx *schemas.BifrostContext,
+	client *fasthttp.Client,
+	url string,
+	request *schemas.BifrostFineTuningJobCreateRequest,
+	key schemas.Key,
+	extraHeaders map[string]string,
+	providerName schemas.ModelProvider,
+	sendBackRawRequest bool,
+	sendBackRawResponse bool,
+) (*schemas.BifrostFineTuningJobCreateResponse, *schemas.BifrostError) {
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+	if request.TrainingFile == "" {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: training_file is required", nil, providerName)
+	}
+
+	// Build request body
+	reqBody := map[string]interface{}{
+		"model":         request.Model,
+		"training_file": request.TrainingFile,
+	}
+	if request.ValidationFile != nil {
+		reqBody["validation_file"] = *request.ValidationFile
+	}
+	if request.Hyperparameters != nil {
+		reqBody["hyperparameters"] = request.Hyperparameters
+	}
+	if request.Method != nil {
+		reqBody["method"] = request.Method
+	}
+	if request.Suffix != nil {
+		reqBody["suffix"] = *request.Suffix
+	}
+	if request.Seed != nil {
+		reqBody["seed"] = *request.Seed
+	}
+	if len(request.Metadata) > 0 {
+		reqBody["metadata"] = request.Metadata
+	}
+
+	// Merge ExtraParams into reqBody (do not overwrite mandatory keys)
+	for k, v := range request.ExtraParams {
+		if _, exists := reqBody[k]; !exists {
+			reqBody[k] = v
+		}
+	}
+
+	jsonBody, err := schemas.Marshal(reqBody)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
+	}
+
+	var job schemas.FineTuningJob
+	latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, client, http.MethodPost, url, jsonBody, key, extraHeaders, schemas.FineTuningJobCreateRequest, providerName, &job, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	return &schemas.BifrostFineTuningJobCreateResponse{
+		FineTuningJob: job,
+		ExtraFields:   jsonRequestExtraFields(providerName, schemas.FineTuningJobCreateRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse),
+	}, nil
+}
+
+// HandleOpenAIFineTuningJobListRequest lists fine-tuning jobs through an OpenAI-compatible API.
+// Uses SerialListHelper for multi-key pagination - exhausts all pages from one key before moving to next.
+func HandleOpenAIFineTuningJobListRequest(
+	ctx *schemas.BifrostContext,
+	client *fasthttp.Client,
+	buildURL FineTuningURLBuilder,
+	keys []schemas.Key,
+	request *schemas.BifrostFineTuningJobListRequest,
+	extraHeaders map[string]string,
+	providerName schemas.ModelProvider,
+	sendBackRawRequest bool,
+	sendBackRawResponse bool,
+	logger schemas.Logger,
+) (*schemas.BifrostFineTuningJobListResponse, *schemas.BifrostError) {
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+
+	// Initialize serial pagination helper for multi-key support
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, logger)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
+	}
+
+	// Get current key to query
+	key, nativeCursor, ok := helper.GetCurrentKey()
+	if !ok {
+		// All keys exhausted
+		return &schemas.BifrostFineTuningJobListResponse{
+			Object:  "list",
+			Data:    []schemas.FineTuningJob{},
+			HasMore: false,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				Provider:    providerName,
+				RequestType: schemas.FineTuningJobListRequest,
+			},
+		}, nil
+	}
+
+	requestURL := buildURL(key, fineTuningJobsPath, schemas.FineTuningJobListRequest) + listQuery(request.Limit, &nativeCursor, nil, nil)
+
+	var listResp openAIFineTuningJobList
+	latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, client, http.MethodGet, requestURL, nil, key, extraHeaders, schemas.FineTuningJobListRequest, providerName, &listResp, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	// Build cursor for next request (handles cross-key pagination)
+	var lastJobID string
+	if len(listResp.Data) > 0 {
+		lastJobID = listResp.Data[len(listResp.Data)-1].ID
+	}
+	nextCursor, hasMore := helper.BuildNextCursor(listResp.HasMore, lastJobID)
+
+	response := &schemas.BifrostFineTuningJobListResponse{
+		Object:      listResp.Object,
+		Data:        listResp.Data,
+		HasMore:     hasMore,
+		ExtraFields: jsonRequestExtraFields(providerName, schemas.FineTuningJobListRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse),
+	}
+	if response.Data == nil {
+		response.Data = []schemas.FineTuningJob{}
+	}
+	if nextCursor != "" {
+		response.After = &nextCursor
+	}
+	return response, nil
+}
+
+// HandleOpenAIFineTuningJobRetrieveRequest retrieves a fine-tuning job through an OpenAI-compatible API,
+// trying each key until one owns the job.
+func HandleOpenAIFineTuningJobRetrieveRequest(
+	ctx *schemas.BifrostContext,
+	client *fasthttp.Client,
+	buildURL FineTuningURLBuilder,
+	keys []schemas.Key,
+	request *schemas.BifrostFineTuningJobRetrieveRequest,
+	extraHeaders map[string]string,
+	providerName schemas.ModelProvider,
+	sendBackRawRequest bool,
+	sendBackRawResponse bool,
+) (*schemas.BifrostFineTuningJobRetrieveResponse, *schemas.BifrostError) {
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+	if request.JobID == "" {
+		return nil, providerUtils.NewBifrostOperationError("fine_tuning_job_id is required", nil, providerName)
+	}
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.JobID, keys, func(key schemas.Key) (*schemas.BifrostFineTuningJobRetrieveResponse, *schemas.BifrostError) {
+		var job schemas.FineTuningJob
+		requestURL := buildURL(key, fineTuningJobsPath+"/"+url.PathEscape(request.JobID), schemas.FineTuningJobRetrieveRequest)
+		latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, client, http.MethodGet, requestURL, nil, key, extraHeaders, schemas.FineTuningJobRetrieveRequest, providerName, &job, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		return &schemas.BifrostFineTuningJobRetrieveResponse{
+			FineTuningJob: job,
+			ExtraFields:   jsonRequestExtraFields(providerName, schemas.FineTuningJobRetrieveRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse),
+		}, nil
+	})
+}
+
+// HandleOpenAIFineTuningJobCancelRequest cancels a fine-tuning job through an OpenAI-compatible API,
+// trying each key until one owns the job.
+func HandleOpenAIFineTuningJobCancelRequest(
+	ctx *schemas.BifrostContext,
+	client *fasthttp.Client,
+	buildURL FineTuningURLBuilder,
+	keys []schemas.Key,
+	request *schemas.BifrostFineTuningJobCancelRequest,
+	extraHeaders map[string]string,
+	providerName schemas.ModelProvider,
+	sendBackRawRequest bool,
+	sendBackRawResponse bool,
+) (*schemas.BifrostFineTuningJobCancelResponse, *schemas.BifrostError) {
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+	if request.JobID == "" {
+		return nil, providerUtils.NewBifrostOperationError("fine_tuning_job_id is required", nil, providerName)
+	}
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.JobID, keys, func(key schemas.Key) (*schemas.BifrostFineTuningJobCancelResponse, *schemas.BifrostError) {
+		var job schemas.FineTuningJob
+		requestURL := buildURL(key, fineTuningJobsPath+"/"+url.PathEscape(request.JobID)+"/cancel", schemas.FineTuningJobCancelRequest)
+		latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, client, http.MethodPost, requestURL, nil, key, extraHeaders, schemas.FineTuningJobCancelRequest, providerName, &job, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		return &schemas.BifrostFineTuningJobCancelResponse{
+			FineTuningJob: job,
+			ExtraFields:   jsonRequestExtraFields(providerName, schemas.FineTuningJobCancelRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse),
+		}, nil
+	})
+}
+
+// HandleOpenAIFineTuningJobEventsRequest lists the events of a fine-tuning job through an
+// OpenAI-compatible API, trying each key until one owns the job.
+func HandleOpenAIFineTuningJobEventsRequest(
+	ctx *schemas.BifrostContext,
+	client *fasthttp.Client,
+	buildURL FineTuningURLBuilder,
+	keys []schemas.Key,
+	request *schemas.BifrostFineTuningJobEventsRequest,
+	extraHeaders map[string]string,
+	providerName schemas.ModelProvider,
+	sendBackRawRequest bool,
+	sendBackRawResponse bool,
+) (*schemas.BifrostFineTuningJobEventsResponse, *schemas.BifrostError) {
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+	if request.JobID == "" {
+		return nil, providerUtils.NewBifrostOperationError("fine_tuning_job_id is required", nil, providerName)
+	}
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.JobID, keys, func(key schemas.Key) (*schemas.BifrostFineTuningJobEventsResponse, *schemas.BifrostError) {
+		var listResp openAIFineTuningJobEventList
+		requestURL := buildURL(key, fineTuningJobsPath+"/"+url.PathEscape(request.JobID)+"/events", schemas.FineTuningJobEventsRequest) + listQuery(request.Limit, request.After, nil, nil)
+		latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, client, http.MethodGet, requestURL, nil, key, extraHeaders, schemas.FineTuningJobEventsRequest, providerName, &listResp, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		response := &schemas.BifrostFineTuningJobEventsResponse{
+			Object:      listResp.Object,
+			Data:        listResp.Data,
+			HasMore:     listResp.HasMore,
+			ExtraFields: jsonRequestExtraFields(providerName, schemas.FineTuningJobEventsRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse),
+		}
+		if response.Data == nil {
+			response.Data = []schemas.FineTuningJobEvent{}
+		}
+		return response, nil
+	})
+}
+
+// HandleOpenAIFineTuningJobCheckpointsRequest lists the checkpoints of a fine-tuning job through an
+// OpenAI-compatible API, trying each key until one owns the job.
+func HandleOpenAIFineTuningJobCheckpointsRequest(
+	ctx *schemas.BifrostContext,
+	client *fasthttp.Client,
+	buildURL FineTuningURLBuilder,
+	keys []schemas.Key,
+	request *schemas.BifrostFineTuningJobCheckpointsRequest,
+	extraHeaders map[string]string,
+	providerName schemas.ModelProvider,
+	sendBackRawRequest bool,
+	sendBackRawResponse bool,
+) (*schemas.BifrostFineTuningJobCheckpointsResponse, *schemas.BifrostError) {
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+	if request.JobID == "" {
+		return nil, providerUtils.NewBifrostOperationError("fine_tuning_job_id is required", nil, providerName)
+	}
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.JobID, keys, func(key schemas.Key) (*schemas.BifrostFineTuningJobCheckpointsResponse, *schemas.BifrostError) {
+		var listResp openAIFineTuningJobCheckpointList
+		requestURL := buildURL(key, fineTuningJobsPath+"/"+url.PathEscape(request.JobID)+"/checkpoints", schemas.FineTuningJobCheckpointsRequest) + listQuery(request.Limit, request.After, nil, nil)
+		latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, client, http.MethodGet, requestURL, nil, key, extraHeaders, schemas.FineTuningJobCheckpointsRequest, providerName, &listResp, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		response := &schemas.BifrostFineTuningJobCheckpointsResponse{
+			Object:      listResp.Object,
+			Data:        listResp.Data,
+			FirstID:     listResp.FirstID,
+			LastID:      listResp.LastID,
+			HasMore:     listResp.HasMore,
+			ExtraFields: jsonRequestExtraFields(providerName, schemas.FineTuningJobCheckpointsRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse),
+		}
+		if response.Data == nil {
+			response.Data = []schemas.FineTuningJobCheckpoint{}
+		}
+		return response, nil
+	})
+}
@@ -0,0 +1,160 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// newFineTuningServer serves the fine-tuning jobs API with one job per key, so that multi-key
+// operations have to find the key owning a job.
+func newFineTuningServer(t *testing.T, createdBody *map[string]interface{}) *httptest.Server {
+	jobs := map[string]string{"Bearer key-a": "ftjob-a", "Bearer key-b": "ftjob-b"}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		jobID, ok := jobs[r.Header.Get("Authorization")]
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"message":"invalid key","type":"invalid_request_error"}}`))
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/v1/fine_tuning/jobs")
+		switch {
+		case r.Method == http.MethodPost && path == "":
+			if err := json.NewDecoder(r.Body).Decode(createdBody); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			fmt.Fprintf(w, `{"id":%q,"object":"fine_tuning.job","model":"gpt-4o-mini-2024-07-18","created_at":1,"status":"validating_files","training_file":"file-train"}`, jobID)
+		case r.Method == http.MethodGet && path == "":
+			fmt.Fprintf(w, `{"object":"list","data":[{"id":%q,"model":"gpt-4o-mini-2024-07-18","status":"running","training_file":"file-train"}],"has_more":false}`, jobID)
+		case path == "/"+jobID+"/events":
+			if r.URL.Query().Get("limit") != "2" || r.URL.Query().Get("after") != "ftevent-1" {
+				t.Errorf("events query = %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"object":"list","data":[{"id":"ftevent-2","created_at":2,"level":"info","message":"Step 10/100: training loss=0.51","type":"metrics","data":{"step":10,"train_loss":0.51}}],"has_more":true}`))
+		case path == "/"+jobID+"/cancel" && r.Method == http.MethodPost:
+			fmt.Fprintf(w, `{"id":%q,"model":"gpt-4o-mini-2024-07-18","status":"cancelled","training_file":"file-train"}`, jobID)
+		case path == "/"+jobID:
+			fmt.Fprintf(w, `{"id":%q,"model":"gpt-4o-mini-2024-07-18","status":"succeeded","fine_tuned_model":"ft:gpt-4o-mini:org::abc","training_file":"file-train","trained_tokens":1200}`, jobID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"message":"job not found","type":"invalid_request_error"}}`))
+		}
+	}))
+}
+
+func TestOpenAIFineTuningJobs(t *testing.T) {
+	var createdBody map[string]interface{}
+	server := newFineTuningServer(t, &createdBody)
+	defer server.Close()
+
+	provider := NewOpenAIProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			BaseURL:                        server.URL,
+			DefaultRequestTimeoutInSeconds: 10,
+		},
+	}, &testLogger{})
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	keyA := schemas.Key{ID: "a", Value: *schemas.NewEnvVar("key-a")}
+	keyB := schemas.Key{ID: "b", Value: *schemas.NewEnvVar("key-b")}
+	keys := []schemas.Key{keyA, keyB}
+
+	t.Run("Create", func(t *testing.T) {
+		resp, bifrostErr := provider.FineTuningJobCreate(ctx, keyA, &schemas.BifrostFineTuningJobCreateRequest{
+			Provider:     schemas.OpenAI,
+			Model:        "gpt-4o-mini-2024-07-18",
+			TrainingFile: "file-train",
+			Suffix:       schemas.Ptr("support"),
+			Method: &schemas.FineTuningMethod{
+				Type:       "supervised",
+				Supervised: &schemas.FineTuningMethodConfig{Hyperparameters: &schemas.FineTuningHyperparameters{NEpochs: 3}},
+			},
+			ExtraParams: map[string]interface{}{"integrations": []interface{}{}, "model": "ignored"},
+		})
+		if bifrostErr != nil {
+			t.Fatalf("FineTuningJobCreate() error = %v", bifrostErr.Error)
+		}
+		if resp.ID != "ftjob-a" || resp.Status != schemas.FineTuningJobStatusValidatingFiles {
+			t.Errorf("unexpected response: %+v", resp.FineTuningJob)
+		}
+		if createdBody["model"] != "gpt-4o-mini-2024-07-18" || createdBody["suffix"] != "support" || createdBody["integrations"] == nil {
+			t.Errorf("request body = %v", createdBody)
+		}
+		method, _ := createdBody["method"].(map[string]interface{})
+		if method["type"] != "supervised" {
+			t.Errorf("method = %v", createdBody["method"])
+		}
+		if resp.ExtraFields.RequestType != schemas.FineTuningJobCreateRequest || resp.ExtraFields.Provider != schemas.OpenAI {
+			t.Errorf("extra fields = %+v", resp.ExtraFields)
+		}
+	})
+
+	t.Run("ListPagesThroughKeys", func(t *testing.T) {
+		var ids []string
+		request := &schemas.BifrostFineTuningJobListRequest{Provider: schemas.OpenAI}
+		for page := 0; page < 3; page++ {
+			resp, bifrostErr := provider.FineTuningJobList(ctx, keys, request)
+			if bifrostErr != nil {
+				t.Fatalf("FineTuningJobList() error = %v", bifrostErr.Error)
+			}
+			for _, job := range resp.Data {
+				ids = append(ids, job.ID)
+			}
+			if !resp.HasMore {
+				break
+			}
+			request.After = resp.After
+		}
+		if strings.Join(ids, ",") != "ftjob-a,ftjob-b" {
+			t.Errorf("listed jobs = %v", ids)
+		}
+	})
+
+	t.Run("RetrieveFindsOwningKey", func(t *testing.T) {
+		resp, bifrostErr := provider.FineTuningJobRetrieve(ctx, keys, &schemas.BifrostFineTuningJobRetrieveRequest{Provider: schemas.OpenAI, JobID: "ftjob-b"})
+		if bifrostErr != nil {
+			t.Fatalf("FineTuningJobRetrieve() error = %v", bifrostErr.Error)
+		}
+		if resp.ID != "ftjob-b" || resp.FineTunedModel == nil || *resp.FineTunedModel != "ft:gpt-4o-mini:org::abc" || resp.TrainedTokens == nil || *resp.TrainedTokens != 1200 {
+			t.Errorf("unexpected response: %+v", resp.FineTuningJob)
+		}
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		resp, bifrostErr := provider.FineTuningJobCancel(ctx, keys, &schemas.BifrostFineTuningJobCancelRequest{Provider: schemas.OpenAI, JobID: "ftjob-a"})
+		if bifrostErr != nil {
+			t.Fatalf("FineTuningJobCancel() error = %v", bifrostErr.Error)
+		}
+		if resp.Status != schemas.FineTuningJobStatusCancelled {
+			t.Errorf("status = %s", resp.Status)
+		}
+	})
+
+	t.Run("Events", func(t *testing.T) {
+		resp, bifrostErr := provider.FineTuningJobEvents(ctx, keys, &schemas.BifrostFineTuningJobEventsRequest{
+			Provider: schemas.OpenAI,
+			JobID:    "ftjob-b",
+			Limit:    2,
+			After:    schemas.Ptr("ftevent-1"),
+		})
+		if bifrostErr != nil {
+			t.Fatalf("FineTuningJobEvents() error = %v", bifrostErr.Error)
+		}
+		if len(resp.Data) != 1 || resp.Data[0].Type != "metrics" || resp.Data[0].Data["train_loss"] != 0.51 || !resp.HasMore {
+			t.Errorf("unexpected response: %+v", resp)
+		}
+	})
+
+	t.Run("UnknownJob", func(t *testing.T) {
+		_, bifrostErr := provider.FineTuningJobRetrieve(ctx, keys, &schemas.BifrostFineTuningJobRetrieveRequest{Provider: schemas.OpenAI, JobID: "ftjob-missing"})
+		if bifrostErr == nil || bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected a not found error, got %+v", bifrostErr)
+		}
+	})
+}
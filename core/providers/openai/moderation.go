@@ -0,0 +1,36 @@
+package openai
+
+import (
+	"github.com/capsohq/bifrost/core/providers/utils"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// ToBifrostModerationRequest converts an OpenAI moderation request to Bifrost format
+func (request *OpenAIModerationRequest) ToBifrostModerationRequest(ctx *schemas.BifrostContext) *schemas.BifrostModerationRequest {
+	provider, model := schemas.ParseModelString(request.Model, utils.CheckAndSetDefaultProvider(ctx, schemas.OpenAI))
+
+	return &schemas.BifrostModerationRequest{
+		Provider:  provider,
+		Model:     model,
+		Input:     request.Input,
+		Params:    &schemas.ModerationParameters{ExtraParams: request.ExtraParams},
+		Fallbacks: schemas.ParseFallbacks(request.Fallbacks),
+	}
+}
+
+// ToOpenAIModerationRequest converts a Bifrost moderation request to OpenAI format
+func ToOpenAIModerationRequest(bifrostReq *schemas.BifrostModerationRequest) *OpenAIModerationRequest {
+	if bifrostReq == nil {
+		return nil
+	}
+
+	openaiReq := &OpenAIModerationRequest{
+		Model: bifrostReq.Model,
+		Input: bifrostReq.Input,
+	}
+
+	if bifrostReq.Params != nil {
+		openaiReq.ExtraParams = bifrostReq.Params.ExtraParams
+	}
+	return openaiReq
+}
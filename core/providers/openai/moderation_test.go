@@ -0,0 +1,89 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+func TestOpenAIModeration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/moderations" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("Authorization = %q", r.Header.Get("Authorization"))
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if body["input"] == "fail" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":{"message":"invalid input","type":"invalid_request_error"}}`))
+			return
+		}
+		if body["model"] != "omni-moderation-latest" || body["user"] != "u-1" {
+			t.Errorf("request body = %v", body)
+		}
+		w.Write([]byte(`{"id":"modr-1","model":"omni-moderation-2024-09-26","results":[{"flagged":true,"categories":{"violence":true,"harassment":false},"category_scores":{"violence":0.91,"harassment":0.02},"category_applied_input_types":{"violence":["text"]}}]}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			BaseURL:                        server.URL,
+			DefaultRequestTimeoutInSeconds: 10,
+		},
+	}, &testLogger{})
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyPassthroughExtraParams, true)
+	key := schemas.Key{Value: *schemas.NewEnvVar("test-key")}
+
+	resp, bifrostErr := provider.Moderation(ctx, key, &schemas.BifrostModerationRequest{
+		Provider: schemas.OpenAI,
+		Model:    "omni-moderation-latest",
+		Input:    &schemas.ModerationInput{Text: schemas.Ptr("some text")},
+		Params:   &schemas.ModerationParameters{ExtraParams: map[string]interface{}{"user": "u-1"}},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("Moderation() error = %v", bifrostErr.Error)
+	}
+	if resp.ID != "modr-1" || len(resp.Results) != 1 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	result := resp.Results[0]
+	if !result.Flagged || !result.Categories["violence"] || result.CategoryScores["violence"] != 0.91 || result.CategoryAppliedInputTypes["violence"][0] != "text" {
+		t.Errorf("result = %+v", result)
+	}
+	if resp.ExtraFields.RequestType != schemas.ModerationRequest || resp.ExtraFields.Provider != schemas.OpenAI {
+		t.Errorf("extra fields = %+v", resp.ExtraFields)
+	}
+
+	_, bifrostErr = provider.Moderation(ctx, key, &schemas.BifrostModerationRequest{
+		Provider: schemas.OpenAI,
+		Model:    "omni-moderation-latest",
+		Input:    &schemas.ModerationInput{Text: schemas.Ptr("fail")},
+	})
+	if bifrostErr == nil || bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Moderation() error = %+v, want a 400", bifrostErr)
+	}
+}
@@ -32,6 +32,35 @@ type OpenAIProvider struct {
 	customProviderConfig *schemas.CustomProviderConfig // Custom provider config
 }
 
+// Compile-time checks for the capabilities OpenAIProvider implements.
+var (
+	_ schemas.ListModelsProvider      = (*OpenAIProvider)(nil)
+	_ schemas.TextCompletionProvider  = (*OpenAIProvider)(nil)
+	_ schemas.ChatProvider            = (*OpenAIProvider)(nil)
+	_ schemas.ResponsesProvider       = (*OpenAIProvider)(nil)
+	_ schemas.CountTokensProvider     = (*OpenAIProvider)(nil)
+	_ schemas.EmbeddingProvider       = (*OpenAIProvider)(nil)
+	_ schemas.SpeechProvider          = (*OpenAIProvider)(nil)
+	_ schemas.TranscriptionProvider   = (*OpenAIProvider)(nil)
+	_ schemas.TranslationProvider     = (*OpenAIProvider)(nil)
+	_ schemas.ImageGenerationProvider = (*OpenAIProvider)(nil)
+	_ schemas.ImageEditProvider       = (*OpenAIProvider)(nil)
+	_ schemas.ImageVariationProvider  = (*OpenAIProvider)(nil)
+	_ schemas.ModerationProvider      = (*OpenAIProvider)(nil)
+	_ schemas.RealtimeProvider        = (*OpenAIProvider)(nil)
+	_ schemas.VideoProvider           = (*OpenAIProvider)(nil)
+	_ schemas.BatchProvider           = (*OpenAIProvider)(nil)
+	_ schemas.FileProvider            = (*OpenAIProvider)(nil)
+	_ schemas.ContainerProvider       = (*OpenAIProvider)(nil)
+	_ schemas.ContainerFileProvider   = (*OpenAIProvider)(nil)
+	_ schemas.FineTuningProvider      = (*OpenAIProvider)(nil)
+	_ schemas.VectorStoreProvider     = (*OpenAIProvider)(nil)
+)
+
+func init() {
+	schemas.RegisterProviderCapabilities(schemas.OpenAI, schemas.CapabilitiesOf((*OpenAIProvider)(nil)))
+}
+
 // NewOpenAIProvider creates a new OpenAI provider instance.
 // It initializes the HTTP client with the provided configuration and sets up response pools.
 // The client is configured with timeouts, concurrency limits, and optional proxy settings.
@@ -75,6 +104,11 @@ func (provider *OpenAIProvider) GetProviderKey() schemas.ModelProvider {
 	return providerUtils.GetProviderName(schemas.OpenAI, provider.customProviderConfig)
 }
 
+// HTTPClient returns the pooled client used for OpenAI API requests.
+func (provider *OpenAIProvider) HTTPClient() *fasthttp.Client {
+	return provider.client
+}
+
 // buildRequestURL constructs the full request URL using the provider's configuration.
 func (provider *OpenAIProvider) buildRequestURL(ctx *schemas.BifrostContext, defaultPath string, requestType schemas.RequestType) string {
 	path, isCompleteURL := providerUtils.GetRequestPath(ctx, defaultPath, provider.customProviderConfig, requestType)
@@ -802,6 +836,10 @@ func HandleOpenAIChatCompletionRequest(
 		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, body, sendBackRawRequest, sendBackRawResponse)
 	}
 
+	if thinkTagProviders[providerName] {
+		ExtractThinkTags(response)
+	}
+
 	response.ExtraFields.Provider = providerName
 	response.ExtraFields.ModelRequested = request.Model
 	response.ExtraFields.RequestType = schemas.ChatCompletionRequest
@@ -1013,6 +1051,7 @@ func HandleOpenAIChatCompletionStreaming(
 
 		var finishReason *string
 		var messageID string
+		thinkTags := newThinkTagSplitter(providerName)
 
 		for scanner.Scan() {
 			// If context was cancelled/timed out, let defer handle it
@@ -1096,6 +1135,9 @@ func HandleOpenAIChatCompletionStreaming(
 			if response.Choices == nil {
 				response.Choices = []schemas.BifrostResponseChoice{}
 			}
+			if thinkTags != nil {
+				thinkTags.split(&response)
+			}
 
 			if isResponsesToChatCompletionsFallback {
 				spreadResponses := response.ToBifrostResponsesStreamResponse(responsesStreamState)
@@ -1817,6 +1859,131 @@ func HandleOpenAIEmbeddingRequest(
 	return response, nil
 }
 
+// Moderation classifies the given text or images against OpenAI's content policy categories.
+func (provider *OpenAIProvider) Moderation(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostModerationRequest) (*schemas.BifrostModerationResponse, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.ModerationRequest); err != nil {
+		return nil, err
+	}
+
+	return HandleOpenAIModerationRequest(
+		ctx,
+		provider.client,
+		provider.buildRequestURL(ctx, "/v1/moderations", schemas.ModerationRequest),
+		request,
+		key,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.logger,
+	)
+}
+
+// Realtime connects the client to an OpenAI Realtime API session over WebSocket.
+func (provider *OpenAIProvider) Realtime(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRealtimeRequest) (*schemas.BifrostRealtimeResponse, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.RealtimeRequest); err != nil {
+		return nil, err
+	}
+
+	return HandleOpenAIRealtimeSession(
+		ctx,
+		provider.buildRequestURL(ctx, "/v1/realtime", schemas.RealtimeRequest),
+		request,
+		key,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		provider.logger,
+	)
+}
+
+// HandleOpenAIModerationRequest handles moderation requests for OpenAI-compatible APIs.
+func HandleOpenAIModerationRequest(
+	ctx *schemas.BifrostContext,
+	client *fasthttp.Client,
+	url string,
+	request *schemas.BifrostModerationRequest,
+	key schemas.Key,
+	extraHeaders map[string]string,
+	providerName schemas.ModelProvider,
+	sendBackRawRequest bool,
+	sendBackRawResponse bool,
+	logger schemas.Logger,
+) (*schemas.BifrostModerationResponse, *schemas.BifrostError) {
+	// Create request
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	// Set any extra headers from network config
+	providerUtils.SetExtraHeaders(ctx, req, extraHeaders, nil)
+
+	req.SetRequestURI(url)
+	req.Header.SetMethod(http.MethodPost)
+	req.Header.SetContentType("application/json")
+
+	if key.Value.GetValue() != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
+	}
+
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToOpenAIModerationRequest(request), nil
+		},
+		providerName)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	req.SetBody(jsonData)
+
+	// Make request
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, client, req, resp)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+	// Extract provider response headers early so they're available on error paths too
+	providerResponseHeaders := providerUtils.ExtractProviderResponseHeaders(resp)
+	ctx.SetValue(schemas.BifrostContextKeyProviderResponseHeaders, providerResponseHeaders)
+
+	// Handle error response
+	if resp.StatusCode() != fasthttp.StatusOK {
+		logger.Debug(fmt.Sprintf("error from %s provider: %s", providerName, string(resp.Body())))
+		return nil, providerUtils.EnrichError(ctx, ParseOpenAIError(resp, schemas.ModerationRequest, providerName, request.Model), jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	body, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, providerUtils.EnrichError(ctx, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName), jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	response := &schemas.BifrostModerationResponse{}
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, response, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, body, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	response.ExtraFields.Provider = providerName
+	response.ExtraFields.ModelRequested = request.Model
+	response.ExtraFields.RequestType = schemas.ModerationRequest
+	response.ExtraFields.Latency = latency.Milliseconds()
+	response.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+
+	// Set raw request if enabled
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+
+	// Set raw response if enabled
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
+
+	return response, nil
+}
+
 // Speech handles non-streaming speech synthesis requests.
 // It formats the request body, makes the API call, and returns the response.
 // Returns the response and any error that occurred.
@@ -1840,11 +2007,6 @@ func (provider *OpenAIProvider) Speech(ctx *schemas.BifrostContext, key schemas.
 	)
 }
 
-// Rerank is not supported by the OpenAI provider.
-func (provider *OpenAIProvider) Rerank(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRerankRequest) (*schemas.BifrostRerankResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.RerankRequest, provider.GetProviderKey())
-}
-
 // HandleOpenAISpeechRequest handles speech requests for OpenAI-compatible APIs.
 // This shared function reduces code duplication between providers that use the same speech request format.
 func HandleOpenAISpeechRequest(
@@ -2346,6 +2508,144 @@ func HandleOpenAITranscriptionRequest(
 	return response, nil
 }
 
+// Translation handles non-streaming audio translation requests.
+// The audio is sent as a multipart form and the response contains the English translation.
+func (provider *OpenAIProvider) Translation(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostTranslationRequest) (*schemas.BifrostTranslationResponse, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.TranslationRequest); err != nil {
+		return nil, err
+	}
+
+	return HandleOpenAITranslationRequest(
+		ctx,
+		provider.client,
+		provider.buildRequestURL(ctx, "/v1/audio/translations", schemas.TranslationRequest),
+		request,
+		key,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.logger,
+	)
+}
+
+// HandleOpenAITranslationRequest sends an audio translation request to an OpenAI-compatible
+// /audio/translations endpoint. Plain text response formats (text, srt, vtt) are returned in the Text field.
+func HandleOpenAITranslationRequest(
+	ctx *schemas.BifrostContext,
+	client *fasthttp.Client,
+	url string,
+	request *schemas.BifrostTranslationRequest,
+	key schemas.Key,
+	extraHeaders map[string]string,
+	providerName schemas.ModelProvider,
+	sendBackRawResponse bool,
+	logger schemas.Logger,
+) (*schemas.BifrostTranslationResponse, *schemas.BifrostError) {
+	if request.Input == nil || request.Input.File == nil {
+		return nil, providerUtils.NewBifrostOperationError("translation input is not provided", nil, providerName)
+	}
+
+	// Create request
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	// Set any extra headers from network config
+	providerUtils.SetExtraHeaders(ctx, req, extraHeaders, nil)
+
+	req.SetRequestURI(url)
+	req.Header.SetMethod(http.MethodPost)
+	if key.Value.GetValue() != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
+	}
+
+	// Create multipart form
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := ParseTranslationFormDataBodyFromRequest(writer, request, ctx.Value(schemas.BifrostContextKeyPassthroughExtraParams) == true, providerName); err != nil {
+		return nil, err
+	}
+
+	req.Header.SetContentType(writer.FormDataContentType()) // This sets multipart/form-data with boundary
+	req.SetBody(body.Bytes())
+
+	// Make request
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, client, req, resp)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	// Extract provider response headers early so they're available on error paths too
+	providerResponseHeaders := providerUtils.ExtractProviderResponseHeaders(resp)
+	ctx.SetValue(schemas.BifrostContextKeyProviderResponseHeaders, providerResponseHeaders)
+
+	// Handle error response
+	if resp.StatusCode() != fasthttp.StatusOK {
+		logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
+		return nil, ParseOpenAIError(resp, schemas.TranslationRequest, providerName, request.Model)
+	}
+
+	responseBody, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
+	}
+
+	// Check for empty response
+	trimmed := strings.TrimSpace(string(responseBody))
+	if len(trimmed) == 0 {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: true,
+			Error: &schemas.ErrorField{
+				Message: schemas.ErrProviderResponseEmpty,
+			},
+		}
+	}
+
+	copiedResponseBody := append([]byte(nil), responseBody...)
+
+	response := &schemas.BifrostTranslationResponse{}
+	var rawResponse interface{}
+	if isTextTranslationFormat(request.Params) {
+		response.Text = trimmed
+		rawResponse = trimmed
+	} else {
+		if err := sonic.Unmarshal(copiedResponseBody, response); err != nil {
+			// Check if it's an HTML response
+			if providerUtils.IsHTMLResponse(resp, copiedResponseBody) {
+				return nil, &schemas.BifrostError{
+					IsBifrostError: false,
+					Error: &schemas.ErrorField{
+						Message: schemas.ErrProviderResponseHTML,
+						Error:   errors.New(string(copiedResponseBody)),
+					},
+				}
+			}
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseUnmarshal, err, providerName)
+		}
+
+		// Parse raw response for RawResponse field
+		if sendBackRawResponse {
+			if err := sonic.Unmarshal(copiedResponseBody, &rawResponse); err != nil {
+				return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRawResponseUnmarshal, err, providerName)
+			}
+		}
+	}
+
+	response.ExtraFields = schemas.BifrostResponseExtraFields{
+		RequestType:             schemas.TranslationRequest,
+		Provider:                providerName,
+		ModelRequested:          request.Model,
+		Latency:                 latency.Milliseconds(),
+		ProviderResponseHeaders: providerResponseHeaders,
+	}
+
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
+
+	return response, nil
+}
+
 // TranscriptionStream performs a streaming transcription request to the OpenAI API.
 func (provider *OpenAIProvider) TranscriptionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
 	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.TranscriptionStreamRequest); err != nil {
@@ -4681,8 +4981,7 @@ func (provider *OpenAIProvider) FileRetrieve(ctx *schemas.BifrostContext, keys [
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -4702,25 +5001,23 @@ func (provider *OpenAIProvider) FileRetrieve(ctx *schemas.BifrostContext, keys [
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = ParseOpenAIError(resp, schemas.FileRetrieveRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.FileRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var openAIResp OpenAIFileResponse
@@ -4728,17 +5025,14 @@ func (provider *OpenAIProvider) FileRetrieve(ctx *schemas.BifrostContext, keys [
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
 		fasthttp.ReleaseResponse(resp)
 
 		return openAIResp.ToBifrostFileRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // FileDelete deletes a file from OpenAI by trying each key until successful.
@@ -4756,8 +5050,7 @@ func (provider *OpenAIProvider) FileDelete(ctx *schemas.BifrostContext, keys []s
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -4777,25 +5070,23 @@ func (provider *OpenAIProvider) FileDelete(ctx *schemas.BifrostContext, keys []s
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = ParseOpenAIError(resp, schemas.FileDeleteRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.FileDeleteRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var openAIResp OpenAIFileDeleteResponse
@@ -4803,8 +5094,7 @@ func (provider *OpenAIProvider) FileDelete(ctx *schemas.BifrostContext, keys []s
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -4830,9 +5120,7 @@ func (provider *OpenAIProvider) FileDelete(ctx *schemas.BifrostContext, keys []s
 		}
 
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // FileContent downloads file content from OpenAI by trying each key until found.
@@ -4847,8 +5135,7 @@ func (provider *OpenAIProvider) FileContent(ctx *schemas.BifrostContext, keys []
 		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -4867,25 +5154,23 @@ func (provider *OpenAIProvider) FileContent(ctx *schemas.BifrostContext, keys []
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
 			provider.logger.Debug("error from %s provider: %s", providerName, string(resp.Body()))
-			lastErr = ParseOpenAIError(resp, schemas.FileContentRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.FileContentRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		// Get content type from response
@@ -4908,9 +5193,7 @@ func (provider *OpenAIProvider) FileContent(ctx *schemas.BifrostContext, keys []
 				Latency:     latency.Milliseconds(),
 			},
 		}, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // VideoRemix remixes an existing video from the OpenAI provider.
@@ -5244,8 +5527,7 @@ func (provider *OpenAIProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -5265,24 +5547,22 @@ func (provider *OpenAIProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			lastErr = ParseOpenAIError(resp, schemas.BatchRetrieveRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.BatchRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var openAIResp OpenAIBatchResponse
@@ -5290,8 +5570,7 @@ func (provider *OpenAIProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -5300,9 +5579,7 @@ func (provider *OpenAIProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys
 		result := openAIResp.ToBifrostBatchRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse)
 		result.ExtraFields.RequestType = schemas.BatchRetrieveRequest
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // BatchCancel cancels a batch job by trying each key until successful.
@@ -5319,8 +5596,7 @@ func (provider *OpenAIProvider) BatchCancel(ctx *schemas.BifrostContext, keys []
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -5340,24 +5616,22 @@ func (provider *OpenAIProvider) BatchCancel(ctx *schemas.BifrostContext, keys []
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			lastErr = ParseOpenAIError(resp, schemas.BatchCancelRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.BatchCancelRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		var openAIResp OpenAIBatchResponse
@@ -5365,8 +5639,7 @@ func (provider *OpenAIProvider) BatchCancel(ctx *schemas.BifrostContext, keys []
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -5402,9 +5675,7 @@ func (provider *OpenAIProvider) BatchCancel(ctx *schemas.BifrostContext, keys []
 		}
 
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // BatchResults retrieves batch results by trying each key until successful.
@@ -5435,8 +5706,7 @@ func (provider *OpenAIProvider) BatchResults(ctx *schemas.BifrostContext, keys [
 	}
 
 	// Download the output file - try each key
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
 
@@ -5454,24 +5724,22 @@ func (provider *OpenAIProvider) BatchResults(ctx *schemas.BifrostContext, keys [
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			lastErr = ParseOpenAIError(resp, schemas.BatchResultsRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.BatchResultsRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		body, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -5505,9 +5773,7 @@ func (provider *OpenAIProvider) BatchResults(ctx *schemas.BifrostContext, keys [
 		}
 
 		return batchResultsResp, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // ContainerCreate creates a new container via OpenAI's API.
@@ -5801,8 +6067,7 @@ func (provider *OpenAIProvider) ContainerRetrieve(ctx *schemas.BifrostContext, k
 		return nil, err
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.ContainerID, keys, func(key schemas.Key) (*schemas.BifrostContainerRetrieveResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -5822,16 +6087,15 @@ func (provider *OpenAIProvider) ContainerRetrieve(ctx *schemas.BifrostContext, k
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			lastErr = ParseOpenAIError(resp, schemas.ContainerRetrieveRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.ContainerRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		// Parse response
@@ -5853,8 +6117,7 @@ func (provider *OpenAIProvider) ContainerRetrieve(ctx *schemas.BifrostContext, k
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		response := &schemas.BifrostContainerRetrieveResponse{
@@ -5884,9 +6147,7 @@ func (provider *OpenAIProvider) ContainerRetrieve(ctx *schemas.BifrostContext, k
 		fasthttp.ReleaseRequest(req)
 		fasthttp.ReleaseResponse(resp)
 		return response, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // ContainerDelete deletes a container via OpenAI's API.
@@ -5911,8 +6172,7 @@ func (provider *OpenAIProvider) ContainerDelete(ctx *schemas.BifrostContext, key
 		return nil, err
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.ContainerID, keys, func(key schemas.Key) (*schemas.BifrostContainerDeleteResponse, *schemas.BifrostError) {
 		// Create request
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
@@ -5932,16 +6192,15 @@ func (provider *OpenAIProvider) ContainerDelete(ctx *schemas.BifrostContext, key
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		// Handle error response
 		if resp.StatusCode() != fasthttp.StatusOK {
-			lastErr = ParseOpenAIError(resp, schemas.ContainerDeleteRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.ContainerDeleteRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		// Parse response
@@ -5957,8 +6216,7 @@ func (provider *OpenAIProvider) ContainerDelete(ctx *schemas.BifrostContext, key
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		response := &schemas.BifrostContainerDeleteResponse{
@@ -5982,9 +6240,7 @@ func (provider *OpenAIProvider) ContainerDelete(ctx *schemas.BifrostContext, key
 		fasthttp.ReleaseRequest(req)
 		fasthttp.ReleaseResponse(resp)
 		return response, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // =============================================================================
@@ -6283,8 +6539,7 @@ func (provider *OpenAIProvider) ContainerFileRetrieve(ctx *schemas.BifrostContex
 		return nil, providerUtils.NewBifrostOperationError("invalid request: file_id is required", nil, providerName)
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.ContainerID, keys, func(key schemas.Key) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
 
@@ -6300,26 +6555,24 @@ func (provider *OpenAIProvider) ContainerFileRetrieve(ctx *schemas.BifrostContex
 
 		latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
 		if bifrostErr != nil {
-			lastErr = bifrostErr
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		if resp.StatusCode() >= 400 {
-			lastErr = ParseOpenAIError(resp, schemas.ContainerFileRetrieveRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.ContainerFileRetrieveRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		// Decode response body (handles content-encoding like gzip)
 		responseBody, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 		sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 		sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
@@ -6336,10 +6589,9 @@ func (provider *OpenAIProvider) ContainerFileRetrieve(ctx *schemas.BifrostContex
 
 		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, &fileResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
-			lastErr = bifrostErr
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		containerFileRetrieveResponse := &schemas.BifrostContainerFileRetrieveResponse{
@@ -6367,9 +6619,7 @@ func (provider *OpenAIProvider) ContainerFileRetrieve(ctx *schemas.BifrostContex
 		fasthttp.ReleaseRequest(req)
 		fasthttp.ReleaseResponse(resp)
 		return containerFileRetrieveResponse, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // ContainerFileContent retrieves the content of a file from a container via OpenAI's API.
@@ -6400,8 +6650,7 @@ func (provider *OpenAIProvider) ContainerFileContent(ctx *schemas.BifrostContext
 		return nil, providerUtils.NewBifrostOperationError("invalid request: file_id is required", nil, providerName)
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.ContainerID, keys, func(key schemas.Key) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
 
@@ -6417,17 +6666,16 @@ func (provider *OpenAIProvider) ContainerFileContent(ctx *schemas.BifrostContext
 
 		latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
 		if bifrostErr != nil {
-			lastErr = bifrostErr
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		if resp.StatusCode() >= 400 {
-			lastErr = ParseOpenAIError(resp, schemas.ContainerFileContentRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.ContainerFileContentRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		// Get content type from response header
@@ -6441,8 +6689,7 @@ func (provider *OpenAIProvider) ContainerFileContent(ctx *schemas.BifrostContext
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 		content := append([]byte(nil), body...)
 
@@ -6469,9 +6716,7 @@ func (provider *OpenAIProvider) ContainerFileContent(ctx *schemas.BifrostContext
 		fasthttp.ReleaseRequest(req)
 		fasthttp.ReleaseResponse(resp)
 		return containerFileContentResponse, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 // ContainerFileDelete deletes a file from a container via OpenAI's API.
@@ -6502,8 +6747,7 @@ func (provider *OpenAIProvider) ContainerFileDelete(ctx *schemas.BifrostContext,
 		return nil, providerUtils.NewBifrostOperationError("invalid request: file_id is required", nil, providerName)
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.ContainerID, keys, func(key schemas.Key) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
 
@@ -6519,26 +6763,24 @@ func (provider *OpenAIProvider) ContainerFileDelete(ctx *schemas.BifrostContext,
 
 		latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
 		if bifrostErr != nil {
-			lastErr = bifrostErr
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		if resp.StatusCode() >= 400 {
-			lastErr = ParseOpenAIError(resp, schemas.ContainerFileDeleteRequest, providerName, "")
+			bifrostErr := ParseOpenAIError(resp, schemas.ContainerFileDeleteRequest, providerName, "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		// Decode response body (handles content-encoding like gzip)
 		responseBody, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, providerName)
 		}
 		sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 		sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
@@ -6551,10 +6793,9 @@ func (provider *OpenAIProvider) ContainerFileDelete(ctx *schemas.BifrostContext,
 
 		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, &deleteResp, nil, sendBackRawRequest, sendBackRawResponse)
 		if bifrostErr != nil {
-			lastErr = bifrostErr
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		containerFileDeleteResponse := &schemas.BifrostContainerFileDeleteResponse{
@@ -6578,7 +6819,136 @@ func (provider *OpenAIProvider) ContainerFileDelete(ctx *schemas.BifrostContext,
 		fasthttp.ReleaseRequest(req)
 		fasthttp.ReleaseResponse(resp)
 		return containerFileDeleteResponse, nil
+	})
+}
+
+// FineTuningJobCreate creates a fine-tuning job via OpenAI's API.
+func (provider *OpenAIProvider) FineTuningJobCreate(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostFineTuningJobCreateRequest) (*schemas.BifrostFineTuningJobCreateResponse, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FineTuningJobCreateRequest); err != nil {
+		return nil, err
+	}
+
+	return HandleOpenAIFineTuningJobCreateRequest(
+		ctx,
+		provider.client,
+		provider.buildRequestURL(ctx, fineTuningJobsPath, schemas.FineTuningJobCreateRequest),
+		request,
+		key,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+	)
+}
+
+// FineTuningJobList lists fine-tuning jobs via OpenAI's API.
+func (provider *OpenAIProvider) FineTuningJobList(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFineTuningJobListRequest) (*schemas.BifrostFineTuningJobListResponse, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FineTuningJobListRequest); err != nil {
+		return nil, err
+	}
+
+	return HandleOpenAIFineTuningJobListRequest(
+		ctx,
+		provider.client,
+		provider.fineTuningURLBuilder(ctx),
+		provider.multiKeyOperationKeys(keys),
+		request,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.logger,
+	)
+}
+
+// FineTuningJobRetrieve retrieves a specific fine-tuning job via OpenAI's API.
+func (provider *OpenAIProvider) FineTuningJobRetrieve(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFineTuningJobRetrieveRequest) (*schemas.BifrostFineTuningJobRetrieveResponse, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FineTuningJobRetrieveRequest); err != nil {
+		return nil, err
 	}
 
-	return nil, lastErr
+	return HandleOpenAIFineTuningJobRetrieveRequest(
+		ctx,
+		provider.client,
+		provider.fineTuningURLBuilder(ctx),
+		provider.multiKeyOperationKeys(keys),
+		request,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+	)
+}
+
+// FineTuningJobCancel cancels a fine-tuning job via OpenAI's API.
+func (provider *OpenAIProvider) FineTuningJobCancel(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFineTuningJobCancelRequest) (*schemas.BifrostFineTuningJobCancelResponse, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FineTuningJobCancelRequest); err != nil {
+		return nil, err
+	}
+
+	return HandleOpenAIFineTuningJobCancelRequest(
+		ctx,
+		provider.client,
+		provider.fineTuningURLBuilder(ctx),
+		provider.multiKeyOperationKeys(keys),
+		request,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+	)
+}
+
+// FineTuningJobEvents lists the events of a fine-tuning job via OpenAI's API.
+func (provider *OpenAIProvider) FineTuningJobEvents(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFineTuningJobEventsRequest) (*schemas.BifrostFineTuningJobEventsResponse, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FineTuningJobEventsRequest); err != nil {
+		return nil, err
+	}
+
+	return HandleOpenAIFineTuningJobEventsRequest(
+		ctx,
+		provider.client,
+		provider.fineTuningURLBuilder(ctx),
+		provider.multiKeyOperationKeys(keys),
+		request,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+	)
+}
+
+// FineTuningJobCheckpoints lists the checkpoints of a fine-tuning job via OpenAI's API.
+func (provider *OpenAIProvider) FineTuningJobCheckpoints(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFineTuningJobCheckpointsRequest) (*schemas.BifrostFineTuningJobCheckpointsResponse, *schemas.BifrostError) {
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.FineTuningJobCheckpointsRequest); err != nil {
+		return nil, err
+	}
+
+	return HandleOpenAIFineTuningJobCheckpointsRequest(
+		ctx,
+		provider.client,
+		provider.fineTuningURLBuilder(ctx),
+		provider.multiKeyOperationKeys(keys),
+		request,
+		provider.networkConfig.ExtraHeaders,
+		provider.GetProviderKey(),
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+	)
+}
+
+// fineTuningURLBuilder builds fine-tuning URLs on the configured base URL, honouring request path overrides.
+func (provider *OpenAIProvider) fineTuningURLBuilder(ctx *schemas.BifrostContext) FineTuningURLBuilder {
+	return func(_ schemas.Key, path string, requestType schemas.RequestType) string {
+		return provider.buildRequestURL(ctx, path, requestType)
+	}
+}
+
+// multiKeyOperationKeys returns the keys to use for a multi-key fine-tuning or vector store
+// operation; keyless custom providers get a single empty key.
+func (provider *OpenAIProvider) multiKeyOperationKeys(keys []schemas.Key) []schemas.Key {
+	if len(keys) == 0 && provider.customProviderConfig != nil && provider.customProviderConfig.IsKeyLess {
+		return []schemas.Key{{}}
+	}
+	return keys
 }
@@ -0,0 +1,235 @@
+package openai
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// OpenAIRealtimeEvent holds the fields of Realtime API events that Bifrost reads.
+type OpenAIRealtimeEvent struct {
+	Type     string                  `json:"type"`
+	Session  *OpenAIRealtimeSession  `json:"session,omitempty"`
+	Response *OpenAIRealtimeResponse `json:"response,omitempty"`
+}
+
+// OpenAIRealtimeSession holds the fields of a Realtime API session that Bifrost reads.
+type OpenAIRealtimeSession struct {
+	ID string `json:"id,omitempty"`
+}
+
+// OpenAIRealtimeResponse holds the fields of a Realtime API response that Bifrost reads.
+type OpenAIRealtimeResponse struct {
+	Usage *OpenAIRealtimeUsage `json:"usage,omitempty"`
+}
+
+// OpenAIRealtimeUsage is the usage of a Realtime API response, reported in its response.done event.
+type OpenAIRealtimeUsage struct {
+	TotalTokens       int `json:"total_tokens"`
+	InputTokens       int `json:"input_tokens"`
+	OutputTokens      int `json:"output_tokens"`
+	InputTokenDetails *struct {
+		TextTokens   int `json:"text_tokens"`
+		AudioTokens  int `json:"audio_tokens"`
+		ImageTokens  int `json:"image_tokens"`
+		CachedTokens int `json:"cached_tokens"`
+	} `json:"input_token_details,omitempty"`
+	OutputTokenDetails *struct {
+		TextTokens  int `json:"text_tokens"`
+		AudioTokens int `json:"audio_tokens"`
+	} `json:"output_token_details,omitempty"`
+}
+
+// ToBifrostLLMUsage converts Realtime API usage to Bifrost usage, keeping the text and audio token split.
+func (u *OpenAIRealtimeUsage) ToBifrostLLMUsage() *schemas.BifrostLLMUsage {
+	usage := &schemas.BifrostLLMUsage{
+		PromptTokens:     u.InputTokens,
+		CompletionTokens: u.OutputTokens,
+		TotalTokens:      u.TotalTokens,
+	}
+	if u.InputTokenDetails != nil {
+		usage.PromptTokensDetails = &schemas.ChatPromptTokensDetails{
+			TextTokens:       u.InputTokenDetails.TextTokens,
+			AudioTokens:      u.InputTokenDetails.AudioTokens,
+			ImageTokens:      u.InputTokenDetails.ImageTokens,
+			CachedReadTokens: u.InputTokenDetails.CachedTokens,
+		}
+	}
+	if u.OutputTokenDetails != nil {
+		usage.CompletionTokensDetails = &schemas.ChatCompletionTokensDetails{
+			TextTokens:  u.OutputTokenDetails.TextTokens,
+			AudioTokens: u.OutputTokenDetails.AudioTokens,
+		}
+	}
+	return usage
+}
+
+// HandleOpenAIRealtimeSession connects the client of a realtime request to a Realtime API session
+// over WebSocket and relays events until either side closes the connection. The provider prefix of
+// the model in session.update events is removed, and the usage of response.done events is summed up.
+func HandleOpenAIRealtimeSession(
+	ctx *schemas.BifrostContext,
+	url string,
+	request *schemas.BifrostRealtimeRequest,
+	key schemas.Key,
+	extraHeaders map[string]string,
+	providerName schemas.ModelProvider,
+	logger schemas.Logger,
+) (*schemas.BifrostRealtimeResponse, *schemas.BifrostError) {
+	realtimeURL, err := buildRealtimeURL(url, request.Model)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid realtime url", err, providerName)
+	}
+
+	headers := make(map[string]string, len(extraHeaders)+len(request.Headers)+1)
+	for name, value := range extraHeaders {
+		headers[name] = value
+	}
+	for name, value := range request.Headers {
+		headers[name] = value
+	}
+	if value := key.Value.GetValue(); value != "" {
+		headers["Authorization"] = "Bearer " + value
+	}
+
+	upstream, err := request.Dial(ctx, realtimeURL, headers)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to connect to realtime session", err, providerName)
+	}
+
+	session := &realtimeSession{modelPrefix: string(providerName) + "/"}
+	if err := providerUtils.RelayRealtime(ctx, request.Client, upstream, session.toProvider, session.toClient); err != nil {
+		logger.Debug("realtime session %s ended: %v", session.id, err)
+	}
+
+	return &schemas.BifrostRealtimeResponse{
+		SessionID: session.id,
+		Responses: session.responses,
+		Usage:     session.usage,
+	}, nil
+}
+
+// buildRealtimeURL turns the HTTP URL of the realtime endpoint into its WebSocket URL for the model.
+func buildRealtimeURL(rawURL string, model string) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+	switch parsed.Scheme {
+	case "https":
+		parsed.Scheme = "wss"
+	case "http":
+		parsed.Scheme = "ws"
+	}
+	query := parsed.Query()
+	query.Set("model", model)
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), nil
+}
+
+// realtimeSession tracks a relayed Realtime API session. Its fields are only written by the
+// direction relaying events to the client, and read once the relay has stopped.
+type realtimeSession struct {
+	modelPrefix string
+	id          string
+	responses   int
+	usage       *schemas.BifrostLLMUsage
+}
+
+var (
+	sessionUpdateEvent  = []byte(`"session.update"`)
+	sessionCreatedEvent = []byte(`"session.created"`)
+	responseDoneEvent   = []byte(`"response.done"`)
+)
+
+// toProvider removes the provider prefix from the model of session.update events, so that clients
+// can use the same provider/model names as for other requests.
+func (s *realtimeSession) toProvider(messageType int, data []byte) []byte {
+	// Most client events carry audio, so only events that can be session.update are decoded
+	if messageType != schemas.RealtimeTextMessage || !bytes.Contains(data, sessionUpdateEvent) {
+		return data
+	}
+	var event map[string]json.RawMessage
+	if err := schemas.Unmarshal(data, &event); err != nil {
+		return data
+	}
+	var eventType string
+	if err := schemas.Unmarshal(event["type"], &eventType); err != nil || eventType != "session.update" {
+		return data
+	}
+	var session map[string]json.RawMessage
+	if err := schemas.Unmarshal(event["session"], &session); err != nil {
+		return data
+	}
+	var model string
+	if err := schemas.Unmarshal(session["model"], &model); err != nil || !strings.HasPrefix(model, s.modelPrefix) {
+		return data
+	}
+	modelJSON, err := schemas.Marshal(strings.TrimPrefix(model, s.modelPrefix))
+	if err != nil {
+		return data
+	}
+	session["model"] = modelJSON
+	if event["session"], err = schemas.Marshal(session); err != nil {
+		return data
+	}
+	rewritten, err := schemas.Marshal(event)
+	if err != nil {
+		return data
+	}
+	return rewritten
+}
+
+// toClient records the session ID and the usage of completed responses. Events are forwarded as is.
+func (s *realtimeSession) toClient(messageType int, data []byte) []byte {
+	if messageType != schemas.RealtimeTextMessage || (!bytes.Contains(data, responseDoneEvent) && !bytes.Contains(data, sessionCreatedEvent)) {
+		return data
+	}
+	var event OpenAIRealtimeEvent
+	if err := schemas.Unmarshal(data, &event); err != nil {
+		return data
+	}
+	switch event.Type {
+	case "session.created":
+		if event.Session != nil {
+			s.id = event.Session.ID
+		}
+	case "response.done":
+		s.responses++
+		if event.Response != nil && event.Response.Usage != nil {
+			s.usage = addUsage(s.usage, event.Response.Usage.ToBifrostLLMUsage())
+		}
+	}
+	return data
+}
+
+// addUsage adds the token counts of usage to total.
+func addUsage(total, usage *schemas.BifrostLLMUsage) *schemas.BifrostLLMUsage {
+	if total == nil {
+		return usage
+	}
+	total.PromptTokens += usage.PromptTokens
+	total.CompletionTokens += usage.CompletionTokens
+	total.TotalTokens += usage.TotalTokens
+	if usage.PromptTokensDetails != nil {
+		if total.PromptTokensDetails == nil {
+			total.PromptTokensDetails = &schemas.ChatPromptTokensDetails{}
+		}
+		total.PromptTokensDetails.TextTokens += usage.PromptTokensDetails.TextTokens
+		total.PromptTokensDetails.AudioTokens += usage.PromptTokensDetails.AudioTokens
+		total.PromptTokensDetails.ImageTokens += usage.PromptTokensDetails.ImageTokens
+		total.PromptTokensDetails.CachedReadTokens += usage.PromptTokensDetails.CachedReadTokens
+	}
+	if usage.CompletionTokensDetails != nil {
+		if total.CompletionTokensDetails == nil {
+			total.CompletionTokensDetails = &schemas.ChatCompletionTokensDetails{}
+		}
+		total.CompletionTokensDetails.TextTokens += usage.CompletionTokensDetails.TextTokens
+		total.CompletionTokensDetails.AudioTokens += usage.CompletionTokensDetails.AudioTokens
+	}
+	return total
+}
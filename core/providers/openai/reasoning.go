@@ -0,0 +1,178 @@
+package openai
+
+import (
+	"strings"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	thinkOpenTag  = "<think>"
+	thinkCloseTag = "</think>"
+)
+
+// thinkTagProviders are the OpenAI-compatible providers that can return reasoning inline in the content,
+// wrapped in <think> tags (e.g. DeepSeek-R1 and Qwen3 served without a reasoning parser, Perplexity
+// sonar-reasoning, MiniMax M2). Their reasoning is moved to the Reasoning field like other providers'.
+var thinkTagProviders = map[schemas.ModelProvider]bool{
+	schemas.Groq:        true,
+	schemas.HuggingFace: true,
+	schemas.Minimax:     true,
+	schemas.Nebius:      true,
+	schemas.Ollama:      true,
+	schemas.Parasail:    true,
+	schemas.Perplexity:  true,
+	schemas.SGL:         true,
+	schemas.VLLM:        true,
+}
+
+// ExtractThinkTags moves a leading <think> block of each choice's content to its Reasoning field.
+// Choices that already carry reasoning are left unchanged. An unclosed block is all reasoning,
+// as happens when the model runs out of tokens while thinking.
+func ExtractThinkTags(response *schemas.BifrostChatResponse) {
+	for _, choice := range response.Choices {
+		if choice.ChatNonStreamResponseChoice == nil || choice.Message == nil {
+			continue
+		}
+		msg := choice.Message
+		if msg.Content == nil || msg.Content.ContentStr == nil {
+			continue
+		}
+		if msg.ChatAssistantMessage != nil && msg.ChatAssistantMessage.Reasoning != nil && *msg.ChatAssistantMessage.Reasoning != "" {
+			continue
+		}
+		rest, found := strings.CutPrefix(strings.TrimLeft(*msg.Content.ContentStr, " \t\r\n"), thinkOpenTag)
+		if !found {
+			continue
+		}
+		reasoning, content, _ := strings.Cut(rest, thinkCloseTag)
+		if msg.ChatAssistantMessage == nil {
+			msg.ChatAssistantMessage = &schemas.ChatAssistantMessage{}
+		}
+		setReasoning(&msg.ChatAssistantMessage.Reasoning, &msg.ChatAssistantMessage.ReasoningDetails, strings.TrimSpace(reasoning))
+		msg.Content.ContentStr = schemas.Ptr(strings.TrimLeft(content, " \t\r\n"))
+	}
+}
+
+// setReasoning sets reasoning and, like the chat message unmarshallers, a matching text reasoning detail.
+func setReasoning(reasoning **string, details *[]schemas.ChatReasoningDetails, text string) {
+	*reasoning = &text
+	*details = []schemas.ChatReasoningDetails{{Index: 0, Type: schemas.BifrostReasoningDetailsTypeText, Text: schemas.Ptr(text)}}
+}
+
+// thinkTagState is the position of a streamed choice relative to its leading <think> block.
+type thinkTagState int
+
+const (
+	thinkTagPending  thinkTagState = iota // No content seen yet, a <think> block may start
+	thinkTagInside                        // Inside the <think> block, content is reasoning
+	thinkTagTrailing                      // After </think>, leading whitespace is dropped
+	thinkTagDone                          // Content is passed through
+)
+
+// thinkTagSplitter moves the leading <think> block of streamed content to the Reasoning field of the deltas.
+// Tags can be split across chunks, so text that may be the start of a tag is held back until the next chunk.
+type thinkTagSplitter struct {
+	states  map[int]thinkTagState
+	pending map[int]string
+}
+
+// newThinkTagSplitter returns a splitter for providers that can inline reasoning in the content, and nil otherwise.
+func newThinkTagSplitter(providerName schemas.ModelProvider) *thinkTagSplitter {
+	if !thinkTagProviders[providerName] {
+		return nil
+	}
+	return &thinkTagSplitter{states: map[int]thinkTagState{}, pending: map[int]string{}}
+}
+
+// split rewrites the content deltas of a stream chunk. Text held back for a choice is released with its finish reason.
+func (s *thinkTagSplitter) split(response *schemas.BifrostChatResponse) {
+	for _, choice := range response.Choices {
+		if choice.ChatStreamResponseChoice == nil || choice.Delta == nil {
+			continue
+		}
+		delta := choice.Delta
+		if s.states[choice.Index] == thinkTagPending && delta.Reasoning != nil && *delta.Reasoning != "" {
+			// The provider already separates reasoning
+			s.states[choice.Index] = thinkTagDone
+		}
+		text := ""
+		if delta.Content != nil {
+			text = *delta.Content
+		}
+		reasoning, content := s.feed(choice.Index, text)
+		if choice.FinishReason != nil {
+			flushReasoning, flushContent := s.flush(choice.Index)
+			reasoning += flushReasoning
+			content += flushContent
+		}
+		if reasoning != "" {
+			setReasoning(&delta.Reasoning, &delta.ReasoningDetails, reasoning)
+		}
+		if delta.Content != nil || content != "" {
+			delta.Content = schemas.Ptr(content)
+		}
+	}
+}
+
+// feed consumes a content delta of a choice and returns its reasoning and content parts.
+func (s *thinkTagSplitter) feed(index int, text string) (reasoning, content string) {
+	buffer := s.pending[index] + text
+	s.pending[index] = ""
+	for {
+		switch s.states[index] {
+		case thinkTagPending:
+			trimmed := strings.TrimLeft(buffer, " \t\r\n")
+			if rest, found := strings.CutPrefix(trimmed, thinkOpenTag); found {
+				s.states[index] = thinkTagInside
+				buffer = rest
+				continue
+			}
+			if strings.HasPrefix(thinkOpenTag, trimmed) {
+				// Whitespace or a partial opening tag
+				s.pending[index] = buffer
+				return reasoning, content
+			}
+			s.states[index] = thinkTagDone
+			return reasoning, content + buffer
+		case thinkTagInside:
+			if before, after, found := strings.Cut(buffer, thinkCloseTag); found {
+				reasoning += before
+				s.states[index] = thinkTagTrailing
+				buffer = after
+				continue
+			}
+			held := partialSuffix(buffer, thinkCloseTag)
+			s.pending[index] = buffer[len(buffer)-held:]
+			return reasoning + buffer[:len(buffer)-held], content
+		case thinkTagTrailing:
+			buffer = strings.TrimLeft(buffer, " \t\r\n")
+			if buffer != "" {
+				s.states[index] = thinkTagDone
+			}
+			return reasoning, content + buffer
+		default:
+			return reasoning, content + buffer
+		}
+	}
+}
+
+// flush releases the text held back for a choice at the end of its stream.
+func (s *thinkTagSplitter) flush(index int) (reasoning, content string) {
+	pending := s.pending[index]
+	s.pending[index] = ""
+	if s.states[index] == thinkTagInside {
+		return pending, ""
+	}
+	return "", pending
+}
+
+// partialSuffix returns the length of the longest suffix of text that is a proper prefix of tag.
+func partialSuffix(text, tag string) int {
+	for n := min(len(text), len(tag)-1); n > 0; n-- {
+		if strings.HasSuffix(text, tag[:n]) {
+			return n
+		}
+	}
+	return 0
+}
@@ -0,0 +1,99 @@
+package openai
+
+import (
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestExtractThinkTags(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		wantReasoning *string
+		wantContent   string
+	}{
+		{"leading block", "<think>\nThe user greets me.\n</think>\n\nHello!", schemas.Ptr("The user greets me."), "Hello!"},
+		{"unclosed block", "<think>Still thinking", schemas.Ptr("Still thinking"), ""},
+		{"no block", "Hello <think>not reasoning</think>", nil, "Hello <think>not reasoning</think>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := &schemas.BifrostChatResponse{Choices: []schemas.BifrostResponseChoice{{
+				ChatNonStreamResponseChoice: &schemas.ChatNonStreamResponseChoice{Message: &schemas.ChatMessage{
+					Role:    schemas.ChatMessageRoleAssistant,
+					Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(tt.content)},
+				}},
+			}}}
+			ExtractThinkTags(response)
+			msg := response.Choices[0].Message
+			if *msg.Content.ContentStr != tt.wantContent {
+				t.Errorf("content = %q, want %q", *msg.Content.ContentStr, tt.wantContent)
+			}
+			if tt.wantReasoning == nil {
+				if msg.ChatAssistantMessage != nil {
+					t.Errorf("expected no reasoning, got %+v", msg.ChatAssistantMessage)
+				}
+				return
+			}
+			if msg.ChatAssistantMessage == nil || msg.Reasoning == nil || *msg.Reasoning != *tt.wantReasoning {
+				t.Fatalf("reasoning = %+v, want %q", msg.ChatAssistantMessage, *tt.wantReasoning)
+			}
+			if len(msg.ReasoningDetails) != 1 || *msg.ReasoningDetails[0].Text != *tt.wantReasoning {
+				t.Errorf("reasoning details = %+v", msg.ReasoningDetails)
+			}
+		})
+	}
+}
+
+func TestThinkTagSplitter(t *testing.T) {
+	streamChunk := func(content string, finish bool) *schemas.BifrostChatResponse {
+		choice := schemas.BifrostResponseChoice{
+			ChatStreamResponseChoice: &schemas.ChatStreamResponseChoice{Delta: &schemas.ChatStreamResponseChoiceDelta{Content: schemas.Ptr(content)}},
+		}
+		if finish {
+			choice.FinishReason = schemas.Ptr("stop")
+		}
+		return &schemas.BifrostChatResponse{Choices: []schemas.BifrostResponseChoice{choice}}
+	}
+	split := func(chunks []string) (reasoning, content string) {
+		splitter := newThinkTagSplitter(schemas.Ollama)
+		for i, text := range chunks {
+			chunk := streamChunk(text, i == len(chunks)-1)
+			splitter.split(chunk)
+			delta := chunk.Choices[0].Delta
+			if delta.Reasoning != nil {
+				reasoning += *delta.Reasoning
+			}
+			if delta.Content != nil {
+				content += *delta.Content
+			}
+		}
+		return reasoning, content
+	}
+
+	t.Run("tags split across chunks", func(t *testing.T) {
+		reasoning, content := split([]string{"\n<th", "ink>Let me", " count.</th", "ink>\n", "\nThree", "."})
+		if reasoning != "Let me count." || content != "Three." {
+			t.Errorf("got reasoning %q and content %q", reasoning, content)
+		}
+	})
+
+	t.Run("content without tags", func(t *testing.T) {
+		reasoning, content := split([]string{"<", "b>Bold</b> text"})
+		if reasoning != "" || content != "<b>Bold</b> text" {
+			t.Errorf("got reasoning %q and content %q", reasoning, content)
+		}
+	})
+
+	t.Run("held back text is flushed", func(t *testing.T) {
+		reasoning, content := split([]string{"<think>Out of tokens</thi"})
+		if reasoning != "Out of tokens</thi" || content != "" {
+			t.Errorf("got reasoning %q and content %q", reasoning, content)
+		}
+	})
+
+	if newThinkTagSplitter(schemas.OpenAI) != nil {
+		t.Errorf("expected no splitter for OpenAI")
+	}
+}
@@ -0,0 +1,95 @@
+package openai
+
+import (
+	"fmt"
+	"mime/multipart"
+
+	"github.com/capsohq/bifrost/core/providers/utils"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// ToBifrostTranslationRequest converts an OpenAI translation request to Bifrost format
+func (request *OpenAITranslationRequest) ToBifrostTranslationRequest(ctx *schemas.BifrostContext) *schemas.BifrostTranslationRequest {
+	provider, model := schemas.ParseModelString(request.Model, utils.CheckAndSetDefaultProvider(ctx, schemas.OpenAI))
+
+	return &schemas.BifrostTranslationRequest{
+		Provider: provider,
+		Model:    model,
+		Input: &schemas.TranscriptionInput{
+			File:     request.File,
+			Filename: request.Filename,
+		},
+		Params:    &request.TranslationParameters,
+		Fallbacks: schemas.ParseFallbacks(request.Fallbacks),
+	}
+}
+
+// isTextTranslationFormat reports whether the translation response format returns plain text instead of JSON.
+func isTextTranslationFormat(params *schemas.TranslationParameters) bool {
+	if params == nil || params.ResponseFormat == nil {
+		return false
+	}
+	switch *params.ResponseFormat {
+	case "text", "srt", "vtt":
+		return true
+	}
+	return false
+}
+
+// ParseTranslationFormDataBodyFromRequest writes the translation request to the multipart form.
+// Extra params are written as form fields only when passthroughExtraParams is set.
+func ParseTranslationFormDataBodyFromRequest(writer *multipart.Writer, request *schemas.BifrostTranslationRequest, passthroughExtraParams bool, providerName schemas.ModelProvider) *schemas.BifrostError {
+	// Add file field
+	filename := request.Input.Filename
+	if filename == "" {
+		filename = utils.AudioFilenameFromBytes(request.Input.File)
+	}
+	fileWriter, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return utils.NewBifrostOperationError("failed to create form file", err, providerName)
+	}
+	if _, err := fileWriter.Write(request.Input.File); err != nil {
+		return utils.NewBifrostOperationError("failed to write file data", err, providerName)
+	}
+
+	// Add model field
+	if err := writer.WriteField("model", request.Model); err != nil {
+		return utils.NewBifrostOperationError("failed to write model field", err, providerName)
+	}
+
+	// Add optional fields
+	if params := request.Params; params != nil {
+		if params.Prompt != nil {
+			if err := writer.WriteField("prompt", *params.Prompt); err != nil {
+				return utils.NewBifrostOperationError("failed to write prompt field", err, providerName)
+			}
+		}
+
+		if params.ResponseFormat != nil {
+			if err := writer.WriteField("response_format", *params.ResponseFormat); err != nil {
+				return utils.NewBifrostOperationError("failed to write response_format field", err, providerName)
+			}
+		}
+
+		if params.Temperature != nil {
+			if err := writer.WriteField("temperature", fmt.Sprintf("%g", *params.Temperature)); err != nil {
+				return utils.NewBifrostOperationError("failed to write temperature field", err, providerName)
+			}
+		}
+
+		if passthroughExtraParams {
+			for key, value := range params.ExtraParams {
+				if err := writer.WriteField(key, fmt.Sprintf("%v", value)); err != nil {
+					return utils.NewBifrostOperationError(fmt.Sprintf("failed to write %s field", key), err, providerName)
+				}
+			}
+		}
+	}
+
+	// Close the multipart writer
+	if err := writer.Close(); err != nil {
+		return utils.NewBifrostOperationError("failed to close multipart writer", err, providerName)
+	}
+
+	return nil
+}
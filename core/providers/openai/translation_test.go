@@ -0,0 +1,79 @@
+package openai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestOpenAITranslation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/audio/translations" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("failed to parse multipart form: %v", err)
+			return
+		}
+		if r.FormValue("model") != "whisper-1" || r.FormValue("prompt") != "A podcast" {
+			t.Errorf("form = %v", r.MultipartForm.Value)
+		}
+		if _, header, err := r.FormFile("file"); err != nil || header.Filename != "clip.mp3" {
+			t.Errorf("file = %v, %v", header, err)
+		}
+		if r.FormValue("response_format") == "srt" {
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte("1\n00:00:00,000 --> 00:00:01,500\nHello, world.\n"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"task":"translate","language":"english","duration":1.5,"text":"Hello, world.","segments":[{"id":0,"start":0,"end":1.5,"text":"Hello, world."}]}`))
+	}))
+	defer server.Close()
+
+	provider := NewOpenAIProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			BaseURL:                        server.URL,
+			DefaultRequestTimeoutInSeconds: 10,
+		},
+	}, &testLogger{})
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	key := schemas.Key{Value: *schemas.NewEnvVar("test-key")}
+	request := func(responseFormat string) *schemas.BifrostTranslationRequest {
+		return &schemas.BifrostTranslationRequest{
+			Provider: schemas.OpenAI,
+			Model:    "whisper-1",
+			Input:    &schemas.TranscriptionInput{File: []byte("ID3audio"), Filename: "clip.mp3"},
+			Params: &schemas.TranslationParameters{
+				Prompt:         schemas.Ptr("A podcast"),
+				ResponseFormat: schemas.Ptr(responseFormat),
+			},
+		}
+	}
+
+	t.Run("VerboseJSON", func(t *testing.T) {
+		resp, bifrostErr := provider.Translation(ctx, key, request("verbose_json"))
+		if bifrostErr != nil {
+			t.Fatalf("Translation() error = %v", bifrostErr.Error)
+		}
+		if resp.Text != "Hello, world." || resp.Duration == nil || *resp.Duration != 1.5 || len(resp.Segments) != 1 {
+			t.Errorf("unexpected response: %+v", resp)
+		}
+		if resp.ExtraFields.RequestType != schemas.TranslationRequest || resp.ExtraFields.ModelRequested != "whisper-1" {
+			t.Errorf("extra fields = %+v", resp.ExtraFields)
+		}
+	})
+
+	t.Run("PlainTextFormat", func(t *testing.T) {
+		resp, bifrostErr := provider.Translation(ctx, key, request("srt"))
+		if bifrostErr != nil {
+			t.Fatalf("Translation() error = %v", bifrostErr.Error)
+		}
+		if resp.Text != "1\n00:00:00,000 --> 00:00:01,500\nHello, world." {
+			t.Errorf("Text = %q", resp.Text)
+		}
+	})
+}
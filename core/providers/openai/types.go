@@ -67,6 +67,24 @@ func (r *OpenAIEmbeddingRequest) SetExtraParams(params map[string]interface{}) {
 	r.EmbeddingParameters.ExtraParams = params
 }
 
+// OpenAIModerationRequest represents an OpenAI moderation request
+type OpenAIModerationRequest struct {
+	Model string                   `json:"model,omitempty"`
+	Input *schemas.ModerationInput `json:"input"` // Can be string, []string or an array of text and image_url parts
+
+	// Bifrost specific field (only parsed when converting from Provider -> Bifrost request)
+	Fallbacks   []string               `json:"fallbacks,omitempty"`
+	ExtraParams map[string]interface{} `json:"-"` // Optional: Extra parameters
+}
+
+func (r *OpenAIModerationRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+func (r *OpenAIModerationRequest) SetExtraParams(params map[string]interface{}) {
+	r.ExtraParams = params
+}
+
 // OpenAIChatRequest represents an OpenAI chat completion request
 type OpenAIChatRequest struct {
 	Model    string          `json:"model"`
@@ -120,6 +138,7 @@ type OpenAIMessage struct {
 // OpenAIChatAssistantMessage represents an OpenAI chat assistant message
 type OpenAIChatAssistantMessage struct {
 	Refusal     *string                                  `json:"refusal,omitempty"`
+	Audio       *schemas.ChatAudioMessageAudio           `json:"audio,omitempty"` // Only the ID is sent, to reference a previous audio response
 	Reasoning   *string                                  `json:"reasoning,omitempty"`
 	Annotations []schemas.ChatAssistantMessageAnnotation `json:"annotations,omitempty"`
 	ToolCalls   []schemas.ChatAssistantMessageToolCall   `json:"tool_calls,omitempty"`
@@ -701,6 +720,18 @@ type OpenAITranscriptionRequest struct {
 	Fallbacks []string `json:"fallbacks,omitempty"`
 }
 
+// OpenAITranslationRequest represents an OpenAI audio translation request
+type OpenAITranslationRequest struct {
+	Model    string `json:"model"`
+	File     []byte `json:"file"`     // Binary audio data
+	Filename string `json:"filename"` // Original filename, used to preserve file format extension
+
+	schemas.TranslationParameters
+
+	// Bifrost specific field (only parsed when converting from Provider -> Bifrost request)
+	Fallbacks []string `json:"fallbacks,omitempty"`
+}
+
 // IsStreamingRequested implements the StreamingRequest interface for speech
 func (r *OpenAISpeechRequest) IsStreamingRequested() bool {
 	return r.StreamFormat != nil && *r.StreamFormat == "sse"
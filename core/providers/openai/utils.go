@@ -1,6 +1,14 @@
 package openai
 
-import "github.com/capsohq/bifrost/core/schemas"
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
 
 // CustomResponseHandler is a function that produces a Bifrost response from a Bifrost request.
 // T is the concrete Bifrost response type (e.g. BifrostEmbeddingResponse, BifrostTextCompletionResponse, BifrostChatResponse, BifrostResponsesResponse, BifrostImageGenerationResponse, BifrostTranscriptionResponse).
@@ -18,6 +26,7 @@ func ConvertOpenAIMessagesToBifrostMessages(messages []OpenAIMessage) []schemas.
 		if message.OpenAIChatAssistantMessage != nil {
 			bifrostMessages[i].ChatAssistantMessage = &schemas.ChatAssistantMessage{
 				Refusal:     message.OpenAIChatAssistantMessage.Refusal,
+				Audio:       message.OpenAIChatAssistantMessage.Audio,
 				Reasoning:   message.OpenAIChatAssistantMessage.Reasoning,
 				Annotations: message.OpenAIChatAssistantMessage.Annotations,
 				ToolCalls:   message.OpenAIChatAssistantMessage.ToolCalls,
@@ -43,6 +52,9 @@ func ConvertBifrostMessagesToOpenAIMessages(messages []schemas.ChatMessage) []Op
 				Annotations: message.ChatAssistantMessage.Annotations,
 				ToolCalls:   message.ChatAssistantMessage.ToolCalls,
 			}
+			if audio := message.ChatAssistantMessage.Audio; audio != nil && audio.ID != "" {
+				openaiMessages[i].OpenAIChatAssistantMessage.Audio = &schemas.ChatAudioMessageAudio{ID: audio.ID}
+			}
 		}
 	}
 	return openaiMessages
@@ -58,3 +70,92 @@ func SanitizeUserField(user *string) *string {
 	}
 	return user
 }
+
+// doJSONRequest sends a JSON API request authenticated with the key and decodes the
+// response into result. It returns the latency and the raw request and response.
+func doJSONRequest[T any](
+	ctx *schemas.BifrostContext,
+	client *fasthttp.Client,
+	method string,
+	requestURL string,
+	body []byte,
+	key schemas.Key,
+	extraHeaders map[string]string,
+	requestType schemas.RequestType,
+	providerName schemas.ModelProvider,
+	result *T,
+	sendBackRawRequest bool,
+	sendBackRawResponse bool,
+) (time.Duration, interface{}, interface{}, *schemas.BifrostError) {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	providerUtils.SetExtraHeaders(ctx, req, extraHeaders, nil)
+
+	req.SetRequestURI(requestURL)
+	req.Header.SetMethod(method)
+	req.Header.SetContentType("application/json")
+	if body != nil {
+		req.SetBody(body)
+	}
+
+	if key.Value.GetValue() != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
+	}
+
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, client, req, resp)
+	if bifrostErr != nil {
+		return 0, nil, nil, bifrostErr
+	}
+
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return 0, nil, nil, ParseOpenAIError(resp, requestType, providerName, "")
+	}
+
+	responseBody := append([]byte(nil), resp.Body()...)
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(responseBody, result, body, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return 0, nil, nil, bifrostErr
+	}
+	return latency, rawRequest, rawResponse, nil
+}
+
+// jsonRequestExtraFields builds the extra fields of a response to a request sent with doJSONRequest.
+func jsonRequestExtraFields(providerName schemas.ModelProvider, requestType schemas.RequestType, latency time.Duration, rawRequest interface{}, rawResponse interface{}, sendBackRawRequest bool, sendBackRawResponse bool) schemas.BifrostResponseExtraFields {
+	extraFields := schemas.BifrostResponseExtraFields{
+		Provider:    providerName,
+		RequestType: requestType,
+		Latency:     latency.Milliseconds(),
+	}
+	if sendBackRawRequest {
+		extraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		extraFields.RawResponse = rawResponse
+	}
+	return extraFields
+}
+
+// listQuery returns the query string of a list request with the parameters that are set, or "" if
+// none is.
+func listQuery(limit int, after *string, order *string, filter *string) string {
+	queryParams := url.Values{}
+	if limit > 0 {
+		queryParams.Set("limit", fmt.Sprintf("%d", limit))
+	}
+	if after != nil && *after != "" {
+		queryParams.Set("after", *after)
+	}
+	if order != nil && *order != "" {
+		queryParams.Set("order", *order)
+	}
+	if filter != nil && *filter != "" {
+		queryParams.Set("filter", *filter)
+	}
+	if len(queryParams) == 0 {
+		return ""
+	}
+	return "?" + queryParams.Encode()
+}
@@ -0,0 +1,325 @@
+package openai
+
+import (
+	"maps"
+	"net/http"
+	"net/url"
+
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// vectorStoresPath is the path of the OpenAI vector stores API.
+const vectorStoresPath = "/v1/vector_stores"
+
+// OpenAI vector store list responses
+type openAIVectorStoreList struct {
+	Object  string                `json:"object"`
+	Data    []schemas.VectorStore `json:"data"`
+	HasMore bool                  `json:"has_more"`
+}
+
+type openAIVectorStoreFileList struct {
+	Object  string                    `json:"object"`
+	Data    []schemas.VectorStoreFile `json:"data"`
+	FirstID *string                   `json:"first_id"`
+	LastID  *string                   `json:"last_id"`
+	HasMore bool                      `json:"has_more"`
+}
+
+// VectorStoreCreate creates a vector store via OpenAI's API.
+func (provider *OpenAIProvider) VectorStoreCreate(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostVectorStoreCreateRequest) (*schemas.BifrostVectorStoreCreateResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.VectorStoreCreateRequest); err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+
+	// Build request body
+	reqBody := map[string]interface{}{}
+	if request.Name != nil {
+		reqBody["name"] = *request.Name
+	}
+	if request.Description != nil {
+		reqBody["description"] = *request.Description
+	}
+	if len(request.FileIDs) > 0 {
+		reqBody["file_ids"] = request.FileIDs
+	}
+	if request.ExpiresAfter != nil {
+		reqBody["expires_after"] = request.ExpiresAfter
+	}
+	if request.ChunkingStrategy != nil {
+		reqBody["chunking_strategy"] = request.ChunkingStrategy
+	}
+	if len(request.Metadata) > 0 {
+		reqBody["metadata"] = request.Metadata
+	}
+	for k, v := range request.ExtraParams {
+		if _, exists := reqBody[k]; !exists {
+			reqBody[k] = v
+		}
+	}
+
+	jsonBody, err := schemas.Marshal(reqBody)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	var vectorStore schemas.VectorStore
+	requestURL := provider.buildRequestURL(ctx, vectorStoresPath, schemas.VectorStoreCreateRequest)
+	latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, provider.client, http.MethodPost, requestURL, jsonBody, key, provider.vectorStoreHeaders(), schemas.VectorStoreCreateRequest, providerName, &vectorStore, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	return &schemas.BifrostVectorStoreCreateResponse{
+		VectorStore: vectorStore,
+		ExtraFields: jsonRequestExtraFields(providerName, schemas.VectorStoreCreateRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse),
+	}, nil
+}
+
+// VectorStoreList lists vector stores via OpenAI's API.
+// Uses SerialListHelper for multi-key pagination - exhausts all pages from one key before moving to next.
+func (provider *OpenAIProvider) VectorStoreList(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostVectorStoreListRequest) (*schemas.BifrostVectorStoreListResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.VectorStoreListRequest); err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+
+	// Initialize serial pagination helper for multi-key support
+	helper, err := providerUtils.NewSerialListHelper(provider.multiKeyOperationKeys(keys), request.After, provider.logger)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
+	}
+
+	// Get current key to query
+	key, nativeCursor, ok := helper.GetCurrentKey()
+	if !ok {
+		// All keys exhausted
+		return &schemas.BifrostVectorStoreListResponse{
+			Object:  "list",
+			Data:    []schemas.VectorStore{},
+			HasMore: false,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				Provider:    providerName,
+				RequestType: schemas.VectorStoreListRequest,
+			},
+		}, nil
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	requestURL := provider.buildRequestURL(ctx, vectorStoresPath, schemas.VectorStoreListRequest) + listQuery(request.Limit, &nativeCursor, request.Order, nil)
+
+	var listResp openAIVectorStoreList
+	latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, provider.client, http.MethodGet, requestURL, nil, key, provider.vectorStoreHeaders(), schemas.VectorStoreListRequest, providerName, &listResp, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	// Build cursor for next request (handles cross-key pagination)
+	var lastVectorStoreID string
+	if len(listResp.Data) > 0 {
+		lastVectorStoreID = listResp.Data[len(listResp.Data)-1].ID
+	}
+	nextCursor, hasMore := helper.BuildNextCursor(listResp.HasMore, lastVectorStoreID)
+
+	response := &schemas.BifrostVectorStoreListResponse{
+		Object:      listResp.Object,
+		Data:        listResp.Data,
+		HasMore:     hasMore,
+		ExtraFields: jsonRequestExtraFields(providerName, schemas.VectorStoreListRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse),
+	}
+	if response.Data == nil {
+		response.Data = []schemas.VectorStore{}
+	}
+	if nextCursor != "" {
+		response.After = &nextCursor
+	}
+	return response, nil
+}
+
+// VectorStoreSearch searches the chunks of a vector store via OpenAI's API, trying each key until
+// one owns the vector store.
+func (provider *OpenAIProvider) VectorStoreSearch(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostVectorStoreSearchRequest) (*schemas.BifrostVectorStoreSearchResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.VectorStoreSearchRequest); err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+	if request.VectorStoreID == "" {
+		return nil, providerUtils.NewBifrostOperationError("vector_store_id is required", nil, providerName)
+	}
+
+	// Build request body
+	reqBody := map[string]interface{}{
+		"query": request.Query,
+	}
+	if request.MaxNumResults != nil {
+		reqBody["max_num_results"] = *request.MaxNumResults
+	}
+	if request.RewriteQuery != nil {
+		reqBody["rewrite_query"] = *request.RewriteQuery
+	}
+	if len(request.Filters) > 0 {
+		reqBody["filters"] = request.Filters
+	}
+	if len(request.RankingOptions) > 0 {
+		reqBody["ranking_options"] = request.RankingOptions
+	}
+	for k, v := range request.ExtraParams {
+		if _, exists := reqBody[k]; !exists {
+			reqBody[k] = v
+		}
+	}
+
+	jsonBody, err := schemas.Marshal(reqBody)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.VectorStoreID, provider.multiKeyOperationKeys(keys), func(key schemas.Key) (*schemas.BifrostVectorStoreSearchResponse, *schemas.BifrostError) {
+		var searchResp schemas.BifrostVectorStoreSearchResponse
+		requestURL := provider.buildRequestURL(ctx, vectorStoresPath+"/"+url.PathEscape(request.VectorStoreID)+"/search", schemas.VectorStoreSearchRequest)
+		latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, provider.client, http.MethodPost, requestURL, jsonBody, key, provider.vectorStoreHeaders(), schemas.VectorStoreSearchRequest, providerName, &searchResp, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		if searchResp.Data == nil {
+			searchResp.Data = []schemas.VectorStoreSearchResult{}
+		}
+		searchResp.ExtraFields = jsonRequestExtraFields(providerName, schemas.VectorStoreSearchRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse)
+		return &searchResp, nil
+	})
+}
+
+// VectorStoreFileCreate adds an uploaded file to a vector store via OpenAI's API, trying each key
+// until one owns the vector store.
+func (provider *OpenAIProvider) VectorStoreFileCreate(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostVectorStoreFileCreateRequest) (*schemas.BifrostVectorStoreFileCreateResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.VectorStoreFileCreateRequest); err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+	if request.VectorStoreID == "" {
+		return nil, providerUtils.NewBifrostOperationError("vector_store_id is required", nil, providerName)
+	}
+	if request.FileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
+	}
+
+	// Build request body
+	reqBody := map[string]interface{}{
+		"file_id": request.FileID,
+	}
+	if len(request.Attributes) > 0 {
+		reqBody["attributes"] = request.Attributes
+	}
+	if request.ChunkingStrategy != nil {
+		reqBody["chunking_strategy"] = request.ChunkingStrategy
+	}
+	for k, v := range request.ExtraParams {
+		if _, exists := reqBody[k]; !exists {
+			reqBody[k] = v
+		}
+	}
+
+	jsonBody, err := schemas.Marshal(reqBody)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.VectorStoreID, provider.multiKeyOperationKeys(keys), func(key schemas.Key) (*schemas.BifrostVectorStoreFileCreateResponse, *schemas.BifrostError) {
+		var vectorStoreFile schemas.VectorStoreFile
+		requestURL := provider.buildRequestURL(ctx, vectorStoresPath+"/"+url.PathEscape(request.VectorStoreID)+"/files", schemas.VectorStoreFileCreateRequest)
+		latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, provider.client, http.MethodPost, requestURL, jsonBody, key, provider.vectorStoreHeaders(), schemas.VectorStoreFileCreateRequest, providerName, &vectorStoreFile, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		return &schemas.BifrostVectorStoreFileCreateResponse{
+			VectorStoreFile: vectorStoreFile,
+			ExtraFields:     jsonRequestExtraFields(providerName, schemas.VectorStoreFileCreateRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse),
+		}, nil
+	})
+}
+
+// VectorStoreFileList lists the files of a vector store via OpenAI's API, trying each key until
+// one owns the vector store.
+func (provider *OpenAIProvider) VectorStoreFileList(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostVectorStoreFileListRequest) (*schemas.BifrostVectorStoreFileListResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if err := providerUtils.CheckOperationAllowed(schemas.OpenAI, provider.customProviderConfig, schemas.VectorStoreFileListRequest); err != nil {
+		return nil, err
+	}
+	if request == nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid request: nil", nil, providerName)
+	}
+	if request.VectorStoreID == "" {
+		return nil, providerUtils.NewBifrostOperationError("vector_store_id is required", nil, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.VectorStoreID, provider.multiKeyOperationKeys(keys), func(key schemas.Key) (*schemas.BifrostVectorStoreFileListResponse, *schemas.BifrostError) {
+		var listResp openAIVectorStoreFileList
+		requestURL := provider.buildRequestURL(ctx, vectorStoresPath+"/"+url.PathEscape(request.VectorStoreID)+"/files", schemas.VectorStoreFileListRequest) +
+			listQuery(request.Limit, request.After, request.Order, request.Filter)
+		latency, rawRequest, rawResponse, bifrostErr := doJSONRequest(ctx, provider.client, http.MethodGet, requestURL, nil, key, provider.vectorStoreHeaders(), schemas.VectorStoreFileListRequest, providerName, &listResp, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		response := &schemas.BifrostVectorStoreFileListResponse{
+			Object:      listResp.Object,
+			Data:        listResp.Data,
+			FirstID:     listResp.FirstID,
+			LastID:      listResp.LastID,
+			HasMore:     listResp.HasMore,
+			ExtraFields: jsonRequestExtraFields(providerName, schemas.VectorStoreFileListRequest, latency, rawRequest, rawResponse, sendBackRawRequest, sendBackRawResponse),
+		}
+		if response.Data == nil {
+			response.Data = []schemas.VectorStoreFile{}
+		}
+		return response, nil
+	})
+}
+
+// vectorStoreHeaders returns the configured extra headers with the Assistants v2 beta header, which
+// the vector stores API requires, unless it has been configured explicitly.
+func (provider *OpenAIProvider) vectorStoreHeaders() map[string]string {
+	headers := maps.Clone(provider.networkConfig.ExtraHeaders)
+	if headers == nil {
+		headers = make(map[string]string, 1)
+	}
+	for name := range headers {
+		if http.CanonicalHeaderKey(name) == "Openai-Beta" {
+			return headers
+		}
+	}
+	headers["OpenAI-Beta"] = "assistants=v2"
+	return headers
+}
@@ -0,0 +1,184 @@
+package openai
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// newVectorStoreServer serves the vector stores API with one vector store per key, so that
+// multi-key operations have to find the key owning a store.
+func newVectorStoreServer(t *testing.T, bodies map[string]map[string]interface{}) *httptest.Server {
+	stores := map[string]string{"Bearer key-a": "vs_a", "Bearer key-b": "vs_b"}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("OpenAI-Beta") != "assistants=v2" {
+			t.Errorf("OpenAI-Beta header = %q", r.Header.Get("OpenAI-Beta"))
+		}
+		storeID, ok := stores[r.Header.Get("Authorization")]
+		if !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"message":"invalid key","type":"invalid_request_error"}}`))
+			return
+		}
+		path := strings.TrimPrefix(r.URL.Path, "/v1/vector_stores")
+		if r.Method == http.MethodPost {
+			body := map[string]interface{}{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode request body: %v", err)
+			}
+			bodies[path] = body
+		}
+		switch {
+		case r.Method == http.MethodPost && path == "":
+			fmt.Fprintf(w, `{"id":%q,"object":"vector_store","created_at":1,"name":"docs","usage_bytes":0,"file_counts":{"in_progress":1,"completed":0,"failed":0,"cancelled":0,"total":1},"status":"in_progress"}`, storeID)
+		case r.Method == http.MethodGet && path == "":
+			fmt.Fprintf(w, `{"object":"list","data":[{"id":%q,"name":"docs","status":"completed","file_counts":{"total":1}}],"has_more":false}`, storeID)
+		case r.Method == http.MethodPost && path == "/"+storeID+"/search":
+			w.Write([]byte(`{"object":"vector_store.search_results.page","search_query":["refund policy"],"data":[{"file_id":"file-1","filename":"policy.md","score":0.91,"attributes":{"team":"support"},"content":[{"type":"text","text":"Refunds are issued within 14 days."}]}],"has_more":false,"next_page":null}`))
+		case r.Method == http.MethodPost && path == "/"+storeID+"/files":
+			fmt.Fprintf(w, `{"id":"file-1","object":"vector_store.file","created_at":2,"vector_store_id":%q,"usage_bytes":0,"status":"in_progress"}`, storeID)
+		case r.Method == http.MethodGet && path == "/"+storeID+"/files":
+			if r.URL.Query().Get("filter") != "completed" || r.URL.Query().Get("order") != "asc" {
+				t.Errorf("file list query = %s", r.URL.RawQuery)
+			}
+			fmt.Fprintf(w, `{"object":"list","data":[{"id":"file-1","vector_store_id":%q,"status":"completed","usage_bytes":2048}],"first_id":"file-1","last_id":"file-1","has_more":false}`, storeID)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"message":"vector store not found","type":"invalid_request_error"}}`))
+		}
+	}))
+}
+
+func TestOpenAIVectorStores(t *testing.T) {
+	bodies := map[string]map[string]interface{}{}
+	server := newVectorStoreServer(t, bodies)
+	defer server.Close()
+
+	provider := NewOpenAIProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			BaseURL:                        server.URL,
+			DefaultRequestTimeoutInSeconds: 10,
+		},
+	}, &testLogger{})
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	keyA := schemas.Key{ID: "a", Value: *schemas.NewEnvVar("key-a")}
+	keyB := schemas.Key{ID: "b", Value: *schemas.NewEnvVar("key-b")}
+	keys := []schemas.Key{keyA, keyB}
+
+	t.Run("Create", func(t *testing.T) {
+		resp, bifrostErr := provider.VectorStoreCreate(ctx, keyA, &schemas.BifrostVectorStoreCreateRequest{
+			Provider: schemas.OpenAI,
+			Name:     schemas.Ptr("docs"),
+			FileIDs:  []string{"file-1"},
+			ChunkingStrategy: &schemas.VectorStoreChunkingStrategy{
+				Type:   "static",
+				Static: &schemas.VectorStoreStaticChunkingStrategy{MaxChunkSizeTokens: 800, ChunkOverlapTokens: 400},
+			},
+		})
+		if bifrostErr != nil {
+			t.Fatalf("VectorStoreCreate() error = %v", bifrostErr.Error)
+		}
+		if resp.ID != "vs_a" || resp.Status != schemas.VectorStoreStatusInProgress || resp.FileCounts.Total != 1 {
+			t.Errorf("unexpected response: %+v", resp.VectorStore)
+		}
+		body := bodies[""]
+		if body["name"] != "docs" || body["file_ids"] == nil {
+			t.Errorf("request body = %v", body)
+		}
+		strategy, _ := body["chunking_strategy"].(map[string]interface{})
+		if strategy["type"] != "static" {
+			t.Errorf("chunking_strategy = %v", body["chunking_strategy"])
+		}
+		if resp.ExtraFields.RequestType != schemas.VectorStoreCreateRequest || resp.ExtraFields.Provider != schemas.OpenAI {
+			t.Errorf("extra fields = %+v", resp.ExtraFields)
+		}
+	})
+
+	t.Run("ListPagesThroughKeys", func(t *testing.T) {
+		var ids []string
+		request := &schemas.BifrostVectorStoreListRequest{Provider: schemas.OpenAI}
+		for page := 0; page < 3; page++ {
+			resp, bifrostErr := provider.VectorStoreList(ctx, keys, request)
+			if bifrostErr != nil {
+				t.Fatalf("VectorStoreList() error = %v", bifrostErr.Error)
+			}
+			for _, store := range resp.Data {
+				ids = append(ids, store.ID)
+			}
+			if !resp.HasMore {
+				break
+			}
+			request.After = resp.After
+		}
+		if strings.Join(ids, ",") != "vs_a,vs_b" {
+			t.Errorf("listed vector stores = %v", ids)
+		}
+	})
+
+	t.Run("SearchFindsOwningKey", func(t *testing.T) {
+		resp, bifrostErr := provider.VectorStoreSearch(ctx, keys, &schemas.BifrostVectorStoreSearchRequest{
+			Provider:      schemas.OpenAI,
+			VectorStoreID: "vs_b",
+			Query:         "refund policy",
+			MaxNumResults: schemas.Ptr(5),
+			Filters:       map[string]interface{}{"type": "eq", "key": "team", "value": "support"},
+		})
+		if bifrostErr != nil {
+			t.Fatalf("VectorStoreSearch() error = %v", bifrostErr.Error)
+		}
+		if len(resp.Data) != 1 || resp.Data[0].FileID != "file-1" || resp.Data[0].Content[0].Text != "Refunds are issued within 14 days." {
+			t.Errorf("unexpected response: %+v", resp)
+		}
+		body := bodies["/vs_b/search"]
+		if body["query"] != "refund policy" || body["max_num_results"] != float64(5) || body["filters"] == nil {
+			t.Errorf("request body = %v", body)
+		}
+	})
+
+	t.Run("FileCreate", func(t *testing.T) {
+		resp, bifrostErr := provider.VectorStoreFileCreate(ctx, keys, &schemas.BifrostVectorStoreFileCreateRequest{
+			Provider:      schemas.OpenAI,
+			VectorStoreID: "vs_b",
+			FileID:        "file-1",
+			Attributes:    map[string]interface{}{"team": "support"},
+		})
+		if bifrostErr != nil {
+			t.Fatalf("VectorStoreFileCreate() error = %v", bifrostErr.Error)
+		}
+		if resp.VectorStoreID != "vs_b" || resp.Status != schemas.VectorStoreFileStatusInProgress {
+			t.Errorf("unexpected response: %+v", resp.VectorStoreFile)
+		}
+		if bodies["/vs_b/files"]["file_id"] != "file-1" {
+			t.Errorf("request body = %v", bodies["/vs_b/files"])
+		}
+	})
+
+	t.Run("FileList", func(t *testing.T) {
+		resp, bifrostErr := provider.VectorStoreFileList(ctx, keys, &schemas.BifrostVectorStoreFileListRequest{
+			Provider:      schemas.OpenAI,
+			VectorStoreID: "vs_a",
+			Order:         schemas.Ptr("asc"),
+			Filter:        schemas.Ptr("completed"),
+		})
+		if bifrostErr != nil {
+			t.Fatalf("VectorStoreFileList() error = %v", bifrostErr.Error)
+		}
+		if len(resp.Data) != 1 || resp.Data[0].UsageBytes != 2048 || resp.LastID == nil || *resp.LastID != "file-1" {
+			t.Errorf("unexpected response: %+v", resp)
+		}
+	})
+
+	t.Run("UnknownVectorStore", func(t *testing.T) {
+		_, bifrostErr := provider.VectorStoreSearch(ctx, keys, &schemas.BifrostVectorStoreSearchRequest{Provider: schemas.OpenAI, VectorStoreID: "vs_missing", Query: "x"})
+		if bifrostErr == nil || bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusNotFound {
+			t.Fatalf("expected a not found error, got %+v", bifrostErr)
+		}
+	})
+}
@@ -0,0 +1,118 @@
+package qwen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// newCompatibleModeTestServer serves the subset of DashScope's OpenAI-compatible files and batches API used by a
+// batch lifecycle: uploading the input file, creating and retrieving the batch and downloading its output file.
+func newCompatibleModeTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"error":{"message":"invalid api key","type":"invalid_request_error","code":"invalid_api_key"}}`)
+			return
+		}
+		switch path := strings.TrimPrefix(r.URL.Path, dashScopeCompatibleModeSuffix); {
+		case r.Method == http.MethodPost && path == compatibleModePathFiles:
+			file, header, err := r.FormFile("file")
+			if err != nil || r.FormValue("purpose") != "batch" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			content, _ := io.ReadAll(file)
+			if !strings.Contains(string(content), `"custom_id":"req-1"`) {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprintf(w, `{"id":"file-in","object":"file","bytes":%d,"created_at":1,"filename":%q,"purpose":"batch","status":"processed"}`, len(content), header.Filename)
+		case r.Method == http.MethodPost && path == compatibleModePathBatches:
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["input_file_id"] != "file-in" || body["completion_window"] != "24h" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"id":"batch-1","object":"batch","endpoint":"/v1/chat/completions","input_file_id":"file-in","completion_window":"24h","status":"validating","created_at":2}`)
+		case r.Method == http.MethodGet && path == compatibleModePathBatches+"/batch-1":
+			fmt.Fprint(w, `{"id":"batch-1","object":"batch","endpoint":"/v1/chat/completions","input_file_id":"file-in","completion_window":"24h","status":"completed","output_file_id":"file-out","created_at":2,"request_counts":{"total":1,"completed":1,"failed":0}}`)
+		case r.Method == http.MethodGet && path == compatibleModePathFiles+"/file-out/content":
+			w.Header().Set("Content-Type", "application/jsonl")
+			fmt.Fprint(w, `{"id":"r1","custom_id":"req-1","response":{"status_code":200,"request_id":"x","body":{"id":"chatcmpl-1"}}}`+"\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error":{"message":"not found","type":"invalid_request_error"}}`)
+		}
+	}))
+}
+
+func TestBatchLifecycle(t *testing.T) {
+	t.Parallel()
+
+	server := newCompatibleModeTestServer(t)
+	defer server.Close()
+	provider := newTestQwenProvider(t, server.URL)
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	key := schemas.Key{Value: schemas.EnvVar{Val: "test-key"}}
+
+	created, bifrostErr := provider.BatchCreate(ctx, key, &schemas.BifrostBatchCreateRequest{
+		Provider: schemas.Qwen,
+		Endpoint: schemas.BatchEndpointChatCompletions,
+		Requests: []schemas.BatchRequestItem{{
+			CustomID: "req-1",
+			Method:   http.MethodPost,
+			URL:      "/v1/chat/completions",
+			Body:     map[string]any{"model": "qwen-plus", "messages": []any{map[string]any{"role": "user", "content": "hi"}}},
+		}},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("BatchCreate() error = %v", bifrostErr.Error.Message)
+	}
+	if created.ID != "batch-1" || created.InputFileID != "file-in" || created.ExtraFields.Provider != schemas.Qwen {
+		t.Fatalf("created batch = %+v", created)
+	}
+
+	keys := []schemas.Key{key}
+	results, bifrostErr := provider.BatchResults(ctx, keys, &schemas.BifrostBatchResultsRequest{Provider: schemas.Qwen, BatchID: "batch-1"})
+	if bifrostErr != nil {
+		t.Fatalf("BatchResults() error = %v", bifrostErr.Error.Message)
+	}
+	if len(results.Results) != 1 || results.Results[0].CustomID != "req-1" || results.ExtraFields.RequestType != schemas.BatchResultsRequest {
+		t.Errorf("results = %+v", results)
+	}
+
+	content, bifrostErr := provider.FileContent(ctx, keys, &schemas.BifrostFileContentRequest{Provider: schemas.Qwen, FileID: "file-out"})
+	if bifrostErr != nil {
+		t.Fatalf("FileContent() error = %v", bifrostErr.Error.Message)
+	}
+	if content.ContentType != "application/jsonl" || !strings.Contains(string(content.Content), "chatcmpl-1") {
+		t.Errorf("content = %s (%s)", content.Content, content.ContentType)
+	}
+}
+
+func TestFileRetrieve_OpenAIError(t *testing.T) {
+	t.Parallel()
+
+	server := newCompatibleModeTestServer(t)
+	defer server.Close()
+	provider := newTestQwenProvider(t, server.URL)
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+
+	_, bifrostErr := provider.FileRetrieve(ctx, []schemas.Key{{Value: schemas.EnvVar{Val: "wrong-key"}}}, &schemas.BifrostFileRetrieveRequest{Provider: schemas.Qwen, FileID: "file-in"})
+	if bifrostErr == nil {
+		t.Fatal("expected error for an invalid key")
+	}
+	if bifrostErr.StatusCode == nil || *bifrostErr.StatusCode != http.StatusUnauthorized || bifrostErr.Error.Message != "invalid api key" {
+		t.Errorf("error = %+v", bifrostErr.Error)
+	}
+}
@@ -0,0 +1,28 @@
+package qwen
+
+import (
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// parseDashScopeError converts an error response of DashScope's native API to a Bifrost error.
+func parseDashScopeError(resp *fasthttp.Response, meta *providerUtils.RequestMetadata) *schemas.BifrostError {
+	var errorResp DashScopeError
+	bifrostErr := providerUtils.HandleProviderAPIError(resp, &errorResp)
+	if bifrostErr.Error == nil {
+		bifrostErr.Error = &schemas.ErrorField{}
+	}
+	if errorResp.Message != "" {
+		bifrostErr.Error.Message = errorResp.Message
+	}
+	if errorResp.Code != "" {
+		bifrostErr.Error.Code = schemas.Ptr(errorResp.Code)
+	}
+	if meta != nil {
+		bifrostErr.ExtraFields.Provider = meta.Provider
+		bifrostErr.ExtraFields.ModelRequested = meta.Model
+		bifrostErr.ExtraFields.RequestType = meta.RequestType
+	}
+	return bifrostErr
+}
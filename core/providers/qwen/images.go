@@ -0,0 +1,134 @@
+package qwen
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// ToDashScopeImageGenerationRequest converts a Bifrost image generation request to a wanx text-to-image task.
+func ToDashScopeImageGenerationRequest(bifrostReq *schemas.BifrostImageGenerationRequest) (*DashScopeImageSynthesisRequest, error) {
+	if bifrostReq == nil || bifrostReq.Input == nil {
+		return nil, fmt.Errorf("bifrost request is nil or input is nil")
+	}
+
+	req := &DashScopeImageSynthesisRequest{
+		Model: bifrostReq.Model,
+		Input: DashScopeImageSynthesisInput{Prompt: bifrostReq.Input.Prompt},
+	}
+
+	if params := bifrostReq.Params; params != nil {
+		req.Input.NegativePrompt = params.NegativePrompt
+		req.Parameters = &DashScopeImageSynthesisParams{
+			Size: toDashScopeSize(params.Size),
+			N:    params.N,
+			Seed: params.Seed,
+		}
+		req.ExtraParams = params.ExtraParams
+	}
+
+	return req, nil
+}
+
+// ToDashScopeImageEditRequest converts a Bifrost image edit request to a wanx image edit task.
+// The edit function is derived from the edit type and whether a mask is given; a DashScope
+// function name (e.g. "stylization_all") may also be passed as the type directly.
+func ToDashScopeImageEditRequest(bifrostReq *schemas.BifrostImageEditRequest) (*DashScopeImageSynthesisRequest, error) {
+	if bifrostReq == nil || bifrostReq.Input == nil {
+		return nil, fmt.Errorf("bifrost request is nil or input is nil")
+	}
+	if len(bifrostReq.Input.Images) == 0 || len(bifrostReq.Input.Images[0].Image) == 0 {
+		return nil, fmt.Errorf("image edit requires an input image")
+	}
+
+	req := &DashScopeImageSynthesisRequest{
+		Model: bifrostReq.Model,
+		Input: DashScopeImageSynthesisInput{
+			Prompt:       bifrostReq.Input.Prompt,
+			Function:     DashScopeImageEditDescription,
+			BaseImageURL: toDataURL(bifrostReq.Input.Images[0].Image),
+		},
+	}
+
+	if params := bifrostReq.Params; params != nil {
+		if len(params.Mask) > 0 {
+			req.Input.Function = DashScopeImageEditDescriptionWithMask
+			req.Input.MaskImageURL = toDataURL(params.Mask)
+		}
+		if params.Type != nil {
+			switch *params.Type {
+			case "inpainting":
+				if req.Input.MaskImageURL == "" {
+					return nil, fmt.Errorf("inpainting requires a mask")
+				}
+			case "outpainting":
+				req.Input.Function = DashScopeImageEditExpand
+			case "":
+			default:
+				req.Input.Function = *params.Type
+			}
+		}
+		req.Input.NegativePrompt = params.NegativePrompt
+		req.Parameters = &DashScopeImageSynthesisParams{
+			Size: toDashScopeSize(params.Size),
+			N:    params.N,
+			Seed: params.Seed,
+		}
+		req.ExtraParams = params.ExtraParams
+	}
+
+	return req, nil
+}
+
+// ToBifrostImageGenerationResponse converts a finished wanx task to Bifrost format.
+// Results that failed individually (e.g. content inspection) are skipped; an error is
+// returned if no image was generated.
+func (response *DashScopeTaskResponse) ToBifrostImageGenerationResponse(model string) (*schemas.BifrostImageGenerationResponse, error) {
+	if response == nil {
+		return nil, fmt.Errorf("task response is nil")
+	}
+
+	bifrostResp := &schemas.BifrostImageGenerationResponse{
+		ID:    response.Output.TaskID,
+		Model: model,
+		Data:  make([]schemas.ImageData, 0, len(response.Output.Results)),
+	}
+	var failure string
+	for _, result := range response.Output.Results {
+		if result.URL == "" {
+			if failure == "" {
+				failure = fmt.Sprintf("%s: %s", result.Code, result.Message)
+			}
+			continue
+		}
+		bifrostResp.Data = append(bifrostResp.Data, schemas.ImageData{
+			URL:           result.URL,
+			RevisedPrompt: result.ActualPrompt,
+			Index:         len(bifrostResp.Data),
+		})
+	}
+	if len(bifrostResp.Data) == 0 {
+		if failure == "" {
+			failure = "task returned no images"
+		}
+		return nil, fmt.Errorf("image task %s failed: %s", response.Output.TaskID, failure)
+	}
+
+	return bifrostResp, nil
+}
+
+// toDashScopeSize converts an OpenAI-style size ("1024x1024") to DashScope's format ("1024*1024").
+func toDashScopeSize(size *string) *string {
+	if size == nil || *size == "" || strings.EqualFold(*size, "auto") {
+		return nil
+	}
+	return schemas.Ptr(strings.Replace(strings.ToLower(*size), "x", "*", 1))
+}
+
+// toDataURL encodes image bytes as a base64 data URL.
+func toDataURL(image []byte) string {
+	return "data:" + http.DetectContentType(image) + ";base64," + base64.StdEncoding.EncodeToString(image)
+}
@@ -0,0 +1,195 @@
+package qwen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+// newTestQwenProvider creates a Qwen provider whose OpenAI-compatible base URL points at a test server.
+func newTestQwenProvider(t *testing.T, serverURL string) *QwenProvider {
+	t.Helper()
+	provider, err := NewQwenProvider(&schemas.ProviderConfig{
+		NetworkConfig: schemas.NetworkConfig{
+			BaseURL:                        serverURL + dashScopeCompatibleModeSuffix,
+			DefaultRequestTimeoutInSeconds: 10,
+		},
+	}, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewQwenProvider() error = %v", err)
+	}
+	return provider
+}
+
+func TestToDashScopeImageGenerationRequest(t *testing.T) {
+	req, err := ToDashScopeImageGenerationRequest(&schemas.BifrostImageGenerationRequest{
+		Model: "wanx2.1-t2i-turbo",
+		Input: &schemas.ImageGenerationInput{Prompt: "a red fox"},
+		Params: &schemas.ImageGenerationParameters{
+			N:              schemas.Ptr(2),
+			Size:           schemas.Ptr("1024x768"),
+			NegativePrompt: schemas.Ptr("blurry"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("ToDashScopeImageGenerationRequest() error = %v", err)
+	}
+	if req.Input.Prompt != "a red fox" || req.Input.NegativePrompt == nil || *req.Input.NegativePrompt != "blurry" {
+		t.Errorf("input = %+v", req.Input)
+	}
+	if req.Parameters == nil || *req.Parameters.Size != "1024*768" || *req.Parameters.N != 2 {
+		t.Errorf("parameters = %+v", req.Parameters)
+	}
+	if req.Input.Function != "" {
+		t.Errorf("text-to-image request should not set an edit function")
+	}
+
+	auto, _ := ToDashScopeImageGenerationRequest(&schemas.BifrostImageGenerationRequest{
+		Model:  "wanx2.1-t2i-turbo",
+		Input:  &schemas.ImageGenerationInput{Prompt: "a red fox"},
+		Params: &schemas.ImageGenerationParameters{Size: schemas.Ptr("auto")},
+	})
+	if auto.Parameters.Size != nil {
+		t.Errorf("auto size should be dropped, got %s", *auto.Parameters.Size)
+	}
+}
+
+func TestToDashScopeImageEditRequest(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n0000")
+	newRequest := func(params *schemas.ImageEditParameters) *schemas.BifrostImageEditRequest {
+		return &schemas.BifrostImageEditRequest{
+			Model:  "wanx2.1-imageedit",
+			Input:  &schemas.ImageEditInput{Prompt: "make it snow", Images: []schemas.ImageInput{{Image: png}}},
+			Params: params,
+		}
+	}
+
+	tests := []struct {
+		name         string
+		params       *schemas.ImageEditParameters
+		wantFunction string
+		wantMask     bool
+		wantErr      bool
+	}{
+		{name: "description edit", wantFunction: DashScopeImageEditDescription},
+		{name: "mask", params: &schemas.ImageEditParameters{Mask: png}, wantFunction: DashScopeImageEditDescriptionWithMask, wantMask: true},
+		{name: "inpainting with mask", params: &schemas.ImageEditParameters{Type: schemas.Ptr("inpainting"), Mask: png}, wantFunction: DashScopeImageEditDescriptionWithMask, wantMask: true},
+		{name: "inpainting without mask", params: &schemas.ImageEditParameters{Type: schemas.Ptr("inpainting")}, wantErr: true},
+		{name: "outpainting", params: &schemas.ImageEditParameters{Type: schemas.Ptr("outpainting")}, wantFunction: DashScopeImageEditExpand},
+		{name: "dashscope function", params: &schemas.ImageEditParameters{Type: schemas.Ptr("stylization_all")}, wantFunction: "stylization_all"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := ToDashScopeImageEditRequest(newRequest(tt.params))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToDashScopeImageEditRequest() error = %v", err)
+			}
+			if req.Input.Function != tt.wantFunction {
+				t.Errorf("function = %s, want %s", req.Input.Function, tt.wantFunction)
+			}
+			if !strings.HasPrefix(req.Input.BaseImageURL, "data:image/png;base64,") {
+				t.Errorf("base_image_url = %s, want a PNG data URL", req.Input.BaseImageURL)
+			}
+			if (req.Input.MaskImageURL != "") != tt.wantMask {
+				t.Errorf("mask_image_url = %q, want mask %v", req.Input.MaskImageURL, tt.wantMask)
+			}
+		})
+	}
+
+	if _, err := ToDashScopeImageEditRequest(&schemas.BifrostImageEditRequest{Model: "wanx2.1-imageedit", Input: &schemas.ImageEditInput{Prompt: "x"}}); err == nil {
+		t.Error("expected error for a request without an input image")
+	}
+}
+
+func TestImageGeneration_PollsTask(t *testing.T) {
+	t.Parallel()
+
+	var polls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == dashScopePathText2Image:
+			if r.Header.Get("X-DashScope-Async") != "enable" || r.Header.Get("Authorization") != "Bearer test-key" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"code":"InvalidParameter","message":"missing headers"}`)
+				return
+			}
+			var body DashScopeImageSynthesisRequest
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Input.Prompt != "a red fox" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			fmt.Fprint(w, `{"request_id":"r1","output":{"task_id":"task-1","task_status":"PENDING"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == dashScopePathTasks+"task-1":
+			polls.Add(1)
+			fmt.Fprint(w, `{"request_id":"r2","output":{"task_id":"task-1","task_status":"SUCCEEDED","results":[
+				{"url":"https://example.com/1.png","orig_prompt":"a red fox","actual_prompt":"a red fox in the snow"},
+				{"code":"DataInspectionFailed","message":"output data may contain inappropriate content"}
+			]},"usage":{"image_count":1}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	provider := newTestQwenProvider(t, server.URL)
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	response, bifrostErr := provider.ImageGeneration(ctx, schemas.Key{Value: schemas.EnvVar{Val: "test-key"}}, &schemas.BifrostImageGenerationRequest{
+		Provider: schemas.Qwen,
+		Model:    "wanx2.1-t2i-turbo",
+		Input:    &schemas.ImageGenerationInput{Prompt: "a red fox"},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("ImageGeneration() error = %v", bifrostErr.Error.Message)
+	}
+	if polls.Load() != 1 {
+		t.Errorf("polls = %d, want 1", polls.Load())
+	}
+	if response.ID != "task-1" || len(response.Data) != 1 {
+		t.Fatalf("response = %+v", response)
+	}
+	if response.Data[0].URL != "https://example.com/1.png" || response.Data[0].RevisedPrompt != "a red fox in the snow" {
+		t.Errorf("image = %+v", response.Data[0])
+	}
+	if response.ExtraFields.Provider != schemas.Qwen || response.ExtraFields.RequestType != schemas.ImageGenerationRequest {
+		t.Errorf("extra fields = %+v", response.ExtraFields)
+	}
+}
+
+func TestDashScopeTaskResponse_AllResultsFailed(t *testing.T) {
+	task := &DashScopeTaskResponse{Output: DashScopeTaskOutput{
+		TaskID:     "task-1",
+		TaskStatus: DashScopeTaskStatusSucceeded,
+		Results:    []DashScopeImageResult{{Code: "DataInspectionFailed", Message: "inappropriate content"}},
+	}}
+	if _, err := task.ToBifrostImageGenerationResponse("wanx2.1-t2i-turbo"); err == nil || !strings.Contains(err.Error(), "DataInspectionFailed") {
+		t.Errorf("error = %v, want the result's failure code", err)
+	}
+}
@@ -3,22 +3,70 @@
 package qwen
 
 import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
+	"github.com/bytedance/sonic"
 	"github.com/capsohq/bifrost/core/providers/openai"
 	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
 	schemas "github.com/capsohq/bifrost/core/schemas"
 	"github.com/valyala/fasthttp"
 )
 
+// DashScope native API paths, relative to the base URL without the compatible-mode suffix.
+const (
+	dashScopeCompatibleModeSuffix = "/compatible-mode/v1"
+	dashScopePathText2Image       = "/api/v1/services/aigc/text2image/image-synthesis"
+	dashScopePathImage2Image      = "/api/v1/services/aigc/image2image/image-synthesis"
+	dashScopePathTasks            = "/api/v1/tasks/"
+	dashScopePathRerank           = "/api/v1/services/rerank/text-rerank/text-rerank"
+	dashScopeTaskPollInterval     = 2 * time.Second
+)
+
+// DashScope OpenAI-compatible API paths, relative to the configured base URL.
+const (
+	compatibleModePathFiles   = "/files"
+	compatibleModePathBatches = "/batches"
+)
+
+// qwenBaseURLs are DashScope's OpenAI-compatible endpoints. International keys are issued either for the
+// US (the default) or the Singapore region; mainland China keys for Beijing.
+var qwenBaseURLs = []providerUtils.RegionalBaseURL{
+	{Region: schemas.EndpointRegionInternational, BaseURL: "https://dashscope-us.aliyuncs.com/compatible-mode/v1"},
+	{Region: schemas.EndpointRegionInternational, BaseURL: "https://dashscope-intl.aliyuncs.com/compatible-mode/v1"},
+	{Region: schemas.EndpointRegionChina, BaseURL: "https://dashscope.aliyuncs.com/compatible-mode/v1"},
+}
+
 // QwenProvider implements the Provider interface for Qwen's API.
 type QwenProvider struct {
-	logger              schemas.Logger        // Logger for provider operations
-	client              *fasthttp.Client      // HTTP client for API requests
-	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
-	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
-	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
+	logger              schemas.Logger                         // Logger for provider operations
+	client              *fasthttp.Client                       // HTTP client for API requests
+	networkConfig       schemas.NetworkConfig                  // Network configuration including extra headers
+	baseURLs            *providerUtils.RegionalBaseURLResolver // Picks the international or China endpoint per key
+	sendBackRawRequest  bool                                   // Whether to include raw request in BifrostResponse
+	sendBackRawResponse bool                                   // Whether to include raw response in BifrostResponse
+}
+
+// Compile-time checks for the capabilities QwenProvider implements.
+var (
+	_ schemas.ListModelsProvider      = (*QwenProvider)(nil)
+	_ schemas.TextCompletionProvider  = (*QwenProvider)(nil)
+	_ schemas.ChatProvider            = (*QwenProvider)(nil)
+	_ schemas.ResponsesProvider       = (*QwenProvider)(nil)
+	_ schemas.RerankProvider          = (*QwenProvider)(nil)
+	_ schemas.ImageGenerationProvider = (*QwenProvider)(nil)
+	_ schemas.ImageEditProvider       = (*QwenProvider)(nil)
+	_ schemas.BatchProvider           = (*QwenProvider)(nil)
+	_ schemas.FileProvider            = (*QwenProvider)(nil)
+)
+
+func init() {
+	schemas.RegisterProviderCapabilities(schemas.Qwen, schemas.CapabilitiesOf((*QwenProvider)(nil)))
 }
 
 // NewQwenProvider creates a new Qwen provider instance.
@@ -38,16 +86,16 @@ func NewQwenProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*Qw
 	// Configure proxy and retry policy
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
 	client = providerUtils.ConfigureDialer(client)
-	// Set default BaseURL if not provided
-	if config.NetworkConfig.BaseURL == "" {
-		config.NetworkConfig.BaseURL = "https://dashscope-us.aliyuncs.com/compatible-mode/v1"
+	baseURLs, err := providerUtils.NewRegionalBaseURLResolver(schemas.Qwen, client, config.NetworkConfig, qwenBaseURLs, "/models", logger)
+	if err != nil {
+		return nil, err
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
 
 	return &QwenProvider{
 		logger:              logger,
 		client:              client,
 		networkConfig:       config.NetworkConfig,
+		baseURLs:            baseURLs,
 		sendBackRawRequest:  config.SendBackRawRequest,
 		sendBackRawResponse: config.SendBackRawResponse,
 	}, nil
@@ -58,18 +106,192 @@ func (provider *QwenProvider) GetProviderKey() schemas.ModelProvider {
 	return schemas.Qwen
 }
 
+// HTTPClient returns the pooled client used for Qwen API requests.
+func (provider *QwenProvider) HTTPClient() *fasthttp.Client {
+	return provider.client
+}
+
+// dashScopeBaseURL returns the base URL of DashScope's native API, which the OpenAI-compatible base URL is nested under.
+func (provider *QwenProvider) dashScopeBaseURL(ctx *schemas.BifrostContext, key schemas.Key) string {
+	return strings.TrimSuffix(provider.baseURLs.BaseURL(ctx, key), dashScopeCompatibleModeSuffix)
+}
+
+// doDashScopeRequest sends a request to DashScope's native API and returns a copy of the response body.
+// Async requests create a task instead of waiting for the result.
+func (provider *QwenProvider) doDashScopeRequest(ctx *schemas.BifrostContext, key schemas.Key, method, path string, jsonData []byte, async bool, meta *providerUtils.RequestMetadata) ([]byte, map[string]string, *schemas.BifrostError) {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	req.SetRequestURI(provider.dashScopeBaseURL(ctx, key) + path)
+	req.Header.SetMethod(method)
+	if key.Value.GetValue() != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
+	}
+	if async {
+		req.Header.Set("X-DashScope-Async", "enable")
+	}
+	if jsonData != nil {
+		req.Header.SetContentType("application/json")
+		req.SetBody(jsonData)
+	}
+
+	_, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
+	if bifrostErr != nil {
+		return nil, nil, bifrostErr
+	}
+	// Extract provider response headers before the status check so error responses also forward them
+	providerResponseHeaders := providerUtils.ExtractProviderResponseHeaders(resp)
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return nil, providerResponseHeaders, parseDashScopeError(resp, meta)
+	}
+
+	body, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, providerResponseHeaders, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
+	}
+	// Copy the body since resp is released on return
+	return append([]byte(nil), body...), providerResponseHeaders, nil
+}
+
+// runImageTask creates a wanx image task, polls it until it reaches a terminal status or the
+// request timeout elapses, and converts the result to a Bifrost image generation response.
+func (provider *QwenProvider) runImageTask(ctx *schemas.BifrostContext, key schemas.Key, path string, jsonData []byte, meta *providerUtils.RequestMetadata) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	startTime := time.Now()
+
+	body, providerResponseHeaders, bifrostErr := provider.doDashScopeRequest(ctx, key, http.MethodPost, path, jsonData, true, meta)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+	var task DashScopeTaskResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &task, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if task.Output.TaskID == "" {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, fmt.Errorf("task creation response has no task_id"), meta.Provider)
+	}
+
+	pollCtx, cancel := schemas.NewBifrostContextWithTimeout(ctx, time.Duration(provider.networkConfig.DefaultRequestTimeoutInSeconds)*time.Second)
+	defer cancel()
+	ticker := time.NewTicker(dashScopeTaskPollInterval)
+	defer ticker.Stop()
+
+	for !isTerminalTaskStatus(task.Output.TaskStatus) {
+		select {
+		case <-pollCtx.Done():
+			return nil, providerUtils.NewBifrostOperationError(
+				schemas.ErrProviderRequestTimedOut,
+				fmt.Errorf("image task %s did not finish within %d seconds", task.Output.TaskID, provider.networkConfig.DefaultRequestTimeoutInSeconds),
+				meta.Provider,
+			)
+		case <-ticker.C:
+			body, providerResponseHeaders, bifrostErr = provider.doDashScopeRequest(pollCtx, key, http.MethodGet, dashScopePathTasks+task.Output.TaskID, nil, false, meta)
+			if bifrostErr != nil {
+				return nil, bifrostErr
+			}
+			task = DashScopeTaskResponse{}
+			_, rawResponse, bifrostErr = providerUtils.HandleProviderResponse(body, &task, nil, false, sendBackRawResponse)
+			if bifrostErr != nil {
+				return nil, bifrostErr
+			}
+			provider.logger.Debug(fmt.Sprintf("qwen image task %s status: %s", task.Output.TaskID, task.Output.TaskStatus))
+		}
+	}
+
+	if task.Output.TaskStatus != DashScopeTaskStatusSucceeded {
+		bifrostErr := providerUtils.NewBifrostOperationError(
+			fmt.Sprintf("image task %s", strings.ToLower(task.Output.TaskStatus)),
+			fmt.Errorf("%s: %s", task.Output.Code, task.Output.Message),
+			meta.Provider,
+		)
+		if task.Output.Code != "" {
+			bifrostErr.Error.Code = schemas.Ptr(task.Output.Code)
+		}
+		return nil, bifrostErr
+	}
+	response, err := task.ToBifrostImageGenerationResponse(meta.Model)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, meta.Provider)
+	}
+
+	response.ExtraFields.Provider = meta.Provider
+	response.ExtraFields.ModelRequested = meta.Model
+	response.ExtraFields.RequestType = meta.RequestType
+	response.ExtraFields.Latency = time.Since(startTime).Milliseconds()
+	response.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = rawResponse
+	}
+
+	return response, nil
+}
+
+// doCompatibleModeRequest sends a request to DashScope's OpenAI-compatible API and returns a copy of the
+// response body, its content type and the request latency. Error responses are parsed in OpenAI's format.
+func (provider *QwenProvider) doCompatibleModeRequest(ctx *schemas.BifrostContext, key schemas.Key, method, path, contentType string, body []byte, requestType schemas.RequestType) ([]byte, string, time.Duration, *schemas.BifrostError) {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + path)
+	req.Header.SetMethod(method)
+	if contentType != "" {
+		req.Header.SetContentType(contentType)
+	}
+	if key.Value.GetValue() != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
+	}
+	if body != nil {
+		req.SetBody(body)
+	}
+
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
+	if bifrostErr != nil {
+		return nil, "", latency, bifrostErr
+	}
+	if resp.StatusCode() != fasthttp.StatusOK {
+		provider.logger.Debug(fmt.Sprintf("error from %s provider: %s", provider.GetProviderKey(), string(resp.Body())))
+		return nil, "", latency, openai.ParseOpenAIError(resp, requestType, provider.GetProviderKey(), "")
+	}
+
+	decoded, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, "", latency, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
+	}
+	// Copy the body since resp is released on return
+	return append([]byte(nil), decoded...), string(resp.Header.ContentType()), latency, nil
+}
+
+// isTerminalTaskStatus reports whether a DashScope task has stopped running.
+func isTerminalTaskStatus(status string) bool {
+	return status != DashScopeTaskStatusPending && status != DashScopeTaskStatusRunning
+}
+
 // ListModels performs a list models request to Qwen's API.
 func (provider *QwenProvider) ListModels(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
-	return openai.HandleOpenAIListModelsRequest(
+	path := providerUtils.GetPathFromContext(ctx, "/models")
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	if len(keys) == 0 {
+		return openai.ListModelsByKey(ctx, provider.client, provider.baseURLs.DefaultBaseURL()+path, schemas.Key{}, request.Unfiltered, provider.networkConfig.ExtraHeaders, schemas.Qwen, sendBackRawRequest, sendBackRawResponse)
+	}
+	return providerUtils.HandleMultipleListModelsRequests(
 		ctx,
-		provider.client,
-		request,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/models"),
 		keys,
-		provider.networkConfig.ExtraHeaders,
-		schemas.Qwen,
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		request,
+		func(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
+			return openai.ListModelsByKey(ctx, provider.client, provider.baseURLs.BaseURL(ctx, key)+path, key, request.Unfiltered, provider.networkConfig.ExtraHeaders, schemas.Qwen, sendBackRawRequest, sendBackRawResponse)
+		},
 	)
 }
 
@@ -78,7 +300,7 @@ func (provider *QwenProvider) TextCompletion(ctx *schemas.BifrostContext, key sc
 	return openai.HandleOpenAITextCompletionRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/completions"),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, "/completions"),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -102,7 +324,7 @@ func (provider *QwenProvider) TextCompletionStream(ctx *schemas.BifrostContext,
 	return openai.HandleOpenAITextCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/completions"),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, "/completions"),
 		request,
 		authHeader,
 		provider.networkConfig.ExtraHeaders,
@@ -122,7 +344,7 @@ func (provider *QwenProvider) ChatCompletion(ctx *schemas.BifrostContext, key sc
 	return openai.HandleOpenAIChatCompletionRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/chat/completions"),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, "/chat/completions"),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -148,7 +370,7 @@ func (provider *QwenProvider) ChatCompletionStream(ctx *schemas.BifrostContext,
 	return openai.HandleOpenAIChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, "/chat/completions"),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, "/chat/completions"),
 		request,
 		authHeader,
 		provider.networkConfig.ExtraHeaders,
@@ -191,39 +413,79 @@ func (provider *QwenProvider) ResponsesStream(ctx *schemas.BifrostContext, postH
 	)
 }
 
-// Embedding is not supported by the Qwen provider.
-func (provider *QwenProvider) Embedding(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostEmbeddingRequest) (*schemas.BifrostEmbeddingResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.EmbeddingRequest, provider.GetProviderKey())
-}
+// Rerank performs a gte-rerank request using DashScope's native text rerank API.
+func (provider *QwenProvider) Rerank(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRerankRequest) (*schemas.BifrostRerankResponse, *schemas.BifrostError) {
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToDashScopeRerankRequest(request), nil
+		},
+		provider.GetProviderKey())
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// Speech is not supported by the Qwen provider.
-func (provider *QwenProvider) Speech(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechRequest, provider.GetProviderKey())
-}
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	startTime := time.Now()
 
-// SpeechStream is not supported by the Qwen provider.
-func (provider *QwenProvider) SpeechStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechStreamRequest, provider.GetProviderKey())
-}
+	body, providerResponseHeaders, bifrostErr := provider.doDashScopeRequest(ctx, key, http.MethodPost, dashScopePathRerank, jsonData, false, &providerUtils.RequestMetadata{
+		Provider:    provider.GetProviderKey(),
+		Model:       request.Model,
+		RequestType: schemas.RerankRequest,
+	})
+	latency := time.Since(startTime)
+	if providerResponseHeaders != nil {
+		ctx.SetValue(schemas.BifrostContextKeyProviderResponseHeaders, providerResponseHeaders)
+	}
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
 
-// Transcription is not supported by the Qwen provider.
-func (provider *QwenProvider) Transcription(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionRequest, provider.GetProviderKey())
-}
+	var response DashScopeRerankResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &response, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, body, sendBackRawRequest, sendBackRawResponse)
+	}
 
-// TranscriptionStream is not supported by the Qwen provider.
-func (provider *QwenProvider) TranscriptionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionStreamRequest, provider.GetProviderKey())
-}
+	returnDocuments := request.Params != nil && request.Params.ReturnDocuments != nil && *request.Params.ReturnDocuments
+	bifrostResponse := response.ToBifrostRerankResponse(request.Documents, returnDocuments)
+	bifrostResponse.Model = request.Model
 
-// Rerank is not supported by the Qwen provider.
-func (provider *QwenProvider) Rerank(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRerankRequest) (*schemas.BifrostRerankResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.RerankRequest, provider.GetProviderKey())
+	bifrostResponse.ExtraFields.Provider = provider.GetProviderKey()
+	bifrostResponse.ExtraFields.ModelRequested = request.Model
+	bifrostResponse.ExtraFields.RequestType = schemas.RerankRequest
+	bifrostResponse.ExtraFields.Latency = latency.Milliseconds()
+	bifrostResponse.ExtraFields.ProviderResponseHeaders = providerResponseHeaders
+	if sendBackRawRequest {
+		bifrostResponse.ExtraFields.RawRequest = rawRequest
+	}
+	if sendBackRawResponse {
+		bifrostResponse.ExtraFields.RawResponse = rawResponse
+	}
+
+	return bifrostResponse, nil
 }
 
-// ImageGeneration is not supported by the Qwen provider.
+// ImageGeneration performs a wanx text-to-image request using DashScope's native API.
+// The task is created asynchronously and polled until it finishes.
 func (provider *QwenProvider) ImageGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageGenerationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageGenerationRequest, provider.GetProviderKey())
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToDashScopeImageGenerationRequest(request)
+		},
+		provider.GetProviderKey())
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	return provider.runImageTask(ctx, key, dashScopePathText2Image, jsonData, &providerUtils.RequestMetadata{
+		Provider:    provider.GetProviderKey(),
+		Model:       request.Model,
+		RequestType: schemas.ImageGenerationRequest,
+	})
 }
 
 // ImageGenerationStream is not supported by the Qwen provider.
@@ -231,9 +493,24 @@ func (provider *QwenProvider) ImageGenerationStream(ctx *schemas.BifrostContext,
 	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageGenerationStreamRequest, provider.GetProviderKey())
 }
 
-// ImageEdit is not supported by the Qwen provider.
+// ImageEdit performs a wanx image edit request using DashScope's native API.
+// The task is created asynchronously and polled until it finishes.
 func (provider *QwenProvider) ImageEdit(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageEditRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditRequest, provider.GetProviderKey())
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return ToDashScopeImageEditRequest(request)
+		},
+		provider.GetProviderKey())
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	return provider.runImageTask(ctx, key, dashScopePathImage2Image, jsonData, &providerUtils.RequestMetadata{
+		Provider:    provider.GetProviderKey(),
+		Model:       request.Model,
+		RequestType: schemas.ImageEditRequest,
+	})
 }
 
 // ImageEditStream is not supported by the Qwen provider.
@@ -241,137 +518,511 @@ func (provider *QwenProvider) ImageEditStream(ctx *schemas.BifrostContext, postH
 	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditStreamRequest, provider.GetProviderKey())
 }
 
-// ImageVariation is not supported by the Qwen provider.
-func (provider *QwenProvider) ImageVariation(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageVariationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageVariationRequest, provider.GetProviderKey())
-}
+// FileUpload uploads a file, e.g. a batch input JSONL file, to DashScope's OpenAI-compatible files API.
+func (provider *QwenProvider) FileUpload(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
 
-// VideoGeneration is not supported by the Qwen provider.
-func (provider *QwenProvider) VideoGeneration(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoGenerationRequest, provider.GetProviderKey())
-}
+	if len(request.File) == 0 {
+		return nil, providerUtils.NewBifrostOperationError("file content is required", nil, providerName)
+	}
+	if request.Purpose == "" {
+		return nil, providerUtils.NewBifrostOperationError("purpose is required", nil, providerName)
+	}
 
-// VideoRetrieve is not supported by the Qwen provider.
-func (provider *QwenProvider) VideoRetrieve(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoRetrieveRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRetrieveRequest, provider.GetProviderKey())
-}
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	if err := writer.WriteField("purpose", string(request.Purpose)); err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to write purpose field", err, providerName)
+	}
+	filename := request.Filename
+	if filename == "" {
+		filename = "file.jsonl"
+	}
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to create form file", err, providerName)
+	}
+	if _, err := part.Write(request.File); err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to write file content", err, providerName)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to close multipart writer", err, providerName)
+	}
 
-// VideoDownload is not supported by the Qwen provider.
-func (provider *QwenProvider) VideoDownload(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoDownloadRequest) (*schemas.BifrostVideoDownloadResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoDownloadRequest, provider.GetProviderKey())
-}
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-// VideoDelete is not supported by Qwen provider.
-func (provider *QwenProvider) VideoDelete(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoDeleteRequest) (*schemas.BifrostVideoDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoDeleteRequest, provider.GetProviderKey())
-}
+	body, _, latency, bifrostErr := provider.doCompatibleModeRequest(ctx, key, http.MethodPost, compatibleModePathFiles, writer.FormDataContentType(), buf.Bytes(), schemas.FileUploadRequest)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// VideoList is not supported by Qwen provider.
-func (provider *QwenProvider) VideoList(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoListRequest) (*schemas.BifrostVideoListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoListRequest, provider.GetProviderKey())
-}
+	var openAIResp openai.OpenAIFileResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// VideoRemix is not supported by Qwen provider.
-func (provider *QwenProvider) VideoRemix(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostVideoRemixRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.VideoRemixRequest, provider.GetProviderKey())
+	return openAIResp.ToBifrostFileUploadResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
 }
 
-// FileUpload is not supported by Qwen provider.
-func (provider *QwenProvider) FileUpload(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostFileUploadRequest) (*schemas.BifrostFileUploadResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileUploadRequest, provider.GetProviderKey())
-}
+// FileList lists files using serial pagination across keys.
+// Exhausts all pages from one key before moving to the next.
+func (provider *QwenProvider) FileList(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFileListRequest) (*schemas.BifrostFileListResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-// FileList is not supported by Qwen provider.
-func (provider *QwenProvider) FileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileListRequest) (*schemas.BifrostFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileListRequest, provider.GetProviderKey())
-}
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
+	}
 
-// FileRetrieve is not supported by Qwen provider.
-func (provider *QwenProvider) FileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileRetrieveRequest, provider.GetProviderKey())
-}
+	key, nativeCursor, ok := helper.GetCurrentKey()
+	if !ok {
+		// All keys exhausted
+		return &schemas.BifrostFileListResponse{
+			Object:  "list",
+			Data:    []schemas.FileObject{},
+			HasMore: false,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.FileListRequest,
+				Provider:    providerName,
+			},
+		}, nil
+	}
 
-// FileDelete is not supported by Qwen provider.
-func (provider *QwenProvider) FileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileDeleteRequest) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileDeleteRequest, provider.GetProviderKey())
-}
+	values := url.Values{}
+	if request.Purpose != "" {
+		values.Set("purpose", string(request.Purpose))
+	}
+	if request.Limit > 0 {
+		values.Set("limit", fmt.Sprintf("%d", request.Limit))
+	}
+	if nativeCursor != "" {
+		values.Set("after", nativeCursor)
+	}
+	if request.Order != nil && *request.Order != "" {
+		values.Set("order", *request.Order)
+	}
+	path := compatibleModePathFiles
+	if encodedValues := values.Encode(); encodedValues != "" {
+		path += "?" + encodedValues
+	}
 
-// FileContent is not supported by Qwen provider.
-func (provider *QwenProvider) FileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostFileContentRequest) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.FileContentRequest, provider.GetProviderKey())
-}
+	body, _, latency, bifrostErr := provider.doCompatibleModeRequest(ctx, key, http.MethodGet, path, "", nil, schemas.FileListRequest)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// BatchCreate is not supported by Qwen provider.
-func (provider *QwenProvider) BatchCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchCreateRequest, provider.GetProviderKey())
-}
+	var openAIResp openai.OpenAIFileListResponse
+	_, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
 
-// BatchList is not supported by Qwen provider.
-func (provider *QwenProvider) BatchList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchListRequest, provider.GetProviderKey())
-}
+	files := make([]schemas.FileObject, 0, len(openAIResp.Data))
+	var lastFileID string
+	for _, file := range openAIResp.Data {
+		files = append(files, schemas.FileObject{
+			ID:            file.ID,
+			Object:        file.Object,
+			Bytes:         file.Bytes,
+			CreatedAt:     file.CreatedAt,
+			Filename:      file.Filename,
+			Purpose:       schemas.FilePurpose(file.Purpose),
+			Status:        openai.ToBifrostFileStatus(file.Status),
+			StatusDetails: file.StatusDetails,
+		})
+		lastFileID = file.ID
+	}
 
-// BatchRetrieve is not supported by Qwen provider.
-func (provider *QwenProvider) BatchRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchRetrieveRequest) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchRetrieveRequest, provider.GetProviderKey())
-}
+	nextCursor, hasMore := helper.BuildNextCursor(openAIResp.HasMore, lastFileID)
+	bifrostResp := &schemas.BifrostFileListResponse{
+		Object:  "list",
+		Data:    files,
+		HasMore: hasMore,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.FileListRequest,
+			Provider:    providerName,
+			Latency:     latency.Milliseconds(),
+		},
+	}
+	if nextCursor != "" {
+		bifrostResp.After = &nextCursor
+	}
+	if sendBackRawResponse {
+		bifrostResp.ExtraFields.RawResponse = rawResponse
+	}
 
-// BatchCancel is not supported by Qwen provider.
-func (provider *QwenProvider) BatchCancel(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchCancelRequest) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchCancelRequest, provider.GetProviderKey())
+	return bifrostResp, nil
 }
 
-// BatchResults is not supported by Qwen provider.
-func (provider *QwenProvider) BatchResults(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchResultsRequest) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchResultsRequest, provider.GetProviderKey())
-}
+// FileRetrieve retrieves file metadata by trying each key until found.
+func (provider *QwenProvider) FileRetrieve(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFileRetrieveRequest) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
 
-// CountTokens is not supported by the Qwen provider.
-func (provider *QwenProvider) CountTokens(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostResponsesRequest) (*schemas.BifrostCountTokensResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.CountTokensRequest, provider.GetProviderKey())
-}
+	if request.FileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
+	}
 
-// ContainerCreate is not supported by the Qwen provider.
-func (provider *QwenProvider) ContainerCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerCreateRequest) (*schemas.BifrostContainerCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerCreateRequest, provider.GetProviderKey())
-}
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
+		body, _, latency, bifrostErr := provider.doCompatibleModeRequest(ctx, key, http.MethodGet, compatibleModePathFiles+"/"+request.FileID, "", nil, schemas.FileRetrieveRequest)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
 
-// ContainerList is not supported by the Qwen provider.
-func (provider *QwenProvider) ContainerList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerListRequest) (*schemas.BifrostContainerListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerListRequest, provider.GetProviderKey())
+		var openAIResp openai.OpenAIFileResponse
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		return openAIResp.ToBifrostFileRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
+	})
 }
 
-// ContainerRetrieve is not supported by the Qwen provider.
-func (provider *QwenProvider) ContainerRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerRetrieveRequest) (*schemas.BifrostContainerRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerRetrieveRequest, provider.GetProviderKey())
+// FileDelete deletes a file by trying each key until successful.
+func (provider *QwenProvider) FileDelete(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFileDeleteRequest) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.FileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
+		body, _, latency, bifrostErr := provider.doCompatibleModeRequest(ctx, key, http.MethodDelete, compatibleModePathFiles+"/"+request.FileID, "", nil, schemas.FileDeleteRequest)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		var openAIResp openai.OpenAIFileDeleteResponse
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		result := &schemas.BifrostFileDeleteResponse{
+			ID:      openAIResp.ID,
+			Object:  openAIResp.Object,
+			Deleted: openAIResp.Deleted,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.FileDeleteRequest,
+				Provider:    providerName,
+				Latency:     latency.Milliseconds(),
+			},
+		}
+		if sendBackRawRequest {
+			result.ExtraFields.RawRequest = rawRequest
+		}
+		if sendBackRawResponse {
+			result.ExtraFields.RawResponse = rawResponse
+		}
+
+		return result, nil
+	})
 }
 
-// ContainerDelete is not supported by the Qwen provider.
-func (provider *QwenProvider) ContainerDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerDeleteRequest) (*schemas.BifrostContainerDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerDeleteRequest, provider.GetProviderKey())
+// FileContent downloads file content by trying each key until found.
+func (provider *QwenProvider) FileContent(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFileContentRequest) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.FileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("file_id is required", nil, providerName)
+	}
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
+		body, contentType, latency, bifrostErr := provider.doCompatibleModeRequest(ctx, key, http.MethodGet, compatibleModePathFiles+"/"+request.FileID+"/content", "", nil, schemas.FileContentRequest)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+
+		return &schemas.BifrostFileContentResponse{
+			FileID:      request.FileID,
+			Content:     body,
+			ContentType: contentType,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.FileContentRequest,
+				Provider:    providerName,
+				Latency:     latency.Milliseconds(),
+			},
+		}, nil
+	})
 }
 
-// ContainerFileCreate is not supported by the Qwen provider.
-func (provider *QwenProvider) ContainerFileCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerFileCreateRequest) (*schemas.BifrostContainerFileCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileCreateRequest, provider.GetProviderKey())
+// BatchCreate creates a batch job. Inline requests are first uploaded as a JSONL file with purpose "batch".
+func (provider *QwenProvider) BatchCreate(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchCreateResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	inputFileID := request.InputFileID
+	if inputFileID == "" && len(request.Requests) > 0 {
+		jsonlData, err := openai.ConvertRequestsToJSONL(request.Requests)
+		if err != nil {
+			return nil, providerUtils.NewBifrostOperationError("failed to convert requests to JSONL", err, providerName)
+		}
+		uploadResp, bifrostErr := provider.FileUpload(ctx, key, &schemas.BifrostFileUploadRequest{
+			Provider: providerName,
+			File:     jsonlData,
+			Filename: "batch_requests.jsonl",
+			Purpose:  schemas.FilePurposeBatch,
+		})
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+		inputFileID = uploadResp.ID
+	}
+
+	if inputFileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("either input_file_id or requests array is required for Qwen batch API", nil, providerName)
+	}
+	if request.Endpoint == "" {
+		return nil, providerUtils.NewBifrostOperationError("endpoint is required for Qwen batch API", nil, providerName)
+	}
+
+	openAIReq := &openai.OpenAIBatchRequest{
+		InputFileID:        inputFileID,
+		Endpoint:           string(request.Endpoint),
+		CompletionWindow:   request.CompletionWindow,
+		Metadata:           request.Metadata,
+		OutputExpiresAfter: request.OutputExpiresAfter,
+	}
+	if openAIReq.CompletionWindow == "" {
+		openAIReq.CompletionWindow = "24h"
+	}
+	jsonData, err := sonic.Marshal(openAIReq)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderRequestMarshal, err, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	body, _, latency, bifrostErr := provider.doCompatibleModeRequest(ctx, key, http.MethodPost, compatibleModePathBatches, "application/json", jsonData, schemas.BatchCreateRequest)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	var openAIResp openai.OpenAIBatchResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, jsonData, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, body, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	return openAIResp.ToBifrostBatchCreateResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
 }
 
-// ContainerFileList is not supported by the Qwen provider.
-func (provider *QwenProvider) ContainerFileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileListRequest) (*schemas.BifrostContainerFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileListRequest, provider.GetProviderKey())
+// BatchList lists batch jobs using serial pagination across keys.
+// Exhausts all pages from one key before moving to the next.
+func (provider *QwenProvider) BatchList(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	helper, err := providerUtils.NewSerialListHelper(keys, request.After, provider.logger)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("invalid pagination cursor", err, providerName)
+	}
+
+	key, nativeCursor, ok := helper.GetCurrentKey()
+	if !ok {
+		// All keys exhausted
+		return &schemas.BifrostBatchListResponse{
+			Object:  "list",
+			Data:    []schemas.BifrostBatchRetrieveResponse{},
+			HasMore: false,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.BatchListRequest,
+				Provider:    providerName,
+			},
+		}, nil
+	}
+
+	values := url.Values{}
+	if request.Limit > 0 {
+		values.Set("limit", fmt.Sprintf("%d", request.Limit))
+	}
+	if nativeCursor != "" {
+		values.Set("after", nativeCursor)
+	}
+	path := compatibleModePathBatches
+	if encodedValues := values.Encode(); encodedValues != "" {
+		path += "?" + encodedValues
+	}
+
+	body, _, latency, bifrostErr := provider.doCompatibleModeRequest(ctx, key, http.MethodGet, path, "", nil, schemas.BatchListRequest)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	var openAIResp openai.OpenAIBatchListResponse
+	rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+
+	batches := make([]schemas.BifrostBatchRetrieveResponse, 0, len(openAIResp.Data))
+	var lastBatchID string
+	for _, batch := range openAIResp.Data {
+		batches = append(batches, *batch.ToBifrostBatchRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse))
+		lastBatchID = batch.ID
+	}
+
+	nextCursor, hasMore := helper.BuildNextCursor(openAIResp.HasMore, lastBatchID)
+	bifrostResp := &schemas.BifrostBatchListResponse{
+		Object:  "list",
+		Data:    batches,
+		HasMore: hasMore,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.BatchListRequest,
+			Provider:    providerName,
+			Latency:     latency.Milliseconds(),
+		},
+	}
+	if nextCursor != "" {
+		bifrostResp.NextCursor = &nextCursor
+	}
+
+	return bifrostResp, nil
 }
 
-// ContainerFileRetrieve is not supported by the Qwen provider.
-func (provider *QwenProvider) ContainerFileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileRetrieveRequest) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileRetrieveRequest, provider.GetProviderKey())
+// BatchRetrieve retrieves a batch job by trying each key until found.
+func (provider *QwenProvider) BatchRetrieve(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostBatchRetrieveRequest) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.BatchID == "" {
+		return nil, providerUtils.NewBifrostOperationError("batch_id is required", nil, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
+		body, _, latency, bifrostErr := provider.doCompatibleModeRequest(ctx, key, http.MethodGet, compatibleModePathBatches+"/"+request.BatchID, "", nil, schemas.BatchRetrieveRequest)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		var openAIResp openai.OpenAIBatchResponse
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		return openAIResp.ToBifrostBatchRetrieveResponse(providerName, latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
+	})
 }
 
-// ContainerFileContent is not supported by the Qwen provider.
-func (provider *QwenProvider) ContainerFileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileContentRequest) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileContentRequest, provider.GetProviderKey())
+// BatchCancel cancels a batch job by trying each key until successful.
+func (provider *QwenProvider) BatchCancel(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostBatchCancelRequest) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.BatchID == "" {
+		return nil, providerUtils.NewBifrostOperationError("batch_id is required", nil, providerName)
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
+		body, _, latency, bifrostErr := provider.doCompatibleModeRequest(ctx, key, http.MethodPost, compatibleModePathBatches+"/"+request.BatchID+"/cancel", "application/json", nil, schemas.BatchCancelRequest)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		var openAIResp openai.OpenAIBatchResponse
+		rawRequest, rawResponse, bifrostErr := providerUtils.HandleProviderResponse(body, &openAIResp, nil, sendBackRawRequest, sendBackRawResponse)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		result := &schemas.BifrostBatchCancelResponse{
+			ID:           openAIResp.ID,
+			Object:       openAIResp.Object,
+			Status:       openai.ToBifrostBatchStatus(openAIResp.Status),
+			CancellingAt: openAIResp.CancellingAt,
+			CancelledAt:  openAIResp.CancelledAt,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.BatchCancelRequest,
+				Provider:    providerName,
+				Latency:     latency.Milliseconds(),
+			},
+		}
+		if openAIResp.RequestCounts != nil {
+			result.RequestCounts = schemas.BatchRequestCounts{
+				Total:     openAIResp.RequestCounts.Total,
+				Completed: openAIResp.RequestCounts.Completed,
+				Failed:    openAIResp.RequestCounts.Failed,
+			}
+		}
+		if sendBackRawRequest {
+			result.ExtraFields.RawRequest = rawRequest
+		}
+		if sendBackRawResponse {
+			result.ExtraFields.RawResponse = rawResponse
+		}
+
+		return result, nil
+	})
 }
 
-// ContainerFileDelete is not supported by the Qwen provider.
-func (provider *QwenProvider) ContainerFileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileDeleteRequest) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileDeleteRequest, provider.GetProviderKey())
+// BatchResults retrieves a finished batch and downloads its output file, parsed as one result per JSONL line.
+func (provider *QwenProvider) BatchResults(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostBatchResultsRequest) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
+	providerName := provider.GetProviderKey()
+
+	if request.BatchID == "" {
+		return nil, providerUtils.NewBifrostOperationError("batch_id is required", nil, providerName)
+	}
+
+	batchResp, bifrostErr := provider.BatchRetrieve(ctx, keys, &schemas.BifrostBatchRetrieveRequest{
+		Provider: request.Provider,
+		BatchID:  request.BatchID,
+	})
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if batchResp.OutputFileID == nil || *batchResp.OutputFileID == "" {
+		return nil, providerUtils.NewBifrostOperationError("batch results not available: output_file_id is empty (batch may not be completed)", nil, providerName)
+	}
+
+	return providerUtils.ExecuteWithKeyFailover(providerName, request.BatchID, keys, func(key schemas.Key) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
+		body, _, latency, bifrostErr := provider.doCompatibleModeRequest(ctx, key, http.MethodGet, compatibleModePathFiles+"/"+*batchResp.OutputFileID+"/content", "", nil, schemas.BatchResultsRequest)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+
+		var results []schemas.BatchResultItem
+		parseResult := providerUtils.ParseJSONL(body, func(line []byte) error {
+			var resultItem schemas.BatchResultItem
+			if err := sonic.Unmarshal(line, &resultItem); err != nil {
+				provider.logger.Warn(fmt.Sprintf("failed to parse batch result line: %v", err))
+				return err
+			}
+			results = append(results, resultItem)
+			return nil
+		})
+
+		batchResultsResp := &schemas.BifrostBatchResultsResponse{
+			BatchID: request.BatchID,
+			Results: results,
+			ExtraFields: schemas.BifrostResponseExtraFields{
+				RequestType: schemas.BatchResultsRequest,
+				Provider:    providerName,
+				Latency:     latency.Milliseconds(),
+			},
+		}
+		if len(parseResult.Errors) > 0 {
+			batchResultsResp.ExtraFields.ParseErrors = parseResult.Errors
+		}
+
+		return batchResultsResp, nil
+	})
 }
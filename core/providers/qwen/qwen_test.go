@@ -44,6 +44,17 @@ func TestQwen(t *testing.T) {
 			End2EndToolCalling:    true,
 			AutomaticFunctionCall: true,
 			ListModels:            true,
+			BatchCreate:           true,
+			BatchList:             true,
+			BatchRetrieve:         true,
+			BatchCancel:           true,
+			BatchResults:          true,
+			FileUpload:            true,
+			FileList:              true,
+			FileRetrieve:          true,
+			FileDelete:            true,
+			FileContent:           true,
+			FileBatchInput:        true,
 		},
 	}
 
@@ -0,0 +1,76 @@
+package qwen
+
+import (
+	"sort"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// ToDashScopeRerankRequest converts a Bifrost rerank request to DashScope's text rerank format.
+// DashScope only ranks plain text, so document IDs and metadata stay on the Bifrost side and are
+// restored from the request when the response is converted.
+func ToDashScopeRerankRequest(bifrostReq *schemas.BifrostRerankRequest) *DashScopeRerankRequest {
+	if bifrostReq == nil {
+		return nil
+	}
+
+	documents := make([]string, len(bifrostReq.Documents))
+	for i, doc := range bifrostReq.Documents {
+		documents[i] = doc.Text
+	}
+	dashScopeReq := &DashScopeRerankRequest{
+		Model: bifrostReq.Model,
+		Input: DashScopeRerankInput{
+			Query:     bifrostReq.Query,
+			Documents: documents,
+		},
+	}
+
+	if bifrostReq.Params != nil {
+		if bifrostReq.Params.TopN != nil {
+			dashScopeReq.Parameters = &DashScopeRerankParams{TopN: bifrostReq.Params.TopN}
+		}
+		dashScopeReq.ExtraParams = bifrostReq.Params.ExtraParams
+	}
+
+	return dashScopeReq
+}
+
+// ToBifrostRerankResponse converts a DashScope rerank response to Bifrost format.
+// Result indices refer to the request's documents, which are attached when returnDocuments is set.
+func (response *DashScopeRerankResponse) ToBifrostRerankResponse(documents []schemas.RerankDocument, returnDocuments bool) *schemas.BifrostRerankResponse {
+	if response == nil {
+		return nil
+	}
+
+	bifrostResponse := &schemas.BifrostRerankResponse{
+		ID:      response.RequestID,
+		Results: make([]schemas.RerankResult, 0, len(response.Output.Results)),
+	}
+	for _, result := range response.Output.Results {
+		rerankResult := schemas.RerankResult{
+			Index:          result.Index,
+			RelevanceScore: result.RelevanceScore,
+		}
+		if returnDocuments && result.Index >= 0 && result.Index < len(documents) {
+			rerankResult.Document = schemas.Ptr(documents[result.Index])
+		}
+		bifrostResponse.Results = append(bifrostResponse.Results, rerankResult)
+	}
+	sort.SliceStable(bifrostResponse.Results, func(i, j int) bool {
+		if bifrostResponse.Results[i].RelevanceScore == bifrostResponse.Results[j].RelevanceScore {
+			return bifrostResponse.Results[i].Index < bifrostResponse.Results[j].Index
+		}
+		return bifrostResponse.Results[i].RelevanceScore > bifrostResponse.Results[j].RelevanceScore
+	})
+
+	// gte-rerank only reports total tokens, all of which are input
+	if response.Usage != nil {
+		bifrostResponse.Usage = &schemas.BifrostLLMUsage{
+			PromptTokens: response.Usage.TotalTokens,
+			TotalTokens:  response.Usage.TotalTokens,
+		}
+	}
+
+	return bifrostResponse
+}
@@ -0,0 +1,117 @@
+package qwen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestToDashScopeRerankRequest(t *testing.T) {
+	req := ToDashScopeRerankRequest(&schemas.BifrostRerankRequest{
+		Model: "gte-rerank-v2",
+		Query: "what is bifrost",
+		Documents: []schemas.RerankDocument{
+			{Text: "Bifrost is an AI gateway", ID: schemas.Ptr("doc-1")},
+			{Text: "Asgard is a realm"},
+		},
+		Params: &schemas.RerankParameters{TopN: schemas.Ptr(1), ReturnDocuments: schemas.Ptr(true)},
+	})
+	if req.Input.Query != "what is bifrost" || len(req.Input.Documents) != 2 || req.Input.Documents[0] != "Bifrost is an AI gateway" {
+		t.Errorf("input = %+v", req.Input)
+	}
+	if req.Parameters == nil || *req.Parameters.TopN != 1 {
+		t.Errorf("parameters = %+v", req.Parameters)
+	}
+	if req.Parameters.ReturnDocuments != nil {
+		t.Error("documents are restored from the request and should not be requested from DashScope")
+	}
+}
+
+func TestDashScopeRerankResponse_ToBifrostRerankResponse(t *testing.T) {
+	documents := []schemas.RerankDocument{
+		{Text: "Asgard is a realm"},
+		{Text: "Bifrost is an AI gateway", ID: schemas.Ptr("doc-2")},
+		{Text: "Midgard is earth"},
+	}
+	response := &DashScopeRerankResponse{
+		RequestID: "req-1",
+		Output: DashScopeRerankOutput{Results: []DashScopeRerankResult{
+			{Index: 2, RelevanceScore: 0.1},
+			{Index: 1, RelevanceScore: 0.9},
+			{Index: 7, RelevanceScore: 0.05},
+		}},
+		Usage: &DashScopeRerankUsage{TotalTokens: 42},
+	}
+
+	bifrostResponse := response.ToBifrostRerankResponse(documents, true)
+	if bifrostResponse.ID != "req-1" || len(bifrostResponse.Results) != 3 {
+		t.Fatalf("response = %+v", bifrostResponse)
+	}
+	first := bifrostResponse.Results[0]
+	if first.Index != 1 || first.RelevanceScore != 0.9 || first.Document == nil || first.Document.ID == nil || *first.Document.ID != "doc-2" {
+		t.Errorf("first result = %+v, want document 1 with its ID", first)
+	}
+	if bifrostResponse.Results[1].Index != 2 || bifrostResponse.Results[1].Document.Text != "Midgard is earth" {
+		t.Errorf("second result = %+v", bifrostResponse.Results[1])
+	}
+	if bifrostResponse.Results[2].Document != nil {
+		t.Error("out of range index should not get a document")
+	}
+	if bifrostResponse.Usage == nil || bifrostResponse.Usage.PromptTokens != 42 || bifrostResponse.Usage.TotalTokens != 42 {
+		t.Errorf("usage = %+v", bifrostResponse.Usage)
+	}
+
+	if withoutDocuments := response.ToBifrostRerankResponse(documents, false); withoutDocuments.Results[0].Document != nil {
+		t.Error("documents should only be attached when requested")
+	}
+}
+
+func TestRerank(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodPost || r.URL.Path != dashScopePathRerank || r.Header.Get("Authorization") != "Bearer test-key" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("X-DashScope-Async") != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"code":"InvalidParameter","message":"rerank does not support async calls"}`)
+			return
+		}
+		var body DashScopeRerankRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Model != "gte-rerank-v2" || len(body.Input.Documents) != 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"code":"InvalidParameter","message":"bad body"}`)
+			return
+		}
+		fmt.Fprint(w, `{"request_id":"req-1","output":{"results":[{"index":1,"relevance_score":0.8},{"index":0,"relevance_score":0.2}]},"usage":{"total_tokens":17}}`)
+	}))
+	defer server.Close()
+
+	provider := newTestQwenProvider(t, server.URL)
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	response, bifrostErr := provider.Rerank(ctx, schemas.Key{Value: schemas.EnvVar{Val: "test-key"}}, &schemas.BifrostRerankRequest{
+		Provider:  schemas.Qwen,
+		Model:     "gte-rerank-v2",
+		Query:     "what is bifrost",
+		Documents: []schemas.RerankDocument{{Text: "Asgard is a realm"}, {Text: "Bifrost is an AI gateway"}},
+		Params:    &schemas.RerankParameters{ReturnDocuments: schemas.Ptr(true)},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("Rerank() error = %v", bifrostErr.Error.Message)
+	}
+	if len(response.Results) != 2 || response.Results[0].Index != 1 || response.Results[0].Document.Text != "Bifrost is an AI gateway" {
+		t.Errorf("results = %+v", response.Results)
+	}
+	if response.Model != "gte-rerank-v2" || response.ExtraFields.Provider != schemas.Qwen || response.ExtraFields.RequestType != schemas.RerankRequest {
+		t.Errorf("response = %+v", response)
+	}
+}
@@ -0,0 +1,146 @@
+package qwen
+
+// DashScope async task statuses returned by the task endpoint.
+const (
+	DashScopeTaskStatusPending   = "PENDING"
+	DashScopeTaskStatusRunning   = "RUNNING"
+	DashScopeTaskStatusSucceeded = "SUCCEEDED"
+	DashScopeTaskStatusFailed    = "FAILED"
+	DashScopeTaskStatusCanceled  = "CANCELED"
+	DashScopeTaskStatusUnknown   = "UNKNOWN" // Task does not exist or has expired
+)
+
+// DashScope image edit functions.
+const (
+	DashScopeImageEditDescription         = "description_edit"
+	DashScopeImageEditDescriptionWithMask = "description_edit_with_mask"
+	DashScopeImageEditExpand              = "expand"
+)
+
+// ==================== IMAGE TYPES ====================
+
+// DashScopeImageSynthesisRequest is the request body for wanx text-to-image and image edit tasks.
+type DashScopeImageSynthesisRequest struct {
+	Model       string                         `json:"model"`
+	Input       DashScopeImageSynthesisInput   `json:"input"`
+	Parameters  *DashScopeImageSynthesisParams `json:"parameters,omitempty"`
+	ExtraParams map[string]interface{}         `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface
+func (r *DashScopeImageSynthesisRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+// DashScopeImageSynthesisInput holds the prompt and, for image edits, the edit function and images.
+type DashScopeImageSynthesisInput struct {
+	Prompt         string  `json:"prompt,omitempty"`
+	NegativePrompt *string `json:"negative_prompt,omitempty"`
+	Function       string  `json:"function,omitempty"`       // Image edit function, e.g. "description_edit"
+	BaseImageURL   string  `json:"base_image_url,omitempty"` // URL or base64 data URL of the image to edit
+	MaskImageURL   string  `json:"mask_image_url,omitempty"` // URL or base64 data URL of the edit mask
+}
+
+// DashScopeImageSynthesisParams are the generation parameters of an image task.
+type DashScopeImageSynthesisParams struct {
+	Size         *string `json:"size,omitempty"` // e.g. "1024*1024"
+	N            *int    `json:"n,omitempty"`
+	Seed         *int    `json:"seed,omitempty"`
+	PromptExtend *bool   `json:"prompt_extend,omitempty"` // Let the model rewrite the prompt
+	Watermark    *bool   `json:"watermark,omitempty"`
+}
+
+// DashScopeTaskResponse is returned when a task is created and when it is polled.
+type DashScopeTaskResponse struct {
+	RequestID string              `json:"request_id"`
+	Output    DashScopeTaskOutput `json:"output"`
+	Usage     *DashScopeTaskUsage `json:"usage,omitempty"`
+}
+
+// DashScopeTaskOutput is the state of an async task.
+type DashScopeTaskOutput struct {
+	TaskID     string                 `json:"task_id"`
+	TaskStatus string                 `json:"task_status"`
+	Results    []DashScopeImageResult `json:"results,omitempty"`
+	SubmitTime string                 `json:"submit_time,omitempty"`
+	EndTime    string                 `json:"end_time,omitempty"`
+	Code       string                 `json:"code,omitempty"` // Set when the task failed
+	Message    string                 `json:"message,omitempty"`
+}
+
+// DashScopeImageResult is a single generated image, or the reason it could not be generated.
+type DashScopeImageResult struct {
+	URL          string `json:"url,omitempty"`
+	OrigPrompt   string `json:"orig_prompt,omitempty"`
+	ActualPrompt string `json:"actual_prompt,omitempty"` // Rewritten prompt when prompt_extend is enabled
+	Code         string `json:"code,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
+
+// DashScopeTaskUsage reports the billable units of a finished task.
+type DashScopeTaskUsage struct {
+	ImageCount int `json:"image_count"`
+}
+
+// DashScopeError is the error body returned by DashScope's native API.
+type DashScopeError struct {
+	RequestID string `json:"request_id"`
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+}
+
+// ==================== RERANK TYPES ====================
+
+// DashScopeRerankRequest is the request body for gte-rerank text rerank requests.
+type DashScopeRerankRequest struct {
+	Model       string                 `json:"model"`
+	Input       DashScopeRerankInput   `json:"input"`
+	Parameters  *DashScopeRerankParams `json:"parameters,omitempty"`
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface
+func (r *DashScopeRerankRequest) GetExtraParams() map[string]interface{} {
+	return r.ExtraParams
+}
+
+// DashScopeRerankInput holds the query and the documents to rank.
+type DashScopeRerankInput struct {
+	Query     string   `json:"query"`
+	Documents []string `json:"documents"`
+}
+
+// DashScopeRerankParams are the parameters of a rerank request.
+type DashScopeRerankParams struct {
+	TopN            *int  `json:"top_n,omitempty"`
+	ReturnDocuments *bool `json:"return_documents,omitempty"`
+}
+
+// DashScopeRerankResponse is the response of a rerank request.
+type DashScopeRerankResponse struct {
+	RequestID string                `json:"request_id"`
+	Output    DashScopeRerankOutput `json:"output"`
+	Usage     *DashScopeRerankUsage `json:"usage,omitempty"`
+}
+
+// DashScopeRerankOutput holds the ranked results, ordered by descending relevance.
+type DashScopeRerankOutput struct {
+	Results []DashScopeRerankResult `json:"results"`
+}
+
+// DashScopeRerankResult is a ranked document; Index refers to the position in the request's documents.
+type DashScopeRerankResult struct {
+	Index          int                      `json:"index"`
+	RelevanceScore float64                  `json:"relevance_score"`
+	Document       *DashScopeRerankDocument `json:"document,omitempty"`
+}
+
+// DashScopeRerankDocument is a document echoed back when return_documents is set.
+type DashScopeRerankDocument struct {
+	Text string `json:"text"`
+}
+
+// DashScopeRerankUsage is the token usage of a rerank request.
+type DashScopeRerankUsage struct {
+	TotalTokens int `json:"total_tokens"`
+}
@@ -0,0 +1,182 @@
+// Package providers implements various LLM providers and their utility functions.
+// This file contains the iFlytek Spark provider implementation.
+package spark
+
+import (
+	"maps"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/capsohq/bifrost/core/providers/openai"
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// SparkProvider implements the Provider interface for iFlytek Spark's OpenAI-compatible HTTP API.
+// Keys are either an APIPassword (sent as a bearer token) or "APIKey:APISecret" credentials,
+// which are signed per request with iFlytek's HMAC-SHA256 scheme.
+type SparkProvider struct {
+	logger              schemas.Logger        // Logger for provider operations
+	client              *fasthttp.Client      // HTTP client for API requests
+	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
+	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
+	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
+}
+
+// Compile-time checks for the capabilities SparkProvider implements.
+var (
+	_ schemas.ChatProvider      = (*SparkProvider)(nil)
+	_ schemas.ResponsesProvider = (*SparkProvider)(nil)
+)
+
+func init() {
+	schemas.RegisterProviderCapabilities(schemas.Spark, schemas.CapabilitiesOf((*SparkProvider)(nil)))
+}
+
+// NewSparkProvider creates a new Spark provider instance.
+// It initializes the HTTP client with the provided configuration and sets up response pools.
+// The client is configured with timeouts, concurrency limits, and optional proxy settings.
+func NewSparkProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*SparkProvider, error) {
+	config.CheckAndSetDefaults()
+
+	client := &fasthttp.Client{
+		ReadTimeout:         time.Second * time.Duration(config.NetworkConfig.DefaultRequestTimeoutInSeconds),
+		WriteTimeout:        time.Second * time.Duration(config.NetworkConfig.DefaultRequestTimeoutInSeconds),
+		MaxConnsPerHost:     5000,
+		MaxIdleConnDuration: 30 * time.Second,
+		MaxConnWaitTimeout:  10 * time.Second,
+	}
+
+	// Configure proxy and retry policy
+	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
+	client = providerUtils.ConfigureDialer(client)
+	// Set default BaseURL if not provided
+	if config.NetworkConfig.BaseURL == "" {
+		config.NetworkConfig.BaseURL = "https://spark-api-open.xf-yun.com"
+	}
+	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
+
+	return &SparkProvider{
+		logger:              logger,
+		client:              client,
+		networkConfig:       config.NetworkConfig,
+		sendBackRawRequest:  config.SendBackRawRequest,
+		sendBackRawResponse: config.SendBackRawResponse,
+	}, nil
+}
+
+// GetProviderKey returns the provider identifier for Spark.
+func (provider *SparkProvider) GetProviderKey() schemas.ModelProvider {
+	return schemas.Spark
+}
+
+// HTTPClient returns the pooled client used for Spark API requests.
+func (provider *SparkProvider) HTTPClient() *fasthttp.Client {
+	return provider.client
+}
+
+// authHeaders returns the headers that authenticate a request to url.
+// "APIKey:APISecret" keys are signed with HMAC-SHA256 (Date and Authorization headers);
+// any other key value is sent as a bearer APIPassword.
+func (provider *SparkProvider) authHeaders(key schemas.Key, url string) (map[string]string, *schemas.BifrostError) {
+	value := key.Value.GetValue()
+	if value == "" {
+		return nil, nil
+	}
+	apiKey, apiSecret, ok := providerUtils.SplitHMACCredentials(value)
+	if !ok {
+		return map[string]string{"Authorization": "Bearer " + value}, nil
+	}
+	headers, err := providerUtils.BuildHMACSignatureHeaders(apiKey, apiSecret, http.MethodPost, url, time.Now())
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError("failed to sign spark request", err, provider.GetProviderKey())
+	}
+	return headers, nil
+}
+
+// ChatCompletion performs a chat completion request to the Spark API.
+func (provider *SparkProvider) ChatCompletion(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostChatRequest) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
+	url := provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, "/v1/chat/completions")
+	authHeaders, bifrostErr := provider.authHeaders(key, url)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	// Auth is carried in the extra headers; clear the key so no conflicting bearer header is added
+	extraHeaders := make(map[string]string, len(provider.networkConfig.ExtraHeaders)+len(authHeaders))
+	maps.Copy(extraHeaders, provider.networkConfig.ExtraHeaders)
+	maps.Copy(extraHeaders, authHeaders)
+	key.Value = *schemas.NewEnvVar("")
+	return openai.HandleOpenAIChatCompletionRequest(
+		ctx,
+		provider.client,
+		url,
+		request,
+		key,
+		extraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		provider.GetProviderKey(),
+		nil,
+		nil,
+		provider.logger,
+	)
+}
+
+// ChatCompletionStream performs a streaming chat completion request to the Spark API.
+// It supports real-time streaming of responses using Server-Sent Events (SSE).
+// Uses Spark's OpenAI-compatible streaming format.
+// Returns a channel containing BifrostStreamChunk objects representing the stream or an error if the request fails.
+func (provider *SparkProvider) ChatCompletionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostChatRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
+	url := provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, "/v1/chat/completions")
+	authHeader, bifrostErr := provider.authHeaders(key, url)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	// Use shared OpenAI-compatible streaming logic
+	return openai.HandleOpenAIChatCompletionStreaming(
+		ctx,
+		provider.client,
+		url,
+		request,
+		authHeader,
+		provider.networkConfig.ExtraHeaders,
+		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
+		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		schemas.Spark,
+		postHookRunner,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		provider.logger,
+	)
+}
+
+// Responses performs a responses request to the Spark API.
+func (provider *SparkProvider) Responses(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostResponsesRequest) (*schemas.BifrostResponsesResponse, *schemas.BifrostError) {
+	chatResponse, err := provider.ChatCompletion(ctx, key, request.ToChatRequest())
+	if err != nil {
+		return nil, err
+	}
+
+	response := chatResponse.ToBifrostResponsesResponse()
+	response.ExtraFields.RequestType = schemas.ResponsesRequest
+	response.ExtraFields.Provider = provider.GetProviderKey()
+	response.ExtraFields.ModelRequested = request.Model
+
+	return response, nil
+}
+
+// ResponsesStream performs a streaming responses request to the Spark API.
+func (provider *SparkProvider) ResponsesStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostResponsesRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
+	ctx.SetValue(schemas.BifrostContextKeyIsResponsesToChatCompletionFallback, true)
+	return provider.ChatCompletionStream(
+		ctx,
+		postHookRunner,
+		key,
+		request.ToChatRequest(),
+	)
+}
@@ -0,0 +1,50 @@
+package spark_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/internal/llmtests"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func envOrDefault(key, fallback string) string {
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func TestSpark(t *testing.T) {
+	t.Parallel()
+
+	if strings.TrimSpace(os.Getenv("SPARK_API_KEY")) == "" {
+		t.Skip("Skipping Spark tests because SPARK_API_KEY is not set")
+	}
+
+	client, ctx, cancel, err := llmtests.SetupTest()
+	if err != nil {
+		t.Fatalf("Error initializing test setup: %v", err)
+	}
+	defer cancel()
+
+	testConfig := llmtests.ComprehensiveTestConfig{
+		Provider:  schemas.Spark,
+		ChatModel: envOrDefault("SPARK_CHAT_MODEL", "4.0Ultra"),
+		Scenarios: llmtests.TestScenarios{
+			SimpleChat:            true,
+			CompletionStream:      true,
+			MultiTurnConversation: true,
+			ToolCalls:             true,
+			MultipleToolCalls:     true,
+			End2EndToolCalling:    true,
+			AutomaticFunctionCall: true,
+		},
+	}
+
+	t.Run("SparkTests", func(t *testing.T) {
+		llmtests.RunAllComprehensiveTests(t, client, ctx, testConfig)
+	})
+	client.Shutdown()
+}
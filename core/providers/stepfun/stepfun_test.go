@@ -0,0 +1,57 @@
+package stepfun_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/internal/llmtests"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func envOrDefault(key, fallback string) string {
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func TestStepFun(t *testing.T) {
+	t.Parallel()
+
+	if strings.TrimSpace(os.Getenv("STEPFUN_API_KEY")) == "" {
+		t.Skip("Skipping StepFun tests because STEPFUN_API_KEY is not set")
+	}
+
+	client, ctx, cancel, err := llmtests.SetupTest()
+	if err != nil {
+		t.Fatalf("Error initializing test setup: %v", err)
+	}
+	defer cancel()
+
+	testConfig := llmtests.ComprehensiveTestConfig{
+		Provider:             schemas.StepFun,
+		ChatModel:            envOrDefault("STEPFUN_CHAT_MODEL", "step-2-mini"),
+		VisionModel:          envOrDefault("STEPFUN_VISION_MODEL", "step-1v-8k"),
+		SpeechSynthesisModel: envOrDefault("STEPFUN_SPEECH_MODEL", "step-tts-mini"),
+		TranscriptionModel:   envOrDefault("STEPFUN_TRANSCRIPTION_MODEL", "step-asr"),
+		Scenarios: llmtests.TestScenarios{
+			SimpleChat:            true,
+			CompletionStream:      true,
+			MultiTurnConversation: true,
+			ToolCalls:             true,
+			MultipleToolCalls:     true,
+			End2EndToolCalling:    true,
+			AutomaticFunctionCall: true,
+			ImageURL:              true,
+			SpeechSynthesis:       true,
+			Transcription:         true,
+			ListModels:            true,
+		},
+	}
+
+	t.Run("StepFunTests", func(t *testing.T) {
+		llmtests.RunAllComprehensiveTests(t, client, ctx, testConfig)
+	})
+	client.Shutdown()
+}
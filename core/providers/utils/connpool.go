@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// connectionHealthCheckTimeout caps how long a single connection health probe may take.
+	connectionHealthCheckTimeout = 5 * time.Second
+	// maxConnectionWarmups caps how many connections a single refresh re-dials per host.
+	maxConnectionWarmups = 32
+)
+
+// PooledClientProvider is implemented by providers that send requests through a pooled
+// fasthttp client, so Bifrost can keep the client's connections healthy.
+type PooledClientProvider interface {
+	HTTPClient() *fasthttp.Client
+}
+
+// ConnectionPool tracks the per-host connection pools of a fasthttp client so that they can be
+// refreshed periodically. Hosts are picked up as the client first connects to them, which covers
+// providers whose endpoint comes from the key or the request rather than a fixed base URL.
+type ConnectionPool struct {
+	mu    sync.Mutex
+	hosts []*fasthttp.HostClient
+}
+
+// WatchConnectionPool starts tracking the host pools of client. It must be called before the
+// client sends its first request, since hosts are registered when their pool is created.
+func WatchConnectionPool(client *fasthttp.Client) *ConnectionPool {
+	pool := &ConnectionPool{}
+	existingConfigure := client.ConfigureClient
+	client.ConfigureClient = func(hc *fasthttp.HostClient) error {
+		if existingConfigure != nil {
+			if err := existingConfigure(hc); err != nil {
+				return err
+			}
+		}
+		pool.mu.Lock()
+		pool.hosts = append(pool.hosts, hc)
+		pool.mu.Unlock()
+		return nil
+	}
+	return pool
+}
+
+// Refresh recycles every idle connection of every tracked host and dials the same number of
+// replacements with concurrent OPTIONS probes, so the next real requests find fresh, validated
+// connections instead of ones a NAT or load balancer may have silently dropped. Hosts without
+// idle connections still get one probe, which keeps a connection warm across quiet periods.
+// Any HTTP response, regardless of status code, counts as healthy.
+func (pool *ConnectionPool) Refresh(ctx context.Context) error {
+	pool.mu.Lock()
+	hosts := append([]*fasthttp.HostClient(nil), pool.hosts...)
+	pool.mu.Unlock()
+
+	var errs []error
+	for _, hc := range hosts {
+		if err := refreshHostConnections(ctx, hc); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// refreshHostConnections closes the idle connections of hc and re-dials as many as were closed.
+func refreshHostConnections(ctx context.Context, hc *fasthttp.HostClient) error {
+	timeout := connectionHealthCheckTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < timeout {
+			timeout = remaining
+		}
+	}
+	if timeout <= 0 {
+		return context.DeadlineExceeded
+	}
+
+	open := hc.ConnsCount()
+	hc.CloseIdleConnections()
+	warmups := min(max(open-hc.ConnsCount(), 1), maxConnectionWarmups)
+
+	probeURL := hostProbeURL(hc)
+	errs := make([]error, warmups)
+	var wg sync.WaitGroup
+	for i := range warmups {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := fasthttp.AcquireRequest()
+			resp := fasthttp.AcquireResponse()
+			defer fasthttp.ReleaseRequest(req)
+			defer fasthttp.ReleaseResponse(resp)
+
+			req.SetRequestURI(probeURL)
+			// OPTIONS rather than HEAD: servers often answer HEAD without a Content-Length,
+			// which makes the client close the connection instead of returning it to the pool.
+			req.Header.SetMethod(http.MethodOptions)
+			errs[i] = hc.DoTimeout(req, resp, timeout)
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		// Don't leave connections behind that were dialed while the upstream was failing
+		hc.CloseIdleConnections()
+		return err
+	}
+	return nil
+}
+
+// hostProbeURL returns the root URL of the host served by hc, without the default port.
+func hostProbeURL(hc *fasthttp.HostClient) string {
+	scheme, defaultPort := "http", ":80"
+	if hc.IsTLS {
+		scheme, defaultPort = "https", ":443"
+	}
+	return scheme + "://" + strings.TrimSuffix(hc.Addr, defaultPort) + "/"
+}
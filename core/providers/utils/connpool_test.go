@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// newConnCountingServer returns a server that counts the connections dialed to it and the
+// OPTIONS probes it receives. Every request is held briefly so concurrent requests overlap.
+func newConnCountingServer(t *testing.T) (*httptest.Server, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+	var conns, probes atomic.Int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			probes.Add(1)
+		}
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			conns.Add(1)
+		}
+	}
+	server.Start()
+	t.Cleanup(server.Close)
+	return server, &conns, &probes
+}
+
+// TestConnectionPoolRefresh_RecyclesIdleConnections verifies that a refresh replaces every idle
+// connection of the pool with a freshly dialed one, whatever status code the probe gets back.
+func TestConnectionPoolRefresh_RecyclesIdleConnections(t *testing.T) {
+	server, conns, probes := newConnCountingServer(t)
+
+	client := ConfigureDialer(&fasthttp.Client{ReadTimeout: 5 * time.Second})
+	pool := WatchConnectionPool(client)
+
+	// Open three pooled connections with overlapping requests
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := client.Get(nil, server.URL+"/v1/models"); err != nil {
+				t.Errorf("Get() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if got := conns.Load(); got != 3 {
+		t.Fatalf("connections before refresh = %d, want 3", got)
+	}
+
+	if err := pool.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v, want nil", err)
+	}
+	if got := probes.Load(); got != 3 {
+		t.Errorf("probes = %d, want 3", got)
+	}
+	if got := conns.Load(); got != 6 {
+		t.Errorf("connections after refresh = %d, want 6 (3 recycled and re-dialed)", got)
+	}
+}
+
+// TestConnectionPoolRefresh_KeepsIdleHostWarm verifies that a host without idle connections
+// still gets a probe, so a connection is ready for the next request.
+func TestConnectionPoolRefresh_KeepsIdleHostWarm(t *testing.T) {
+	server, conns, probes := newConnCountingServer(t)
+
+	client := ConfigureDialer(&fasthttp.Client{ReadTimeout: 5 * time.Second, MaxIdleConnDuration: time.Minute})
+	pool := WatchConnectionPool(client)
+	if _, _, err := client.Get(nil, server.URL); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	client.CloseIdleConnections()
+
+	if err := pool.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v, want nil", err)
+	}
+	if got := probes.Load(); got != 1 {
+		t.Errorf("probes = %d, want 1", got)
+	}
+
+	// The next request reuses the connection dialed by the probe
+	dialed := conns.Load()
+	if _, _, err := client.Get(nil, server.URL); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got := conns.Load(); got != dialed {
+		t.Errorf("connections = %d, want %d (request should reuse the warmed connection)", got, dialed)
+	}
+}
+
+// TestConnectionPoolRefresh_UnreachableHost verifies that a failed probe is reported.
+func TestConnectionPoolRefresh_UnreachableHost(t *testing.T) {
+	server, _, _ := newConnCountingServer(t)
+
+	client := ConfigureDialer(&fasthttp.Client{ReadTimeout: time.Second})
+	pool := WatchConnectionPool(client)
+	if _, _, err := client.Get(nil, server.URL); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	server.Close()
+
+	if err := pool.Refresh(context.Background()); err == nil {
+		t.Fatal("Refresh() error = nil, want error for unreachable host")
+	}
+}
+
+// TestConnectionPoolRefresh_NoHosts verifies that nothing is probed before the client
+// has connected to any host.
+func TestConnectionPoolRefresh_NoHosts(t *testing.T) {
+	pool := WatchConnectionPool(&fasthttp.Client{})
+	if err := pool.Refresh(context.Background()); err != nil {
+		t.Fatalf("Refresh() error = %v, want nil", err)
+	}
+}
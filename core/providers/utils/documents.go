@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/valyala/fasthttp"
+)
+
+// MaxDocumentBytes caps the size of a single document downloaded by DownloadDocument.
+const MaxDocumentBytes = 32 * 1024 * 1024
+
+// documentDownloadClient is shared by all document downloads so connections are reused.
+var documentDownloadClient = &fasthttp.Client{
+	ReadTimeout:         60 * time.Second,
+	MaxResponseBodySize: MaxDocumentBytes,
+}
+
+// DownloadDocument fetches a document and returns its bytes and MIME type.
+// The MIME type comes from the Content-Type header, falling back to content sniffing.
+func DownloadDocument(ctx context.Context, documentURL string) ([]byte, string, error) {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	req.SetRequestURI(documentURL)
+	req.Header.SetMethod(http.MethodGet)
+
+	if _, bifrostErr := MakeRequestWithContext(ctx, documentDownloadClient, req, resp); bifrostErr != nil {
+		if bifrostErr.Error != nil {
+			return nil, "", fmt.Errorf("failed to download document: %s", bifrostErr.Error.Message)
+		}
+		return nil, "", fmt.Errorf("failed to download document")
+	}
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return nil, "", fmt.Errorf("failed to download document: status=%d", resp.StatusCode())
+	}
+	body, err := CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read document data: %w", err)
+	}
+	// Copy the body to avoid use-after-free
+	data := append([]byte(nil), body...)
+
+	mimeType, _, _ := mime.ParseMediaType(string(resp.Header.ContentType()))
+	if mimeType == "" || mimeType == "application/octet-stream" {
+		mimeType, _, _ = mime.ParseMediaType(http.DetectContentType(data))
+	}
+	return data, mimeType, nil
+}
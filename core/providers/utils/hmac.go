@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SplitHMACCredentials splits a "apiKey:apiSecret" key value into its parts.
+// ok is false when the value is not in that form (e.g. a plain bearer token).
+func SplitHMACCredentials(value string) (apiKey, apiSecret string, ok bool) {
+	apiKey, apiSecret, ok = strings.Cut(value, ":")
+	if !ok || apiKey == "" || apiSecret == "" {
+		return "", "", false
+	}
+	return apiKey, apiSecret, true
+}
+
+// BuildHMACSignatureHeaders signs a request with the HMAC-SHA256 scheme used by iFlytek APIs.
+// The signature covers the host, date and request line of rawURL:
+//
+//	signature = base64(hmac-sha256(apiSecret, "host: <host>\ndate: <date>\n<METHOD> <path> HTTP/1.1"))
+//
+// It returns the Date and Authorization headers to send with the request.
+func BuildHMACSignatureHeaders(apiKey, apiSecret, method, rawURL string, now time.Time) (map[string]string, error) {
+	if apiKey == "" || apiSecret == "" {
+		return nil, fmt.Errorf("hmac signing requires both an api key and an api secret")
+	}
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request url: %w", err)
+	}
+	path := parsed.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if parsed.RawQuery != "" {
+		path += "?" + parsed.RawQuery
+	}
+
+	date := now.UTC().Format(http.TimeFormat)
+	signatureOrigin := fmt.Sprintf("host: %s\ndate: %s\n%s %s HTTP/1.1", parsed.Host, date, strings.ToUpper(method), path)
+	mac := hmac.New(sha256.New, []byte(apiSecret))
+	mac.Write([]byte(signatureOrigin))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	return map[string]string{
+		"Date":          date,
+		"Authorization": fmt.Sprintf(`api_key="%s", algorithm="hmac-sha256", headers="host date request-line", signature="%s"`, apiKey, signature),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+func TestBuildHMACSignatureHeaders(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	headers, err := BuildHMACSignatureHeaders("key-id", "secret", "post", "https://spark-api-open.xf-yun.com/v1/chat/completions", now)
+	if err != nil {
+		t.Fatalf("BuildHMACSignatureHeaders() error = %v", err)
+	}
+
+	if headers["Date"] != "Fri, 02 Jan 2026 03:04:05 GMT" {
+		t.Errorf("Date = %q", headers["Date"])
+	}
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("host: spark-api-open.xf-yun.com\ndate: Fri, 02 Jan 2026 03:04:05 GMT\nPOST /v1/chat/completions HTTP/1.1"))
+	want := `api_key="key-id", algorithm="hmac-sha256", headers="host date request-line", signature="` + base64.StdEncoding.EncodeToString(mac.Sum(nil)) + `"`
+	if headers["Authorization"] != want {
+		t.Errorf("Authorization = %q, want %q", headers["Authorization"], want)
+	}
+
+	if _, err := BuildHMACSignatureHeaders("key-id", "", "POST", "https://example.com", now); err == nil {
+		t.Error("expected error for missing api secret")
+	}
+}
+
+func TestSplitHMACCredentials(t *testing.T) {
+	if apiKey, apiSecret, ok := SplitHMACCredentials("abc:def"); !ok || apiKey != "abc" || apiSecret != "def" {
+		t.Errorf("SplitHMACCredentials(abc:def) = %q, %q, %v", apiKey, apiSecret, ok)
+	}
+	for _, value := range []string{"password-only", ":def", "abc:", ""} {
+		if _, _, ok := SplitHMACCredentials(value); ok {
+			t.Errorf("SplitHMACCredentials(%q) ok = true, want false", value)
+		}
+	}
+}
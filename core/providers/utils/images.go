@@ -0,0 +1,83 @@
+package utils
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// MaxInlineImageBytes caps the size of a single image downloaded by InlineImageURLs.
+const MaxInlineImageBytes = 20 * 1024 * 1024
+
+// imageDownloadClient is shared by all image downloads so connections are reused.
+var imageDownloadClient = &fasthttp.Client{
+	ReadTimeout:         30 * time.Second,
+	MaxResponseBodySize: MaxInlineImageBytes,
+}
+
+// DownloadImage fetches an image and returns its bytes and MIME type.
+// The MIME type comes from the Content-Type header, falling back to content sniffing.
+func DownloadImage(ctx context.Context, imageURL string) ([]byte, string, error) {
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	req.SetRequestURI(imageURL)
+	req.Header.SetMethod(http.MethodGet)
+
+	if _, bifrostErr := MakeRequestWithContext(ctx, imageDownloadClient, req, resp); bifrostErr != nil {
+		if bifrostErr.Error != nil {
+			return nil, "", fmt.Errorf("failed to download image: %s", bifrostErr.Error.Message)
+		}
+		return nil, "", fmt.Errorf("failed to download image")
+	}
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return nil, "", fmt.Errorf("failed to download image: status=%d", resp.StatusCode())
+	}
+	body, err := CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read image data: %w", err)
+	}
+	// Copy the body to avoid use-after-free
+	data := append([]byte(nil), body...)
+
+	mimeType, _, _ := mime.ParseMediaType(string(resp.Header.ContentType()))
+	if !strings.HasPrefix(mimeType, "image/") {
+		mimeType = http.DetectContentType(data)
+		if !strings.HasPrefix(mimeType, "image/") {
+			return nil, "", fmt.Errorf("downloaded content is not an image: %s", mimeType)
+		}
+	}
+	return data, mimeType, nil
+}
+
+// InlineImageURLs downloads every URL-only image in resp and replaces the URL with base64 data,
+// so callers always receive image bytes regardless of how the provider returned them.
+// Provider URLs are often short-lived, which makes inlining useful for logs and caches too.
+func InlineImageURLs(ctx context.Context, resp *schemas.BifrostImageGenerationResponse) error {
+	if resp == nil {
+		return nil
+	}
+	for i := range resp.Data {
+		image := &resp.Data[i]
+		if image.URL == "" || image.B64JSON != "" {
+			continue
+		}
+		data, mimeType, err := DownloadImage(ctx, image.URL)
+		if err != nil {
+			return fmt.Errorf("image %d: %w", i, err)
+		}
+		image.B64JSON = base64.StdEncoding.EncodeToString(data)
+		image.MimeType = mimeType
+		image.URL = ""
+	}
+	return nil
+}
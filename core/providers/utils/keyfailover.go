@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	// resourceKeyIndexTTL is how long a resource-to-key mapping is trusted after it was last recorded.
+	resourceKeyIndexTTL = 7 * 24 * time.Hour
+	// resourceKeyIndexMaxEntries bounds the index; expired entries are swept once it is exceeded.
+	resourceKeyIndexMaxEntries = 100_000
+)
+
+// resourceKeyRef identifies a provider-side resource (file, batch, container, ...).
+type resourceKeyRef struct {
+	provider   schemas.ModelProvider
+	resourceID string
+}
+
+// resourceKeyEntry records which key owns a resource and when that was last observed.
+type resourceKeyEntry struct {
+	keyID      string
+	recordedAt time.Time
+}
+
+var (
+	resourceKeyIndex      sync.Map // resourceKeyRef -> resourceKeyEntry
+	resourceKeyIndexCount atomic.Int64
+	resourceKeyIndexSweep sync.Mutex
+)
+
+// RecordResourceKey remembers that resourceID on provider was created by (or is reachable with) keyID,
+// so later key-scoped operations on the same resource try that key first.
+func RecordResourceKey(provider schemas.ModelProvider, resourceID string, keyID string) {
+	if resourceID == "" || keyID == "" {
+		return
+	}
+	ref := resourceKeyRef{provider: provider, resourceID: resourceID}
+	if _, loaded := resourceKeyIndex.Swap(ref, resourceKeyEntry{keyID: keyID, recordedAt: time.Now()}); !loaded {
+		if resourceKeyIndexCount.Add(1) > resourceKeyIndexMaxEntries {
+			sweepResourceKeyIndex()
+		}
+	}
+}
+
+// LookupResourceKey returns the key ID recorded for resourceID on provider, if any and not expired.
+func LookupResourceKey(provider schemas.ModelProvider, resourceID string) (string, bool) {
+	if resourceID == "" {
+		return "", false
+	}
+	value, ok := resourceKeyIndex.Load(resourceKeyRef{provider: provider, resourceID: resourceID})
+	if !ok {
+		return "", false
+	}
+	entry := value.(resourceKeyEntry)
+	if time.Since(entry.recordedAt) > resourceKeyIndexTTL {
+		return "", false
+	}
+	return entry.keyID, true
+}
+
+// ForgetResourceKey drops the recorded key for resourceID on provider.
+func ForgetResourceKey(provider schemas.ModelProvider, resourceID string) {
+	if _, loaded := resourceKeyIndex.LoadAndDelete(resourceKeyRef{provider: provider, resourceID: resourceID}); loaded {
+		resourceKeyIndexCount.Add(-1)
+	}
+}
+
+// sweepResourceKeyIndex removes expired entries. If the index is still over capacity
+// afterwards (all entries fresh), it is cleared: the index is only an ordering hint.
+func sweepResourceKeyIndex() {
+	if !resourceKeyIndexSweep.TryLock() {
+		return
+	}
+	defer resourceKeyIndexSweep.Unlock()
+
+	resourceKeyIndex.Range(func(ref, value any) bool {
+		if time.Since(value.(resourceKeyEntry).recordedAt) > resourceKeyIndexTTL {
+			if _, loaded := resourceKeyIndex.LoadAndDelete(ref); loaded {
+				resourceKeyIndexCount.Add(-1)
+			}
+		}
+		return true
+	})
+	if resourceKeyIndexCount.Load() > resourceKeyIndexMaxEntries {
+		resourceKeyIndex.Range(func(ref, _ any) bool {
+			if _, loaded := resourceKeyIndex.LoadAndDelete(ref); loaded {
+				resourceKeyIndexCount.Add(-1)
+			}
+			return true
+		})
+	}
+}
+
+// OrderKeysForResource returns keys with the key recorded for resourceID moved to the front.
+// The relative order of the remaining keys is preserved. The input slice is not modified.
+func OrderKeysForResource(provider schemas.ModelProvider, resourceID string, keys []schemas.Key) []schemas.Key {
+	keyID, ok := LookupResourceKey(provider, resourceID)
+	if !ok || len(keys) < 2 {
+		return keys
+	}
+	for i, key := range keys {
+		if key.ID != keyID {
+			continue
+		}
+		if i == 0 {
+			return keys
+		}
+		ordered := make([]schemas.Key, 0, len(keys))
+		ordered = append(ordered, key)
+		ordered = append(ordered, keys[:i]...)
+		ordered = append(ordered, keys[i+1:]...)
+		return ordered
+	}
+	return keys
+}
+
+// ExecuteWithKeyFailover runs a key-scoped resource operation (retrieve/cancel/delete/content of a
+// file, batch, container, ...) against each key in turn until one succeeds.
+// The key recorded for resourceID is tried first; on success the winning key is recorded so that
+// subsequent operations on the same resource go straight to it. If every key fails, the last
+// error is returned.
+func ExecuteWithKeyFailover[T any](providerName schemas.ModelProvider, resourceID string, keys []schemas.Key, operation func(key schemas.Key) (*T, *schemas.BifrostError)) (*T, *schemas.BifrostError) {
+	if len(keys) == 0 {
+		return nil, NewBifrostOperationError("no keys available for this operation", nil, providerName)
+	}
+
+	var lastErr *schemas.BifrostError
+	for _, key := range OrderKeysForResource(providerName, resourceID, keys) {
+		response, bifrostErr := operation(key)
+		if bifrostErr != nil {
+			lastErr = bifrostErr
+			continue
+		}
+		RecordResourceKey(providerName, resourceID, key.ID)
+		return response, nil
+	}
+	return nil, lastErr
+}
@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func testKeys(ids ...string) []schemas.Key {
+	keys := make([]schemas.Key, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, schemas.Key{ID: id, Name: id})
+	}
+	return keys
+}
+
+// TestOrderKeysForResource_PrefersRecordedKey verifies that the recorded key is moved
+// to the front while the other keys keep their order.
+func TestOrderKeysForResource_PrefersRecordedKey(t *testing.T) {
+	provider := schemas.ModelProvider("test-order")
+	keys := testKeys("a", "b", "c")
+	RecordResourceKey(provider, "file-1", "c")
+	defer ForgetResourceKey(provider, "file-1")
+
+	ordered := OrderKeysForResource(provider, "file-1", keys)
+	got := []string{ordered[0].ID, ordered[1].ID, ordered[2].ID}
+	want := []string{"c", "a", "b"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("ordered keys = %v, want %v", got, want)
+		}
+	}
+	if keys[0].ID != "a" {
+		t.Errorf("input slice was modified: %v", keys)
+	}
+
+	// Unknown resources keep the original order
+	if unknown := OrderKeysForResource(provider, "file-2", keys); unknown[0].ID != "a" {
+		t.Errorf("unknown resource first key = %s, want a", unknown[0].ID)
+	}
+}
+
+// TestExecuteWithKeyFailover_FailsOverAndRecords verifies that keys are tried until one
+// succeeds and that the winning key is tried first on the next call.
+func TestExecuteWithKeyFailover_FailsOverAndRecords(t *testing.T) {
+	provider := schemas.ModelProvider("test-failover")
+	keys := testKeys("a", "b", "c")
+	defer ForgetResourceKey(provider, "batch-1")
+
+	var tried []string
+	operation := func(key schemas.Key) (*string, *schemas.BifrostError) {
+		tried = append(tried, key.ID)
+		if key.ID != "b" {
+			return nil, NewBifrostOperationError("not found", nil, provider)
+		}
+		result := "ok"
+		return &result, nil
+	}
+
+	result, bifrostErr := ExecuteWithKeyFailover(provider, "batch-1", keys, operation)
+	if bifrostErr != nil || result == nil || *result != "ok" {
+		t.Fatalf("ExecuteWithKeyFailover() = %v, %v; want ok, nil", result, bifrostErr)
+	}
+	if len(tried) != 2 || tried[0] != "a" || tried[1] != "b" {
+		t.Fatalf("first call tried %v, want [a b]", tried)
+	}
+
+	tried = nil
+	if _, bifrostErr := ExecuteWithKeyFailover(provider, "batch-1", keys, operation); bifrostErr != nil {
+		t.Fatalf("second call error = %v", bifrostErr)
+	}
+	if len(tried) != 1 || tried[0] != "b" {
+		t.Fatalf("second call tried %v, want [b]", tried)
+	}
+}
+
+// TestExecuteWithKeyFailover_AllKeysFail verifies that the last error is returned when
+// no key succeeds, and that an empty key list is reported as an error.
+func TestExecuteWithKeyFailover_AllKeysFail(t *testing.T) {
+	provider := schemas.ModelProvider("test-all-fail")
+	operation := func(key schemas.Key) (*string, *schemas.BifrostError) {
+		return nil, NewBifrostOperationError("failed with "+key.ID, nil, provider)
+	}
+
+	_, bifrostErr := ExecuteWithKeyFailover(provider, "file-1", testKeys("a", "b"), operation)
+	if bifrostErr == nil || bifrostErr.Error.Message != "failed with b" {
+		t.Fatalf("error = %v, want last key's error", bifrostErr)
+	}
+	if _, ok := LookupResourceKey(provider, "file-1"); ok {
+		t.Error("failed operation should not record a key")
+	}
+
+	if _, bifrostErr := ExecuteWithKeyFailover(provider, "file-1", nil, operation); bifrostErr == nil {
+		t.Error("expected error for empty key list")
+	}
+}
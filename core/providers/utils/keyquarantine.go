@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// KeyQuarantine records why a key was taken out of rotation.
+type KeyQuarantine struct {
+	KeyID         string    `json:"key_id"`
+	StatusCode    int       `json:"status_code"`
+	Reason        string    `json:"reason,omitempty"`
+	QuarantinedAt time.Time `json:"quarantined_at"`
+}
+
+// quarantinedKeys holds the keys a provider rejected as invalid: key ID -> KeyQuarantine. Unlike
+// cooldowns, a quarantine does not expire; the key stays out of rotation until a request or a
+// health check with it succeeds, or it is released manually.
+var quarantinedKeys sync.Map
+
+// IsInvalidKeyStatus reports whether a provider status code means the key itself was rejected
+// (401 Unauthorized or 403 Forbidden).
+func IsInvalidKeyStatus(statusCode int) bool {
+	return statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden
+}
+
+// QuarantineKey takes keyID out of rotation after the provider rejected it with statusCode.
+// It returns true when the key was not quarantined before.
+func QuarantineKey(keyID string, statusCode int, reason string) bool {
+	if keyID == "" {
+		return false
+	}
+	_, loaded := quarantinedKeys.LoadOrStore(keyID, KeyQuarantine{
+		KeyID:         keyID,
+		StatusCode:    statusCode,
+		Reason:        reason,
+		QuarantinedAt: time.Now(),
+	})
+	return !loaded
+}
+
+// GetKeyQuarantine returns the quarantine of keyID, if it is quarantined.
+func GetKeyQuarantine(keyID string) (KeyQuarantine, bool) {
+	value, ok := quarantinedKeys.Load(keyID)
+	if !ok {
+		return KeyQuarantine{}, false
+	}
+	return value.(KeyQuarantine), true
+}
+
+// ReleaseKey puts a quarantined key back into rotation. It returns true when the key was quarantined.
+func ReleaseKey(keyID string) bool {
+	_, loaded := quarantinedKeys.LoadAndDelete(keyID)
+	return loaded
+}
+
+// QuarantinedKeys returns every quarantined key, ordered by key ID.
+func QuarantinedKeys() []KeyQuarantine {
+	var quarantines []KeyQuarantine
+	quarantinedKeys.Range(func(_, value any) bool {
+		quarantines = append(quarantines, value.(KeyQuarantine))
+		return true
+	})
+	sort.Slice(quarantines, func(i, j int) bool { return quarantines[i].KeyID < quarantines[j].KeyID })
+	return quarantines
+}
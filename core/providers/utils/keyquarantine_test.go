@@ -0,0 +1,47 @@
+package utils
+
+import (
+	"net/http"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestKeyQuarantine(t *testing.T) {
+	keys := []schemas.Key{{ID: "quarantine-test-a"}, {ID: "quarantine-test-b"}}
+	for _, key := range keys {
+		defer ReleaseKey(key.ID)
+	}
+
+	if !IsInvalidKeyStatus(http.StatusUnauthorized) || !IsInvalidKeyStatus(http.StatusForbidden) || IsInvalidKeyStatus(http.StatusTooManyRequests) {
+		t.Error("only 401 and 403 should mark a key invalid")
+	}
+	if !QuarantineKey("quarantine-test-a", http.StatusUnauthorized, "invalid api key") {
+		t.Fatal("expected the key to be newly quarantined")
+	}
+	if QuarantineKey("quarantine-test-a", http.StatusForbidden, "forbidden") {
+		t.Error("quarantining a quarantined key should not replace its quarantine")
+	}
+	if quarantine, ok := GetKeyQuarantine("quarantine-test-a"); !ok || quarantine.StatusCode != http.StatusUnauthorized || quarantine.Reason != "invalid api key" {
+		t.Errorf("GetKeyQuarantine() = %+v, %v", quarantine, ok)
+	}
+	if got := AvailableKeys(keys); len(got) != 1 || got[0].ID != "quarantine-test-b" {
+		t.Errorf("AvailableKeys() = %+v, want the quarantined key skipped", got)
+	}
+
+	// When every key is quarantined they are all kept, so the provider error reaches the caller
+	QuarantineKey("quarantine-test-b", http.StatusForbidden, "")
+	if got := AvailableKeys(keys); len(got) != 2 {
+		t.Errorf("AvailableKeys() = %+v, want all keys", got)
+	}
+	if got := QuarantinedKeys(); len(got) < 2 {
+		t.Errorf("QuarantinedKeys() = %+v", got)
+	}
+
+	if !ReleaseKey("quarantine-test-a") || ReleaseKey("quarantine-test-a") {
+		t.Error("ReleaseKey should only report a release once")
+	}
+	if got := AvailableKeys(keys); len(got) != 1 || got[0].ID != "quarantine-test-a" {
+		t.Errorf("AvailableKeys() = %+v, want the released key", got)
+	}
+}
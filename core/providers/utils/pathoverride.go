@@ -0,0 +1,86 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// defaultPathOverridePatterns lists, per provider, the upstream paths a per-request URL path override
+// (schemas.BifrostContextKeyURLPath) may target. Patterns use path.Match syntax and are matched against
+// the decoded path without its query. Providers without an entry accept no overrides unless
+// NetworkConfig.AllowedPathOverrides allows them.
+var defaultPathOverridePatterns = map[schemas.ModelProvider][]string{
+	// Anthropic passthrough (e.g. Claude Code with OAuth) forwards the client's exact path
+	schemas.Anthropic: {
+		"/v1/messages",
+		"/v1/messages/*",
+		"/v1/messages/batches/*",
+		"/v1/messages/batches/*/results",
+		"/v1/models",
+		"/v1/models/*",
+		"/v1/complete",
+	},
+}
+
+// DefaultPathOverridePatterns returns the built-in URL path override patterns for a provider.
+func DefaultPathOverridePatterns(provider schemas.ModelProvider) []string {
+	return defaultPathOverridePatterns[provider]
+}
+
+// ValidatePathOverride checks that a per-request URL path override is a plain absolute path, optionally
+// with a query string. Full URLs, protocol-relative URLs, fragments, backslashes, control characters and
+// dot segments are rejected so an override can't leave the provider's base URL.
+func ValidatePathOverride(override string) error {
+	if override == "" {
+		return fmt.Errorf("path override is empty")
+	}
+	for _, r := range override {
+		if r <= ' ' || r == 0x7f {
+			return fmt.Errorf("path override contains whitespace or control characters")
+		}
+	}
+	if !strings.HasPrefix(override, "/") || strings.HasPrefix(override, "//") {
+		return fmt.Errorf("path override must be a path starting with a single /")
+	}
+	if strings.Contains(override, "\\") {
+		return fmt.Errorf("path override must not contain backslashes")
+	}
+	u, err := url.Parse(override)
+	if err != nil {
+		return fmt.Errorf("invalid path override: %w", err)
+	}
+	if u.Scheme != "" || u.Host != "" || u.User != nil {
+		return fmt.Errorf("path override must not contain a scheme or host")
+	}
+	if u.Fragment != "" || strings.Contains(override, "#") {
+		return fmt.Errorf("path override must not contain a fragment")
+	}
+	// u.Path is decoded, so encoded dot segments (%2e%2e) and separators (%2f) are caught too
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment == "." || segment == ".." {
+			return fmt.Errorf("path override must not contain dot segments")
+		}
+	}
+	return nil
+}
+
+// CheckPathOverride validates a per-request URL path override and checks it against the provider's
+// default patterns and the extra patterns from its network config.
+func CheckPathOverride(provider schemas.ModelProvider, override string, extraPatterns []string) error {
+	if err := ValidatePathOverride(override); err != nil {
+		return err
+	}
+	u, _ := url.Parse(override) // already parsed successfully by ValidatePathOverride
+	for _, patterns := range [][]string{defaultPathOverridePatterns[provider], extraPatterns} {
+		for _, pattern := range patterns {
+			if matched, err := path.Match(pattern, u.Path); err == nil && matched {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("path override %s is not in the allowlist of provider %s", u.Path, provider)
+}
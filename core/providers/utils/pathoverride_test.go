@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestValidatePathOverride(t *testing.T) {
+	tests := []struct {
+		name     string
+		override string
+		wantErr  bool
+	}{
+		{name: "plain path", override: "/v1/messages"},
+		{name: "path with query", override: "/v1/messages?beta=true"},
+		{name: "empty", override: "", wantErr: true},
+		{name: "relative path", override: "v1/messages", wantErr: true},
+		{name: "absolute url", override: "https://evil.example/v1/messages", wantErr: true},
+		{name: "protocol relative url", override: "//evil.example/v1/messages", wantErr: true},
+		{name: "dot segments", override: "/v1/../admin", wantErr: true},
+		{name: "encoded dot segments", override: "/v1/%2e%2e/admin", wantErr: true},
+		{name: "encoded separator with dot segment", override: "/v1/..%2fadmin", wantErr: true},
+		{name: "backslash", override: "/v1\\..\\admin", wantErr: true},
+		{name: "fragment", override: "/v1/messages#x", wantErr: true},
+		{name: "whitespace", override: "/v1/messages /admin", wantErr: true},
+		{name: "control character", override: "/v1/messages\r\nHost: evil", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidatePathOverride(tt.override); (err != nil) != tt.wantErr {
+				t.Errorf("ValidatePathOverride(%q) error = %v, wantErr %v", tt.override, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckPathOverride(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider schemas.ModelProvider
+		override string
+		extra    []string
+		wantErr  bool
+	}{
+		{name: "anthropic messages", provider: schemas.Anthropic, override: "/v1/messages?beta=true"},
+		{name: "anthropic count tokens", provider: schemas.Anthropic, override: "/v1/messages/count_tokens"},
+		{name: "anthropic batch results", provider: schemas.Anthropic, override: "/v1/messages/batches/msgbatch_1/results"},
+		{name: "anthropic path outside allowlist", provider: schemas.Anthropic, override: "/v1/organizations/users", wantErr: true},
+		{name: "wildcard does not cross segments", provider: schemas.Anthropic, override: "/v1/models/a/b", wantErr: true},
+		{name: "provider without defaults", provider: schemas.OpenAI, override: "/v1/chat/completions", wantErr: true},
+		{name: "extra pattern", provider: schemas.OpenAI, override: "/v1/chat/completions", extra: []string{"/v1/chat/*"}},
+		{name: "extra pattern still validated", provider: schemas.OpenAI, override: "https://evil.example/v1/chat/completions", extra: []string{"*"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := CheckPathOverride(tt.provider, tt.override, tt.extra); (err != nil) != tt.wantErr {
+				t.Errorf("CheckPathOverride(%s, %q) error = %v, wantErr %v", tt.provider, tt.override, err, tt.wantErr)
+			}
+		})
+	}
+}
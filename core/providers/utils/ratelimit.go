@@ -0,0 +1,218 @@
+package utils
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	// DefaultKeyCooldown is how long a key that hit a rate limit is avoided when the provider did
+	// not say when the limit resets.
+	DefaultKeyCooldown = 10 * time.Second
+	// MaxKeyCooldown caps the cooldown taken from provider headers, so a bogus reset time cannot
+	// take a key out of rotation for long.
+	MaxKeyCooldown = 5 * time.Minute
+)
+
+// RateLimitInfo is the rate limit state a provider reported in its response headers.
+type RateLimitInfo struct {
+	RetryAfter        time.Duration // Retry-After (or retry-after-ms); 0 when absent
+	RemainingRequests *int          // x-ratelimit-remaining-requests
+	RemainingTokens   *int          // x-ratelimit-remaining-tokens
+	ResetRequests     time.Duration // x-ratelimit-reset-requests: time until the request limit resets
+	ResetTokens       time.Duration // x-ratelimit-reset-tokens: time until the token limit resets
+}
+
+// ParseRateLimitHeaders reads the Retry-After and x-ratelimit-* headers of a provider response.
+// Header names are matched case-insensitively. Reset values may be durations ("6m0s", "20ms"),
+// seconds, unix timestamps or RFC 3339 times; Retry-After may also be an HTTP date.
+func ParseRateLimitHeaders(headers map[string]string, now time.Time) RateLimitInfo {
+	var info RateLimitInfo
+	if len(headers) == 0 {
+		return info
+	}
+	lower := make(map[string]string, len(headers))
+	for k, v := range headers {
+		lower[strings.ToLower(k)] = strings.TrimSpace(v)
+	}
+
+	if ms, err := strconv.ParseFloat(lower["retry-after-ms"], 64); err == nil && ms > 0 {
+		info.RetryAfter = time.Duration(ms * float64(time.Millisecond))
+	} else if value := lower["retry-after"]; value != "" {
+		if date, err := http.ParseTime(value); err == nil {
+			info.RetryAfter = max(date.Sub(now), 0)
+		} else {
+			info.RetryAfter = parseResetValue(value, now)
+		}
+	}
+	info.RemainingRequests = parseRemaining(lower["x-ratelimit-remaining-requests"])
+	info.RemainingTokens = parseRemaining(lower["x-ratelimit-remaining-tokens"])
+	info.ResetRequests = parseResetValue(lower["x-ratelimit-reset-requests"], now)
+	info.ResetTokens = parseResetValue(lower["x-ratelimit-reset-tokens"], now)
+	// Providers with a single limit report it without the -requests/-tokens suffix
+	if info.RemainingRequests == nil {
+		info.RemainingRequests = parseRemaining(lower["x-ratelimit-remaining"])
+	}
+	info.ResetRequests = max(info.ResetRequests, parseResetValue(lower["x-ratelimit-reset"], now))
+	return info
+}
+
+// Exhausted reports whether a request or token limit has no budget left.
+func (info RateLimitInfo) Exhausted() bool {
+	return (info.RemainingRequests != nil && *info.RemainingRequests <= 0) ||
+		(info.RemainingTokens != nil && *info.RemainingTokens <= 0)
+}
+
+// Cooldown returns how long to avoid a key after a response with this rate limit state. A rate
+// limited response (429) waits for Retry-After, else for the exhausted limit (or any limit) to
+// reset, else DefaultKeyCooldown. Other responses only cool the key down when a limit is exhausted.
+func (info RateLimitInfo) Cooldown(rateLimited bool) time.Duration {
+	var cooldown time.Duration
+	switch {
+	case info.RetryAfter > 0:
+		cooldown = info.RetryAfter
+	case info.Exhausted():
+		if info.RemainingRequests != nil && *info.RemainingRequests <= 0 {
+			cooldown = info.ResetRequests
+		}
+		if info.RemainingTokens != nil && *info.RemainingTokens <= 0 {
+			cooldown = max(cooldown, info.ResetTokens)
+		}
+		if cooldown == 0 {
+			cooldown = DefaultKeyCooldown
+		}
+	case rateLimited:
+		cooldown = max(info.ResetRequests, info.ResetTokens)
+		if cooldown == 0 {
+			cooldown = DefaultKeyCooldown
+		}
+	}
+	return min(cooldown, MaxKeyCooldown)
+}
+
+func parseRemaining(value string) *int {
+	if value == "" {
+		return nil
+	}
+	remaining, err := strconv.Atoi(value)
+	if err != nil {
+		return nil
+	}
+	return &remaining
+}
+
+// parseResetValue converts a reset header value to the time left until the reset.
+func parseResetValue(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		if seconds <= 0 || math.IsNaN(seconds) || math.IsInf(seconds, 0) {
+			return 0
+		}
+		// Values this large are unix timestamps rather than a number of seconds
+		if seconds > 1e9 {
+			return max(time.Unix(int64(seconds), 0).Sub(now), 0)
+		}
+		return time.Duration(seconds * float64(time.Second))
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		return max(duration, 0)
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return max(t.Sub(now), 0)
+	}
+	return 0
+}
+
+// keyCooldowns is the shared key-state tracker: key ID -> time.Time until which the key is
+// avoided. Expired entries are dropped when they are looked up.
+var keyCooldowns sync.Map
+
+// RecordKeyRateLimit updates the state of keyID from a provider response: a rate limited (429)
+// response or exhausted rate limit headers cool the key down so traffic shifts to other keys.
+// It returns the cooldown applied, 0 when the key stays available.
+func RecordKeyRateLimit(keyID string, statusCode int, headers map[string]string) time.Duration {
+	if keyID == "" {
+		return 0
+	}
+	now := time.Now()
+	cooldown := ParseRateLimitHeaders(headers, now).Cooldown(statusCode == http.StatusTooManyRequests)
+	if cooldown <= 0 {
+		return 0
+	}
+	until := now.Add(cooldown)
+	// Keep the later deadline when concurrent responses report different resets
+	if existing, ok := keyCooldowns.Load(keyID); ok && existing.(time.Time).After(until) {
+		return existing.(time.Time).Sub(now)
+	}
+	keyCooldowns.Store(keyID, until)
+	return cooldown
+}
+
+// KeyCooldownRemaining returns how long keyID is still cooling down, if it is.
+func KeyCooldownRemaining(keyID string) (time.Duration, bool) {
+	value, ok := keyCooldowns.Load(keyID)
+	if !ok {
+		return 0, false
+	}
+	remaining := time.Until(value.(time.Time))
+	if remaining <= 0 {
+		keyCooldowns.CompareAndDelete(keyID, value)
+		return 0, false
+	}
+	return remaining, true
+}
+
+// ClearKeyCooldown makes keyID available again.
+func ClearKeyCooldown(keyID string) {
+	keyCooldowns.Delete(keyID)
+}
+
+// AvailableKeys returns the keys that are neither quarantined nor cooling down, in their original
+// order. Quarantined keys are only kept when every key is quarantined, so the provider's error still
+// reaches the caller (and a key that works again is released). When every remaining key is cooling
+// down, the one that becomes available first is returned so requests still go out.
+// The input slice is not modified.
+func AvailableKeys(keys []schemas.Key) []schemas.Key {
+	keys = unquarantinedKeys(keys)
+	var available []schemas.Key
+	soonest, soonestRemaining := -1, time.Duration(0)
+	for i, key := range keys {
+		remaining, coolingDown := KeyCooldownRemaining(key.ID)
+		if !coolingDown {
+			available = append(available, key)
+			continue
+		}
+		if soonest < 0 || remaining < soonestRemaining {
+			soonest, soonestRemaining = i, remaining
+		}
+	}
+	if len(available) == len(keys) {
+		return keys
+	}
+	if len(available) == 0 {
+		return []schemas.Key{keys[soonest]}
+	}
+	return available
+}
+
+// unquarantinedKeys returns the keys that are not quarantined, or keys itself when none or all are.
+func unquarantinedKeys(keys []schemas.Key) []schemas.Key {
+	var unquarantined []schemas.Key
+	for _, key := range keys {
+		if _, quarantined := GetKeyQuarantine(key.ID); !quarantined {
+			unquarantined = append(unquarantined, key)
+		}
+	}
+	if len(unquarantined) == 0 || len(unquarantined) == len(keys) {
+		return keys
+	}
+	return unquarantined
+}
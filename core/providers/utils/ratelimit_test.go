@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	info := ParseRateLimitHeaders(map[string]string{
+		"Retry-After":                    "7",
+		"X-Ratelimit-Remaining-Requests": "0",
+		"X-Ratelimit-Remaining-Tokens":   "1500",
+		"X-Ratelimit-Reset-Requests":     "1m30s",
+		"X-Ratelimit-Reset-Tokens":       "250ms",
+	}, now)
+	if info.RetryAfter != 7*time.Second || info.ResetRequests != 90*time.Second || info.ResetTokens != 250*time.Millisecond {
+		t.Errorf("durations = %v, %v, %v", info.RetryAfter, info.ResetRequests, info.ResetTokens)
+	}
+	if info.RemainingRequests == nil || *info.RemainingRequests != 0 || info.RemainingTokens == nil || *info.RemainingTokens != 1500 || !info.Exhausted() {
+		t.Errorf("remaining = %v, %v", info.RemainingRequests, info.RemainingTokens)
+	}
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    time.Duration
+	}{
+		{"retry-after-ms", map[string]string{"retry-after-ms": "1500", "retry-after": "9"}, 1500 * time.Millisecond},
+		{"http date", map[string]string{"Retry-After": now.Add(20 * time.Second).Format(http.TimeFormat)}, 20 * time.Second},
+		{"unix reset", map[string]string{"x-ratelimit-remaining": "0", "x-ratelimit-reset": "1735732860"}, time.Minute},
+		{"rfc3339 reset", map[string]string{"x-ratelimit-remaining-requests": "0", "x-ratelimit-reset-requests": "2025-01-01T12:00:05Z"}, 5 * time.Second},
+	}
+	for _, tt := range tests {
+		info := ParseRateLimitHeaders(tt.headers, now)
+		if got := max(info.RetryAfter, info.ResetRequests); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRateLimitInfoCooldown(t *testing.T) {
+	zero, some := 0, 10
+	tests := []struct {
+		name        string
+		info        RateLimitInfo
+		rateLimited bool
+		want        time.Duration
+	}{
+		{"ok response", RateLimitInfo{RemainingRequests: &some, ResetRequests: time.Second}, false, 0},
+		{"exhausted tokens", RateLimitInfo{RemainingRequests: &some, RemainingTokens: &zero, ResetRequests: time.Second, ResetTokens: 3 * time.Second}, false, 3 * time.Second},
+		{"429 with retry-after", RateLimitInfo{RetryAfter: 2 * time.Second}, true, 2 * time.Second},
+		{"429 with reset", RateLimitInfo{ResetTokens: 4 * time.Second}, true, 4 * time.Second},
+		{"429 without hints", RateLimitInfo{}, true, DefaultKeyCooldown},
+		{"capped", RateLimitInfo{RetryAfter: time.Hour}, true, MaxKeyCooldown},
+	}
+	for _, tt := range tests {
+		if got := tt.info.Cooldown(tt.rateLimited); got != tt.want {
+			t.Errorf("%s: Cooldown() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestAvailableKeys(t *testing.T) {
+	keys := []schemas.Key{{ID: "ratelimit-test-a"}, {ID: "ratelimit-test-b"}, {ID: "ratelimit-test-c"}}
+	for _, key := range keys {
+		defer ClearKeyCooldown(key.ID)
+	}
+
+	if got := AvailableKeys(keys); len(got) != 3 {
+		t.Fatalf("expected all keys to be available, got %+v", got)
+	}
+	if cooldown := RecordKeyRateLimit("ratelimit-test-b", http.StatusTooManyRequests, map[string]string{"Retry-After": "30"}); cooldown != 30*time.Second {
+		t.Errorf("cooldown = %v", cooldown)
+	}
+	if cooldown := RecordKeyRateLimit("ratelimit-test-c", http.StatusOK, map[string]string{"x-ratelimit-remaining-requests": "5"}); cooldown != 0 {
+		t.Errorf("expected no cooldown for a key with budget left, got %v", cooldown)
+	}
+	if got := AvailableKeys(keys); len(got) != 2 || got[0].ID != "ratelimit-test-a" || got[1].ID != "ratelimit-test-c" {
+		t.Errorf("AvailableKeys() = %+v", got)
+	}
+
+	// When every key is cooling down, the one that frees up first is still used
+	RecordKeyRateLimit("ratelimit-test-a", http.StatusTooManyRequests, map[string]string{"Retry-After": "60"})
+	RecordKeyRateLimit("ratelimit-test-c", http.StatusTooManyRequests, map[string]string{"Retry-After": "45"})
+	if got := AvailableKeys(keys); len(got) != 1 || got[0].ID != "ratelimit-test-b" {
+		t.Errorf("AvailableKeys() = %+v, want only the key with the shortest cooldown", got)
+	}
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"context"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// RealtimeRewriter rewrites a realtime message before it is forwarded. Returning nil drops the message.
+type RealtimeRewriter func(messageType int, data []byte) []byte
+
+// RelayRealtime relays messages between a realtime client and the provider until either side closes
+// its connection or ctx is done. toProvider and toClient, if set, see every message in order and may
+// rewrite it. Both connections are closed and both directions have stopped when it returns; the
+// returned error is the one that ended the session.
+func RelayRealtime(ctx context.Context, client, provider schemas.RealtimeConn, toProvider, toClient RealtimeRewriter) error {
+	errs := make(chan error, 2)
+	relay := func(from, to schemas.RealtimeConn, rewrite RealtimeRewriter) {
+		for {
+			messageType, data, err := from.ReadMessage()
+			if err != nil {
+				errs <- err
+				return
+			}
+			if rewrite != nil {
+				if data = rewrite(messageType, data); data == nil {
+					continue
+				}
+			}
+			if err := to.WriteMessage(messageType, data); err != nil {
+				errs <- err
+				return
+			}
+		}
+	}
+	go relay(client, provider, toProvider)
+	go relay(provider, client, toClient)
+
+	var err error
+	pending := 2
+	select {
+	case err = <-errs:
+		pending--
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	// Closing both connections unblocks the reads of the direction still running
+	client.Close()
+	provider.Close()
+	for ; pending > 0; pending-- {
+		<-errs
+	}
+	return err
+}
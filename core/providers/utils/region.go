@@ -0,0 +1,168 @@
+package utils
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+const (
+	// regionProbeTimeout bounds each endpoint probe made while detecting the region of a key.
+	regionProbeTimeout = 5 * time.Second
+	// regionProbeRetryInterval is how long an inconclusive detection (every endpoint rejected the key or
+	// was unreachable) is cached before the key is probed again.
+	regionProbeRetryInterval = 5 * time.Minute
+)
+
+// RegionalBaseURL is a base URL a provider serves from in an endpoint region.
+type RegionalBaseURL struct {
+	Region  schemas.EndpointRegion
+	BaseURL string
+}
+
+// RegionalBaseURLResolver picks the base URL of a provider with separate international and mainland China
+// endpoints. An explicit NetworkConfig.BaseURL always wins. Otherwise NetworkConfig.EndpointRegion selects
+// the region, and in auto mode each key is probed against the candidate endpoints, in order, until one
+// accepts it. Keys are told apart by a hash of their value, so rotating a key triggers a new probe.
+type RegionalBaseURLResolver struct {
+	provider   schemas.ModelProvider
+	client     *fasthttp.Client
+	logger     schemas.Logger
+	candidates []RegionalBaseURL
+	probePath  string
+	fixed      string   // base URL used for every key; empty in auto mode
+	detected   sync.Map // key value hash -> regionProbeResult
+}
+
+// regionProbeResult is the detected base URL of a key. expiresAt is zero when the detection was conclusive.
+type regionProbeResult struct {
+	baseURL   string
+	expiresAt time.Time
+}
+
+// NewRegionalBaseURLResolver creates a resolver for a provider's candidate endpoints. The first candidate
+// is the provider's default and the first candidate of each region is that region's base URL. probePath
+// is appended to a candidate's base URL to check a key, and should be a cheap authenticated GET such as
+// the provider's list models endpoint.
+func NewRegionalBaseURLResolver(provider schemas.ModelProvider, client *fasthttp.Client, networkConfig schemas.NetworkConfig, candidates []RegionalBaseURL, probePath string, logger schemas.Logger) (*RegionalBaseURLResolver, error) {
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("provider %s has no regional endpoints", provider)
+	}
+	resolver := &RegionalBaseURLResolver{
+		provider:   provider,
+		client:     client,
+		logger:     logger,
+		candidates: candidates,
+		probePath:  probePath,
+	}
+	if networkConfig.BaseURL != "" {
+		resolver.fixed = strings.TrimRight(networkConfig.BaseURL, "/")
+		return resolver, nil
+	}
+	switch networkConfig.EndpointRegion {
+	case "", schemas.EndpointRegionAuto:
+		return resolver, nil
+	case schemas.EndpointRegionInternational, schemas.EndpointRegionChina:
+		for _, candidate := range candidates {
+			if candidate.Region == networkConfig.EndpointRegion {
+				resolver.fixed = candidate.BaseURL
+				return resolver, nil
+			}
+		}
+		return nil, fmt.Errorf("provider %s has no %s endpoint", provider, networkConfig.EndpointRegion)
+	default:
+		return nil, fmt.Errorf("unsupported endpoint region %q, expected %q, %q or %q", networkConfig.EndpointRegion, schemas.EndpointRegionAuto, schemas.EndpointRegionInternational, schemas.EndpointRegionChina)
+	}
+}
+
+// DefaultBaseURL returns the base URL used when no key is available, e.g. for connection health checks.
+func (r *RegionalBaseURLResolver) DefaultBaseURL() string {
+	if r.fixed != "" {
+		return r.fixed
+	}
+	return r.candidates[0].BaseURL
+}
+
+// BaseURL returns the base URL to send a key's requests to. In auto mode the first request of each key
+// probes the candidate endpoints; the result is cached for the lifetime of the provider.
+func (r *RegionalBaseURLResolver) BaseURL(ctx context.Context, key schemas.Key) string {
+	if r.fixed != "" {
+		return r.fixed
+	}
+	value := key.Value.GetValue()
+	if value == "" {
+		return r.DefaultBaseURL()
+	}
+	sum := sha256.Sum256([]byte(value))
+	hash := hex.EncodeToString(sum[:])
+	if cached, ok := r.detected.Load(hash); ok {
+		result := cached.(regionProbeResult)
+		if result.expiresAt.IsZero() || time.Now().Before(result.expiresAt) {
+			return result.baseURL
+		}
+	}
+
+	for _, candidate := range r.candidates {
+		accepted, err := r.probe(ctx, candidate.BaseURL, value)
+		if err != nil {
+			r.logger.Debug(fmt.Sprintf("%s endpoint region probe of %s failed: %v", r.provider, candidate.BaseURL, err))
+			continue
+		}
+		if accepted {
+			r.logger.Info(fmt.Sprintf("%s key %s belongs to the %s endpoint %s", r.provider, keyLabel(key), candidate.Region, candidate.BaseURL))
+			r.detected.Store(hash, regionProbeResult{baseURL: candidate.BaseURL})
+			return candidate.BaseURL
+		}
+	}
+
+	r.logger.Warn(fmt.Sprintf("could not detect the endpoint region of %s key %s, using %s; set endpoint_region or base_url in the provider's network config to skip detection", r.provider, keyLabel(key), r.DefaultBaseURL()))
+	r.detected.Store(hash, regionProbeResult{baseURL: r.DefaultBaseURL(), expiresAt: time.Now().Add(regionProbeRetryInterval)})
+	return r.DefaultBaseURL()
+}
+
+// probe reports whether the endpoint at baseURL accepts the key. Any response other than 401 or 403
+// counts as accepted, since only authentication failures tell the key belongs to another region.
+func (r *RegionalBaseURLResolver) probe(ctx context.Context, baseURL, keyValue string) (bool, error) {
+	timeout := regionProbeTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline); remaining < timeout {
+			timeout = remaining
+		}
+	}
+	if timeout <= 0 {
+		return false, context.DeadlineExceeded
+	}
+
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	req.SetRequestURI(baseURL + r.probePath)
+	req.Header.SetMethod(http.MethodGet)
+	req.Header.Set("Authorization", "Bearer "+keyValue)
+	if err := r.client.DoTimeout(req, resp, timeout); err != nil {
+		return false, err
+	}
+	status := resp.StatusCode()
+	return status != fasthttp.StatusUnauthorized && status != fasthttp.StatusForbidden, nil
+}
+
+// keyLabel identifies a key in logs without revealing its value.
+func keyLabel(key schemas.Key) string {
+	if key.Name != "" {
+		return key.Name
+	}
+	if key.ID != "" {
+		return key.ID
+	}
+	return "(unnamed)"
+}
@@ -0,0 +1,127 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+type regionTestLogger struct{}
+
+func (l *regionTestLogger) Debug(msg string, args ...any)                     {}
+func (l *regionTestLogger) Info(msg string, args ...any)                      {}
+func (l *regionTestLogger) Warn(msg string, args ...any)                      {}
+func (l *regionTestLogger) Error(msg string, args ...any)                     {}
+func (l *regionTestLogger) Fatal(msg string, args ...any)                     {}
+func (l *regionTestLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *regionTestLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *regionTestLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+// newRegionTestServer serves a models endpoint that only accepts the given key and counts the probes it receives.
+func newRegionTestServer(t *testing.T, acceptedKey string, probes *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		probes.Add(1)
+		if r.URL.Path != "/v1/models" || r.Header.Get("Authorization") != "Bearer "+acceptedKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRegionalBaseURLResolver_Auto(t *testing.T) {
+	var internationalProbes, chinaProbes atomic.Int32
+	international := newRegionTestServer(t, "intl-key", &internationalProbes)
+	china := newRegionTestServer(t, "cn-key", &chinaProbes)
+
+	resolver, err := NewRegionalBaseURLResolver(schemas.Moonshot, &fasthttp.Client{}, schemas.NetworkConfig{}, []RegionalBaseURL{
+		{Region: schemas.EndpointRegionInternational, BaseURL: international.URL},
+		{Region: schemas.EndpointRegionChina, BaseURL: china.URL},
+	}, "/v1/models", &regionTestLogger{})
+	if err != nil {
+		t.Fatalf("NewRegionalBaseURLResolver() error = %v", err)
+	}
+
+	ctx := context.Background()
+	chinaKey := schemas.Key{Value: schemas.EnvVar{Val: "cn-key"}}
+	for i := 0; i < 2; i++ {
+		if got := resolver.BaseURL(ctx, chinaKey); got != china.URL {
+			t.Errorf("BaseURL(china key) = %s, want %s", got, china.URL)
+		}
+	}
+	if internationalProbes.Load() != 1 || chinaProbes.Load() != 1 {
+		t.Errorf("probes = %d international, %d china; want the detected region to be cached", internationalProbes.Load(), chinaProbes.Load())
+	}
+
+	if got := resolver.BaseURL(ctx, schemas.Key{Value: schemas.EnvVar{Val: "intl-key"}}); got != international.URL {
+		t.Errorf("BaseURL(international key) = %s, want %s", got, international.URL)
+	}
+	if got := resolver.BaseURL(ctx, schemas.Key{}); got != international.URL {
+		t.Errorf("BaseURL(no key) = %s, want the default %s", got, international.URL)
+	}
+
+	// A key rejected everywhere falls back to the default and is re-probed only after the retry interval
+	unknownKey := schemas.Key{Value: schemas.EnvVar{Val: "unknown-key"}}
+	before := chinaProbes.Load()
+	resolver.BaseURL(ctx, unknownKey)
+	if got := resolver.BaseURL(ctx, unknownKey); got != international.URL {
+		t.Errorf("BaseURL(unknown key) = %s, want the default %s", got, international.URL)
+	}
+	if chinaProbes.Load() != before+1 {
+		t.Errorf("unknown key probed %d times, want 1 within the retry interval", chinaProbes.Load()-before)
+	}
+}
+
+func TestRegionalBaseURLResolver_Fixed(t *testing.T) {
+	candidates := []RegionalBaseURL{
+		{Region: schemas.EndpointRegionInternational, BaseURL: "https://intl.example"},
+		{Region: schemas.EndpointRegionChina, BaseURL: "https://cn.example"},
+	}
+	tests := []struct {
+		name          string
+		networkConfig schemas.NetworkConfig
+		want          string
+		wantErr       bool
+	}{
+		{name: "china region", networkConfig: schemas.NetworkConfig{EndpointRegion: schemas.EndpointRegionChina}, want: "https://cn.example"},
+		{name: "international region", networkConfig: schemas.NetworkConfig{EndpointRegion: schemas.EndpointRegionInternational}, want: "https://intl.example"},
+		{name: "base url wins", networkConfig: schemas.NetworkConfig{BaseURL: "https://proxy.example/", EndpointRegion: schemas.EndpointRegionChina}, want: "https://proxy.example"},
+		{name: "unknown region", networkConfig: schemas.NetworkConfig{EndpointRegion: "mars"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolver, err := NewRegionalBaseURLResolver(schemas.Moonshot, &fasthttp.Client{ReadTimeout: time.Millisecond}, tt.networkConfig, candidates, "/v1/models", &regionTestLogger{})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewRegionalBaseURLResolver() error = %v", err)
+			}
+			// A fixed base URL never probes, so the unreachable candidates don't matter
+			if got := resolver.BaseURL(context.Background(), schemas.Key{Value: schemas.EnvVar{Val: "key"}}); got != tt.want {
+				t.Errorf("BaseURL() = %s, want %s", got, tt.want)
+			}
+			if got := resolver.DefaultBaseURL(); got != tt.want {
+				t.Errorf("DefaultBaseURL() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := NewRegionalBaseURLResolver(schemas.Moonshot, &fasthttp.Client{}, schemas.NetworkConfig{EndpointRegion: schemas.EndpointRegionChina}, candidates[:1], "/v1/models", &regionTestLogger{}); err == nil {
+		t.Error("expected error for a region the provider has no endpoint in")
+	}
+}
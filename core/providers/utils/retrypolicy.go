@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// nonIdempotentRequestTypes are the request types whose POST calls create something upstream, so
+// sending them twice could create it twice.
+var nonIdempotentRequestTypes = map[schemas.RequestType]bool{
+	schemas.VideoGenerationRequest:       true,
+	schemas.VideoRemixRequest:            true,
+	schemas.BatchCreateRequest:           true,
+	schemas.BatchCancelRequest:           true,
+	schemas.FileUploadRequest:            true,
+	schemas.ContainerCreateRequest:       true,
+	schemas.ContainerFileCreateRequest:   true,
+	schemas.ContextCacheCreateRequest:    true,
+	schemas.FineTuningJobCreateRequest:   true,
+	schemas.FineTuningJobCancelRequest:   true,
+	schemas.VectorStoreCreateRequest:     true,
+	schemas.VectorStoreFileCreateRequest: true,
+	schemas.MCPToolExecutionRequest:      true,
+}
+
+// isRetrySafe reports whether a request can be sent again after a failed attempt. Streaming
+// responses and streamed request bodies cannot be replayed. Idempotent methods and requests
+// carrying an Idempotency-Key header are safe; a POST is only safe for requests that do not
+// create anything upstream, such as chat completions or embeddings.
+func isRetrySafe(ctx context.Context, req *fasthttp.Request, resp *fasthttp.Response) bool {
+	if resp.StreamBody || req.IsBodyStream() {
+		return false
+	}
+	switch string(req.Header.Method()) {
+	case fasthttp.MethodGet, fasthttp.MethodHead, fasthttp.MethodOptions, fasthttp.MethodPut, fasthttp.MethodDelete:
+		return true
+	}
+	if len(req.Header.Peek("Idempotency-Key")) > 0 {
+		return true
+	}
+	if !bytes.Equal(req.Header.Method(), []byte(fasthttp.MethodPost)) {
+		return false
+	}
+	requestType, ok := ctx.Value(schemas.BifrostContextKeyRequestType).(schemas.RequestType)
+	return ok && !nonIdempotentRequestTypes[requestType]
+}
+
+// makeRequestWithRetryPolicy sends the request up to the policy's maximum attempts. Network errors
+// and responses with a retryable status code are retried after the backoff, or after the
+// upstream's Retry-After when the policy respects it. The last attempt's response (or error) is
+// returned, with the latency of all attempts.
+func makeRequestWithRetryPolicy(ctx context.Context, client *fasthttp.Client, req *fasthttp.Request, resp *fasthttp.Response, policy *schemas.RetryPolicy) (time.Duration, *schemas.BifrostError) {
+	startTime := time.Now()
+	for attempt := 1; ; attempt++ {
+		_, bifrostErr := makeRequestOnce(ctx, client, req, resp)
+		if attempt >= policy.MaxAttempts {
+			return time.Since(startTime), bifrostErr
+		}
+		wait, retry := retryWait(policy, attempt-1, resp, bifrostErr)
+		if !retry {
+			return time.Since(startTime), bifrostErr
+		}
+		if bifrostErr != nil && bifrostErr.Error != nil {
+			getLogger().Debug("retrying %s %s (attempt %d/%d) in %v after error: %s", req.Header.Method(), req.URI().Path(), attempt+1, policy.MaxAttempts, wait, bifrostErr.Error.Message)
+		} else {
+			getLogger().Debug("retrying %s %s (attempt %d/%d) in %v after status %d", req.Header.Method(), req.URI().Path(), attempt+1, policy.MaxAttempts, wait, resp.StatusCode())
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return time.Since(startTime), &schemas.BifrostError{
+				IsBifrostError: true,
+				Error: &schemas.ErrorField{
+					Type:    schemas.Ptr(schemas.RequestCancelled),
+					Message: fmt.Sprintf("Request cancelled or timed out by context: %v", ctx.Err()),
+					Error:   ctx.Err(),
+				},
+			}
+		case <-timer.C:
+		}
+	}
+}
+
+// retryWait decides whether an attempt is retried and how long to wait first. Network errors and
+// the policy's retryable status codes are retried; a Retry-After the policy respects replaces the
+// backoff, and one longer than the maximum backoff is not waited for.
+func retryWait(policy *schemas.RetryPolicy, retry int, resp *fasthttp.Response, bifrostErr *schemas.BifrostError) (time.Duration, bool) {
+	if bifrostErr != nil {
+		if bifrostErr.Error == nil {
+			return 0, false
+		}
+		switch bifrostErr.Error.Message {
+		case schemas.ErrProviderDoRequest, schemas.ErrProviderNetworkError:
+			return policy.Backoff(retry), true
+		}
+		return 0, false
+	}
+	if !policy.IsRetryableStatus(resp.StatusCode()) {
+		return 0, false
+	}
+	if policy.RespectRetryAfter {
+		info := ParseRateLimitHeaders(map[string]string{
+			"retry-after":    string(resp.Header.Peek("Retry-After")),
+			"retry-after-ms": string(resp.Header.Peek("Retry-After-Ms")),
+		}, time.Now())
+		if info.RetryAfter > policy.GetBackoffMax() {
+			return 0, false
+		}
+		if info.RetryAfter > 0 {
+			return info.RetryAfter, true
+		}
+	}
+	return policy.Backoff(retry), true
+}
@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := &schemas.RetryPolicy{BackoffBaseMs: 100, BackoffMaxMs: 500}
+	for retry, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 500 * time.Millisecond, 500 * time.Millisecond} {
+		if got := policy.Backoff(retry); got != want {
+			t.Errorf("Backoff(%d) = %v, want %v", retry, got, want)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for range 100 {
+		if got := policy.Backoff(1); got < 100*time.Millisecond || got > 300*time.Millisecond {
+			t.Fatalf("Backoff(1) with jitter = %v, want between 100ms and 300ms", got)
+		}
+	}
+
+	for _, invalid := range []*schemas.RetryPolicy{
+		{MaxAttempts: -1},
+		{BackoffBaseMs: 1000, BackoffMaxMs: 100},
+		{Jitter: 1.5},
+		{RetryableStatusCodes: []int{200}},
+	} {
+		if invalid.Validate() == nil {
+			t.Errorf("Validate(%+v) = nil, want an error", *invalid)
+		}
+	}
+}
+
+func TestMakeRequestWithRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		requestType schemas.RequestType
+		header      string
+		retryAfter  string
+		status      int
+		wantCalls   int32
+		wantStatus  int
+	}{
+		{name: "inference POST is retried", method: http.MethodPost, requestType: schemas.ChatCompletionRequest, wantCalls: 3, wantStatus: http.StatusOK},
+		{name: "GET is retried", method: http.MethodGet, requestType: schemas.BatchRetrieveRequest, wantCalls: 3, wantStatus: http.StatusOK},
+		{name: "create POST is not retried", method: http.MethodPost, requestType: schemas.BatchCreateRequest, wantCalls: 1, wantStatus: http.StatusServiceUnavailable},
+		{name: "create POST with an idempotency key is retried", method: http.MethodPost, requestType: schemas.BatchCreateRequest, header: "Idempotency-Key", wantCalls: 3, wantStatus: http.StatusOK},
+		{name: "PATCH is not retried", method: http.MethodPatch, requestType: schemas.ChatCompletionRequest, wantCalls: 1, wantStatus: http.StatusServiceUnavailable},
+		{name: "long Retry-After is not waited for", method: http.MethodPost, requestType: schemas.ChatCompletionRequest, retryAfter: "60", wantCalls: 1, wantStatus: http.StatusServiceUnavailable},
+		{name: "short Retry-After is waited for", method: http.MethodPost, requestType: schemas.ChatCompletionRequest, retryAfter: "0", wantCalls: 3, wantStatus: http.StatusOK},
+		{name: "429 is left to key rotation", method: http.MethodPost, requestType: schemas.ChatCompletionRequest, status: http.StatusTooManyRequests, wantCalls: 1, wantStatus: http.StatusTooManyRequests},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.status
+			if status == 0 {
+				status = http.StatusServiceUnavailable
+			}
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) < 3 {
+					if tt.retryAfter != "" {
+						w.Header().Set("Retry-After", tt.retryAfter)
+					}
+					w.WriteHeader(status)
+					return
+				}
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			ctx := schemas.NewBifrostContext(nil, schemas.NoDeadline)
+			ctx.SetValue(schemas.BifrostContextKeyRequestType, tt.requestType)
+			ctx.SetValue(schemas.BifrostContextKeyRetryPolicy, &schemas.RetryPolicy{MaxAttempts: 3, BackoffBaseMs: 1, BackoffMaxMs: 1000, RespectRetryAfter: true})
+
+			req := fasthttp.AcquireRequest()
+			resp := fasthttp.AcquireResponse()
+			defer fasthttp.ReleaseRequest(req)
+			defer fasthttp.ReleaseResponse(resp)
+			req.SetRequestURI(server.URL)
+			req.Header.SetMethod(tt.method)
+			if tt.header != "" {
+				req.Header.Set(tt.header, "batch-1")
+			}
+
+			if _, bifrostErr := MakeRequestWithContext(ctx, &fasthttp.Client{}, req, resp); bifrostErr != nil {
+				t.Fatalf("MakeRequestWithContext() error = %v", bifrostErr.Error.Message)
+			}
+			if calls.Load() != tt.wantCalls || resp.StatusCode() != tt.wantStatus {
+				t.Errorf("calls = %d, status = %d; want %d, %d", calls.Load(), resp.StatusCode(), tt.wantCalls, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestMakeRequestWithoutRetryPolicy(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+	req.SetRequestURI(server.URL)
+
+	ctx := schemas.NewBifrostContext(nil, schemas.NoDeadline)
+	if _, bifrostErr := MakeRequestWithContext(ctx, &fasthttp.Client{}, req, resp); bifrostErr != nil {
+		t.Fatalf("MakeRequestWithContext() error = %v", bifrostErr.Error.Message)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("calls = %d, want 1", calls.Load())
+	}
+}
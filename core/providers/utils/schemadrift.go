@@ -0,0 +1,325 @@
+package utils
+
+import (
+	"encoding"
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/bytedance/sonic"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	// schemaDriftWarmup is the number of responses in which a field must have been present
+	// before its absence is reported as a missing field.
+	schemaDriftWarmup = 20
+	// maxSchemaDriftPaths bounds the field paths tracked per schema.
+	maxSchemaDriftPaths = 512
+	// maxReportedSchemaDrifts bounds the distinct drifts reported, which keeps metric cardinality in check.
+	maxReportedSchemaDrifts = 1000
+)
+
+// schemaDriftAcceptedKeys lists response types that are checked despite a custom UnmarshalJSON, with
+// the keys their unmarshaler accepts besides the struct fields. Other types with custom unmarshaling
+// are treated as opaque. Usage types are listed because billing depends on them.
+var schemaDriftAcceptedKeys = map[reflect.Type][]string{
+	reflect.TypeOf(schemas.BifrostLLMUsage{}):              {"prompt_cache_hit_tokens"},
+	reflect.TypeOf(schemas.ChatPromptTokensDetails{}):      {"cached_tokens"},
+	reflect.TypeOf(schemas.ResponsesResponseInputTokens{}): {"cached_tokens"},
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// schemaDriftDetector is the detector used by HandleProviderResponse (nil = disabled).
+var schemaDriftDetector atomic.Pointer[SchemaDriftDetector]
+
+// SetSchemaDriftDetector sets the detector that checks decoded provider responses; nil disables the checks.
+func SetSchemaDriftDetector(detector *SchemaDriftDetector) {
+	schemaDriftDetector.Store(detector)
+}
+
+// GetSchemaDriftDetector returns the current detector, or nil if the checks are disabled.
+func GetSchemaDriftDetector() *SchemaDriftDetector {
+	return schemaDriftDetector.Load()
+}
+
+// SchemaDriftDetector compares provider response bodies with the Go types they are decoded into.
+// Keys the type does not declare are reported as unknown fields. Declared keys that were present in
+// every one of at least schemaDriftWarmup earlier responses of the same type are reported as missing
+// once they disappear, which catches renamed keys without flagging fields providers send only sometimes.
+// Each distinct drift is logged once as a warning and passed to the observer every time it is seen.
+type SchemaDriftDetector struct {
+	config   schemas.SchemaDriftConfig
+	observer func(schemas.SchemaDrift)
+	shapes   sync.Map // reflect.Type -> *shapeNode
+
+	mu       sync.Mutex
+	states   map[string]*schemaDriftState
+	reported map[schemas.SchemaDrift]struct{}
+	capped   bool
+}
+
+// schemaDriftState counts, per field path, the number of responses of one schema the path was present in.
+// The root path "" counts the checked responses and "x[]" the elements of array x.
+type schemaDriftState struct {
+	seen map[string]int
+}
+
+// shapeNode is the expected JSON shape of a Go type. Objects have fields, arrays have an element shape;
+// opaque values (maps, interfaces, custom unmarshalers) are represented by a nil node.
+type shapeNode struct {
+	fields   map[string]*shapeNode
+	elem     *shapeNode
+	accepted map[string]struct{}
+}
+
+// NewSchemaDriftDetector creates a detector, or returns nil if the config is nil or disabled.
+// The observer, if not nil, is called for every drift found, e.g. to increment a metric.
+func NewSchemaDriftDetector(config *schemas.SchemaDriftConfig, observer func(schemas.SchemaDrift)) *SchemaDriftDetector {
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	return &SchemaDriftDetector{
+		config:   *config,
+		observer: observer,
+		states:   make(map[string]*schemaDriftState),
+		reported: make(map[schemas.SchemaDrift]struct{}),
+	}
+}
+
+// Config returns the config the detector was created with.
+func (d *SchemaDriftDetector) Config() schemas.SchemaDriftConfig {
+	return d.config
+}
+
+// Check compares a response body with the type it was decoded into and reports the differences.
+// Only a sample of the responses is checked, according to the configured sample rate.
+func (d *SchemaDriftDetector) Check(responseBody []byte, response any) {
+	if sampleRate := d.config.EffectiveSampleRate(); sampleRate < 1 && rand.Float64() >= sampleRate {
+		return
+	}
+	t := reflect.TypeOf(response)
+	if t == nil {
+		return
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	root := d.shapeOf(t)
+	if root == nil || root.fields == nil {
+		return
+	}
+	var body interface{}
+	if err := sonic.Unmarshal(responseBody, &body); err != nil {
+		return
+	}
+
+	schema := t.String()
+	var drifts []schemas.SchemaDrift
+	d.mu.Lock()
+	state, ok := d.states[schema]
+	if !ok {
+		state = &schemaDriftState{seen: make(map[string]int)}
+		d.states[schema] = state
+	}
+	state.seen[""]++
+	var found []schemas.SchemaDrift
+	state.walk("", body, root, &found)
+	for _, drift := range found {
+		drift.Schema = schema
+		if _, ok := d.reported[drift]; !ok {
+			if len(d.reported) >= maxReportedSchemaDrifts {
+				if !d.capped {
+					d.capped = true
+					getLogger().Warn("provider response schema drift: %d distinct drifts reported, ignoring new ones", maxReportedSchemaDrifts)
+				}
+				continue
+			}
+			d.reported[drift] = struct{}{}
+			if drift.Kind == schemas.SchemaDriftMissingField {
+				getLogger().Warn("provider response schema drift: %s is missing field %s, which was present in all %d earlier responses", schema, drift.Field, state.seen[parentPath(drift.Field)]-1)
+			} else {
+				getLogger().Warn("provider response schema drift: %s has unknown field %s", schema, drift.Field)
+			}
+		}
+		drifts = append(drifts, drift)
+	}
+	d.mu.Unlock()
+
+	if d.observer != nil {
+		for _, drift := range drifts {
+			d.observer(drift)
+		}
+	}
+}
+
+// walk records the fields present in value, an object or array at path whose own presence is
+// already counted, and appends the unknown and missing fields to drifts.
+func (s *schemaDriftState) walk(path string, value interface{}, node *shapeNode, drifts *[]schemas.SchemaDrift) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if node.fields == nil {
+			return
+		}
+		present := make(map[string]struct{}, len(v))
+		for key, child := range v {
+			name, childNode, ok := node.lookup(key)
+			if !ok {
+				if _, accepted := node.accepted[key]; !accepted {
+					*drifts = append(*drifts, schemas.SchemaDrift{Kind: schemas.SchemaDriftUnknownField, Field: joinFieldPath(path, key)})
+				}
+				continue
+			}
+			present[name] = struct{}{}
+			childPath := joinFieldPath(path, name)
+			s.increment(childPath)
+			if childNode != nil && child != nil {
+				s.walk(childPath, child, childNode, drifts)
+			}
+		}
+		// The parent count includes the current response, the field counts do not.
+		if parentCount := s.seen[path] - 1; parentCount >= schemaDriftWarmup {
+			for name := range node.fields {
+				if _, ok := present[name]; ok {
+					continue
+				}
+				if childPath := joinFieldPath(path, name); s.seen[childPath] == parentCount {
+					*drifts = append(*drifts, schemas.SchemaDrift{Kind: schemas.SchemaDriftMissingField, Field: childPath})
+				}
+			}
+		}
+	case []interface{}:
+		if node.elem == nil {
+			return
+		}
+		elemPath := path + "[]"
+		for _, elem := range v {
+			switch elem.(type) {
+			case map[string]interface{}, []interface{}:
+				s.increment(elemPath)
+				s.walk(elemPath, elem, node.elem, drifts)
+			}
+		}
+	}
+}
+
+// increment counts a presence of path, without tracking new paths once the limit is reached.
+func (s *schemaDriftState) increment(path string) {
+	if _, ok := s.seen[path]; !ok && len(s.seen) >= maxSchemaDriftPaths {
+		return
+	}
+	s.seen[path]++
+}
+
+// lookup finds the field for a JSON key, matching case-insensitively like the JSON decoders do.
+func (n *shapeNode) lookup(key string) (string, *shapeNode, bool) {
+	if child, ok := n.fields[key]; ok {
+		return key, child, true
+	}
+	for name, child := range n.fields {
+		if strings.EqualFold(name, key) {
+			return name, child, true
+		}
+	}
+	return "", nil, false
+}
+
+// shapeOf returns the cached shape of t.
+func (d *SchemaDriftDetector) shapeOf(t reflect.Type) *shapeNode {
+	if cached, ok := d.shapes.Load(t); ok {
+		return cached.(*shapeNode)
+	}
+	node := buildShape(t, make(map[reflect.Type]*shapeNode))
+	d.shapes.Store(t, node)
+	return node
+}
+
+// buildShape derives the expected JSON shape of t from its json tags. building holds the structs
+// being built, so that recursive types refer to their own node.
+func buildShape(t reflect.Type, building map[reflect.Type]*shapeNode) *shapeNode {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if node, ok := building[t]; ok {
+		return node
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		acceptedKeys, checked := schemaDriftAcceptedKeys[t]
+		if !checked && hasCustomUnmarshaler(t) {
+			return nil
+		}
+		node := &shapeNode{fields: make(map[string]*shapeNode), accepted: make(map[string]struct{}, len(acceptedKeys))}
+		for _, key := range acceptedKeys {
+			node.accepted[key] = struct{}{}
+		}
+		building[t] = node
+		addStructFields(node, t, building)
+		return node
+	case reflect.Slice, reflect.Array:
+		// []byte is decoded from a base64 string
+		if t.Elem().Kind() == reflect.Uint8 || hasCustomUnmarshaler(t) {
+			return nil
+		}
+		if elem := buildShape(t.Elem(), building); elem != nil {
+			return &shapeNode{elem: elem}
+		}
+	}
+	return nil
+}
+
+// addStructFields adds the JSON fields of struct t to node, flattening untagged embedded structs.
+func addStructFields(node *shapeNode, t reflect.Type, building map[reflect.Type]*shapeNode) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Ptr {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				addStructFields(node, embedded, building)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		node.fields[name] = buildShape(field.Type, building)
+	}
+}
+
+func hasCustomUnmarshaler(t reflect.Type) bool {
+	ptr := reflect.PointerTo(t)
+	return t.Implements(jsonUnmarshalerType) || ptr.Implements(jsonUnmarshalerType) ||
+		t.Implements(textUnmarshalerType) || ptr.Implements(textUnmarshalerType)
+}
+
+func joinFieldPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func parentPath(path string) string {
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		return path[:i]
+	}
+	return ""
+}
@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+type driftTestChoice struct {
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+type driftTestResponse struct {
+	ID      string                   `json:"id"`
+	Choices []driftTestChoice        `json:"choices"`
+	Usage   *schemas.BifrostLLMUsage `json:"usage,omitempty"`
+	Extra   map[string]interface{}   `json:"extra,omitempty"`
+	Ignored string                   `json:"-"`
+}
+
+func newTestSchemaDriftDetector() (*SchemaDriftDetector, *[]schemas.SchemaDrift) {
+	var drifts []schemas.SchemaDrift
+	detector := NewSchemaDriftDetector(&schemas.SchemaDriftConfig{Enabled: true}, func(drift schemas.SchemaDrift) {
+		drifts = append(drifts, drift)
+	})
+	return detector, &drifts
+}
+
+// TestSchemaDriftDetector_UnknownFields verifies that undeclared keys are reported with their path,
+// while opaque values and keys accepted by custom unmarshalers are not
+func TestSchemaDriftDetector_UnknownFields(t *testing.T) {
+	detector, drifts := newTestSchemaDriftDetector()
+	body := []byte(`{
+		"id": "resp-1",
+		"service_tier": "default",
+		"choices": [{"index": 0, "message": "hi", "logprobs": null}],
+		"usage": {"prompt_tokens": 5, "total_tokens": 7, "prompt_cache_hit_tokens": 2, "prompt_tokens_details": {"cached_tokens": 2, "web_tokens": 1}},
+		"extra": {"anything": true}
+	}`)
+	detector.Check(body, &driftTestResponse{})
+
+	want := map[string]bool{
+		"service_tier":                           true,
+		"choices[].logprobs":                     true,
+		"usage.prompt_tokens_details.web_tokens": true,
+	}
+	if len(*drifts) != len(want) {
+		t.Fatalf("expected %d drifts, got %+v", len(want), *drifts)
+	}
+	for _, drift := range *drifts {
+		if drift.Kind != schemas.SchemaDriftUnknownField || !want[drift.Field] {
+			t.Errorf("unexpected drift: %+v", drift)
+		}
+		if drift.Schema != "utils.driftTestResponse" {
+			t.Errorf("expected schema utils.driftTestResponse, got %s", drift.Schema)
+		}
+	}
+}
+
+// TestSchemaDriftDetector_MissingFields verifies that a field is reported as missing only after it
+// was present in every earlier response, and only once
+func TestSchemaDriftDetector_MissingFields(t *testing.T) {
+	detector, drifts := newTestSchemaDriftDetector()
+	for i := 0; i < schemaDriftWarmup; i++ {
+		body := fmt.Sprintf(`{"id": "resp-%d", "choices": [{"index": 0, "message": "hi"}]}`, i)
+		if i%2 == 0 {
+			body = fmt.Sprintf(`{"id": "resp-%d", "choices": [{"index": 0, "message": "hi"}], "usage": {"total_tokens": 3}}`, i)
+		}
+		detector.Check([]byte(body), &driftTestResponse{})
+	}
+	if len(*drifts) != 0 {
+		t.Fatalf("expected no drifts during warmup, got %+v", *drifts)
+	}
+
+	// "id" renamed, "message" renamed inside the choices, "usage" absent as it often is
+	renamed := []byte(`{"response_id": "resp-x", "choices": [{"index": 0, "text": "hi"}]}`)
+	detector.Check(renamed, &driftTestResponse{})
+	detector.Check(renamed, &driftTestResponse{})
+
+	missing := map[string]int{}
+	unknown := map[string]int{}
+	for _, drift := range *drifts {
+		if drift.Kind == schemas.SchemaDriftMissingField {
+			missing[drift.Field]++
+		} else {
+			unknown[drift.Field]++
+		}
+	}
+	if len(missing) != 2 || missing["id"] != 1 || missing["choices[].message"] != 1 {
+		t.Errorf("expected id and choices[].message to be reported missing once, got %v", missing)
+	}
+	if len(unknown) != 2 || unknown["response_id"] != 2 || unknown["choices[].text"] != 2 {
+		t.Errorf("expected the renamed keys to be reported unknown on every response, got %v", unknown)
+	}
+}
+
+// TestNewSchemaDriftDetector_Disabled verifies that no detector is created for a nil or disabled config
+func TestNewSchemaDriftDetector_Disabled(t *testing.T) {
+	if NewSchemaDriftDetector(nil, nil) != nil {
+		t.Error("expected nil detector for nil config")
+	}
+	if NewSchemaDriftDetector(&schemas.SchemaDriftConfig{SampleRate: 1}, nil) != nil {
+		t.Error("expected nil detector for disabled config")
+	}
+}
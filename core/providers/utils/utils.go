@@ -98,11 +98,23 @@ var UnsupportedSpeechStreamModels = []string{"tts-1", "tts-1-hd"}
 
 // MakeRequestWithContext makes a request with a context and returns the latency and error.
 // IMPORTANT: This function does NOT truly cancel the underlying fasthttp network request if the
-// context is done. The fasthttp client call will continue in its goroutine until it completes
-// or times out based on its own settings. This function merely stops *waiting* for the
-// fasthttp call and returns an error related to the context.
+// context is done. The fasthttp client call continues until it completes or times out based on
+// its own settings, and this function waits for it before returning the context error: callers
+// release req and resp as soon as it returns, so returning early would let the client write into
+// a pooled response that is already reused. Callers waiting on the request context (e.g. the
+// Bifrost request queue) are not held up by this.
+// When the provider has a retry policy (BifrostContextKeyRetryPolicy) and the request is safe to
+// repeat, network errors and retryable status codes are retried in place.
 // Returns the request latency and any error that occurred.
 func MakeRequestWithContext(ctx context.Context, client *fasthttp.Client, req *fasthttp.Request, resp *fasthttp.Response) (time.Duration, *schemas.BifrostError) {
+	if policy, ok := ctx.Value(schemas.BifrostContextKeyRetryPolicy).(*schemas.RetryPolicy); ok && policy != nil && policy.MaxAttempts > 1 && isRetrySafe(ctx, req, resp) {
+		return makeRequestWithRetryPolicy(ctx, client, req, resp, policy)
+	}
+	return makeRequestOnce(ctx, client, req, resp)
+}
+
+// makeRequestOnce sends the request a single time; see MakeRequestWithContext.
+func makeRequestOnce(ctx context.Context, client *fasthttp.Client, req *fasthttp.Request, resp *fasthttp.Response) (time.Duration, *schemas.BifrostError) {
 	startTime := time.Now()
 	errChan := make(chan error, 1)
 
@@ -117,6 +129,8 @@ func MakeRequestWithContext(ctx context.Context, client *fasthttp.Client, req *f
 		// Context was cancelled (e.g., deadline exceeded or manual cancellation).
 		// Calculate latency even for cancelled requests
 		latency := time.Since(startTime)
+		// Wait for the client to let go of req and resp before the caller releases them
+		<-errChan
 		return latency, &schemas.BifrostError{
 			IsBifrostError: true,
 			Error: &schemas.ErrorField{
@@ -930,6 +944,11 @@ func HandleProviderResponse[T any](responseBody []byte, response *T, requestBody
 		}
 	}
 
+	// Report keys that differ from the decoded type, to catch upstream API changes
+	if detector := schemaDriftDetector.Load(); detector != nil {
+		detector.Check(responseBody, response)
+	}
+
 	if shouldCaptureRawRequest || sendBackRawResponse {
 		return rawRequest, rawResponse, nil
 	}
@@ -0,0 +1,146 @@
+package volcengine
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/capsohq/bifrost/core/providers/openai"
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// volcengineContextCreateRequest is the body of POST /context/create.
+type volcengineContextCreateRequest struct {
+	Model              string                                  `json:"model"`
+	Messages           []openai.OpenAIMessage                  `json:"messages"`
+	Mode               string                                  `json:"mode"`
+	TTL                *int                                    `json:"ttl,omitempty"`
+	TruncationStrategy *schemas.ContextCacheTruncationStrategy `json:"truncation_strategy,omitempty"`
+	ExtraParams        map[string]interface{}                  `json:"-"`
+}
+
+// GetExtraParams implements the RequestBodyWithExtraParams interface
+func (req *volcengineContextCreateRequest) GetExtraParams() map[string]interface{} {
+	return req.ExtraParams
+}
+
+type volcengineContextCreateResponse struct {
+	ID                 string                                  `json:"id"`
+	Model              string                                  `json:"model"`
+	Mode               string                                  `json:"mode"`
+	TTL                int                                     `json:"ttl"`
+	TruncationStrategy *schemas.ContextCacheTruncationStrategy `json:"truncation_strategy,omitempty"`
+	Usage              *schemas.BifrostLLMUsage                `json:"usage,omitempty"`
+}
+
+// toVolcengineContextCreateRequest converts a bifrost context cache request. Volcengine requires a mode,
+// so session caching is used unless another mode is given.
+func toVolcengineContextCreateRequest(request *schemas.BifrostContextCacheCreateRequest) *volcengineContextCreateRequest {
+	nativeReq := &volcengineContextCreateRequest{
+		Model:    request.Model,
+		Messages: openai.ConvertBifrostMessagesToOpenAIMessages(request.Input),
+		Mode:     schemas.ContextCacheModeSession,
+	}
+	if request.Params != nil {
+		if request.Params.Mode != nil && *request.Params.Mode != "" {
+			nativeReq.Mode = *request.Params.Mode
+		}
+		nativeReq.TTL = request.Params.TTL
+		nativeReq.TruncationStrategy = request.Params.TruncationStrategy
+		nativeReq.ExtraParams = request.Params.ExtraParams
+	}
+	return nativeReq
+}
+
+// volcengineChatPath returns the chat completions path, which moves under /context when the request continues a cached context.
+func volcengineChatPath(request *schemas.BifrostChatRequest) string {
+	if request.Params != nil && request.Params.ContextID != nil && *request.Params.ContextID != "" {
+		return volcenginePathContextChatCompletions
+	}
+	return volcenginePathChatCompletions
+}
+
+// ContextCacheCreate caches the request's messages through Volcengine's context API.
+// The returned ID is passed as context_id in later chat requests to the same model.
+func (provider *VolcengineProvider) ContextCacheCreate(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostContextCacheCreateRequest) (*schemas.BifrostContextCacheCreateResponse, *schemas.BifrostError) {
+	if request.Params != nil && request.Params.Mode != nil {
+		switch *request.Params.Mode {
+		case "", schemas.ContextCacheModeSession, schemas.ContextCacheModeCommonPrefix:
+		default:
+			return nil, providerUtils.NewBifrostOperationError(fmt.Sprintf("unsupported context cache mode %q: expected %s or %s", *request.Params.Mode, schemas.ContextCacheModeSession, schemas.ContextCacheModeCommonPrefix), nil, provider.GetProviderKey())
+		}
+	}
+
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
+	req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, volcenginePathContextCreate))
+	req.Header.SetMethod(http.MethodPost)
+	req.Header.SetContentType("application/json")
+	if key.Value.GetValue() != "" {
+		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
+	}
+
+	jsonData, bifrostErr := providerUtils.CheckContextAndGetRequestBody(
+		ctx,
+		request,
+		func() (providerUtils.RequestBodyWithExtraParams, error) {
+			return toVolcengineContextCreateRequest(request), nil
+		},
+		provider.GetProviderKey())
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	req.SetBody(jsonData)
+
+	latency, bifrostErr := providerUtils.MakeRequestWithContext(ctx, provider.client, req, resp)
+	if bifrostErr != nil {
+		return nil, providerUtils.EnrichError(ctx, bifrostErr, jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	if resp.StatusCode() != fasthttp.StatusOK {
+		provider.logger.Debug(fmt.Sprintf("error from volcengine context create: %s", string(resp.Body())))
+		return nil, providerUtils.EnrichError(ctx, openai.ParseOpenAIError(resp, schemas.ContextCacheCreateRequest, provider.GetProviderKey(), request.Model), jsonData, nil, sendBackRawRequest, sendBackRawResponse)
+	}
+
+	body, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
+	}
+
+	var nativeResp volcengineContextCreateResponse
+	if err := schemas.Unmarshal(body, &nativeResp); err != nil {
+		return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
+	}
+
+	response := &schemas.BifrostContextCacheCreateResponse{
+		ID:                 nativeResp.ID,
+		Model:              nativeResp.Model,
+		Mode:               nativeResp.Mode,
+		TTL:                nativeResp.TTL,
+		TruncationStrategy: nativeResp.TruncationStrategy,
+		Usage:              nativeResp.Usage,
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType:    schemas.ContextCacheCreateRequest,
+			Provider:       provider.GetProviderKey(),
+			ModelRequested: request.Model,
+			Latency:        latency.Milliseconds(),
+		},
+	}
+
+	if sendBackRawRequest {
+		response.ExtraFields.RawRequest = jsonData
+	}
+	if sendBackRawResponse {
+		response.ExtraFields.RawResponse = body
+	}
+
+	return response, nil
+}
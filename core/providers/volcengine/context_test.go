@@ -0,0 +1,158 @@
+package volcengine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestContextCacheCreate(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/context/create" {
+			t.Errorf("expected path /context/create, got %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer test-key" {
+			t.Errorf("expected Authorization Bearer test-key, got %s", r.Header.Get("Authorization"))
+		}
+		var requestBody struct {
+			Model    string `json:"model"`
+			Mode     string `json:"mode"`
+			TTL      int    `json:"ttl"`
+			Messages []struct {
+				Role    string `json:"role"`
+				Content string `json:"content"`
+			} `json:"messages"`
+			TruncationStrategy struct {
+				Type              string `json:"type"`
+				LastHistoryTokens int    `json:"last_history_tokens"`
+			} `json:"truncation_strategy"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if requestBody.Model != "ep-20250101-abcde" || requestBody.Mode != "session" || requestBody.TTL != 3600 {
+			t.Errorf("unexpected request: model=%s mode=%s ttl=%d", requestBody.Model, requestBody.Mode, requestBody.TTL)
+		}
+		if len(requestBody.Messages) != 1 || requestBody.Messages[0].Role != "system" || requestBody.Messages[0].Content != "You are a contract reviewer." {
+			t.Errorf("unexpected messages: %+v", requestBody.Messages)
+		}
+		if requestBody.TruncationStrategy.Type != "last_history_tokens" || requestBody.TruncationStrategy.LastHistoryTokens != 4096 {
+			t.Errorf("unexpected truncation strategy: %+v", requestBody.TruncationStrategy)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "ctx-20250101-xyz",
+			"model": "ep-20250101-abcde",
+			"mode": "session",
+			"ttl": 3600,
+			"truncation_strategy": {"type": "last_history_tokens", "last_history_tokens": 4096},
+			"usage": {"prompt_tokens": 18, "completion_tokens": 0, "total_tokens": 18, "prompt_tokens_details": {"cached_tokens": 0}}
+		}`)
+	}))
+	defer server.Close()
+
+	provider := newTestVolcengineProvider(server.URL)
+	systemPrompt := "You are a contract reviewer."
+	request := &schemas.BifrostContextCacheCreateRequest{
+		Provider: schemas.Volcengine,
+		Model:    "ep-20250101-abcde",
+		Input: []schemas.ChatMessage{
+			{Role: schemas.ChatMessageRoleSystem, Content: &schemas.ChatMessageContent{ContentStr: &systemPrompt}},
+		},
+		Params: &schemas.ContextCacheParameters{
+			TTL: intPtr(3600),
+			TruncationStrategy: &schemas.ContextCacheTruncationStrategy{
+				Type:              "last_history_tokens",
+				LastHistoryTokens: intPtr(4096),
+			},
+		},
+	}
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	resp, bifrostErr := provider.ContextCacheCreate(ctx, schemas.Key{Value: schemas.EnvVar{Val: "test-key"}}, request)
+	if bifrostErr != nil {
+		t.Fatalf("ContextCacheCreate returned error: %v", bifrostErr.Error)
+	}
+	if resp.ID != "ctx-20250101-xyz" || resp.Mode != "session" || resp.TTL != 3600 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp.Usage == nil || resp.Usage.PromptTokens != 18 {
+		t.Fatalf("expected usage.prompt_tokens=18, got %v", resp.Usage)
+	}
+	if resp.ExtraFields.RequestType != schemas.ContextCacheCreateRequest {
+		t.Fatalf("expected request type context_cache_create, got %s", resp.ExtraFields.RequestType)
+	}
+}
+
+func TestContextCacheCreate_InvalidMode(t *testing.T) {
+	t.Parallel()
+
+	provider := newTestVolcengineProvider("http://127.0.0.1:1")
+	request := &schemas.BifrostContextCacheCreateRequest{
+		Provider: schemas.Volcengine,
+		Model:    "ep-20250101-abcde",
+		Params:   &schemas.ContextCacheParameters{Mode: schemas.Ptr("prefix")},
+	}
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	if _, bifrostErr := provider.ContextCacheCreate(ctx, schemas.Key{}, request); bifrostErr == nil {
+		t.Fatal("expected an error for an unsupported mode")
+	}
+}
+
+func TestChatCompletion_WithContextID(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/context/chat/completions" {
+			t.Errorf("expected path /context/chat/completions, got %s", r.URL.Path)
+		}
+		var requestBody struct {
+			ContextID string `json:"context_id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if requestBody.ContextID != "ctx-20250101-xyz" {
+			t.Errorf("expected context_id ctx-20250101-xyz, got %q", requestBody.ContextID)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"id": "chat-1",
+			"object": "chat.completion",
+			"created": 1752133360,
+			"model": "ep-20250101-abcde",
+			"choices": [{"index": 0, "message": {"role": "assistant", "content": "Clause 4 is missing a cap."}, "finish_reason": "stop"}],
+			"usage": {"prompt_tokens": 30, "completion_tokens": 8, "total_tokens": 38, "prompt_tokens_details": {"cached_tokens": 18}}
+		}`)
+	}))
+	defer server.Close()
+
+	provider := newTestVolcengineProvider(server.URL)
+	question := "Review clause 4."
+	request := &schemas.BifrostChatRequest{
+		Provider: schemas.Volcengine,
+		Model:    "ep-20250101-abcde",
+		Input: []schemas.ChatMessage{
+			{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: &question}},
+		},
+		Params: &schemas.ChatParameters{ContextID: schemas.Ptr("ctx-20250101-xyz")},
+	}
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	resp, bifrostErr := provider.ChatCompletion(ctx, schemas.Key{Value: schemas.EnvVar{Val: "test-key"}}, request)
+	if bifrostErr != nil {
+		t.Fatalf("ChatCompletion returned error: %v", bifrostErr.Error)
+	}
+	if resp.Usage == nil || resp.Usage.PromptTokensDetails == nil || resp.Usage.PromptTokensDetails.CachedReadTokens != 18 {
+		t.Fatalf("expected 18 cached prompt tokens, got %+v", resp.Usage)
+	}
+}
@@ -0,0 +1,114 @@
+package volcengine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestImageEdit(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/images/generations" {
+			t.Errorf("expected path /images/generations, got %s", r.URL.Path)
+		}
+		var requestBody struct {
+			Model         string  `json:"model"`
+			Prompt        string  `json:"prompt"`
+			Image         string  `json:"image"`
+			Size          string  `json:"size"`
+			Seed          int     `json:"seed"`
+			GuidanceScale float64 `json:"guidance_scale"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&requestBody); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if requestBody.Model != "doubao-seededit-3-0-i2i-250628" || requestBody.Prompt != "Make the sky purple" {
+			t.Errorf("unexpected request: model=%s prompt=%s", requestBody.Model, requestBody.Prompt)
+		}
+		if !strings.HasPrefix(requestBody.Image, "data:image/png;base64,") {
+			t.Errorf("expected a PNG data URL, got %q", requestBody.Image)
+		}
+		if requestBody.Size != "adaptive" || requestBody.Seed != 42 || requestBody.GuidanceScale != 5.5 {
+			t.Errorf("unexpected params: size=%s seed=%d guidance_scale=%v", requestBody.Size, requestBody.Seed, requestBody.GuidanceScale)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"model": "doubao-seededit-3-0-i2i-250628",
+			"created": 1752133360,
+			"data": [{"url": "https://ark.example.com/edited.jpeg", "size": "1024x1024"}],
+			"usage": {"generated_images": 1, "output_tokens": 4096, "total_tokens": 4096}
+		}`)
+	}))
+	defer server.Close()
+
+	provider := newTestVolcengineProvider(server.URL)
+	request := &schemas.BifrostImageEditRequest{
+		Provider: schemas.Volcengine,
+		Model:    "doubao-seededit-3-0-i2i-250628",
+		Input: &schemas.ImageEditInput{
+			Prompt: "Make the sky purple",
+			Images: []schemas.ImageInput{{Image: testPNG}},
+		},
+		Params: &schemas.ImageEditParameters{
+			Size:        schemas.Ptr("auto"),
+			Seed:        intPtr(42),
+			ExtraParams: map[string]interface{}{"guidance_scale": 5.5},
+		},
+	}
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyPassthroughExtraParams, true)
+	resp, bifrostErr := provider.ImageEdit(ctx, schemas.Key{Value: schemas.EnvVar{Val: "test-key"}}, request)
+	if bifrostErr != nil {
+		t.Fatalf("ImageEdit returned error: %v", bifrostErr.Error)
+	}
+	if len(resp.Data) != 1 || resp.Data[0].URL != "https://ark.example.com/edited.jpeg" {
+		t.Fatalf("unexpected data: %+v", resp.Data)
+	}
+	if resp.Usage == nil || resp.Usage.OutputTokens != 4096 {
+		t.Fatalf("expected usage.output_tokens=4096, got %+v", resp.Usage)
+	}
+	if resp.ExtraFields.RequestType != schemas.ImageEditRequest {
+		t.Fatalf("expected request type image_edit, got %s", resp.ExtraFields.RequestType)
+	}
+}
+
+func TestToVolcengineImageEditRequest(t *testing.T) {
+	t.Parallel()
+
+	request := &schemas.BifrostImageEditRequest{
+		Model: "doubao-seedream-4-0-250828",
+		Input: &schemas.ImageEditInput{
+			Prompt: "Put the product from the first image on the table from the second",
+			Images: []schemas.ImageInput{{Image: testPNG}, {Image: testPNG}},
+		},
+	}
+	nativeReq, err := toVolcengineImageEditRequest(request)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if images, ok := nativeReq.Image.([]string); !ok || len(images) != 2 {
+		t.Fatalf("expected two reference images, got %#v", nativeReq.Image)
+	}
+
+	request.Params = &schemas.ImageEditParameters{Mask: testPNG}
+	if _, err := toVolcengineImageEditRequest(request); err == nil {
+		t.Fatal("expected an error for a masked edit")
+	}
+
+	request.Input.Images = nil
+	if _, err := toVolcengineImageEditRequest(request); err == nil {
+		t.Fatal("expected an error without an input image")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
 	schemas "github.com/capsohq/bifrost/core/schemas"
 	"github.com/valyala/fasthttp"
 )
@@ -35,16 +36,20 @@ func (b *noopLogEventBuilder) Int64(key string, val int64) schemas.LogEventBuild
 func (b *noopLogEventBuilder) Send()                                               {}
 
 func newTestVolcengineCompatibleProvider(baseURL string, providerKey schemas.ModelProvider) *VolcengineProvider {
+	client := &fasthttp.Client{
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 5 * time.Second,
+	}
+	networkConfig := schemas.NetworkConfig{
+		BaseURL: baseURL,
+	}
+	baseURLs, _ := providerUtils.NewRegionalBaseURLResolver(providerKey, client, networkConfig, []providerUtils.RegionalBaseURL{volcengineChinaBaseURL}, volcenginePathModels, &testLogger{})
 	return &VolcengineProvider{
-		logger: &testLogger{},
-		client: &fasthttp.Client{
-			ReadTimeout:  5 * time.Second,
-			WriteTimeout: 5 * time.Second,
-		},
-		networkConfig: schemas.NetworkConfig{
-			BaseURL: baseURL,
-		},
-		providerKey: providerKey,
+		logger:        &testLogger{},
+		client:        client,
+		networkConfig: networkConfig,
+		baseURLs:      baseURLs,
+		providerKey:   providerKey,
 	}
 }
 
@@ -63,8 +68,8 @@ func TestNewModelArkProvider_Defaults(t *testing.T) {
 	if provider.GetProviderKey() != schemas.ModelArk {
 		t.Fatalf("expected provider key %s, got %s", schemas.ModelArk, provider.GetProviderKey())
 	}
-	if provider.networkConfig.BaseURL != "https://ark.ap-southeast.bytepluses.com/api/v3" {
-		t.Fatalf("unexpected default base URL: %s", provider.networkConfig.BaseURL)
+	if provider.baseURLs.DefaultBaseURL() != "https://ark.ap-southeast.bytepluses.com/api/v3" {
+		t.Fatalf("unexpected default base URL: %s", provider.baseURLs.DefaultBaseURL())
 	}
 }
 
@@ -472,18 +477,9 @@ func TestMultiModalEmbedding_RawRequestResponse(t *testing.T) {
 	}))
 	defer server.Close()
 
-	provider := &VolcengineProvider{
-		logger: &testLogger{},
-		client: &fasthttp.Client{
-			ReadTimeout:  5 * time.Second,
-			WriteTimeout: 5 * time.Second,
-		},
-		networkConfig: schemas.NetworkConfig{
-			BaseURL: server.URL,
-		},
-		sendBackRawRequest:  true,
-		sendBackRawResponse: true,
-	}
+	provider := newTestVolcengineProvider(server.URL)
+	provider.sendBackRawRequest = true
+	provider.sendBackRawResponse = true
 
 	text := "test"
 	request := &schemas.BifrostEmbeddingRequest{
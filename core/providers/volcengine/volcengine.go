@@ -19,41 +19,71 @@ import (
 )
 
 const (
-	volcenginePathModels               = "/models"
-	volcenginePathCompletions          = "/completions"
-	volcenginePathChatCompletions      = "/chat/completions"
-	volcenginePathEmbeddings           = "/embeddings"
-	volcenginePathMultiModalEmbeddings = "/embeddings/multimodal"
-	volcenginePathImages               = "/images/generations"
-	volcenginePathVideos               = "/contents/generations/tasks"
-	volcenginePathFiles                = "/files"
-	volcenginePathResponses            = "/responses"
+	volcenginePathModels                 = "/models"
+	volcenginePathCompletions            = "/completions"
+	volcenginePathChatCompletions        = "/chat/completions"
+	volcenginePathEmbeddings             = "/embeddings"
+	volcenginePathMultiModalEmbeddings   = "/embeddings/multimodal"
+	volcenginePathImages                 = "/images/generations"
+	volcenginePathVideos                 = "/contents/generations/tasks"
+	volcenginePathFiles                  = "/files"
+	volcenginePathResponses              = "/responses"
+	volcenginePathContextCreate          = "/context/create"
+	volcenginePathContextChatCompletions = "/context/chat/completions"
+)
+
+// ARK endpoints: Volcengine (mainland China) and BytePlus ModelArk (international). Volcengine defaults to
+// the China endpoint and ModelArk to the international one.
+var (
+	volcengineChinaBaseURL         = providerUtils.RegionalBaseURL{Region: schemas.EndpointRegionChina, BaseURL: "https://ark.cn-beijing.volces.com/api/v3"}
+	volcengineInternationalBaseURL = providerUtils.RegionalBaseURL{Region: schemas.EndpointRegionInternational, BaseURL: "https://ark.ap-southeast.bytepluses.com/api/v3"}
 )
 
 // VolcengineProvider implements the Provider interface for Volcengine's API.
 type VolcengineProvider struct {
-	logger              schemas.Logger        // Logger for provider operations
-	client              *fasthttp.Client      // HTTP client for API requests
-	networkConfig       schemas.NetworkConfig // Network configuration including extra headers
-	sendBackRawRequest  bool                  // Whether to include raw request in BifrostResponse
-	sendBackRawResponse bool                  // Whether to include raw response in BifrostResponse
-	providerKey         schemas.ModelProvider // Provider identifier for error/response metadata
+	logger              schemas.Logger                         // Logger for provider operations
+	client              *fasthttp.Client                       // HTTP client for API requests
+	networkConfig       schemas.NetworkConfig                  // Network configuration including extra headers
+	baseURLs            *providerUtils.RegionalBaseURLResolver // Picks the international or China endpoint per key
+	sendBackRawRequest  bool                                   // Whether to include raw request in BifrostResponse
+	sendBackRawResponse bool                                   // Whether to include raw response in BifrostResponse
+	providerKey         schemas.ModelProvider                  // Provider identifier for error/response metadata
+}
+
+// Compile-time checks for the capabilities VolcengineProvider implements.
+var (
+	_ schemas.ListModelsProvider      = (*VolcengineProvider)(nil)
+	_ schemas.TextCompletionProvider  = (*VolcengineProvider)(nil)
+	_ schemas.ChatProvider            = (*VolcengineProvider)(nil)
+	_ schemas.ResponsesProvider       = (*VolcengineProvider)(nil)
+	_ schemas.EmbeddingProvider       = (*VolcengineProvider)(nil)
+	_ schemas.ImageGenerationProvider = (*VolcengineProvider)(nil)
+	_ schemas.ImageEditProvider       = (*VolcengineProvider)(nil)
+	_ schemas.ContextCacheProvider    = (*VolcengineProvider)(nil)
+	_ schemas.VideoProvider           = (*VolcengineProvider)(nil)
+	_ schemas.FileProvider            = (*VolcengineProvider)(nil)
+)
+
+func init() {
+	capabilities := schemas.CapabilitiesOf((*VolcengineProvider)(nil))
+	schemas.RegisterProviderCapabilities(schemas.Volcengine, capabilities)
+	schemas.RegisterProviderCapabilities(schemas.ModelArk, capabilities)
 }
 
 // NewVolcengineProvider creates a new Volcengine provider instance.
 // It initializes the HTTP client with the provided configuration and sets up response pools.
 // The client is configured with timeouts, concurrency limits, and optional proxy settings.
 func NewVolcengineProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*VolcengineProvider, error) {
-	return newVolcengineCompatibleProvider(config, logger, schemas.Volcengine, "https://ark.cn-beijing.volces.com/api/v3")
+	return newVolcengineCompatibleProvider(config, logger, schemas.Volcengine, []providerUtils.RegionalBaseURL{volcengineChinaBaseURL, volcengineInternationalBaseURL})
 }
 
 // NewModelArkProvider creates a new ModelArk provider instance.
 // ModelArk uses the BytePlus-hosted international ARK endpoint but otherwise shares Volcengine behavior.
 func NewModelArkProvider(config *schemas.ProviderConfig, logger schemas.Logger) (*VolcengineProvider, error) {
-	return newVolcengineCompatibleProvider(config, logger, schemas.ModelArk, "https://ark.ap-southeast.bytepluses.com/api/v3")
+	return newVolcengineCompatibleProvider(config, logger, schemas.ModelArk, []providerUtils.RegionalBaseURL{volcengineInternationalBaseURL, volcengineChinaBaseURL})
 }
 
-func newVolcengineCompatibleProvider(config *schemas.ProviderConfig, logger schemas.Logger, providerKey schemas.ModelProvider, defaultBaseURL string) (*VolcengineProvider, error) {
+func newVolcengineCompatibleProvider(config *schemas.ProviderConfig, logger schemas.Logger, providerKey schemas.ModelProvider, regionalBaseURLs []providerUtils.RegionalBaseURL) (*VolcengineProvider, error) {
 	config.CheckAndSetDefaults()
 
 	client := &fasthttp.Client{
@@ -67,15 +97,16 @@ func newVolcengineCompatibleProvider(config *schemas.ProviderConfig, logger sche
 	// Configure proxy and retry policy
 	client = providerUtils.ConfigureProxy(client, config.ProxyConfig, logger)
 	client = providerUtils.ConfigureDialer(client)
-	if config.NetworkConfig.BaseURL == "" {
-		config.NetworkConfig.BaseURL = defaultBaseURL
+	baseURLs, err := providerUtils.NewRegionalBaseURLResolver(providerKey, client, config.NetworkConfig, regionalBaseURLs, volcenginePathModels, logger)
+	if err != nil {
+		return nil, err
 	}
-	config.NetworkConfig.BaseURL = strings.TrimRight(config.NetworkConfig.BaseURL, "/")
 
 	return &VolcengineProvider{
 		logger:              logger,
 		client:              client,
 		networkConfig:       config.NetworkConfig,
+		baseURLs:            baseURLs,
 		sendBackRawRequest:  config.SendBackRawRequest,
 		sendBackRawResponse: config.SendBackRawResponse,
 		providerKey:         providerKey,
@@ -87,6 +118,11 @@ func (provider *VolcengineProvider) GetProviderKey() schemas.ModelProvider {
 	return provider.providerKey
 }
 
+// HTTPClient returns the pooled client used for Volcengine API requests.
+func (provider *VolcengineProvider) HTTPClient() *fasthttp.Client {
+	return provider.client
+}
+
 var volcengineVisionEmbeddingModelPrefixes = []string{
 	"doubao-embedding-vision-",
 	"skylark-embedding-vision-",
@@ -104,16 +140,19 @@ func isVolcengineVisionEmbeddingModel(model string) bool {
 
 // ListModels performs a list models request to Volcengine's API.
 func (provider *VolcengineProvider) ListModels(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
-	return openai.HandleOpenAIListModelsRequest(
+	path := providerUtils.GetPathFromContext(ctx, volcenginePathModels)
+	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
+	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
+	if len(keys) == 0 {
+		return openai.ListModelsByKey(ctx, provider.client, provider.baseURLs.DefaultBaseURL()+path, schemas.Key{}, request.Unfiltered, provider.networkConfig.ExtraHeaders, provider.GetProviderKey(), sendBackRawRequest, sendBackRawResponse)
+	}
+	return providerUtils.HandleMultipleListModelsRequests(
 		ctx,
-		provider.client,
-		request,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathModels),
 		keys,
-		provider.networkConfig.ExtraHeaders,
-		provider.GetProviderKey(),
-		providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest),
-		providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse),
+		request,
+		func(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
+			return openai.ListModelsByKey(ctx, provider.client, provider.baseURLs.BaseURL(ctx, key)+path, key, request.Unfiltered, provider.networkConfig.ExtraHeaders, provider.GetProviderKey(), sendBackRawRequest, sendBackRawResponse)
+		},
 	)
 }
 
@@ -122,7 +161,7 @@ func (provider *VolcengineProvider) TextCompletion(ctx *schemas.BifrostContext,
 	return openai.HandleOpenAITextCompletionRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathCompletions),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, volcenginePathCompletions),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -146,7 +185,7 @@ func (provider *VolcengineProvider) TextCompletionStream(ctx *schemas.BifrostCon
 	return openai.HandleOpenAITextCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathCompletions),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, volcenginePathCompletions),
 		request,
 		authHeader,
 		provider.networkConfig.ExtraHeaders,
@@ -166,7 +205,7 @@ func (provider *VolcengineProvider) ChatCompletion(ctx *schemas.BifrostContext,
 	return openai.HandleOpenAIChatCompletionRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathChatCompletions),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, volcengineChatPath(request)),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -192,7 +231,7 @@ func (provider *VolcengineProvider) ChatCompletionStream(ctx *schemas.BifrostCon
 	return openai.HandleOpenAIChatCompletionStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathChatCompletions),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, volcengineChatPath(request)),
 		request,
 		authHeader,
 		provider.networkConfig.ExtraHeaders,
@@ -214,7 +253,7 @@ func (provider *VolcengineProvider) Responses(ctx *schemas.BifrostContext, key s
 	return openai.HandleOpenAIResponsesRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathResponses),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, volcenginePathResponses),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -236,7 +275,7 @@ func (provider *VolcengineProvider) ResponsesStream(ctx *schemas.BifrostContext,
 	return openai.HandleOpenAIResponsesStreaming(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathResponses),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, volcenginePathResponses),
 		request,
 		authHeader,
 		provider.networkConfig.ExtraHeaders,
@@ -260,7 +299,7 @@ func (provider *VolcengineProvider) Embedding(ctx *schemas.BifrostContext, key s
 	return openai.HandleOpenAIEmbeddingRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathEmbeddings),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, volcenginePathEmbeddings),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -615,7 +654,7 @@ func (provider *VolcengineProvider) multiModalEmbedding(ctx *schemas.BifrostCont
 	defer fasthttp.ReleaseResponse(resp)
 
 	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, volcenginePathMultiModalEmbeddings))
+	req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, volcenginePathMultiModalEmbeddings))
 	req.Header.SetMethod(http.MethodPost)
 	req.Header.SetContentType("application/json")
 
@@ -722,36 +761,11 @@ func (provider *VolcengineProvider) multiModalEmbedding(ctx *schemas.BifrostCont
 	return response, nil
 }
 
-// Speech is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) Speech(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostSpeechRequest) (*schemas.BifrostSpeechResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechRequest, provider.GetProviderKey())
-}
-
-// SpeechStream is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) SpeechStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostSpeechRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.SpeechStreamRequest, provider.GetProviderKey())
-}
-
-// Transcription is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) Transcription(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (*schemas.BifrostTranscriptionResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionRequest, provider.GetProviderKey())
-}
-
-// TranscriptionStream is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) TranscriptionStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostTranscriptionRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.TranscriptionStreamRequest, provider.GetProviderKey())
-}
-
-// Rerank is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) Rerank(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostRerankRequest) (*schemas.BifrostRerankResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.RerankRequest, provider.GetProviderKey())
-}
-
 func (provider *VolcengineProvider) ImageGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageGenerationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
 	return openai.HandleOpenAIImageGenerationRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathImages),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, volcenginePathImages),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -767,26 +781,11 @@ func (provider *VolcengineProvider) ImageGenerationStream(ctx *schemas.BifrostCo
 	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageGenerationStreamRequest, provider.GetProviderKey())
 }
 
-// ImageEdit is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ImageEdit(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageEditRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditRequest, provider.GetProviderKey())
-}
-
-// ImageEditStream is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ImageEditStream(ctx *schemas.BifrostContext, postHookRunner schemas.PostHookRunner, key schemas.Key, request *schemas.BifrostImageEditRequest) (chan *schemas.BifrostStreamChunk, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageEditStreamRequest, provider.GetProviderKey())
-}
-
-// ImageVariation is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ImageVariation(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostImageVariationRequest) (*schemas.BifrostImageGenerationResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ImageVariationRequest, provider.GetProviderKey())
-}
-
 func (provider *VolcengineProvider) VideoGeneration(ctx *schemas.BifrostContext, key schemas.Key, request *schemas.BifrostVideoGenerationRequest) (*schemas.BifrostVideoGenerationResponse, *schemas.BifrostError) {
 	return openai.HandleOpenAIVideoGenerationRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathVideos),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, volcenginePathVideos),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -802,7 +801,7 @@ func (provider *VolcengineProvider) VideoRetrieve(ctx *schemas.BifrostContext, k
 	return openai.HandleOpenAIVideoRetrieveRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s", volcenginePathVideos, videoID)),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s", volcenginePathVideos, videoID)),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -823,7 +822,7 @@ func (provider *VolcengineProvider) VideoDownload(ctx *schemas.BifrostContext, k
 	defer fasthttp.ReleaseResponse(resp)
 
 	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s/content", volcenginePathVideos, videoID)))
+	req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s/content", volcenginePathVideos, videoID)))
 	req.Header.SetMethod(http.MethodGet)
 	if key.Value.GetValue() != "" {
 		req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
@@ -864,7 +863,7 @@ func (provider *VolcengineProvider) VideoDelete(ctx *schemas.BifrostContext, key
 	return openai.HandleOpenAIVideoDeleteRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s", volcenginePathVideos, videoID)),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s", volcenginePathVideos, videoID)),
 		videoID,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -879,7 +878,7 @@ func (provider *VolcengineProvider) VideoList(ctx *schemas.BifrostContext, key s
 	return openai.HandleOpenAIVideoListRequest(
 		ctx,
 		provider.client,
-		provider.networkConfig.BaseURL+providerUtils.GetPathFromContext(ctx, volcenginePathVideos),
+		provider.baseURLs.BaseURL(ctx, key)+providerUtils.GetPathFromContext(ctx, volcenginePathVideos),
 		request,
 		key,
 		provider.networkConfig.ExtraHeaders,
@@ -939,7 +938,7 @@ func (provider *VolcengineProvider) FileUpload(ctx *schemas.BifrostContext, key
 	defer fasthttp.ReleaseResponse(resp)
 
 	providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-	req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, volcenginePathFiles))
+	req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, volcenginePathFiles))
 	req.Header.SetMethod(http.MethodPost)
 	req.Header.SetContentType(writer.FormDataContentType())
 	if key.Value.GetValue() != "" {
@@ -1010,7 +1009,7 @@ func (provider *VolcengineProvider) FileList(ctx *schemas.BifrostContext, keys [
 	defer fasthttp.ReleaseRequest(req)
 	defer fasthttp.ReleaseResponse(resp)
 
-	requestURL := provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, volcenginePathFiles)
+	requestURL := provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, volcenginePathFiles)
 	values := url.Values{}
 	if request.Purpose != "" {
 		values.Set("purpose", string(request.Purpose))
@@ -1106,13 +1105,12 @@ func (provider *VolcengineProvider) FileRetrieve(ctx *schemas.BifrostContext, ke
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileRetrieveResponse, *schemas.BifrostError) {
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
 
 		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s", volcenginePathFiles, request.FileID)))
+		req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s", volcenginePathFiles, request.FileID)))
 		req.Header.SetMethod(http.MethodGet)
 		req.Header.SetContentType("application/json")
 		if key.Value.GetValue() != "" {
@@ -1123,22 +1121,20 @@ func (provider *VolcengineProvider) FileRetrieve(ctx *schemas.BifrostContext, ke
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 		if resp.StatusCode() != fasthttp.StatusOK {
-			lastErr = openai.ParseOpenAIError(resp, schemas.FileRetrieveRequest, provider.GetProviderKey(), "")
+			bifrostErr := openai.ParseOpenAIError(resp, schemas.FileRetrieveRequest, provider.GetProviderKey(), "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		responseBody, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
 		}
 
 		var parsed openai.OpenAIFileResponse
@@ -1146,17 +1142,14 @@ func (provider *VolcengineProvider) FileRetrieve(ctx *schemas.BifrostContext, ke
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
 		fasthttp.ReleaseResponse(resp)
 
 		return parsed.ToBifrostFileRetrieveResponse(provider.GetProviderKey(), latency, sendBackRawRequest, sendBackRawResponse, rawRequest, rawResponse), nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 func (provider *VolcengineProvider) FileDelete(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFileDeleteRequest) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
@@ -1170,13 +1163,12 @@ func (provider *VolcengineProvider) FileDelete(ctx *schemas.BifrostContext, keys
 	sendBackRawRequest := providerUtils.ShouldSendBackRawRequest(ctx, provider.sendBackRawRequest)
 	sendBackRawResponse := providerUtils.ShouldSendBackRawResponse(ctx, provider.sendBackRawResponse)
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileDeleteResponse, *schemas.BifrostError) {
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
 
 		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s", volcenginePathFiles, request.FileID)))
+		req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s", volcenginePathFiles, request.FileID)))
 		req.Header.SetMethod(http.MethodDelete)
 		req.Header.SetContentType("application/json")
 		if key.Value.GetValue() != "" {
@@ -1187,22 +1179,20 @@ func (provider *VolcengineProvider) FileDelete(ctx *schemas.BifrostContext, keys
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 		if resp.StatusCode() != fasthttp.StatusOK {
-			lastErr = openai.ParseOpenAIError(resp, schemas.FileDeleteRequest, provider.GetProviderKey(), "")
+			bifrostErr := openai.ParseOpenAIError(resp, schemas.FileDeleteRequest, provider.GetProviderKey(), "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		responseBody, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
 		}
 
 		var parsed openai.OpenAIFileDeleteResponse
@@ -1210,8 +1200,7 @@ func (provider *VolcengineProvider) FileDelete(ctx *schemas.BifrostContext, keys
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 
 		fasthttp.ReleaseRequest(req)
@@ -1234,9 +1223,7 @@ func (provider *VolcengineProvider) FileDelete(ctx *schemas.BifrostContext, keys
 			result.ExtraFields.RawResponse = rawResponse
 		}
 		return result, nil
-	}
-
-	return nil, lastErr
+	})
 }
 
 func (provider *VolcengineProvider) FileContent(ctx *schemas.BifrostContext, keys []schemas.Key, request *schemas.BifrostFileContentRequest) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
@@ -1247,13 +1234,12 @@ func (provider *VolcengineProvider) FileContent(ctx *schemas.BifrostContext, key
 		return nil, providerUtils.NewBifrostOperationError("no keys provided", nil, provider.GetProviderKey())
 	}
 
-	var lastErr *schemas.BifrostError
-	for _, key := range keys {
+	return providerUtils.ExecuteWithKeyFailover(provider.GetProviderKey(), request.FileID, keys, func(key schemas.Key) (*schemas.BifrostFileContentResponse, *schemas.BifrostError) {
 		req := fasthttp.AcquireRequest()
 		resp := fasthttp.AcquireResponse()
 
 		providerUtils.SetExtraHeaders(ctx, req, provider.networkConfig.ExtraHeaders, nil)
-		req.SetRequestURI(provider.networkConfig.BaseURL + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s/content", volcenginePathFiles, request.FileID)))
+		req.SetRequestURI(provider.baseURLs.BaseURL(ctx, key) + providerUtils.GetPathFromContext(ctx, fmt.Sprintf("%s/%s/content", volcenginePathFiles, request.FileID)))
 		req.Header.SetMethod(http.MethodGet)
 		if key.Value.GetValue() != "" {
 			req.Header.Set("Authorization", "Bearer "+key.Value.GetValue())
@@ -1263,22 +1249,20 @@ func (provider *VolcengineProvider) FileContent(ctx *schemas.BifrostContext, key
 		if bifrostErr != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = bifrostErr
-			continue
+			return nil, bifrostErr
 		}
 		if resp.StatusCode() != fasthttp.StatusOK {
-			lastErr = openai.ParseOpenAIError(resp, schemas.FileContentRequest, provider.GetProviderKey(), "")
+			bifrostErr := openai.ParseOpenAIError(resp, schemas.FileContentRequest, provider.GetProviderKey(), "")
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			continue
+			return nil, bifrostErr
 		}
 
 		responseBody, err := providerUtils.CheckAndDecodeBody(resp)
 		if err != nil {
 			fasthttp.ReleaseRequest(req)
 			fasthttp.ReleaseResponse(resp)
-			lastErr = providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
-			continue
+			return nil, providerUtils.NewBifrostOperationError(schemas.ErrProviderResponseDecode, err, provider.GetProviderKey())
 		}
 
 		contentType := string(resp.Header.ContentType())
@@ -1299,82 +1283,5 @@ func (provider *VolcengineProvider) FileContent(ctx *schemas.BifrostContext, key
 				Latency:     latency.Milliseconds(),
 			},
 		}, nil
-	}
-
-	return nil, lastErr
-}
-
-// BatchCreate is not supported by Volcengine provider.
-func (provider *VolcengineProvider) BatchCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostBatchCreateRequest) (*schemas.BifrostBatchCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchCreateRequest, provider.GetProviderKey())
-}
-
-// BatchList is not supported by Volcengine provider.
-func (provider *VolcengineProvider) BatchList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchListRequest) (*schemas.BifrostBatchListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchListRequest, provider.GetProviderKey())
-}
-
-// BatchRetrieve is not supported by Volcengine provider.
-func (provider *VolcengineProvider) BatchRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchRetrieveRequest) (*schemas.BifrostBatchRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchRetrieveRequest, provider.GetProviderKey())
-}
-
-// BatchCancel is not supported by Volcengine provider.
-func (provider *VolcengineProvider) BatchCancel(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchCancelRequest) (*schemas.BifrostBatchCancelResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchCancelRequest, provider.GetProviderKey())
-}
-
-// BatchResults is not supported by Volcengine provider.
-func (provider *VolcengineProvider) BatchResults(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostBatchResultsRequest) (*schemas.BifrostBatchResultsResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.BatchResultsRequest, provider.GetProviderKey())
-}
-
-// CountTokens is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) CountTokens(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostResponsesRequest) (*schemas.BifrostCountTokensResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.CountTokensRequest, provider.GetProviderKey())
-}
-
-// ContainerCreate is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ContainerCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerCreateRequest) (*schemas.BifrostContainerCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerCreateRequest, provider.GetProviderKey())
-}
-
-// ContainerList is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ContainerList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerListRequest) (*schemas.BifrostContainerListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerListRequest, provider.GetProviderKey())
-}
-
-// ContainerRetrieve is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ContainerRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerRetrieveRequest) (*schemas.BifrostContainerRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerRetrieveRequest, provider.GetProviderKey())
-}
-
-// ContainerDelete is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ContainerDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerDeleteRequest) (*schemas.BifrostContainerDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerDeleteRequest, provider.GetProviderKey())
-}
-
-// ContainerFileCreate is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ContainerFileCreate(_ *schemas.BifrostContext, _ schemas.Key, _ *schemas.BifrostContainerFileCreateRequest) (*schemas.BifrostContainerFileCreateResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileCreateRequest, provider.GetProviderKey())
-}
-
-// ContainerFileList is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ContainerFileList(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileListRequest) (*schemas.BifrostContainerFileListResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileListRequest, provider.GetProviderKey())
-}
-
-// ContainerFileRetrieve is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ContainerFileRetrieve(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileRetrieveRequest) (*schemas.BifrostContainerFileRetrieveResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileRetrieveRequest, provider.GetProviderKey())
-}
-
-// ContainerFileContent is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ContainerFileContent(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileContentRequest) (*schemas.BifrostContainerFileContentResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileContentRequest, provider.GetProviderKey())
-}
-
-// ContainerFileDelete is not supported by the Volcengine provider.
-func (provider *VolcengineProvider) ContainerFileDelete(_ *schemas.BifrostContext, _ []schemas.Key, _ *schemas.BifrostContainerFileDeleteRequest) (*schemas.BifrostContainerFileDeleteResponse, *schemas.BifrostError) {
-	return nil, providerUtils.NewUnsupportedOperationError(schemas.ContainerFileDeleteRequest, provider.GetProviderKey())
+	})
 }
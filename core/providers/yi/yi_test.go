@@ -0,0 +1,53 @@
+package yi_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/internal/llmtests"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func envOrDefault(key, fallback string) string {
+	if value := strings.TrimSpace(os.Getenv(key)); value != "" {
+		return value
+	}
+	return fallback
+}
+
+func TestYi(t *testing.T) {
+	t.Parallel()
+
+	if strings.TrimSpace(os.Getenv("YI_API_KEY")) == "" {
+		t.Skip("Skipping Yi tests because YI_API_KEY is not set")
+	}
+
+	client, ctx, cancel, err := llmtests.SetupTest()
+	if err != nil {
+		t.Fatalf("Error initializing test setup: %v", err)
+	}
+	defer cancel()
+
+	testConfig := llmtests.ComprehensiveTestConfig{
+		Provider:    schemas.Yi,
+		ChatModel:   envOrDefault("YI_CHAT_MODEL", "yi-lightning"),
+		VisionModel: envOrDefault("YI_VISION_MODEL", "yi-vision-v2"),
+		Scenarios: llmtests.TestScenarios{
+			SimpleChat:            true,
+			CompletionStream:      true,
+			MultiTurnConversation: true,
+			ToolCalls:             true,
+			MultipleToolCalls:     true,
+			End2EndToolCalling:    true,
+			AutomaticFunctionCall: true,
+			ImageURL:              true,
+			ListModels:            true,
+		},
+	}
+
+	t.Run("YiTests", func(t *testing.T) {
+		llmtests.RunAllComprehensiveTests(t, client, ctx, testConfig)
+	})
+	client.Shutdown()
+}
@@ -0,0 +1,143 @@
+package bifrost
+
+import (
+	"fmt"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/google/uuid"
+)
+
+// RealtimeRequest connects a client to a realtime session of the provider and relays events until
+// either side closes the connection; it returns when the session ends. Plugin pre-hooks run when the
+// session is opened, so they can reject it, and post-hooks run once it has ended with the usage of
+// the whole session.
+//
+// Sessions do not go through the provider queue and are not retried or sent to fallbacks.
+func (bifrost *Bifrost) RealtimeRequest(ctx *schemas.BifrostContext, req *schemas.BifrostRealtimeRequest) (*schemas.BifrostRealtimeResponse, *schemas.BifrostError) {
+	if req == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "realtime request is nil",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType: schemas.RealtimeRequest,
+			},
+		}
+	}
+	if req.Model == "" || req.Client == nil || req.Dial == nil {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: false,
+			Error: &schemas.ErrorField{
+				Message: "model, client and dialer are required for realtime request",
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    schemas.RealtimeRequest,
+				Provider:       req.Provider,
+				ModelRequested: req.Model,
+			},
+		}
+	}
+	if ctx == nil {
+		ctx = bifrost.ctx
+	}
+	if _, ok := ctx.Value(schemas.BifrostContextKeyRequestID).(string); !ok {
+		ctx.SetValue(schemas.BifrostContextKeyRequestID, uuid.New().String())
+	}
+	if tracer := bifrost.getTracer(); tracer != nil {
+		ctx.SetValue(schemas.BifrostContextKeyTracer, tracer)
+	}
+
+	pipeline := bifrost.getPluginPipeline()
+	defer bifrost.releasePluginPipeline(pipeline)
+
+	preReq, shortCircuit, preCount := pipeline.RunLLMPreHooks(ctx, &schemas.BifrostRequest{
+		RequestType:     schemas.RealtimeRequest,
+		RealtimeRequest: req,
+	})
+	if shortCircuit != nil {
+		if shortCircuit.Error == nil {
+			shortCircuit.Error = newBifrostErrorFromMsg("realtime sessions cannot be short-circuited with a response")
+		}
+		_, bifrostErr := pipeline.RunPostLLMHooks(ctx, nil, shortCircuit.Error, preCount)
+		return nil, realtimeError(bifrostErr, req)
+	}
+	if preReq != nil && preReq.RealtimeRequest != nil {
+		req = preReq.RealtimeRequest
+	}
+
+	result, bifrostErr := bifrost.openRealtimeSession(ctx, req)
+	var response *schemas.BifrostResponse
+	if result != nil {
+		response = &schemas.BifrostResponse{RealtimeResponse: result}
+	}
+	response, bifrostErr = pipeline.RunPostLLMHooks(ctx, response, realtimeError(bifrostErr, req), len(*bifrost.llmPlugins.Load()))
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if response == nil || response.RealtimeResponse == nil {
+		return nil, realtimeError(newBifrostErrorFromMsg("received nil response from provider"), req)
+	}
+	return response.RealtimeResponse, nil
+}
+
+// openRealtimeSession selects a key of the provider and runs the session.
+func (bifrost *Bifrost) openRealtimeSession(ctx *schemas.BifrostContext, req *schemas.BifrostRealtimeRequest) (*schemas.BifrostRealtimeResponse, *schemas.BifrostError) {
+	provider := bifrost.getProviderByKey(req.Provider)
+	if provider == nil {
+		return nil, newBifrostErrorFromMsg(fmt.Sprintf("provider %s not found for realtime request", req.Provider))
+	}
+	realtimeProvider, ok := provider.(schemas.RealtimeProvider)
+	if !ok {
+		return nil, newBifrostErrorFromMsg(fmt.Sprintf("realtime is not supported by provider %s", req.Provider))
+	}
+	config, err := bifrost.account.GetConfigForProvider(req.Provider)
+	if err != nil {
+		return nil, newBifrostError(err)
+	}
+
+	baseProvider := req.Provider
+	var customConfig *schemas.CustomProviderConfig
+	if config != nil && config.CustomProviderConfig != nil {
+		customConfig = config.CustomProviderConfig
+		if customConfig.BaseProviderType != "" {
+			baseProvider = customConfig.BaseProviderType
+		}
+	}
+	key := schemas.Key{}
+	if providerRequiresKey(baseProvider, customConfig) {
+		key, err = bifrost.selectKeyFromProviderForModel(ctx, schemas.RealtimeRequest, req.Provider, req.Model, baseProvider)
+		if err != nil {
+			return nil, newBifrostError(err)
+		}
+		ctx.SetValue(schemas.BifrostContextKeySelectedKeyID, key.ID)
+		ctx.SetValue(schemas.BifrostContextKeySelectedKeyName, key.Name)
+	}
+	ctx.SetValue(schemas.BifrostContextKeyRequestType, schemas.RealtimeRequest)
+
+	start := time.Now()
+	result, bifrostErr := realtimeProvider.Realtime(ctx, key, req)
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	if result == nil {
+		return nil, nil
+	}
+	result.ExtraFields.RequestType = schemas.RealtimeRequest
+	result.ExtraFields.Provider = req.Provider
+	result.ExtraFields.ModelRequested = req.Model
+	result.ExtraFields.Latency = time.Since(start).Milliseconds()
+	return result, nil
+}
+
+// realtimeError sets the extra fields of an error of a realtime session.
+func realtimeError(bifrostErr *schemas.BifrostError, req *schemas.BifrostRealtimeRequest) *schemas.BifrostError {
+	if bifrostErr == nil {
+		return nil
+	}
+	bifrostErr.ExtraFields.RequestType = schemas.RealtimeRequest
+	bifrostErr.ExtraFields.Provider = req.Provider
+	bifrostErr.ExtraFields.ModelRequested = req.Model
+	return bifrostErr
+}
@@ -0,0 +1,167 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// fakeRealtimeConn is one end of an in-memory realtime connection; closing either end closes both.
+type fakeRealtimeConn struct {
+	in, out chan []byte
+	done    chan struct{}
+	once    *sync.Once
+}
+
+func newRealtimePipe() (*fakeRealtimeConn, *fakeRealtimeConn) {
+	forward, backward := make(chan []byte, 8), make(chan []byte, 8)
+	done, once := make(chan struct{}), &sync.Once{}
+	return &fakeRealtimeConn{in: backward, out: forward, done: done, once: once},
+		&fakeRealtimeConn{in: forward, out: backward, done: done, once: once}
+}
+
+func (c *fakeRealtimeConn) ReadMessage() (int, []byte, error) {
+	select {
+	case data := <-c.in:
+		return schemas.RealtimeTextMessage, data, nil
+	case <-c.done:
+		return 0, nil, io.EOF
+	}
+}
+
+func (c *fakeRealtimeConn) WriteMessage(_ int, data []byte) error {
+	select {
+	case c.out <- data:
+		return nil
+	case <-c.done:
+		return io.EOF
+	}
+}
+
+func (c *fakeRealtimeConn) Close() error {
+	c.once.Do(func() { close(c.done) })
+	return nil
+}
+
+func (c *fakeRealtimeConn) read(t *testing.T) map[string]any {
+	t.Helper()
+	_, data, err := c.ReadMessage()
+	if err != nil {
+		t.Fatalf("ReadMessage() error = %v", err)
+	}
+	var event map[string]any
+	if err := json.Unmarshal(data, &event); err != nil {
+		t.Fatalf("unmarshal event: %v", err)
+	}
+	return event
+}
+
+func TestRealtimeRequest_RelaysEventsAndSumsUsage(t *testing.T) {
+	account := NewMockAccount()
+	account.AddProviderWithBaseURL(schemas.OpenAI, 2, 10, "https://api.openai.test")
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	bifrost, err := Init(ctx, schemas.BifrostConfig{Account: account, Logger: NewDefaultLogger(schemas.LogLevelError)})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(bifrost.Shutdown)
+
+	client, clientPeer := newRealtimePipe()
+	upstream, upstreamPeer := newRealtimePipe()
+	var dialedURL string
+	var dialedHeaders map[string]string
+	dial := func(_ context.Context, url string, headers map[string]string) (schemas.RealtimeConn, error) {
+		dialedURL, dialedHeaders = url, headers
+		return upstream, nil
+	}
+
+	type result struct {
+		response   *schemas.BifrostRealtimeResponse
+		bifrostErr *schemas.BifrostError
+	}
+	done := make(chan result, 1)
+	go func() {
+		response, bifrostErr := bifrost.RealtimeRequest(schemas.NewBifrostContext(context.Background(), schemas.NoDeadline), &schemas.BifrostRealtimeRequest{
+			Provider: schemas.OpenAI,
+			Model:    "gpt-4o-realtime-preview",
+			Headers:  map[string]string{"OpenAI-Beta": "realtime=v1"},
+			Client:   client,
+			Dial:     dial,
+		})
+		done <- result{response, bifrostErr}
+	}()
+
+	upstreamPeer.WriteMessage(schemas.RealtimeTextMessage, []byte(`{"type":"session.created","session":{"id":"sess_1","model":"gpt-4o-realtime-preview"}}`))
+	if event := clientPeer.read(t); event["type"] != "session.created" {
+		t.Fatalf("client got %v, want session.created", event)
+	}
+
+	clientPeer.WriteMessage(schemas.RealtimeTextMessage, []byte(`{"type":"session.update","session":{"model":"openai/gpt-4o-realtime-preview","voice":"alloy"}}`))
+	session := upstreamPeer.read(t)["session"].(map[string]any)
+	if session["model"] != "gpt-4o-realtime-preview" || session["voice"] != "alloy" {
+		t.Errorf("provider got session %v, want the model without provider prefix", session)
+	}
+
+	responseDone := `{"type":"response.done","response":{"id":"resp_1","usage":{"total_tokens":150,"input_tokens":100,"output_tokens":50,"input_token_details":{"text_tokens":20,"audio_tokens":80,"cached_tokens":10},"output_token_details":{"text_tokens":10,"audio_tokens":40}}}}`
+	for range 2 {
+		upstreamPeer.WriteMessage(schemas.RealtimeTextMessage, []byte(responseDone))
+		clientPeer.read(t)
+	}
+	clientPeer.Close()
+
+	r := <-done
+	if r.bifrostErr != nil {
+		t.Fatalf("RealtimeRequest() error = %v", r.bifrostErr.Error.Message)
+	}
+	if dialedURL != "wss://api.openai.test/v1/realtime?model=gpt-4o-realtime-preview" {
+		t.Errorf("dialed %q", dialedURL)
+	}
+	if dialedHeaders["Authorization"] != "Bearer sk-test-openai" || dialedHeaders["OpenAI-Beta"] != "realtime=v1" {
+		t.Errorf("dialed with headers %v, want the provider key and the client headers", dialedHeaders)
+	}
+	response := r.response
+	if response.SessionID != "sess_1" || response.Responses != 2 || response.ExtraFields.RequestType != schemas.RealtimeRequest || response.ExtraFields.Provider != schemas.OpenAI {
+		t.Errorf("response = %+v", response)
+	}
+	usage := response.Usage
+	if usage == nil || usage.PromptTokens != 200 || usage.CompletionTokens != 100 || usage.TotalTokens != 300 ||
+		usage.PromptTokensDetails.AudioTokens != 160 || usage.PromptTokensDetails.CachedReadTokens != 20 ||
+		usage.CompletionTokensDetails.AudioTokens != 80 || usage.CompletionTokensDetails.TextTokens != 20 {
+		t.Errorf("usage = %+v, want the sum of both responses", usage)
+	}
+}
+
+func TestRealtimeRequest_Errors(t *testing.T) {
+	account := NewMockAccount()
+	account.AddProvider(schemas.OpenAI, 2, 10)
+	account.AddProvider(schemas.Anthropic, 2, 10)
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	bifrost, err := Init(ctx, schemas.BifrostConfig{Account: account, Logger: NewDefaultLogger(schemas.LogLevelError)})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	t.Cleanup(bifrost.Shutdown)
+
+	client, _ := newRealtimePipe()
+	dial := func(context.Context, string, map[string]string) (schemas.RealtimeConn, error) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	for name, req := range map[string]*schemas.BifrostRealtimeRequest{
+		"missing dialer":   {Provider: schemas.OpenAI, Model: "gpt-4o-realtime-preview", Client: client},
+		"unsupported":      {Provider: schemas.Anthropic, Model: "claude-sonnet-4-5", Client: client, Dial: dial},
+		"connection error": {Provider: schemas.OpenAI, Model: "gpt-4o-realtime-preview", Client: client, Dial: dial},
+	} {
+		response, bifrostErr := bifrost.RealtimeRequest(schemas.NewBifrostContext(context.Background(), schemas.NoDeadline), req)
+		if response != nil || bifrostErr == nil {
+			t.Errorf("%s: RealtimeRequest() = %+v, want an error", name, response)
+			continue
+		}
+		if bifrostErr.ExtraFields.RequestType != schemas.RealtimeRequest {
+			t.Errorf("%s: error request type = %q", name, bifrostErr.ExtraFields.RequestType)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package bifrost
+
+import (
+	"slices"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// applyProviderOverride pins the request to the provider set in the context, keeping its model.
+// It runs before model alias routing, so a pinned request is never routed to another provider.
+func (bifrost *Bifrost) applyProviderOverride(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) {
+	if ctx == nil || req == nil {
+		return
+	}
+	provider, ok := ctx.Value(schemas.BifrostContextKeyProviderOverride).(schemas.ModelProvider)
+	if !ok || provider == "" {
+		return
+	}
+	if current, _, _ := req.GetRequestFields(); current != provider {
+		bifrost.logger.Debug("pinning request to provider %s (requested %s)", provider, current)
+		req.SetProvider(provider)
+	}
+}
+
+// applyFallbacksOverride replaces the fallbacks of the request with the ones set in the context.
+// An empty list in the context disables fallbacks, including the ones added by model alias routing.
+func (bifrost *Bifrost) applyFallbacksOverride(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) {
+	if ctx == nil || req == nil {
+		return
+	}
+	fallbacks, ok := ctx.Value(schemas.BifrostContextKeyFallbacksOverride).([]schemas.Fallback)
+	if !ok {
+		return
+	}
+	req.SetFallbacks(slices.Clone(fallbacks))
+}
@@ -0,0 +1,94 @@
+package bifrost
+
+import (
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestProviderAndFallbacksOverride(t *testing.T) {
+	bifrost := &Bifrost{account: NewMockAccount(), logger: NewDefaultLogger(schemas.LogLevelError)}
+	primary := schemas.Fallback{Provider: schemas.OpenAI, Model: "gpt-4o"}
+	candidate := schemas.Fallback{Provider: schemas.Anthropic, Model: "claude-sonnet-4"}
+	if err := bifrost.UpdateTrafficSplits([]schemas.TrafficSplit{{Name: "chat", Primary: primary, Candidate: candidate, CandidatePercent: 100}}); err != nil {
+		t.Fatalf("UpdateTrafficSplits() error = %v", err)
+	}
+	route := func(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) {
+		bifrost.applyProviderOverride(ctx, req)
+		bifrost.routeModelAlias(ctx, req)
+		bifrost.applyFallbacksOverride(ctx, req)
+	}
+
+	// Without overrides the alias is routed to the candidate with the primary as fallback
+	req := &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{Model: "chat"}}
+	route(schemas.NewBifrostContext(nil, schemas.NoDeadline), req)
+	if provider, model, fallbacks := req.GetRequestFields(); provider != candidate.Provider || model != candidate.Model || len(fallbacks) != 1 {
+		t.Fatalf("routed to %s/%s with fallbacks %+v", provider, model, fallbacks)
+	}
+
+	// A pinned provider skips alias routing and keeps the model
+	ctx := schemas.NewBifrostContext(nil, schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyProviderOverride, schemas.Gemini)
+	req = &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{Model: "chat"}}
+	route(ctx, req)
+	if provider, model, fallbacks := req.GetRequestFields(); provider != schemas.Gemini || model != "chat" || len(fallbacks) != 0 {
+		t.Errorf("pinned request routed to %s/%s with fallbacks %+v", provider, model, fallbacks)
+	}
+
+	// Overridden fallbacks replace the ones of the request and of alias routing
+	override := []schemas.Fallback{{Provider: schemas.Mistral, Model: "mistral-large"}}
+	ctx = schemas.NewBifrostContext(nil, schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyFallbacksOverride, override)
+	req = &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{Model: "chat"}}
+	route(ctx, req)
+	if _, _, fallbacks := req.GetRequestFields(); len(fallbacks) != 1 || fallbacks[0] != override[0] {
+		t.Errorf("fallbacks = %+v, want %+v", fallbacks, override)
+	}
+
+	// An empty override disables fallbacks
+	ctx = schemas.NewBifrostContext(nil, schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyFallbacksOverride, []schemas.Fallback{})
+	req = &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{Provider: schemas.OpenAI, Model: "gpt-4o", Fallbacks: []schemas.Fallback{candidate}}}
+	route(ctx, req)
+	if _, _, fallbacks := req.GetRequestFields(); len(fallbacks) != 0 {
+		t.Errorf("fallbacks = %+v, want none", fallbacks)
+	}
+}
+
+func TestSelectPinnedKeyID(t *testing.T) {
+	account := NewMockAccount()
+	account.AddProvider(schemas.OpenAI, 1, 10)
+	account.keys[schemas.OpenAI] = []schemas.Key{
+		{ID: "key-a", Name: "a", Value: *schemas.NewEnvVar("sk-a"), Weight: 1},
+		{ID: "key-b", Name: "b", Value: *schemas.NewEnvVar("sk-b"), Weight: 1},
+		{ID: "key-c", Name: "c", Value: *schemas.NewEnvVar("sk-c"), Weight: 1, Models: []string{"gpt-4o-mini"}},
+	}
+	bifrost := &Bifrost{account: account, logger: NewDefaultLogger(schemas.LogLevelError), keySelector: WeightedRandomKeySelector}
+
+	ctx := schemas.NewBifrostContext(nil, schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyAPIKeyID, "key-b")
+	for range 20 {
+		key, err := bifrost.selectKeyFromProviderForModel(ctx, schemas.ChatCompletionRequest, schemas.OpenAI, "gpt-4o", schemas.OpenAI)
+		if err != nil {
+			t.Fatalf("selectKeyFromProviderForModel() error = %v", err)
+		}
+		if key.ID != "key-b" {
+			t.Fatalf("selected key %s, want key-b", key.ID)
+		}
+	}
+
+	// The ID takes precedence over a key name
+	ctx.SetValue(schemas.BifrostContextKeyAPIKeyName, "a")
+	if key, err := bifrost.selectKeyFromProviderForModel(ctx, schemas.ChatCompletionRequest, schemas.OpenAI, "gpt-4o", schemas.OpenAI); err != nil || key.ID != "key-b" {
+		t.Errorf("selected key %s (error %v), want key-b", key.ID, err)
+	}
+
+	// A key that does not support the model, or is unknown, cannot be pinned
+	for _, id := range []string{"key-c", "key-unknown"} {
+		ctx := schemas.NewBifrostContext(nil, schemas.NoDeadline)
+		ctx.SetValue(schemas.BifrostContextKeyAPIKeyID, id)
+		if _, err := bifrost.selectKeyFromProviderForModel(ctx, schemas.ChatCompletionRequest, schemas.OpenAI, "gpt-4o", schemas.OpenAI); err == nil {
+			t.Errorf("expected pinning %s to fail", id)
+		}
+	}
+}
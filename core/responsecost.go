@@ -0,0 +1,17 @@
+package bifrost
+
+import (
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// attachResponseCost prices a provider response with the configured cost calculator and stores the
+// cost in its extra fields, before plugins run so they see it too. Stream chunks without usage are
+// left unpriced.
+func (bifrost *Bifrost) attachResponseCost(result *schemas.BifrostResponse) {
+	if bifrost.costCalculator == nil || result == nil {
+		return
+	}
+	if cost := bifrost.costCalculator(result); cost != nil {
+		result.GetExtraFields().CostUSD = cost
+	}
+}
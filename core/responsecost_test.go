@@ -0,0 +1,34 @@
+package bifrost
+
+import (
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestAttachResponseCost(t *testing.T) {
+	bifrost := &Bifrost{}
+	result := &schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{}}
+
+	// Without a calculator responses carry no cost
+	bifrost.attachResponseCost(result)
+	if result.ChatResponse.ExtraFields.CostUSD != nil {
+		t.Fatalf("cost = %+v, want none", result.ChatResponse.ExtraFields.CostUSD)
+	}
+
+	bifrost.costCalculator = func(result *schemas.BifrostResponse) *schemas.BifrostResponseCost {
+		if result.ChatResponse.Usage == nil {
+			return nil
+		}
+		return &schemas.BifrostResponseCost{Total: float64(result.ChatResponse.Usage.TotalTokens)}
+	}
+	bifrost.attachResponseCost(result)
+	if result.ChatResponse.ExtraFields.CostUSD != nil {
+		t.Errorf("chunk without usage priced at %+v", result.ChatResponse.ExtraFields.CostUSD)
+	}
+	result.ChatResponse.Usage = &schemas.BifrostLLMUsage{TotalTokens: 42}
+	bifrost.attachResponseCost(result)
+	if cost := result.ChatResponse.ExtraFields.CostUSD; cost == nil || cost.Total != 42 {
+		t.Errorf("cost = %+v, want a total of 42", cost)
+	}
+}
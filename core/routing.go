@@ -0,0 +1,56 @@
+package bifrost
+
+import (
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// SupportsOperation reports whether a provider can serve the request type according to the
+// capability registry. Custom providers are checked against their base provider, and token
+// counts are always supported since Bifrost estimates them locally when the provider cannot.
+func (bifrost *Bifrost) SupportsOperation(provider schemas.ModelProvider, requestType schemas.RequestType) bool {
+	if requestType == schemas.CountTokensRequest {
+		return true
+	}
+	capabilityProvider := provider
+	if bifrost.account != nil {
+		if config, err := bifrost.account.GetConfigForProvider(provider); err == nil && config != nil &&
+			config.CustomProviderConfig != nil && config.CustomProviderConfig.BaseProviderType != "" {
+			capabilityProvider = config.CustomProviderConfig.BaseProviderType
+		}
+	}
+	return schemas.ProviderSupportsRequestType(capabilityProvider, requestType)
+}
+
+// RouteByOperation returns the candidates, in order, whose provider supports the request type.
+// Bifrost uses it to skip fallbacks that would fail with an unsupported operation error, e.g. an
+// embedding request with Qwen as the primary provider falls through to an OpenAI fallback.
+func (bifrost *Bifrost) RouteByOperation(requestType schemas.RequestType, candidates []schemas.Fallback) []schemas.Fallback {
+	routed := make([]schemas.Fallback, 0, len(candidates))
+	for _, candidate := range candidates {
+		if !bifrost.SupportsOperation(candidate.Provider, requestType) {
+			bifrost.logger.Debug("provider %s does not support %s, skipping %s/%s", candidate.Provider, requestType, candidate.Provider, candidate.Model)
+			continue
+		}
+		routed = append(routed, candidate)
+	}
+	return routed
+}
+
+// routeRequest applies RouteByOperation to a request's fallbacks. It reports whether the primary
+// provider should be tried: a primary provider without the capability is skipped when a fallback
+// can serve the request, with the unsupported operation error kept as the primary error. A primary
+// provider and model with an open circuit is skipped as well, so a failing upstream costs no
+// latency; without fallbacks the circuit error is returned right away.
+func (bifrost *Bifrost) routeRequest(requestType schemas.RequestType, provider schemas.ModelProvider, model string, fallbacks []schemas.Fallback) (bool, []schemas.Fallback, *schemas.BifrostError) {
+	routed := bifrost.RouteByOperation(requestType, fallbacks)
+	if len(routed) > 0 && !bifrost.SupportsOperation(provider, requestType) {
+		bifrost.logger.Debug("primary provider %s does not support %s, routing to %d fallbacks", provider, requestType, len(routed))
+		return false, routed, providerUtils.NewUnsupportedOperationError(requestType, provider)
+	}
+	if circuitErr := bifrost.checkCircuit(provider, model); circuitErr != nil {
+		bifrost.logger.Debug("circuit open for primary provider %s and model %s, routing to %d fallbacks", provider, model, len(routed))
+		return false, routed, circuitErr
+	}
+	return true, routed, nil
+}
@@ -0,0 +1,108 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestRouteByOperation(t *testing.T) {
+	account := NewMockAccount()
+	account.AddProvider(schemas.OpenAI, 1, 10)
+	account.configs["my-tts"] = &schemas.ProviderConfig{CustomProviderConfig: &schemas.CustomProviderConfig{BaseProviderType: schemas.Elevenlabs}}
+	bifrost := &Bifrost{account: account, logger: NewDefaultLogger(schemas.LogLevelError)}
+
+	candidates := []schemas.Fallback{
+		{Provider: schemas.Qwen, Model: "qwen-plus"},
+		{Provider: "my-tts", Model: "eleven_v3"},
+		{Provider: schemas.OpenAI, Model: "text-embedding-3-small"},
+	}
+	routed := bifrost.RouteByOperation(schemas.EmbeddingRequest, candidates)
+	if len(routed) != 1 || routed[0].Provider != schemas.OpenAI {
+		t.Errorf("embedding route = %+v, want only openai", routed)
+	}
+	if routed := bifrost.RouteByOperation(schemas.SpeechRequest, candidates); len(routed) != 2 || routed[0].Provider != "my-tts" {
+		t.Errorf("speech route = %+v, want the custom elevenlabs provider and openai", routed)
+	}
+	// Token counts are estimated locally for every provider
+	if routed := bifrost.RouteByOperation(schemas.CountTokensRequest, candidates); len(routed) != len(candidates) {
+		t.Errorf("count tokens route = %+v, want all candidates", routed)
+	}
+
+	tryPrimary, fallbacks, primaryErr := bifrost.routeRequest(schemas.EmbeddingRequest, schemas.Qwen, "qwen-plus", candidates)
+	if tryPrimary || len(fallbacks) != 1 || primaryErr == nil || *primaryErr.Error.Code != "unsupported_operation" {
+		t.Errorf("routeRequest() = %v, %+v, %+v", tryPrimary, fallbacks, primaryErr)
+	}
+	// Without a capable fallback the primary provider returns its own error
+	if tryPrimary, _, _ := bifrost.routeRequest(schemas.EmbeddingRequest, schemas.Qwen, "qwen-plus", candidates[:2]); !tryPrimary {
+		t.Error("expected the primary provider to be tried when no fallback supports the request")
+	}
+}
+
+// TestRouteRequestSkipsUnsupportedFallbacks checks that fallbacks without the capability are
+// dropped while a capable primary provider is still tried first.
+func TestRouteRequestSkipsUnsupportedFallbacks(t *testing.T) {
+	account := NewMockAccount()
+	account.AddProvider(schemas.OpenAI, 1, 10)
+	account.AddProvider(schemas.Elevenlabs, 1, 10)
+	account.configs["my-tts"] = &schemas.ProviderConfig{CustomProviderConfig: &schemas.CustomProviderConfig{BaseProviderType: schemas.Elevenlabs}}
+	bifrost := &Bifrost{account: account, logger: NewDefaultLogger(schemas.LogLevelError)}
+
+	fallbacks := []schemas.Fallback{
+		{Provider: schemas.Elevenlabs, Model: "eleven_v3"},
+		{Provider: "my-tts", Model: "eleven_v3"},
+		{Provider: schemas.OpenAI, Model: "gpt-4o"},
+	}
+	for _, requestType := range []schemas.RequestType{schemas.ChatCompletionRequest, schemas.ChatCompletionStreamRequest} {
+		tryPrimary, routed, primaryErr := bifrost.routeRequest(requestType, schemas.Anthropic, "claude-sonnet-4-5", fallbacks)
+		if !tryPrimary || primaryErr != nil {
+			t.Errorf("%s: expected the anthropic primary to be tried, got %v, %+v", requestType, tryPrimary, primaryErr)
+		}
+		if len(routed) != 1 || routed[0].Provider != schemas.OpenAI {
+			t.Errorf("%s: fallbacks = %+v, want only openai", requestType, routed)
+		}
+	}
+	// No capable fallback leaves nothing to fall back to
+	if _, routed, _ := bifrost.routeRequest(schemas.ChatCompletionRequest, schemas.Anthropic, "claude-sonnet-4-5", fallbacks[:2]); len(routed) != 0 {
+		t.Errorf("fallbacks = %+v, want none", routed)
+	}
+}
+
+func TestEmbeddingFallsThroughUnsupportedPrimary(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"object":"list","model":"text-embedding-3-small","data":[{"object":"embedding","index":0,"embedding":[0.1,0.2]}],"usage":{"prompt_tokens":1,"total_tokens":1}}`)
+	}))
+	defer server.Close()
+
+	account := NewMockAccount()
+	account.AddProvider(schemas.Qwen, 1, 10)
+	account.AddProviderWithBaseURL(schemas.OpenAI, 1, 10, server.URL)
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	bifrost, err := Init(ctx, schemas.BifrostConfig{Account: account, Logger: NewDefaultLogger(schemas.LogLevelError)})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	defer bifrost.Shutdown()
+
+	text := "hello"
+	response, bifrostErr := bifrost.EmbeddingRequest(schemas.NewBifrostContext(context.Background(), schemas.NoDeadline), &schemas.BifrostEmbeddingRequest{
+		Provider:  schemas.Qwen,
+		Model:     "text-embedding-v4",
+		Input:     &schemas.EmbeddingInput{Text: &text},
+		Fallbacks: []schemas.Fallback{{Provider: schemas.OpenAI, Model: "text-embedding-3-small"}},
+	})
+	if bifrostErr != nil {
+		t.Fatalf("EmbeddingRequest() error = %+v", bifrostErr.Error)
+	}
+	if response.ExtraFields.Provider != schemas.OpenAI || calls.Load() != 1 {
+		t.Errorf("expected one call to the openai fallback, got provider %s and %d calls", response.ExtraFields.Provider, calls.Load())
+	}
+}
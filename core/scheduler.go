@@ -0,0 +1,198 @@
+package bifrost
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// schedulerPriorities are the request priorities in the order queued requests are admitted.
+var schedulerPriorities = [...]schemas.RequestPriority{
+	schemas.RequestPriorityInteractive,
+	schemas.RequestPriorityNormal,
+	schemas.RequestPriorityBackground,
+}
+
+// schedulerWaiter is a request waiting for an in-flight slot.
+type schedulerWaiter struct {
+	ready   chan struct{} // closed when the waiter is granted a slot
+	granted bool          // guarded by the scheduler's mutex
+}
+
+// providerScheduler limits the requests a provider has in flight and queues the excess, admitting
+// queued requests by priority and in arrival order within a priority.
+type providerScheduler struct {
+	config schemas.SchedulerConfig
+
+	mu       sync.Mutex
+	inFlight int
+	queued   int
+	waiting  [len(schedulerPriorities)]list.List // *schedulerWaiter per priority, highest first
+}
+
+// newProviderScheduler returns a scheduler for the config, or nil if the config is nil.
+func newProviderScheduler(config *schemas.SchedulerConfig) *providerScheduler {
+	if config == nil {
+		return nil
+	}
+	return &providerScheduler{config: *config}
+}
+
+// schedulerPriorityIndex returns the index of a priority in schedulerPriorities.
+func schedulerPriorityIndex(priority schemas.RequestPriority) int {
+	for i, p := range schedulerPriorities {
+		if p == priority {
+			return i
+		}
+	}
+	return 1
+}
+
+// acquire waits for an in-flight slot. It returns the func releasing the slot, or an error
+// with a code of "queue_full" or "queue_timeout", or the context's error.
+func (s *providerScheduler) acquire(ctx *schemas.BifrostContext, priority schemas.RequestPriority) (func(), string, error) {
+	s.mu.Lock()
+	if s.inFlight < s.config.MaxInFlight && s.queued == 0 {
+		s.inFlight++
+		s.mu.Unlock()
+		return s.releaseOnce(), "", nil
+	}
+	if s.queued >= s.config.GetMaxQueueDepth() {
+		s.mu.Unlock()
+		return nil, "queue_full", fmt.Errorf("%d requests are already queued", s.queued)
+	}
+	waiter := &schedulerWaiter{ready: make(chan struct{})}
+	queue := &s.waiting[schedulerPriorityIndex(priority)]
+	elem := queue.PushBack(waiter)
+	s.queued++
+	s.mu.Unlock()
+
+	timeout := s.config.GetQueueTimeout()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-waiter.ready:
+		return s.releaseOnce(), "", nil
+	case <-timer.C:
+		if s.cancelWait(queue, elem, waiter) {
+			return s.releaseOnce(), "", nil
+		}
+		return nil, "queue_timeout", fmt.Errorf("no slot became free within %s", timeout)
+	case <-ctx.Done():
+		if s.cancelWait(queue, elem, waiter) {
+			s.release()
+		}
+		return nil, "", ctx.Err()
+	}
+}
+
+// cancelWait removes a waiter from its queue. It returns true if the waiter was granted a slot
+// before it could be removed, in which case the caller owns the slot.
+func (s *providerScheduler) cancelWait(queue *list.List, elem *list.Element, waiter *schedulerWaiter) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if waiter.granted {
+		return true
+	}
+	queue.Remove(elem)
+	s.queued--
+	return false
+}
+
+// release frees an in-flight slot, handing it to the highest priority waiter if there is one.
+func (s *providerScheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.waiting {
+		if elem := s.waiting[i].Front(); elem != nil {
+			waiter := s.waiting[i].Remove(elem).(*schedulerWaiter)
+			s.queued--
+			waiter.granted = true
+			close(waiter.ready)
+			return
+		}
+	}
+	s.inFlight--
+}
+
+// releaseOnce returns a func releasing a slot that is safe to call more than once.
+func (s *providerScheduler) releaseOnce() func() {
+	var once sync.Once
+	return func() { once.Do(s.release) }
+}
+
+// stats returns the number of requests in flight and waiting.
+func (s *providerScheduler) stats() (inFlight, queued int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.inFlight, s.queued
+}
+
+// scheduleRequest waits for an in-flight slot on the provider's scheduler. It returns the func
+// releasing the slot (a no-op when the provider has no scheduler), or a 503 error when the
+// request could not get a slot.
+func (bifrost *Bifrost) scheduleRequest(ctx *schemas.BifrostContext, pq *ProviderQueue, req *schemas.BifrostRequest) (func(), *schemas.BifrostError) {
+	if pq.scheduler == nil {
+		return func() {}, nil
+	}
+	priority := requestPriority(ctx)
+	release, code, err := pq.scheduler.acquire(ctx, priority)
+	if err == nil {
+		return release, nil
+	}
+	provider, model, _ := req.GetRequestFields()
+	extraFields := schemas.BifrostErrorExtraFields{
+		RequestType:    req.RequestType,
+		Provider:       provider,
+		ModelRequested: model,
+	}
+	if code == "" {
+		return nil, &schemas.BifrostError{
+			IsBifrostError: true,
+			Error: &schemas.ErrorField{
+				Type:    schemas.Ptr(schemas.RequestCancelled),
+				Message: fmt.Sprintf("request cancelled while waiting for a provider slot: %v", err),
+				Error:   err,
+			},
+			ExtraFields: extraFields,
+		}
+	}
+	inFlight, queued := pq.scheduler.stats()
+	bifrost.logger.Debug("scheduler rejected %s request for provider %s (%s): %d in flight, %d queued", priority, provider, code, inFlight, queued)
+	return nil, &schemas.BifrostError{
+		IsBifrostError: true,
+		StatusCode:     schemas.Ptr(fasthttp.StatusServiceUnavailable),
+		Type:           schemas.Ptr("overloaded_error"),
+		Error: &schemas.ErrorField{
+			Type:    schemas.Ptr("overloaded_error"),
+			Code:    schemas.Ptr(code),
+			Message: fmt.Sprintf("provider %s is at its in-flight limit: %v", provider, err),
+		},
+		ExtraFields: extraFields,
+	}
+}
+
+// releaseWithStream returns a stream forwarding the chunks of stream that releases the slot once
+// stream is closed. If the request is cancelled, the rest of stream is drained so its producer
+// can finish.
+func releaseWithStream(ctx *schemas.BifrostContext, stream chan *schemas.BifrostStreamChunk, release func()) chan *schemas.BifrostStreamChunk {
+	out := make(chan *schemas.BifrostStreamChunk)
+	go func() {
+		defer close(out)
+		defer release()
+		for chunk := range stream {
+			select {
+			case out <- chunk:
+			case <-ctx.Done():
+				for range stream {
+				}
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,128 @@
+package bifrost
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestProviderSchedulerPriority(t *testing.T) {
+	scheduler := newProviderScheduler(&schemas.SchedulerConfig{MaxInFlight: 1})
+	ctx := schemas.NewBifrostContext(context.Background(), time.Now().Add(time.Minute))
+
+	release, _, err := scheduler.acquire(ctx, schemas.RequestPriorityNormal)
+	if err != nil {
+		t.Fatalf("acquire() with a free slot error = %v", err)
+	}
+
+	// Queue a background request, then an interactive one; the interactive one is admitted first
+	admitted := make(chan schemas.RequestPriority, 2)
+	for _, priority := range []schemas.RequestPriority{schemas.RequestPriorityBackground, schemas.RequestPriorityInteractive} {
+		go func() {
+			release, _, err := scheduler.acquire(ctx, priority)
+			if err != nil {
+				t.Errorf("acquire(%s) error = %v", priority, err)
+				return
+			}
+			admitted <- priority
+			release()
+		}()
+		for {
+			if _, queued := scheduler.stats(); queued > 0 && (priority == schemas.RequestPriorityBackground || queued > 1) {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	release()
+	release() // releasing twice must not free a second slot
+	if first := <-admitted; first != schemas.RequestPriorityInteractive {
+		t.Errorf("first admitted = %s, want interactive", first)
+	}
+	if second := <-admitted; second != schemas.RequestPriorityBackground {
+		t.Errorf("second admitted = %s, want background", second)
+	}
+	if inFlight, queued := scheduler.stats(); inFlight != 0 || queued != 0 {
+		t.Errorf("stats = %d in flight, %d queued; want 0 and 0", inFlight, queued)
+	}
+}
+
+func TestScheduleRequestRejections(t *testing.T) {
+	bifrost := &Bifrost{logger: NewDefaultLogger(schemas.LogLevelError)}
+	pq := &ProviderQueue{scheduler: newProviderScheduler(&schemas.SchedulerConfig{MaxInFlight: 1, MaxQueueDepth: 1, QueueTimeoutMs: 20})}
+	req := &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{Provider: schemas.OpenAI, Model: "gpt-4o"}}
+	ctx := schemas.NewBifrostContext(context.Background(), time.Now().Add(time.Minute))
+
+	release, bifrostErr := bifrost.scheduleRequest(ctx, pq, req)
+	if bifrostErr != nil {
+		t.Fatalf("scheduleRequest() with a free slot error = %v", bifrostErr.Error.Message)
+	}
+	defer release()
+
+	// The queued request times out while the slot stays taken
+	waited := make(chan *schemas.BifrostError)
+	go func() {
+		_, bifrostErr := bifrost.scheduleRequest(ctx, pq, req)
+		waited <- bifrostErr
+	}()
+	for {
+		if _, queued := pq.scheduler.stats(); queued == 1 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	// The queue is full
+	_, bifrostErr = bifrost.scheduleRequest(ctx, pq, req)
+	if bifrostErr == nil || *bifrostErr.StatusCode != 503 || *bifrostErr.Error.Code != "queue_full" {
+		t.Errorf("scheduleRequest() with a full queue = %+v, want a 503 queue_full error", bifrostErr)
+	}
+	bifrostErr = <-waited
+	if bifrostErr == nil || *bifrostErr.StatusCode != 503 || *bifrostErr.Error.Code != "queue_timeout" {
+		t.Errorf("scheduleRequest() after the queue timeout = %+v, want a 503 queue_timeout error", bifrostErr)
+	}
+	if bifrostErr.ExtraFields.Provider != schemas.OpenAI {
+		t.Errorf("error provider = %q", bifrostErr.ExtraFields.Provider)
+	}
+
+	// A cancelled request leaves the queue
+	cancelled, cancel := schemas.NewBifrostContextWithCancel(context.Background())
+	cancel()
+	if _, bifrostErr := bifrost.scheduleRequest(cancelled, pq, req); bifrostErr == nil || bifrostErr.StatusCode != nil {
+		t.Errorf("scheduleRequest() with a cancelled context = %+v, want a cancellation error", bifrostErr)
+	}
+	if _, queued := pq.scheduler.stats(); queued != 0 {
+		t.Errorf("queued = %d after cancellation, want 0", queued)
+	}
+
+	// Without a scheduler every request is admitted
+	if _, bifrostErr := bifrost.scheduleRequest(ctx, &ProviderQueue{}, req); bifrostErr != nil {
+		t.Errorf("scheduleRequest() without a scheduler error = %v", bifrostErr.Error.Message)
+	}
+}
+
+func TestReleaseWithStream(t *testing.T) {
+	scheduler := newProviderScheduler(&schemas.SchedulerConfig{MaxInFlight: 1})
+	ctx := schemas.NewBifrostContext(context.Background(), time.Now().Add(time.Minute))
+	release, _, err := scheduler.acquire(ctx, schemas.RequestPriorityNormal)
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+
+	stream := make(chan *schemas.BifrostStreamChunk, 2)
+	stream <- &schemas.BifrostStreamChunk{}
+	stream <- &schemas.BifrostStreamChunk{}
+	out := releaseWithStream(ctx, stream, release)
+	<-out
+	if inFlight, _ := scheduler.stats(); inFlight != 1 {
+		t.Errorf("in flight = %d while streaming, want 1", inFlight)
+	}
+	close(stream)
+	for range out {
+	}
+	if inFlight, _ := scheduler.stats(); inFlight != 0 {
+		t.Errorf("in flight = %d after the stream ended, want 0", inFlight)
+	}
+}
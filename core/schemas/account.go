@@ -24,6 +24,8 @@ type Key struct {
 	HuggingFaceKeyConfig *HuggingFaceKeyConfig `json:"huggingface_key_config,omitempty"` // Hugging Face-specific key configuration
 	ReplicateKeyConfig   *ReplicateKeyConfig   `json:"replicate_key_config,omitempty"`   // Replicate-specific key configuration
 	VLLMKeyConfig        *VLLMKeyConfig        `json:"vllm_key_config,omitempty"`        // vLLM-specific key configuration
+	HunyuanKeyConfig     *HunyuanKeyConfig     `json:"hunyuan_key_config,omitempty"`     // Tencent Hunyuan-specific key configuration
+	GLMKeyConfig         *GLMKeyConfig         `json:"glm_key_config,omitempty"`         // Zhipu GLM-specific key configuration
 	Enabled              *bool                 `json:"enabled,omitempty"`                // Whether the key is active (default:true)
 	UseForBatchAPI       *bool                 `json:"use_for_batch_api,omitempty"`      // Whether this key can be used for batch API operations (default:false for new keys, migrated keys default to true)
 	ConfigHash           string                `json:"config_hash,omitempty"`            // Hash of config.json version, used for change detection
@@ -112,6 +114,36 @@ type VLLMKeyConfig struct {
 	ModelName string `json:"model_name"` // Exact model name served on this VLLM instance (used for key selection)
 }
 
+// HunyuanKeyConfig represents the Tencent Hunyuan-specific key configuration.
+// When SecretID and SecretKey are set, requests are signed with Tencent Cloud's
+// TC3-HMAC-SHA256 scheme instead of sending Value as a bearer token.
+type HunyuanKeyConfig struct {
+	SecretID  EnvVar  `json:"secret_id"`        // Tencent Cloud SecretId used for request signing
+	SecretKey EnvVar  `json:"secret_key"`       // Tencent Cloud SecretKey used for request signing
+	Region    *EnvVar `json:"region,omitempty"` // Tencent Cloud region sent as X-TC-Region (optional)
+}
+
+// NOTE: To use Hunyuan signature authentication, leave Value in Key struct empty and set
+// SecretID and SecretKey in HunyuanKeyConfig.
+
+// GLMKeyConfig represents the Zhipu GLM-specific key configuration.
+type GLMKeyConfig struct {
+	WebSearch *GLMWebSearchConfig `json:"web_search,omitempty"` // Built-in web_search tool added to chat requests (optional)
+}
+
+// GLMWebSearchConfig configures GLM's built-in web_search tool.
+// A "web_search" extra param on a request (true, false, or an object with these fields)
+// overrides this configuration for that request.
+type GLMWebSearchConfig struct {
+	Enabled             bool    `json:"enabled"`                         // Whether the web_search tool is added to chat requests
+	SearchEngine        *string `json:"search_engine,omitempty"`         // e.g. "search_std", "search_pro"
+	Count               *int    `json:"count,omitempty"`                 // Number of results to return (1-50)
+	SearchDomainFilter  *string `json:"search_domain_filter,omitempty"`  // Restrict results to a domain
+	SearchRecencyFilter *string `json:"search_recency_filter,omitempty"` // "oneDay" | "oneWeek" | "oneMonth" | "oneYear" | "noLimit"
+	ContentSize         *string `json:"content_size,omitempty"`          // "medium" | "high"
+	SearchPrompt        *string `json:"search_prompt,omitempty"`         // Custom prompt for summarizing search results
+}
+
 // Account defines the interface for managing provider accounts and their configurations.
 // It provides methods to access provider-specific settings, API keys, and configurations.
 type Account interface {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 )
 
 const (
@@ -23,11 +24,35 @@ type BifrostConfig struct {
 	MCPPlugins         []MCPPlugin
 	OAuth2Provider     OAuth2Provider
 	Logger             Logger
-	Tracer             Tracer      // Tracer for distributed tracing (nil = NoOpTracer)
-	InitialPoolSize    int         // Initial pool size for sync pools in Bifrost. Higher values will reduce memory allocations but will increase memory usage.
-	DropExcessRequests bool        // If true, in cases where the queue is full, requests will not wait for the queue to be empty and will be dropped instead.
-	MCPConfig          *MCPConfig  // MCP (Model Context Protocol) configuration for tool integration
-	KeySelector        KeySelector // Custom key selector function
+	Tracer             Tracer                 // Tracer for distributed tracing (nil = NoOpTracer)
+	InitialPoolSize    int                    // Initial pool size for sync pools in Bifrost. Higher values will reduce memory allocations but will increase memory usage.
+	DropExcessRequests bool                   // If true, in cases where the queue is full, requests will not wait for the queue to be empty and will be dropped instead.
+	MCPConfig          *MCPConfig             // MCP (Model Context Protocol) configuration for tool integration
+	KeySelector        KeySelector            // Custom key selector function
+	ParameterPresets   []ParameterPreset      // Named parameter presets, in addition to the built-in ones
+	LoadShedding       *LoadSheddingConfig    // Early rejection of low priority requests under overload (nil = disabled)
+	CircuitBreaker     *CircuitBreakerConfig  // Per provider and model circuit breakers (nil = disabled)
+	LatencyRouting     *LatencyRoutingConfig  // Model aliases routed to their fastest healthy target (nil = no aliases)
+	CostRouting        *CostRoutingConfig     // Model aliases routed to their cheapest target (nil = no aliases)
+	ModelPricing       ModelPricing           // Per-token prices cost-routed aliases are ranked by (nil = targets keep their config order)
+	CostCalculator     ResponseCostCalculator // Prices every response into its extra fields (nil = responses carry no cost)
+	VirtualModels      []VirtualModel         // Model names mapped to prioritized provider and model targets
+	TrafficSplits      []TrafficSplit         // Logical models sending a percentage of their traffic to a candidate model
+	SingleFlight       *SingleFlightConfig    // Deduplication of identical in-flight requests (nil = disabled)
+	SchemaDrift        *SchemaDriftConfig     // Checks of provider responses against their expected shapes (nil = disabled)
+	ToolExecution      *ToolExecutionConfig   // Tools executed by Bifrost for requests that opt in (nil = none)
+	StreamUsage        *StreamUsageConfig     // Estimated usage for streams that end without it (nil = disabled)
+	HeaderPolicy       *HeaderPolicyConfig    // Headers forwarded, stripped and injected on provider requests (nil = x-bf-eh-* headers only)
+	// PluginHookTimeout bounds every LLM and MCP plugin hook call, plugins can override it with HookTimeout (0 = no timeout)
+	PluginHookTimeout time.Duration
+	// SchemaDriftObserver is called for every schema drift found in a provider response, e.g. to record a metric
+	SchemaDriftObserver func(SchemaDrift)
+	// CircuitBreakerObserver is called whenever a circuit opens or closes, e.g. to send a notification
+	CircuitBreakerObserver func(CircuitBreakerEvent)
+	// BatchObserver is called with every batch retrieved, e.g. to send a notification once it finishes
+	BatchObserver func(*BifrostBatchRetrieveResponse)
+	// BatchCreateObserver is called with every batch created, e.g. to watch it until it finishes
+	BatchCreateObserver func(*BifrostBatchCreateResponse)
 }
 
 // ModelProvider represents the different AI model providers supported by Bifrost.
@@ -63,6 +88,10 @@ const (
 	Moonshot    ModelProvider = "moonshot"
 	ModelArk    ModelProvider = "modelark"
 	Volcengine  ModelProvider = "volcengine"
+	Hunyuan     ModelProvider = "hunyuan"
+	StepFun     ModelProvider = "stepfun"
+	Yi          ModelProvider = "yi"
+	Spark       ModelProvider = "spark"
 )
 
 // SupportedBaseProviders is the list of base providers allowed for custom providers.
@@ -87,6 +116,7 @@ var StandardProviders = []ModelProvider{
 	Gemini,
 	GLM,
 	Groq,
+	Hunyuan,
 	Mistral,
 	Minimax,
 	Moonshot,
@@ -97,6 +127,7 @@ var StandardProviders = []ModelProvider{
 	Perplexity,
 	Qwen,
 	SGL,
+	StepFun,
 	Vertex,
 	ModelArk,
 	Volcengine,
@@ -108,58 +139,76 @@ var StandardProviders = []ModelProvider{
 	Replicate,
 	VLLM,
 	Runway,
+	Yi,
+	Spark,
 }
 
 // RequestType represents the type of request being made to a provider.
 type RequestType string
 
 const (
-	ListModelsRequest            RequestType = "list_models"
-	TextCompletionRequest        RequestType = "text_completion"
-	TextCompletionStreamRequest  RequestType = "text_completion_stream"
-	ChatCompletionRequest        RequestType = "chat_completion"
-	ChatCompletionStreamRequest  RequestType = "chat_completion_stream"
-	ResponsesRequest             RequestType = "responses"
-	ResponsesStreamRequest       RequestType = "responses_stream"
-	EmbeddingRequest             RequestType = "embedding"
-	SpeechRequest                RequestType = "speech"
-	SpeechStreamRequest          RequestType = "speech_stream"
-	TranscriptionRequest         RequestType = "transcription"
-	TranscriptionStreamRequest   RequestType = "transcription_stream"
-	ImageGenerationRequest       RequestType = "image_generation"
-	ImageGenerationStreamRequest RequestType = "image_generation_stream"
-	ImageEditRequest             RequestType = "image_edit"
-	ImageEditStreamRequest       RequestType = "image_edit_stream"
-	ImageVariationRequest        RequestType = "image_variation"
-	VideoGenerationRequest       RequestType = "video_generation"
-	VideoRetrieveRequest         RequestType = "video_retrieve"
-	VideoDownloadRequest         RequestType = "video_download"
-	VideoDeleteRequest           RequestType = "video_delete"
-	VideoListRequest             RequestType = "video_list"
-	VideoRemixRequest            RequestType = "video_remix"
-	BatchCreateRequest           RequestType = "batch_create"
-	BatchListRequest             RequestType = "batch_list"
-	BatchRetrieveRequest         RequestType = "batch_retrieve"
-	BatchCancelRequest           RequestType = "batch_cancel"
-	BatchResultsRequest          RequestType = "batch_results"
-	FileUploadRequest            RequestType = "file_upload"
-	FileListRequest              RequestType = "file_list"
-	FileRetrieveRequest          RequestType = "file_retrieve"
-	FileDeleteRequest            RequestType = "file_delete"
-	FileContentRequest           RequestType = "file_content"
-	ContainerCreateRequest       RequestType = "container_create"
-	ContainerListRequest         RequestType = "container_list"
-	ContainerRetrieveRequest     RequestType = "container_retrieve"
-	ContainerDeleteRequest       RequestType = "container_delete"
-	ContainerFileCreateRequest   RequestType = "container_file_create"
-	ContainerFileListRequest     RequestType = "container_file_list"
-	ContainerFileRetrieveRequest RequestType = "container_file_retrieve"
-	ContainerFileContentRequest  RequestType = "container_file_content"
-	ContainerFileDeleteRequest   RequestType = "container_file_delete"
-	RerankRequest                RequestType = "rerank"
-	CountTokensRequest           RequestType = "count_tokens"
-	MCPToolExecutionRequest      RequestType = "mcp_tool_execution"
-	UnknownRequest               RequestType = "unknown"
+	ListModelsRequest               RequestType = "list_models"
+	TextCompletionRequest           RequestType = "text_completion"
+	TextCompletionStreamRequest     RequestType = "text_completion_stream"
+	ChatCompletionRequest           RequestType = "chat_completion"
+	ChatCompletionStreamRequest     RequestType = "chat_completion_stream"
+	ResponsesRequest                RequestType = "responses"
+	ResponsesStreamRequest          RequestType = "responses_stream"
+	EmbeddingRequest                RequestType = "embedding"
+	SpeechRequest                   RequestType = "speech"
+	SpeechStreamRequest             RequestType = "speech_stream"
+	TranscriptionRequest            RequestType = "transcription"
+	TranscriptionStreamRequest      RequestType = "transcription_stream"
+	TranslationRequest              RequestType = "translation"
+	ImageGenerationRequest          RequestType = "image_generation"
+	ImageGenerationStreamRequest    RequestType = "image_generation_stream"
+	ImageEditRequest                RequestType = "image_edit"
+	ImageEditStreamRequest          RequestType = "image_edit_stream"
+	ImageVariationRequest           RequestType = "image_variation"
+	MusicGenerationRequest          RequestType = "music_generation"
+	VideoGenerationRequest          RequestType = "video_generation"
+	VideoRetrieveRequest            RequestType = "video_retrieve"
+	VideoDownloadRequest            RequestType = "video_download"
+	VideoDeleteRequest              RequestType = "video_delete"
+	VideoListRequest                RequestType = "video_list"
+	VideoRemixRequest               RequestType = "video_remix"
+	BatchCreateRequest              RequestType = "batch_create"
+	BatchListRequest                RequestType = "batch_list"
+	BatchRetrieveRequest            RequestType = "batch_retrieve"
+	BatchCancelRequest              RequestType = "batch_cancel"
+	BatchResultsRequest             RequestType = "batch_results"
+	FileUploadRequest               RequestType = "file_upload"
+	FileListRequest                 RequestType = "file_list"
+	FileRetrieveRequest             RequestType = "file_retrieve"
+	FileDeleteRequest               RequestType = "file_delete"
+	FileContentRequest              RequestType = "file_content"
+	ContainerCreateRequest          RequestType = "container_create"
+	ContainerListRequest            RequestType = "container_list"
+	ContainerRetrieveRequest        RequestType = "container_retrieve"
+	ContainerDeleteRequest          RequestType = "container_delete"
+	ContainerFileCreateRequest      RequestType = "container_file_create"
+	ContainerFileListRequest        RequestType = "container_file_list"
+	ContainerFileRetrieveRequest    RequestType = "container_file_retrieve"
+	ContainerFileContentRequest     RequestType = "container_file_content"
+	ContainerFileDeleteRequest      RequestType = "container_file_delete"
+	FineTuningJobCreateRequest      RequestType = "fine_tuning_job_create"
+	FineTuningJobListRequest        RequestType = "fine_tuning_job_list"
+	FineTuningJobRetrieveRequest    RequestType = "fine_tuning_job_retrieve"
+	FineTuningJobCancelRequest      RequestType = "fine_tuning_job_cancel"
+	FineTuningJobEventsRequest      RequestType = "fine_tuning_job_events"
+	FineTuningJobCheckpointsRequest RequestType = "fine_tuning_job_checkpoints"
+	VectorStoreCreateRequest        RequestType = "vector_store_create"
+	VectorStoreListRequest          RequestType = "vector_store_list"
+	VectorStoreSearchRequest        RequestType = "vector_store_search"
+	VectorStoreFileCreateRequest    RequestType = "vector_store_file_create"
+	VectorStoreFileListRequest      RequestType = "vector_store_file_list"
+	RerankRequest                   RequestType = "rerank"
+	ModerationRequest               RequestType = "moderation"
+	RealtimeRequest                 RequestType = "realtime"
+	ContextCacheCreateRequest       RequestType = "context_cache_create"
+	CountTokensRequest              RequestType = "count_tokens"
+	MCPToolExecutionRequest         RequestType = "mcp_tool_execution"
+	UnknownRequest                  RequestType = "unknown"
 )
 
 // BifrostContextKey is a type for context keys used in Bifrost.
@@ -167,9 +216,12 @@ type BifrostContextKey string
 
 // BifrostContextKeyRequestType is a context key for the request type.
 const (
-	BifrostContextKeySessionToken                        BifrostContextKey = "bifrost-session-token"                 // string (session token for authentication - set by auth middleware)
+	BifrostContextKeySessionToken                        BifrostContextKey = "bifrost-session-token"                // string (session token for authentication - set by auth middleware)
 	BifrostContextKeyVirtualKey                          BifrostContextKey = "x-bf-vk"                              // string
 	BifrostContextKeyAPIKeyName                          BifrostContextKey = "x-bf-api-key"                         // string (explicit key name selection)
+	BifrostContextKeyAPIKeyID                            BifrostContextKey = "x-bf-key-id"                          // string (explicit key ID selection)
+	BifrostContextKeyProviderOverride                    BifrostContextKey = "x-bf-provider"                        // ModelProvider (provider the request is pinned to, replaces the provider of the request)
+	BifrostContextKeyFallbacksOverride                   BifrostContextKey = "x-bf-fallbacks"                       // []Fallback (replaces the fallbacks of the request, empty for no fallbacks)
 	BifrostContextKeyRequestID                           BifrostContextKey = "request-id"                           // string
 	BifrostContextKeyFallbackRequestID                   BifrostContextKey = "fallback-request-id"                  // string
 	BifrostContextKeyDirectKey                           BifrostContextKey = "bifrost-direct-key"                   // Key struct
@@ -177,11 +229,12 @@ const (
 	BifrostContextKeySelectedKeyName                     BifrostContextKey = "bifrost-selected-key-name"            // string (to store the selected key name (set by bifrost governance plugin - DO NOT SET THIS MANUALLY))
 	BifrostContextKeyGovernanceVirtualKeyID              BifrostContextKey = "bifrost-governance-virtual-key-id"    // string (to store the virtual key ID (set by bifrost governance plugin - DO NOT SET THIS MANUALLY))
 	BifrostContextKeyGovernanceVirtualKeyName            BifrostContextKey = "bifrost-governance-virtual-key-name"  // string (to store the virtual key name (set by bifrost governance plugin - DO NOT SET THIS MANUALLY))
-	BifrostContextKeyGovernanceTeamID                    BifrostContextKey = "bifrost-governance-team-id"           // string (to store the team ID (set by bifrost governance plugin - DO NOT SET THIS MANUALLY))
+	BifrostContextKeyGovernanceTeamID                    BifrostContextKey = "bifrost-governance-team-id"           // string (to store the team ID (set by bifrost governance plugin or JWT auth - DO NOT SET THIS MANUALLY))
 	BifrostContextKeyGovernanceTeamName                  BifrostContextKey = "bifrost-governance-team-name"         // string (to store the team name (set by bifrost governance plugin - DO NOT SET THIS MANUALLY))
-	BifrostContextKeyGovernanceCustomerID                BifrostContextKey = "bifrost-governance-customer-id"       // string (to store the customer ID (set by bifrost governance plugin - DO NOT SET THIS MANUALLY))
+	BifrostContextKeyGovernanceCustomerID                BifrostContextKey = "bifrost-governance-customer-id"       // string (to store the customer ID (set by bifrost governance plugin or JWT auth - DO NOT SET THIS MANUALLY))
 	BifrostContextKeyGovernanceCustomerName              BifrostContextKey = "bifrost-governance-customer-name"     // string (to store the customer name (set by bifrost governance plugin - DO NOT SET THIS MANUALLY))
-	BifrostContextKeyGovernanceUserID                    BifrostContextKey = "bifrost-governance-user-id"           // string (to store the user ID (set by enterprise governance plugin - DO NOT SET THIS MANUALLY))
+	BifrostContextKeyGovernanceUserID                    BifrostContextKey = "bifrost-governance-user-id"           // string (to store the user ID (set by enterprise governance plugin or JWT auth - DO NOT SET THIS MANUALLY))
+	BifrostContextKeyGovernanceRoles                     BifrostContextKey = "bifrost-governance-roles"             // []string (to store the roles of the authenticated caller (set by JWT auth - DO NOT SET THIS MANUALLY))
 	BifrostContextKeyGovernanceRoutingRuleID             BifrostContextKey = "bifrost-governance-routing-rule-id"   // string (to store the routing rule ID (set by bifrost governance plugin - DO NOT SET THIS MANUALLY))
 	BifrostContextKeyGovernanceRoutingRuleName           BifrostContextKey = "bifrost-governance-routing-rule-name" // string (to store the routing rule name (set by bifrost governance plugin - DO NOT SET THIS MANUALLY))
 	BifrostContextKeyGovernanceIncludeOnlyKeys           BifrostContextKey = "bf-governance-include-only-keys"      // []string (to store the include-only key IDs for provider config routing (set by bifrost governance plugin - DO NOT SET THIS MANUALLY))
@@ -231,6 +284,20 @@ const (
 	BifrostContextKeyVideoOutputRequested                BifrostContextKey = "bifrost-video-output-requested"
 	BifrostContextKeyValidateKeys                        BifrostContextKey = "bifrost-validate-keys"             // bool (triggers additional key validation during provider add/update)
 	BifrostContextKeyProviderResponseHeaders             BifrostContextKey = "bifrost-provider-response-headers" // map[string]string (set by provider handlers for response header forwarding)
+	BifrostContextKeyParameterPreset                     BifrostContextKey = "bifrost-parameter-preset"          // string (name or alias of the parameter preset to expand into the request params)
+	BifrostContextKeyInlineImageURLs                     BifrostContextKey = "bifrost-inline-image-urls"         // bool (download URL image results and return them as base64)
+	BifrostContextKeyStripReasoning                      BifrostContextKey = "bifrost-strip-reasoning"           // bool (remove reasoning from chat responses before they are returned)
+	BifrostContextKeyRequestPriority                     BifrostContextKey = "bifrost-request-priority"          // RequestPriority (load shedding priority, defaults to normal)
+	BifrostContextKeyHedgeDelay                          BifrostContextKey = "bifrost-hedge-delay"               // time.Duration (send a hedge request when the primary has no first byte after this delay)
+	BifrostContextKeyRetryPolicy                         BifrostContextKey = "bifrost-retry-policy"              // *RetryPolicy (the provider's retry policy for its HTTP calls (set by bifrost - DO NOT SET THIS MANUALLY))
+	BifrostContextKeyRequestType                         BifrostContextKey = "bifrost-request-type"              // RequestType (the type of the request being sent to the provider (set by bifrost - DO NOT SET THIS MANUALLY))
+	BifrostContextKeyTrafficSplitKey                     BifrostContextKey = "bifrost-traffic-split-key"         // string (key requests are bucketed by for traffic splits, e.g. a session or user ID)
+	BifrostContextKeyTrafficSplit                        BifrostContextKey = "bifrost-traffic-split"             // *TrafficSplitResult (the traffic split variant of the request (set by bifrost - DO NOT SET THIS MANUALLY))
+	BifrostContextKeySingleFlightShared                  BifrostContextKey = "bifrost-single-flight-shared"      // bool (the response was shared from an identical in-flight request (set by bifrost - DO NOT SET THIS MANUALLY))
+	BifrostContextKeyExecuteTools                        BifrostContextKey = "bifrost-execute-tools"             // bool (execute the calls of registered tools and continue the conversation, see ToolExecutionConfig)
+	BifrostContextKeyMaxToolIterations                   BifrostContextKey = "bifrost-max-tool-iterations"       // int (tool-call rounds for this request, at most ToolExecutionConfig.MaxIterations)
+	BifrostContextKeyClientExtraHeaders                  BifrostContextKey = "bifrost-client-extra-headers"      // map[string][]string (the extra headers of the request before the header policy was applied (set by bifrost - DO NOT SET THIS MANUALLY))
+	BifrostContextKeyRequestTags                         BifrostContextKey = "bifrost-request-tags"              // map[string]string (tags for cost attribution, recorded in logs, metrics and governance usage, see ValidateRequestTags)
 )
 
 // RoutingEngine constants
@@ -238,6 +305,10 @@ const (
 	RoutingEngineGovernance    = "governance"
 	RoutingEngineRoutingRule   = "routing-rule"
 	RoutingEngineLoadbalancing = "loadbalancing"
+	RoutingEngineLatency       = "latency"
+	RoutingEngineCost          = "cost"
+	RoutingEngineVirtualModel  = "virtual-model"
+	RoutingEngineTrafficSplit  = "traffic-split"
 )
 
 // RoutingEngineLogEntry represents a log entry from a routing engine
@@ -268,50 +339,71 @@ type Fallback struct {
 // - CountTokensRequest
 // - EmbeddingRequest
 // - RerankRequest
+// - ModerationRequest
+// - RealtimeRequest
 // - SpeechRequest
 // - TranscriptionRequest
+// - TranslationRequest
 // - ImageGenerationRequest
+// - MusicGenerationRequest
+// - ContextCacheCreateRequest
 // NOTE: Bifrost Request is submitted back to pool after every use so DO NOT keep references to this struct after use, especially in go routines.
 type BifrostRequest struct {
 	RequestType RequestType
 
-	ListModelsRequest            *BifrostListModelsRequest
-	TextCompletionRequest        *BifrostTextCompletionRequest
-	ChatRequest                  *BifrostChatRequest
-	ResponsesRequest             *BifrostResponsesRequest
-	CountTokensRequest           *BifrostResponsesRequest
-	EmbeddingRequest             *BifrostEmbeddingRequest
-	RerankRequest                *BifrostRerankRequest
-	SpeechRequest                *BifrostSpeechRequest
-	TranscriptionRequest         *BifrostTranscriptionRequest
-	ImageGenerationRequest       *BifrostImageGenerationRequest
-	ImageEditRequest             *BifrostImageEditRequest
-	ImageVariationRequest        *BifrostImageVariationRequest
-	VideoGenerationRequest       *BifrostVideoGenerationRequest
-	VideoRetrieveRequest         *BifrostVideoRetrieveRequest
-	VideoDownloadRequest         *BifrostVideoDownloadRequest
-	VideoListRequest             *BifrostVideoListRequest
-	VideoRemixRequest            *BifrostVideoRemixRequest
-	VideoDeleteRequest           *BifrostVideoDeleteRequest
-	FileUploadRequest            *BifrostFileUploadRequest
-	FileListRequest              *BifrostFileListRequest
-	FileRetrieveRequest          *BifrostFileRetrieveRequest
-	FileDeleteRequest            *BifrostFileDeleteRequest
-	FileContentRequest           *BifrostFileContentRequest
-	BatchCreateRequest           *BifrostBatchCreateRequest
-	BatchListRequest             *BifrostBatchListRequest
-	BatchRetrieveRequest         *BifrostBatchRetrieveRequest
-	BatchCancelRequest           *BifrostBatchCancelRequest
-	BatchResultsRequest          *BifrostBatchResultsRequest
-	ContainerCreateRequest       *BifrostContainerCreateRequest
-	ContainerListRequest         *BifrostContainerListRequest
-	ContainerRetrieveRequest     *BifrostContainerRetrieveRequest
-	ContainerDeleteRequest       *BifrostContainerDeleteRequest
-	ContainerFileCreateRequest   *BifrostContainerFileCreateRequest
-	ContainerFileListRequest     *BifrostContainerFileListRequest
-	ContainerFileRetrieveRequest *BifrostContainerFileRetrieveRequest
-	ContainerFileContentRequest  *BifrostContainerFileContentRequest
-	ContainerFileDeleteRequest   *BifrostContainerFileDeleteRequest
+	ListModelsRequest               *BifrostListModelsRequest
+	TextCompletionRequest           *BifrostTextCompletionRequest
+	ChatRequest                     *BifrostChatRequest
+	ResponsesRequest                *BifrostResponsesRequest
+	CountTokensRequest              *BifrostResponsesRequest
+	EmbeddingRequest                *BifrostEmbeddingRequest
+	RerankRequest                   *BifrostRerankRequest
+	ModerationRequest               *BifrostModerationRequest
+	RealtimeRequest                 *BifrostRealtimeRequest
+	SpeechRequest                   *BifrostSpeechRequest
+	TranscriptionRequest            *BifrostTranscriptionRequest
+	TranslationRequest              *BifrostTranslationRequest
+	ImageGenerationRequest          *BifrostImageGenerationRequest
+	ImageEditRequest                *BifrostImageEditRequest
+	ImageVariationRequest           *BifrostImageVariationRequest
+	MusicGenerationRequest          *BifrostMusicGenerationRequest
+	ContextCacheCreateRequest       *BifrostContextCacheCreateRequest
+	VideoGenerationRequest          *BifrostVideoGenerationRequest
+	VideoRetrieveRequest            *BifrostVideoRetrieveRequest
+	VideoDownloadRequest            *BifrostVideoDownloadRequest
+	VideoListRequest                *BifrostVideoListRequest
+	VideoRemixRequest               *BifrostVideoRemixRequest
+	VideoDeleteRequest              *BifrostVideoDeleteRequest
+	FileUploadRequest               *BifrostFileUploadRequest
+	FileListRequest                 *BifrostFileListRequest
+	FileRetrieveRequest             *BifrostFileRetrieveRequest
+	FileDeleteRequest               *BifrostFileDeleteRequest
+	FileContentRequest              *BifrostFileContentRequest
+	BatchCreateRequest              *BifrostBatchCreateRequest
+	BatchListRequest                *BifrostBatchListRequest
+	BatchRetrieveRequest            *BifrostBatchRetrieveRequest
+	BatchCancelRequest              *BifrostBatchCancelRequest
+	BatchResultsRequest             *BifrostBatchResultsRequest
+	ContainerCreateRequest          *BifrostContainerCreateRequest
+	ContainerListRequest            *BifrostContainerListRequest
+	ContainerRetrieveRequest        *BifrostContainerRetrieveRequest
+	ContainerDeleteRequest          *BifrostContainerDeleteRequest
+	ContainerFileCreateRequest      *BifrostContainerFileCreateRequest
+	ContainerFileListRequest        *BifrostContainerFileListRequest
+	ContainerFileRetrieveRequest    *BifrostContainerFileRetrieveRequest
+	ContainerFileContentRequest     *BifrostContainerFileContentRequest
+	ContainerFileDeleteRequest      *BifrostContainerFileDeleteRequest
+	FineTuningJobCreateRequest      *BifrostFineTuningJobCreateRequest
+	FineTuningJobListRequest        *BifrostFineTuningJobListRequest
+	FineTuningJobRetrieveRequest    *BifrostFineTuningJobRetrieveRequest
+	FineTuningJobCancelRequest      *BifrostFineTuningJobCancelRequest
+	FineTuningJobEventsRequest      *BifrostFineTuningJobEventsRequest
+	FineTuningJobCheckpointsRequest *BifrostFineTuningJobCheckpointsRequest
+	VectorStoreCreateRequest        *BifrostVectorStoreCreateRequest
+	VectorStoreListRequest          *BifrostVectorStoreListRequest
+	VectorStoreSearchRequest        *BifrostVectorStoreSearchRequest
+	VectorStoreFileCreateRequest    *BifrostVectorStoreFileCreateRequest
+	VectorStoreFileListRequest      *BifrostVectorStoreFileListRequest
 }
 
 // GetRequestFields returns the provider, model, and fallbacks from the request.
@@ -331,16 +423,26 @@ func (br *BifrostRequest) GetRequestFields() (provider ModelProvider, model stri
 		return br.EmbeddingRequest.Provider, br.EmbeddingRequest.Model, br.EmbeddingRequest.Fallbacks
 	case br.RerankRequest != nil:
 		return br.RerankRequest.Provider, br.RerankRequest.Model, br.RerankRequest.Fallbacks
+	case br.ModerationRequest != nil:
+		return br.ModerationRequest.Provider, br.ModerationRequest.Model, br.ModerationRequest.Fallbacks
+	case br.RealtimeRequest != nil:
+		return br.RealtimeRequest.Provider, br.RealtimeRequest.Model, nil
 	case br.SpeechRequest != nil:
 		return br.SpeechRequest.Provider, br.SpeechRequest.Model, br.SpeechRequest.Fallbacks
 	case br.TranscriptionRequest != nil:
 		return br.TranscriptionRequest.Provider, br.TranscriptionRequest.Model, br.TranscriptionRequest.Fallbacks
+	case br.TranslationRequest != nil:
+		return br.TranslationRequest.Provider, br.TranslationRequest.Model, br.TranslationRequest.Fallbacks
 	case br.ImageGenerationRequest != nil:
 		return br.ImageGenerationRequest.Provider, br.ImageGenerationRequest.Model, br.ImageGenerationRequest.Fallbacks
 	case br.ImageEditRequest != nil:
 		return br.ImageEditRequest.Provider, br.ImageEditRequest.Model, br.ImageEditRequest.Fallbacks
 	case br.ImageVariationRequest != nil:
 		return br.ImageVariationRequest.Provider, br.ImageVariationRequest.Model, br.ImageVariationRequest.Fallbacks
+	case br.MusicGenerationRequest != nil:
+		return br.MusicGenerationRequest.Provider, br.MusicGenerationRequest.Model, br.MusicGenerationRequest.Fallbacks
+	case br.ContextCacheCreateRequest != nil:
+		return br.ContextCacheCreateRequest.Provider, br.ContextCacheCreateRequest.Model, br.ContextCacheCreateRequest.Fallbacks
 	case br.VideoGenerationRequest != nil:
 		return br.VideoGenerationRequest.Provider, br.VideoGenerationRequest.Model, br.VideoGenerationRequest.Fallbacks
 	case br.VideoRetrieveRequest != nil:
@@ -421,6 +523,28 @@ func (br *BifrostRequest) GetRequestFields() (provider ModelProvider, model stri
 		return br.ContainerFileContentRequest.Provider, "", nil
 	case br.ContainerFileDeleteRequest != nil:
 		return br.ContainerFileDeleteRequest.Provider, "", nil
+	case br.FineTuningJobCreateRequest != nil:
+		return br.FineTuningJobCreateRequest.Provider, br.FineTuningJobCreateRequest.Model, nil
+	case br.FineTuningJobListRequest != nil:
+		return br.FineTuningJobListRequest.Provider, "", nil
+	case br.FineTuningJobRetrieveRequest != nil:
+		return br.FineTuningJobRetrieveRequest.Provider, "", nil
+	case br.FineTuningJobCancelRequest != nil:
+		return br.FineTuningJobCancelRequest.Provider, "", nil
+	case br.FineTuningJobEventsRequest != nil:
+		return br.FineTuningJobEventsRequest.Provider, "", nil
+	case br.FineTuningJobCheckpointsRequest != nil:
+		return br.FineTuningJobCheckpointsRequest.Provider, "", nil
+	case br.VectorStoreCreateRequest != nil:
+		return br.VectorStoreCreateRequest.Provider, "", nil
+	case br.VectorStoreListRequest != nil:
+		return br.VectorStoreListRequest.Provider, "", nil
+	case br.VectorStoreSearchRequest != nil:
+		return br.VectorStoreSearchRequest.Provider, "", nil
+	case br.VectorStoreFileCreateRequest != nil:
+		return br.VectorStoreFileCreateRequest.Provider, "", nil
+	case br.VectorStoreFileListRequest != nil:
+		return br.VectorStoreFileListRequest.Provider, "", nil
 	}
 	return "", "", nil
 }
@@ -441,16 +565,26 @@ func (br *BifrostRequest) SetProvider(provider ModelProvider) {
 		br.EmbeddingRequest.Provider = provider
 	case br.RerankRequest != nil:
 		br.RerankRequest.Provider = provider
+	case br.ModerationRequest != nil:
+		br.ModerationRequest.Provider = provider
+	case br.RealtimeRequest != nil:
+		br.RealtimeRequest.Provider = provider
 	case br.SpeechRequest != nil:
 		br.SpeechRequest.Provider = provider
 	case br.TranscriptionRequest != nil:
 		br.TranscriptionRequest.Provider = provider
+	case br.TranslationRequest != nil:
+		br.TranslationRequest.Provider = provider
 	case br.ImageGenerationRequest != nil:
 		br.ImageGenerationRequest.Provider = provider
 	case br.ImageEditRequest != nil:
 		br.ImageEditRequest.Provider = provider
 	case br.ImageVariationRequest != nil:
 		br.ImageVariationRequest.Provider = provider
+	case br.MusicGenerationRequest != nil:
+		br.MusicGenerationRequest.Provider = provider
+	case br.ContextCacheCreateRequest != nil:
+		br.ContextCacheCreateRequest.Provider = provider
 	case br.VideoGenerationRequest != nil:
 		br.VideoGenerationRequest.Provider = provider
 	case br.VideoRetrieveRequest != nil:
@@ -480,16 +614,26 @@ func (br *BifrostRequest) SetModel(model string) {
 		br.EmbeddingRequest.Model = model
 	case br.RerankRequest != nil:
 		br.RerankRequest.Model = model
+	case br.ModerationRequest != nil:
+		br.ModerationRequest.Model = model
+	case br.RealtimeRequest != nil:
+		br.RealtimeRequest.Model = model
 	case br.SpeechRequest != nil:
 		br.SpeechRequest.Model = model
 	case br.TranscriptionRequest != nil:
 		br.TranscriptionRequest.Model = model
+	case br.TranslationRequest != nil:
+		br.TranslationRequest.Model = model
 	case br.ImageGenerationRequest != nil:
 		br.ImageGenerationRequest.Model = model
 	case br.ImageEditRequest != nil:
 		br.ImageEditRequest.Model = model
 	case br.ImageVariationRequest != nil:
 		br.ImageVariationRequest.Model = model
+	case br.MusicGenerationRequest != nil:
+		br.MusicGenerationRequest.Model = model
+	case br.ContextCacheCreateRequest != nil:
+		br.ContextCacheCreateRequest.Model = model
 	case br.VideoGenerationRequest != nil:
 		br.VideoGenerationRequest.Model = model
 	}
@@ -509,16 +653,24 @@ func (br *BifrostRequest) SetFallbacks(fallbacks []Fallback) {
 		br.EmbeddingRequest.Fallbacks = fallbacks
 	case br.RerankRequest != nil:
 		br.RerankRequest.Fallbacks = fallbacks
+	case br.ModerationRequest != nil:
+		br.ModerationRequest.Fallbacks = fallbacks
 	case br.SpeechRequest != nil:
 		br.SpeechRequest.Fallbacks = fallbacks
 	case br.TranscriptionRequest != nil:
 		br.TranscriptionRequest.Fallbacks = fallbacks
+	case br.TranslationRequest != nil:
+		br.TranslationRequest.Fallbacks = fallbacks
 	case br.ImageGenerationRequest != nil:
 		br.ImageGenerationRequest.Fallbacks = fallbacks
 	case br.ImageEditRequest != nil:
 		br.ImageEditRequest.Fallbacks = fallbacks
 	case br.ImageVariationRequest != nil:
 		br.ImageVariationRequest.Fallbacks = fallbacks
+	case br.MusicGenerationRequest != nil:
+		br.MusicGenerationRequest.Fallbacks = fallbacks
+	case br.ContextCacheCreateRequest != nil:
+		br.ContextCacheCreateRequest.Fallbacks = fallbacks
 	case br.VideoGenerationRequest != nil:
 		br.VideoGenerationRequest.Fallbacks = fallbacks
 	}
@@ -538,16 +690,24 @@ func (br *BifrostRequest) SetRawRequestBody(rawRequestBody []byte) {
 		br.EmbeddingRequest.RawRequestBody = rawRequestBody
 	case br.RerankRequest != nil:
 		br.RerankRequest.RawRequestBody = rawRequestBody
+	case br.ModerationRequest != nil:
+		br.ModerationRequest.RawRequestBody = rawRequestBody
 	case br.SpeechRequest != nil:
 		br.SpeechRequest.RawRequestBody = rawRequestBody
 	case br.TranscriptionRequest != nil:
 		br.TranscriptionRequest.RawRequestBody = rawRequestBody
+	case br.TranslationRequest != nil:
+		br.TranslationRequest.RawRequestBody = rawRequestBody
 	case br.ImageGenerationRequest != nil:
 		br.ImageGenerationRequest.RawRequestBody = rawRequestBody
 	case br.ImageEditRequest != nil:
 		br.ImageEditRequest.RawRequestBody = rawRequestBody
 	case br.ImageVariationRequest != nil:
 		br.ImageVariationRequest.RawRequestBody = rawRequestBody
+	case br.MusicGenerationRequest != nil:
+		br.MusicGenerationRequest.RawRequestBody = rawRequestBody
+	case br.ContextCacheCreateRequest != nil:
+		br.ContextCacheCreateRequest.RawRequestBody = rawRequestBody
 	case br.VideoGenerationRequest != nil:
 		br.VideoGenerationRequest.RawRequestBody = rawRequestBody
 	case br.VideoRemixRequest != nil:
@@ -601,43 +761,59 @@ func (r *BifrostMCPRequest) GetToolArguments() interface{} {
 
 // BifrostResponse represents the complete result from any bifrost request.
 type BifrostResponse struct {
-	ListModelsResponse            *BifrostListModelsResponse
-	TextCompletionResponse        *BifrostTextCompletionResponse
-	ChatResponse                  *BifrostChatResponse
-	ResponsesResponse             *BifrostResponsesResponse
-	ResponsesStreamResponse       *BifrostResponsesStreamResponse
-	CountTokensResponse           *BifrostCountTokensResponse
-	EmbeddingResponse             *BifrostEmbeddingResponse
-	RerankResponse                *BifrostRerankResponse
-	SpeechResponse                *BifrostSpeechResponse
-	SpeechStreamResponse          *BifrostSpeechStreamResponse
-	TranscriptionResponse         *BifrostTranscriptionResponse
-	TranscriptionStreamResponse   *BifrostTranscriptionStreamResponse
-	ImageGenerationResponse       *BifrostImageGenerationResponse
-	ImageGenerationStreamResponse *BifrostImageGenerationStreamResponse
-	VideoGenerationResponse       *BifrostVideoGenerationResponse
-	VideoDownloadResponse         *BifrostVideoDownloadResponse
-	VideoListResponse             *BifrostVideoListResponse
-	VideoDeleteResponse           *BifrostVideoDeleteResponse
-	FileUploadResponse            *BifrostFileUploadResponse
-	FileListResponse              *BifrostFileListResponse
-	FileRetrieveResponse          *BifrostFileRetrieveResponse
-	FileDeleteResponse            *BifrostFileDeleteResponse
-	FileContentResponse           *BifrostFileContentResponse
-	BatchCreateResponse           *BifrostBatchCreateResponse
-	BatchListResponse             *BifrostBatchListResponse
-	BatchRetrieveResponse         *BifrostBatchRetrieveResponse
-	BatchCancelResponse           *BifrostBatchCancelResponse
-	BatchResultsResponse          *BifrostBatchResultsResponse
-	ContainerCreateResponse       *BifrostContainerCreateResponse
-	ContainerListResponse         *BifrostContainerListResponse
-	ContainerRetrieveResponse     *BifrostContainerRetrieveResponse
-	ContainerDeleteResponse       *BifrostContainerDeleteResponse
-	ContainerFileCreateResponse   *BifrostContainerFileCreateResponse
-	ContainerFileListResponse     *BifrostContainerFileListResponse
-	ContainerFileRetrieveResponse *BifrostContainerFileRetrieveResponse
-	ContainerFileContentResponse  *BifrostContainerFileContentResponse
-	ContainerFileDeleteResponse   *BifrostContainerFileDeleteResponse
+	ListModelsResponse               *BifrostListModelsResponse
+	TextCompletionResponse           *BifrostTextCompletionResponse
+	ChatResponse                     *BifrostChatResponse
+	ResponsesResponse                *BifrostResponsesResponse
+	ResponsesStreamResponse          *BifrostResponsesStreamResponse
+	CountTokensResponse              *BifrostCountTokensResponse
+	EmbeddingResponse                *BifrostEmbeddingResponse
+	RerankResponse                   *BifrostRerankResponse
+	ModerationResponse               *BifrostModerationResponse
+	RealtimeResponse                 *BifrostRealtimeResponse
+	SpeechResponse                   *BifrostSpeechResponse
+	SpeechStreamResponse             *BifrostSpeechStreamResponse
+	TranscriptionResponse            *BifrostTranscriptionResponse
+	TranscriptionStreamResponse      *BifrostTranscriptionStreamResponse
+	TranslationResponse              *BifrostTranslationResponse
+	ImageGenerationResponse          *BifrostImageGenerationResponse
+	ImageGenerationStreamResponse    *BifrostImageGenerationStreamResponse
+	MusicGenerationResponse          *BifrostMusicGenerationResponse
+	ContextCacheCreateResponse       *BifrostContextCacheCreateResponse
+	VideoGenerationResponse          *BifrostVideoGenerationResponse
+	VideoDownloadResponse            *BifrostVideoDownloadResponse
+	VideoListResponse                *BifrostVideoListResponse
+	VideoDeleteResponse              *BifrostVideoDeleteResponse
+	FileUploadResponse               *BifrostFileUploadResponse
+	FileListResponse                 *BifrostFileListResponse
+	FileRetrieveResponse             *BifrostFileRetrieveResponse
+	FileDeleteResponse               *BifrostFileDeleteResponse
+	FileContentResponse              *BifrostFileContentResponse
+	BatchCreateResponse              *BifrostBatchCreateResponse
+	BatchListResponse                *BifrostBatchListResponse
+	BatchRetrieveResponse            *BifrostBatchRetrieveResponse
+	BatchCancelResponse              *BifrostBatchCancelResponse
+	BatchResultsResponse             *BifrostBatchResultsResponse
+	ContainerCreateResponse          *BifrostContainerCreateResponse
+	ContainerListResponse            *BifrostContainerListResponse
+	ContainerRetrieveResponse        *BifrostContainerRetrieveResponse
+	ContainerDeleteResponse          *BifrostContainerDeleteResponse
+	ContainerFileCreateResponse      *BifrostContainerFileCreateResponse
+	ContainerFileListResponse        *BifrostContainerFileListResponse
+	ContainerFileRetrieveResponse    *BifrostContainerFileRetrieveResponse
+	ContainerFileContentResponse     *BifrostContainerFileContentResponse
+	ContainerFileDeleteResponse      *BifrostContainerFileDeleteResponse
+	FineTuningJobCreateResponse      *BifrostFineTuningJobCreateResponse
+	FineTuningJobListResponse        *BifrostFineTuningJobListResponse
+	FineTuningJobRetrieveResponse    *BifrostFineTuningJobRetrieveResponse
+	FineTuningJobCancelResponse      *BifrostFineTuningJobCancelResponse
+	FineTuningJobEventsResponse      *BifrostFineTuningJobEventsResponse
+	FineTuningJobCheckpointsResponse *BifrostFineTuningJobCheckpointsResponse
+	VectorStoreCreateResponse        *BifrostVectorStoreCreateResponse
+	VectorStoreListResponse          *BifrostVectorStoreListResponse
+	VectorStoreSearchResponse        *BifrostVectorStoreSearchResponse
+	VectorStoreFileCreateResponse    *BifrostVectorStoreFileCreateResponse
+	VectorStoreFileListResponse      *BifrostVectorStoreFileListResponse
 }
 
 func (r *BifrostResponse) GetExtraFields() *BifrostResponseExtraFields {
@@ -658,6 +834,10 @@ func (r *BifrostResponse) GetExtraFields() *BifrostResponseExtraFields {
 		return &r.EmbeddingResponse.ExtraFields
 	case r.RerankResponse != nil:
 		return &r.RerankResponse.ExtraFields
+	case r.ModerationResponse != nil:
+		return &r.ModerationResponse.ExtraFields
+	case r.RealtimeResponse != nil:
+		return &r.RealtimeResponse.ExtraFields
 	case r.SpeechResponse != nil:
 		return &r.SpeechResponse.ExtraFields
 	case r.SpeechStreamResponse != nil:
@@ -666,10 +846,16 @@ func (r *BifrostResponse) GetExtraFields() *BifrostResponseExtraFields {
 		return &r.TranscriptionResponse.ExtraFields
 	case r.TranscriptionStreamResponse != nil:
 		return &r.TranscriptionStreamResponse.ExtraFields
+	case r.TranslationResponse != nil:
+		return &r.TranslationResponse.ExtraFields
 	case r.ImageGenerationResponse != nil:
 		return &r.ImageGenerationResponse.ExtraFields
 	case r.ImageGenerationStreamResponse != nil:
 		return &r.ImageGenerationStreamResponse.ExtraFields
+	case r.MusicGenerationResponse != nil:
+		return &r.MusicGenerationResponse.ExtraFields
+	case r.ContextCacheCreateResponse != nil:
+		return &r.ContextCacheCreateResponse.ExtraFields
 	case r.FileUploadResponse != nil:
 		return &r.FileUploadResponse.ExtraFields
 	case r.FileListResponse != nil:
@@ -716,6 +902,28 @@ func (r *BifrostResponse) GetExtraFields() *BifrostResponseExtraFields {
 		return &r.ContainerFileContentResponse.ExtraFields
 	case r.ContainerFileDeleteResponse != nil:
 		return &r.ContainerFileDeleteResponse.ExtraFields
+	case r.FineTuningJobCreateResponse != nil:
+		return &r.FineTuningJobCreateResponse.ExtraFields
+	case r.FineTuningJobListResponse != nil:
+		return &r.FineTuningJobListResponse.ExtraFields
+	case r.FineTuningJobRetrieveResponse != nil:
+		return &r.FineTuningJobRetrieveResponse.ExtraFields
+	case r.FineTuningJobCancelResponse != nil:
+		return &r.FineTuningJobCancelResponse.ExtraFields
+	case r.FineTuningJobEventsResponse != nil:
+		return &r.FineTuningJobEventsResponse.ExtraFields
+	case r.FineTuningJobCheckpointsResponse != nil:
+		return &r.FineTuningJobCheckpointsResponse.ExtraFields
+	case r.VectorStoreCreateResponse != nil:
+		return &r.VectorStoreCreateResponse.ExtraFields
+	case r.VectorStoreListResponse != nil:
+		return &r.VectorStoreListResponse.ExtraFields
+	case r.VectorStoreSearchResponse != nil:
+		return &r.VectorStoreSearchResponse.ExtraFields
+	case r.VectorStoreFileCreateResponse != nil:
+		return &r.VectorStoreFileCreateResponse.ExtraFields
+	case r.VectorStoreFileListResponse != nil:
+		return &r.VectorStoreFileListResponse.ExtraFields
 	}
 
 	return &BifrostResponseExtraFields{}
@@ -733,18 +941,26 @@ type BifrostMCPResponse struct {
 
 // BifrostResponseExtraFields contains additional fields in a response.
 type BifrostResponseExtraFields struct {
-	RequestType             RequestType        `json:"request_type"`
-	Provider                ModelProvider      `json:"provider,omitempty"`
-	ModelRequested          string             `json:"model_requested,omitempty"`
-	ModelDeployment         string             `json:"model_deployment,omitempty"` // only present for providers which use model deployments (e.g. Azure, Bedrock)
-	Latency                 int64              `json:"latency"`                    // in milliseconds (for streaming responses this will be each chunk latency, and the last chunk latency will be the total latency)
-	ChunkIndex              int                `json:"chunk_index"`                // used for streaming responses to identify the chunk index, will be 0 for non-streaming responses
-	RawRequest              interface{}        `json:"raw_request,omitempty"`
-	RawResponse             interface{}        `json:"raw_response,omitempty"`
-	CacheDebug              *BifrostCacheDebug `json:"cache_debug,omitempty"`
-	ParseErrors             []BatchError       `json:"parse_errors,omitempty"` // errors encountered while parsing JSONL batch results
-	LiteLLMCompat           bool               `json:"litellm_compat,omitempty"`
-	ProviderResponseHeaders map[string]string  `json:"provider_response_headers,omitempty"` // HTTP response headers from the provider (filtered to exclude transport-level headers)
+	RequestType             RequestType          `json:"request_type"`
+	Provider                ModelProvider        `json:"provider,omitempty"`
+	ModelRequested          string               `json:"model_requested,omitempty"`
+	ModelDeployment         string               `json:"model_deployment,omitempty"` // only present for providers which use model deployments (e.g. Azure, Bedrock)
+	Latency                 int64                `json:"latency"`                    // in milliseconds (for streaming responses this will be each chunk latency, and the last chunk latency will be the total latency)
+	ChunkIndex              int                  `json:"chunk_index"`                // used for streaming responses to identify the chunk index, will be 0 for non-streaming responses
+	RawRequest              interface{}          `json:"raw_request,omitempty"`
+	RawResponse             interface{}          `json:"raw_response,omitempty"`
+	CacheDebug              *BifrostCacheDebug   `json:"cache_debug,omitempty"`
+	ParseErrors             []BatchError         `json:"parse_errors,omitempty"` // errors encountered while parsing JSONL batch results
+	LiteLLMCompat           bool                 `json:"litellm_compat,omitempty"`
+	ProviderResponseHeaders map[string]string    `json:"provider_response_headers,omitempty"` // HTTP response headers from the provider (filtered to exclude transport-level headers)
+	Warnings                []string             `json:"warnings,omitempty"`                  // non-fatal notices about changes made to the request (e.g. history compaction)
+	Approximate             bool                 `json:"approximate,omitempty"`               // the response was estimated locally instead of by the provider (e.g. token counts without a provider API)
+	TrafficSplit            *TrafficSplitResult  `json:"traffic_split,omitempty"`             // the traffic split variant the request was assigned to
+	SingleFlightShared      bool                 `json:"single_flight_shared,omitempty"`      // the response was shared from an identical in-flight request instead of its own upstream call
+	Guardrails              []GuardrailResult    `json:"guardrails,omitempty"`                // guardrail rules that matched the request or response
+	ToolInvocations         []ToolInvocation     `json:"tool_invocations,omitempty"`          // tool calls executed by bifrost before the final response
+	Retrieval               *RetrievalResult     `json:"retrieval,omitempty"`                 // chunks retrieved from a vector store and injected into the prompt
+	CostUSD                 *BifrostResponseCost `json:"cost_usd,omitempty"`                  // cost of the request priced from the model catalog (for streams, on the chunks carrying usage)
 }
 
 type BifrostMCPResponseExtraFields struct {
@@ -821,8 +1037,9 @@ type BifrostError struct {
 	IsBifrostError bool                    `json:"is_bifrost_error"`
 	StatusCode     *int                    `json:"status_code,omitempty"`
 	Error          *ErrorField             `json:"error"`
-	AllowFallbacks *bool                   `json:"-"` // Optional: Controls fallback behavior (nil = true by default)
-	StreamControl  *StreamControl          `json:"-"` // Optional: Controls stream behavior
+	AllowFallbacks *bool                   `json:"-"`                     // Optional: Controls fallback behavior (nil = true by default)
+	StreamControl  *StreamControl          `json:"-"`                     // Optional: Controls stream behavior
+	RetryAfter     *int                    `json:"retry_after,omitempty"` // Optional: Seconds the client should wait before retrying, sent as the Retry-After header
 	ExtraFields    BifrostErrorExtraFields `json:"extra_fields"`
 }
 
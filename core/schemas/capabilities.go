@@ -0,0 +1,106 @@
+package schemas
+
+import (
+	"slices"
+	"sync"
+)
+
+// ProviderCapabilities is the set of request types a provider supports, keyed by request type.
+// Each provider package registers its capabilities on init, so routers and the HTTP layer can
+// reject or re-route unsupported operations without calling the provider.
+type ProviderCapabilities map[RequestType]bool
+
+// Supports reports whether the request type is in the capability set.
+func (c ProviderCapabilities) Supports(requestType RequestType) bool {
+	return c[requestType]
+}
+
+// RequestTypes returns the supported request types in a stable order.
+func (c ProviderCapabilities) RequestTypes() []RequestType {
+	requestTypes := make([]RequestType, 0, len(c))
+	for requestType, ok := range c {
+		if ok {
+			requestTypes = append(requestTypes, requestType)
+		}
+	}
+	slices.Sort(requestTypes)
+	return requestTypes
+}
+
+// ProviderRequestTypes lists every request type that is served by a provider.
+var ProviderRequestTypes = []RequestType{
+	ListModelsRequest,
+	TextCompletionRequest, TextCompletionStreamRequest,
+	ChatCompletionRequest, ChatCompletionStreamRequest,
+	ResponsesRequest, ResponsesStreamRequest,
+	CountTokensRequest,
+	EmbeddingRequest,
+	RerankRequest,
+	ModerationRequest,
+	RealtimeRequest,
+	SpeechRequest, SpeechStreamRequest,
+	TranscriptionRequest, TranscriptionStreamRequest,
+	TranslationRequest,
+	ImageGenerationRequest, ImageGenerationStreamRequest,
+	ImageEditRequest, ImageEditStreamRequest,
+	ImageVariationRequest,
+	MusicGenerationRequest,
+	ContextCacheCreateRequest,
+	VideoGenerationRequest, VideoRetrieveRequest, VideoDownloadRequest, VideoDeleteRequest, VideoListRequest, VideoRemixRequest,
+	BatchCreateRequest, BatchListRequest, BatchRetrieveRequest, BatchCancelRequest, BatchResultsRequest,
+	FileUploadRequest, FileListRequest, FileRetrieveRequest, FileDeleteRequest, FileContentRequest,
+	ContainerCreateRequest, ContainerListRequest, ContainerRetrieveRequest, ContainerDeleteRequest,
+	ContainerFileCreateRequest, ContainerFileListRequest, ContainerFileRetrieveRequest, ContainerFileContentRequest, ContainerFileDeleteRequest,
+	FineTuningJobCreateRequest, FineTuningJobListRequest, FineTuningJobRetrieveRequest, FineTuningJobCancelRequest, FineTuningJobEventsRequest, FineTuningJobCheckpointsRequest,
+	VectorStoreCreateRequest, VectorStoreListRequest, VectorStoreSearchRequest, VectorStoreFileCreateRequest, VectorStoreFileListRequest,
+}
+
+// CapabilitiesOf derives the capabilities of a provider from the capability interfaces it
+// implements. A nil pointer of the provider type is enough, e.g. CapabilitiesOf((*OpenAIProvider)(nil)).
+func CapabilitiesOf(provider Provider) ProviderCapabilities {
+	capabilities := make(ProviderCapabilities)
+	for _, requestType := range ProviderRequestTypes {
+		if SupportsRequestType(provider, requestType) {
+			capabilities[requestType] = true
+		}
+	}
+	return capabilities
+}
+
+var (
+	providerCapabilitiesMu sync.RWMutex
+	providerCapabilities   = make(map[ModelProvider]ProviderCapabilities)
+)
+
+// RegisterProviderCapabilities records the capabilities of a provider, replacing any earlier entry.
+func RegisterProviderCapabilities(provider ModelProvider, capabilities ProviderCapabilities) {
+	providerCapabilitiesMu.Lock()
+	defer providerCapabilitiesMu.Unlock()
+	providerCapabilities[provider] = capabilities
+}
+
+// GetProviderCapabilities returns the registered capabilities of a provider.
+func GetProviderCapabilities(provider ModelProvider) (ProviderCapabilities, bool) {
+	providerCapabilitiesMu.RLock()
+	defer providerCapabilitiesMu.RUnlock()
+	capabilities, ok := providerCapabilities[provider]
+	return capabilities, ok
+}
+
+// GetAllProviderCapabilities returns a copy of the capability matrix of all registered providers.
+func GetAllProviderCapabilities() map[ModelProvider]ProviderCapabilities {
+	providerCapabilitiesMu.RLock()
+	defer providerCapabilitiesMu.RUnlock()
+	matrix := make(map[ModelProvider]ProviderCapabilities, len(providerCapabilities))
+	for provider, capabilities := range providerCapabilities {
+		matrix[provider] = capabilities
+	}
+	return matrix
+}
+
+// ProviderSupportsRequestType reports whether a provider supports the request type. Providers
+// without registered capabilities (e.g. custom providers) are assumed to support it.
+func ProviderSupportsRequestType(provider ModelProvider, requestType RequestType) bool {
+	capabilities, ok := GetProviderCapabilities(provider)
+	return !ok || capabilities.Supports(requestType)
+}
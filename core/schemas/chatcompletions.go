@@ -3,6 +3,7 @@ package schemas
 import (
 	"bytes"
 	"fmt"
+	"strings"
 )
 
 // BifrostChatRequest is the request struct for chat completion requests
@@ -46,6 +47,49 @@ type BifrostChatResponse struct {
 	Citations     []string       `json:"citations,omitempty"`
 }
 
+// WithoutReasoning returns the response without the reasoning of its choices, for clients that only want
+// the answer. The affected choices are copied, so the response, which plugins may still hold, is unchanged.
+func (cr *BifrostChatResponse) WithoutReasoning() *BifrostChatResponse {
+	if cr == nil {
+		return nil
+	}
+	var choices []BifrostResponseChoice
+	for i, choice := range cr.Choices {
+		switch {
+		case choice.ChatNonStreamResponseChoice != nil && choice.Message != nil && choice.Message.ChatAssistantMessage != nil &&
+			(choice.Message.Reasoning != nil || choice.Message.ReasoningDetails != nil):
+			assistantMessage := *choice.Message.ChatAssistantMessage
+			assistantMessage.Reasoning = nil
+			assistantMessage.ReasoningDetails = nil
+			message := *choice.Message
+			message.ChatAssistantMessage = &assistantMessage
+			nonStreamChoice := *choice.ChatNonStreamResponseChoice
+			nonStreamChoice.Message = &message
+			choice.ChatNonStreamResponseChoice = &nonStreamChoice
+		case choice.ChatStreamResponseChoice != nil && choice.Delta != nil &&
+			(choice.Delta.Reasoning != nil || choice.Delta.ReasoningDetails != nil):
+			delta := *choice.Delta
+			delta.Reasoning = nil
+			delta.ReasoningDetails = nil
+			streamChoice := *choice.ChatStreamResponseChoice
+			streamChoice.Delta = &delta
+			choice.ChatStreamResponseChoice = &streamChoice
+		default:
+			continue
+		}
+		if choices == nil {
+			choices = append([]BifrostResponseChoice(nil), cr.Choices...)
+		}
+		choices[i] = choice
+	}
+	if choices == nil {
+		return cr
+	}
+	stripped := *cr
+	stripped.Choices = choices
+	return &stripped
+}
+
 // ToTextCompletionResponse converts a BifrostChatResponse to a BifrostTextCompletionResponse
 func (cr *BifrostChatResponse) ToTextCompletionResponse() *BifrostTextCompletionResponse {
 	if cr == nil {
@@ -163,6 +207,7 @@ func (cr *BifrostChatResponse) ToTextCompletionResponse() *BifrostTextCompletion
 // ChatParameters represents the parameters for a chat completion.
 type ChatParameters struct {
 	Audio                *ChatAudioParameters  `json:"audio,omitempty"`                 // Audio parameters
+	ContextID            *string               `json:"context_id,omitempty"`            // Context cache to continue from (Volcengine only, see ContextCacheCreateRequest)
 	FrequencyPenalty     *float64              `json:"frequency_penalty,omitempty"`     // Penalizes frequent tokens
 	LogitBias            *map[string]float64   `json:"logit_bias,omitempty"`            // Bias for logit values
 	LogProbs             *bool                 `json:"logprobs,omitempty"`              // Number of logprobs to return
@@ -241,12 +286,19 @@ func (cp *ChatParameters) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// ChatAudioParameters represents the parameters for a chat audio completion. (Only supported by OpenAI Models that support audio input)
+// ChatAudioParameters represents the parameters for audio output of a chat completion.
+// Requires "audio" in Modalities (OpenAI gpt-4o-audio models and Qwen-Omni models, which only stream audio).
 type ChatAudioParameters struct {
-	Format string `json:"format,omitempty"` // Format for the audio completion
-	Voice  string `json:"voice,omitempty"`  // Voice to use for the audio completion
+	Format string `json:"format,omitempty"` // Format of the output audio, e.g. "wav", "mp3", "pcm16"
+	Voice  string `json:"voice,omitempty"`  // Voice to use, e.g. "alloy" (OpenAI) or "Cherry" (Qwen-Omni)
 }
 
+// Chat output modalities, set in ChatParameters.Modalities
+const (
+	ChatModalityText  = "text"
+	ChatModalityAudio = "audio"
+)
+
 // Not in OpenAI's spec, but needed to support extra parameters for reasoning.
 type ChatReasoning struct {
 	Effort    *string `json:"effort,omitempty"`     // "none" |  "minimal" | "low" | "medium" | "high" (any value other than "none" will enable reasoning)
@@ -781,6 +833,7 @@ const (
 	ChatContentBlockTypeImage      ChatContentBlockType = "image_url"
 	ChatContentBlockTypeInputAudio ChatContentBlockType = "input_audio"
 	ChatContentBlockTypeFile       ChatContentBlockType = "file"
+	ChatContentBlockTypeDocument   ChatContentBlockType = "document"
 	ChatContentBlockTypeRefusal    ChatContentBlockType = "refusal"
 )
 
@@ -792,6 +845,7 @@ type ChatContentBlock struct {
 	ImageURLStruct *ChatInputImage      `json:"image_url,omitempty"`
 	InputAudio     *ChatInputAudio      `json:"input_audio,omitempty"`
 	File           *ChatInputFile       `json:"file,omitempty"`
+	Document       *ChatInputDocument   `json:"document,omitempty"`
 
 	// Not in OpenAI's schemas, but sent by a few providers (Anthropic, Bedrock are some of them)
 	CacheControl *CacheControl `json:"cache_control,omitempty"`
@@ -842,6 +896,73 @@ type ChatInputFile struct {
 	FileType *string `json:"file_type,omitempty"` // Type of the file
 }
 
+// DefaultDocumentMediaType is the media type assumed for documents that don't set one.
+const DefaultDocumentMediaType = "application/pdf"
+
+// ChatInputDocument represents a document (typically a PDF) in a message.
+// Exactly one of Data and URL should be set. Providers translate documents to their
+// native representation (Anthropic document blocks, OpenAI file inputs, Moonshot file references).
+type ChatInputDocument struct {
+	Data      *string `json:"data,omitempty"`       // Base64 encoded document or a data URL
+	URL       *string `json:"url,omitempty"`        // Public URL of the document
+	MediaType *string `json:"media_type,omitempty"` // Defaults to application/pdf
+	Title     *string `json:"title,omitempty"`      // Optional document title, also used as the filename
+}
+
+// GetMediaType returns the media type of the document, taken from MediaType, the data URL or the default.
+func (d *ChatInputDocument) GetMediaType() string {
+	if d.MediaType != nil && *d.MediaType != "" {
+		return *d.MediaType
+	}
+	if d.Data != nil && strings.HasPrefix(*d.Data, "data:") {
+		if header, _, ok := strings.Cut((*d.Data)[len("data:"):], ","); ok {
+			if mediaType, _, _ := strings.Cut(header, ";"); mediaType != "" {
+				return mediaType
+			}
+		}
+	}
+	return DefaultDocumentMediaType
+}
+
+// GetFilename returns the title of the document, or a generic filename matching its media type.
+func (d *ChatInputDocument) GetFilename() string {
+	if d.Title != nil && *d.Title != "" {
+		return *d.Title
+	}
+	if d.GetMediaType() == DefaultDocumentMediaType {
+		return "document.pdf"
+	}
+	return "document"
+}
+
+// DocumentAsFile returns the block with its document converted to the equivalent file input,
+// for providers that accept documents as files. Base64 data is returned as a data URL.
+// Blocks without a document are returned unchanged.
+func (cb ChatContentBlock) DocumentAsFile() ChatContentBlock {
+	if cb.Document == nil {
+		return cb
+	}
+	doc := cb.Document
+	mediaType := doc.GetMediaType()
+	file := &ChatInputFile{
+		Filename: Ptr(doc.GetFilename()),
+		FileType: Ptr(mediaType),
+	}
+	if doc.Data != nil && *doc.Data != "" {
+		data := *doc.Data
+		if !strings.HasPrefix(data, "data:") {
+			data = "data:" + mediaType + ";base64," + data
+		}
+		file.FileData = &data
+	} else if doc.URL != nil {
+		file.FileURL = Ptr(*doc.URL)
+	}
+	cb.Type = ChatContentBlockTypeFile
+	cb.File = file
+	cb.Document = nil
+	return cb
+}
+
 // ChatToolMessage represents a tool message in a chat conversation.
 type ChatToolMessage struct {
 	ToolCallID *string `json:"tool_call_id,omitempty"`
@@ -933,12 +1054,15 @@ type ChatAssistantMessageToolCallFunction struct {
 	Arguments string  `json:"arguments"` // stringified json as retured by OpenAI, might not be a valid JSON always
 }
 
-// ChatAudioMessageAudio represents audio data in a message.
+// ChatAudioMessageAudio represents the audio output of an assistant message.
+// In stream deltas the fields arrive incrementally: Data and Transcript are split across
+// chunks and ID and ExpiresAt are usually only set on one of them.
+// To continue a conversation, send the assistant message back with the audio ID.
 type ChatAudioMessageAudio struct {
-	ID         string `json:"id"`
-	Data       string `json:"data"`
-	ExpiresAt  int    `json:"expires_at"`
-	Transcript string `json:"transcript"`
+	ID         string `json:"id,omitempty"`
+	Data       string `json:"data,omitempty"`       // Base64 encoded audio in the requested format
+	ExpiresAt  int    `json:"expires_at,omitempty"` // Unix timestamp after which the audio ID can no longer be referenced
+	Transcript string `json:"transcript,omitempty"`
 }
 
 // BifrostResponseChoice represents a choice in the completion result.
@@ -1085,6 +1209,30 @@ type BifrostLLMUsage struct {
 	Cost                    *BifrostCost                 `json:"cost,omitempty"` //Only for the providers which support cost calculation
 }
 
+// UnmarshalJSON maps DeepSeek's prompt_cache_hit_tokens into PromptTokensDetails.CachedReadTokens, so that
+// its context cache hits are logged and priced like any other provider's cached tokens.
+func (u *BifrostLLMUsage) UnmarshalJSON(data []byte) error {
+	type usageAlias BifrostLLMUsage
+	var raw struct {
+		usageAlias
+		PromptCacheHitTokens *int `json:"prompt_cache_hit_tokens"`
+	}
+	if err := Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	*u = BifrostLLMUsage(raw.usageAlias)
+	// Newer DeepSeek responses also send prompt_tokens_details.cached_tokens, which takes precedence.
+	if raw.PromptCacheHitTokens != nil && *raw.PromptCacheHitTokens > 0 {
+		if u.PromptTokensDetails == nil {
+			u.PromptTokensDetails = &ChatPromptTokensDetails{}
+		}
+		if u.PromptTokensDetails.CachedReadTokens == 0 {
+			u.PromptTokensDetails.CachedReadTokens = *raw.PromptCacheHitTokens
+		}
+	}
+	return nil
+}
+
 type ChatPromptTokensDetails struct {
 	TextTokens  int `json:"text_tokens,omitempty"`
 	AudioTokens int `json:"audio_tokens,omitempty"`
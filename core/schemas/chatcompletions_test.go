@@ -0,0 +1,40 @@
+package schemas
+
+import "testing"
+
+func TestBifrostChatResponseWithoutReasoning(t *testing.T) {
+	response := &BifrostChatResponse{Choices: []BifrostResponseChoice{
+		{ChatNonStreamResponseChoice: &ChatNonStreamResponseChoice{Message: &ChatMessage{
+			Role:    ChatMessageRoleAssistant,
+			Content: &ChatMessageContent{ContentStr: Ptr("Three.")},
+			ChatAssistantMessage: &ChatAssistantMessage{
+				Reasoning:        Ptr("Let me count."),
+				ReasoningDetails: []ChatReasoningDetails{{Type: BifrostReasoningDetailsTypeText, Text: Ptr("Let me count.")}},
+			},
+		}}},
+		{Index: 1, ChatStreamResponseChoice: &ChatStreamResponseChoice{Delta: &ChatStreamResponseChoiceDelta{
+			Content:   Ptr("Thr"),
+			Reasoning: Ptr("Let me"),
+		}}},
+	}}
+
+	stripped := response.WithoutReasoning()
+	message := stripped.Choices[0].Message
+	if message.Reasoning != nil || message.ReasoningDetails != nil || *message.Content.ContentStr != "Three." {
+		t.Errorf("unexpected message: %+v", message.ChatAssistantMessage)
+	}
+	delta := stripped.Choices[1].Delta
+	if delta.Reasoning != nil || *delta.Content != "Thr" || stripped.Choices[1].Index != 1 {
+		t.Errorf("unexpected delta: %+v", delta)
+	}
+
+	// The original response is left unchanged
+	if response.Choices[0].Message.Reasoning == nil || response.Choices[1].Delta.Reasoning == nil {
+		t.Errorf("expected the original response to keep its reasoning")
+	}
+
+	plain := &BifrostChatResponse{Choices: []BifrostResponseChoice{{ChatNonStreamResponseChoice: &ChatNonStreamResponseChoice{Message: &ChatMessage{Role: ChatMessageRoleAssistant}}}}}
+	if plain.WithoutReasoning() != plain {
+		t.Errorf("expected a response without reasoning to be returned as is")
+	}
+}
@@ -0,0 +1,83 @@
+package schemas
+
+import "fmt"
+
+// Circuit breaker defaults.
+const (
+	DefaultCircuitBreakerErrorRateThreshold = 0.5
+	DefaultCircuitBreakerMinRequests        = 20
+	DefaultCircuitBreakerWindowSeconds      = 60
+	DefaultCircuitBreakerProbeInterval      = 30
+)
+
+// CircuitBreakerConfig controls the circuit breakers Bifrost keeps per provider and model. A
+// circuit opens when the share of upstream failures in the window reaches the threshold; while
+// open, requests skip the provider and model (or fail fast when there is no fallback) until a
+// single probe request is let through after the probe interval.
+type CircuitBreakerConfig struct {
+	Enabled              bool    `json:"enabled"`
+	ErrorRateThreshold   float64 `json:"error_rate_threshold,omitempty"`   // Failure ratio at which a circuit opens (default 0.5)
+	MinRequests          int     `json:"min_requests,omitempty"`           // Requests needed in the window before a circuit can open (default 20)
+	WindowSeconds        int     `json:"window_seconds,omitempty"`         // Length of the window outcomes are counted over (default 60)
+	ProbeIntervalSeconds int     `json:"probe_interval_seconds,omitempty"` // Time an open circuit waits before a half-open probe (default 30)
+}
+
+// CircuitBreakerEvent reports the circuit of a provider and model opening or closing. A failed
+// half-open probe reports the circuit opening again.
+type CircuitBreakerEvent struct {
+	Provider ModelProvider `json:"provider"`
+	Model    string        `json:"model"`
+	Open     bool          `json:"open"` // true when the circuit opened, false when it closed
+}
+
+// Validate checks the threshold is a ratio and the counts are not negative.
+func (c *CircuitBreakerConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.ErrorRateThreshold < 0 || c.ErrorRateThreshold > 1 {
+		return fmt.Errorf("error_rate_threshold must be between 0 and 1")
+	}
+	if c.MinRequests < 0 {
+		return fmt.Errorf("min_requests must not be negative")
+	}
+	if c.WindowSeconds < 0 {
+		return fmt.Errorf("window_seconds must not be negative")
+	}
+	if c.ProbeIntervalSeconds < 0 {
+		return fmt.Errorf("probe_interval_seconds must not be negative")
+	}
+	return nil
+}
+
+// GetErrorRateThreshold returns the error rate threshold, or its default when unset.
+func (c *CircuitBreakerConfig) GetErrorRateThreshold() float64 {
+	if c.ErrorRateThreshold <= 0 {
+		return DefaultCircuitBreakerErrorRateThreshold
+	}
+	return c.ErrorRateThreshold
+}
+
+// GetMinRequests returns the minimum number of requests, or its default when unset.
+func (c *CircuitBreakerConfig) GetMinRequests() int {
+	if c.MinRequests <= 0 {
+		return DefaultCircuitBreakerMinRequests
+	}
+	return c.MinRequests
+}
+
+// GetWindowSeconds returns the window length, or its default when unset.
+func (c *CircuitBreakerConfig) GetWindowSeconds() int {
+	if c.WindowSeconds <= 0 {
+		return DefaultCircuitBreakerWindowSeconds
+	}
+	return c.WindowSeconds
+}
+
+// GetProbeIntervalSeconds returns the half-open probe interval, or its default when unset.
+func (c *CircuitBreakerConfig) GetProbeIntervalSeconds() int {
+	if c.ProbeIntervalSeconds <= 0 {
+		return DefaultCircuitBreakerProbeInterval
+	}
+	return c.ProbeIntervalSeconds
+}
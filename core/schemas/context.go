@@ -13,6 +13,9 @@ var NoDeadline time.Time
 var reservedKeys = []any{
 	BifrostContextKeyVirtualKey,
 	BifrostContextKeyAPIKeyName,
+	BifrostContextKeyAPIKeyID,
+	BifrostContextKeyProviderOverride,
+	BifrostContextKeyFallbacksOverride,
 	BifrostContextKeyRequestID,
 	BifrostContextKeyFallbackRequestID,
 	BifrostContextKeyDirectKey,
@@ -0,0 +1,50 @@
+package schemas
+
+// Context cache modes.
+const (
+	ContextCacheModeSession      = "session"       // The cache grows with each chat turn made against it
+	ContextCacheModeCommonPrefix = "common_prefix" // The cached messages are a fixed prefix shared by many requests
+)
+
+// BifrostContextCacheCreateRequest represents a request to cache a message prefix on the provider,
+// so that later chat requests can reference it by ID (see ChatParameters.ContextID) instead of
+// resending it.
+type BifrostContextCacheCreateRequest struct {
+	Provider       ModelProvider           `json:"provider"`
+	Model          string                  `json:"model"`
+	Input          []ChatMessage           `json:"input"` // Messages to cache
+	Params         *ContextCacheParameters `json:"params,omitempty"`
+	Fallbacks      []Fallback              `json:"fallbacks,omitempty"`
+	RawRequestBody []byte                  `json:"-"`
+}
+
+// GetRawRequestBody implements utils.RequestBodyGetter.
+func (b *BifrostContextCacheCreateRequest) GetRawRequestBody() []byte {
+	return b.RawRequestBody
+}
+
+type ContextCacheParameters struct {
+	Mode               *string                         `json:"mode,omitempty"` // "session" or "common_prefix"
+	TTL                *int                            `json:"ttl,omitempty"`  // Seconds the cache is kept after its last use
+	TruncationStrategy *ContextCacheTruncationStrategy `json:"truncation_strategy,omitempty"`
+	ExtraParams        map[string]interface{}          `json:"-"`
+}
+
+// ContextCacheTruncationStrategy controls how a session cache is trimmed once it outgrows the model's context.
+type ContextCacheTruncationStrategy struct {
+	Type              string `json:"type"`                          // "last_history_tokens" or "rolling_tokens"
+	LastHistoryTokens *int   `json:"last_history_tokens,omitempty"` // Tokens of history kept with "last_history_tokens"
+	RollingTokens     *bool  `json:"rolling_tokens,omitempty"`      // Whether to drop the oldest turns with "rolling_tokens"
+}
+
+// BifrostContextCacheCreateResponse represents a created context cache in bifrost format.
+type BifrostContextCacheCreateResponse struct {
+	ID                 string                          `json:"id"` // Pass as context_id in chat requests
+	Model              string                          `json:"model,omitempty"`
+	Mode               string                          `json:"mode,omitempty"`
+	TTL                int                             `json:"ttl,omitempty"`
+	TruncationStrategy *ContextCacheTruncationStrategy `json:"truncation_strategy,omitempty"`
+	Usage              *BifrostLLMUsage                `json:"usage,omitempty"`
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields,omitempty"`
+}
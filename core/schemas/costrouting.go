@@ -0,0 +1,70 @@
+package schemas
+
+import "fmt"
+
+// DefaultCostRoutingExpectedOutputTokens is the number of output tokens a request is priced with
+// when it does not limit them itself.
+const DefaultCostRoutingExpectedOutputTokens = 256
+
+// ModelPricing looks up the per-token input and output prices of a provider and model for a
+// request type. It returns false when the model has no known pricing.
+type ModelPricing func(provider ModelProvider, model string, requestType RequestType) (inputCostPerToken, outputCostPerToken float64, ok bool)
+
+// CostRoutingTarget is a provider and model serving a cost-routed alias, with its quality score.
+type CostRoutingTarget struct {
+	Provider ModelProvider `json:"provider"`
+	Model    string        `json:"model"`
+	Quality  float64       `json:"quality,omitempty"` // Score compared against the quality floor, on a scale of your choosing
+}
+
+// CostRoutingConfig maps logical model aliases to the provider and model targets that serve them.
+// A request for an alias (a model name without a provider) goes to the target with the lowest
+// estimated cost: its estimated prompt tokens times the input price plus its output token limit
+// (or ExpectedOutputTokens) times the output price, with prices from the model catalog. Targets
+// with a quality below QualityFloor are never used. Targets without pricing rank after the priced
+// ones, and targets that cannot serve the request type or whose circuit is open are tried last.
+// The other targets become the request's fallbacks, in rank order. An alias that is also a
+// latency-routed alias is routed by latency.
+type CostRoutingConfig struct {
+	Aliases              map[string][]CostRoutingTarget `json:"aliases"`                          // Alias name -> provider and model targets
+	QualityFloor         float64                        `json:"quality_floor,omitempty"`          // Minimum target quality (default 0, every target)
+	ExpectedOutputTokens int                            `json:"expected_output_tokens,omitempty"` // Output tokens priced when the request sets no limit (default 256)
+}
+
+// Validate checks every alias has targets with a provider and model, at least one of which meets
+// the quality floor.
+func (c *CostRoutingConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for alias, targets := range c.Aliases {
+		if alias == "" {
+			return fmt.Errorf("aliases: alias name must not be empty")
+		}
+		eligible := 0
+		for _, target := range targets {
+			if target.Provider == "" || target.Model == "" {
+				return fmt.Errorf("aliases.%s: targets need a provider and a model", alias)
+			}
+			if target.Quality >= c.QualityFloor {
+				eligible++
+			}
+		}
+		if eligible == 0 {
+			return fmt.Errorf("aliases.%s: at least one target must meet the quality floor of %v", alias, c.QualityFloor)
+		}
+	}
+	if c.ExpectedOutputTokens < 0 {
+		return fmt.Errorf("expected_output_tokens must not be negative")
+	}
+	return nil
+}
+
+// GetExpectedOutputTokens returns the output tokens priced for requests without a limit, or its
+// default when unset.
+func (c *CostRoutingConfig) GetExpectedOutputTokens() int {
+	if c.ExpectedOutputTokens <= 0 {
+		return DefaultCostRoutingExpectedOutputTokens
+	}
+	return c.ExpectedOutputTokens
+}
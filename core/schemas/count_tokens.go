@@ -10,5 +10,6 @@ type BifrostCountTokensResponse struct {
 	TokenStrings       []string                      `json:"token_strings,omitempty"`
 	OutputTokens       *int                          `json:"output_tokens,omitempty"`
 	TotalTokens        *int                          `json:"total_tokens"`
+	Tokenizer          string                        `json:"tokenizer,omitempty"` // Local tokenizer used when the count is approximate (see ExtraFields.Approximate)
 	ExtraFields        BifrostResponseExtraFields    `json:"extra_fields"`
 }
@@ -10,7 +10,9 @@ import (
 	"github.com/bytedance/sonic"
 )
 
-// EnvVar is a wrapper around a value that can be sourced from an environment variable.
+// EnvVar is a wrapper around a value that can be sourced from an environment variable ("env.NAME")
+// or from a secret manager ("vault://", "aws-sm://" or "gcp-sm://" references, see ParseSecretReference).
+// A secret manager reference is read on every access, so rotated secrets apply without a reload.
 type EnvVar struct {
 	Val     string `json:"value"`
 	EnvVar  string `json:"env_var"`
@@ -50,11 +52,16 @@ func NewEnvVar(value string) *EnvVar {
 						e.Val = envValue
 					}
 					e.FromEnv = true
+				} else if e.Val == e.EnvVar && IsExternalSecretReference(e.EnvVar) {
+					return newExternalSecretEnvVar(e.EnvVar)
 				}
 				return e
 			}
 		}
 	}
+	if IsExternalSecretReference(val) {
+		return newExternalSecretEnvVar(val)
+	}
 	if envKey, ok := strings.CutPrefix(val, "env."); ok {
 		if envValue, ok := os.LookupEnv(envKey); ok {
 			return &EnvVar{
@@ -180,12 +187,18 @@ func (e *EnvVar) UnmarshalJSON(data []byte) error {
 						e.Val = envValue
 					}
 					e.FromEnv = true
+				} else if e.Val == e.EnvVar && IsExternalSecretReference(e.EnvVar) {
+					*e = *newExternalSecretEnvVar(e.EnvVar)
 				}
 				return nil
 			}
 			// Else the value is JSON, so we will treat this as a normal value
 		}
 	}
+	if IsExternalSecretReference(val) {
+		*e = *newExternalSecretEnvVar(val)
+		return nil
+	}
 	if envKey, ok := strings.CutPrefix(val, "env."); ok {
 		if envValue, ok := os.LookupEnv(envKey); ok {
 			e.Val = envValue
@@ -206,7 +219,7 @@ func (e *EnvVar) UnmarshalJSON(data []byte) error {
 
 // String returns the value as a string.
 func (e *EnvVar) String() string {
-	return e.Val
+	return e.GetValue()
 }
 
 // Scan scans the value from the database.
@@ -224,6 +237,10 @@ func (e *EnvVar) Scan(value any) error {
 		// Cleanup string if required
 		// The string may have "\"env.TEST\"", "env.TEST" or "env.TEST\"", we need to clean it up to "env.TEST"
 		val := strings.Trim(v, "\"")
+		if IsExternalSecretReference(val) {
+			*e = *newExternalSecretEnvVar(val)
+			return nil
+		}
 		if envKey, ok := strings.CutPrefix(val, "env."); ok {
 			if envValue, ok := os.LookupEnv(envKey); ok {
 				e.Val = envValue
@@ -254,16 +271,21 @@ func (e EnvVar) Value() (driver.Value, error) {
 	return e.Val, nil
 }
 
-// IsFromEnv returns true if the value is sourced from an environment variable.
+// IsFromEnv returns true if the value is sourced from an environment variable or a secret manager.
 func (e *EnvVar) IsFromEnv() bool {
 	return e.FromEnv
 }
 
-// GetValue returns the value.
+// GetValue returns the value. A secret manager reference returns the value it was last resolved to.
 func (e *EnvVar) GetValue() string {
 	if e == nil {
 		return ""
 	}
+	if e.FromEnv && e.EnvVar != "" {
+		if value, ok := ResolvedSecret(e.EnvVar); ok {
+			return value
+		}
+	}
 	return e.Val
 }
 
@@ -272,6 +294,11 @@ func (e *EnvVar) GetValuePtr() *string {
 	if e == nil {
 		return nil
 	}
+	if e.FromEnv && e.EnvVar != "" {
+		if value, ok := ResolvedSecret(e.EnvVar); ok {
+			return &value
+		}
+	}
 	return &e.Val
 }
 
@@ -298,3 +325,14 @@ func (e *EnvVar) CoerceBool(defaultValue bool) bool {
 	}
 	return val
 }
+
+// newExternalSecretEnvVar creates the EnvVar of a secret manager reference, holding the value the
+// reference was last resolved to ("" until the secret rotator resolves it).
+func newExternalSecretEnvVar(reference string) *EnvVar {
+	value, _ := ResolvedSecret(reference)
+	return &EnvVar{
+		Val:     value,
+		FromEnv: true,
+		EnvVar:  reference,
+	}
+}
@@ -419,3 +419,48 @@ func TestEnvVar_IsRedacted(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvVar_ExternalSecretReference(t *testing.T) {
+	const reference = "vault://secret/data/envvar-test#api_key"
+	defer ForgetResolvedSecret(reference)
+
+	for _, plain := range []string{"sk-plain-value", "vault.internal", "aws-sm.prod/openai"} {
+		if _, _, ok := ParseSecretReference(plain); ok {
+			t.Errorf("plain value %q parsed as a reference", plain)
+		}
+	}
+	if envVar := NewEnvVar("vault.internal"); envVar.GetValue() != "vault.internal" || envVar.IsFromEnv() {
+		t.Errorf("plain value with a source prefix was not kept: %+v", envVar)
+	}
+	if source, ref, ok := ParseSecretReference(reference); !ok || source != SecretSourceVault || ref != "secret/data/envvar-test#api_key" {
+		t.Errorf("ParseSecretReference() = %q, %q, %v", source, ref, ok)
+	}
+	if IsExternalSecretReference("env.TEST_API_KEY") || !IsExternalSecretReference("aws-sm://prod/openai") {
+		t.Error("only secret manager references are external")
+	}
+
+	// Unresolved references are kept with an empty value, and stored as the reference
+	var envVar EnvVar
+	if err := json.Unmarshal([]byte(`"`+reference+`"`), &envVar); err != nil {
+		t.Fatalf("UnmarshalJSON failed: %v", err)
+	}
+	if envVar.GetValue() != "" || !envVar.IsFromEnv() || envVar.EnvVar != reference {
+		t.Errorf("unexpected unresolved reference: %+v", envVar)
+	}
+	if stored, _ := envVar.Value(); stored != reference {
+		t.Errorf("Value() = %v, want the reference", stored)
+	}
+
+	// Resolved and rotated values apply to already parsed references
+	SetResolvedSecret(reference, "sk-first")
+	if envVar.GetValue() != "sk-first" {
+		t.Errorf("GetValue() = %q, want the resolved secret", envVar.GetValue())
+	}
+	SetResolvedSecret(reference, "sk-rotated")
+	if envVar.GetValue() != "sk-rotated" || *envVar.GetValuePtr() != "sk-rotated" {
+		t.Errorf("GetValue() = %q, want the rotated secret", envVar.GetValue())
+	}
+	if scanned := NewEnvVar(reference); scanned.Val != "sk-rotated" {
+		t.Errorf("NewEnvVar() = %+v, want the resolved secret", scanned)
+	}
+}
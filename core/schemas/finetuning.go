@@ -0,0 +1,217 @@
+// Package schemas defines the core schemas and types used by the Bifrost system.
+package schemas
+
+// FineTuningJobStatus represents the status of a fine-tuning job.
+type FineTuningJobStatus string
+
+const (
+	FineTuningJobStatusValidatingFiles FineTuningJobStatus = "validating_files"
+	FineTuningJobStatusQueued          FineTuningJobStatus = "queued"
+	FineTuningJobStatusRunning         FineTuningJobStatus = "running"
+	FineTuningJobStatusSucceeded       FineTuningJobStatus = "succeeded"
+	FineTuningJobStatusFailed          FineTuningJobStatus = "failed"
+	FineTuningJobStatusCancelled       FineTuningJobStatus = "cancelled"
+)
+
+// FineTuningHyperparameters are the hyperparameters of a fine-tuning job. Each value is either
+// "auto" or a number, so they are kept as interface{}.
+type FineTuningHyperparameters struct {
+	BatchSize              interface{} `json:"batch_size,omitempty"`
+	LearningRateMultiplier interface{} `json:"learning_rate_multiplier,omitempty"`
+	NEpochs                interface{} `json:"n_epochs,omitempty"`
+	Beta                   interface{} `json:"beta,omitempty"` // DPO only
+}
+
+// FineTuningMethod is the method used to fine-tune a model.
+type FineTuningMethod struct {
+	Type       string                  `json:"type"` // "supervised", "dpo" or "reinforcement"
+	Supervised *FineTuningMethodConfig `json:"supervised,omitempty"`
+	DPO        *FineTuningMethodConfig `json:"dpo,omitempty"`
+
+	// Reinforcement fine-tuning carries a grader, which is passed through as is
+	Reinforcement map[string]interface{} `json:"reinforcement,omitempty"`
+}
+
+// FineTuningMethodConfig is the configuration of a supervised or DPO fine-tuning method.
+type FineTuningMethodConfig struct {
+	Hyperparameters *FineTuningHyperparameters `json:"hyperparameters,omitempty"`
+}
+
+// FineTuningJobError is the error of a failed fine-tuning job.
+type FineTuningJobError struct {
+	Code    string  `json:"code,omitempty"`
+	Message string  `json:"message,omitempty"`
+	Param   *string `json:"param,omitempty"`
+}
+
+// FineTuningJob represents a fine-tuning job returned by the API.
+type FineTuningJob struct {
+	ID              string                     `json:"id"`
+	Object          string                     `json:"object,omitempty"` // "fine_tuning.job"
+	Model           string                     `json:"model"`            // Base model being fine-tuned
+	CreatedAt       int64                      `json:"created_at"`
+	FinishedAt      *int64                     `json:"finished_at,omitempty"`
+	EstimatedFinish *int64                     `json:"estimated_finish,omitempty"`
+	FineTunedModel  *string                    `json:"fine_tuned_model,omitempty"` // Set once the job has succeeded
+	OrganizationID  string                     `json:"organization_id,omitempty"`
+	Status          FineTuningJobStatus        `json:"status"`
+	TrainingFile    string                     `json:"training_file"`
+	ValidationFile  *string                    `json:"validation_file,omitempty"`
+	ResultFiles     []string                   `json:"result_files,omitempty"`
+	TrainedTokens   *int                       `json:"trained_tokens,omitempty"`
+	Hyperparameters *FineTuningHyperparameters `json:"hyperparameters,omitempty"`
+	Method          *FineTuningMethod          `json:"method,omitempty"`
+	Error           *FineTuningJobError        `json:"error,omitempty"`
+	Seed            *int                       `json:"seed,omitempty"`
+	Suffix          *string                    `json:"suffix,omitempty"`
+	Metadata        map[string]string          `json:"metadata,omitempty"`
+}
+
+// FineTuningJobEvent represents a status or metrics event of a fine-tuning job.
+type FineTuningJobEvent struct {
+	ID        string                 `json:"id"`
+	Object    string                 `json:"object,omitempty"` // "fine_tuning.job.event"
+	CreatedAt int64                  `json:"created_at"`
+	Level     string                 `json:"level"` // "info", "warn" or "error"
+	Message   string                 `json:"message"`
+	Type      string                 `json:"type,omitempty"` // "message" or "metrics"
+	Data      map[string]interface{} `json:"data,omitempty"`
+}
+
+// FineTuningJobCheckpoint represents a model checkpoint saved during a fine-tuning job.
+type FineTuningJobCheckpoint struct {
+	ID                       string             `json:"id"`
+	Object                   string             `json:"object,omitempty"` // "fine_tuning.job.checkpoint"
+	CreatedAt                int64              `json:"created_at"`
+	FineTunedModelCheckpoint string             `json:"fine_tuned_model_checkpoint"` // Model name of the checkpoint, usable for inference
+	FineTuningJobID          string             `json:"fine_tuning_job_id"`
+	StepNumber               int                `json:"step_number"`
+	Metrics                  map[string]float64 `json:"metrics,omitempty"`
+}
+
+// BifrostFineTuningJobCreateRequest represents a request to create a fine-tuning job.
+type BifrostFineTuningJobCreateRequest struct {
+	Provider ModelProvider `json:"provider"`
+	Model    string        `json:"model"` // Base model to fine-tune
+
+	// Required fields
+	TrainingFile string `json:"training_file"` // ID of an uploaded file with the training data
+
+	// Optional fields
+	ValidationFile  *string                    `json:"validation_file,omitempty"`
+	Hyperparameters *FineTuningHyperparameters `json:"hyperparameters,omitempty"` // Deprecated by OpenAI in favour of Method
+	Method          *FineTuningMethod          `json:"method,omitempty"`
+	Suffix          *string                    `json:"suffix,omitempty"` // Added to the name of the fine-tuned model
+	Seed            *int                       `json:"seed,omitempty"`
+	Metadata        map[string]string          `json:"metadata,omitempty"`
+
+	// Extra parameters for provider-specific features, e.g. integrations
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostFineTuningJobCreateResponse represents the response from creating a fine-tuning job.
+type BifrostFineTuningJobCreateResponse struct {
+	FineTuningJob
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
+// BifrostFineTuningJobListRequest represents a request to list fine-tuning jobs.
+type BifrostFineTuningJobListRequest struct {
+	Provider ModelProvider `json:"provider"`
+
+	// Pagination
+	Limit int     `json:"limit,omitempty"` // Max results to return (default 20)
+	After *string `json:"after,omitempty"` // Cursor for pagination
+
+	// Extra parameters for provider-specific features
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostFineTuningJobListResponse represents the response from listing fine-tuning jobs.
+type BifrostFineTuningJobListResponse struct {
+	Object  string          `json:"object,omitempty"` // "list"
+	Data    []FineTuningJob `json:"data"`
+	HasMore bool            `json:"has_more,omitempty"`
+	After   *string         `json:"after,omitempty"` // Encoded cursor for next page (includes key index for multi-key pagination)
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
+// BifrostFineTuningJobRetrieveRequest represents a request to retrieve a fine-tuning job.
+type BifrostFineTuningJobRetrieveRequest struct {
+	Provider ModelProvider `json:"provider"`
+	JobID    string        `json:"fine_tuning_job_id"` // ID of the fine-tuning job to retrieve
+
+	// Extra parameters for provider-specific features
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostFineTuningJobRetrieveResponse represents the response from retrieving a fine-tuning job.
+type BifrostFineTuningJobRetrieveResponse struct {
+	FineTuningJob
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
+// BifrostFineTuningJobCancelRequest represents a request to cancel a fine-tuning job.
+type BifrostFineTuningJobCancelRequest struct {
+	Provider ModelProvider `json:"provider"`
+	JobID    string        `json:"fine_tuning_job_id"` // ID of the fine-tuning job to cancel
+
+	// Extra parameters for provider-specific features
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostFineTuningJobCancelResponse represents the response from cancelling a fine-tuning job.
+type BifrostFineTuningJobCancelResponse struct {
+	FineTuningJob
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
+// BifrostFineTuningJobEventsRequest represents a request to list the events of a fine-tuning job.
+type BifrostFineTuningJobEventsRequest struct {
+	Provider ModelProvider `json:"provider"`
+	JobID    string        `json:"fine_tuning_job_id"` // ID of the fine-tuning job
+
+	// Pagination
+	Limit int     `json:"limit,omitempty"` // Max results to return (default 20)
+	After *string `json:"after,omitempty"` // ID of the last event of the previous page
+
+	// Extra parameters for provider-specific features
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostFineTuningJobEventsResponse represents the response from listing the events of a fine-tuning job.
+type BifrostFineTuningJobEventsResponse struct {
+	Object  string               `json:"object,omitempty"` // "list"
+	Data    []FineTuningJobEvent `json:"data"`
+	HasMore bool                 `json:"has_more,omitempty"`
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
+// BifrostFineTuningJobCheckpointsRequest represents a request to list the checkpoints of a fine-tuning job.
+type BifrostFineTuningJobCheckpointsRequest struct {
+	Provider ModelProvider `json:"provider"`
+	JobID    string        `json:"fine_tuning_job_id"` // ID of the fine-tuning job
+
+	// Pagination
+	Limit int     `json:"limit,omitempty"` // Max results to return (default 10)
+	After *string `json:"after,omitempty"` // ID of the last checkpoint of the previous page
+
+	// Extra parameters for provider-specific features
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostFineTuningJobCheckpointsResponse represents the response from listing the checkpoints of a fine-tuning job.
+type BifrostFineTuningJobCheckpointsResponse struct {
+	Object  string                    `json:"object,omitempty"` // "list"
+	Data    []FineTuningJobCheckpoint `json:"data"`
+	FirstID *string                   `json:"first_id,omitempty"`
+	LastID  *string                   `json:"last_id,omitempty"`
+	HasMore bool                      `json:"has_more,omitempty"`
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
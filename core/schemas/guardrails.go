@@ -0,0 +1,25 @@
+package schemas
+
+// Guardrail stages
+const (
+	GuardrailStageInput  = "input"
+	GuardrailStageOutput = "output"
+)
+
+// Guardrail actions
+const (
+	GuardrailActionBlock    = "block"
+	GuardrailActionRedact   = "redact"
+	GuardrailActionAnnotate = "annotate"
+	GuardrailActionTokenize = "tokenize"
+)
+
+// GuardrailResult records a guardrail rule that matched the text of a request or response. It is
+// added to the response's extra fields by guardrail plugins.
+type GuardrailResult struct {
+	Rule    string   `json:"rule"`              // Name of the rule
+	Type    string   `json:"type"`              // Kind of check, e.g. "pii", "prompt_injection" or "blocklist"
+	Stage   string   `json:"stage"`             // "input" or "output"
+	Action  string   `json:"action"`            // "block", "redact", "tokenize" or "annotate"
+	Matches []string `json:"matches,omitempty"` // What matched, e.g. PII types or blocklist terms (never the matched text itself)
+}
@@ -0,0 +1,112 @@
+package schemas
+
+import (
+	"fmt"
+	"strings"
+)
+
+// protectedHeaders are never forwarded from client requests nor injected by a header policy: credentials,
+// which providers set from their keys, and connection level headers.
+var protectedHeaders = map[string]bool{
+	"authorization":       true,
+	"proxy-authorization": true,
+	"x-api-key":           true,
+	"x-goog-api-key":      true,
+	"api-key":             true,
+	"cookie":              true,
+	"host":                true,
+	"content-length":      true,
+	"content-type":        true,
+	"accept-encoding":     true,
+	"connection":          true,
+	"keep-alive":          true,
+	"transfer-encoding":   true,
+	"te":                  true,
+	"trailer":             true,
+	"upgrade":             true,
+}
+
+// IsProtectedHeader reports whether a header (case-insensitive) can never be forwarded or injected by a header policy.
+func IsProtectedHeader(name string) bool {
+	name = strings.ToLower(name)
+	return protectedHeaders[name] || strings.HasPrefix(name, "x-bf-")
+}
+
+// HeaderPolicyConfig controls the headers sent to providers, on top of the x-bf-eh-* headers of the request:
+// which client request headers are forwarded as is, which headers are stripped, and which static headers
+// are injected for a provider or a team. It supersedes the static extra headers of the network config,
+// which are only added when no header of the same name is sent.
+//
+// Header names are case-insensitive. A name ending with "*" matches every header with that prefix.
+// Credentials and connection headers (see IsProtectedHeader) are never forwarded or injected.
+type HeaderPolicyConfig struct {
+	Forward []string          `json:"forward,omitempty"` // Client request headers forwarded to every provider, e.g. "x-request-id" or "openai-*"
+	Strip   []string          `json:"strip,omitempty"`   // Headers removed from the forwarded and x-bf-eh-* headers
+	Inject  []HeaderInjection `json:"inject,omitempty"`  // Static headers added to the requests of matching providers and teams, in order
+}
+
+// HeaderInjection is a set of static headers sent to the providers and for the teams it matches.
+// Injected headers replace the client's headers of the same name only when Override is set, and
+// the headers of earlier injections in any case.
+type HeaderInjection struct {
+	Providers []ModelProvider   `json:"providers,omitempty"` // Providers the headers are sent to (empty = all)
+	Teams     []string          `json:"teams,omitempty"`     // IDs of the governance teams whose requests get the headers (empty = all)
+	Headers   map[string]string `json:"headers"`
+	Override  bool              `json:"override,omitempty"` // Replace headers of the same name sent by the client
+}
+
+// Validate checks that the header names are valid and allowed.
+func (c *HeaderPolicyConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for _, pattern := range c.Forward {
+		if err := validateHeaderPattern(pattern); err != nil {
+			return fmt.Errorf("forward: %w", err)
+		}
+		if !strings.HasSuffix(pattern, "*") && IsProtectedHeader(pattern) {
+			return fmt.Errorf("forward: header %q cannot be forwarded", pattern)
+		}
+	}
+	for _, pattern := range c.Strip {
+		if err := validateHeaderPattern(pattern); err != nil {
+			return fmt.Errorf("strip: %w", err)
+		}
+	}
+	for i, injection := range c.Inject {
+		if len(injection.Headers) == 0 {
+			return fmt.Errorf("inject[%d]: headers are required", i)
+		}
+		for name := range injection.Headers {
+			if err := validateHeaderPattern(name); err != nil || strings.HasSuffix(name, "*") {
+				return fmt.Errorf("inject[%d]: invalid header name %q", i, name)
+			}
+			if IsProtectedHeader(name) {
+				return fmt.Errorf("inject[%d]: header %q cannot be injected", i, name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateHeaderPattern checks that pattern is a header name, optionally ending with "*".
+func validateHeaderPattern(pattern string) error {
+	name := strings.TrimSuffix(pattern, "*")
+	if name == "" && pattern != "*" {
+		return fmt.Errorf("empty header name")
+	}
+	for _, r := range name {
+		if r > 0x7e || r <= ' ' || strings.ContainsRune("\"(),/:;<=>?@[\\]{}*", r) {
+			return fmt.Errorf("invalid header name %q", pattern)
+		}
+	}
+	return nil
+}
+
+// MatchesHeader reports whether a header name matches pattern (case-insensitive, with a trailing "*" wildcard).
+func MatchesHeader(pattern string, name string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return len(name) >= len(prefix) && strings.EqualFold(name[:len(prefix)], prefix)
+	}
+	return strings.EqualFold(pattern, name)
+}
@@ -1,5 +1,11 @@
 package schemas
 
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+)
+
 type ImageEventType string
 
 const (
@@ -72,10 +78,19 @@ type ImageGenerationResponseParameters struct {
 }
 
 type ImageData struct {
-	URL           string `json:"url,omitempty"`
-	B64JSON       string `json:"b64_json,omitempty"`
-	RevisedPrompt string `json:"revised_prompt,omitempty"`
-	Index         int    `json:"index"`
+	URL           string                 `json:"url,omitempty"`
+	B64JSON       string                 `json:"b64_json,omitempty"`
+	MimeType      string                 `json:"mime_type,omitempty"`
+	RevisedPrompt string                 `json:"revised_prompt,omitempty"`
+	Seed          *int64                 `json:"seed,omitempty"`
+	Safety        *ImageSafetyAnnotation `json:"safety,omitempty"`
+	Index         int                    `json:"index"`
+}
+
+// ImageSafetyAnnotation reports a provider's content-safety verdict for a generated image
+type ImageSafetyAnnotation struct {
+	Flagged bool   `json:"flagged"`
+	Reason  string `json:"reason,omitempty"` // provider-specific reason or category, e.g. "nsfw_concepts"
 }
 
 type ImageUsage struct {
@@ -92,6 +107,95 @@ type ImageTokenDetails struct {
 	TextTokens  int `json:"text_tokens,omitempty"`
 }
 
+// Normalize brings provider-specific image results into a consistent shape:
+// images are indexed by position, base64 data URLs are split into MimeType and raw base64,
+// MimeType is filled from the image bytes or the output format, and OutputFormat is set
+// when every image has the same type.
+func (r *BifrostImageGenerationResponse) Normalize() {
+	if r == nil {
+		return
+	}
+	var outputFormat string
+	if r.ImageGenerationResponseParameters != nil {
+		outputFormat = r.OutputFormat
+	}
+	commonMimeType := ""
+	for i := range r.Data {
+		image := &r.Data[i]
+		image.Index = i
+		if rest, ok := strings.CutPrefix(image.B64JSON, "data:"); ok {
+			if header, data, found := strings.Cut(rest, ","); found && strings.HasSuffix(header, ";base64") {
+				image.B64JSON = data
+				if image.MimeType == "" {
+					image.MimeType = strings.TrimSuffix(header, ";base64")
+				}
+			}
+		}
+		if image.MimeType == "" && image.B64JSON != "" {
+			image.MimeType = detectImageMimeType(image.B64JSON)
+		}
+		if image.MimeType == "" && outputFormat != "" && (image.B64JSON != "" || image.URL != "") {
+			image.MimeType = imageFormatToMimeType(outputFormat)
+		}
+		if i == 0 {
+			commonMimeType = image.MimeType
+		} else if image.MimeType != commonMimeType {
+			commonMimeType = ""
+		}
+	}
+	if outputFormat == "" && commonMimeType != "" {
+		if r.ImageGenerationResponseParameters == nil {
+			r.ImageGenerationResponseParameters = &ImageGenerationResponseParameters{}
+		}
+		r.OutputFormat = strings.TrimPrefix(strings.TrimPrefix(commonMimeType, "image/"), "x-")
+		if r.OutputFormat == "jpg" {
+			r.OutputFormat = "jpeg"
+		}
+	}
+}
+
+// imageFormatToMimeType maps an output format ("png", "jpg", ...) to its MIME type
+func imageFormatToMimeType(format string) string {
+	format = strings.ToLower(strings.TrimPrefix(format, "."))
+	if format == "jpg" {
+		format = "jpeg"
+	}
+	return "image/" + format
+}
+
+// imageSignatures maps leading magic bytes to MIME types for the formats providers return
+var imageSignatures = []struct {
+	prefix   []byte
+	mimeType string
+}{
+	{[]byte("\x89PNG\r\n\x1a\n"), "image/png"},
+	{[]byte{0xFF, 0xD8, 0xFF}, "image/jpeg"},
+	{[]byte("GIF87a"), "image/gif"},
+	{[]byte("GIF89a"), "image/gif"},
+}
+
+// detectImageMimeType sniffs the MIME type of base64-encoded image data, returning "" if unknown
+func detectImageMimeType(b64 string) string {
+	// 16 base64 characters decode to 12 bytes, enough for every signature
+	head := b64
+	if len(head) > 16 {
+		head = head[:16]
+	}
+	data, err := base64.StdEncoding.DecodeString(head)
+	if err != nil {
+		return ""
+	}
+	for _, signature := range imageSignatures {
+		if bytes.HasPrefix(data, signature.prefix) {
+			return signature.mimeType
+		}
+	}
+	if len(data) >= 12 && string(data[0:4]) == "RIFF" && string(data[8:12]) == "WEBP" {
+		return "image/webp"
+	}
+	return ""
+}
+
 // Streaming Response
 type BifrostImageGenerationStreamResponse struct {
 	ID                string                     `json:"id,omitempty"`
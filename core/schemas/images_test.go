@@ -0,0 +1,52 @@
+package schemas
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestBifrostImageGenerationResponse_Normalize(t *testing.T) {
+	png := base64.StdEncoding.EncodeToString([]byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR"))
+	jpeg := base64.StdEncoding.EncodeToString([]byte{0xFF, 0xD8, 0xFF, 0xE0, 0x00, 0x10, 'J', 'F', 'I', 'F', 0x00, 0x01})
+
+	resp := &BifrostImageGenerationResponse{
+		Data: []ImageData{
+			{B64JSON: png, Index: 1},
+			{B64JSON: "data:image/png;base64," + png, Index: 3},
+		},
+	}
+	resp.Normalize()
+
+	for i, image := range resp.Data {
+		if image.Index != i {
+			t.Errorf("image %d index = %d", i, image.Index)
+		}
+		if image.MimeType != "image/png" {
+			t.Errorf("image %d mime type = %q, want image/png", i, image.MimeType)
+		}
+		if image.B64JSON != png {
+			t.Errorf("image %d b64 = %q, want data URL prefix stripped", i, image.B64JSON)
+		}
+	}
+	if resp.ImageGenerationResponseParameters == nil || resp.OutputFormat != "png" {
+		t.Errorf("output format = %+v, want png", resp.ImageGenerationResponseParameters)
+	}
+
+	mixed := &BifrostImageGenerationResponse{Data: []ImageData{{B64JSON: png}, {B64JSON: jpeg}}}
+	mixed.Normalize()
+	if mixed.Data[1].MimeType != "image/jpeg" {
+		t.Errorf("jpeg mime type = %q", mixed.Data[1].MimeType)
+	}
+	if mixed.ImageGenerationResponseParameters != nil {
+		t.Errorf("output format should stay unset for mixed types, got %+v", mixed.ImageGenerationResponseParameters)
+	}
+
+	urls := &BifrostImageGenerationResponse{
+		Data:                              []ImageData{{URL: "https://example.com/a"}},
+		ImageGenerationResponseParameters: &ImageGenerationResponseParameters{OutputFormat: "jpg"},
+	}
+	urls.Normalize()
+	if urls.Data[0].MimeType != "image/jpeg" {
+		t.Errorf("url image mime type = %q, want image/jpeg from output format", urls.Data[0].MimeType)
+	}
+}
@@ -0,0 +1,105 @@
+package schemas
+
+import "fmt"
+
+// Latency routing defaults.
+const (
+	DefaultLatencyRoutingMinSamples    = 10
+	DefaultLatencyRoutingWindowSeconds = 300
+)
+
+// LatencyPercentile is the latency percentile alias targets are ranked by.
+type LatencyPercentile string
+
+const (
+	LatencyPercentileP50 LatencyPercentile = "p50"
+	LatencyPercentileP95 LatencyPercentile = "p95"
+)
+
+// LatencyRoutingConfig maps logical model aliases to the provider and model targets that serve
+// them. A request for an alias (a model name without a provider) goes to the currently fastest
+// healthy target. Targets that cannot serve the request type, whose circuit is open or that failed
+// more often than they succeeded in the window are tried last; the rest are ranked by their recent
+// latency at the configured percentile. Targets with fewer than MinSamples latencies in the window
+// rank first, in config order, so every target gets measured. The other targets become the
+// request's fallbacks, in rank order.
+type LatencyRoutingConfig struct {
+	Aliases       map[string][]Fallback `json:"aliases"`                  // Alias name -> provider and model targets
+	Percentile    LatencyPercentile     `json:"percentile,omitempty"`     // Percentile targets are ranked by (default p95)
+	MinSamples    int                   `json:"min_samples,omitempty"`    // Latencies needed before a target is ranked by them (default 10)
+	WindowSeconds int                   `json:"window_seconds,omitempty"` // Age of the oldest latency taken into account (default 300)
+}
+
+// Validate checks every alias has targets with a provider and model, and the percentile is known.
+func (c *LatencyRoutingConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for alias, targets := range c.Aliases {
+		if alias == "" {
+			return fmt.Errorf("aliases: alias name must not be empty")
+		}
+		if len(targets) == 0 {
+			return fmt.Errorf("aliases.%s: at least one target is required", alias)
+		}
+		for _, target := range targets {
+			if target.Provider == "" || target.Model == "" {
+				return fmt.Errorf("aliases.%s: targets need a provider and a model", alias)
+			}
+		}
+	}
+	switch c.Percentile {
+	case "", LatencyPercentileP50, LatencyPercentileP95:
+	default:
+		return fmt.Errorf("percentile must be %s or %s", LatencyPercentileP50, LatencyPercentileP95)
+	}
+	if c.MinSamples < 0 {
+		return fmt.Errorf("min_samples must not be negative")
+	}
+	if c.WindowSeconds < 0 {
+		return fmt.Errorf("window_seconds must not be negative")
+	}
+	return nil
+}
+
+// GetPercentile returns the ranking percentile, or its default when unset.
+func (c *LatencyRoutingConfig) GetPercentile() LatencyPercentile {
+	if c.Percentile == "" {
+		return LatencyPercentileP95
+	}
+	return c.Percentile
+}
+
+// GetMinSamples returns the minimum number of latencies, or its default when unset.
+func (c *LatencyRoutingConfig) GetMinSamples() int {
+	if c.MinSamples <= 0 {
+		return DefaultLatencyRoutingMinSamples
+	}
+	return c.MinSamples
+}
+
+// GetWindowSeconds returns the latency window, or its default when unset.
+func (c *LatencyRoutingConfig) GetWindowSeconds() int {
+	if c.WindowSeconds <= 0 {
+		return DefaultLatencyRoutingWindowSeconds
+	}
+	return c.WindowSeconds
+}
+
+// LatencyStats are the recent latencies of a provider and model.
+type LatencyStats struct {
+	Provider ModelProvider `json:"provider"`
+	Model    string        `json:"model"`
+	Samples  int           `json:"samples"`  // Latencies in the window
+	Failures int           `json:"failures"` // Upstream failures in the window
+	P50      int64         `json:"p50_ms"`   // Median latency in milliseconds
+	P95      int64         `json:"p95_ms"`   // 95th percentile latency in milliseconds
+}
+
+// Percentile returns the latency at the given percentile in milliseconds.
+func (s LatencyStats) Percentile(p LatencyPercentile) int64 {
+	if p == LatencyPercentileP50 {
+		return s.P50
+	}
+	return s.P95
+}
@@ -0,0 +1,84 @@
+package schemas
+
+import "fmt"
+
+// RequestPriority classifies a request for load shedding and provider scheduling. Under overload,
+// lower priority requests are rejected first so interactive traffic keeps its latency, and a
+// provider's scheduler admits queued requests by priority.
+type RequestPriority string
+
+const (
+	RequestPriorityInteractive RequestPriority = "interactive" // Never shed; only limited by the queue size
+	RequestPriorityNormal      RequestPriority = "normal"      // Default for requests without a priority
+	RequestPriorityBackground  RequestPriority = "background"  // Shed first (evals, batch backfills, ...)
+)
+
+// Default queue fill ratios at which requests are shed.
+const (
+	DefaultLoadSheddingNormalThreshold     = 0.9
+	DefaultLoadSheddingBackgroundThreshold = 0.5
+	DefaultLoadSheddingMaxRetryAfter       = 60
+)
+
+// ParseRequestPriority parses a priority name, returning false for unknown names.
+func ParseRequestPriority(value string) (RequestPriority, bool) {
+	switch priority := RequestPriority(value); priority {
+	case RequestPriorityInteractive, RequestPriorityNormal, RequestPriorityBackground:
+		return priority, true
+	}
+	return "", false
+}
+
+// LoadSheddingConfig controls early rejection of low priority requests while a provider's
+// request queue is filling up. Thresholds are fractions of the provider's buffer size;
+// interactive requests are never shed.
+type LoadSheddingConfig struct {
+	Enabled              bool    `json:"enabled"`
+	NormalThreshold      float64 `json:"normal_threshold,omitempty"`        // Queue fill ratio at which normal requests are shed (default 0.9)
+	BackgroundThreshold  float64 `json:"background_threshold,omitempty"`    // Queue fill ratio at which background requests are shed (default 0.5)
+	MaxRetryAfterSeconds int     `json:"max_retry_after_seconds,omitempty"` // Upper bound of the Retry-After returned to shed requests (default 60)
+}
+
+// Validate checks that the thresholds are fill ratios and ordered by priority.
+func (c *LoadSheddingConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.NormalThreshold < 0 || c.NormalThreshold > 1 {
+		return fmt.Errorf("normal_threshold must be between 0 and 1")
+	}
+	if c.BackgroundThreshold < 0 || c.BackgroundThreshold > 1 {
+		return fmt.Errorf("background_threshold must be between 0 and 1")
+	}
+	if c.MaxRetryAfterSeconds < 0 {
+		return fmt.Errorf("max_retry_after_seconds must not be negative")
+	}
+	if c.GetBackgroundThreshold() > c.GetNormalThreshold() {
+		return fmt.Errorf("background_threshold must not be greater than normal_threshold")
+	}
+	return nil
+}
+
+// GetNormalThreshold returns the normal threshold, or its default when unset.
+func (c *LoadSheddingConfig) GetNormalThreshold() float64 {
+	if c.NormalThreshold <= 0 {
+		return DefaultLoadSheddingNormalThreshold
+	}
+	return c.NormalThreshold
+}
+
+// GetBackgroundThreshold returns the background threshold, or its default when unset.
+func (c *LoadSheddingConfig) GetBackgroundThreshold() float64 {
+	if c.BackgroundThreshold <= 0 {
+		return DefaultLoadSheddingBackgroundThreshold
+	}
+	return c.BackgroundThreshold
+}
+
+// GetMaxRetryAfterSeconds returns the Retry-After upper bound, or its default when unset.
+func (c *LoadSheddingConfig) GetMaxRetryAfterSeconds() int {
+	if c.MaxRetryAfterSeconds <= 0 {
+		return DefaultLoadSheddingMaxRetryAfter
+	}
+	return c.MaxRetryAfterSeconds
+}
@@ -0,0 +1,130 @@
+package schemas
+
+import (
+	"fmt"
+)
+
+// BifrostModerationRequest represents a content moderation request in bifrost format.
+type BifrostModerationRequest struct {
+	Provider       ModelProvider         `json:"provider"`
+	Model          string                `json:"model"`
+	Input          *ModerationInput      `json:"input,omitempty"`
+	Params         *ModerationParameters `json:"params,omitempty"`
+	Fallbacks      []Fallback            `json:"fallbacks,omitempty"`
+	RawRequestBody []byte                `json:"-"` // set bifrost-use-raw-request-body to true in ctx to use the raw request body. Bifrost will directly send this to the downstream provider.
+}
+
+// GetRawRequestBody implements utils.RequestBodyGetter.
+func (r *BifrostModerationRequest) GetRawRequestBody() []byte {
+	return r.RawRequestBody
+}
+
+// ModerationInput represents the input of a moderation request: a text, a list of texts classified
+// separately, or a list of text and image parts classified together.
+type ModerationInput struct {
+	Text             *string
+	Texts            []string
+	MultiModalInputs []ModerationMultiModalInput
+}
+
+// ModerationMultiModalInputType represents the type of a multimodal moderation input.
+type ModerationMultiModalInputType string
+
+const (
+	ModerationInputText     ModerationMultiModalInputType = "text"
+	ModerationInputImageURL ModerationMultiModalInputType = "image_url"
+)
+
+// ModerationMultiModalInput represents a single text or image part of a moderation input.
+type ModerationMultiModalInput struct {
+	Type     ModerationMultiModalInputType `json:"type"`
+	Text     *string                       `json:"text,omitempty"`
+	ImageURL *ModerationImageURL           `json:"image_url,omitempty"`
+}
+
+// ModerationImageURL holds the URL or base64 data URL of an image input.
+type ModerationImageURL struct {
+	URL string `json:"url"`
+}
+
+func (m *ModerationInput) MarshalJSON() ([]byte, error) {
+	// enforce one-of
+	set := 0
+	if m.Text != nil {
+		set++
+	}
+	if m.Texts != nil {
+		set++
+	}
+	if m.MultiModalInputs != nil {
+		set++
+	}
+	if set == 0 {
+		return nil, fmt.Errorf("moderation input is empty")
+	}
+	if set > 1 {
+		return nil, fmt.Errorf("moderation input must set exactly one of: text, texts, multimodal")
+	}
+
+	if m.Text != nil {
+		return Marshal(*m.Text)
+	}
+	if m.Texts != nil {
+		return Marshal(m.Texts)
+	}
+	return Marshal(m.MultiModalInputs)
+}
+
+func (m *ModerationInput) UnmarshalJSON(data []byte) error {
+	m.Text = nil
+	m.Texts = nil
+	m.MultiModalInputs = nil
+	// Try string
+	var s string
+	if err := Unmarshal(data, &s); err == nil {
+		m.Text = &s
+		return nil
+	}
+	// Try multimodal (array of objects with "type" field) — must try before []string
+	var mm []ModerationMultiModalInput
+	if err := Unmarshal(data, &mm); err == nil && len(mm) > 0 && mm[0].Type != "" {
+		m.MultiModalInputs = mm
+		return nil
+	}
+	// Try []string
+	var ss []string
+	if err := Unmarshal(data, &ss); err == nil {
+		m.Texts = ss
+		return nil
+	}
+
+	return fmt.Errorf("unsupported moderation input shape")
+}
+
+// IsEmpty reports whether the input has nothing to classify.
+func (m *ModerationInput) IsEmpty() bool {
+	return m == nil || (m.Text == nil && len(m.Texts) == 0 && len(m.MultiModalInputs) == 0)
+}
+
+type ModerationParameters struct {
+	// Dynamic parameters that can be provider-specific, they are directly
+	// added to the request as is.
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostModerationResponse represents the moderation response in bifrost format, with one result
+// per classified input.
+type BifrostModerationResponse struct {
+	ID          string                     `json:"id"`
+	Model       string                     `json:"model"`
+	Results     []ModerationResult         `json:"results"`
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
+// ModerationResult is the classification of one input.
+type ModerationResult struct {
+	Flagged                   bool                `json:"flagged"`                                // Whether any category was flagged
+	Categories                map[string]bool     `json:"categories"`                             // Per category, whether it was flagged (e.g. "harassment", "self-harm")
+	CategoryScores            map[string]float64  `json:"category_scores"`                        // Per category, the model's confidence between 0 and 1
+	CategoryAppliedInputTypes map[string][]string `json:"category_applied_input_types,omitempty"` // Per category, the input types ("text", "image") the score applies to
+}
@@ -0,0 +1,61 @@
+package schemas
+
+import (
+	"testing"
+)
+
+func TestModerationInput_UnmarshalJSON(t *testing.T) {
+	input := &ModerationInput{}
+	if err := input.UnmarshalJSON([]byte(`"hello world"`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if input.Text == nil || *input.Text != "hello world" {
+		t.Fatalf("expected Text='hello world', got %v", input.Text)
+	}
+
+	if err := input.UnmarshalJSON([]byte(`["hello","world"]`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if input.Text != nil || len(input.Texts) != 2 {
+		t.Fatalf("expected Texts=['hello','world'], got %+v", input)
+	}
+
+	data := []byte(`[
+		{"type":"text","text":"a caption"},
+		{"type":"image_url","image_url":{"url":"https://example.com/image.png"}}
+	]`)
+	if err := input.UnmarshalJSON(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if input.Texts != nil || len(input.MultiModalInputs) != 2 {
+		t.Fatalf("expected 2 multimodal inputs, got %+v", input)
+	}
+	if image := input.MultiModalInputs[1]; image.Type != ModerationInputImageURL || image.ImageURL == nil || image.ImageURL.URL != "https://example.com/image.png" {
+		t.Fatalf("unexpected image input: %+v", image)
+	}
+
+	if err := input.UnmarshalJSON([]byte(`42`)); err == nil {
+		t.Fatal("expected an error for a number input")
+	}
+}
+
+func TestModerationInput_MarshalJSON(t *testing.T) {
+	text := "hello"
+	data, err := Marshal(&ModerationInput{Text: &text})
+	if err != nil || string(data) != `"hello"` {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+	data, err = Marshal(&ModerationInput{Texts: []string{"a", "b"}})
+	if err != nil || string(data) != `["a","b"]` {
+		t.Fatalf("Marshal() = %s, %v", data, err)
+	}
+	if _, err := Marshal(&ModerationInput{}); err == nil {
+		t.Fatal("expected an error for an empty input")
+	}
+	if _, err := Marshal(&ModerationInput{Text: &text, Texts: []string{"a"}}); err == nil {
+		t.Fatal("expected an error when more than one input form is set")
+	}
+	if !(&ModerationInput{Texts: []string{}}).IsEmpty() || (&ModerationInput{Text: &text}).IsEmpty() {
+		t.Fatal("unexpected IsEmpty() result")
+	}
+}
@@ -0,0 +1,55 @@
+package schemas
+
+// BifrostMusicGenerationRequest represents a music generation request in bifrost format.
+// The input and parameters are kept provider-neutral; providers map what they support and
+// reject or ignore the rest as documented per provider.
+type BifrostMusicGenerationRequest struct {
+	Provider       ModelProvider              `json:"provider"`
+	Model          string                     `json:"model"`
+	Input          *MusicGenerationInput      `json:"input"`
+	Params         *MusicGenerationParameters `json:"params,omitempty"`
+	Fallbacks      []Fallback                 `json:"fallbacks,omitempty"`
+	RawRequestBody []byte                     `json:"-"`
+}
+
+// GetRawRequestBody implements utils.RequestBodyGetter.
+func (b *BifrostMusicGenerationRequest) GetRawRequestBody() []byte {
+	return b.RawRequestBody
+}
+
+type MusicGenerationInput struct {
+	Prompt         string  `json:"prompt"`                    // Style, mood and instrumentation of the track
+	Lyrics         *string `json:"lyrics,omitempty"`          // Lyrics to sing; omitted for instrumentals
+	ReferenceAudio *string `json:"reference_audio,omitempty"` // URL or base64 data URL of a track to take the style from
+}
+
+type MusicGenerationParameters struct {
+	Duration       *int                   `json:"duration,omitempty"`        // Target length in seconds
+	Format         *string                `json:"format,omitempty"`          // "mp3", "wav", "pcm", ...
+	SampleRate     *int                   `json:"sample_rate,omitempty"`     // e.g. 44100
+	Bitrate        *int                   `json:"bitrate,omitempty"`         // e.g. 256000
+	ResponseFormat *string                `json:"response_format,omitempty"` // "url", "b64_json"
+	ExtraParams    map[string]interface{} `json:"-"`
+}
+
+// BifrostMusicGenerationResponse represents the music generation response in bifrost format
+type BifrostMusicGenerationResponse struct {
+	ID      string        `json:"id,omitempty"`
+	Created int64         `json:"created,omitempty"`
+	Model   string        `json:"model,omitempty"`
+	Data    []MusicOutput `json:"data"`
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields,omitempty"`
+}
+
+// MusicOutput is a generated track, returned either as a URL or base64 encoded audio.
+type MusicOutput struct {
+	URL        string   `json:"url,omitempty"`
+	B64JSON    string   `json:"b64_json,omitempty"`
+	Format     string   `json:"format,omitempty"`
+	Duration   *float64 `json:"duration,omitempty"` // in seconds
+	SampleRate *int     `json:"sample_rate,omitempty"`
+	Bitrate    *int     `json:"bitrate,omitempty"`
+	Size       *int64   `json:"size,omitempty"` // in bytes
+	Index      int      `json:"index"`
+}
@@ -512,6 +512,7 @@ func (cm *ChatMessage) ToResponsesMessages() []ResponsesMessage {
 		} else {
 			responseBlocks := make([]ResponsesMessageContentBlock, len(cm.Content.ContentBlocks))
 			for i, block := range cm.Content.ContentBlocks {
+				block = block.DocumentAsFile()
 				blockType := ResponsesMessageContentBlockType(block.Type)
 
 				switch block.Type {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"strings"
 	"sync"
+	"time"
 )
 
 // PluginStatus constants
@@ -187,6 +188,10 @@ func ReleaseHTTPResponse(resp *HTTPResponse) {
 // - If a PreLLMHook returns a LLMPluginShortCircuit, the provider call may be skipped and only the PostLLMHook methods of plugins that had their PreLLMHook executed are called in reverse order.
 // - The plugin pipeline ensures symmetry: for every PreLLMHook executed, the corresponding PostLLMHook will be called in reverse order.
 //
+// Plugin isolation:
+// - A panic in PreLLMHook, PostLLMHook, PreMCPHook or PostMCPHook is recovered and handled like a returned error: the hook's results are discarded, the request/response and error it was given are passed on unchanged, and the remaining plugins run.
+// - A hook that runs longer than its timeout (see PluginWithHookTimeout and BifrostConfig.PluginHookTimeout) is handled the same way. The hook is not interrupted: it keeps running in the background, and its results are discarded once it returns.
+//
 // IMPORTANT: When returning BifrostError from PreLLMHook or PostLLMHook:
 // - You can set the AllowFallbacks field to control fallback behavior
 // - AllowFallbacks = &true: Allow Bifrost to try fallback providers
@@ -266,6 +271,13 @@ type MCPPlugin interface {
 	PostMCPHook(ctx *BifrostContext, resp *BifrostMCPResponse, bifrostErr *BifrostError) (*BifrostMCPResponse, *BifrostError, error)
 }
 
+// PluginWithHookTimeout is implemented by plugins that bound how long each of their LLM and MCP
+// hook calls may run, overriding BifrostConfig.PluginHookTimeout for them.
+// HookTimeout returns the timeout; 0 uses BifrostConfig.PluginHookTimeout and a negative value disables it.
+type PluginWithHookTimeout interface {
+	HookTimeout() time.Duration
+}
+
 // PluginConfig is the configuration for a plugin.
 // It contains the name of the plugin, whether it is enabled, and the configuration for the plugin.
 type PluginConfig struct {
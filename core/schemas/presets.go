@@ -0,0 +1,131 @@
+package schemas
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ParameterPreset is a named set of inference parameters (e.g. "deterministic", "creative").
+// Params uses the request's JSON parameter names ("temperature", "response_format", ...).
+// When a preset is applied, parameters set explicitly on the request take precedence and
+// parameters that don't exist for the request type are ignored.
+type ParameterPreset struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Aliases     []string               `json:"aliases,omitempty"` // Alternative names the preset can be referenced by
+	Params      map[string]interface{} `json:"params"`
+}
+
+// Built-in parameter preset names
+const (
+	ParameterPresetDeterministic = "deterministic"
+	ParameterPresetCreative      = "creative"
+	ParameterPresetJSONStrict    = "json-strict"
+)
+
+// BuiltinParameterPresets returns the presets available without any configuration.
+// User-defined presets with the same name replace them.
+func BuiltinParameterPresets() []ParameterPreset {
+	return []ParameterPreset{
+		{
+			Name:        ParameterPresetDeterministic,
+			Description: "Greedy, reproducible sampling",
+			Params: map[string]interface{}{
+				"temperature": 0,
+				"top_p":       1,
+				"seed":        0,
+			},
+		},
+		{
+			Name:        ParameterPresetCreative,
+			Description: "Higher-entropy sampling for open-ended generation",
+			Params: map[string]interface{}{
+				"temperature":       1.0,
+				"top_p":             0.95,
+				"presence_penalty":  0.3,
+				"frequency_penalty": 0.3,
+			},
+		},
+		{
+			Name:        ParameterPresetJSONStrict,
+			Description: "Deterministic sampling with JSON object output",
+			Params: map[string]interface{}{
+				"temperature":     0,
+				"response_format": map[string]interface{}{"type": "json_object"},
+				"text":            map[string]interface{}{"format": map[string]interface{}{"type": "json_object"}},
+			},
+		},
+	}
+}
+
+// Validate checks that the preset has a usable name and parameters.
+func (p *ParameterPreset) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("preset name is required")
+	}
+	for _, alias := range p.Aliases {
+		if strings.TrimSpace(alias) == "" {
+			return fmt.Errorf("preset %s has an empty alias", p.Name)
+		}
+	}
+	if len(p.Params) == 0 {
+		return fmt.Errorf("preset %s has no params", p.Name)
+	}
+	if _, err := Marshal(p.Params); err != nil {
+		return fmt.Errorf("preset %s has invalid params: %w", p.Name, err)
+	}
+	return nil
+}
+
+// ApplyTo expands the preset into the parameters of req. Only parameters that are unset on
+// the request are filled in. Request types without inference parameters are left unchanged.
+func (p *ParameterPreset) ApplyTo(req *BifrostRequest) error {
+	if req == nil || len(p.Params) == 0 {
+		return nil
+	}
+	switch {
+	case req.TextCompletionRequest != nil:
+		return applyPresetParams(p, &req.TextCompletionRequest.Params)
+	case req.ChatRequest != nil:
+		return applyPresetParams(p, &req.ChatRequest.Params)
+	case req.ResponsesRequest != nil:
+		return applyPresetParams(p, &req.ResponsesRequest.Params)
+	case req.EmbeddingRequest != nil:
+		return applyPresetParams(p, &req.EmbeddingRequest.Params)
+	case req.SpeechRequest != nil:
+		return applyPresetParams(p, &req.SpeechRequest.Params)
+	case req.TranscriptionRequest != nil:
+		return applyPresetParams(p, &req.TranscriptionRequest.Params)
+	case req.ImageGenerationRequest != nil:
+		return applyPresetParams(p, &req.ImageGenerationRequest.Params)
+	}
+	return nil
+}
+
+// applyPresetParams decodes the preset into a fresh T and copies every field that is unset in *params.
+func applyPresetParams[T any](p *ParameterPreset, params **T) error {
+	data, err := Marshal(p.Params)
+	if err != nil {
+		return fmt.Errorf("failed to encode preset %s: %w", p.Name, err)
+	}
+	presetParams := new(T)
+	if err := Unmarshal(data, presetParams); err != nil {
+		return fmt.Errorf("failed to apply preset %s: %w", p.Name, err)
+	}
+	if *params == nil {
+		*params = new(T)
+	}
+	dst := reflect.ValueOf(*params).Elem()
+	src := reflect.ValueOf(presetParams).Elem()
+	for i := 0; i < dst.NumField(); i++ {
+		field := dst.Type().Field(i)
+		if !field.IsExported() || field.Tag.Get("json") == "-" {
+			continue
+		}
+		if dst.Field(i).IsZero() && !src.Field(i).IsZero() {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package schemas
+
+import (
+	"testing"
+)
+
+func TestParameterPreset_ApplyTo_FillsUnsetParams(t *testing.T) {
+	preset := ParameterPreset{
+		Name: "test",
+		Params: map[string]interface{}{
+			"temperature":     0,
+			"top_p":           0.5,
+			"response_format": map[string]interface{}{"type": "json_object"},
+			"unknown_param":   true,
+		},
+	}
+	req := &BifrostRequest{
+		RequestType: ChatCompletionRequest,
+		ChatRequest: &BifrostChatRequest{
+			Provider: OpenAI,
+			Model:    "gpt-4o",
+			Params: &ChatParameters{
+				TopP:        Ptr(0.9),
+				ExtraParams: map[string]interface{}{"custom": "value"},
+			},
+		},
+	}
+
+	if err := preset.ApplyTo(req); err != nil {
+		t.Fatalf("ApplyTo() error = %v", err)
+	}
+	params := req.ChatRequest.Params
+	if params.Temperature == nil || *params.Temperature != 0 {
+		t.Errorf("temperature = %v, want 0", params.Temperature)
+	}
+	if params.TopP == nil || *params.TopP != 0.9 {
+		t.Errorf("top_p = %v, want explicit request value 0.9", params.TopP)
+	}
+	if params.ResponseFormat == nil {
+		t.Error("response_format was not applied")
+	}
+	if len(params.ExtraParams) != 1 || params.ExtraParams["custom"] != "value" {
+		t.Errorf("extra params = %v, want untouched", params.ExtraParams)
+	}
+}
+
+func TestParameterPreset_ApplyTo_NilParams(t *testing.T) {
+	preset := ParameterPreset{Name: "test", Params: map[string]interface{}{"temperature": 0.7}}
+	req := &BifrostRequest{
+		RequestType:      ResponsesRequest,
+		ResponsesRequest: &BifrostResponsesRequest{Provider: OpenAI, Model: "gpt-4o"},
+	}
+
+	if err := preset.ApplyTo(req); err != nil {
+		t.Fatalf("ApplyTo() error = %v", err)
+	}
+	if req.ResponsesRequest.Params == nil || req.ResponsesRequest.Params.Temperature == nil || *req.ResponsesRequest.Params.Temperature != 0.7 {
+		t.Fatalf("temperature was not applied to nil params: %+v", req.ResponsesRequest.Params)
+	}
+}
+
+func TestParameterPreset_Validate(t *testing.T) {
+	for _, preset := range BuiltinParameterPresets() {
+		if err := preset.Validate(); err != nil {
+			t.Errorf("built-in preset %s is invalid: %v", preset.Name, err)
+		}
+	}
+	invalid := []ParameterPreset{
+		{Name: "", Params: map[string]interface{}{"temperature": 0}},
+		{Name: "no-params"},
+		{Name: "bad-alias", Aliases: []string{" "}, Params: map[string]interface{}{"temperature": 0}},
+	}
+	for _, preset := range invalid {
+		if err := preset.Validate(); err == nil {
+			t.Errorf("Validate(%q) = nil, want error", preset.Name)
+		}
+	}
+}
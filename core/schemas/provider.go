@@ -4,6 +4,7 @@ package schemas
 import (
 	"encoding/json"
 	"maps"
+	"slices"
 	"time"
 )
 
@@ -48,13 +49,35 @@ const (
 type NetworkConfig struct {
 	// BaseURL is supported for OpenAI, Anthropic, Cohere, Mistral, and Ollama providers (required for Ollama)
 	BaseURL                        string            `json:"base_url,omitempty"`                 // Base URL for the provider (optional)
-	ExtraHeaders                   map[string]string `json:"extra_headers,omitempty"`            // Additional headers to include in requests (optional)
+	ExtraHeaders                   map[string]string `json:"extra_headers,omitempty"`            // Static headers added to requests unless sent by the client (optional, see HeaderPolicyConfig for per-team and overriding headers)
 	DefaultRequestTimeoutInSeconds int               `json:"default_request_timeout_in_seconds"` // Default timeout for requests
 	MaxRetries                     int               `json:"max_retries"`                        // Maximum number of retries
 	RetryBackoffInitial            time.Duration     `json:"retry_backoff_initial"`              // Initial backoff duration (stored as nanoseconds, JSON as milliseconds)
 	RetryBackoffMax                time.Duration     `json:"retry_backoff_max"`                  // Maximum backoff duration (stored as nanoseconds, JSON as milliseconds)
+	// HealthCheckIntervalInSeconds enables periodic probing of the provider's pooled connections.
+	// Stale connections are recycled before the next real request reuses them. 0 disables probing.
+	HealthCheckIntervalInSeconds int `json:"health_check_interval_in_seconds,omitempty"`
+	// AllowedPathOverrides are extra path patterns (path.Match syntax, e.g. "/v1/messages/*") that a
+	// per-request URL path override (BifrostContextKeyURLPath) may target, on top of the provider's defaults.
+	AllowedPathOverrides []string `json:"allowed_path_overrides,omitempty"`
+	// EndpointRegion selects between the international and mainland China endpoints of providers that
+	// serve both (MiniMax, Moonshot, Qwen, GLM, Volcengine and ModelArk) when BaseURL is not set.
+	// Empty means EndpointRegionAuto.
+	EndpointRegion EndpointRegion `json:"endpoint_region,omitempty"`
+	// RetryPolicy retries the provider's HTTP calls that are safe to repeat, in place of MaxRetries.
+	RetryPolicy *RetryPolicy `json:"retry_policy,omitempty"`
 }
 
+// EndpointRegion is the endpoint region of a provider with separate international and mainland China endpoints.
+type EndpointRegion string
+
+const (
+	// EndpointRegionAuto probes each key against the provider's endpoints and uses the first one that accepts it.
+	EndpointRegionAuto          EndpointRegion = "auto"
+	EndpointRegionInternational EndpointRegion = "international"
+	EndpointRegionChina         EndpointRegion = "china"
+)
+
 // UnmarshalJSON customizes JSON unmarshaling for NetworkConfig.
 // RetryBackoffInitial and RetryBackoffMax are interpreted as milliseconds in JSON,
 // but stored as time.Duration (nanoseconds) internally.
@@ -67,6 +90,10 @@ func (nc *NetworkConfig) UnmarshalJSON(data []byte) error {
 		MaxRetries                     int               `json:"max_retries"`
 		RetryBackoffInitial            int64             `json:"retry_backoff_initial"` // milliseconds in JSON
 		RetryBackoffMax                int64             `json:"retry_backoff_max"`     // milliseconds in JSON
+		HealthCheckIntervalInSeconds   int               `json:"health_check_interval_in_seconds,omitempty"`
+		AllowedPathOverrides           []string          `json:"allowed_path_overrides,omitempty"`
+		EndpointRegion                 EndpointRegion    `json:"endpoint_region,omitempty"`
+		RetryPolicy                    *RetryPolicy      `json:"retry_policy,omitempty"`
 	}
 
 	var alias NetworkConfigAlias
@@ -79,6 +106,10 @@ func (nc *NetworkConfig) UnmarshalJSON(data []byte) error {
 	nc.ExtraHeaders = alias.ExtraHeaders
 	nc.DefaultRequestTimeoutInSeconds = alias.DefaultRequestTimeoutInSeconds
 	nc.MaxRetries = alias.MaxRetries
+	nc.HealthCheckIntervalInSeconds = alias.HealthCheckIntervalInSeconds
+	nc.AllowedPathOverrides = alias.AllowedPathOverrides
+	nc.EndpointRegion = alias.EndpointRegion
+	nc.RetryPolicy = alias.RetryPolicy
 
 	// Convert milliseconds to time.Duration (nanoseconds)
 	// Only convert if value is greater than 0
@@ -104,6 +135,10 @@ func (nc NetworkConfig) MarshalJSON() ([]byte, error) {
 		MaxRetries                     int               `json:"max_retries"`
 		RetryBackoffInitial            int64             `json:"retry_backoff_initial"` // milliseconds in JSON
 		RetryBackoffMax                int64             `json:"retry_backoff_max"`     // milliseconds in JSON
+		HealthCheckIntervalInSeconds   int               `json:"health_check_interval_in_seconds,omitempty"`
+		AllowedPathOverrides           []string          `json:"allowed_path_overrides,omitempty"`
+		EndpointRegion                 EndpointRegion    `json:"endpoint_region,omitempty"`
+		RetryPolicy                    *RetryPolicy      `json:"retry_policy,omitempty"`
 	}
 
 	alias := NetworkConfigAlias{
@@ -112,8 +147,12 @@ func (nc NetworkConfig) MarshalJSON() ([]byte, error) {
 		DefaultRequestTimeoutInSeconds: nc.DefaultRequestTimeoutInSeconds,
 		MaxRetries:                     nc.MaxRetries,
 		// Convert time.Duration (nanoseconds) to milliseconds
-		RetryBackoffInitial: int64(nc.RetryBackoffInitial / time.Millisecond),
-		RetryBackoffMax:     int64(nc.RetryBackoffMax / time.Millisecond),
+		RetryBackoffInitial:          int64(nc.RetryBackoffInitial / time.Millisecond),
+		RetryBackoffMax:              int64(nc.RetryBackoffMax / time.Millisecond),
+		HealthCheckIntervalInSeconds: nc.HealthCheckIntervalInSeconds,
+		AllowedPathOverrides:         nc.AllowedPathOverrides,
+		EndpointRegion:               nc.EndpointRegion,
+		RetryPolicy:                  nc.RetryPolicy,
 	}
 
 	return json.Marshal(alias)
@@ -131,6 +170,8 @@ var DefaultNetworkConfig = NetworkConfig{
 type ConcurrencyAndBufferSize struct {
 	Concurrency int `json:"concurrency"` // Number of concurrent operations. Also used as the initial pool size for the provider reponses.
 	BufferSize  int `json:"buffer_size"` // Size of the buffer
+	// Scheduler limits the provider's in-flight requests, queuing the excess by priority.
+	Scheduler *SchedulerConfig `json:"scheduler,omitempty"`
 }
 
 // DefaultConcurrencyAndBufferSize is the default concurrency and buffer size for provider operations.
@@ -188,50 +229,62 @@ func (pc *ProxyConfig) Redacted() *ProxyConfig {
 // A nil *AllowedRequests means "all operations allowed."
 // A non-nil value only allows fields set to true; omitted or false fields are disallowed.
 type AllowedRequests struct {
-	ListModels            bool `json:"list_models"`
-	TextCompletion        bool `json:"text_completion"`
-	TextCompletionStream  bool `json:"text_completion_stream"`
-	ChatCompletion        bool `json:"chat_completion"`
-	ChatCompletionStream  bool `json:"chat_completion_stream"`
-	Responses             bool `json:"responses"`
-	ResponsesStream       bool `json:"responses_stream"`
-	CountTokens           bool `json:"count_tokens"`
-	Embedding             bool `json:"embedding"`
-	Rerank                bool `json:"rerank"`
-	Speech                bool `json:"speech"`
-	SpeechStream          bool `json:"speech_stream"`
-	Transcription         bool `json:"transcription"`
-	TranscriptionStream   bool `json:"transcription_stream"`
-	ImageGeneration       bool `json:"image_generation"`
-	ImageGenerationStream bool `json:"image_generation_stream"`
-	ImageEdit             bool `json:"image_edit"`
-	ImageEditStream       bool `json:"image_edit_stream"`
-	ImageVariation        bool `json:"image_variation"`
-	VideoGeneration       bool `json:"video_generation"`
-	VideoRetrieve         bool `json:"video_retrieve"`
-	VideoDownload         bool `json:"video_download"`
-	VideoDelete           bool `json:"video_delete"`
-	VideoList             bool `json:"video_list"`
-	VideoRemix            bool `json:"video_remix"`
-	BatchCreate           bool `json:"batch_create"`
-	BatchList             bool `json:"batch_list"`
-	BatchRetrieve         bool `json:"batch_retrieve"`
-	BatchCancel           bool `json:"batch_cancel"`
-	BatchResults          bool `json:"batch_results"`
-	FileUpload            bool `json:"file_upload"`
-	FileList              bool `json:"file_list"`
-	FileRetrieve          bool `json:"file_retrieve"`
-	FileDelete            bool `json:"file_delete"`
-	FileContent           bool `json:"file_content"`
-	ContainerCreate       bool `json:"container_create"`
-	ContainerList         bool `json:"container_list"`
-	ContainerRetrieve     bool `json:"container_retrieve"`
-	ContainerDelete       bool `json:"container_delete"`
-	ContainerFileCreate   bool `json:"container_file_create"`
-	ContainerFileList     bool `json:"container_file_list"`
-	ContainerFileRetrieve bool `json:"container_file_retrieve"`
-	ContainerFileContent  bool `json:"container_file_content"`
-	ContainerFileDelete   bool `json:"container_file_delete"`
+	ListModels               bool `json:"list_models"`
+	TextCompletion           bool `json:"text_completion"`
+	TextCompletionStream     bool `json:"text_completion_stream"`
+	ChatCompletion           bool `json:"chat_completion"`
+	ChatCompletionStream     bool `json:"chat_completion_stream"`
+	Responses                bool `json:"responses"`
+	ResponsesStream          bool `json:"responses_stream"`
+	CountTokens              bool `json:"count_tokens"`
+	Embedding                bool `json:"embedding"`
+	Rerank                   bool `json:"rerank"`
+	Speech                   bool `json:"speech"`
+	SpeechStream             bool `json:"speech_stream"`
+	Transcription            bool `json:"transcription"`
+	TranscriptionStream      bool `json:"transcription_stream"`
+	Translation              bool `json:"translation"`
+	ImageGeneration          bool `json:"image_generation"`
+	ImageGenerationStream    bool `json:"image_generation_stream"`
+	ImageEdit                bool `json:"image_edit"`
+	ImageEditStream          bool `json:"image_edit_stream"`
+	ImageVariation           bool `json:"image_variation"`
+	VideoGeneration          bool `json:"video_generation"`
+	VideoRetrieve            bool `json:"video_retrieve"`
+	VideoDownload            bool `json:"video_download"`
+	VideoDelete              bool `json:"video_delete"`
+	VideoList                bool `json:"video_list"`
+	VideoRemix               bool `json:"video_remix"`
+	BatchCreate              bool `json:"batch_create"`
+	BatchList                bool `json:"batch_list"`
+	BatchRetrieve            bool `json:"batch_retrieve"`
+	BatchCancel              bool `json:"batch_cancel"`
+	BatchResults             bool `json:"batch_results"`
+	FileUpload               bool `json:"file_upload"`
+	FileList                 bool `json:"file_list"`
+	FileRetrieve             bool `json:"file_retrieve"`
+	FileDelete               bool `json:"file_delete"`
+	FileContent              bool `json:"file_content"`
+	ContainerCreate          bool `json:"container_create"`
+	ContainerList            bool `json:"container_list"`
+	ContainerRetrieve        bool `json:"container_retrieve"`
+	ContainerDelete          bool `json:"container_delete"`
+	ContainerFileCreate      bool `json:"container_file_create"`
+	ContainerFileList        bool `json:"container_file_list"`
+	ContainerFileRetrieve    bool `json:"container_file_retrieve"`
+	ContainerFileContent     bool `json:"container_file_content"`
+	ContainerFileDelete      bool `json:"container_file_delete"`
+	FineTuningJobCreate      bool `json:"fine_tuning_job_create"`
+	FineTuningJobList        bool `json:"fine_tuning_job_list"`
+	FineTuningJobRetrieve    bool `json:"fine_tuning_job_retrieve"`
+	FineTuningJobCancel      bool `json:"fine_tuning_job_cancel"`
+	FineTuningJobEvents      bool `json:"fine_tuning_job_events"`
+	FineTuningJobCheckpoints bool `json:"fine_tuning_job_checkpoints"`
+	VectorStoreCreate        bool `json:"vector_store_create"`
+	VectorStoreList          bool `json:"vector_store_list"`
+	VectorStoreSearch        bool `json:"vector_store_search"`
+	VectorStoreFileCreate    bool `json:"vector_store_file_create"`
+	VectorStoreFileList      bool `json:"vector_store_file_list"`
 }
 
 // IsOperationAllowed checks if a specific operation is allowed
@@ -269,6 +322,8 @@ func (ar *AllowedRequests) IsOperationAllowed(operation RequestType) bool {
 		return ar.Transcription
 	case TranscriptionStreamRequest:
 		return ar.TranscriptionStream
+	case TranslationRequest:
+		return ar.Translation
 	case ImageGenerationRequest:
 		return ar.ImageGeneration
 	case ImageGenerationStreamRequest:
@@ -329,6 +384,28 @@ func (ar *AllowedRequests) IsOperationAllowed(operation RequestType) bool {
 		return ar.ContainerFileContent
 	case ContainerFileDeleteRequest:
 		return ar.ContainerFileDelete
+	case FineTuningJobCreateRequest:
+		return ar.FineTuningJobCreate
+	case FineTuningJobListRequest:
+		return ar.FineTuningJobList
+	case FineTuningJobRetrieveRequest:
+		return ar.FineTuningJobRetrieve
+	case FineTuningJobCancelRequest:
+		return ar.FineTuningJobCancel
+	case FineTuningJobEventsRequest:
+		return ar.FineTuningJobEvents
+	case FineTuningJobCheckpointsRequest:
+		return ar.FineTuningJobCheckpoints
+	case VectorStoreCreateRequest:
+		return ar.VectorStoreCreate
+	case VectorStoreListRequest:
+		return ar.VectorStoreList
+	case VectorStoreSearchRequest:
+		return ar.VectorStoreSearch
+	case VectorStoreFileCreateRequest:
+		return ar.VectorStoreFileCreate
+	case VectorStoreFileListRequest:
+		return ar.VectorStoreFileList
 	default:
 		return false // Default to not allowed for unknown operations
 	}
@@ -452,55 +529,163 @@ func (config *ProviderConfig) CheckAndSetDefaults() {
 		maps.Copy(headersCopy, config.NetworkConfig.ExtraHeaders)
 		config.NetworkConfig.ExtraHeaders = headersCopy
 	}
+	config.NetworkConfig.AllowedPathOverrides = slices.Clone(config.NetworkConfig.AllowedPathOverrides)
 }
 
 type PostHookRunner func(ctx *BifrostContext, result *BifrostResponse, err *BifrostError) (*BifrostResponse, *BifrostError)
 
 // Provider defines the interface for AI model providers.
+//
+// Request methods are grouped into optional capability interfaces (ChatProvider, FileProvider,
+// VideoProvider, ...). A provider implements only the capabilities its API supports; Bifrost detects
+// them at runtime with SupportsRequestType and answers every other request type with an
+// unsupported operation error.
 type Provider interface {
 	// GetProviderKey returns the provider's identifier
 	GetProviderKey() ModelProvider
+}
+
+// ListModelsProvider is implemented by providers that can list their models.
+type ListModelsProvider interface {
+	Provider
 	// ListModels performs a list models request
 	ListModels(ctx *BifrostContext, keys []Key, request *BifrostListModelsRequest) (*BifrostListModelsResponse, *BifrostError)
+}
+
+// TextCompletionProvider is implemented by providers that support text completions.
+type TextCompletionProvider interface {
+	Provider
 	// TextCompletion performs a text completion request
 	TextCompletion(ctx *BifrostContext, key Key, request *BifrostTextCompletionRequest) (*BifrostTextCompletionResponse, *BifrostError)
 	// TextCompletionStream performs a text completion stream request
 	TextCompletionStream(ctx *BifrostContext, postHookRunner PostHookRunner, key Key, request *BifrostTextCompletionRequest) (chan *BifrostStreamChunk, *BifrostError)
+}
+
+// ChatProvider is implemented by providers that support chat completions.
+type ChatProvider interface {
+	Provider
 	// ChatCompletion performs a chat completion request
 	ChatCompletion(ctx *BifrostContext, key Key, request *BifrostChatRequest) (*BifrostChatResponse, *BifrostError)
 	// ChatCompletionStream performs a chat completion stream request
 	ChatCompletionStream(ctx *BifrostContext, postHookRunner PostHookRunner, key Key, request *BifrostChatRequest) (chan *BifrostStreamChunk, *BifrostError)
+}
+
+// ResponsesProvider is implemented by providers that support the Responses API.
+type ResponsesProvider interface {
+	Provider
 	// Responses performs a completion request using the Responses API (uses chat completion request internally for non-openai providers)
 	Responses(ctx *BifrostContext, key Key, request *BifrostResponsesRequest) (*BifrostResponsesResponse, *BifrostError)
 	// ResponsesStream performs a completion request using the Responses API stream (uses chat completion stream request internally for non-openai providers)
 	ResponsesStream(ctx *BifrostContext, postHookRunner PostHookRunner, key Key, request *BifrostResponsesRequest) (chan *BifrostStreamChunk, *BifrostError)
+}
+
+// CountTokensProvider is implemented by providers that can count input tokens.
+type CountTokensProvider interface {
+	Provider
 	// CountTokens performs a count tokens request
 	CountTokens(ctx *BifrostContext, key Key, request *BifrostResponsesRequest) (*BifrostCountTokensResponse, *BifrostError)
+}
+
+// EmbeddingProvider is implemented by providers that support embeddings.
+type EmbeddingProvider interface {
+	Provider
 	// Embedding performs an embedding request
 	Embedding(ctx *BifrostContext, key Key, request *BifrostEmbeddingRequest) (*BifrostEmbeddingResponse, *BifrostError)
+}
+
+// RerankProvider is implemented by providers that support reranking.
+type RerankProvider interface {
+	Provider
 	// Rerank performs a rerank request to reorder documents by relevance to a query
 	Rerank(ctx *BifrostContext, key Key, request *BifrostRerankRequest) (*BifrostRerankResponse, *BifrostError)
+}
+
+// SpeechProvider is implemented by providers that support text to speech.
+type SpeechProvider interface {
+	Provider
 	// Speech performs a text to speech request
 	Speech(ctx *BifrostContext, key Key, request *BifrostSpeechRequest) (*BifrostSpeechResponse, *BifrostError)
 	// SpeechStream performs a text to speech stream request
 	SpeechStream(ctx *BifrostContext, postHookRunner PostHookRunner, key Key, request *BifrostSpeechRequest) (chan *BifrostStreamChunk, *BifrostError)
+}
+
+// TranscriptionProvider is implemented by providers that support speech to text.
+type TranscriptionProvider interface {
+	Provider
 	// Transcription performs a transcription request
 	Transcription(ctx *BifrostContext, key Key, request *BifrostTranscriptionRequest) (*BifrostTranscriptionResponse, *BifrostError)
 	// TranscriptionStream performs a transcription stream request
 	TranscriptionStream(ctx *BifrostContext, postHookRunner PostHookRunner, key Key, request *BifrostTranscriptionRequest) (chan *BifrostStreamChunk, *BifrostError)
+}
+
+// TranslationProvider is implemented by providers that support translating speech to English text.
+type TranslationProvider interface {
+	Provider
+	// Translation performs an audio translation request
+	Translation(ctx *BifrostContext, key Key, request *BifrostTranslationRequest) (*BifrostTranslationResponse, *BifrostError)
+}
+
+// ImageGenerationProvider is implemented by providers that support image generation.
+type ImageGenerationProvider interface {
+	Provider
 	// ImageGeneration performs an image generation request
 	ImageGeneration(ctx *BifrostContext, key Key, request *BifrostImageGenerationRequest) (
 		*BifrostImageGenerationResponse, *BifrostError)
 	// ImageGenerationStream performs an image generation stream request
 	ImageGenerationStream(ctx *BifrostContext, postHookRunner PostHookRunner, key Key,
 		request *BifrostImageGenerationRequest) (chan *BifrostStreamChunk, *BifrostError)
+}
+
+// ImageEditProvider is implemented by providers that support image edits.
+type ImageEditProvider interface {
+	Provider
 	// ImageEdit performs an image edit request
 	ImageEdit(ctx *BifrostContext, key Key, request *BifrostImageEditRequest) (*BifrostImageGenerationResponse, *BifrostError)
 	// ImageEditStream performs an image edit stream request
 	ImageEditStream(ctx *BifrostContext, postHookRunner PostHookRunner, key Key,
 		request *BifrostImageEditRequest) (chan *BifrostStreamChunk, *BifrostError)
+}
+
+// ImageVariationProvider is implemented by providers that support image variations.
+type ImageVariationProvider interface {
+	Provider
 	// ImageVariation performs an image variation request
 	ImageVariation(ctx *BifrostContext, key Key, request *BifrostImageVariationRequest) (*BifrostImageGenerationResponse, *BifrostError)
+}
+
+// ModerationProvider is implemented by providers that support content moderation.
+type ModerationProvider interface {
+	Provider
+	// Moderation classifies text or images against the provider's content policy categories
+	Moderation(ctx *BifrostContext, key Key, request *BifrostModerationRequest) (*BifrostModerationResponse, *BifrostError)
+}
+
+// RealtimeProvider is implemented by providers that support realtime sessions.
+type RealtimeProvider interface {
+	Provider
+	// Realtime connects the request's client to a realtime session of the provider and relays events
+	// until either side closes the connection or ctx is done. It returns the session's usage.
+	Realtime(ctx *BifrostContext, key Key, request *BifrostRealtimeRequest) (*BifrostRealtimeResponse, *BifrostError)
+}
+
+// MusicGenerationProvider is implemented by providers that support music generation.
+type MusicGenerationProvider interface {
+	Provider
+	// MusicGeneration performs a music generation request
+	MusicGeneration(ctx *BifrostContext, key Key, request *BifrostMusicGenerationRequest) (*BifrostMusicGenerationResponse, *BifrostError)
+}
+
+// ContextCacheProvider is implemented by providers that can cache a message prefix for reuse
+// across chat requests via ChatParameters.ContextID.
+type ContextCacheProvider interface {
+	Provider
+	// ContextCacheCreate caches the request's messages and returns the context ID
+	ContextCacheCreate(ctx *BifrostContext, key Key, request *BifrostContextCacheCreateRequest) (*BifrostContextCacheCreateResponse, *BifrostError)
+}
+
+// VideoProvider is implemented by providers that support video generation.
+type VideoProvider interface {
+	Provider
 	// VideoGeneration performs a video generation request
 	VideoGeneration(ctx *BifrostContext, key Key, request *BifrostVideoGenerationRequest) (*BifrostVideoGenerationResponse, *BifrostError)
 	// VideoRetrieve retrieves a video from the provider
@@ -513,6 +698,11 @@ type Provider interface {
 	VideoList(ctx *BifrostContext, key Key, request *BifrostVideoListRequest) (*BifrostVideoListResponse, *BifrostError)
 	// VideoRemix remixes a video from the provider
 	VideoRemix(ctx *BifrostContext, key Key, request *BifrostVideoRemixRequest) (*BifrostVideoGenerationResponse, *BifrostError)
+}
+
+// BatchProvider is implemented by providers that support batch jobs.
+type BatchProvider interface {
+	Provider
 	// BatchCreate creates a new batch job for asynchronous processing
 	BatchCreate(ctx *BifrostContext, key Key, request *BifrostBatchCreateRequest) (*BifrostBatchCreateResponse, *BifrostError)
 	// BatchList lists batch jobs
@@ -523,6 +713,11 @@ type Provider interface {
 	BatchCancel(ctx *BifrostContext, keys []Key, request *BifrostBatchCancelRequest) (*BifrostBatchCancelResponse, *BifrostError)
 	// BatchResults retrieves results from a completed batch job
 	BatchResults(ctx *BifrostContext, keys []Key, request *BifrostBatchResultsRequest) (*BifrostBatchResultsResponse, *BifrostError)
+}
+
+// FileProvider is implemented by providers that support file storage.
+type FileProvider interface {
+	Provider
 	// FileUpload uploads a file to the provider
 	FileUpload(ctx *BifrostContext, key Key, request *BifrostFileUploadRequest) (*BifrostFileUploadResponse, *BifrostError)
 	// FileList lists files from the provider
@@ -533,6 +728,11 @@ type Provider interface {
 	FileDelete(ctx *BifrostContext, keys []Key, request *BifrostFileDeleteRequest) (*BifrostFileDeleteResponse, *BifrostError)
 	// FileContent downloads file content from the provider
 	FileContent(ctx *BifrostContext, keys []Key, request *BifrostFileContentRequest) (*BifrostFileContentResponse, *BifrostError)
+}
+
+// ContainerProvider is implemented by providers that support containers.
+type ContainerProvider interface {
+	Provider
 	// ContainerCreate creates a new container
 	ContainerCreate(ctx *BifrostContext, key Key, request *BifrostContainerCreateRequest) (*BifrostContainerCreateResponse, *BifrostError)
 	// ContainerList lists containers
@@ -541,6 +741,11 @@ type Provider interface {
 	ContainerRetrieve(ctx *BifrostContext, keys []Key, request *BifrostContainerRetrieveRequest) (*BifrostContainerRetrieveResponse, *BifrostError)
 	// ContainerDelete deletes a container
 	ContainerDelete(ctx *BifrostContext, keys []Key, request *BifrostContainerDeleteRequest) (*BifrostContainerDeleteResponse, *BifrostError)
+}
+
+// ContainerFileProvider is implemented by providers that support files inside containers.
+type ContainerFileProvider interface {
+	Provider
 	// ContainerFileCreate creates a file in a container
 	ContainerFileCreate(ctx *BifrostContext, key Key, request *BifrostContainerFileCreateRequest) (*BifrostContainerFileCreateResponse, *BifrostError)
 	// ContainerFileList lists files in a container
@@ -552,3 +757,92 @@ type Provider interface {
 	// ContainerFileDelete deletes a file from a container
 	ContainerFileDelete(ctx *BifrostContext, keys []Key, request *BifrostContainerFileDeleteRequest) (*BifrostContainerFileDeleteResponse, *BifrostError)
 }
+
+// FineTuningProvider is implemented by providers that support fine-tuning jobs.
+type FineTuningProvider interface {
+	Provider
+	// FineTuningJobCreate creates a fine-tuning job
+	FineTuningJobCreate(ctx *BifrostContext, key Key, request *BifrostFineTuningJobCreateRequest) (*BifrostFineTuningJobCreateResponse, *BifrostError)
+	// FineTuningJobList lists fine-tuning jobs
+	FineTuningJobList(ctx *BifrostContext, keys []Key, request *BifrostFineTuningJobListRequest) (*BifrostFineTuningJobListResponse, *BifrostError)
+	// FineTuningJobRetrieve retrieves a specific fine-tuning job
+	FineTuningJobRetrieve(ctx *BifrostContext, keys []Key, request *BifrostFineTuningJobRetrieveRequest) (*BifrostFineTuningJobRetrieveResponse, *BifrostError)
+	// FineTuningJobCancel cancels a fine-tuning job
+	FineTuningJobCancel(ctx *BifrostContext, keys []Key, request *BifrostFineTuningJobCancelRequest) (*BifrostFineTuningJobCancelResponse, *BifrostError)
+	// FineTuningJobEvents lists the events of a fine-tuning job
+	FineTuningJobEvents(ctx *BifrostContext, keys []Key, request *BifrostFineTuningJobEventsRequest) (*BifrostFineTuningJobEventsResponse, *BifrostError)
+	// FineTuningJobCheckpoints lists the checkpoints of a fine-tuning job
+	FineTuningJobCheckpoints(ctx *BifrostContext, keys []Key, request *BifrostFineTuningJobCheckpointsRequest) (*BifrostFineTuningJobCheckpointsResponse, *BifrostError)
+}
+
+// VectorStoreProvider is implemented by providers that support vector stores.
+type VectorStoreProvider interface {
+	Provider
+	// VectorStoreCreate creates a vector store
+	VectorStoreCreate(ctx *BifrostContext, key Key, request *BifrostVectorStoreCreateRequest) (*BifrostVectorStoreCreateResponse, *BifrostError)
+	// VectorStoreList lists vector stores
+	VectorStoreList(ctx *BifrostContext, keys []Key, request *BifrostVectorStoreListRequest) (*BifrostVectorStoreListResponse, *BifrostError)
+	// VectorStoreSearch searches the chunks of a vector store
+	VectorStoreSearch(ctx *BifrostContext, keys []Key, request *BifrostVectorStoreSearchRequest) (*BifrostVectorStoreSearchResponse, *BifrostError)
+	// VectorStoreFileCreate adds an uploaded file to a vector store
+	VectorStoreFileCreate(ctx *BifrostContext, keys []Key, request *BifrostVectorStoreFileCreateRequest) (*BifrostVectorStoreFileCreateResponse, *BifrostError)
+	// VectorStoreFileList lists the files of a vector store
+	VectorStoreFileList(ctx *BifrostContext, keys []Key, request *BifrostVectorStoreFileListRequest) (*BifrostVectorStoreFileListResponse, *BifrostError)
+}
+
+// SupportsRequestType reports whether the provider implements the capability interface
+// that serves the request type.
+func SupportsRequestType(provider Provider, requestType RequestType) bool {
+	var ok bool
+	switch requestType {
+	case ListModelsRequest:
+		_, ok = provider.(ListModelsProvider)
+	case TextCompletionRequest, TextCompletionStreamRequest:
+		_, ok = provider.(TextCompletionProvider)
+	case ChatCompletionRequest, ChatCompletionStreamRequest:
+		_, ok = provider.(ChatProvider)
+	case ResponsesRequest, ResponsesStreamRequest:
+		_, ok = provider.(ResponsesProvider)
+	case CountTokensRequest:
+		_, ok = provider.(CountTokensProvider)
+	case EmbeddingRequest:
+		_, ok = provider.(EmbeddingProvider)
+	case RerankRequest:
+		_, ok = provider.(RerankProvider)
+	case ModerationRequest:
+		_, ok = provider.(ModerationProvider)
+	case RealtimeRequest:
+		_, ok = provider.(RealtimeProvider)
+	case SpeechRequest, SpeechStreamRequest:
+		_, ok = provider.(SpeechProvider)
+	case TranscriptionRequest, TranscriptionStreamRequest:
+		_, ok = provider.(TranscriptionProvider)
+	case TranslationRequest:
+		_, ok = provider.(TranslationProvider)
+	case ImageGenerationRequest, ImageGenerationStreamRequest:
+		_, ok = provider.(ImageGenerationProvider)
+	case ImageEditRequest, ImageEditStreamRequest:
+		_, ok = provider.(ImageEditProvider)
+	case ImageVariationRequest:
+		_, ok = provider.(ImageVariationProvider)
+	case MusicGenerationRequest:
+		_, ok = provider.(MusicGenerationProvider)
+	case ContextCacheCreateRequest:
+		_, ok = provider.(ContextCacheProvider)
+	case VideoGenerationRequest, VideoRetrieveRequest, VideoDownloadRequest, VideoDeleteRequest, VideoListRequest, VideoRemixRequest:
+		_, ok = provider.(VideoProvider)
+	case BatchCreateRequest, BatchListRequest, BatchRetrieveRequest, BatchCancelRequest, BatchResultsRequest:
+		_, ok = provider.(BatchProvider)
+	case FileUploadRequest, FileListRequest, FileRetrieveRequest, FileDeleteRequest, FileContentRequest:
+		_, ok = provider.(FileProvider)
+	case ContainerCreateRequest, ContainerListRequest, ContainerRetrieveRequest, ContainerDeleteRequest:
+		_, ok = provider.(ContainerProvider)
+	case ContainerFileCreateRequest, ContainerFileListRequest, ContainerFileRetrieveRequest, ContainerFileContentRequest, ContainerFileDeleteRequest:
+		_, ok = provider.(ContainerFileProvider)
+	case FineTuningJobCreateRequest, FineTuningJobListRequest, FineTuningJobRetrieveRequest, FineTuningJobCancelRequest, FineTuningJobEventsRequest, FineTuningJobCheckpointsRequest:
+		_, ok = provider.(FineTuningProvider)
+	case VectorStoreCreateRequest, VectorStoreListRequest, VectorStoreSearchRequest, VectorStoreFileCreateRequest, VectorStoreFileListRequest:
+		_, ok = provider.(VectorStoreProvider)
+	}
+	return ok
+}
@@ -0,0 +1,35 @@
+package schemas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type keyOnlyProvider struct{}
+
+func (p *keyOnlyProvider) GetProviderKey() ModelProvider { return "key-only" }
+
+type chatOnlyProvider struct{ keyOnlyProvider }
+
+func (p *chatOnlyProvider) ChatCompletion(ctx *BifrostContext, key Key, request *BifrostChatRequest) (*BifrostChatResponse, *BifrostError) {
+	return &BifrostChatResponse{}, nil
+}
+
+func (p *chatOnlyProvider) ChatCompletionStream(ctx *BifrostContext, postHookRunner PostHookRunner, key Key, request *BifrostChatRequest) (chan *BifrostStreamChunk, *BifrostError) {
+	return nil, nil
+}
+
+func TestSupportsRequestType(t *testing.T) {
+	chat := &chatOnlyProvider{}
+	assert.True(t, SupportsRequestType(chat, ChatCompletionRequest))
+	assert.True(t, SupportsRequestType(chat, ChatCompletionStreamRequest))
+	assert.False(t, SupportsRequestType(chat, ResponsesRequest))
+	assert.False(t, SupportsRequestType(chat, EmbeddingRequest))
+	assert.False(t, SupportsRequestType(chat, MCPToolExecutionRequest))
+
+	keyOnly := &keyOnlyProvider{}
+	for _, requestType := range []RequestType{ListModelsRequest, ChatCompletionRequest, VideoGenerationRequest, BatchCreateRequest, FileUploadRequest, ContainerCreateRequest, ContainerFileDeleteRequest} {
+		assert.False(t, SupportsRequestType(keyOnly, requestType), requestType)
+	}
+}
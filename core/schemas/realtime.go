@@ -0,0 +1,45 @@
+package schemas
+
+import (
+	"context"
+)
+
+// Realtime message types, matching the WebSocket text and binary frame opcodes.
+const (
+	RealtimeTextMessage   = 1
+	RealtimeBinaryMessage = 2
+)
+
+// RealtimeConn is one side of a realtime session: the client connection accepted by the transport,
+// or the connection to the provider opened with a RealtimeDialer. Messages are whole frames.
+// A *websocket.Conn of github.com/fasthttp/websocket or github.com/gorilla/websocket satisfies it.
+type RealtimeConn interface {
+	ReadMessage() (messageType int, data []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	Close() error
+}
+
+// RealtimeDialer opens the connection to a provider's realtime endpoint with the given headers.
+// It is supplied by the caller, so that core does not depend on a WebSocket implementation.
+type RealtimeDialer func(ctx context.Context, url string, headers map[string]string) (RealtimeConn, error)
+
+// BifrostRealtimeRequest represents a realtime session in bifrost format. Bifrost connects Client to
+// a realtime session of the provider, authenticated with a key of the provider, and relays events
+// in both directions until either side closes the connection.
+type BifrostRealtimeRequest struct {
+	Provider ModelProvider     `json:"provider"`
+	Model    string            `json:"model"`
+	Headers  map[string]string `json:"-"` // Sent to the provider when connecting, e.g. OpenAI-Beta
+	Client   RealtimeConn      `json:"-"`
+	Dial     RealtimeDialer    `json:"-"`
+}
+
+// BifrostRealtimeResponse summarizes a realtime session once it has ended. Usage is the sum of the
+// usage reported for every response of the session, including its audio tokens. The latency in the
+// extra fields is the duration of the session.
+type BifrostRealtimeResponse struct {
+	SessionID   string                     `json:"session_id,omitempty"`
+	Responses   int                        `json:"responses"` // Number of model responses completed during the session
+	Usage       *BifrostLLMUsage           `json:"usage,omitempty"`
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
@@ -0,0 +1,18 @@
+package schemas
+
+// BifrostResponseCost is the cost of a request in US dollars, priced from the model catalog and
+// the normalized usage of its response. The breakdown covers token usage: Cached is the prompt
+// cache share of the prompt and Reasoning the reasoning share of the completion, so Total is
+// Prompt + Cached + Completion plus any cost priced outside tokens (audio, images, video and
+// semantic cache lookups).
+type BifrostResponseCost struct {
+	Prompt     float64 `json:"prompt"`     // Uncached prompt tokens
+	Cached     float64 `json:"cached"`     // Prompt tokens read from or written to the provider's prompt cache
+	Completion float64 `json:"completion"` // Completion tokens, reasoning tokens included
+	Reasoning  float64 `json:"reasoning"`  // Reasoning tokens, a part of Completion
+	Total      float64 `json:"total"`
+}
+
+// ResponseCostCalculator prices a response. It returns nil when the response has no usage or its
+// model has no known pricing.
+type ResponseCostCalculator func(result *BifrostResponse) *BifrostResponseCost
@@ -0,0 +1,19 @@
+package schemas
+
+// RetrievalResult records the chunks a retrieval plugin injected into the prompt of a request. It
+// is added to the response's extra fields.
+type RetrievalResult struct {
+	Namespace       string           `json:"namespace"`                  // Vector store namespace the chunks were retrieved from
+	TopK            int              `json:"top_k"`                      // Maximum number of chunks requested
+	Chunks          []RetrievedChunk `json:"chunks"`                     // Chunks injected into the prompt, most similar first
+	EmbeddingTokens int              `json:"embedding_tokens,omitempty"` // Tokens used to embed the query
+	Latency         int64            `json:"latency"`                    // Time spent embedding and searching, in milliseconds
+	Error           string           `json:"error,omitempty"`            // Why retrieval failed; the request was sent without context
+}
+
+// RetrievedChunk identifies a chunk injected into the prompt. The chunk text itself is not recorded.
+type RetrievedChunk struct {
+	ID     string  `json:"id"`
+	Score  float64 `json:"score"`            // Cosine similarity to the query
+	Source string  `json:"source,omitempty"` // Source of the chunk, e.g. a document name or URL
+}
@@ -0,0 +1,97 @@
+package schemas
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+// Retry policy defaults.
+const (
+	DefaultRetryPolicyBackoffBaseMs = 500
+	DefaultRetryPolicyBackoffMaxMs  = 10000
+)
+
+// DefaultRetryPolicyStatusCodes are the upstream status codes a retry policy retries when none are configured.
+// 429 is left out so rate-limited keys are cooled down and rotated instead of retried in place.
+var DefaultRetryPolicyStatusCodes = []int{500, 502, 503, 504}
+
+// RetryPolicy retries a provider's HTTP calls in place, with exponential backoff and jitter.
+// Only calls that are safe to repeat are retried: idempotent HTTP methods, calls carrying an
+// Idempotency-Key header and non-streaming inference requests. Streaming responses, uploads and
+// calls that create resources (batches, files, containers, videos) are never retried.
+// When set, it replaces the request-level retries of MaxRetries for the provider.
+type RetryPolicy struct {
+	MaxAttempts          int     `json:"max_attempts"`                     // Total attempts, including the first (0 or 1 disables retries)
+	BackoffBaseMs        int     `json:"backoff_base_ms,omitempty"`        // Backoff before the first retry, doubled on each retry (default 500)
+	BackoffMaxMs         int     `json:"backoff_max_ms,omitempty"`         // Upper bound of a single backoff (default 10000)
+	Jitter               float64 `json:"jitter,omitempty"`                 // Fraction of the backoff randomized in both directions, between 0 and 1
+	RetryableStatusCodes []int   `json:"retryable_status_codes,omitempty"` // Status codes that are retried (default 429, 500, 502, 503 and 504)
+	RespectRetryAfter    bool    `json:"respect_retry_after,omitempty"`    // Wait for the upstream's Retry-After instead of the backoff; a longer wait than BackoffMaxMs is not retried
+}
+
+// Validate checks the attempts and backoffs are not negative, the jitter is a ratio and the
+// status codes are HTTP error codes.
+func (p *RetryPolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.MaxAttempts < 0 {
+		return fmt.Errorf("retry_policy.max_attempts must not be negative")
+	}
+	if p.BackoffBaseMs < 0 || p.BackoffMaxMs < 0 {
+		return fmt.Errorf("retry_policy backoffs must not be negative")
+	}
+	if p.BackoffBaseMs > 0 && p.BackoffMaxMs > 0 && p.BackoffBaseMs > p.BackoffMaxMs {
+		return fmt.Errorf("retry_policy.backoff_base_ms must be less than or equal to retry_policy.backoff_max_ms")
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("retry_policy.jitter must be between 0 and 1")
+	}
+	for _, code := range p.RetryableStatusCodes {
+		if code < 400 || code > 599 {
+			return fmt.Errorf("retry_policy.retryable_status_codes: %d is not an HTTP error status code", code)
+		}
+	}
+	return nil
+}
+
+// GetBackoffBase returns the base backoff, or its default when unset.
+func (p *RetryPolicy) GetBackoffBase() time.Duration {
+	if p.BackoffBaseMs <= 0 {
+		return DefaultRetryPolicyBackoffBaseMs * time.Millisecond
+	}
+	return time.Duration(p.BackoffBaseMs) * time.Millisecond
+}
+
+// GetBackoffMax returns the maximum backoff, or its default when unset.
+func (p *RetryPolicy) GetBackoffMax() time.Duration {
+	if p.BackoffMaxMs <= 0 {
+		return max(DefaultRetryPolicyBackoffMaxMs*time.Millisecond, p.GetBackoffBase())
+	}
+	return time.Duration(p.BackoffMaxMs) * time.Millisecond
+}
+
+// IsRetryableStatus reports whether the policy retries a response with the status code.
+func (p *RetryPolicy) IsRetryableStatus(statusCode int) bool {
+	if len(p.RetryableStatusCodes) == 0 {
+		return slices.Contains(DefaultRetryPolicyStatusCodes, statusCode)
+	}
+	return slices.Contains(p.RetryableStatusCodes, statusCode)
+}
+
+// Backoff returns the wait before the given retry (0 for the first): the base backoff doubled per
+// retry, randomized by the jitter and capped at the maximum backoff.
+func (p *RetryPolicy) Backoff(retry int) time.Duration {
+	backoffMax := p.GetBackoffMax()
+	backoff := p.GetBackoffBase()
+	for i := 0; i < retry && backoff < backoffMax; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, backoffMax)
+	if p.Jitter > 0 {
+		backoff = time.Duration(float64(backoff) * (1 - p.Jitter + 2*p.Jitter*rand.Float64()))
+	}
+	return min(backoff, backoffMax)
+}
@@ -0,0 +1,57 @@
+package schemas
+
+import (
+	"fmt"
+	"time"
+)
+
+// Scheduler defaults.
+const (
+	DefaultSchedulerMaxQueueDepth  = 1000
+	DefaultSchedulerQueueTimeoutMs = 30000
+)
+
+// SchedulerConfig limits how many requests a provider has in flight at once, streams included
+// until they end. Requests over the limit wait in a queue instead of opening more upstream
+// connections, and are admitted by priority (interactive, then normal, then background, set with
+// the x-bf-priority header or BifrostContextKeyRequestPriority), first come first served within a
+// priority. A request is rejected with a 503 when the queue is full or when it waited longer than
+// the queue timeout.
+type SchedulerConfig struct {
+	MaxInFlight    int `json:"max_in_flight"`              // Maximum requests in flight to the provider
+	MaxQueueDepth  int `json:"max_queue_depth,omitempty"`  // Maximum requests waiting for a slot (default 1000)
+	QueueTimeoutMs int `json:"queue_timeout_ms,omitempty"` // Maximum time a request waits for a slot (default 30000)
+}
+
+// Validate checks the in-flight limit is positive and the queue settings are not negative.
+func (c *SchedulerConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.MaxInFlight <= 0 {
+		return fmt.Errorf("scheduler.max_in_flight must be greater than 0")
+	}
+	if c.MaxQueueDepth < 0 {
+		return fmt.Errorf("scheduler.max_queue_depth must not be negative")
+	}
+	if c.QueueTimeoutMs < 0 {
+		return fmt.Errorf("scheduler.queue_timeout_ms must not be negative")
+	}
+	return nil
+}
+
+// GetMaxQueueDepth returns the maximum number of waiting requests, or its default when unset.
+func (c *SchedulerConfig) GetMaxQueueDepth() int {
+	if c.MaxQueueDepth <= 0 {
+		return DefaultSchedulerMaxQueueDepth
+	}
+	return c.MaxQueueDepth
+}
+
+// GetQueueTimeout returns how long a request waits for a slot, or its default when unset.
+func (c *SchedulerConfig) GetQueueTimeout() time.Duration {
+	if c.QueueTimeoutMs <= 0 {
+		return DefaultSchedulerQueueTimeoutMs * time.Millisecond
+	}
+	return time.Duration(c.QueueTimeoutMs) * time.Millisecond
+}
@@ -0,0 +1,49 @@
+package schemas
+
+import "fmt"
+
+// SchemaDriftKind classifies a difference between a provider response and the type it is decoded into.
+type SchemaDriftKind string
+
+const (
+	SchemaDriftUnknownField SchemaDriftKind = "unknown_field" // The response has a key the decoding type does not declare
+	SchemaDriftMissingField SchemaDriftKind = "missing_field" // A declared key that was present in every earlier response is absent
+)
+
+// DefaultSchemaDriftSampleRate is the fraction of responses checked when no sample rate is configured.
+const DefaultSchemaDriftSampleRate = 1.0
+
+// SchemaDrift is a single difference between a provider response and its expected shape.
+// Schema is the Go type the response was decoded into (e.g. "anthropic.AnthropicMessageResponse")
+// and Field the dotted JSON path of the key, with "[]" marking array elements.
+type SchemaDrift struct {
+	Schema string          `json:"schema"`
+	Kind   SchemaDriftKind `json:"kind"`
+	Field  string          `json:"field"`
+}
+
+// SchemaDriftConfig controls the checks of provider responses against the types they are decoded into,
+// which surface upstream API changes (new usage fields, renamed keys) as warnings and metrics.
+type SchemaDriftConfig struct {
+	Enabled    bool    `json:"enabled"`
+	SampleRate float64 `json:"sample_rate,omitempty"` // Fraction of responses checked, between 0 and 1 (default 1)
+}
+
+// Validate checks that the sample rate is a fraction.
+func (c *SchemaDriftConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.SampleRate < 0 || c.SampleRate > 1 {
+		return fmt.Errorf("sample_rate must be between 0 and 1")
+	}
+	return nil
+}
+
+// EffectiveSampleRate returns the configured sample rate, or the default when unset.
+func (c *SchemaDriftConfig) EffectiveSampleRate() float64 {
+	if c == nil || c.SampleRate == 0 {
+		return DefaultSchemaDriftSampleRate
+	}
+	return c.SampleRate
+}
@@ -0,0 +1,76 @@
+package schemas
+
+import (
+	"strings"
+	"sync"
+)
+
+// Secret sources a value can reference with a "<source>://<reference>" prefix, besides "env.":
+//
+//	vault://secret/data/openai#api_key           - a field of a HashiCorp Vault KV secret
+//	aws-sm://prod/openai#api_key                 - an AWS Secrets Manager secret (or a field of a JSON secret)
+//	gcp-sm://projects/my-project/secrets/openai  - a GCP Secret Manager secret (latest version unless one is given)
+//
+// The "://" separator keeps plain values such as a "vault.internal" host from being read as
+// references. External references are resolved by the secret rotator, which re-resolves them on a
+// schedule.
+const (
+	SecretSourceEnv               = "env"
+	SecretSourceVault             = "vault"
+	SecretSourceAWSSecretsManager = "aws-sm"
+	SecretSourceGCPSecretManager  = "gcp-sm"
+	envReferenceSeparator         = "."
+	externalReferenceSeparator    = "://"
+)
+
+// externalSecretSources are the secret sources resolved by the secret rotator.
+var externalSecretSources = []string{SecretSourceVault, SecretSourceAWSSecretsManager, SecretSourceGCPSecretManager}
+
+// ParseSecretReference splits a value reference such as "env.OPENAI_API_KEY" or
+// "vault://secret/data/openai#api_key" into its source and reference. ok is false for plain values.
+func ParseSecretReference(value string) (source string, reference string, ok bool) {
+	if reference, found := strings.CutPrefix(value, SecretSourceEnv+envReferenceSeparator); found && reference != "" {
+		return SecretSourceEnv, reference, true
+	}
+	source, reference, found := strings.Cut(value, externalReferenceSeparator)
+	if !found || reference == "" {
+		return "", "", false
+	}
+	for _, external := range externalSecretSources {
+		if source == external {
+			return source, reference, true
+		}
+	}
+	return "", "", false
+}
+
+// IsExternalSecretReference reports whether value references a secret manager rather than being a
+// plain value or an environment variable.
+func IsExternalSecretReference(value string) bool {
+	source, _, ok := ParseSecretReference(value)
+	return ok && source != SecretSourceEnv
+}
+
+// resolvedSecrets holds the values the secret rotator last resolved: reference -> value. Every
+// EnvVar holding a reference reads it on access, so a rotated secret is used by the next request
+// without reloading the configuration.
+var resolvedSecrets sync.Map
+
+// SetResolvedSecret records the current value of a secret reference.
+func SetResolvedSecret(reference string, value string) {
+	resolvedSecrets.Store(reference, value)
+}
+
+// ResolvedSecret returns the value last resolved for a secret reference.
+func ResolvedSecret(reference string) (string, bool) {
+	value, ok := resolvedSecrets.Load(reference)
+	if !ok {
+		return "", false
+	}
+	return value.(string), true
+}
+
+// ForgetResolvedSecret drops the resolved value of a secret reference.
+func ForgetResolvedSecret(reference string) {
+	resolvedSecrets.Delete(reference)
+}
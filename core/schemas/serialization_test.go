@@ -254,3 +254,35 @@ func TestSonic_ChatTool_ToolFunctionParametersPreservesOrder(t *testing.T) {
 	paramOutputKeys := ExtractTopLevelKeyOrder(paramOutput)
 	assert.Equal(t, "$defs", paramOutputKeys[0], "parameters should have $defs first")
 }
+
+// --- BifrostLLMUsage ---
+
+func TestSonic_BifrostLLMUsage_DeepSeekPromptCache(t *testing.T) {
+	input := `{"prompt_tokens":120,"completion_tokens":8,"total_tokens":128,"prompt_cache_hit_tokens":100,"prompt_cache_miss_tokens":20}`
+
+	var u BifrostLLMUsage
+	err := Unmarshal([]byte(input), &u)
+	require.NoError(t, err)
+
+	assert.Equal(t, 120, u.PromptTokens)
+	assert.Equal(t, 128, u.TotalTokens)
+	require.NotNil(t, u.PromptTokensDetails)
+	assert.Equal(t, 100, u.PromptTokensDetails.CachedReadTokens)
+
+	// prompt_tokens_details.cached_tokens wins when both are present
+	input = `{"prompt_tokens":120,"total_tokens":128,"prompt_cache_hit_tokens":100,"prompt_tokens_details":{"cached_tokens":96}}`
+	u = BifrostLLMUsage{}
+	require.NoError(t, Unmarshal([]byte(input), &u))
+	assert.Equal(t, 96, u.PromptTokensDetails.CachedReadTokens)
+
+	// Misses alone don't create prompt token details
+	input = `{"prompt_tokens":20,"total_tokens":28,"prompt_cache_hit_tokens":0,"prompt_cache_miss_tokens":20}`
+	u = BifrostLLMUsage{}
+	require.NoError(t, Unmarshal([]byte(input), &u))
+	assert.Nil(t, u.PromptTokensDetails)
+
+	output, err := Marshal(BifrostLLMUsage{PromptTokens: 120, TotalTokens: 128, PromptTokensDetails: &ChatPromptTokensDetails{CachedReadTokens: 100}})
+	require.NoError(t, err)
+	assert.Contains(t, string(output), `"cached_tokens":100`)
+	assert.NotContains(t, string(output), `prompt_cache_hit_tokens`)
+}
@@ -0,0 +1,59 @@
+package schemas
+
+import "fmt"
+
+// SingleFlightRequestTypes are the request types that can be deduplicated by single-flight.
+var SingleFlightRequestTypes = []RequestType{
+	TextCompletionRequest,
+	ChatCompletionRequest,
+	ResponsesRequest,
+	EmbeddingRequest,
+}
+
+// SingleFlightConfig controls the deduplication of identical in-flight requests. While a
+// non-streaming request is waiting for its provider, identical requests (same type, provider,
+// model, body and credentials, after plugin pre-hooks) wait for it instead of making their own
+// upstream call, and each gets its own copy of the response. This absorbs retry storms where
+// clients resend a request before the first attempt has answered.
+type SingleFlightConfig struct {
+	Enabled      bool          `json:"enabled"`
+	RequestTypes []RequestType `json:"request_types,omitempty"` // Request types to deduplicate (default all supported types)
+}
+
+// Validate checks every request type can be deduplicated.
+func (c *SingleFlightConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	for _, requestType := range c.RequestTypes {
+		if !isSingleFlightRequestType(requestType) {
+			return fmt.Errorf("request type %q cannot be deduplicated", requestType)
+		}
+	}
+	return nil
+}
+
+// Covers reports whether requests of the type are deduplicated.
+func (c *SingleFlightConfig) Covers(requestType RequestType) bool {
+	if c == nil || !c.Enabled {
+		return false
+	}
+	if len(c.RequestTypes) == 0 {
+		return isSingleFlightRequestType(requestType)
+	}
+	for _, t := range c.RequestTypes {
+		if t == requestType {
+			return true
+		}
+	}
+	return false
+}
+
+func isSingleFlightRequestType(requestType RequestType) bool {
+	for _, t := range SingleFlightRequestTypes {
+		if t == requestType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,344 @@
+package schemas
+
+import (
+	"sort"
+	"strings"
+)
+
+// StreamAccumulator reconstructs the complete chat response of a stream from its chunks: content,
+// reasoning, audio and tool calls are merged per choice, and the last usage reported is kept.
+// Chunks that are not chat chunks are ignored. It is not safe for concurrent use.
+type StreamAccumulator struct {
+	response BifrostChatResponse
+	choices  map[int]*accumulatedChoice
+	err      *BifrostError
+}
+
+// accumulatedChoice is the merged state of one choice of a stream.
+type accumulatedChoice struct {
+	role             *string
+	content          strings.Builder
+	hasContent       bool
+	refusal          strings.Builder
+	reasoning        strings.Builder
+	reasoningDetails []ChatReasoningDetails
+	audio            *ChatAudioMessageAudio
+	audioData        strings.Builder
+	audioTranscript  strings.Builder
+	toolCalls        []ChatAssistantMessageToolCall
+	finishReason     *string
+	logProbs         *BifrostLogProbs
+}
+
+// NewStreamAccumulator returns an empty stream accumulator.
+func NewStreamAccumulator() *StreamAccumulator {
+	return &StreamAccumulator{choices: map[int]*accumulatedChoice{}}
+}
+
+// Add merges a stream chunk. An error chunk is recorded and returned by Err.
+func (a *StreamAccumulator) Add(chunk *BifrostStreamChunk) {
+	if chunk == nil {
+		return
+	}
+	if chunk.BifrostError != nil {
+		a.err = chunk.BifrostError
+		return
+	}
+	if chunk.BifrostChatResponse != nil {
+		a.AddChatResponse(chunk.BifrostChatResponse)
+	}
+}
+
+// AddChatResponse merges a chat stream chunk, e.g. the one a plugin receives in its post-hook.
+func (a *StreamAccumulator) AddChatResponse(chunk *BifrostChatResponse) {
+	if chunk == nil {
+		return
+	}
+	if chunk.ID != "" {
+		a.response.ID = chunk.ID
+	}
+	if chunk.Model != "" {
+		a.response.Model = chunk.Model
+	}
+	if a.response.Created == 0 {
+		a.response.Created = chunk.Created
+	}
+	if chunk.SystemFingerprint != "" {
+		a.response.SystemFingerprint = chunk.SystemFingerprint
+	}
+	if chunk.ServiceTier != nil {
+		a.response.ServiceTier = chunk.ServiceTier
+	}
+	if chunk.Usage != nil {
+		a.response.Usage = chunk.Usage
+	}
+	if len(chunk.SearchResults) > 0 {
+		a.response.SearchResults = chunk.SearchResults
+	}
+	if len(chunk.Videos) > 0 {
+		a.response.Videos = chunk.Videos
+	}
+	if len(chunk.Citations) > 0 {
+		a.response.Citations = chunk.Citations
+	}
+	// The last chunk carries the total latency
+	a.response.ExtraFields = chunk.ExtraFields
+	a.response.ExtraFields.ChunkIndex = 0
+	a.response.ExtraFields.RawResponse = nil
+
+	for _, choice := range chunk.Choices {
+		state := a.choice(choice.Index)
+		if choice.FinishReason != nil {
+			state.finishReason = choice.FinishReason
+		}
+		if choice.LogProbs != nil {
+			if state.logProbs == nil {
+				state.logProbs = &BifrostLogProbs{}
+			}
+			state.logProbs.Content = append(state.logProbs.Content, choice.LogProbs.Content...)
+			state.logProbs.Refusal = append(state.logProbs.Refusal, choice.LogProbs.Refusal...)
+		}
+		if choice.ChatStreamResponseChoice != nil {
+			a.AddDelta(choice.Index, choice.Delta)
+		}
+	}
+}
+
+// AddDelta merges the delta of a choice.
+func (a *StreamAccumulator) AddDelta(index int, delta *ChatStreamResponseChoiceDelta) {
+	if delta == nil {
+		return
+	}
+	state := a.choice(index)
+	if delta.Role != nil {
+		state.role = delta.Role
+	}
+	if delta.Content != nil {
+		state.content.WriteString(*delta.Content)
+		state.hasContent = true
+	}
+	if delta.Refusal != nil {
+		state.refusal.WriteString(*delta.Refusal)
+	}
+	if delta.Reasoning != nil {
+		state.reasoning.WriteString(*delta.Reasoning)
+	}
+	for _, detail := range delta.ReasoningDetails {
+		state.reasoningDetails = accumulateReasoningDetail(state.reasoningDetails, detail)
+	}
+	if delta.Audio != nil {
+		if state.audio == nil {
+			state.audio = &ChatAudioMessageAudio{}
+		}
+		if delta.Audio.ID != "" {
+			state.audio.ID = delta.Audio.ID
+		}
+		if delta.Audio.ExpiresAt != 0 {
+			state.audio.ExpiresAt = delta.Audio.ExpiresAt
+		}
+		state.audioData.WriteString(delta.Audio.Data)
+		state.audioTranscript.WriteString(delta.Audio.Transcript)
+	}
+	if len(delta.ToolCalls) > 0 {
+		state.toolCalls = AccumulateToolCallDeltas(state.toolCalls, delta.ToolCalls)
+	}
+}
+
+// choice returns the state of a choice, creating it on first use.
+func (a *StreamAccumulator) choice(index int) *accumulatedChoice {
+	if a.choices == nil {
+		a.choices = map[int]*accumulatedChoice{}
+	}
+	state, ok := a.choices[index]
+	if !ok {
+		state = &accumulatedChoice{}
+		a.choices[index] = state
+	}
+	return state
+}
+
+// Err returns the error the stream ended with, if any.
+func (a *StreamAccumulator) Err() *BifrostError {
+	return a.err
+}
+
+// Message returns the message accumulated so far for a choice, or nil if the stream has no such choice.
+func (a *StreamAccumulator) Message(index int) *ChatMessage {
+	state, ok := a.choices[index]
+	if !ok {
+		return nil
+	}
+	return state.message()
+}
+
+// Response returns the chat completion accumulated so far, with choices ordered by index.
+// It can be called at any point of the stream; the accumulator keeps no reference to the result.
+func (a *StreamAccumulator) Response() *BifrostChatResponse {
+	response := a.response
+	response.Object = "chat.completion"
+	indexes := make([]int, 0, len(a.choices))
+	for index := range a.choices {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	response.Choices = make([]BifrostResponseChoice, 0, len(indexes))
+	for _, index := range indexes {
+		state := a.choices[index]
+		choice := BifrostResponseChoice{
+			Index:                       index,
+			FinishReason:                state.finishReason,
+			ChatNonStreamResponseChoice: &ChatNonStreamResponseChoice{Message: state.message()},
+		}
+		if state.logProbs != nil {
+			choice.LogProbs = &BifrostLogProbs{
+				Content: append([]ContentLogProb(nil), state.logProbs.Content...),
+				Refusal: append([]LogProb(nil), state.logProbs.Refusal...),
+			}
+		}
+		response.Choices = append(response.Choices, choice)
+	}
+	return &response
+}
+
+// message builds the message of a choice from its merged state.
+func (state *accumulatedChoice) message() *ChatMessage {
+	message := &ChatMessage{Role: ChatMessageRoleAssistant}
+	if state.role != nil && *state.role != "" {
+		message.Role = ChatMessageRole(*state.role)
+	}
+	// A choice without any delta content, such as a tool call only, has no content like non-stream responses
+	if state.hasContent || len(state.toolCalls) == 0 {
+		message.Content = &ChatMessageContent{ContentStr: Ptr(state.content.String())}
+	}
+	assistant := &ChatAssistantMessage{}
+	hasAssistant := false
+	if state.refusal.Len() > 0 {
+		assistant.Refusal = Ptr(state.refusal.String())
+		hasAssistant = true
+	}
+	if state.reasoning.Len() > 0 {
+		assistant.Reasoning = Ptr(state.reasoning.String())
+		hasAssistant = true
+	}
+	if len(state.reasoningDetails) > 0 {
+		assistant.ReasoningDetails = make([]ChatReasoningDetails, len(state.reasoningDetails))
+		copy(assistant.ReasoningDetails, state.reasoningDetails)
+		hasAssistant = true
+	}
+	if state.audio != nil {
+		audio := *state.audio
+		audio.Data = state.audioData.String()
+		audio.Transcript = state.audioTranscript.String()
+		assistant.Audio = &audio
+		hasAssistant = true
+	}
+	if len(state.toolCalls) > 0 {
+		assistant.ToolCalls = make([]ChatAssistantMessageToolCall, len(state.toolCalls))
+		copy(assistant.ToolCalls, state.toolCalls)
+		hasAssistant = true
+	}
+	if hasAssistant {
+		message.ChatAssistantMessage = assistant
+	}
+	return message
+}
+
+// accumulateReasoningDetail merges a reasoning detail delta into the detail with the same index.
+// Text, summary and data are appended, while signature, type and ID replace the previous values.
+func accumulateReasoningDetail(details []ChatReasoningDetails, delta ChatReasoningDetails) []ChatReasoningDetails {
+	for i := range details {
+		detail := &details[i]
+		if detail.Index != delta.Index {
+			continue
+		}
+		detail.Text = appendStringPtr(detail.Text, delta.Text)
+		detail.Summary = appendStringPtr(detail.Summary, delta.Summary)
+		detail.Data = appendStringPtr(detail.Data, delta.Data)
+		if delta.Signature != nil {
+			detail.Signature = Ptr(*delta.Signature)
+		}
+		if delta.Type != "" {
+			detail.Type = delta.Type
+		}
+		if delta.ID != nil {
+			detail.ID = Ptr(*delta.ID)
+		}
+		return details
+	}
+	detail := ChatReasoningDetails{Index: delta.Index, Type: delta.Type}
+	detail.ID = appendStringPtr(nil, delta.ID)
+	detail.Text = appendStringPtr(nil, delta.Text)
+	detail.Summary = appendStringPtr(nil, delta.Summary)
+	detail.Data = appendStringPtr(nil, delta.Data)
+	detail.Signature = appendStringPtr(nil, delta.Signature)
+	return append(details, detail)
+}
+
+// appendStringPtr returns a new string holding value followed by delta. Value is never modified, as it
+// may be shared with a chunk.
+func appendStringPtr(value, delta *string) *string {
+	switch {
+	case delta == nil || (*delta == "" && value != nil):
+		return value
+	case value == nil:
+		return Ptr(*delta)
+	default:
+		return Ptr(*value + *delta)
+	}
+}
+
+// AccumulateToolCallDeltas merges streamed tool call deltas into toolCalls and returns the result.
+// A delta belongs to the tool call with the same ID or, when either has no ID (as in OpenAI's argument
+// fragments), to the one with the same index; its arguments are appended to those received so far.
+// A delta matching no tool call starts a new one if it names a function, and is dropped otherwise.
+func AccumulateToolCallDeltas(toolCalls []ChatAssistantMessageToolCall, deltas []ChatAssistantMessageToolCall) []ChatAssistantMessageToolCall {
+	for _, delta := range deltas {
+		position := -1
+		if delta.ID != nil && *delta.ID != "" {
+			for i, toolCall := range toolCalls {
+				if toolCall.ID != nil && *toolCall.ID == *delta.ID {
+					position = i
+					break
+				}
+			}
+		}
+		if position == -1 {
+			for i, toolCall := range toolCalls {
+				// Providers that send whole tool calls may reuse an index for different calls
+				if delta.ID != nil && *delta.ID != "" && toolCall.ID != nil && *toolCall.ID != "" {
+					continue
+				}
+				if toolCall.Index == delta.Index {
+					position = i
+					break
+				}
+			}
+		}
+
+		if position == -1 {
+			if delta.Function.Name == nil {
+				continue
+			}
+			toolCall := delta
+			// Some providers open a tool call with empty arguments before streaming them
+			if toolCall.Function.Arguments == "{}" {
+				toolCall.Function.Arguments = ""
+			}
+			toolCalls = append(toolCalls, toolCall)
+			continue
+		}
+
+		toolCall := &toolCalls[position]
+		if delta.Type != nil {
+			toolCall.Type = delta.Type
+		}
+		if delta.ID != nil && *delta.ID != "" {
+			toolCall.ID = delta.ID
+		}
+		if delta.Function.Name != nil && *delta.Function.Name != "" {
+			toolCall.Function.Name = delta.Function.Name
+		}
+		toolCall.Function.Arguments += delta.Function.Arguments
+	}
+	return toolCalls
+}
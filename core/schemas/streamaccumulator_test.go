@@ -0,0 +1,114 @@
+package schemas
+
+import "testing"
+
+func streamChunk(index int, delta *ChatStreamResponseChoiceDelta, finishReason *string) *BifrostStreamChunk {
+	return &BifrostStreamChunk{BifrostChatResponse: &BifrostChatResponse{
+		ID:     "chatcmpl-1",
+		Model:  "gpt-4o",
+		Object: "chat.completion.chunk",
+		Choices: []BifrostResponseChoice{{
+			Index:                    index,
+			FinishReason:             finishReason,
+			ChatStreamResponseChoice: &ChatStreamResponseChoice{Delta: delta},
+		}},
+	}}
+}
+
+func TestStreamAccumulator(t *testing.T) {
+	accumulator := NewStreamAccumulator()
+	accumulator.Add(streamChunk(0, &ChatStreamResponseChoiceDelta{Role: Ptr("assistant"), Content: Ptr("")}, nil))
+	accumulator.Add(streamChunk(0, &ChatStreamResponseChoiceDelta{Reasoning: Ptr("Look up "), ReasoningDetails: []ChatReasoningDetails{{Index: 0, Type: BifrostReasoningDetailsTypeText, Text: Ptr("Look up ")}}}, nil))
+	accumulator.Add(streamChunk(0, &ChatStreamResponseChoiceDelta{Reasoning: Ptr("the weather."), ReasoningDetails: []ChatReasoningDetails{{Index: 0, Text: Ptr("the weather."), Signature: Ptr("sig")}}}, nil))
+	accumulator.Add(streamChunk(0, &ChatStreamResponseChoiceDelta{Content: Ptr("Checking")}, nil))
+	accumulator.Add(streamChunk(0, &ChatStreamResponseChoiceDelta{ToolCalls: []ChatAssistantMessageToolCall{
+		{Index: 0, ID: Ptr("call_1"), Type: Ptr("function"), Function: ChatAssistantMessageToolCallFunction{Name: Ptr("get_weather")}},
+	}}, nil))
+	accumulator.Add(streamChunk(0, &ChatStreamResponseChoiceDelta{ToolCalls: []ChatAssistantMessageToolCall{
+		{Index: 0, Function: ChatAssistantMessageToolCallFunction{Arguments: `{"city":`}},
+	}}, nil))
+	accumulator.Add(streamChunk(1, &ChatStreamResponseChoiceDelta{Content: Ptr("Second choice")}, Ptr("stop")))
+	accumulator.Add(streamChunk(0, &ChatStreamResponseChoiceDelta{ToolCalls: []ChatAssistantMessageToolCall{
+		{Index: 0, Function: ChatAssistantMessageToolCallFunction{Arguments: `"Paris"}`}},
+	}}, Ptr("tool_calls")))
+	usageChunk := &BifrostChatResponse{
+		ID:          "chatcmpl-1",
+		Usage:       &BifrostLLMUsage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+		ExtraFields: BifrostResponseExtraFields{Provider: OpenAI, Latency: 120, ChunkIndex: 8},
+	}
+	accumulator.AddChatResponse(usageChunk)
+
+	response := accumulator.Response()
+	if response.ID != "chatcmpl-1" || response.Model != "gpt-4o" || response.Object != "chat.completion" {
+		t.Fatalf("unexpected response metadata: id=%q model=%q object=%q", response.ID, response.Model, response.Object)
+	}
+	if response.Usage == nil || response.Usage.TotalTokens != 15 {
+		t.Fatalf("expected usage of the last chunk, got %+v", response.Usage)
+	}
+	if response.ExtraFields.Provider != OpenAI || response.ExtraFields.Latency != 120 || response.ExtraFields.ChunkIndex != 0 {
+		t.Fatalf("unexpected extra fields: %+v", response.ExtraFields)
+	}
+	if len(response.Choices) != 2 || response.Choices[0].Index != 0 || response.Choices[1].Index != 1 {
+		t.Fatalf("expected choices 0 and 1, got %+v", response.Choices)
+	}
+
+	first := response.Choices[0]
+	if first.FinishReason == nil || *first.FinishReason != "tool_calls" {
+		t.Fatalf("expected finish reason tool_calls, got %v", first.FinishReason)
+	}
+	message := first.Message
+	if message.Content == nil || message.Content.ContentStr == nil || *message.Content.ContentStr != "Checking" {
+		t.Fatalf("unexpected content: %+v", message.Content)
+	}
+	if message.ChatAssistantMessage == nil || message.Reasoning == nil || *message.Reasoning != "Look up the weather." {
+		t.Fatalf("unexpected reasoning: %+v", message.ChatAssistantMessage)
+	}
+	if len(message.ReasoningDetails) != 1 || *message.ReasoningDetails[0].Text != "Look up the weather." || *message.ReasoningDetails[0].Signature != "sig" {
+		t.Fatalf("unexpected reasoning details: %+v", message.ReasoningDetails)
+	}
+	if len(message.ToolCalls) != 1 || *message.ToolCalls[0].ID != "call_1" || message.ToolCalls[0].Function.Arguments != `{"city":"Paris"}` {
+		t.Fatalf("unexpected tool calls: %+v", message.ToolCalls)
+	}
+	if second := response.Choices[1].Message; second.Content == nil || *second.Content.ContentStr != "Second choice" {
+		t.Fatalf("unexpected second choice: %+v", second)
+	}
+
+	// The response is a snapshot: later chunks don't change it
+	accumulator.Add(streamChunk(1, &ChatStreamResponseChoiceDelta{Content: Ptr("!")}, nil))
+	if *response.Choices[1].Message.Content.ContentStr != "Second choice" {
+		t.Fatalf("response changed after a later chunk")
+	}
+	if *accumulator.Message(1).Content.ContentStr != "Second choice!" {
+		t.Fatalf("expected the later chunk to be accumulated")
+	}
+}
+
+func TestStreamAccumulatorError(t *testing.T) {
+	accumulator := NewStreamAccumulator()
+	accumulator.Add(streamChunk(0, &ChatStreamResponseChoiceDelta{Content: Ptr("Hel")}, nil))
+	accumulator.Add(&BifrostStreamChunk{BifrostError: &BifrostError{Error: &ErrorField{Message: "stream interrupted"}}})
+
+	if accumulator.Err() == nil || accumulator.Err().Error.Message != "stream interrupted" {
+		t.Fatalf("expected the stream error, got %+v", accumulator.Err())
+	}
+	if message := accumulator.Message(0); message == nil || *message.Content.ContentStr != "Hel" {
+		t.Fatalf("expected the partial content, got %+v", message)
+	}
+}
+
+func TestAccumulateToolCallDeltas(t *testing.T) {
+	// Whole tool calls sharing an index are kept apart by their IDs
+	toolCalls := AccumulateToolCallDeltas(nil, []ChatAssistantMessageToolCall{
+		{ID: Ptr("call_a"), Function: ChatAssistantMessageToolCallFunction{Name: Ptr("a"), Arguments: "{}"}},
+	})
+	toolCalls = AccumulateToolCallDeltas(toolCalls, []ChatAssistantMessageToolCall{
+		{ID: Ptr("call_b"), Function: ChatAssistantMessageToolCallFunction{Name: Ptr("b"), Arguments: `{"x":1}`}},
+		{Function: ChatAssistantMessageToolCallFunction{Arguments: "ignored"}, Index: 5},
+	})
+	if len(toolCalls) != 2 {
+		t.Fatalf("expected 2 tool calls, got %+v", toolCalls)
+	}
+	if toolCalls[0].Function.Arguments != "" || toolCalls[1].Function.Arguments != `{"x":1}` {
+		t.Fatalf("unexpected arguments: %q, %q", toolCalls[0].Function.Arguments, toolCalls[1].Function.Arguments)
+	}
+}
@@ -0,0 +1,9 @@
+package schemas
+
+// StreamUsageConfig guarantees usage on every chat and text completion stream. When a provider ends
+// a stream without reporting usage, e.g. because it doesn't support stream_options, the prompt and
+// completion tokens are estimated with the tokenizer of the model's family and set on the final
+// chunk, which is flagged with extra_fields.approximate.
+type StreamUsageConfig struct {
+	Enabled bool `json:"enabled"`
+}
@@ -0,0 +1,90 @@
+package schemas
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Limits of the tags of a request.
+const (
+	MaxRequestTags           = 16
+	MaxRequestTagKeyLength   = 64
+	MaxRequestTagValueLength = 128
+)
+
+// ValidateRequestTags checks the tags of a request: at most MaxRequestTags tags, with keys of letters,
+// digits, '_', '-', '.' and ':', and values without quotes, backslashes, commas, '=' or control characters,
+// so that tags can be sent in a header and used as metric labels and log filters.
+func ValidateRequestTags(tags map[string]string) error {
+	if len(tags) > MaxRequestTags {
+		return fmt.Errorf("at most %d tags are allowed, got %d", MaxRequestTags, len(tags))
+	}
+	for key, value := range tags {
+		if key == "" || len(key) > MaxRequestTagKeyLength {
+			return fmt.Errorf("tag key %q must be 1 to %d characters long", key, MaxRequestTagKeyLength)
+		}
+		for _, r := range key {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || strings.ContainsRune("_-.:", r)) {
+				return fmt.Errorf("invalid tag key %q", key)
+			}
+		}
+		if len(value) > MaxRequestTagValueLength {
+			return fmt.Errorf("tag %q: value must be at most %d characters long", key, MaxRequestTagValueLength)
+		}
+		for _, r := range value {
+			if r < ' ' || r == 0x7f || strings.ContainsRune("\"\\,=", r) {
+				return fmt.Errorf("tag %q: invalid value %q", key, value)
+			}
+		}
+	}
+	return nil
+}
+
+// ParseRequestTags parses tags in the "key=value,key=value" format of the x-bf-tags header.
+func ParseRequestTags(value string) (map[string]string, error) {
+	tags := make(map[string]string)
+	for entry := range strings.SplitSeq(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		key, tagValue, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("tag %q must be in key=value format", entry)
+		}
+		tags[strings.TrimSpace(key)] = strings.TrimSpace(tagValue)
+	}
+	if err := ValidateRequestTags(tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// MergeRequestTags adds tags to the tags of the request in ctx, replacing tags with the same key.
+func MergeRequestTags(ctx *BifrostContext, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	existing := GetRequestTags(ctx)
+	merged := make(map[string]string, len(existing)+len(tags))
+	for key, value := range existing {
+		merged[key] = value
+	}
+	for key, value := range tags {
+		merged[key] = value
+	}
+	if err := ValidateRequestTags(merged); err != nil {
+		return err
+	}
+	ctx.SetValue(BifrostContextKeyRequestTags, merged)
+	return nil
+}
+
+// GetRequestTags returns the tags of the request in ctx, or nil if it has none.
+func GetRequestTags(ctx *BifrostContext) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+	tags, _ := ctx.Value(BifrostContextKeyRequestTags).(map[string]string)
+	return tags
+}
@@ -0,0 +1,43 @@
+package schemas
+
+import "testing"
+
+func TestParseRequestTags(t *testing.T) {
+	tags, err := ParseRequestTags(" feature=search , customer=acme-corp,env=prod:eu ")
+	if err != nil {
+		t.Fatalf("ParseRequestTags() error = %v", err)
+	}
+	if len(tags) != 3 || tags["feature"] != "search" || tags["customer"] != "acme-corp" || tags["env"] != "prod:eu" {
+		t.Errorf("tags = %v", tags)
+	}
+
+	for _, value := range []string{"feature", "=search", "fea ture=search", `feature=se"arch`} {
+		if _, err := ParseRequestTags(value); err == nil {
+			t.Errorf("expected %q to be invalid", value)
+		}
+	}
+}
+
+func TestMergeRequestTags(t *testing.T) {
+	ctx := NewBifrostContext(nil, NoDeadline)
+	if err := MergeRequestTags(ctx, map[string]string{"feature": "search", "customer": "acme"}); err != nil {
+		t.Fatalf("MergeRequestTags() error = %v", err)
+	}
+	if err := MergeRequestTags(ctx, map[string]string{"feature": "chat"}); err != nil {
+		t.Fatalf("MergeRequestTags() error = %v", err)
+	}
+	if tags := GetRequestTags(ctx); len(tags) != 2 || tags["feature"] != "chat" || tags["customer"] != "acme" {
+		t.Errorf("tags = %v", tags)
+	}
+
+	tooMany := make(map[string]string)
+	for i := range MaxRequestTags {
+		tooMany[string(rune('a'+i))] = "x"
+	}
+	if err := MergeRequestTags(ctx, tooMany); err == nil {
+		t.Error("expected more than MaxRequestTags tags to be rejected")
+	}
+	if tags := GetRequestTags(ctx); len(tags) != 2 {
+		t.Errorf("rejected tags were merged: %v", tags)
+	}
+}
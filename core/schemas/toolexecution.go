@@ -0,0 +1,83 @@
+package schemas
+
+import (
+	"fmt"
+)
+
+// DefaultToolExecutionMaxIterations is the number of tool-call rounds executed for a request when
+// ToolExecutionConfig.MaxIterations is not set.
+const DefaultToolExecutionMaxIterations = 5
+
+// DefaultToolWebhookTimeoutInSeconds is the timeout of a tool webhook call when
+// ToolWebhook.TimeoutInSeconds is not set.
+const DefaultToolWebhookTimeoutInSeconds = 30
+
+// ToolFunction executes a tool call. It gets the JSON arguments generated by the model and returns
+// the result sent back to the model as the tool message. A returned error is sent to the model as
+// the result instead, so it can correct the call.
+type ToolFunction func(ctx *BifrostContext, arguments string) (string, error)
+
+// ToolWebhook executes tool calls with an HTTP endpoint. Bifrost POSTs
+// {"tool_call_id": ..., "name": ..., "arguments": {...}} and uses the response body as the result.
+// Responses other than 2xx are errors.
+type ToolWebhook struct {
+	URL              string            `json:"url"`
+	Headers          map[string]string `json:"headers,omitempty"`            // Sent with every call, e.g. for authentication
+	TimeoutInSeconds int               `json:"timeout_in_seconds,omitempty"` // Per call (default DefaultToolWebhookTimeoutInSeconds)
+}
+
+// ToolExecutionConfig registers the tools Bifrost executes itself. When a request opts in with
+// BifrostContextKeyExecuteTools, the tool calls of a chat completion response are executed while
+// every called tool is registered, the results are appended to the conversation and the model is
+// called again, until it answers without tool calls or MaxIterations rounds were executed. The
+// final response carries a trace of every invocation in its extra fields.
+//
+// Tools are matched by function name; their definitions are still sent in the request's tools.
+// A response calling any unregistered tool is returned to the caller as is.
+type ToolExecutionConfig struct {
+	Functions     map[string]ToolFunction `json:"-"`                        // Go callbacks by tool name
+	Webhooks      map[string]ToolWebhook  `json:"webhooks,omitempty"`       // HTTP endpoints by tool name
+	MaxIterations int                     `json:"max_iterations,omitempty"` // Tool-call rounds per request (default DefaultToolExecutionMaxIterations)
+}
+
+// Validate checks the iteration limit, that every webhook has a URL and that no tool is registered twice.
+func (c *ToolExecutionConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	if c.MaxIterations < 0 {
+		return fmt.Errorf("tool execution max_iterations must not be negative")
+	}
+	for name, webhook := range c.Webhooks {
+		if webhook.URL == "" {
+			return fmt.Errorf("tool webhook %q has no url", name)
+		}
+		if _, ok := c.Functions[name]; ok {
+			return fmt.Errorf("tool %q is registered as both a function and a webhook", name)
+		}
+	}
+	return nil
+}
+
+// Has reports whether a tool is registered.
+func (c *ToolExecutionConfig) Has(name string) bool {
+	if c == nil {
+		return false
+	}
+	if _, ok := c.Functions[name]; ok {
+		return true
+	}
+	_, ok := c.Webhooks[name]
+	return ok
+}
+
+// ToolInvocation records a tool call executed by Bifrost.
+type ToolInvocation struct {
+	Iteration  int    `json:"iteration"`       // Tool-call round, starting at 1
+	ToolCallID string `json:"tool_call_id"`    // ID of the tool call in the model's response
+	Name       string `json:"name"`            // Tool name
+	Arguments  string `json:"arguments"`       // JSON arguments generated by the model
+	Result     string `json:"result"`          // Result sent back to the model
+	Error      string `json:"error,omitempty"` // Execution error, also sent back to the model
+	Latency    int64  `json:"latency"`         // in milliseconds
+}
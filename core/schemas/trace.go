@@ -161,8 +161,10 @@ const (
 // and are compatible with both OTEL and Datadog backends.
 const (
 	// Provider and Model Attributes
-	AttrProviderName = "gen_ai.provider.name"
-	AttrRequestModel = "gen_ai.request.model"
+	AttrProviderName  = "gen_ai.provider.name"
+	AttrSystem        = "gen_ai.system" // well-known system name, kept for backends predating gen_ai.provider.name
+	AttrOperationName = "gen_ai.operation.name"
+	AttrRequestModel  = "gen_ai.request.model"
 
 	// Request Parameter Attributes
 	AttrMaxTokens        = "gen_ai.request.max_tokens"
@@ -0,0 +1,68 @@
+package schemas
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+)
+
+// Traffic split variants
+const (
+	TrafficSplitVariantPrimary   = "primary"
+	TrafficSplitVariantCandidate = "candidate"
+)
+
+// TrafficSplit sends a percentage of the requests for a logical model to a candidate model and the
+// rest to the primary one, e.g. to move traffic from gpt-4o to a cheaper model gradually. Requests
+// with a split key (the x-bf-split-key header, or BifrostContextKeyTrafficSplitKey) are bucketed
+// deterministically, so the same key always gets the same variant; requests without one are
+// bucketed at random. Requests sent to the candidate fall back to the primary model unless they
+// bring their own fallbacks.
+type TrafficSplit struct {
+	Name             string   `json:"name"`              // Logical model name requests use, without a provider prefix
+	Primary          Fallback `json:"primary"`           // Provider and model serving the remaining traffic
+	Candidate        Fallback `json:"candidate"`         // Provider and model receiving CandidatePercent of the traffic
+	CandidatePercent float64  `json:"candidate_percent"` // Share of the traffic sent to the candidate, between 0 and 100
+}
+
+// TrafficSplitResult records the variant a request was assigned by a traffic split. It is set in
+// the context under BifrostContextKeyTrafficSplit and on the response's extra fields.
+type TrafficSplitResult struct {
+	Name    string `json:"name"`    // Logical model name of the split
+	Variant string `json:"variant"` // "primary" or "candidate"
+}
+
+// Validate checks the split has a name without a provider prefix, both targets and a percentage.
+func (s *TrafficSplit) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("traffic split name is required")
+	}
+	if strings.Contains(s.Name, "/") {
+		return fmt.Errorf("traffic split %s: name must not contain a provider prefix", s.Name)
+	}
+	if s.Primary.Provider == "" || s.Primary.Model == "" || s.Candidate.Provider == "" || s.Candidate.Model == "" {
+		return fmt.Errorf("traffic split %s: primary and candidate need a provider and a model", s.Name)
+	}
+	if s.CandidatePercent < 0 || s.CandidatePercent > 100 {
+		return fmt.Errorf("traffic split %s: candidate_percent must be between 0 and 100", s.Name)
+	}
+	return nil
+}
+
+// Variant returns the variant of a bucket between 0 and 100.
+func (s *TrafficSplit) Variant(bucket float64) string {
+	if bucket < s.CandidatePercent {
+		return TrafficSplitVariantCandidate
+	}
+	return TrafficSplitVariantPrimary
+}
+
+// TrafficSplitBucket deterministically maps a split key to a bucket between 0 and 100. The split
+// name is hashed in, so a key lands in unrelated buckets for different splits.
+func TrafficSplitBucket(name, key string) float64 {
+	hash := fnv.New64a()
+	hash.Write([]byte(name))
+	hash.Write([]byte{0})
+	hash.Write([]byte(key))
+	return float64(hash.Sum64()%10000) / 100
+}
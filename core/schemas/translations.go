@@ -0,0 +1,36 @@
+package schemas
+
+// BifrostTranslationRequest represents a request to translate audio into English text.
+// Unlike a transcription, the output is always English regardless of the spoken language.
+type BifrostTranslationRequest struct {
+	Provider       ModelProvider          `json:"provider"`
+	Model          string                 `json:"model"`
+	Input          *TranscriptionInput    `json:"input,omitempty"` // Audio file to translate
+	Params         *TranslationParameters `json:"params,omitempty"`
+	Fallbacks      []Fallback             `json:"fallbacks,omitempty"`
+	RawRequestBody []byte                 `json:"-"` // set bifrost-use-raw-request-body to true in ctx to use the raw request body. Bifrost will directly send this to the downstream provider.
+}
+
+func (r *BifrostTranslationRequest) GetRawRequestBody() []byte {
+	return r.RawRequestBody
+}
+
+type TranslationParameters struct {
+	Prompt         *string  `json:"prompt,omitempty"`          // Optional text to guide the model's style, should be in English
+	ResponseFormat *string  `json:"response_format,omitempty"` // "json" (default), "text", "srt", "verbose_json" or "vtt"
+	Temperature    *float64 `json:"temperature,omitempty"`     // Sampling temperature (0.0-1.0)
+
+	// Dynamic parameters that can be provider-specific, they are directly
+	// added to the request as is.
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+type BifrostTranslationResponse struct {
+	Duration    *float64                   `json:"duration,omitempty"` // Duration of the input audio in seconds, returned with verbose_json
+	Language    *string                    `json:"language,omitempty"` // Output language, always "english"
+	Segments    []TranscriptionSegment     `json:"segments,omitempty"`
+	Task        *string                    `json:"task,omitempty"` // "translate"
+	Text        string                     `json:"text"`
+	Usage       *TranscriptionUsage        `json:"usage,omitempty"`
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
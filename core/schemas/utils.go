@@ -812,6 +812,27 @@ func deepCopyChatContentBlock(original ChatContentBlock) ChatContentBlock {
 		copy.File = &copyFile
 	}
 
+	if original.Document != nil {
+		copyDocument := ChatInputDocument{}
+		if original.Document.Data != nil {
+			copyData := *original.Document.Data
+			copyDocument.Data = &copyData
+		}
+		if original.Document.URL != nil {
+			copyURL := *original.Document.URL
+			copyDocument.URL = &copyURL
+		}
+		if original.Document.MediaType != nil {
+			copyMediaType := *original.Document.MediaType
+			copyDocument.MediaType = &copyMediaType
+		}
+		if original.Document.Title != nil {
+			copyTitle := *original.Document.Title
+			copyDocument.Title = &copyTitle
+		}
+		copy.Document = &copyDocument
+	}
+
 	return copy
 }
 
@@ -0,0 +1,222 @@
+// Package schemas defines the core schemas and types used by the Bifrost system.
+package schemas
+
+// VectorStoreStatus represents the status of a vector store.
+type VectorStoreStatus string
+
+const (
+	VectorStoreStatusExpired    VectorStoreStatus = "expired"
+	VectorStoreStatusInProgress VectorStoreStatus = "in_progress"
+	VectorStoreStatusCompleted  VectorStoreStatus = "completed"
+)
+
+// VectorStoreFileStatus represents the processing status of a file in a vector store.
+type VectorStoreFileStatus string
+
+const (
+	VectorStoreFileStatusInProgress VectorStoreFileStatus = "in_progress"
+	VectorStoreFileStatusCompleted  VectorStoreFileStatus = "completed"
+	VectorStoreFileStatusCancelled  VectorStoreFileStatus = "cancelled"
+	VectorStoreFileStatusFailed     VectorStoreFileStatus = "failed"
+)
+
+// VectorStoreExpiresAfter is the expiration policy of a vector store.
+type VectorStoreExpiresAfter struct {
+	Anchor string `json:"anchor"` // "last_active_at"
+	Days   int    `json:"days"`
+}
+
+// VectorStoreFileCounts counts the files of a vector store by status.
+type VectorStoreFileCounts struct {
+	InProgress int `json:"in_progress"`
+	Completed  int `json:"completed"`
+	Failed     int `json:"failed"`
+	Cancelled  int `json:"cancelled"`
+	Total      int `json:"total"`
+}
+
+// VectorStoreChunkingStrategy is the strategy used to chunk files added to a vector store.
+type VectorStoreChunkingStrategy struct {
+	Type   string                             `json:"type"` // "auto", "static" or "other"
+	Static *VectorStoreStaticChunkingStrategy `json:"static,omitempty"`
+}
+
+// VectorStoreStaticChunkingStrategy configures fixed size chunks.
+type VectorStoreStaticChunkingStrategy struct {
+	MaxChunkSizeTokens int `json:"max_chunk_size_tokens"`
+	ChunkOverlapTokens int `json:"chunk_overlap_tokens"`
+}
+
+// VectorStore represents a vector store returned by the API.
+type VectorStore struct {
+	ID           string                   `json:"id"`
+	Object       string                   `json:"object,omitempty"` // "vector_store"
+	CreatedAt    int64                    `json:"created_at"`
+	Name         string                   `json:"name"`
+	Description  *string                  `json:"description,omitempty"`
+	UsageBytes   int64                    `json:"usage_bytes"`
+	FileCounts   VectorStoreFileCounts    `json:"file_counts"`
+	Status       VectorStoreStatus        `json:"status"`
+	ExpiresAfter *VectorStoreExpiresAfter `json:"expires_after,omitempty"`
+	ExpiresAt    *int64                   `json:"expires_at,omitempty"`
+	LastActiveAt *int64                   `json:"last_active_at,omitempty"`
+	Metadata     map[string]string        `json:"metadata,omitempty"`
+}
+
+// VectorStoreFileError is the error of a file that could not be processed.
+type VectorStoreFileError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// VectorStoreFile represents a file attached to a vector store.
+type VectorStoreFile struct {
+	ID               string                       `json:"id"`
+	Object           string                       `json:"object,omitempty"` // "vector_store.file"
+	CreatedAt        int64                        `json:"created_at"`
+	VectorStoreID    string                       `json:"vector_store_id"`
+	UsageBytes       int64                        `json:"usage_bytes"`
+	Status           VectorStoreFileStatus        `json:"status"`
+	LastError        *VectorStoreFileError        `json:"last_error,omitempty"`
+	ChunkingStrategy *VectorStoreChunkingStrategy `json:"chunking_strategy,omitempty"`
+	Attributes       map[string]interface{}       `json:"attributes,omitempty"` // Used by search filters
+}
+
+// VectorStoreSearchResult is a chunk of a file matching a vector store search.
+type VectorStoreSearchResult struct {
+	FileID     string                     `json:"file_id"`
+	Filename   string                     `json:"filename"`
+	Score      float64                    `json:"score"`
+	Attributes map[string]interface{}     `json:"attributes,omitempty"`
+	Content    []VectorStoreSearchContent `json:"content"`
+}
+
+// VectorStoreSearchContent is the content of a search result.
+type VectorStoreSearchContent struct {
+	Type string `json:"type"` // "text"
+	Text string `json:"text"`
+}
+
+// BifrostVectorStoreCreateRequest represents a request to create a vector store.
+type BifrostVectorStoreCreateRequest struct {
+	Provider ModelProvider `json:"provider"`
+
+	// Optional fields
+	Name             *string                      `json:"name,omitempty"`
+	Description      *string                      `json:"description,omitempty"`
+	FileIDs          []string                     `json:"file_ids,omitempty"` // Files to add to the store, uploaded with the same key
+	ExpiresAfter     *VectorStoreExpiresAfter     `json:"expires_after,omitempty"`
+	ChunkingStrategy *VectorStoreChunkingStrategy `json:"chunking_strategy,omitempty"`
+	Metadata         map[string]string            `json:"metadata,omitempty"`
+
+	// Extra parameters for provider-specific features
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostVectorStoreCreateResponse represents the response from creating a vector store.
+type BifrostVectorStoreCreateResponse struct {
+	VectorStore
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
+// BifrostVectorStoreListRequest represents a request to list vector stores.
+type BifrostVectorStoreListRequest struct {
+	Provider ModelProvider `json:"provider"`
+
+	// Pagination
+	Limit int     `json:"limit,omitempty"` // Max results to return (default 20)
+	After *string `json:"after,omitempty"` // Cursor for pagination
+	Order *string `json:"order,omitempty"` // "asc" or "desc" by created_at
+
+	// Extra parameters for provider-specific features
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostVectorStoreListResponse represents the response from listing vector stores.
+type BifrostVectorStoreListResponse struct {
+	Object  string        `json:"object,omitempty"` // "list"
+	Data    []VectorStore `json:"data"`
+	HasMore bool          `json:"has_more,omitempty"`
+	After   *string       `json:"after,omitempty"` // Encoded cursor for next page (includes key index for multi-key pagination)
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
+// BifrostVectorStoreSearchRequest represents a request to search a vector store.
+type BifrostVectorStoreSearchRequest struct {
+	Provider      ModelProvider `json:"provider"`
+	VectorStoreID string        `json:"vector_store_id"`
+
+	// Required fields
+	Query interface{} `json:"query"` // A string or an array of strings
+
+	// Optional fields
+	MaxNumResults  *int                   `json:"max_num_results,omitempty"` // 1 to 50
+	RewriteQuery   *bool                  `json:"rewrite_query,omitempty"`
+	Filters        map[string]interface{} `json:"filters,omitempty"` // Comparison or compound filter on file attributes
+	RankingOptions map[string]interface{} `json:"ranking_options,omitempty"`
+
+	// Extra parameters for provider-specific features
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostVectorStoreSearchResponse represents the response from searching a vector store.
+type BifrostVectorStoreSearchResponse struct {
+	Object      string                    `json:"object,omitempty"` // "vector_store.search_results.page"
+	SearchQuery []string                  `json:"search_query,omitempty"`
+	Data        []VectorStoreSearchResult `json:"data"`
+	HasMore     bool                      `json:"has_more,omitempty"`
+	NextPage    *string                   `json:"next_page,omitempty"`
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
+// BifrostVectorStoreFileCreateRequest represents a request to add a file to a vector store.
+type BifrostVectorStoreFileCreateRequest struct {
+	Provider      ModelProvider `json:"provider"`
+	VectorStoreID string        `json:"vector_store_id"`
+
+	// Required fields
+	FileID string `json:"file_id"` // ID of an uploaded file
+
+	// Optional fields
+	Attributes       map[string]interface{}       `json:"attributes,omitempty"`
+	ChunkingStrategy *VectorStoreChunkingStrategy `json:"chunking_strategy,omitempty"`
+
+	// Extra parameters for provider-specific features
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostVectorStoreFileCreateResponse represents the response from adding a file to a vector store.
+type BifrostVectorStoreFileCreateResponse struct {
+	VectorStoreFile
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
+
+// BifrostVectorStoreFileListRequest represents a request to list the files of a vector store.
+type BifrostVectorStoreFileListRequest struct {
+	Provider      ModelProvider `json:"provider"`
+	VectorStoreID string        `json:"vector_store_id"`
+
+	// Pagination
+	Limit  int     `json:"limit,omitempty"`  // Max results to return (default 20)
+	After  *string `json:"after,omitempty"`  // ID of the last file of the previous page
+	Order  *string `json:"order,omitempty"`  // "asc" or "desc" by created_at
+	Filter *string `json:"filter,omitempty"` // Only list files with this status
+
+	// Extra parameters for provider-specific features
+	ExtraParams map[string]interface{} `json:"-"`
+}
+
+// BifrostVectorStoreFileListResponse represents the response from listing the files of a vector store.
+type BifrostVectorStoreFileListResponse struct {
+	Object  string            `json:"object,omitempty"` // "list"
+	Data    []VectorStoreFile `json:"data"`
+	FirstID *string           `json:"first_id,omitempty"`
+	LastID  *string           `json:"last_id,omitempty"`
+	HasMore bool              `json:"has_more,omitempty"`
+
+	ExtraFields BifrostResponseExtraFields `json:"extra_fields"`
+}
@@ -0,0 +1,36 @@
+package schemas
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VirtualModel is a model name applications use instead of a concrete model (e.g. "fast-chat",
+// "cheap-embeddings"), mapped to provider and model targets in priority order. A request for it
+// (without a provider) goes to the first target that can serve the request type and whose circuit
+// is not open; the other targets become the request's fallbacks, in priority order.
+type VirtualModel struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Targets     []Fallback `json:"targets"` // Provider and model targets, highest priority first
+}
+
+// Validate checks that the virtual model has a name without a provider prefix and targets with a
+// provider and model.
+func (m *VirtualModel) Validate() error {
+	if strings.TrimSpace(m.Name) == "" {
+		return fmt.Errorf("virtual model name is required")
+	}
+	if strings.Contains(m.Name, "/") {
+		return fmt.Errorf("virtual model %s: name must not contain a provider prefix", m.Name)
+	}
+	if len(m.Targets) == 0 {
+		return fmt.Errorf("virtual model %s: at least one target is required", m.Name)
+	}
+	for _, target := range m.Targets {
+		if target.Provider == "" || target.Model == "" {
+			return fmt.Errorf("virtual model %s: targets need a provider and a model", m.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,295 @@
+package bifrost
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// singleFlightCall is an upstream call identical requests wait for.
+type singleFlightCall struct {
+	key     string
+	done    chan struct{}
+	waiters int // guarded by singleFlightGroup.mu
+
+	// Set before done is closed
+	shared   bool // false when the leader gave up without an upstream outcome
+	response *schemas.BifrostResponse
+	data     []byte // JSON of the active response, copied for each waiter
+	err      *schemas.BifrostError
+}
+
+// singleFlightGroup tracks the upstream calls in flight by request key.
+type singleFlightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*singleFlightCall
+}
+
+// UpdateSingleFlightConfig updates the deduplication of identical in-flight requests at runtime.
+// Requests already waiting for a shared call keep waiting for it.
+func (bifrost *Bifrost) UpdateSingleFlightConfig(config *schemas.SingleFlightConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	bifrost.singleFlight.Store(config)
+	if config != nil {
+		bifrost.logger.Info("single_flight updated: enabled=%v", config.Enabled)
+	}
+	return nil
+}
+
+// joinSingleFlight returns the in-flight call of an identical request and false, or registers a new
+// call the request leads and returns true. It returns nil when the request is not deduplicated;
+// hedged requests never are, as they ask for duplicate upstream calls.
+func (bifrost *Bifrost) joinSingleFlight(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*singleFlightCall, bool) {
+	if !bifrost.singleFlight.Load().Covers(req.RequestType) || hedgeDelay(ctx) > 0 {
+		return nil, false
+	}
+	key, ok := singleFlightKey(ctx, req)
+	if !ok {
+		return nil, false
+	}
+	group := &bifrost.singleFlightCalls
+	group.mu.Lock()
+	defer group.mu.Unlock()
+	if call, ok := group.calls[key]; ok {
+		call.waiters++
+		return call, false
+	}
+	if group.calls == nil {
+		group.calls = make(map[string]*singleFlightCall)
+	}
+	call := &singleFlightCall{key: key, done: make(chan struct{})}
+	group.calls[key] = call
+	return call, true
+}
+
+// shareSingleFlight hands the upstream outcome of a call to the requests waiting for it, before
+// any post-hook of the leader runs. It does nothing when call is nil or already finished.
+func (bifrost *Bifrost) shareSingleFlight(call *singleFlightCall, result *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) {
+	if call == nil {
+		return
+	}
+	group := &bifrost.singleFlightCalls
+	group.mu.Lock()
+	defer group.mu.Unlock()
+	if group.calls[call.key] != call {
+		return
+	}
+	delete(group.calls, call.key)
+	if call.waiters > 0 {
+		call.shared = true
+		if bifrostErr != nil {
+			call.err = copySingleFlightError(bifrostErr)
+		} else if data, err := singleFlightResponseData(result); err == nil {
+			call.response = result
+			call.data = data
+		} else {
+			bifrost.logger.Debug(fmt.Sprintf("failed to share single-flight response: %v", err))
+			call.shared = false
+		}
+	}
+	close(call.done)
+}
+
+// abandonSingleFlight finishes a call that has no upstream outcome to share, e.g. because the leader
+// was cancelled, so its waiters make their own upstream calls.
+func (bifrost *Bifrost) abandonSingleFlight(call *singleFlightCall) {
+	if call == nil {
+		return
+	}
+	group := &bifrost.singleFlightCalls
+	group.mu.Lock()
+	defer group.mu.Unlock()
+	if group.calls[call.key] != call {
+		return
+	}
+	delete(group.calls, call.key)
+	close(call.done)
+}
+
+// waitSingleFlight waits for the leader of a call and returns a copy of its response or error. It
+// returns false when the leader gave up, in which case the request makes its own upstream call,
+// and a cancellation error when ctx is done first.
+func waitSingleFlight(ctx *schemas.BifrostContext, call *singleFlightCall, req *schemas.BifrostRequest) (*schemas.BifrostResponse, *schemas.BifrostError, bool) {
+	select {
+	case <-call.done:
+	case <-ctx.Done():
+		provider, model, _ := req.GetRequestFields()
+		return nil, &schemas.BifrostError{
+			IsBifrostError: true,
+			Error: &schemas.ErrorField{
+				Type:    schemas.Ptr(schemas.RequestCancelled),
+				Message: fmt.Sprintf("request timed out waiting for an identical in-flight request: %v", ctx.Err()),
+				Error:   ctx.Err(),
+			},
+			ExtraFields: schemas.BifrostErrorExtraFields{
+				RequestType:    req.RequestType,
+				Provider:       provider,
+				ModelRequested: model,
+			},
+		}, true
+	}
+	if !call.shared {
+		return nil, nil, false
+	}
+	ctx.SetValue(schemas.BifrostContextKeySingleFlightShared, true)
+	if call.err != nil {
+		return nil, copySingleFlightError(call.err), true
+	}
+	result, err := copySingleFlightResponse(call.response, call.data)
+	if err != nil {
+		return nil, newBifrostError(fmt.Errorf("failed to copy shared response: %w", err)), true
+	}
+	result.GetExtraFields().SingleFlightShared = true
+	return result, nil, true
+}
+
+// runSharedPostHooks runs the post-hooks of a request on the response or error it shared, the way
+// they run on the outcome of its own upstream call.
+func (bifrost *Bifrost) runSharedPostHooks(ctx *schemas.BifrostContext, pipeline *PluginPipeline, result *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError) {
+	pluginCount := len(*bifrost.llmPlugins.Load())
+	if bifrostErr == nil {
+		tagTrafficSplit(ctx, result)
+	}
+	resp, bifrostErr := pipeline.RunPostLLMHooks(ctx, result, bifrostErr, pluginCount)
+	if drop, ok := ctx.Value(schemas.BifrostContextKeyRawRequestResponseForLogging).(bool); ok && drop {
+		if bifrostErr != nil {
+			bifrostErr.ExtraFields.RawRequest = nil
+			bifrostErr.ExtraFields.RawResponse = nil
+		}
+		if resp != nil {
+			extraField := resp.GetExtraFields()
+			extraField.RawRequest = nil
+			extraField.RawResponse = nil
+		}
+	}
+	if bifrostErr != nil {
+		return nil, bifrostErr
+	}
+	return resp, nil
+}
+
+// singleFlightKey hashes everything that makes two requests identical upstream: the request as
+// plugins left it and the credentials it is sent with.
+func singleFlightKey(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (string, bool) {
+	var body any
+	var rawBody []byte
+	switch req.RequestType {
+	case schemas.TextCompletionRequest:
+		if req.TextCompletionRequest == nil {
+			return "", false
+		}
+		body, rawBody = req.TextCompletionRequest, req.TextCompletionRequest.RawRequestBody
+	case schemas.ChatCompletionRequest:
+		if req.ChatRequest == nil {
+			return "", false
+		}
+		body, rawBody = req.ChatRequest, req.ChatRequest.RawRequestBody
+	case schemas.ResponsesRequest:
+		if req.ResponsesRequest == nil {
+			return "", false
+		}
+		body, rawBody = req.ResponsesRequest, req.ResponsesRequest.RawRequestBody
+	case schemas.EmbeddingRequest:
+		if req.EmbeddingRequest == nil {
+			return "", false
+		}
+		body, rawBody = req.EmbeddingRequest, req.EmbeddingRequest.RawRequestBody
+	default:
+		return "", false
+	}
+	data, err := schemas.MarshalDeeplySorted(body)
+	if err != nil {
+		return "", false
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(req.RequestType))
+	hash.Write([]byte{0})
+	hash.Write(data)
+	hash.Write([]byte{0})
+	hash.Write(rawBody)
+	for _, key := range []schemas.BifrostContextKey{
+		schemas.BifrostContextKeyVirtualKey,
+		schemas.BifrostContextKeyAPIKeyName,
+		schemas.BifrostContextKeyAPIKeyID,
+		schemas.BifrostContextKeySelectedKeyID,
+		schemas.BifrostContextKeyGovernanceUserID,
+		schemas.BifrostContextKeyURLPath,
+	} {
+		hash.Write([]byte{0})
+		if value, ok := ctx.Value(key).(string); ok {
+			hash.Write([]byte(value))
+		}
+	}
+	hash.Write([]byte{0})
+	if key, ok := ctx.Value(schemas.BifrostContextKeyDirectKey).(schemas.Key); ok {
+		hash.Write([]byte(key.Value.GetValue()))
+	}
+	hash.Write([]byte{0})
+	if headers, ok := ctx.Value(schemas.BifrostContextKeyExtraHeaders).(map[string][]string); ok && len(headers) > 0 {
+		data, err := schemas.MarshalSorted(headers)
+		if err != nil {
+			return "", false
+		}
+		hash.Write(data)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), true
+}
+
+// singleFlightResponseData returns the JSON of the active response of result.
+func singleFlightResponseData(result *schemas.BifrostResponse) ([]byte, error) {
+	switch {
+	case result == nil:
+		return nil, fmt.Errorf("response is nil")
+	case result.TextCompletionResponse != nil:
+		return schemas.Marshal(result.TextCompletionResponse)
+	case result.ChatResponse != nil:
+		return schemas.Marshal(result.ChatResponse)
+	case result.ResponsesResponse != nil:
+		return schemas.Marshal(result.ResponsesResponse)
+	case result.EmbeddingResponse != nil:
+		return schemas.Marshal(result.EmbeddingResponse)
+	}
+	return nil, fmt.Errorf("response type cannot be shared")
+}
+
+// copySingleFlightResponse decodes a new response of the same type as result from data.
+func copySingleFlightResponse(result *schemas.BifrostResponse, data []byte) (*schemas.BifrostResponse, error) {
+	out := &schemas.BifrostResponse{}
+	var target any
+	switch {
+	case result.TextCompletionResponse != nil:
+		out.TextCompletionResponse = &schemas.BifrostTextCompletionResponse{}
+		target = out.TextCompletionResponse
+	case result.ChatResponse != nil:
+		out.ChatResponse = &schemas.BifrostChatResponse{}
+		target = out.ChatResponse
+	case result.ResponsesResponse != nil:
+		out.ResponsesResponse = &schemas.BifrostResponsesResponse{}
+		target = out.ResponsesResponse
+	case result.EmbeddingResponse != nil:
+		out.EmbeddingResponse = &schemas.BifrostEmbeddingResponse{}
+		target = out.EmbeddingResponse
+	default:
+		return nil, fmt.Errorf("response type cannot be shared")
+	}
+	if err := schemas.Unmarshal(data, target); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// copySingleFlightError copies the parts of an error post-hooks may change.
+func copySingleFlightError(bifrostErr *schemas.BifrostError) *schemas.BifrostError {
+	errCopy := *bifrostErr
+	if bifrostErr.Error != nil {
+		field := *bifrostErr.Error
+		errCopy.Error = &field
+	}
+	return &errCopy
+}
@@ -0,0 +1,166 @@
+package bifrost
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// newSingleFlightTestServer serves OpenAI chat completions that hang until release is closed,
+// counting the calls it receives.
+func newSingleFlightTestServer(t *testing.T, calls *atomic.Int32) (*httptest.Server, chan struct{}) {
+	release := make(chan struct{})
+	server := newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		calls.Add(1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		if strings.Contains(string(body), "fail") {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":{"message":"bad request","type":"invalid_request_error"}}`)
+			return
+		}
+		writeMockChatCompletion(w, "gpt-4o", "hi")
+	})
+	return server, release
+}
+
+func initSingleFlightTestBifrost(t *testing.T, baseURL string, config *schemas.SingleFlightConfig) *Bifrost {
+	return initMockProviderBifrost(t, baseURL, 8, func(_ *schemas.ProviderConfig, bifrostConfig *schemas.BifrostConfig) {
+		bifrostConfig.SingleFlight = config
+	})
+}
+
+type singleFlightTestResult struct {
+	response *schemas.BifrostChatResponse
+	err      *schemas.BifrostError
+}
+
+// sendConcurrently sends the requests at once, releases the server after they all had time to
+// reach Bifrost and returns their results in order.
+func sendConcurrently(bifrost *Bifrost, release chan struct{}, requests ...*schemas.BifrostChatRequest) []singleFlightTestResult {
+	results := make([]singleFlightTestResult, len(requests))
+	var wg sync.WaitGroup
+	for i, req := range requests {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+			results[i].response, results[i].err = bifrost.ChatCompletionRequest(ctx, req)
+		}()
+	}
+	time.Sleep(200 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	return results
+}
+
+func TestSingleFlight_SharesIdenticalRequests(t *testing.T) {
+	var calls atomic.Int32
+	server, release := newSingleFlightTestServer(t, &calls)
+	bifrost := initSingleFlightTestBifrost(t, server.URL, &schemas.SingleFlightConfig{Enabled: true})
+
+	results := sendConcurrently(bifrost, release,
+		mockChatRequest("gpt-4o", "hello"),
+		mockChatRequest("gpt-4o", "hello"),
+		mockChatRequest("gpt-4o", "hello"),
+		mockChatRequest("gpt-4o", "bye"),
+	)
+	if calls.Load() != 2 {
+		t.Errorf("upstream calls = %d, want 2", calls.Load())
+	}
+	shared := 0
+	for i, result := range results {
+		if result.err != nil {
+			t.Fatalf("request %d error = %+v", i, result.err.Error)
+		}
+		if result.response.ExtraFields.SingleFlightShared {
+			shared++
+		}
+	}
+	if shared != 2 {
+		t.Errorf("shared responses = %d, want 2", shared)
+	}
+
+	// Every request gets its own copy of the response
+	content := results[0].response.Choices[0].Message.Content.ContentStr
+	*results[1].response.Choices[0].Message.Content.ContentStr = "changed"
+	if *content != "hi" || *results[2].response.Choices[0].Message.Content.ContentStr != "hi" {
+		t.Error("expected shared responses to be copies")
+	}
+}
+
+func TestSingleFlight_SharesErrors(t *testing.T) {
+	var calls atomic.Int32
+	server, release := newSingleFlightTestServer(t, &calls)
+	bifrost := initSingleFlightTestBifrost(t, server.URL, &schemas.SingleFlightConfig{Enabled: true})
+
+	results := sendConcurrently(bifrost, release, mockChatRequest("gpt-4o", "fail"), mockChatRequest("gpt-4o", "fail"))
+	if calls.Load() != 1 {
+		t.Errorf("upstream calls = %d, want 1", calls.Load())
+	}
+	for i, result := range results {
+		if result.err == nil {
+			t.Fatalf("request %d succeeded, want the upstream error", i)
+		}
+	}
+	if results[0].err == results[1].err {
+		t.Error("expected each request to get its own error")
+	}
+}
+
+func TestSingleFlight_Disabled(t *testing.T) {
+	var calls atomic.Int32
+	server, release := newSingleFlightTestServer(t, &calls)
+	bifrost := initSingleFlightTestBifrost(t, server.URL, &schemas.SingleFlightConfig{
+		Enabled:      true,
+		RequestTypes: []schemas.RequestType{schemas.EmbeddingRequest},
+	})
+
+	sendConcurrently(bifrost, release, mockChatRequest("gpt-4o", "hello"), mockChatRequest("gpt-4o", "hello"))
+	if calls.Load() != 2 {
+		t.Errorf("upstream calls = %d, want 2 when chat completions are not deduplicated", calls.Load())
+	}
+}
+
+func TestSingleFlight_WaiterCancelled(t *testing.T) {
+	var calls atomic.Int32
+	server, release := newSingleFlightTestServer(t, &calls)
+	defer close(release)
+	bifrost := initSingleFlightTestBifrost(t, server.URL, &schemas.SingleFlightConfig{Enabled: true})
+
+	go bifrost.ChatCompletionRequest(schemas.NewBifrostContext(context.Background(), schemas.NoDeadline), mockChatRequest("gpt-4o", "hello"))
+	time.Sleep(100 * time.Millisecond)
+
+	ctx := schemas.NewBifrostContext(context.Background(), time.Now().Add(100*time.Millisecond))
+	_, bifrostErr := bifrost.ChatCompletionRequest(ctx, mockChatRequest("gpt-4o", "hello"))
+	if bifrostErr == nil || bifrostErr.Error.Type == nil || *bifrostErr.Error.Type != schemas.RequestCancelled {
+		t.Fatalf("expected a cancelled waiter, got %+v", bifrostErr)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("upstream calls = %d, want 1", calls.Load())
+	}
+}
+
+func TestSingleFlightConfig_Validate(t *testing.T) {
+	config := &schemas.SingleFlightConfig{Enabled: true, RequestTypes: []schemas.RequestType{schemas.ChatCompletionStreamRequest}}
+	if err := config.Validate(); err == nil {
+		t.Error("expected streaming requests to be rejected")
+	}
+	config.RequestTypes = nil
+	if !config.Covers(schemas.ChatCompletionRequest) || config.Covers(schemas.ChatCompletionStreamRequest) {
+		t.Error("expected all non-streaming supported types to be covered by default")
+	}
+}
@@ -0,0 +1,107 @@
+package bifrost
+
+import (
+	"strings"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/core/tokenizer"
+)
+
+// UpdateStreamUsageConfig updates the usage guarantee of streams at runtime. Streams already
+// running keep the setting they started with.
+func (bifrost *Bifrost) UpdateStreamUsageConfig(config *schemas.StreamUsageConfig) {
+	bifrost.streamUsage.Store(config)
+	if config != nil {
+		bifrost.logger.Info("stream_usage updated: enabled=%v", config.Enabled)
+	}
+}
+
+// streamUsageEstimator collects the output of a chat or text completion stream, so that usage can be
+// estimated when the provider ends the stream without reporting it.
+// An estimator holds the state of one stream and is not safe for concurrent use.
+type streamUsageEstimator struct {
+	req      *schemas.BifrostRequest
+	output   strings.Builder
+	hasUsage bool
+}
+
+// newStreamUsageEstimator returns an estimator for the stream of req, or nil if its usage isn't guaranteed.
+func (bifrost *Bifrost) newStreamUsageEstimator(req *schemas.BifrostRequest) *streamUsageEstimator {
+	config := bifrost.streamUsage.Load()
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	if req.RequestType != schemas.ChatCompletionStreamRequest && req.RequestType != schemas.TextCompletionStreamRequest {
+		return nil
+	}
+	return &streamUsageEstimator{req: req}
+}
+
+// observe records the output of a stream chunk and, on the final chunk of a stream that reported no
+// usage, sets the estimated usage on it.
+func (e *streamUsageEstimator) observe(ctx *schemas.BifrostContext, result *schemas.BifrostResponse) {
+	if e == nil || result == nil {
+		return
+	}
+	var usage *schemas.BifrostLLMUsage
+	switch {
+	case result.ChatResponse != nil:
+		usage = result.ChatResponse.Usage
+		for _, choice := range result.ChatResponse.Choices {
+			if choice.ChatStreamResponseChoice == nil || choice.Delta == nil {
+				continue
+			}
+			delta := choice.Delta
+			for _, text := range []*string{delta.Content, delta.Refusal, delta.Reasoning} {
+				if text != nil {
+					e.output.WriteString(*text)
+				}
+			}
+			if delta.Audio != nil {
+				e.output.WriteString(delta.Audio.Transcript)
+			}
+			for _, toolCall := range delta.ToolCalls {
+				if toolCall.Function.Name != nil {
+					e.output.WriteString(*toolCall.Function.Name)
+				}
+				e.output.WriteString(toolCall.Function.Arguments)
+			}
+		}
+	case result.TextCompletionResponse != nil:
+		usage = result.TextCompletionResponse.Usage
+		for _, choice := range result.TextCompletionResponse.Choices {
+			if choice.TextCompletionResponseChoice != nil && choice.Text != nil {
+				e.output.WriteString(*choice.Text)
+			}
+		}
+	default:
+		return
+	}
+	if usage != nil && usage.TotalTokens > 0 {
+		e.hasUsage = true
+	}
+	if e.hasUsage || !IsFinalChunk(ctx) {
+		return
+	}
+
+	promptTokens, _ := estimateRequestTokens(e.req)
+	provider, model, _ := e.req.GetRequestFields()
+	completionTokens := tokenizer.DefaultRegistry.Lookup(provider, model).CountTokens(e.output.String())
+	estimated := &schemas.BifrostLLMUsage{
+		PromptTokens:     promptTokens,
+		CompletionTokens: completionTokens,
+		TotalTokens:      promptTokens + completionTokens,
+	}
+	// The chunk is replaced rather than modified, as it may already be held by the tracer
+	if result.ChatResponse != nil {
+		response := *result.ChatResponse
+		response.Usage = estimated
+		response.ExtraFields.Approximate = true
+		result.ChatResponse = &response
+		return
+	}
+	response := *result.TextCompletionResponse
+	response.Usage = estimated
+	response.ExtraFields.Approximate = true
+	result.TextCompletionResponse = &response
+}
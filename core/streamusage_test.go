@@ -0,0 +1,67 @@
+package bifrost
+
+import (
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func contentChunk(content string, usage *schemas.BifrostLLMUsage) *schemas.BifrostResponse {
+	return &schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{
+		Usage: usage,
+		Choices: []schemas.BifrostResponseChoice{{
+			ChatStreamResponseChoice: &schemas.ChatStreamResponseChoice{
+				Delta: &schemas.ChatStreamResponseChoiceDelta{Content: schemas.Ptr(content)},
+			},
+		}},
+	}}
+}
+
+func TestStreamUsageEstimator(t *testing.T) {
+	bifrost := &Bifrost{}
+	req := &schemas.BifrostRequest{RequestType: schemas.ChatCompletionStreamRequest, ChatRequest: &schemas.BifrostChatRequest{
+		Provider: schemas.OpenAI,
+		Model:    "gpt-4o",
+		Input:    []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr("Hello there")}}},
+	}}
+	if bifrost.newStreamUsageEstimator(req) != nil {
+		t.Fatal("expected no estimator while stream_usage is disabled")
+	}
+	bifrost.streamUsage.Store(&schemas.StreamUsageConfig{Enabled: true})
+	if bifrost.newStreamUsageEstimator(&schemas.BifrostRequest{RequestType: schemas.SpeechStreamRequest}) != nil {
+		t.Fatal("expected no estimator for a speech stream")
+	}
+
+	t.Run("Estimated", func(t *testing.T) {
+		estimator := bifrost.newStreamUsageEstimator(req)
+		ctx := schemas.NewBifrostContext(nil, schemas.NoDeadline)
+		estimator.observe(ctx, contentChunk("Hi! How can I ", nil))
+		final := contentChunk("help you today?", nil)
+		original := final.ChatResponse
+		ctx.SetValue(schemas.BifrostContextKeyStreamEndIndicator, true)
+		estimator.observe(ctx, final)
+
+		usage := final.ChatResponse.Usage
+		if usage == nil || usage.PromptTokens == 0 || usage.CompletionTokens == 0 || usage.TotalTokens != usage.PromptTokens+usage.CompletionTokens {
+			t.Fatalf("expected estimated usage, got %+v", usage)
+		}
+		if !final.ChatResponse.ExtraFields.Approximate {
+			t.Fatal("expected the final chunk to be flagged as approximate")
+		}
+		if original.Usage != nil || original.ExtraFields.Approximate {
+			t.Fatal("expected the original chunk to be left unchanged")
+		}
+	})
+
+	t.Run("ProviderUsage", func(t *testing.T) {
+		estimator := bifrost.newStreamUsageEstimator(req)
+		ctx := schemas.NewBifrostContext(nil, schemas.NoDeadline)
+		estimator.observe(ctx, contentChunk("Hi!", &schemas.BifrostLLMUsage{PromptTokens: 9, CompletionTokens: 2, TotalTokens: 11}))
+		final := contentChunk("", nil)
+		ctx.SetValue(schemas.BifrostContextKeyStreamEndIndicator, true)
+		estimator.observe(ctx, final)
+		if final.ChatResponse.Usage != nil || final.ChatResponse.ExtraFields.Approximate {
+			t.Fatalf("expected no estimate after provider usage, got %+v", final.ChatResponse.Usage)
+		}
+	})
+}
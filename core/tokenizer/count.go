@@ -0,0 +1,106 @@
+package tokenizer
+
+import (
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// Fixed overheads of the chat formats, following OpenAI's accounting for chat messages.
+const (
+	tokensPerMessage = 3   // role and message delimiters
+	tokensPerReply   = 3   // priming of the assistant reply
+	tokensPerTool    = 8   // tool definition wrapper
+	tokensPerImage   = 765 // a 1024x1024 image at high detail; the actual count depends on the size
+)
+
+// CountResponsesRequest estimates the input tokens of a responses request (instructions, input
+// messages and tool definitions) with the tokenizer registered for its model. Images count as a
+// flat estimate; file and audio contents are not counted.
+func (r *Registry) CountResponsesRequest(req *schemas.BifrostResponsesRequest) *schemas.BifrostCountTokensResponse {
+	tokenizer := r.Lookup(req.Provider, req.Model)
+
+	inputTokens := tokensPerReply
+	if req.Params != nil {
+		if req.Params.Instructions != nil {
+			inputTokens += tokensPerMessage + tokenizer.CountTokens(*req.Params.Instructions)
+		}
+		for _, tool := range req.Params.Tools {
+			inputTokens += tokensPerTool + countTool(tokenizer, tool)
+		}
+	}
+	for _, message := range req.Input {
+		inputTokens += tokensPerMessage + countMessage(tokenizer, message)
+	}
+
+	return &schemas.BifrostCountTokensResponse{
+		Object:      "response.input_tokens",
+		Model:       req.Model,
+		InputTokens: inputTokens,
+		TotalTokens: schemas.Ptr(inputTokens),
+		Tokenizer:   tokenizer.Name(),
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType:    schemas.CountTokensRequest,
+			Provider:       req.Provider,
+			ModelRequested: req.Model,
+			Approximate:    true,
+		},
+	}
+}
+
+func countMessage(tokenizer Tokenizer, message schemas.ResponsesMessage) int {
+	tokens := 0
+	if message.Content != nil {
+		if message.Content.ContentStr != nil {
+			tokens += tokenizer.CountTokens(*message.Content.ContentStr)
+		}
+		tokens += countBlocks(tokenizer, message.Content.ContentBlocks)
+	}
+	if message.ResponsesToolMessage != nil {
+		if message.Name != nil {
+			tokens += tokenizer.CountTokens(*message.Name)
+		}
+		if message.Arguments != nil {
+			tokens += tokenizer.CountTokens(*message.Arguments)
+		}
+		if message.Output != nil {
+			if message.Output.ResponsesToolCallOutputStr != nil {
+				tokens += tokenizer.CountTokens(*message.Output.ResponsesToolCallOutputStr)
+			}
+			tokens += countBlocks(tokenizer, message.Output.ResponsesFunctionToolCallOutputBlocks)
+		}
+	}
+	if message.ResponsesReasoning != nil {
+		for _, summary := range message.Summary {
+			tokens += tokenizer.CountTokens(summary.Text)
+		}
+	}
+	return tokens
+}
+
+func countBlocks(tokenizer Tokenizer, blocks []schemas.ResponsesMessageContentBlock) int {
+	tokens := 0
+	for _, block := range blocks {
+		if block.Text != nil {
+			tokens += tokenizer.CountTokens(*block.Text)
+		}
+		if block.Type == schemas.ResponsesInputMessageContentBlockTypeImage {
+			tokens += tokensPerImage
+		}
+	}
+	return tokens
+}
+
+func countTool(tokenizer Tokenizer, tool schemas.ResponsesTool) int {
+	tokens := tokenizer.CountTokens(string(tool.Type))
+	if tool.Name != nil {
+		tokens += tokenizer.CountTokens(*tool.Name)
+	}
+	if tool.Description != nil {
+		tokens += tokenizer.CountTokens(*tool.Description)
+	}
+	if tool.ResponsesToolFunction != nil && tool.ResponsesToolFunction.Parameters != nil {
+		if parameters, err := schemas.Marshal(tool.ResponsesToolFunction.Parameters); err == nil {
+			tokens += tokenizer.CountTokens(string(parameters))
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,198 @@
+package tokenizer
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+	"unicode/utf8"
+)
+
+// SentencePiece piece types (sentencepiece_model.proto).
+// Unknown, control and unused pieces never match input text.
+const (
+	sentencePieceNormal      = 1
+	sentencePieceUserDefined = 4
+	sentencePieceByte        = 6
+)
+
+// sentencePieceSpace replaces spaces in SentencePiece vocabularies.
+const sentencePieceSpace = "▁"
+
+var errTruncatedProto = errors.New("truncated protobuf")
+
+// SentencePieceTokenizer counts tokens with a SentencePiece vocabulary, as used by Llama 2,
+// Gemma, Mistral and many other open models. Text is segmented by the Viterbi search of the unigram
+// model, which BPE models follow closely since their piece scores rank the merges. Characters
+// outside the vocabulary cost one token per UTF-8 byte when the model has byte fallback, else one.
+type SentencePieceTokenizer struct {
+	name         string
+	scores       map[string]float64
+	maxPieceLen  int // in runes
+	byteFallback bool
+}
+
+// LoadSentencePiece reads a serialized SentencePiece ModelProto (a tokenizer.model file).
+func LoadSentencePiece(name string, r io.Reader) (*SentencePieceTokenizer, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	t := &SentencePieceTokenizer{name: name, scores: make(map[string]float64)}
+	// ModelProto field 1 holds the pieces; the trainer and normalizer specs are not needed
+	err = walkProto(data, func(field int, value []byte) error {
+		if field != 1 {
+			return nil
+		}
+		return t.addPiece(value)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid sentencepiece model: %w", err)
+	}
+	if len(t.scores) == 0 {
+		return nil, fmt.Errorf("sentencepiece model has no pieces")
+	}
+	return t, nil
+}
+
+// LoadSentencePieceFile reads a SentencePiece model from a file (e.g. tokenizer.model).
+func LoadSentencePieceFile(name, path string) (*SentencePieceTokenizer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadSentencePiece(name, file)
+}
+
+// addPiece adds a SentencePiece message: field 1 is the piece, 2 its score and 3 its type.
+func (t *SentencePieceTokenizer) addPiece(data []byte) error {
+	var piece string
+	var score float64
+	pieceType := sentencePieceNormal
+	err := walkProto(data, func(field int, value []byte) error {
+		switch field {
+		case 1:
+			piece = string(value)
+		case 2:
+			if len(value) != 4 {
+				return fmt.Errorf("invalid piece score")
+			}
+			score = float64(math.Float32frombits(binary.LittleEndian.Uint32(value)))
+		case 3:
+			if len(value) != 8 {
+				return fmt.Errorf("invalid piece type")
+			}
+			pieceType = int(binary.LittleEndian.Uint64(value))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	switch pieceType {
+	case sentencePieceNormal, sentencePieceUserDefined:
+		t.scores[piece] = score
+		if n := utf8.RuneCountInString(piece); n > t.maxPieceLen {
+			t.maxPieceLen = n
+		}
+	case sentencePieceByte:
+		t.byteFallback = true
+	}
+	return nil
+}
+
+// Name implements Tokenizer.
+func (t *SentencePieceTokenizer) Name() string {
+	return t.name
+}
+
+// CountTokens implements Tokenizer.
+func (t *SentencePieceTokenizer) CountTokens(text string) int {
+	if text == "" {
+		return 0
+	}
+	runes := []rune(sentencePieceSpace + strings.ReplaceAll(text, " ", sentencePieceSpace))
+
+	// best[i] is the best segmentation of runes[:i]: its total score and token count
+	type node struct {
+		score  float64
+		tokens int
+	}
+	best := make([]node, len(runes)+1)
+	for i := 1; i <= len(runes); i++ {
+		best[i] = node{score: math.Inf(-1)}
+	}
+	// Unknown characters score below any piece so that known pieces are always preferred
+	const unknownScore = -1e6
+	for start := 0; start < len(runes); start++ {
+		if math.IsInf(best[start].score, -1) {
+			continue
+		}
+		for end := start + 1; end <= len(runes) && end-start <= t.maxPieceLen; end++ {
+			if score, ok := t.scores[string(runes[start:end])]; ok {
+				if candidate := best[start].score + score; candidate > best[end].score {
+					best[end] = node{score: candidate, tokens: best[start].tokens + 1}
+				}
+			}
+		}
+		if _, ok := t.scores[string(runes[start])]; !ok {
+			tokens := 1
+			if t.byteFallback {
+				tokens = utf8.RuneLen(runes[start])
+			}
+			if candidate := best[start].score + unknownScore; candidate > best[start+1].score {
+				best[start+1] = node{score: candidate, tokens: best[start].tokens + tokens}
+			}
+		}
+	}
+	return best[len(runes)].tokens
+}
+
+// walkProto calls fn with the field number and value of each field of a protobuf message. Varint
+// values are passed as little-endian bytes, fixed-width and length-delimited values as they are.
+func walkProto(data []byte, fn func(field int, value []byte) error) error {
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			return errTruncatedProto
+		}
+		data = data[n:]
+		field := int(tag >> 3)
+		var value []byte
+		switch tag & 7 {
+		case 0: // varint
+			v, n := binary.Uvarint(data)
+			if n <= 0 {
+				return errTruncatedProto
+			}
+			value = binary.LittleEndian.AppendUint64(nil, v)
+			data = data[n:]
+		case 1: // 64-bit
+			if len(data) < 8 {
+				return errTruncatedProto
+			}
+			value, data = data[:8], data[8:]
+		case 2: // length-delimited
+			length, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < length {
+				return errTruncatedProto
+			}
+			value, data = data[n:n+int(length)], data[n+int(length):]
+		case 5: // 32-bit
+			if len(data) < 4 {
+				return errTruncatedProto
+			}
+			value, data = data[:4], data[4:]
+		default:
+			return fmt.Errorf("unsupported protobuf wire type %d", tag&7)
+		}
+		if err := fn(field, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
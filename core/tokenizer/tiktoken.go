@@ -0,0 +1,143 @@
+package tokenizer
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tiktokenPreTokenizer splits text into the pieces that are encoded independently, following the
+// cl100k/o200k split pattern. RE2 has no lookahead, so the pattern's `\s+(?!\S)` alternative is
+// emulated by splitPieces, which leaves the last whitespace character of a run to the next piece.
+var tiktokenPreTokenizer = regexp.MustCompile(`^(?:(?i:'s|'t|'re|'ve|'m|'ll|'d)|[^\r\n\p{L}\p{N}]?\p{L}+|\p{N}{1,3}| ?[^\s\p{L}\p{N}]+[\r\n]*|\s*[\r\n]+|\s+)`)
+
+// BPETokenizer counts tokens with a byte-level BPE vocabulary in tiktoken's format, as used by
+// OpenAI's cl100k_base and o200k_base encodings and by several open models.
+type BPETokenizer struct {
+	name  string
+	ranks map[string]int
+}
+
+// LoadTiktoken reads a tiktoken vocabulary: one token per line, as the base64-encoded token bytes
+// followed by its rank (e.g. "IQ== 0"). Lower ranks are merged first.
+func LoadTiktoken(name string, r io.Reader) (*BPETokenizer, error) {
+	ranks := make(map[string]int)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		encoded, rankText, ok := strings.Cut(text, " ")
+		if !ok {
+			return nil, fmt.Errorf("invalid tiktoken line %d: expected token and rank", line)
+		}
+		token, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tiktoken line %d: %w", line, err)
+		}
+		rank, err := strconv.Atoi(strings.TrimSpace(rankText))
+		if err != nil {
+			return nil, fmt.Errorf("invalid tiktoken line %d: %w", line, err)
+		}
+		ranks[string(token)] = rank
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(ranks) == 0 {
+		return nil, fmt.Errorf("tiktoken vocabulary is empty")
+	}
+	return &BPETokenizer{name: name, ranks: ranks}, nil
+}
+
+// LoadTiktokenFile reads a tiktoken vocabulary from a file (e.g. o200k_base.tiktoken).
+func LoadTiktokenFile(name, path string) (*BPETokenizer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return LoadTiktoken(name, file)
+}
+
+// Name implements Tokenizer.
+func (t *BPETokenizer) Name() string {
+	return t.name
+}
+
+// CountTokens implements Tokenizer. Special tokens are not recognized and count as plain text.
+func (t *BPETokenizer) CountTokens(text string) int {
+	tokens := 0
+	for _, piece := range splitPieces(text) {
+		if _, ok := t.ranks[piece]; ok {
+			tokens++
+			continue
+		}
+		tokens += t.countMerged([]byte(piece))
+	}
+	return tokens
+}
+
+// countMerged applies the BPE merges to piece, always merging the adjacent pair with the lowest
+// rank, and returns the number of parts left. Bytes missing from the vocabulary count as one token.
+func (t *BPETokenizer) countMerged(piece []byte) int {
+	// boundaries[i] is the start offset of part i; the last entry is len(piece)
+	boundaries := make([]int, len(piece)+1)
+	for i := range boundaries {
+		boundaries[i] = i
+	}
+	for len(boundaries) > 2 {
+		bestRank, best := math.MaxInt, -1
+		for i := 0; i+2 < len(boundaries); i++ {
+			if rank, ok := t.ranks[string(piece[boundaries[i]:boundaries[i+2]])]; ok && rank < bestRank {
+				bestRank, best = rank, i
+			}
+		}
+		if best < 0 {
+			break
+		}
+		boundaries = append(boundaries[:best+1], boundaries[best+2:]...)
+	}
+	return len(boundaries) - 1
+}
+
+// splitPieces pre-tokenizes text with tiktokenPreTokenizer.
+func splitPieces(text string) []string {
+	var pieces []string
+	for len(text) > 0 {
+		loc := tiktokenPreTokenizer.FindStringIndex(text)
+		end := 1
+		if loc != nil && loc[1] > 0 {
+			end = loc[1]
+		}
+		// `\s+(?!\S)`: a whitespace run followed by text gives its last character to the next piece.
+		// Runs ending in a line break were matched by `\s*[\r\n]+` and are kept whole.
+		if end < len(text) && isAllSpace(text[:end]) {
+			if last, size := utf8.DecodeLastRuneInString(text[:end]); last != '\r' && last != '\n' && end-size > 0 {
+				end -= size
+			}
+		}
+		pieces = append(pieces, text[:end])
+		text = text[end:]
+	}
+	return pieces
+}
+
+func isAllSpace(s string) bool {
+	for _, r := range s {
+		if !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,230 @@
+// Package tokenizer estimates input token counts locally, for providers that have no native
+// count tokens API. The built-in estimators approximate each model family's tokenizer from
+// character classes; exact vocabularies can be loaded from tiktoken (.tiktoken) and
+// SentencePiece (.model) files and registered for the models that use them. Counts are meant
+// for budgeting and routing decisions rather than billing.
+package tokenizer
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// Tokenizer counts the tokens of a text for a model family.
+type Tokenizer interface {
+	// Name identifies the tokenizer family (e.g. "o200k", "claude").
+	Name() string
+	// CountTokens returns the number of tokens in text.
+	CountTokens(text string) int
+}
+
+// Estimator approximates a BPE tokenizer from the character classes of the text:
+// runs of letters are split into chunks of about charsPerToken characters, digits are grouped
+// digitsPerToken at a time, each CJK character costs cjkTokensPerChar tokens, and every
+// other symbol is a token of its own. Whitespace is folded into the following word.
+type Estimator struct {
+	name             string
+	charsPerToken    float64
+	cjkTokensPerChar float64
+	digitsPerToken   int
+}
+
+// NewEstimator creates an estimator. Non-positive ratios fall back to those of the generic estimator.
+func NewEstimator(name string, charsPerToken, cjkTokensPerChar float64, digitsPerToken int) *Estimator {
+	if charsPerToken <= 0 {
+		charsPerToken = 4
+	}
+	if cjkTokensPerChar <= 0 {
+		cjkTokensPerChar = 1
+	}
+	if digitsPerToken <= 0 {
+		digitsPerToken = 3
+	}
+	return &Estimator{name: name, charsPerToken: charsPerToken, cjkTokensPerChar: cjkTokensPerChar, digitsPerToken: digitsPerToken}
+}
+
+// Name implements Tokenizer.
+func (e *Estimator) Name() string {
+	return e.name
+}
+
+// CountTokens implements Tokenizer.
+func (e *Estimator) CountTokens(text string) int {
+	var tokens float64
+	letters, digits := 0, 0
+	nonASCII := false
+
+	flush := func() {
+		if letters > 0 {
+			charsPerToken := e.charsPerToken
+			// Non-Latin alphabets (Cyrillic, Greek, Arabic, ...) are split into much shorter pieces
+			if nonASCII {
+				charsPerToken /= 2
+			}
+			tokens += math.Max(1, math.Round(float64(letters)/charsPerToken))
+			letters, nonASCII = 0, false
+		}
+		if digits > 0 {
+			tokens += math.Ceil(float64(digits) / float64(e.digitsPerToken))
+			digits = 0
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flush()
+			tokens += e.cjkTokensPerChar
+		case unicode.IsLetter(r) || unicode.IsMark(r):
+			if digits > 0 {
+				flush()
+			}
+			letters++
+			if r > unicode.MaxASCII {
+				nonASCII = true
+			}
+		case unicode.IsDigit(r):
+			if letters > 0 {
+				flush()
+			}
+			digits++
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			flush()
+			tokens++
+		}
+	}
+	flush()
+	return int(math.Ceil(tokens))
+}
+
+// isCJK reports whether r is a Chinese, Japanese or Korean character.
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+// Built-in estimators for the common model families. The ratios approximate each family's
+// tokenizer on mixed English prose and code; larger vocabularies pack more characters per token.
+var (
+	Generic  = NewEstimator("generic", 4.0, 1.0, 3)
+	O200K    = NewEstimator("o200k", 4.3, 0.8, 3)
+	CL100K   = NewEstimator("cl100k", 4.0, 1.2, 3)
+	Claude   = NewEstimator("claude", 3.6, 1.3, 3)
+	Gemini   = NewEstimator("gemini", 4.2, 0.8, 1)
+	Llama    = NewEstimator("llama3", 4.1, 1.1, 3)
+	Mistral  = NewEstimator("mistral", 3.7, 1.3, 1)
+	Qwen     = NewEstimator("qwen", 4.0, 0.7, 1)
+	DeepSeek = NewEstimator("deepseek", 4.0, 0.6, 3)
+)
+
+type entry struct {
+	provider  schemas.ModelProvider // empty matches every provider
+	pattern   string                // lowercase prefix of a model name segment
+	tokenizer Tokenizer
+}
+
+// Registry maps models to tokenizers. A model matches an entry when a segment of its lowercase
+// name starts with the entry's pattern; provider-specific entries win over generic ones, then
+// longer patterns over shorter ones. Models without a match use the fallback tokenizer.
+type Registry struct {
+	mu       sync.RWMutex
+	entries  []entry
+	fallback Tokenizer
+}
+
+// NewRegistry creates a registry with the built-in model families registered.
+func NewRegistry() *Registry {
+	r := &Registry{fallback: Generic}
+	for pattern, tokenizer := range map[string]Tokenizer{
+		"gpt-4o":      O200K,
+		"gpt-4.1":     O200K,
+		"gpt-4.5":     O200K,
+		"gpt-5":       O200K,
+		"gpt-oss":     O200K,
+		"chatgpt":     O200K,
+		"o1":          O200K,
+		"o3":          O200K,
+		"o4":          O200K,
+		"gpt-4":       CL100K,
+		"gpt-3.5":     CL100K,
+		"embedding-3": CL100K,
+		"claude":      Claude,
+		"gemini":      Gemini,
+		"gemma":       Gemini,
+		"llama":       Llama,
+		"mistral":     Mistral,
+		"mixtral":     Mistral,
+		"codestral":   Mistral,
+		"qwen":        Qwen,
+		"qwq":         Qwen,
+		"deepseek":    DeepSeek,
+	} {
+		r.Register("", pattern, tokenizer)
+	}
+	return r
+}
+
+// DefaultRegistry is the registry Bifrost uses to count tokens for providers without a count tokens API.
+var DefaultRegistry = NewRegistry()
+
+// Register adds a tokenizer for models with a name segment starting with pattern. An empty provider
+// registers it for every provider.
+func (r *Registry) Register(provider schemas.ModelProvider, pattern string, tokenizer Tokenizer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, entry{provider: provider, pattern: strings.ToLower(pattern), tokenizer: tokenizer})
+}
+
+// SetFallback sets the tokenizer used for models without a registered family.
+func (r *Registry) SetFallback(tokenizer Tokenizer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fallback = tokenizer
+}
+
+// Lookup returns the tokenizer for a provider's model.
+func (r *Registry) Lookup(provider schemas.ModelProvider, model string) Tokenizer {
+	model = strings.ToLower(model)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var best *entry
+	for i := range r.entries {
+		e := &r.entries[i]
+		if e.provider != "" && e.provider != provider {
+			continue
+		}
+		if !matchesModel(model, e.pattern) {
+			continue
+		}
+		if best == nil || (e.provider != "" && best.provider == "") || (e.provider == best.provider && len(e.pattern) > len(best.pattern)) {
+			best = e
+		}
+	}
+	if best == nil {
+		return r.fallback
+	}
+	return best.tokenizer
+}
+
+// matchesModel reports whether pattern occurs in model at the start of a name segment, so that
+// "o1" matches "o1-mini" and "openai/o1" but not "gpt-4o1x" or "qwen2.5-coder-7b-o1".
+func matchesModel(model, pattern string) bool {
+	for offset := 0; offset < len(model); {
+		i := strings.Index(model[offset:], pattern)
+		if i < 0 {
+			return false
+		}
+		start := offset + i
+		if start == 0 || strings.ContainsRune("/.:_ ", rune(model[start-1])) {
+			return true
+		}
+		offset = start + 1
+	}
+	return false
+}
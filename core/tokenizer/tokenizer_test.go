@@ -0,0 +1,196 @@
+package tokenizer
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestEstimatorCountTokens(t *testing.T) {
+	estimator := NewEstimator("test", 4, 1, 3)
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		{"hello world", 2},
+		{"internationalization", 5},
+		{"Hello, world!", 4},
+		{"1234567", 3},
+		{"你好世界", 4},
+		{"привет", 3},
+		{"version 2.5", 5},
+	}
+	for _, tt := range tests {
+		if got := estimator.CountTokens(tt.text); got != tt.want {
+			t.Errorf("CountTokens(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestRegistryLookup(t *testing.T) {
+	registry := NewRegistry()
+	custom := NewEstimator("custom", 3, 1, 3)
+	registry.Register(schemas.Bedrock, "claude", custom)
+
+	tests := []struct {
+		provider schemas.ModelProvider
+		model    string
+		want     string
+	}{
+		{schemas.OpenAI, "gpt-4o-mini", "o200k"},
+		{schemas.OpenAI, "gpt-4-turbo", "cl100k"},
+		{schemas.OpenAI, "o1-mini", "o200k"},
+		{schemas.OpenRouter, "openai/o3", "o200k"},
+		{schemas.Anthropic, "claude-sonnet-4-5", "claude"},
+		{schemas.Bedrock, "us.anthropic.claude-3-5-sonnet-20241022-v2:0", "custom"},
+		{schemas.Groq, "llama-3.3-70b-versatile", "llama3"},
+		{schemas.Ollama, "qwen2.5-coder-7b-o1", "qwen"},
+		{schemas.Cohere, "command-r-plus", "generic"},
+	}
+	for _, tt := range tests {
+		if got := registry.Lookup(tt.provider, tt.model).Name(); got != tt.want {
+			t.Errorf("Lookup(%s, %s) = %s, want %s", tt.provider, tt.model, got, tt.want)
+		}
+	}
+}
+
+func TestCountResponsesRequest(t *testing.T) {
+	registry := NewRegistry()
+	registry.SetFallback(NewEstimator("flat", 4, 1, 3))
+
+	text := "hello world"
+	req := &schemas.BifrostResponsesRequest{
+		Provider: schemas.Cohere,
+		Model:    "command-r-plus",
+		Input: []schemas.ResponsesMessage{
+			{
+				Role:    schemas.Ptr(schemas.ResponsesInputMessageRoleUser),
+				Content: &schemas.ResponsesMessageContent{ContentStr: &text},
+			},
+			{
+				Role: schemas.Ptr(schemas.ResponsesInputMessageRoleUser),
+				Content: &schemas.ResponsesMessageContent{ContentBlocks: []schemas.ResponsesMessageContentBlock{
+					{Type: schemas.ResponsesInputMessageContentBlockTypeText, Text: &text},
+					{Type: schemas.ResponsesInputMessageContentBlockTypeImage, ResponsesInputMessageContentBlockImage: &schemas.ResponsesInputMessageContentBlockImage{ImageURL: schemas.Ptr("https://example.com/cat.png")}},
+				}},
+			},
+		},
+		Params: &schemas.ResponsesParameters{Instructions: &text},
+	}
+
+	resp := registry.CountResponsesRequest(req)
+	// reply priming + 3 messages with overheads + 3 texts of 2 tokens + 1 image
+	want := tokensPerReply + 3*tokensPerMessage + 3*2 + tokensPerImage
+	if resp.InputTokens != want || resp.TotalTokens == nil || *resp.TotalTokens != want {
+		t.Errorf("InputTokens = %d, want %d", resp.InputTokens, want)
+	}
+	if !resp.ExtraFields.Approximate || resp.Tokenizer != "flat" {
+		t.Errorf("expected an estimate from the fallback tokenizer, got approximate=%v tokenizer=%s", resp.ExtraFields.Approximate, resp.Tokenizer)
+	}
+	if resp.ExtraFields.Provider != schemas.Cohere || resp.ExtraFields.RequestType != schemas.CountTokensRequest {
+		t.Errorf("unexpected extra fields: %+v", resp.ExtraFields)
+	}
+}
+
+func TestSplitPieces(t *testing.T) {
+	got := splitPieces("hello  world\n\nit's 12345!")
+	want := []string{"hello", " ", " world", "\n\n", "it", "'s", " ", "123", "45", "!"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("splitPieces() = %q, want %q", got, want)
+	}
+}
+
+func TestLoadTiktoken(t *testing.T) {
+	var vocab strings.Builder
+	for rank, token := range []string{"a", "b", "c", " ", "ab", "abc", " ab"} {
+		fmt.Fprintf(&vocab, "%s %d\n", base64.StdEncoding.EncodeToString([]byte(token)), rank)
+	}
+	tok, err := LoadTiktoken("test", strings.NewReader(vocab.String()))
+	if err != nil {
+		t.Fatalf("LoadTiktoken() error = %v", err)
+	}
+	// "abc" is one token; " abc" merges "ab" then "abc" (rank 5) before " ab" (rank 6)
+	if got := tok.CountTokens("abc abc"); got != 3 {
+		t.Errorf("CountTokens() = %d, want 3", got)
+	}
+	// "x" is not in the vocabulary and costs one token
+	if got := tok.CountTokens("xab"); got != 2 {
+		t.Errorf("CountTokens() = %d, want 2", got)
+	}
+
+	if _, err := LoadTiktoken("test", strings.NewReader("YQ==\n")); err == nil {
+		t.Error("expected an error for a line without a rank")
+	}
+}
+
+// encodeSentencePieceModel serializes pieces as a SentencePiece ModelProto.
+func encodeSentencePieceModel(pieces []struct {
+	piece     string
+	score     float32
+	pieceType uint64
+}) []byte {
+	var model []byte
+	for _, p := range pieces {
+		var msg []byte
+		msg = binary.AppendUvarint(msg, 1<<3|2)
+		msg = binary.AppendUvarint(msg, uint64(len(p.piece)))
+		msg = append(msg, p.piece...)
+		msg = binary.AppendUvarint(msg, 2<<3|5)
+		msg = binary.LittleEndian.AppendUint32(msg, math.Float32bits(p.score))
+		msg = binary.AppendUvarint(msg, 3<<3|0)
+		msg = binary.AppendUvarint(msg, p.pieceType)
+
+		model = binary.AppendUvarint(model, 1<<3|2)
+		model = binary.AppendUvarint(model, uint64(len(msg)))
+		model = append(model, msg...)
+	}
+	return model
+}
+
+func TestLoadSentencePiece(t *testing.T) {
+	model := encodeSentencePieceModel([]struct {
+		piece     string
+		score     float32
+		pieceType uint64
+	}{
+		{"<unk>", 0, 2},
+		{"<s>", 0, 3},
+		{"<0xC3>", 0, 6},
+		{"▁", -1, 1},
+		{"▁hello", -2, 1},
+		{"▁world", -3, 1},
+		{"▁wor", -2, 1},
+		{"l", -1, 1},
+		{"d", -1, 1},
+	})
+	tok, err := LoadSentencePiece("test", strings.NewReader(string(model)))
+	if err != nil {
+		t.Fatalf("LoadSentencePiece() error = %v", err)
+	}
+	tests := []struct {
+		text string
+		want int
+	}{
+		{"", 0},
+		// "▁world" (-3) scores higher than "▁wor" + "l" + "d" (-4)
+		{"hello world", 2},
+		// "é" falls back to its two UTF-8 bytes
+		{"hello é", 4},
+	}
+	for _, tt := range tests {
+		if got := tok.CountTokens(tt.text); got != tt.want {
+			t.Errorf("CountTokens(%q) = %d, want %d", tt.text, got, tt.want)
+		}
+	}
+
+	if _, err := LoadSentencePiece("test", strings.NewReader("\x0a\x10")); err == nil {
+		t.Error("expected an error for a truncated model")
+	}
+}
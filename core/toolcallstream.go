@@ -0,0 +1,139 @@
+package bifrost
+
+import (
+	"encoding/json"
+	"strings"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// toolCallStreamNormalizer rewrites the tool call deltas of a chat stream into OpenAI's incremental form, whatever
+// the provider sends: every tool call of a choice gets its own index, counted from 0 in the order the calls start,
+// its first delta carries the ID, type and name, and the following deltas only carry the index and the new
+// argument fragment. Providers that resend whole tool calls in every chunk, or restart indexes in each chunk
+// (e.g. Gemini), therefore reach plugins and clients the same way as OpenAI's fragments.
+// A normalizer holds the state of one stream and is not safe for concurrent use.
+type toolCallStreamNormalizer struct {
+	choices map[int]*toolCallStreamChoice
+}
+
+// toolCallStreamChoice is the state of the tool calls of one choice of a stream.
+type toolCallStreamChoice struct {
+	calls   []*streamedToolCall
+	byIndex map[uint16]*streamedToolCall // Last call started under each provider index
+	last    *streamedToolCall            // Last call started, for fragments of calls never started
+}
+
+// streamedToolCall is a tool call whose start has already been sent.
+type streamedToolCall struct {
+	index     uint16
+	id        string
+	arguments string // Arguments sent so far
+}
+
+func newToolCallStreamNormalizer() *toolCallStreamNormalizer {
+	return &toolCallStreamNormalizer{choices: map[int]*toolCallStreamChoice{}}
+}
+
+// normalize rewrites the tool call deltas of a chat stream chunk. The deltas are replaced rather than modified,
+// as the chunk may already be held by the tracer.
+func (n *toolCallStreamNormalizer) normalize(result *schemas.BifrostResponse) {
+	if n == nil || result == nil || result.ChatResponse == nil {
+		return
+	}
+	for i := range result.ChatResponse.Choices {
+		choice := &result.ChatResponse.Choices[i]
+		if choice.ChatStreamResponseChoice == nil || choice.Delta == nil || len(choice.Delta.ToolCalls) == 0 {
+			continue
+		}
+		state, ok := n.choices[choice.Index]
+		if !ok {
+			state = &toolCallStreamChoice{byIndex: map[uint16]*streamedToolCall{}}
+			n.choices[choice.Index] = state
+		}
+		toolCalls := make([]schemas.ChatAssistantMessageToolCall, 0, len(choice.Delta.ToolCalls))
+		for _, toolCall := range choice.Delta.ToolCalls {
+			if normalized, ok := state.normalize(toolCall); ok {
+				toolCalls = append(toolCalls, normalized)
+			}
+		}
+		delta := *choice.Delta
+		delta.ToolCalls = toolCalls
+		streamChoice := *choice.ChatStreamResponseChoice
+		streamChoice.Delta = &delta
+		choice.ChatStreamResponseChoice = &streamChoice
+	}
+}
+
+// normalize returns the normalized form of a tool call delta, and false if it adds nothing to what was sent.
+func (s *toolCallStreamChoice) normalize(toolCall schemas.ChatAssistantMessageToolCall) (schemas.ChatAssistantMessageToolCall, bool) {
+	call := s.find(toolCall)
+	if call == nil {
+		call = &streamedToolCall{index: uint16(len(s.calls)), arguments: toolCall.Function.Arguments}
+		if toolCall.ID != nil {
+			call.id = *toolCall.ID
+		}
+		s.calls = append(s.calls, call)
+		s.byIndex[toolCall.Index] = call
+		s.last = call
+		normalized := toolCall
+		normalized.Index = call.index
+		if normalized.Type == nil {
+			normalized.Type = schemas.Ptr(string(schemas.ChatToolTypeFunction))
+		}
+		return normalized, true
+	}
+
+	// Providers sending whole tool calls repeat the arguments sent so far
+	fragment := toolCall.Function.Arguments
+	if call.arguments != "" && strings.HasPrefix(fragment, call.arguments) {
+		fragment = fragment[len(call.arguments):]
+	}
+	if call.id == "" && toolCall.ID != nil && *toolCall.ID != "" {
+		call.id = *toolCall.ID
+	}
+	if fragment == "" {
+		return schemas.ChatAssistantMessageToolCall{}, false
+	}
+	call.arguments += fragment
+	return schemas.ChatAssistantMessageToolCall{
+		Index:    call.index,
+		Function: schemas.ChatAssistantMessageToolCallFunction{Arguments: fragment},
+	}, true
+}
+
+// find returns the tool call a delta continues, or nil if the delta starts a new one.
+// A delta continues the call with its ID or, without an ID, the last call started under its index.
+// A named delta starts a new call when that call's arguments are already complete and it doesn't
+// resend them, as when a provider gives every call the same index or the function name as ID.
+func (s *toolCallStreamChoice) find(toolCall schemas.ChatAssistantMessageToolCall) *streamedToolCall {
+	var call *streamedToolCall
+	if toolCall.ID != nil && *toolCall.ID != "" {
+		for i := len(s.calls) - 1; i >= 0; i-- {
+			if s.calls[i].id == *toolCall.ID {
+				call = s.calls[i]
+				break
+			}
+		}
+		if call == nil {
+			// A known index with an unseen ID is a new call unless the ID arrives late
+			if indexed, ok := s.byIndex[toolCall.Index]; ok && indexed.id == "" {
+				call = indexed
+			}
+		}
+	} else if indexed, ok := s.byIndex[toolCall.Index]; ok {
+		call = indexed
+	}
+	if call == nil {
+		if toolCall.Function.Name == nil || *toolCall.Function.Name == "" {
+			// A fragment for a call that was never started belongs to the last one
+			return s.last
+		}
+		return nil
+	}
+	if toolCall.Function.Name != nil && *toolCall.Function.Name != "" && call.arguments != "" &&
+		!strings.HasPrefix(toolCall.Function.Arguments, call.arguments) && json.Valid([]byte(call.arguments)) {
+		return nil
+	}
+	return call
+}
@@ -0,0 +1,96 @@
+package bifrost
+
+import (
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// streamToolCalls runs each chunk's tool call deltas through a normalizer and returns the normalized deltas.
+func streamToolCalls(chunks ...[]schemas.ChatAssistantMessageToolCall) [][]schemas.ChatAssistantMessageToolCall {
+	normalizer := newToolCallStreamNormalizer()
+	var normalized [][]schemas.ChatAssistantMessageToolCall
+	for _, toolCalls := range chunks {
+		result := &schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{
+			Choices: []schemas.BifrostResponseChoice{{
+				ChatStreamResponseChoice: &schemas.ChatStreamResponseChoice{
+					Delta: &schemas.ChatStreamResponseChoiceDelta{ToolCalls: toolCalls},
+				},
+			}},
+		}}
+		normalizer.normalize(result)
+		normalized = append(normalized, result.ChatResponse.Choices[0].Delta.ToolCalls)
+	}
+	return normalized
+}
+
+func toolCallDelta(index uint16, id, name, arguments string) schemas.ChatAssistantMessageToolCall {
+	toolCall := schemas.ChatAssistantMessageToolCall{Index: index, Function: schemas.ChatAssistantMessageToolCallFunction{Arguments: arguments}}
+	if id != "" {
+		toolCall.ID = schemas.Ptr(id)
+	}
+	if name != "" {
+		toolCall.Function.Name = schemas.Ptr(name)
+	}
+	return toolCall
+}
+
+// assertToolCallDelta checks the index and arguments of a normalized delta, and that only the first delta of a call is named.
+func assertToolCallDelta(t *testing.T, toolCall schemas.ChatAssistantMessageToolCall, index uint16, start bool, arguments string) {
+	t.Helper()
+	if toolCall.Index != index || toolCall.Function.Arguments != arguments {
+		t.Fatalf("expected index %d with arguments %q, got index %d with %q", index, arguments, toolCall.Index, toolCall.Function.Arguments)
+	}
+	if start != (toolCall.Function.Name != nil) || start != (toolCall.ID != nil) || start != (toolCall.Type != nil) {
+		t.Fatalf("expected start=%v, got id=%v name=%v type=%v", start, toolCall.ID, toolCall.Function.Name, toolCall.Type)
+	}
+}
+
+func TestToolCallStreamNormalizer(t *testing.T) {
+	t.Run("Fragments", func(t *testing.T) {
+		// Bedrock-style block indexes are renumbered from 0
+		chunks := streamToolCalls(
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(1, "call_a", "get_weather", "")},
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(1, "", "", `{"city":`)},
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(2, "call_b", "get_time", `{}`)},
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(1, "", "", `"Paris"}`)},
+		)
+		assertToolCallDelta(t, chunks[0][0], 0, true, "")
+		assertToolCallDelta(t, chunks[1][0], 0, false, `{"city":`)
+		assertToolCallDelta(t, chunks[2][0], 1, true, `{}`)
+		assertToolCallDelta(t, chunks[3][0], 0, false, `"Paris"}`)
+	})
+
+	t.Run("WholeCallsPerChunk", func(t *testing.T) {
+		// Gemini-style: each chunk restarts indexes, and the function name stands in for a missing ID
+		chunks := streamToolCalls(
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(0, "get_weather", "get_weather", `{"city":"Paris"}`)},
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(0, "get_weather", "get_weather", `{"city":"London"}`)},
+		)
+		assertToolCallDelta(t, chunks[0][0], 0, true, `{"city":"Paris"}`)
+		assertToolCallDelta(t, chunks[1][0], 1, true, `{"city":"London"}`)
+	})
+
+	t.Run("CumulativeArguments", func(t *testing.T) {
+		// Providers resending the whole call with the arguments so far only yield the new part
+		chunks := streamToolCalls(
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(0, "call_a", "search", `{"q":`)},
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(0, "call_a", "search", `{"q":"go"}`)},
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(0, "call_a", "search", `{"q":"go"}`)},
+		)
+		assertToolCallDelta(t, chunks[0][0], 0, true, `{"q":`)
+		assertToolCallDelta(t, chunks[1][0], 0, false, `"go"}`)
+		if len(chunks[2]) != 0 {
+			t.Fatalf("expected a repeated call to be dropped, got %+v", chunks[2])
+		}
+	})
+
+	t.Run("NamedFragments", func(t *testing.T) {
+		// Fragments that repeat the ID and name are still continuations while the arguments are incomplete
+		chunks := streamToolCalls(
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(0, "call_a", "search", `{"q":`)},
+			[]schemas.ChatAssistantMessageToolCall{toolCallDelta(0, "call_a", "search", `"go"}`)},
+		)
+		assertToolCallDelta(t, chunks[1][0], 0, false, `"go"}`)
+	})
+}
@@ -0,0 +1,234 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// maxToolWebhookResponseBytes caps the size of a tool webhook result.
+const maxToolWebhookResponseBytes = 4 * 1024 * 1024
+
+// toolWebhookClient is shared by all tool webhook calls so connections are reused.
+var toolWebhookClient = &fasthttp.Client{
+	MaxResponseBodySize: maxToolWebhookResponseBytes,
+}
+
+// UpdateToolExecutionConfig replaces the tools Bifrost executes at runtime. Requests already
+// executing tool calls finish with the tools they started with.
+func (bifrost *Bifrost) UpdateToolExecutionConfig(config *schemas.ToolExecutionConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	bifrost.toolExecution.Store(config)
+	if config != nil {
+		bifrost.logger.Info("tool_execution updated: functions=%d, webhooks=%d", len(config.Functions), len(config.Webhooks))
+	}
+	return nil
+}
+
+// maxToolIterations returns the tool-call rounds Bifrost executes for a request, or 0 when the
+// request did not opt in or no tools are registered.
+func maxToolIterations(ctx *schemas.BifrostContext, config *schemas.ToolExecutionConfig) int {
+	if config == nil || len(config.Functions)+len(config.Webhooks) == 0 {
+		return 0
+	}
+	if execute, ok := ctx.Value(schemas.BifrostContextKeyExecuteTools).(bool); !ok || !execute {
+		return 0
+	}
+	limit := config.MaxIterations
+	if limit == 0 {
+		limit = schemas.DefaultToolExecutionMaxIterations
+	}
+	if requested, ok := ctx.Value(schemas.BifrostContextKeyMaxToolIterations).(int); ok && requested > 0 && requested < limit {
+		return requested
+	}
+	return limit
+}
+
+// executeToolCalls executes the calls of registered tools in a chat completion response, appends
+// the results to the conversation and calls the model again, until the response has no tool calls,
+// calls a tool that is not registered or the iteration limit is reached. Only the first choice is
+// followed. The final response records every invocation in its extra fields.
+func (bifrost *Bifrost) executeToolCalls(ctx *schemas.BifrostContext, req *schemas.BifrostChatRequest, response *schemas.BifrostChatResponse) (*schemas.BifrostChatResponse, *schemas.BifrostError) {
+	config := bifrost.toolExecution.Load()
+	maxIterations := maxToolIterations(ctx, config)
+	if maxIterations == 0 {
+		return response, nil
+	}
+
+	// Each follow-up call gets its own request ID so plugins such as logging record it separately
+	originalRequestID, hasRequestID := ctx.Value(schemas.BifrostContextKeyRequestID).(string)
+	if hasRequestID {
+		defer ctx.SetValue(schemas.BifrostContextKeyRequestID, originalRequestID)
+	}
+
+	messages := slices.Clip(req.Input)
+	var invocations []schemas.ToolInvocation
+	for iteration := 1; ; iteration++ {
+		message := toolCallMessage(response)
+		if message == nil {
+			break
+		}
+		if slices.ContainsFunc(message.ToolCalls, func(call schemas.ChatAssistantMessageToolCall) bool {
+			return call.Function.Name == nil || !config.Has(*call.Function.Name)
+		}) {
+			// The caller has to execute some of the calls, so it gets all of them
+			break
+		}
+		if iteration > maxIterations {
+			response.ExtraFields.Warnings = append(response.ExtraFields.Warnings, fmt.Sprintf("tool execution stopped after %d iterations", maxIterations))
+			break
+		}
+
+		results := bifrost.invokeTools(ctx, config, iteration, message.ToolCalls)
+		messages = append(messages, *message)
+		for i, result := range results {
+			content := result.Result
+			if result.Error != "" {
+				content = fmt.Sprintf("Error executing tool %s: %s", result.Name, result.Error)
+			}
+			messages = append(messages, schemas.ChatMessage{
+				Role:            schemas.ChatMessageRoleTool,
+				Content:         &schemas.ChatMessageContent{ContentStr: &content},
+				ChatToolMessage: &schemas.ChatToolMessage{ToolCallID: message.ToolCalls[i].ID},
+			})
+		}
+		invocations = append(invocations, results...)
+
+		next := *req
+		next.Input = messages
+		if hasRequestID {
+			ctx.SetValue(schemas.BifrostContextKeyRequestID, fmt.Sprintf("%s-tools-%d", originalRequestID, iteration))
+		}
+		var bifrostErr *schemas.BifrostError
+		response, bifrostErr = bifrost.makeChatCompletionRequest(ctx, &next)
+		if bifrostErr != nil {
+			return nil, bifrostErr
+		}
+	}
+
+	if len(invocations) > 0 {
+		response.ExtraFields.ToolInvocations = append(response.ExtraFields.ToolInvocations, invocations...)
+	}
+	return response, nil
+}
+
+// toolCallMessage returns the assistant message of the first choice if it has tool calls.
+func toolCallMessage(response *schemas.BifrostChatResponse) *schemas.ChatMessage {
+	if response == nil || len(response.Choices) == 0 {
+		return nil
+	}
+	choice := response.Choices[0]
+	if choice.ChatNonStreamResponseChoice == nil || choice.Message == nil || choice.Message.ChatAssistantMessage == nil || len(choice.Message.ToolCalls) == 0 {
+		return nil
+	}
+	return choice.Message
+}
+
+// invokeTools executes the tool calls in parallel and returns their invocations in call order.
+func (bifrost *Bifrost) invokeTools(ctx *schemas.BifrostContext, config *schemas.ToolExecutionConfig, iteration int, toolCalls []schemas.ChatAssistantMessageToolCall) []schemas.ToolInvocation {
+	invocations := make([]schemas.ToolInvocation, len(toolCalls))
+	var wg sync.WaitGroup
+	for i, call := range toolCalls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			invocation := schemas.ToolInvocation{
+				Iteration: iteration,
+				Name:      *call.Function.Name,
+				Arguments: call.Function.Arguments,
+			}
+			if call.ID != nil {
+				invocation.ToolCallID = *call.ID
+			}
+			start := time.Now()
+			result, err := invokeTool(ctx, config, invocation)
+			invocation.Latency = time.Since(start).Milliseconds()
+			if err != nil {
+				bifrost.logger.Debug("tool %s failed: %v", invocation.Name, err)
+				invocation.Error = err.Error()
+			} else {
+				invocation.Result = result
+			}
+			invocations[i] = invocation
+		}()
+	}
+	wg.Wait()
+	return invocations
+}
+
+// invokeTool executes a tool call with its registered function or webhook.
+func invokeTool(ctx *schemas.BifrostContext, config *schemas.ToolExecutionConfig, invocation schemas.ToolInvocation) (result string, err error) {
+	if function, ok := config.Functions[invocation.Name]; ok {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("tool panicked: %v", r)
+			}
+		}()
+		return function(ctx, invocation.Arguments)
+	}
+	return callToolWebhook(ctx, config.Webhooks[invocation.Name], invocation)
+}
+
+// callToolWebhook POSTs a tool call to its webhook and returns the response body.
+func callToolWebhook(ctx context.Context, webhook schemas.ToolWebhook, invocation schemas.ToolInvocation) (string, error) {
+	arguments := json.RawMessage(invocation.Arguments)
+	if len(arguments) == 0 {
+		arguments = json.RawMessage("{}")
+	}
+	if !json.Valid(arguments) {
+		return "", fmt.Errorf("tool call arguments are not valid JSON")
+	}
+	body, err := schemas.Marshal(map[string]any{
+		"tool_call_id": invocation.ToolCallID,
+		"name":         invocation.Name,
+		"arguments":    arguments,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	timeout := webhook.TimeoutInSeconds
+	if timeout <= 0 {
+		timeout = schemas.DefaultToolWebhookTimeoutInSeconds
+	}
+	callCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	req := fasthttp.AcquireRequest()
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseRequest(req)
+	defer fasthttp.ReleaseResponse(resp)
+
+	req.SetRequestURI(webhook.URL)
+	req.Header.SetMethod(http.MethodPost)
+	req.Header.SetContentType("application/json")
+	for name, value := range webhook.Headers {
+		req.Header.Set(name, value)
+	}
+	req.SetBody(body)
+
+	if _, bifrostErr := providerUtils.MakeRequestWithContext(callCtx, toolWebhookClient, req, resp); bifrostErr != nil {
+		if bifrostErr.Error != nil {
+			return "", fmt.Errorf("tool webhook failed: %s", bifrostErr.Error.Message)
+		}
+		return "", fmt.Errorf("tool webhook failed")
+	}
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return "", fmt.Errorf("tool webhook returned status %d", resp.StatusCode())
+	}
+	data, err := providerUtils.CheckAndDecodeBody(resp)
+	if err != nil {
+		return "", fmt.Errorf("failed to read tool webhook response: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,202 @@
+package bifrost
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// newToolExecutionTestServer serves OpenAI chat completions that call the tool named in the last
+// user message until a tool result is in the conversation, then answer with the tool results.
+func newToolExecutionTestServer(t *testing.T, calls *atomic.Int32) *httptest.Server {
+	return newMockProviderServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		var body struct {
+			Messages []struct {
+				Role    string `json:"role"`
+				Content string `json:"content"`
+			} `json:"messages"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		last := body.Messages[len(body.Messages)-1]
+		if last.Role == "tool" && !strings.Contains(body.Messages[0].Content, "loop") {
+			writeMockChatCompletion(w, "gpt-4o", "answer: "+last.Content)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		tool := strings.Fields(body.Messages[0].Content)[0]
+		fmt.Fprintf(w, `{"id":"chatcmpl-1","object":"chat.completion","model":"gpt-4o","choices":[{"index":0,"message":{"role":"assistant","tool_calls":[{"id":"call_%d","type":"function","function":{"name":%q,"arguments":"{\"city\":\"Paris\"}"}}]},"finish_reason":"tool_calls"}]}`, len(body.Messages), tool)
+	})
+}
+
+func initToolExecutionTestBifrost(t *testing.T, baseURL string, config *schemas.ToolExecutionConfig) *Bifrost {
+	return initMockProviderBifrost(t, baseURL, 8, func(_ *schemas.ProviderConfig, bifrostConfig *schemas.BifrostConfig) {
+		bifrostConfig.ToolExecution = config
+	})
+}
+
+func executeToolsContext() *schemas.BifrostContext {
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyExecuteTools, true)
+	return ctx
+}
+
+func weatherFunction(ctx *schemas.BifrostContext, arguments string) (string, error) {
+	var args struct {
+		City string `json:"city"`
+	}
+	if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+		return "", err
+	}
+	return "sunny in " + args.City, nil
+}
+
+func TestToolExecution_ExecutesFunctions(t *testing.T) {
+	var calls atomic.Int32
+	server := newToolExecutionTestServer(t, &calls)
+	bifrost := initToolExecutionTestBifrost(t, server.URL, &schemas.ToolExecutionConfig{
+		Functions: map[string]schemas.ToolFunction{"weather": weatherFunction},
+	})
+
+	response, err := bifrost.ChatCompletionRequest(executeToolsContext(), mockChatRequest("gpt-4o", "weather please"))
+	if err != nil {
+		t.Fatalf("ChatCompletionRequest() error = %+v", err.Error)
+	}
+	if got := *response.Choices[0].Message.Content.ContentStr; got != "answer: sunny in Paris" {
+		t.Errorf("final answer = %q", got)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("upstream calls = %d, want 2", calls.Load())
+	}
+	invocations := response.ExtraFields.ToolInvocations
+	if len(invocations) != 1 || invocations[0].Name != "weather" || invocations[0].Iteration != 1 || invocations[0].ToolCallID != "call_1" ||
+		invocations[0].Arguments != `{"city":"Paris"}` || invocations[0].Result != "sunny in Paris" {
+		t.Errorf("tool invocations = %+v", invocations)
+	}
+}
+
+func TestToolExecution_ExecutesWebhooks(t *testing.T) {
+	var received struct {
+		ToolCallID string            `json:"tool_call_id"`
+		Name       string            `json:"name"`
+		Arguments  map[string]string `json:"arguments"`
+	}
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		json.Unmarshal(body, &received)
+		fmt.Fprint(w, "rainy")
+	}))
+	t.Cleanup(webhook.Close)
+
+	var calls atomic.Int32
+	server := newToolExecutionTestServer(t, &calls)
+	bifrost := initToolExecutionTestBifrost(t, server.URL, &schemas.ToolExecutionConfig{
+		Webhooks: map[string]schemas.ToolWebhook{"forecast": {URL: webhook.URL, Headers: map[string]string{"Authorization": "Bearer secret"}}},
+	})
+
+	response, err := bifrost.ChatCompletionRequest(executeToolsContext(), mockChatRequest("gpt-4o", "forecast please"))
+	if err != nil {
+		t.Fatalf("ChatCompletionRequest() error = %+v", err.Error)
+	}
+	if got := *response.Choices[0].Message.Content.ContentStr; got != "answer: rainy" {
+		t.Errorf("final answer = %q", got)
+	}
+	if received.Name != "forecast" || received.ToolCallID != "call_1" || received.Arguments["city"] != "Paris" {
+		t.Errorf("webhook received %+v", received)
+	}
+}
+
+func TestToolExecution_ReturnsToolCalls(t *testing.T) {
+	var calls atomic.Int32
+	server := newToolExecutionTestServer(t, &calls)
+	bifrost := initToolExecutionTestBifrost(t, server.URL, &schemas.ToolExecutionConfig{
+		Functions:     map[string]schemas.ToolFunction{"weather": weatherFunction},
+		MaxIterations: 3,
+	})
+
+	// Without opting in, tool calls are returned to the caller
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	response, err := bifrost.ChatCompletionRequest(ctx, mockChatRequest("gpt-4o", "weather please"))
+	if err != nil {
+		t.Fatalf("ChatCompletionRequest() error = %+v", err.Error)
+	}
+	if toolCallMessage(response) == nil || len(response.ExtraFields.ToolInvocations) != 0 {
+		t.Errorf("response without opt-in = %+v, want the tool calls", response)
+	}
+
+	// Calls of unregistered tools are returned to the caller
+	response, err = bifrost.ChatCompletionRequest(executeToolsContext(), mockChatRequest("gpt-4o", "search please"))
+	if err != nil {
+		t.Fatalf("ChatCompletionRequest() error = %+v", err.Error)
+	}
+	if toolCallMessage(response) == nil || len(response.ExtraFields.ToolInvocations) != 0 {
+		t.Errorf("response with an unregistered tool = %+v, want the tool calls", response)
+	}
+
+	// A model that keeps calling tools stops at the iteration limit of the request
+	calls.Store(0)
+	ctx = executeToolsContext()
+	ctx.SetValue(schemas.BifrostContextKeyMaxToolIterations, 2)
+	response, err = bifrost.ChatCompletionRequest(ctx, mockChatRequest("gpt-4o", "weather loop"))
+	if err != nil {
+		t.Fatalf("ChatCompletionRequest() error = %+v", err.Error)
+	}
+	if calls.Load() != 3 || len(response.ExtraFields.ToolInvocations) != 2 || response.ExtraFields.ToolInvocations[1].Iteration != 2 {
+		t.Errorf("upstream calls = %d, invocations = %+v; want 3 calls and 2 iterations", calls.Load(), response.ExtraFields.ToolInvocations)
+	}
+	if toolCallMessage(response) == nil || len(response.ExtraFields.Warnings) != 1 {
+		t.Errorf("response at the limit = %+v, want the pending tool calls and a warning", response)
+	}
+}
+
+func TestToolExecution_ReportsToolErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := newToolExecutionTestServer(t, &calls)
+	bifrost := initToolExecutionTestBifrost(t, server.URL, &schemas.ToolExecutionConfig{
+		Functions: map[string]schemas.ToolFunction{
+			"weather": func(ctx *schemas.BifrostContext, arguments string) (string, error) {
+				return "", fmt.Errorf("service down")
+			},
+		},
+	})
+
+	response, err := bifrost.ChatCompletionRequest(executeToolsContext(), mockChatRequest("gpt-4o", "weather please"))
+	if err != nil {
+		t.Fatalf("ChatCompletionRequest() error = %+v", err.Error)
+	}
+	if got := *response.Choices[0].Message.Content.ContentStr; got != "answer: Error executing tool weather: service down" {
+		t.Errorf("final answer = %q, want the error sent to the model", got)
+	}
+	if invocations := response.ExtraFields.ToolInvocations; len(invocations) != 1 || invocations[0].Error != "service down" {
+		t.Errorf("tool invocations = %+v", invocations)
+	}
+}
+
+func TestToolExecutionConfig_Validate(t *testing.T) {
+	for _, config := range []*schemas.ToolExecutionConfig{
+		{MaxIterations: -1},
+		{Webhooks: map[string]schemas.ToolWebhook{"weather": {}}},
+		{
+			Functions: map[string]schemas.ToolFunction{"weather": weatherFunction},
+			Webhooks:  map[string]schemas.ToolWebhook{"weather": {URL: "http://localhost"}},
+		},
+	} {
+		if err := config.Validate(); err == nil {
+			t.Errorf("Validate(%+v) succeeded, want an error", config)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Package main scaffolds a new OpenAI-compatible provider package from a manifest.
+//
+// Usage (from the core directory):
+//
+//	go run ./tools/newprovider -manifest provider.json
+//
+// The generator writes providers/<name>/<name>.go, containing the provider struct, its constructor,
+// OpenAI-compatible implementations of the manifest's capabilities, and providers/<name>/<name>_test.go,
+// an llmtests test skeleton. See testdata/moonshot.json for an example manifest.
+//
+// The provider still has to be registered by hand; the generator prints the remaining steps.
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// GeneratedFile is a rendered file, relative to the providers directory.
+type GeneratedFile struct {
+	Path    string
+	Content []byte
+}
+
+// UsesOpenAI reports whether the generated provider delegates to the openai package.
+func (m *Manifest) UsesOpenAI() bool {
+	return len(m.Capabilities) > 0
+}
+
+// Generate renders the provider package for the manifest.
+func Generate(manifest *Manifest) ([]GeneratedFile, error) {
+	files := []struct {
+		template string
+		path     string
+	}{
+		{"provider.go.tmpl", filepath.Join(manifest.Name, manifest.Name+".go")},
+		{"provider_test.go.tmpl", filepath.Join(manifest.Name, manifest.Name+"_test.go")},
+	}
+	generated := make([]GeneratedFile, 0, len(files))
+	for _, file := range files {
+		var buf bytes.Buffer
+		if err := templates.ExecuteTemplate(&buf, file.template, manifest); err != nil {
+			return nil, fmt.Errorf("failed to render %s: %w", file.path, err)
+		}
+		content, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("failed to format %s: %w", file.path, err)
+		}
+		generated = append(generated, GeneratedFile{Path: file.path, Content: content})
+	}
+	return generated, nil
+}
+
+// writeFiles writes the generated files below dir, refusing to overwrite existing files unless force is set.
+func writeFiles(dir string, files []GeneratedFile, force bool) error {
+	for _, file := range files {
+		path := filepath.Join(dir, file.Path)
+		if _, err := os.Stat(path); err == nil && !force {
+			return fmt.Errorf("%s already exists, use -force to overwrite it", path)
+		} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	for _, file := range files {
+		path := filepath.Join(dir, file.Path)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(path, file.Content, 0o644); err != nil {
+			return err
+		}
+		fmt.Printf("wrote %s\n", path)
+	}
+	return nil
+}
+
+// printNextSteps lists the registrations the generator does not perform.
+func printNextSteps(manifest *Manifest) {
+	fmt.Printf(`
+Next steps:
+  1. Add the %[1]s ModelProvider constant ("%[2]s") to schemas/bifrost.go and to StandardProviders.
+  2. Register %[2]s.New%[1]sProvider in createBaseProvider in bifrost.go.
+  3. Add %[1]s keys (env.%[3]s_API_KEY) and a provider config (%[3]s_BASE_URL, default %[4]s) to internal/llmtests/account.go.
+  4. Add default models to framework/modelcatalog/default_models.go and the provider to the UI constants.
+  5. Document the provider under docs/providers and run: go test ./providers/%[2]s/...
+`, manifest.DisplayName, manifest.Name, manifest.EnvPrefix, manifest.BaseURL)
+}
+
+func main() {
+	manifestPath := flag.String("manifest", "", "path to the provider manifest (JSON)")
+	dir := flag.String("dir", "providers", "directory the provider package is created in")
+	force := flag.Bool("force", false, "overwrite existing files")
+	flag.Parse()
+
+	if *manifestPath == "" {
+		fmt.Fprintln(os.Stderr, "usage: go run ./tools/newprovider -manifest <path> [-dir providers] [-force]")
+		os.Exit(2)
+	}
+	manifest, err := LoadManifest(*manifestPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	files, err := Generate(manifest)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	if err := writeFiles(*dir, files, *force); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	printNextSteps(manifest)
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// generatedMethods parses a generated provider file and returns the names of its methods and its imports.
+func generatedMethods(t *testing.T, content []byte) (map[string]bool, map[string]bool) {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "provider.go", content, 0)
+	if err != nil {
+		t.Fatalf("generated provider does not parse: %v", err)
+	}
+	methods := map[string]bool{}
+	for _, decl := range file.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv != nil {
+			methods[fn.Name.Name] = true
+		}
+	}
+	imports := map[string]bool{}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		imports[path] = true
+	}
+	return methods, imports
+}
+
+// capabilityInterfaces maps each manifest capability to the schemas interface it implements.
+var capabilityInterfaces = map[string]reflect.Type{
+	CapabilityListModels:     reflect.TypeOf((*schemas.ListModelsProvider)(nil)).Elem(),
+	CapabilityTextCompletion: reflect.TypeOf((*schemas.TextCompletionProvider)(nil)).Elem(),
+	CapabilityChatCompletion: reflect.TypeOf((*schemas.ChatProvider)(nil)).Elem(),
+	CapabilityResponses:      reflect.TypeOf((*schemas.ResponsesProvider)(nil)).Elem(),
+	CapabilityEmbedding:      reflect.TypeOf((*schemas.EmbeddingProvider)(nil)).Elem(),
+}
+
+func TestGenerate(t *testing.T) {
+	manifest, err := LoadManifest(filepath.Join("testdata", "moonshot.json"))
+	if err != nil {
+		t.Fatalf("LoadManifest() error = %v", err)
+	}
+	files, err := Generate(manifest)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(files) != 2 || files[0].Path != filepath.Join("moonshot", "moonshot.go") || files[1].Path != filepath.Join("moonshot", "moonshot_test.go") {
+		t.Fatalf("Generate() files = %v", files)
+	}
+
+	methods, _ := generatedMethods(t, files[0].Content)
+	for _, capability := range manifest.Capabilities {
+		capabilityType := capabilityInterfaces[capability]
+		for i := 0; i < capabilityType.NumMethod(); i++ {
+			if name := capabilityType.Method(i).Name; !methods[name] {
+				t.Errorf("generated provider is missing %s method %s", capabilityType.Name(), name)
+			}
+		}
+	}
+	if methods["Embedding"] {
+		t.Error("generated provider implements a capability missing from the manifest")
+	}
+
+	provider := string(files[0].Content)
+	for _, want := range []string{
+		`config.NetworkConfig.BaseURL = "https://api.moonshot.ai"`,
+		`GetPathFromContext(ctx, "/v1/chat/completions")`,
+	} {
+		if !strings.Contains(provider, want) {
+			t.Errorf("generated provider does not contain %q", want)
+		}
+	}
+	test := string(files[1].Content)
+	for _, want := range []string{`os.Getenv("MOONSHOT_API_KEY")`, `envOrDefault("MOONSHOT_CHAT_MODEL", "kimi-k2.5")`, "TextCompletionStream:"} {
+		if !strings.Contains(test, want) {
+			t.Errorf("generated test does not contain %q", want)
+		}
+	}
+	if strings.Contains(test, "Embedding") {
+		t.Error("generated test enables scenarios for unsupported capabilities")
+	}
+}
+
+func TestGenerate_NoCapabilities(t *testing.T) {
+	manifest := &Manifest{Name: "acme", DisplayName: "Acme", BaseURL: "https://api.acme.example/"}
+	if err := manifest.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	files, err := Generate(manifest)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	methods, imports := generatedMethods(t, files[0].Content)
+	if imports["github.com/capsohq/bifrost/core/providers/openai"] {
+		t.Error("provider without capabilities should not import the openai package")
+	}
+	if len(methods) != 2 || !methods["GetProviderKey"] || !methods["CheckConnectionHealth"] {
+		t.Errorf("provider without capabilities should only implement schemas.Provider, got %v", methods)
+	}
+	if !strings.Contains(string(files[0].Content), `"https://api.acme.example"`) {
+		t.Error("trailing slash should be trimmed from the base URL")
+	}
+}
+
+func TestManifestValidate(t *testing.T) {
+	valid := func() Manifest {
+		return Manifest{Name: "acme", DisplayName: "Acme", BaseURL: "https://api.acme.example", Capabilities: []string{CapabilityChatCompletion}, ChatModel: "acme-1"}
+	}
+	tests := []struct {
+		name   string
+		modify func(*Manifest)
+	}{
+		{"uppercase name", func(m *Manifest) { m.Name = "Acme" }},
+		{"keyword name", func(m *Manifest) { m.Name = "func" }},
+		{"unexported display name", func(m *Manifest) { m.DisplayName = "acme" }},
+		{"missing base url", func(m *Manifest) { m.BaseURL = "" }},
+		{"relative path prefix", func(m *Manifest) { m.PathPrefix = "v1" }},
+		{"unknown capability", func(m *Manifest) { m.Capabilities = append(m.Capabilities, "telepathy") }},
+		{"responses without chat", func(m *Manifest) { m.Capabilities = []string{CapabilityResponses} }},
+		{"chat without model", func(m *Manifest) { m.ChatModel = "" }},
+	}
+	manifest := valid()
+	if err := manifest.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if manifest.EnvPrefix != "ACME" {
+		t.Errorf("EnvPrefix = %q, want ACME", manifest.EnvPrefix)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := valid()
+			tt.modify(&manifest)
+			if err := manifest.Validate(); err == nil {
+				t.Error("Validate() expected error")
+			}
+		})
+	}
+}
+
+func TestWriteFiles(t *testing.T) {
+	dir := t.TempDir()
+	files := []GeneratedFile{{Path: filepath.Join("acme", "acme.go"), Content: []byte("package acme\n")}}
+	if err := writeFiles(dir, files, false); err != nil {
+		t.Fatalf("writeFiles() error = %v", err)
+	}
+	if err := writeFiles(dir, files, false); err == nil {
+		t.Error("writeFiles() should refuse to overwrite existing files")
+	}
+	if err := writeFiles(dir, files, true); err != nil {
+		t.Errorf("writeFiles() with force error = %v", err)
+	}
+	if content, err := os.ReadFile(filepath.Join(dir, "acme", "acme.go")); err != nil || string(content) != "package acme\n" {
+		t.Errorf("written file = %q, %v", content, err)
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Capabilities a generated provider can implement. Each capability implements one of the
+// schemas capability interfaces by delegating to the shared OpenAI-compatible handlers; Bifrost
+// rejects request types of every other capability as unsupported.
+const (
+	CapabilityListModels     = "list_models"     // schemas.ListModelsProvider via {path_prefix}/models
+	CapabilityTextCompletion = "text_completion" // schemas.TextCompletionProvider via {path_prefix}/completions
+	CapabilityChatCompletion = "chat_completion" // schemas.ChatProvider via {path_prefix}/chat/completions
+	CapabilityResponses      = "responses"       // schemas.ResponsesProvider on top of chat completion
+	CapabilityEmbedding      = "embedding"       // schemas.EmbeddingProvider via {path_prefix}/embeddings
+)
+
+var supportedCapabilities = []string{
+	CapabilityListModels,
+	CapabilityTextCompletion,
+	CapabilityChatCompletion,
+	CapabilityResponses,
+	CapabilityEmbedding,
+}
+
+var providerNamePattern = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+
+// Manifest describes the provider to scaffold.
+type Manifest struct {
+	Name           string   `json:"name"`                      // Provider key and package name, e.g. "moonshot"
+	DisplayName    string   `json:"display_name"`              // Prefix of Go identifiers and name used in comments, e.g. "Moonshot"
+	BaseURL        string   `json:"base_url"`                  // Default base URL, e.g. "https://api.moonshot.ai"
+	PathPrefix     string   `json:"path_prefix,omitempty"`     // Prefix of endpoint paths, e.g. "/v1"
+	EnvPrefix      string   `json:"env_prefix,omitempty"`      // Prefix of test environment variables, defaults to the upper-cased name
+	Capabilities   []string `json:"capabilities"`              // Implemented capabilities, see the Capability* constants
+	ChatModel      string   `json:"chat_model,omitempty"`      // Default model for chat tests
+	TextModel      string   `json:"text_model,omitempty"`      // Default model for text completion tests
+	EmbeddingModel string   `json:"embedding_model,omitempty"` // Default model for embedding tests
+}
+
+// LoadManifest reads and validates a manifest file.
+func LoadManifest(path string) (*Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// Validate checks the manifest and fills in defaults.
+func (m *Manifest) Validate() error {
+	if !providerNamePattern.MatchString(m.Name) {
+		return fmt.Errorf("name %q must be lowercase letters and digits, starting with a letter", m.Name)
+	}
+	if token.IsKeyword(m.Name) {
+		return fmt.Errorf("name %q is a Go keyword", m.Name)
+	}
+	if !token.IsIdentifier(m.DisplayName) || !token.IsExported(m.DisplayName) {
+		return fmt.Errorf("display_name %q must be an exported Go identifier", m.DisplayName)
+	}
+	if !strings.HasPrefix(m.BaseURL, "https://") && !strings.HasPrefix(m.BaseURL, "http://") {
+		return fmt.Errorf("base_url %q must be an http(s) URL", m.BaseURL)
+	}
+	m.BaseURL = strings.TrimRight(m.BaseURL, "/")
+	if m.PathPrefix != "" && !strings.HasPrefix(m.PathPrefix, "/") {
+		return fmt.Errorf("path_prefix %q must start with /", m.PathPrefix)
+	}
+	m.PathPrefix = strings.TrimRight(m.PathPrefix, "/")
+	if m.EnvPrefix == "" {
+		m.EnvPrefix = strings.ToUpper(m.Name)
+	}
+
+	for _, capability := range m.Capabilities {
+		if !slices.Contains(supportedCapabilities, capability) {
+			return fmt.Errorf("unknown capability %q, supported capabilities are %s", capability, strings.Join(supportedCapabilities, ", "))
+		}
+	}
+	if m.Has(CapabilityResponses) && !m.Has(CapabilityChatCompletion) {
+		return fmt.Errorf("capability %q requires %q", CapabilityResponses, CapabilityChatCompletion)
+	}
+	if m.Has(CapabilityChatCompletion) && m.ChatModel == "" {
+		return fmt.Errorf("chat_model is required for capability %q", CapabilityChatCompletion)
+	}
+	if m.Has(CapabilityTextCompletion) && m.TextModel == "" {
+		return fmt.Errorf("text_model is required for capability %q", CapabilityTextCompletion)
+	}
+	if m.Has(CapabilityEmbedding) && m.EmbeddingModel == "" {
+		return fmt.Errorf("embedding_model is required for capability %q", CapabilityEmbedding)
+	}
+	return nil
+}
+
+// Has reports whether the manifest declares the capability.
+func (m *Manifest) Has(capability string) bool {
+	return slices.Contains(m.Capabilities, capability)
+}
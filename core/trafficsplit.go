@@ -0,0 +1,81 @@
+package bifrost
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// trafficSplitIndex maps logical model names to their traffic splits.
+type trafficSplitIndex map[string]schemas.TrafficSplit
+
+// UpdateTrafficSplits replaces the traffic splits at runtime. Names must be unique.
+func (bifrost *Bifrost) UpdateTrafficSplits(splits []schemas.TrafficSplit) error {
+	index := make(trafficSplitIndex, len(splits))
+	for _, split := range splits {
+		if err := split.Validate(); err != nil {
+			return fmt.Errorf("invalid traffic split: %w", err)
+		}
+		if _, exists := index[split.Name]; exists {
+			return fmt.Errorf("invalid traffic split: %s is defined more than once", split.Name)
+		}
+		index[split.Name] = split
+	}
+	bifrost.trafficSplits.Store(&index)
+	return nil
+}
+
+// GetTrafficSplit returns the traffic split of a logical model.
+func (bifrost *Bifrost) GetTrafficSplit(name string) (schemas.TrafficSplit, bool) {
+	index := bifrost.trafficSplits.Load()
+	if index == nil {
+		return schemas.TrafficSplit{}, false
+	}
+	split, ok := (*index)[name]
+	return split, ok
+}
+
+// ListTrafficSplits returns the traffic splits sorted by name.
+func (bifrost *Bifrost) ListTrafficSplits() []schemas.TrafficSplit {
+	index := bifrost.trafficSplits.Load()
+	if index == nil {
+		return nil
+	}
+	splits := make([]schemas.TrafficSplit, 0, len(*index))
+	for _, split := range *index {
+		splits = append(splits, split)
+	}
+	sort.Slice(splits, func(i, j int) bool { return splits[i].Name < splits[j].Name })
+	return splits
+}
+
+// SplitTraffic assigns a request for a logical model to a variant of its traffic split, bucketing
+// it by key (at random when key is empty). It returns the variant and the targets to try, the
+// assigned one first, or false if name has no traffic split.
+func (bifrost *Bifrost) SplitTraffic(name, key string) (*schemas.TrafficSplitResult, []schemas.Fallback, bool) {
+	split, ok := bifrost.GetTrafficSplit(name)
+	if !ok {
+		return nil, nil, false
+	}
+	bucket := rand.Float64() * 100
+	if key != "" {
+		bucket = schemas.TrafficSplitBucket(name, key)
+	}
+	result := &schemas.TrafficSplitResult{Name: name, Variant: split.Variant(bucket)}
+	if result.Variant == schemas.TrafficSplitVariantCandidate {
+		return result, []schemas.Fallback{split.Candidate, split.Primary}, true
+	}
+	return result, []schemas.Fallback{split.Primary}, true
+}
+
+// tagTrafficSplit records the traffic split variant of the request, if any, on a response.
+func tagTrafficSplit(ctx *schemas.BifrostContext, result *schemas.BifrostResponse) {
+	if ctx == nil || result == nil {
+		return
+	}
+	if split, ok := ctx.Value(schemas.BifrostContextKeyTrafficSplit).(*schemas.TrafficSplitResult); ok && split != nil {
+		result.GetExtraFields().TrafficSplit = split
+	}
+}
@@ -0,0 +1,61 @@
+package bifrost
+
+import (
+	"fmt"
+	"testing"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestSplitTraffic(t *testing.T) {
+	bifrost := &Bifrost{account: NewMockAccount(), logger: NewDefaultLogger(schemas.LogLevelError)}
+	primary := schemas.Fallback{Provider: schemas.OpenAI, Model: "gpt-4o"}
+	candidate := schemas.Fallback{Provider: schemas.OpenAI, Model: "gpt-4o-mini"}
+	if err := bifrost.UpdateTrafficSplits([]schemas.TrafficSplit{{Name: "chat", Primary: primary, Candidate: candidate, CandidatePercent: 20}}); err != nil {
+		t.Fatalf("UpdateTrafficSplits() error = %v", err)
+	}
+
+	// The same key always gets the same variant, and roughly the configured share goes to the candidate
+	candidates := 0
+	for i := range 2000 {
+		key := fmt.Sprintf("session-%d", i)
+		first, targets, ok := bifrost.SplitTraffic("chat", key)
+		if !ok {
+			t.Fatal("expected chat to have a traffic split")
+		}
+		if again, _, _ := bifrost.SplitTraffic("chat", key); again.Variant != first.Variant {
+			t.Fatalf("key %s was assigned %s and then %s", key, first.Variant, again.Variant)
+		}
+		if first.Variant == schemas.TrafficSplitVariantCandidate {
+			candidates++
+			if len(targets) != 2 || targets[0] != candidate || targets[1] != primary {
+				t.Fatalf("candidate targets = %+v", targets)
+			}
+		} else if len(targets) != 1 || targets[0] != primary {
+			t.Fatalf("primary targets = %+v", targets)
+		}
+	}
+	if candidates < 300 || candidates > 500 {
+		t.Errorf("%d of 2000 keys went to the candidate, want about 400", candidates)
+	}
+
+	// The variant is recorded in the context and tagged on the response
+	ctx := schemas.NewBifrostContext(nil, schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKeyTrafficSplitKey, "session-1")
+	req := &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest, ChatRequest: &schemas.BifrostChatRequest{Model: "chat"}}
+	bifrost.routeModelAlias(ctx, req)
+	split, _, _ := bifrost.SplitTraffic("chat", "session-1")
+	if provider, model, _ := req.GetRequestFields(); split.Variant == schemas.TrafficSplitVariantPrimary && (provider != primary.Provider || model != primary.Model) ||
+		split.Variant == schemas.TrafficSplitVariantCandidate && (provider != candidate.Provider || model != candidate.Model) {
+		t.Errorf("request for the %s variant was routed to %s/%s", split.Variant, provider, model)
+	}
+	response := &schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{}}
+	tagTrafficSplit(ctx, response)
+	if tag := response.ChatResponse.ExtraFields.TrafficSplit; tag == nil || *tag != *split {
+		t.Errorf("response tagged with %+v, want %+v", tag, split)
+	}
+
+	if bifrost.UpdateTrafficSplits([]schemas.TrafficSplit{{Name: "chat", Primary: primary, Candidate: candidate, CandidatePercent: 120}}) == nil {
+		t.Error("expected a candidate percentage above 100 to be invalid")
+	}
+}
@@ -72,7 +72,7 @@ var dynamicallyConfigurableProviders = []schemas.ModelProvider{
 
 // isModelRequired returns true if the request type requires a model
 func isModelRequired(reqType schemas.RequestType) bool {
-	return reqType == schemas.TextCompletionRequest || reqType == schemas.TextCompletionStreamRequest || reqType == schemas.ChatCompletionRequest || reqType == schemas.ChatCompletionStreamRequest || reqType == schemas.ResponsesRequest || reqType == schemas.ResponsesStreamRequest || reqType == schemas.SpeechRequest || reqType == schemas.SpeechStreamRequest || reqType == schemas.TranscriptionRequest || reqType == schemas.TranscriptionStreamRequest || reqType == schemas.EmbeddingRequest || reqType == schemas.ImageGenerationRequest || reqType == schemas.ImageGenerationStreamRequest || reqType == schemas.VideoGenerationRequest
+	return reqType == schemas.TextCompletionRequest || reqType == schemas.TextCompletionStreamRequest || reqType == schemas.ChatCompletionRequest || reqType == schemas.ChatCompletionStreamRequest || reqType == schemas.ResponsesRequest || reqType == schemas.ResponsesStreamRequest || reqType == schemas.SpeechRequest || reqType == schemas.SpeechStreamRequest || reqType == schemas.TranscriptionRequest || reqType == schemas.TranscriptionStreamRequest || reqType == schemas.TranslationRequest || reqType == schemas.EmbeddingRequest || reqType == schemas.ModerationRequest || reqType == schemas.ImageGenerationRequest || reqType == schemas.ImageGenerationStreamRequest || reqType == schemas.MusicGenerationRequest || reqType == schemas.ContextCacheCreateRequest || reqType == schemas.VideoGenerationRequest
 }
 
 // Ptr returns a pointer to the given value.
@@ -93,7 +93,7 @@ func providerRequiresKey(providerKey schemas.ModelProvider, customConfig *schema
 // canProviderKeyValueBeEmpty returns true if the given provider allows the API key to be empty.
 // Some providers like Vertex and Bedrock have their credentials in additional key configs..
 func CanProviderKeyValueBeEmpty(providerKey schemas.ModelProvider) bool {
-	return providerKey == schemas.Vertex || providerKey == schemas.Bedrock || providerKey == schemas.VLLM || providerKey == schemas.Azure
+	return providerKey == schemas.Vertex || providerKey == schemas.Bedrock || providerKey == schemas.VLLM || providerKey == schemas.Azure || providerKey == schemas.Hunyuan
 }
 
 func isKeySkippingAllowed(providerKey schemas.ModelProvider) bool {
@@ -257,6 +257,28 @@ func isContainerRequestType(reqType schemas.RequestType) bool {
 		reqType == schemas.ContainerFileDeleteRequest
 }
 
+// isFineTuningRequestType returns true if the given request type is a fine-tuning job API operation.
+func isFineTuningRequestType(reqType schemas.RequestType) bool {
+	switch reqType {
+	case schemas.FineTuningJobCreateRequest, schemas.FineTuningJobListRequest, schemas.FineTuningJobRetrieveRequest,
+		schemas.FineTuningJobCancelRequest, schemas.FineTuningJobEventsRequest, schemas.FineTuningJobCheckpointsRequest:
+		return true
+	default:
+		return false
+	}
+}
+
+// isVectorStoreRequestType returns true if the given request type is a vector store API operation.
+func isVectorStoreRequestType(reqType schemas.RequestType) bool {
+	switch reqType {
+	case schemas.VectorStoreCreateRequest, schemas.VectorStoreListRequest, schemas.VectorStoreSearchRequest,
+		schemas.VectorStoreFileCreateRequest, schemas.VectorStoreFileListRequest:
+		return true
+	default:
+		return false
+	}
+}
+
 // isModellessVideoRequestType returns true if the given request type is a video request that does not require a model.
 func isModellessVideoRequestType(reqType schemas.RequestType) bool {
 	switch reqType {
@@ -0,0 +1,70 @@
+package bifrost
+
+import (
+	"fmt"
+	"sort"
+
+	schemas "github.com/capsohq/bifrost/core/schemas"
+)
+
+// virtualModelIndex maps virtual model names to their definitions.
+type virtualModelIndex map[string]schemas.VirtualModel
+
+// UpdateVirtualModels replaces the virtual models at runtime. Names must be unique.
+func (bifrost *Bifrost) UpdateVirtualModels(models []schemas.VirtualModel) error {
+	index := make(virtualModelIndex, len(models))
+	for _, model := range models {
+		if err := model.Validate(); err != nil {
+			return fmt.Errorf("invalid virtual model: %w", err)
+		}
+		if _, exists := index[model.Name]; exists {
+			return fmt.Errorf("invalid virtual model: %s is defined more than once", model.Name)
+		}
+		index[model.Name] = model
+	}
+	bifrost.virtualModels.Store(&index)
+	return nil
+}
+
+// GetVirtualModel returns the virtual model registered under name.
+func (bifrost *Bifrost) GetVirtualModel(name string) (schemas.VirtualModel, bool) {
+	index := bifrost.virtualModels.Load()
+	if index == nil {
+		return schemas.VirtualModel{}, false
+	}
+	model, ok := (*index)[name]
+	return model, ok
+}
+
+// ListVirtualModels returns the virtual models sorted by name.
+func (bifrost *Bifrost) ListVirtualModels() []schemas.VirtualModel {
+	index := bifrost.virtualModels.Load()
+	if index == nil {
+		return nil
+	}
+	models := make([]schemas.VirtualModel, 0, len(*index))
+	for _, model := range *index {
+		models = append(models, model)
+	}
+	sort.Slice(models, func(i, j int) bool { return models[i].Name < models[j].Name })
+	return models
+}
+
+// routeVirtualModel returns the targets of a virtual model in priority order, with the targets
+// that cannot serve the request type or whose circuit is open moved to the end.
+func (bifrost *Bifrost) routeVirtualModel(name string, requestType schemas.RequestType) ([]schemas.Fallback, bool) {
+	model, ok := bifrost.GetVirtualModel(name)
+	if !ok {
+		return nil, false
+	}
+	routed := make([]schemas.Fallback, 0, len(model.Targets))
+	var unavailable []schemas.Fallback
+	for _, target := range model.Targets {
+		if !bifrost.SupportsOperation(target.Provider, requestType) || bifrost.circuitOpen(target.Provider, target.Model) {
+			unavailable = append(unavailable, target)
+			continue
+		}
+		routed = append(routed, target)
+	}
+	return append(routed, unavailable...), true
+}
@@ -0,0 +1,56 @@
+package clustersync
+
+import (
+	"fmt"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// Backends config changes can be published over.
+const (
+	BackendRedis    = "redis"
+	BackendPostgres = "postgres"
+)
+
+// DefaultChannel is the Redis channel or Postgres notification channel events are published on.
+const DefaultChannel = "bifrost_config_sync"
+
+// Config holds the configuration of the config synchronization between Bifrost replicas.
+type Config struct {
+	Enabled bool         `json:"enabled"`
+	Backend string       `json:"backend"`           // redis or postgres. postgres publishes over the config store database.
+	Channel string       `json:"channel,omitempty"` // Channel events are published on. Default is "bifrost_config_sync".
+	Redis   *RedisConfig `json:"redis,omitempty"`   // Required for the redis backend
+}
+
+// RedisConfig holds the connection settings of the redis backend.
+type RedisConfig struct {
+	Addr     *schemas.EnvVar `json:"addr"`               // Redis server address (host:port)
+	Username *schemas.EnvVar `json:"username,omitempty"` // Username for Redis AUTH (optional)
+	Password *schemas.EnvVar `json:"password,omitempty"` // Password for Redis AUTH (optional)
+	DB       int             `json:"db,omitempty"`       // Redis database number. Default is 0.
+}
+
+// Validate checks the config names a known backend with its connection settings.
+func (c *Config) Validate() error {
+	switch c.Backend {
+	case BackendRedis:
+		if c.Redis == nil || c.Redis.Addr == nil || c.Redis.Addr.GetValue() == "" {
+			return fmt.Errorf("cluster sync redis addr is required")
+		}
+		if c.Redis.DB < 0 {
+			return fmt.Errorf("cluster sync redis db cannot be negative")
+		}
+	case BackendPostgres:
+	default:
+		return fmt.Errorf("unsupported cluster sync backend %q, expected redis or postgres", c.Backend)
+	}
+	return nil
+}
+
+func (c *Config) channel() string {
+	if c.Channel == "" {
+		return DefaultChannel
+	}
+	return c.Channel
+}
@@ -0,0 +1,72 @@
+package clustersync
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/gorm"
+)
+
+// postgresTransport publishes events with Postgres NOTIFY and receives them on a connection of
+// the config store pool that LISTENs on the channel.
+type postgresTransport struct {
+	db      *sql.DB
+	channel string
+}
+
+func newPostgresTransport(db *gorm.DB, channel string) (*postgresTransport, error) {
+	if db == nil || db.Dialector.Name() != "postgres" {
+		return nil, fmt.Errorf("cluster sync postgres backend requires a postgres config store")
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	return &postgresTransport{db: sqlDB, channel: channel}, nil
+}
+
+func (t *postgresTransport) publish(ctx context.Context, payload []byte) error {
+	_, err := t.db.ExecContext(ctx, "SELECT pg_notify($1, $2)", t.channel, string(payload))
+	return err
+}
+
+func (t *postgresTransport) listen(ctx context.Context, deliver func(payload []byte)) error {
+	conn, err := t.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	var listenErr error
+	// The connection keeps listening after LISTEN, so it is always discarded instead of being
+	// returned to the pool by returning driver.ErrBadConn
+	_ = conn.Raw(func(driverConn any) error {
+		stdlibConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			listenErr = fmt.Errorf("unexpected postgres driver connection %T", driverConn)
+			return driver.ErrBadConn
+		}
+		pgConn := stdlibConn.Conn()
+		if _, err := pgConn.Exec(ctx, "LISTEN "+pgx.Identifier{t.channel}.Sanitize()); err != nil {
+			listenErr = err
+			return driver.ErrBadConn
+		}
+		for {
+			notification, err := pgConn.WaitForNotification(ctx)
+			if err != nil {
+				listenErr = err
+				return driver.ErrBadConn
+			}
+			deliver([]byte(notification.Payload))
+		}
+	})
+	return listenErr
+}
+
+// close is a no-op, the database belongs to the config store.
+func (t *postgresTransport) close() error {
+	return nil
+}
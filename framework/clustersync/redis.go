@@ -0,0 +1,77 @@
+package clustersync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisTransport publishes events over Redis pub/sub.
+type redisTransport struct {
+	client  *redis.Client
+	channel string
+}
+
+// NewClient creates a client for the Redis server of c.
+func (c *RedisConfig) NewClient() *redis.Client {
+	var username, password string
+	if c.Username != nil {
+		username = c.Username.GetValue()
+	}
+	if c.Password != nil {
+		password = c.Password.GetValue()
+	}
+	return redis.NewClient(&redis.Options{
+		Addr:     c.Addr.GetValue(),
+		Username: username,
+		Password: password,
+		DB:       c.DB,
+	})
+}
+
+// newRedisTransport connects to the Redis server of config and checks it is reachable.
+func newRedisTransport(ctx context.Context, config *RedisConfig, channel string) (*redisTransport, error) {
+	t := &redisTransport{
+		client:  config.NewClient(),
+		channel: channel,
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := t.client.Ping(pingCtx).Err(); err != nil {
+		t.client.Close()
+		return nil, fmt.Errorf("failed to connect to cluster sync redis at %s: %w", config.Addr.GetValue(), err)
+	}
+	return t, nil
+}
+
+func (t *redisTransport) publish(ctx context.Context, payload []byte) error {
+	return t.client.Publish(ctx, t.channel, payload).Err()
+}
+
+func (t *redisTransport) listen(ctx context.Context, deliver func(payload []byte)) error {
+	pubsub := t.client.Subscribe(ctx, t.channel)
+	defer pubsub.Close()
+	// Wait for the subscription to be confirmed so failures are reported
+	if _, err := pubsub.Receive(ctx); err != nil {
+		return err
+	}
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case message, ok := <-messages:
+			if !ok {
+				return errors.New("redis subscription closed")
+			}
+			deliver([]byte(message.Payload))
+		}
+	}
+}
+
+func (t *redisTransport) close() error {
+	return t.client.Close()
+}
@@ -0,0 +1,217 @@
+// Package clustersync propagates config changes made through the admin API of one Bifrost replica
+// to the other replicas sharing its config store. Replicas publish a small event naming what
+// changed over Redis pub/sub or Postgres LISTEN/NOTIFY, and receivers reload it from the config
+// store, so an event never carries config values or secrets.
+package clustersync
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Kind is the kind of config an event is about.
+type Kind string
+
+const (
+	KindProvider     Kind = "provider"      // ID is the provider name
+	KindVirtualKey   Kind = "virtual_key"   // ID is the virtual key ID
+	KindTeam         Kind = "team"          // ID is the team ID
+	KindCustomer     Kind = "customer"      // ID is the customer ID
+	KindModelConfig  Kind = "model_config"  // ID is the model config ID
+	KindRoutingRule  Kind = "routing_rule"  // ID is the routing rule ID
+	KindMCPClient    Kind = "mcp_client"    // ID is the MCP client ID
+	KindPlugin       Kind = "plugin"        // ID is the plugin name
+	KindClientConfig Kind = "client_config" // the client config, without ID
+	KindAuthConfig   Kind = "auth_config"   // the admin auth config, without ID
+	KindProxyConfig  Kind = "proxy_config"  // the global proxy config, without ID
+	KindPricing      Kind = "pricing"       // the pricing sync settings, without ID
+	KindModelCatalog Kind = "model_catalog" // the synced pricing data and provider model snapshots, without ID
+)
+
+// Op is the change an event reports.
+type Op string
+
+const (
+	OpReload Op = "reload" // the config was created or updated
+	OpRemove Op = "remove" // the config was deleted
+)
+
+// Event reports a config change of a replica.
+type Event struct {
+	Kind   Kind      `json:"kind"`
+	Op     Op        `json:"op"`
+	ID     string    `json:"id,omitempty"`
+	NodeID string    `json:"node_id"` // replica the change was made on
+	SentAt time.Time `json:"sent_at"`
+}
+
+// Handler applies an event of another replica. Events are handled one at a time, in the order
+// they were received.
+type Handler func(ctx context.Context, event Event)
+
+// transport publishes and receives the encoded events of a backend.
+type transport interface {
+	publish(ctx context.Context, payload []byte) error
+	// listen subscribes to the channel and delivers the received payloads until ctx is done or
+	// the subscription fails.
+	listen(ctx context.Context, deliver func(payload []byte)) error
+	close() error
+}
+
+const (
+	publishTimeout    = 5 * time.Second
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// Syncer publishes the config changes of this replica and applies those of the others.
+type Syncer struct {
+	transport transport
+	nodeID    string
+	logger    schemas.Logger
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates a syncer for the backend of config. db is the config store database, which the
+// postgres backend publishes over and which must then be a Postgres database.
+func New(ctx context.Context, config Config, db *gorm.DB, logger schemas.Logger) (*Syncer, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	var t transport
+	var err error
+	switch config.Backend {
+	case BackendRedis:
+		t, err = newRedisTransport(ctx, config.Redis, config.channel())
+	case BackendPostgres:
+		t, err = newPostgresTransport(db, config.channel())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newSyncer(t, logger), nil
+}
+
+func newSyncer(t transport, logger schemas.Logger) *Syncer {
+	return &Syncer{
+		transport: t,
+		nodeID:    uuid.NewString(),
+		logger:    logger,
+	}
+}
+
+// NodeID returns the ID this replica publishes its events with.
+func (s *Syncer) NodeID() string {
+	return s.nodeID
+}
+
+// Publish sends a config change of this replica to the others.
+func (s *Syncer) Publish(ctx context.Context, kind Kind, op Op, id string) error {
+	payload, err := sonic.Marshal(Event{
+		Kind:   kind,
+		Op:     op,
+		ID:     id,
+		NodeID: s.nodeID,
+		SentAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal cluster sync event: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), publishTimeout)
+	defer cancel()
+	if err := s.transport.publish(ctx, payload); err != nil {
+		return fmt.Errorf("failed to publish %s %s event: %w", kind, op, err)
+	}
+	return nil
+}
+
+// Start receives the events of the other replicas in the background and passes them to handler.
+// The subscription is re-established with a backoff when it fails.
+func (s *Syncer) Start(ctx context.Context, handler Handler) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	events := make(chan Event, 256)
+	s.wg.Add(2)
+	go func() {
+		defer s.wg.Done()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-events:
+				handler(WithRemoteEvent(ctx), event)
+			}
+		}
+	}()
+	go func() {
+		defer s.wg.Done()
+		s.receive(ctx, events)
+	}()
+}
+
+// receive keeps a subscription open until ctx is done, queueing the events of other replicas.
+func (s *Syncer) receive(ctx context.Context, events chan<- Event) {
+	delay := minReconnectDelay
+	for {
+		connectedAt := time.Now()
+		err := s.transport.listen(ctx, func(payload []byte) {
+			var event Event
+			if err := sonic.Unmarshal(payload, &event); err != nil {
+				s.logger.Warn("ignoring malformed cluster sync event: %v", err)
+				return
+			}
+			if event.NodeID == s.nodeID {
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+			}
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		// Changes published while the subscription was down are only picked up after a restart
+		s.logger.Warn("cluster sync subscription failed, changes of other replicas are not applied until it is restored: %v", err)
+		if time.Since(connectedAt) > maxReconnectDelay {
+			delay = minReconnectDelay
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay = min(delay*2, maxReconnectDelay)
+	}
+}
+
+// Close stops receiving events and closes the backend connection.
+func (s *Syncer) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+	return s.transport.close()
+}
+
+type remoteEventKey struct{}
+
+// WithRemoteEvent marks ctx as applying an event of another replica, so the change it makes is not
+// published again.
+func WithRemoteEvent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, remoteEventKey{}, true)
+}
+
+// IsRemoteEvent reports whether ctx applies an event of another replica.
+func IsRemoteEvent(ctx context.Context) bool {
+	remote, _ := ctx.Value(remoteEventKey{}).(bool)
+	return remote
+}
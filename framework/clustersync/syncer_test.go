@@ -0,0 +1,178 @@
+package clustersync
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+// memoryBus is an in-process channel every memoryTransport publishes to and listens on.
+type memoryBus struct {
+	mu          sync.Mutex
+	subscribers []chan []byte
+	subscribed  chan struct{}
+}
+
+func newMemoryBus() *memoryBus {
+	return &memoryBus{subscribed: make(chan struct{}, 16)}
+}
+
+type memoryTransport struct {
+	bus *memoryBus
+}
+
+func (t *memoryTransport) publish(ctx context.Context, payload []byte) error {
+	t.bus.mu.Lock()
+	defer t.bus.mu.Unlock()
+	for _, subscriber := range t.bus.subscribers {
+		subscriber <- payload
+	}
+	return nil
+}
+
+func (t *memoryTransport) listen(ctx context.Context, deliver func(payload []byte)) error {
+	messages := make(chan []byte, 16)
+	t.bus.mu.Lock()
+	t.bus.subscribers = append(t.bus.subscribers, messages)
+	t.bus.mu.Unlock()
+	t.bus.subscribed <- struct{}{}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case payload := <-messages:
+			deliver(payload)
+		}
+	}
+}
+
+func (t *memoryTransport) close() error { return nil }
+
+type receivedEvent struct {
+	event  Event
+	remote bool
+}
+
+func startSyncer(t *testing.T, bus *memoryBus) (*Syncer, chan receivedEvent) {
+	t.Helper()
+	syncer := newSyncer(&memoryTransport{bus: bus}, &testLogger{})
+	received := make(chan receivedEvent, 16)
+	syncer.Start(context.Background(), func(ctx context.Context, event Event) {
+		received <- receivedEvent{event: event, remote: IsRemoteEvent(ctx)}
+	})
+	t.Cleanup(func() { syncer.Close() })
+	select {
+	case <-bus.subscribed:
+	case <-time.After(time.Second):
+		t.Fatal("syncer did not subscribe")
+	}
+	return syncer, received
+}
+
+func TestSyncer_DeliversEventsOfOtherReplicas(t *testing.T) {
+	bus := newMemoryBus()
+	first, firstReceived := startSyncer(t, bus)
+	second, secondReceived := startSyncer(t, bus)
+	require.NotEqual(t, first.NodeID(), second.NodeID())
+
+	require.NoError(t, first.Publish(context.Background(), KindProvider, OpReload, "openai"))
+	require.NoError(t, first.Publish(context.Background(), KindVirtualKey, OpRemove, "vk-1"))
+
+	for _, expected := range []Event{
+		{Kind: KindProvider, Op: OpReload, ID: "openai"},
+		{Kind: KindVirtualKey, Op: OpRemove, ID: "vk-1"},
+	} {
+		select {
+		case received := <-secondReceived:
+			assert.Equal(t, expected.Kind, received.event.Kind)
+			assert.Equal(t, expected.Op, received.event.Op)
+			assert.Equal(t, expected.ID, received.event.ID)
+			assert.Equal(t, first.NodeID(), received.event.NodeID)
+			assert.True(t, received.remote, "handler context should be marked as a remote event")
+		case <-time.After(time.Second):
+			t.Fatalf("second replica did not receive %s event", expected.Kind)
+		}
+	}
+
+	// The publishing replica ignores its own events
+	select {
+	case received := <-firstReceived:
+		t.Fatalf("publishing replica received its own event: %+v", received.event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestSyncer_IgnoresMalformedEvents(t *testing.T) {
+	bus := newMemoryBus()
+	_, received := startSyncer(t, bus)
+
+	publisher := &memoryTransport{bus: bus}
+	require.NoError(t, publisher.publish(context.Background(), []byte("not json")))
+	require.NoError(t, publisher.publish(context.Background(), []byte(`{"kind":"team","op":"reload","id":"t-1","node_id":"other"}`)))
+
+	select {
+	case event := <-received:
+		assert.Equal(t, KindTeam, event.event.Kind)
+		assert.Equal(t, "t-1", event.event.ID)
+	case <-time.After(time.Second):
+		t.Fatal("valid event after a malformed one was not delivered")
+	}
+}
+
+func TestIsRemoteEvent(t *testing.T) {
+	assert.False(t, IsRemoteEvent(context.Background()))
+	assert.True(t, IsRemoteEvent(WithRemoteEvent(context.Background())))
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{name: "postgres", config: Config{Backend: BackendPostgres}},
+		{name: "redis", config: Config{Backend: BackendRedis, Redis: &RedisConfig{Addr: schemas.NewEnvVar("localhost:6379")}}},
+		{name: "redis without addr", config: Config{Backend: BackendRedis}, wantErr: true},
+		{name: "redis with negative db", config: Config{Backend: BackendRedis, Redis: &RedisConfig{Addr: schemas.NewEnvVar("localhost:6379"), DB: -1}}, wantErr: true},
+		{name: "unknown backend", config: Config{Backend: "nats"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNew_PostgresBackendRequiresPostgresStore(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+
+	_, err = New(context.Background(), Config{Enabled: true, Backend: BackendPostgres}, db, &testLogger{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires a postgres config store")
+}
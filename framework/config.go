@@ -1,8 +1,24 @@
 package framework
 
-import "github.com/capsohq/bifrost/framework/modelcatalog"
+import (
+	"github.com/capsohq/bifrost/framework/clustersync"
+	"github.com/capsohq/bifrost/framework/keyhealth"
+	"github.com/capsohq/bifrost/framework/leaderelection"
+	"github.com/capsohq/bifrost/framework/modelcatalog"
+	"github.com/capsohq/bifrost/framework/ratelimit"
+	"github.com/capsohq/bifrost/framework/reconciliation"
+	"github.com/capsohq/bifrost/framework/secrets"
+	"github.com/capsohq/bifrost/framework/webhooks"
+)
 
 // FrameworkConfig represents the configuration for the framework.
 type FrameworkConfig struct {
-	Pricing *modelcatalog.Config `json:"pricing,omitempty"`
+	Pricing             *modelcatalog.Config   `json:"pricing,omitempty"`
+	UsageReconciliation *reconciliation.Config `json:"usage_reconciliation,omitempty"`
+	RateLimiter         *ratelimit.Config      `json:"rate_limiter,omitempty"`
+	Webhooks            *webhooks.Config       `json:"webhooks,omitempty"`
+	KeyHealthCheck      *keyhealth.Config      `json:"key_health_check,omitempty"`
+	Secrets             *secrets.Config        `json:"secrets,omitempty"`
+	ClusterSync         *clustersync.Config    `json:"cluster_sync,omitempty"`
+	LeaderElection      *leaderelection.Config `json:"leader_election,omitempty"`
 }
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"sort"
 	"strconv"
+	"time"
 
 	"github.com/bytedance/sonic"
 	bifrost "github.com/capsohq/bifrost/core"
@@ -57,9 +58,23 @@ type ClientConfig struct {
 	MCPToolSyncInterval             int                              `json:"mcp_tool_sync_interval"`               // Global tool sync interval in minutes (default: 10, 0 = disabled)
 	HeaderFilterConfig              *tables.GlobalHeaderFilterConfig `json:"header_filter_config,omitempty"`       // Global header filtering configuration for x-bf-eh-* headers
 	AsyncJobResultTTL               int                              `json:"async_job_result_ttl"`                 // Default TTL for async job results in seconds (default: 3600 = 1 hour)
+	SSEKeepAliveInterval            int                              `json:"sse_keep_alive_interval"`              // Seconds of stream silence before an SSE keep-alive comment is sent (default: 15, negative = disabled)
 	RequiredHeaders                 []string                         `json:"required_headers,omitempty"`           // Headers that must be present on every request (case-insensitive)
 	LoggingHeaders                  []string                         `json:"logging_headers,omitempty"`            // Headers to capture in log metadata
 	HideDeletedVirtualKeysInFilters bool                             `json:"hide_deleted_virtual_keys_in_filters"` // Hide deleted virtual keys from logs/MCP filter data
+	SSEOutputDialects               map[string]string                `json:"sse_output_dialects,omitempty"`        // Per-route SSE framing for streamed responses (route path -> "openai" | "anthropic")
+	ResponseEnvelopes               map[string]string                `json:"response_envelopes,omitempty"`         // Per-route placement of Bifrost metadata in responses (route path -> "inline" | "strip" | "envelope")
+	LoadShedding                    *schemas.LoadSheddingConfig      `json:"load_shedding,omitempty"`              // Early rejection of low priority requests when provider queues fill up
+	SchemaDrift                     *schemas.SchemaDriftConfig       `json:"schema_drift,omitempty"`               // Checks of provider responses against their expected shapes
+	CircuitBreaker                  *schemas.CircuitBreakerConfig    `json:"circuit_breaker,omitempty"`            // Per provider and model circuit breakers for failing upstreams
+	LatencyRouting                  *schemas.LatencyRoutingConfig    `json:"latency_routing,omitempty"`            // Model aliases routed to their fastest healthy target
+	CostRouting                     *schemas.CostRoutingConfig       `json:"cost_routing,omitempty"`               // Model aliases routed to their cheapest target
+	VirtualModels                   []schemas.VirtualModel           `json:"virtual_models,omitempty"`             // Model names mapped to prioritized provider and model targets
+	TrafficSplits                   []schemas.TrafficSplit           `json:"traffic_splits,omitempty"`             // Logical models sending a percentage of their traffic to a candidate model
+	SingleFlight                    *schemas.SingleFlightConfig      `json:"single_flight,omitempty"`              // Deduplication of identical in-flight requests
+	ToolExecution                   *schemas.ToolExecutionConfig     `json:"tool_execution,omitempty"`             // Tool webhooks executed by bifrost for requests that opt in
+	StreamUsage                     *schemas.StreamUsageConfig       `json:"stream_usage,omitempty"`               // Estimated usage for streams that end without it
+	HeaderPolicy                    *schemas.HeaderPolicyConfig      `json:"header_policy,omitempty"`              // Headers forwarded, stripped and injected on provider requests
 	ConfigHash                      string                           `json:"-"`                                    // Config hash for reconciliation (not serialized)
 }
 
@@ -146,6 +161,10 @@ func (c *ClientConfig) GenerateClientConfigHash() (string, error) {
 		hash.Write([]byte("asyncJobResultTTL:0"))
 	}
 
+	if c.SSEKeepAliveInterval != 0 {
+		hash.Write([]byte("sseKeepAliveInterval:" + strconv.Itoa(c.SSEKeepAliveInterval)))
+	}
+
 	// Hash integer fields
 	data, err := sonic.Marshal(c.InitialPoolSize)
 	if err != nil {
@@ -214,6 +233,142 @@ func (c *ClientConfig) GenerateClientConfigHash() (string, error) {
 		hash.Write(data)
 	}
 
+	// Hash SSEOutputDialects (sorted by route for deterministic hashing)
+	if len(c.SSEOutputDialects) > 0 {
+		routes := make([]string, 0, len(c.SSEOutputDialects))
+		for route := range c.SSEOutputDialects {
+			routes = append(routes, route)
+		}
+		sort.Strings(routes)
+		hash.Write([]byte("sseOutputDialects:"))
+		for _, route := range routes {
+			hash.Write([]byte(route + "=" + c.SSEOutputDialects[route] + ";"))
+		}
+	}
+
+	// Hash ResponseEnvelopes (sorted by route for deterministic hashing)
+	if len(c.ResponseEnvelopes) > 0 {
+		routes := make([]string, 0, len(c.ResponseEnvelopes))
+		for route := range c.ResponseEnvelopes {
+			routes = append(routes, route)
+		}
+		sort.Strings(routes)
+		hash.Write([]byte("responseEnvelopes:"))
+		for _, route := range routes {
+			hash.Write([]byte(route + "=" + c.ResponseEnvelopes[route] + ";"))
+		}
+	}
+
+	// Hash LoadShedding
+	if c.LoadShedding != nil {
+		data, err := sonic.Marshal(c.LoadShedding)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("loadShedding:"))
+		hash.Write(data)
+	}
+
+	// Hash CircuitBreaker
+	if c.CircuitBreaker != nil {
+		data, err := sonic.Marshal(c.CircuitBreaker)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("circuitBreaker:"))
+		hash.Write(data)
+	}
+
+	// Hash LatencyRouting
+	if c.LatencyRouting != nil {
+		data, err := sonic.Marshal(c.LatencyRouting)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("latencyRouting:"))
+		hash.Write(data)
+	}
+
+	// Hash CostRouting
+	if c.CostRouting != nil {
+		data, err := sonic.Marshal(c.CostRouting)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("costRouting:"))
+		hash.Write(data)
+	}
+
+	// Hash VirtualModels
+	if len(c.VirtualModels) > 0 {
+		data, err := sonic.Marshal(c.VirtualModels)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("virtualModels:"))
+		hash.Write(data)
+	}
+
+	// Hash TrafficSplits
+	if len(c.TrafficSplits) > 0 {
+		data, err := sonic.Marshal(c.TrafficSplits)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("trafficSplits:"))
+		hash.Write(data)
+	}
+
+	// Hash SingleFlight
+	if c.SingleFlight != nil {
+		data, err := sonic.Marshal(c.SingleFlight)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("singleFlight:"))
+		hash.Write(data)
+	}
+
+	// Hash ToolExecution
+	if c.ToolExecution != nil {
+		data, err := sonic.Marshal(c.ToolExecution)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("toolExecution:"))
+		hash.Write(data)
+	}
+
+	// Hash StreamUsage
+	if c.StreamUsage != nil {
+		data, err := sonic.Marshal(c.StreamUsage)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("streamUsage:"))
+		hash.Write(data)
+	}
+
+	// Hash HeaderPolicy
+	if c.HeaderPolicy != nil {
+		data, err := sonic.Marshal(c.HeaderPolicy)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("headerPolicy:"))
+		hash.Write(data)
+	}
+
+	// Hash SchemaDrift
+	if c.SchemaDrift != nil {
+		data, err := sonic.Marshal(c.SchemaDrift)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte("schemaDrift:"))
+		hash.Write(data)
+	}
+
 	// Hash HeaderFilterConfig
 	if c.HeaderFilterConfig != nil {
 		// Hash Allowlist (sorted for deterministic hashing)
@@ -390,6 +545,21 @@ func (p *ProviderConfig) Redacted() *ProviderConfig {
 			vllmConfig.URL = *key.VLLMKeyConfig.URL.Redacted()
 			redactedConfig.Keys[i].VLLMKeyConfig = vllmConfig
 		}
+
+		if key.HunyuanKeyConfig != nil {
+			hunyuanConfig := &schemas.HunyuanKeyConfig{}
+			hunyuanConfig.SecretID = *key.HunyuanKeyConfig.SecretID.Redacted()
+			hunyuanConfig.SecretKey = *key.HunyuanKeyConfig.SecretKey.Redacted()
+			if key.HunyuanKeyConfig.Region != nil {
+				hunyuanConfig.Region = key.HunyuanKeyConfig.Region
+			}
+			redactedConfig.Keys[i].HunyuanKeyConfig = hunyuanConfig
+		}
+
+		// GLM key config has no sensitive fields
+		if key.GLMKeyConfig != nil {
+			redactedConfig.Keys[i].GLMKeyConfig = key.GLMKeyConfig
+		}
 	}
 	return &redactedConfig
 }
@@ -531,6 +701,22 @@ func GenerateKeyHash(key schemas.Key) (string, error) {
 		}
 		hash.Write(data)
 	}
+	// Hash HunyuanKeyConfig
+	if key.HunyuanKeyConfig != nil {
+		data, err := sonic.Marshal(key.HunyuanKeyConfig)
+		if err != nil {
+			return "", err
+		}
+		hash.Write(data)
+	}
+	// Hash GLMKeyConfig
+	if key.GLMKeyConfig != nil {
+		data, err := sonic.Marshal(key.GLMKeyConfig)
+		if err != nil {
+			return "", err
+		}
+		hash.Write(data)
+	}
 	// Hash Enabled (nil = false, only true produces different hash)
 	if key.Enabled != nil && *key.Enabled {
 		hash.Write([]byte("enabled:true"))
@@ -611,6 +797,10 @@ func GenerateVirtualKeyHash(vk tables.TableVirtualKey) (string, error) {
 	if vk.RateLimitID != nil {
 		hash.Write([]byte("rateLimitID:" + *vk.RateLimitID))
 	}
+	// Hash Role
+	if vk.Role != nil {
+		hash.Write([]byte("role:" + *vk.Role))
+	}
 	// Hash ProviderConfigs
 	if len(vk.ProviderConfigs) > 0 {
 		// Copy and sort provider configs for deterministic hashing
@@ -703,7 +893,7 @@ func GenerateVirtualKeyHash(vk tables.TableVirtualKey) (string, error) {
 
 // GenerateBudgetHash generates a SHA256 hash for a budget.
 // This is used to detect changes to budgets between config.json and database.
-// Skips: LastReset, CurrentUsage, CreatedAt, UpdatedAt (dynamic fields)
+// Skips: LastReset, CurrentUsage, PreviousUsage, CreatedAt, UpdatedAt (dynamic fields)
 func GenerateBudgetHash(b tables.TableBudget) (string, error) {
 	hash := sha256.New()
 
@@ -720,6 +910,14 @@ func GenerateBudgetHash(b tables.TableBudget) (string, error) {
 	// Hash ResetDuration
 	hash.Write([]byte(b.ResetDuration))
 
+	// Hash SlidingWindow and DowngradeModel only when set, so hashes of older budgets do not change
+	if b.SlidingWindow {
+		hash.Write([]byte("sliding_window"))
+	}
+	if b.DowngradeModel != nil {
+		hash.Write([]byte("downgrade_model:" + *b.DowngradeModel))
+	}
+
 	return hex.EncodeToString(hash.Sum(nil)), nil
 }
 
@@ -1035,6 +1233,45 @@ type AuthConfig struct {
 	AdminPassword          *schemas.EnvVar `json:"admin_password"`
 	IsEnabled              bool            `json:"is_enabled"`
 	DisableAuthOnInference bool            `json:"disable_auth_on_inference"`
+	JWT                    *JWTAuthConfig  `json:"jwt,omitempty"`
+}
+
+// JWTAuthConfig configures validation of JWT bearer tokens on inference requests.
+// Tokens are verified with the static HMAC secret (HS256/HS384/HS512) or against the keys
+// published at JWKSURL (RS*, PS* and ES* algorithms), and their claims are mapped to governance.
+type JWTAuthConfig struct {
+	Enabled         bool              `json:"enabled"`
+	JWKSURL         string            `json:"jwks_url,omitempty"`
+	HMACSecret      *schemas.EnvVar   `json:"hmac_secret,omitempty"`
+	Issuer          string            `json:"issuer,omitempty"`
+	Audience        string            `json:"audience,omitempty"`
+	UserIDClaim     string            `json:"user_id_claim,omitempty"`     // defaults to "sub"
+	TeamIDClaim     string            `json:"team_id_claim,omitempty"`     // optional, claim holding the governance team ID
+	CustomerIDClaim string            `json:"customer_id_claim,omitempty"` // optional, claim holding the governance customer ID
+	RolesClaim      string            `json:"roles_claim,omitempty"`       // optional, claim holding a role or list of roles
+	RoleMappings    map[string]string `json:"role_mappings,omitempty"`     // optional, maps role claim values to admin, operator or viewer
+}
+
+// Redacted returns a copy of the config with the HMAC secret redacted.
+func (c *JWTAuthConfig) Redacted() *JWTAuthConfig {
+	if c == nil {
+		return nil
+	}
+	redacted := *c
+	if c.HMACSecret != nil {
+		redacted.HMACSecret = c.HMACSecret.Redacted()
+	}
+	return &redacted
+}
+
+// AuditLogFilters filters audit log queries; empty fields match every entry.
+type AuditLogFilters struct {
+	Actor        string     `json:"actor,omitempty"`
+	Action       string     `json:"action,omitempty"`
+	ResourceType string     `json:"resource_type,omitempty"`
+	ResourceID   string     `json:"resource_id,omitempty"`
+	StartTime    *time.Time `json:"start_time,omitempty"`
+	EndTime      *time.Time `json:"end_time,omitempty"`
 }
 
 // ConfigMap maps provider names to their configurations.
@@ -0,0 +1,249 @@
+package configstore
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/capsohq/bifrost/framework/encrypt"
+	"gorm.io/gorm"
+)
+
+const (
+	dataKeysLockKey = "config_encryption_keys"
+	// dataKeysLockTTL covers re-encrypting every row, which follows the first data key and rotations
+	dataKeysLockTTL = 10 * time.Minute
+)
+
+// initDataKeys loads the data keys and hands them to the encrypt package. On the first start with
+// encryption enabled it creates the first data key and re-encrypts the rows encrypted directly with
+// the passphrase. Data keys wrapped with a previous master key are re-wrapped with the current one.
+func (s *RDBConfigStore) initDataKeys(ctx context.Context) error {
+	master := encrypt.GetMasterKey()
+	if master == nil {
+		return nil
+	}
+	unlock, err := s.lockDataKeys(ctx)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	var rows []tables.TableEncryptionKey
+	if err := s.db.WithContext(ctx).Order("created_at ASC").Find(&rows).Error; err != nil {
+		return fmt.Errorf("failed to load encryption keys: %w", err)
+	}
+	if len(rows) == 0 {
+		id, key, err := s.createDataKey(ctx, master)
+		if err != nil {
+			return err
+		}
+		encrypt.SetDataKeys(map[string][]byte{id: key}, id)
+		count, err := s.reencryptRows(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to re-encrypt rows with the data key: %w", err)
+		}
+		if count > 0 && s.logger != nil {
+			s.logger.Info("re-encrypted %d rows with envelope encryption", count)
+		}
+		return nil
+	}
+
+	keys := make(map[string][]byte, len(rows))
+	activeID := ""
+	for i := range rows {
+		key, err := s.unwrapDataKey(ctx, master, &rows[i])
+		if err != nil {
+			return err
+		}
+		keys[rows[i].ID] = key
+		if rows[i].Active {
+			activeID = rows[i].ID
+		}
+	}
+	if activeID == "" {
+		return fmt.Errorf("no active encryption key found in %s", tables.TableEncryptionKey{}.TableName())
+	}
+	encrypt.SetDataKeys(keys, activeID)
+	return nil
+}
+
+// RotateEncryptionKey creates a new data key, re-encrypts every encrypted row with it and retires
+// the previous data keys. Retired keys are kept, so values written concurrently by instances that
+// have not reloaded the keys yet stay readable. Returns the number of re-encrypted rows.
+func (s *RDBConfigStore) RotateEncryptionKey(ctx context.Context) (int, error) {
+	master := encrypt.GetMasterKey()
+	if master == nil {
+		return 0, fmt.Errorf("encryption is not enabled")
+	}
+	unlock, err := s.lockDataKeys(ctx)
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	var rows []tables.TableEncryptionKey
+	if err := s.db.WithContext(ctx).Find(&rows).Error; err != nil {
+		return 0, fmt.Errorf("failed to load encryption keys: %w", err)
+	}
+	keys := make(map[string][]byte, len(rows)+1)
+	for i := range rows {
+		key, err := s.unwrapDataKey(ctx, master, &rows[i])
+		if err != nil {
+			return 0, err
+		}
+		keys[rows[i].ID] = key
+	}
+	id, key, err := s.createDataKey(ctx, master)
+	if err != nil {
+		return 0, err
+	}
+	keys[id] = key
+	encrypt.SetDataKeys(keys, id)
+
+	count, err := s.reencryptRows(ctx)
+	if err != nil {
+		return count, fmt.Errorf("failed to re-encrypt rows with the new data key: %w", err)
+	}
+	if s.logger != nil {
+		s.logger.Info("rotated encryption key to %s, re-encrypted %d rows", id, count)
+	}
+	return count, nil
+}
+
+// createDataKey generates a data key, stores it wrapped with master as the only active key and
+// retires the others.
+func (s *RDBConfigStore) createDataKey(ctx context.Context, master encrypt.MasterKey) (string, []byte, error) {
+	id, key, err := encrypt.NewDataKey()
+	if err != nil {
+		return "", nil, err
+	}
+	wrapped, err := master.Wrap(ctx, key)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to wrap data key with master key %s: %w", master.ID(), err)
+	}
+	now := time.Now().UTC()
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&tables.TableEncryptionKey{}).Where("active = ?", true).
+			Updates(map[string]any{"active": false, "retired_at": now}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&tables.TableEncryptionKey{
+			ID:          id,
+			WrappedKey:  base64.StdEncoding.EncodeToString(wrapped),
+			MasterKeyID: master.ID(),
+			Active:      true,
+			CreatedAt:   now,
+		}).Error
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to store data key: %w", err)
+	}
+	return id, key, nil
+}
+
+// unwrapDataKey unwraps a stored data key with master or, after a master key change, with a
+// previous master key, in which case the data key is re-wrapped with master.
+func (s *RDBConfigStore) unwrapDataKey(ctx context.Context, master encrypt.MasterKey, row *tables.TableEncryptionKey) ([]byte, error) {
+	wrapped, err := base64.StdEncoding.DecodeString(row.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode data key %s: %w", row.ID, err)
+	}
+	key, err := master.Unwrap(ctx, wrapped)
+	if err == nil {
+		if row.MasterKeyID != master.ID() {
+			// KMS unwraps data keys of its other keys too
+			return key, s.rewrapDataKey(ctx, master, row, key)
+		}
+		return key, nil
+	}
+	for _, previous := range encrypt.PreviousMasterKeys() {
+		if key, prevErr := previous.Unwrap(ctx, wrapped); prevErr == nil {
+			return key, s.rewrapDataKey(ctx, master, row, key)
+		}
+	}
+	return nil, fmt.Errorf("failed to unwrap data key %s wrapped with master key %s: %w (if the encryption key was changed, set the previous one in BIFROST_PREVIOUS_ENCRYPTION_KEY)", row.ID, row.MasterKeyID, err)
+}
+
+// rewrapDataKey stores key wrapped with master in place of its previous wrapping.
+func (s *RDBConfigStore) rewrapDataKey(ctx context.Context, master encrypt.MasterKey, row *tables.TableEncryptionKey, key []byte) error {
+	wrapped, err := master.Wrap(ctx, key)
+	if err != nil {
+		return fmt.Errorf("failed to wrap data key %s with master key %s: %w", row.ID, master.ID(), err)
+	}
+	previousMasterKeyID := row.MasterKeyID
+	row.WrappedKey = base64.StdEncoding.EncodeToString(wrapped)
+	row.MasterKeyID = master.ID()
+	if err := s.db.WithContext(ctx).Model(row).
+		Updates(map[string]any{"wrapped_key": row.WrappedKey, "master_key_id": row.MasterKeyID}).Error; err != nil {
+		return fmt.Errorf("failed to store re-wrapped data key %s: %w", row.ID, err)
+	}
+	if s.logger != nil {
+		s.logger.Info("re-wrapped data key %s from master key %s with master key %s", row.ID, previousMasterKeyID, row.MasterKeyID)
+	}
+	return nil
+}
+
+// lockDataKeys serializes data key creation and rotation across instances sharing the store.
+func (s *RDBConfigStore) lockDataKeys(ctx context.Context) (func(), error) {
+	lock, err := NewDistributedLockManager(s, s.logger).NewLockWithTTL(dataKeysLockKey, dataKeysLockTTL)
+	if err != nil {
+		return nil, err
+	}
+	if err := lock.Lock(ctx); err != nil {
+		return nil, fmt.Errorf("failed to lock encryption keys: %w", err)
+	}
+	return func() {
+		if err := lock.Unlock(context.WithoutCancel(ctx)); err != nil && !errors.Is(err, ErrLockNotHeld) && s.logger != nil {
+			s.logger.Warn("failed to unlock encryption keys: %v", err)
+		}
+	}, nil
+}
+
+// reencryptRows re-saves every encrypted row of the sensitive tables. Each table's AfterFind hook
+// decrypts the row with the key it was written with and its BeforeSave hook encrypts it with the
+// active data key.
+func (s *RDBConfigStore) reencryptRows(ctx context.Context) (int, error) {
+	var total int
+	for _, reencrypt := range []func(context.Context, *gorm.DB) (int, error){
+		reencryptTable[tables.TableKey],
+		reencryptTable[tables.TableVirtualKey],
+		reencryptTable[tables.SessionsTable],
+		reencryptTable[tables.TableOauthToken],
+		reencryptTable[tables.TableOauthConfig],
+		reencryptTable[tables.TableMCPClient],
+		reencryptTable[tables.TableProvider],
+		reencryptTable[tables.TableVectorStoreConfig],
+		reencryptTable[tables.TablePlugin],
+	} {
+		count, err := reencrypt(ctx, s.db)
+		total += count
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// reencryptTable re-saves the encrypted rows of the table of T in batches.
+func reencryptTable[T any](ctx context.Context, db *gorm.DB) (int, error) {
+	var count int
+	var rows []T
+	err := db.WithContext(ctx).
+		Where("encryption_status = ?", encryptionStatusEncrypted).
+		FindInBatches(&rows, encryptionBatchSize, func(_ *gorm.DB, _ int) error {
+			return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+				for i := range rows {
+					if err := tx.Save(&rows[i]).Error; err != nil {
+						return err
+					}
+				}
+				count += len(rows)
+				return nil
+			})
+		}).Error
+	return count, err
+}
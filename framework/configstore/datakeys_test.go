@@ -0,0 +1,139 @@
+package configstore
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/capsohq/bifrost/framework/encrypt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// setupDataKeysTestStore creates an encryption test store with the data key and lock tables and
+// a legacy encrypted key and virtual key, written before any data key exists.
+func setupDataKeysTestStore(t *testing.T) (*RDBConfigStore, *gorm.DB) {
+	t.Helper()
+	encrypt.SetDataKeys(nil, "")
+	t.Cleanup(func() {
+		encrypt.Init(testEncryptionKey, bifrost.NewDefaultLogger(schemas.LogLevelInfo))
+		encrypt.SetDataKeys(nil, "")
+	})
+	store, db := setupEncryptionTestStore(t)
+	require.NoError(t, db.AutoMigrate(&tables.TableEncryptionKey{}, &tables.TableDistributedLock{}))
+
+	now := time.Now().UTC().Format("2006-01-02 15:04:05")
+	future := time.Now().Add(time.Hour).UTC().Format("2006-01-02 15:04:05")
+	insertPlaintextRow(t, db,
+		`INSERT INTO config_keys (name, provider_id, provider, key_id, value, encryption_status, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, ?, 'plain_text', ?, ?)`,
+		"envelope-key", 1, "openai", "ek-1", "sk-envelope-secret", now, now)
+	insertPlaintextRow(t, db,
+		`INSERT INTO sessions (token, encryption_status, expires_at, created_at, updated_at)
+		 VALUES (?, 'plain_text', ?, ?, ?)`,
+		"session-envelope-secret", future, now, now)
+	require.NoError(t, store.EncryptPlaintextRows(context.Background()))
+	return store, db
+}
+
+// rawKeyValue returns the stored value of the envelope-key row, bypassing GORM hooks.
+func rawKeyValue(t *testing.T, db *gorm.DB) string {
+	t.Helper()
+	var raw map[string]any
+	require.NoError(t, db.Table("config_keys").Where("name = ?", "envelope-key").Take(&raw).Error)
+	return raw["value"].(string)
+}
+
+func assertRowsReadable(t *testing.T, db *gorm.DB) {
+	t.Helper()
+	var key tables.TableKey
+	require.NoError(t, db.Where("name = ?", "envelope-key").First(&key).Error)
+	assert.Equal(t, "sk-envelope-secret", key.Value.GetValue())
+	var session tables.SessionsTable
+	require.NoError(t, db.First(&session).Error)
+	assert.Equal(t, "session-envelope-secret", session.Token)
+}
+
+func TestInitDataKeys_MigratesLegacyRows(t *testing.T) {
+	store, db := setupDataKeysTestStore(t)
+	ctx := context.Background()
+	assert.False(t, strings.HasPrefix(rawKeyValue(t, db), "v2:"))
+
+	require.NoError(t, store.initDataKeys(ctx))
+
+	var keys []tables.TableEncryptionKey
+	require.NoError(t, db.Find(&keys).Error)
+	require.Len(t, keys, 1)
+	assert.True(t, keys[0].Active)
+	assert.Equal(t, encrypt.GetMasterKey().ID(), keys[0].MasterKeyID)
+	assert.Equal(t, keys[0].ID, encrypt.ActiveDataKeyID())
+	assert.True(t, strings.HasPrefix(rawKeyValue(t, db), "v2:"+keys[0].ID+":"))
+	assertRowsReadable(t, db)
+
+	// A restart loads the stored data key instead of creating another
+	encrypt.SetDataKeys(nil, "")
+	require.NoError(t, store.initDataKeys(ctx))
+	assert.Equal(t, keys[0].ID, encrypt.ActiveDataKeyID())
+	var count int64
+	require.NoError(t, db.Model(&tables.TableEncryptionKey{}).Count(&count).Error)
+	assert.Equal(t, int64(1), count)
+	assertRowsReadable(t, db)
+}
+
+func TestRotateEncryptionKey_ReencryptsWithNewDataKey(t *testing.T) {
+	store, db := setupDataKeysTestStore(t)
+	ctx := context.Background()
+	require.NoError(t, store.initDataKeys(ctx))
+	oldID := encrypt.ActiveDataKeyID()
+
+	count, err := store.RotateEncryptionKey(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	newID := encrypt.ActiveDataKeyID()
+	assert.NotEqual(t, oldID, newID)
+	assert.True(t, strings.HasPrefix(rawKeyValue(t, db), "v2:"+newID+":"))
+	assertRowsReadable(t, db)
+
+	var old tables.TableEncryptionKey
+	require.NoError(t, db.First(&old, "id = ?", oldID).Error)
+	assert.False(t, old.Active)
+	assert.NotNil(t, old.RetiredAt)
+
+	// After a restart both data keys are loaded and the new one stays active
+	encrypt.SetDataKeys(nil, "")
+	require.NoError(t, store.initDataKeys(ctx))
+	assert.Equal(t, newID, encrypt.ActiveDataKeyID())
+	assertRowsReadable(t, db)
+}
+
+func TestInitDataKeys_RewrapsAfterPassphraseChange(t *testing.T) {
+	store, db := setupDataKeysTestStore(t)
+	ctx := context.Background()
+	require.NoError(t, store.initDataKeys(ctx))
+	activeID := encrypt.ActiveDataKeyID()
+
+	encrypt.Init("a-new-encryption-key-after-rotation", bifrost.NewDefaultLogger(schemas.LogLevelInfo))
+	encrypt.AddPreviousKey(testEncryptionKey)
+	encrypt.SetDataKeys(nil, "")
+	require.NoError(t, store.initDataKeys(ctx))
+
+	var key tables.TableEncryptionKey
+	require.NoError(t, db.First(&key, "id = ?", activeID).Error)
+	assert.Equal(t, encrypt.GetMasterKey().ID(), key.MasterKeyID)
+	assert.Equal(t, activeID, encrypt.ActiveDataKeyID())
+	assertRowsReadable(t, db)
+}
+
+func TestRotateEncryptionKey_EncryptionDisabled(t *testing.T) {
+	store, _ := setupDataKeysTestStore(t)
+	encrypt.Init("", bifrost.NewDefaultLogger(schemas.LogLevelInfo))
+
+	_, err := store.RotateEncryptionKey(context.Background())
+	assert.Error(t, err)
+}
@@ -32,13 +32,6 @@ func (s *RDBConfigStore) EncryptPlaintextRows(ctx context.Context) error {
 	}
 	totalEncrypted += count
 
-	// governance_virtual_keys
-	count, err = s.encryptPlaintextVirtualKeys(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to encrypt virtual_keys: %w", err)
-	}
-	totalEncrypted += count
-
 	// sessions
 	count, err = s.encryptPlaintextSessions(ctx)
 	if err != nil {
@@ -125,14 +118,16 @@ func (s *RDBConfigStore) encryptPlaintextKeys(ctx context.Context) (int, error)
 	return count, nil
 }
 
-// encryptPlaintextVirtualKeys finds all governance_virtual_keys rows with plaintext encryption
-// status and re-saves them in batches. The TableVirtualKey.BeforeSave hook handles encryption.
-func (s *RDBConfigStore) encryptPlaintextVirtualKeys(ctx context.Context) (int, error) {
+// hashVirtualKeyValues finds all governance_virtual_keys rows still holding the virtual key value,
+// in plaintext or encrypted, and re-saves them in batches. The TableVirtualKey.AfterFind hook
+// decrypts the value and the BeforeSave hook replaces it with its hash and a masked hint. Called
+// during startup whether or not encryption is enabled.
+func (s *RDBConfigStore) hashVirtualKeyValues(ctx context.Context) (int, error) {
 	var count int
 	for {
 		var vks []tables.TableVirtualKey
 		if err := s.db.WithContext(ctx).
-			Where("(encryption_status = ? OR encryption_status IS NULL OR encryption_status = '') AND value != ''", encryptionStatusPlainText).
+			Where("value != '' AND value NOT LIKE ?", "%"+tables.VirtualKeyValueMask+"%").
 			Limit(encryptionBatchSize).
 			Find(&vks).Error; err != nil {
 			return count, err
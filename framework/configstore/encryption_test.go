@@ -84,11 +84,6 @@ func TestEncryptPlaintextRows_EncryptsAllTables(t *testing.T) {
 		 VALUES (?, ?, ?, ?, ?, 'plain_text', ?, ?)`,
 		"test-key", 1, "openai", "key-1", "sk-plaintext-key", now, now)
 
-	insertPlaintextRow(t, db,
-		`INSERT INTO governance_virtual_keys (id, name, value, is_active, encryption_status, created_at, updated_at)
-		 VALUES (?, ?, ?, ?, 'plain_text', ?, ?)`,
-		"vk-1", "test-vk", "vk-plaintext-value", true, now, now)
-
 	insertPlaintextRow(t, db,
 		`INSERT INTO sessions (token, encryption_status, expires_at, created_at, updated_at)
 		 VALUES (?, 'plain_text', ?, ?, ?)`,
@@ -134,11 +129,6 @@ func TestEncryptPlaintextRows_EncryptsAllTables(t *testing.T) {
 	assert.Equal(t, "encrypted", keyRow["encryption_status"])
 	assert.NotEqual(t, "sk-plaintext-key", keyRow["value"])
 
-	var vkRow map[string]any
-	db.Table("governance_virtual_keys").Where("id = ?", "vk-1").Take(&vkRow)
-	assert.Equal(t, "encrypted", vkRow["encryption_status"])
-	assert.NotEqual(t, "vk-plaintext-value", vkRow["value"])
-
 	var sessionRow map[string]any
 	db.Table("sessions").Take(&sessionRow)
 	assert.Equal(t, "encrypted", sessionRow["encryption_status"])
@@ -402,7 +392,7 @@ func TestEncryptPlaintextKeys_EncryptsAndDecryptsCorrectly(t *testing.T) {
 	assert.Equal(t, "sk-batch-secret-2", found2.Value.GetValue())
 }
 
-func TestEncryptPlaintextVirtualKeys_EncryptsAndDecryptsCorrectly(t *testing.T) {
+func TestHashVirtualKeyValues_ReplacesPlaintextValues(t *testing.T) {
 	store, db := setupEncryptionTestStore(t)
 	ctx := context.Background()
 	now := time.Now().UTC().Format("2006-01-02 15:04:05")
@@ -410,22 +400,51 @@ func TestEncryptPlaintextVirtualKeys_EncryptsAndDecryptsCorrectly(t *testing.T)
 	insertPlaintextRow(t, db,
 		`INSERT INTO governance_virtual_keys (id, name, value, is_active, encryption_status, created_at, updated_at)
 		 VALUES (?, ?, ?, ?, 'plain_text', ?, ?)`,
-		"vk-batch-1", "batch-vk", "vk-batch-secret", true, now, now)
+		"vk-batch-1", "batch-vk", "sk-bf-vk-batch-secret", true, now, now)
 
-	count, err := store.encryptPlaintextVirtualKeys(ctx)
+	count, err := store.hashVirtualKeyValues(ctx)
 	require.NoError(t, err)
 	assert.Equal(t, 1, count)
 
-	// Raw DB should have encrypted value
+	// Raw DB should only have the hash and a masked hint
 	var raw map[string]any
 	db.Table("governance_virtual_keys").Where("id = ?", "vk-batch-1").Take(&raw)
-	assert.Equal(t, "encrypted", raw["encryption_status"])
-	assert.NotEqual(t, "vk-batch-secret", raw["value"])
+	assert.Equal(t, "plain_text", raw["encryption_status"])
+	assert.Equal(t, "sk-bf-****cret", raw["value"])
+	assert.Equal(t, encrypt.HashSHA256("sk-bf-vk-batch-secret"), raw["value_hash"])
 
-	// GORM hooks should decrypt on read
-	var found tables.TableVirtualKey
-	require.NoError(t, db.Where("id = ?", "vk-batch-1").First(&found).Error)
-	assert.Equal(t, "vk-batch-secret", found.Value)
+	// Running again is a no-op
+	count, err = store.hashVirtualKeyValues(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestHashVirtualKeyValues_ReplacesEncryptedValues(t *testing.T) {
+	store, db := setupEncryptionTestStore(t)
+	ctx := context.Background()
+	now := time.Now().UTC().Format("2006-01-02 15:04:05")
+
+	// Rows encrypted before values were stored as hashes
+	encrypted, err := encrypt.Encrypt("sk-bf-vk-encrypted-secret")
+	require.NoError(t, err)
+	insertPlaintextRow(t, db,
+		`INSERT INTO governance_virtual_keys (id, name, value, is_active, encryption_status, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, 'encrypted', ?, ?)`,
+		"vk-enc-1", "encrypted-vk", encrypted, true, now, now)
+
+	count, err := store.hashVirtualKeyValues(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	var raw map[string]any
+	db.Table("governance_virtual_keys").Where("id = ?", "vk-enc-1").Take(&raw)
+	assert.Equal(t, "plain_text", raw["encryption_status"])
+	assert.Equal(t, "sk-bf-****cret", raw["value"])
+	assert.Equal(t, encrypt.HashSHA256("sk-bf-vk-encrypted-secret"), raw["value_hash"])
+
+	found, err := store.GetVirtualKeyByValue(ctx, "sk-bf-vk-encrypted-secret")
+	require.NoError(t, err)
+	assert.Equal(t, "vk-enc-1", found.ID)
 }
 
 func TestEncryptPlaintextOAuthConfigs_EncryptsAndDecryptsCorrectly(t *testing.T) {
@@ -1234,7 +1253,7 @@ func TestEncryptPlaintextOAuthConfigs_SkipsBothEmptySecrets(t *testing.T) {
 }
 
 // ============================================================================
-// Hash computation during startup pass (sessions + virtual keys)
+// Hash computation during startup pass
 // ============================================================================
 
 func TestEncryptPlaintextSessions_HashComputedDuringStartup(t *testing.T) {
@@ -1258,26 +1277,6 @@ func TestEncryptPlaintextSessions_HashComputedDuringStartup(t *testing.T) {
 	assert.Equal(t, encrypt.HashSHA256("hash-startup-token"), raw["token_hash"])
 }
 
-func TestEncryptPlaintextVirtualKeys_HashComputedDuringStartup(t *testing.T) {
-	store, db := setupEncryptionTestStore(t)
-	ctx := context.Background()
-	now := time.Now().UTC().Format("2006-01-02 15:04:05")
-
-	insertPlaintextRow(t, db,
-		`INSERT INTO governance_virtual_keys (id, name, value, is_active, encryption_status, created_at, updated_at)
-		 VALUES (?, ?, ?, ?, 'plain_text', ?, ?)`,
-		"vk-hash-startup", "hash-vk", "vk-hash-startup-value", true, now, now)
-
-	count, err := store.encryptPlaintextVirtualKeys(ctx)
-	require.NoError(t, err)
-	assert.Equal(t, 1, count)
-
-	var raw map[string]any
-	db.Table("governance_virtual_keys").Where("id = ?", "vk-hash-startup").Take(&raw)
-	assert.Equal(t, "encrypted", raw["encryption_status"])
-	assert.Equal(t, encrypt.HashSHA256("vk-hash-startup-value"), raw["value_hash"])
-}
-
 // ============================================================================
 // MCP client env var connection string survives startup pass
 // ============================================================================
@@ -1335,28 +1334,30 @@ func TestEncryptPlaintextRows_SkipsAlreadyEncryptedSessions(t *testing.T) {
 	assert.Equal(t, encryptedBefore, rawAfter["token"])
 }
 
-func TestEncryptPlaintextRows_SkipsAlreadyEncryptedVirtualKeys(t *testing.T) {
+func TestEncryptPlaintextRows_LeavesVirtualKeyValuesHashed(t *testing.T) {
 	store, db := setupEncryptionTestStore(t)
 	ctx := context.Background()
 
 	vk := &tables.TableVirtualKey{
-		ID:       "vk-already-enc",
-		Name:     "already-encrypted-vk",
-		Value:    "vk-secret-already",
+		ID:       "vk-hashed",
+		Name:     "hashed-vk",
+		Value:    "sk-bf-vk-secret-hashed",
 		IsActive: true,
 	}
 	require.NoError(t, db.Create(vk).Error)
 
 	var rawBefore map[string]any
-	db.Table("governance_virtual_keys").Where("id = ?", "vk-already-enc").Take(&rawBefore)
-	encryptedBefore := rawBefore["value"]
+	db.Table("governance_virtual_keys").Where("id = ?", "vk-hashed").Take(&rawBefore)
+	assert.Equal(t, "plain_text", rawBefore["encryption_status"])
+	assert.Equal(t, "sk-bf-****shed", rawBefore["value"])
 
 	err := store.EncryptPlaintextRows(ctx)
 	require.NoError(t, err)
 
 	var rawAfter map[string]any
-	db.Table("governance_virtual_keys").Where("id = ?", "vk-already-enc").Take(&rawAfter)
-	assert.Equal(t, encryptedBefore, rawAfter["value"])
+	db.Table("governance_virtual_keys").Where("id = ?", "vk-hashed").Take(&rawAfter)
+	assert.Equal(t, rawBefore["value"], rawAfter["value"])
+	assert.Equal(t, rawBefore["value_hash"], rawAfter["value_hash"])
 }
 
 // ============================================================================
@@ -1387,3 +1388,37 @@ func TestEncryptPlaintextOAuthTokens_EmptyRefreshToken(t *testing.T) {
 	assert.Equal(t, "access-only-startup", found.AccessToken)
 	assert.Equal(t, "", found.RefreshToken)
 }
+
+// ============================================================================
+// JWT auth config HMAC secret
+// ============================================================================
+
+func TestUpdateAuthConfig_EncryptsJWTHMACSecret(t *testing.T) {
+	store, db := setupEncryptionTestStore(t)
+	require.NoError(t, db.AutoMigrate(&tables.TableGovernanceConfig{}))
+	ctx := context.Background()
+
+	require.NoError(t, store.UpdateAuthConfig(ctx, &AuthConfig{
+		AdminUserName: schemas.NewEnvVar("admin"),
+		AdminPassword: schemas.NewEnvVar("hashed-password"),
+		IsEnabled:     true,
+		JWT: &JWTAuthConfig{
+			Enabled:     true,
+			HMACSecret:  schemas.NewEnvVar("jwt-hmac-secret"),
+			Issuer:      "https://issuer.example.com",
+			TeamIDClaim: "team",
+		},
+	}))
+
+	// Raw DB should not contain the plaintext secret
+	var entry tables.TableGovernanceConfig
+	require.NoError(t, db.First(&entry, "key = ?", tables.ConfigJWTAuthKey).Error)
+	assert.NotContains(t, entry.Value, "jwt-hmac-secret")
+
+	authConfig, err := store.GetAuthConfig(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, authConfig.JWT)
+	assert.Equal(t, "jwt-hmac-secret", authConfig.JWT.HMACSecret.GetValue())
+	assert.Equal(t, "https://issuer.example.com", authConfig.JWT.Issuer)
+	assert.Equal(t, "team", authConfig.JWT.TeamIDClaim)
+}
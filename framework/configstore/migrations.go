@@ -23,19 +23,24 @@ const (
 	// migrationAdvisoryLockKey is used for PostgreSQL advisory locks
 	// to serialize migrations across cluster nodes
 	migrationAdvisoryLockKey = 1000001
+	// migrationNamedLock is the MySQL named lock (GET_LOCK) serializing migrations across cluster nodes
+	migrationNamedLock = "bifrost_configstore_migrations"
 )
 
 // migrationLock holds a dedicated connection for the advisory lock.
 // This ensures the lock is held on the same connection throughout migrations,
 // preventing race conditions caused by GORM's connection pooling.
 type migrationLock struct {
-	conn *sql.Conn
+	conn    *sql.Conn
+	dialect string
 }
 
-// acquireMigrationLock gets a dedicated connection and acquires an advisory lock.
-// For non-PostgreSQL databases, returns a no-op lock.
+// acquireMigrationLock gets a dedicated connection and acquires an advisory lock:
+// pg_advisory_lock on PostgreSQL and GET_LOCK on MySQL.
+// For SQLite, which is not shared across nodes, returns a no-op lock.
 func acquireMigrationLock(ctx context.Context, db *gorm.DB) (*migrationLock, error) {
-	if db.Dialector.Name() != "postgres" {
+	dialect := db.Dialector.Name()
+	if dialect != "postgres" && dialect != "mysql" {
 		return &migrationLock{}, nil
 	}
 
@@ -52,13 +57,22 @@ func acquireMigrationLock(ctx context.Context, db *gorm.DB) (*migrationLock, err
 
 	// Acquire advisory lock on this dedicated connection.
 	// This will BLOCK if another node holds the lock.
-	_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationAdvisoryLockKey)
+	if dialect == "mysql" {
+		// A negative timeout waits indefinitely; the result is 1 once the lock is held
+		var acquired sql.NullInt64
+		err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, -1)", migrationNamedLock).Scan(&acquired)
+		if err == nil && acquired.Int64 != 1 {
+			err = fmt.Errorf("GET_LOCK returned %v", acquired)
+		}
+	} else {
+		_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", migrationAdvisoryLockKey)
+	}
 	if err != nil {
 		conn.Close()
 		return nil, fmt.Errorf("failed to acquire migration advisory lock: %w", err)
 	}
 
-	return &migrationLock{conn: conn}, nil
+	return &migrationLock{conn: conn, dialect: dialect}, nil
 }
 
 // release unlocks and closes the dedicated connection
@@ -67,10 +81,42 @@ func (l *migrationLock) release(ctx context.Context) {
 		return
 	}
 	// Release lock on the SAME connection that acquired it
-	_, _ = l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationAdvisoryLockKey)
+	if l.dialect == "mysql" {
+		_, _ = l.conn.ExecContext(ctx, "SELECT RELEASE_LOCK(?)", migrationNamedLock)
+	} else {
+		_, _ = l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", migrationAdvisoryLockKey)
+	}
 	l.conn.Close()
 }
 
+// createIndexIfNotExists creates an index on columns of table unless it already exists.
+// MySQL has no CREATE INDEX IF NOT EXISTS, so the index is looked up first there.
+func createIndexIfNotExists(tx *gorm.DB, table string, name string, columns string, unique bool) error {
+	kind := "INDEX"
+	if unique {
+		kind = "UNIQUE INDEX"
+	}
+	if tx.Dialector.Name() == "mysql" {
+		if tx.Migrator().HasIndex(table, name) {
+			return nil
+		}
+		return tx.Exec(fmt.Sprintf("CREATE %s %s ON %s (%s)", kind, name, table, columns)).Error
+	}
+	return tx.Exec(fmt.Sprintf("CREATE %s IF NOT EXISTS %s ON %s (%s)", kind, name, table, columns)).Error
+}
+
+// dropIndexIfExists drops an index of table if it exists. MySQL indexes are scoped to their table
+// and have no DROP INDEX IF EXISTS, so the index is looked up first there.
+func dropIndexIfExists(tx *gorm.DB, table string, name string) error {
+	if tx.Dialector.Name() == "mysql" {
+		if !tx.Migrator().HasIndex(table, name) {
+			return nil
+		}
+		return tx.Exec(fmt.Sprintf("DROP INDEX %s ON %s", name, table)).Error
+	}
+	return tx.Exec(fmt.Sprintf("DROP INDEX IF EXISTS %s", name)).Error
+}
+
 // Migrate performs the necessary database migrations.
 func triggerMigrations(ctx context.Context, db *gorm.DB) error {
 	// Acquire advisory lock to serialize migrations across cluster nodes.
@@ -299,6 +345,78 @@ func triggerMigrations(ctx context.Context, db *gorm.DB) error {
 	if err := migrationAddBedrockAssumeRoleColumns(ctx, db); err != nil {
 		return err
 	}
+	if err := migrationAddHunyuanKeyConfigColumns(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddSSEOutputDialectsJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddResponseEnvelopesJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddLoadSheddingJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddParameterPresetsTable(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddGLMWebSearchJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddSchemaDriftJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddCircuitBreakerJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddLatencyRoutingJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddCostRoutingJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddVirtualModelsJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddTrafficSplitsJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddBudgetWindowColumns(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddSingleFlightJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddToolExecutionJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddStreamUsageJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddHeaderPolicyJSONColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddVirtualKeyRoleColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddAuditLogsTable(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddWebhookDeadLettersTable(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddSSEKeepAliveIntervalColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddEncryptionKeysTable(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddPricingOverridesTable(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationDropVirtualKeyValueUniqueIndex(ctx, db); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -488,15 +606,20 @@ func migrationMany2ManyJoinTable(ctx context.Context, db *gorm.DB) error {
 
 			// create the many-to-many join table for virtual keys and keys
 			if !migrator.HasTable("governance_virtual_key_keys") {
-				createJoinTableSQL := `
+				// Foreign key columns must match the referenced column type, which is unsigned on MySQL
+				keyIDType := "INTEGER"
+				if tx.Dialector.Name() == "mysql" {
+					keyIDType = "BIGINT UNSIGNED"
+				}
+				createJoinTableSQL := fmt.Sprintf(`
 					CREATE TABLE IF NOT EXISTS governance_virtual_key_keys (
 						table_virtual_key_id VARCHAR(255) NOT NULL,
-						table_key_id INTEGER NOT NULL,
+						table_key_id %s NOT NULL,
 						PRIMARY KEY (table_virtual_key_id, table_key_id),
 						FOREIGN KEY (table_virtual_key_id) REFERENCES governance_virtual_keys(id) ON DELETE CASCADE,
 						FOREIGN KEY (table_key_id) REFERENCES config_keys(id) ON DELETE CASCADE
 					)
-				`
+				`, keyIDType)
 				if err := tx.Exec(createJoinTableSQL).Error; err != nil {
 					return fmt.Errorf("failed to create governance_virtual_key_keys table: %w", err)
 				}
@@ -762,7 +885,7 @@ func migrationAddKeyNameColumn(ctx context.Context, db *gorm.DB) error {
 				}
 
 				// Step 3: Add unique index (SQLite compatible)
-				if err := tx.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_key_name ON config_keys (name)").Error; err != nil {
+				if err := createIndexIfNotExists(tx, "config_keys", "idx_key_name", "name", true); err != nil {
 					return fmt.Errorf("failed to create unique index on name: %w", err)
 				}
 			}
@@ -773,7 +896,7 @@ func migrationAddKeyNameColumn(ctx context.Context, db *gorm.DB) error {
 			tx = tx.WithContext(ctx)
 			migrator := tx.Migrator()
 			// Drop the unique index first to avoid orphaned index artifacts
-			if err := tx.Exec("DROP INDEX IF EXISTS idx_key_name").Error; err != nil {
+			if err := dropIndexIfExists(tx, "config_keys", "idx_key_name"); err != nil {
 				return err
 			}
 			if err := migrator.DropColumn(&tables.TableKey{}, "name"); err != nil {
@@ -903,13 +1026,13 @@ func migrationAddProviderConfigBudgetRateLimit(ctx context.Context, db *gorm.DB)
 
 				// Create foreign key indexes for better performance
 				if !migrator.HasIndex(&tables.TableVirtualKeyProviderConfig{}, "idx_provider_config_budget") {
-					if err := tx.Exec("CREATE INDEX IF NOT EXISTS idx_provider_config_budget ON governance_virtual_key_provider_configs (budget_id)").Error; err != nil {
+					if err := createIndexIfNotExists(tx, "governance_virtual_key_provider_configs", "idx_provider_config_budget", "budget_id", false); err != nil {
 						return fmt.Errorf("failed to create budget_id index: %w", err)
 					}
 				}
 
 				if !migrator.HasIndex(&tables.TableVirtualKeyProviderConfig{}, "idx_provider_config_rate_limit") {
-					if err := tx.Exec("CREATE INDEX IF NOT EXISTS idx_provider_config_rate_limit ON governance_virtual_key_provider_configs (rate_limit_id)").Error; err != nil {
+					if err := createIndexIfNotExists(tx, "governance_virtual_key_provider_configs", "idx_provider_config_rate_limit", "rate_limit_id", false); err != nil {
 						return fmt.Errorf("failed to create rate_limit_id index: %w", err)
 					}
 				}
@@ -934,10 +1057,10 @@ func migrationAddProviderConfigBudgetRateLimit(ctx context.Context, db *gorm.DB)
 			migrator := tx.Migrator()
 
 			// Drop indexes first
-			if err := tx.Exec("DROP INDEX IF EXISTS idx_provider_config_budget").Error; err != nil {
+			if err := dropIndexIfExists(tx, "governance_virtual_key_provider_configs", "idx_provider_config_budget"); err != nil {
 				return fmt.Errorf("failed to drop budget_id index: %w", err)
 			}
-			if err := tx.Exec("DROP INDEX IF EXISTS idx_provider_config_rate_limit").Error; err != nil {
+			if err := dropIndexIfExists(tx, "governance_virtual_key_provider_configs", "idx_provider_config_rate_limit"); err != nil {
 				return fmt.Errorf("failed to drop rate_limit_id index: %w", err)
 			}
 
@@ -1134,7 +1257,7 @@ func migrationAddMCPClientIDColumn(ctx context.Context, db *gorm.DB) error {
 				}
 
 				// Create unique index on client_id
-				if err := tx.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_mcp_client_id ON config_mcp_clients (client_id)").Error; err != nil {
+				if err := createIndexIfNotExists(tx, "config_mcp_clients", "idx_mcp_client_id", "client_id", true); err != nil {
 					return fmt.Errorf("failed to create unique index on client_id: %w", err)
 				}
 				// Enforce NOT NULL in Postgres to guarantee ID presence on new rows
@@ -1152,7 +1275,7 @@ func migrationAddMCPClientIDColumn(ctx context.Context, db *gorm.DB) error {
 			migrator := tx.Migrator()
 
 			// Drop the unique index first to avoid orphaned index artifacts
-			if err := tx.Exec("DROP INDEX IF EXISTS idx_mcp_client_id").Error; err != nil {
+			if err := dropIndexIfExists(tx, "config_mcp_clients", "idx_mcp_client_id"); err != nil {
 				return fmt.Errorf("failed to drop client_id index: %w", err)
 			}
 
@@ -2932,8 +3055,7 @@ func migrationAddDistributedLocksTable(ctx context.Context, db *gorm.DB) error {
 				return fmt.Errorf("failed to create distributed_locks table: %w", err)
 			}
 			// Create index on expires_at for efficient cleanup queries
-			createIndexSQL := `CREATE INDEX IF NOT EXISTS idx_distributed_locks_expires_at ON distributed_locks (expires_at)`
-			if err := tx.Exec(createIndexSQL).Error; err != nil {
+			if err := createIndexIfNotExists(tx, "distributed_locks", "idx_distributed_locks_expires_at", "expires_at", false); err != nil {
 				return fmt.Errorf("failed to create expires_at index: %w", err)
 			}
 			return nil
@@ -3000,7 +3122,7 @@ func migrationAddProviderGovernanceColumns(ctx context.Context, db *gorm.DB) err
 			}
 			// Create index for budget_id (outside HasColumn to handle reruns where column exists but index doesn't)
 			if !migrator.HasIndex(provider, "idx_provider_budget") {
-				if err := tx.Exec("CREATE INDEX IF NOT EXISTS idx_provider_budget ON config_providers (budget_id)").Error; err != nil {
+				if err := createIndexIfNotExists(tx, "config_providers", "idx_provider_budget", "budget_id", false); err != nil {
 					return fmt.Errorf("failed to create budget_id index: %w", err)
 				}
 			}
@@ -3013,7 +3135,7 @@ func migrationAddProviderGovernanceColumns(ctx context.Context, db *gorm.DB) err
 			}
 			// Create index for rate_limit_id (outside HasColumn to handle reruns where column exists but index doesn't)
 			if !migrator.HasIndex(provider, "idx_provider_rate_limit") {
-				if err := tx.Exec("CREATE INDEX IF NOT EXISTS idx_provider_rate_limit ON config_providers (rate_limit_id)").Error; err != nil {
+				if err := createIndexIfNotExists(tx, "config_providers", "idx_provider_rate_limit", "rate_limit_id", false); err != nil {
 					return fmt.Errorf("failed to create rate_limit_id index: %w", err)
 				}
 			}
@@ -3027,13 +3149,13 @@ func migrationAddProviderGovernanceColumns(ctx context.Context, db *gorm.DB) err
 
 			// Drop indexes first
 			if migrator.HasIndex(provider, "idx_provider_rate_limit") {
-				if err := tx.Exec("DROP INDEX IF EXISTS idx_provider_rate_limit").Error; err != nil {
+				if err := dropIndexIfExists(tx, "config_providers", "idx_provider_rate_limit"); err != nil {
 					return fmt.Errorf("failed to drop rate_limit_id index: %w", err)
 				}
 			}
 
 			if migrator.HasIndex(provider, "idx_provider_budget") {
-				if err := tx.Exec("DROP INDEX IF EXISTS idx_provider_budget").Error; err != nil {
+				if err := dropIndexIfExists(tx, "config_providers", "idx_provider_budget"); err != nil {
 					return fmt.Errorf("failed to drop budget_id index: %w", err)
 				}
 			}
@@ -4143,3 +4265,824 @@ func migrationAddBedrockAssumeRoleColumns(ctx context.Context, db *gorm.DB) erro
 	}
 	return nil
 }
+
+// migrationAddHunyuanKeyConfigColumns adds hunyuan_secret_id, hunyuan_secret_key, and hunyuan_region
+// columns to the config_keys table for Tencent Cloud signature authentication in Hunyuan keys.
+func migrationAddHunyuanKeyConfigColumns(ctx context.Context, db *gorm.DB) error {
+	columns := []string{"hunyuan_secret_id", "hunyuan_secret_key", "hunyuan_region"}
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_hunyuan_key_config_columns",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			for _, column := range columns {
+				if !mg.HasColumn(&tables.TableKey{}, column) {
+					if err := mg.AddColumn(&tables.TableKey{}, column); err != nil {
+						return fmt.Errorf("failed to add %s column: %w", column, err)
+					}
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			for _, column := range columns {
+				if mg.HasColumn(&tables.TableKey{}, column) {
+					if err := mg.DropColumn(&tables.TableKey{}, column); err != nil {
+						return fmt.Errorf("failed to drop %s column: %w", column, err)
+					}
+				}
+			}
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error while running hunyuan key config columns migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddSSEOutputDialectsJSONColumn adds the sse_output_dialects_json column to the config_client table
+func migrationAddSSEOutputDialectsJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_sse_output_dialects_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "sse_output_dialects_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "SSEOutputDialectsJSON"); err != nil {
+					return fmt.Errorf("failed to add sse_output_dialects_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "sse_output_dialects_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "sse_output_dialects_json"); err != nil {
+					return fmt.Errorf("failed to drop sse_output_dialects_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running sse_output_dialects_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddResponseEnvelopesJSONColumn adds the response_envelopes_json column to the config_client table
+func migrationAddResponseEnvelopesJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_response_envelopes_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "response_envelopes_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "ResponseEnvelopesJSON"); err != nil {
+					return fmt.Errorf("failed to add response_envelopes_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "response_envelopes_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "response_envelopes_json"); err != nil {
+					return fmt.Errorf("failed to drop response_envelopes_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running response_envelopes_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddLoadSheddingJSONColumn adds the load_shedding_json column to the config_client table
+func migrationAddLoadSheddingJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_load_shedding_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "load_shedding_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "LoadSheddingJSON"); err != nil {
+					return fmt.Errorf("failed to add load_shedding_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "load_shedding_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "load_shedding_json"); err != nil {
+					return fmt.Errorf("failed to drop load_shedding_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running load_shedding_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddSchemaDriftJSONColumn adds the schema_drift_json column to the config_client table
+func migrationAddSchemaDriftJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_schema_drift_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "schema_drift_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "SchemaDriftJSON"); err != nil {
+					return fmt.Errorf("failed to add schema_drift_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "schema_drift_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "schema_drift_json"); err != nil {
+					return fmt.Errorf("failed to drop schema_drift_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running schema_drift_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddCircuitBreakerJSONColumn adds the circuit_breaker_json column to the config_client table
+func migrationAddCircuitBreakerJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_circuit_breaker_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "circuit_breaker_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "CircuitBreakerJSON"); err != nil {
+					return fmt.Errorf("failed to add circuit_breaker_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "circuit_breaker_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "circuit_breaker_json"); err != nil {
+					return fmt.Errorf("failed to drop circuit_breaker_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running circuit_breaker_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddLatencyRoutingJSONColumn adds the latency_routing_json column to the config_client table
+func migrationAddLatencyRoutingJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_latency_routing_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "latency_routing_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "LatencyRoutingJSON"); err != nil {
+					return fmt.Errorf("failed to add latency_routing_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "latency_routing_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "latency_routing_json"); err != nil {
+					return fmt.Errorf("failed to drop latency_routing_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running latency_routing_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddCostRoutingJSONColumn adds the cost_routing_json column to the config_client table
+func migrationAddCostRoutingJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_cost_routing_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "cost_routing_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "CostRoutingJSON"); err != nil {
+					return fmt.Errorf("failed to add cost_routing_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "cost_routing_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "cost_routing_json"); err != nil {
+					return fmt.Errorf("failed to drop cost_routing_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running cost_routing_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddVirtualModelsJSONColumn adds the virtual_models_json column to the config_client table
+func migrationAddVirtualModelsJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_virtual_models_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "virtual_models_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "VirtualModelsJSON"); err != nil {
+					return fmt.Errorf("failed to add virtual_models_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "virtual_models_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "virtual_models_json"); err != nil {
+					return fmt.Errorf("failed to drop virtual_models_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running virtual_models_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddTrafficSplitsJSONColumn adds the traffic_splits_json column to the config_client table
+func migrationAddTrafficSplitsJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_traffic_splits_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "traffic_splits_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "TrafficSplitsJSON"); err != nil {
+					return fmt.Errorf("failed to add traffic_splits_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "traffic_splits_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "traffic_splits_json"); err != nil {
+					return fmt.Errorf("failed to drop traffic_splits_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running traffic_splits_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddParameterPresetsTable adds the config_parameter_presets table for named inference parameter presets
+func migrationAddParameterPresetsTable(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_parameter_presets_table",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasTable(&tables.TableParameterPreset{}) {
+				if err := migrator.CreateTable(&tables.TableParameterPreset{}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if err := migrator.DropTable(&tables.TableParameterPreset{}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error while running parameter_presets_table migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddGLMWebSearchJSONColumn adds the glm_web_search_json column to the config_keys table
+// for GLM's built-in web_search tool configuration
+func migrationAddGLMWebSearchJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_glm_web_search_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableKey{}, "glm_web_search_json") {
+				if err := migrator.AddColumn(&tables.TableKey{}, "GLMWebSearchJSON"); err != nil {
+					return fmt.Errorf("failed to add glm_web_search_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableKey{}, "glm_web_search_json") {
+				if err := migrator.DropColumn(&tables.TableKey{}, "glm_web_search_json"); err != nil {
+					return fmt.Errorf("failed to drop glm_web_search_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running glm_web_search_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddBudgetWindowColumns adds the sliding_window, previous_usage and downgrade_model columns
+// to the governance_budgets table
+func migrationAddBudgetWindowColumns(ctx context.Context, db *gorm.DB) error {
+	columns := []string{"sliding_window", "previous_usage", "downgrade_model"}
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_budget_window_columns",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			for _, column := range columns {
+				if !mg.HasColumn(&tables.TableBudget{}, column) {
+					if err := mg.AddColumn(&tables.TableBudget{}, column); err != nil {
+						return fmt.Errorf("failed to add %s column: %w", column, err)
+					}
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			mg := tx.Migrator()
+			for _, column := range columns {
+				if mg.HasColumn(&tables.TableBudget{}, column) {
+					if err := mg.DropColumn(&tables.TableBudget{}, column); err != nil {
+						return fmt.Errorf("failed to drop %s column: %w", column, err)
+					}
+				}
+			}
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error while running budget window columns migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddSingleFlightJSONColumn adds the single_flight_json column to the config_client table
+func migrationAddSingleFlightJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_single_flight_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "single_flight_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "SingleFlightJSON"); err != nil {
+					return fmt.Errorf("failed to add single_flight_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "single_flight_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "single_flight_json"); err != nil {
+					return fmt.Errorf("failed to drop single_flight_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running single_flight_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddToolExecutionJSONColumn adds the tool_execution_json column to the config_client table
+func migrationAddToolExecutionJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_tool_execution_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "tool_execution_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "ToolExecutionJSON"); err != nil {
+					return fmt.Errorf("failed to add tool_execution_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "tool_execution_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "tool_execution_json"); err != nil {
+					return fmt.Errorf("failed to drop tool_execution_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running tool_execution_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddStreamUsageJSONColumn adds the stream_usage_json column to the config_client table
+func migrationAddStreamUsageJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_stream_usage_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "stream_usage_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "StreamUsageJSON"); err != nil {
+					return fmt.Errorf("failed to add stream_usage_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "stream_usage_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "stream_usage_json"); err != nil {
+					return fmt.Errorf("failed to drop stream_usage_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running stream_usage_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddHeaderPolicyJSONColumn adds the header_policy_json column to the config_client table
+func migrationAddHeaderPolicyJSONColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_header_policy_json_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "header_policy_json") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "HeaderPolicyJSON"); err != nil {
+					return fmt.Errorf("failed to add header_policy_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "header_policy_json") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "header_policy_json"); err != nil {
+					return fmt.Errorf("failed to drop header_policy_json column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running header_policy_json migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddVirtualKeyRoleColumn adds the role column to the virtual keys table
+func migrationAddVirtualKeyRoleColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_virtual_key_role_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableVirtualKey{}, "role") {
+				if err := migrator.AddColumn(&tables.TableVirtualKey{}, "Role"); err != nil {
+					return fmt.Errorf("failed to add role column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableVirtualKey{}, "role") {
+				if err := migrator.DropColumn(&tables.TableVirtualKey{}, "role"); err != nil {
+					return fmt.Errorf("failed to drop role column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running virtual key role migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddAuditLogsTable adds the audit_logs table recording management API changes
+func migrationAddAuditLogsTable(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_audit_logs_table",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasTable(&tables.TableAuditLog{}) {
+				if err := migrator.CreateTable(&tables.TableAuditLog{}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if err := migrator.DropTable(&tables.TableAuditLog{}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error while running audit_logs_table migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddWebhookDeadLettersTable adds the webhook_dead_letters table keeping undelivered webhook events
+func migrationAddWebhookDeadLettersTable(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_webhook_dead_letters_table",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasTable(&tables.TableWebhookDeadLetter{}) {
+				if err := migrator.CreateTable(&tables.TableWebhookDeadLetter{}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if err := migrator.DropTable(&tables.TableWebhookDeadLetter{}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error while running webhook_dead_letters_table migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddSSEKeepAliveIntervalColumn adds the sse_keep_alive_interval column to the config_client table
+func migrationAddSSEKeepAliveIntervalColumn(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_sse_keep_alive_interval_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasColumn(&tables.TableClientConfig{}, "sse_keep_alive_interval") {
+				if err := migrator.AddColumn(&tables.TableClientConfig{}, "SSEKeepAliveInterval"); err != nil {
+					return fmt.Errorf("failed to add sse_keep_alive_interval column: %w", err)
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if migrator.HasColumn(&tables.TableClientConfig{}, "sse_keep_alive_interval") {
+				if err := migrator.DropColumn(&tables.TableClientConfig{}, "sse_keep_alive_interval"); err != nil {
+					return fmt.Errorf("failed to drop sse_keep_alive_interval column: %w", err)
+				}
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error running sse_keep_alive_interval migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddEncryptionKeysTable adds the config_encryption_keys table holding the wrapped data keys
+func migrationAddEncryptionKeysTable(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_encryption_keys_table",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasTable(&tables.TableEncryptionKey{}) {
+				if err := migrator.CreateTable(&tables.TableEncryptionKey{}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if err := migrator.DropTable(&tables.TableEncryptionKey{}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error while running encryption_keys_table migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddPricingOverridesTable adds the config_pricing_overrides table for custom pricing overrides
+func migrationAddPricingOverridesTable(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "add_pricing_overrides_table",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if !migrator.HasTable(&tables.TablePricingOverride{}) {
+				if err := migrator.CreateTable(&tables.TablePricingOverride{}); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+
+			if err := migrator.DropTable(&tables.TablePricingOverride{}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error while running pricing_overrides_table migration: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationDropVirtualKeyValueUniqueIndex drops the unique index on governance_virtual_keys.value.
+// The column now holds a masked hint of the value, which several keys can share; uniqueness is
+// enforced by the index on value_hash.
+func migrationDropVirtualKeyValueUniqueIndex(ctx context.Context, db *gorm.DB) error {
+	m := migrator.New(db, migrator.DefaultOptions, []*migrator.Migration{{
+		ID: "drop_virtual_key_value_unique_index",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			return dropIndexIfExists(tx, "governance_virtual_keys", "idx_virtual_key_value")
+		},
+		Rollback: func(tx *gorm.DB) error {
+			// The masked hints left in the column are not unique, so the index is not restored
+			return nil
+		},
+	}})
+	if err := m.Migrate(); err != nil {
+		return fmt.Errorf("error while running drop_virtual_key_value_unique_index migration: %s", err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package configstore
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// defaultMySQLConnMaxLifetime recycles connections before MySQL's wait_timeout or a proxy in
+// front of it closes them, which would otherwise surface as "invalid connection" errors.
+const defaultMySQLConnMaxLifetime = 3 * time.Minute
+
+// MySQLConfig represents the configuration for a MySQL (5.7.5+/8.x) or MariaDB database.
+type MySQLConfig struct {
+	Host         *schemas.EnvVar `json:"host"`
+	Port         *schemas.EnvVar `json:"port"`
+	User         *schemas.EnvVar `json:"user"`
+	Password     *schemas.EnvVar `json:"password"`
+	DBName       *schemas.EnvVar `json:"db_name"`
+	TLS          *schemas.EnvVar `json:"tls,omitempty"` // true, false, skip-verify or preferred. Default is false.
+	MaxIdleConns int             `json:"max_idle_conns"`
+	MaxOpenConns int             `json:"max_open_conns"`
+	// ConnMaxLifetimeSeconds closes connections after this many seconds. Default is 3 minutes.
+	ConnMaxLifetimeSeconds int `json:"conn_max_lifetime_seconds,omitempty"`
+	// ConnMaxIdleTimeSeconds closes connections idle for this many seconds. 0 keeps them open.
+	ConnMaxIdleTimeSeconds int `json:"conn_max_idle_time_seconds,omitempty"`
+}
+
+// dsn builds the driver DSN. Times are parsed into time.Time in UTC, and utf8mb4 stores any
+// unicode text.
+func (c *MySQLConfig) dsn() string {
+	cfg := mysqldriver.NewConfig()
+	cfg.User = c.User.GetValue()
+	cfg.Passwd = c.Password.GetValue()
+	cfg.Net = "tcp"
+	cfg.Addr = net.JoinHostPort(c.Host.GetValue(), c.Port.GetValue())
+	cfg.DBName = c.DBName.GetValue()
+	cfg.ParseTime = true
+	cfg.Loc = time.UTC
+	cfg.Params = map[string]string{"charset": "utf8mb4"}
+	if c.TLS != nil && c.TLS.GetValue() != "" {
+		cfg.TLSConfig = c.TLS.GetValue()
+	}
+	return cfg.FormatDSN()
+}
+
+// newMySQLConfigStore creates a new MySQL config store.
+func newMySQLConfigStore(ctx context.Context, config *MySQLConfig, logger schemas.Logger) (ConfigStore, error) {
+	if config == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+	// Validate required config
+	if config.Host == nil || config.Host.GetValue() == "" {
+		return nil, fmt.Errorf("mysql host is required")
+	}
+	if config.Port == nil || config.Port.GetValue() == "" {
+		return nil, fmt.Errorf("mysql port is required")
+	}
+	if config.User == nil || config.User.GetValue() == "" {
+		return nil, fmt.Errorf("mysql user is required")
+	}
+	if config.Password == nil {
+		return nil, fmt.Errorf("mysql password is required")
+	}
+	if config.DBName == nil || config.DBName.GetValue() == "" {
+		return nil, fmt.Errorf("mysql db name is required")
+	}
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		DSN: config.dsn(),
+	}), &gorm.Config{
+		Logger: newGormLogger(logger),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Configure connection pool
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	connMaxLifetime := defaultMySQLConnMaxLifetime
+	if config.ConnMaxLifetimeSeconds > 0 {
+		connMaxLifetime = time.Duration(config.ConnMaxLifetimeSeconds) * time.Second
+	}
+	connectionPoolConfig{
+		maxIdleConns:    config.MaxIdleConns,
+		maxOpenConns:    config.MaxOpenConns,
+		connMaxLifetime: connMaxLifetime,
+		connMaxIdleTime: time.Duration(config.ConnMaxIdleTimeSeconds) * time.Second,
+	}.configure(sqlDB)
+
+	d := &RDBConfigStore{db: db, logger: logger}
+	if err := d.initialize(ctx); err != nil {
+		// Closing the DB connection
+		if closeErr := sqlDB.Close(); closeErr != nil {
+			logger.Error("failed to close DB connection: %v", closeErr)
+		}
+		return nil, err
+	}
+	return d, nil
+}
@@ -0,0 +1,112 @@
+package configstore
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore/tables"
+	mysqldriver "github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestMySQLConfig_DSN(t *testing.T) {
+	config := &MySQLConfig{
+		Host:     schemas.NewEnvVar("db.internal"),
+		Port:     schemas.NewEnvVar("3306"),
+		User:     schemas.NewEnvVar("bifrost"),
+		Password: schemas.NewEnvVar("p@ss:word"),
+		DBName:   schemas.NewEnvVar("bifrost"),
+		TLS:      schemas.NewEnvVar("skip-verify"),
+	}
+
+	parsed, err := mysqldriver.ParseDSN(config.dsn())
+	require.NoError(t, err)
+	assert.Equal(t, "bifrost", parsed.User)
+	assert.Equal(t, "p@ss:word", parsed.Passwd)
+	assert.Equal(t, "tcp", parsed.Net)
+	assert.Equal(t, "db.internal:3306", parsed.Addr)
+	assert.Equal(t, "bifrost", parsed.DBName)
+	assert.Equal(t, "skip-verify", parsed.TLSConfig)
+	assert.True(t, parsed.ParseTime)
+	assert.Equal(t, time.UTC, parsed.Loc)
+	assert.Equal(t, "utf8mb4", parsed.Params["charset"])
+}
+
+func TestMySQLConfig_DSNWithoutTLS(t *testing.T) {
+	config := &MySQLConfig{
+		Host:     schemas.NewEnvVar("::1"),
+		Port:     schemas.NewEnvVar("3306"),
+		User:     schemas.NewEnvVar("root"),
+		Password: schemas.NewEnvVar(""),
+		DBName:   schemas.NewEnvVar("bifrost"),
+	}
+
+	parsed, err := mysqldriver.ParseDSN(config.dsn())
+	require.NoError(t, err)
+	assert.Equal(t, "[::1]:3306", parsed.Addr)
+	assert.Empty(t, parsed.TLSConfig)
+}
+
+func TestConfig_UnmarshalMySQL(t *testing.T) {
+	data := []byte(`{
+		"enabled": true,
+		"type": "mysql",
+		"config": {
+			"host": "localhost",
+			"port": "3306",
+			"user": "bifrost",
+			"password": "secret",
+			"db_name": "bifrost",
+			"max_open_conns": 20,
+			"conn_max_lifetime_seconds": 60
+		}
+	}`)
+
+	var config Config
+	require.NoError(t, json.Unmarshal(data, &config))
+	assert.Equal(t, ConfigStoreTypeMySQL, config.Type)
+	mysqlConfig, ok := config.Config.(*MySQLConfig)
+	require.True(t, ok, "expected *MySQLConfig, got %T", config.Config)
+	assert.Equal(t, "localhost", mysqlConfig.Host.GetValue())
+	assert.Equal(t, "bifrost", mysqlConfig.DBName.GetValue())
+	assert.Equal(t, 20, mysqlConfig.MaxOpenConns)
+	assert.Equal(t, 60, mysqlConfig.ConnMaxLifetimeSeconds)
+}
+
+func TestNewMySQLConfigStore_RequiresConfig(t *testing.T) {
+	_, err := newMySQLConfigStore(t.Context(), &MySQLConfig{Port: schemas.NewEnvVar("3306")}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "mysql host is required")
+}
+
+func TestConnectionPoolConfig_Defaults(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	defer sqlDB.Close()
+
+	connectionPoolConfig{}.configure(sqlDB)
+	assert.Equal(t, defaultMaxOpenConns, sqlDB.Stats().MaxOpenConnections)
+
+	connectionPoolConfig{maxOpenConns: 7, connMaxLifetime: time.Minute}.configure(sqlDB)
+	assert.Equal(t, 7, sqlDB.Stats().MaxOpenConnections)
+}
+
+func TestCreateAndDropIndexIfExists(t *testing.T) {
+	db := setupTestDB(t)
+
+	// Creating twice is a no-op the second time
+	require.NoError(t, createIndexIfNotExists(db, "config_mcp_clients", "idx_test_mcp_name", "name, client_id", true))
+	require.NoError(t, createIndexIfNotExists(db, "config_mcp_clients", "idx_test_mcp_name", "name, client_id", true))
+	assert.True(t, db.Migrator().HasIndex(&tables.TableMCPClient{}, "idx_test_mcp_name"))
+
+	require.NoError(t, dropIndexIfExists(db, "config_mcp_clients", "idx_test_mcp_name"))
+	require.NoError(t, dropIndexIfExists(db, "config_mcp_clients", "idx_test_mcp_name"))
+	assert.False(t, db.Migrator().HasIndex(&tables.TableMCPClient{}, "idx_test_mcp_name"))
+}
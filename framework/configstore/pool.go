@@ -0,0 +1,37 @@
+package configstore
+
+import (
+	"database/sql"
+	"time"
+)
+
+// Connection pool defaults of the server-backed config stores.
+const (
+	defaultMaxIdleConns = 5
+	defaultMaxOpenConns = 50
+)
+
+// connectionPoolConfig holds the connection pool settings of a server-backed config store.
+type connectionPoolConfig struct {
+	maxIdleConns    int
+	maxOpenConns    int
+	connMaxLifetime time.Duration
+	connMaxIdleTime time.Duration
+}
+
+// configure applies the pool settings to sqlDB, falling back to the defaults for unset counts.
+// Zero durations keep connections open indefinitely.
+func (c connectionPoolConfig) configure(sqlDB *sql.DB) {
+	maxIdleConns := c.maxIdleConns
+	if maxIdleConns == 0 {
+		maxIdleConns = defaultMaxIdleConns
+	}
+	sqlDB.SetMaxIdleConns(maxIdleConns)
+	maxOpenConns := c.maxOpenConns
+	if maxOpenConns == 0 {
+		maxOpenConns = defaultMaxOpenConns
+	}
+	sqlDB.SetMaxOpenConns(maxOpenConns)
+	sqlDB.SetConnMaxLifetime(c.connMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(c.connMaxIdleTime)
+}
@@ -3,6 +3,7 @@ package configstore
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/capsohq/bifrost/core/schemas"
 	"gorm.io/driver/postgres"
@@ -19,6 +20,11 @@ type PostgresConfig struct {
 	SSLMode      *schemas.EnvVar `json:"ssl_mode"`
 	MaxIdleConns int             `json:"max_idle_conns"`
 	MaxOpenConns int             `json:"max_open_conns"`
+	// ConnMaxLifetimeSeconds closes connections after this many seconds, e.g. to follow failovers
+	// behind a load balancer or a connection pooler. 0 keeps them open.
+	ConnMaxLifetimeSeconds int `json:"conn_max_lifetime_seconds,omitempty"`
+	// ConnMaxIdleTimeSeconds closes connections idle for this many seconds. 0 keeps them open.
+	ConnMaxIdleTimeSeconds int `json:"conn_max_idle_time_seconds,omitempty"`
 }
 
 // newPostgresConfigStore creates a new Postgres config store.
@@ -60,39 +66,20 @@ func newPostgresConfigStore(ctx context.Context, config *PostgresConfig, logger
 	if err != nil {
 		return nil, err
 	}
-	// Set MaxIdleConns (default: 5)
-	maxIdleConns := config.MaxIdleConns
-	if maxIdleConns == 0 {
-		maxIdleConns = 5
-	}
-	sqlDB.SetMaxIdleConns(maxIdleConns)
-
-	// Set MaxOpenConns (default: 50)
-	maxOpenConns := config.MaxOpenConns
-	if maxOpenConns == 0 {
-		maxOpenConns = 50
-	}
-	sqlDB.SetMaxOpenConns(maxOpenConns)
+	connectionPoolConfig{
+		maxIdleConns:    config.MaxIdleConns,
+		maxOpenConns:    config.MaxOpenConns,
+		connMaxLifetime: time.Duration(config.ConnMaxLifetimeSeconds) * time.Second,
+		connMaxIdleTime: time.Duration(config.ConnMaxIdleTimeSeconds) * time.Second,
+	}.configure(sqlDB)
 
 	d := &RDBConfigStore{db: db, logger: logger}
-	// Run migrations
-	if err := triggerMigrations(ctx, db); err != nil {
+	if err := d.initialize(ctx); err != nil {
 		// Closing the DB connection
-		if sqlDB, dbErr := db.DB(); dbErr == nil {
-			if closeErr := sqlDB.Close(); closeErr != nil {
-				logger.Error("failed to close DB connection: %v", closeErr)
-			}
+		if closeErr := sqlDB.Close(); closeErr != nil {
+			logger.Error("failed to close DB connection: %v", closeErr)
 		}
 		return nil, err
 	}
-	// Encrypt any plaintext rows if encryption is enabled
-	if err := d.EncryptPlaintextRows(ctx); err != nil {
-		if sqlDB, dbErr := db.DB(); dbErr == nil {
-			if closeErr := sqlDB.Close(); closeErr != nil {
-				logger.Error("failed to close DB connection: %v", closeErr)
-			}
-		}
-		return nil, fmt.Errorf("failed to encrypt plaintext rows: %w", err)
-	}
 	return d, nil
 }
@@ -0,0 +1,45 @@
+package configstore
+
+import "fmt"
+
+// Role is the access level of a caller on the management API.
+// Dashboard sessions and the admin credentials always act as RoleAdmin.
+type Role string
+
+const (
+	// RoleAdmin has full access, including config, auth and governance management.
+	RoleAdmin Role = "admin"
+	// RoleOperator can read everything and manage providers, keys, MCP clients, plugins and presets.
+	RoleOperator Role = "operator"
+	// RoleViewer has read-only access.
+	RoleViewer Role = "viewer"
+)
+
+// roleRanks orders roles from the least to the most privileged.
+var roleRanks = map[Role]int{
+	RoleViewer:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// ParseRole validates a role name.
+func ParseRole(value string) (Role, error) {
+	role := Role(value)
+	if _, ok := roleRanks[role]; !ok {
+		return "", fmt.Errorf("invalid role %q, must be one of admin, operator or viewer", value)
+	}
+	return role, nil
+}
+
+// HighestRole returns the most privileged of the given role names, ignoring unknown ones.
+// It returns false if none of them is a role.
+func HighestRole(values []string) (Role, bool) {
+	var highest Role
+	for _, value := range values {
+		role := Role(value)
+		if roleRanks[role] > roleRanks[highest] {
+			highest = role
+		}
+	}
+	return highest, highest != ""
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/capsohq/bifrost/framework/logstore"
 	"github.com/capsohq/bifrost/framework/migrator"
 	"github.com/capsohq/bifrost/framework/vectorstore"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 )
@@ -58,8 +59,22 @@ func (s *RDBConfigStore) UpdateClientConfig(ctx context.Context, config *ClientC
 		MCPCodeModeBindingLevel:         config.MCPCodeModeBindingLevel,
 		MCPToolSyncInterval:             config.MCPToolSyncInterval,
 		AsyncJobResultTTL:               config.AsyncJobResultTTL,
+		SSEKeepAliveInterval:            config.SSEKeepAliveInterval,
 		RequiredHeaders:                 config.RequiredHeaders,
 		LoggingHeaders:                  config.LoggingHeaders,
+		SSEOutputDialects:               config.SSEOutputDialects,
+		ResponseEnvelopes:               config.ResponseEnvelopes,
+		LoadShedding:                    config.LoadShedding,
+		SchemaDrift:                     config.SchemaDrift,
+		CircuitBreaker:                  config.CircuitBreaker,
+		LatencyRouting:                  config.LatencyRouting,
+		CostRouting:                     config.CostRouting,
+		VirtualModels:                   config.VirtualModels,
+		TrafficSplits:                   config.TrafficSplits,
+		SingleFlight:                    config.SingleFlight,
+		ToolExecution:                   config.ToolExecution,
+		StreamUsage:                     config.StreamUsage,
+		HeaderPolicy:                    config.HeaderPolicy,
 		HideDeletedVirtualKeysInFilters: config.HideDeletedVirtualKeysInFilters,
 		HeaderFilterConfig:              config.HeaderFilterConfig,
 		ConfigHash:                      config.ConfigHash,
@@ -73,6 +88,24 @@ func (s *RDBConfigStore) UpdateClientConfig(ctx context.Context, config *ClientC
 	})
 }
 
+// initialize runs the migrations, loads the data keys encrypted rows are written with and encrypts
+// any plaintext rows if encryption is enabled. Every backend calls it once its database is open.
+func (s *RDBConfigStore) initialize(ctx context.Context) error {
+	if err := triggerMigrations(ctx, s.db); err != nil {
+		return err
+	}
+	if err := s.initDataKeys(ctx); err != nil {
+		return fmt.Errorf("failed to initialize encryption keys: %w", err)
+	}
+	if _, err := s.hashVirtualKeyValues(ctx); err != nil {
+		return fmt.Errorf("failed to hash virtual key values: %w", err)
+	}
+	if err := s.EncryptPlaintextRows(ctx); err != nil {
+		return fmt.Errorf("failed to encrypt plaintext rows: %w", err)
+	}
+	return nil
+}
+
 // Ping checks if the database is reachable.
 func (s *RDBConfigStore) Ping(ctx context.Context) error {
 	return s.db.WithContext(ctx).Exec("SELECT 1").Error
@@ -224,8 +257,22 @@ func (s *RDBConfigStore) GetClientConfig(ctx context.Context) (*ClientConfig, er
 		MCPCodeModeBindingLevel:         dbConfig.MCPCodeModeBindingLevel,
 		MCPToolSyncInterval:             dbConfig.MCPToolSyncInterval,
 		AsyncJobResultTTL:               dbConfig.AsyncJobResultTTL,
+		SSEKeepAliveInterval:            dbConfig.SSEKeepAliveInterval,
 		RequiredHeaders:                 dbConfig.RequiredHeaders,
 		LoggingHeaders:                  dbConfig.LoggingHeaders,
+		SSEOutputDialects:               dbConfig.SSEOutputDialects,
+		ResponseEnvelopes:               dbConfig.ResponseEnvelopes,
+		LoadShedding:                    dbConfig.LoadShedding,
+		SchemaDrift:                     dbConfig.SchemaDrift,
+		CircuitBreaker:                  dbConfig.CircuitBreaker,
+		LatencyRouting:                  dbConfig.LatencyRouting,
+		CostRouting:                     dbConfig.CostRouting,
+		VirtualModels:                   dbConfig.VirtualModels,
+		TrafficSplits:                   dbConfig.TrafficSplits,
+		SingleFlight:                    dbConfig.SingleFlight,
+		ToolExecution:                   dbConfig.ToolExecution,
+		StreamUsage:                     dbConfig.StreamUsage,
+		HeaderPolicy:                    dbConfig.HeaderPolicy,
 		HideDeletedVirtualKeysInFilters: dbConfig.HideDeletedVirtualKeysInFilters,
 		HeaderFilterConfig:              dbConfig.HeaderFilterConfig,
 		ConfigHash:                      dbConfig.ConfigHash,
@@ -265,6 +312,12 @@ func (s *RDBConfigStore) UpdateProvidersConfig(ctx context.Context, providers ma
 		).Create(&dbProvider).Error; err != nil {
 			return s.parseGormError(err)
 		}
+		// MySQL has no RETURNING, and ON DUPLICATE KEY UPDATE does not report the id of an updated row
+		if txDB.Dialector.Name() == "mysql" {
+			if err := txDB.WithContext(ctx).Model(&tables.TableProvider{}).Where("name = ?", dbProvider.Name).Select("id").Row().Scan(&dbProvider.ID); err != nil {
+				return s.parseGormError(err)
+			}
+		}
 
 		// Create keys for this provider
 		dbKeys := make([]tables.TableKey, 0, len(providerConfig.Keys))
@@ -294,6 +347,8 @@ func (s *RDBConfigStore) UpdateProvidersConfig(ctx context.Context, providers ma
 				BedrockKeyConfig:   key.BedrockKeyConfig,
 				ReplicateKeyConfig: key.ReplicateKeyConfig,
 				VLLMKeyConfig:      key.VLLMKeyConfig,
+				HunyuanKeyConfig:   key.HunyuanKeyConfig,
+				GLMKeyConfig:       key.GLMKeyConfig,
 				ConfigHash:         keyHash,
 				Status:             string(key.Status),
 				Description:        key.Description,
@@ -461,6 +516,8 @@ func (s *RDBConfigStore) UpdateProvider(ctx context.Context, provider schemas.Mo
 			BedrockKeyConfig:   key.BedrockKeyConfig,
 			ReplicateKeyConfig: key.ReplicateKeyConfig,
 			VLLMKeyConfig:      key.VLLMKeyConfig,
+			HunyuanKeyConfig:   key.HunyuanKeyConfig,
+			GLMKeyConfig:       key.GLMKeyConfig,
 			ConfigHash:         keyHash,
 			Status:             string(key.Status),
 			Description:        key.Description,
@@ -581,6 +638,8 @@ func (s *RDBConfigStore) AddProvider(ctx context.Context, provider schemas.Model
 			BedrockKeyConfig:   key.BedrockKeyConfig,
 			ReplicateKeyConfig: key.ReplicateKeyConfig,
 			VLLMKeyConfig:      key.VLLMKeyConfig,
+			HunyuanKeyConfig:   key.HunyuanKeyConfig,
+			GLMKeyConfig:       key.GLMKeyConfig,
 			ConfigHash:         key.ConfigHash,
 			Status:             string(key.Status),
 			Description:        key.Description,
@@ -702,6 +761,8 @@ func (s *RDBConfigStore) GetProvidersConfig(ctx context.Context) (map[schemas.Mo
 				BedrockKeyConfig:   dbKey.BedrockKeyConfig,
 				ReplicateKeyConfig: dbKey.ReplicateKeyConfig,
 				VLLMKeyConfig:      dbKey.VLLMKeyConfig,
+				HunyuanKeyConfig:   dbKey.HunyuanKeyConfig,
+				GLMKeyConfig:       dbKey.GLMKeyConfig,
 				ConfigHash:         dbKey.ConfigHash,
 				Status:             schemas.KeyStatusType(dbKey.Status),
 				Description:        dbKey.Description,
@@ -750,6 +811,8 @@ func (s *RDBConfigStore) GetProviderConfig(ctx context.Context, provider schemas
 			BedrockKeyConfig:   dbKey.BedrockKeyConfig,
 			ReplicateKeyConfig: dbKey.ReplicateKeyConfig,
 			VLLMKeyConfig:      dbKey.VLLMKeyConfig,
+			HunyuanKeyConfig:   dbKey.HunyuanKeyConfig,
+			GLMKeyConfig:       dbKey.GLMKeyConfig,
 			ConfigHash:         dbKey.ConfigHash,
 			Status:             schemas.KeyStatusType(dbKey.Status),
 			Description:        dbKey.Description,
@@ -1345,9 +1408,10 @@ func (s *RDBConfigStore) ReplaceProviderModelNames(ctx context.Context, provider
 
 // PLUGINS METHODS
 
+// GetPlugins returns the plugins in the order they were added, which is the order custom plugins are loaded and run in.
 func (s *RDBConfigStore) GetPlugins(ctx context.Context) ([]*tables.TablePlugin, error) {
 	var plugins []*tables.TablePlugin
-	if err := s.db.WithContext(ctx).Find(&plugins).Error; err != nil {
+	if err := s.db.WithContext(ctx).Order("id ASC").Find(&plugins).Error; err != nil {
 		return nil, err
 	}
 	return plugins, nil
@@ -1544,7 +1608,7 @@ func (s *RDBConfigStore) GetVirtualKey(ctx context.Context, id string) (*tables.
 
 // GetVirtualKeyByValue retrieves a virtual key by its value using hash-based lookup.
 func (s *RDBConfigStore) GetVirtualKeyByValue(ctx context.Context, value string) (*tables.TableVirtualKey, error) {
-	valueHash := encrypt.HashSHA256(value)
+	valueHash := tables.HashVirtualKeyValue(value)
 	var virtualKey tables.TableVirtualKey
 	query := s.db.WithContext(ctx).
 		Preload("Team").
@@ -1561,19 +1625,12 @@ func (s *RDBConfigStore) GetVirtualKeyByValue(ctx context.Context, value string)
 		Preload("MCPConfigs").
 		Preload("MCPConfigs.MCPClient")
 
-	// Use hash-based lookup if hash column is populated, fall back to plaintext for backward compat
+	// Only the hash of the value is stored
 	if err := query.Where("value_hash = ?", valueHash).First(&virtualKey).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// Fallback: try plaintext lookup for rows not yet migrated
-			if err := query.Where("value = ?", value).First(&virtualKey).Error; err != nil {
-				if errors.Is(err, gorm.ErrRecordNotFound) {
-					return nil, ErrNotFound
-				}
-				return nil, err
-			}
-		} else {
-			return nil, err
+			return nil, ErrNotFound
 		}
+		return nil, err
 	}
 	return &virtualKey, nil
 }
@@ -2569,6 +2626,130 @@ func (s *RDBConfigStore) DeleteRoutingRule(ctx context.Context, id string, tx ..
 	return nil
 }
 
+// GetParameterPresets retrieves all parameter presets from the database.
+func (s *RDBConfigStore) GetParameterPresets(ctx context.Context) ([]tables.TableParameterPreset, error) {
+	var presets []tables.TableParameterPreset
+	if err := s.db.WithContext(ctx).Order("name ASC").Find(&presets).Error; err != nil {
+		return nil, err
+	}
+	return presets, nil
+}
+
+// GetParameterPreset retrieves a specific parameter preset by name.
+func (s *RDBConfigStore) GetParameterPreset(ctx context.Context, name string) (*tables.TableParameterPreset, error) {
+	var preset tables.TableParameterPreset
+	if err := s.db.WithContext(ctx).Where("name = ?", name).First(&preset).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &preset, nil
+}
+
+// CreateParameterPreset creates a new parameter preset in the database.
+func (s *RDBConfigStore) CreateParameterPreset(ctx context.Context, preset *tables.TableParameterPreset, tx ...*gorm.DB) error {
+	database := s.db
+	if len(tx) > 0 && tx[0] != nil {
+		database = tx[0]
+	}
+	if err := database.WithContext(ctx).Create(preset).Error; err != nil {
+		return s.parseGormError(err)
+	}
+	return nil
+}
+
+// UpdateParameterPreset updates an existing parameter preset in the database.
+func (s *RDBConfigStore) UpdateParameterPreset(ctx context.Context, preset *tables.TableParameterPreset, tx ...*gorm.DB) error {
+	database := s.db
+	if len(tx) > 0 && tx[0] != nil {
+		database = tx[0]
+	}
+	if err := database.WithContext(ctx).Save(preset).Error; err != nil {
+		return s.parseGormError(err)
+	}
+	return nil
+}
+
+// DeleteParameterPreset deletes a parameter preset from the database.
+func (s *RDBConfigStore) DeleteParameterPreset(ctx context.Context, name string, tx ...*gorm.DB) error {
+	database := s.db
+	if len(tx) > 0 && tx[0] != nil {
+		database = tx[0]
+	}
+
+	result := database.WithContext(ctx).Delete(&tables.TableParameterPreset{}, "name = ?", name)
+	if result.Error != nil {
+		return s.parseGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// GetPricingOverrides retrieves all custom pricing overrides from the database, oldest first.
+func (s *RDBConfigStore) GetPricingOverrides(ctx context.Context) ([]tables.TablePricingOverride, error) {
+	var overrides []tables.TablePricingOverride
+	if err := s.db.WithContext(ctx).Order("created_at ASC, id ASC").Find(&overrides).Error; err != nil {
+		return nil, err
+	}
+	return overrides, nil
+}
+
+// GetPricingOverride retrieves a specific custom pricing override by ID.
+func (s *RDBConfigStore) GetPricingOverride(ctx context.Context, id string) (*tables.TablePricingOverride, error) {
+	var override tables.TablePricingOverride
+	if err := s.db.WithContext(ctx).Where("id = ?", id).First(&override).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &override, nil
+}
+
+// CreatePricingOverride creates a new custom pricing override in the database.
+func (s *RDBConfigStore) CreatePricingOverride(ctx context.Context, override *tables.TablePricingOverride, tx ...*gorm.DB) error {
+	database := s.db
+	if len(tx) > 0 && tx[0] != nil {
+		database = tx[0]
+	}
+	if err := database.WithContext(ctx).Create(override).Error; err != nil {
+		return s.parseGormError(err)
+	}
+	return nil
+}
+
+// UpdatePricingOverride updates an existing custom pricing override in the database.
+func (s *RDBConfigStore) UpdatePricingOverride(ctx context.Context, override *tables.TablePricingOverride, tx ...*gorm.DB) error {
+	database := s.db
+	if len(tx) > 0 && tx[0] != nil {
+		database = tx[0]
+	}
+	if err := database.WithContext(ctx).Save(override).Error; err != nil {
+		return s.parseGormError(err)
+	}
+	return nil
+}
+
+// DeletePricingOverride deletes a custom pricing override from the database.
+func (s *RDBConfigStore) DeletePricingOverride(ctx context.Context, id string, tx ...*gorm.DB) error {
+	database := s.db
+	if len(tx) > 0 && tx[0] != nil {
+		database = tx[0]
+	}
+
+	result := database.WithContext(ctx).Delete(&tables.TablePricingOverride{}, "id = ?", id)
+	if result.Error != nil {
+		return s.parseGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
 // GetModelConfigs retrieves all model configs from the database.
 func (s *RDBConfigStore) GetModelConfigs(ctx context.Context) ([]tables.TableModelConfig, error) {
 	var modelConfigs []tables.TableModelConfig
@@ -2745,6 +2926,7 @@ func (s *RDBConfigStore) GetGovernanceConfig(ctx context.Context) (*GovernanceCo
 		var password *string
 		var isEnabled bool
 		var disableAuthOnInference bool
+		var jwtAuthConfig *JWTAuthConfig
 		for _, entry := range governanceConfigs {
 			switch entry.Key {
 			case tables.ConfigAdminUsernameKey:
@@ -2755,6 +2937,12 @@ func (s *RDBConfigStore) GetGovernanceConfig(ctx context.Context) (*GovernanceCo
 				isEnabled = entry.Value == "true"
 			case tables.ConfigDisableAuthOnInferenceKey:
 				disableAuthOnInference = entry.Value == "true"
+			case tables.ConfigJWTAuthKey:
+				parsedJWTAuthConfig, err := parseJWTAuthConfig(entry.Value)
+				if err != nil {
+					return nil, err
+				}
+				jwtAuthConfig = parsedJWTAuthConfig
 			}
 		}
 		if username != nil && password != nil {
@@ -2763,6 +2951,7 @@ func (s *RDBConfigStore) GetGovernanceConfig(ctx context.Context) (*GovernanceCo
 				AdminPassword:          schemas.NewEnvVar(*password),
 				IsEnabled:              isEnabled,
 				DisableAuthOnInference: disableAuthOnInference,
+				JWT:                    jwtAuthConfig,
 			}
 		}
 	}
@@ -2806,6 +2995,16 @@ func (s *RDBConfigStore) GetAuthConfig(ctx context.Context) (*AuthConfig, error)
 			return nil, err
 		}
 	}
+	var jwtAuthEntry tables.TableGovernanceConfig
+	if err := s.db.WithContext(ctx).First(&jwtAuthEntry, "key = ?", tables.ConfigJWTAuthKey).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+	jwtAuthConfig, err := parseJWTAuthConfig(jwtAuthEntry.Value)
+	if err != nil {
+		return nil, err
+	}
 	if username == nil || password == nil {
 		return nil, nil
 	}
@@ -2814,6 +3013,7 @@ func (s *RDBConfigStore) GetAuthConfig(ctx context.Context) (*AuthConfig, error)
 		AdminPassword:          schemas.NewEnvVar(*password),
 		IsEnabled:              isEnabled,
 		DisableAuthOnInference: disableAuthOnInference,
+		JWT:                    jwtAuthConfig,
 	}, nil
 }
 
@@ -2844,10 +3044,170 @@ func (s *RDBConfigStore) UpdateAuthConfig(ctx context.Context, config *AuthConfi
 		}).Error; err != nil {
 			return err
 		}
+		jwtAuthValue, err := marshalJWTAuthConfig(config.JWT)
+		if err != nil {
+			return err
+		}
+		if err := tx.Save(&tables.TableGovernanceConfig{
+			Key:   tables.ConfigJWTAuthKey,
+			Value: jwtAuthValue,
+		}).Error; err != nil {
+			return err
+		}
 		return nil
 	})
 }
 
+// marshalJWTAuthConfig serializes the JWT auth config for the governance config table.
+// Env-sourced HMAC secrets keep their env reference, literal ones are encrypted.
+func marshalJWTAuthConfig(config *JWTAuthConfig) (string, error) {
+	if config == nil {
+		return "", nil
+	}
+	configCopy := *config
+	if config.HMACSecret != nil {
+		secret := *config.HMACSecret
+		if secret.FromEnv {
+			secret.Val = secret.EnvVar
+		} else {
+			encryptedSecret, err := encrypt.Encrypt(secret.Val)
+			if err != nil {
+				return "", fmt.Errorf("failed to encrypt jwt hmac secret: %w", err)
+			}
+			secret.Val = encryptedSecret
+		}
+		configCopy.HMACSecret = &secret
+	}
+	configJSON, err := json.Marshal(&configCopy)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal jwt auth config: %w", err)
+	}
+	return string(configJSON), nil
+}
+
+// parseJWTAuthConfig parses a JWT auth config stored by marshalJWTAuthConfig.
+func parseJWTAuthConfig(value string) (*JWTAuthConfig, error) {
+	if value == "" {
+		return nil, nil
+	}
+	var config JWTAuthConfig
+	if err := json.Unmarshal([]byte(value), &config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal jwt auth config: %w", err)
+	}
+	if config.HMACSecret != nil && !config.HMACSecret.FromEnv && config.HMACSecret.Val != "" {
+		decryptedSecret, err := encrypt.Decrypt(config.HMACSecret.Val)
+		if err != nil {
+			// The secret may have been stored in plaintext before encryption was enabled
+			if !errors.Is(err, encrypt.ErrEncryptionKeyNotInitialized) {
+				return nil, fmt.Errorf("failed to decrypt jwt hmac secret: %w", err)
+			}
+		} else {
+			config.HMACSecret.Val = decryptedSecret
+		}
+	}
+	return &config, nil
+}
+
+// CreateAuditLog writes an audit log entry.
+func (s *RDBConfigStore) CreateAuditLog(ctx context.Context, entry *tables.TableAuditLog) error {
+	if entry.ID == "" {
+		entry.ID = uuid.NewString()
+	}
+	if entry.Timestamp.IsZero() {
+		entry.Timestamp = time.Now().UTC()
+	}
+	if err := s.db.WithContext(ctx).Create(entry).Error; err != nil {
+		return s.parseGormError(err)
+	}
+	return nil
+}
+
+// GetAuditLogs retrieves a page of audit log entries matching the filters, newest first,
+// along with the total number of matching entries.
+func (s *RDBConfigStore) GetAuditLogs(ctx context.Context, filters AuditLogFilters, limit int, offset int) ([]tables.TableAuditLog, int64, error) {
+	query := s.db.WithContext(ctx).Model(&tables.TableAuditLog{})
+	if filters.Actor != "" {
+		query = query.Where("actor = ?", filters.Actor)
+	}
+	if filters.Action != "" {
+		query = query.Where("action = ?", filters.Action)
+	}
+	if filters.ResourceType != "" {
+		query = query.Where("resource_type = ?", filters.ResourceType)
+	}
+	if filters.ResourceID != "" {
+		query = query.Where("resource_id = ?", filters.ResourceID)
+	}
+	if filters.StartTime != nil {
+		query = query.Where("timestamp >= ?", *filters.StartTime)
+	}
+	if filters.EndTime != nil {
+		query = query.Where("timestamp <= ?", *filters.EndTime)
+	}
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var entries []tables.TableAuditLog
+	if err := query.Order("timestamp DESC").Limit(limit).Offset(offset).Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
+
+// CreateWebhookDeadLetter stores a webhook event that could not be delivered.
+func (s *RDBConfigStore) CreateWebhookDeadLetter(ctx context.Context, deadLetter *tables.TableWebhookDeadLetter) error {
+	if deadLetter.ID == "" {
+		deadLetter.ID = uuid.NewString()
+	}
+	if deadLetter.CreatedAt.IsZero() {
+		deadLetter.CreatedAt = time.Now().UTC()
+	}
+	if err := s.db.WithContext(ctx).Create(deadLetter).Error; err != nil {
+		return s.parseGormError(err)
+	}
+	return nil
+}
+
+// GetWebhookDeadLetters retrieves a page of undelivered webhook events, newest first, along with
+// the total number of them.
+func (s *RDBConfigStore) GetWebhookDeadLetters(ctx context.Context, limit int, offset int) ([]tables.TableWebhookDeadLetter, int64, error) {
+	query := s.db.WithContext(ctx).Model(&tables.TableWebhookDeadLetter{})
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var deadLetters []tables.TableWebhookDeadLetter
+	if err := query.Order("created_at DESC").Limit(limit).Offset(offset).Find(&deadLetters).Error; err != nil {
+		return nil, 0, err
+	}
+	return deadLetters, total, nil
+}
+
+// GetWebhookDeadLetter retrieves an undelivered webhook event by ID.
+func (s *RDBConfigStore) GetWebhookDeadLetter(ctx context.Context, id string) (*tables.TableWebhookDeadLetter, error) {
+	var deadLetter tables.TableWebhookDeadLetter
+	if err := s.db.WithContext(ctx).First(&deadLetter, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &deadLetter, nil
+}
+
+// DeleteWebhookDeadLetter deletes an undelivered webhook event by ID.
+func (s *RDBConfigStore) DeleteWebhookDeadLetter(ctx context.Context, id string) error {
+	result := s.db.WithContext(ctx).Delete(&tables.TableWebhookDeadLetter{}, "id = ?", id)
+	if result.Error != nil {
+		return s.parseGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
 // GetProxyConfig retrieves the proxy configuration from the database.
 func (s *RDBConfigStore) GetProxyConfig(ctx context.Context) (*tables.GlobalProxyConfig, error) {
 	var configEntry tables.TableGovernanceConfig
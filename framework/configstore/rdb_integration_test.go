@@ -0,0 +1,202 @@
+package configstore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/capsohq/bifrost/framework/migrator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// The integration tests below migrate a fresh database on a real MySQL or Postgres server. They run
+// only when the server is configured, e.g.:
+//
+//	BIFROST_TEST_MYSQL_HOST=localhost BIFROST_TEST_MYSQL_PASSWORD=secret go test -run Integration ./configstore
+//	BIFROST_TEST_POSTGRES_HOST=localhost BIFROST_TEST_POSTGRES_PASSWORD=secret go test -run Integration ./configstore
+//
+// The user needs permission to create and drop databases.
+
+const (
+	integrationTestTimeout = 2 * time.Minute
+	// integrationTestNodes is the number of stores migrating the same database concurrently
+	integrationTestNodes = 3
+)
+
+func getEnvWithDefault(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// freshDatabaseName returns a database name no earlier test run has used.
+func freshDatabaseName() string {
+	return fmt.Sprintf("bifrost_migrations_%d", time.Now().UnixNano())
+}
+
+// createTestDatabase creates dbName through the admin connection and drops it when the test ends.
+func createTestDatabase(t *testing.T, admin *gorm.DB, dbName string, dropSQL string) {
+	t.Helper()
+	require.NoError(t, admin.Exec("CREATE DATABASE "+dbName).Error)
+	t.Cleanup(func() {
+		if err := admin.Exec(dropSQL).Error; err != nil {
+			t.Logf("failed to drop test database %s: %v", dbName, err)
+		}
+		if sqlDB, err := admin.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+}
+
+// assertMigratesFreshDatabase opens integrationTestNodes stores on an empty database at once, as
+// nodes of a cluster starting together do, and checks that the migration lock lets all of them
+// migrate it exactly once.
+func assertMigratesFreshDatabase(t *testing.T, open func(ctx context.Context) (ConfigStore, error)) {
+	ctx, cancel := context.WithTimeout(context.Background(), integrationTestTimeout)
+	defer cancel()
+
+	stores := make([]ConfigStore, integrationTestNodes)
+	errs := make([]error, integrationTestNodes)
+	var wg sync.WaitGroup
+	for i := range integrationTestNodes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			stores[i], errs[i] = open(ctx)
+		}()
+	}
+	wg.Wait()
+	for i, store := range stores {
+		require.NoError(t, errs[i], "node %d failed to open the config store", i)
+		defer store.Close(context.Background())
+	}
+
+	db := stores[0].(*RDBConfigStore).DB()
+	for _, table := range []any{
+		&tables.TableProvider{},
+		&tables.TableKey{},
+		&tables.TableVirtualKey{},
+		&tables.TableMCPClient{},
+		&tables.TableWebhookDeadLetter{},
+		"governance_virtual_key_keys",
+	} {
+		assert.True(t, db.Migrator().HasTable(table), "expected table %v to exist", table)
+	}
+
+	// Every migration is recorded once, and migrating again is a no-op
+	var applied int64
+	require.NoError(t, db.Table(migrator.DefaultOptions.TableName).Count(&applied).Error)
+	require.Positive(t, applied)
+	var distinct int64
+	require.NoError(t, db.Table(migrator.DefaultOptions.TableName).Distinct("id").Count(&distinct).Error)
+	assert.Equal(t, applied, distinct, "a migration was recorded more than once")
+
+	require.NoError(t, triggerMigrations(ctx, db))
+	var reapplied int64
+	require.NoError(t, db.Table(migrator.DefaultOptions.TableName).Count(&reapplied).Error)
+	assert.Equal(t, applied, reapplied)
+
+	// The store is usable once migrated
+	require.NoError(t, stores[1].AddProvider(ctx, schemas.OpenAI, ProviderConfig{Keys: []schemas.Key{}}))
+	providers, err := stores[2].GetProvidersConfig(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, providers, schemas.OpenAI)
+}
+
+// assertMigrationLockIsExclusive checks that a second node waits for the migration lock until the
+// node holding it releases it.
+func assertMigrationLockIsExclusive(t *testing.T, db *gorm.DB) {
+	ctx, cancel := context.WithTimeout(context.Background(), integrationTestTimeout)
+	defer cancel()
+
+	lock, err := acquireMigrationLock(ctx, db)
+	require.NoError(t, err)
+	require.NotNil(t, lock.conn, "expected a lock held on a dedicated connection")
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, 500*time.Millisecond)
+	defer waitCancel()
+	_, err = acquireMigrationLock(waitCtx, db)
+	require.Error(t, err, "expected the second lock to wait while the first is held")
+
+	lock.release(ctx)
+	second, err := acquireMigrationLock(ctx, db)
+	require.NoError(t, err)
+	second.release(ctx)
+}
+
+func TestMySQLConfigStore_Integration(t *testing.T) {
+	host := os.Getenv("BIFROST_TEST_MYSQL_HOST")
+	if host == "" {
+		t.Skip("BIFROST_TEST_MYSQL_HOST not set, skipping MySQL integration test")
+	}
+	config := &MySQLConfig{
+		Host:     schemas.NewEnvVar(host),
+		Port:     schemas.NewEnvVar(getEnvWithDefault("BIFROST_TEST_MYSQL_PORT", "3306")),
+		User:     schemas.NewEnvVar(getEnvWithDefault("BIFROST_TEST_MYSQL_USER", "root")),
+		Password: schemas.NewEnvVar(os.Getenv("BIFROST_TEST_MYSQL_PASSWORD")),
+		DBName:   schemas.NewEnvVar(""),
+	}
+	admin, err := gorm.Open(mysql.Open(config.dsn()), &gorm.Config{})
+	require.NoError(t, err)
+	dbName := freshDatabaseName()
+	createTestDatabase(t, admin, dbName, "DROP DATABASE IF EXISTS "+dbName)
+	config.DBName = schemas.NewEnvVar(dbName)
+	logger := bifrost.NewDefaultLogger(schemas.LogLevelError)
+
+	t.Run("MigratesFreshDatabase", func(t *testing.T) {
+		assertMigratesFreshDatabase(t, func(ctx context.Context) (ConfigStore, error) {
+			return newMySQLConfigStore(ctx, config, logger)
+		})
+	})
+	t.Run("NamedLock", func(t *testing.T) {
+		store, err := newMySQLConfigStore(t.Context(), config, logger)
+		require.NoError(t, err)
+		defer store.Close(context.Background())
+		assertMigrationLockIsExclusive(t, store.(*RDBConfigStore).DB())
+	})
+}
+
+func TestPostgresConfigStore_Integration(t *testing.T) {
+	host := os.Getenv("BIFROST_TEST_POSTGRES_HOST")
+	if host == "" {
+		t.Skip("BIFROST_TEST_POSTGRES_HOST not set, skipping Postgres integration test")
+	}
+	config := &PostgresConfig{
+		Host:     schemas.NewEnvVar(host),
+		Port:     schemas.NewEnvVar(getEnvWithDefault("BIFROST_TEST_POSTGRES_PORT", "5432")),
+		User:     schemas.NewEnvVar(getEnvWithDefault("BIFROST_TEST_POSTGRES_USER", "postgres")),
+		Password: schemas.NewEnvVar(os.Getenv("BIFROST_TEST_POSTGRES_PASSWORD")),
+		DBName:   schemas.NewEnvVar(getEnvWithDefault("BIFROST_TEST_POSTGRES_DB_NAME", "postgres")),
+		SSLMode:  schemas.NewEnvVar(getEnvWithDefault("BIFROST_TEST_POSTGRES_SSL_MODE", "disable")),
+	}
+	adminDSN := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s", config.Host.GetValue(), config.Port.GetValue(), config.User.GetValue(), config.Password.GetValue(), config.DBName.GetValue(), config.SSLMode.GetValue())
+	admin, err := gorm.Open(postgres.Open(adminDSN), &gorm.Config{})
+	require.NoError(t, err)
+	dbName := freshDatabaseName()
+	createTestDatabase(t, admin, dbName, "DROP DATABASE IF EXISTS "+dbName+" WITH (FORCE)")
+	config.DBName = schemas.NewEnvVar(dbName)
+	logger := bifrost.NewDefaultLogger(schemas.LogLevelError)
+
+	t.Run("MigratesFreshDatabase", func(t *testing.T) {
+		assertMigratesFreshDatabase(t, func(ctx context.Context) (ConfigStore, error) {
+			return newPostgresConfigStore(ctx, config, logger)
+		})
+	})
+	t.Run("AdvisoryLock", func(t *testing.T) {
+		store, err := newPostgresConfigStore(t.Context(), config, logger)
+		require.NoError(t, err)
+		defer store.Close(context.Background())
+		assertMigrationLockIsExclusive(t, store.(*RDBConfigStore).DB())
+	})
+}
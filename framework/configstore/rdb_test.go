@@ -3,6 +3,7 @@ package configstore
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/capsohq/bifrost/core/schemas"
 	"github.com/capsohq/bifrost/framework/configstore/tables"
@@ -35,6 +36,9 @@ func setupRDBTestStore(t *testing.T) *RDBConfigStore {
 		&tables.TablePlugin{},
 		&tables.TableMCPClient{},
 		&tables.TableVirtualKeyMCPConfig{},
+		&tables.TableAuditLog{},
+		&tables.TableWebhookDeadLetter{},
+		&tables.TablePricingOverride{},
 	)
 	require.NoError(t, err, "Failed to migrate test database")
 
@@ -404,7 +408,8 @@ func TestCreateVirtualKey(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "vk-test", result.ID)
 	assert.Equal(t, "Test Virtual Key", result.Name)
-	assert.Equal(t, "vk-test-value-123", result.Value)
+	assert.Equal(t, "vk-tes****-123", result.Value)
+	assert.Equal(t, tables.HashVirtualKeyValue("vk-test-value-123"), result.ValueHash)
 	assert.True(t, result.IsActive)
 }
 
@@ -1042,3 +1047,114 @@ func TestRateLimitDurationFormats(t *testing.T) {
 		assert.NoError(t, err, "Duration %s should be valid", duration)
 	}
 }
+
+// =============================================================================
+// Audit Log Tests
+// =============================================================================
+
+func TestGetAuditLogs_FiltersAndPagination(t *testing.T) {
+	store := setupRDBTestStore(t)
+	ctx := context.Background()
+
+	start := time.Now().UTC().Add(-time.Hour)
+	entries := []*tables.TableAuditLog{
+		{Timestamp: start, Actor: "admin:admin", Action: tables.AuditActionCreate, ResourceType: "providers", ResourceID: "openai", NewValue: map[string]any{"name": "openai"}},
+		{Timestamp: start.Add(time.Minute), Actor: "user:user-1", Action: tables.AuditActionUpdate, ResourceType: "providers", ResourceID: "openai"},
+		{Timestamp: start.Add(2 * time.Minute), Actor: "admin:admin", Action: tables.AuditActionDelete, ResourceType: "governance/budgets", ResourceID: "budget-1"},
+	}
+	for _, entry := range entries {
+		require.NoError(t, store.CreateAuditLog(ctx, entry))
+		assert.NotEmpty(t, entry.ID)
+	}
+
+	// Newest first
+	logs, total, err := store.GetAuditLogs(ctx, AuditLogFilters{}, 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, logs, 2)
+	assert.Equal(t, tables.AuditActionDelete, logs[0].Action)
+	assert.Equal(t, tables.AuditActionUpdate, logs[1].Action)
+
+	logs, total, err = store.GetAuditLogs(ctx, AuditLogFilters{Actor: "admin:admin", ResourceType: "providers"}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, logs, 1)
+	assert.Equal(t, map[string]any{"name": "openai"}, logs[0].NewValue)
+
+	since := start.Add(30 * time.Second)
+	_, total, err = store.GetAuditLogs(ctx, AuditLogFilters{StartTime: &since}, 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+}
+
+func TestWebhookDeadLetters(t *testing.T) {
+	store := setupRDBTestStore(t)
+	ctx := context.Background()
+
+	start := time.Now().UTC().Add(-time.Hour)
+	deadLetters := []*tables.TableWebhookDeadLetter{
+		{CreatedAt: start, EventID: "evt-1", EventType: "budget.exceeded", Endpoint: "ops", URL: "https://hooks.example.com", Payload: `{"id":"evt-1"}`, Attempts: 5, LastStatusCode: 500},
+		{CreatedAt: start.Add(time.Minute), EventID: "evt-2", EventType: "batch.completed", Endpoint: "ops", URL: "https://hooks.example.com", Payload: `{"id":"evt-2"}`, Attempts: 5, LastError: "connection refused"},
+	}
+	for _, deadLetter := range deadLetters {
+		require.NoError(t, store.CreateWebhookDeadLetter(ctx, deadLetter))
+		assert.NotEmpty(t, deadLetter.ID)
+	}
+
+	// Newest first
+	page, total, err := store.GetWebhookDeadLetters(ctx, 1, 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), total)
+	require.Len(t, page, 1)
+	assert.Equal(t, "evt-2", page[0].EventID)
+
+	found, err := store.GetWebhookDeadLetter(ctx, deadLetters[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"evt-1"}`, found.Payload)
+	assert.Equal(t, 500, found.LastStatusCode)
+
+	require.NoError(t, store.DeleteWebhookDeadLetter(ctx, deadLetters[0].ID))
+	_, err = store.GetWebhookDeadLetter(ctx, deadLetters[0].ID)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, store.DeleteWebhookDeadLetter(ctx, deadLetters[0].ID), ErrNotFound)
+}
+
+func TestPricingOverrideCRUD(t *testing.T) {
+	store := setupRDBTestStore(t)
+	ctx := context.Background()
+
+	zero := 0.0
+	negotiated := 0.000002
+	overrides := []*tables.TablePricingOverride{
+		{ID: "po-1", Name: "Negotiated GPT-4o", Provider: "openai", ProviderPricingOverride: schemas.ProviderPricingOverride{
+			ModelPattern: "gpt-4o*", MatchType: schemas.PricingOverrideMatchWildcard, InputCostPerToken: &negotiated,
+		}},
+		{ID: "po-2", Name: "Self-hosted Llama", Provider: "ollama", ProviderPricingOverride: schemas.ProviderPricingOverride{
+			ModelPattern: " llama3 ", MatchType: schemas.PricingOverrideMatchExact, InputCostPerToken: &zero, OutputCostPerToken: &zero,
+		}},
+	}
+	for _, override := range overrides {
+		require.NoError(t, store.CreatePricingOverride(ctx, override))
+	}
+
+	found, err := store.GetPricingOverride(ctx, "po-2")
+	require.NoError(t, err)
+	assert.Equal(t, "llama3", found.ModelPattern)
+	assert.Equal(t, schemas.PricingOverrideMatchExact, found.MatchType)
+	require.NotNil(t, found.OutputCostPerToken, "a $0 price must survive the round trip")
+	assert.Equal(t, 0.0, *found.OutputCostPerToken)
+	assert.Nil(t, found.CacheReadInputTokenCost)
+
+	found.OutputCostPerToken = &negotiated
+	require.NoError(t, store.UpdatePricingOverride(ctx, found))
+	all, err := store.GetPricingOverrides(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+	assert.Equal(t, "po-1", all[0].ID)
+	assert.Equal(t, negotiated, *all[1].OutputCostPerToken)
+
+	require.NoError(t, store.DeletePricingOverride(ctx, "po-1"))
+	_, err = store.GetPricingOverride(ctx, "po-1")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.ErrorIs(t, store.DeletePricingOverride(ctx, "po-1"), ErrNotFound)
+}
@@ -41,13 +41,8 @@ func newSqliteConfigStore(ctx context.Context, config *SQLiteConfig, logger sche
 	if err := s.removeDuplicateKeysAndNullKeys(ctx); err != nil {
 		return nil, fmt.Errorf("failed to remove duplicate keys: %w", err)
 	}
-	// Run migrations
-	if err := triggerMigrations(ctx, db); err != nil {
+	if err := s.initialize(ctx); err != nil {
 		return nil, err
 	}
-	// Encrypt any plaintext rows if encryption is enabled
-	if err := s.EncryptPlaintextRows(ctx); err != nil {
-		return nil, fmt.Errorf("failed to encrypt plaintext rows: %w", err)
-	}
 	return s, nil
 }
@@ -21,6 +21,7 @@ type ConfigStore interface {
 
 	// Encryption
 	EncryptPlaintextRows(ctx context.Context) error
+	RotateEncryptionKey(ctx context.Context) (int, error)
 
 	// Client config CRUD
 	UpdateClientConfig(ctx context.Context, config *ClientConfig) error
@@ -131,6 +132,30 @@ type ConfigStore interface {
 	UpdateRoutingRule(ctx context.Context, rule *tables.TableRoutingRule, tx ...*gorm.DB) error
 	DeleteRoutingRule(ctx context.Context, id string, tx ...*gorm.DB) error
 
+	// Parameter presets CRUD
+	GetParameterPresets(ctx context.Context) ([]tables.TableParameterPreset, error)
+	GetParameterPreset(ctx context.Context, name string) (*tables.TableParameterPreset, error)
+	CreateParameterPreset(ctx context.Context, preset *tables.TableParameterPreset, tx ...*gorm.DB) error
+	UpdateParameterPreset(ctx context.Context, preset *tables.TableParameterPreset, tx ...*gorm.DB) error
+	DeleteParameterPreset(ctx context.Context, name string, tx ...*gorm.DB) error
+
+	// Custom pricing overrides CRUD
+	GetPricingOverrides(ctx context.Context) ([]tables.TablePricingOverride, error)
+	GetPricingOverride(ctx context.Context, id string) (*tables.TablePricingOverride, error)
+	CreatePricingOverride(ctx context.Context, override *tables.TablePricingOverride, tx ...*gorm.DB) error
+	UpdatePricingOverride(ctx context.Context, override *tables.TablePricingOverride, tx ...*gorm.DB) error
+	DeletePricingOverride(ctx context.Context, id string, tx ...*gorm.DB) error
+
+	// Audit log
+	CreateAuditLog(ctx context.Context, entry *tables.TableAuditLog) error
+	GetAuditLogs(ctx context.Context, filters AuditLogFilters, limit int, offset int) ([]tables.TableAuditLog, int64, error)
+
+	// Webhook dead letters
+	CreateWebhookDeadLetter(ctx context.Context, deadLetter *tables.TableWebhookDeadLetter) error
+	GetWebhookDeadLetters(ctx context.Context, limit int, offset int) ([]tables.TableWebhookDeadLetter, int64, error)
+	GetWebhookDeadLetter(ctx context.Context, id string) (*tables.TableWebhookDeadLetter, error)
+	DeleteWebhookDeadLetter(ctx context.Context, id string) error
+
 	// Model config CRUD
 	GetModelConfigs(ctx context.Context) ([]tables.TableModelConfig, error)
 	GetModelConfig(ctx context.Context, modelName string, provider *string) (*tables.TableModelConfig, error)
@@ -244,6 +269,11 @@ func NewConfigStore(ctx context.Context, config *Config, logger schemas.Logger)
 			return newPostgresConfigStore(ctx, postgresConfig, logger)
 		}
 		return nil, fmt.Errorf("invalid postgres config: %T", config.Config)
+	case ConfigStoreTypeMySQL:
+		if mysqlConfig, ok := config.Config.(*MySQLConfig); ok {
+			return newMySQLConfigStore(ctx, mysqlConfig, logger)
+		}
+		return nil, fmt.Errorf("invalid mysql config: %T", config.Config)
 	}
 	return nil, fmt.Errorf("unsupported config store type: %s", config.Type)
 }
@@ -0,0 +1,75 @@
+package tables
+
+import (
+	"time"
+
+	"github.com/bytedance/sonic"
+	"gorm.io/gorm"
+)
+
+// Audit log actions
+const (
+	AuditActionCreate = "create"
+	AuditActionUpdate = "update"
+	AuditActionDelete = "delete"
+	AuditActionDenied = "denied" // management API request rejected by RBAC
+)
+
+// TableAuditLog records a change to the gateway configuration made through the management API,
+// or a management API request denied by RBAC
+type TableAuditLog struct {
+	ID           string    `gorm:"primaryKey;type:varchar(255)" json:"id"`
+	Timestamp    time.Time `gorm:"index;not null" json:"timestamp"`
+	Actor        string    `gorm:"type:varchar(255);index" json:"actor"` // admin:<username>, virtual_key:<name>, user:<jwt user id> or anonymous
+	Action       string    `gorm:"type:varchar(32);index" json:"action"`
+	ResourceType string    `gorm:"type:varchar(64);index" json:"resource_type"` // e.g. providers, governance/virtual-keys
+	ResourceID   string    `gorm:"type:varchar(255);index" json:"resource_id,omitempty"`
+	Method       string    `gorm:"type:varchar(16)" json:"method"`
+	Path         string    `gorm:"type:text" json:"path"`
+	StatusCode   int       `json:"status_code"`
+	OldValueJSON string    `gorm:"type:text" json:"-"` // JSON with secrets redacted
+	NewValueJSON string    `gorm:"type:text" json:"-"` // JSON with secrets redacted
+
+	// Virtual fields for runtime use (not stored in DB)
+	OldValue any `gorm:"-" json:"old_value,omitempty"`
+	NewValue any `gorm:"-" json:"new_value,omitempty"`
+}
+
+// TableName for TableAuditLog
+func (TableAuditLog) TableName() string { return "audit_logs" }
+
+// BeforeSave hook for TableAuditLog to serialize JSON fields
+func (a *TableAuditLog) BeforeSave(tx *gorm.DB) error {
+	a.OldValueJSON = ""
+	if a.OldValue != nil {
+		data, err := sonic.Marshal(a.OldValue)
+		if err != nil {
+			return err
+		}
+		a.OldValueJSON = string(data)
+	}
+	a.NewValueJSON = ""
+	if a.NewValue != nil {
+		data, err := sonic.Marshal(a.NewValue)
+		if err != nil {
+			return err
+		}
+		a.NewValueJSON = string(data)
+	}
+	return nil
+}
+
+// AfterFind hook for TableAuditLog to deserialize JSON fields
+func (a *TableAuditLog) AfterFind(tx *gorm.DB) error {
+	if a.OldValueJSON != "" {
+		if err := sonic.Unmarshal([]byte(a.OldValueJSON), &a.OldValue); err != nil {
+			return err
+		}
+	}
+	if a.NewValueJSON != "" {
+		if err := sonic.Unmarshal([]byte(a.NewValueJSON), &a.NewValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
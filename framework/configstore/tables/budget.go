@@ -2,18 +2,27 @@ package tables
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/capsohq/bifrost/core/schemas"
 	"gorm.io/gorm"
 )
 
-// TableBudget defines spending limits with configurable reset periods
+// TableBudget defines spending limits with configurable reset periods.
+//
+// A fixed window budget starts over at every reset. A sliding window budget also counts the
+// previous window, weighted by how much of it still falls within ResetDuration of now, so spend
+// cannot double up around a reset.
 type TableBudget struct {
-	ID            string    `gorm:"primaryKey;type:varchar(255)" json:"id"`
-	MaxLimit      float64   `gorm:"not null" json:"max_limit"`                       // Maximum budget in dollars
-	ResetDuration string    `gorm:"type:varchar(50);not null" json:"reset_duration"` // e.g., "30s", "5m", "1h", "1d", "1w", "1M", "1Y"
-	LastReset     time.Time `gorm:"index" json:"last_reset"`                         // Last time budget was reset
-	CurrentUsage  float64   `gorm:"default:0" json:"current_usage"`                  // Current usage in dollars
+	ID             string    `gorm:"primaryKey;type:varchar(255)" json:"id"`
+	MaxLimit       float64   `gorm:"not null" json:"max_limit"`                          // Maximum budget in dollars
+	ResetDuration  string    `gorm:"type:varchar(50);not null" json:"reset_duration"`    // e.g., "30s", "5m", "1h", "1d", "1w", "1M", "1Y"
+	LastReset      time.Time `gorm:"index" json:"last_reset"`                            // Last time budget was reset
+	CurrentUsage   float64   `gorm:"default:0" json:"current_usage"`                     // Current usage in dollars
+	SlidingWindow  bool      `gorm:"default:false" json:"sliding_window"`                // Count the previous window too, weighted by its overlap with the sliding window
+	PreviousUsage  float64   `gorm:"default:0" json:"previous_usage"`                    // Usage of the previous window (sliding windows only)
+	DowngradeModel *string   `gorm:"type:varchar(255)" json:"downgrade_model,omitempty"` // Model ("provider/model" or "model") requests are sent to once the budget is exceeded, instead of being rejected
 
 	// Config hash is used to detect the changes synced from config.json file
 	// Every time we sync the config.json file, we will update the config hash
@@ -38,6 +47,69 @@ func (b *TableBudget) BeforeSave(tx *gorm.DB) error {
 	if b.MaxLimit < 0 {
 		return fmt.Errorf("budget max_limit cannot be negative: %.2f", b.MaxLimit)
 	}
+	if b.DowngradeModel != nil && strings.TrimSpace(*b.DowngradeModel) == "" {
+		return fmt.Errorf("budget downgrade_model cannot be empty")
+	}
 
 	return nil
 }
+
+// WindowUsage returns the usage counted against the limit at now. It returns false for a fixed
+// window budget past its reset duration, which counts nothing until it is reset.
+func (b *TableBudget) WindowUsage(now time.Time) (float64, bool) {
+	duration, err := ParseDuration(b.ResetDuration)
+	if err != nil || duration <= 0 {
+		return b.CurrentUsage, true
+	}
+	elapsed := now.Sub(b.LastReset)
+	if !b.SlidingWindow {
+		if elapsed >= duration {
+			return 0, false
+		}
+		return b.CurrentUsage, true
+	}
+	switch {
+	case elapsed < duration:
+		overlap := 1 - float64(elapsed)/float64(duration)
+		return b.CurrentUsage + b.PreviousUsage*overlap, true
+	case elapsed < 2*duration:
+		// The current window ended but has not been rotated yet
+		overlap := 1 - float64(elapsed-duration)/float64(duration)
+		return b.CurrentUsage * overlap, true
+	default:
+		return 0, true
+	}
+}
+
+// Rotate starts a new window at now if the current one has ended, returning true if it did.
+// A sliding window budget keeps the usage of the window that just ended as its previous usage.
+func (b *TableBudget) Rotate(now time.Time) (bool, error) {
+	duration, err := ParseDuration(b.ResetDuration)
+	if err != nil {
+		return false, err
+	}
+	elapsed := now.Sub(b.LastReset)
+	if elapsed < duration {
+		return false, nil
+	}
+	if b.SlidingWindow && elapsed < 2*duration {
+		// Keep windows aligned so the previous window's weight stays exact
+		b.PreviousUsage = b.CurrentUsage
+		b.LastReset = b.LastReset.Add(duration)
+	} else {
+		b.PreviousUsage = 0
+		b.LastReset = now
+	}
+	b.CurrentUsage = 0
+	return true, nil
+}
+
+// DowngradeTarget returns the provider and model requests are downgraded to once the budget is
+// exceeded, resolving a model without a provider prefix against the requested provider.
+func (b *TableBudget) DowngradeTarget(requestedProvider schemas.ModelProvider) (schemas.ModelProvider, string, bool) {
+	if b.DowngradeModel == nil || *b.DowngradeModel == "" {
+		return "", "", false
+	}
+	provider, model := schemas.ParseModelString(*b.DowngradeModel, requestedProvider)
+	return provider, model, true
+}
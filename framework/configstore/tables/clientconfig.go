@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"time"
 
+	"github.com/capsohq/bifrost/core/schemas"
 	"gorm.io/gorm"
 )
 
@@ -30,8 +31,22 @@ type TableClientConfig struct {
 	MCPCodeModeBindingLevel         string `gorm:"default:server" json:"mcp_code_mode_binding_level"`         // How tools are exposed in VFS: "server" or "tool"
 	MCPToolSyncInterval             int    `gorm:"default:10" json:"mcp_tool_sync_interval"`                  // Global tool sync interval in minutes (default: 10, 0 = disabled)
 	AsyncJobResultTTL               int    `gorm:"default:3600" json:"async_job_result_ttl"`                  // Default TTL for async job results in seconds (default: 3600 = 1 hour)
+	SSEKeepAliveInterval            int    `gorm:"default:0" json:"sse_keep_alive_interval"`                  // Seconds of stream silence before an SSE keep-alive comment (0 = default of 15, negative = disabled)
 	RequiredHeadersJSON             string `gorm:"type:text" json:"-"`                                        // JSON serialized []string
 	LoggingHeadersJSON              string `gorm:"type:text" json:"-"`                                        // JSON serialized []string
+	SSEOutputDialectsJSON           string `gorm:"type:text" json:"-"`                                        // JSON serialized map[string]string
+	ResponseEnvelopesJSON           string `gorm:"type:text" json:"-"`                                        // JSON serialized map[string]string
+	LoadSheddingJSON                string `gorm:"type:text" json:"-"`                                        // JSON serialized schemas.LoadSheddingConfig
+	SchemaDriftJSON                 string `gorm:"type:text" json:"-"`                                        // JSON serialized schemas.SchemaDriftConfig
+	CircuitBreakerJSON              string `gorm:"type:text" json:"-"`                                        // JSON serialized schemas.CircuitBreakerConfig
+	LatencyRoutingJSON              string `gorm:"type:text" json:"-"`                                        // JSON serialized schemas.LatencyRoutingConfig
+	CostRoutingJSON                 string `gorm:"type:text" json:"-"`                                        // JSON serialized schemas.CostRoutingConfig
+	VirtualModelsJSON               string `gorm:"type:text" json:"-"`                                        // JSON serialized []schemas.VirtualModel
+	TrafficSplitsJSON               string `gorm:"type:text" json:"-"`                                        // JSON serialized []schemas.TrafficSplit
+	SingleFlightJSON                string `gorm:"type:text" json:"-"`                                        // JSON serialized schemas.SingleFlightConfig
+	ToolExecutionJSON               string `gorm:"type:text" json:"-"`                                        // JSON serialized schemas.ToolExecutionConfig
+	StreamUsageJSON                 string `gorm:"type:text" json:"-"`                                        // JSON serialized schemas.StreamUsageConfig
+	HeaderPolicyJSON                string `gorm:"type:text" json:"-"`                                        // JSON serialized schemas.HeaderPolicyConfig
 	HideDeletedVirtualKeysInFilters bool   `gorm:"default:false" json:"hide_deleted_virtual_keys_in_filters"` // Hide deleted virtual keys in logs filter dropdowns
 
 	// LiteLLM fallback flag
@@ -45,12 +60,25 @@ type TableClientConfig struct {
 	UpdatedAt time.Time `gorm:"index;not null" json:"updated_at"`
 
 	// Virtual fields for runtime use (not stored in DB)
-	PrometheusLabels   []string                  `gorm:"-" json:"prometheus_labels"`
-	AllowedOrigins     []string                  `gorm:"-" json:"allowed_origins,omitempty"`
-	AllowedHeaders     []string                  `gorm:"-" json:"allowed_headers,omitempty"`
-	RequiredHeaders    []string                  `gorm:"-" json:"required_headers,omitempty"`
-	LoggingHeaders     []string                  `gorm:"-" json:"logging_headers,omitempty"`
-	HeaderFilterConfig *GlobalHeaderFilterConfig `gorm:"-" json:"header_filter_config,omitempty"`
+	PrometheusLabels   []string                      `gorm:"-" json:"prometheus_labels"`
+	AllowedOrigins     []string                      `gorm:"-" json:"allowed_origins,omitempty"`
+	AllowedHeaders     []string                      `gorm:"-" json:"allowed_headers,omitempty"`
+	RequiredHeaders    []string                      `gorm:"-" json:"required_headers,omitempty"`
+	LoggingHeaders     []string                      `gorm:"-" json:"logging_headers,omitempty"`
+	SSEOutputDialects  map[string]string             `gorm:"-" json:"sse_output_dialects,omitempty"`
+	ResponseEnvelopes  map[string]string             `gorm:"-" json:"response_envelopes,omitempty"`
+	HeaderFilterConfig *GlobalHeaderFilterConfig     `gorm:"-" json:"header_filter_config,omitempty"`
+	LoadShedding       *schemas.LoadSheddingConfig   `gorm:"-" json:"load_shedding,omitempty"`
+	SchemaDrift        *schemas.SchemaDriftConfig    `gorm:"-" json:"schema_drift,omitempty"`
+	CircuitBreaker     *schemas.CircuitBreakerConfig `gorm:"-" json:"circuit_breaker,omitempty"`
+	LatencyRouting     *schemas.LatencyRoutingConfig `gorm:"-" json:"latency_routing,omitempty"`
+	CostRouting        *schemas.CostRoutingConfig    `gorm:"-" json:"cost_routing,omitempty"`
+	VirtualModels      []schemas.VirtualModel        `gorm:"-" json:"virtual_models,omitempty"`
+	TrafficSplits      []schemas.TrafficSplit        `gorm:"-" json:"traffic_splits,omitempty"`
+	SingleFlight       *schemas.SingleFlightConfig   `gorm:"-" json:"single_flight,omitempty"`
+	ToolExecution      *schemas.ToolExecutionConfig  `gorm:"-" json:"tool_execution,omitempty"`
+	StreamUsage        *schemas.StreamUsageConfig    `gorm:"-" json:"stream_usage,omitempty"`
+	HeaderPolicy       *schemas.HeaderPolicyConfig   `gorm:"-" json:"header_policy,omitempty"`
 }
 
 // TableName sets the table name for each model
@@ -107,6 +135,26 @@ func (cc *TableClientConfig) BeforeSave(tx *gorm.DB) error {
 		cc.LoggingHeadersJSON = "[]"
 	}
 
+	if cc.SSEOutputDialects != nil {
+		data, err := json.Marshal(cc.SSEOutputDialects)
+		if err != nil {
+			return err
+		}
+		cc.SSEOutputDialectsJSON = string(data)
+	} else {
+		cc.SSEOutputDialectsJSON = "{}"
+	}
+
+	if cc.ResponseEnvelopes != nil {
+		data, err := json.Marshal(cc.ResponseEnvelopes)
+		if err != nil {
+			return err
+		}
+		cc.ResponseEnvelopesJSON = string(data)
+	} else {
+		cc.ResponseEnvelopesJSON = "{}"
+	}
+
 	if cc.HeaderFilterConfig != nil {
 		data, err := json.Marshal(cc.HeaderFilterConfig)
 		if err != nil {
@@ -117,6 +165,116 @@ func (cc *TableClientConfig) BeforeSave(tx *gorm.DB) error {
 		cc.HeaderFilterConfigJSON = ""
 	}
 
+	if cc.LoadShedding != nil {
+		data, err := json.Marshal(cc.LoadShedding)
+		if err != nil {
+			return err
+		}
+		cc.LoadSheddingJSON = string(data)
+	} else {
+		cc.LoadSheddingJSON = ""
+	}
+
+	if cc.SchemaDrift != nil {
+		data, err := json.Marshal(cc.SchemaDrift)
+		if err != nil {
+			return err
+		}
+		cc.SchemaDriftJSON = string(data)
+	} else {
+		cc.SchemaDriftJSON = ""
+	}
+
+	if cc.CircuitBreaker != nil {
+		data, err := json.Marshal(cc.CircuitBreaker)
+		if err != nil {
+			return err
+		}
+		cc.CircuitBreakerJSON = string(data)
+	} else {
+		cc.CircuitBreakerJSON = ""
+	}
+
+	if cc.LatencyRouting != nil {
+		data, err := json.Marshal(cc.LatencyRouting)
+		if err != nil {
+			return err
+		}
+		cc.LatencyRoutingJSON = string(data)
+	} else {
+		cc.LatencyRoutingJSON = ""
+	}
+
+	if cc.CostRouting != nil {
+		data, err := json.Marshal(cc.CostRouting)
+		if err != nil {
+			return err
+		}
+		cc.CostRoutingJSON = string(data)
+	} else {
+		cc.CostRoutingJSON = ""
+	}
+
+	if len(cc.VirtualModels) > 0 {
+		data, err := json.Marshal(cc.VirtualModels)
+		if err != nil {
+			return err
+		}
+		cc.VirtualModelsJSON = string(data)
+	} else {
+		cc.VirtualModelsJSON = ""
+	}
+
+	if len(cc.TrafficSplits) > 0 {
+		data, err := json.Marshal(cc.TrafficSplits)
+		if err != nil {
+			return err
+		}
+		cc.TrafficSplitsJSON = string(data)
+	} else {
+		cc.TrafficSplitsJSON = ""
+	}
+
+	if cc.SingleFlight != nil {
+		data, err := json.Marshal(cc.SingleFlight)
+		if err != nil {
+			return err
+		}
+		cc.SingleFlightJSON = string(data)
+	} else {
+		cc.SingleFlightJSON = ""
+	}
+
+	if cc.ToolExecution != nil {
+		data, err := json.Marshal(cc.ToolExecution)
+		if err != nil {
+			return err
+		}
+		cc.ToolExecutionJSON = string(data)
+	} else {
+		cc.ToolExecutionJSON = ""
+	}
+
+	if cc.StreamUsage != nil {
+		data, err := json.Marshal(cc.StreamUsage)
+		if err != nil {
+			return err
+		}
+		cc.StreamUsageJSON = string(data)
+	} else {
+		cc.StreamUsageJSON = ""
+	}
+
+	if cc.HeaderPolicy != nil {
+		data, err := json.Marshal(cc.HeaderPolicy)
+		if err != nil {
+			return err
+		}
+		cc.HeaderPolicyJSON = string(data)
+	} else {
+		cc.HeaderPolicyJSON = ""
+	}
+
 	return nil
 }
 
@@ -152,6 +310,18 @@ func (cc *TableClientConfig) AfterFind(tx *gorm.DB) error {
 		}
 	}
 
+	if cc.SSEOutputDialectsJSON != "" {
+		if err := json.Unmarshal([]byte(cc.SSEOutputDialectsJSON), &cc.SSEOutputDialects); err != nil {
+			return err
+		}
+	}
+
+	if cc.ResponseEnvelopesJSON != "" {
+		if err := json.Unmarshal([]byte(cc.ResponseEnvelopesJSON), &cc.ResponseEnvelopes); err != nil {
+			return err
+		}
+	}
+
 	if cc.HeaderFilterConfigJSON != "" {
 		var headerFilterConfig GlobalHeaderFilterConfig
 		if err := json.Unmarshal([]byte(cc.HeaderFilterConfigJSON), &headerFilterConfig); err != nil {
@@ -160,5 +330,89 @@ func (cc *TableClientConfig) AfterFind(tx *gorm.DB) error {
 		cc.HeaderFilterConfig = &headerFilterConfig
 	}
 
+	if cc.LoadSheddingJSON != "" {
+		var loadShedding schemas.LoadSheddingConfig
+		if err := json.Unmarshal([]byte(cc.LoadSheddingJSON), &loadShedding); err != nil {
+			return err
+		}
+		cc.LoadShedding = &loadShedding
+	}
+
+	if cc.SchemaDriftJSON != "" {
+		var schemaDrift schemas.SchemaDriftConfig
+		if err := json.Unmarshal([]byte(cc.SchemaDriftJSON), &schemaDrift); err != nil {
+			return err
+		}
+		cc.SchemaDrift = &schemaDrift
+	}
+
+	if cc.CircuitBreakerJSON != "" {
+		var circuitBreaker schemas.CircuitBreakerConfig
+		if err := json.Unmarshal([]byte(cc.CircuitBreakerJSON), &circuitBreaker); err != nil {
+			return err
+		}
+		cc.CircuitBreaker = &circuitBreaker
+	}
+
+	if cc.LatencyRoutingJSON != "" {
+		var latencyRouting schemas.LatencyRoutingConfig
+		if err := json.Unmarshal([]byte(cc.LatencyRoutingJSON), &latencyRouting); err != nil {
+			return err
+		}
+		cc.LatencyRouting = &latencyRouting
+	}
+
+	if cc.CostRoutingJSON != "" {
+		var costRouting schemas.CostRoutingConfig
+		if err := json.Unmarshal([]byte(cc.CostRoutingJSON), &costRouting); err != nil {
+			return err
+		}
+		cc.CostRouting = &costRouting
+	}
+
+	if cc.VirtualModelsJSON != "" {
+		if err := json.Unmarshal([]byte(cc.VirtualModelsJSON), &cc.VirtualModels); err != nil {
+			return err
+		}
+	}
+
+	if cc.TrafficSplitsJSON != "" {
+		if err := json.Unmarshal([]byte(cc.TrafficSplitsJSON), &cc.TrafficSplits); err != nil {
+			return err
+		}
+	}
+
+	if cc.SingleFlightJSON != "" {
+		var singleFlight schemas.SingleFlightConfig
+		if err := json.Unmarshal([]byte(cc.SingleFlightJSON), &singleFlight); err != nil {
+			return err
+		}
+		cc.SingleFlight = &singleFlight
+	}
+
+	if cc.ToolExecutionJSON != "" {
+		var toolExecution schemas.ToolExecutionConfig
+		if err := json.Unmarshal([]byte(cc.ToolExecutionJSON), &toolExecution); err != nil {
+			return err
+		}
+		cc.ToolExecution = &toolExecution
+	}
+
+	if cc.StreamUsageJSON != "" {
+		var streamUsage schemas.StreamUsageConfig
+		if err := json.Unmarshal([]byte(cc.StreamUsageJSON), &streamUsage); err != nil {
+			return err
+		}
+		cc.StreamUsage = &streamUsage
+	}
+
+	if cc.HeaderPolicyJSON != "" {
+		var headerPolicy schemas.HeaderPolicyConfig
+		if err := json.Unmarshal([]byte(cc.HeaderPolicyJSON), &headerPolicy); err != nil {
+			return err
+		}
+		cc.HeaderPolicy = &headerPolicy
+	}
+
 	return nil
 }
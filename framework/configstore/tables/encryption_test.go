@@ -391,33 +391,75 @@ func TestTablePlugin_EmptyConfig_NoEncryption(t *testing.T) {
 // TableVirtualKey encryption tests
 // ============================================================================
 
-func TestTableVirtualKey_EncryptDecrypt(t *testing.T) {
+func TestTableVirtualKey_StoresOnlyHashAndMask(t *testing.T) {
 	db := setupTestDB(t)
 
 	vk := &TableVirtualKey{
 		ID:       "vk-1",
 		Name:     "test-vk",
-		Value:    "vk-secret-value-xyz",
+		Value:    "sk-bf-vk-secret-value-xyz",
 		IsActive: true,
 	}
 
 	require.NoError(t, db.Create(vk).Error)
 
 	raw := rawRow(t, db, "governance_virtual_keys", "vk-1")
-	assert.Equal(t, "encrypted", raw["encryption_status"])
-	assert.NotEqual(t, "vk-secret-value-xyz", raw["value"])
+	assert.Equal(t, "plain_text", raw["encryption_status"])
+	assert.Equal(t, "sk-bf-****-xyz", raw["value"])
 
 	// Verify hash was computed from plaintext
-	expectedHash := encrypt.HashSHA256("vk-secret-value-xyz")
+	expectedHash := encrypt.HashSHA256("sk-bf-vk-secret-value-xyz")
 	assert.Equal(t, expectedHash, raw["value_hash"])
 
 	var found TableVirtualKey
 	require.NoError(t, db.First(&found, "id = ?", "vk-1").Error)
-	assert.Equal(t, "vk-secret-value-xyz", found.Value)
+	assert.Equal(t, "sk-bf-****-xyz", found.Value)
 	assert.Equal(t, expectedHash, found.ValueHash)
+	assert.Equal(t, expectedHash, found.LookupHash())
+}
+
+func TestTableVirtualKey_RejectsMaskedValueWithoutHash(t *testing.T) {
+	db := setupTestDB(t)
+
+	vk := &TableVirtualKey{
+		ID:       "vk-masked",
+		Name:     "masked-vk",
+		Value:    "sk-bf-****-xyz",
+		IsActive: true,
+	}
+
+	require.Error(t, db.Create(vk).Error)
+}
+
+func TestTableVirtualKey_DecryptsLegacyEncryptedValue(t *testing.T) {
+	db := setupTestDB(t)
+
+	encrypted, err := encrypt.Encrypt("sk-bf-legacy-value")
+	require.NoError(t, err)
+	require.NoError(t, db.Exec(
+		`INSERT INTO governance_virtual_keys (id, name, value, is_active, encryption_status, created_at, updated_at)
+		 VALUES (?, ?, ?, ?, 'encrypted', ?, ?)`,
+		"vk-legacy", "legacy-vk", encrypted, true, time.Now(), time.Now()).Error)
+
+	var found TableVirtualKey
+	require.NoError(t, db.First(&found, "id = ?", "vk-legacy").Error)
+	assert.Equal(t, "sk-bf-legacy-value", found.Value)
+
+	// Saving it stores only the hash and mask
+	require.NoError(t, db.Save(&found).Error)
+	raw := rawRow(t, db, "governance_virtual_keys", "vk-legacy")
+	assert.Equal(t, "plain_text", raw["encryption_status"])
+	assert.Equal(t, "sk-bf-****alue", raw["value"])
+	assert.Equal(t, encrypt.HashSHA256("sk-bf-legacy-value"), raw["value_hash"])
+}
+
+func TestMaskVirtualKeyValue(t *testing.T) {
+	assert.Equal(t, "sk-bf-****1a2b", MaskVirtualKeyValue("sk-bf-0123456789abcdef1a2b"))
+	assert.Equal(t, "****", MaskVirtualKeyValue("short-value"))
+	assert.Equal(t, "sk-bf-****1a2b", MaskVirtualKeyValue("sk-bf-****1a2b"))
 }
 
-func TestTableVirtualKey_HashComputedBeforeEncryption(t *testing.T) {
+func TestTableVirtualKey_HashComputedFromPlaintext(t *testing.T) {
 	db := setupTestDB(t)
 
 	vk := &TableVirtualKey{
@@ -430,7 +472,7 @@ func TestTableVirtualKey_HashComputedBeforeEncryption(t *testing.T) {
 	require.NoError(t, db.Create(vk).Error)
 
 	raw := rawRow(t, db, "governance_virtual_keys", "vk-hash")
-	// Hash should be of the plaintext, not the ciphertext
+	// Hash should be of the plaintext, not the mask
 	assert.Equal(t, encrypt.HashSHA256("plaintext-value"), raw["value_hash"])
 }
 
@@ -875,7 +917,7 @@ func TestTableMCPClient_HeadersOnly_NoConnStr(t *testing.T) {
 // Round-trip update tests for remaining tables
 // ============================================================================
 
-func TestTableVirtualKey_UpdatePreservesDecryption(t *testing.T) {
+func TestTableVirtualKey_UpdateKeepsHash(t *testing.T) {
 	db := setupTestDB(t)
 
 	vk := &TableVirtualKey{
@@ -886,19 +928,24 @@ func TestTableVirtualKey_UpdatePreservesDecryption(t *testing.T) {
 	}
 	require.NoError(t, db.Create(vk).Error)
 
+	// Saving a loaded key keeps its hash
 	var found TableVirtualKey
 	require.NoError(t, db.First(&found, "id = ?", "vk-update").Error)
-	assert.Equal(t, "original-vk-value", found.Value)
+	found.Description = "updated"
+	require.NoError(t, db.Save(&found).Error)
+	raw := rawRow(t, db, "governance_virtual_keys", "vk-update")
+	assert.Equal(t, encrypt.HashSHA256("original-vk-value"), raw["value_hash"])
 
+	// Saving a new value replaces it
 	found.Value = "updated-vk-value"
 	require.NoError(t, db.Save(&found).Error)
 
 	var found2 TableVirtualKey
 	require.NoError(t, db.First(&found2, "id = ?", "vk-update").Error)
-	assert.Equal(t, "updated-vk-value", found2.Value)
+	assert.Equal(t, "update****alue", found2.Value)
 
-	raw := rawRow(t, db, "governance_virtual_keys", "vk-update")
-	assert.Equal(t, "encrypted", raw["encryption_status"])
+	raw = rawRow(t, db, "governance_virtual_keys", "vk-update")
+	assert.Equal(t, "plain_text", raw["encryption_status"])
 	assert.Equal(t, encrypt.HashSHA256("updated-vk-value"), raw["value_hash"])
 }
 
@@ -1292,7 +1339,7 @@ func TestTableMCPClient_EncryptionDisabled_StoresPlaintext(t *testing.T) {
 	assert.Equal(t, "Bearer secret-token", found.Headers["Authorization"].Val)
 }
 
-func TestTableVirtualKey_EncryptionDisabled_StoresPlaintext(t *testing.T) {
+func TestTableVirtualKey_EncryptionDisabled_StoresOnlyHash(t *testing.T) {
 	disableEncryption(t)
 	db := setupTestDB(t)
 
@@ -1305,17 +1352,12 @@ func TestTableVirtualKey_EncryptionDisabled_StoresPlaintext(t *testing.T) {
 
 	require.NoError(t, db.Create(vk).Error)
 
-	// Raw DB should have plaintext value — hash should still be computed
+	// Raw DB should have only the hash and mask, as with encryption enabled
 	var raw map[string]any
 	db.Table("governance_virtual_keys").Where("id = ?", "vk-dis-1").Take(&raw)
 	assert.Equal(t, "plain_text", raw["encryption_status"])
-	assert.Equal(t, "vk-plaintext-value", raw["value"])
-	assert.NotEmpty(t, raw["value_hash"], "hash should still be computed even without encryption")
-
-	// GORM read should return same plaintext
-	var found TableVirtualKey
-	require.NoError(t, db.Where("id = ?", "vk-dis-1").First(&found).Error)
-	assert.Equal(t, "vk-plaintext-value", found.Value)
+	assert.Equal(t, "vk-pla****alue", raw["value"])
+	assert.Equal(t, encrypt.HashSHA256("vk-plaintext-value"), raw["value_hash"])
 }
 
 func TestSessionsTable_EncryptionDisabled_StoresPlaintext(t *testing.T) {
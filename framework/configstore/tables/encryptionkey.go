@@ -0,0 +1,19 @@
+package tables
+
+import "time"
+
+// TableEncryptionKey is a data key that encrypts the sensitive fields of the config store,
+// stored wrapped by the master key (the encryption passphrase or a KMS key). Exactly one data key
+// is active and encrypts new values; retired keys are kept to decrypt values written before a
+// rotation re-encrypted them.
+type TableEncryptionKey struct {
+	ID          string     `gorm:"primaryKey;type:varchar(64)" json:"id"`
+	WrappedKey  string     `gorm:"type:text;not null" json:"-"`                     // Base64 data key, encrypted with the master key
+	MasterKeyID string     `gorm:"type:varchar(512);not null" json:"master_key_id"` // Master key the data key is wrapped with
+	Active      bool       `gorm:"index;not null;default:false" json:"active"`
+	CreatedAt   time.Time  `gorm:"not null" json:"created_at"`
+	RetiredAt   *time.Time `json:"retired_at,omitempty"`
+}
+
+// TableName for TableEncryptionKey
+func (TableEncryptionKey) TableName() string { return "config_encryption_keys" }
@@ -63,6 +63,14 @@ type TableKey struct {
 	VLLMUrl       *schemas.EnvVar `gorm:"type:text" json:"vllm_url,omitempty"`
 	VLLMModelName *string         `gorm:"type:varchar(255)" json:"vllm_model_name,omitempty"`
 
+	// Hunyuan config fields (embedded)
+	HunyuanSecretID  *schemas.EnvVar `gorm:"type:text" json:"hunyuan_secret_id,omitempty"`
+	HunyuanSecretKey *schemas.EnvVar `gorm:"type:text" json:"hunyuan_secret_key,omitempty"`
+	HunyuanRegion    *schemas.EnvVar `gorm:"type:text" json:"hunyuan_region,omitempty"`
+
+	// GLM config fields (embedded)
+	GLMWebSearchJSON *string `gorm:"type:text" json:"-"` // JSON serialized schemas.GLMWebSearchConfig
+
 	// Batch API configuration
 	UseForBatchAPI *bool `gorm:"default:false" json:"use_for_batch_api,omitempty"` // Whether this key can be used for batch API operations
 
@@ -78,6 +86,8 @@ type TableKey struct {
 	BedrockKeyConfig   *schemas.BedrockKeyConfig   `gorm:"-" json:"bedrock_key_config,omitempty"`
 	ReplicateKeyConfig *schemas.ReplicateKeyConfig `gorm:"-" json:"replicate_key_config,omitempty"`
 	VLLMKeyConfig      *schemas.VLLMKeyConfig      `gorm:"-" json:"vllm_key_config,omitempty"`
+	HunyuanKeyConfig   *schemas.HunyuanKeyConfig   `gorm:"-" json:"hunyuan_key_config,omitempty"`
+	GLMKeyConfig       *schemas.GLMKeyConfig       `gorm:"-" json:"glm_key_config,omitempty"`
 }
 
 // TableName sets the table name for each model
@@ -331,6 +341,42 @@ func (k *TableKey) BeforeSave(tx *gorm.DB) error {
 		k.VLLMModelName = nil
 	}
 
+	if k.HunyuanKeyConfig != nil {
+		if k.HunyuanKeyConfig.SecretID.GetValue() != "" {
+			id := k.HunyuanKeyConfig.SecretID // Value-copy to prevent shared pointer mutation
+			k.HunyuanSecretID = &id
+		} else {
+			k.HunyuanSecretID = nil
+		}
+		if k.HunyuanKeyConfig.SecretKey.GetValue() != "" {
+			secret := k.HunyuanKeyConfig.SecretKey
+			k.HunyuanSecretKey = &secret
+		} else {
+			k.HunyuanSecretKey = nil
+		}
+		if k.HunyuanKeyConfig.Region != nil {
+			region := *k.HunyuanKeyConfig.Region
+			k.HunyuanRegion = &region
+		} else {
+			k.HunyuanRegion = nil
+		}
+	} else {
+		k.HunyuanSecretID = nil
+		k.HunyuanSecretKey = nil
+		k.HunyuanRegion = nil
+	}
+
+	if k.GLMKeyConfig != nil && k.GLMKeyConfig.WebSearch != nil {
+		data, err := sonic.Marshal(k.GLMKeyConfig.WebSearch)
+		if err != nil {
+			return err
+		}
+		s := string(data)
+		k.GLMWebSearchJSON = &s
+	} else {
+		k.GLMWebSearchJSON = nil
+	}
+
 	// Encrypt sensitive fields after serialization
 	if encrypt.IsEnabled() {
 		if err := encryptEnvVar(&k.Value); err != nil {
@@ -400,6 +446,13 @@ func (k *TableKey) BeforeSave(tx *gorm.DB) error {
 		if err := encryptEnvVarPtr(&k.VLLMUrl); err != nil {
 			return fmt.Errorf("failed to encrypt vllm url: %w", err)
 		}
+		// Hunyuan
+		if err := encryptEnvVarPtr(&k.HunyuanSecretID); err != nil {
+			return fmt.Errorf("failed to encrypt hunyuan secret id: %w", err)
+		}
+		if err := encryptEnvVarPtr(&k.HunyuanSecretKey); err != nil {
+			return fmt.Errorf("failed to encrypt hunyuan secret key: %w", err)
+		}
 		k.EncryptionStatus = EncryptionStatusEncrypted
 	}
 	return nil
@@ -478,6 +531,13 @@ func (k *TableKey) AfterFind(tx *gorm.DB) error {
 		if err := decryptEnvVarPtr(&k.VLLMUrl); err != nil {
 			return fmt.Errorf("failed to decrypt vllm url: %w", err)
 		}
+		// Hunyuan
+		if err := decryptEnvVarPtr(&k.HunyuanSecretID); err != nil {
+			return fmt.Errorf("failed to decrypt hunyuan secret id: %w", err)
+		}
+		if err := decryptEnvVarPtr(&k.HunyuanSecretKey); err != nil {
+			return fmt.Errorf("failed to decrypt hunyuan secret key: %w", err)
+		}
 	}
 
 	if k.ModelsJSON != "" {
@@ -620,5 +680,30 @@ func (k *TableKey) AfterFind(tx *gorm.DB) error {
 	} else {
 		k.VLLMKeyConfig = nil
 	}
+	// Reconstruct Hunyuan config if fields are present
+	if k.HunyuanSecretID != nil || k.HunyuanSecretKey != nil {
+		hunyuanConfig := &schemas.HunyuanKeyConfig{
+			Region: k.HunyuanRegion,
+		}
+		if k.HunyuanSecretID != nil {
+			hunyuanConfig.SecretID = *k.HunyuanSecretID
+		}
+		if k.HunyuanSecretKey != nil {
+			hunyuanConfig.SecretKey = *k.HunyuanSecretKey
+		}
+		k.HunyuanKeyConfig = hunyuanConfig
+	} else {
+		k.HunyuanKeyConfig = nil
+	}
+	// Reconstruct GLM config if fields are present
+	if k.GLMWebSearchJSON != nil && *k.GLMWebSearchJSON != "" {
+		var webSearch schemas.GLMWebSearchConfig
+		if err := sonic.Unmarshal([]byte(*k.GLMWebSearchJSON), &webSearch); err != nil {
+			return err
+		}
+		k.GLMKeyConfig = &schemas.GLMKeyConfig{WebSearch: &webSearch}
+	} else {
+		k.GLMKeyConfig = nil
+	}
 	return nil
 }
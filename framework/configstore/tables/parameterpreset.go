@@ -0,0 +1,73 @@
+package tables
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+	"gorm.io/gorm"
+)
+
+// TableParameterPreset represents a named inference parameter preset in the database
+type TableParameterPreset struct {
+	Name        string `gorm:"primaryKey;type:varchar(255)" json:"name"`
+	Description string `gorm:"type:text" json:"description"`
+	AliasesJSON string `gorm:"type:text" json:"-"` // JSON array of alternative names
+	ParamsJSON  string `gorm:"type:text;not null" json:"-"`
+	ConfigHash  string `gorm:"type:varchar(255)" json:"config_hash"` // Hash of config.json version, used for change detection
+
+	CreatedAt time.Time `gorm:"index;not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"index;not null" json:"updated_at"`
+
+	// Virtual fields for runtime use (not stored in DB)
+	Aliases []string               `gorm:"-" json:"aliases,omitempty"`
+	Params  map[string]interface{} `gorm:"-" json:"params"`
+}
+
+// TableName for TableParameterPreset
+func (TableParameterPreset) TableName() string { return "config_parameter_presets" }
+
+// BeforeSave hook for TableParameterPreset to serialize JSON fields
+func (p *TableParameterPreset) BeforeSave(tx *gorm.DB) error {
+	if len(p.Aliases) > 0 {
+		data, err := sonic.Marshal(p.Aliases)
+		if err != nil {
+			return err
+		}
+		p.AliasesJSON = string(data)
+	} else {
+		p.AliasesJSON = ""
+	}
+	data, err := sonic.Marshal(p.Params)
+	if err != nil {
+		return err
+	}
+	p.ParamsJSON = string(data)
+	return nil
+}
+
+// AfterFind hook for TableParameterPreset to deserialize JSON fields
+func (p *TableParameterPreset) AfterFind(tx *gorm.DB) error {
+	if strings.TrimSpace(p.AliasesJSON) != "" {
+		if err := sonic.Unmarshal([]byte(p.AliasesJSON), &p.Aliases); err != nil {
+			return err
+		}
+	}
+	if strings.TrimSpace(p.ParamsJSON) != "" {
+		if err := sonic.Unmarshal([]byte(p.ParamsJSON), &p.Params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ToSchema converts the row into the preset type understood by the bifrost core
+func (p *TableParameterPreset) ToSchema() schemas.ParameterPreset {
+	return schemas.ParameterPreset{
+		Name:        p.Name,
+		Description: p.Description,
+		Aliases:     p.Aliases,
+		Params:      p.Params,
+	}
+}
@@ -0,0 +1,51 @@
+package tables
+
+import (
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+	"gorm.io/gorm"
+)
+
+// TablePricingOverride represents a custom pricing override managed through the admin API,
+// e.g. an enterprise negotiated rate or a $0 price for a self-hosted model.
+// It takes precedence over provider-level pricing overrides and the pricing catalog.
+type TablePricingOverride struct {
+	ID           string `gorm:"primaryKey;type:varchar(255)" json:"id"`
+	Name         string `gorm:"type:varchar(255);not null" json:"name"`
+	Description  string `gorm:"type:text" json:"description,omitempty"`
+	Provider     string `gorm:"type:varchar(50);not null;index" json:"provider"`
+	OverrideJSON string `gorm:"type:text;not null" json:"-"` // JSON of the pricing override (pattern, request types and prices)
+
+	CreatedAt time.Time `gorm:"index;not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"index;not null" json:"updated_at"`
+
+	// Virtual fields for runtime use (not stored in DB)
+	schemas.ProviderPricingOverride `gorm:"-"`
+}
+
+// TableName for TablePricingOverride
+func (TablePricingOverride) TableName() string { return "config_pricing_overrides" }
+
+// BeforeSave hook for TablePricingOverride to serialize the override
+func (p *TablePricingOverride) BeforeSave(tx *gorm.DB) error {
+	p.ModelPattern = strings.TrimSpace(p.ModelPattern)
+	data, err := sonic.Marshal(p.ProviderPricingOverride)
+	if err != nil {
+		return err
+	}
+	p.OverrideJSON = string(data)
+	return nil
+}
+
+// AfterFind hook for TablePricingOverride to deserialize the override
+func (p *TablePricingOverride) AfterFind(tx *gorm.DB) error {
+	if strings.TrimSpace(p.OverrideJSON) != "" {
+		if err := sonic.Unmarshal([]byte(p.OverrideJSON), &p.ProviderPricingOverride); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -11,7 +11,7 @@ import (
 // SessionsTable represents a session in the database
 type SessionsTable struct {
 	ID               int       `gorm:"primaryKey;autoIncrement" json:"id"`
-	Token            string    `gorm:"type:text;not null;uniqueIndex" json:"token"`
+	Token            string    `gorm:"type:text;not null;uniqueIndex:,length:255" json:"token"`
 	ExpiresAt        time.Time `gorm:"index;not null" json:"expires_at,omitempty"`
 	CreatedAt        time.Time `gorm:"index;not null" json:"created_at"`
 	UpdatedAt        time.Time `gorm:"index;not null" json:"updated_at"`
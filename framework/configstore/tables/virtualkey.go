@@ -3,6 +3,7 @@ package tables
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/capsohq/bifrost/core/schemas"
@@ -189,7 +190,7 @@ type TableVirtualKey struct {
 	ID              string                          `gorm:"primaryKey;type:varchar(255)" json:"id"`
 	Name            string                          `gorm:"uniqueIndex:idx_virtual_key_name;type:varchar(255);not null" json:"name"`
 	Description     string                          `gorm:"type:text" json:"description,omitempty"`
-	Value           string                          `gorm:"uniqueIndex:idx_virtual_key_value;type:text;not null" json:"value"` // The virtual key value
+	Value           string                          `gorm:"type:text;not null" json:"value"` // The virtual key value until it is saved, then a masked hint of it
 	IsActive        bool                            `gorm:"default:true" json:"is_active"`
 	ProviderConfigs []TableVirtualKeyProviderConfig `gorm:"foreignKey:VirtualKeyID;constraint:OnDelete:CASCADE" json:"provider_configs"` // Empty means all providers allowed
 	MCPConfigs      []TableVirtualKeyMCPConfig      `gorm:"foreignKey:VirtualKeyID;constraint:OnDelete:CASCADE" json:"mcp_configs"`
@@ -200,6 +201,9 @@ type TableVirtualKey struct {
 	BudgetID    *string `gorm:"type:varchar(255);index" json:"budget_id,omitempty"`
 	RateLimitID *string `gorm:"type:varchar(255);index" json:"rate_limit_id,omitempty"`
 
+	// Role grants the key access to the management API (admin, operator or viewer); nil means inference only
+	Role *string `gorm:"type:varchar(32)" json:"role,omitempty"`
+
 	// Relationships
 	Team      *TableTeam      `gorm:"foreignKey:TeamID" json:"team,omitempty"`
 	Customer  *TableCustomer  `gorm:"foreignKey:CustomerID" json:"customer,omitempty"`
@@ -211,7 +215,7 @@ type TableVirtualKey struct {
 	ConfigHash string `gorm:"type:varchar(255);null" json:"config_hash"`
 
 	EncryptionStatus string `gorm:"type:varchar(20);default:'plain_text'" json:"-"`
-	ValueHash        string `gorm:"type:varchar(64);index:idx_virtual_key_value_hash,unique" json:"-"`
+	ValueHash        string `gorm:"type:varchar(64);index:idx_virtual_key_value_hash,unique" json:"-"` // SHA-256 of the value, the only form of it stored
 
 	CreatedAt time.Time `gorm:"index;not null" json:"created_at"`
 	UpdatedAt time.Time `gorm:"index;not null" json:"updated_at"`
@@ -220,29 +224,63 @@ type TableVirtualKey struct {
 // TableName sets the table name for each model
 func (TableVirtualKey) TableName() string { return "governance_virtual_keys" }
 
-// BeforeSave is a GORM hook that enforces mutual exclusion (team vs customer), computes
-// a SHA-256 hash of the plaintext value for indexed lookups, and encrypts the virtual key
-// value before writing to the database.
+// VirtualKeyValueMask replaces the middle of a masked virtual key value.
+const VirtualKeyValueMask = "****"
+
+// HashVirtualKeyValue returns the hash a virtual key value is stored and looked up by.
+func HashVirtualKeyValue(value string) string {
+	return encrypt.HashSHA256(value)
+}
+
+// MaskVirtualKeyValue returns the hint of a virtual key value that is stored in its place: the
+// prefix and the last four characters, e.g. "sk-bf-****1a2b", or only the mask for short values.
+func MaskVirtualKeyValue(value string) string {
+	if IsMaskedVirtualKeyValue(value) {
+		return value
+	}
+	if len(value) <= 12 {
+		return VirtualKeyValueMask
+	}
+	return value[:6] + VirtualKeyValueMask + value[len(value)-4:]
+}
+
+// IsMaskedVirtualKeyValue reports whether value is a masked hint rather than a virtual key value.
+func IsMaskedVirtualKeyValue(value string) bool {
+	return strings.Contains(value, VirtualKeyValueMask)
+}
+
+// LookupHash returns the hash the virtual key is looked up by: the stored value hash, or the hash
+// of the value for a key that has not been saved yet.
+func (vk *TableVirtualKey) LookupHash() string {
+	if vk.ValueHash != "" {
+		return vk.ValueHash
+	}
+	return HashVirtualKeyValue(vk.Value)
+}
+
+// BeforeSave is a GORM hook that enforces mutual exclusion (team vs customer) and replaces a
+// plaintext virtual key value with its SHA-256 hash, used for lookups, and a masked hint shown in
+// the UI. The value itself is never written to the database, so it can only be read back when the
+// key is created.
 func (vk *TableVirtualKey) BeforeSave(tx *gorm.DB) error {
 	// Enforce mutual exclusion: VK can belong to either Team OR Customer, not both
 	if vk.TeamID != nil && vk.CustomerID != nil {
 		return fmt.Errorf("virtual key cannot belong to both team and customer")
 	}
 
-	// Hash must be computed before encryption (from plaintext value)
-	if vk.Value != "" {
-		vk.ValueHash = encrypt.HashSHA256(vk.Value)
+	if vk.ValueHash == "" && IsMaskedVirtualKeyValue(vk.Value) {
+		return fmt.Errorf("virtual key value cannot contain %q", VirtualKeyValueMask)
 	}
-	if encrypt.IsEnabled() && vk.Value != "" {
-		if err := encryptString(&vk.Value); err != nil {
-			return fmt.Errorf("failed to encrypt virtual key value: %w", err)
-		}
-		vk.EncryptionStatus = EncryptionStatusEncrypted
+	if vk.Value != "" && !IsMaskedVirtualKeyValue(vk.Value) {
+		vk.ValueHash = HashVirtualKeyValue(vk.Value)
+		vk.Value = MaskVirtualKeyValue(vk.Value)
+		vk.EncryptionStatus = EncryptionStatusPlainText
 	}
 	return nil
 }
 
-// AfterFind is a GORM hook that decrypts the virtual key value after reading from the database.
+// AfterFind is a GORM hook that decrypts the values of virtual keys encrypted before values were
+// stored as hashes, until they are replaced at startup.
 func (vk *TableVirtualKey) AfterFind(tx *gorm.DB) error {
 	if vk.EncryptionStatus == EncryptionStatusEncrypted {
 		if err := decryptString(&vk.Value); err != nil {
@@ -0,0 +1,21 @@
+package tables
+
+import "time"
+
+// TableWebhookDeadLetter is a webhook event that could not be delivered to an endpoint after all
+// retries. It is kept until it is redelivered or deleted.
+type TableWebhookDeadLetter struct {
+	ID             string    `gorm:"primaryKey;type:varchar(255)" json:"id"`
+	EventID        string    `gorm:"type:varchar(255);index" json:"event_id"`
+	EventType      string    `gorm:"type:varchar(64);index" json:"event_type"`
+	Endpoint       string    `gorm:"type:varchar(255);index" json:"endpoint"` // Name of the configured endpoint
+	URL            string    `gorm:"type:text" json:"url"`
+	Payload        string    `gorm:"type:text" json:"payload"` // Signed JSON body of the event
+	Attempts       int       `json:"attempts"`
+	LastStatusCode int       `json:"last_status_code,omitempty"` // 0 when the endpoint could not be reached
+	LastError      string    `gorm:"type:text" json:"last_error"`
+	CreatedAt      time.Time `gorm:"index;not null" json:"created_at"`
+}
+
+// TableName for TableWebhookDeadLetter
+func (TableWebhookDeadLetter) TableName() string { return "webhook_dead_letters" }
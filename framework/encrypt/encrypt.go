@@ -12,6 +12,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/capsohq/bifrost/core/schemas"
 	"golang.org/x/crypto/argon2"
@@ -21,7 +22,10 @@ import (
 var encryptionKey []byte
 var logger schemas.Logger
 
-var ErrEncryptionKeyNotInitialized = errors.New("encryption key is not initialized")
+var (
+	ErrEncryptionKeyNotInitialized = errors.New("encryption key is not initialized")
+	ErrDataKeysNotLoaded           = errors.New("encryption data keys are not loaded")
+)
 
 // Init initializes the encryption key using Argon2id KDF to derive a secure 32-byte key
 // from the provided passphrase. This ensures strong entropy regardless of passphrase length.
@@ -30,7 +34,9 @@ func Init(key string, _logger schemas.Logger) {
 	logger = _logger
 	if key == "" {
 		encryptionKey = nil
-		logger.Warn("encryption key is not set, encryption will be disabled. To set encryption key: use the encryption_key field in the configuration file or set the BIFROST_ENCRYPTION_KEY environment variable. Note that - once encryption key is set, it cannot be changed later unless you clean up the database.")
+		SetMasterKey(nil)
+		SetDataKeys(nil, "")
+		logger.Warn("encryption key is not set, encryption will be disabled. To set encryption key: use the encryption_key field in the configuration file or set the BIFROST_ENCRYPTION_KEY environment variable. To change it later, set the previous key in BIFROST_PREVIOUS_ENCRYPTION_KEY on the next start.")
 		return
 	}
 
@@ -40,11 +46,17 @@ func Init(key string, _logger schemas.Logger) {
 	}
 
 	// Derive a secure 32-byte key using Argon2id KDF
+	encryptionKey = deriveKey(key)
+	SetMasterKey(&passphraseMasterKey{key: encryptionKey})
+}
+
+// deriveKey derives a 32-byte AES key from a passphrase.
+func deriveKey(passphrase string) []byte {
 	// We use a fixed salt since this is a system-wide encryption key (not per-user passwords)
 	// Argon2id parameters: time=1, memory=64MB, threads=4, keyLen=32
 	// This provides strong security while maintaining reasonable performance for initialization
 	salt := []byte("bifrost-encryption-v1-salt-2024")
-	encryptionKey = argon2.IDKey([]byte(key), salt, 1, 64*1024, 4, 32)
+	return argon2.IDKey([]byte(passphrase), salt, 1, 64*1024, 4, 32)
 }
 
 // CompareHash compares a hash and a password
@@ -68,41 +80,90 @@ func Hash(password string) (string, error) {
 	return string(hashedPassword), nil
 }
 
-// Encrypt encrypts a plaintext string using AES-256-GCM and returns a base64-encoded ciphertext
+// Encrypt encrypts a plaintext string using AES-256-GCM and returns a base64-encoded ciphertext.
+// Once data keys are loaded, the active data key is used and its ID is prefixed to the ciphertext.
 func Encrypt(plaintext string) (string, error) {
-	if encryptionKey == nil {
+	if !IsEnabled() {
 		return plaintext, nil
 	}
 	if plaintext == "" {
 		return "", nil
 	}
 
-	block, err := aes.NewCipher(encryptionKey)
+	if id, key, ok := activeDataKey(); ok {
+		ciphertext, err := seal(key, []byte(plaintext))
+		if err != nil {
+			return plaintext, err
+		}
+		return dataKeyPrefix + id + ":" + base64.StdEncoding.EncodeToString(ciphertext), nil
+	}
+	if encryptionKey == nil {
+		// A KMS master key without loaded data keys has nothing to encrypt with
+		return plaintext, ErrDataKeysNotLoaded
+	}
+
+	ciphertext, err := seal(encryptionKey, []byte(plaintext))
+	if err != nil {
+		return plaintext, err
+	}
+
+	// Encode to base64 for storage
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// seal encrypts plaintext with key and returns the nonce followed by the ciphertext.
+func seal(key []byte, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
 	if err != nil {
-		return plaintext, fmt.Errorf("failed to create cipher: %w", err)
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
 	}
 
 	aesGCM, err := cipher.NewGCM(block)
 	if err != nil {
-		return plaintext, fmt.Errorf("failed to create GCM: %w", err)
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
 	}
 
 	// Create a nonce (number used once)
 	nonce := make([]byte, aesGCM.NonceSize())
 	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
-		return plaintext, fmt.Errorf("failed to read nonce: %w", err)
+		return nil, fmt.Errorf("failed to read nonce: %w", err)
 	}
 
 	// Encrypt the data
-	ciphertext := aesGCM.Seal(nonce, nonce, []byte(plaintext), nil)
+	return aesGCM.Seal(nonce, nonce, plaintext, nil), nil
+}
 
-	// Encode to base64 for storage
-	return base64.StdEncoding.EncodeToString(ciphertext), nil
+// open decrypts the output of seal with key.
+func open(key []byte, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+
+	aesGCM, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+
+	// Extract nonce
+	nonceSize := aesGCM.NonceSize()
+	if len(data) < nonceSize {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
+	nonce, ciphertextBytes := data[:nonceSize], data[nonceSize:]
+
+	// Decrypt the data
+	plaintext, err := aesGCM.Open(nil, nonce, ciphertextBytes, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt: %w", err)
+	}
+	return plaintext, nil
 }
 
-// IsEnabled returns true if the encryption key has been initialized
+// IsEnabled returns true if an encryption key or a master key has been initialized
 func IsEnabled() bool {
-	return encryptionKey != nil
+	return encryptionKey != nil || GetMasterKey() != nil
 }
 
 // HashSHA256 returns a deterministic hex-encoded SHA-256 hash of the input.
@@ -112,44 +173,55 @@ func HashSHA256(value string) string {
 	return hex.EncodeToString(h[:])
 }
 
-// Decrypt decrypts a base64-encoded ciphertext using AES-256-GCM and returns the plaintext
+// Decrypt decrypts a base64-encoded ciphertext using AES-256-GCM and returns the plaintext.
+// Ciphertexts prefixed with a data key ID are decrypted with that data key, others with the
+// encryption key or, failing that, a previous encryption key.
 func Decrypt(ciphertext string) (string, error) {
-	if encryptionKey == nil {
+	if !IsEnabled() {
 		return ciphertext, ErrEncryptionKeyNotInitialized
 	}
 	if ciphertext == "" {
 		return ciphertext, nil
 	}
 
+	if rest, ok := strings.CutPrefix(ciphertext, dataKeyPrefix); ok {
+		id, encoded, found := strings.Cut(rest, ":")
+		if !found {
+			return "", fmt.Errorf("malformed ciphertext")
+		}
+		key, ok := dataKey(id)
+		if !ok {
+			return "", fmt.Errorf("data key %s is not loaded", id)
+		}
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return "", fmt.Errorf("failed to decode base64: %w", err)
+		}
+		plaintext, err := open(key, data)
+		if err != nil {
+			return "", err
+		}
+		return string(plaintext), nil
+	}
+
 	// Decode from base64
 	data, err := base64.StdEncoding.DecodeString(ciphertext)
 	if err != nil {
 		return "", fmt.Errorf("failed to decode base64: %w", err)
 	}
 
-	block, err := aes.NewCipher(encryptionKey)
-	if err != nil {
-		return "", fmt.Errorf("failed to create cipher: %w", err)
+	keys := previousKeys()
+	if encryptionKey != nil {
+		keys = append([][]byte{encryptionKey}, keys...)
 	}
-
-	aesGCM, err := cipher.NewGCM(block)
-	if err != nil {
-		return "", fmt.Errorf("failed to create GCM: %w", err)
-	}
-
-	// Extract nonce
-	nonceSize := aesGCM.NonceSize()
-	if len(data) < nonceSize {
-		return "", fmt.Errorf("ciphertext too short")
+	if len(keys) == 0 {
+		return ciphertext, ErrEncryptionKeyNotInitialized
 	}
-
-	nonce, ciphertextBytes := data[:nonceSize], data[nonceSize:]
-
-	// Decrypt the data
-	plaintext, err := aesGCM.Open(nil, nonce, ciphertextBytes, nil)
-	if err != nil {
-		return "", fmt.Errorf("failed to decrypt: %w", err)
+	for _, key := range keys {
+		var plaintext []byte
+		if plaintext, err = open(key, data); err == nil {
+			return string(plaintext), nil
+		}
 	}
-
-	return string(plaintext), nil
+	return "", err
 }
@@ -0,0 +1,135 @@
+package encrypt
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+)
+
+// dataKeyPrefix marks ciphertexts encrypted with a data key: v2:<data key id>:<base64 ciphertext>.
+// Ciphertexts without it were encrypted directly with the passphrase-derived key.
+const dataKeyPrefix = "v2:"
+
+// MasterKey wraps the data keys that encrypt stored secrets (envelope encryption). Only wrapped
+// data keys are persisted; the master key itself stays in the environment or in a KMS.
+type MasterKey interface {
+	// ID identifies the master key. It is stored next to the data keys it wraps.
+	ID() string
+	Wrap(ctx context.Context, dataKey []byte) ([]byte, error)
+	Unwrap(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+var (
+	keyringMu          sync.RWMutex
+	masterKey          MasterKey
+	previousMasterKeys []MasterKey
+	previousEncKeys    [][]byte
+	dataKeys           map[string][]byte // data key ID -> data key
+	activeDataKeyID    string
+)
+
+// SetMasterKey sets the master key data keys are wrapped with. Init sets the passphrase master
+// key; a KMS master key set afterwards replaces it, and the passphrase master key is kept to unwrap
+// the data keys it wrapped so they can be re-wrapped with the KMS key.
+func SetMasterKey(key MasterKey) {
+	keyringMu.Lock()
+	defer keyringMu.Unlock()
+	if masterKey != nil && key != nil {
+		previousMasterKeys = append(previousMasterKeys, masterKey)
+	}
+	masterKey = key
+}
+
+// GetMasterKey returns the master key, or nil when encryption is disabled.
+func GetMasterKey() MasterKey {
+	keyringMu.RLock()
+	defer keyringMu.RUnlock()
+	return masterKey
+}
+
+// AddPreviousKey registers a previous encryption passphrase after it was changed. Values and data
+// keys it encrypted stay readable until they are re-encrypted with the current key.
+func AddPreviousKey(passphrase string) {
+	key := deriveKey(passphrase)
+	keyringMu.Lock()
+	defer keyringMu.Unlock()
+	previousEncKeys = append(previousEncKeys, key)
+	previousMasterKeys = append(previousMasterKeys, &passphraseMasterKey{key: key})
+}
+
+// PreviousMasterKeys returns the master keys of the previous passphrases.
+func PreviousMasterKeys() []MasterKey {
+	keyringMu.RLock()
+	defer keyringMu.RUnlock()
+	return append([]MasterKey(nil), previousMasterKeys...)
+}
+
+func previousKeys() [][]byte {
+	keyringMu.RLock()
+	defer keyringMu.RUnlock()
+	return append([][]byte(nil), previousEncKeys...)
+}
+
+// NewDataKey generates a random data key and its ID.
+func NewDataKey() (id string, key []byte, err error) {
+	idBytes := make([]byte, 8)
+	key = make([]byte, 32)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", nil, fmt.Errorf("failed to generate data key id: %w", err)
+	}
+	if _, err := rand.Read(key); err != nil {
+		return "", nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	return hex.EncodeToString(idBytes), key, nil
+}
+
+// SetDataKeys replaces the loaded data keys. New values are encrypted with the active one, the
+// others only decrypt values encrypted before a rotation.
+func SetDataKeys(keys map[string][]byte, activeID string) {
+	keyringMu.Lock()
+	defer keyringMu.Unlock()
+	dataKeys = keys
+	activeDataKeyID = activeID
+}
+
+// ActiveDataKeyID returns the ID of the data key new values are encrypted with, or "" when no
+// data keys are loaded.
+func ActiveDataKeyID() string {
+	keyringMu.RLock()
+	defer keyringMu.RUnlock()
+	return activeDataKeyID
+}
+
+func activeDataKey() (string, []byte, bool) {
+	keyringMu.RLock()
+	defer keyringMu.RUnlock()
+	key, ok := dataKeys[activeDataKeyID]
+	return activeDataKeyID, key, ok
+}
+
+func dataKey(id string) ([]byte, bool) {
+	keyringMu.RLock()
+	defer keyringMu.RUnlock()
+	key, ok := dataKeys[id]
+	return key, ok
+}
+
+// passphraseMasterKey wraps data keys with the key derived from the encryption passphrase.
+type passphraseMasterKey struct {
+	key []byte
+}
+
+// ID is a fingerprint of the derived key, so a changed passphrase is detected.
+func (k *passphraseMasterKey) ID() string {
+	return "passphrase:" + HashSHA256(hex.EncodeToString(k.key))[:16]
+}
+
+func (k *passphraseMasterKey) Wrap(_ context.Context, dataKey []byte) ([]byte, error) {
+	return seal(k.key, dataKey)
+}
+
+func (k *passphraseMasterKey) Unwrap(_ context.Context, wrapped []byte) ([]byte, error) {
+	return open(k.key, wrapped)
+}
@@ -0,0 +1,168 @@
+package encrypt
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/bytedance/sonic"
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func resetEnvelope(t *testing.T) {
+	t.Cleanup(func() {
+		Init("", bifrost.NewDefaultLogger(schemas.LogLevelInfo))
+	})
+}
+
+func TestEncryptDecrypt_DataKeys(t *testing.T) {
+	resetEnvelope(t)
+	Init("test-encryption-key-for-testing-32bytes", bifrost.NewDefaultLogger(schemas.LogLevelInfo))
+	legacy, err := Encrypt("sk-legacy")
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+
+	oldID, oldKey, err := NewDataKey()
+	if err != nil {
+		t.Fatalf("Failed to create data key: %v", err)
+	}
+	SetDataKeys(map[string][]byte{oldID: oldKey}, oldID)
+	old, err := Encrypt("sk-old")
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+	if !strings.HasPrefix(old, dataKeyPrefix+oldID+":") {
+		t.Fatalf("Expected ciphertext prefixed with data key %s, got %s", oldID, old)
+	}
+
+	newID, newKey, err := NewDataKey()
+	if err != nil {
+		t.Fatalf("Failed to create data key: %v", err)
+	}
+	SetDataKeys(map[string][]byte{oldID: oldKey, newID: newKey}, newID)
+
+	for ciphertext, want := range map[string]string{legacy: "sk-legacy", old: "sk-old"} {
+		got, err := Decrypt(ciphertext)
+		if err != nil {
+			t.Fatalf("Failed to decrypt: %v", err)
+		}
+		if got != want {
+			t.Errorf("Expected %s, got %s", want, got)
+		}
+	}
+
+	SetDataKeys(map[string][]byte{newID: newKey}, newID)
+	if _, err := Decrypt(old); err == nil {
+		t.Error("Expected an error decrypting with an unloaded data key")
+	}
+}
+
+func TestDecrypt_PreviousKey(t *testing.T) {
+	resetEnvelope(t)
+	Init("the-previous-encryption-passphrase", bifrost.NewDefaultLogger(schemas.LogLevelInfo))
+	ciphertext, err := Encrypt("sk-before-change")
+	if err != nil {
+		t.Fatalf("Failed to encrypt: %v", err)
+	}
+	previousID := GetMasterKey().ID()
+
+	Init("the-current-encryption-passphrase", bifrost.NewDefaultLogger(schemas.LogLevelInfo))
+	if _, err := Decrypt(ciphertext); err == nil {
+		t.Fatal("Expected an error decrypting with a changed key")
+	}
+	if GetMasterKey().ID() == previousID {
+		t.Error("Expected a different master key ID after changing the passphrase")
+	}
+
+	AddPreviousKey("the-previous-encryption-passphrase")
+	got, err := Decrypt(ciphertext)
+	if err != nil {
+		t.Fatalf("Failed to decrypt with the previous key: %v", err)
+	}
+	if got != "sk-before-change" {
+		t.Errorf("Expected sk-before-change, got %s", got)
+	}
+}
+
+func TestEncrypt_MasterKeyWithoutDataKeys(t *testing.T) {
+	resetEnvelope(t)
+	SetMasterKey(&passphraseMasterKey{key: deriveKey("kms-stand-in")})
+	if !IsEnabled() {
+		t.Fatal("Expected encryption to be enabled with a master key")
+	}
+	if _, err := Encrypt("sk-secret"); err != ErrDataKeysNotLoaded {
+		t.Errorf("Expected ErrDataKeysNotLoaded, got %v", err)
+	}
+}
+
+func TestAWSKMSMasterKey_WrapUnwrap(t *testing.T) {
+	// The fake KMS "encrypts" by reversing the plaintext
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var req map[string]string
+		if err := sonic.ConfigDefault.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.Header.Get("X-Amz-Target") {
+		case "TrentService.Encrypt":
+			if req["KeyId"] != "alias/bifrost" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			data, _ := base64.StdEncoding.DecodeString(req["Plaintext"])
+			_, _ = w.Write([]byte(`{"CiphertextBlob":"` + base64.StdEncoding.EncodeToString(reverse(data)) + `"}`))
+		case "TrentService.Decrypt":
+			data, _ := base64.StdEncoding.DecodeString(req["CiphertextBlob"])
+			_, _ = w.Write([]byte(`{"Plaintext":"` + base64.StdEncoding.EncodeToString(reverse(data)) + `"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	key := &awsKMSMasterKey{
+		keyID:    "alias/bifrost",
+		region:   "us-east-1",
+		endpoint: server.URL,
+		config: aws.Config{Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+			return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}, nil
+		})},
+		client: server.Client(),
+	}
+	if key.ID() != "aws-kms:alias/bifrost" {
+		t.Errorf("Unexpected master key ID %s", key.ID())
+	}
+	_, dataKey, err := NewDataKey()
+	if err != nil {
+		t.Fatalf("Failed to create data key: %v", err)
+	}
+	wrapped, err := key.Wrap(context.Background(), dataKey)
+	if err != nil {
+		t.Fatalf("Failed to wrap: %v", err)
+	}
+	unwrapped, err := key.Unwrap(context.Background(), wrapped)
+	if err != nil {
+		t.Fatalf("Failed to unwrap: %v", err)
+	}
+	if string(unwrapped) != string(dataKey) {
+		t.Error("Unwrapped data key does not match")
+	}
+}
+
+func reverse(data []byte) []byte {
+	out := make([]byte, len(data))
+	for i, b := range data {
+		out[len(data)-1-i] = b
+	}
+	return out
+}
@@ -0,0 +1,126 @@
+package encrypt
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/bytedance/sonic"
+)
+
+const kmsRequestTimeout = 30 * time.Second
+
+// awsKMSMasterKey wraps data keys with the Encrypt and Decrypt APIs of an AWS KMS key, so the
+// master key never leaves KMS.
+type awsKMSMasterKey struct {
+	keyID    string
+	region   string
+	endpoint string
+	config   aws.Config
+	client   *http.Client
+}
+
+// NewAWSKMSMasterKey creates a master key backed by an AWS KMS symmetric key, given as a key ID,
+// key ARN or alias. The region is taken from the ARN, or from the AWS configuration otherwise.
+// Credentials come from the default AWS credential chain.
+func NewAWSKMSMasterKey(ctx context.Context, keyID string) (MasterKey, error) {
+	if keyID == "" {
+		return nil, fmt.Errorf("kms key id is required")
+	}
+	var options []func(*awsconfig.LoadOptions) error
+	// arn:aws:kms:<region>:<account>:key/<id>
+	if parts := strings.Split(keyID, ":"); len(parts) >= 6 && parts[0] == "arn" {
+		options = append(options, awsconfig.WithRegion(parts[3]))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("aws region of kms key %s is unknown: use the key ARN or set AWS_REGION", keyID)
+	}
+	return &awsKMSMasterKey{
+		keyID:    keyID,
+		region:   cfg.Region,
+		endpoint: fmt.Sprintf("https://kms.%s.amazonaws.com/", cfg.Region),
+		config:   cfg,
+		client:   &http.Client{Timeout: kmsRequestTimeout},
+	}, nil
+}
+
+func (k *awsKMSMasterKey) ID() string {
+	return "aws-kms:" + k.keyID
+}
+
+func (k *awsKMSMasterKey) Wrap(ctx context.Context, dataKey []byte) ([]byte, error) {
+	var resp struct {
+		CiphertextBlob string `json:"CiphertextBlob"`
+	}
+	if err := k.call(ctx, "Encrypt", map[string]string{
+		"KeyId":     k.keyID,
+		"Plaintext": base64.StdEncoding.EncodeToString(dataKey),
+	}, &resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.CiphertextBlob)
+}
+
+// Unwrap decrypts without naming the key: KMS reads it from the ciphertext, so data keys wrapped
+// with a previous KMS key stay readable as long as it can still be used.
+func (k *awsKMSMasterKey) Unwrap(ctx context.Context, wrapped []byte) ([]byte, error) {
+	var resp struct {
+		Plaintext string `json:"Plaintext"`
+	}
+	if err := k.call(ctx, "Decrypt", map[string]string{
+		"CiphertextBlob": base64.StdEncoding.EncodeToString(wrapped),
+	}, &resp); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Plaintext)
+}
+
+// call sends a signed request to a KMS JSON API action and decodes its response into out.
+func (k *awsKMSMasterKey) call(ctx context.Context, action string, input map[string]string, out any) error {
+	body, err := sonic.Marshal(input)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, k.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "TrentService."+action)
+
+	creds, err := k.config.Credentials.Retrieve(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve aws credentials: %w", err)
+	}
+	hash := sha256.Sum256(body)
+	if err := v4.NewSigner().SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "kms", k.region, time.Now()); err != nil {
+		return fmt.Errorf("failed to sign request: %w", err)
+	}
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("kms %s failed: %w", action, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("kms %s returned status %d: %s", action, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	if err := sonic.ConfigDefault.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode kms %s response: %w", action, err)
+	}
+	return nil
+}
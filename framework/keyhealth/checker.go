@@ -0,0 +1,229 @@
+// Package keyhealth periodically validates the configured provider keys with a list models call,
+// which providers serve at no cost, and quarantines the keys a provider rejects with a 401 or 403
+// so routing skips them. A quarantined key is released as soon as a check or a request with it
+// succeeds again.
+package keyhealth
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// Status is the health of a key.
+type Status string
+
+const (
+	StatusHealthy     Status = "healthy"      // the last check succeeded
+	StatusQuarantined Status = "quarantined"  // the provider rejected the key, routing skips it
+	StatusCoolingDown Status = "cooling_down" // the key hit a rate limit and is avoided for a while
+	StatusFailing     Status = "failing"      // the last check failed for a reason other than an invalid key
+	StatusUnchecked   Status = "unchecked"    // the key has not been checked yet
+	StatusDisabled    Status = "disabled"     // the key is disabled and not checked
+)
+
+// KeyHealth is the health of a single key.
+type KeyHealth struct {
+	Provider            schemas.ModelProvider        `json:"provider"`
+	KeyID               string                       `json:"key_id"`
+	KeyName             string                       `json:"key_name"`
+	Status              Status                       `json:"status"`
+	LastCheckedAt       *time.Time                   `json:"last_checked_at,omitempty"`
+	LastLatencyMs       int64                        `json:"last_latency_ms,omitempty"`
+	LastError           string                       `json:"last_error,omitempty"`
+	Quarantine          *providerUtils.KeyQuarantine `json:"quarantine,omitempty"`
+	CooldownRemainingMs int64                        `json:"cooldown_remaining_ms,omitempty"`
+}
+
+// ModelLister is the subset of the Bifrost client keys are checked with.
+type ModelLister interface {
+	ListModelsRequest(ctx *schemas.BifrostContext, req *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError)
+}
+
+// KeySource returns the configured keys of every provider.
+type KeySource func() map[schemas.ModelProvider][]schemas.Key
+
+// checkResult is the outcome of the last check of a key.
+type checkResult struct {
+	checkedAt time.Time
+	latency   time.Duration
+	err       string
+}
+
+// Checker periodically checks the configured keys and quarantines the invalid ones.
+type Checker struct {
+	client ModelLister
+	keys   KeySource
+	config Config
+	logger schemas.Logger
+
+	runMu   sync.Mutex // serializes runs
+	mu      sync.RWMutex
+	results map[string]checkResult // key ID -> last check
+	stopCh  chan struct{}
+}
+
+// NewChecker creates a checker validating the keys returned by keys through client.
+func NewChecker(client ModelLister, keys KeySource, config Config, logger schemas.Logger) *Checker {
+	return &Checker{
+		client:  client,
+		keys:    keys,
+		config:  config,
+		logger:  logger,
+		results: make(map[string]checkResult),
+	}
+}
+
+// Start checks every key immediately and then on every configured interval.
+func (c *Checker) Start() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopCh != nil {
+		c.logger.Debug("key health check routine already running")
+		return
+	}
+	c.stopCh = make(chan struct{})
+	stopCh := c.stopCh
+
+	go func() {
+		ticker := time.NewTicker(c.config.interval())
+		defer ticker.Stop()
+		for {
+			c.Run(context.Background())
+			select {
+			case <-ticker.C:
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic key health check routine.
+func (c *Checker) Stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.stopCh != nil {
+		close(c.stopCh)
+		c.stopCh = nil
+	}
+}
+
+// Run checks every enabled key concurrently and returns the health of all configured keys.
+func (c *Checker) Run(ctx context.Context) []KeyHealth {
+	c.runMu.Lock()
+	defer c.runMu.Unlock()
+
+	var wg sync.WaitGroup
+	for provider, keys := range c.keys() {
+		for _, key := range keys {
+			if key.ID == "" || (key.Enabled != nil && !*key.Enabled) {
+				continue
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				c.CheckKey(ctx, provider, key)
+			}()
+		}
+	}
+	wg.Wait()
+	return c.Health()
+}
+
+// CheckKey lists the models of provider with key alone. A 401 or 403 quarantines the key and a
+// success releases it; other failures (timeouts, provider outages) leave its quarantine unchanged.
+func (c *Checker) CheckKey(ctx context.Context, provider schemas.ModelProvider, key schemas.Key) KeyHealth {
+	bfCtx := schemas.NewBifrostContext(ctx, time.Now().Add(c.config.timeout()))
+	defer bfCtx.Cancel()
+	bfCtx.SetValue(schemas.BifrostContextKeySkipPluginPipeline, true)
+	bfCtx.SetValue(schemas.BifrostContextKeyDirectKey, key)
+
+	start := time.Now()
+	_, bifrostErr := c.client.ListModelsRequest(bfCtx, &schemas.BifrostListModelsRequest{Provider: provider})
+	result := checkResult{checkedAt: start, latency: time.Since(start)}
+	if bifrostErr != nil {
+		result.err = bifrost.GetErrorMessage(bifrostErr)
+		if bifrostErr.StatusCode != nil && providerUtils.IsInvalidKeyStatus(*bifrostErr.StatusCode) {
+			if providerUtils.QuarantineKey(key.ID, *bifrostErr.StatusCode, result.err) {
+				c.logger.Warn("key %s (%s) for provider %s failed its health check with status %d, quarantining it", key.ID, key.Name, provider, *bifrostErr.StatusCode)
+			}
+		} else {
+			c.logger.Debug("health check of key %s for provider %s failed: %s", key.ID, provider, result.err)
+		}
+	} else if providerUtils.ReleaseKey(key.ID) {
+		c.logger.Info("key %s (%s) for provider %s passed its health check, releasing it from quarantine", key.ID, key.Name, provider)
+	}
+
+	c.mu.Lock()
+	c.results[key.ID] = result
+	c.mu.Unlock()
+	return c.keyHealth(provider, key)
+}
+
+// Health returns the health of every configured key, ordered by provider and key name.
+func (c *Checker) Health() []KeyHealth {
+	health := []KeyHealth{}
+	for provider, keys := range c.keys() {
+		for _, key := range keys {
+			if key.ID == "" {
+				continue
+			}
+			health = append(health, c.keyHealth(provider, key))
+		}
+	}
+	sort.Slice(health, func(i, j int) bool {
+		if health[i].Provider != health[j].Provider {
+			return health[i].Provider < health[j].Provider
+		}
+		if health[i].KeyName != health[j].KeyName {
+			return health[i].KeyName < health[j].KeyName
+		}
+		return health[i].KeyID < health[j].KeyID
+	})
+	return health
+}
+
+// Release puts a quarantined key back into rotation. It returns false when the key was not quarantined.
+func (c *Checker) Release(keyID string) bool {
+	return providerUtils.ReleaseKey(keyID)
+}
+
+// keyHealth combines the last check of key with its live quarantine and cooldown state.
+func (c *Checker) keyHealth(provider schemas.ModelProvider, key schemas.Key) KeyHealth {
+	health := KeyHealth{Provider: provider, KeyID: key.ID, KeyName: key.Name, Status: StatusUnchecked}
+
+	c.mu.RLock()
+	result, checked := c.results[key.ID]
+	c.mu.RUnlock()
+	if checked {
+		checkedAt := result.checkedAt
+		health.LastCheckedAt = &checkedAt
+		health.LastLatencyMs = result.latency.Milliseconds()
+		health.LastError = result.err
+		if result.err == "" {
+			health.Status = StatusHealthy
+		} else {
+			health.Status = StatusFailing
+		}
+	}
+	if remaining, coolingDown := providerUtils.KeyCooldownRemaining(key.ID); coolingDown {
+		health.Status = StatusCoolingDown
+		health.CooldownRemainingMs = remaining.Milliseconds()
+	}
+	if quarantine, quarantined := providerUtils.GetKeyQuarantine(key.ID); quarantined {
+		health.Status = StatusQuarantined
+		health.Quarantine = &quarantine
+	}
+	if key.Enabled != nil && !*key.Enabled {
+		health.Status = StatusDisabled
+	}
+	return health
+}
@@ -0,0 +1,94 @@
+package keyhealth
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+// fakeLister answers list models requests with the status code configured for the direct key.
+type fakeLister struct {
+	statusCodes map[string]int
+}
+
+func (f *fakeLister) ListModelsRequest(ctx *schemas.BifrostContext, req *schemas.BifrostListModelsRequest) (*schemas.BifrostListModelsResponse, *schemas.BifrostError) {
+	key, _ := ctx.Value(schemas.BifrostContextKeyDirectKey).(schemas.Key)
+	if statusCode := f.statusCodes[key.ID]; statusCode != 0 {
+		return nil, &schemas.BifrostError{StatusCode: bifrost.Ptr(statusCode), Error: &schemas.ErrorField{Message: http.StatusText(statusCode)}}
+	}
+	return &schemas.BifrostListModelsResponse{}, nil
+}
+
+func TestCheckerRun(t *testing.T) {
+	keys := []schemas.Key{
+		{ID: "keyhealth-test-valid", Name: "valid"},
+		{ID: "keyhealth-test-revoked", Name: "revoked"},
+		{ID: "keyhealth-test-outage", Name: "outage"},
+		{ID: "keyhealth-test-disabled", Name: "disabled", Enabled: bifrost.Ptr(false)},
+	}
+	for _, key := range keys {
+		defer providerUtils.ReleaseKey(key.ID)
+	}
+	lister := &fakeLister{statusCodes: map[string]int{
+		"keyhealth-test-revoked":  http.StatusUnauthorized,
+		"keyhealth-test-outage":   http.StatusServiceUnavailable,
+		"keyhealth-test-disabled": http.StatusUnauthorized,
+	}}
+	checker := NewChecker(lister, func() map[schemas.ModelProvider][]schemas.Key {
+		return map[schemas.ModelProvider][]schemas.Key{schemas.OpenAI: keys}
+	}, Config{}, &testLogger{})
+
+	// A key quarantined by an earlier request is released once its check passes
+	providerUtils.QuarantineKey("keyhealth-test-valid", http.StatusForbidden, "")
+
+	health := checker.Run(context.Background())
+	want := map[string]Status{
+		"keyhealth-test-valid":    StatusHealthy,
+		"keyhealth-test-revoked":  StatusQuarantined,
+		"keyhealth-test-outage":   StatusFailing,
+		"keyhealth-test-disabled": StatusDisabled,
+	}
+	if len(health) != len(want) {
+		t.Fatalf("expected %d keys, got %+v", len(want), health)
+	}
+	for _, h := range health {
+		if h.Status != want[h.KeyID] {
+			t.Errorf("key %s: status = %s, want %s", h.KeyID, h.Status, want[h.KeyID])
+		}
+	}
+	if _, quarantined := providerUtils.GetKeyQuarantine("keyhealth-test-outage"); quarantined {
+		t.Error("a provider outage must not quarantine the key")
+	}
+	if _, quarantined := providerUtils.GetKeyQuarantine("keyhealth-test-disabled"); quarantined {
+		t.Error("disabled keys must not be checked")
+	}
+	if health[0].KeyName != "disabled" || health[len(health)-1].KeyName != "valid" {
+		t.Errorf("expected keys ordered by name, got %+v", health)
+	}
+
+	if !checker.Release("keyhealth-test-revoked") {
+		t.Error("expected the revoked key to be released")
+	}
+	for _, h := range checker.Health() {
+		if h.KeyID == "keyhealth-test-revoked" && (h.Status != StatusFailing || h.Quarantine != nil) {
+			t.Errorf("released key health = %+v", h)
+		}
+	}
+}
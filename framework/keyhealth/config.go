@@ -0,0 +1,31 @@
+package keyhealth
+
+import "time"
+
+const (
+	DefaultInterval = 5 * time.Minute
+	MinInterval     = 30 * time.Second
+	DefaultTimeout  = 15 * time.Second
+)
+
+// Config holds the configuration for the key health check job.
+type Config struct {
+	Enabled         bool `json:"enabled"`
+	IntervalSeconds int  `json:"interval_seconds,omitempty"` // How often every key is checked. Default is 5 minutes, minimum is 30 seconds.
+	TimeoutSeconds  int  `json:"timeout_seconds,omitempty"`  // Timeout of the check of a single key. Default is 15 seconds.
+}
+
+// interval returns the configured check interval, falling back to the default and clamping to the minimum.
+func (c *Config) interval() time.Duration {
+	if c.IntervalSeconds <= 0 {
+		return DefaultInterval
+	}
+	return max(time.Duration(c.IntervalSeconds)*time.Second, MinInterval)
+}
+
+func (c *Config) timeout() time.Duration {
+	if c.TimeoutSeconds <= 0 {
+		return DefaultTimeout
+	}
+	return time.Duration(c.TimeoutSeconds) * time.Second
+}
@@ -0,0 +1,63 @@
+package leaderelection
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/capsohq/bifrost/framework/clustersync"
+)
+
+// Backends the leader lease can be held on.
+const (
+	BackendConfigStore = "config_store"
+	BackendRedis       = "redis"
+)
+
+// Defaults of the leader lease.
+const (
+	DefaultKey                  = "bifrost_leader"
+	DefaultLeaseDurationSeconds = 30
+)
+
+// Config holds the configuration of the leader election between Bifrost replicas.
+type Config struct {
+	Enabled              bool                     `json:"enabled"`
+	Backend              string                   `json:"backend"`                          // config_store or redis
+	Key                  string                   `json:"key,omitempty"`                    // Lock key or Redis key of the lease. Default is "bifrost_leader".
+	LeaseDurationSeconds int                      `json:"lease_duration_seconds,omitempty"` // Time a crashed leader keeps the lease. Default is 30.
+	Redis                *clustersync.RedisConfig `json:"redis,omitempty"`                  // Required for the redis backend
+}
+
+// Validate checks the config names a known backend with its connection settings.
+func (c *Config) Validate() error {
+	switch c.Backend {
+	case BackendConfigStore:
+	case BackendRedis:
+		if c.Redis == nil || c.Redis.Addr == nil || c.Redis.Addr.GetValue() == "" {
+			return fmt.Errorf("leader election redis addr is required")
+		}
+		if c.Redis.DB < 0 {
+			return fmt.Errorf("leader election redis db cannot be negative")
+		}
+	default:
+		return fmt.Errorf("unsupported leader election backend %q, expected config_store or redis", c.Backend)
+	}
+	if c.LeaseDurationSeconds < 0 {
+		return fmt.Errorf("leader election lease_duration_seconds cannot be negative")
+	}
+	return nil
+}
+
+func (c *Config) key() string {
+	if c.Key == "" {
+		return DefaultKey
+	}
+	return c.Key
+}
+
+func (c *Config) leaseDuration() time.Duration {
+	if c.LeaseDurationSeconds <= 0 {
+		return DefaultLeaseDurationSeconds * time.Second
+	}
+	return time.Duration(c.LeaseDurationSeconds) * time.Second
+}
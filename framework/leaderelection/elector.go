@@ -0,0 +1,201 @@
+// Package leaderelection elects one of the Bifrost replicas sharing a config store to run the
+// background workers that write shared state, such as the pricing sync and the persistence of
+// discovered provider models. The leader holds a lease on a config store lock row or a Redis key
+// and renews it while it runs; when it stops renewing, another replica takes the lease over once
+// it expires.
+package leaderelection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+)
+
+// lease is the lease of a backend a replica leads while it holds it.
+type lease interface {
+	// acquire takes the lease if no other replica holds it.
+	acquire(ctx context.Context) (bool, error)
+	// renew extends the lease, it returns false when another replica holds it.
+	renew(ctx context.Context) (bool, error)
+	release(ctx context.Context) error
+	close() error
+}
+
+const releaseTimeout = 5 * time.Second
+
+// Elector campaigns for the lease and reports whether this replica is the leader.
+type Elector struct {
+	lease         lease
+	leaseDuration time.Duration
+	logger        schemas.Logger
+
+	leader    atomic.Bool
+	renewedAt time.Time // last successful acquire or renew, only used by the campaign
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// New creates an elector for the backend of config. store is the config store, which holds the
+// lease of the config_store backend.
+func New(ctx context.Context, config Config, store configstore.LockStore, logger schemas.Logger) (*Elector, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	var l lease
+	var err error
+	switch config.Backend {
+	case BackendConfigStore:
+		l, err = newConfigStoreLease(store, config.key(), config.leaseDuration(), logger)
+	case BackendRedis:
+		l, err = newRedisLease(ctx, config.Redis, config.key(), config.leaseDuration())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newElector(l, config.leaseDuration(), logger), nil
+}
+
+func newElector(l lease, leaseDuration time.Duration, logger schemas.Logger) *Elector {
+	return &Elector{
+		lease:         l,
+		leaseDuration: leaseDuration,
+		logger:        logger,
+	}
+}
+
+// IsLeader reports whether this replica holds the lease. A nil elector, when leader election is
+// disabled, always leads so a single replica runs every worker.
+func (e *Elector) IsLeader() bool {
+	if e == nil {
+		return true
+	}
+	return e.leader.Load()
+}
+
+// Start campaigns for the lease once before returning, so the first replica leads right away,
+// and then keeps acquiring or renewing it until ctx is done or Close is called.
+func (e *Elector) Start(ctx context.Context) {
+	ctx, e.cancel = context.WithCancel(ctx)
+	e.campaign(ctx)
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(e.retryInterval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				e.campaign(ctx)
+			}
+		}
+	}()
+}
+
+// Close stops campaigning and releases the lease, so another replica can take over without
+// waiting for it to expire.
+func (e *Elector) Close() error {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.wg.Wait()
+	if e.leader.Swap(false) {
+		ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+		defer cancel()
+		if err := e.lease.release(ctx); err != nil {
+			e.logger.Warn("failed to release leader lease: %v", err)
+		}
+	}
+	return e.lease.close()
+}
+
+// retryInterval is the interval the lease is acquired or renewed at, a third of its duration so
+// a renewal can fail twice before the lease expires.
+func (e *Elector) retryInterval() time.Duration {
+	return e.leaseDuration / 3
+}
+
+// campaign renews the lease when this replica leads and tries to acquire it otherwise.
+func (e *Elector) campaign(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, e.retryInterval())
+	defer cancel()
+
+	if !e.leader.Load() {
+		acquired, err := e.lease.acquire(ctx)
+		if err != nil {
+			e.logger.Debug("failed to acquire leader lease: %v", err)
+			return
+		}
+		if acquired {
+			e.renewedAt = time.Now()
+			e.leader.Store(true)
+			e.logger.Info("this replica is now the leader and runs the background workers")
+		}
+		return
+	}
+
+	held, err := e.lease.renew(ctx)
+	switch {
+	case err == nil && held:
+		e.renewedAt = time.Now()
+	case err == nil:
+		e.leader.Store(false)
+		e.logger.Warn("leader lease was taken over by another replica, stopping the background workers")
+	case time.Since(e.renewedAt)+e.retryInterval() >= e.leaseDuration:
+		// The lease may expire before the next renewal, step down before another replica takes it over
+		e.leader.Store(false)
+		e.logger.Warn("failed to renew leader lease, stopping the background workers: %v", err)
+	default:
+		e.logger.Warn("failed to renew leader lease, retrying: %v", err)
+	}
+}
+
+// configStoreLease is a distributed lock row of the config store.
+type configStoreLease struct {
+	lock *configstore.DistributedLock
+}
+
+func newConfigStoreLease(store configstore.LockStore, key string, duration time.Duration, logger schemas.Logger) (*configStoreLease, error) {
+	if store == nil {
+		return nil, fmt.Errorf("leader election config_store backend requires a config store")
+	}
+	lock, err := configstore.NewDistributedLockManager(store, logger).NewLockWithTTL(key, duration)
+	if err != nil {
+		return nil, err
+	}
+	return &configStoreLease{lock: lock}, nil
+}
+
+func (l *configStoreLease) acquire(ctx context.Context) (bool, error) {
+	return l.lock.TryLock(ctx)
+}
+
+func (l *configStoreLease) renew(ctx context.Context) (bool, error) {
+	if err := l.lock.Extend(ctx); err != nil {
+		if errors.Is(err, configstore.ErrLockNotHeld) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (l *configStoreLease) release(ctx context.Context) error {
+	if err := l.lock.Unlock(ctx); err != nil && !errors.Is(err, configstore.ErrLockNotHeld) {
+		return err
+	}
+	return nil
+}
+
+// close is a no-op, the database belongs to the config store.
+func (l *configStoreLease) close() error {
+	return nil
+}
@@ -0,0 +1,142 @@
+package leaderelection
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/clustersync"
+	"github.com/capsohq/bifrost/framework/configstore"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+func newTestStore(t *testing.T) configstore.ConfigStore {
+	t.Helper()
+	store, err := configstore.NewConfigStore(context.Background(), &configstore.Config{
+		Enabled: true,
+		Type:    configstore.ConfigStoreTypeSQLite,
+		Config:  &configstore.SQLiteConfig{Path: filepath.Join(t.TempDir(), "config.db")},
+	}, &testLogger{})
+	require.NoError(t, err)
+	t.Cleanup(func() { store.Close(context.Background()) })
+	return store
+}
+
+func newConfigStoreElector(t *testing.T, store configstore.ConfigStore, duration time.Duration) *Elector {
+	t.Helper()
+	l, err := newConfigStoreLease(store, DefaultKey, duration, &testLogger{})
+	require.NoError(t, err)
+	return newElector(l, duration, &testLogger{})
+}
+
+func TestElector_OneReplicaLeadsAndHandsOverOnClose(t *testing.T) {
+	store := newTestStore(t)
+	first := newConfigStoreElector(t, store, 300*time.Millisecond)
+	second := newConfigStoreElector(t, store, 300*time.Millisecond)
+
+	first.Start(context.Background())
+	second.Start(context.Background())
+	defer second.Close()
+	assert.True(t, first.IsLeader())
+	assert.False(t, second.IsLeader())
+
+	require.NoError(t, first.Close())
+	assert.False(t, first.IsLeader())
+	assert.Eventually(t, second.IsLeader, time.Second, 20*time.Millisecond, "second replica should take over the released lease")
+}
+
+func TestElector_ExpiredLeaseIsTakenOver(t *testing.T) {
+	store := newTestStore(t)
+	first := newConfigStoreElector(t, store, 200*time.Millisecond)
+	second := newConfigStoreElector(t, store, 200*time.Millisecond)
+
+	first.campaign(context.Background())
+	second.campaign(context.Background())
+	require.True(t, first.IsLeader())
+	require.False(t, second.IsLeader())
+
+	// The first replica stalls past its lease, the second takes it over
+	time.Sleep(250 * time.Millisecond)
+	second.campaign(context.Background())
+	assert.True(t, second.IsLeader())
+
+	first.campaign(context.Background())
+	assert.False(t, first.IsLeader(), "a replica whose lease was taken over should step down")
+}
+
+// failingLease is a lease whose renewals fail.
+type failingLease struct{}
+
+func (l *failingLease) acquire(ctx context.Context) (bool, error) { return true, nil }
+func (l *failingLease) renew(ctx context.Context) (bool, error) {
+	return false, errors.New("connection refused")
+}
+func (l *failingLease) release(ctx context.Context) error { return nil }
+func (l *failingLease) close() error                      { return nil }
+
+func TestElector_StepsDownBeforeFailingLeaseExpires(t *testing.T) {
+	e := newElector(&failingLease{}, 300*time.Millisecond, &testLogger{})
+	e.campaign(context.Background())
+	require.True(t, e.IsLeader())
+
+	// A single failed renewal keeps the lease
+	e.campaign(context.Background())
+	assert.True(t, e.IsLeader())
+
+	// A renewal failing when the lease may expire before the next one steps down
+	time.Sleep(200 * time.Millisecond)
+	e.campaign(context.Background())
+	assert.False(t, e.IsLeader())
+}
+
+func TestElector_NilElectorLeads(t *testing.T) {
+	var e *Elector
+	assert.True(t, e.IsLeader())
+}
+
+func TestNew_ConfigStoreBackendRequiresConfigStore(t *testing.T) {
+	_, err := New(context.Background(), Config{Enabled: true, Backend: BackendConfigStore}, nil, &testLogger{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "requires a config store")
+}
+
+func TestConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{name: "config store", config: Config{Backend: BackendConfigStore}},
+		{name: "redis", config: Config{Backend: BackendRedis, Redis: &clustersync.RedisConfig{Addr: schemas.NewEnvVar("localhost:6379")}}},
+		{name: "redis without addr", config: Config{Backend: BackendRedis}, wantErr: true},
+		{name: "negative lease duration", config: Config{Backend: BackendConfigStore, LeaseDurationSeconds: -1}, wantErr: true},
+		{name: "unknown backend", config: Config{Backend: "etcd"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
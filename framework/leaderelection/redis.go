@@ -0,0 +1,70 @@
+package leaderelection
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/capsohq/bifrost/framework/clustersync"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// renewScript extends the lease key only while it still holds the value of this replica.
+var renewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+// releaseScript deletes the lease key only while it still holds the value of this replica.
+var releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// redisLease is a Redis key set to the ID of the replica holding it, expiring after the lease duration.
+type redisLease struct {
+	client   *redis.Client
+	key      string
+	holderID string
+	duration time.Duration
+}
+
+// newRedisLease connects to the Redis server of config and checks it is reachable.
+func newRedisLease(ctx context.Context, config *clustersync.RedisConfig, key string, duration time.Duration) (*redisLease, error) {
+	l := &redisLease{
+		client:   config.NewClient(),
+		key:      key,
+		holderID: uuid.NewString(),
+		duration: duration,
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := l.client.Ping(pingCtx).Err(); err != nil {
+		l.client.Close()
+		return nil, fmt.Errorf("failed to connect to leader election redis at %s: %w", config.Addr.GetValue(), err)
+	}
+	return l, nil
+}
+
+func (l *redisLease) acquire(ctx context.Context) (bool, error) {
+	return l.client.SetNX(ctx, l.key, l.holderID, l.duration).Result()
+}
+
+func (l *redisLease) renew(ctx context.Context) (bool, error) {
+	renewed, err := renewScript.Run(ctx, l.client, []string{l.key}, l.holderID, l.duration.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	return renewed == 1, nil
+}
+
+func (l *redisLease) release(ctx context.Context) error {
+	return releaseScript.Run(ctx, l.client, []string{l.key}, l.holderID).Err()
+}
+
+func (l *redisLease) close() error {
+	return l.client.Close()
+}
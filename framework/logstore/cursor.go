@@ -0,0 +1,36 @@
+package logstore
+
+import (
+	"encoding/base64"
+	"time"
+
+	"github.com/bytedance/sonic"
+)
+
+// logCursor is the position of the last log of a page sorted by timestamp
+type logCursor struct {
+	Timestamp time.Time `json:"t"`
+	ID        string    `json:"id"`
+}
+
+// encodeLogCursor encodes the position of a log as an opaque cursor
+func encodeLogCursor(timestamp time.Time, id string) string {
+	data, err := sonic.Marshal(logCursor{Timestamp: timestamp, ID: id})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// decodeLogCursor decodes a cursor returned by encodeLogCursor
+func decodeLogCursor(cursor string) (*logCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var decoded logCursor
+	if err := sonic.Unmarshal(data, &decoded); err != nil || decoded.ID == "" || decoded.Timestamp.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	return &decoded, nil
+}
@@ -3,5 +3,6 @@ package logstore
 import "fmt"
 
 var (
-	ErrNotFound = fmt.Errorf("log not found")
+	ErrNotFound      = fmt.Errorf("log not found")
+	ErrInvalidCursor = fmt.Errorf("invalid cursor")
 )
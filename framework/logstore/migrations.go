@@ -157,6 +157,12 @@ func triggerMigrations(ctx context.Context, db *gorm.DB) error {
 	if err := migrationAddProviderHistogramIndex(ctx, db); err != nil {
 		return err
 	}
+	if err := migrationAddTraceIDColumn(ctx, db); err != nil {
+		return err
+	}
+	if err := migrationAddTagsColumn(ctx, db); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1562,3 +1568,81 @@ func migrationAddProviderHistogramIndex(ctx context.Context, db *gorm.DB) error
 	}
 	return nil
 }
+
+// migrationAddTraceIDColumn adds the indexed trace_id column to the logs table
+func migrationAddTraceIDColumn(ctx context.Context, db *gorm.DB) error {
+	opts := *migrator.DefaultOptions
+	opts.UseTransaction = true
+	m := migrator.New(db, &opts, []*migrator.Migration{{
+		ID: "logs_add_trace_id_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+			if !migrator.HasColumn(&Log{}, "trace_id") {
+				if err := migrator.AddColumn(&Log{}, "trace_id"); err != nil {
+					return err
+				}
+			}
+			if !migrator.HasIndex(&Log{}, "idx_logs_trace_id") {
+				if err := migrator.CreateIndex(&Log{}, "idx_logs_trace_id"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+			if migrator.HasIndex(&Log{}, "idx_logs_trace_id") {
+				if err := migrator.DropIndex(&Log{}, "idx_logs_trace_id"); err != nil {
+					return err
+				}
+			}
+			if migrator.HasColumn(&Log{}, "trace_id") {
+				if err := migrator.DropColumn(&Log{}, "trace_id"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}})
+	err := m.Migrate()
+	if err != nil {
+		return fmt.Errorf("error while adding trace_id column: %s", err.Error())
+	}
+	return nil
+}
+
+// migrationAddTagsColumn adds the tags column to the logs table
+func migrationAddTagsColumn(ctx context.Context, db *gorm.DB) error {
+	opts := *migrator.DefaultOptions
+	opts.UseTransaction = true
+	m := migrator.New(db, &opts, []*migrator.Migration{{
+		ID: "logs_add_tags_column",
+		Migrate: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+			if !migrator.HasColumn(&Log{}, "tags") {
+				if err := migrator.AddColumn(&Log{}, "tags"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		Rollback: func(tx *gorm.DB) error {
+			tx = tx.WithContext(ctx)
+			migrator := tx.Migrator()
+			if migrator.HasColumn(&Log{}, "tags") {
+				if err := migrator.DropColumn(&Log{}, "tags"); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}})
+	err := m.Migrate()
+	if err != nil {
+		return fmt.Errorf("error while adding tags column: %s", err.Error())
+	}
+	return nil
+}
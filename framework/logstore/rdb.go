@@ -99,6 +99,21 @@ func (s *RDBLogStore) applyFilters(baseQuery *gorm.DB, filters SearchFilters) *g
 			baseQuery = baseQuery.Where(strings.Join(engineConditions, " OR "), engineArgs...)
 		}
 	}
+	if len(filters.TraceIDs) > 0 {
+		baseQuery = baseQuery.Where("trace_id IN ?", filters.TraceIDs)
+	}
+	if len(filters.Tags) > 0 {
+		// Tags are stored as comma-separated "key=value" pairs: match whole pairs, logs must have every tag
+		concatExpr := "CONCAT(',', tags, ',')"
+		if s.db.Dialector.Name() == "sqlite" {
+			concatExpr = "',' || tags || ','"
+		}
+		for _, tag := range filters.Tags {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				baseQuery = baseQuery.Where(concatExpr+" LIKE ?", "%,"+tag+",%")
+			}
+		}
+	}
 	if filters.StartTime != nil {
 		baseQuery = baseQuery.Where("timestamp >= ?", *filters.StartTime)
 	}
@@ -212,18 +227,21 @@ func (s *RDBLogStore) SearchLogs(ctx context.Context, filters SearchFilters, pag
 		direction = "ASC"
 	}
 
+	// Timestamp ordering is broken by ID so that cursors address a unique position
+	sortByTimestamp := true
 	var orderClause string
 	switch pagination.SortBy {
-	case "timestamp":
-		orderClause = "timestamp " + direction
 	case "latency":
 		orderClause = "latency " + direction
+		sortByTimestamp = false
 	case "tokens":
 		orderClause = "total_tokens " + direction
+		sortByTimestamp = false
 	case "cost":
 		orderClause = "cost " + direction
+		sortByTimestamp = false
 	default:
-		orderClause = "timestamp " + direction
+		orderClause = "timestamp " + direction + ", id " + direction
 	}
 
 	// Execute main query with sorting and pagination.
@@ -236,7 +254,20 @@ func (s *RDBLogStore) SearchLogs(ctx context.Context, filters SearchFilters, pag
 	if pagination.Limit > 0 {
 		mainQuery = mainQuery.Limit(pagination.Limit)
 	}
-	if pagination.Offset > 0 {
+	if pagination.Cursor != "" {
+		if !sortByTimestamp {
+			return nil, ErrInvalidCursor
+		}
+		cursor, err := decodeLogCursor(pagination.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		comparison := "<"
+		if direction == "ASC" {
+			comparison = ">"
+		}
+		mainQuery = mainQuery.Where("(timestamp "+comparison+" ? OR (timestamp = ? AND id "+comparison+" ?))", cursor.Timestamp, cursor.Timestamp, cursor.ID)
+	} else if pagination.Offset > 0 {
 		mainQuery = mainQuery.Offset(pagination.Offset)
 	}
 
@@ -253,6 +284,12 @@ func (s *RDBLogStore) SearchLogs(ctx context.Context, filters SearchFilters, pag
 		return nil, err
 	}
 
+	pagination.NextCursor = ""
+	if sortByTimestamp && pagination.Limit > 0 && len(logs) == pagination.Limit {
+		last := logs[len(logs)-1]
+		pagination.NextCursor = encodeLogCursor(last.Timestamp, last.ID)
+	}
+
 	hasLogs := len(logs) > 0
 	if !hasLogs {
 		hasLogs, err = s.HasLogs(ctx)
@@ -1194,6 +1231,66 @@ func (s *RDBLogStore) GetProviderTokenHistogram(ctx context.Context, filters Sea
 	}, nil
 }
 
+// GetUsageSummary returns successful request counts and token usage grouped by time bucket, provider and model.
+// Only successful requests are counted, since failed requests are generally not billed by providers.
+func (s *RDBLogStore) GetUsageSummary(ctx context.Context, filters SearchFilters, bucketSizeSeconds int64) ([]UsageSummaryRow, error) {
+	if bucketSizeSeconds <= 0 {
+		bucketSizeSeconds = 86400
+	}
+
+	dialect := s.db.Dialector.Name()
+
+	baseQuery := s.db.WithContext(ctx).Model(&Log{})
+	baseQuery = s.applyFilters(baseQuery, filters)
+	baseQuery = baseQuery.Where("status = ?", "success")
+
+	var results []struct {
+		BucketTimestamp  int64  `gorm:"column:bucket_timestamp"`
+		Provider         string `gorm:"column:provider"`
+		Model            string `gorm:"column:model"`
+		Requests         int64  `gorm:"column:requests"`
+		PromptTokens     int64  `gorm:"column:prompt_tokens"`
+		CompletionTokens int64  `gorm:"column:completion_tokens"`
+	}
+
+	var bucketExpr string
+	switch dialect {
+	case "sqlite":
+		bucketExpr = fmt.Sprintf("(CAST(strftime('%%s', timestamp) AS INTEGER) / %d) * %d", bucketSizeSeconds, bucketSizeSeconds)
+	case "mysql":
+		bucketExpr = fmt.Sprintf("(FLOOR(UNIX_TIMESTAMP(timestamp) / %d) * %d)", bucketSizeSeconds, bucketSizeSeconds)
+	default:
+		bucketExpr = fmt.Sprintf("CAST(FLOOR(EXTRACT(EPOCH FROM timestamp) / %d) * %d AS BIGINT)", bucketSizeSeconds, bucketSizeSeconds)
+	}
+	selectClause := bucketExpr + ` as bucket_timestamp,
+		provider,
+		model,
+		COUNT(*) as requests,
+		COALESCE(SUM(prompt_tokens), 0) as prompt_tokens,
+		COALESCE(SUM(completion_tokens), 0) as completion_tokens`
+
+	if err := baseQuery.
+		Select(selectClause).
+		Group("bucket_timestamp, provider, model").
+		Order("bucket_timestamp ASC").
+		Find(&results).Error; err != nil {
+		return nil, fmt.Errorf("failed to get usage summary: %w", err)
+	}
+
+	rows := make([]UsageSummaryRow, 0, len(results))
+	for _, r := range results {
+		rows = append(rows, UsageSummaryRow{
+			Timestamp:        time.Unix(r.BucketTimestamp, 0).UTC(),
+			Provider:         r.Provider,
+			Model:            r.Model,
+			Requests:         r.Requests,
+			PromptTokens:     r.PromptTokens,
+			CompletionTokens: r.CompletionTokens,
+		})
+	}
+	return rows, nil
+}
+
 // GetProviderLatencyHistogram returns time-bucketed latency percentiles with provider breakdown for the given filters.
 func (s *RDBLogStore) GetProviderLatencyHistogram(ctx context.Context, filters SearchFilters, bucketSizeSeconds int64) (*ProviderLatencyHistogramResult, error) {
 	if bucketSizeSeconds <= 0 {
@@ -1,6 +1,7 @@
 package logstore
 
 import (
+	"slices"
 	"strings"
 	"time"
 
@@ -37,6 +38,8 @@ type SearchFilters struct {
 	VirtualKeyIDs     []string   `json:"virtual_key_ids,omitempty"`
 	RoutingRuleIDs    []string   `json:"routing_rule_ids,omitempty"`
 	RoutingEngineUsed []string   `json:"routing_engine_used,omitempty"` // For filtering by routing engine (routing-rule, governance, loadbalancing)
+	TraceIDs          []string   `json:"trace_ids,omitempty"`
+	Tags              []string   `json:"tags,omitempty"` // "key=value" request tags, logs must have all of them
 	StartTime         *time.Time `json:"start_time,omitempty"`
 	EndTime           *time.Time `json:"end_time,omitempty"`
 	MinLatency        *float64   `json:"min_latency,omitempty"`
@@ -56,6 +59,8 @@ type PaginationOptions struct {
 	SortBy     string `json:"sort_by"`     // "timestamp", "latency", "tokens", "cost"
 	Order      string `json:"order"`       // "asc", "desc"
 	TotalCount int64  `json:"total_count"` // Total number of items matching the query
+	Cursor     string `json:"cursor,omitempty"`      // Opaque cursor from a previous page, replaces offset (logs sorted by timestamp only)
+	NextCursor string `json:"next_cursor,omitempty"` // Cursor of the next page, empty on the last page
 }
 
 // SearchResult represents the result of a log search
@@ -79,6 +84,7 @@ type SearchStats struct {
 type Log struct {
 	ID                    string    `gorm:"primaryKey;type:varchar(255)" json:"id"`
 	ParentRequestID       *string   `gorm:"type:varchar(255)" json:"parent_request_id"`
+	TraceID               *string   `gorm:"type:varchar(255);index:idx_logs_trace_id" json:"trace_id,omitempty"`
 	Timestamp             time.Time `gorm:"index;index:idx_logs_ts_provider_status,priority:1;not null" json:"timestamp"`
 	Object                string    `gorm:"type:varchar(255);index;not null;column:object_type" json:"object"` // text.completion, chat.completion, or embedding
 	Provider              string    `gorm:"type:varchar(255);index;index:idx_logs_ts_provider_status,priority:2;not null" json:"provider"`
@@ -90,6 +96,7 @@ type Log struct {
 	VirtualKeyID          *string   `gorm:"type:varchar(255);index:idx_logs_virtual_key_id" json:"virtual_key_id"`
 	VirtualKeyName        *string   `gorm:"type:varchar(255)" json:"virtual_key_name"`
 	RoutingEnginesUsedStr *string   `gorm:"type:varchar(255);column:routing_engines_used" json:"-"` // Comma-separated routing engines
+	TagsStr               *string   `gorm:"type:text;column:tags" json:"-"`                         // Comma-separated sorted "key=value" request tags
 	RoutingRuleID         *string   `gorm:"type:varchar(255);index:idx_logs_routing_rule_id" json:"routing_rule_id"`
 	RoutingRuleName       *string   `gorm:"type:varchar(255)" json:"routing_rule_name"`
 	InputHistory          string    `gorm:"type:text" json:"-"` // JSON serialized []schemas.ChatMessage
@@ -136,6 +143,7 @@ type Log struct {
 
 	// Virtual fields for JSON output - these will be populated when needed
 	RoutingEnginesUsed          []string                                `gorm:"-" json:"routing_engines_used,omitempty"` // Virtual field deserialized from JSON
+	Tags                        map[string]string                       `gorm:"-" json:"tags,omitempty"`                 // Request tags for cost attribution
 	InputHistoryParsed          []schemas.ChatMessage                   `gorm:"-" json:"input_history,omitempty"`
 	ResponsesInputHistoryParsed []schemas.ResponsesMessage              `gorm:"-" json:"responses_input_history,omitempty"`
 	OutputMessageParsed         *schemas.ChatMessage                    `gorm:"-" json:"output_message,omitempty"`
@@ -215,6 +223,13 @@ func (l *Log) SerializeFields() error {
 		l.RoutingEnginesUsedStr = nil
 	}
 
+	if len(l.Tags) > 0 {
+		tagsStr := formatTags(l.Tags)
+		l.TagsStr = &tagsStr
+	} else {
+		l.TagsStr = nil
+	}
+
 	if l.InputHistoryParsed != nil {
 		if data, err := sonic.Marshal(l.InputHistoryParsed); err != nil {
 			return err
@@ -433,6 +448,17 @@ func (l *Log) SerializeFields() error {
 	return nil
 }
 
+// formatTags formats request tags as comma-separated "key=value" pairs sorted by key, the format of the
+// tags column, which tag filters match whole pairs of.
+func formatTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for key, value := range tags {
+		pairs = append(pairs, key+"="+value)
+	}
+	slices.Sort(pairs)
+	return strings.Join(pairs, ",")
+}
+
 // DeserializeFields converts JSON strings back to Go structs
 func (l *Log) DeserializeFields() error {
 	if l.InputHistory != "" {
@@ -624,6 +650,15 @@ func (l *Log) DeserializeFields() error {
 		l.RoutingEnginesUsed = []string{}
 	}
 
+	if l.TagsStr != nil && *l.TagsStr != "" {
+		l.Tags = make(map[string]string)
+		for tag := range strings.SplitSeq(*l.TagsStr, ",") {
+			if key, value, ok := strings.Cut(tag, "="); ok {
+				l.Tags[key] = value
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -1130,6 +1165,16 @@ type ProviderTokenHistogramResult struct {
 	Providers         []string                       `json:"providers"`
 }
 
+// UsageSummaryRow represents metered usage of a single provider/model pair within one time bucket
+type UsageSummaryRow struct {
+	Timestamp        time.Time `json:"timestamp"`
+	Provider         string    `json:"provider"`
+	Model            string    `json:"model"`
+	Requests         int64     `json:"requests"`
+	PromptTokens     int64     `json:"prompt_tokens"`
+	CompletionTokens int64     `json:"completion_tokens"`
+}
+
 // ProviderLatencyStats represents latency statistics for a single provider
 type ProviderLatencyStats struct {
 	AvgLatency    float64 `json:"avg_latency"`
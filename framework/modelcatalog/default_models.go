@@ -27,6 +27,12 @@ var defaultProviderModels = map[schemas.ModelProvider][]string{
 		"glm-z1-flashx",
 		"glm-z1-thinking",
 		"glm-z1-rumination",
+		"cogview-4",
+		"cogview-4-250304",
+		"cogview-3-flash",
+		"cogvideox-3",
+		"cogvideox-2",
+		"cogvideox-flash",
 	},
 	schemas.Minimax: {
 		"MiniMax-M2.5",
@@ -38,6 +44,13 @@ var defaultProviderModels = map[schemas.ModelProvider][]string{
 		"speech-2.6-turbo",
 		"speech-02-hd",
 		"speech-02-turbo",
+		"music-2.5",
+		"embo-01",
+		"MiniMax-Hailuo-02",
+		"T2V-01-Director",
+		"I2V-01-Director",
+		"I2V-01",
+		"T2V-01",
 	},
 	schemas.Deepseek: {
 		"deepseek-chat",
@@ -81,6 +94,55 @@ var defaultProviderModels = map[schemas.ModelProvider][]string{
 		"qwen3-max-preview",
 		"qwen3-coder-plus",
 		"qwen3-coder-480b-a35b-instruct",
+		"gte-rerank-v2",
+		"gte-rerank",
+		"wanx2.1-t2i-turbo",
+		"wanx2.1-t2i-plus",
+		"wanx2.1-imageedit",
+	},
+	schemas.Hunyuan: {
+		"hunyuan-turbos-latest",
+		"hunyuan-t1-latest",
+		"hunyuan-large",
+		"hunyuan-standard",
+		"hunyuan-standard-256K",
+		"hunyuan-lite",
+		"hunyuan-vision",
+		"hunyuan-turbos-vision",
+		"hunyuan-embedding",
+	},
+	schemas.StepFun: {
+		"step-2-16k",
+		"step-2-mini",
+		"step-1-8k",
+		"step-1-32k",
+		"step-1-128k",
+		"step-1-256k",
+		"step-1-flash",
+		"step-1v-8k",
+		"step-1v-32k",
+		"step-1o-turbo-vision",
+		"step-tts-mini",
+		"step-asr",
+	},
+	schemas.Yi: {
+		"yi-lightning",
+		"yi-large",
+		"yi-large-turbo",
+		"yi-large-fc",
+		"yi-medium",
+		"yi-medium-200k",
+		"yi-spark",
+		"yi-vision",
+		"yi-vision-v2",
+	},
+	schemas.Spark: {
+		"4.0Ultra",
+		"max-32k",
+		"generalv3.5",
+		"pro-128k",
+		"generalv3",
+		"lite",
 	},
 }
 
@@ -7,6 +7,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/capsohq/bifrost/core/schemas"
@@ -32,15 +33,20 @@ type ModelCatalog struct {
 	pricingMu           sync.RWMutex
 
 	shouldSyncPricingFunc ShouldSyncPricingFunc
+	isLeaderFunc          atomic.Pointer[IsLeaderFunc]
 
 	// In-memory cache for fast access - direct map for O(1) lookups
 	pricingData map[string]configstoreTables.TableModelPricing
 	mu          sync.RWMutex
+	// pricingRefresh tracks the refreshes of pricingData from the remote datasheet (protected by mu)
+	pricingRefresh pricingRefreshState
 
 	// Provider-level pricing overrides are maintained separately to avoid contention
 	// with pricing cache rebuilds.
 	compiledOverrides map[schemas.ModelProvider][]compiledProviderPricingOverride
-	overridesMu       sync.RWMutex
+	// Custom pricing overrides managed through the admin API, layered above provider-level overrides
+	customOverrides map[schemas.ModelProvider][]compiledProviderPricingOverride
+	overridesMu     sync.RWMutex
 
 	modelPool           map[schemas.ModelProvider][]string
 	unfilteredModelPool map[schemas.ModelProvider][]string // model pool without allowed models filtering
@@ -118,6 +124,10 @@ type PricingEntry struct {
 // syncPricing function will be called if this function returns true
 type ShouldSyncPricingFunc func(ctx context.Context) bool
 
+// IsLeaderFunc reports whether this replica is the one running the background workers that write
+// shared state to the config store, when several replicas share it.
+type IsLeaderFunc func() bool
+
 // Init initializes the model catalog
 func Init(ctx context.Context, config *Config, configStore configstore.ConfigStore, shouldSyncPricingFunc ShouldSyncPricingFunc, logger schemas.Logger) (*ModelCatalog, error) {
 	// Initialize pricing URL and sync interval
@@ -141,6 +151,7 @@ func Init(ctx context.Context, config *Config, configStore configstore.ConfigSto
 		logger:                             logger,
 		pricingData:                        make(map[string]configstoreTables.TableModelPricing),
 		compiledOverrides:                  make(map[schemas.ModelProvider][]compiledProviderPricingOverride),
+		customOverrides:                    make(map[schemas.ModelProvider][]compiledProviderPricingOverride),
 		modelPool:                          make(map[schemas.ModelProvider][]string),
 		unfilteredModelPool:                make(map[schemas.ModelProvider][]string),
 		providerModelSnapshots:             make(map[schemas.ModelProvider][]string),
@@ -269,6 +280,36 @@ func (mc *ModelCatalog) ForceReloadPricing(ctx context.Context) error {
 	return nil
 }
 
+// SetIsLeaderFunc restricts the periodic pricing sync and the persistence of discovered provider
+// models to the replica isLeader reports as leader. The other replicas reload the synced pricing
+// from the config store instead. A nil isLeader makes this replica run them again.
+func (mc *ModelCatalog) SetIsLeaderFunc(isLeader IsLeaderFunc) {
+	if isLeader == nil {
+		mc.isLeaderFunc.Store(nil)
+		return
+	}
+	mc.isLeaderFunc.Store(&isLeader)
+}
+
+// isLeader reports whether this replica runs the workers writing shared state, which it does
+// unless a leader election says otherwise.
+func (mc *ModelCatalog) isLeader() bool {
+	isLeader := mc.isLeaderFunc.Load()
+	return isLeader == nil || (*isLeader)()
+}
+
+// ReloadFromStore reloads the pricing data and the provider model snapshots from the config store
+// without syncing them from their sources, e.g. after another replica synced them, and rebuilds
+// the model pool from them.
+func (mc *ModelCatalog) ReloadFromStore(ctx context.Context) error {
+	if err := mc.loadPricingFromDatabase(ctx); err != nil {
+		return err
+	}
+	mc.loadProviderModelSnapshots(ctx)
+	mc.populateModelPoolFromPricingData()
+	return nil
+}
+
 // getPricingURL returns a copy of the pricing URL under mutex protection
 func (mc *ModelCatalog) getPricingURL() string {
 	mc.pricingMu.RLock()
@@ -293,10 +334,8 @@ func (mc *ModelCatalog) getProviderModelHealthPersistDebounce() time.Duration {
 	return mc.providerModelHealthPersistDebounce
 }
 
-// GetPricingEntryForModel returns the pricing data
+// GetPricingEntryForModel returns the pricing data, with pricing overrides applied
 func (mc *ModelCatalog) GetPricingEntryForModel(model string, provider schemas.ModelProvider) *PricingEntry {
-	mc.mu.RLock()
-	defer mc.mu.RUnlock()
 	// Check all modes
 	for _, mode := range []schemas.RequestType{
 		schemas.TextCompletionRequest,
@@ -308,8 +347,10 @@ func (mc *ModelCatalog) GetPricingEntryForModel(model string, provider schemas.M
 		schemas.TranscriptionRequest,
 	} {
 		key := makeKey(model, string(provider), normalizeRequestType(mode))
+		mc.mu.RLock()
 		pricing, ok := mc.pricingData[key]
-		if ok {
+		mc.mu.RUnlock()
+		if pricing, _, ok := mc.layerPricingOverrides(provider, model, mode, pricing, ok); ok {
 			return convertTableModelPricingToPricingData(&pricing)
 		}
 	}
@@ -902,6 +943,7 @@ func NewTestCatalog(baseModelIndex map[string]string) *ModelCatalog {
 		baseModelIndex:                     baseModelIndex,
 		pricingData:                        make(map[string]configstoreTables.TableModelPricing),
 		compiledOverrides:                  make(map[schemas.ModelProvider][]compiledProviderPricingOverride),
+		customOverrides:                    make(map[schemas.ModelProvider][]compiledProviderPricingOverride),
 		done:                               make(chan struct{}),
 	}
 }
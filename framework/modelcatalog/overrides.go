@@ -3,13 +3,30 @@ package modelcatalog
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/capsohq/bifrost/core/schemas"
 	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
 )
 
+// PricingSource identifies the layer a model's pricing comes from
+type PricingSource string
+
+const (
+	PricingSourceCatalog          PricingSource = "catalog"
+	PricingSourceProviderOverride PricingSource = "provider_override"
+	PricingSourceCustomOverride   PricingSource = "custom_override"
+)
+
+// PricingResolution describes how the effective pricing of a model was resolved
+type PricingResolution struct {
+	Source     PricingSource `json:"source"`
+	OverrideID string        `json:"override_id,omitempty"` // ID of the custom override applied, if any
+}
+
 type compiledProviderPricingOverride struct {
+	id               string
 	override         schemas.ProviderPricingOverride
 	regex            *regexp.Regexp
 	requestModes     map[string]struct{}
@@ -44,22 +61,80 @@ func (mc *ModelCatalog) DeleteProviderPricingOverrides(provider schemas.ModelPro
 	delete(mc.compiledOverrides, provider)
 }
 
-func (mc *ModelCatalog) applyPricingOverrides(provider schemas.ModelProvider, model string, requestType schemas.RequestType, pricing configstoreTables.TableModelPricing) configstoreTables.TableModelPricing {
+// SetCustomPricingOverrides replaces the custom pricing overrides managed through the admin API.
+// Overrides tying on specificity are applied in the order given.
+func (mc *ModelCatalog) SetCustomPricingOverrides(overrides []configstoreTables.TablePricingOverride) error {
+	compiled := make(map[schemas.ModelProvider][]compiledProviderPricingOverride)
+	for i := range overrides {
+		provider := schemas.ModelProvider(strings.TrimSpace(overrides[i].Provider))
+		if provider == "" {
+			return fmt.Errorf("invalid custom pricing override %q: provider cannot be empty", overrides[i].Name)
+		}
+		item, err := compileProviderPricingOverride(i, overrides[i].ProviderPricingOverride)
+		if err != nil {
+			return fmt.Errorf("invalid custom pricing override %q: %w", overrides[i].Name, err)
+		}
+		item.id = overrides[i].ID
+		compiled[provider] = append(compiled[provider], item)
+	}
+
+	mc.overridesMu.Lock()
+	defer mc.overridesMu.Unlock()
+	mc.customOverrides = compiled
+	return nil
+}
+
+// layerPricingOverrides applies the pricing overrides of a model over its catalog pricing.
+// Custom overrides take precedence over provider-level overrides, which take precedence over the catalog.
+// A model missing from the catalog is only priced by a custom override that sets both per-token prices,
+// e.g. a self-hosted model priced at $0.
+func (mc *ModelCatalog) layerPricingOverrides(provider schemas.ModelProvider, model string, requestType schemas.RequestType, pricing configstoreTables.TableModelPricing, found bool) (configstoreTables.TableModelPricing, PricingResolution, bool) {
 	mc.overridesMu.RLock()
-	overrides := mc.compiledOverrides[provider]
+	customOverrides := mc.customOverrides[provider]
+	providerOverrides := mc.compiledOverrides[provider]
 	mc.overridesMu.RUnlock()
-	if len(overrides) == 0 {
-		return pricing
-	}
 
 	modelCandidates := []string{model}
 	mode := normalizeRequestType(requestType)
-	best := selectBestOverride(overrides, modelCandidates, mode)
-	if best == nil {
-		return pricing
+	custom := selectBestOverride(customOverrides, modelCandidates, mode)
+	if !found {
+		if custom == nil || custom.override.InputCostPerToken == nil || custom.override.OutputCostPerToken == nil {
+			return pricing, PricingResolution{}, false
+		}
+		pricing = configstoreTables.TableModelPricing{Model: model, Provider: string(provider), Mode: mode}
+	}
+
+	resolution := PricingResolution{Source: PricingSourceCatalog}
+	if best := selectBestOverride(providerOverrides, modelCandidates, mode); best != nil {
+		pricing = patchPricing(pricing, best.override)
+		resolution.Source = PricingSourceProviderOverride
 	}
+	if custom != nil {
+		pricing = patchPricing(pricing, custom.override)
+		resolution = PricingResolution{Source: PricingSourceCustomOverride, OverrideID: custom.id}
+	}
+	return pricing, resolution, true
+}
 
-	return patchPricing(pricing, best.override)
+// pricingOverrideCounts returns the number of custom and provider-level pricing overrides, and the providers having any
+func (mc *ModelCatalog) pricingOverrideCounts() (custom, providerLevel int, providers []schemas.ModelProvider) {
+	mc.overridesMu.RLock()
+	defer mc.overridesMu.RUnlock()
+	seen := make(map[schemas.ModelProvider]struct{})
+	for provider, overrides := range mc.customOverrides {
+		custom += len(overrides)
+		seen[provider] = struct{}{}
+	}
+	for provider, overrides := range mc.compiledOverrides {
+		providerLevel += len(overrides)
+		seen[provider] = struct{}{}
+	}
+	providers = make([]schemas.ModelProvider, 0, len(seen))
+	for provider := range seen {
+		providers = append(providers, provider)
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i] < providers[j] })
+	return custom, providerLevel, providers
 }
 
 func compileProviderPricingOverride(order int, override schemas.ProviderPricingOverride) (compiledProviderPricingOverride, error) {
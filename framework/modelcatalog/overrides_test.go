@@ -355,3 +355,69 @@ func TestPatchPricing_PartialPatchOnlyChangesSpecifiedFields(t *testing.T) {
 	require.NotNil(t, patched.CacheReadInputImageTokenCost)
 	assert.Equal(t, 0.2, *patched.CacheReadInputImageTokenCost)
 }
+
+func TestResolvePricing_CustomOverridePrecedence(t *testing.T) {
+	mc := newTestCatalog(nil, nil)
+	mc.logger = noOpLogger{}
+	mc.pricingData[makeKey("gpt-4o", "openai", "chat")] = configstoreTables.TableModelPricing{
+		Model:              "gpt-4o",
+		Provider:           "openai",
+		Mode:               "chat",
+		InputCostPerToken:  1,
+		OutputCostPerToken: 2,
+	}
+
+	entry, resolution, ok := mc.ResolvePricing(schemas.OpenAI, "gpt-4o", schemas.ChatCompletionRequest)
+	require.True(t, ok)
+	assert.Equal(t, PricingSourceCatalog, resolution.Source)
+	assert.Equal(t, 1.0, entry.InputCostPerToken)
+
+	providerInput := 0.5
+	require.NoError(t, mc.SetProviderPricingOverrides(schemas.OpenAI, []schemas.ProviderPricingOverride{
+		{ModelPattern: "gpt-4o", MatchType: schemas.PricingOverrideMatchExact, InputCostPerToken: &providerInput},
+	}))
+	_, resolution, ok = mc.ResolvePricing(schemas.OpenAI, "gpt-4o", schemas.ChatCompletionRequest)
+	require.True(t, ok)
+	assert.Equal(t, PricingSourceProviderOverride, resolution.Source)
+
+	// A custom wildcard override wins over the provider-level exact override and keeps the fields it does not set
+	negotiated := 0.25
+	zero := 0.0
+	require.NoError(t, mc.SetCustomPricingOverrides([]configstoreTables.TablePricingOverride{
+		{ID: "negotiated", Name: "Negotiated", Provider: "openai", ProviderPricingOverride: schemas.ProviderPricingOverride{
+			ModelPattern: "gpt-4o*", MatchType: schemas.PricingOverrideMatchWildcard, InputCostPerToken: &negotiated,
+		}},
+		{ID: "self-hosted", Name: "Self-hosted", Provider: "ollama", ProviderPricingOverride: schemas.ProviderPricingOverride{
+			ModelPattern: "llama3", MatchType: schemas.PricingOverrideMatchExact, InputCostPerToken: &zero, OutputCostPerToken: &zero,
+		}},
+	}))
+	entry, resolution, ok = mc.ResolvePricing(schemas.OpenAI, "gpt-4o", schemas.ChatCompletionRequest)
+	require.True(t, ok)
+	assert.Equal(t, PricingResolution{Source: PricingSourceCustomOverride, OverrideID: "negotiated"}, resolution)
+	assert.Equal(t, 0.25, entry.InputCostPerToken)
+	assert.Equal(t, 2.0, entry.OutputCostPerToken)
+
+	// A model missing from the catalog is priced by a custom override setting both token prices
+	entry, resolution, ok = mc.ResolvePricing(schemas.Ollama, "llama3", schemas.ChatCompletionRequest)
+	require.True(t, ok)
+	assert.Equal(t, "self-hosted", resolution.OverrideID)
+	assert.Equal(t, 0.0, entry.InputCostPerToken)
+	cost := mc.CalculateResponseCost(&schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{
+		Usage: &schemas.BifrostLLMUsage{PromptTokens: 100, CompletionTokens: 50, TotalTokens: 150},
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			RequestType: schemas.ChatCompletionRequest, Provider: schemas.Ollama, ModelRequested: "llama3",
+		},
+	}})
+	require.NotNil(t, cost, "a self-hosted model priced at $0 must still report its cost")
+	assert.Equal(t, 0.0, cost.Total)
+	assert.NotNil(t, mc.GetPricingEntryForModel("llama3", schemas.Ollama))
+
+	// Partial custom overrides do not price models missing from the catalog
+	_, _, ok = mc.ResolvePricing(schemas.OpenAI, "gpt-4o-unlisted", schemas.ChatCompletionRequest)
+	assert.False(t, ok)
+
+	health := mc.GetProviderModelSnapshotHealthReport().PricingOverrides
+	assert.Equal(t, 2, health.CustomOverrides)
+	assert.Equal(t, 1, health.ProviderOverrides)
+	assert.Equal(t, []schemas.ModelProvider{schemas.Ollama, schemas.OpenAI}, health.Providers)
+}
@@ -1,6 +1,7 @@
 package modelcatalog
 
 import (
+	"math"
 	"strconv"
 	"strings"
 
@@ -50,6 +51,10 @@ func (mc *ModelCatalog) CalculateCost(result *schemas.BifrostResponse) float64 {
 		usage = result.EmbeddingResponse.Usage
 	case result.RerankResponse != nil && result.RerankResponse.Usage != nil:
 		usage = result.RerankResponse.Usage
+	case result.ContextCacheCreateResponse != nil && result.ContextCacheCreateResponse.Usage != nil:
+		usage = result.ContextCacheCreateResponse.Usage
+	case result.RealtimeResponse != nil && result.RealtimeResponse.Usage != nil:
+		usage = result.RealtimeResponse.Usage
 	case result.SpeechResponse != nil:
 		if result.SpeechResponse.Usage != nil {
 			usage = &schemas.BifrostLLMUsage{
@@ -108,6 +113,27 @@ func (mc *ModelCatalog) CalculateCost(result *schemas.BifrostResponse) float64 {
 		if result.TranscriptionStreamResponse.Usage.Seconds != nil {
 			audioSeconds = result.TranscriptionStreamResponse.Usage.Seconds
 		}
+	case result.TranslationResponse != nil && (result.TranslationResponse.Usage != nil || result.TranslationResponse.Duration != nil):
+		if translationUsage := result.TranslationResponse.Usage; translationUsage != nil {
+			usage = &schemas.BifrostLLMUsage{}
+			if translationUsage.InputTokens != nil {
+				usage.PromptTokens = *translationUsage.InputTokens
+			}
+			if translationUsage.OutputTokens != nil {
+				usage.CompletionTokens = *translationUsage.OutputTokens
+			}
+			if translationUsage.TotalTokens != nil {
+				usage.TotalTokens = *translationUsage.TotalTokens
+			} else {
+				usage.TotalTokens = usage.PromptTokens + usage.CompletionTokens
+			}
+			audioSeconds = translationUsage.Seconds
+		}
+		// Whisper translations only report the audio duration (with verbose_json), bill it per second
+		if audioSeconds == nil && result.TranslationResponse.Duration != nil {
+			seconds := int(math.Ceil(*result.TranslationResponse.Duration))
+			audioSeconds = &seconds
+		}
 	case result.ImageGenerationResponse != nil && result.ImageGenerationResponse.Usage != nil:
 		imageUsage = result.ImageGenerationResponse.Usage
 	case result.ImageGenerationStreamResponse != nil && result.ImageGenerationStreamResponse.Usage != nil:
@@ -178,6 +204,101 @@ func (mc *ModelCatalog) CalculateCostWithCacheDebug(result *schemas.BifrostRespo
 	return mc.CalculateCost(result)
 }
 
+// CalculateResponseCost prices a response like CalculateCostWithCacheDebug and breaks the cost of its
+// token usage down into prompt, cached, completion and reasoning costs. It returns nil when the response
+// has no usage or its model has no pricing.
+func (mc *ModelCatalog) CalculateResponseCost(result *schemas.BifrostResponse) *schemas.BifrostResponseCost {
+	if result == nil {
+		return nil
+	}
+	extraFields := result.GetExtraFields()
+	pricing, ok := mc.getPricing(extraFields.ModelRequested, string(extraFields.Provider), extraFields.RequestType)
+	if !ok && extraFields.ModelDeployment != "" {
+		pricing, ok = mc.getPricing(extraFields.ModelDeployment, string(extraFields.Provider), extraFields.RequestType)
+	}
+	if !ok {
+		return nil
+	}
+	total := mc.CalculateCostWithCacheDebug(result)
+	usage := responseTokenUsage(result)
+	if usage == nil && total == 0 {
+		return nil
+	}
+
+	cost := &schemas.BifrostResponseCost{Total: total}
+	// Cache hits are priced by their lookup only, the usage is the one of the cached response
+	if usage == nil || (extraFields.CacheDebug != nil && extraFields.CacheDebug.CacheHit) {
+		return cost
+	}
+	if usage.Cost != nil && usage.Cost.TotalCost > 0 {
+		// Cost reported by the provider
+		cost.Prompt = usage.Cost.InputTokensCost
+		cost.Completion = usage.Cost.OutputTokensCost
+		cost.Reasoning = usage.Cost.ReasoningTokensCost
+		return cost
+	}
+
+	var cachedReadTokens, cachedWriteTokens, reasoningTokens int
+	if usage.PromptTokensDetails != nil {
+		cachedReadTokens = usage.PromptTokensDetails.CachedReadTokens
+		cachedWriteTokens = usage.PromptTokensDetails.CachedWriteTokens
+	}
+	if usage.CompletionTokensDetails != nil {
+		reasoningTokens = usage.CompletionTokensDetails.ReasoningTokens
+	}
+	cost.Prompt = float64(usage.PromptTokens-cachedReadTokens-cachedWriteTokens) * pricing.InputCostPerToken
+	cost.Cached = float64(cachedReadTokens)*getSafeFloat64(pricing.CacheReadInputTokenCost, pricing.InputCostPerToken) +
+		float64(cachedWriteTokens)*getSafeFloat64(pricing.CacheCreationInputTokenCost, pricing.InputCostPerToken)
+	cost.Completion = float64(usage.CompletionTokens) * pricing.OutputCostPerToken
+	cost.Reasoning = float64(reasoningTokens) * pricing.OutputCostPerToken
+	return cost
+}
+
+// responseTokenUsage returns the token usage of text, chat, responses, embedding and rerank responses,
+// or nil for other responses and chunks without usage.
+func responseTokenUsage(result *schemas.BifrostResponse) *schemas.BifrostLLMUsage {
+	switch {
+	case result.TextCompletionResponse != nil:
+		return result.TextCompletionResponse.Usage
+	case result.ChatResponse != nil:
+		return result.ChatResponse.Usage
+	case result.EmbeddingResponse != nil:
+		return result.EmbeddingResponse.Usage
+	case result.RerankResponse != nil:
+		return result.RerankResponse.Usage
+	case result.ResponsesResponse != nil:
+		return responsesTokenUsage(result.ResponsesResponse.Usage)
+	case result.ResponsesStreamResponse != nil && result.ResponsesStreamResponse.Response != nil:
+		return responsesTokenUsage(result.ResponsesStreamResponse.Response.Usage)
+	}
+	return nil
+}
+
+// responsesTokenUsage converts responses API usage to chat usage, keeping cached and reasoning tokens
+func responsesTokenUsage(usage *schemas.ResponsesResponseUsage) *schemas.BifrostLLMUsage {
+	if usage == nil {
+		return nil
+	}
+	converted := &schemas.BifrostLLMUsage{
+		PromptTokens:     usage.InputTokens,
+		CompletionTokens: usage.OutputTokens,
+		TotalTokens:      usage.TotalTokens,
+		Cost:             usage.Cost,
+	}
+	if usage.InputTokensDetails != nil {
+		converted.PromptTokensDetails = &schemas.ChatPromptTokensDetails{
+			CachedReadTokens:  usage.InputTokensDetails.CachedReadTokens,
+			CachedWriteTokens: usage.InputTokensDetails.CachedWriteTokens,
+		}
+	}
+	if usage.OutputTokensDetails != nil {
+		converted.CompletionTokensDetails = &schemas.ChatCompletionTokensDetails{
+			ReasoningTokens: usage.OutputTokensDetails.ReasoningTokens,
+		}
+	}
+	return converted
+}
+
 // CalculateCostFromUsage calculates cost in dollars using pricing manager and usage data with conditional pricing
 func (mc *ModelCatalog) CalculateCostFromUsage(provider string, model string, deployment string, usage *schemas.BifrostLLMUsage, requestType schemas.RequestType, isBatch bool, audioSeconds *int, audioTokenDetails *schemas.TranscriptionUsageInputTokenDetails, imageUsage *schemas.ImageUsage, videoSeconds *int) float64 {
 	// Allow audio-only and image-only flows by only returning early if we have no usage data at all
@@ -237,7 +358,7 @@ func (mc *ModelCatalog) CalculateCostFromUsage(provider string, model string, de
 	})
 
 	// Special handling for audio operations with duration-based pricing
-	if (requestType == schemas.SpeechRequest || requestType == schemas.TranscriptionRequest) && audioSeconds != nil && *audioSeconds > 0 {
+	if (requestType == schemas.SpeechRequest || requestType == schemas.TranscriptionRequest || requestType == schemas.TranslationRequest) && audioSeconds != nil && *audioSeconds > 0 {
 		// Determine if this is above TokenTierAbove128K for pricing tier selection
 		isAbove128k := totalTokens > TokenTierAbove128K
 
@@ -499,19 +620,43 @@ func (mc *ModelCatalog) CalculateCostFromUsage(provider string, model string, de
 	return totalCost
 }
 
+// GetTokenPricing returns the per-token input and output prices of a model for a request type,
+// with pricing overrides applied. It returns false when the model has no pricing.
+func (mc *ModelCatalog) GetTokenPricing(provider schemas.ModelProvider, model string, requestType schemas.RequestType) (inputCostPerToken, outputCostPerToken float64, ok bool) {
+	pricing, ok := mc.getPricing(model, string(provider), requestType)
+	if !ok {
+		return 0, 0, false
+	}
+	return pricing.InputCostPerToken, pricing.OutputCostPerToken, true
+}
+
 // getPricing returns pricing information for a model (thread-safe)
 func (mc *ModelCatalog) getPricing(model, provider string, requestType schemas.RequestType) (*configstoreTables.TableModelPricing, bool) {
 	mc.mu.RLock()
 	pricing, ok := mc.resolvePricingEntryLocked(model, provider, requestType)
 	mc.mu.RUnlock()
+
+	patched, _, ok := mc.layerPricingOverrides(schemas.ModelProvider(provider), model, requestType, pricing, ok)
 	if !ok {
 		return nil, false
 	}
-
-	patched := mc.applyPricingOverrides(schemas.ModelProvider(provider), model, requestType, pricing)
 	return &patched, true
 }
 
+// ResolvePricing returns the effective pricing of a model for a request type, with the layer it comes from.
+// It returns false when the model has no pricing.
+func (mc *ModelCatalog) ResolvePricing(provider schemas.ModelProvider, model string, requestType schemas.RequestType) (*PricingEntry, PricingResolution, bool) {
+	mc.mu.RLock()
+	pricing, ok := mc.resolvePricingEntryLocked(model, string(provider), requestType)
+	mc.mu.RUnlock()
+
+	patched, resolution, ok := mc.layerPricingOverrides(provider, model, requestType, pricing, ok)
+	if !ok {
+		return nil, PricingResolution{}, false
+	}
+	return convertTableModelPricingToPricingData(&patched), resolution, true
+}
+
 // resolvePricingEntryLocked resolves pricing data from the base catalog including all existing fallback logic.
 // Caller must hold mc.mu read lock.
 func (mc *ModelCatalog) resolvePricingEntryLocked(model, provider string, requestType schemas.RequestType) (configstoreTables.TableModelPricing, bool) {
@@ -0,0 +1,50 @@
+package modelcatalog
+
+import (
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculateResponseCost_Breakdown(t *testing.T) {
+	mc := newTestCatalog(nil, nil)
+	mc.logger = noOpLogger{}
+	cacheRead := 0.1
+	mc.pricingData[makeKey("gpt-4o", "openai", "chat")] = configstoreTables.TableModelPricing{
+		Model:                   "gpt-4o",
+		Provider:                "openai",
+		Mode:                    "chat",
+		InputCostPerToken:       1,
+		OutputCostPerToken:      2,
+		CacheReadInputTokenCost: &cacheRead,
+	}
+	extraFields := schemas.BifrostResponseExtraFields{RequestType: schemas.ChatCompletionRequest, Provider: schemas.OpenAI, ModelRequested: "gpt-4o"}
+
+	cost := mc.CalculateResponseCost(&schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{
+		ExtraFields: extraFields,
+		Usage: &schemas.BifrostLLMUsage{
+			PromptTokens:            100,
+			PromptTokensDetails:     &schemas.ChatPromptTokensDetails{CachedReadTokens: 40},
+			CompletionTokens:        30,
+			CompletionTokensDetails: &schemas.ChatCompletionTokensDetails{ReasoningTokens: 10},
+			TotalTokens:             130,
+		},
+	}})
+	require.NotNil(t, cost)
+	assert.InDelta(t, 60.0, cost.Prompt, 1e-9)
+	assert.InDelta(t, 4.0, cost.Cached, 1e-9)
+	assert.InDelta(t, 60.0, cost.Completion, 1e-9)
+	assert.InDelta(t, 20.0, cost.Reasoning, 1e-9)
+	assert.InDelta(t, cost.Prompt+cost.Cached+cost.Completion, cost.Total, 1e-9)
+
+	// Stream chunks without usage and models without pricing are not priced
+	assert.Nil(t, mc.CalculateResponseCost(&schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{ExtraFields: extraFields}}))
+	extraFields.ModelRequested = "unknown-model"
+	assert.Nil(t, mc.CalculateResponseCost(&schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{
+		ExtraFields: extraFields,
+		Usage:       &schemas.BifrostLLMUsage{PromptTokens: 10, TotalTokens: 10},
+	}}))
+}
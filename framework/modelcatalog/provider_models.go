@@ -49,7 +49,8 @@ func (mc *ModelCatalog) loadProviderModelSnapshots(ctx context.Context) {
 }
 
 func (mc *ModelCatalog) persistProviderModelSnapshot(provider schemas.ModelProvider, models []string) {
-	if len(models) == 0 {
+	// Only the leader writes the snapshots shared by all replicas
+	if len(models) == 0 || !mc.isLeader() {
 		return
 	}
 
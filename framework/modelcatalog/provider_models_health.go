@@ -82,12 +82,35 @@ type ProviderModelSnapshotHealthSummary struct {
 	UnknownProviders  int `json:"unknown_providers"`
 }
 
+// PricingRefreshHealth reports the refreshes of the pricing data from the remote datasheet.
+// It is stale when the last successful refresh is older than twice the sync interval.
+type PricingRefreshHealth struct {
+	Status           ProviderModelHealthStatus `json:"status"`
+	SourceURL        string                    `json:"source_url"`
+	IntervalSeconds  int64                     `json:"interval_seconds"`
+	LastAttemptAt    *time.Time                `json:"last_attempt_at,omitempty"`
+	LastSuccessAt    *time.Time                `json:"last_success_at,omitempty"`
+	LastErrorAt      *time.Time                `json:"last_error_at,omitempty"`
+	LastError        string                    `json:"last_error,omitempty"`
+	LastRecordsCount int                       `json:"last_records_count"`
+	LastDiff         PricingDiff               `json:"last_diff"`
+}
+
+// PricingOverridesHealth summarizes the pricing overrides layered over the pricing catalog.
+type PricingOverridesHealth struct {
+	CustomOverrides   int                     `json:"custom_overrides"`
+	ProviderOverrides int                     `json:"provider_overrides"`
+	Providers         []schemas.ModelProvider `json:"providers"` // Providers with at least one override
+}
+
 type ProviderModelSnapshotHealthReport struct {
 	Status            ProviderModelHealthStatus          `json:"status"`
 	GeneratedAt       time.Time                          `json:"generated_at"`
 	StaleAfterSeconds int64                              `json:"stale_after_seconds"`
 	Summary           ProviderModelSnapshotHealthSummary `json:"summary"`
 	Providers         []ProviderModelSnapshotHealth      `json:"providers"`
+	Pricing           PricingRefreshHealth               `json:"pricing"`
+	PricingOverrides  PricingOverridesHealth             `json:"pricing_overrides"`
 }
 
 type providerModelHealthStore interface {
@@ -257,7 +280,10 @@ func (mc *ModelCatalog) GetProviderModelSnapshotHealthReport() ProviderModelSnap
 			summary.UnknownProviders++
 		}
 	}
+	pricingRefresh := mc.pricingRefresh
 	mc.mu.RUnlock()
+	pricing := mc.toPricingRefreshHealth(pricingRefresh, now)
+	customOverrides, providerOverrides, overrideProviders := mc.pricingOverrideCounts()
 
 	reportStatus := ProviderModelHealthUnknown
 	switch {
@@ -270,6 +296,10 @@ func (mc *ModelCatalog) GetProviderModelSnapshotHealthReport() ProviderModelSnap
 	case summary.HealthyProviders > 0:
 		reportStatus = ProviderModelHealthHealthy
 	}
+	// Failing or stale pricing refreshes degrade an otherwise healthy report
+	if reportStatus == ProviderModelHealthHealthy && (pricing.Status == ProviderModelHealthError || pricing.Status == ProviderModelHealthStale) {
+		reportStatus = ProviderModelHealthDegraded
+	}
 
 	return ProviderModelSnapshotHealthReport{
 		Status:            reportStatus,
@@ -277,7 +307,50 @@ func (mc *ModelCatalog) GetProviderModelSnapshotHealthReport() ProviderModelSnap
 		StaleAfterSeconds: int64(DefaultProviderModelSnapshotStaleAfter.Seconds()),
 		Summary:           summary,
 		Providers:         items,
+		Pricing:           pricing,
+		PricingOverrides: PricingOverridesHealth{
+			CustomOverrides:   customOverrides,
+			ProviderOverrides: providerOverrides,
+			Providers:         overrideProviders,
+		},
+	}
+}
+
+func (mc *ModelCatalog) toPricingRefreshHealth(state pricingRefreshState, now time.Time) PricingRefreshHealth {
+	interval := mc.getPricingSyncInterval()
+	status := ProviderModelHealthUnknown
+	switch {
+	case state.LastAttemptAt.IsZero():
+		status = ProviderModelHealthUnknown
+	case !state.LastErrorAt.IsZero() && (state.LastSuccessAt.IsZero() || !state.LastErrorAt.Before(state.LastSuccessAt)):
+		status = ProviderModelHealthError
+	case now.Sub(state.LastSuccessAt) > 2*interval:
+		status = ProviderModelHealthStale
+	default:
+		status = ProviderModelHealthHealthy
+	}
+
+	health := PricingRefreshHealth{
+		Status:           status,
+		SourceURL:        mc.getPricingURL(),
+		IntervalSeconds:  int64(interval.Seconds()),
+		LastError:        state.LastError,
+		LastRecordsCount: state.LastRecordsCount,
+		LastDiff:         state.LastDiff,
+	}
+	if !state.LastAttemptAt.IsZero() {
+		lastAttemptAt := state.LastAttemptAt
+		health.LastAttemptAt = &lastAttemptAt
+	}
+	if !state.LastSuccessAt.IsZero() {
+		lastSuccessAt := state.LastSuccessAt
+		health.LastSuccessAt = &lastSuccessAt
+	}
+	if !state.LastErrorAt.IsZero() {
+		lastErrorAt := state.LastErrorAt
+		health.LastErrorAt = &lastErrorAt
 	}
+	return health
 }
 
 func mergeProviderHealthStatus(filtered ProviderModelHealthStatus, unfiltered ProviderModelHealthStatus) ProviderModelHealthStatus {
@@ -382,7 +455,8 @@ func (mc *ModelCatalog) loadProviderModelHealthState(ctx context.Context) {
 }
 
 func (mc *ModelCatalog) persistProviderModelHealthState() {
-	if !mc.shouldPersistProviderModelHealthState() {
+	// Only the leader writes the health state shared by all replicas
+	if !mc.isLeader() || !mc.shouldPersistProviderModelHealthState() {
 		return
 	}
 
@@ -164,6 +164,35 @@ func TestProviderModelHealthPersistenceDebounced(t *testing.T) {
 	mc.wg.Wait()
 }
 
+func TestProviderModelHealthPersistenceOnlyOnLeader(t *testing.T) {
+	mc := newTestCatalog(nil, nil)
+
+	var persistCount atomic.Int32
+	mc.providerModelHealthPersistCallback = func() {
+		persistCount.Add(1)
+	}
+	var leader atomic.Bool
+	mc.SetIsLeaderFunc(leader.Load)
+
+	modelData := &schemas.BifrostListModelsResponse{
+		Data: []schemas.Model{
+			{ID: "glm/glm-5"},
+		},
+	}
+	mc.RecordProviderModelDiscoveryResult(schemas.GLM, false, modelData, nil)
+	assert.Equal(t, int32(0), persistCount.Load(), "a follower should not persist the shared health state")
+
+	leader.Store(true)
+	mc.RecordProviderModelDiscoveryResult(schemas.GLM, false, modelData, nil)
+	assert.Equal(t, int32(1), persistCount.Load())
+
+	// Without a leader election every replica persists
+	leader.Store(false)
+	mc.SetIsLeaderFunc(nil)
+	mc.RecordProviderModelDiscoveryResult(schemas.GLM, false, modelData, nil)
+	assert.Equal(t, int32(2), persistCount.Load())
+}
+
 func getProviderSnapshotHealth(
 	items []ProviderModelSnapshotHealth,
 	provider schemas.ModelProvider,
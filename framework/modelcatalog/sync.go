@@ -6,21 +6,46 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"reflect"
 	"time"
 
 	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
 	"gorm.io/gorm"
 )
 
+// PricingDiff counts the pricing entries a refresh added or changed, and the ones it left unchanged.
+type PricingDiff struct {
+	Added     int `json:"added"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+}
+
+// pricingRefreshState tracks the refreshes of the pricing data from the remote datasheet.
+type pricingRefreshState struct {
+	LastAttemptAt    time.Time
+	LastSuccessAt    time.Time
+	LastErrorAt      time.Time
+	LastError        string
+	LastRecordsCount int
+	LastDiff         PricingDiff
+}
+
 // checkAndSyncPricing determines if pricing data needs to be synced and performs the sync if needed.
 // It syncs pricing data in the following scenarios:
-//   - No config store available (returns early with no error)
+//   - No config store available and the sync interval has elapsed since the last refresh in memory
 //   - No previous sync record exists
 //   - Previous sync timestamp is invalid/corrupted
 //   - Sync interval has elapsed since last successful sync
 func (mc *ModelCatalog) checkAndSyncPricing(ctx context.Context) error {
-	// Skip sync if no config store is available
+	// Without a config store the pricing data is refreshed in memory
 	if mc.configStore == nil {
+		mc.mu.RLock()
+		lastRefresh := mc.pricingRefresh.LastSuccessAt
+		mc.mu.RUnlock()
+		if time.Since(lastRefresh) >= mc.getPricingSyncInterval() {
+			mc.logger.Debug("pricing refresh needed: sync interval elapsed")
+			return mc.loadPricingIntoMemory(ctx)
+		}
 		return nil
 	}
 
@@ -63,9 +88,13 @@ func (mc *ModelCatalog) syncPricing(ctx context.Context) error {
 			return nil
 		}
 	}
+	if mc.configStore == nil {
+		return mc.loadPricingIntoMemory(ctx)
+	}
 	// Load pricing data from URL
 	pricingData, err := mc.loadPricingFromURL(ctx)
 	if err != nil {
+		mc.recordPricingRefresh(0, PricingDiff{}, err)
 		// Check if we have existing data in database
 		pricingRecords, pricingErr := mc.configStore.GetModelPrices(ctx)
 		if pricingErr != nil {
@@ -79,33 +108,26 @@ func (mc *ModelCatalog) syncPricing(ctx context.Context) error {
 		}
 	}
 
-	// Update database in transaction
+	// Diff against the current pricing so only added and changed entries are written
+	next := buildPricingMap(pricingData)
+	mc.mu.RLock()
+	diff, changed := diffPricing(mc.pricingData, next)
+	mc.mu.RUnlock()
+
+	// Update database in transaction, so a failed refresh leaves the previous pricing in place
 	err = mc.configStore.ExecuteTransaction(ctx, func(tx *gorm.DB) error {
-		// Deduplicate and insert new pricing data
-		seen := make(map[string]bool)
-		for modelKey, entry := range pricingData {
-			pricing := convertPricingDataToTableModelPricing(modelKey, entry)
-			// Create composite key for deduplication
-			key := makeKey(pricing.Model, pricing.Provider, pricing.Mode)
-			// Skip if already seen
-			if exists, ok := seen[key]; ok && exists {
-				continue
-			}
-			// Mark as seen
-			seen[key] = true
+		for _, pricing := range changed {
 			if err := mc.configStore.UpsertModelPrices(ctx, &pricing, tx); err != nil {
 				return fmt.Errorf("failed to create pricing record for model %s: %w", pricing.Model, err)
 			}
 		}
-
-		// Clear seen map
-		seen = nil
-
 		return nil
 	})
 
 	if err != nil {
-		return fmt.Errorf("failed to sync pricing data to database: %w", err)
+		err = fmt.Errorf("failed to sync pricing data to database: %w", err)
+		mc.recordPricingRefresh(0, PricingDiff{}, err)
+		return err
 	}
 
 	config := &configstoreTables.TableGovernanceConfig{
@@ -120,13 +142,76 @@ func (mc *ModelCatalog) syncPricing(ctx context.Context) error {
 
 	// Reload cache from database
 	if err := mc.loadPricingFromDatabase(ctx); err != nil {
-		return fmt.Errorf("failed to reload pricing cache: %w", err)
+		err = fmt.Errorf("failed to reload pricing cache: %w", err)
+		mc.recordPricingRefresh(0, PricingDiff{}, err)
+		return err
 	}
 
-	mc.logger.Info("successfully synced %d pricing records", len(pricingData))
+	mc.recordPricingRefresh(len(next), diff, nil)
+	mc.logger.Info("successfully synced %d pricing records (%d added, %d updated)", len(next), diff.Added, diff.Updated)
 	return nil
 }
 
+// buildPricingMap converts datasheet entries to pricing records keyed by model, provider and mode.
+// The first entry of duplicate keys wins.
+func buildPricingMap(pricingData map[string]PricingEntry) map[string]configstoreTables.TableModelPricing {
+	pricingMap := make(map[string]configstoreTables.TableModelPricing, len(pricingData))
+	for modelKey, entry := range pricingData {
+		pricing := convertPricingDataToTableModelPricing(modelKey, entry)
+		key := makeKey(pricing.Model, pricing.Provider, pricing.Mode)
+		if _, exists := pricingMap[key]; exists {
+			continue
+		}
+		pricingMap[key] = pricing
+	}
+	return pricingMap
+}
+
+// diffPricing compares refreshed pricing against the current one and returns the counts of added,
+// updated and unchanged entries, with the added and updated ones. Record IDs are ignored.
+func diffPricing(current, next map[string]configstoreTables.TableModelPricing) (PricingDiff, []configstoreTables.TableModelPricing) {
+	var diff PricingDiff
+	var changed []configstoreTables.TableModelPricing
+	for key, pricing := range next {
+		existing, exists := current[key]
+		switch {
+		case !exists:
+			diff.Added++
+		case samePricing(existing, pricing):
+			diff.Unchanged++
+			continue
+		default:
+			diff.Updated++
+		}
+		changed = append(changed, pricing)
+	}
+	return diff, changed
+}
+
+// samePricing reports whether two pricing records have the same prices, ignoring their IDs
+func samePricing(a, b configstoreTables.TableModelPricing) bool {
+	a.ID, b.ID = 0, 0
+	return reflect.DeepEqual(a, b)
+}
+
+// recordPricingRefresh records the outcome of a pricing refresh for the snapshot health report
+func (mc *ModelCatalog) recordPricingRefresh(recordsCount int, diff PricingDiff, refreshErr error) {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	now := time.Now().UTC()
+	mc.pricingRefresh.LastAttemptAt = now
+	if refreshErr != nil {
+		mc.pricingRefresh.LastErrorAt = now
+		mc.pricingRefresh.LastError = refreshErr.Error()
+		return
+	}
+	mc.pricingRefresh.LastSuccessAt = now
+	mc.pricingRefresh.LastErrorAt = time.Time{}
+	mc.pricingRefresh.LastError = ""
+	mc.pricingRefresh.LastRecordsCount = recordsCount
+	mc.pricingRefresh.LastDiff = diff
+}
+
 // loadPricingFromURL loads pricing data from the remote URL
 func (mc *ModelCatalog) loadPricingFromURL(ctx context.Context) (map[string]PricingEntry, error) {
 	// Create HTTP client with timeout
@@ -168,20 +253,19 @@ func (mc *ModelCatalog) loadPricingFromURL(ctx context.Context) (map[string]Pric
 func (mc *ModelCatalog) loadPricingIntoMemory(ctx context.Context) error {
 	pricingData, err := mc.loadPricingFromURL(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to load pricing data from URL: %w", err)
+		err = fmt.Errorf("failed to load pricing data from URL: %w", err)
+		mc.recordPricingRefresh(0, PricingDiff{}, err)
+		return err
 	}
 
+	// Build the new pricing map before swapping it in, so lookups never see a partial refresh
+	next := buildPricingMap(pricingData)
 	mc.mu.Lock()
-	defer mc.mu.Unlock()
-
-	// Clear and rebuild the pricing map
-	mc.pricingData = make(map[string]configstoreTables.TableModelPricing, len(pricingData))
-	for modelKey, entry := range pricingData {
-		pricing := convertPricingDataToTableModelPricing(modelKey, entry)
-		key := makeKey(pricing.Model, pricing.Provider, pricing.Mode)
-		mc.pricingData[key] = pricing
-	}
+	diff, _ := diffPricing(mc.pricingData, next)
+	mc.pricingData = next
+	mc.mu.Unlock()
 
+	mc.recordPricingRefresh(len(next), diff, nil)
 	return nil
 }
 
@@ -210,16 +294,28 @@ func (mc *ModelCatalog) loadPricingFromDatabase(ctx context.Context) error {
 	return nil
 }
 
-// startSyncWorker starts the background sync worker
+// startSyncWorker starts the background sync worker.
+// The caller holds pricingMu or has exclusive access to the catalog.
 func (mc *ModelCatalog) startSyncWorker(ctx context.Context) {
-	// Use a ticker that checks every hour, but only sync when needed
-	mc.syncTicker = time.NewTicker(1 * time.Hour)
+	// Use a ticker that checks every hour (or every sync interval if shorter), but only sync when needed
+	tick := time.Hour
+	if mc.pricingSyncInterval > 0 && mc.pricingSyncInterval < tick {
+		tick = mc.pricingSyncInterval
+	}
+	mc.syncTicker = time.NewTicker(tick)
 	mc.wg.Add(1)
 	go mc.syncWorker(ctx)
 }
 
 // syncTick performs a single sync tick with proper lock management
 func (mc *ModelCatalog) syncTick(ctx context.Context) {
+	if !mc.isLeader() {
+		// The leader syncs the pricing into the config store, pick up its last sync
+		if err := mc.loadPricingFromDatabase(ctx); err != nil {
+			mc.logger.Error("background pricing reload failed: %v", err)
+		}
+		return
+	}
 	if mc.distributedLockManager == nil {
 		if err := mc.checkAndSyncPricing(ctx); err != nil {
 			mc.logger.Error("background pricing sync failed: %v", err)
@@ -0,0 +1,76 @@
+package modelcatalog
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffPricing(t *testing.T) {
+	current := map[string]configstoreTables.TableModelPricing{
+		makeKey("gpt-4o", "openai", "chat"):      {ID: 1, Model: "gpt-4o", Provider: "openai", Mode: "chat", InputCostPerToken: 1, OutputCostPerToken: 2},
+		makeKey("gpt-4o-mini", "openai", "chat"): {ID: 2, Model: "gpt-4o-mini", Provider: "openai", Mode: "chat", InputCostPerToken: 0.1, OutputCostPerToken: 0.2},
+	}
+	cacheRead := 0.5
+	next := map[string]configstoreTables.TableModelPricing{
+		makeKey("gpt-4o", "openai", "chat"):      {Model: "gpt-4o", Provider: "openai", Mode: "chat", InputCostPerToken: 1, OutputCostPerToken: 2},
+		makeKey("gpt-4o-mini", "openai", "chat"): {Model: "gpt-4o-mini", Provider: "openai", Mode: "chat", InputCostPerToken: 0.1, OutputCostPerToken: 0.2, CacheReadInputTokenCost: &cacheRead},
+		makeKey("o3", "openai", "chat"):          {Model: "o3", Provider: "openai", Mode: "chat", InputCostPerToken: 2, OutputCostPerToken: 8},
+	}
+
+	diff, changed := diffPricing(current, next)
+	assert.Equal(t, PricingDiff{Added: 1, Updated: 1, Unchanged: 1}, diff)
+	require.Len(t, changed, 2)
+	for _, pricing := range changed {
+		assert.NotEqual(t, "gpt-4o", pricing.Model, "unchanged entries must not be rewritten")
+	}
+}
+
+func TestLoadPricingIntoMemory_RecordsRefreshHealth(t *testing.T) {
+	var fail atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		_, _ = w.Write([]byte(`{"gpt-4o": {"provider": "openai", "mode": "chat", "input_cost_per_token": 1, "output_cost_per_token": 2}}`))
+	}))
+	defer server.Close()
+
+	mc := newTestCatalog(nil, nil)
+	mc.logger = noOpLogger{}
+	mc.pricingURL = server.URL
+	mc.pricingSyncInterval = time.Hour
+
+	assert.Equal(t, ProviderModelHealthUnknown, mc.GetProviderModelSnapshotHealthReport().Pricing.Status)
+
+	require.NoError(t, mc.loadPricingIntoMemory(t.Context()))
+	pricing := mc.GetProviderModelSnapshotHealthReport().Pricing
+	assert.Equal(t, ProviderModelHealthHealthy, pricing.Status)
+	assert.Equal(t, 1, pricing.LastRecordsCount)
+	assert.Equal(t, PricingDiff{Added: 1}, pricing.LastDiff)
+	assert.Equal(t, int64(3600), pricing.IntervalSeconds)
+
+	// A failed refresh keeps the previous pricing and is reported
+	fail.Store(true)
+	require.Error(t, mc.loadPricingIntoMemory(t.Context()))
+	pricing = mc.GetProviderModelSnapshotHealthReport().Pricing
+	assert.Equal(t, ProviderModelHealthError, pricing.Status)
+	assert.NotEmpty(t, pricing.LastError)
+	_, ok := mc.getPricing("gpt-4o", "openai", schemas.ChatCompletionRequest)
+	assert.True(t, ok)
+
+	// The next successful refresh clears the error
+	fail.Store(false)
+	require.NoError(t, mc.loadPricingIntoMemory(t.Context()))
+	pricing = mc.GetProviderModelSnapshotHealthReport().Pricing
+	assert.Equal(t, ProviderModelHealthHealthy, pricing.Status)
+	assert.Equal(t, PricingDiff{Unchanged: 1}, pricing.LastDiff)
+}
@@ -30,7 +30,7 @@ func normalizeRequestType(reqType schemas.RequestType) string {
 	switch reqType {
 	case schemas.TextCompletionRequest, schemas.TextCompletionStreamRequest:
 		baseType = "completion"
-	case schemas.ChatCompletionRequest, schemas.ChatCompletionStreamRequest:
+	case schemas.ChatCompletionRequest, schemas.ChatCompletionStreamRequest, schemas.ContextCacheCreateRequest, schemas.RealtimeRequest:
 		baseType = "chat"
 	case schemas.ResponsesRequest, schemas.ResponsesStreamRequest:
 		baseType = "responses"
@@ -38,12 +38,16 @@ func normalizeRequestType(reqType schemas.RequestType) string {
 		baseType = "embedding"
 	case schemas.RerankRequest:
 		baseType = "rerank"
+	case schemas.ModerationRequest:
+		baseType = "moderation"
 	case schemas.SpeechRequest, schemas.SpeechStreamRequest:
 		baseType = "audio_speech"
-	case schemas.TranscriptionRequest, schemas.TranscriptionStreamRequest:
+	case schemas.TranscriptionRequest, schemas.TranscriptionStreamRequest, schemas.TranslationRequest:
 		baseType = "audio_transcription"
 	case schemas.ImageGenerationRequest, schemas.ImageGenerationStreamRequest:
 		baseType = "image_generation"
+	case schemas.MusicGenerationRequest:
+		baseType = "music_generation"
 	case schemas.VideoGenerationRequest:
 		baseType = "video_generation"
 	}
@@ -2,9 +2,11 @@ package oauth2
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore/tables"
 )
 
 // TokenRefreshWorker manages automatic token refresh for expiring OAuth tokens
@@ -14,6 +16,9 @@ type TokenRefreshWorker struct {
 	lookAheadWindow time.Duration // How far ahead to look for expiring tokens
 	stopCh          chan struct{}
 	logger          schemas.Logger
+
+	observerMu       sync.RWMutex
+	expiringObserver func(config *tables.TableOauthConfig, token *tables.TableOauthToken) // Called when an expiring token cannot be refreshed
 }
 
 // NewTokenRefreshWorker creates a new token refresh worker
@@ -112,6 +117,11 @@ func (w *TokenRefreshWorker) refreshExpiredTokens(ctx context.Context) {
 				w.logger.Error("Failed to refresh token", "oauth_config_id", oauthConfig.ID, "error", err)
 			}
 
+			// Notify once, when the oauth_config first needs re-authorization
+			if oauthConfig.Status != "expired" {
+				w.notifyExpiring(oauthConfig, token)
+			}
+
 			// Mark the oauth_config as expired so user knows to re-authorize
 			oauthConfig.Status = "expired"
 			if updateErr := w.provider.configStore.UpdateOauthConfig(ctx, oauthConfig); updateErr != nil {
@@ -127,6 +137,24 @@ func (w *TokenRefreshWorker) refreshExpiredTokens(ctx context.Context) {
 	}
 }
 
+// SetExpiringTokenObserver sets the function called when a token about to expire cannot be
+// refreshed and its oauth_config needs to be authorized again
+func (w *TokenRefreshWorker) SetExpiringTokenObserver(observer func(config *tables.TableOauthConfig, token *tables.TableOauthToken)) {
+	w.observerMu.Lock()
+	defer w.observerMu.Unlock()
+	w.expiringObserver = observer
+}
+
+// notifyExpiring calls the expiring token observer, if set
+func (w *TokenRefreshWorker) notifyExpiring(config *tables.TableOauthConfig, token *tables.TableOauthToken) {
+	w.observerMu.RLock()
+	observer := w.expiringObserver
+	w.observerMu.RUnlock()
+	if observer != nil {
+		observer(config, token)
+	}
+}
+
 // SetRefreshInterval updates the refresh check interval (for testing)
 func (w *TokenRefreshWorker) SetRefreshInterval(interval time.Duration) {
 	w.refreshInterval = interval
@@ -0,0 +1,176 @@
+package plugins
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// GRPCPluginService is the gRPC service implemented by plugin servers, see proto/bifrost/plugin/v1/plugin.proto
+const GRPCPluginService = "bifrost.plugin.v1.PluginService"
+
+// DefaultGRPCSetupTimeout is the default timeout of the GetName, Init and Cleanup calls of a gRPC plugin
+const DefaultGRPCSetupTimeout = 30 * time.Second
+
+// grpcHookMethods maps the hooks to the methods of the plugin service implementing them
+var grpcHookMethods = map[string]string{
+	HookHTTPPreHook:         "HTTPPreHook",
+	HookHTTPPostHook:        "HTTPPostHook",
+	HookHTTPStreamChunkHook: "HTTPStreamChunkHook",
+	HookPreHook:             "PreHook",
+	HookPostHook:            "PostHook",
+}
+
+// GRPCPluginLoader is the loader for plugins served over gRPC, at grpc://host:port (plaintext)
+// or grpcs://host:port (TLS) paths. The plugin server runs in its own process and can be written
+// in any language; its hooks exchange the same JSON documents as WASM plugins.
+type GRPCPluginLoader struct {
+	// SetupTimeout is the timeout of the GetName, Init and Cleanup calls (default: 30s)
+	SetupTimeout time.Duration
+}
+
+// grpcPlugin is a connection to a plugin server
+type grpcPlugin struct {
+	conn         *grpc.ClientConn
+	setupTimeout time.Duration
+	// unimplemented holds the hooks the server answered UNIMPLEMENTED to, which are not called again
+	unimplemented sync.Map
+}
+
+// IsGRPCPluginPath reports whether path is the address of a gRPC plugin server
+func IsGRPCPluginPath(path string) bool {
+	return strings.HasPrefix(path, "grpc://") || strings.HasPrefix(path, "grpcs://")
+}
+
+// LoadPlugin connects to the plugin server at path and calls its Init method with the JSON of config.
+func (l *GRPCPluginLoader) LoadPlugin(path string, config any) (schemas.BasePlugin, error) {
+	p, err := l.dial(path)
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	if config != nil {
+		if data, err = schemas.Marshal(config); err != nil {
+			p.conn.Close()
+			return nil, fmt.Errorf("failed to marshal plugin config: %w", err)
+		}
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.setupTimeout)
+	defer cancel()
+	name, err := p.getName(ctx)
+	if err != nil {
+		p.conn.Close()
+		return nil, err
+	}
+	// Init is optional
+	if err := p.conn.Invoke(ctx, p.method("Init"), wrapperspb.Bytes(data), &emptypb.Empty{}); err != nil && status.Code(err) != codes.Unimplemented {
+		p.conn.Close()
+		return nil, fmt.Errorf("plugin init failed: %w", err)
+	}
+	hooks := make([]string, 0, len(grpcHookMethods))
+	for hook := range grpcHookMethods {
+		hooks = append(hooks, hook)
+	}
+	return newRemotePlugin(path, name, hooks, p.call, p.cleanup), nil
+}
+
+// VerifyBasePlugin connects to the plugin server at path and returns its name
+func (l *GRPCPluginLoader) VerifyBasePlugin(path string) (string, error) {
+	p, err := l.dial(path)
+	if err != nil {
+		return "", err
+	}
+	defer p.conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), p.setupTimeout)
+	defer cancel()
+	return p.getName(ctx)
+}
+
+// dial creates the client connection of a grpc:// or grpcs:// path.
+func (l *GRPCPluginLoader) dial(path string) (*grpcPlugin, error) {
+	var target string
+	var creds credentials.TransportCredentials
+	switch {
+	case strings.HasPrefix(path, "grpcs://"):
+		target = strings.TrimPrefix(path, "grpcs://")
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	case strings.HasPrefix(path, "grpc://"):
+		target = strings.TrimPrefix(path, "grpc://")
+		creds = insecure.NewCredentials()
+	default:
+		return nil, fmt.Errorf("invalid gRPC plugin path %q, expected grpc://host:port or grpcs://host:port", path)
+	}
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gRPC client: %w", err)
+	}
+	setupTimeout := l.SetupTimeout
+	if setupTimeout <= 0 {
+		setupTimeout = DefaultGRPCSetupTimeout
+	}
+	return &grpcPlugin{conn: conn, setupTimeout: setupTimeout}, nil
+}
+
+// method returns the full name of a method of the plugin service.
+func (p *grpcPlugin) method(name string) string {
+	return "/" + GRPCPluginService + "/" + name
+}
+
+// getName calls the GetName method.
+func (p *grpcPlugin) getName(ctx context.Context) (string, error) {
+	name := &wrapperspb.StringValue{}
+	if err := p.conn.Invoke(ctx, p.method("GetName"), &emptypb.Empty{}, name); err != nil {
+		return "", fmt.Errorf("failed to get plugin name: %w", err)
+	}
+	if name.GetValue() == "" {
+		return "", fmt.Errorf("plugin returned an empty name")
+	}
+	return name.GetValue(), nil
+}
+
+// call calls the method of hook. A hook the server does not implement is reported as such and
+// skipped afterwards.
+func (p *grpcPlugin) call(ctx context.Context, hook string, input []byte) ([]byte, error) {
+	method, ok := grpcHookMethods[hook]
+	if !ok {
+		return nil, errHookNotImplemented
+	}
+	if _, ok := p.unimplemented.Load(hook); ok {
+		return nil, errHookNotImplemented
+	}
+	output := &wrapperspb.BytesValue{}
+	if err := p.conn.Invoke(ctx, p.method(method), wrapperspb.Bytes(input), output); err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			p.unimplemented.Store(hook, struct{}{})
+			return nil, errHookNotImplemented
+		}
+		return nil, fmt.Errorf("%s failed: %w", method, err)
+	}
+	return output.GetValue(), nil
+}
+
+// cleanup calls the optional Cleanup method and closes the connection.
+func (p *grpcPlugin) cleanup() error {
+	ctx, cancel := context.WithTimeout(context.Background(), p.setupTimeout)
+	defer cancel()
+	err := p.conn.Invoke(ctx, p.method("Cleanup"), &emptypb.Empty{}, &emptypb.Empty{})
+	if status.Code(err) == codes.Unimplemented {
+		err = nil
+	}
+	if closeErr := p.conn.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
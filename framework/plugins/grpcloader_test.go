@@ -0,0 +1,185 @@
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// testPluginServer is a plugin server implementing GetName, Init, HTTPPreHook and PostHook
+type testPluginServer struct {
+	config   []byte
+	cleanups int
+}
+
+func (s *testPluginServer) handle(method string, input []byte) ([]byte, error) {
+	switch method {
+	case "HTTPPreHook":
+		var in HTTPPreHookInput
+		if err := json.Unmarshal(input, &in); err != nil {
+			return nil, err
+		}
+		out := HTTPPreHookOutput{Context: map[string]interface{}{"seen_path": in.Request.Path}}
+		if in.Request.Headers["x-block"] != "" {
+			out.HasResponse = true
+			out.Response = &schemas.HTTPResponse{StatusCode: 403, Headers: map[string]string{}, Body: []byte("blocked")}
+		}
+		return json.Marshal(out)
+	case "PostHook":
+		var in PostHookInput
+		if err := json.Unmarshal(input, &in); err != nil {
+			return nil, err
+		}
+		out := PostHookOutput{Context: in.Context}
+		if in.HasError {
+			// Recover from the error
+			out.Response = &schemas.BifrostResponse{}
+		}
+		return json.Marshal(out)
+	}
+	return nil, status.Error(codes.Unimplemented, method)
+}
+
+// startTestPluginServer serves s on a local port and returns its grpc:// path.
+func startTestPluginServer(t *testing.T, s *testPluginServer) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	hook := func(method string) grpc.MethodDesc {
+		return grpc.MethodDesc{
+			MethodName: method,
+			Handler: func(srv any, ctx context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+				in := &wrapperspb.BytesValue{}
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				out, err := srv.(*testPluginServer).handle(method, in.GetValue())
+				if err != nil {
+					return nil, err
+				}
+				return wrapperspb.Bytes(out), nil
+			},
+		}
+	}
+	desc := grpc.ServiceDesc{
+		ServiceName: GRPCPluginService,
+		HandlerType: (*any)(nil),
+		Methods: []grpc.MethodDesc{
+			{
+				MethodName: "GetName",
+				Handler: func(srv any, ctx context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+					return wrapperspb.String("grpc-test"), dec(&emptypb.Empty{})
+				},
+			},
+			{
+				MethodName: "Init",
+				Handler: func(srv any, ctx context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+					in := &wrapperspb.BytesValue{}
+					err := dec(in)
+					srv.(*testPluginServer).config = in.GetValue()
+					return &emptypb.Empty{}, err
+				},
+			},
+			{
+				MethodName: "Cleanup",
+				Handler: func(srv any, ctx context.Context, dec func(any) error, _ grpc.UnaryServerInterceptor) (any, error) {
+					srv.(*testPluginServer).cleanups++
+					return &emptypb.Empty{}, dec(&emptypb.Empty{})
+				},
+			},
+			hook("HTTPPreHook"),
+			hook("HTTPPostHook"),
+			hook("HTTPStreamChunkHook"),
+			hook("PreHook"),
+			hook("PostHook"),
+		},
+	}
+	server := grpc.NewServer()
+	server.RegisterService(&desc, s)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return "grpc://" + listener.Addr().String()
+}
+
+func TestGRPCPluginLoader(t *testing.T) {
+	server := &testPluginServer{}
+	path := startTestPluginServer(t, server)
+	loader := NewMultiPluginLoader()
+
+	name, err := loader.VerifyBasePlugin(path)
+	require.NoError(t, err)
+	assert.Equal(t, "grpc-test", name)
+
+	plugin, err := loader.LoadPlugin(path, map[string]any{"mode": "strict"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"mode":"strict"}`, string(server.config))
+
+	httpPlugin := AsHTTPTransportPlugin(plugin)
+	require.NotNil(t, httpPlugin)
+	llmPlugin := AsLLMPlugin(plugin)
+	require.NotNil(t, llmPlugin)
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+
+	t.Run("HTTPPreHook", func(t *testing.T) {
+		req := &schemas.HTTPRequest{Method: "POST", Path: "/v1/chat/completions", Headers: map[string]string{}}
+		resp, err := httpPlugin.HTTPTransportPreHook(ctx, req)
+		require.NoError(t, err)
+		assert.Nil(t, resp)
+		assert.Equal(t, "/v1/chat/completions", ctx.Value(schemas.BifrostContextKey("seen_path")))
+
+		req.Headers["x-block"] = "1"
+		resp, err = httpPlugin.HTTPTransportPreHook(ctx, req)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		assert.Equal(t, 403, resp.StatusCode)
+		assert.Equal(t, "blocked", string(resp.Body))
+	})
+
+	t.Run("UnimplementedHookPassesThrough", func(t *testing.T) {
+		req := &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest}
+		out, shortCircuit, err := llmPlugin.PreLLMHook(ctx, req)
+		require.NoError(t, err)
+		assert.Same(t, req, out)
+		assert.Nil(t, shortCircuit)
+
+		resp := &schemas.HTTPResponse{StatusCode: 200, Headers: map[string]string{}}
+		require.NoError(t, httpPlugin.HTTPTransportPostHook(ctx, &schemas.HTTPRequest{}, resp))
+		assert.Equal(t, 200, resp.StatusCode)
+	})
+
+	t.Run("PostHookRecovers", func(t *testing.T) {
+		bifrostErr := &schemas.BifrostError{Error: &schemas.ErrorField{Message: "upstream failed"}}
+		resp, outErr, err := llmPlugin.PostLLMHook(ctx, nil, bifrostErr)
+		require.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Nil(t, outErr)
+	})
+
+	require.NoError(t, plugin.Cleanup())
+	assert.Equal(t, 1, server.cleanups)
+}
+
+func TestGRPCPluginLoader_Unreachable(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	path := "grpc://" + listener.Addr().String()
+	listener.Close()
+
+	loader := &GRPCPluginLoader{SetupTimeout: 200 * time.Millisecond}
+	_, err = loader.LoadPlugin(path, nil)
+	assert.Error(t, err)
+	_, err = loader.VerifyBasePlugin("http://localhost:1")
+	assert.Error(t, err)
+}
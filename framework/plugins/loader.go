@@ -1,6 +1,10 @@
 package plugins
 
-import "github.com/capsohq/bifrost/core/schemas"
+import (
+	"strings"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
 
 // PluginLoader is the contract for a plugin loader
 type PluginLoader interface {
@@ -14,3 +18,50 @@ type PluginLoader interface {
 	// This method is used to verify that the plugin is a valid base plugin and has the required symbols
 	VerifyBasePlugin(path string) (string, error)
 }
+
+// MultiPluginLoader dispatches to the loader of the kind of plugin at a path: gRPC servers at
+// grpc:// and grpcs:// addresses, WebAssembly modules for .wasm paths and shared objects otherwise.
+type MultiPluginLoader struct {
+	SharedObject PluginLoader
+	WASM         PluginLoader
+	GRPC         PluginLoader
+}
+
+// NewMultiPluginLoader returns a MultiPluginLoader with the default loaders
+func NewMultiPluginLoader() *MultiPluginLoader {
+	return &MultiPluginLoader{
+		SharedObject: &SharedObjectPluginLoader{},
+		WASM:         &WASMPluginLoader{},
+		GRPC:         &GRPCPluginLoader{},
+	}
+}
+
+// loaderFor returns the loader of the plugin at path.
+func (l *MultiPluginLoader) loaderFor(path string) PluginLoader {
+	switch {
+	case IsGRPCPluginPath(path):
+		return l.GRPC
+	case strings.HasSuffix(strings.ToLower(stripQuery(path)), ".wasm"):
+		return l.WASM
+	default:
+		return l.SharedObject
+	}
+}
+
+// LoadPlugin loads the plugin at path with the loader of its kind
+func (l *MultiPluginLoader) LoadPlugin(path string, config any) (schemas.BasePlugin, error) {
+	return l.loaderFor(path).LoadPlugin(path, config)
+}
+
+// VerifyBasePlugin verifies the plugin at path with the loader of its kind
+func (l *MultiPluginLoader) VerifyBasePlugin(path string) (string, error) {
+	return l.loaderFor(path).VerifyBasePlugin(path)
+}
+
+// stripQuery removes the query and fragment of a plugin URL.
+func stripQuery(path string) string {
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		return path[:i]
+	}
+	return path
+}
@@ -0,0 +1,378 @@
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// Hook names of out-of-process (WASM and gRPC) plugins. The hooks exchange the JSON documents below.
+const (
+	HookHTTPPreHook         = "http_pre_hook"
+	HookHTTPPostHook        = "http_post_hook"
+	HookHTTPStreamChunkHook = "http_stream_chunk_hook"
+	HookPreHook             = "pre_hook"
+	HookPostHook            = "post_hook"
+)
+
+// errHookNotImplemented is returned by a hookCaller for a hook the plugin does not implement
+var errHookNotImplemented = errors.New("hook not implemented")
+
+// hookCaller calls a hook of an out-of-process plugin with its JSON input and returns its JSON output
+type hookCaller func(ctx context.Context, hook string, input []byte) ([]byte, error)
+
+// HTTPPreHookInput is the input of http_pre_hook
+type HTTPPreHookInput struct {
+	Context map[string]interface{} `json:"context"`
+	Request *schemas.HTTPRequest   `json:"request,omitempty"`
+}
+
+// HTTPPreHookOutput is the output of http_pre_hook
+type HTTPPreHookOutput struct {
+	Context     map[string]interface{} `json:"context"`
+	Request     *schemas.HTTPRequest   `json:"request,omitempty"`  // Replaces the request, nil keeps it
+	Response    *schemas.HTTPResponse  `json:"response,omitempty"` // Short-circuits with this response when has_response is set
+	HasResponse bool                   `json:"has_response"`
+	Error       string                 `json:"error"`
+}
+
+// HTTPPostHookInput is the input of http_post_hook
+type HTTPPostHookInput struct {
+	Context  map[string]interface{} `json:"context"`
+	Request  *schemas.HTTPRequest   `json:"request,omitempty"`
+	Response *schemas.HTTPResponse  `json:"response,omitempty"`
+}
+
+// HTTPPostHookOutput is the output of http_post_hook
+type HTTPPostHookOutput struct {
+	Context  map[string]interface{} `json:"context"`
+	Response *schemas.HTTPResponse  `json:"response,omitempty"` // Replaces the response, nil keeps it
+	Error    string                 `json:"error"`
+}
+
+// HTTPStreamChunkHookInput is the input of http_stream_chunk_hook
+type HTTPStreamChunkHookInput struct {
+	Context map[string]interface{}      `json:"context"`
+	Request *schemas.HTTPRequest        `json:"request,omitempty"`
+	Chunk   *schemas.BifrostStreamChunk `json:"chunk,omitempty"`
+}
+
+// HTTPStreamChunkHookOutput is the output of http_stream_chunk_hook
+type HTTPStreamChunkHookOutput struct {
+	Context  map[string]interface{} `json:"context"`
+	Chunk    json.RawMessage        `json:"chunk,omitempty"` // Replaces the chunk when has_chunk is set
+	HasChunk bool                   `json:"has_chunk"`
+	Skip     bool                   `json:"skip"` // Drops the chunk
+	Error    string                 `json:"error"`
+}
+
+// PreHookInput is the input of pre_hook
+type PreHookInput struct {
+	Context map[string]interface{}  `json:"context"`
+	Request *schemas.BifrostRequest `json:"request,omitempty"`
+}
+
+// PreHookOutput is the output of pre_hook
+type PreHookOutput struct {
+	Context         map[string]interface{}         `json:"context"`
+	Request         *schemas.BifrostRequest        `json:"request,omitempty"` // Replaces the request, nil keeps it
+	ShortCircuit    *schemas.LLMPluginShortCircuit `json:"short_circuit,omitempty"`
+	HasShortCircuit bool                           `json:"has_short_circuit"`
+	Error           string                         `json:"error"`
+}
+
+// PostHookInput is the input of post_hook
+type PostHookInput struct {
+	Context  map[string]interface{}   `json:"context"`
+	Response *schemas.BifrostResponse `json:"response,omitempty"`
+	Error    *schemas.BifrostError    `json:"error,omitempty"`
+	HasError bool                     `json:"has_error"`
+}
+
+// PostHookOutput is the output of post_hook
+type PostHookOutput struct {
+	Context   map[string]interface{}   `json:"context"`
+	Response  *schemas.BifrostResponse `json:"response,omitempty"` // Replaces the response, nil keeps it
+	Error     *schemas.BifrostError    `json:"error,omitempty"`    // Replaces the error when has_error is set
+	HasError  bool                     `json:"has_error"`
+	HookError string                   `json:"hook_error"`
+}
+
+// remoteHooks implements the hooks of a DynamicPlugin by exchanging JSON documents with an
+// out-of-process plugin.
+type remoteHooks struct {
+	call hookCaller
+}
+
+// newRemotePlugin returns a DynamicPlugin whose hooks in hooks are called through call.
+func newRemotePlugin(path string, name string, hooks []string, call hookCaller, cleanup func() error) *DynamicPlugin {
+	r := &remoteHooks{call: call}
+	dp := &DynamicPlugin{
+		Path:    path,
+		getName: func() string { return name },
+		cleanup: cleanup,
+	}
+	for _, hook := range hooks {
+		switch hook {
+		case HookHTTPPreHook:
+			dp.httpTransportPreHook = r.httpPreHook
+		case HookHTTPPostHook:
+			dp.httpTransportPostHook = r.httpPostHook
+		case HookHTTPStreamChunkHook:
+			dp.httpTransportStreamChunkHook = r.httpStreamChunkHook
+		case HookPreHook:
+			dp.preLLMHook = r.preHook
+		case HookPostHook:
+			dp.postLLMHook = r.postHook
+		}
+	}
+	return dp
+}
+
+// invoke marshals input, calls hook and unmarshals its output into output.
+func (r *remoteHooks) invoke(ctx *schemas.BifrostContext, hook string, input any, output any) error {
+	data, err := schemas.Marshal(input)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s input: %w", hook, err)
+	}
+	result, err := r.call(ctx, hook, data)
+	if err != nil {
+		return err
+	}
+	if err := schemas.Unmarshal(result, output); err != nil {
+		return fmt.Errorf("failed to unmarshal %s output: %w", hook, err)
+	}
+	return nil
+}
+
+func (r *remoteHooks) httpPreHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest) (*schemas.HTTPResponse, error) {
+	values := contextValues(ctx)
+	var output HTTPPreHookOutput
+	if err := r.invoke(ctx, HookHTTPPreHook, HTTPPreHookInput{Context: values, Request: req}, &output); err != nil {
+		if errors.Is(err, errHookNotImplemented) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	applyContextValues(ctx, values, output.Context)
+	if output.Error != "" {
+		return nil, errors.New(output.Error)
+	}
+	if output.HasResponse && output.Response != nil {
+		return output.Response, nil
+	}
+	if output.Request != nil {
+		replaceHTTPRequest(req, output.Request)
+	}
+	return nil, nil
+}
+
+func (r *remoteHooks) httpPostHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest, resp *schemas.HTTPResponse) error {
+	values := contextValues(ctx)
+	var output HTTPPostHookOutput
+	if err := r.invoke(ctx, HookHTTPPostHook, HTTPPostHookInput{Context: values, Request: req, Response: resp}, &output); err != nil {
+		if errors.Is(err, errHookNotImplemented) {
+			return nil
+		}
+		return err
+	}
+	applyContextValues(ctx, values, output.Context)
+	if output.Response != nil {
+		resp.StatusCode = output.Response.StatusCode
+		resp.Body = output.Response.Body
+		clear(resp.Headers)
+		if resp.Headers == nil {
+			resp.Headers = make(map[string]string, len(output.Response.Headers))
+		}
+		for key, value := range output.Response.Headers {
+			resp.Headers[key] = value
+		}
+	}
+	if output.Error != "" {
+		return errors.New(output.Error)
+	}
+	return nil
+}
+
+func (r *remoteHooks) httpStreamChunkHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest, chunk *schemas.BifrostStreamChunk) (*schemas.BifrostStreamChunk, error) {
+	values := contextValues(ctx)
+	var output HTTPStreamChunkHookOutput
+	if err := r.invoke(ctx, HookHTTPStreamChunkHook, HTTPStreamChunkHookInput{Context: values, Request: req, Chunk: chunk}, &output); err != nil {
+		if errors.Is(err, errHookNotImplemented) {
+			return chunk, nil
+		}
+		// Failing to reach the plugin must not end the stream
+		return chunk, err
+	}
+	applyContextValues(ctx, values, output.Context)
+	result := chunk
+	switch {
+	case output.Skip:
+		result = nil
+	case output.HasChunk && len(output.Chunk) > 0:
+		replaced, err := decodeStreamChunk(output.Chunk, chunk)
+		if err != nil {
+			return chunk, err
+		}
+		result = replaced
+	}
+	if output.Error != "" {
+		return result, errors.New(output.Error)
+	}
+	return result, nil
+}
+
+func (r *remoteHooks) preHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.LLMPluginShortCircuit, error) {
+	values := contextValues(ctx)
+	var output PreHookOutput
+	if err := r.invoke(ctx, HookPreHook, PreHookInput{Context: values, Request: req}, &output); err != nil {
+		if errors.Is(err, errHookNotImplemented) {
+			return req, nil, nil
+		}
+		return req, nil, err
+	}
+	applyContextValues(ctx, values, output.Context)
+	if output.Request != nil {
+		req = output.Request
+	}
+	if output.Error != "" {
+		return req, nil, errors.New(output.Error)
+	}
+	if output.HasShortCircuit && output.ShortCircuit != nil {
+		return req, output.ShortCircuit, nil
+	}
+	return req, nil, nil
+}
+
+func (r *remoteHooks) postHook(ctx *schemas.BifrostContext, resp *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	values := contextValues(ctx)
+	var output PostHookOutput
+	input := PostHookInput{Context: values, Response: resp, Error: bifrostErr, HasError: bifrostErr != nil}
+	if err := r.invoke(ctx, HookPostHook, input, &output); err != nil {
+		if errors.Is(err, errHookNotImplemented) {
+			return resp, bifrostErr, nil
+		}
+		return resp, bifrostErr, err
+	}
+	applyContextValues(ctx, values, output.Context)
+	if output.Response != nil {
+		resp = output.Response
+	}
+	switch {
+	case output.HasError && output.Error != nil:
+		bifrostErr = output.Error
+	case !output.HasError && resp != nil:
+		// The plugin recovered from the error, or there was none
+		bifrostErr = nil
+	}
+	if output.HookError != "" {
+		return resp, bifrostErr, errors.New(output.HookError)
+	}
+	return resp, bifrostErr, nil
+}
+
+// contextValues returns the context values sent to out-of-process plugins: the values with string
+// keys whose value is a string, a bool or a number.
+func contextValues(ctx *schemas.BifrostContext) map[string]interface{} {
+	values := make(map[string]interface{})
+	if ctx == nil {
+		return values
+	}
+	for key, value := range ctx.GetUserValues() {
+		var name string
+		switch k := key.(type) {
+		case schemas.BifrostContextKey:
+			name = string(k)
+		case string:
+			name = k
+		default:
+			continue
+		}
+		switch value.(type) {
+		case string, bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+			values[name] = value
+		}
+	}
+	return values
+}
+
+// applyContextValues sets the context values an out-of-process plugin added or changed, comparing
+// its output with sent, the values it was given.
+func applyContextValues(ctx *schemas.BifrostContext, sent map[string]interface{}, output map[string]interface{}) {
+	if ctx == nil || len(output) == 0 {
+		return
+	}
+	// Compare with the sent values as they were decoded by the plugin, e.g. numbers as float64
+	var normalized map[string]interface{}
+	if data, err := schemas.Marshal(sent); err == nil {
+		_ = schemas.Unmarshal(data, &normalized)
+	}
+	for key, value := range output {
+		if previous, ok := normalized[key]; ok && reflect.DeepEqual(previous, value) {
+			continue
+		}
+		ctx.SetValue(schemas.BifrostContextKey(key), value)
+	}
+}
+
+// replaceHTTPRequest copies src into the pooled request dst.
+func replaceHTTPRequest(dst *schemas.HTTPRequest, src *schemas.HTTPRequest) {
+	dst.Method = src.Method
+	dst.Path = src.Path
+	dst.Body = src.Body
+	for _, pair := range []struct{ dst, src *map[string]string }{
+		{&dst.Headers, &src.Headers},
+		{&dst.Query, &src.Query},
+		{&dst.PathParams, &src.PathParams},
+	} {
+		if *pair.dst == nil {
+			*pair.dst = make(map[string]string, len(*pair.src))
+		}
+		clear(*pair.dst)
+		for key, value := range *pair.src {
+			(*pair.dst)[key] = value
+		}
+	}
+}
+
+// decodeStreamChunk decodes the chunk an out-of-process plugin returned into the response type of
+// like, the chunk it was given.
+func decodeStreamChunk(data []byte, like *schemas.BifrostStreamChunk) (*schemas.BifrostStreamChunk, error) {
+	chunk := &schemas.BifrostStreamChunk{}
+	var target any
+	switch {
+	case like == nil:
+		return nil, fmt.Errorf("cannot replace a missing stream chunk")
+	case like.BifrostTextCompletionResponse != nil:
+		chunk.BifrostTextCompletionResponse = &schemas.BifrostTextCompletionResponse{}
+		target = chunk.BifrostTextCompletionResponse
+	case like.BifrostChatResponse != nil:
+		chunk.BifrostChatResponse = &schemas.BifrostChatResponse{}
+		target = chunk.BifrostChatResponse
+	case like.BifrostResponsesStreamResponse != nil:
+		chunk.BifrostResponsesStreamResponse = &schemas.BifrostResponsesStreamResponse{}
+		target = chunk.BifrostResponsesStreamResponse
+	case like.BifrostSpeechStreamResponse != nil:
+		chunk.BifrostSpeechStreamResponse = &schemas.BifrostSpeechStreamResponse{}
+		target = chunk.BifrostSpeechStreamResponse
+	case like.BifrostTranscriptionStreamResponse != nil:
+		chunk.BifrostTranscriptionStreamResponse = &schemas.BifrostTranscriptionStreamResponse{}
+		target = chunk.BifrostTranscriptionStreamResponse
+	case like.BifrostImageGenerationStreamResponse != nil:
+		chunk.BifrostImageGenerationStreamResponse = &schemas.BifrostImageGenerationStreamResponse{}
+		target = chunk.BifrostImageGenerationStreamResponse
+	case like.BifrostError != nil:
+		chunk.BifrostError = &schemas.BifrostError{}
+		target = chunk.BifrostError
+	default:
+		return nil, fmt.Errorf("cannot replace an empty stream chunk")
+	}
+	if err := schemas.Unmarshal(data, target); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stream chunk: %w", err)
+	}
+	return chunk, nil
+}
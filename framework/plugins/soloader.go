@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"plugin"
 	"strings"
+	"time"
 
 	"github.com/capsohq/bifrost/core/schemas"
 )
@@ -139,6 +140,13 @@ func (l *SharedObjectPluginLoader) LoadPlugin(path string, config any) (schemas.
 		}
 	}
 
+	// Optional: HookTimeout (PluginWithHookTimeout)
+	if sym, err := pluginObj.Lookup("HookTimeout"); err == nil {
+		if dp.hookTimeout, ok = sym.(func() time.Duration); !ok {
+			return nil, fmt.Errorf("failed to cast HookTimeout to func() time.Duration")
+		}
+	}
+
 	return dp, nil
 }
 
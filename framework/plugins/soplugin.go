@@ -3,6 +3,7 @@ package plugins
 import (
 	"context"
 	"plugin"
+	"time"
 
 	"github.com/capsohq/bifrost/core/schemas"
 )
@@ -36,6 +37,9 @@ type DynamicPlugin struct {
 
 	// ObservabilityPlugin (optional)
 	inject func(ctx context.Context, trace *schemas.Trace) error
+
+	// PluginWithHookTimeout (optional)
+	hookTimeout func() time.Duration
 }
 
 // GetName returns the name of the plugin (BasePlugin interface)
@@ -111,3 +115,11 @@ func (dp *DynamicPlugin) Inject(ctx context.Context, trace *schemas.Trace) error
 	}
 	return dp.inject(ctx, trace)
 }
+
+// HookTimeout returns the timeout of the LLM and MCP hook calls of the plugin (PluginWithHookTimeout interface)
+func (dp *DynamicPlugin) HookTimeout() time.Duration {
+	if dp.hookTimeout == nil {
+		return 0 // Bifrost default if not implemented
+	}
+	return dp.hookTimeout()
+}
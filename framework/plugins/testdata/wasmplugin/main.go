@@ -0,0 +1,76 @@
+// Command wasmplugin is a WASM plugin used by the loader tests.
+// Build with: GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -o plugin.wasm .
+package main
+
+import (
+	"encoding/json"
+	"unsafe"
+)
+
+// buffers keeps the memory handed to the host alive until it is freed
+var buffers = map[uint32][]byte{}
+
+var prefix string
+
+func main() {}
+
+//go:wasmexport malloc
+func malloc(size uint32) uint32 {
+	buf := make([]byte, size)
+	ptr := uint32(uintptr(unsafe.Pointer(&buf[0])))
+	buffers[ptr] = buf
+	return ptr
+}
+
+//go:wasmexport free
+func free(ptr uint32) {
+	delete(buffers, ptr)
+}
+
+func read(ptr uint32, length uint32) []byte {
+	return buffers[ptr][:length]
+}
+
+func write(data []byte) uint64 {
+	ptr := malloc(uint32(len(data)))
+	copy(buffers[ptr], data)
+	return uint64(ptr)<<32 | uint64(len(data))
+}
+
+//go:wasmexport get_name
+func getName() uint64 {
+	return write([]byte("wasm-test"))
+}
+
+//go:wasmexport init
+func initPlugin(ptr uint32, length uint32) int32 {
+	var config struct {
+		Prefix string `json:"prefix"`
+	}
+	if err := json.Unmarshal(read(ptr, length), &config); err != nil {
+		return 1
+	}
+	prefix = config.Prefix
+	return 0
+}
+
+//go:wasmexport pre_hook
+func preHook(ptr uint32, length uint32) uint64 {
+	var input struct {
+		Context map[string]any `json:"context"`
+	}
+	if err := json.Unmarshal(read(ptr, length), &input); err != nil {
+		return write([]byte(`{"error":"invalid input"}`))
+	}
+	if input.Context["trap"] == true {
+		panic("trap requested")
+	}
+	count := 1.0
+	if n, ok := input.Context["count"].(float64); ok {
+		count = n + 1
+	}
+	input.Context["count"] = count
+	input.Context["prefix"] = prefix
+	output, _ := json.Marshal(map[string]any{"context": input.Context})
+	return write(output)
+}
@@ -0,0 +1,391 @@
+package plugins
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+)
+
+// DefaultWASMInstances is the default number of module instances of a WASM plugin
+const DefaultWASMInstances = 1
+
+// wasmHookExports maps the hooks to the exports implementing them, in order of preference
+var wasmHookExports = map[string][]string{
+	HookHTTPPreHook:         {"http_pre_hook", "http_intercept"},
+	HookHTTPPostHook:        {"http_post_hook"},
+	HookHTTPStreamChunkHook: {"http_stream_chunk_hook"},
+	HookPreHook:             {"pre_hook"},
+	HookPostHook:            {"post_hook"},
+}
+
+// WASMPluginLoader is the loader for WebAssembly plugins (.wasm files)
+// The module is run in a sandboxed wazero runtime with WASI; it exchanges the JSON documents of
+// the hooks with the host through its linear memory, see the WASM plugin documentation.
+type WASMPluginLoader struct {
+	// Instances is the number of module instances the hook calls of a plugin are spread over.
+	// Each instance runs one call at a time and has its own memory and state (default: 1)
+	Instances int
+}
+
+// wasmPlugin is a loaded WASM plugin and its pool of module instances
+type wasmPlugin struct {
+	runtime  wazero.Runtime
+	compiled wazero.CompiledModule
+	config   []byte
+	// hooks maps the implemented hooks to their exports
+	hooks map[string]string
+	// instances holds the idle instances; nil is a slot whose instance must be (re)created
+	instances chan *wasmInstance
+	created   atomic.Int64
+}
+
+// wasmInstance is a module instance with its memory management exports
+type wasmInstance struct {
+	module        api.Module
+	malloc        api.Function
+	free          api.Function
+	freeTakesSize bool
+	reset         api.Function // optional, resets the bump allocator of the module after each call
+}
+
+// LoadPlugin loads a WASM plugin from a .wasm file or URL, instantiates it and calls its init
+// export with the JSON of config.
+func (l *WASMPluginLoader) LoadPlugin(path string, config any) (schemas.BasePlugin, error) {
+	p, err := compileWASMPlugin(path)
+	if err != nil {
+		return nil, err
+	}
+	if config != nil {
+		if p.config, err = schemas.Marshal(config); err != nil {
+			p.close()
+			return nil, fmt.Errorf("failed to marshal plugin config: %w", err)
+		}
+	}
+
+	instances := l.Instances
+	if instances <= 0 {
+		instances = DefaultWASMInstances
+	}
+	p.instances = make(chan *wasmInstance, instances)
+	var first *wasmInstance
+	for range instances {
+		instance, err := p.instantiate(context.Background(), true)
+		if err != nil {
+			p.close()
+			return nil, err
+		}
+		if first == nil {
+			first = instance
+		}
+		p.instances <- instance
+	}
+
+	name, err := first.getName(context.Background())
+	if err != nil {
+		p.close()
+		return nil, err
+	}
+	hooks := make([]string, 0, len(p.hooks))
+	for hook := range p.hooks {
+		hooks = append(hooks, hook)
+	}
+	return newRemotePlugin(path, name, hooks, p.call, p.cleanup), nil
+}
+
+// VerifyBasePlugin verifies a WASM plugin at the given path without calling its init export
+// Returns the name of the plugin or an error if the plugin is invalid
+func (l *WASMPluginLoader) VerifyBasePlugin(path string) (string, error) {
+	p, err := compileWASMPlugin(path)
+	if err != nil {
+		return "", err
+	}
+	defer p.close()
+	instance, err := p.instantiate(context.Background(), false)
+	if err != nil {
+		return "", err
+	}
+	return instance.getName(context.Background())
+}
+
+// compileWASMPlugin downloads the module if path is a URL, and compiles it in a new runtime.
+func compileWASMPlugin(path string) (*wasmPlugin, error) {
+	if strings.HasPrefix(path, "http") {
+		tempPath, err := DownloadPlugin(path, ".wasm")
+		if err != nil {
+			return nil, err
+		}
+		path = tempPath
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WASM module: %w", err)
+	}
+
+	ctx := context.Background()
+	// Calls are aborted when their request context is done
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().WithCloseOnContextDone(true))
+	p := &wasmPlugin{runtime: runtime}
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		p.close()
+		return nil, fmt.Errorf("failed to instantiate WASI: %w", err)
+	}
+	// AssemblyScript modules import env.abort unless built with --use abort=
+	_, err = runtime.NewHostModuleBuilder("env").
+		NewFunctionBuilder().
+		WithFunc(func(ctx context.Context, message, fileName, line, column uint32) {
+			panic(fmt.Sprintf("abort called at line %d, column %d", line, column))
+		}).
+		Export("abort").
+		Instantiate(ctx)
+	if err != nil {
+		p.close()
+		return nil, fmt.Errorf("failed to instantiate host module: %w", err)
+	}
+	if p.compiled, err = runtime.CompileModule(ctx, data); err != nil {
+		p.close()
+		return nil, fmt.Errorf("failed to compile WASM module: %w", err)
+	}
+
+	exports := p.compiled.ExportedFunctions()
+	if _, ok := exports["get_name"]; !ok {
+		p.close()
+		return nil, fmt.Errorf("required export get_name not found")
+	}
+	p.hooks = make(map[string]string)
+	for hook, names := range wasmHookExports {
+		for _, name := range names {
+			if _, ok := exports[name]; ok {
+				p.hooks[hook] = name
+				break
+			}
+		}
+	}
+	return p, nil
+}
+
+// instantiate creates a module instance, running its start function and, when initialize is set,
+// its init export with the plugin config.
+func (p *wasmPlugin) instantiate(ctx context.Context, initialize bool) (*wasmInstance, error) {
+	exports := p.compiled.ExportedFunctions()
+	config := wazero.NewModuleConfig().
+		WithName(fmt.Sprintf("instance-%d", p.created.Add(1))).
+		WithStdout(os.Stdout).
+		WithStderr(os.Stderr).
+		WithSysWalltime().
+		WithSysNanotime().
+		WithRandSource(rand.Reader)
+	// Reactor modules are initialized with _initialize, command modules (e.g. TinyGo) with _start
+	if _, ok := exports["_initialize"]; ok {
+		config = config.WithStartFunctions("_initialize")
+	}
+	module, err := p.runtime.InstantiateModule(ctx, p.compiled, config)
+	if err != nil {
+		var exitErr *sys.ExitError
+		if errors.As(err, &exitErr) {
+			return nil, fmt.Errorf("WASM module exited with code %d while starting, build it as a library (reactor)", exitErr.ExitCode())
+		}
+		return nil, fmt.Errorf("failed to instantiate WASM module: %w", err)
+	}
+
+	instance := &wasmInstance{module: module}
+	for _, name := range []string{"plugin_malloc", "malloc"} {
+		if instance.malloc = module.ExportedFunction(name); instance.malloc != nil {
+			break
+		}
+	}
+	for _, name := range []string{"plugin_free", "free"} {
+		if instance.free = module.ExportedFunction(name); instance.free != nil {
+			instance.freeTakesSize = len(instance.free.Definition().ParamTypes()) == 2
+			break
+		}
+	}
+	instance.reset = module.ExportedFunction("plugin_reset")
+	if instance.malloc == nil {
+		module.Close(ctx)
+		return nil, fmt.Errorf("required export malloc not found")
+	}
+
+	if initialize && module.ExportedFunction("init") != nil {
+		if err := instance.init(ctx, p.config); err != nil {
+			module.Close(ctx)
+			return nil, err
+		}
+	}
+	return instance, nil
+}
+
+// call calls the export of hook on an idle instance. An instance whose call failed is replaced,
+// as its memory may be left inconsistent.
+func (p *wasmPlugin) call(ctx context.Context, hook string, input []byte) ([]byte, error) {
+	export, ok := p.hooks[hook]
+	if !ok {
+		return nil, errHookNotImplemented
+	}
+	var instance *wasmInstance
+	select {
+	case instance = <-p.instances:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if instance == nil {
+		var err error
+		if instance, err = p.instantiate(context.Background(), true); err != nil {
+			p.instances <- nil
+			return nil, err
+		}
+	}
+	output, err := instance.call(ctx, export, input)
+	if err != nil {
+		instance.module.Close(context.Background())
+		p.instances <- nil
+		return nil, fmt.Errorf("%s failed: %w", export, err)
+	}
+	p.instances <- instance
+	return output, nil
+}
+
+// cleanup calls the cleanup export of every instance and closes the runtime.
+func (p *wasmPlugin) cleanup() error {
+	var errs []error
+	ctx := context.Background()
+	for range cap(p.instances) {
+		instance := <-p.instances
+		if instance == nil {
+			continue
+		}
+		if fn := instance.module.ExportedFunction("cleanup"); fn != nil {
+			results, err := fn.Call(ctx)
+			if err != nil {
+				errs = append(errs, err)
+			} else if len(results) > 0 && int32(results[0]) != 0 {
+				errs = append(errs, fmt.Errorf("cleanup returned %d", int32(results[0])))
+			}
+		}
+	}
+	p.close()
+	return errors.Join(errs...)
+}
+
+// close closes the runtime and all its instances.
+func (p *wasmPlugin) close() {
+	p.runtime.Close(context.Background())
+}
+
+// getName calls the get_name export.
+func (i *wasmInstance) getName(ctx context.Context) (string, error) {
+	results, err := i.module.ExportedFunction("get_name").Call(ctx)
+	if err != nil {
+		return "", fmt.Errorf("get_name failed: %w", err)
+	}
+	name, err := i.readResult(ctx, results)
+	if err != nil {
+		return "", fmt.Errorf("get_name failed: %w", err)
+	}
+	if len(name) == 0 {
+		return "", fmt.Errorf("get_name returned an empty name")
+	}
+	return string(name), nil
+}
+
+// init calls the init export with the plugin config.
+func (i *wasmInstance) init(ctx context.Context, config []byte) error {
+	ptr, err := i.write(ctx, config)
+	if err != nil {
+		return err
+	}
+	defer i.release(ctx, ptr, uint32(len(config)))
+	results, err := i.module.ExportedFunction("init").Call(ctx, uint64(ptr), uint64(len(config)))
+	if err != nil {
+		return fmt.Errorf("plugin init failed: %w", err)
+	}
+	if len(results) > 0 && int32(results[0]) != 0 {
+		return fmt.Errorf("plugin init failed with code %d", int32(results[0]))
+	}
+	return nil
+}
+
+// call calls export with input and returns its output.
+func (i *wasmInstance) call(ctx context.Context, export string, input []byte) ([]byte, error) {
+	ptr, err := i.write(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	results, err := i.module.ExportedFunction(export).Call(ctx, uint64(ptr), uint64(len(input)))
+	if err != nil {
+		return nil, err
+	}
+	output, err := i.readResult(ctx, results)
+	i.release(ctx, ptr, uint32(len(input)))
+	if err != nil {
+		return nil, err
+	}
+	if len(output) == 0 {
+		return nil, fmt.Errorf("no output")
+	}
+	return output, nil
+}
+
+// write copies data into memory allocated in the module and returns its pointer.
+func (i *wasmInstance) write(ctx context.Context, data []byte) (uint32, error) {
+	if len(data) == 0 {
+		return 0, nil
+	}
+	results, err := i.malloc.Call(ctx, uint64(len(data)))
+	if err != nil {
+		return 0, fmt.Errorf("malloc failed: %w", err)
+	}
+	ptr := uint32(results[0])
+	if ptr == 0 {
+		return 0, fmt.Errorf("malloc failed to allocate %d bytes", len(data))
+	}
+	if !i.module.Memory().Write(ptr, data) {
+		return 0, fmt.Errorf("malloc returned an out of range pointer")
+	}
+	return ptr, nil
+}
+
+// readResult copies the data a packed (pointer << 32 | length) result points to, and frees it.
+func (i *wasmInstance) readResult(ctx context.Context, results []uint64) ([]byte, error) {
+	if len(results) == 0 {
+		return nil, fmt.Errorf("no result")
+	}
+	ptr, length := uint32(results[0]>>32), uint32(results[0])
+	if length == 0 {
+		return nil, nil
+	}
+	data, ok := i.module.Memory().Read(ptr, length)
+	if !ok {
+		return nil, fmt.Errorf("result is out of memory range")
+	}
+	output := make([]byte, length)
+	copy(output, data)
+	i.release(ctx, ptr, length)
+	return output, nil
+}
+
+// release frees memory returned by the module or allocated in it, and resets its allocator.
+func (i *wasmInstance) release(ctx context.Context, ptr uint32, length uint32) {
+	if ptr == 0 {
+		return
+	}
+	if i.free != nil {
+		if i.freeTakesSize {
+			i.free.Call(ctx, uint64(ptr), uint64(length))
+		} else {
+			i.free.Call(ctx, uint64(ptr))
+		}
+	}
+	if i.reset != nil {
+		i.reset.Call(ctx)
+	}
+}
@@ -0,0 +1,73 @@
+package plugins
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildWASMTestPlugin builds testdata/wasmplugin and returns the path of the module.
+func buildWASMTestPlugin(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "plugin.wasm")
+	cmd := exec.Command("go", "build", "-buildmode=c-shared", "-o", path, "./testdata/wasmplugin")
+	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Skipf("failed to build WASM test plugin: %v\n%s", err, output)
+	}
+	return path
+}
+
+func TestWASMPluginLoader(t *testing.T) {
+	path := buildWASMTestPlugin(t)
+	loader := &WASMPluginLoader{Instances: 2}
+
+	name, err := loader.VerifyBasePlugin(path)
+	require.NoError(t, err)
+	assert.Equal(t, "wasm-test", name)
+
+	plugin, err := loader.LoadPlugin(path, map[string]any{"prefix": "p-"})
+	require.NoError(t, err)
+	defer plugin.Cleanup()
+	assert.Equal(t, "wasm-test", plugin.GetName())
+
+	llmPlugin := AsLLMPlugin(plugin)
+	require.NotNil(t, llmPlugin, "plugin exporting pre_hook should be an LLM plugin")
+	assert.Nil(t, AsHTTPTransportPlugin(plugin), "plugin without HTTP hooks should not be an HTTP transport plugin")
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	ctx.SetValue(schemas.BifrostContextKey("count"), 1)
+	req := &schemas.BifrostRequest{RequestType: schemas.ChatCompletionRequest}
+	out, shortCircuit, err := llmPlugin.PreLLMHook(ctx, req)
+	require.NoError(t, err)
+	assert.Nil(t, shortCircuit)
+	assert.Same(t, req, out)
+	assert.Equal(t, 2.0, ctx.Value(schemas.BifrostContextKey("count")))
+	assert.Equal(t, "p-", ctx.Value(schemas.BifrostContextKey("prefix")))
+
+	// A trapping call fails without breaking the plugin: the instance is replaced
+	ctx.SetValue(schemas.BifrostContextKey("trap"), true)
+	_, _, err = llmPlugin.PreLLMHook(ctx, req)
+	require.Error(t, err)
+	ctx.SetValue(schemas.BifrostContextKey("trap"), false)
+	for range 3 {
+		_, _, err = llmPlugin.PreLLMHook(ctx, req)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, "p-", ctx.Value(schemas.BifrostContextKey("prefix")), "replacement instance should be initialized with the config")
+}
+
+func TestMultiPluginLoader_Dispatch(t *testing.T) {
+	loader := NewMultiPluginLoader()
+	assert.Same(t, loader.GRPC, loader.loaderFor("grpc://localhost:50051"))
+	assert.Same(t, loader.GRPC, loader.loaderFor("grpcs://plugins.internal:443"))
+	assert.Same(t, loader.WASM, loader.loaderFor("/plugins/filter.wasm"))
+	assert.Same(t, loader.WASM, loader.loaderFor("https://example.com/filter.WASM?v=2"))
+	assert.Same(t, loader.SharedObject, loader.loaderFor("/plugins/filter.so"))
+}
@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	DefaultKeyPrefix = "bifrost:ratelimit:"
+	DefaultTimeout   = 100 * time.Millisecond
+)
+
+// Config holds the configuration of the Redis rate limiter shared by all Bifrost replicas.
+type Config struct {
+	Enabled   bool            `json:"enabled"`
+	Addr      *schemas.EnvVar `json:"addr"`                 // Redis server address (host:port)
+	Username  *schemas.EnvVar `json:"username,omitempty"`   // Username for Redis AUTH (optional)
+	Password  *schemas.EnvVar `json:"password,omitempty"`   // Password for Redis AUTH (optional)
+	DB        int             `json:"db,omitempty"`         // Redis database number. Default is 0.
+	KeyPrefix string          `json:"key_prefix,omitempty"` // Prefix of the bucket keys. Default is "bifrost:ratelimit:".
+	TimeoutMs int             `json:"timeout_ms,omitempty"` // Timeout of each Redis call. Default is 100ms.
+}
+
+// Validate checks the config has a Redis address.
+func (c *Config) Validate() error {
+	if c.Addr == nil || c.Addr.GetValue() == "" {
+		return fmt.Errorf("rate limiter redis addr is required")
+	}
+	if c.DB < 0 || c.TimeoutMs < 0 {
+		return fmt.Errorf("rate limiter db and timeout_ms cannot be negative")
+	}
+	return nil
+}
+
+func (c *Config) keyPrefix() string {
+	if c.KeyPrefix == "" {
+		return DefaultKeyPrefix
+	}
+	return c.KeyPrefix
+}
+
+func (c *Config) timeout() time.Duration {
+	if c.TimeoutMs <= 0 {
+		return DefaultTimeout
+	}
+	return time.Duration(c.TimeoutMs) * time.Millisecond
+}
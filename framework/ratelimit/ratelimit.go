@@ -0,0 +1,20 @@
+// Package ratelimit provides token buckets shared by all Bifrost replicas, so rate limits are
+// enforced cluster-wide rather than per process.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// RateLimiter keeps token buckets shared by every process using it. A bucket holds up to limit
+// tokens and refills continuously at limit tokens per window; a new bucket starts full.
+type RateLimiter interface {
+	// Available returns the tokens left in the bucket of key. It is negative while the bucket is in
+	// debt, after more tokens were consumed than it held.
+	Available(ctx context.Context, key string, limit int64, window time.Duration) (float64, error)
+	// Consume takes n tokens from the bucket of key, leaving it in debt if it holds fewer.
+	Consume(ctx context.Context, key string, limit int64, window time.Duration, n int64) error
+	// Close releases the resources of the rate limiter.
+	Close() error
+}
@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/redis/go-redis/v9"
+)
+
+// tokenBucketScript refills the bucket at KEYS[1] up to now and takes ARGV[3] tokens from it,
+// returning the tokens left. The Redis clock is used so every replica refills buckets alike.
+//
+// ARGV[1] is the bucket size, ARGV[2] the window in milliseconds over which it refills.
+var tokenBucketScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local take = tonumber(ARGV[3])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(bucket[1])
+local ts = tonumber(bucket[2])
+if tokens == nil or ts == nil then
+	tokens = limit
+	ts = now
+end
+tokens = math.min(limit, tokens + math.max(0, now - ts) * limit / window)
+if take > 0 then
+	tokens = tokens - take
+	redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', now)
+	-- Expire the bucket once it would be full again
+	redis.call('PEXPIRE', KEYS[1], math.ceil((limit - tokens) * window / limit) + 1000)
+end
+return tostring(tokens)
+`)
+
+// RedisRateLimiter is a RateLimiter keeping its buckets in Redis.
+type RedisRateLimiter struct {
+	client    *redis.Client
+	keyPrefix string
+	timeout   time.Duration
+}
+
+// NewRedisRateLimiter connects to the Redis server of the config and checks it is reachable.
+func NewRedisRateLimiter(ctx context.Context, config Config, logger schemas.Logger) (*RedisRateLimiter, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	var username, password string
+	if config.Username != nil {
+		username = config.Username.GetValue()
+	}
+	if config.Password != nil {
+		password = config.Password.GetValue()
+	}
+	limiter := &RedisRateLimiter{
+		client: redis.NewClient(&redis.Options{
+			Addr:     config.Addr.GetValue(),
+			Username: username,
+			Password: password,
+			DB:       config.DB,
+		}),
+		keyPrefix: config.keyPrefix(),
+		timeout:   config.timeout(),
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := limiter.client.Ping(pingCtx).Err(); err != nil {
+		limiter.client.Close()
+		return nil, fmt.Errorf("failed to connect to rate limiter redis at %s: %w", config.Addr.GetValue(), err)
+	}
+	logger.Info("connected to rate limiter redis at %s", config.Addr.GetValue())
+	return limiter, nil
+}
+
+// Available returns the tokens left in the bucket of key.
+func (l *RedisRateLimiter) Available(ctx context.Context, key string, limit int64, window time.Duration) (float64, error) {
+	return l.run(ctx, key, limit, window, 0)
+}
+
+// Consume takes n tokens from the bucket of key.
+func (l *RedisRateLimiter) Consume(ctx context.Context, key string, limit int64, window time.Duration, n int64) error {
+	if n <= 0 {
+		return nil
+	}
+	_, err := l.run(ctx, key, limit, window, n)
+	return err
+}
+
+// Close closes the Redis client.
+func (l *RedisRateLimiter) Close() error {
+	return l.client.Close()
+}
+
+func (l *RedisRateLimiter) run(ctx context.Context, key string, limit int64, window time.Duration, take int64) (float64, error) {
+	if limit <= 0 || window <= 0 {
+		return 0, fmt.Errorf("rate limit bucket %s needs a positive limit and window", key)
+	}
+	ctx, cancel := context.WithTimeout(ctx, l.timeout)
+	defer cancel()
+	result, err := tokenBucketScript.Run(ctx, l.client, []string{l.keyPrefix + key}, limit, max(window.Milliseconds(), 1), take).Text()
+	if err != nil {
+		return 0, fmt.Errorf("rate limit bucket %s: %w", key, err)
+	}
+	tokens, err := strconv.ParseFloat(result, 64)
+	if err != nil {
+		return 0, fmt.Errorf("rate limit bucket %s: invalid token count %q", key, result)
+	}
+	return tokens, nil
+}
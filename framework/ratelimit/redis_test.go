@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	config := Config{}
+	assert.Error(t, config.Validate(), "an address is required")
+
+	config.Addr = schemas.NewEnvVar("localhost:6379")
+	require.NoError(t, config.Validate())
+	assert.Equal(t, DefaultKeyPrefix, config.keyPrefix())
+	assert.Equal(t, DefaultTimeout, config.timeout())
+
+	config.TimeoutMs = -1
+	assert.Error(t, config.Validate())
+}
+
+// TestRedisRateLimiter_Integration requires a Redis server at REDIS_ADDR (default localhost:6379)
+func TestRedisRateLimiter_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	addr := os.Getenv("REDIS_ADDR")
+	if addr == "" {
+		addr = "localhost:6379"
+	}
+	limiter, err := NewRedisRateLimiter(context.Background(), Config{
+		Enabled:   true,
+		Addr:      schemas.NewEnvVar(addr),
+		KeyPrefix: "bifrost:ratelimit:test:",
+	}, bifrost.NewDefaultLogger(schemas.LogLevelError))
+	if err != nil {
+		t.Skipf("Redis not available: %v", err)
+	}
+	defer limiter.Close()
+
+	ctx := context.Background()
+	key := uuid.NewString()
+
+	// A new bucket starts full
+	available, err := limiter.Available(ctx, key, 10, time.Hour)
+	require.NoError(t, err)
+	assert.InDelta(t, 10, available, 0.01)
+
+	// Consuming past the bucket leaves it in debt
+	require.NoError(t, limiter.Consume(ctx, key, 10, time.Hour, 4))
+	require.NoError(t, limiter.Consume(ctx, key, 10, time.Hour, 8))
+	available, err = limiter.Available(ctx, key, 10, time.Hour)
+	require.NoError(t, err)
+	assert.InDelta(t, -2, available, 0.01)
+
+	// A bucket refills at limit tokens per window
+	key = uuid.NewString()
+	require.NoError(t, limiter.Consume(ctx, key, 10, time.Second, 10))
+	time.Sleep(500 * time.Millisecond)
+	available, err = limiter.Available(ctx, key, 10, time.Second)
+	require.NoError(t, err)
+	assert.InDelta(t, 5, available, 1.5)
+}
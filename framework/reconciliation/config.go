@@ -0,0 +1,52 @@
+package reconciliation
+
+import (
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	DefaultInterval         = 24 * time.Hour
+	DefaultLookbackDays     = 7
+	DefaultTolerancePercent = 1.0
+	MinInterval             = time.Hour
+)
+
+// Config holds the configuration for the usage reconciliation job.
+type Config struct {
+	Enabled          bool           `json:"enabled"`
+	IntervalSeconds  int            `json:"interval_seconds,omitempty"`  // How often the job runs. Default is 24 hours, minimum is 1 hour.
+	LookbackDays     int            `json:"lookback_days,omitempty"`     // Number of complete UTC days compared on each run. Default is 7.
+	TolerancePercent float64        `json:"tolerance_percent,omitempty"` // Relative difference tolerated before a discrepancy is flagged. Default is 1%.
+	Sources          []SourceConfig `json:"sources,omitempty"`
+}
+
+// SourceConfig configures a provider usage API to reconcile against.
+type SourceConfig struct {
+	Provider schemas.ModelProvider `json:"provider"`
+	AdminKey schemas.EnvVar        `json:"admin_key"`          // Organization admin key; regular inference keys cannot read usage
+	BaseURL  string                `json:"base_url,omitempty"` // Overrides the provider's default API base URL
+}
+
+// interval returns the configured run interval, falling back to the default and clamping to the minimum.
+func (c *Config) interval() time.Duration {
+	if c.IntervalSeconds <= 0 {
+		return DefaultInterval
+	}
+	return max(time.Duration(c.IntervalSeconds)*time.Second, MinInterval)
+}
+
+func (c *Config) lookbackDays() int {
+	if c.LookbackDays <= 0 {
+		return DefaultLookbackDays
+	}
+	return c.LookbackDays
+}
+
+func (c *Config) tolerancePercent() float64 {
+	if c.TolerancePercent <= 0 {
+		return DefaultTolerancePercent
+	}
+	return c.TolerancePercent
+}
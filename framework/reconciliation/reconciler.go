@@ -0,0 +1,379 @@
+// Package reconciliation compares the usage Bifrost meters in its log store against the usage
+// providers report through their organization usage APIs, and flags discrepancies in a report.
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/logstore"
+)
+
+const runTimeout = 10 * time.Minute
+
+// DiscrepancyKind classifies a reconciliation discrepancy.
+type DiscrepancyKind string
+
+const (
+	// DiscrepancyMissedRequests means the provider reports more usage than Bifrost metered: requests that
+	// bypassed Bifrost, were dropped by the logging pipeline, or share the provider organization with other clients.
+	DiscrepancyMissedRequests DiscrepancyKind = "missed_requests"
+	// DiscrepancyUnreportedUsage means Bifrost metered usage that the provider does not report.
+	DiscrepancyUnreportedUsage DiscrepancyKind = "unreported_usage"
+	// DiscrepancyUnitMismatch means request counts agree but token counts do not, which usually points to
+	// units being counted differently (cached tokens, reasoning tokens, ...).
+	DiscrepancyUnitMismatch DiscrepancyKind = "unit_mismatch"
+)
+
+// UsageTotals holds request and token counts on one side of the comparison.
+// Requests is omitted for providers whose usage API does not report request counts.
+type UsageTotals struct {
+	Requests     *int64 `json:"requests,omitempty"`
+	InputTokens  int64  `json:"input_tokens"`
+	OutputTokens int64  `json:"output_tokens"`
+}
+
+// Discrepancy is a provider/model/day whose metered and reported usage disagree.
+type Discrepancy struct {
+	Provider schemas.ModelProvider `json:"provider"`
+	Model    string                `json:"model"`
+	Date     string                `json:"date"` // UTC day, YYYY-MM-DD
+	Kind     DiscrepancyKind       `json:"kind"`
+	Metered  UsageTotals           `json:"metered"`
+	Reported UsageTotals           `json:"reported"`
+	Message  string                `json:"message"`
+}
+
+// ProviderSummary summarizes a single provider's reconciliation run.
+type ProviderSummary struct {
+	Provider      schemas.ModelProvider `json:"provider"`
+	Metered       UsageTotals           `json:"metered"`
+	Reported      UsageTotals           `json:"reported"`
+	Discrepancies int                   `json:"discrepancies"`
+	Error         string                `json:"error,omitempty"`
+}
+
+// Report is the outcome of a reconciliation run.
+type Report struct {
+	GeneratedAt      time.Time         `json:"generated_at"`
+	WindowStart      time.Time         `json:"window_start"`
+	WindowEnd        time.Time         `json:"window_end"`
+	TolerancePercent float64           `json:"tolerance_percent"`
+	Providers        []ProviderSummary `json:"providers"`
+	Discrepancies    []Discrepancy     `json:"discrepancies"`
+}
+
+// MeteredUsageStore is the subset of the log store the reconciler reads metered usage from.
+type MeteredUsageStore interface {
+	GetUsageSummary(ctx context.Context, filters logstore.SearchFilters, bucketSizeSeconds int64) ([]logstore.UsageSummaryRow, error)
+}
+
+// Reconciler periodically reconciles metered usage against provider usage APIs.
+type Reconciler struct {
+	store   MeteredUsageStore
+	sources []UsageSource
+	config  Config
+	logger  schemas.Logger
+
+	// now is overridable in tests.
+	now func() time.Time
+
+	runMu  sync.Mutex // serializes runs
+	mu     sync.RWMutex
+	report *Report
+	stopCh chan struct{}
+}
+
+// NewReconciler creates a reconciler for the configured sources. Sources that cannot be created
+// (unsupported provider, missing admin key) are logged and skipped.
+func NewReconciler(store MeteredUsageStore, config Config, logger schemas.Logger) *Reconciler {
+	sources := make([]UsageSource, 0, len(config.Sources))
+	for _, sourceConfig := range config.Sources {
+		source, err := NewUsageSource(sourceConfig, nil)
+		if err != nil {
+			logger.Warn("skipping usage reconciliation source: %v", err)
+			continue
+		}
+		sources = append(sources, source)
+	}
+	return newReconciler(store, sources, config, logger)
+}
+
+func newReconciler(store MeteredUsageStore, sources []UsageSource, config Config, logger schemas.Logger) *Reconciler {
+	return &Reconciler{
+		store:   store,
+		sources: sources,
+		config:  config,
+		logger:  logger,
+		now:     time.Now,
+	}
+}
+
+// Start runs a reconciliation immediately and then on every configured interval.
+func (r *Reconciler) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopCh != nil {
+		r.logger.Debug("usage reconciliation routine already running")
+		return
+	}
+	r.stopCh = make(chan struct{})
+	stopCh := r.stopCh
+
+	go func() {
+		ticker := time.NewTicker(r.config.interval())
+		defer ticker.Stop()
+		for {
+			ctx, cancel := context.WithTimeout(context.Background(), runTimeout)
+			if _, err := r.Run(ctx); err != nil {
+				r.logger.Warn("usage reconciliation failed: %v", err)
+			}
+			cancel()
+			select {
+			case <-ticker.C:
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic reconciliation routine.
+func (r *Reconciler) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopCh != nil {
+		close(r.stopCh)
+		r.stopCh = nil
+	}
+}
+
+// LatestReport returns the report of the most recent run, or nil if no run has completed yet.
+func (r *Reconciler) LatestReport() *Report {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.report
+}
+
+// Run reconciles the last LookbackDays complete UTC days and stores the resulting report.
+// A failing source is recorded in its provider summary rather than failing the whole run.
+func (r *Reconciler) Run(ctx context.Context) (*Report, error) {
+	r.runMu.Lock()
+	defer r.runMu.Unlock()
+
+	now := r.now().UTC()
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	start := end.AddDate(0, 0, -r.config.lookbackDays())
+	tolerance := r.config.tolerancePercent()
+
+	report := &Report{
+		GeneratedAt:      now,
+		WindowStart:      start,
+		WindowEnd:        end,
+		TolerancePercent: tolerance,
+		Providers:        []ProviderSummary{},
+		Discrepancies:    []Discrepancy{},
+	}
+
+	for _, source := range r.sources {
+		summary := ProviderSummary{Provider: source.Provider()}
+		discrepancies, err := r.reconcileSource(ctx, source, start, end, tolerance, &summary)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			summary.Error = err.Error()
+			r.logger.Warn("usage reconciliation for %s failed: %v", source.Provider(), err)
+		}
+		summary.Discrepancies = len(discrepancies)
+		report.Providers = append(report.Providers, summary)
+		report.Discrepancies = append(report.Discrepancies, discrepancies...)
+	}
+
+	if len(report.Discrepancies) > 0 {
+		r.logger.Warn("usage reconciliation found %d discrepancies between %s and %s",
+			len(report.Discrepancies), start.Format(time.DateOnly), end.Format(time.DateOnly))
+	}
+
+	r.mu.Lock()
+	r.report = report
+	r.mu.Unlock()
+	return report, nil
+}
+
+// usageKey identifies a model's usage on one UTC day.
+type usageKey struct {
+	date  string
+	model string
+}
+
+func (r *Reconciler) reconcileSource(ctx context.Context, source UsageSource, start, end time.Time, tolerance float64, summary *ProviderSummary) ([]Discrepancy, error) {
+	objects := make([]string, 0, len(source.RequestTypes()))
+	for _, requestType := range source.RequestTypes() {
+		objects = append(objects, string(requestType))
+	}
+	// The log store's end filter is inclusive, so stop just before the window end.
+	lastInstant := end.Add(-time.Nanosecond)
+	rows, err := r.store.GetUsageSummary(ctx, logstore.SearchFilters{
+		Providers: []string{string(source.Provider())},
+		Objects:   objects,
+		StartTime: &start,
+		EndTime:   &lastInstant,
+	}, int64((24 * time.Hour).Seconds()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read metered usage: %w", err)
+	}
+	metered := make(map[usageKey]*UsageRecord)
+	for _, row := range rows {
+		key := usageKey{date: row.Timestamp.UTC().Format(time.DateOnly), model: normalizeModel(row.Model)}
+		record := metered[key]
+		if record == nil {
+			record = &UsageRecord{Model: key.model}
+			metered[key] = record
+		}
+		record.Requests += row.Requests
+		record.InputTokens += row.PromptTokens
+		record.OutputTokens += row.CompletionTokens
+	}
+
+	records, err := source.FetchUsage(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	reported := make(map[usageKey]*UsageRecord)
+	for _, rec := range records {
+		key := usageKey{date: rec.Date.UTC().Format(time.DateOnly), model: normalizeModel(rec.Model)}
+		record := reported[key]
+		if record == nil {
+			record = &UsageRecord{Model: key.model}
+			reported[key] = record
+		}
+		record.Requests += rec.Requests
+		record.InputTokens += rec.InputTokens
+		record.OutputTokens += rec.OutputTokens
+	}
+
+	return compareUsage(source.Provider(), metered, reported, source.ReportsRequestCounts(), tolerance, summary), nil
+}
+
+// compareUsage flags every provider/model/day whose metered and reported usage differ by more than
+// tolerance percent, and accumulates the totals of both sides into summary.
+func compareUsage(provider schemas.ModelProvider, metered, reported map[usageKey]*UsageRecord, countsRequests bool, tolerance float64, summary *ProviderSummary) []Discrepancy {
+	keys := make([]usageKey, 0, len(metered)+len(reported))
+	for key := range metered {
+		keys = append(keys, key)
+	}
+	for key := range reported {
+		if _, ok := metered[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].date != keys[j].date {
+			return keys[i].date < keys[j].date
+		}
+		return keys[i].model < keys[j].model
+	})
+
+	if countsRequests {
+		summary.Metered.Requests = schemas.Ptr(int64(0))
+		summary.Reported.Requests = schemas.Ptr(int64(0))
+	}
+
+	discrepancies := []Discrepancy{}
+	for _, key := range keys {
+		m, r := metered[key], reported[key]
+		if m == nil {
+			m = &UsageRecord{}
+		}
+		if r == nil {
+			r = &UsageRecord{}
+		}
+		meteredTotals := toUsageTotals(m, countsRequests)
+		reportedTotals := toUsageTotals(r, countsRequests)
+		summary.Metered = addTotals(summary.Metered, meteredTotals)
+		summary.Reported = addTotals(summary.Reported, reportedTotals)
+
+		discrepancy := Discrepancy{
+			Provider: provider,
+			Model:    key.model,
+			Date:     key.date,
+			Metered:  meteredTotals,
+			Reported: reportedTotals,
+		}
+		meteredVolume, reportedVolume := m.InputTokens+m.OutputTokens, r.InputTokens+r.OutputTokens
+		if countsRequests {
+			meteredVolume, reportedVolume = m.Requests, r.Requests
+		}
+
+		switch {
+		case meteredVolume == 0 && reportedVolume == 0:
+			continue
+		case meteredVolume == 0:
+			discrepancy.Kind = DiscrepancyMissedRequests
+			discrepancy.Message = "provider reports usage that Bifrost did not meter"
+		case reportedVolume == 0:
+			discrepancy.Kind = DiscrepancyUnreportedUsage
+			discrepancy.Message = "Bifrost metered usage that the provider does not report"
+		case countsRequests && exceedsTolerance(m.Requests, r.Requests, tolerance):
+			if r.Requests > m.Requests {
+				discrepancy.Kind = DiscrepancyMissedRequests
+				discrepancy.Message = fmt.Sprintf("provider reports %d requests, Bifrost metered %d", r.Requests, m.Requests)
+			} else {
+				discrepancy.Kind = DiscrepancyUnreportedUsage
+				discrepancy.Message = fmt.Sprintf("Bifrost metered %d requests, provider reports %d", m.Requests, r.Requests)
+			}
+		case exceedsTolerance(m.InputTokens, r.InputTokens, tolerance) || exceedsTolerance(m.OutputTokens, r.OutputTokens, tolerance):
+			discrepancy.Kind = DiscrepancyUnitMismatch
+			discrepancy.Message = fmt.Sprintf("token counts differ: input %d metered vs %d reported, output %d metered vs %d reported",
+				m.InputTokens, r.InputTokens, m.OutputTokens, r.OutputTokens)
+		default:
+			continue
+		}
+		discrepancies = append(discrepancies, discrepancy)
+	}
+	return discrepancies
+}
+
+func toUsageTotals(record *UsageRecord, countsRequests bool) UsageTotals {
+	totals := UsageTotals{InputTokens: record.InputTokens, OutputTokens: record.OutputTokens}
+	if countsRequests {
+		totals.Requests = schemas.Ptr(record.Requests)
+	}
+	return totals
+}
+
+func addTotals(a, b UsageTotals) UsageTotals {
+	a.InputTokens += b.InputTokens
+	a.OutputTokens += b.OutputTokens
+	if a.Requests != nil && b.Requests != nil {
+		a.Requests = schemas.Ptr(*a.Requests + *b.Requests)
+	}
+	return a
+}
+
+// exceedsTolerance reports whether a and b differ by more than tolerance percent of the larger value.
+func exceedsTolerance(a, b int64, tolerance float64) bool {
+	if a == b {
+		return false
+	}
+	larger := math.Max(float64(a), float64(b))
+	return math.Abs(float64(a-b))/larger*100 > tolerance
+}
+
+// datedSnapshotSuffix matches the date suffix of pinned model snapshots, e.g. "-2024-08-06" or "-20250514".
+var datedSnapshotSuffix = regexp.MustCompile(`-(\d{4}-\d{2}-\d{2}|\d{8})$`)
+
+// normalizeModel strips dated snapshot suffixes, since provider usage APIs report the resolved
+// snapshot while Bifrost meters the model alias the client asked for.
+func normalizeModel(model string) string {
+	return datedSnapshotSuffix.ReplaceAllString(model, "")
+}
@@ -0,0 +1,146 @@
+package reconciliation
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/logstore"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+type fakeStore struct {
+	rows    []logstore.UsageSummaryRow
+	filters logstore.SearchFilters
+}
+
+func (s *fakeStore) GetUsageSummary(ctx context.Context, filters logstore.SearchFilters, bucketSizeSeconds int64) ([]logstore.UsageSummaryRow, error) {
+	s.filters = filters
+	return s.rows, nil
+}
+
+func TestReconcilerRunWithOpenAIUsage(t *testing.T) {
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/organization/usage/completions" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer admin-key" {
+			t.Errorf("missing admin key, got %q", r.Header.Get("Authorization"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page") == "" {
+			w.Write([]byte(`{"data":[{"start_time":1773014400,"results":[
+				{"model":"gpt-4o-mini-2024-07-18","input_tokens":1000,"output_tokens":500,"num_model_requests":10},
+				{"model":"gpt-4o-2024-08-06","input_tokens":2000,"output_tokens":100,"num_model_requests":12}
+			]}],"has_more":true,"next_page":"page_2"}`))
+			return
+		}
+		w.Write([]byte(`{"data":[{"start_time":1773014400,"results":[
+			{"model":"o3","input_tokens":300,"output_tokens":300,"num_model_requests":3},
+			{"model":"gpt-4.1","input_tokens":100,"output_tokens":100,"num_model_requests":1}
+		]}],"has_more":false}`))
+	}))
+	defer server.Close()
+
+	store := &fakeStore{rows: []logstore.UsageSummaryRow{
+		// Matches after the snapshot suffix is stripped.
+		{Timestamp: day, Provider: "openai", Model: "gpt-4o-mini", Requests: 10, PromptTokens: 1000, CompletionTokens: 500},
+		// Two requests never made it into the logs.
+		{Timestamp: day, Provider: "openai", Model: "gpt-4o", Requests: 10, PromptTokens: 1700, CompletionTokens: 90},
+		// Same request count, tokens counted differently.
+		{Timestamp: day, Provider: "openai", Model: "gpt-4.1", Requests: 1, PromptTokens: 60, CompletionTokens: 100},
+		// Metered but absent from the provider report.
+		{Timestamp: day, Provider: "openai", Model: "gpt-5", Requests: 4, PromptTokens: 40, CompletionTokens: 40},
+	}}
+
+	source, err := NewUsageSource(SourceConfig{Provider: schemas.OpenAI, AdminKey: *schemas.NewEnvVar("admin-key"), BaseURL: server.URL}, server.Client())
+	if err != nil {
+		t.Fatalf("NewUsageSource() error = %v", err)
+	}
+	r := newReconciler(store, []UsageSource{source}, Config{LookbackDays: 1}, &testLogger{})
+	r.now = func() time.Time { return day.Add(30 * time.Hour) }
+
+	report, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !report.WindowStart.Equal(day) || !report.WindowEnd.Equal(day.AddDate(0, 0, 1)) {
+		t.Errorf("window = %s - %s, want the complete day %s", report.WindowStart, report.WindowEnd, day)
+	}
+	if len(store.filters.Providers) != 1 || store.filters.Providers[0] != "openai" || len(store.filters.Objects) == 0 {
+		t.Errorf("metered usage filters = %+v", store.filters)
+	}
+
+	want := map[string]DiscrepancyKind{
+		"gpt-4o":  DiscrepancyMissedRequests,
+		"gpt-4.1": DiscrepancyUnitMismatch,
+		"gpt-5":   DiscrepancyUnreportedUsage,
+		"o3":      DiscrepancyMissedRequests,
+	}
+	if len(report.Discrepancies) != len(want) {
+		t.Fatalf("discrepancies = %+v, want %d", report.Discrepancies, len(want))
+	}
+	for _, d := range report.Discrepancies {
+		if want[d.Model] != d.Kind || d.Date != "2026-03-09" {
+			t.Errorf("discrepancy for %s = %s on %s, want %s", d.Model, d.Kind, d.Date, want[d.Model])
+		}
+	}
+
+	if len(report.Providers) != 1 || report.Providers[0].Error != "" || report.Providers[0].Discrepancies != 4 {
+		t.Fatalf("providers = %+v", report.Providers)
+	}
+	if got := *report.Providers[0].Reported.Requests; got != 26 {
+		t.Errorf("reported requests = %d, want 26", got)
+	}
+	if r.LatestReport() != report {
+		t.Error("LatestReport() should return the report of the last run")
+	}
+}
+
+func TestCompareUsageWithoutRequestCounts(t *testing.T) {
+	key := usageKey{date: "2026-03-09", model: "claude-sonnet-4-5"}
+	metered := map[usageKey]*UsageRecord{key: {Requests: 3, InputTokens: 1000, OutputTokens: 200}}
+
+	summary := ProviderSummary{}
+	matching := map[usageKey]*UsageRecord{key: {InputTokens: 1005, OutputTokens: 200}}
+	if got := compareUsage(schemas.Anthropic, metered, matching, false, 1, &summary); len(got) != 0 {
+		t.Errorf("differences within tolerance should not be flagged: %+v", got)
+	}
+	if summary.Metered.Requests != nil {
+		t.Error("request totals should be omitted when the provider does not report them")
+	}
+
+	differing := map[usageKey]*UsageRecord{key: {InputTokens: 1500, OutputTokens: 200}}
+	got := compareUsage(schemas.Anthropic, metered, differing, false, 1, &ProviderSummary{})
+	if len(got) != 1 || got[0].Kind != DiscrepancyUnitMismatch {
+		t.Errorf("compareUsage() = %+v, want a unit mismatch", got)
+	}
+}
+
+func TestNewUsageSource(t *testing.T) {
+	if _, err := NewUsageSource(SourceConfig{Provider: schemas.OpenAI}, nil); err == nil {
+		t.Error("expected an error without an admin key")
+	}
+	if _, err := NewUsageSource(SourceConfig{Provider: schemas.Mistral, AdminKey: *schemas.NewEnvVar("key")}, nil); err == nil {
+		t.Error("expected an error for a provider without a usage API")
+	}
+	if source, err := NewUsageSource(SourceConfig{Provider: schemas.Anthropic, AdminKey: *schemas.NewEnvVar("key")}, nil); err != nil || source.ReportsRequestCounts() {
+		t.Errorf("anthropic source = %v, %v; want a source without request counts", source, err)
+	}
+}
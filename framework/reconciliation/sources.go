@@ -0,0 +1,228 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// UsageRecord is the usage a provider reports for one model on one UTC day.
+type UsageRecord struct {
+	Date         time.Time
+	Model        string
+	Requests     int64
+	InputTokens  int64
+	OutputTokens int64
+}
+
+// UsageSource pulls usage from a provider's organization usage API.
+type UsageSource interface {
+	Provider() schemas.ModelProvider
+	// RequestTypes returns the request types the provider's usage report covers, so that
+	// metered usage of other endpoints (embeddings, images, ...) is left out of the comparison.
+	RequestTypes() []schemas.RequestType
+	// ReportsRequestCounts reports whether FetchUsage fills in UsageRecord.Requests.
+	ReportsRequestCounts() bool
+	// FetchUsage returns daily usage per model for [start, end).
+	FetchUsage(ctx context.Context, start, end time.Time) ([]UsageRecord, error)
+}
+
+// textRequestTypes are the request types billed as text generation by providers.
+var textRequestTypes = []schemas.RequestType{
+	schemas.TextCompletionRequest,
+	schemas.TextCompletionStreamRequest,
+	schemas.ChatCompletionRequest,
+	schemas.ChatCompletionStreamRequest,
+	schemas.ResponsesRequest,
+	schemas.ResponsesStreamRequest,
+}
+
+// maxUsagePages bounds pagination so a misbehaving API cannot keep a run going forever.
+const maxUsagePages = 100
+
+// NewUsageSource creates the usage source for a configured provider.
+func NewUsageSource(config SourceConfig, client *http.Client) (UsageSource, error) {
+	if config.AdminKey.GetValue() == "" {
+		return nil, fmt.Errorf("admin_key is required for %s usage reconciliation", config.Provider)
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 60 * time.Second}
+	}
+	switch config.Provider {
+	case schemas.OpenAI:
+		return &openAIUsageSource{
+			baseURL:  baseURLOrDefault(config.BaseURL, "https://api.openai.com"),
+			adminKey: config.AdminKey.GetValue(),
+			client:   client,
+		}, nil
+	case schemas.Anthropic:
+		return &anthropicUsageSource{
+			baseURL:  baseURLOrDefault(config.BaseURL, "https://api.anthropic.com"),
+			adminKey: config.AdminKey.GetValue(),
+			client:   client,
+		}, nil
+	default:
+		return nil, fmt.Errorf("usage reconciliation is not supported for provider %s", config.Provider)
+	}
+}
+
+func baseURLOrDefault(baseURL, defaultURL string) string {
+	if baseURL == "" {
+		return defaultURL
+	}
+	return strings.TrimRight(baseURL, "/")
+}
+
+// getJSON performs an authenticated GET request and decodes the JSON response into out.
+func getJSON(ctx context.Context, client *http.Client, endpoint string, headers map[string]string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch usage: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("usage API returned status %d", resp.StatusCode)
+	}
+	if err := sonic.ConfigDefault.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode usage response: %w", err)
+	}
+	return nil
+}
+
+// openAIUsageSource reads the OpenAI organization completions usage API.
+type openAIUsageSource struct {
+	baseURL  string
+	adminKey string
+	client   *http.Client
+}
+
+type openAIUsagePage struct {
+	Data []struct {
+		StartTime int64 `json:"start_time"`
+		Results   []struct {
+			Model            string `json:"model"`
+			InputTokens      int64  `json:"input_tokens"`
+			OutputTokens     int64  `json:"output_tokens"`
+			NumModelRequests int64  `json:"num_model_requests"`
+		} `json:"results"`
+	} `json:"data"`
+	HasMore  bool   `json:"has_more"`
+	NextPage string `json:"next_page"`
+}
+
+func (s *openAIUsageSource) Provider() schemas.ModelProvider     { return schemas.OpenAI }
+func (s *openAIUsageSource) RequestTypes() []schemas.RequestType { return textRequestTypes }
+func (s *openAIUsageSource) ReportsRequestCounts() bool          { return true }
+
+func (s *openAIUsageSource) FetchUsage(ctx context.Context, start, end time.Time) ([]UsageRecord, error) {
+	var records []UsageRecord
+	page := ""
+	for range maxUsagePages {
+		endpoint := fmt.Sprintf("%s/v1/organization/usage/completions?start_time=%d&end_time=%d&bucket_width=1d&group_by=model&limit=31",
+			s.baseURL, start.Unix(), end.Unix())
+		if page != "" {
+			endpoint += "&page=" + url.QueryEscape(page)
+		}
+		var resp openAIUsagePage
+		if err := getJSON(ctx, s.client, endpoint, map[string]string{"Authorization": "Bearer " + s.adminKey}, &resp); err != nil {
+			return nil, err
+		}
+		for _, bucket := range resp.Data {
+			for _, result := range bucket.Results {
+				records = append(records, UsageRecord{
+					Date:         time.Unix(bucket.StartTime, 0).UTC(),
+					Model:        result.Model,
+					Requests:     result.NumModelRequests,
+					InputTokens:  result.InputTokens,
+					OutputTokens: result.OutputTokens,
+				})
+			}
+		}
+		if !resp.HasMore || resp.NextPage == "" {
+			return records, nil
+		}
+		page = resp.NextPage
+	}
+	return nil, fmt.Errorf("usage API returned more than %d pages", maxUsagePages)
+}
+
+// anthropicUsageSource reads the Anthropic organization messages usage report.
+// The report does not include request counts, so only token usage is compared.
+type anthropicUsageSource struct {
+	baseURL  string
+	adminKey string
+	client   *http.Client
+}
+
+type anthropicUsagePage struct {
+	Data []struct {
+		StartingAt time.Time `json:"starting_at"`
+		Results    []struct {
+			Model                string `json:"model"`
+			UncachedInputTokens  int64  `json:"uncached_input_tokens"`
+			CacheReadInputTokens int64  `json:"cache_read_input_tokens"`
+			CacheCreation        struct {
+				Ephemeral1hInputTokens int64 `json:"ephemeral_1h_input_tokens"`
+				Ephemeral5mInputTokens int64 `json:"ephemeral_5m_input_tokens"`
+			} `json:"cache_creation"`
+			OutputTokens int64 `json:"output_tokens"`
+		} `json:"results"`
+	} `json:"data"`
+	HasMore  bool   `json:"has_more"`
+	NextPage string `json:"next_page"`
+}
+
+func (s *anthropicUsageSource) Provider() schemas.ModelProvider     { return schemas.Anthropic }
+func (s *anthropicUsageSource) RequestTypes() []schemas.RequestType { return textRequestTypes }
+func (s *anthropicUsageSource) ReportsRequestCounts() bool          { return false }
+
+func (s *anthropicUsageSource) FetchUsage(ctx context.Context, start, end time.Time) ([]UsageRecord, error) {
+	headers := map[string]string{
+		"x-api-key":         s.adminKey,
+		"anthropic-version": "2023-06-01",
+	}
+	var records []UsageRecord
+	page := ""
+	for range maxUsagePages {
+		endpoint := fmt.Sprintf("%s/v1/organizations/usage_report/messages?starting_at=%s&ending_at=%s&bucket_width=1d&group_by[]=model&limit=31",
+			s.baseURL, start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339))
+		if page != "" {
+			endpoint += "&page=" + url.QueryEscape(page)
+		}
+		var resp anthropicUsagePage
+		if err := getJSON(ctx, s.client, endpoint, headers, &resp); err != nil {
+			return nil, err
+		}
+		for _, bucket := range resp.Data {
+			for _, result := range bucket.Results {
+				// Bifrost meters prompt tokens including cached ones, so all input buckets are summed.
+				input := result.UncachedInputTokens + result.CacheReadInputTokens +
+					result.CacheCreation.Ephemeral1hInputTokens + result.CacheCreation.Ephemeral5mInputTokens
+				records = append(records, UsageRecord{
+					Date:         bucket.StartingAt.UTC(),
+					Model:        result.Model,
+					InputTokens:  input,
+					OutputTokens: result.OutputTokens,
+				})
+			}
+		}
+		if !resp.HasMore || resp.NextPage == "" {
+			return records, nil
+		}
+		page = resp.NextPage
+	}
+	return nil, fmt.Errorf("usage API returned more than %d pages", maxUsagePages)
+}
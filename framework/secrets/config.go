@@ -0,0 +1,54 @@
+package secrets
+
+import (
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	DefaultRotationInterval = 5 * time.Minute
+	MinRotationInterval     = 30 * time.Second
+	resolveTimeout          = 30 * time.Second
+)
+
+// Config holds the configuration of the secret managers key values can reference, and how often
+// the references are re-resolved.
+type Config struct {
+	RotationIntervalSeconds int                      `json:"rotation_interval_seconds,omitempty"` // How often references are re-resolved. Default is 5 minutes, minimum is 30 seconds.
+	Vault                   *VaultConfig             `json:"vault,omitempty"`
+	AWSSecretsManager       *AWSSecretsManagerConfig `json:"aws_secrets_manager,omitempty"`
+	GCPSecretManager        *GCPSecretManagerConfig  `json:"gcp_secret_manager,omitempty"`
+}
+
+// VaultConfig configures access to a HashiCorp Vault server.
+type VaultConfig struct {
+	Address   schemas.EnvVar `json:"address"`             // Vault server address, e.g. https://vault.internal:8200
+	Token     schemas.EnvVar `json:"token"`               // Vault token with read access to the referenced secrets
+	Namespace string         `json:"namespace,omitempty"` // Vault Enterprise namespace
+}
+
+// AWSSecretsManagerConfig configures access to AWS Secrets Manager. Without an access key, the
+// default AWS credential chain (environment, shared config, instance role) is used.
+type AWSSecretsManagerConfig struct {
+	Region       schemas.EnvVar  `json:"region"`
+	AccessKey    *schemas.EnvVar `json:"access_key,omitempty"`
+	SecretKey    *schemas.EnvVar `json:"secret_key,omitempty"`
+	SessionToken *schemas.EnvVar `json:"session_token,omitempty"`
+	Endpoint     string          `json:"endpoint,omitempty"` // Overrides the regional endpoint, e.g. for VPC endpoints
+}
+
+// GCPSecretManagerConfig configures access to GCP Secret Manager. Without auth credentials, the
+// application default credentials are used.
+type GCPSecretManagerConfig struct {
+	AuthCredentials *schemas.EnvVar `json:"auth_credentials,omitempty"` // Service account JSON
+	Endpoint        string          `json:"endpoint,omitempty"`         // Overrides https://secretmanager.googleapis.com
+}
+
+// rotationInterval returns the configured rotation interval, falling back to the default and clamping to the minimum.
+func (c *Config) rotationInterval() time.Duration {
+	if c.RotationIntervalSeconds <= 0 {
+		return DefaultRotationInterval
+	}
+	return max(time.Duration(c.RotationIntervalSeconds)*time.Second, MinRotationInterval)
+}
@@ -0,0 +1,49 @@
+package secrets
+
+import (
+	"reflect"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+var envVarType = reflect.TypeFor[schemas.EnvVar]()
+
+// References returns the secret manager references held by the EnvVar fields of values, which may
+// be (pointers to) structs, slices and maps nesting EnvVars at any depth.
+func References(values ...any) []string {
+	var references []string
+	for _, value := range values {
+		collectReferences(reflect.ValueOf(value), &references)
+	}
+	return references
+}
+
+func collectReferences(v reflect.Value, references *[]string) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			collectReferences(v.Elem(), references)
+		}
+	case reflect.Struct:
+		if v.Type() == envVarType {
+			if reference := v.FieldByName("EnvVar").String(); schemas.IsExternalSecretReference(reference) {
+				*references = append(*references, reference)
+			}
+			return
+		}
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				collectReferences(v.Field(i), references)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			collectReferences(v.Index(i), references)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			collectReferences(iter.Value(), references)
+		}
+	}
+}
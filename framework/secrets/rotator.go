@@ -0,0 +1,150 @@
+// Package secrets resolves the values that reference a secret manager (HashiCorp Vault, AWS Secrets
+// Manager or GCP Secret Manager) and re-resolves them on a schedule, so rotated secrets are picked up
+// without a restart or a configuration reload. Resolved values are published with
+// schemas.SetResolvedSecret, which every EnvVar holding the reference reads on access.
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// ReferenceSource returns the secret manager references currently in use.
+type ReferenceSource func() []string
+
+// Rotator periodically re-resolves secret manager references.
+type Rotator struct {
+	sources    map[string]Source
+	references ReferenceSource
+	config     Config
+	logger     schemas.Logger
+
+	runMu  sync.Mutex // serializes runs
+	mu     sync.Mutex
+	stopCh chan struct{}
+}
+
+// NewRotator creates a rotator resolving the references returned by references with sources,
+// which are keyed by reference prefix (see NewSources).
+func NewRotator(sources map[string]Source, references ReferenceSource, config Config, logger schemas.Logger) *Rotator {
+	return &Rotator{
+		sources:    sources,
+		references: references,
+		config:     config,
+		logger:     logger,
+	}
+}
+
+// Start re-resolves the references on every configured interval. References are expected to have
+// been resolved once with Run before the values are first used.
+func (r *Rotator) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopCh != nil {
+		r.logger.Debug("secret rotation routine already running")
+		return
+	}
+	r.stopCh = make(chan struct{})
+	stopCh := r.stopCh
+
+	go func() {
+		ticker := time.NewTicker(r.config.rotationInterval())
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if _, err := r.Run(context.Background()); err != nil {
+					r.logger.Warn("secret rotation failed: %v", err)
+				}
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the periodic secret rotation routine.
+func (r *Rotator) Stop() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stopCh != nil {
+		close(r.stopCh)
+		r.stopCh = nil
+	}
+}
+
+// Run resolves every reference in use and returns how many changed value. A reference that cannot
+// be resolved keeps its last value; the failures are returned joined.
+func (r *Rotator) Run(ctx context.Context) (int, error) {
+	return r.run(ctx, false)
+}
+
+// ResolvePending resolves the references in use that were never resolved, such as the ones of a key
+// that was just added, without waiting for the next rotation.
+func (r *Rotator) ResolvePending(ctx context.Context) (int, error) {
+	return r.run(ctx, true)
+}
+
+func (r *Rotator) run(ctx context.Context, pendingOnly bool) (int, error) {
+	r.runMu.Lock()
+	defer r.runMu.Unlock()
+
+	references := r.references()
+	slices.Sort(references)
+	references = slices.Compact(references)
+
+	rotated := 0
+	var errs []error
+	for _, reference := range references {
+		if _, resolved := schemas.ResolvedSecret(reference); pendingOnly && resolved {
+			continue
+		}
+		changed, err := r.resolve(ctx, reference)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", reference, err))
+			continue
+		}
+		if changed {
+			rotated++
+		}
+	}
+	if rotated > 0 {
+		r.logger.Info("secret rotation updated %d of %d secret references", rotated, len(references))
+	}
+	return rotated, errors.Join(errs...)
+}
+
+// resolve resolves a single reference and publishes its value, reporting whether the value changed.
+func (r *Rotator) resolve(ctx context.Context, reference string) (bool, error) {
+	sourceName, secretReference, ok := schemas.ParseSecretReference(reference)
+	if !ok || sourceName == schemas.SecretSourceEnv {
+		return false, nil
+	}
+	source, ok := r.sources[sourceName]
+	if !ok {
+		return false, fmt.Errorf("no %s secret manager is configured", sourceName)
+	}
+	resolveCtx, cancel := context.WithTimeout(ctx, resolveTimeout)
+	defer cancel()
+	value, err := source.Resolve(resolveCtx, secretReference)
+	if err != nil {
+		return false, err
+	}
+	previous, resolved := schemas.ResolvedSecret(reference)
+	if resolved && previous == value {
+		return false, nil
+	}
+	schemas.SetResolvedSecret(reference, value)
+	if resolved {
+		r.logger.Info("secret %s was rotated", reference)
+	}
+	return true, nil
+}
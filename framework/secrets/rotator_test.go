@@ -0,0 +1,150 @@
+package secrets
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+func TestVaultSourceResolve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "vault-token" {
+			t.Errorf("missing vault token, got %q", r.Header.Get("X-Vault-Token"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/secret/data/openai":
+			w.Write([]byte(`{"data":{"data":{"api_key":"sk-kv2","org":"org-1"},"metadata":{"version":3}}}`))
+		case "/v1/kv/anthropic":
+			w.Write([]byte(`{"data":{"api_key":"sk-kv1"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	source, err := newVaultSource(VaultConfig{Address: *schemas.NewEnvVar(server.URL), Token: *schemas.NewEnvVar("vault-token")}, server.Client())
+	if err != nil {
+		t.Fatalf("newVaultSource() error = %v", err)
+	}
+	tests := []struct {
+		reference string
+		want      string
+		wantErr   bool
+	}{
+		{"secret/data/openai#api_key", "sk-kv2", false},
+		{"kv/anthropic", "sk-kv1", false},
+		{"secret/data/openai", "", true}, // two fields, none referenced
+		{"secret/data/openai#missing", "", true},
+		{"secret/data/unknown#api_key", "", true},
+	}
+	for _, tt := range tests {
+		got, err := source.Resolve(context.Background(), tt.reference)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("Resolve(%q) = %q, %v", tt.reference, got, err)
+		}
+	}
+}
+
+func TestAWSSecretsManagerSourceResolve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Amz-Target") != "secretsmanager.GetSecretValue" {
+			t.Errorf("unexpected target %q", r.Header.Get("X-Amz-Target"))
+		}
+		if !strings.Contains(r.Header.Get("Authorization"), "Credential=AKIATEST/") {
+			t.Errorf("request is not signed with the configured credentials: %q", r.Header.Get("Authorization"))
+		}
+		w.Write([]byte(`{"Name":"prod/openai","SecretString":"{\"api_key\":\"sk-aws\"}"}`))
+	}))
+	defer server.Close()
+
+	source, err := newAWSSecretsManagerSource(context.Background(), AWSSecretsManagerConfig{
+		Region:    *schemas.NewEnvVar("us-east-1"),
+		AccessKey: schemas.NewEnvVar("AKIATEST"),
+		SecretKey: schemas.NewEnvVar("secret"),
+		Endpoint:  server.URL,
+	}, server.Client())
+	if err != nil {
+		t.Fatalf("newAWSSecretsManagerSource() error = %v", err)
+	}
+	if got, err := source.Resolve(context.Background(), "prod/openai#api_key"); err != nil || got != "sk-aws" {
+		t.Errorf("Resolve() = %q, %v", got, err)
+	}
+	if got, err := source.Resolve(context.Background(), "prod/openai"); err != nil || got != `{"api_key":"sk-aws"}` {
+		t.Errorf("Resolve() without field = %q, %v", got, err)
+	}
+}
+
+// fakeSource returns the current value of its secrets.
+type fakeSource struct {
+	values map[string]string
+}
+
+func (s *fakeSource) Resolve(ctx context.Context, reference string) (string, error) {
+	value, ok := s.values[reference]
+	if !ok {
+		return "", context.DeadlineExceeded
+	}
+	return value, nil
+}
+
+func TestRotatorRun(t *testing.T) {
+	key := schemas.Key{
+		ID:    "rotator-test",
+		Value: *schemas.NewEnvVar("vault://secret/data/rotator-test#api_key"),
+		AzureKeyConfig: &schemas.AzureKeyConfig{
+			Endpoint:     *schemas.NewEnvVar("https://example.openai.azure.com"),
+			ClientSecret: schemas.NewEnvVar("vault://secret/data/rotator-test#client_secret"),
+		},
+	}
+	keys := map[schemas.ModelProvider][]schemas.Key{schemas.OpenAI: {key, key}}
+	references := References(keys, schemas.NewEnvVar("env.HOME"), schemas.NewEnvVar("gcp-sm://projects/p/secrets/unconfigured"))
+	if len(references) != 5 {
+		t.Fatalf("References() = %v", references)
+	}
+	for _, reference := range references {
+		defer schemas.ForgetResolvedSecret(reference)
+	}
+
+	source := &fakeSource{values: map[string]string{"secret/data/rotator-test#api_key": "sk-first", "secret/data/rotator-test#client_secret": "client-secret"}}
+	rotator := NewRotator(map[string]Source{schemas.SecretSourceVault: source}, func() []string { return references }, Config{}, &testLogger{})
+
+	rotated, err := rotator.Run(context.Background())
+	if rotated != 2 || err == nil || !strings.Contains(err.Error(), "no gcp-sm secret manager is configured") {
+		t.Fatalf("Run() = %d, %v", rotated, err)
+	}
+	if key.Value.GetValue() != "sk-first" || key.AzureKeyConfig.ClientSecret.GetValue() != "client-secret" {
+		t.Errorf("resolved values = %q, %q", key.Value.GetValue(), key.AzureKeyConfig.ClientSecret.GetValue())
+	}
+
+	// Resolving pending references leaves the resolved ones alone
+	source.values["secret/data/rotator-test#api_key"] = "sk-rotated"
+	if rotated, _ := rotator.ResolvePending(context.Background()); rotated != 0 || key.Value.GetValue() != "sk-first" {
+		t.Errorf("ResolvePending() = %d, value = %q", rotated, key.Value.GetValue())
+	}
+
+	// Only the rotated secret changes value; a failing resolution keeps the last value
+	delete(source.values, "secret/data/rotator-test#client_secret")
+	rotated, _ = rotator.Run(context.Background())
+	if rotated != 1 || key.Value.GetValue() != "sk-rotated" || key.AzureKeyConfig.ClientSecret.GetValue() != "client-secret" {
+		t.Errorf("after rotation: rotated = %d, values = %q, %q", rotated, key.Value.GetValue(), key.AzureKeyConfig.ClientSecret.GetValue())
+	}
+}
@@ -0,0 +1,293 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+)
+
+const cloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+
+// Source reads secrets from a secret manager.
+type Source interface {
+	// Resolve returns the current value of a reference, without its source prefix
+	// (e.g. "secret/data/openai#api_key" for "vault://secret/data/openai#api_key").
+	Resolve(ctx context.Context, reference string) (string, error)
+}
+
+// NewSources creates the sources of the configured secret managers, keyed by their reference prefix.
+func NewSources(ctx context.Context, config Config, client *http.Client) (map[string]Source, error) {
+	if client == nil {
+		client = &http.Client{Timeout: resolveTimeout}
+	}
+	sources := make(map[string]Source)
+	if config.Vault != nil {
+		source, err := newVaultSource(*config.Vault, client)
+		if err != nil {
+			return nil, err
+		}
+		sources[schemas.SecretSourceVault] = source
+	}
+	if config.AWSSecretsManager != nil {
+		source, err := newAWSSecretsManagerSource(ctx, *config.AWSSecretsManager, client)
+		if err != nil {
+			return nil, err
+		}
+		sources[schemas.SecretSourceAWSSecretsManager] = source
+	}
+	if config.GCPSecretManager != nil {
+		source, err := newGCPSecretManagerSource(ctx, *config.GCPSecretManager, client)
+		if err != nil {
+			return nil, err
+		}
+		sources[schemas.SecretSourceGCPSecretManager] = source
+	}
+	return sources, nil
+}
+
+// splitField splits a reference into the secret and the optional "#field" of a JSON secret.
+func splitField(reference string) (secret string, field string) {
+	secret, field, _ = strings.Cut(reference, "#")
+	return secret, field
+}
+
+// extractField returns the field of a JSON object secret, or the secret itself when no field is referenced.
+func extractField(secret string, field string) (string, error) {
+	if field == "" {
+		return secret, nil
+	}
+	var values map[string]any
+	if err := sonic.UnmarshalString(secret, &values); err != nil {
+		return "", fmt.Errorf("secret is not a JSON object, cannot read field %q", field)
+	}
+	return stringField(values, field)
+}
+
+// stringField returns a string field of a secret's key/value data.
+func stringField(values map[string]any, field string) (string, error) {
+	value, ok := values[field]
+	if !ok {
+		return "", fmt.Errorf("secret has no field %q", field)
+	}
+	str, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("field %q of the secret is not a string", field)
+	}
+	return str, nil
+}
+
+// doJSON sends a request and decodes the JSON response into out.
+func doJSON(client *http.Client, req *http.Request, out any) error {
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch secret: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("secret manager returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := sonic.ConfigDefault.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode secret response: %w", err)
+	}
+	return nil
+}
+
+// vaultSource reads secrets from the HashiCorp Vault HTTP API. References are "<path>#<field>",
+// where path is the full API path of the secret (e.g. secret/data/openai for a KV v2 mount).
+type vaultSource struct {
+	address   string
+	token     string
+	namespace string
+	client    *http.Client
+}
+
+func newVaultSource(config VaultConfig, client *http.Client) (*vaultSource, error) {
+	address := config.Address.GetValue()
+	if address == "" {
+		address = os.Getenv("VAULT_ADDR")
+	}
+	token := config.Token.GetValue()
+	if token == "" {
+		token = os.Getenv("VAULT_TOKEN")
+	}
+	if address == "" || token == "" {
+		return nil, fmt.Errorf("vault address and token are required")
+	}
+	return &vaultSource{address: strings.TrimRight(address, "/"), token: token, namespace: config.Namespace, client: client}, nil
+}
+
+func (s *vaultSource) Resolve(ctx context.Context, reference string) (string, error) {
+	path, field := splitField(reference)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.address+"/v1/"+strings.TrimLeft(path, "/"), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("X-Vault-Token", s.token)
+	if s.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", s.namespace)
+	}
+	var resp struct {
+		Data map[string]any `json:"data"`
+	}
+	if err := doJSON(s.client, req, &resp); err != nil {
+		return "", err
+	}
+	data := resp.Data
+	// KV v2 nests the secret under data.data, next to its metadata
+	if nested, ok := data["data"].(map[string]any); ok {
+		if _, versioned := data["metadata"]; versioned {
+			data = nested
+		}
+	}
+	if field == "" {
+		if len(data) != 1 {
+			return "", fmt.Errorf("secret has %d fields, reference one with #field", len(data))
+		}
+		for name := range data {
+			field = name
+		}
+	}
+	return stringField(data, field)
+}
+
+// awsSecretsManagerSource reads secrets with the AWS Secrets Manager GetSecretValue API. References
+// are "<secret id or ARN>[#<field>]".
+type awsSecretsManagerSource struct {
+	endpoint string
+	region   string
+	config   aws.Config
+	client   *http.Client
+}
+
+func newAWSSecretsManagerSource(ctx context.Context, config AWSSecretsManagerConfig, client *http.Client) (*awsSecretsManagerSource, error) {
+	region := config.Region.GetValue()
+	if region == "" {
+		return nil, fmt.Errorf("aws secrets manager region is required")
+	}
+	options := []func(*awsconfig.LoadOptions) error{awsconfig.WithRegion(region)}
+	if config.AccessKey != nil && config.AccessKey.GetValue() != "" {
+		accessKey, secretKey, sessionToken := config.AccessKey, config.SecretKey, config.SessionToken
+		options = append(options, awsconfig.WithCredentialsProvider(aws.CredentialsProviderFunc(func(ctx context.Context) (aws.Credentials, error) {
+			return aws.Credentials{
+				AccessKeyID:     accessKey.GetValue(),
+				SecretAccessKey: secretKey.GetValue(),
+				SessionToken:    sessionToken.GetValue(),
+			}, nil
+		})))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aws config: %w", err)
+	}
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com", region)
+	}
+	return &awsSecretsManagerSource{endpoint: strings.TrimRight(endpoint, "/") + "/", region: region, config: cfg, client: client}, nil
+}
+
+func (s *awsSecretsManagerSource) Resolve(ctx context.Context, reference string) (string, error) {
+	secretID, field := splitField(reference)
+	body, err := sonic.Marshal(map[string]string{"SecretId": secretID})
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	creds, err := s.config.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve aws credentials: %w", err)
+	}
+	hash := sha256.Sum256(body)
+	if err := v4.NewSigner().SignHTTP(ctx, creds, req, hex.EncodeToString(hash[:]), "secretsmanager", s.region, time.Now()); err != nil {
+		return "", fmt.Errorf("failed to sign request: %w", err)
+	}
+	var resp struct {
+		SecretString *string `json:"SecretString"`
+	}
+	if err := doJSON(s.client, req, &resp); err != nil {
+		return "", err
+	}
+	if resp.SecretString == nil {
+		return "", fmt.Errorf("secret %s has no string value", secretID)
+	}
+	return extractField(*resp.SecretString, field)
+}
+
+// gcpSecretManagerSource reads secrets with the GCP Secret Manager access API. References are
+// "projects/<project>/secrets/<secret>[/versions/<version>][#<field>]", the latest version by default.
+type gcpSecretManagerSource struct {
+	endpoint string
+	client   *http.Client
+}
+
+func newGCPSecretManagerSource(ctx context.Context, config GCPSecretManagerConfig, client *http.Client) (*gcpSecretManagerSource, error) {
+	var creds *google.Credentials
+	var err error
+	if config.AuthCredentials != nil && config.AuthCredentials.GetValue() != "" {
+		creds, err = google.CredentialsFromJSON(ctx, []byte(config.AuthCredentials.GetValue()), cloudPlatformScope)
+	} else {
+		creds, err = google.FindDefaultCredentials(ctx, cloudPlatformScope)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gcp credentials: %w", err)
+	}
+	endpoint := config.Endpoint
+	if endpoint == "" {
+		endpoint = "https://secretmanager.googleapis.com"
+	}
+	// The oauth2 client adds the access token to every request, refreshing it when it expires
+	authorized := &http.Client{
+		Timeout:   client.Timeout,
+		Transport: &oauth2.Transport{Source: creds.TokenSource, Base: client.Transport},
+	}
+	return &gcpSecretManagerSource{endpoint: strings.TrimRight(endpoint, "/"), client: authorized}, nil
+}
+
+func (s *gcpSecretManagerSource) Resolve(ctx context.Context, reference string) (string, error) {
+	name, field := splitField(reference)
+	name = strings.Trim(name, "/")
+	if !strings.Contains(name, "/versions/") {
+		name += "/versions/latest"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.endpoint+"/v1/"+(&url.URL{Path: name}).EscapedPath()+":access", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %w", err)
+	}
+	var resp struct {
+		Payload struct {
+			Data string `json:"data"`
+		} `json:"payload"`
+	}
+	if err := doJSON(s.client, req, &resp); err != nil {
+		return "", err
+	}
+	data, err := base64.StdEncoding.DecodeString(resp.Payload.Data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode secret payload: %w", err)
+	}
+	return extractField(string(data), field)
+}
@@ -378,121 +378,6 @@ func (a *Accumulator) cleanupStreamAccumulator(requestID string) {
 	}
 }
 
-// accumulateToolCallsInMessage efficiently accumulates tool calls in a message
-func (a *Accumulator) accumulateToolCallsInMessage(message *schemas.ChatMessage, deltaToolCalls []schemas.ChatAssistantMessageToolCall) {
-	if message == nil {
-		return
-	}
-	if message.ChatAssistantMessage == nil {
-		message.ChatAssistantMessage = &schemas.ChatAssistantMessage{}
-	}
-	existingToolCalls := message.ChatAssistantMessage.ToolCalls
-	if len(existingToolCalls) == 0 {
-		existingToolCalls = []schemas.ChatAssistantMessageToolCall{}
-	}
-
-	// Build lookup maps for existing tool calls to support interleaved/parallel deltas
-	idToIndex := make(map[string]int, len(existingToolCalls))
-	indexToIndex := make(map[uint16]int, len(existingToolCalls))
-	for i, toolCall := range existingToolCalls {
-		if toolCall.ID != nil && *toolCall.ID != "" {
-			idToIndex[*toolCall.ID] = i
-		}
-		indexToIndex[toolCall.Index] = i
-	}
-
-	for _, deltaToolCall := range deltaToolCalls {
-		index := -1
-		if deltaToolCall.ID != nil && *deltaToolCall.ID != "" {
-			if existingIndex, ok := idToIndex[*deltaToolCall.ID]; ok {
-				index = existingIndex
-			}
-		}
-		if index == -1 {
-			if existingIndex, ok := indexToIndex[deltaToolCall.Index]; ok {
-				index = existingIndex
-			}
-		}
-
-		if index == -1 {
-			// Cannot place a delta without a tool name or known identifier
-			if deltaToolCall.Function.Name == nil {
-				a.logger.Warn("received tool call delta without name, but no existing tool calls to append to")
-				continue
-			}
-			// Creating a new tool call
-			// Only set arguments if they're not empty or just empty braces
-			args := deltaToolCall.Function.Arguments
-			if args == "{}" {
-				args = "" // Reset empty braces to empty string to avoid duplication
-			}
-			newToolCall := schemas.ChatAssistantMessageToolCall{
-				Index: deltaToolCall.Index,
-				Type:  deltaToolCall.Type,
-				ID:    deltaToolCall.ID,
-				Function: schemas.ChatAssistantMessageToolCallFunction{
-					Name:      deltaToolCall.Function.Name,
-					Arguments: args,
-				},
-			}
-			existingToolCalls = append(existingToolCalls, newToolCall)
-			index = len(existingToolCalls) - 1
-			indexToIndex[newToolCall.Index] = index
-			if newToolCall.ID != nil && *newToolCall.ID != "" {
-				idToIndex[*newToolCall.ID] = index
-			}
-			continue
-		}
-
-		// Update existing tool call
-		if deltaToolCall.Type != nil {
-			existingToolCalls[index].Type = deltaToolCall.Type
-		}
-		if deltaToolCall.ID != nil && *deltaToolCall.ID != "" {
-			existingToolCalls[index].ID = deltaToolCall.ID
-			idToIndex[*deltaToolCall.ID] = index
-		}
-		if deltaToolCall.Function.Name != nil && *deltaToolCall.Function.Name != "" {
-			existingToolCalls[index].Function.Name = deltaToolCall.Function.Name
-		}
-		if deltaToolCall.Function.Arguments != "" {
-			existingToolCalls[index].Function.Arguments += deltaToolCall.Function.Arguments
-		}
-	}
-	message.ChatAssistantMessage.ToolCalls = existingToolCalls
-}
-
-// appendContentToMessage efficiently appends content to a message
-func (a *Accumulator) appendContentToMessage(message *schemas.ChatMessage, newContent string) {
-	if message == nil {
-		return
-	}
-	if message.Content != nil && message.Content.ContentStr != nil {
-		// Append to existing string content
-		*message.Content.ContentStr += newContent
-	} else if message.Content != nil && message.Content.ContentBlocks != nil {
-		// Find the last text block and append, or create new one
-		blocks := message.Content.ContentBlocks
-		if len(blocks) > 0 && blocks[len(blocks)-1].Type == schemas.ChatContentBlockTypeText && blocks[len(blocks)-1].Text != nil {
-			// Append to last text block
-			*blocks[len(blocks)-1].Text += newContent
-		} else {
-			// Create new text block
-			blocks = append(blocks, schemas.ChatContentBlock{
-				Type: schemas.ChatContentBlockTypeText,
-				Text: &newContent,
-			})
-			message.Content.ContentBlocks = blocks
-		}
-	} else {
-		if message.Content == nil {
-			message.Content = &schemas.ChatMessageContent{}
-		}
-		// Initialize with string content
-		message.Content.ContentStr = &newContent
-	}
-}
-
 // ProcessStreamingResponse processes a streaming response
 // It handles chat, audio, and responses streaming responses
 func (a *Accumulator) ProcessStreamingResponse(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) (*ProcessedStreamResponse, error) {
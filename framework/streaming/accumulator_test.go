@@ -267,10 +267,6 @@ func TestAccumulateToolCallsInterleavedParallel(t *testing.T) {
 	logger := bifrost.NewDefaultLogger(schemas.LogLevelDebug)
 	accumulator := NewAccumulator(nil, logger)
 
-	message := &schemas.ChatMessage{
-		Role: schemas.ChatMessageRoleAssistant,
-	}
-
 	makeDelta := func(index uint16, id *string, name *string, args string) schemas.ChatAssistantMessageToolCall {
 		return schemas.ChatAssistantMessageToolCall{
 			Index: index,
@@ -289,25 +285,21 @@ func TestAccumulateToolCallsInterleavedParallel(t *testing.T) {
 	toolNameMultiply := "multiply"
 
 	// Interleaved deltas for parallel tool calls
-	accumulator.accumulateToolCallsInMessage(message, []schemas.ChatAssistantMessageToolCall{
-		makeDelta(0, &toolCallID0, &toolNameAdd, ""),
-	})
-	accumulator.accumulateToolCallsInMessage(message, []schemas.ChatAssistantMessageToolCall{
-		makeDelta(1, &toolCallID1, &toolNameMultiply, ""),
-	})
-	accumulator.accumulateToolCallsInMessage(message, []schemas.ChatAssistantMessageToolCall{
-		makeDelta(0, nil, nil, "{\"a\": 1"),
-	})
-	accumulator.accumulateToolCallsInMessage(message, []schemas.ChatAssistantMessageToolCall{
-		makeDelta(1, nil, nil, "{\"a\": 2"),
-	})
-	accumulator.accumulateToolCallsInMessage(message, []schemas.ChatAssistantMessageToolCall{
-		makeDelta(0, nil, nil, ", \"b\": 3}"),
-	})
-	accumulator.accumulateToolCallsInMessage(message, []schemas.ChatAssistantMessageToolCall{
-		makeDelta(1, nil, nil, ", \"b\": 4}"),
-	})
-
+	var chunks []*ChatStreamChunk
+	addDelta := func(toolCall schemas.ChatAssistantMessageToolCall) {
+		chunks = append(chunks, &ChatStreamChunk{
+			ChunkIndex: len(chunks),
+			Delta:      &schemas.ChatStreamResponseChoiceDelta{ToolCalls: []schemas.ChatAssistantMessageToolCall{toolCall}},
+		})
+	}
+	addDelta(makeDelta(0, &toolCallID0, &toolNameAdd, ""))
+	addDelta(makeDelta(1, &toolCallID1, &toolNameMultiply, ""))
+	addDelta(makeDelta(0, nil, nil, "{\"a\": 1"))
+	addDelta(makeDelta(1, nil, nil, "{\"a\": 2"))
+	addDelta(makeDelta(0, nil, nil, ", \"b\": 3}"))
+	addDelta(makeDelta(1, nil, nil, ", \"b\": 4}"))
+
+	message := accumulator.buildCompleteMessageFromChatStreamChunks(chunks)
 	if message.ChatAssistantMessage == nil {
 		t.Fatal("expected ChatAssistantMessage to be initialized")
 	}
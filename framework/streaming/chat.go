@@ -111,176 +111,21 @@ func deepCopyChatStreamDelta(original *schemas.ChatStreamResponseChoiceDelta) *s
 
 // buildCompleteMessageFromChunks builds a complete message from accumulated chunks
 func (a *Accumulator) buildCompleteMessageFromChatStreamChunks(chunks []*ChatStreamChunk) *schemas.ChatMessage {
-	completeMessage := &schemas.ChatMessage{
-		Role:    schemas.ChatMessageRoleAssistant,
-		Content: &schemas.ChatMessageContent{},
-	}
 	sort.Slice(chunks, func(i, j int) bool {
 		return chunks[i].ChunkIndex < chunks[j].ChunkIndex
 	})
 
+	streamAccumulator := schemas.NewStreamAccumulator()
 	for _, chunk := range chunks {
-		if chunk.Delta == nil {
-			continue
-		}
-		// Handle role (usually in first chunk)
-		if chunk.Delta.Role != nil {
-			completeMessage.Role = schemas.ChatMessageRole(*chunk.Delta.Role)
-		}
-		// Append content delta
-		if chunk.Delta.Content != nil && *chunk.Delta.Content != "" {
-			a.appendContentToMessage(completeMessage, *chunk.Delta.Content)
-		}
-		// Handle refusal delta
-		if chunk.Delta.Refusal != nil && *chunk.Delta.Refusal != "" {
-			if completeMessage.ChatAssistantMessage == nil {
-				completeMessage.ChatAssistantMessage = &schemas.ChatAssistantMessage{}
-			}
-			if completeMessage.ChatAssistantMessage.Refusal == nil {
-				// Deep copy on first assignment
-				refusalCopy := *chunk.Delta.Refusal
-				completeMessage.ChatAssistantMessage.Refusal = &refusalCopy
-			} else {
-				*completeMessage.ChatAssistantMessage.Refusal += *chunk.Delta.Refusal
-			}
-		}
-		// Handle reasoning delta
-		if chunk.Delta.Reasoning != nil && *chunk.Delta.Reasoning != "" {
-			if completeMessage.ChatAssistantMessage == nil {
-				completeMessage.ChatAssistantMessage = &schemas.ChatAssistantMessage{}
-			}
-			if completeMessage.ChatAssistantMessage.Reasoning == nil {
-				// Deep copy on first assignment
-				reasoningCopy := *chunk.Delta.Reasoning
-				completeMessage.ChatAssistantMessage.Reasoning = &reasoningCopy
-			} else {
-				*completeMessage.ChatAssistantMessage.Reasoning += *chunk.Delta.Reasoning
-			}
-		}
-		// Handle reasoning details delta
-		if len(chunk.Delta.ReasoningDetails) > 0 {
-			if completeMessage.ChatAssistantMessage == nil {
-				completeMessage.ChatAssistantMessage = &schemas.ChatAssistantMessage{}
-			}
-			// Accumulate reasoning details by index
-			for _, rd := range chunk.Delta.ReasoningDetails {
-				found := false
-				for i := range completeMessage.ChatAssistantMessage.ReasoningDetails {
-					existingRd := &completeMessage.ChatAssistantMessage.ReasoningDetails[i]
-					if existingRd.Index == rd.Index {
-						// Found matching index - accumulate text delta
-						if rd.Text != nil && *rd.Text != "" {
-							if existingRd.Text == nil {
-								// Deep copy on first assignment
-								textCopy := *rd.Text
-								existingRd.Text = &textCopy
-							} else {
-								*existingRd.Text += *rd.Text
-							}
-						}
-						// Accumulate summary delta
-						if rd.Summary != nil && *rd.Summary != "" {
-							if existingRd.Summary == nil {
-								summaryCopy := *rd.Summary
-								existingRd.Summary = &summaryCopy
-							} else {
-								*existingRd.Summary += *rd.Summary
-							}
-						}
-						// Accumulate data delta
-						if rd.Data != nil && *rd.Data != "" {
-							if existingRd.Data == nil {
-								dataCopy := *rd.Data
-								existingRd.Data = &dataCopy
-							} else {
-								*existingRd.Data += *rd.Data
-							}
-						}
-						// Overwrite signature (typically sent once at the end)
-						if rd.Signature != nil {
-							sigCopy := *rd.Signature
-							existingRd.Signature = &sigCopy
-						}
-						// Update type if present
-						if rd.Type != "" {
-							existingRd.Type = rd.Type
-						}
-						// Update ID if present
-						if rd.ID != nil {
-							idCopy := *rd.ID
-							existingRd.ID = &idCopy
-						}
-						found = true
-						break
-					}
-				}
-				// If not found, add new entry with deep copied values
-				if !found {
-					newRd := schemas.ChatReasoningDetails{
-						Index: rd.Index,
-						Type:  rd.Type,
-					}
-					if rd.ID != nil {
-						idCopy := *rd.ID
-						newRd.ID = &idCopy
-					}
-					if rd.Text != nil {
-						textCopy := *rd.Text
-						newRd.Text = &textCopy
-					}
-					if rd.Signature != nil {
-						sigCopy := *rd.Signature
-						newRd.Signature = &sigCopy
-					}
-					if rd.Summary != nil {
-						summaryCopy := *rd.Summary
-						newRd.Summary = &summaryCopy
-					}
-					if rd.Data != nil {
-						dataCopy := *rd.Data
-						newRd.Data = &dataCopy
-					}
-					completeMessage.ChatAssistantMessage.ReasoningDetails = append(
-						completeMessage.ChatAssistantMessage.ReasoningDetails, newRd)
-				}
-			}
-		}
-		// Handle audio data - accumulate audio data and transcript
-		if chunk.Delta.Audio != nil {
-			if completeMessage.ChatAssistantMessage == nil {
-				completeMessage.ChatAssistantMessage = &schemas.ChatAssistantMessage{}
-			}
-			if completeMessage.ChatAssistantMessage.Audio == nil {
-				// First chunk with audio - initialize with copies
-				completeMessage.ChatAssistantMessage.Audio = &schemas.ChatAudioMessageAudio{
-					ID:         chunk.Delta.Audio.ID,
-					Data:       chunk.Delta.Audio.Data,
-					ExpiresAt:  chunk.Delta.Audio.ExpiresAt,
-					Transcript: chunk.Delta.Audio.Transcript,
-				}
-			} else {
-				// Subsequent chunks - accumulate data and transcript
-				if chunk.Delta.Audio.Data != "" {
-					completeMessage.ChatAssistantMessage.Audio.Data += chunk.Delta.Audio.Data
-				}
-				if chunk.Delta.Audio.Transcript != "" {
-					completeMessage.ChatAssistantMessage.Audio.Transcript += chunk.Delta.Audio.Transcript
-				}
-				// Update ID and ExpiresAt if present (they should be consistent or final)
-				if chunk.Delta.Audio.ID != "" {
-					completeMessage.ChatAssistantMessage.Audio.ID = chunk.Delta.Audio.ID
-				}
-				if chunk.Delta.Audio.ExpiresAt != 0 {
-					completeMessage.ChatAssistantMessage.Audio.ExpiresAt = chunk.Delta.Audio.ExpiresAt
-				}
-			}
-		}
-		// Accumulate tool calls
-		if len(chunk.Delta.ToolCalls) > 0 {
-			a.accumulateToolCallsInMessage(completeMessage, chunk.Delta.ToolCalls)
-		}
+		streamAccumulator.AddDelta(0, chunk.Delta)
+	}
+	completeMessage := streamAccumulator.Message(0)
+	if completeMessage == nil {
+		completeMessage = &schemas.ChatMessage{Role: schemas.ChatMessageRoleAssistant}
+	}
+	if completeMessage.Content == nil {
+		completeMessage.Content = &schemas.ChatMessageContent{}
 	}
-
 	return completeMessage
 }
 
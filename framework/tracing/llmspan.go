@@ -18,7 +18,11 @@ func PopulateRequestAttributes(req *schemas.BifrostRequest) map[string]any {
 
 	provider, model, _ := req.GetRequestFields()
 	attrs[schemas.AttrProviderName] = string(provider)
+	attrs[schemas.AttrSystem] = genAISystem(provider)
 	attrs[schemas.AttrRequestModel] = model
+	if operation := genAIOperationName(req.RequestType); operation != "" {
+		attrs[schemas.AttrOperationName] = operation
+	}
 
 	switch req.RequestType {
 	case schemas.ChatCompletionRequest, schemas.ChatCompletionStreamRequest:
@@ -58,6 +62,42 @@ func PopulateRequestAttributes(req *schemas.BifrostRequest) map[string]any {
 	return attrs
 }
 
+// genAISystems maps providers to the well-known gen_ai.system values of the GenAI semantic conventions.
+// Providers without a well-known value use their own name.
+var genAISystems = map[schemas.ModelProvider]string{
+	schemas.Azure:      "az.ai.openai",
+	schemas.Bedrock:    "aws.bedrock",
+	schemas.Vertex:     "gcp.vertex_ai",
+	schemas.Gemini:     "gcp.gemini",
+	schemas.Mistral:    "mistral_ai",
+	schemas.Perplexity: "perplexity",
+	schemas.Deepseek:   "deepseek",
+	schemas.XAI:        "xai",
+}
+
+// genAISystem returns the gen_ai.system value for a provider.
+func genAISystem(provider schemas.ModelProvider) string {
+	if system, ok := genAISystems[provider]; ok {
+		return system
+	}
+	return string(provider)
+}
+
+// genAIOperationName returns the gen_ai.operation.name value for a request type,
+// or an empty string when the GenAI semantic conventions define no operation for it.
+func genAIOperationName(requestType schemas.RequestType) string {
+	switch requestType {
+	case schemas.ChatCompletionRequest, schemas.ChatCompletionStreamRequest,
+		schemas.ResponsesRequest, schemas.ResponsesStreamRequest:
+		return "chat"
+	case schemas.TextCompletionRequest, schemas.TextCompletionStreamRequest:
+		return "text_completion"
+	case schemas.EmbeddingRequest:
+		return "embeddings"
+	}
+	return ""
+}
+
 // PopulateResponseAttributes extracts common response attributes from a BifrostResponse.
 // This is the main entry point for populating response attributes on a span.
 func PopulateResponseAttributes(resp *schemas.BifrostResponse) map[string]any {
@@ -241,6 +281,8 @@ func PopulateChatResponseAttributes(resp *schemas.BifrostChatResponse, attrs map
 	if resp.Usage != nil {
 		attrs[schemas.AttrPromptTokens] = resp.Usage.PromptTokens
 		attrs[schemas.AttrCompletionTokens] = resp.Usage.CompletionTokens
+		attrs[schemas.AttrInputTokens] = resp.Usage.PromptTokens
+		attrs[schemas.AttrOutputTokens] = resp.Usage.CompletionTokens
 		attrs[schemas.AttrTotalTokens] = resp.Usage.TotalTokens
 
 		if resp.Usage.PromptTokensDetails != nil {
@@ -389,6 +431,8 @@ func PopulateTextCompletionResponseAttributes(resp *schemas.BifrostTextCompletio
 	if resp.Usage != nil {
 		attrs[schemas.AttrPromptTokens] = resp.Usage.PromptTokens
 		attrs[schemas.AttrCompletionTokens] = resp.Usage.CompletionTokens
+		attrs[schemas.AttrInputTokens] = resp.Usage.PromptTokens
+		attrs[schemas.AttrOutputTokens] = resp.Usage.CompletionTokens
 		attrs[schemas.AttrTotalTokens] = resp.Usage.TotalTokens
 	}
 }
@@ -442,6 +486,8 @@ func PopulateEmbeddingResponseAttributes(resp *schemas.BifrostEmbeddingResponse,
 	if resp.Usage != nil {
 		attrs[schemas.AttrPromptTokens] = resp.Usage.PromptTokens
 		attrs[schemas.AttrCompletionTokens] = resp.Usage.CompletionTokens
+		attrs[schemas.AttrInputTokens] = resp.Usage.PromptTokens
+		attrs[schemas.AttrOutputTokens] = resp.Usage.CompletionTokens
 		attrs[schemas.AttrTotalTokens] = resp.Usage.TotalTokens
 	}
 }
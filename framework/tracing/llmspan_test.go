@@ -0,0 +1,78 @@
+package tracing
+
+import (
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestPopulateRequestAttributes_GenAIConventions(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *schemas.BifrostRequest
+		system    string
+		operation string
+	}{
+		{
+			name: "openai chat",
+			req: &schemas.BifrostRequest{
+				RequestType: schemas.ChatCompletionRequest,
+				ChatRequest: &schemas.BifrostChatRequest{Provider: schemas.OpenAI, Model: "gpt-4o"},
+			},
+			system:    "openai",
+			operation: "chat",
+		},
+		{
+			name: "bedrock embedding",
+			req: &schemas.BifrostRequest{
+				RequestType:      schemas.EmbeddingRequest,
+				EmbeddingRequest: &schemas.BifrostEmbeddingRequest{Provider: schemas.Bedrock, Model: "titan-embed"},
+			},
+			system:    "aws.bedrock",
+			operation: "embeddings",
+		},
+		{
+			name: "speech has no operation",
+			req: &schemas.BifrostRequest{
+				RequestType:   schemas.SpeechRequest,
+				SpeechRequest: &schemas.BifrostSpeechRequest{Provider: schemas.Elevenlabs, Model: "eleven_v3"},
+			},
+			system: "elevenlabs",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs := PopulateRequestAttributes(tt.req)
+			if got := attrs[schemas.AttrSystem]; got != tt.system {
+				t.Errorf("%s = %v, want %q", schemas.AttrSystem, got, tt.system)
+			}
+			got, ok := attrs[schemas.AttrOperationName]
+			if tt.operation == "" {
+				if ok {
+					t.Errorf("%s = %v, want unset", schemas.AttrOperationName, got)
+				}
+			} else if got != tt.operation {
+				t.Errorf("%s = %v, want %q", schemas.AttrOperationName, got, tt.operation)
+			}
+		})
+	}
+}
+
+func TestPopulateChatResponseAttributes_Usage(t *testing.T) {
+	attrs := map[string]any{}
+	PopulateChatResponseAttributes(&schemas.BifrostChatResponse{
+		Usage: &schemas.BifrostLLMUsage{PromptTokens: 12, CompletionTokens: 34, TotalTokens: 46},
+	}, attrs)
+
+	for key, want := range map[string]int{
+		schemas.AttrInputTokens:      12,
+		schemas.AttrOutputTokens:     34,
+		schemas.AttrPromptTokens:     12,
+		schemas.AttrCompletionTokens: 34,
+		schemas.AttrTotalTokens:      46,
+	} {
+		if got := attrs[key]; got != want {
+			t.Errorf("%s = %v, want %d", key, got, want)
+		}
+	}
+}
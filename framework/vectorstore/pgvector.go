@@ -0,0 +1,475 @@
+package vectorstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PgVectorConfig represents the configuration for the pgvector vector store.
+type PgVectorConfig struct {
+	Host     *schemas.EnvVar `json:"host"`                // Postgres server host - REQUIRED
+	Port     *schemas.EnvVar `json:"port"`                // Postgres server port (fallback to 5432)
+	User     *schemas.EnvVar `json:"user"`                // Postgres user - REQUIRED
+	Password *schemas.EnvVar `json:"password,omitempty"`  // Postgres password - Optional
+	DBName   *schemas.EnvVar `json:"db_name"`             // Postgres database - REQUIRED
+	SSLMode  *schemas.EnvVar `json:"ssl_mode,omitempty"`  // Postgres SSL mode (fallback to disable)
+	MaxConns int             `json:"max_conns,omitempty"` // Maximum connections of the pool - Optional
+}
+
+// PgVectorStore represents the pgvector vector store. Each namespace is a table holding the id,
+// the embedding and the properties as JSONB of its entries.
+type PgVectorStore struct {
+	pool   *pgxpool.Pool
+	logger schemas.Logger
+}
+
+// Ping checks if the Postgres server is reachable.
+func (s *PgVectorStore) Ping(ctx context.Context) error {
+	return s.pool.Ping(ctx)
+}
+
+// CreateNamespace creates the table of a namespace with a cosine HNSW index on its embeddings and a
+// GIN index on its properties. Properties need no columns, they are filtered in the JSONB document.
+func (s *PgVectorStore) CreateNamespace(ctx context.Context, namespace string, dimension int, properties map[string]VectorStoreProperties) error {
+	if strings.TrimSpace(namespace) == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	if _, err := s.pool.Exec(ctx, "CREATE EXTENSION IF NOT EXISTS vector"); err != nil {
+		return fmt.Errorf("failed to create vector extension: %w", err)
+	}
+
+	// Without a dimension the embeddings cannot be indexed, so searches scan the table
+	embeddingType := "vector"
+	if dimension > 0 {
+		embeddingType = fmt.Sprintf("vector(%d)", dimension)
+	}
+	table := pgIdentifier(namespace)
+	if _, err := s.pool.Exec(ctx, fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (id TEXT PRIMARY KEY, embedding %s, properties JSONB NOT NULL DEFAULT '{}'::jsonb)",
+		table, embeddingType,
+	)); err != nil {
+		return fmt.Errorf("failed to create table: %w", err)
+	}
+	if dimension > 0 {
+		if _, err := s.pool.Exec(ctx, fmt.Sprintf(
+			"CREATE INDEX IF NOT EXISTS %s ON %s USING hnsw (embedding vector_cosine_ops)",
+			pgIdentifier(namespace+"_embedding_idx"), table,
+		)); err != nil {
+			return fmt.Errorf("failed to create embedding index: %w", err)
+		}
+	}
+	if _, err := s.pool.Exec(ctx, fmt.Sprintf(
+		"CREATE INDEX IF NOT EXISTS %s ON %s USING gin (properties jsonb_path_ops)",
+		pgIdentifier(namespace+"_properties_idx"), table,
+	)); err != nil {
+		return fmt.Errorf("failed to create properties index: %w", err)
+	}
+	return nil
+}
+
+// DeleteNamespace drops the table of a namespace.
+func (s *PgVectorStore) DeleteNamespace(ctx context.Context, namespace string) error {
+	_, err := s.pool.Exec(ctx, "DROP TABLE IF EXISTS "+pgIdentifier(namespace))
+	return err
+}
+
+// GetChunk retrieves a single entry from the pgvector store.
+func (s *PgVectorStore) GetChunk(ctx context.Context, namespace string, id string) (SearchResult, error) {
+	if strings.TrimSpace(id) == "" {
+		return SearchResult{}, fmt.Errorf("id is required")
+	}
+	var rawProperties []byte
+	err := s.pool.QueryRow(ctx, "SELECT properties FROM "+pgIdentifier(namespace)+" WHERE id = $1", id).Scan(&rawProperties)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return SearchResult{}, fmt.Errorf("not found: %s", id)
+		}
+		return SearchResult{}, fmt.Errorf("failed to get entry: %w", err)
+	}
+	properties, err := decodePgProperties(rawProperties)
+	if err != nil {
+		return SearchResult{}, err
+	}
+	return SearchResult{ID: id, Properties: properties}, nil
+}
+
+// GetChunks retrieves multiple entries from the pgvector store.
+func (s *PgVectorStore) GetChunks(ctx context.Context, namespace string, ids []string) ([]SearchResult, error) {
+	if len(ids) == 0 {
+		return []SearchResult{}, nil
+	}
+	rows, err := s.pool.Query(ctx, "SELECT id, properties FROM "+pgIdentifier(namespace)+" WHERE id = ANY($1)", ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get entries: %w", err)
+	}
+	return scanPgResults(rows, nil, false)
+}
+
+// GetAll retrieves all entries matching the queries, ordered by id. The cursor is the id of the
+// last entry of the previous page.
+func (s *PgVectorStore) GetAll(ctx context.Context, namespace string, queries []Query, selectFields []string, cursor *string, limit int64) ([]SearchResult, *string, error) {
+	builder := &pgFilterBuilder{}
+	conditions := builder.build(queries)
+	if cursor != nil && *cursor != "" {
+		conditions = append(conditions, "id > "+builder.arg(*cursor))
+	}
+	if limit <= 0 {
+		limit = 100
+	}
+	query := "SELECT id, properties FROM " + pgIdentifier(namespace) + pgWhere(conditions) +
+		" ORDER BY id LIMIT " + builder.arg(limit)
+
+	rows, err := s.pool.Query(ctx, query, builder.args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query entries: %w", err)
+	}
+	results, err := scanPgResults(rows, selectFields, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if int64(len(results)) >= limit {
+		lastID := results[len(results)-1].ID
+		return results, &lastID, nil
+	}
+	return results, nil, nil
+}
+
+// GetNearest retrieves the entries whose cosine similarity to vector is at least threshold,
+// most similar first.
+func (s *PgVectorStore) GetNearest(ctx context.Context, namespace string, vector []float32, queries []Query, selectFields []string, threshold float64, limit int64) ([]SearchResult, error) {
+	if len(vector) == 0 {
+		return nil, fmt.Errorf("vector is required")
+	}
+	builder := &pgFilterBuilder{}
+	vectorArg := builder.arg(formatPgVector(vector)) + "::vector"
+	conditions := append([]string{"embedding IS NOT NULL"}, builder.build(queries)...)
+	conditions = append(conditions, fmt.Sprintf("1 - (embedding <=> %s) >= %s", vectorArg, builder.arg(threshold)))
+	if limit <= 0 {
+		limit = 10
+	}
+	query := fmt.Sprintf("SELECT id, properties, 1 - (embedding <=> %s) AS score FROM %s%s ORDER BY embedding <=> %s LIMIT %s",
+		vectorArg, pgIdentifier(namespace), pgWhere(conditions), vectorArg, builder.arg(limit))
+
+	rows, err := s.pool.Query(ctx, query, builder.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search entries: %w", err)
+	}
+	return scanPgResults(rows, selectFields, true)
+}
+
+// RequiresVectors returns false because entries without an embedding are stored with a NULL one.
+func (s *PgVectorStore) RequiresVectors() bool {
+	return false
+}
+
+// Add inserts an entry into the pgvector store, or replaces the entry with the same id.
+func (s *PgVectorStore) Add(ctx context.Context, namespace string, id string, embedding []float32, metadata map[string]interface{}) error {
+	if strings.TrimSpace(id) == "" {
+		return fmt.Errorf("id is required")
+	}
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	properties, err := json.Marshal(metadata)
+	if err != nil {
+		return fmt.Errorf("failed to marshal properties: %w", err)
+	}
+	var vector *string
+	if len(embedding) > 0 {
+		formatted := formatPgVector(embedding)
+		vector = &formatted
+	}
+	_, err = s.pool.Exec(ctx, "INSERT INTO "+pgIdentifier(namespace)+" (id, embedding, properties) VALUES ($1, $2::vector, $3::jsonb) "+
+		"ON CONFLICT (id) DO UPDATE SET embedding = EXCLUDED.embedding, properties = EXCLUDED.properties",
+		id, vector, string(properties))
+	if err != nil {
+		return fmt.Errorf("failed to upsert entry: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an entry from the pgvector store.
+func (s *PgVectorStore) Delete(ctx context.Context, namespace string, id string) error {
+	if strings.TrimSpace(id) == "" {
+		return fmt.Errorf("id is required")
+	}
+	_, err := s.pool.Exec(ctx, "DELETE FROM "+pgIdentifier(namespace)+" WHERE id = $1", id)
+	return err
+}
+
+// DeleteAll removes the entries matching the queries.
+func (s *PgVectorStore) DeleteAll(ctx context.Context, namespace string, queries []Query) ([]DeleteResult, error) {
+	builder := &pgFilterBuilder{}
+	rows, err := s.pool.Query(ctx, "DELETE FROM "+pgIdentifier(namespace)+pgWhere(builder.build(queries))+" RETURNING id", builder.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete entries: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete entries: %w", err)
+	}
+	results := make([]DeleteResult, len(ids))
+	for i, id := range ids {
+		results[i] = DeleteResult{ID: id, Status: DeleteStatusSuccess}
+	}
+	return results, nil
+}
+
+// Close closes the connection pool.
+func (s *PgVectorStore) Close(ctx context.Context, namespace string) error {
+	s.pool.Close()
+	return nil
+}
+
+// newPgVectorStore creates a new pgvector vector store.
+func newPgVectorStore(ctx context.Context, config *PgVectorConfig, logger schemas.Logger) (*PgVectorStore, error) {
+	if config.Host == nil || strings.TrimSpace(config.Host.GetValue()) == "" {
+		return nil, fmt.Errorf("pgvector host is required")
+	}
+	if config.User == nil || config.User.GetValue() == "" {
+		return nil, fmt.Errorf("pgvector user is required")
+	}
+	if config.DBName == nil || config.DBName.GetValue() == "" {
+		return nil, fmt.Errorf("pgvector db name is required")
+	}
+	port := "5432"
+	if config.Port != nil && config.Port.GetValue() != "" {
+		port = config.Port.GetValue()
+	}
+	sslMode := "disable"
+	if config.SSLMode != nil && config.SSLMode.GetValue() != "" {
+		sslMode = config.SSLMode.GetValue()
+	}
+	password := ""
+	if config.Password != nil {
+		password = config.Password.GetValue()
+	}
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		pgConnValue(config.Host.GetValue()), pgConnValue(port), pgConnValue(config.User.GetValue()),
+		pgConnValue(password), pgConnValue(config.DBName.GetValue()), pgConnValue(sslMode))
+	poolConfig, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pgvector config: %w", err)
+	}
+	if config.MaxConns > 0 {
+		poolConfig.MaxConns = int32(config.MaxConns)
+	}
+	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pgvector pool: %w", err)
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to connect to pgvector: %w", err)
+	}
+	return &PgVectorStore{
+		pool:   pool,
+		logger: logger,
+	}, nil
+}
+
+// pgIdentifier quotes a namespace or index name for use in a statement.
+func pgIdentifier(name string) string {
+	return pgx.Identifier{name}.Sanitize()
+}
+
+// pgConnValue quotes a value of a key/value connection string.
+func pgConnValue(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+func pgWhere(conditions []string) string {
+	if len(conditions) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(conditions, " AND ")
+}
+
+// formatPgVector formats an embedding as a pgvector text literal.
+func formatPgVector(vector []float32) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, v := range vector {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(v), 'f', -1, 32))
+	}
+	b.WriteByte(']')
+	return b.String()
+}
+
+func decodePgProperties(raw []byte) (map[string]interface{}, error) {
+	properties := make(map[string]interface{})
+	if len(raw) == 0 {
+		return properties, nil
+	}
+	if err := json.Unmarshal(raw, &properties); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal properties: %w", err)
+	}
+	return properties, nil
+}
+
+// scanPgResults reads the id, properties and, with a score, score columns of rows.
+func scanPgResults(rows pgx.Rows, selectFields []string, withScore bool) ([]SearchResult, error) {
+	defer rows.Close()
+	results := []SearchResult{}
+	for rows.Next() {
+		var id string
+		var rawProperties []byte
+		var score float64
+		dest := []any{&id, &rawProperties}
+		if withScore {
+			dest = append(dest, &score)
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, fmt.Errorf("failed to scan entry: %w", err)
+		}
+		properties, err := decodePgProperties(rawProperties)
+		if err != nil {
+			return nil, err
+		}
+		result := SearchResult{ID: id, Properties: filterProperties(properties, selectFields)}
+		if withScore {
+			result.Score = &score
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read entries: %w", err)
+	}
+	return results, nil
+}
+
+// pgFilterBuilder translates queries to conditions on the properties JSONB column, collecting
+// their values as statement arguments.
+type pgFilterBuilder struct {
+	args []any
+}
+
+// arg adds a statement argument and returns its placeholder.
+func (b *pgFilterBuilder) arg(value any) string {
+	b.args = append(b.args, value)
+	return "$" + strconv.Itoa(len(b.args))
+}
+
+// containsArg adds {field: value} as a JSONB argument, matched by the @> containment operator.
+func (b *pgFilterBuilder) containsArg(field string, value any) string {
+	document, err := json.Marshal(map[string]any{field: value})
+	if err != nil {
+		document = []byte("{}")
+	}
+	return b.arg(string(document)) + "::jsonb"
+}
+
+func (b *pgFilterBuilder) build(queries []Query) []string {
+	conditions := make([]string, 0, len(queries))
+	for _, q := range queries {
+		if condition := b.condition(q); condition != "" {
+			conditions = append(conditions, condition)
+		}
+	}
+	return conditions
+}
+
+func (b *pgFilterBuilder) condition(q Query) string {
+	switch q.Operator {
+	case QueryOperatorEqual:
+		return "properties @> " + b.containsArg(q.Field, q.Value)
+	case QueryOperatorNotEqual:
+		return "NOT (properties @> " + b.containsArg(q.Field, q.Value) + ")"
+	case QueryOperatorGreaterThan:
+		return b.compare(q.Field, ">", q.Value)
+	case QueryOperatorGreaterThanOrEqual:
+		return b.compare(q.Field, ">=", q.Value)
+	case QueryOperatorLessThan:
+		return b.compare(q.Field, "<", q.Value)
+	case QueryOperatorLessThanOrEqual:
+		return b.compare(q.Field, "<=", q.Value)
+	case QueryOperatorLike:
+		str, ok := q.Value.(string)
+		if !ok {
+			return ""
+		}
+		// Weaviate style wildcards, * for any sequence and ? for a single character
+		pattern := strings.NewReplacer(`%`, `\%`, `_`, `\_`, `*`, `%`, `?`, `_`).Replace(str)
+		return fmt.Sprintf("properties ->> %s LIKE %s", b.arg(q.Field), b.arg(pattern))
+	case QueryOperatorIsNull:
+		field := b.arg(q.Field)
+		return fmt.Sprintf("(NOT properties ? %s OR properties -> %s = 'null'::jsonb)", field, field)
+	case QueryOperatorIsNotNull:
+		field := b.arg(q.Field)
+		return fmt.Sprintf("(properties ? %s AND properties -> %s <> 'null'::jsonb)", field, field)
+	case QueryOperatorContainsAny, QueryOperatorContainsAll:
+		values := toInterfaceSlice(q.Value)
+		if len(values) == 0 {
+			return ""
+		}
+		joiner := " OR "
+		if q.Operator == QueryOperatorContainsAll {
+			joiner = " AND "
+		}
+		// A value is contained by an array property holding it or a scalar property equal to it
+		parts := make([]string, len(values))
+		for i, value := range values {
+			parts[i] = fmt.Sprintf("(properties @> %s OR properties @> %s)",
+				b.containsArg(q.Field, []any{value}), b.containsArg(q.Field, value))
+		}
+		return "(" + strings.Join(parts, joiner) + ")"
+	default:
+		return "properties @> " + b.containsArg(q.Field, q.Value)
+	}
+}
+
+// compare compares a numeric property to a number, or a string property to a string. Properties of
+// another type never match.
+func (b *pgFilterBuilder) compare(field, operator string, value any) string {
+	switch value.(type) {
+	case int, int32, int64, float32, float64:
+		fieldArg := b.arg(field)
+		return fmt.Sprintf("CASE WHEN jsonb_typeof(properties -> %s) = 'number' THEN (properties ->> %s)::numeric %s %s ELSE false END",
+			fieldArg, fieldArg, operator, b.arg(value))
+	case string:
+		return fmt.Sprintf("properties ->> %s %s %s", b.arg(field), operator, b.arg(value))
+	default:
+		return ""
+	}
+}
+
+// toInterfaceSlice returns the elements of the slice values, or values itself when it is a scalar.
+func toInterfaceSlice(values any) []any {
+	switch v := values.(type) {
+	case nil:
+		return nil
+	case []any:
+		return v
+	case []string:
+		result := make([]any, len(v))
+		for i, s := range v {
+			result[i] = s
+		}
+		return result
+	case []int:
+		result := make([]any, len(v))
+		for i, n := range v {
+			result[i] = n
+		}
+		return result
+	case []int64:
+		result := make([]any, len(v))
+		for i, n := range v {
+			result[i] = n
+		}
+		return result
+	default:
+		return []any{v}
+	}
+}
@@ -0,0 +1,326 @@
+package vectorstore
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	PgVectorTestTimeout       = 30 * time.Second
+	PgVectorTestNamespace     = "bifrost_test_namespace"
+	PgVectorTestDefaultHost   = "localhost"
+	PgVectorTestDefaultPort   = "5432"
+	PgVectorTestDefaultUser   = "bifrost"
+	PgVectorTestDefaultDBName = "bifrost"
+	PgVectorTestDimension     = 384
+)
+
+type PgVectorTestSetup struct {
+	Store  *PgVectorStore
+	Logger schemas.Logger
+	Config PgVectorConfig
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func NewPgVectorTestSetup(t *testing.T) *PgVectorTestSetup {
+	config := PgVectorConfig{
+		Host:     schemas.NewEnvVar(getEnvWithDefault("PGVECTOR_HOST", PgVectorTestDefaultHost)),
+		Port:     schemas.NewEnvVar(getEnvWithDefault("PGVECTOR_PORT", PgVectorTestDefaultPort)),
+		User:     schemas.NewEnvVar(getEnvWithDefault("PGVECTOR_USER", PgVectorTestDefaultUser)),
+		Password: schemas.NewEnvVar(os.Getenv("PGVECTOR_PASSWORD")),
+		DBName:   schemas.NewEnvVar(getEnvWithDefault("PGVECTOR_DB_NAME", PgVectorTestDefaultDBName)),
+	}
+
+	logger := bifrost.NewDefaultLogger(schemas.LogLevelInfo)
+	ctx, cancel := context.WithTimeout(context.Background(), PgVectorTestTimeout)
+
+	store, err := newPgVectorStore(ctx, &config, logger)
+	if err != nil {
+		cancel()
+		t.Fatalf("Failed to create pgvector store: %v", err)
+	}
+
+	setup := &PgVectorTestSetup{
+		Store:  store,
+		Logger: logger,
+		Config: config,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	err = store.CreateNamespace(ctx, PgVectorTestNamespace, PgVectorTestDimension, nil)
+	if err != nil {
+		setup.Cleanup(t)
+		t.Fatalf("Failed to create namespace %q: %v", PgVectorTestNamespace, err)
+	}
+
+	return setup
+}
+
+func (ts *PgVectorTestSetup) Cleanup(t *testing.T) {
+	defer ts.cancel()
+
+	if err := ts.Store.DeleteNamespace(ts.ctx, PgVectorTestNamespace); err != nil {
+		t.Logf("Warning: Failed to delete namespace: %v", err)
+	}
+	if err := ts.Store.Close(ts.ctx, PgVectorTestNamespace); err != nil {
+		t.Logf("Warning: Failed to close store: %v", err)
+	}
+}
+
+func TestPgVectorConfig_Validation(t *testing.T) {
+	logger := bifrost.NewDefaultLogger(schemas.LogLevelInfo)
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		config   PgVectorConfig
+		errorMsg string
+	}{
+		{
+			name: "missing host",
+			config: PgVectorConfig{
+				User:   schemas.NewEnvVar("bifrost"),
+				DBName: schemas.NewEnvVar("bifrost"),
+			},
+			errorMsg: "pgvector host is required",
+		},
+		{
+			name: "missing user",
+			config: PgVectorConfig{
+				Host:   schemas.NewEnvVar("localhost"),
+				DBName: schemas.NewEnvVar("bifrost"),
+			},
+			errorMsg: "pgvector user is required",
+		},
+		{
+			name: "missing db name",
+			config: PgVectorConfig{
+				Host: schemas.NewEnvVar("localhost"),
+				User: schemas.NewEnvVar("bifrost"),
+			},
+			errorMsg: "pgvector db name is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, err := newPgVectorStore(ctx, &tt.config, logger)
+			assert.Error(t, err)
+			assert.Nil(t, store)
+			assert.Contains(t, err.Error(), tt.errorMsg)
+		})
+	}
+}
+
+func TestFormatPgVector(t *testing.T) {
+	assert.Equal(t, "[]", formatPgVector(nil))
+	assert.Equal(t, "[0.5,-1,0.25]", formatPgVector([]float32{0.5, -1, 0.25}))
+}
+
+func TestPgIdentifier(t *testing.T) {
+	assert.Equal(t, `"bifrost_cache"`, pgIdentifier("bifrost_cache"))
+	assert.Equal(t, `"bifrost""; DROP TABLE x; --"`, pgIdentifier(`bifrost"; DROP TABLE x; --`))
+}
+
+func TestBuildPgFilter(t *testing.T) {
+	tests := []struct {
+		name       string
+		queries    []Query
+		conditions []string
+		args       []any
+	}{
+		{
+			name:       "empty queries",
+			queries:    []Query{},
+			conditions: []string{},
+		},
+		{
+			name: "equal",
+			queries: []Query{
+				{Field: "category", Operator: QueryOperatorEqual, Value: "tech"},
+			},
+			conditions: []string{"properties @> $1::jsonb"},
+			args:       []any{`{"category":"tech"}`},
+		},
+		{
+			name: "not equal",
+			queries: []Query{
+				{Field: "public", Operator: QueryOperatorNotEqual, Value: true},
+			},
+			conditions: []string{"NOT (properties @> $1::jsonb)"},
+			args:       []any{`{"public":true}`},
+		},
+		{
+			name: "numeric comparison",
+			queries: []Query{
+				{Field: "size", Operator: QueryOperatorGreaterThan, Value: 1000},
+			},
+			conditions: []string{"CASE WHEN jsonb_typeof(properties -> $1) = 'number' THEN (properties ->> $1)::numeric > $2 ELSE false END"},
+			args:       []any{"size", 1000},
+		},
+		{
+			name: "like",
+			queries: []Query{
+				{Field: "content", Operator: QueryOperatorLike, Value: "100%_*?"},
+			},
+			conditions: []string{"properties ->> $1 LIKE $2"},
+			args:       []any{"content", `100\%\_%_`},
+		},
+		{
+			name: "null checks",
+			queries: []Query{
+				{Field: "author", Operator: QueryOperatorIsNull},
+				{Field: "user", Operator: QueryOperatorIsNotNull},
+			},
+			conditions: []string{
+				"(NOT properties ? $1 OR properties -> $1 = 'null'::jsonb)",
+				"(properties ? $2 AND properties -> $2 <> 'null'::jsonb)",
+			},
+			args: []any{"author", "user"},
+		},
+		{
+			name: "contains all",
+			queries: []Query{
+				{Field: "tags", Operator: QueryOperatorContainsAll, Value: []string{"a", "b"}},
+			},
+			conditions: []string{"((properties @> $1::jsonb OR properties @> $2::jsonb) AND (properties @> $3::jsonb OR properties @> $4::jsonb))"},
+			args:       []any{`{"tags":["a"]}`, `{"tags":"a"}`, `{"tags":["b"]}`, `{"tags":"b"}`},
+		},
+		{
+			name: "unsupported value is skipped",
+			queries: []Query{
+				{Field: "size", Operator: QueryOperatorLessThan, Value: true},
+				{Field: "content", Operator: QueryOperatorLike, Value: 1},
+			},
+			conditions: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := &pgFilterBuilder{}
+			assert.Equal(t, tt.conditions, builder.build(tt.queries))
+			assert.Equal(t, tt.args, builder.args)
+		})
+	}
+}
+
+func TestPgVectorStore_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	setup := NewPgVectorTestSetup(t)
+	defer setup.Cleanup(t)
+
+	err := setup.Store.Ping(setup.ctx)
+	require.NoError(t, err)
+
+	key := generateUUID()
+	err = setup.Store.Add(setup.ctx, PgVectorTestNamespace, key, generateTestEmbedding(PgVectorTestDimension), map[string]interface{}{"type": "document"})
+	require.NoError(t, err)
+
+	result, err := setup.Store.GetChunk(setup.ctx, PgVectorTestNamespace, key)
+	require.NoError(t, err)
+	assert.Equal(t, "document", result.Properties["type"])
+
+	// Entries without an embedding are stored too
+	keys := []string{generateUUID(), generateUUID()}
+	for _, k := range keys {
+		err = setup.Store.Add(setup.ctx, PgVectorTestNamespace, k, nil, map[string]interface{}{"type": "metadata"})
+		require.NoError(t, err)
+	}
+
+	results, err := setup.Store.GetChunks(setup.ctx, PgVectorTestNamespace, keys)
+	require.NoError(t, err)
+	assert.Len(t, results, 2)
+
+	page, cursor, err := setup.Store.GetAll(setup.ctx, PgVectorTestNamespace, nil, []string{"type"}, nil, 2)
+	require.NoError(t, err)
+	assert.Len(t, page, 2)
+	require.NotNil(t, cursor)
+
+	page, _, err = setup.Store.GetAll(setup.ctx, PgVectorTestNamespace, nil, []string{"type"}, cursor, 2)
+	require.NoError(t, err)
+	assert.Len(t, page, 1)
+
+	deleted, err := setup.Store.DeleteAll(setup.ctx, PgVectorTestNamespace, []Query{{Field: "type", Operator: QueryOperatorEqual, Value: "metadata"}})
+	require.NoError(t, err)
+	assert.Len(t, deleted, 2)
+
+	_, err = setup.Store.GetChunk(setup.ctx, PgVectorTestNamespace, keys[0])
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestPgVectorStore_VectorSearch(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	setup := NewPgVectorTestSetup(t)
+	defer setup.Cleanup(t)
+
+	emb := generateTestEmbedding(PgVectorTestDimension)
+	err := setup.Store.Add(setup.ctx, PgVectorTestNamespace, generateUUID(), emb, map[string]interface{}{"type": "tech", "size": 2048})
+	require.NoError(t, err)
+
+	err = setup.Store.Add(setup.ctx, PgVectorTestNamespace, generateUUID(), generateTestEmbedding(PgVectorTestDimension), map[string]interface{}{"type": "sports", "size": 10})
+	require.NoError(t, err)
+
+	results, err := setup.Store.GetNearest(setup.ctx, PgVectorTestNamespace, emb, nil, []string{"type"}, 0.99, 10)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.NotNil(t, results[0].Score)
+	assert.InDelta(t, 1.0, *results[0].Score, 0.001)
+	assert.Equal(t, "tech", results[0].Properties["type"])
+
+	queries := []Query{{Field: "size", Operator: QueryOperatorLessThan, Value: 100}}
+	results, err = setup.Store.GetNearest(setup.ctx, PgVectorTestNamespace, emb, queries, []string{"type"}, -1, 10)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "sports", results[0].Properties["type"])
+}
+
+func TestPgVectorStore_InterfaceCompliance(t *testing.T) {
+	var _ VectorStore = (*PgVectorStore)(nil)
+}
+
+func TestVectorStoreFactory_PgVector(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	logger := bifrost.NewDefaultLogger(schemas.LogLevelInfo)
+
+	config := &Config{
+		Enabled: true,
+		Type:    VectorStoreTypePgVector,
+		Config: PgVectorConfig{
+			Host:     schemas.NewEnvVar(getEnvWithDefault("PGVECTOR_HOST", PgVectorTestDefaultHost)),
+			Port:     schemas.NewEnvVar(getEnvWithDefault("PGVECTOR_PORT", PgVectorTestDefaultPort)),
+			User:     schemas.NewEnvVar(getEnvWithDefault("PGVECTOR_USER", PgVectorTestDefaultUser)),
+			Password: schemas.NewEnvVar(os.Getenv("PGVECTOR_PASSWORD")),
+			DBName:   schemas.NewEnvVar(getEnvWithDefault("PGVECTOR_DB_NAME", PgVectorTestDefaultDBName)),
+		},
+	}
+
+	store, err := NewVectorStore(context.Background(), config, logger)
+	if err != nil {
+		t.Skipf("Could not create pgvector store: %v", err)
+	}
+	defer store.Close(context.Background(), PgVectorTestNamespace)
+
+	pgVectorStore, ok := store.(*PgVectorStore)
+	assert.True(t, ok)
+	assert.NotNil(t, pgVectorStore)
+}
@@ -16,6 +16,7 @@ const (
 	VectorStoreTypeRedis    VectorStoreType = "redis"
 	VectorStoreTypeQdrant   VectorStoreType = "qdrant"
 	VectorStoreTypePinecone VectorStoreType = "pinecone"
+	VectorStoreTypePgVector VectorStoreType = "pgvector"
 )
 
 // Query represents a query to the vector store.
@@ -158,6 +159,12 @@ func (c *Config) UnmarshalJSON(data []byte) error {
 			return fmt.Errorf("failed to unmarshal pinecone config: %w", err)
 		}
 		c.Config = pineconeConfig
+	case VectorStoreTypePgVector:
+		var pgVectorConfig PgVectorConfig
+		if err := json.Unmarshal(temp.Config, &pgVectorConfig); err != nil {
+			return fmt.Errorf("failed to unmarshal pgvector config: %w", err)
+		}
+		c.Config = pgVectorConfig
 	default:
 		return fmt.Errorf("unknown vector store type: %s", temp.Type)
 	}
@@ -212,6 +219,15 @@ func NewVectorStore(ctx context.Context, config *Config, logger schemas.Logger)
 			return nil, fmt.Errorf("invalid pinecone config")
 		}
 		return newPineconeStore(ctx, &pineconeConfig, logger)
+	case VectorStoreTypePgVector:
+		if config.Config == nil {
+			return nil, fmt.Errorf("pgvector config is required")
+		}
+		pgVectorConfig, ok := config.Config.(PgVectorConfig)
+		if !ok {
+			return nil, fmt.Errorf("invalid pgvector config")
+		}
+		return newPgVectorStore(ctx, &pgVectorConfig, logger)
 	}
 	return nil, fmt.Errorf("invalid vector store type: %s", config.Type)
 }
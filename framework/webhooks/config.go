@@ -0,0 +1,127 @@
+package webhooks
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	DefaultMaxRetries     = 5
+	DefaultTimeout        = 10 * time.Second
+	DefaultQueueSize      = 1000
+	DefaultWorkers        = 4
+	DefaultInitialBackoff = time.Second
+	MaxBackoff            = time.Minute
+
+	DefaultBatchPollInterval = time.Minute
+)
+
+// EventType identifies a gateway event webhooks are sent for.
+type EventType string
+
+const (
+	// EventBudgetExceeded is sent when usage takes a governance budget to its limit, once per window.
+	EventBudgetExceeded EventType = "budget.exceeded"
+	// EventCircuitOpened is sent when the circuit breaker of a provider and model opens.
+	EventCircuitOpened EventType = "provider.circuit_opened"
+	// EventOAuthTokenExpiring is sent when an OAuth token about to expire cannot be refreshed and its
+	// OAuth configuration has to be authorized again. Provider API keys have no expiry and are not reported.
+	EventOAuthTokenExpiring EventType = "oauth.token_expiring"
+	// EventBatchCompleted is sent when a batch created or retrieved through Bifrost has reached a final status.
+	EventBatchCompleted EventType = "batch.completed"
+)
+
+// EventTypes lists every event type.
+var EventTypes = []EventType{EventBudgetExceeded, EventCircuitOpened, EventOAuthTokenExpiring, EventBatchCompleted}
+
+// Config holds the configuration of webhook notifications.
+type Config struct {
+	Enabled          bool             `json:"enabled"`
+	Endpoints        []EndpointConfig `json:"endpoints"`
+	MaxRetries       int              `json:"max_retries,omitempty"`        // Retries of a failed delivery before it is dead-lettered. Default is 5.
+	InitialBackoffMs int              `json:"initial_backoff_ms,omitempty"` // Wait before the first retry, doubled on each retry up to a minute. Default is 1s.
+	TimeoutSeconds   int              `json:"timeout_seconds,omitempty"`    // Timeout of each delivery attempt. Default is 10s.
+	QueueSize        int              `json:"queue_size,omitempty"`         // Deliveries buffered before new events are dropped. Default is 1000.
+	// Interval at which unfinished batches are retrieved to send batch.completed. Default is 60s.
+	BatchPollIntervalSeconds int `json:"batch_poll_interval_seconds,omitempty"`
+}
+
+// EndpointConfig is a URL webhook events are POSTed to.
+type EndpointConfig struct {
+	Name    string            `json:"name"`
+	URL     string            `json:"url"`
+	Secret  *schemas.EnvVar   `json:"secret,omitempty"`  // Key of the HMAC-SHA256 signature sent with each event (optional)
+	Events  []EventType       `json:"events,omitempty"`  // Event types sent to the endpoint. Empty sends every event.
+	Headers map[string]string `json:"headers,omitempty"` // Extra headers sent with each event
+}
+
+// Validate checks every endpoint has a unique name, an http(s) URL and known event types.
+func (c *Config) Validate() error {
+	names := make(map[string]bool, len(c.Endpoints))
+	for i, endpoint := range c.Endpoints {
+		if endpoint.Name == "" {
+			return fmt.Errorf("webhook endpoint %d: name is required", i)
+		}
+		if names[endpoint.Name] {
+			return fmt.Errorf("webhook endpoint %s: duplicate name", endpoint.Name)
+		}
+		names[endpoint.Name] = true
+		parsed, err := url.Parse(endpoint.URL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("webhook endpoint %s: url must be an http or https URL", endpoint.Name)
+		}
+		for _, eventType := range endpoint.Events {
+			if !slices.Contains(EventTypes, eventType) {
+				return fmt.Errorf("webhook endpoint %s: unknown event type %q", endpoint.Name, eventType)
+			}
+		}
+	}
+	if c.MaxRetries < 0 || c.InitialBackoffMs < 0 || c.TimeoutSeconds < 0 || c.QueueSize < 0 || c.BatchPollIntervalSeconds < 0 {
+		return fmt.Errorf("webhook max_retries, initial_backoff_ms, timeout_seconds, queue_size and batch_poll_interval_seconds cannot be negative")
+	}
+	return nil
+}
+
+// subscribes reports whether the endpoint receives events of the type.
+func (e *EndpointConfig) subscribes(eventType EventType) bool {
+	return len(e.Events) == 0 || slices.Contains(e.Events, eventType)
+}
+
+func (c *Config) maxRetries() int {
+	if c.MaxRetries <= 0 {
+		return DefaultMaxRetries
+	}
+	return c.MaxRetries
+}
+
+func (c *Config) initialBackoff() time.Duration {
+	if c.InitialBackoffMs <= 0 {
+		return DefaultInitialBackoff
+	}
+	return time.Duration(c.InitialBackoffMs) * time.Millisecond
+}
+
+func (c *Config) timeout() time.Duration {
+	if c.TimeoutSeconds <= 0 {
+		return DefaultTimeout
+	}
+	return time.Duration(c.TimeoutSeconds) * time.Second
+}
+
+func (c *Config) queueSize() int {
+	if c.QueueSize <= 0 {
+		return DefaultQueueSize
+	}
+	return c.QueueSize
+}
+
+func (c *Config) batchPollInterval() time.Duration {
+	if c.BatchPollIntervalSeconds <= 0 {
+		return DefaultBatchPollInterval
+	}
+	return time.Duration(c.BatchPollIntervalSeconds) * time.Second
+}
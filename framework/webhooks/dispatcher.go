@@ -0,0 +1,520 @@
+// Package webhooks POSTs signed JSON notifications of gateway events to configured URLs. Failed
+// deliveries are retried with exponential backoff, and the ones that still fail are kept as dead
+// letters that can be inspected and redelivered. Batches created or retrieved through Bifrost are
+// polled in the background until they finish, so batch.completed is sent without client requests.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/google/uuid"
+)
+
+// Headers sent with every event
+const (
+	HeaderEventID   = "X-Bifrost-Event-Id"
+	HeaderEventType = "X-Bifrost-Event-Type"
+	HeaderTimestamp = "X-Bifrost-Timestamp" // Unix seconds the delivery was signed at
+	HeaderSignature = "X-Bifrost-Signature" // v1=<hex HMAC-SHA256 of "<timestamp>.<body>">, sent when the endpoint has a secret
+)
+
+// maxTrackedBatches bounds the batch IDs remembered to send one event per finished batch, and the
+// unfinished batches polled.
+const maxTrackedBatches = 10000
+
+// ErrEndpointNotFound is returned when redelivering a dead letter whose endpoint is no longer configured.
+var ErrEndpointNotFound = errors.New("webhook endpoint not found")
+
+// Event is the JSON body POSTed to webhook endpoints.
+type Event struct {
+	ID        string    `json:"id"`
+	Type      EventType `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// BudgetExceededData is the data of a budget.exceeded event.
+type BudgetExceededData struct {
+	BudgetID      string    `json:"budget_id"`
+	MaxLimit      float64   `json:"max_limit"`
+	CurrentUsage  float64   `json:"current_usage"`
+	ResetDuration string    `json:"reset_duration"`
+	LastReset     time.Time `json:"last_reset"`
+}
+
+// CircuitOpenedData is the data of a provider.circuit_opened event.
+type CircuitOpenedData struct {
+	Provider schemas.ModelProvider `json:"provider"`
+	Model    string                `json:"model"`
+}
+
+// OAuthTokenExpiringData is the data of an oauth.token_expiring event.
+type OAuthTokenExpiringData struct {
+	OAuthConfigID string    `json:"oauth_config_id"`
+	ServerURL     string    `json:"server_url,omitempty"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// BatchCompletedData is the data of a batch.completed event.
+type BatchCompletedData struct {
+	BatchID       string                     `json:"batch_id"`
+	Provider      schemas.ModelProvider      `json:"provider,omitempty"`
+	Status        schemas.BatchStatus        `json:"status"`
+	RequestCounts schemas.BatchRequestCounts `json:"request_counts"`
+	OutputFileID  *string                    `json:"output_file_id,omitempty"`
+	ErrorFileID   *string                    `json:"error_file_id,omitempty"`
+}
+
+// BatchRetriever retrieves a batch, e.g. through Bifrost's BatchRetrieveRequest. It is used to poll
+// unfinished batches.
+type BatchRetriever func(ctx context.Context, provider schemas.ModelProvider, batchID string) (*schemas.BifrostBatchRetrieveResponse, error)
+
+// pendingBatch is an unfinished batch polled until it reaches a final status.
+type pendingBatch struct {
+	provider schemas.ModelProvider
+	batchID  string
+}
+
+// DeadLetterStore persists the deliveries that failed after all retries.
+type DeadLetterStore interface {
+	CreateWebhookDeadLetter(ctx context.Context, deadLetter *tables.TableWebhookDeadLetter) error
+	GetWebhookDeadLetter(ctx context.Context, id string) (*tables.TableWebhookDeadLetter, error)
+	DeleteWebhookDeadLetter(ctx context.Context, id string) error
+}
+
+// delivery is an event waiting to be sent to one endpoint.
+type delivery struct {
+	endpoint  *EndpointConfig
+	eventID   string
+	eventType EventType
+	payload   []byte
+}
+
+// Dispatcher sends gateway events to the configured webhook endpoints in the background.
+type Dispatcher struct {
+	config Config
+	store  DeadLetterStore // nil drops deliveries that fail after all retries
+	client *http.Client
+	logger schemas.Logger
+
+	queue  chan *delivery
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+
+	mu              sync.Mutex
+	started         bool
+	openCircuits    map[string]bool         // provider/model circuits reported open, to skip failed probes reopening them
+	finishedBatches map[string]struct{}     // batches already reported finished
+	pendingBatches  map[string]pendingBatch // unfinished batches polled until they finish
+	batchRetriever  BatchRetriever          // nil disables batch polling
+
+	// now and sleep are overridable in tests.
+	now   func() time.Time
+	sleep func(d time.Duration, stopCh <-chan struct{}) bool
+}
+
+// NewDispatcher creates a dispatcher for the configured endpoints. Call Start to begin sending.
+func NewDispatcher(config Config, store DeadLetterStore, logger schemas.Logger) (*Dispatcher, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &Dispatcher{
+		config:          config,
+		store:           store,
+		client:          &http.Client{Timeout: config.timeout()},
+		logger:          logger,
+		queue:           make(chan *delivery, config.queueSize()),
+		stopCh:          make(chan struct{}),
+		openCircuits:    make(map[string]bool),
+		finishedBatches: make(map[string]struct{}),
+		pendingBatches:  make(map[string]pendingBatch),
+		now:             time.Now,
+		sleep:           sleep,
+	}, nil
+}
+
+// SetBatchRetriever sets the function unfinished batches are polled with. It must be called before Start.
+func (d *Dispatcher) SetBatchRetriever(retriever BatchRetriever) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.batchRetriever = retriever
+}
+
+// Start starts the delivery workers, and the batch poller when a batch retriever is set.
+func (d *Dispatcher) Start() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.started {
+		return
+	}
+	d.started = true
+	for range DefaultWorkers {
+		d.wg.Add(1)
+		go d.work()
+	}
+	if d.batchRetriever != nil {
+		d.wg.Add(1)
+		go d.pollBatches(d.batchRetriever)
+	}
+}
+
+// Stop stops the delivery workers. Deliveries still queued or waiting for a retry are dead-lettered.
+func (d *Dispatcher) Stop() {
+	d.mu.Lock()
+	if !d.started {
+		d.mu.Unlock()
+		return
+	}
+	d.started = false
+	d.mu.Unlock()
+
+	close(d.stopCh)
+	d.wg.Wait()
+	for {
+		select {
+		case del := <-d.queue:
+			d.deadLetter(del, 0, 0, "dispatcher stopped before delivery")
+		default:
+			return
+		}
+	}
+}
+
+// Publish queues an event for every endpoint subscribed to its type. It never blocks: events are
+// dropped with a warning when the queue is full.
+func (d *Dispatcher) Publish(eventType EventType, data any) {
+	event := Event{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		CreatedAt: d.now().UTC(),
+		Data:      data,
+	}
+	payload, err := sonic.Marshal(event)
+	if err != nil {
+		d.logger.Error("failed to marshal webhook event %s: %v", eventType, err)
+		return
+	}
+	for i := range d.config.Endpoints {
+		endpoint := &d.config.Endpoints[i]
+		if !endpoint.subscribes(eventType) {
+			continue
+		}
+		select {
+		case d.queue <- &delivery{endpoint: endpoint, eventID: event.ID, eventType: eventType, payload: payload}:
+		default:
+			d.logger.Warn("webhook queue is full, dropping %s event %s for endpoint %s", eventType, event.ID, endpoint.Name)
+		}
+	}
+}
+
+// ObserveBudgetExceeded publishes a budget.exceeded event. It is the governance budget exceeded observer.
+func (d *Dispatcher) ObserveBudgetExceeded(budget tables.TableBudget) {
+	usage, _ := budget.WindowUsage(d.now())
+	d.Publish(EventBudgetExceeded, BudgetExceededData{
+		BudgetID:      budget.ID,
+		MaxLimit:      budget.MaxLimit,
+		CurrentUsage:  usage,
+		ResetDuration: budget.ResetDuration,
+		LastReset:     budget.LastReset,
+	})
+}
+
+// ObserveCircuitBreaker publishes a provider.circuit_opened event when a closed circuit opens. It
+// is the Bifrost circuit breaker observer; failed probes reopening a circuit are not reported again.
+func (d *Dispatcher) ObserveCircuitBreaker(event schemas.CircuitBreakerEvent) {
+	key := string(event.Provider) + "/" + event.Model
+	d.mu.Lock()
+	wasOpen := d.openCircuits[key]
+	if event.Open {
+		d.openCircuits[key] = true
+	} else {
+		delete(d.openCircuits, key)
+	}
+	d.mu.Unlock()
+	if event.Open && !wasOpen {
+		d.Publish(EventCircuitOpened, CircuitOpenedData{Provider: event.Provider, Model: event.Model})
+	}
+}
+
+// ObserveExpiringToken publishes an oauth.token_expiring event. It is the OAuth token refresh
+// worker's expiring token observer.
+func (d *Dispatcher) ObserveExpiringToken(config *tables.TableOauthConfig, token *tables.TableOauthToken) {
+	data := OAuthTokenExpiringData{OAuthConfigID: config.ID, ServerURL: config.ServerURL}
+	if token != nil {
+		data.ExpiresAt = token.ExpiresAt
+	}
+	d.Publish(EventOAuthTokenExpiring, data)
+}
+
+// ObserveBatchCreated starts polling a batch created through Bifrost. It is the Bifrost batch
+// create observer.
+func (d *Dispatcher) ObserveBatchCreated(batch *schemas.BifrostBatchCreateResponse) {
+	if isFinalBatchStatus(batch.Status) {
+		return
+	}
+	d.trackPendingBatch(batch.ExtraFields.Provider, batch.ID)
+}
+
+// ObserveBatch publishes a batch.completed event the first time a batch is retrieved with a final
+// status, and polls it until then. It is the Bifrost batch observer.
+func (d *Dispatcher) ObserveBatch(batch *schemas.BifrostBatchRetrieveResponse) {
+	if !isFinalBatchStatus(batch.Status) {
+		d.trackPendingBatch(batch.ExtraFields.Provider, batch.ID)
+		return
+	}
+	key := string(batch.ExtraFields.Provider) + "/" + batch.ID
+	d.mu.Lock()
+	delete(d.pendingBatches, key)
+	if _, seen := d.finishedBatches[key]; seen {
+		d.mu.Unlock()
+		return
+	}
+	if len(d.finishedBatches) >= maxTrackedBatches {
+		clear(d.finishedBatches)
+	}
+	d.finishedBatches[key] = struct{}{}
+	d.mu.Unlock()
+	d.Publish(EventBatchCompleted, BatchCompletedData{
+		BatchID:       batch.ID,
+		Provider:      batch.ExtraFields.Provider,
+		Status:        batch.Status,
+		RequestCounts: batch.RequestCounts,
+		OutputFileID:  batch.OutputFileID,
+		ErrorFileID:   batch.ErrorFileID,
+	})
+}
+
+// trackPendingBatch adds an unfinished batch to the batches polled.
+func (d *Dispatcher) trackPendingBatch(provider schemas.ModelProvider, batchID string) {
+	if provider == "" || batchID == "" {
+		return
+	}
+	key := string(provider) + "/" + batchID
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, finished := d.finishedBatches[key]; finished {
+		return
+	}
+	if _, pending := d.pendingBatches[key]; !pending && len(d.pendingBatches) >= maxTrackedBatches {
+		d.logger.Warn("too many unfinished batches, batch %s of %s will not be polled", batchID, provider)
+		return
+	}
+	d.pendingBatches[key] = pendingBatch{provider: provider, batchID: batchID}
+}
+
+// pollBatches retrieves the unfinished batches at every poll interval until the dispatcher stops.
+func (d *Dispatcher) pollBatches(retrieve BatchRetriever) {
+	defer d.wg.Done()
+	ticker := time.NewTicker(d.config.batchPollInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			d.pollPendingBatches(retrieve)
+		case <-d.stopCh:
+			return
+		}
+	}
+}
+
+// pollPendingBatches retrieves every unfinished batch once, publishing batch.completed for the ones
+// that have finished. Batches that cannot be retrieved are tried again at the next poll.
+func (d *Dispatcher) pollPendingBatches(retrieve BatchRetriever) {
+	d.mu.Lock()
+	batches := make([]pendingBatch, 0, len(d.pendingBatches))
+	for _, batch := range d.pendingBatches {
+		batches = append(batches, batch)
+	}
+	d.mu.Unlock()
+
+	for _, batch := range batches {
+		select {
+		case <-d.stopCh:
+			return
+		default:
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), d.config.timeout())
+		response, err := retrieve(ctx, batch.provider, batch.batchID)
+		cancel()
+		if err != nil {
+			d.logger.Debug("failed to poll batch %s of %s: %v", batch.batchID, batch.provider, err)
+			continue
+		}
+		if response == nil {
+			continue
+		}
+		if response.ExtraFields.Provider == "" {
+			response.ExtraFields.Provider = batch.provider
+		}
+		d.ObserveBatch(response)
+	}
+}
+
+// Redeliver sends a dead letter to its endpoint once more, deleting it when the endpoint accepts it.
+func (d *Dispatcher) Redeliver(ctx context.Context, id string) error {
+	if d.store == nil {
+		return fmt.Errorf("webhook dead letters are not stored")
+	}
+	deadLetter, err := d.store.GetWebhookDeadLetter(ctx, id)
+	if err != nil {
+		return err
+	}
+	endpoint := d.endpoint(deadLetter.Endpoint)
+	if endpoint == nil {
+		return ErrEndpointNotFound
+	}
+	if _, err := d.send(ctx, endpoint, deadLetter.EventID, EventType(deadLetter.EventType), []byte(deadLetter.Payload)); err != nil {
+		return err
+	}
+	return d.store.DeleteWebhookDeadLetter(ctx, id)
+}
+
+// endpoint returns the configured endpoint with the name, or nil.
+func (d *Dispatcher) endpoint(name string) *EndpointConfig {
+	for i := range d.config.Endpoints {
+		if d.config.Endpoints[i].Name == name {
+			return &d.config.Endpoints[i]
+		}
+	}
+	return nil
+}
+
+// work delivers queued events until the dispatcher stops.
+func (d *Dispatcher) work() {
+	defer d.wg.Done()
+	for {
+		select {
+		case del := <-d.queue:
+			d.deliver(del)
+		case <-d.stopCh:
+			return
+		}
+	}
+}
+
+// deliver sends an event to its endpoint, retrying network errors, 429 and 5xx responses with
+// exponential backoff, and dead-letters it when it still fails.
+func (d *Dispatcher) deliver(del *delivery) {
+	backoff := d.config.initialBackoff()
+	for attempt := 1; ; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), d.config.timeout())
+		statusCode, err := d.send(ctx, del.endpoint, del.eventID, del.eventType, del.payload)
+		cancel()
+		if err == nil {
+			return
+		}
+		if !isRetryable(statusCode) || attempt > d.config.maxRetries() {
+			d.deadLetter(del, attempt, statusCode, err.Error())
+			return
+		}
+		d.logger.Debug("webhook %s event %s to endpoint %s failed (attempt %d), retrying in %s: %v", del.eventType, del.eventID, del.endpoint.Name, attempt, backoff, err)
+		if !d.sleep(backoff, d.stopCh) {
+			d.deadLetter(del, attempt, statusCode, err.Error())
+			return
+		}
+		backoff = min(backoff*2, MaxBackoff)
+	}
+}
+
+// send POSTs a signed payload to the endpoint. It returns the response status code, or 0 when the
+// endpoint could not be reached, and an error unless the endpoint answered with a 2xx status.
+func (d *Dispatcher) send(ctx context.Context, endpoint *EndpointConfig, eventID string, eventType EventType, payload []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+	for key, value := range endpoint.Headers {
+		req.Header.Set(key, value)
+	}
+	timestamp := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEventID, eventID)
+	req.Header.Set(HeaderEventType, string(eventType))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	if endpoint.Secret != nil && endpoint.Secret.GetValue() != "" {
+		req.Header.Set(HeaderSignature, Sign(endpoint.Secret.GetValue(), timestamp, payload))
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook endpoint responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// deadLetter stores a delivery that could not be sent.
+func (d *Dispatcher) deadLetter(del *delivery, attempts int, statusCode int, lastError string) {
+	d.logger.Warn("webhook %s event %s to endpoint %s failed after %d attempts: %s", del.eventType, del.eventID, del.endpoint.Name, attempts, lastError)
+	if d.store == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), d.config.timeout())
+	defer cancel()
+	if err := d.store.CreateWebhookDeadLetter(ctx, &tables.TableWebhookDeadLetter{
+		EventID:        del.eventID,
+		EventType:      string(del.eventType),
+		Endpoint:       del.endpoint.Name,
+		URL:            del.endpoint.URL,
+		Payload:        string(del.payload),
+		Attempts:       attempts,
+		LastStatusCode: statusCode,
+		LastError:      lastError,
+	}); err != nil {
+		d.logger.Error("failed to store webhook dead letter for event %s: %v", del.eventID, err)
+	}
+}
+
+// Sign returns the signature of a payload sent at timestamp: "v1=" followed by the hex HMAC-SHA256
+// of "<timestamp>.<payload>" keyed with the endpoint secret. Receivers recompute it to verify events.
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// isFinalBatchStatus reports whether a batch has finished and will not change status again.
+func isFinalBatchStatus(status schemas.BatchStatus) bool {
+	switch status {
+	case schemas.BatchStatusCompleted, schemas.BatchStatusFailed, schemas.BatchStatusExpired, schemas.BatchStatusCancelled, schemas.BatchStatusEnded:
+		return true
+	}
+	return false
+}
+
+// isRetryable reports whether a failed delivery is retried: the endpoint could not be reached,
+// was rate limited or failed with a server error.
+func isRetryable(statusCode int) bool {
+	return statusCode == 0 || statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// sleep waits for d, returning false if stopCh is closed first.
+func sleep(d time.Duration, stopCh <-chan struct{}) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-stopCh:
+		return false
+	}
+}
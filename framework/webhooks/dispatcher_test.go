@@ -0,0 +1,270 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore/tables"
+)
+
+type testLogger struct{}
+
+func (l *testLogger) Debug(msg string, args ...any)                     {}
+func (l *testLogger) Info(msg string, args ...any)                      {}
+func (l *testLogger) Warn(msg string, args ...any)                      {}
+func (l *testLogger) Error(msg string, args ...any)                     {}
+func (l *testLogger) Fatal(msg string, args ...any)                     {}
+func (l *testLogger) SetLevel(level schemas.LogLevel)                   {}
+func (l *testLogger) SetOutputType(outputType schemas.LoggerOutputType) {}
+func (l *testLogger) LogHTTPRequest(level schemas.LogLevel, msg string) schemas.LogEventBuilder {
+	return schemas.NoopLogEvent
+}
+
+type fakeDeadLetterStore struct {
+	mu          sync.Mutex
+	deadLetters map[string]*tables.TableWebhookDeadLetter
+}
+
+func (s *fakeDeadLetterStore) CreateWebhookDeadLetter(ctx context.Context, deadLetter *tables.TableWebhookDeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	deadLetter.ID = strconv.Itoa(len(s.deadLetters) + 1)
+	s.deadLetters[deadLetter.ID] = deadLetter
+	return nil
+}
+
+func (s *fakeDeadLetterStore) GetWebhookDeadLetter(ctx context.Context, id string) (*tables.TableWebhookDeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deadLetters[id], nil
+}
+
+func (s *fakeDeadLetterStore) DeleteWebhookDeadLetter(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.deadLetters, id)
+	return nil
+}
+
+func (s *fakeDeadLetterStore) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.deadLetters)
+}
+
+// newTestDispatcher creates a dispatcher that does not wait between retries
+func newTestDispatcher(t *testing.T, config Config, store DeadLetterStore) *Dispatcher {
+	t.Helper()
+	d, err := NewDispatcher(config, store, &testLogger{})
+	if err != nil {
+		t.Fatalf("NewDispatcher() error = %v", err)
+	}
+	d.sleep = func(time.Duration, <-chan struct{}) bool { return true }
+	return d
+}
+
+func TestDispatcherSignsAndDeliversEvents(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	d := newTestDispatcher(t, Config{
+		Enabled: true,
+		Endpoints: []EndpointConfig{
+			{Name: "ops", URL: server.URL, Secret: schemas.NewEnvVar("whsec"), Events: []EventType{EventCircuitOpened}},
+			{Name: "billing", URL: server.URL + "/billing", Events: []EventType{EventBudgetExceeded}},
+		},
+	}, nil)
+	d.Start()
+	defer d.Stop()
+
+	d.ObserveCircuitBreaker(schemas.CircuitBreakerEvent{Provider: schemas.OpenAI, Model: "gpt-4o", Open: true})
+
+	var req *http.Request
+	select {
+	case req = <-received:
+	case <-time.After(5 * time.Second):
+		t.Fatal("webhook was not delivered")
+	}
+	body := <-bodies
+	if req.URL.Path != "/" {
+		t.Errorf("expected the event on the ops endpoint only, got path %s", req.URL.Path)
+	}
+	timestamp, err := strconv.ParseInt(req.Header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid timestamp header %q", req.Header.Get(HeaderTimestamp))
+	}
+	if got, want := req.Header.Get(HeaderSignature), Sign("whsec", timestamp, body); got != want {
+		t.Errorf("signature = %q, want %q", got, want)
+	}
+	var event struct {
+		ID   string            `json:"id"`
+		Type EventType         `json:"type"`
+		Data CircuitOpenedData `json:"data"`
+	}
+	if err := json.Unmarshal(body, &event); err != nil {
+		t.Fatalf("invalid event body: %v", err)
+	}
+	if event.Type != EventCircuitOpened || event.Data.Provider != schemas.OpenAI || event.Data.Model != "gpt-4o" {
+		t.Errorf("unexpected event %+v", event)
+	}
+	if req.Header.Get(HeaderEventID) != event.ID || req.Header.Get(HeaderEventType) != string(EventCircuitOpened) {
+		t.Errorf("event headers do not match the body: %v", req.Header)
+	}
+}
+
+func TestDispatcherDeadLettersAndRedelivers(t *testing.T) {
+	var mu sync.Mutex
+	failing := true
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if failing {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	store := &fakeDeadLetterStore{deadLetters: map[string]*tables.TableWebhookDeadLetter{}}
+	d := newTestDispatcher(t, Config{
+		Enabled:    true,
+		Endpoints:  []EndpointConfig{{Name: "ops", URL: server.URL}},
+		MaxRetries: 2,
+	}, store)
+
+	d.deliver(&delivery{endpoint: &d.config.Endpoints[0], eventID: "evt-1", eventType: EventBatchCompleted, payload: []byte(`{"id":"evt-1"}`)})
+	if attempts != 3 {
+		t.Errorf("expected the first attempt and 2 retries, got %d attempts", attempts)
+	}
+	if store.count() != 1 {
+		t.Fatalf("expected 1 dead letter, got %d", store.count())
+	}
+	deadLetter := store.deadLetters["1"]
+	if deadLetter.Attempts != 3 || deadLetter.LastStatusCode != http.StatusBadGateway || deadLetter.EventID != "evt-1" {
+		t.Errorf("unexpected dead letter %+v", deadLetter)
+	}
+
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+	if err := d.Redeliver(context.Background(), "1"); err != nil {
+		t.Fatalf("Redeliver() error = %v", err)
+	}
+	if store.count() != 0 {
+		t.Errorf("expected the redelivered dead letter to be deleted")
+	}
+}
+
+func TestDispatcherDoesNotRetryClientErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	store := &fakeDeadLetterStore{deadLetters: map[string]*tables.TableWebhookDeadLetter{}}
+	d := newTestDispatcher(t, Config{Enabled: true, Endpoints: []EndpointConfig{{Name: "ops", URL: server.URL}}}, store)
+	d.deliver(&delivery{endpoint: &d.config.Endpoints[0], eventID: "evt-1", eventType: EventOAuthTokenExpiring, payload: []byte(`{}`)})
+	if attempts != 1 || store.count() != 1 {
+		t.Errorf("expected a single attempt and a dead letter, got %d attempts and %d dead letters", attempts, store.count())
+	}
+}
+
+func TestDispatcherDeduplicatesEvents(t *testing.T) {
+	d := newTestDispatcher(t, Config{Enabled: true, Endpoints: []EndpointConfig{{Name: "ops", URL: "https://hooks.example.com"}}}, nil)
+
+	// A failed probe reopening the circuit is not reported again
+	d.ObserveCircuitBreaker(schemas.CircuitBreakerEvent{Provider: schemas.Anthropic, Model: "claude", Open: true})
+	d.ObserveCircuitBreaker(schemas.CircuitBreakerEvent{Provider: schemas.Anthropic, Model: "claude", Open: true})
+	d.ObserveCircuitBreaker(schemas.CircuitBreakerEvent{Provider: schemas.Anthropic, Model: "claude", Open: false})
+	d.ObserveCircuitBreaker(schemas.CircuitBreakerEvent{Provider: schemas.Anthropic, Model: "claude", Open: true})
+	if len(d.queue) != 2 {
+		t.Errorf("expected 2 circuit opened events, got %d", len(d.queue))
+	}
+
+	batch := &schemas.BifrostBatchRetrieveResponse{ID: "batch-1", Status: schemas.BatchStatusInProgress}
+	d.ObserveBatch(batch)
+	batch.Status = schemas.BatchStatusCompleted
+	d.ObserveBatch(batch)
+	d.ObserveBatch(batch)
+	if len(d.queue) != 3 {
+		t.Errorf("expected a single batch completed event, got %d events", len(d.queue)-2)
+	}
+}
+
+func TestDispatcherPollsPendingBatches(t *testing.T) {
+	d := newTestDispatcher(t, Config{Enabled: true, Endpoints: []EndpointConfig{{Name: "ops", URL: "https://hooks.example.com"}}}, nil)
+
+	status := schemas.BatchStatusInProgress
+	var polls []string
+	retrieve := func(ctx context.Context, provider schemas.ModelProvider, batchID string) (*schemas.BifrostBatchRetrieveResponse, error) {
+		polls = append(polls, string(provider)+"/"+batchID)
+		return &schemas.BifrostBatchRetrieveResponse{ID: batchID, Status: status}, nil
+	}
+
+	created := &schemas.BifrostBatchCreateResponse{ID: "batch-1", Status: schemas.BatchStatusValidating}
+	created.ExtraFields.Provider = schemas.OpenAI
+	d.ObserveBatchCreated(created)
+
+	// Still running: polled again next time, nothing sent
+	d.pollPendingBatches(retrieve)
+	if len(d.queue) != 0 {
+		t.Fatalf("expected no event for a running batch, got %d", len(d.queue))
+	}
+
+	status = schemas.BatchStatusCompleted
+	d.pollPendingBatches(retrieve)
+	if len(d.queue) != 1 {
+		t.Fatalf("expected a batch completed event, got %d events", len(d.queue))
+	}
+	var event Event
+	if err := json.Unmarshal((<-d.queue).payload, &event); err != nil {
+		t.Fatalf("failed to decode event: %v", err)
+	}
+	if data := event.Data.(map[string]any); event.Type != EventBatchCompleted || data["provider"] != "openai" || data["status"] != "completed" {
+		t.Errorf("unexpected event: %+v", event)
+	}
+
+	// A finished batch is no longer polled
+	d.pollPendingBatches(retrieve)
+	if len(polls) != 2 || polls[0] != "openai/batch-1" {
+		t.Errorf("polls = %v, want openai/batch-1 twice", polls)
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  Config
+		wantErr bool
+	}{
+		{"valid", Config{Endpoints: []EndpointConfig{{Name: "ops", URL: "https://hooks.example.com", Events: []EventType{EventBudgetExceeded}}}}, false},
+		{"missing name", Config{Endpoints: []EndpointConfig{{URL: "https://hooks.example.com"}}}, true},
+		{"duplicate name", Config{Endpoints: []EndpointConfig{{Name: "ops", URL: "https://a.example.com"}, {Name: "ops", URL: "https://b.example.com"}}}, true},
+		{"invalid url", Config{Endpoints: []EndpointConfig{{Name: "ops", URL: "hooks.example.com"}}}, true},
+		{"unknown event", Config{Endpoints: []EndpointConfig{{Name: "ops", URL: "https://hooks.example.com", Events: []EventType{"key.created"}}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -775,6 +775,12 @@ false
 {{- if $inputConfig.metrics_push_interval }}
 {{- $_ := set $otelConfig "metrics_push_interval" $inputConfig.metrics_push_interval }}
 {{- end }}
+{{- if hasKey $inputConfig "disable_content" }}
+{{- $_ := set $otelConfig "disable_content" $inputConfig.disable_content }}
+{{- end }}
+{{- if $inputConfig.exporters }}
+{{- $_ := set $otelConfig "exporters" $inputConfig.exporters }}
+{{- end }}
 {{- $plugins = append $plugins (dict "enabled" true "name" "otel" "config" $otelConfig) }}
 {{- end }}
 {{- if .Values.bifrost.plugins.datadog.enabled }}
@@ -851,9 +857,9 @@ Call this template at the beginning of deployment/stateful templates
 {{- end }}
 
 {{/* Validate OTEL plugin when enabled */}}
-{{- if .Values.bifrost.plugins.otel.enabled }}
+{{- if and .Values.bifrost.plugins.otel.enabled (not .Values.bifrost.plugins.otel.config.exporters) }}
 {{- if not .Values.bifrost.plugins.otel.config.collector_url }}
-{{- fail "ERROR: bifrost.plugins.otel.config.collector_url is required when OTEL plugin is enabled. Provide the URL of your OpenTelemetry collector." }}
+{{- fail "ERROR: bifrost.plugins.otel.config.collector_url is required when OTEL plugin is enabled without exporters. Provide the URL of your OpenTelemetry collector." }}
 {{- end }}
 {{- if not .Values.bifrost.plugins.otel.config.trace_type }}
 {{- fail "ERROR: bifrost.plugins.otel.config.trace_type is required when OTEL plugin is enabled. Supported value: otel" }}
@@ -0,0 +1,122 @@
+// Package governance provides budget downgrades for the governance plugin
+package governance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
+)
+
+// BudgetExceededError is returned by the budget checks when a budget is exceeded. DowngradeModel is
+// set when the budget sends requests over it to a cheaper model instead of rejecting them.
+type BudgetExceededError struct {
+	Message           string
+	DowngradeProvider schemas.ModelProvider // Provider of the downgrade model (empty keeps the requested provider)
+	DowngradeModel    string
+}
+
+// Error returns the message of the exceeded budget.
+func (e *BudgetExceededError) Error() string {
+	return e.Message
+}
+
+// budgetExceeded returns the error for an exceeded budget, or nil when the request is already for
+// the budget's downgrade model, which may keep spending past the budget.
+func budgetExceeded(budget *configstoreTables.TableBudget, request *EvaluationRequest, message string) error {
+	var requestedProvider schemas.ModelProvider
+	var requestedModel string
+	if request != nil {
+		requestedProvider = request.Provider
+		requestedModel = request.Model
+	}
+	err := &BudgetExceededError{Message: message}
+	if provider, model, ok := budget.DowngradeTarget(requestedProvider); ok {
+		if provider == requestedProvider && model == requestedModel {
+			return nil
+		}
+		err.DowngradeProvider = provider
+		err.DowngradeModel = model
+	}
+	return err
+}
+
+// budgetExceededResult turns a failed budget check into a downgrade when the exceeded budget has a
+// downgrade model, or a rejection otherwise.
+func budgetExceededResult(err error, reason string, vk *configstoreTables.TableVirtualKey) *EvaluationResult {
+	var exceeded *BudgetExceededError
+	if errors.As(err, &exceeded) && exceeded.DowngradeModel != "" {
+		return &EvaluationResult{
+			Decision:          DecisionBudgetDowngrade,
+			Reason:            fmt.Sprintf("%s, downgrading to %s", reason, downgradeModelString(exceeded.DowngradeProvider, exceeded.DowngradeModel)),
+			VirtualKey:        vk,
+			DowngradeProvider: exceeded.DowngradeProvider,
+			DowngradeModel:    exceeded.DowngradeModel,
+		}
+	}
+	return &EvaluationResult{
+		Decision:   DecisionBudgetExceeded,
+		Reason:     reason,
+		VirtualKey: vk,
+	}
+}
+
+// downgradeModelString formats a downgrade target as "provider/model", or "model" without a provider.
+func downgradeModelString(provider schemas.ModelProvider, model string) string {
+	if provider == "" {
+		return model
+	}
+	return string(provider) + "/" + model
+}
+
+// budgetDowngrade checks the provider, model and virtual key budgets of a request and returns the
+// model to downgrade it to, if the first exceeded budget has one.
+func (r *BudgetResolver) budgetDowngrade(ctx context.Context, vk *configstoreTables.TableVirtualKey, provider schemas.ModelProvider, model string) (schemas.ModelProvider, string, bool) {
+	request := &EvaluationRequest{Provider: provider, Model: model}
+	err := r.store.CheckProviderBudget(ctx, request, nil)
+	if err == nil {
+		err = r.store.CheckModelBudget(ctx, request, nil)
+	}
+	if err == nil && vk != nil {
+		err = r.store.CheckBudget(ctx, vk, request, nil)
+	}
+	var exceeded *BudgetExceededError
+	if !errors.As(err, &exceeded) || exceeded.DowngradeModel == "" {
+		return "", "", false
+	}
+	if exceeded.DowngradeProvider == "" {
+		return provider, exceeded.DowngradeModel, true
+	}
+	return exceeded.DowngradeProvider, exceeded.DowngradeModel, true
+}
+
+// applyBudgetDowngrade rewrites the model of a request body to the downgrade model of an exceeded
+// budget. Downgrading here, before the request reaches a provider queue, lets the downgrade model
+// be on another provider.
+func (p *GovernancePlugin) applyBudgetDowngrade(ctx *schemas.BifrostContext, body map[string]any, virtualKey *configstoreTables.TableVirtualKey) bool {
+	modelStr, ok := body["model"].(string)
+	if !ok || modelStr == "" {
+		return false
+	}
+	provider, model := schemas.ParseModelString(modelStr, "")
+	if bifrost.GetStringFromContext(ctx, schemas.BifrostContextKeyGovernanceUserID) != "" {
+		// With user auth, virtual key budgets are not enforced
+		virtualKey = nil
+	}
+	downgradeProvider, downgradeModel, ok := p.resolver.budgetDowngrade(ctx, virtualKey, provider, model)
+	if !ok {
+		return false
+	}
+	downgraded := downgradeModelString(downgradeProvider, downgradeModel)
+	if strings.EqualFold(downgraded, modelStr) {
+		return false
+	}
+	body["model"] = downgraded
+	ctx.AppendRoutingEngineLog(schemas.RoutingEngineGovernance, fmt.Sprintf("Budget exceeded for model %s, downgraded to %s", modelStr, downgraded))
+	schemas.AppendToContextList(ctx, schemas.BifrostContextKeyRoutingEnginesUsed, schemas.RoutingEngineGovernance)
+	return true
+}
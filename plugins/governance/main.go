@@ -22,6 +22,7 @@ import (
 	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
 	"github.com/capsohq/bifrost/framework/mcpcatalog"
 	"github.com/capsohq/bifrost/framework/modelcatalog"
+	"github.com/capsohq/bifrost/framework/ratelimit"
 	"github.com/google/uuid"
 )
 
@@ -38,9 +39,12 @@ const (
 
 // Config is the configuration for the governance plugin
 type Config struct {
-	IsVkMandatory   *bool     `json:"is_vk_mandatory"`
-	RequiredHeaders *[]string `json:"required_headers"` // Pointer to live config slice; changes are reflected immediately without restart
-	IsEnterprise    bool      `json:"is_enterprise"`
+	IsVkMandatory   *bool                 `json:"is_vk_mandatory"`
+	RequiredHeaders *[]string             `json:"required_headers"` // Pointer to live config slice; changes are reflected immediately without restart
+	IsEnterprise    bool                  `json:"is_enterprise"`
+	RateLimiter     ratelimit.RateLimiter `json:"-"` // Shared token buckets enforcing rate limits across replicas (optional)
+	// OnBudgetExceeded is called when usage takes a budget to its limit, once per window (optional)
+	OnBudgetExceeded func(budget configstoreTables.TableBudget) `json:"-"`
 }
 
 type InMemoryStore interface {
@@ -159,6 +163,12 @@ func Init(
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize governance store: %w", err)
 	}
+	if config != nil && config.RateLimiter != nil {
+		governanceStore.SetRateLimiter(config.RateLimiter)
+	}
+	if config != nil && config.OnBudgetExceeded != nil {
+		governanceStore.SetBudgetExceededObserver(config.OnBudgetExceeded)
+	}
 	// Initialize components in dependency order with fixed, optimal settings
 	// Resolver (pure decision engine for hierarchical governance, depends only on store)
 	resolver := NewBudgetResolver(governanceStore, modelCatalog, logger)
@@ -389,7 +399,9 @@ func (p *GovernancePlugin) HTTPTransportPreHook(ctx *schemas.BifrostContext, req
 		if err != nil {
 			return nil, err
 		}
-		//3. Add MCP tools
+		//3. Downgrade the model if the virtual key or its provider or model is over a budget
+		p.applyBudgetDowngrade(ctx, payload, virtualKey)
+		//4. Add MCP tools
 		headers, err := p.addMCPIncludeTools(nil, virtualKey)
 		if err != nil {
 			p.logger.Error("failed to add MCP include tools: %v", err)
@@ -472,6 +484,11 @@ func (p *GovernancePlugin) loadBalanceProvider(ctx *schemas.BifrostContext, req
 			}
 		}
 	}
+	// Requests pinned to a provider (x-bf-provider) are not load balanced, the pre-hook checks that the virtual key allows the provider
+	if pinnedProvider := strings.TrimSpace(req.CaseInsensitiveHeaderLookup("x-bf-provider")); pinnedProvider != "" {
+		ctx.AppendRoutingEngineLog(schemas.RoutingEngineGovernance, fmt.Sprintf("Request pinned to provider %s, skipping load balancing", pinnedProvider))
+		return body, nil
+	}
 	// Check if model already has provider prefix (contains "/")
 	if strings.Contains(modelStr, "/") {
 		provider, _ := schemas.ParseModelString(modelStr, "")
@@ -893,8 +910,13 @@ func (p *GovernancePlugin) evaluateGovernanceRequest(ctx *schemas.BifrostContext
 		}
 	}
 
+	// MCP tool executions have no model to downgrade
+	if result.Decision == DecisionBudgetDowngrade && requestType == schemas.MCPToolExecutionRequest {
+		result.Decision = DecisionBudgetExceeded
+	}
+
 	// Mark request as rejected in context if not allowed
-	if result.Decision != DecisionAllow {
+	if result.Decision != DecisionAllow && result.Decision != DecisionBudgetDowngrade {
 		if ctx != nil {
 			if _, ok := ctx.Value(governanceRejectedContextKey).(bool); !ok {
 				ctx.SetValue(governanceRejectedContextKey, true)
@@ -904,7 +926,7 @@ func (p *GovernancePlugin) evaluateGovernanceRequest(ctx *schemas.BifrostContext
 
 	// Handle decision
 	switch result.Decision {
-	case DecisionAllow:
+	case DecisionAllow, DecisionBudgetDowngrade:
 		return result, nil
 
 	case DecisionVirtualKeyNotFound, DecisionVirtualKeyBlocked, DecisionModelBlocked, DecisionProviderBlocked:
@@ -977,7 +999,10 @@ func (p *GovernancePlugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.
 		UserID:     userID,
 	}
 	// Evaluate governance using common function
-	_, bifrostError := p.evaluateGovernanceRequest(ctx, evaluationRequest, req.RequestType)
+	result, bifrostError := p.evaluateGovernanceRequest(ctx, evaluationRequest, req.RequestType)
+	if bifrostError == nil && result.Decision == DecisionBudgetDowngrade {
+		result, bifrostError = p.downgradeRequest(ctx, req, evaluationRequest, result)
+	}
 	// Convert BifrostError to LLMPluginShortCircuit if needed
 	if bifrostError != nil {
 		return req, &schemas.LLMPluginShortCircuit{
@@ -988,6 +1013,38 @@ func (p *GovernancePlugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.
 	return req, nil, nil
 }
 
+// downgradeRequest switches a request to the downgrade model of its exceeded budget and evaluates
+// it again. The provider queue is already chosen at this point, so a downgrade model on another
+// provider (one not applied by HTTPTransportPreHook) rejects the request instead.
+func (p *GovernancePlugin) downgradeRequest(ctx *schemas.BifrostContext, req *schemas.BifrostRequest, evaluationRequest *EvaluationRequest, result *EvaluationResult) (*EvaluationResult, *schemas.BifrostError) {
+	if result.DowngradeProvider != "" && result.DowngradeProvider != evaluationRequest.Provider {
+		return result, p.budgetExceededError(ctx, result.Reason)
+	}
+	ctx.AppendRoutingEngineLog(schemas.RoutingEngineGovernance, fmt.Sprintf("Budget exceeded for model %s, downgraded to %s", evaluationRequest.Model, result.DowngradeModel))
+	schemas.AppendToContextList(ctx, schemas.BifrostContextKeyRoutingEnginesUsed, schemas.RoutingEngineGovernance)
+	req.SetModel(result.DowngradeModel)
+	evaluationRequest.Model = result.DowngradeModel
+
+	result, bifrostError := p.evaluateGovernanceRequest(ctx, evaluationRequest, req.RequestType)
+	if bifrostError == nil && result.Decision == DecisionBudgetDowngrade {
+		// The downgrade model is over another budget too; downgrades are not chained
+		return result, p.budgetExceededError(ctx, result.Reason)
+	}
+	return result, bifrostError
+}
+
+// budgetExceededError marks the request as rejected and returns the 402 error of an exceeded budget.
+func (p *GovernancePlugin) budgetExceededError(ctx *schemas.BifrostContext, reason string) *schemas.BifrostError {
+	ctx.SetValue(governanceRejectedContextKey, true)
+	return &schemas.BifrostError{
+		Type:       bifrost.Ptr(string(DecisionBudgetExceeded)),
+		StatusCode: bifrost.Ptr(402),
+		Error: &schemas.ErrorField{
+			Message: reason,
+		},
+	}
+}
+
 // PostLLMHook processes the response and updates usage tracking (business logic execution)
 // Parameters:
 //   - ctx: The Bifrost context
@@ -1032,6 +1089,7 @@ func (p *GovernancePlugin) PostLLMHook(ctx *schemas.BifrostContext, result *sche
 	}
 
 	isFinalChunk := bifrost.IsFinalChunk(ctx)
+	tags := schemas.GetRequestTags(ctx)
 
 	// Always process usage tracking (with or without virtual key)
 	// When user auth is present, skip VK usage tracking to avoid double-counting
@@ -1045,7 +1103,7 @@ func (p *GovernancePlugin) PostLLMHook(ctx *schemas.BifrostContext, result *sche
 		p.wg.Add(1)
 		go func() {
 			defer p.wg.Done()
-			p.postHookWorker(result, provider, model, requestType, effectiveVK, requestID, userID, isCacheRead, isBatch, isFinalChunk)
+			p.postHookWorker(result, provider, model, requestType, effectiveVK, requestID, userID, tags, isCacheRead, isBatch, isFinalChunk)
 		}()
 	}
 
@@ -1194,10 +1252,11 @@ func (p *GovernancePlugin) Cleanup() error {
 //   - virtualKey: The virtual key of the request (empty string if not present)
 //   - requestID: The request ID
 //   - userID: The user ID for enterprise user-level governance (empty string if not present)
+//   - tags: The request tags the usage is attributed to
 //   - isCacheRead: Whether the request is a cache read
 //   - isBatch: Whether the request is a batch request
 //   - isFinalChunk: Whether the request is the final chunk
-func (p *GovernancePlugin) postHookWorker(result *schemas.BifrostResponse, provider schemas.ModelProvider, model string, requestType schemas.RequestType, virtualKey, requestID, userID string, _, _, isFinalChunk bool) {
+func (p *GovernancePlugin) postHookWorker(result *schemas.BifrostResponse, provider schemas.ModelProvider, model string, requestType schemas.RequestType, virtualKey, requestID, userID string, tags map[string]string, _, _, isFinalChunk bool) {
 	// Determine if request was successful
 	success := (result != nil)
 
@@ -1222,6 +1281,8 @@ func (p *GovernancePlugin) postHookWorker(result *schemas.BifrostResponse, provi
 				tokensUsed = result.ResponsesStreamResponse.Response.Usage.TotalTokens
 			case result.EmbeddingResponse != nil && result.EmbeddingResponse.Usage != nil:
 				tokensUsed = result.EmbeddingResponse.Usage.TotalTokens
+			case result.RealtimeResponse != nil && result.RealtimeResponse.Usage != nil:
+				tokensUsed = result.RealtimeResponse.Usage.TotalTokens
 			case result.SpeechResponse != nil && result.SpeechResponse.Usage != nil:
 				tokensUsed = result.SpeechResponse.Usage.TotalTokens
 			case result.SpeechStreamResponse != nil && result.SpeechStreamResponse.Usage != nil:
@@ -1242,6 +1303,7 @@ func (p *GovernancePlugin) postHookWorker(result *schemas.BifrostResponse, provi
 			Cost:         cost,
 			RequestID:    requestID,
 			UserID:       userID,
+			Tags:         tags,
 			IsStreaming:  isStreaming,
 			IsFinalChunk: isFinalChunk,
 			HasUsageData: tokensUsed > 0,
@@ -1258,6 +1320,11 @@ func (p *GovernancePlugin) GetGovernanceStore() GovernanceStore {
 	return p.store
 }
 
+// GetTagUsage returns the usage attributed to each request tag since startup, keyed by "key=value"
+func (p *GovernancePlugin) GetTagUsage() map[string]TagUsage {
+	return p.tracker.GetTagUsage()
+}
+
 // GenerateVirtualKey is a helper function
 func GenerateVirtualKey() string {
 	return VirtualKeyPrefix + uuid.NewString()
@@ -0,0 +1,86 @@
+// Package governance provides cluster-wide rate limit enforcement for the governance store
+package governance
+
+import (
+	"context"
+	"math"
+
+	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/capsohq/bifrost/framework/ratelimit"
+)
+
+// SetRateLimiter makes the store check rate limits against token buckets shared by all Bifrost
+// replicas instead of the usage counters of this process. The counters keep tracking local usage
+// and are still used whenever the rate limiter cannot be reached.
+func (gs *LocalGovernanceStore) SetRateLimiter(limiter ratelimit.RateLimiter) {
+	gs.rateLimiter = limiter
+}
+
+// rateLimitBucketKeys returns the keys of the shared token and request buckets of a rate limit.
+func rateLimitBucketKeys(rateLimit *configstoreTables.TableRateLimit) (tokens, requests string) {
+	return rateLimit.ID + ":tokens", rateLimit.ID + ":requests"
+}
+
+// sharedRateLimitUsage returns the cluster-wide token and request usage of a rate limit: its limits
+// minus the whole tokens left in its shared buckets. It returns false when no rate limiter is set
+// or it could not be reached, in which case the usage counters of this process apply.
+func (gs *LocalGovernanceStore) sharedRateLimitUsage(ctx context.Context, rateLimit *configstoreTables.TableRateLimit) (tokensUsage, requestsUsage int64, ok bool) {
+	if gs.rateLimiter == nil {
+		return 0, 0, false
+	}
+	tokensKey, requestsKey := rateLimitBucketKeys(rateLimit)
+	tokensUsage, ok = gs.sharedBucketUsage(ctx, tokensKey, rateLimit.TokenMaxLimit, rateLimit.TokenResetDuration)
+	if !ok {
+		return 0, 0, false
+	}
+	requestsUsage, ok = gs.sharedBucketUsage(ctx, requestsKey, rateLimit.RequestMaxLimit, rateLimit.RequestResetDuration)
+	if !ok {
+		return 0, 0, false
+	}
+	return tokensUsage, requestsUsage, true
+}
+
+// sharedBucketUsage returns the usage of one shared bucket, or 0 if the limit is not configured.
+func (gs *LocalGovernanceStore) sharedBucketUsage(ctx context.Context, key string, maxLimit *int64, resetDuration *string) (int64, bool) {
+	if maxLimit == nil || resetDuration == nil {
+		return 0, true
+	}
+	window, err := configstoreTables.ParseDuration(*resetDuration)
+	if err != nil {
+		return 0, true
+	}
+	available, err := gs.rateLimiter.Available(ctx, key, *maxLimit, window)
+	if err != nil {
+		gs.logger.Warn("shared rate limiter unavailable, using local usage: %v", err)
+		return 0, false
+	}
+	return *maxLimit - int64(math.Floor(available)), true
+}
+
+// consumeSharedRateLimit takes the tokens and the request of a finished request from the shared
+// buckets of a rate limit.
+func (gs *LocalGovernanceStore) consumeSharedRateLimit(ctx context.Context, rateLimit *configstoreTables.TableRateLimit, tokensUsed int64, shouldUpdateTokens bool, shouldUpdateRequests bool) {
+	if gs.rateLimiter == nil {
+		return
+	}
+	tokensKey, requestsKey := rateLimitBucketKeys(rateLimit)
+	if shouldUpdateTokens {
+		gs.consumeSharedBucket(ctx, tokensKey, rateLimit.TokenMaxLimit, rateLimit.TokenResetDuration, tokensUsed)
+	}
+	if shouldUpdateRequests {
+		gs.consumeSharedBucket(ctx, requestsKey, rateLimit.RequestMaxLimit, rateLimit.RequestResetDuration, 1)
+	}
+}
+
+func (gs *LocalGovernanceStore) consumeSharedBucket(ctx context.Context, key string, maxLimit *int64, resetDuration *string, n int64) {
+	if maxLimit == nil || resetDuration == nil || n <= 0 {
+		return
+	}
+	window, err := configstoreTables.ParseDuration(*resetDuration)
+	if err != nil {
+		return
+	}
+	if err := gs.rateLimiter.Consume(ctx, key, *maxLimit, window, n); err != nil {
+		gs.logger.Warn("failed to update shared rate limit bucket %s: %v", key, err)
+	}
+}
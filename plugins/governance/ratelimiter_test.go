@@ -0,0 +1,83 @@
+package governance
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRateLimiter is a shared rate limiter whose buckets never refill
+type fakeRateLimiter struct {
+	mu       sync.Mutex
+	consumed map[string]int64
+	err      error
+}
+
+func (f *fakeRateLimiter) Available(ctx context.Context, key string, limit int64, window time.Duration) (float64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return 0, f.err
+	}
+	return float64(limit - f.consumed[key]), nil
+}
+
+func (f *fakeRateLimiter) Consume(ctx context.Context, key string, limit int64, window time.Duration, n int64) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return f.err
+	}
+	f.consumed[key] += n
+	return nil
+}
+
+func (f *fakeRateLimiter) Close() error { return nil }
+
+// TestGovernanceStore_SharedRateLimiter tests that rate limits are checked against the shared
+// buckets, which hold the usage of every replica
+func TestGovernanceStore_SharedRateLimiter(t *testing.T) {
+	logger := NewMockLogger()
+
+	rateLimit := buildRateLimit("rl1", 1000, 3)
+	vk := buildVirtualKeyWithRateLimit("vk1", "sk-bf-test", "Test VK", rateLimit)
+
+	store, err := NewLocalGovernanceStore(context.Background(), logger, nil, &configstore.GovernanceConfig{
+		VirtualKeys: []configstoreTables.TableVirtualKey{*vk},
+		RateLimits:  []configstoreTables.TableRateLimit{*rateLimit},
+	}, nil)
+	require.NoError(t, err)
+	limiter := &fakeRateLimiter{consumed: map[string]int64{}}
+	store.SetRateLimiter(limiter)
+
+	request := &EvaluationRequest{Provider: schemas.OpenAI}
+	decision, err := store.CheckRateLimit(context.Background(), vk, request, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DecisionAllow, decision)
+
+	// Usage of this replica is consumed from the shared buckets
+	err = store.UpdateVirtualKeyRateLimitUsageInMemory(context.Background(), vk, schemas.OpenAI, 400, true, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(400), limiter.consumed["rl1:tokens"])
+	assert.Equal(t, int64(1), limiter.consumed["rl1:requests"])
+
+	// Requests made through other replicas exhaust the request bucket
+	limiter.consumed["rl1:requests"] = 3
+	decision, err = store.CheckRateLimit(context.Background(), vk, request, nil, nil)
+	assert.Error(t, err)
+	assert.Equal(t, DecisionRequestLimited, decision)
+
+	// When the shared rate limiter is unavailable, the local usage applies
+	limiter.err = errors.New("connection refused")
+	decision, err = store.CheckRateLimit(context.Background(), vk, request, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, DecisionAllow, decision)
+}
@@ -20,6 +20,7 @@ const (
 	DecisionVirtualKeyBlocked  Decision = "virtual_key_blocked"
 	DecisionRateLimited        Decision = "rate_limited"
 	DecisionBudgetExceeded     Decision = "budget_exceeded"
+	DecisionBudgetDowngrade    Decision = "budget_downgrade" // A budget is exceeded and its requests go to its downgrade model
 	DecisionTokenLimited       Decision = "token_limited"
 	DecisionRequestLimited     Decision = "request_limited"
 	DecisionModelBlocked       Decision = "model_blocked"
@@ -42,6 +43,10 @@ type EvaluationResult struct {
 	RateLimitInfo *configstoreTables.TableRateLimit  `json:"rate_limit_info,omitempty"`
 	BudgetInfo    []*configstoreTables.TableBudget   `json:"budget_info,omitempty"` // All budgets in hierarchy
 	UsageInfo     *UsageInfo                         `json:"usage_info,omitempty"`
+
+	// Downgrade target of an exceeded budget (DecisionBudgetDowngrade only)
+	DowngradeProvider schemas.ModelProvider `json:"downgrade_provider,omitempty"`
+	DowngradeModel    string                `json:"downgrade_model,omitempty"`
 }
 
 // UsageInfo represents current usage levels for rate limits and budgets
@@ -94,10 +99,7 @@ func (r *BudgetResolver) EvaluateModelAndProviderRequest(ctx *schemas.BifrostCon
 		}
 		// 2. Check provider-level budgets FIRST (before model-level checks)
 		if err := r.store.CheckProviderBudget(ctx, request, nil); err != nil {
-			return &EvaluationResult{
-				Decision: DecisionBudgetExceeded,
-				Reason:   fmt.Sprintf("Provider-level budget exceeded: %s", err.Error()),
-			}
+			return budgetExceededResult(err, fmt.Sprintf("Provider-level budget exceeded: %s", err.Error()), nil)
 		}
 	}
 	// 3. Check model-level rate limits (after provider-level checks)
@@ -111,10 +113,7 @@ func (r *BudgetResolver) EvaluateModelAndProviderRequest(ctx *schemas.BifrostCon
 
 		// 4. Check model-level budgets (after provider-level checks)
 		if err := r.store.CheckModelBudget(ctx, request, nil); err != nil {
-			return &EvaluationResult{
-				Decision: DecisionBudgetExceeded,
-				Reason:   fmt.Sprintf("Model-level budget exceeded: %s", err.Error()),
-			}
+			return budgetExceededResult(err, fmt.Sprintf("Model-level budget exceeded: %s", err.Error()), nil)
 		}
 	}
 	// All provider-level and model-level checks passed
@@ -146,10 +145,7 @@ func (r *BudgetResolver) EvaluateUserRequest(ctx *schemas.BifrostContext, userID
 
 	// Check user-level budget
 	if err := r.store.CheckUserBudget(ctx, userID, request, nil); err != nil {
-		return &EvaluationResult{
-			Decision: DecisionBudgetExceeded,
-			Reason:   fmt.Sprintf("User-level budget exceeded: %s", err.Error()),
-		}
+		return budgetExceededResult(err, fmt.Sprintf("User-level budget exceeded: %s", err.Error()), nil)
 	}
 
 	return &EvaluationResult{
@@ -161,9 +157,9 @@ func (r *BudgetResolver) EvaluateUserRequest(ctx *schemas.BifrostContext, userID
 // isModelRequired checks if the requested model is required for this request
 func (r *BudgetResolver) isModelRequired(requestType schemas.RequestType) bool {
 	// Here we will have to check for some requests which do not need model
-	// For example, batches, container, files requests
+	// For example, batches, container, files, fine-tuning job and vector store requests
 	// For these requests, we will only check for provider filtering
-	if requestType == schemas.ListModelsRequest || requestType == schemas.MCPToolExecutionRequest || requestType == schemas.BatchCreateRequest || requestType == schemas.BatchListRequest || requestType == schemas.BatchRetrieveRequest || requestType == schemas.BatchCancelRequest || requestType == schemas.BatchResultsRequest || requestType == schemas.FileUploadRequest || requestType == schemas.FileListRequest || requestType == schemas.FileRetrieveRequest || requestType == schemas.FileDeleteRequest || requestType == schemas.FileContentRequest || requestType == schemas.ContainerCreateRequest || requestType == schemas.ContainerListRequest || requestType == schemas.ContainerRetrieveRequest || requestType == schemas.ContainerDeleteRequest || requestType == schemas.ContainerFileCreateRequest || requestType == schemas.ContainerFileListRequest || requestType == schemas.ContainerFileRetrieveRequest || requestType == schemas.ContainerFileContentRequest || requestType == schemas.ContainerFileDeleteRequest {
+	if requestType == schemas.ListModelsRequest || requestType == schemas.MCPToolExecutionRequest || requestType == schemas.BatchCreateRequest || requestType == schemas.BatchListRequest || requestType == schemas.BatchRetrieveRequest || requestType == schemas.BatchCancelRequest || requestType == schemas.BatchResultsRequest || requestType == schemas.FileUploadRequest || requestType == schemas.FileListRequest || requestType == schemas.FileRetrieveRequest || requestType == schemas.FileDeleteRequest || requestType == schemas.FileContentRequest || requestType == schemas.ContainerCreateRequest || requestType == schemas.ContainerListRequest || requestType == schemas.ContainerRetrieveRequest || requestType == schemas.ContainerDeleteRequest || requestType == schemas.ContainerFileCreateRequest || requestType == schemas.ContainerFileListRequest || requestType == schemas.ContainerFileRetrieveRequest || requestType == schemas.ContainerFileContentRequest || requestType == schemas.ContainerFileDeleteRequest || requestType == schemas.FineTuningJobListRequest || requestType == schemas.FineTuningJobRetrieveRequest || requestType == schemas.FineTuningJobCancelRequest || requestType == schemas.FineTuningJobEventsRequest || requestType == schemas.FineTuningJobCheckpointsRequest || requestType == schemas.VectorStoreCreateRequest || requestType == schemas.VectorStoreListRequest || requestType == schemas.VectorStoreSearchRequest || requestType == schemas.VectorStoreFileCreateRequest || requestType == schemas.VectorStoreFileListRequest {
 		return false
 	}
 	return true
@@ -395,11 +391,7 @@ func (r *BudgetResolver) checkBudgetHierarchy(ctx context.Context, vk *configsto
 	if err := r.store.CheckBudget(ctx, vk, request, nil); err != nil {
 		r.logger.Debug(fmt.Sprintf("Atomic budget exceeded for VK %s: %s", vk.ID, err.Error()))
 
-		return &EvaluationResult{
-			Decision:   DecisionBudgetExceeded,
-			Reason:     fmt.Sprintf("Budget exceeded: %s", err.Error()),
-			VirtualKey: vk,
-		}
+		return budgetExceededResult(err, fmt.Sprintf("Budget exceeded: %s", err.Error()), vk)
 	}
 
 	return nil // No budget violations
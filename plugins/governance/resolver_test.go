@@ -253,6 +253,73 @@ func TestBudgetResolver_EvaluateRequest_BudgetExpired(t *testing.T) {
 	assertDecision(t, DecisionAllow, result)
 }
 
+// TestBudgetResolver_EvaluateRequest_BudgetDowngrade tests downgrading past an exceeded budget
+func TestBudgetResolver_EvaluateRequest_BudgetDowngrade(t *testing.T) {
+	logger := NewMockLogger()
+
+	budget := buildBudgetWithUsage("budget1", 100.0, 100.0, "1d") // At limit
+	budget.DowngradeModel = bifrost.Ptr("gpt-4o-mini")
+	vk := buildVirtualKeyWithBudget("vk1", "sk-bf-test", "Test VK", budget)
+
+	store, err := NewLocalGovernanceStore(context.Background(), logger, nil, &configstore.GovernanceConfig{
+		VirtualKeys: []configstoreTables.TableVirtualKey{*vk},
+		Budgets:     []configstoreTables.TableBudget{*budget},
+	}, nil)
+	require.NoError(t, err)
+
+	resolver := NewBudgetResolver(store, nil, logger)
+	ctx := &schemas.BifrostContext{}
+
+	result := resolver.EvaluateVirtualKeyRequest(ctx, "sk-bf-test", schemas.OpenAI, "gpt-4", schemas.ChatCompletionRequest)
+	assertDecision(t, DecisionBudgetDowngrade, result)
+	assert.Equal(t, schemas.OpenAI, result.DowngradeProvider)
+	assert.Equal(t, "gpt-4o-mini", result.DowngradeModel)
+
+	// The downgrade model may keep spending past the budget
+	result = resolver.EvaluateVirtualKeyRequest(ctx, "sk-bf-test", schemas.OpenAI, "gpt-4o-mini", schemas.ChatCompletionRequest)
+	assertDecision(t, DecisionAllow, result)
+}
+
+// TestBudgetResolver_EvaluateRequest_SlidingWindowBudget tests that a sliding window budget still
+// counts the previous window after a reset
+func TestBudgetResolver_EvaluateRequest_SlidingWindowBudget(t *testing.T) {
+	logger := NewMockLogger()
+
+	budget := &configstoreTables.TableBudget{
+		ID:            "budget1",
+		MaxLimit:      100.0,
+		CurrentUsage:  10.0,
+		PreviousUsage: 160.0, // Weighted by the 3/4 of the previous window still in the sliding window
+		ResetDuration: "1d",
+		SlidingWindow: true,
+		LastReset:     time.Now().Add(-6 * time.Hour),
+	}
+	vk := buildVirtualKeyWithBudget("vk1", "sk-bf-test", "Test VK", budget)
+
+	store, err := NewLocalGovernanceStore(context.Background(), logger, nil, &configstore.GovernanceConfig{
+		VirtualKeys: []configstoreTables.TableVirtualKey{*vk},
+		Budgets:     []configstoreTables.TableBudget{*budget},
+	}, nil)
+	require.NoError(t, err)
+
+	resolver := NewBudgetResolver(store, nil, logger)
+	ctx := &schemas.BifrostContext{}
+
+	result := resolver.EvaluateVirtualKeyRequest(ctx, "sk-bf-test", schemas.OpenAI, "gpt-4", schemas.ChatCompletionRequest)
+	assertDecision(t, DecisionBudgetExceeded, result)
+
+	// Half as much previous usage fits within the limit
+	budget.PreviousUsage = 80.0
+	store, err = NewLocalGovernanceStore(context.Background(), logger, nil, &configstore.GovernanceConfig{
+		VirtualKeys: []configstoreTables.TableVirtualKey{*vk},
+		Budgets:     []configstoreTables.TableBudget{*budget},
+	}, nil)
+	require.NoError(t, err)
+
+	result = NewBudgetResolver(store, nil, logger).EvaluateVirtualKeyRequest(ctx, "sk-bf-test", schemas.OpenAI, "gpt-4", schemas.ChatCompletionRequest)
+	assertDecision(t, DecisionAllow, result)
+}
+
 // TestBudgetResolver_EvaluateRequest_MultiLevelBudgetHierarchy tests hierarchy checking
 func TestBudgetResolver_EvaluateRequest_MultiLevelBudgetHierarchy(t *testing.T) {
 	logger := NewMockLogger()
@@ -13,6 +13,7 @@ import (
 	"github.com/capsohq/bifrost/framework/configstore"
 	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
 	"github.com/capsohq/bifrost/framework/modelcatalog"
+	"github.com/capsohq/bifrost/framework/ratelimit"
 	"github.com/google/cel-go/cel"
 	"gorm.io/gorm"
 )
@@ -20,7 +21,7 @@ import (
 // LocalGovernanceStore provides in-memory cache for governance data with fast, non-blocking access
 type LocalGovernanceStore struct {
 	// Core data maps using sync.Map for lock-free reads
-	virtualKeys  sync.Map // string -> *VirtualKey (VK value -> VirtualKey with preloaded relationships)
+	virtualKeys  sync.Map // string -> *VirtualKey (VK value hash -> VirtualKey with preloaded relationships)
 	teams        sync.Map // string -> *Team (Team ID -> Team)
 	customers    sync.Map // string -> *Customer (Customer ID -> Customer)
 	budgets      sync.Map // string -> *Budget (Budget ID -> Budget)
@@ -48,6 +49,12 @@ type LocalGovernanceStore struct {
 	// Model catalog for cross-provider model matching (optional)
 	modelCatalog *modelcatalog.ModelCatalog
 
+	// Shared token buckets enforcing rate limits across replicas (optional)
+	rateLimiter ratelimit.RateLimiter
+
+	// Called when usage takes a budget to its limit (optional)
+	budgetExceededObserver func(configstoreTables.TableBudget)
+
 	// Logger
 	logger schemas.Logger
 }
@@ -418,9 +425,10 @@ func (gs *LocalGovernanceStore) GetGovernanceData() *GovernanceData {
 	}
 }
 
-// GetVirtualKey retrieves a virtual key by its value (lock-free) with all relationships preloaded
+// GetVirtualKey retrieves a virtual key by its value (lock-free) with all relationships preloaded.
+// Virtual keys are stored by the hash of their value, as only the hash is persisted.
 func (gs *LocalGovernanceStore) GetVirtualKey(vkValue string) (*configstoreTables.TableVirtualKey, bool) {
-	value, exists := gs.virtualKeys.Load(vkValue)
+	value, exists := gs.virtualKeys.Load(configstoreTables.HashVirtualKeyValue(vkValue))
 	if !exists || value == nil {
 		return nil, false
 	}
@@ -458,17 +466,14 @@ func (gs *LocalGovernanceStore) CheckBudget(ctx context.Context, vk *configstore
 	}
 
 	// Check each budget in hierarchy order using in-memory data
+	now := time.Now()
 	for i, budget := range budgetsToCheck {
-		// Check if budget needs reset (in-memory check)
-		if budget.ResetDuration != "" {
-			if duration, err := configstoreTables.ParseDuration(budget.ResetDuration); err == nil {
-				if time.Since(budget.LastReset) >= duration {
-					// Budget expired but hasn't been reset yet - treat as reset
-					// Note: actual reset will happen in post-hook via AtomicBudgetUpdate
-					gs.logger.Debug("LocalStore CheckBudget: Budget %s (%s) expired, skipping check", budget.ID, budgetNames[i])
-					continue // Skip budget check for expired budgets
-				}
-			}
+		usage, counted := budget.WindowUsage(now)
+		if !counted {
+			// Budget expired but hasn't been reset yet - treat as reset
+			// Note: actual reset will happen in post-hook via AtomicBudgetUpdate
+			gs.logger.Debug("LocalStore CheckBudget: Budget %s (%s) expired, skipping check", budget.ID, budgetNames[i])
+			continue // Skip budget check for expired budgets
 		}
 
 		baseline, exists := baselines[budget.ID]
@@ -477,13 +482,15 @@ func (gs *LocalGovernanceStore) CheckBudget(ctx context.Context, vk *configstore
 		}
 
 		gs.logger.Debug("LocalStore CheckBudget: Checking %s budget %s: local=%.4f, remote=%.4f, total=%.4f, limit=%.4f",
-			budgetNames[i], budget.ID, budget.CurrentUsage, baseline, budget.CurrentUsage+baseline, budget.MaxLimit)
+			budgetNames[i], budget.ID, usage, baseline, usage+baseline, budget.MaxLimit)
 
 		// Check if current usage (local + remote baseline) exceeds budget limit
-		if budget.CurrentUsage+baseline >= budget.MaxLimit {
+		if usage+baseline >= budget.MaxLimit {
 			gs.logger.Debug("LocalStore CheckBudget: Budget %s EXCEEDED", budget.ID)
-			return fmt.Errorf("%s budget exceeded: %.4f >= %.4f dollars",
-				budgetNames[i], budget.CurrentUsage+baseline, budget.MaxLimit)
+			if err := budgetExceeded(budget, request, fmt.Sprintf("%s budget exceeded: %.4f >= %.4f dollars",
+				budgetNames[i], usage+baseline, budget.MaxLimit)); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -532,14 +539,10 @@ func (gs *LocalGovernanceStore) CheckProviderBudget(ctx context.Context, request
 		return nil
 	}
 
-	// Check if budget needs reset (in-memory check)
-	if budget.ResetDuration != "" {
-		if duration, err := configstoreTables.ParseDuration(budget.ResetDuration); err == nil {
-			if time.Since(budget.LastReset) >= duration {
-				// Budget expired but hasn't been reset yet - treat as reset
-				return nil // Skip budget check for expired budgets
-			}
-		}
+	usage, counted := budget.WindowUsage(time.Now())
+	if !counted {
+		// Budget expired but hasn't been reset yet - treat as reset
+		return nil // Skip budget check for expired budgets
 	}
 
 	baseline, exists := baselines[budget.ID]
@@ -548,9 +551,9 @@ func (gs *LocalGovernanceStore) CheckProviderBudget(ctx context.Context, request
 	}
 
 	// Check if current usage (local + remote baseline) exceeds budget limit
-	if budget.CurrentUsage+baseline >= budget.MaxLimit {
-		return fmt.Errorf("%s budget exceeded: %.4f >= %.4f dollars",
-			providerKey, budget.CurrentUsage+baseline, budget.MaxLimit)
+	if usage+baseline >= budget.MaxLimit {
+		return budgetExceeded(budget, request, fmt.Sprintf("%s budget exceeded: %.4f >= %.4f dollars",
+			providerKey, usage+baseline, budget.MaxLimit))
 	}
 
 	return nil
@@ -630,27 +633,34 @@ func (gs *LocalGovernanceStore) CheckProviderRateLimit(ctx context.Context, requ
 	if !exists {
 		requestsBaseline = 0
 	}
+	tokensUsage := rateLimit.TokenCurrentUsage + tokensBaseline
+	requestsUsage := rateLimit.RequestCurrentUsage + requestsBaseline
+	if sharedTokens, sharedRequests, ok := gs.sharedRateLimitUsage(ctx, rateLimit); ok {
+		// Cluster-wide usage from the shared buckets, which refill instead of expiring
+		tokensUsage, requestsUsage = sharedTokens, sharedRequests
+		tokenLimitExpired, requestLimitExpired = false, false
+	}
 
 	// Token limits - check if total usage (local + remote baseline) exceeds limit
 	// Skip this check if token limit has expired
-	if !tokenLimitExpired && rateLimit.TokenMaxLimit != nil && rateLimit.TokenCurrentUsage+tokensBaseline >= *rateLimit.TokenMaxLimit {
+	if !tokenLimitExpired && rateLimit.TokenMaxLimit != nil && tokensUsage >= *rateLimit.TokenMaxLimit {
 		duration := "unknown"
 		if rateLimit.TokenResetDuration != nil {
 			duration = *rateLimit.TokenResetDuration
 		}
 		violations = append(violations, fmt.Sprintf("token limit exceeded (%d/%d, resets every %s)",
-			rateLimit.TokenCurrentUsage+tokensBaseline, *rateLimit.TokenMaxLimit, duration))
+			tokensUsage, *rateLimit.TokenMaxLimit, duration))
 	}
 
 	// Request limits - check if total usage (local + remote baseline) exceeds limit
 	// Skip this check if request limit has expired
-	if !requestLimitExpired && rateLimit.RequestMaxLimit != nil && rateLimit.RequestCurrentUsage+requestsBaseline >= *rateLimit.RequestMaxLimit {
+	if !requestLimitExpired && rateLimit.RequestMaxLimit != nil && requestsUsage >= *rateLimit.RequestMaxLimit {
 		duration := "unknown"
 		if rateLimit.RequestResetDuration != nil {
 			duration = *rateLimit.RequestResetDuration
 		}
 		violations = append(violations, fmt.Sprintf("request limit exceeded (%d/%d, resets every %s)",
-			rateLimit.RequestCurrentUsage+requestsBaseline, *rateLimit.RequestMaxLimit, duration))
+			requestsUsage, *rateLimit.RequestMaxLimit, duration))
 	}
 
 	if len(violations) > 0 {
@@ -752,14 +762,10 @@ func (gs *LocalGovernanceStore) CheckModelBudget(ctx context.Context, request *E
 			continue
 		}
 
-		// Check if budget needs reset (in-memory check)
-		if budget.ResetDuration != "" {
-			if duration, err := configstoreTables.ParseDuration(budget.ResetDuration); err == nil {
-				if time.Since(budget.LastReset) >= duration {
-					// Budget expired but hasn't been reset yet - treat as reset
-					continue // Skip budget check for expired budgets
-				}
-			}
+		usage, counted := budget.WindowUsage(time.Now())
+		if !counted {
+			// Budget expired but hasn't been reset yet - treat as reset
+			continue // Skip budget check for expired budgets
 		}
 
 		baseline, exists := baselines[budget.ID]
@@ -768,9 +774,11 @@ func (gs *LocalGovernanceStore) CheckModelBudget(ctx context.Context, request *E
 		}
 
 		// Check if current usage (local + remote baseline) exceeds budget limit
-		if budget.CurrentUsage+baseline >= budget.MaxLimit {
-			return fmt.Errorf("%s budget exceeded: %.4f >= %.4f dollars",
-				budgetNames[i], budget.CurrentUsage+baseline, budget.MaxLimit)
+		if usage+baseline >= budget.MaxLimit {
+			if err := budgetExceeded(budget, request, fmt.Sprintf("%s budget exceeded: %.4f >= %.4f dollars",
+				budgetNames[i], usage+baseline, budget.MaxLimit)); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -802,18 +810,14 @@ func (gs *LocalGovernanceStore) CheckUserBudget(ctx context.Context, userID stri
 		return nil
 	}
 
-	// Check if budget needs reset
-	if budget.ResetDuration != "" {
-		if duration, err := configstoreTables.ParseDuration(budget.ResetDuration); err == nil {
-			if time.Since(budget.LastReset) >= duration {
-				return nil // Budget expired, skip check
-			}
-		}
+	usage, counted := budget.WindowUsage(time.Now())
+	if !counted {
+		return nil // Budget expired, skip check
 	}
 
 	baseline := baselines[budget.ID]
-	if budget.CurrentUsage+baseline >= budget.MaxLimit {
-		return fmt.Errorf("user budget exceeded: %.4f >= %.4f dollars", budget.CurrentUsage+baseline, budget.MaxLimit)
+	if usage+baseline >= budget.MaxLimit {
+		return budgetExceeded(budget, request, fmt.Sprintf("user budget exceeded: %.4f >= %.4f dollars", usage+baseline, budget.MaxLimit))
 	}
 
 	return nil
@@ -911,27 +915,34 @@ func (gs *LocalGovernanceStore) CheckModelRateLimit(ctx context.Context, request
 		if !exists {
 			requestsBaseline = 0
 		}
+		tokensUsage := rateLimit.TokenCurrentUsage + tokensBaseline
+		requestsUsage := rateLimit.RequestCurrentUsage + requestsBaseline
+		if sharedTokens, sharedRequests, ok := gs.sharedRateLimitUsage(ctx, rateLimit); ok {
+			// Cluster-wide usage from the shared buckets, which refill instead of expiring
+			tokensUsage, requestsUsage = sharedTokens, sharedRequests
+			tokenLimitExpired, requestLimitExpired = false, false
+		}
 
 		// Token limits - check if total usage (local + remote baseline) exceeds limit
 		// Skip this check if token limit has expired
-		if !tokenLimitExpired && rateLimit.TokenMaxLimit != nil && rateLimit.TokenCurrentUsage+tokensBaseline >= *rateLimit.TokenMaxLimit {
+		if !tokenLimitExpired && rateLimit.TokenMaxLimit != nil && tokensUsage >= *rateLimit.TokenMaxLimit {
 			duration := "unknown"
 			if rateLimit.TokenResetDuration != nil {
 				duration = *rateLimit.TokenResetDuration
 			}
 			violations = append(violations, fmt.Sprintf("token limit exceeded (%d/%d, resets every %s)",
-				rateLimit.TokenCurrentUsage+tokensBaseline, *rateLimit.TokenMaxLimit, duration))
+				tokensUsage, *rateLimit.TokenMaxLimit, duration))
 		}
 
 		// Request limits - check if total usage (local + remote baseline) exceeds limit
 		// Skip this check if request limit has expired
-		if !requestLimitExpired && rateLimit.RequestMaxLimit != nil && rateLimit.RequestCurrentUsage+requestsBaseline >= *rateLimit.RequestMaxLimit {
+		if !requestLimitExpired && rateLimit.RequestMaxLimit != nil && requestsUsage >= *rateLimit.RequestMaxLimit {
 			duration := "unknown"
 			if rateLimit.RequestResetDuration != nil {
 				duration = *rateLimit.RequestResetDuration
 			}
 			violations = append(violations, fmt.Sprintf("request limit exceeded (%d/%d, resets every %s)",
-				rateLimit.RequestCurrentUsage+requestsBaseline, *rateLimit.RequestMaxLimit, duration))
+				requestsUsage, *rateLimit.RequestMaxLimit, duration))
 		}
 
 		if len(violations) > 0 {
@@ -1003,25 +1014,32 @@ func (gs *LocalGovernanceStore) CheckUserRateLimit(ctx context.Context, userID s
 
 	tokensBaseline := tokensBaselines[rateLimit.ID]
 	requestsBaseline := requestsBaselines[rateLimit.ID]
+	tokensUsage := rateLimit.TokenCurrentUsage + tokensBaseline
+	requestsUsage := rateLimit.RequestCurrentUsage + requestsBaseline
+	if sharedTokens, sharedRequests, ok := gs.sharedRateLimitUsage(ctx, rateLimit); ok {
+		// Cluster-wide usage from the shared buckets, which refill instead of expiring
+		tokensUsage, requestsUsage = sharedTokens, sharedRequests
+		tokenLimitExpired, requestLimitExpired = false, false
+	}
 
 	// Check token limit
-	if !tokenLimitExpired && rateLimit.TokenMaxLimit != nil && rateLimit.TokenCurrentUsage+tokensBaseline >= *rateLimit.TokenMaxLimit {
+	if !tokenLimitExpired && rateLimit.TokenMaxLimit != nil && tokensUsage >= *rateLimit.TokenMaxLimit {
 		duration := "unknown"
 		if rateLimit.TokenResetDuration != nil {
 			duration = *rateLimit.TokenResetDuration
 		}
 		violations = append(violations, fmt.Sprintf("user token limit exceeded (%d/%d, resets every %s)",
-			rateLimit.TokenCurrentUsage+tokensBaseline, *rateLimit.TokenMaxLimit, duration))
+			tokensUsage, *rateLimit.TokenMaxLimit, duration))
 	}
 
 	// Check request limit
-	if !requestLimitExpired && rateLimit.RequestMaxLimit != nil && rateLimit.RequestCurrentUsage+requestsBaseline >= *rateLimit.RequestMaxLimit {
+	if !requestLimitExpired && rateLimit.RequestMaxLimit != nil && requestsUsage >= *rateLimit.RequestMaxLimit {
 		duration := "unknown"
 		if rateLimit.RequestResetDuration != nil {
 			duration = *rateLimit.RequestResetDuration
 		}
 		violations = append(violations, fmt.Sprintf("user request limit exceeded (%d/%d, resets every %s)",
-			rateLimit.RequestCurrentUsage+requestsBaseline, *rateLimit.RequestMaxLimit, duration))
+			requestsUsage, *rateLimit.RequestMaxLimit, duration))
 	}
 
 	if len(violations) > 0 {
@@ -1095,27 +1113,34 @@ func (gs *LocalGovernanceStore) CheckRateLimit(ctx context.Context, vk *configst
 		if !exists {
 			requestsBaseline = 0
 		}
+		tokensUsage := rateLimit.TokenCurrentUsage + tokensBaseline
+		requestsUsage := rateLimit.RequestCurrentUsage + requestsBaseline
+		if sharedTokens, sharedRequests, ok := gs.sharedRateLimitUsage(ctx, rateLimit); ok {
+			// Cluster-wide usage from the shared buckets, which refill instead of expiring
+			tokensUsage, requestsUsage = sharedTokens, sharedRequests
+			tokenExpired, requestExpired = false, false
+		}
 
 		// Token limits - check if total usage (local + remote baseline) exceeds limit
 		// Only check if token limit is not expired
-		if !tokenExpired && rateLimit.TokenMaxLimit != nil && rateLimit.TokenCurrentUsage+tokensBaseline >= *rateLimit.TokenMaxLimit {
+		if !tokenExpired && rateLimit.TokenMaxLimit != nil && tokensUsage >= *rateLimit.TokenMaxLimit {
 			duration := "unknown"
 			if rateLimit.TokenResetDuration != nil {
 				duration = *rateLimit.TokenResetDuration
 			}
 			violations = append(violations, fmt.Sprintf("token limit exceeded (%d/%d, resets every %s)",
-				rateLimit.TokenCurrentUsage+tokensBaseline, *rateLimit.TokenMaxLimit, duration))
+				tokensUsage, *rateLimit.TokenMaxLimit, duration))
 		}
 
 		// Request limits - check if total usage (local + remote baseline) exceeds limit
 		// Only check if request limit is not expired
-		if !requestExpired && rateLimit.RequestMaxLimit != nil && rateLimit.RequestCurrentUsage+requestsBaseline >= *rateLimit.RequestMaxLimit {
+		if !requestExpired && rateLimit.RequestMaxLimit != nil && requestsUsage >= *rateLimit.RequestMaxLimit {
 			duration := "unknown"
 			if rateLimit.RequestResetDuration != nil {
 				duration = *rateLimit.RequestResetDuration
 			}
 			violations = append(violations, fmt.Sprintf("request limit exceeded (%d/%d, resets every %s)",
-				rateLimit.RequestCurrentUsage+requestsBaseline, *rateLimit.RequestMaxLimit, duration))
+				requestsUsage, *rateLimit.RequestMaxLimit, duration))
 		}
 
 		if len(violations) > 0 {
@@ -1154,19 +1179,15 @@ func (gs *LocalGovernanceStore) UpdateVirtualKeyBudgetUsageInMemory(ctx context.
 				oldUsage := clone.CurrentUsage
 
 				// Check if budget needs reset (in-memory check) - operate on clone
-				if clone.ResetDuration != "" {
-					if duration, err := configstoreTables.ParseDuration(clone.ResetDuration); err == nil {
-						if now.Sub(clone.LastReset) >= duration {
-							clone.CurrentUsage = 0
-							clone.LastReset = now
-							gs.logger.Debug("UpdateVirtualKeyBudgetUsageInMemory: Budget %s was reset (expired, duration: %v)", budgetID, duration)
-						}
-					}
+				if rotated, err := clone.Rotate(now); err == nil && rotated {
+					gs.logger.Debug("UpdateVirtualKeyBudgetUsageInMemory: Budget %s was reset (expired, duration: %s)", budgetID, clone.ResetDuration)
 				}
+				usageBefore, _ := clone.WindowUsage(now)
 
 				// Update the clone
 				clone.CurrentUsage += cost
 				gs.budgets.Store(budgetID, &clone)
+				gs.notifyBudgetExceeded(&clone, usageBefore, now)
 				gs.logger.Debug("UpdateVirtualKeyBudgetUsageInMemory: Updated budget %s: %.4f -> %.4f (added %.4f)",
 					budgetID, oldUsage, clone.CurrentUsage, cost)
 			}
@@ -1188,17 +1209,12 @@ func (gs *LocalGovernanceStore) UpdateProviderAndModelBudgetUsageInMemory(ctx co
 				// Clone FIRST to avoid race conditions
 				clone := *cachedBudget
 				// Check if budget needs reset (in-memory check) - operate on clone
-				if clone.ResetDuration != "" {
-					if duration, err := configstoreTables.ParseDuration(clone.ResetDuration); err == nil {
-						if now.Sub(clone.LastReset) >= duration {
-							clone.CurrentUsage = 0
-							clone.LastReset = now
-						}
-					}
-				}
+				clone.Rotate(now)
+				usageBefore, _ := clone.WindowUsage(now)
 				// Update the clone
 				clone.CurrentUsage += cost
 				gs.budgets.Store(budgetID, &clone)
+				gs.notifyBudgetExceeded(&clone, usageBefore, now)
 			}
 		}
 	}
@@ -1258,21 +1274,33 @@ func (gs *LocalGovernanceStore) UpdateUserBudgetUsageInMemory(ctx context.Contex
 	now := time.Now()
 	clone := *budget
 	// Check if budget needs reset (in-memory check) - operate on clone
-	if clone.ResetDuration != "" {
-		if duration, err := configstoreTables.ParseDuration(clone.ResetDuration); err == nil {
-			if now.Sub(clone.LastReset) >= duration {
-				clone.CurrentUsage = 0
-				clone.LastReset = now
-			}
-		}
-	}
+	clone.Rotate(now)
+	usageBefore, _ := clone.WindowUsage(now)
 	// Update the clone
 	clone.CurrentUsage += cost
 	gs.budgets.Store(clone.ID, &clone)
+	gs.notifyBudgetExceeded(&clone, usageBefore, now)
 
 	return nil
 }
 
+// SetBudgetExceededObserver sets the function called when usage takes a budget to its limit.
+func (gs *LocalGovernanceStore) SetBudgetExceededObserver(observer func(configstoreTables.TableBudget)) {
+	gs.budgetExceededObserver = observer
+}
+
+// notifyBudgetExceeded calls the budget exceeded observer when an update took the usage of the
+// budget from below its limit to at or above it, so each window is reported once per process.
+func (gs *LocalGovernanceStore) notifyBudgetExceeded(budget *configstoreTables.TableBudget, usageBefore float64, now time.Time) {
+	if gs.budgetExceededObserver == nil || budget.MaxLimit <= 0 {
+		return
+	}
+	usageAfter, _ := budget.WindowUsage(now)
+	if usageBefore < budget.MaxLimit && usageAfter >= budget.MaxLimit {
+		gs.budgetExceededObserver(*budget)
+	}
+}
+
 // UpdateProviderAndModelRateLimitUsageInMemory updates rate limit counters for both provider-level and model-level rate limits (lock-free)
 func (gs *LocalGovernanceStore) UpdateProviderAndModelRateLimitUsageInMemory(ctx context.Context, model string, provider schemas.ModelProvider, tokensUsed int64, shouldUpdateTokens bool, shouldUpdateRequests bool) error {
 	now := time.Now()
@@ -1307,6 +1335,7 @@ func (gs *LocalGovernanceStore) UpdateProviderAndModelRateLimitUsageInMemory(ctx
 				if shouldUpdateRequests {
 					clone.RequestCurrentUsage += 1
 				}
+				gs.consumeSharedRateLimit(ctx, &clone, tokensUsed, shouldUpdateTokens, shouldUpdateRequests)
 				gs.rateLimits.Store(rateLimitID, &clone)
 			}
 		}
@@ -1386,6 +1415,7 @@ func (gs *LocalGovernanceStore) UpdateVirtualKeyRateLimitUsageInMemory(ctx conte
 				if shouldUpdateRequests {
 					clone.RequestCurrentUsage += 1
 				}
+				gs.consumeSharedRateLimit(ctx, &clone, tokensUsed, shouldUpdateTokens, shouldUpdateRequests)
 				gs.rateLimits.Store(rateLimitID, &clone)
 			}
 		}
@@ -1441,6 +1471,7 @@ func (gs *LocalGovernanceStore) UpdateUserRateLimitUsageInMemory(ctx context.Con
 	if shouldUpdateRequests {
 		clone.RequestCurrentUsage++
 	}
+	gs.consumeSharedRateLimit(ctx, &clone, tokensUsed, shouldUpdateTokens, shouldUpdateRequests)
 	gs.rateLimits.Store(clone.ID, &clone)
 
 	return nil
@@ -1458,18 +1489,16 @@ func (gs *LocalGovernanceStore) ResetExpiredBudgetsInMemory(ctx context.Context)
 			return true // continue
 		}
 
-		duration, err := configstoreTables.ParseDuration(budget.ResetDuration)
+		// Create a copy to avoid data race (sync.Map is concurrent-safe for reads/writes but not mutations)
+		copiedBudget := *budget
+		oldUsage := copiedBudget.CurrentUsage
+		rotated, err := copiedBudget.Rotate(now)
 		if err != nil {
 			gs.logger.Error("invalid budget reset duration %s: %v", budget.ResetDuration, err)
 			return true // continue
 		}
 
-		if now.Sub(budget.LastReset) >= duration {
-			// Create a copy to avoid data race (sync.Map is concurrent-safe for reads/writes but not mutations)
-			copiedBudget := *budget
-			oldUsage := copiedBudget.CurrentUsage
-			copiedBudget.CurrentUsage = 0
-			copiedBudget.LastReset = now
+		if rotated {
 			gs.LastDBUsagesBudgetsMu.Lock()
 			gs.LastDBUsagesBudgets[copiedBudget.ID] = 0
 			gs.LastDBUsagesBudgetsMu.Unlock()
@@ -1568,15 +1597,16 @@ func (gs *LocalGovernanceStore) ResetExpiredBudgets(ctx context.Context, resetBu
 	if len(resetBudgets) > 0 && gs.configStore != nil {
 		if err := gs.configStore.ExecuteTransaction(ctx, func(tx *gorm.DB) error {
 			for _, budget := range resetBudgets {
-				// Direct UPDATE only resets current_usage and last_reset
+				// Direct UPDATE only resets current_usage, previous_usage and last_reset
 				// This prevents overwriting max_limit or reset_duration that may have been changed by other nodes/requests
 				result := tx.WithContext(ctx).
 					Session(&gorm.Session{SkipHooks: true}).
 					Model(&configstoreTables.TableBudget{}).
 					Where("id = ?", budget.ID).
 					Updates(map[string]interface{}{
-						"current_usage": budget.CurrentUsage,
-						"last_reset":    budget.LastReset,
+						"current_usage":  budget.CurrentUsage,
+						"previous_usage": budget.PreviousUsage,
+						"last_reset":     budget.LastReset,
 					})
 
 				if result.Error != nil {
@@ -1826,13 +1856,20 @@ func (gs *LocalGovernanceStore) DumpBudgets(ctx context.Context, baselines map[s
 					newUsage += baseline
 				}
 
+				updates := map[string]interface{}{"current_usage": newUsage}
+				if inMemoryBudget.SlidingWindow {
+					// Sliding windows rotate on usage updates too, so their window is dumped with the usage
+					updates["previous_usage"] = inMemoryBudget.PreviousUsage
+					updates["last_reset"] = inMemoryBudget.LastReset
+				}
+
 				// Direct UPDATE avoids read-then-write lock escalation that causes deadlocks
 				// Use Session with SkipHooks to avoid triggering BeforeSave hook validation
 				result := tx.WithContext(ctx).
 					Session(&gorm.Session{SkipHooks: true}).
 					Model(&configstoreTables.TableBudget{}).
 					Where("id = ?", inMemoryBudget.ID).
-					Update("current_usage", newUsage)
+					Updates(updates)
 
 				if result.Error != nil {
 					return fmt.Errorf("failed to update budget %s: %w", inMemoryBudget.ID, result.Error)
@@ -2105,7 +2142,7 @@ func (gs *LocalGovernanceStore) rebuildInMemoryStructures(ctx context.Context, c
 	// Build virtual keys map and track active VKs
 	for i := range virtualKeys {
 		vk := &virtualKeys[i]
-		gs.virtualKeys.Store(vk.Value, vk)
+		gs.virtualKeys.Store(vk.LookupHash(), vk)
 	}
 
 	// Build model configs map
@@ -2414,7 +2451,7 @@ func (gs *LocalGovernanceStore) CreateVirtualKeyInMemory(vk *configstoreTables.T
 		}
 	}
 
-	gs.virtualKeys.Store(vk.Value, vk)
+	gs.virtualKeys.Store(vk.LookupHash(), vk)
 }
 
 // UpdateVirtualKeyInMemory updates an existing virtual key in the in-memory store (lock-free)
@@ -2425,7 +2462,7 @@ func (gs *LocalGovernanceStore) UpdateVirtualKeyInMemory(vk *configstoreTables.T
 
 	// Do not update the current usage of the rate limit, as it will be updated by the usage tracker.
 	// But update if max limit or reset duration changes.
-	if existingVKValue, exists := gs.virtualKeys.Load(vk.Value); exists && existingVKValue != nil {
+	if existingVKValue, exists := gs.virtualKeys.Load(vk.LookupHash()); exists && existingVKValue != nil {
 		existingVK, ok := existingVKValue.(*configstoreTables.TableVirtualKey)
 		if !ok || existingVK == nil {
 			return // Nothing to update
@@ -2443,6 +2480,7 @@ func (gs *LocalGovernanceStore) UpdateVirtualKeyInMemory(vk *configstoreTables.T
 					// Preserve current usage and last reset time from existing in-memory budget
 					clone.Budget.CurrentUsage = existingBudget.CurrentUsage
 					clone.Budget.LastReset = existingBudget.LastReset
+					clone.Budget.PreviousUsage = existingBudget.PreviousUsage
 				}
 			}
 			gs.budgets.Store(clone.Budget.ID, clone.Budget)
@@ -2507,6 +2545,7 @@ func (gs *LocalGovernanceStore) UpdateVirtualKeyInMemory(vk *configstoreTables.T
 							// Preserve current usage and last reset time from existing in-memory budget
 							clone.ProviderConfigs[i].Budget.CurrentUsage = existingBudget.CurrentUsage
 							clone.ProviderConfigs[i].Budget.LastReset = existingBudget.LastReset
+							clone.ProviderConfigs[i].Budget.PreviousUsage = existingBudget.PreviousUsage
 						}
 					}
 					gs.budgets.Store(clone.ProviderConfigs[i].Budget.ID, clone.ProviderConfigs[i].Budget)
@@ -2519,7 +2558,7 @@ func (gs *LocalGovernanceStore) UpdateVirtualKeyInMemory(vk *configstoreTables.T
 				}
 			}
 		}
-		gs.virtualKeys.Store(vk.Value, &clone)
+		gs.virtualKeys.Store(vk.LookupHash(), &clone)
 	} else {
 		gs.CreateVirtualKeyInMemory(vk)
 	}
@@ -2612,6 +2651,7 @@ func (gs *LocalGovernanceStore) UpdateTeamInMemory(team *configstoreTables.Table
 					// Preserve current usage and last reset time from existing in-memory budget
 					clone.Budget.CurrentUsage = existingBudget.CurrentUsage
 					clone.Budget.LastReset = existingBudget.LastReset
+					clone.Budget.PreviousUsage = existingBudget.PreviousUsage
 				}
 			}
 			gs.budgets.Store(clone.Budget.ID, clone.Budget)
@@ -2724,6 +2764,7 @@ func (gs *LocalGovernanceStore) UpdateCustomerInMemory(customer *configstoreTabl
 					// Preserve current usage and last reset time from existing in-memory budget
 					clone.Budget.CurrentUsage = existingBudget.CurrentUsage
 					clone.Budget.LastReset = existingBudget.LastReset
+					clone.Budget.PreviousUsage = existingBudget.PreviousUsage
 				}
 			}
 			gs.budgets.Store(clone.Budget.ID, clone.Budget)
@@ -2873,6 +2914,7 @@ func (gs *LocalGovernanceStore) UpdateUserGovernanceInMemory(userID string, budg
 			if existingBudget, ok := existingBudgetValue.(*configstoreTables.TableBudget); ok && existingBudget != nil {
 				budget.CurrentUsage = existingBudget.CurrentUsage
 				budget.LastReset = existingBudget.LastReset
+				budget.PreviousUsage = existingBudget.PreviousUsage
 			}
 		}
 		ug.Budget = budget
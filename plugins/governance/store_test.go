@@ -162,6 +162,36 @@ func TestGovernanceStore_CheckBudget_SingleBudget(t *testing.T) {
 	}
 }
 
+// TestGovernanceStore_BudgetExceededObserver tests the observer is called once when usage reaches the limit
+func TestGovernanceStore_BudgetExceededObserver(t *testing.T) {
+	logger := NewMockLogger()
+	budget := buildBudgetWithUsage("budget1", 100.0, 80.0, "1d")
+	vk := buildVirtualKeyWithBudget("vk1", "sk-bf-test", "Test VK", budget)
+
+	store, err := NewLocalGovernanceStore(context.Background(), logger, nil, &configstore.GovernanceConfig{
+		VirtualKeys: []configstoreTables.TableVirtualKey{*vk},
+		Budgets:     []configstoreTables.TableBudget{*budget},
+	}, nil)
+	require.NoError(t, err)
+
+	var exceeded []configstoreTables.TableBudget
+	store.SetBudgetExceededObserver(func(budget configstoreTables.TableBudget) {
+		exceeded = append(exceeded, budget)
+	})
+	vk, _ = store.GetVirtualKey("sk-bf-test")
+
+	require.NoError(t, store.UpdateVirtualKeyBudgetUsageInMemory(context.Background(), vk, schemas.OpenAI, 10.0))
+	assert.Empty(t, exceeded, "Expected no notification below the limit")
+
+	require.NoError(t, store.UpdateVirtualKeyBudgetUsageInMemory(context.Background(), vk, schemas.OpenAI, 15.0))
+	require.Len(t, exceeded, 1, "Expected a notification when the limit is reached")
+	assert.Equal(t, "budget1", exceeded[0].ID)
+	assert.InDelta(t, 105.0, exceeded[0].CurrentUsage, 0.0001)
+
+	require.NoError(t, store.UpdateVirtualKeyBudgetUsageInMemory(context.Background(), vk, schemas.OpenAI, 5.0))
+	assert.Len(t, exceeded, 1, "Expected no further notification while the budget stays exceeded")
+}
+
 // TestGovernanceStore_CheckBudget_HierarchyValidation tests multi-level budget hierarchy
 func TestGovernanceStore_CheckBudget_HierarchyValidation(t *testing.T) {
 	logger := NewMockLogger()
@@ -23,6 +23,7 @@ type UsageUpdate struct {
 	Cost       float64               `json:"cost"` // Cost in dollars
 	RequestID  string                `json:"request_id"`
 	UserID     string                `json:"user_id,omitempty"` // User ID for enterprise user-level governance
+	Tags       map[string]string     `json:"tags,omitempty"`    // Request tags the usage is attributed to
 
 	// Streaming optimization fields
 	IsStreaming  bool `json:"is_streaming"`   // Whether this is a streaming response
@@ -30,6 +31,13 @@ type UsageUpdate struct {
 	HasUsageData bool `json:"has_usage_data"` // Whether this chunk contains usage data
 }
 
+// TagUsage is the usage attributed to a request tag since startup
+type TagUsage struct {
+	Cost       float64 `json:"cost"` // Cost in dollars
+	TokensUsed int64   `json:"tokens_used"`
+	Requests   int64   `json:"requests"`
+}
+
 // UsageTracker manages VK-level usage tracking and budget management
 type UsageTracker struct {
 	store       GovernanceStore
@@ -37,6 +45,10 @@ type UsageTracker struct {
 	configStore configstore.ConfigStore
 	logger      schemas.Logger
 
+	// In-memory usage per request tag, keyed by "key=value"
+	tagUsageMu sync.Mutex
+	tagUsage   map[string]*TagUsage
+
 	// Background workers
 	trackerCtx    context.Context
 	trackerCancel context.CancelFunc
@@ -56,6 +68,7 @@ func NewUsageTracker(ctx context.Context, store GovernanceStore, resolver *Budge
 		resolver:    resolver,
 		configStore: configStore,
 		logger:      logger,
+		tagUsage:    make(map[string]*TagUsage),
 		done:        make(chan struct{}),
 	}
 
@@ -111,7 +124,12 @@ func (t *UsageTracker) UpdateUsage(ctx context.Context, update *UsageUpdate) {
 		}
 	}
 
-	// 4. Now handle virtual key-level updates (if virtual key exists)
+	// 4. Attribute usage to request tags
+	if len(update.Tags) > 0 {
+		t.updateTagUsage(update, shouldUpdateTokens, shouldUpdateRequests, shouldUpdateBudget)
+	}
+
+	// 5. Now handle virtual key-level updates (if virtual key exists)
 	if update.VirtualKey == "" {
 		// No virtual key, provider-level and model-level updates already done above
 		return
@@ -142,6 +160,40 @@ func (t *UsageTracker) UpdateUsage(ctx context.Context, update *UsageUpdate) {
 	}
 }
 
+// updateTagUsage adds the usage of a request to the totals of each of its tags
+func (t *UsageTracker) updateTagUsage(update *UsageUpdate, shouldUpdateTokens, shouldUpdateRequests, shouldUpdateBudget bool) {
+	t.tagUsageMu.Lock()
+	defer t.tagUsageMu.Unlock()
+	for key, value := range update.Tags {
+		tag := key + "=" + value
+		usage, exists := t.tagUsage[tag]
+		if !exists {
+			usage = &TagUsage{}
+			t.tagUsage[tag] = usage
+		}
+		if shouldUpdateTokens {
+			usage.TokensUsed += update.TokensUsed
+		}
+		if shouldUpdateRequests {
+			usage.Requests++
+		}
+		if shouldUpdateBudget {
+			usage.Cost += update.Cost
+		}
+	}
+}
+
+// GetTagUsage returns a snapshot of the usage attributed to each request tag, keyed by "key=value"
+func (t *UsageTracker) GetTagUsage() map[string]TagUsage {
+	t.tagUsageMu.Lock()
+	defer t.tagUsageMu.Unlock()
+	snapshot := make(map[string]TagUsage, len(t.tagUsage))
+	for tag, usage := range t.tagUsage {
+		snapshot[tag] = *usage
+	}
+	return snapshot
+}
+
 // startWorkers starts all background workers for business logic
 func (t *UsageTracker) startWorkers(ctx context.Context) {
 	// Counter reset manager (business logic)
@@ -151,6 +151,32 @@ func TestUsageTracker_UpdateUsage_StreamingOptimization(t *testing.T) {
 	assert.Equal(t, int64(1), updatedRateLimit.RequestCurrentUsage, "Request should be incremented on final chunk")
 }
 
+// TestUsageTracker_UpdateUsage_Tags tests usage attribution to request tags
+func TestUsageTracker_UpdateUsage_Tags(t *testing.T) {
+	logger := NewMockLogger()
+
+	store, err := NewLocalGovernanceStore(context.Background(), logger, nil, &configstore.GovernanceConfig{}, nil)
+	require.NoError(t, err)
+
+	resolver := NewBudgetResolver(store, nil, logger)
+	tracker := NewUsageTracker(context.Background(), store, resolver, nil, logger)
+	defer tracker.Cleanup()
+
+	for _, update := range []*UsageUpdate{
+		{Provider: schemas.OpenAI, Model: "gpt-4", Success: true, TokensUsed: 100, Cost: 1.5, RequestID: "req-1", Tags: map[string]string{"team": "search", "feature": "autocomplete"}},
+		{Provider: schemas.OpenAI, Model: "gpt-4", Success: true, TokensUsed: 50, Cost: 0.5, RequestID: "req-2", Tags: map[string]string{"team": "search"}},
+		{Provider: schemas.OpenAI, Model: "gpt-4", Success: false, TokensUsed: 10, Cost: 0.1, RequestID: "req-3", Tags: map[string]string{"team": "search"}},
+		{Provider: schemas.OpenAI, Model: "gpt-4", Success: true, TokensUsed: 10, Cost: 0.1, RequestID: "req-4"},
+	} {
+		tracker.UpdateUsage(context.Background(), update)
+	}
+
+	usage := tracker.GetTagUsage()
+	require.Len(t, usage, 2)
+	assert.Equal(t, TagUsage{Cost: 2.0, TokensUsed: 150, Requests: 2}, usage["team=search"])
+	assert.Equal(t, TagUsage{Cost: 1.5, TokensUsed: 100, Requests: 1}, usage["feature=autocomplete"])
+}
+
 // TestUsageTracker_Cleanup tests cleanup of the usage tracker
 func TestUsageTracker_Cleanup(t *testing.T) {
 	logger := NewMockLogger()
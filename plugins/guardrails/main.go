@@ -0,0 +1,217 @@
+// Package guardrails provides a plugin that checks the text of requests and responses against
+// configurable rules: PII detection, prompt injection heuristics and blocklists of terms or
+// patterns.
+//
+// Each rule runs on the input (the messages or prompt sent to the provider), the output (the
+// generated text) or both, and takes one of four actions when it matches: block fails the
+// request, redact replaces every match with a marker such as "[REDACTED:email]", tokenize replaces
+// every match in the input with a placeholder such as "[EMAIL_1]" that is replaced with the
+// original value again in the response, and annotate only records the match. Every rule that matched is recorded in the response's extra fields. Matched
+// text is never recorded or logged.
+//
+// Streamed output is checked chunk by chunk, so a match split across two chunks is not detected.
+package guardrails
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	PluginName = "guardrails"
+)
+
+// ErrorTypeGuardrailBlocked is the error type of requests and responses blocked by a rule
+const ErrorTypeGuardrailBlocked = "guardrail_blocked"
+
+// inputResultsKey stores the results of the input rules of the current request in the context
+const inputResultsKey schemas.BifrostContextKey = "guardrails-input-results"
+
+// Config holds configuration options for the guardrails plugin
+type Config struct {
+	Rules []RuleConfig `json:"rules"` // Rules run in order; a blocking match stops the remaining rules
+}
+
+// GuardrailsPlugin checks request and response text against guardrail rules
+type GuardrailsPlugin struct {
+	rules  []*rule
+	logger schemas.Logger
+}
+
+// Init creates a new guardrails plugin instance, validating every rule.
+func Init(config Config, logger schemas.Logger) (*GuardrailsPlugin, error) {
+	plugin := &GuardrailsPlugin{logger: logger}
+	names := make(map[string]bool, len(config.Rules))
+	for _, ruleConfig := range config.Rules {
+		if names[ruleConfig.Name] {
+			return nil, fmt.Errorf("duplicate guardrail rule name %q", ruleConfig.Name)
+		}
+		names[ruleConfig.Name] = true
+		r, err := compileRule(ruleConfig)
+		if err != nil {
+			return nil, err
+		}
+		plugin.rules = append(plugin.rules, r)
+	}
+	return plugin, nil
+}
+
+// GetName returns the plugin name
+func (p *GuardrailsPlugin) GetName() string {
+	return PluginName
+}
+
+// HTTPTransportPreHook is not used for this plugin
+func (p *GuardrailsPlugin) HTTPTransportPreHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest) (*schemas.HTTPResponse, error) {
+	return nil, nil
+}
+
+// HTTPTransportPostHook is not used for this plugin
+func (p *GuardrailsPlugin) HTTPTransportPostHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest, resp *schemas.HTTPResponse) error {
+	return nil
+}
+
+// HTTPTransportStreamChunkHook passes through streaming chunks unchanged
+func (p *GuardrailsPlugin) HTTPTransportStreamChunkHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest, chunk *schemas.BifrostStreamChunk) (*schemas.BifrostStreamChunk, error) {
+	return chunk, nil
+}
+
+// PreLLMHook runs the input rules on the messages or prompt of chat, responses and text completion
+// requests. A blocking match short-circuits the request with an error. Redacted messages are
+// copies; the caller's messages are never modified.
+func (p *GuardrailsPlugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.LLMPluginShortCircuit, error) {
+	if !p.hasRules(schemas.GuardrailStageInput) {
+		return req, nil, nil
+	}
+	vault := newTokenVault()
+	results, blocked := p.check(requestTexts(req), schemas.GuardrailStageInput, vault)
+	if blocked != nil {
+		return req, &schemas.LLMPluginShortCircuit{Error: p.blockedError(blocked)}, nil
+	}
+	if len(results) > 0 {
+		ctx.SetValue(inputResultsKey, results)
+	}
+	if vault.size() > 0 {
+		ctx.SetValue(tokenVaultKey, vault)
+	}
+	return req, nil, nil
+}
+
+// PostLLMHook runs the output rules on the generated text and records the results of the input
+// and output rules in the response's extra fields. Output rules see the generated text with
+// placeholder tokens; the tokens are then replaced with their original values. For streams, every
+// chunk is checked and the input results are added to the final chunk only. Errors pass through
+// unchanged.
+func (p *GuardrailsPlugin) PostLLMHook(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	if result == nil {
+		return result, bifrostErr, nil
+	}
+	extraFields := result.GetExtraFields()
+	if extraFields == nil {
+		return result, bifrostErr, nil
+	}
+	stream := bifrost.IsStreamRequestType(extraFields.RequestType)
+	final := !stream || bifrost.IsFinalChunk(ctx)
+	var results []schemas.GuardrailResult
+	if inputResults, ok := ctx.Value(inputResultsKey).([]schemas.GuardrailResult); ok && final {
+		results = inputResults
+	}
+	if p.hasRules(schemas.GuardrailStageOutput) {
+		outputResults, blocked := p.check(responseTexts(result), schemas.GuardrailStageOutput, nil)
+		if blocked != nil {
+			return nil, p.blockedError(blocked), nil
+		}
+		results = append(results, outputResults...)
+	}
+	if vault, ok := ctx.Value(tokenVaultKey).(*tokenVault); ok {
+		restoreTokens(vault, result, stream, final)
+	}
+	if len(results) > 0 {
+		extraFields.Guardrails = append(extraFields.Guardrails, results...)
+	}
+	return result, bifrostErr, nil
+}
+
+// Cleanup performs plugin cleanup
+func (p *GuardrailsPlugin) Cleanup() error {
+	return nil
+}
+
+// hasRules reports whether any rule runs at the stage
+func (p *GuardrailsPlugin) hasRules(stage string) bool {
+	return slices.ContainsFunc(p.rules, func(r *rule) bool { return r.runsAt(stage) })
+}
+
+// check runs the rules of the stage on every text, redacting the texts matched by redact rules and
+// replacing the matches of tokenize rules with tokens of the vault. It returns the results of the
+// rules that matched, or the result of the first blocking match.
+func (p *GuardrailsPlugin) check(texts []textRef, stage string, vault *tokenVault) ([]schemas.GuardrailResult, *schemas.GuardrailResult) {
+	var results []schemas.GuardrailResult
+	for _, r := range p.rules {
+		if !r.runsAt(stage) {
+			continue
+		}
+		var matches []string
+		for _, text := range texts {
+			var redacted string
+			var labels []string
+			if r.action == schemas.GuardrailActionTokenize {
+				redacted, labels = r.replace(text.get(), vault.tokenize(r))
+			} else {
+				redacted, labels = r.apply(text.get())
+			}
+			if len(labels) == 0 {
+				continue
+			}
+			for _, label := range labels {
+				if !slices.Contains(matches, label) {
+					matches = append(matches, label)
+				}
+			}
+			if r.action == schemas.GuardrailActionRedact || r.action == schemas.GuardrailActionTokenize {
+				text.set(redacted)
+			}
+		}
+		if len(matches) == 0 {
+			continue
+		}
+		result := schemas.GuardrailResult{
+			Rule:    r.name,
+			Type:    r.ruleType,
+			Stage:   stage,
+			Action:  r.action,
+			Matches: matches,
+		}
+		if p.logger != nil {
+			p.logger.Debug("%s: rule %s matched %s (%s), action %s", PluginName, r.name, stage, strings.Join(matches, ", "), r.action)
+		}
+		if r.action == schemas.GuardrailActionBlock {
+			return nil, &result
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// blockedError returns the error of a request or response blocked by a rule. Fallbacks are not
+// attempted, as the same rule would block them.
+func (p *GuardrailsPlugin) blockedError(result *schemas.GuardrailResult) *schemas.BifrostError {
+	subject := "request"
+	if result.Stage == schemas.GuardrailStageOutput {
+		subject = "response"
+	}
+	return &schemas.BifrostError{
+		Type:           bifrost.Ptr(ErrorTypeGuardrailBlocked),
+		StatusCode:     bifrost.Ptr(400),
+		AllowFallbacks: bifrost.Ptr(false),
+		Error: &schemas.ErrorField{
+			Type:    bifrost.Ptr(ErrorTypeGuardrailBlocked),
+			Code:    bifrost.Ptr(result.Rule),
+			Message: fmt.Sprintf("%s blocked by guardrail %s (%s: %s)", subject, result.Rule, result.Type, strings.Join(result.Matches, ", ")),
+		},
+	}
+}
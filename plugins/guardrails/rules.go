@@ -0,0 +1,244 @@
+package guardrails
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// Rule types
+const (
+	RuleTypePII             = "pii"
+	RuleTypePromptInjection = "prompt_injection"
+	RuleTypeBlocklist       = "blocklist"
+)
+
+// PII types detected by pii rules
+const (
+	PIIEmail      = "email"
+	PIICreditCard = "credit_card"
+	PIISSN        = "ssn"
+	PIIPhone      = "phone"
+	PIIIPAddress  = "ip_address"
+)
+
+// RuleConfig configures a guardrail rule
+type RuleConfig struct {
+	Name   string `json:"name"`             // Unique name, reported in results and errors
+	Type   string `json:"type"`             // "pii", "prompt_injection" or "blocklist"
+	Stage  string `json:"stage,omitempty"`  // "input", "output" or "both" (default "input" for prompt_injection, "both" otherwise)
+	Action string `json:"action,omitempty"` // "block", "redact", "tokenize" or "annotate" (default "redact" for pii, "block" otherwise)
+
+	PIITypes      []string `json:"pii_types,omitempty"`      // PII types to detect (pii rules, default all)
+	Terms         []string `json:"terms,omitempty"`          // Words or phrases to match (blocklist rules)
+	Patterns      []string `json:"patterns,omitempty"`       // Regular expressions to match (blocklist rules)
+	CaseSensitive bool     `json:"case_sensitive,omitempty"` // Match terms and patterns case-sensitively (blocklist rules)
+}
+
+// detector finds one kind of content in a text
+type detector struct {
+	label string
+	re    *regexp.Regexp
+	valid func(match string) bool // optional check of a regexp match, e.g. a checksum
+}
+
+// rule is a compiled RuleConfig
+type rule struct {
+	name      string
+	ruleType  string
+	input     bool
+	output    bool
+	action    string
+	detectors []detector
+}
+
+// piiDetectors are ordered so that longer formats are redacted before the ones they contain,
+// e.g. credit card numbers before phone numbers.
+var piiDetectors = []detector{
+	{label: PIIEmail, re: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)},
+	{label: PIICreditCard, re: regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`), valid: luhnValid},
+	{label: PIISSN, re: regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`)},
+	{label: PIIPhone, re: regexp.MustCompile(`(?:\+\d{1,3}[\s.-]?)?(?:\(\d{3}\)|\b\d{3})[\s.-]?\d{3}[\s.-]?\d{4}\b`)},
+	{label: PIIIPAddress, re: regexp.MustCompile(`\b(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)\b`)},
+}
+
+// promptInjectionDetectors are heuristics for common prompt injection phrasings.
+var promptInjectionDetectors = []detector{
+	{label: "ignore_instructions", re: regexp.MustCompile(`(?i)\b(?:ignore|disregard|forget|override)\b[^.\n]{0,40}\b(?:previous|prior|above|earlier|preceding|all|your)\b[^.\n]{0,40}\b(?:instructions?|prompts?|rules|directions|guidelines)\b`)},
+	{label: "system_prompt_extraction", re: regexp.MustCompile(`(?i)\b(?:reveal|show|print|repeat|output|tell me|leak)\b[^.\n]{0,40}\b(?:system prompt|system message|hidden instructions|initial instructions|original instructions)\b`)},
+	{label: "role_override", re: regexp.MustCompile(`(?i)\b(?:you are now|from now on you are|pretend to be|act as)\b[^.\n]{0,40}\b(?:unrestricted|unfiltered|jailbroken|without (?:any )?(?:restrictions|rules|filters))\b|\b(?:developer|jailbreak|god) mode\b`)},
+	{label: "role_delimiter", re: regexp.MustCompile(`(?im)^\s*(?:#{1,3}\s*)?(?:system|assistant)\s*:|<\|im_start\|>\s*system|\[/?INST\]`)},
+}
+
+// compileRule validates a rule config and fills in its defaults
+func compileRule(config RuleConfig) (*rule, error) {
+	if strings.TrimSpace(config.Name) == "" {
+		return nil, fmt.Errorf("guardrail rule name is required")
+	}
+	r := &rule{name: config.Name, ruleType: config.Type, action: config.Action}
+
+	switch config.Type {
+	case RuleTypePII:
+		if r.action == "" {
+			r.action = schemas.GuardrailActionRedact
+		}
+		if len(config.PIITypes) == 0 {
+			r.detectors = piiDetectors
+			break
+		}
+		for _, d := range piiDetectors {
+			if slices.Contains(config.PIITypes, d.label) {
+				r.detectors = append(r.detectors, d)
+			}
+		}
+		for _, piiType := range config.PIITypes {
+			if !slices.ContainsFunc(piiDetectors, func(d detector) bool { return d.label == piiType }) {
+				return nil, fmt.Errorf("guardrail rule %s: unknown pii type %q", config.Name, piiType)
+			}
+		}
+	case RuleTypePromptInjection:
+		r.detectors = promptInjectionDetectors
+	case RuleTypeBlocklist:
+		if len(config.Terms) == 0 && len(config.Patterns) == 0 {
+			return nil, fmt.Errorf("guardrail rule %s: a blocklist needs terms or patterns", config.Name)
+		}
+		flags := "(?i)"
+		if config.CaseSensitive {
+			flags = ""
+		}
+		for _, term := range config.Terms {
+			if strings.TrimSpace(term) == "" {
+				continue
+			}
+			r.detectors = append(r.detectors, detector{label: term, re: regexp.MustCompile(flags + termPattern(term))})
+		}
+		for _, pattern := range config.Patterns {
+			re, err := regexp.Compile(flags + pattern)
+			if err != nil {
+				return nil, fmt.Errorf("guardrail rule %s: invalid pattern %q: %w", config.Name, pattern, err)
+			}
+			r.detectors = append(r.detectors, detector{label: pattern, re: re})
+		}
+	default:
+		return nil, fmt.Errorf("guardrail rule %s: unknown type %q", config.Name, config.Type)
+	}
+	if r.action == "" {
+		r.action = schemas.GuardrailActionBlock
+	}
+	switch r.action {
+	case schemas.GuardrailActionBlock, schemas.GuardrailActionRedact, schemas.GuardrailActionAnnotate:
+	case schemas.GuardrailActionTokenize:
+		if config.Type == RuleTypePromptInjection {
+			return nil, fmt.Errorf("guardrail rule %s: prompt_injection rules cannot tokenize", config.Name)
+		}
+		if config.Stage == schemas.GuardrailStageOutput {
+			return nil, fmt.Errorf("guardrail rule %s: tokenize rules run on the input", config.Name)
+		}
+	default:
+		return nil, fmt.Errorf("guardrail rule %s: unknown action %q", config.Name, config.Action)
+	}
+
+	switch config.Stage {
+	case "":
+		r.input = true
+		r.output = config.Type != RuleTypePromptInjection
+	case schemas.GuardrailStageInput:
+		r.input = true
+	case schemas.GuardrailStageOutput:
+		r.output = true
+	case "both":
+		r.input, r.output = true, true
+	default:
+		return nil, fmt.Errorf("guardrail rule %s: unknown stage %q", config.Name, config.Stage)
+	}
+	// Tokens are restored in every response, so tokenize rules have no output stage
+	if r.action == schemas.GuardrailActionTokenize {
+		r.output = false
+	}
+	return r, nil
+}
+
+// termPattern matches a blocklist term as a whole word where it starts or ends with a word character
+func termPattern(term string) string {
+	pattern := regexp.QuoteMeta(term)
+	runes := []rune(term)
+	if isWordRune(runes[0]) {
+		pattern = `\b` + pattern
+	}
+	if isWordRune(runes[len(runes)-1]) {
+		pattern += `\b`
+	}
+	return pattern
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// runsAt reports whether the rule checks the text of the stage
+func (r *rule) runsAt(stage string) bool {
+	if stage == schemas.GuardrailStageOutput {
+		return r.output
+	}
+	return r.input
+}
+
+// apply returns the labels of the detectors that match text and the text with every match
+// replaced by a redaction marker.
+func (r *rule) apply(text string) (string, []string) {
+	return r.replace(text, func(label, match string) string { return r.redaction(label) })
+}
+
+// replace returns the labels of the detectors that match text and the text with every match
+// replaced by replacement. Detectors run in order on the already replaced text, so a match is only
+// reported once.
+func (r *rule) replace(text string, replacement func(label, match string) string) (string, []string) {
+	var labels []string
+	for _, d := range r.detectors {
+		matched := false
+		text = d.re.ReplaceAllStringFunc(text, func(match string) string {
+			if d.valid != nil && !d.valid(match) {
+				return match
+			}
+			matched = true
+			return replacement(d.label, match)
+		})
+		if matched {
+			labels = append(labels, d.label)
+		}
+	}
+	return text, labels
+}
+
+// redaction returns the marker replacing redacted content
+func (r *rule) redaction(label string) string {
+	if r.ruleType == RuleTypePII {
+		return "[REDACTED:" + label + "]"
+	}
+	return "[REDACTED:" + r.ruleType + "]"
+}
+
+// luhnValid checks the Luhn checksum of a card number, ignoring separators
+func luhnValid(number string) bool {
+	sum, digits := 0, 0
+	for i := len(number) - 1; i >= 0; i-- {
+		c := number[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if digits%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		digits++
+	}
+	return digits >= 13 && sum%10 == 0
+}
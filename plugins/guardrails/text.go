@@ -0,0 +1,129 @@
+package guardrails
+
+import (
+	"slices"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// textRef reads and replaces one text of a request or response. Replacing a text points its
+// field at a new string, never writes through the old pointer.
+type textRef struct {
+	get func() string
+	set func(string)
+}
+
+// fieldRef returns the textRef of a string pointer field that is not nil
+func fieldRef(field **string) textRef {
+	return textRef{
+		get: func() string { return **field },
+		set: func(text string) { *field = &text },
+	}
+}
+
+// requestTexts returns the text fields of the input of chat, responses and text completion
+// requests. The input is copied first, so replacing a text leaves the caller's messages untouched.
+func requestTexts(req *schemas.BifrostRequest) []textRef {
+	var refs []textRef
+	switch {
+	case req.ChatRequest != nil:
+		req.ChatRequest.Input = slices.Clone(req.ChatRequest.Input)
+		for i := range req.ChatRequest.Input {
+			message := &req.ChatRequest.Input[i]
+			if message.Content != nil {
+				content := *message.Content
+				message.Content = &content
+				refs = append(refs, chatContentTexts(message.Content)...)
+			}
+		}
+	case req.ResponsesRequest != nil:
+		req.ResponsesRequest.Input = slices.Clone(req.ResponsesRequest.Input)
+		for i := range req.ResponsesRequest.Input {
+			message := &req.ResponsesRequest.Input[i]
+			if message.Content != nil {
+				content := *message.Content
+				message.Content = &content
+				refs = append(refs, responsesContentTexts(message.Content)...)
+			}
+		}
+	case req.TextCompletionRequest != nil && req.TextCompletionRequest.Input != nil:
+		input := *req.TextCompletionRequest.Input
+		req.TextCompletionRequest.Input = &input
+		if input.PromptStr != nil {
+			refs = append(refs, fieldRef(&input.PromptStr))
+		}
+		prompts := slices.Clone(input.PromptArray)
+		input.PromptArray = prompts
+		for i := range prompts {
+			refs = append(refs, textRef{
+				get: func() string { return prompts[i] },
+				set: func(text string) { prompts[i] = text },
+			})
+		}
+	}
+	return refs
+}
+
+// responseTexts returns the text fields of the output of chat, responses and text completion
+// responses and of their stream chunks.
+func responseTexts(result *schemas.BifrostResponse) []textRef {
+	var refs []textRef
+	switch {
+	case result.ChatResponse != nil:
+		for i := range result.ChatResponse.Choices {
+			choice := &result.ChatResponse.Choices[i]
+			if choice.ChatNonStreamResponseChoice != nil && choice.Message != nil && choice.Message.Content != nil {
+				refs = append(refs, chatContentTexts(choice.Message.Content)...)
+			}
+			if choice.ChatStreamResponseChoice != nil && choice.Delta != nil && choice.Delta.Content != nil {
+				refs = append(refs, fieldRef(&choice.Delta.Content))
+			}
+		}
+	case result.TextCompletionResponse != nil:
+		for i := range result.TextCompletionResponse.Choices {
+			choice := &result.TextCompletionResponse.Choices[i]
+			if choice.TextCompletionResponseChoice != nil && choice.Text != nil {
+				refs = append(refs, fieldRef(&choice.Text))
+			}
+		}
+	case result.ResponsesResponse != nil:
+		for i := range result.ResponsesResponse.Output {
+			if content := result.ResponsesResponse.Output[i].Content; content != nil {
+				refs = append(refs, responsesContentTexts(content)...)
+			}
+		}
+	case result.ResponsesStreamResponse != nil:
+		if result.ResponsesStreamResponse.Type == schemas.ResponsesStreamResponseTypeOutputTextDelta && result.ResponsesStreamResponse.Delta != nil {
+			refs = append(refs, fieldRef(&result.ResponsesStreamResponse.Delta))
+		}
+	}
+	return refs
+}
+
+func chatContentTexts(content *schemas.ChatMessageContent) []textRef {
+	var refs []textRef
+	if content.ContentStr != nil {
+		refs = append(refs, fieldRef(&content.ContentStr))
+	}
+	content.ContentBlocks = slices.Clone(content.ContentBlocks)
+	for i := range content.ContentBlocks {
+		if content.ContentBlocks[i].Text != nil {
+			refs = append(refs, fieldRef(&content.ContentBlocks[i].Text))
+		}
+	}
+	return refs
+}
+
+func responsesContentTexts(content *schemas.ResponsesMessageContent) []textRef {
+	var refs []textRef
+	if content.ContentStr != nil {
+		refs = append(refs, fieldRef(&content.ContentStr))
+	}
+	content.ContentBlocks = slices.Clone(content.ContentBlocks)
+	for i := range content.ContentBlocks {
+		if content.ContentBlocks[i].Text != nil {
+			refs = append(refs, fieldRef(&content.ContentBlocks[i].Text))
+		}
+	}
+	return refs
+}
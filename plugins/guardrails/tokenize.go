@@ -0,0 +1,307 @@
+package guardrails
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// tokenVaultKey stores the tokenVault of the current request in the context
+const tokenVaultKey schemas.BifrostContextKey = "guardrails-token-vault"
+
+// tokenVault maps the placeholder tokens of one request to the values they replaced. The same value
+// always gets the same token, so the model sees consistent placeholders across messages.
+type tokenVault struct {
+	mu       sync.Mutex
+	tokens   map[string]string // value -> token
+	values   map[string]string // token -> value
+	counts   map[string]int    // tokens issued per label
+	pending  map[string]string // per stream key, the end of the last chunk that may start a token
+	replacer *strings.Replacer // token -> value, nil until built
+	escaped  *strings.Replacer // token -> JSON-escaped value, nil until built
+}
+
+func newTokenVault() *tokenVault {
+	return &tokenVault{
+		tokens:  make(map[string]string),
+		values:  make(map[string]string),
+		counts:  make(map[string]int),
+		pending: make(map[string]string),
+	}
+}
+
+// tokenize returns the replacement function of a tokenize rule, issuing tokens such as
+// "[EMAIL_1]" for PII and "[BLOCKLIST_1]" for blocklist matches.
+func (v *tokenVault) tokenize(r *rule) func(label, match string) string {
+	return func(label, match string) string {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		if token, ok := v.tokens[match]; ok {
+			return token
+		}
+		name := r.ruleType
+		if r.ruleType == RuleTypePII {
+			name = label
+		}
+		name = strings.ToUpper(name)
+		v.counts[name]++
+		token := "[" + name + "_" + strconv.Itoa(v.counts[name]) + "]"
+		v.tokens[match] = token
+		v.values[token] = match
+		v.replacer, v.escaped = nil, nil
+		return token
+	}
+}
+
+func (v *tokenVault) size() int {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return len(v.values)
+}
+
+// restore replaces the tokens in text with their values, JSON-escaped when text is JSON such as
+// tool call arguments. Texts with a stream key are parts of a stream: the end of a part that may
+// be the start of a token split across chunks is held back and prepended to the next part with the
+// same key, until final.
+func (v *tokenVault) restore(text string, key string, isJSON bool, final bool) string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if key != "" {
+		text = v.pending[key] + text
+		delete(v.pending, key)
+		if !final {
+			if i := strings.LastIndexByte(text, '['); i >= 0 && v.isTokenPrefix(text[i:]) {
+				v.pending[key] = text[i:]
+				text = text[:i]
+			}
+		}
+	}
+	if v.replacer == nil {
+		pairs := make([]string, 0, 2*len(v.values))
+		escapedPairs := make([]string, 0, 2*len(v.values))
+		for token, value := range v.values {
+			pairs = append(pairs, token, value)
+			escapedPairs = append(escapedPairs, token, jsonEscape(value))
+		}
+		v.replacer = strings.NewReplacer(pairs...)
+		v.escaped = strings.NewReplacer(escapedPairs...)
+	}
+	if isJSON {
+		return v.escaped.Replace(text)
+	}
+	return v.replacer.Replace(text)
+}
+
+// flushChoices returns and clears the held back ends of streamed choice texts, by choice index.
+// Tool call arguments are JSON and never end in the middle of a token.
+func (v *tokenVault) flushChoices() map[int]string {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	texts := make(map[int]string)
+	for key, text := range v.pending {
+		var index int
+		if _, err := fmt.Sscanf(key, "choice:%d", &index); err == nil && !strings.Contains(key, ":tool:") {
+			texts[index] = text
+		}
+		delete(v.pending, key)
+	}
+	return texts
+}
+
+// isTokenPrefix reports whether text is the start of a token but not a whole token
+func (v *tokenVault) isTokenPrefix(text string) bool {
+	for token := range v.values {
+		if len(text) < len(token) && strings.HasPrefix(token, text) {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonEscape escapes a value for use inside a JSON string
+func jsonEscape(value string) string {
+	quoted, err := json.Marshal(value)
+	if err != nil {
+		return value
+	}
+	return string(quoted[1 : len(quoted)-1])
+}
+
+// restoreRef is a text of a response that may contain tokens
+type restoreRef struct {
+	textRef
+	key  string // stream key of a part of a streamed text, "" for complete texts
+	json bool   // the text is JSON, e.g. tool call arguments
+}
+
+// restoreTexts returns the texts of a response or stream chunk in which tokens are restored:
+// generated text and tool call arguments.
+func restoreTexts(result *schemas.BifrostResponse, stream bool) []restoreRef {
+	var refs []restoreRef
+	complete := func(ref textRef, json bool) {
+		refs = append(refs, restoreRef{textRef: ref, json: json})
+	}
+	switch {
+	case result.ChatResponse != nil:
+		for i := range result.ChatResponse.Choices {
+			choice := &result.ChatResponse.Choices[i]
+			if choice.ChatNonStreamResponseChoice != nil && choice.Message != nil {
+				message := choice.Message
+				if message.Content != nil {
+					for _, ref := range chatContentTexts(message.Content) {
+						complete(ref, false)
+					}
+				}
+				if message.ChatAssistantMessage != nil {
+					for j := range message.ToolCalls {
+						complete(argumentsRef(&message.ToolCalls[j].Function.Arguments), true)
+					}
+				}
+			}
+			if choice.ChatStreamResponseChoice != nil && choice.Delta != nil {
+				delta := choice.Delta
+				key := fmt.Sprintf("choice:%d", choice.Index)
+				refs = append(refs, restoreRef{textRef: optionalFieldRef(&delta.Content), key: key})
+				for j := range delta.ToolCalls {
+					toolCall := &delta.ToolCalls[j]
+					refs = append(refs, restoreRef{
+						textRef: argumentsRef(&toolCall.Function.Arguments),
+						key:     fmt.Sprintf("%s:tool:%d", key, toolCall.Index),
+						json:    true,
+					})
+				}
+			}
+		}
+	case result.TextCompletionResponse != nil:
+		for i := range result.TextCompletionResponse.Choices {
+			choice := &result.TextCompletionResponse.Choices[i]
+			if choice.TextCompletionResponseChoice == nil {
+				continue
+			}
+			ref := restoreRef{textRef: optionalFieldRef(&choice.Text)}
+			if stream {
+				ref.key = fmt.Sprintf("choice:%d", choice.Index)
+			}
+			refs = append(refs, ref)
+		}
+	case result.ResponsesResponse != nil:
+		refs = append(refs, responsesOutputRestoreTexts(result.ResponsesResponse.Output)...)
+	case result.ResponsesStreamResponse != nil:
+		event := result.ResponsesStreamResponse
+		switch event.Type {
+		case schemas.ResponsesStreamResponseTypeOutputTextDelta, schemas.ResponsesStreamResponseTypeFunctionCallArgumentsDelta:
+			if event.Delta != nil {
+				refs = append(refs, restoreRef{
+					textRef: fieldRef(&event.Delta),
+					key:     fmt.Sprintf("output:%d:%d", derefInt(event.OutputIndex), derefInt(event.ContentIndex)),
+					json:    event.Type == schemas.ResponsesStreamResponseTypeFunctionCallArgumentsDelta,
+				})
+			}
+		default:
+			if event.Text != nil {
+				complete(fieldRef(&event.Text), false)
+			}
+			if event.Arguments != nil {
+				complete(fieldRef(&event.Arguments), true)
+			}
+			if event.Part != nil && event.Part.Text != nil {
+				complete(fieldRef(&event.Part.Text), false)
+			}
+			if event.Item != nil {
+				refs = append(refs, responsesOutputRestoreTexts([]schemas.ResponsesMessage{*event.Item})...)
+			}
+			if event.Response != nil {
+				refs = append(refs, responsesOutputRestoreTexts(event.Response.Output)...)
+			}
+		}
+	}
+	return refs
+}
+
+// responsesOutputRestoreTexts returns the texts and function call arguments of responses output items
+func responsesOutputRestoreTexts(output []schemas.ResponsesMessage) []restoreRef {
+	var refs []restoreRef
+	for i := range output {
+		item := &output[i]
+		if item.Content != nil {
+			for _, ref := range responsesContentTexts(item.Content) {
+				refs = append(refs, restoreRef{textRef: ref})
+			}
+		}
+		if item.ResponsesToolMessage != nil && item.Arguments != nil {
+			refs = append(refs, restoreRef{textRef: fieldRef(&item.Arguments), json: true})
+		}
+	}
+	return refs
+}
+
+// optionalFieldRef returns the textRef of a string pointer field that may be nil. Setting the
+// empty text leaves a nil field nil.
+func optionalFieldRef(field **string) textRef {
+	return textRef{
+		get: func() string {
+			if *field == nil {
+				return ""
+			}
+			return **field
+		},
+		set: func(text string) {
+			if *field == nil && text == "" {
+				return
+			}
+			*field = &text
+		},
+	}
+}
+
+func argumentsRef(field *string) textRef {
+	return textRef{
+		get: func() string { return *field },
+		set: func(text string) { *field = text },
+	}
+}
+
+func derefInt(i *int) int {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
+
+// restoreTokens replaces the tokens of the request's tokenize rules in a response or stream chunk
+// with the values they replaced. On the final chunk of a stream, text held back in case it starts a
+// token is released.
+func restoreTokens(vault *tokenVault, result *schemas.BifrostResponse, stream bool, final bool) {
+	for _, ref := range restoreTexts(result, stream) {
+		text := ref.get()
+		restored := vault.restore(text, ref.key, ref.json, final)
+		if restored != text {
+			ref.set(restored)
+		}
+	}
+	// A final chunk without the choices whose text was held back, e.g. a usage-only chunk, gets
+	// a choice delta with the held back text so no output is lost
+	if !final || result.ChatResponse == nil {
+		return
+	}
+	texts := vault.flushChoices()
+	indexes := make([]int, 0, len(texts))
+	for index := range texts {
+		indexes = append(indexes, index)
+	}
+	sort.Ints(indexes)
+	for _, index := range indexes {
+		text := vault.restore(texts[index], "", false, true)
+		result.ChatResponse.Choices = append(result.ChatResponse.Choices, schemas.BifrostResponseChoice{
+			Index: index,
+			ChatStreamResponseChoice: &schemas.ChatStreamResponseChoice{
+				Delta: &schemas.ChatStreamResponseChoiceDelta{Content: &text},
+			},
+		})
+	}
+}
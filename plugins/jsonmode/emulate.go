@@ -0,0 +1,135 @@
+package jsonmode
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// response_format types
+const (
+	formatJSONSchema = "json_schema"
+	formatJSONObject = "json_object"
+)
+
+// responseFormat is the part of a chat response_format the emulation needs
+type responseFormat struct {
+	Type       string `json:"type"`
+	JSONSchema *struct {
+		Name        string          `json:"name,omitempty"`
+		Description string          `json:"description,omitempty"`
+		Schema      json.RawMessage `json:"schema,omitempty"`
+	} `json:"json_schema,omitempty"`
+}
+
+func parseResponseFormat(value interface{}) (*responseFormat, error) {
+	data, err := schemas.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var format responseFormat
+	if err := schemas.Unmarshal(data, &format); err != nil {
+		return nil, err
+	}
+	return &format, nil
+}
+
+// instructions returns the system prompt text describing the expected JSON
+func (f *responseFormat) instructions() string {
+	var b strings.Builder
+	if f.Type == formatJSONSchema && f.JSONSchema != nil && len(f.JSONSchema.Schema) > 0 {
+		b.WriteString("Respond only with JSON that conforms to the following JSON schema.")
+		if f.JSONSchema.Description != "" {
+			b.WriteString(" The JSON is ")
+			b.WriteString(f.JSONSchema.Description)
+			if !strings.HasSuffix(f.JSONSchema.Description, ".") {
+				b.WriteString(".")
+			}
+		}
+		b.WriteString(" Do not wrap the JSON in markdown code fences and do not add any other text.\n\nJSON schema")
+		if f.JSONSchema.Name != "" {
+			b.WriteString(" (")
+			b.WriteString(f.JSONSchema.Name)
+			b.WriteString(")")
+		}
+		b.WriteString(":\n")
+		var schema bytes.Buffer
+		if err := json.Compact(&schema, f.JSONSchema.Schema); err == nil {
+			b.Write(schema.Bytes())
+		} else {
+			b.Write(f.JSONSchema.Schema)
+		}
+		return b.String()
+	}
+	return "Respond only with a valid JSON object. Do not wrap the JSON in markdown code fences and do not add any other text."
+}
+
+// withInstructions returns a copy of the messages with the instructions appended to the leading
+// system message, or added as a new system message if there is none.
+func withInstructions(messages []schemas.ChatMessage, instructions string) []schemas.ChatMessage {
+	if len(messages) > 0 && (messages[0].Role == schemas.ChatMessageRoleSystem || messages[0].Role == schemas.ChatMessageRoleDeveloper) && messages[0].Content != nil {
+		result := make([]schemas.ChatMessage, len(messages))
+		copy(result, messages)
+		system := result[0]
+		content := *system.Content
+		switch {
+		case content.ContentStr != nil:
+			text := *content.ContentStr + "\n\n" + instructions
+			content.ContentStr = &text
+		default:
+			content.ContentBlocks = append(append([]schemas.ChatContentBlock(nil), content.ContentBlocks...), schemas.ChatContentBlock{
+				Type: schemas.ChatContentBlockTypeText,
+				Text: &instructions,
+			})
+		}
+		system.Content = &content
+		result[0] = system
+		return result
+	}
+	result := make([]schemas.ChatMessage, 0, len(messages)+1)
+	result = append(result, schemas.ChatMessage{
+		Role:    schemas.ChatMessageRoleSystem,
+		Content: &schemas.ChatMessageContent{ContentStr: &instructions},
+	})
+	return append(result, messages...)
+}
+
+var (
+	// thinkBlock matches the reasoning blocks some models put before their answer
+	thinkBlock = regexp.MustCompile(`(?s)<think>.*?</think>`)
+	// codeFence matches a markdown code block, optionally tagged as JSON
+	codeFence = regexp.MustCompile("(?s)```(?:json|JSON)?[ \t]*\n?(.*?)```")
+)
+
+// extractJSON returns the JSON in a model's text: the whole text, the first fenced code block or
+// the span from the first opening to the last closing bracket, whichever is valid JSON first.
+func extractJSON(text string) (string, bool) {
+	text = strings.TrimSpace(thinkBlock.ReplaceAllString(text, ""))
+	if json.Valid([]byte(text)) {
+		return text, true
+	}
+	if match := codeFence.FindStringSubmatch(text); match != nil {
+		if candidate := strings.TrimSpace(match[1]); json.Valid([]byte(candidate)) {
+			return candidate, true
+		}
+	}
+	start := strings.IndexAny(text, "{[")
+	if start < 0 {
+		return "", false
+	}
+	closing := "}"
+	if text[start] == '[' {
+		closing = "]"
+	}
+	end := strings.LastIndex(text, closing)
+	if end <= start {
+		return "", false
+	}
+	if candidate := text[start : end+1]; json.Valid([]byte(candidate)) {
+		return candidate, true
+	}
+	return "", false
+}
@@ -0,0 +1,180 @@
+// Package jsonmode provides a plugin that emulates the response_format parameter of chat
+// completions for providers without native structured output support, so that
+// response_format json_schema and json_object requests work the same across providers.
+//
+// For an emulated provider, the expected JSON (and the JSON schema, if any) is described in the
+// system prompt, and response_format is either downgraded to json_object or dropped, depending on
+// what the provider supports. The text of the response is then reduced to the JSON it contains:
+// markdown code fences, reasoning blocks and text around the JSON are removed. Each emulated
+// request gets a warning in the response's extra fields.
+//
+// Streamed responses are not rewritten: the instructions are added, but chunks are passed through.
+package jsonmode
+
+import (
+	"fmt"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	PluginName = "jsonmode"
+)
+
+// Emulation modes
+const (
+	ModeNative     = "native"      // response_format is sent as is
+	ModeJSONObject = "json_object" // json_schema is downgraded to json_object and the schema is described in the system prompt
+	ModePrompt     = "prompt"      // response_format is dropped and the expected JSON is described in the system prompt
+)
+
+// DefaultProviderModes are the emulation modes of providers without native json_schema support.
+// Providers that are not listed use ModeNative.
+var DefaultProviderModes = map[schemas.ModelProvider]string{
+	schemas.Deepseek: ModeJSONObject,
+	schemas.GLM:      ModeJSONObject,
+	schemas.Qwen:     ModeJSONObject,
+	schemas.Moonshot: ModeJSONObject,
+	schemas.Minimax:  ModePrompt,
+	schemas.Hunyuan:  ModePrompt,
+	schemas.StepFun:  ModePrompt,
+	schemas.Yi:       ModePrompt,
+	schemas.Spark:    ModePrompt,
+}
+
+// emulationKey stores the emulation of the current request attempt in the context
+const emulationKey schemas.BifrostContextKey = "jsonmode-emulation"
+
+// Config holds configuration options for the JSON mode plugin
+type Config struct {
+	Providers map[schemas.ModelProvider]string `json:"providers"` // Emulation mode per provider, merged over DefaultProviderModes
+}
+
+// JSONModePlugin emulates response_format for chat completion requests to providers without native support
+type JSONModePlugin struct {
+	modes  map[schemas.ModelProvider]string
+	logger schemas.Logger
+}
+
+// emulation records how the response_format of a request attempt was emulated
+type emulation struct {
+	provider schemas.ModelProvider
+	mode     string
+}
+
+// Init creates a new JSON mode plugin instance, validating the configured modes.
+func Init(config Config, logger schemas.Logger) (*JSONModePlugin, error) {
+	modes := make(map[schemas.ModelProvider]string, len(DefaultProviderModes)+len(config.Providers))
+	for provider, mode := range DefaultProviderModes {
+		modes[provider] = mode
+	}
+	for provider, mode := range config.Providers {
+		switch mode {
+		case ModeNative, ModeJSONObject, ModePrompt:
+			modes[provider] = mode
+		default:
+			return nil, fmt.Errorf("unknown json mode %q for provider %s", mode, provider)
+		}
+	}
+	return &JSONModePlugin{
+		modes:  modes,
+		logger: logger,
+	}, nil
+}
+
+// GetName returns the plugin name
+func (p *JSONModePlugin) GetName() string {
+	return PluginName
+}
+
+// HTTPTransportPreHook is not used for this plugin
+func (p *JSONModePlugin) HTTPTransportPreHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest) (*schemas.HTTPResponse, error) {
+	return nil, nil
+}
+
+// HTTPTransportPostHook is not used for this plugin
+func (p *JSONModePlugin) HTTPTransportPostHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest, resp *schemas.HTTPResponse) error {
+	return nil
+}
+
+// HTTPTransportStreamChunkHook passes through streaming chunks unchanged
+func (p *JSONModePlugin) HTTPTransportStreamChunkHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest, chunk *schemas.BifrostStreamChunk) (*schemas.BifrostStreamChunk, error) {
+	return chunk, nil
+}
+
+// PreLLMHook rewrites the response_format and system prompt of chat requests to emulated providers.
+// It runs for every attempt, so each fallback is emulated according to its own provider. The
+// caller's messages and parameters are never modified; rewritten ones are copies.
+func (p *JSONModePlugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.LLMPluginShortCircuit, error) {
+	ctx.SetValue(emulationKey, nil)
+	chatReq := req.ChatRequest
+	if chatReq == nil || chatReq.Params == nil || chatReq.Params.ResponseFormat == nil {
+		return req, nil, nil
+	}
+	mode, ok := p.modes[chatReq.Provider]
+	if !ok || mode == ModeNative {
+		return req, nil, nil
+	}
+	format, err := parseResponseFormat(*chatReq.Params.ResponseFormat)
+	if err != nil || (format.Type != formatJSONSchema && format.Type != formatJSONObject) {
+		return req, nil, nil
+	}
+	if mode == ModeJSONObject && format.Type == formatJSONObject {
+		// Supported natively; only the response text is cleaned up
+		ctx.SetValue(emulationKey, &emulation{provider: chatReq.Provider, mode: mode})
+		return req, nil, nil
+	}
+
+	params := *chatReq.Params
+	if mode == ModeJSONObject {
+		var jsonObject interface{} = map[string]interface{}{"type": formatJSONObject}
+		params.ResponseFormat = &jsonObject
+	} else {
+		params.ResponseFormat = nil
+	}
+	chatReq.Params = &params
+	chatReq.Input = withInstructions(chatReq.Input, format.instructions())
+	ctx.SetValue(emulationKey, &emulation{provider: chatReq.Provider, mode: mode})
+	if p.logger != nil {
+		p.logger.Debug("%s: emulating %s response_format for %s with mode %s", PluginName, format.Type, chatReq.Provider, mode)
+	}
+	return req, nil, nil
+}
+
+// PostLLMHook reduces the message text of emulated chat responses to the JSON it contains and
+// records the emulation in the response warnings. For streams, the warning is added to the final
+// chunk only.
+func (p *JSONModePlugin) PostLLMHook(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	emulated, ok := ctx.Value(emulationKey).(*emulation)
+	if !ok || emulated == nil || result == nil || result.ChatResponse == nil {
+		return result, bifrostErr, nil
+	}
+	extraFields := &result.ChatResponse.ExtraFields
+	if bifrost.IsStreamRequestType(extraFields.RequestType) {
+		if bifrost.IsFinalChunk(ctx) {
+			extraFields.Warnings = append(extraFields.Warnings, fmt.Sprintf("%s: response_format emulated for %s (%s), streamed text is not cleaned up", PluginName, emulated.provider, emulated.mode))
+		}
+		return result, bifrostErr, nil
+	}
+	warning := fmt.Sprintf("%s: response_format emulated for %s (%s)", PluginName, emulated.provider, emulated.mode)
+	for i := range result.ChatResponse.Choices {
+		choice := &result.ChatResponse.Choices[i]
+		if choice.ChatNonStreamResponseChoice == nil || choice.Message == nil || choice.Message.Content == nil || choice.Message.Content.ContentStr == nil {
+			continue
+		}
+		text, ok := extractJSON(*choice.Message.Content.ContentStr)
+		if !ok {
+			warning = fmt.Sprintf("%s: response_format emulated for %s (%s), choice %d has no valid JSON", PluginName, emulated.provider, emulated.mode, choice.Index)
+			continue
+		}
+		choice.Message.Content.ContentStr = &text
+	}
+	extraFields.Warnings = append(extraFields.Warnings, warning)
+	return result, bifrostErr, nil
+}
+
+// Cleanup performs plugin cleanup
+func (p *JSONModePlugin) Cleanup() error {
+	return nil
+}
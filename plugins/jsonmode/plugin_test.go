@@ -0,0 +1,238 @@
+package jsonmode
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+var personFormat interface{} = map[string]interface{}{
+	"type": "json_schema",
+	"json_schema": map[string]interface{}{
+		"name": "person",
+		"schema": map[string]interface{}{
+			"type":       "object",
+			"properties": map[string]interface{}{"name": map[string]interface{}{"type": "string"}},
+		},
+	},
+}
+
+func chatRequest(provider schemas.ModelProvider, format interface{}, messages ...schemas.ChatMessage) *schemas.BifrostRequest {
+	return &schemas.BifrostRequest{
+		RequestType: schemas.ChatCompletionRequest,
+		ChatRequest: &schemas.BifrostChatRequest{
+			Provider: provider,
+			Model:    "model",
+			Input:    messages,
+			Params:   &schemas.ChatParameters{ResponseFormat: &format},
+		},
+	}
+}
+
+func message(role schemas.ChatMessageRole, text string) schemas.ChatMessage {
+	return schemas.ChatMessage{Role: role, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(text)}}
+}
+
+func chatResponse(requestType schemas.RequestType, text string) *schemas.BifrostResponse {
+	return &schemas.BifrostResponse{
+		ChatResponse: &schemas.BifrostChatResponse{
+			Choices: []schemas.BifrostResponseChoice{{
+				ChatNonStreamResponseChoice: &schemas.ChatNonStreamResponseChoice{
+					Message: &schemas.ChatMessage{
+						Role:    schemas.ChatMessageRoleAssistant,
+						Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(text)},
+					},
+				},
+			}},
+			ExtraFields: schemas.BifrostResponseExtraFields{RequestType: requestType},
+		},
+	}
+}
+
+func newContext() *schemas.BifrostContext {
+	return schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+}
+
+func TestInit(t *testing.T) {
+	p, err := Init(Config{Providers: map[schemas.ModelProvider]string{schemas.Deepseek: ModeNative, schemas.OpenAI: ModePrompt}}, nil)
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	if p.modes[schemas.Deepseek] != ModeNative || p.modes[schemas.OpenAI] != ModePrompt || p.modes[schemas.Minimax] != ModePrompt {
+		t.Errorf("modes = %v, want configured modes merged over the defaults", p.modes)
+	}
+	if _, err := Init(Config{Providers: map[schemas.ModelProvider]string{schemas.OpenAI: "strict"}}, nil); err == nil {
+		t.Error("Init() with an unknown mode succeeded, want an error")
+	}
+}
+
+func TestPreLLMHook(t *testing.T) {
+	p, err := Init(Config{}, nil)
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+
+	t.Run("native provider", func(t *testing.T) {
+		ctx := newContext()
+		req := chatRequest(schemas.OpenAI, personFormat, message(schemas.ChatMessageRoleUser, "hi"))
+		params := req.ChatRequest.Params
+		if _, _, err := p.PreLLMHook(ctx, req); err != nil {
+			t.Fatalf("PreLLMHook() error = %v", err)
+		}
+		if req.ChatRequest.Params != params || len(req.ChatRequest.Input) != 1 || ctx.Value(emulationKey) != nil {
+			t.Error("request to a native provider was rewritten")
+		}
+	})
+
+	t.Run("json_object mode", func(t *testing.T) {
+		ctx := newContext()
+		system := message(schemas.ChatMessageRoleSystem, "Be brief.")
+		input := []schemas.ChatMessage{system, message(schemas.ChatMessageRoleUser, "hi")}
+		req := chatRequest(schemas.Deepseek, personFormat, input...)
+		params := req.ChatRequest.Params
+		if _, _, err := p.PreLLMHook(ctx, req); err != nil {
+			t.Fatalf("PreLLMHook() error = %v", err)
+		}
+		format, err := parseResponseFormat(*req.ChatRequest.Params.ResponseFormat)
+		if err != nil || format.Type != formatJSONObject {
+			t.Errorf("response_format = %v, want json_object", *req.ChatRequest.Params.ResponseFormat)
+		}
+		if len(req.ChatRequest.Input) != 2 {
+			t.Fatalf("got %d messages, want the instructions merged into the system message", len(req.ChatRequest.Input))
+		}
+		text := *req.ChatRequest.Input[0].Content.ContentStr
+		if !strings.HasPrefix(text, "Be brief.\n\n") || !strings.Contains(text, `"properties":{"name":{"type":"string"}}`) || !strings.Contains(text, "(person)") {
+			t.Errorf("system message = %q, want the schema appended", text)
+		}
+		if original, _ := parseResponseFormat(*params.ResponseFormat); *input[0].Content.ContentStr != "Be brief." || original.Type != formatJSONSchema {
+			t.Error("caller's messages or parameters were modified")
+		}
+		if emulated, _ := ctx.Value(emulationKey).(*emulation); emulated == nil || emulated.mode != ModeJSONObject {
+			t.Errorf("emulation = %v, want json_object", ctx.Value(emulationKey))
+		}
+	})
+
+	t.Run("json_object request in json_object mode", func(t *testing.T) {
+		ctx := newContext()
+		var jsonObject interface{} = map[string]interface{}{"type": "json_object"}
+		req := chatRequest(schemas.Qwen, jsonObject, message(schemas.ChatMessageRoleUser, "hi"))
+		params := req.ChatRequest.Params
+		if _, _, err := p.PreLLMHook(ctx, req); err != nil {
+			t.Fatalf("PreLLMHook() error = %v", err)
+		}
+		if req.ChatRequest.Params != params || len(req.ChatRequest.Input) != 1 || ctx.Value(emulationKey) == nil {
+			t.Error("natively supported json_object request was rewritten or not marked as emulated")
+		}
+	})
+
+	t.Run("prompt mode", func(t *testing.T) {
+		ctx := newContext()
+		req := chatRequest(schemas.Minimax, personFormat, message(schemas.ChatMessageRoleUser, "hi"))
+		if _, _, err := p.PreLLMHook(ctx, req); err != nil {
+			t.Fatalf("PreLLMHook() error = %v", err)
+		}
+		if req.ChatRequest.Params.ResponseFormat != nil {
+			t.Error("response_format was not dropped")
+		}
+		if len(req.ChatRequest.Input) != 2 || req.ChatRequest.Input[0].Role != schemas.ChatMessageRoleSystem {
+			t.Fatalf("messages = %+v, want a system message prepended", req.ChatRequest.Input)
+		}
+	})
+
+	t.Run("fallback resets emulation", func(t *testing.T) {
+		ctx := newContext()
+		if _, _, err := p.PreLLMHook(ctx, chatRequest(schemas.Minimax, personFormat, message(schemas.ChatMessageRoleUser, "hi"))); err != nil {
+			t.Fatalf("PreLLMHook() error = %v", err)
+		}
+		if _, _, err := p.PreLLMHook(ctx, chatRequest(schemas.OpenAI, personFormat, message(schemas.ChatMessageRoleUser, "hi"))); err != nil {
+			t.Fatalf("PreLLMHook() error = %v", err)
+		}
+		if emulated, _ := ctx.Value(emulationKey).(*emulation); emulated != nil {
+			t.Errorf("emulation = %+v after a native fallback, want none", emulated)
+		}
+	})
+}
+
+func TestWithInstructionsContentBlocks(t *testing.T) {
+	blocks := []schemas.ChatContentBlock{{Type: schemas.ChatContentBlockTypeText, Text: schemas.Ptr("Be brief.")}}
+	input := []schemas.ChatMessage{{Role: schemas.ChatMessageRoleSystem, Content: &schemas.ChatMessageContent{ContentBlocks: blocks}}}
+	result := withInstructions(input, "Respond with JSON.")
+	if got := result[0].Content.ContentBlocks; len(got) != 2 || *got[1].Text != "Respond with JSON." {
+		t.Errorf("content blocks = %+v, want the instructions appended as a text block", got)
+	}
+	if len(input[0].Content.ContentBlocks) != 1 {
+		t.Error("caller's content blocks were modified")
+	}
+}
+
+func TestExtractJSON(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+		ok   bool
+	}{
+		{`{"name":"Ada"}`, `{"name":"Ada"}`, true},
+		{"```json\n{\"name\": \"Ada\"}\n```", `{"name": "Ada"}`, true},
+		{"Here you go:\n```\n[1, 2]\n```\nAnything else?", `[1, 2]`, true},
+		{"<think>The user wants {a person}.</think>\n{\"name\":\"Ada\"}", `{"name":"Ada"}`, true},
+		{`Sure! {"name":"Ada"} Hope this helps.`, `{"name":"Ada"}`, true},
+		{`{"name":`, "", false},
+		{"no json here", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := extractJSON(tt.text)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("extractJSON(%q) = %q, %v, want %q, %v", tt.text, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestPostLLMHook(t *testing.T) {
+	p, err := Init(Config{}, nil)
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+
+	ctx := newContext()
+	result, _, err := p.PostLLMHook(ctx, chatResponse(schemas.ChatCompletionRequest, "```json\n{}\n```"), nil)
+	if err != nil {
+		t.Fatalf("PostLLMHook() error = %v", err)
+	}
+	if got := *result.ChatResponse.Choices[0].Message.Content.ContentStr; got != "```json\n{}\n```" || len(result.ChatResponse.ExtraFields.Warnings) != 0 {
+		t.Errorf("response without emulation was rewritten to %q", got)
+	}
+
+	ctx.SetValue(emulationKey, &emulation{provider: schemas.Minimax, mode: ModePrompt})
+	result, _, err = p.PostLLMHook(ctx, chatResponse(schemas.ChatCompletionRequest, "```json\n{}\n```"), nil)
+	if err != nil {
+		t.Fatalf("PostLLMHook() error = %v", err)
+	}
+	if got := *result.ChatResponse.Choices[0].Message.Content.ContentStr; got != "{}" {
+		t.Errorf("content = %q, want the extracted JSON", got)
+	}
+	if warnings := result.ChatResponse.ExtraFields.Warnings; len(warnings) != 1 || !strings.HasPrefix(warnings[0], PluginName+": ") {
+		t.Errorf("warnings = %v, want one jsonmode warning", warnings)
+	}
+
+	result, _, err = p.PostLLMHook(ctx, chatResponse(schemas.ChatCompletionRequest, "I can't do that."), nil)
+	if err != nil {
+		t.Fatalf("PostLLMHook() error = %v", err)
+	}
+	if got := *result.ChatResponse.Choices[0].Message.Content.ContentStr; got != "I can't do that." {
+		t.Errorf("content = %q, want the text kept when it has no JSON", got)
+	}
+	if warnings := result.ChatResponse.ExtraFields.Warnings; len(warnings) != 1 || !strings.Contains(warnings[0], "no valid JSON") {
+		t.Errorf("warnings = %v, want a no valid JSON warning", warnings)
+	}
+
+	stream := chatResponse(schemas.ChatCompletionStreamRequest, "{")
+	result, _, err = p.PostLLMHook(ctx, stream, nil)
+	if err != nil {
+		t.Fatalf("PostLLMHook() error = %v", err)
+	}
+	if got := *result.ChatResponse.Choices[0].Message.Content.ContentStr; got != "{" || len(result.ChatResponse.ExtraFields.Warnings) != 0 {
+		t.Error("non-final stream chunk was rewritten")
+	}
+}
@@ -105,6 +105,7 @@ type InitialLogData struct {
 	Tools                 []schemas.ChatTool
 	RoutingEngineUsed     []string
 	Metadata              map[string]interface{}
+	Tags                  map[string]string
 }
 
 // LogCallback is a function that gets called when a new log entry is created
@@ -359,15 +360,24 @@ func (p *LoggerPlugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.Bifr
 			initialData.Params = req.EmbeddingRequest.Params
 		case schemas.RerankRequest:
 			initialData.Params = req.RerankRequest.Params
+		case schemas.ModerationRequest:
+			initialData.Params = req.ModerationRequest.Params
 		case schemas.SpeechRequest, schemas.SpeechStreamRequest:
 			initialData.Params = req.SpeechRequest.Params
 			initialData.SpeechInput = req.SpeechRequest.Input
 		case schemas.TranscriptionRequest, schemas.TranscriptionStreamRequest:
 			initialData.Params = req.TranscriptionRequest.Params
 			initialData.TranscriptionInput = req.TranscriptionRequest.Input
+		case schemas.TranslationRequest:
+			initialData.Params = req.TranslationRequest.Params
+			initialData.TranscriptionInput = req.TranslationRequest.Input
 		case schemas.ImageGenerationRequest, schemas.ImageGenerationStreamRequest:
 			initialData.Params = req.ImageGenerationRequest.Params
 			initialData.ImageGenerationInput = req.ImageGenerationRequest.Input
+		case schemas.MusicGenerationRequest:
+			initialData.Params = req.MusicGenerationRequest.Params
+		case schemas.ContextCacheCreateRequest:
+			initialData.Params = req.ContextCacheCreateRequest.Params
 		case schemas.VideoGenerationRequest:
 			initialData.Params = req.VideoGenerationRequest.Params
 			initialData.VideoGenerationInput = req.VideoGenerationRequest.Input
@@ -424,6 +434,7 @@ func (p *LoggerPlugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.Bifr
 	}
 
 	initialData.RoutingEngineUsed = routingEngines
+	initialData.Tags = schemas.GetRequestTags(ctx)
 	initialData.Status = "processing"
 
 	// Store input data in pendingLogs for later combination with PostLLMHook output.
@@ -431,6 +442,7 @@ func (p *LoggerPlugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.Bifr
 	pending := &PendingLogData{
 		RequestID:          effectiveRequestID,
 		ParentRequestID:    parentRequestID,
+		TraceID:            bifrost.GetStringFromContext(ctx, schemas.BifrostContextKeyTraceID),
 		Timestamp:          createdTimestamp,
 		FallbackIndex:      fallbackIndex,
 		RoutingEnginesUsed: routingEngines,
@@ -42,6 +42,7 @@ func (p *LoggerPlugin) insertInitialLogEntry(
 		ImageGenerationInputParsed:  data.ImageGenerationInput,
 		RoutingEnginesUsed:          routingEnginesUsed,
 		MetadataParsed:              data.Metadata,
+		Tags:                        data.Tags,
 		VideoGenerationInputParsed:  data.VideoGenerationInput,
 	}
 	if parentRequestID != "" {
@@ -549,6 +550,8 @@ func (p *LoggerPlugin) applyNonStreamingOutputToEntry(entry *logstore.Log, resul
 		usage = result.ResponsesResponse.Usage.ToBifrostLLMUsage()
 	case result.EmbeddingResponse != nil && result.EmbeddingResponse.Usage != nil:
 		usage = result.EmbeddingResponse.Usage
+	case result.RealtimeResponse != nil && result.RealtimeResponse.Usage != nil:
+		usage = result.RealtimeResponse.Usage
 	case result.TranscriptionResponse != nil && result.TranscriptionResponse.Usage != nil:
 		usage = &schemas.BifrostLLMUsage{}
 		if result.TranscriptionResponse.Usage.InputTokens != nil {
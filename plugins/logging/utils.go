@@ -325,6 +325,9 @@ func (p *LoggerPlugin) extractInputHistory(request *schemas.BifrostRequest) ([]s
 	if request.ChatRequest != nil {
 		return request.ChatRequest.Input, []schemas.ResponsesMessage{}
 	}
+	if request.ContextCacheCreateRequest != nil {
+		return request.ContextCacheCreateRequest.Input, []schemas.ResponsesMessage{}
+	}
 	if request.ResponsesRequest != nil && len(request.ResponsesRequest.Input) > 0 {
 		return []schemas.ChatMessage{}, request.ResponsesRequest.Input
 	}
@@ -22,6 +22,7 @@ const (
 type PendingLogData struct {
 	RequestID          string
 	ParentRequestID    string
+	TraceID            string
 	Timestamp          time.Time
 	FallbackIndex      int
 	Status             string
@@ -206,9 +207,13 @@ func buildInitialLogEntry(pending *PendingLogData) *logstore.Log {
 	if pending.ParentRequestID != "" {
 		entry.ParentRequestID = &pending.ParentRequestID
 	}
+	if pending.TraceID != "" {
+		entry.TraceID = &pending.TraceID
+	}
 	if len(pending.RoutingEnginesUsed) > 0 {
 		entry.RoutingEnginesUsed = pending.RoutingEnginesUsed
 	}
+	entry.Tags = pending.InitialData.Tags
 	return entry
 }
 
@@ -236,9 +241,13 @@ func buildCompleteLogEntryFromPending(pending *PendingLogData) *logstore.Log {
 	if pending.ParentRequestID != "" {
 		entry.ParentRequestID = &pending.ParentRequestID
 	}
+	if pending.TraceID != "" {
+		entry.TraceID = &pending.TraceID
+	}
 	if len(pending.RoutingEnginesUsed) > 0 {
 		entry.RoutingEnginesUsed = pending.RoutingEnginesUsed
 	}
+	entry.Tags = pending.InitialData.Tags
 	return entry
 }
 
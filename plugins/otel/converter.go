@@ -69,11 +69,11 @@ func hexToBytes(hexStr string, length int) []byte {
 	return bytes
 }
 
-// convertTraceToResourceSpan converts a Bifrost trace to OTEL ResourceSpan
-func (p *OtelPlugin) convertTraceToResourceSpan(trace *schemas.Trace) *ResourceSpan {
+// convertTraceToResourceSpan converts a Bifrost trace to OTEL ResourceSpan, dropping prompts and responses when disableContent is set
+func (p *OtelPlugin) convertTraceToResourceSpan(trace *schemas.Trace, disableContent bool) *ResourceSpan {
 	otelSpans := make([]*Span, 0, len(trace.Spans))
 	for _, span := range trace.Spans {
-		otelSpans = append(otelSpans, p.convertSpanToOTELSpan(trace.TraceID, span))
+		otelSpans = append(otelSpans, p.convertSpanToOTELSpan(trace.TraceID, span, disableContent))
 	}
 
 	return &ResourceSpan{
@@ -88,7 +88,16 @@ func (p *OtelPlugin) convertTraceToResourceSpan(trace *schemas.Trace) *ResourceS
 }
 
 // convertSpanToOTELSpan converts a single Bifrost span to OTEL format
-func (p *OtelPlugin) convertSpanToOTELSpan(traceID string, span *schemas.Span) *Span {
+func (p *OtelPlugin) convertSpanToOTELSpan(traceID string, span *schemas.Span, disableContent bool) *Span {
+	attributes := span.Attributes
+	if disableContent {
+		attributes = make(map[string]any, len(span.Attributes))
+		for k, v := range span.Attributes {
+			if !contentAttributes[k] {
+				attributes[k] = v
+			}
+		}
+	}
 	otelSpan := &Span{
 		TraceId:           hexToBytes(traceID, 16),
 		SpanId:            hexToBytes(span.SpanID, 8),
@@ -96,7 +105,7 @@ func (p *OtelPlugin) convertSpanToOTELSpan(traceID string, span *schemas.Span) *
 		Kind:              convertSpanKind(span.Kind),
 		StartTimeUnixNano: uint64(span.StartTime.UnixNano()),
 		EndTimeUnixNano:   uint64(span.EndTime.UnixNano()),
-		Attributes:        convertAttributesToKeyValues(span.Attributes),
+		Attributes:        convertAttributesToKeyValues(attributes),
 		Status:            convertSpanStatus(span.Status, span.StatusMsg),
 		Events:            convertSpanEvents(span.Events),
 	}
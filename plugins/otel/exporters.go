@@ -0,0 +1,202 @@
+package otel
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// Platform is the observability platform an exporter ships traces to
+type Platform string
+
+// PlatformOTLP is a generic OTLP collector
+const PlatformOTLP Platform = "otlp"
+
+// PlatformLangfuse is Langfuse, which ingests OTLP over HTTP authenticated with a public and secret key
+const PlatformLangfuse Platform = "langfuse"
+
+// PlatformPhoenix is Arize Phoenix, which ingests OTLP authenticated with an API key
+const PlatformPhoenix Platform = "phoenix"
+
+// Default endpoints of the hosted platforms
+const (
+	defaultLangfuseEndpoint = "https://cloud.langfuse.com/api/public/otel/v1/traces"
+	defaultPhoenixEndpoint  = "https://app.phoenix.arize.com/v1/traces"
+)
+
+// contentAttributes are the span attributes holding prompts and responses, dropped when content export is disabled
+var contentAttributes = map[string]bool{
+	schemas.AttrInputText:      true,
+	schemas.AttrInputMessages:  true,
+	schemas.AttrInputSpeech:    true,
+	schemas.AttrInputEmbedding: true,
+	schemas.AttrOutputMessages: true,
+	schemas.AttrPrompt:         true,
+	schemas.AttrInstructions:   true,
+}
+
+// ExporterConfig is an additional destination for completed traces
+type ExporterConfig struct {
+	Name         string            `json:"name"`
+	Platform     Platform          `json:"platform"`      // otlp (default), langfuse or phoenix
+	CollectorURL string            `json:"collector_url"` // defaults to the hosted endpoint for langfuse and phoenix
+	Headers      map[string]string `json:"headers"`
+	Protocol     Protocol          `json:"protocol"` // defaults to http
+	TLSCACert    string            `json:"tls_ca_cert"`
+	Insecure     bool              `json:"insecure"`
+
+	PublicKey string `json:"public_key"` // Langfuse public key
+	SecretKey string `json:"secret_key"` // Langfuse secret key
+	APIKey    string `json:"api_key"`    // Phoenix API key
+
+	// TeamIDs restricts the exporter to requests made by these governance teams, empty exports every request
+	TeamIDs []string `json:"team_ids,omitempty"`
+	// DisableContent drops prompts and responses from the exported spans
+	DisableContent bool `json:"disable_content"`
+}
+
+// exporter ships completed traces to one destination
+type exporter struct {
+	name           string
+	client         OtelClient
+	teamIDs        []string
+	disableContent bool
+}
+
+// resolveEnvValue resolves values of the form env.NAME from the environment
+func resolveEnvValue(value string) (string, error) {
+	name, ok := strings.CutPrefix(value, "env.")
+	if !ok {
+		return value, nil
+	}
+	resolved := os.Getenv(name)
+	if resolved == "" {
+		return "", fmt.Errorf("environment variable %s not found", name)
+	}
+	return resolved, nil
+}
+
+// newExporter creates the client of an exporter, applying the defaults and credentials of its platform
+func newExporter(config ExporterConfig) (*exporter, error) {
+	headers := make(map[string]string, len(config.Headers)+1)
+	for key, value := range config.Headers {
+		resolved, err := resolveEnvValue(value)
+		if err != nil {
+			return nil, err
+		}
+		headers[key] = resolved
+	}
+	endpoint := config.CollectorURL
+	protocol := config.Protocol
+	if protocol == "" {
+		protocol = ProtocolHTTP
+	}
+	switch config.Platform {
+	case "", PlatformOTLP:
+		if endpoint == "" {
+			return nil, fmt.Errorf("collector_url is required")
+		}
+	case PlatformLangfuse:
+		if endpoint == "" {
+			endpoint = defaultLangfuseEndpoint
+		}
+		if protocol != ProtocolHTTP {
+			return nil, fmt.Errorf("langfuse only supports the http protocol")
+		}
+		publicKey, err := resolveEnvValue(config.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		secretKey, err := resolveEnvValue(config.SecretKey)
+		if err != nil {
+			return nil, err
+		}
+		if publicKey == "" || secretKey == "" {
+			return nil, fmt.Errorf("public_key and secret_key are required for langfuse")
+		}
+		headers["Authorization"] = "Basic " + base64.StdEncoding.EncodeToString([]byte(publicKey+":"+secretKey))
+	case PlatformPhoenix:
+		if endpoint == "" {
+			endpoint = defaultPhoenixEndpoint
+		}
+		if config.APIKey != "" {
+			apiKey, err := resolveEnvValue(config.APIKey)
+			if err != nil {
+				return nil, err
+			}
+			headers["api_key"] = apiKey
+		}
+	default:
+		return nil, fmt.Errorf("unsupported platform %q", config.Platform)
+	}
+
+	var client OtelClient
+	var err error
+	switch protocol {
+	case ProtocolHTTP:
+		client, err = NewOtelClientHTTP(endpoint, headers, config.TLSCACert, config.Insecure)
+	case ProtocolGRPC:
+		client, err = NewOtelClientGRPC(endpoint, headers, config.TLSCACert, config.Insecure)
+	default:
+		return nil, fmt.Errorf("invalid protocol type %q", protocol)
+	}
+	if err != nil {
+		return nil, err
+	}
+	name := config.Name
+	if name == "" {
+		name = endpoint
+	}
+	return &exporter{
+		name:           name,
+		client:         client,
+		teamIDs:        config.TeamIDs,
+		disableContent: config.DisableContent,
+	}, nil
+}
+
+// accepts reports whether the exporter ships traces of the team
+func (e *exporter) accepts(teamID string) bool {
+	return len(e.teamIDs) == 0 || slices.Contains(e.teamIDs, teamID)
+}
+
+// emit converts the trace and ships it to every exporter accepting it
+func (p *OtelPlugin) emit(ctx context.Context, trace *schemas.Trace) {
+	teamID := traceTeamID(trace)
+	var withContent, withoutContent *ResourceSpan
+	for _, e := range p.exporters {
+		if !e.accepts(teamID) {
+			continue
+		}
+		var resourceSpan *ResourceSpan
+		if e.disableContent {
+			if withoutContent == nil {
+				withoutContent = p.convertTraceToResourceSpan(trace, true)
+			}
+			resourceSpan = withoutContent
+		} else {
+			if withContent == nil {
+				withContent = p.convertTraceToResourceSpan(trace, false)
+			}
+			resourceSpan = withContent
+		}
+		if err := e.client.Emit(ctx, []*ResourceSpan{resourceSpan}); err != nil {
+			logger.Error("failed to emit trace %s to %s: %v", trace.TraceID, e.name, err)
+		}
+	}
+}
+
+// traceTeamID returns the governance team of the request traced, or an empty string
+func traceTeamID(trace *schemas.Trace) string {
+	for _, span := range trace.Spans {
+		if teamID := getStringAttr(span.Attributes, schemas.AttrTeamID); teamID != "" {
+			return teamID
+		}
+	}
+	return ""
+}
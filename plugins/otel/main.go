@@ -52,6 +52,11 @@ type Config struct {
 	TLSCACert    string            `json:"tls_ca_cert"`
 	Insecure     bool              `json:"insecure"` // Skip TLS when true; ignored if TLSCACert is set
 
+	// DisableContent drops prompts and responses from the spans sent to the collector
+	DisableContent bool `json:"disable_content"`
+	// Exporters are additional destinations such as Langfuse or Phoenix, optionally restricted to teams
+	Exporters []ExporterConfig `json:"exporters,omitempty"`
+
 	// Metrics push configuration
 	MetricsEnabled      bool   `json:"metrics_enabled"`
 	MetricsEndpoint     string `json:"metrics_endpoint"`
@@ -75,7 +80,7 @@ type OtelPlugin struct {
 
 	attributesFromEnvironment []*commonpb.KeyValue
 
-	client OtelClient
+	exporters []*exporter
 
 	pricingManager *modelcatalog.ModelCatalog
 
@@ -131,20 +136,34 @@ func Init(ctx context.Context, config *Config, _logger schemas.Logger, pricingMa
 		attributesFromEnvironment: attributesFromEnvironment,
 	}
 	p.ctx, p.cancel = context.WithCancel(ctx)
-	if config.Protocol == ProtocolGRPC {
-		p.client, err = NewOtelClientGRPC(config.CollectorURL, config.Headers, config.TLSCACert, config.Insecure)
+	if config.CollectorURL != "" {
+		if config.Protocol != ProtocolGRPC && config.Protocol != ProtocolHTTP {
+			return nil, fmt.Errorf("otel client is not initialized. invalid protocol type")
+		}
+		collector, err := newExporter(ExporterConfig{
+			Name:           "collector",
+			CollectorURL:   config.CollectorURL,
+			Headers:        config.Headers,
+			Protocol:       config.Protocol,
+			TLSCACert:      config.TLSCACert,
+			Insecure:       config.Insecure,
+			DisableContent: config.DisableContent,
+		})
 		if err != nil {
 			return nil, err
 		}
+		p.exporters = append(p.exporters, collector)
 	}
-	if config.Protocol == ProtocolHTTP {
-		p.client, err = NewOtelClientHTTP(config.CollectorURL, config.Headers, config.TLSCACert, config.Insecure)
+	for i, exporterConfig := range config.Exporters {
+		e, err := newExporter(exporterConfig)
 		if err != nil {
-			return nil, err
+			p.closeExporters()
+			return nil, fmt.Errorf("failed to initialize exporter %d: %w", i, err)
 		}
+		p.exporters = append(p.exporters, e)
 	}
-	if p.client == nil {
-		return nil, fmt.Errorf("otel client is not initialized. invalid protocol type")
+	if len(p.exporters) == 0 {
+		return nil, fmt.Errorf("collector url or at least one exporter is required")
 	}
 
 	// Initialize metrics exporter if enabled
@@ -169,10 +188,8 @@ func Init(ctx context.Context, config *Config, _logger schemas.Logger, pricingMa
 		}
 		p.metricsExporter, err = NewMetricsExporter(p.ctx, metricsConfig)
 		if err != nil {
-			// Clean up trace client if metrics exporter fails
-			if p.client != nil {
-				p.client.Close()
-			}
+			// Clean up trace clients if metrics exporter fails
+			p.closeExporters()
 			return nil, fmt.Errorf("failed to initialize metrics exporter: %w", err)
 		}
 		logger.Info("OTEL metrics push enabled, pushing to %s every %d seconds", config.MetricsEndpoint, pushInterval)
@@ -225,14 +242,31 @@ func (p *OtelPlugin) ValidateConfig(config any) (*Config, error) {
 		otelConfig = *config
 	}
 	// Validating fields
-	if otelConfig.CollectorURL == "" {
+	if otelConfig.CollectorURL == "" && len(otelConfig.Exporters) == 0 {
 		return nil, fmt.Errorf("collector url is required")
 	}
-	if otelConfig.TraceType == "" {
-		return nil, fmt.Errorf("trace type is required")
+	if otelConfig.CollectorURL != "" {
+		if otelConfig.TraceType == "" {
+			return nil, fmt.Errorf("trace type is required")
+		}
+		if otelConfig.Protocol == "" {
+			return nil, fmt.Errorf("protocol is required")
+		}
 	}
-	if otelConfig.Protocol == "" {
-		return nil, fmt.Errorf("protocol is required")
+	for i, exporterConfig := range otelConfig.Exporters {
+		switch exporterConfig.Platform {
+		case "", PlatformOTLP:
+			if exporterConfig.CollectorURL == "" {
+				return nil, fmt.Errorf("exporter %d: collector url is required", i)
+			}
+		case PlatformLangfuse:
+			if exporterConfig.PublicKey == "" || exporterConfig.SecretKey == "" {
+				return nil, fmt.Errorf("exporter %d: public_key and secret_key are required for langfuse", i)
+			}
+		case PlatformPhoenix:
+		default:
+			return nil, fmt.Errorf("exporter %d: unsupported platform %q", i, exporterConfig.Platform)
+		}
 	}
 	return &otelConfig, nil
 }
@@ -258,16 +292,8 @@ func (p *OtelPlugin) Inject(ctx context.Context, trace *schemas.Trace) error {
 		return nil
 	}
 
-	// Emit trace to collector if client is initialized
-	if p.client != nil {
-		// Convert schemas.Trace to OTEL ResourceSpan
-		resourceSpan := p.convertTraceToResourceSpan(trace)
-
-		// Emit to collector
-		if err := p.client.Emit(ctx, []*ResourceSpan{resourceSpan}); err != nil {
-			logger.Error("failed to emit trace %s: %v", trace.TraceID, err)
-		}
-	}
+	// Emit trace to the collector and the exporters accepting it
+	p.emit(ctx, trace)
 
 	// Record metrics if metrics exporter is enabled
 	if p.metricsExporter != nil {
@@ -432,10 +458,18 @@ func (p *OtelPlugin) Cleanup() error {
 			logger.Error("failed to shutdown metrics exporter: %v", err)
 		}
 	}
-	if p.client != nil {
-		return p.client.Close()
+	return p.closeExporters()
+}
+
+// closeExporters closes the clients of all exporters, returning the first error
+func (p *OtelPlugin) closeExporters() error {
+	var firstErr error
+	for _, e := range p.exporters {
+		if err := e.client.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
 	}
-	return nil
+	return firstErr
 }
 
 // GetMetricsExporter returns the metrics exporter for external use (e.g., by telemetry plugin)
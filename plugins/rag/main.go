@@ -0,0 +1,314 @@
+// Package rag provides a plugin that adds retrieval augmented generation to chat and responses
+// requests: the latest user message is embedded, the most similar chunks of a vector store
+// namespace are retrieved, and a context block rendered from them is injected into the prompt.
+//
+// The namespace is read only; it is populated out of band with entries holding the chunk text in
+// a property (and, optionally, its source in another). Retrieval failures never fail the request:
+// it is sent without context and the error is recorded in the response's extra fields, along with
+// the ids, scores and sources of the injected chunks.
+//
+// Fallbacks reuse the chunks retrieved for the first attempt.
+package rag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework"
+	"github.com/capsohq/bifrost/framework/vectorstore"
+)
+
+const (
+	PluginName = "rag"
+)
+
+// Injection modes
+const (
+	InjectAsSystem = "system" // The context block is appended to the system prompt, or added as a system message
+	InjectAsUser   = "user"   // The context block is prepended to the latest user message
+)
+
+// Defaults of the retrieval
+const (
+	DefaultTopK         = 4
+	DefaultThreshold    = 0.5
+	DefaultContentField = "content"
+	DefaultSourceField  = "source"
+)
+
+// DefaultTemplate is the text/template of the context block. It is executed with the query and
+// the retrieved chunks, see TemplateData.
+const DefaultTemplate = `Use the following context to answer the user's question. If the context does not contain the answer, say so.
+
+<context>
+{{range .Chunks}}[{{.Index}}]{{if .Source}} ({{.Source}}){{end}}
+{{.Content}}
+
+{{end}}</context>`
+
+const (
+	SkipKey schemas.BifrostContextKey = "rag_skip"  // To skip retrieval for a request
+	TopKKey schemas.BifrostContextKey = "rag_top_k" // To explicitly set the number of chunks retrieved for a request
+
+	// retrievalKey stores the retrieval of the current request in the context, for fallbacks and the response
+	retrievalKey schemas.BifrostContextKey = "rag_retrieval"
+)
+
+// Dependencies is a list of dependencies that the plugin requires.
+var Dependencies []framework.FrameworkDependency = []framework.FrameworkDependency{framework.FrameworkDependencyVectorStore}
+
+// Config holds configuration options for the RAG plugin
+type Config struct {
+	// Embedding model settings - REQUIRED, must match the model the namespace was populated with
+	Provider       schemas.ModelProvider `json:"provider"`
+	Keys           []schemas.Key         `json:"keys"`
+	EmbeddingModel string                `json:"embedding_model,omitempty"`
+
+	Namespace    string                 `json:"namespace"`               // Vector store namespace holding the chunks - REQUIRED
+	TopK         int                    `json:"top_k,omitempty"`         // Maximum number of chunks injected (default: 4)
+	Threshold    float64                `json:"threshold,omitempty"`     // Minimum cosine similarity of an injected chunk (default: 0.5)
+	ContentField string                 `json:"content_field,omitempty"` // Property holding the chunk text (default: "content")
+	SourceField  string                 `json:"source_field,omitempty"`  // Property holding the chunk source (default: "source")
+	Filters      map[string]interface{} `json:"filters,omitempty"`       // Properties the chunks must be equal to, e.g. {"tenant": "acme"}
+	Template     string                 `json:"template,omitempty"`      // text/template of the context block (default: DefaultTemplate)
+	InjectAs     string                 `json:"inject_as,omitempty"`     // "system" or "user" (default: "system")
+}
+
+// TemplateData is the data the context block template is executed with.
+type TemplateData struct {
+	Query  string          // Text of the latest user message
+	Chunks []TemplateChunk // Retrieved chunks, most similar first
+}
+
+// TemplateChunk is a retrieved chunk in the context block template.
+type TemplateChunk struct {
+	Index      int // 1-based position of the chunk
+	ID         string
+	Content    string
+	Source     string
+	Score      float64
+	Properties map[string]interface{}
+}
+
+// RAGPlugin injects chunks retrieved from a vector store into chat and responses requests
+type RAGPlugin struct {
+	store    vectorstore.VectorStore
+	config   Config
+	template *template.Template
+	queries  []vectorstore.Query
+	client   *bifrost.Bifrost
+	logger   schemas.Logger
+
+	// embed embeds the query, returning the embedding and the tokens used
+	embed func(ctx *schemas.BifrostContext, text string) ([]float32, int, error)
+}
+
+// retrieval is the outcome of the retrieval of a request
+type retrieval struct {
+	result schemas.RetrievalResult
+	block  string // Rendered context block, empty when no chunk was retrieved
+}
+
+// pluginAccount gives the embedding client the configured provider and keys
+type pluginAccount struct {
+	provider schemas.ModelProvider
+	keys     []schemas.Key
+}
+
+func (pa *pluginAccount) GetConfiguredProviders() ([]schemas.ModelProvider, error) {
+	return []schemas.ModelProvider{pa.provider}, nil
+}
+
+func (pa *pluginAccount) GetKeysForProvider(ctx context.Context, providerKey schemas.ModelProvider) ([]schemas.Key, error) {
+	return pa.keys, nil
+}
+
+func (pa *pluginAccount) GetConfigForProvider(providerKey schemas.ModelProvider) (*schemas.ProviderConfig, error) {
+	return &schemas.ProviderConfig{
+		NetworkConfig:            schemas.DefaultNetworkConfig,
+		ConcurrencyAndBufferSize: schemas.DefaultConcurrencyAndBufferSize,
+	}, nil
+}
+
+// Init creates a new RAG plugin instance, validating the configuration and initializing the
+// client used to embed queries.
+func Init(ctx context.Context, config Config, logger schemas.Logger, store vectorstore.VectorStore) (*RAGPlugin, error) {
+	plugin, err := newPlugin(config, logger, store)
+	if err != nil {
+		return nil, err
+	}
+	if config.Provider == "" || len(config.Keys) == 0 {
+		return nil, fmt.Errorf("provider and keys are required to embed queries")
+	}
+	client, err := bifrost.Init(ctx, schemas.BifrostConfig{
+		Logger:  logger,
+		Account: &pluginAccount{provider: config.Provider, keys: config.Keys},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize bifrost for rag: %w", err)
+	}
+	plugin.client = client
+	plugin.embed = plugin.generateEmbedding
+	return plugin, nil
+}
+
+// newPlugin applies the defaults of config and validates it, without an embedding client.
+func newPlugin(config Config, logger schemas.Logger, store vectorstore.VectorStore) (*RAGPlugin, error) {
+	if store == nil {
+		return nil, fmt.Errorf("vector store is required")
+	}
+	if config.Namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if config.TopK < 0 {
+		return nil, fmt.Errorf("top_k cannot be negative")
+	}
+	if config.TopK == 0 {
+		config.TopK = DefaultTopK
+	}
+	if config.Threshold == 0 {
+		config.Threshold = DefaultThreshold
+	}
+	if config.ContentField == "" {
+		config.ContentField = DefaultContentField
+	}
+	if config.SourceField == "" {
+		config.SourceField = DefaultSourceField
+	}
+	switch config.InjectAs {
+	case "":
+		config.InjectAs = InjectAsSystem
+	case InjectAsSystem, InjectAsUser:
+	default:
+		return nil, fmt.Errorf("unknown inject_as %q, expected system or user", config.InjectAs)
+	}
+	text := config.Template
+	if text == "" {
+		text = DefaultTemplate
+	}
+	tmpl, err := template.New(PluginName).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rag template: %w", err)
+	}
+	queries := make([]vectorstore.Query, 0, len(config.Filters))
+	for field, value := range config.Filters {
+		queries = append(queries, vectorstore.Query{Field: field, Operator: vectorstore.QueryOperatorEqual, Value: value})
+	}
+	return &RAGPlugin{
+		store:    store,
+		config:   config,
+		template: tmpl,
+		queries:  queries,
+		logger:   logger,
+	}, nil
+}
+
+// GetName returns the plugin name
+func (p *RAGPlugin) GetName() string {
+	return PluginName
+}
+
+// HTTPTransportPreHook is not used for this plugin
+func (p *RAGPlugin) HTTPTransportPreHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest) (*schemas.HTTPResponse, error) {
+	return nil, nil
+}
+
+// HTTPTransportPostHook is not used for this plugin
+func (p *RAGPlugin) HTTPTransportPostHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest, resp *schemas.HTTPResponse) error {
+	return nil
+}
+
+// HTTPTransportStreamChunkHook passes through streaming chunks unchanged
+func (p *RAGPlugin) HTTPTransportStreamChunkHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest, chunk *schemas.BifrostStreamChunk) (*schemas.BifrostStreamChunk, error) {
+	return chunk, nil
+}
+
+// PreLLMHook retrieves the chunks most similar to the latest user message of chat and responses
+// requests and injects their context block into the prompt. The retrieval of the first attempt is
+// reused by fallbacks. The caller's request is never modified; the augmented one is a copy.
+func (p *RAGPlugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.LLMPluginShortCircuit, error) {
+	if skip, ok := ctx.Value(SkipKey).(bool); ok && skip {
+		return req, nil, nil
+	}
+	r, ok := ctx.Value(retrievalKey).(*retrieval)
+	if !ok || r == nil {
+		query := latestUserText(req)
+		if query == "" {
+			return req, nil, nil
+		}
+		r = p.retrieve(ctx, query)
+		ctx.SetValue(retrievalKey, r)
+	}
+	if r.block == "" {
+		return req, nil, nil
+	}
+	return inject(req, r.block, p.config.InjectAs), nil, nil
+}
+
+// PostLLMHook records the retrieval in the response's extra fields. For streams, it is added to
+// the final chunk only.
+func (p *RAGPlugin) PostLLMHook(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	r, ok := ctx.Value(retrievalKey).(*retrieval)
+	if !ok || r == nil || result == nil {
+		return result, bifrostErr, nil
+	}
+	extraFields := result.GetExtraFields()
+	if extraFields == nil {
+		return result, bifrostErr, nil
+	}
+	if bifrost.IsStreamRequestType(extraFields.RequestType) && !bifrost.IsFinalChunk(ctx) {
+		return result, bifrostErr, nil
+	}
+	retrievalResult := r.result
+	extraFields.Retrieval = &retrievalResult
+	return result, bifrostErr, nil
+}
+
+// Cleanup shuts down the embedding client
+func (p *RAGPlugin) Cleanup() error {
+	if p.client != nil {
+		p.client.Shutdown()
+	}
+	return nil
+}
+
+// generateEmbedding embeds text with the configured provider and model.
+func (p *RAGPlugin) generateEmbedding(ctx *schemas.BifrostContext, text string) ([]float32, int, error) {
+	response, err := p.client.EmbeddingRequest(ctx, &schemas.BifrostEmbeddingRequest{
+		Provider: p.config.Provider,
+		Model:    p.config.EmbeddingModel,
+		Input:    &schemas.EmbeddingInput{Text: &text},
+	})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to generate embedding: %s", bifrost.GetErrorMessage(err))
+	}
+	if len(response.Data) == 0 {
+		return nil, 0, fmt.Errorf("no embeddings returned from provider")
+	}
+	tokens := 0
+	if response.Usage != nil {
+		tokens = response.Usage.TotalTokens
+	}
+	embedding := response.Data[0].Embedding
+	switch {
+	case embedding.EmbeddingArray != nil:
+		return embedding.EmbeddingArray, tokens, nil
+	case embedding.EmbeddingStr != nil:
+		var values []float32
+		if err := json.Unmarshal([]byte(*embedding.EmbeddingStr), &values); err != nil {
+			return nil, 0, fmt.Errorf("failed to parse string embedding: %w", err)
+		}
+		return values, tokens, nil
+	case len(embedding.Embedding2DArray) > 0:
+		var flattened []float32
+		for _, values := range embedding.Embedding2DArray {
+			flattened = append(flattened, values...)
+		}
+		return flattened, tokens, nil
+	}
+	return nil, 0, fmt.Errorf("embedding data is not in expected format")
+}
@@ -0,0 +1,250 @@
+package rag
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/vectorstore"
+)
+
+// fakeStore returns canned results from GetNearest and records its arguments
+type fakeStore struct {
+	vectorstore.VectorStore
+	results   []vectorstore.SearchResult
+	err       error
+	searches  int
+	namespace string
+	queries   []vectorstore.Query
+	threshold float64
+	limit     int64
+}
+
+func (s *fakeStore) GetNearest(ctx context.Context, namespace string, vector []float32, queries []vectorstore.Query, selectFields []string, threshold float64, limit int64) ([]vectorstore.SearchResult, error) {
+	s.searches++
+	s.namespace = namespace
+	s.queries = queries
+	s.threshold = threshold
+	s.limit = limit
+	return s.results, s.err
+}
+
+func score(value float64) *float64 {
+	return &value
+}
+
+func newTestPlugin(t *testing.T, config Config, store *fakeStore) *RAGPlugin {
+	t.Helper()
+	if config.Namespace == "" {
+		config.Namespace = "docs"
+	}
+	plugin, err := newPlugin(config, nil, store)
+	if err != nil {
+		t.Fatalf("newPlugin() error = %v", err)
+	}
+	plugin.embed = func(ctx *schemas.BifrostContext, text string) ([]float32, int, error) {
+		return []float32{1, 0}, 7, nil
+	}
+	return plugin
+}
+
+func chatRequest(messages ...schemas.ChatMessage) *schemas.BifrostRequest {
+	return &schemas.BifrostRequest{
+		RequestType: schemas.ChatCompletionRequest,
+		ChatRequest: &schemas.BifrostChatRequest{Provider: schemas.OpenAI, Model: "gpt-4o", Input: messages},
+	}
+}
+
+func message(role schemas.ChatMessageRole, text string) schemas.ChatMessage {
+	return schemas.ChatMessage{Role: role, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(text)}}
+}
+
+func chatResponse() *schemas.BifrostResponse {
+	return &schemas.BifrostResponse{
+		ChatResponse: &schemas.BifrostChatResponse{
+			ExtraFields: schemas.BifrostResponseExtraFields{RequestType: schemas.ChatCompletionRequest},
+		},
+	}
+}
+
+func newContext() *schemas.BifrostContext {
+	return schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+}
+
+var testChunks = []vectorstore.SearchResult{
+	{ID: "a", Score: score(0.92), Properties: map[string]interface{}{"content": "Refunds are issued within 14 days.", "source": "refunds.md"}},
+	{ID: "b", Score: score(0.81), Properties: map[string]interface{}{"content": "Shipping is free above $50."}},
+	{ID: "c", Score: score(0.75), Properties: map[string]interface{}{"title": "no content"}},
+}
+
+func TestNewPluginValidation(t *testing.T) {
+	store := &fakeStore{}
+	if _, err := newPlugin(Config{}, nil, store); err == nil {
+		t.Error("newPlugin() without namespace succeeded, want error")
+	}
+	if _, err := newPlugin(Config{Namespace: "docs"}, nil, nil); err == nil {
+		t.Error("newPlugin() without store succeeded, want error")
+	}
+	if _, err := newPlugin(Config{Namespace: "docs", InjectAs: "assistant"}, nil, store); err == nil {
+		t.Error("newPlugin() with unknown inject_as succeeded, want error")
+	}
+	if _, err := newPlugin(Config{Namespace: "docs", Template: "{{range .Chunks}"}, nil, store); err == nil {
+		t.Error("newPlugin() with invalid template succeeded, want error")
+	}
+
+	plugin, err := newPlugin(Config{Namespace: "docs"}, nil, store)
+	if err != nil {
+		t.Fatalf("newPlugin() error = %v", err)
+	}
+	if plugin.config.TopK != DefaultTopK || plugin.config.Threshold != DefaultThreshold || plugin.config.InjectAs != InjectAsSystem {
+		t.Errorf("defaults = %+v, want top_k %d, threshold %v and inject_as system", plugin.config, DefaultTopK, DefaultThreshold)
+	}
+}
+
+func TestInjectsContextIntoSystemPrompt(t *testing.T) {
+	store := &fakeStore{results: testChunks}
+	plugin := newTestPlugin(t, Config{TopK: 3, Filters: map[string]interface{}{"tenant": "acme"}}, store)
+	ctx := newContext()
+	req := chatRequest(message(schemas.ChatMessageRoleSystem, "You are a support agent."), message(schemas.ChatMessageRoleUser, "How long do refunds take?"))
+
+	out, shortCircuit, err := plugin.PreLLMHook(ctx, req)
+	if err != nil || shortCircuit != nil {
+		t.Fatalf("PreLLMHook() = %v, %v", shortCircuit, err)
+	}
+	if store.namespace != "docs" || store.limit != 3 || store.threshold != DefaultThreshold {
+		t.Errorf("search = %s, limit %d, threshold %v", store.namespace, store.limit, store.threshold)
+	}
+	if len(store.queries) != 1 || store.queries[0].Field != "tenant" || store.queries[0].Value != "acme" {
+		t.Errorf("search queries = %+v, want tenant filter", store.queries)
+	}
+
+	system := *out.ChatRequest.Input[0].Content.ContentStr
+	if !strings.HasPrefix(system, "You are a support agent.\n\n") {
+		t.Errorf("system prompt = %q, want the original prompt first", system)
+	}
+	if !strings.Contains(system, "[1] (refunds.md)\nRefunds are issued within 14 days.") || !strings.Contains(system, "[2]\nShipping is free above $50.") {
+		t.Errorf("system prompt = %q, want both chunks", system)
+	}
+	if strings.Contains(system, "[3]") {
+		t.Errorf("system prompt = %q, want the chunk without content skipped", system)
+	}
+	if *req.ChatRequest.Input[0].Content.ContentStr != "You are a support agent." {
+		t.Error("caller's system message was modified")
+	}
+
+	result, _, _ := plugin.PostLLMHook(ctx, chatResponse(), nil)
+	retrieval := result.ChatResponse.ExtraFields.Retrieval
+	if retrieval == nil {
+		t.Fatal("extra fields have no retrieval")
+	}
+	if retrieval.Namespace != "docs" || retrieval.TopK != 3 || retrieval.EmbeddingTokens != 7 || len(retrieval.Chunks) != 2 {
+		t.Errorf("retrieval = %+v", retrieval)
+	}
+	if retrieval.Chunks[0] != (schemas.RetrievedChunk{ID: "a", Score: 0.92, Source: "refunds.md"}) {
+		t.Errorf("first chunk = %+v", retrieval.Chunks[0])
+	}
+}
+
+func TestInjectsContextIntoUserMessage(t *testing.T) {
+	store := &fakeStore{results: testChunks[:1]}
+	plugin := newTestPlugin(t, Config{InjectAs: InjectAsUser, Template: "Context: {{range .Chunks}}{{.Content}}{{end}}"}, store)
+	req := chatRequest(message(schemas.ChatMessageRoleUser, "first question"), message(schemas.ChatMessageRoleAssistant, "answer"), message(schemas.ChatMessageRoleUser, "refunds?"))
+
+	out, _, _ := plugin.PreLLMHook(newContext(), req)
+	if len(out.ChatRequest.Input) != 3 {
+		t.Fatalf("messages = %d, want 3", len(out.ChatRequest.Input))
+	}
+	if got := *out.ChatRequest.Input[2].Content.ContentStr; got != "Context: Refunds are issued within 14 days.\n\nrefunds?" {
+		t.Errorf("user message = %q", got)
+	}
+	if got := *out.ChatRequest.Input[0].Content.ContentStr; got != "first question" {
+		t.Errorf("earlier user message = %q, want it unchanged", got)
+	}
+}
+
+func TestResponsesRequest(t *testing.T) {
+	store := &fakeStore{results: testChunks[:1]}
+	plugin := newTestPlugin(t, Config{}, store)
+	req := &schemas.BifrostRequest{
+		RequestType: schemas.ResponsesRequest,
+		ResponsesRequest: &schemas.BifrostResponsesRequest{
+			Provider: schemas.OpenAI,
+			Model:    "gpt-4o",
+			Input: []schemas.ResponsesMessage{{
+				Role: schemas.Ptr(schemas.ResponsesInputMessageRoleUser),
+				Content: &schemas.ResponsesMessageContent{ContentBlocks: []schemas.ResponsesMessageContentBlock{
+					{Type: schemas.ResponsesInputMessageContentBlockTypeText, Text: schemas.Ptr("How long do refunds take?")},
+				}},
+			}},
+		},
+	}
+
+	out, _, _ := plugin.PreLLMHook(newContext(), req)
+	if len(out.ResponsesRequest.Input) != 2 || len(req.ResponsesRequest.Input) != 1 {
+		t.Fatalf("input = %d messages, caller's = %d, want 2 and 1", len(out.ResponsesRequest.Input), len(req.ResponsesRequest.Input))
+	}
+	system := out.ResponsesRequest.Input[0]
+	if *system.Role != schemas.ResponsesInputMessageRoleSystem || !strings.Contains(*system.Content.ContentStr, "Refunds are issued within 14 days.") {
+		t.Errorf("first message = %+v, want the context block as a system message", system)
+	}
+}
+
+func TestFallbackReusesRetrieval(t *testing.T) {
+	store := &fakeStore{results: testChunks[:1]}
+	plugin := newTestPlugin(t, Config{}, store)
+	ctx := newContext()
+	req := chatRequest(message(schemas.ChatMessageRoleUser, "refunds?"))
+
+	plugin.PreLLMHook(ctx, req)
+	out, _, _ := plugin.PreLLMHook(ctx, req)
+	if store.searches != 1 {
+		t.Errorf("searches = %d, want 1", store.searches)
+	}
+	if len(out.ChatRequest.Input) != 2 {
+		t.Errorf("messages = %d, want the context block added once", len(out.ChatRequest.Input))
+	}
+}
+
+func TestRetrievalFailureSendsRequestUnchanged(t *testing.T) {
+	store := &fakeStore{err: errors.New("connection refused")}
+	plugin := newTestPlugin(t, Config{}, store)
+	ctx := newContext()
+	req := chatRequest(message(schemas.ChatMessageRoleUser, "refunds?"))
+
+	out, shortCircuit, err := plugin.PreLLMHook(ctx, req)
+	if err != nil || shortCircuit != nil || out != req {
+		t.Fatalf("PreLLMHook() = %v, %v, %v, want the request unchanged", out, shortCircuit, err)
+	}
+	result, _, _ := plugin.PostLLMHook(ctx, chatResponse(), nil)
+	retrieval := result.ChatResponse.ExtraFields.Retrieval
+	if retrieval == nil || !strings.Contains(retrieval.Error, "connection refused") || len(retrieval.Chunks) != 0 {
+		t.Errorf("retrieval = %+v, want the error recorded", retrieval)
+	}
+}
+
+func TestSkipAndTopKOverride(t *testing.T) {
+	store := &fakeStore{results: testChunks}
+	plugin := newTestPlugin(t, Config{}, store)
+
+	ctx := newContext()
+	ctx.SetValue(SkipKey, true)
+	req := chatRequest(message(schemas.ChatMessageRoleUser, "refunds?"))
+	if out, _, _ := plugin.PreLLMHook(ctx, req); out != req || store.searches != 0 {
+		t.Error("PreLLMHook() retrieved chunks for a skipped request")
+	}
+
+	ctx = newContext()
+	ctx.SetValue(TopKKey, 1)
+	plugin.PreLLMHook(ctx, req)
+	if store.limit != 1 {
+		t.Errorf("limit = %d, want the override", store.limit)
+	}
+
+	// Requests without a user message are not searched
+	plugin.PreLLMHook(newContext(), chatRequest(message(schemas.ChatMessageRoleSystem, "system only")))
+	if store.searches != 1 {
+		t.Errorf("searches = %d, want 1", store.searches)
+	}
+}
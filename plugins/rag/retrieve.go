@@ -0,0 +1,218 @@
+package rag
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// retrieve embeds query, searches the namespace and renders the context block of the chunks found.
+// Failures are recorded in the result and leave the block empty.
+func (p *RAGPlugin) retrieve(ctx *schemas.BifrostContext, query string) *retrieval {
+	start := time.Now()
+	topK := p.config.TopK
+	if override, ok := ctx.Value(TopKKey).(int); ok && override > 0 {
+		topK = override
+	}
+	r := &retrieval{result: schemas.RetrievalResult{
+		Namespace: p.config.Namespace,
+		TopK:      topK,
+		Chunks:    []schemas.RetrievedChunk{},
+	}}
+	fail := func(err error) *retrieval {
+		r.result.Error = err.Error()
+		r.result.Latency = time.Since(start).Milliseconds()
+		if p.logger != nil {
+			p.logger.Warn("%s: retrieval from %s failed, sending the request without context: %v", PluginName, p.config.Namespace, err)
+		}
+		return r
+	}
+
+	embedding, tokens, err := p.embed(ctx, query)
+	if err != nil {
+		return fail(err)
+	}
+	r.result.EmbeddingTokens = tokens
+	results, err := p.store.GetNearest(ctx, p.config.Namespace, embedding, p.queries,
+		[]string{p.config.ContentField, p.config.SourceField}, p.config.Threshold, int64(topK))
+	if err != nil {
+		return fail(fmt.Errorf("failed to search vector store: %w", err))
+	}
+
+	data := TemplateData{Query: query}
+	for _, result := range results {
+		content, _ := result.Properties[p.config.ContentField].(string)
+		if strings.TrimSpace(content) == "" {
+			continue
+		}
+		source, _ := result.Properties[p.config.SourceField].(string)
+		var score float64
+		if result.Score != nil {
+			score = *result.Score
+		}
+		data.Chunks = append(data.Chunks, TemplateChunk{
+			Index:      len(data.Chunks) + 1,
+			ID:         result.ID,
+			Content:    content,
+			Source:     source,
+			Score:      score,
+			Properties: result.Properties,
+		})
+		r.result.Chunks = append(r.result.Chunks, schemas.RetrievedChunk{ID: result.ID, Score: score, Source: source})
+	}
+	if len(data.Chunks) > 0 {
+		var block strings.Builder
+		if err := p.template.Execute(&block, data); err != nil {
+			r.result.Chunks = []schemas.RetrievedChunk{}
+			return fail(fmt.Errorf("failed to render context block: %w", err))
+		}
+		r.block = block.String()
+	}
+	r.result.Latency = time.Since(start).Milliseconds()
+	if p.logger != nil {
+		p.logger.Debug("%s: retrieved %d chunks from %s", PluginName, len(r.result.Chunks), p.config.Namespace)
+	}
+	return r
+}
+
+// latestUserText returns the text of the latest user message of chat and responses requests.
+func latestUserText(req *schemas.BifrostRequest) string {
+	switch {
+	case req.ChatRequest != nil:
+		for i := len(req.ChatRequest.Input) - 1; i >= 0; i-- {
+			message := req.ChatRequest.Input[i]
+			if message.Role != schemas.ChatMessageRoleUser || message.Content == nil {
+				continue
+			}
+			if message.Content.ContentStr != nil {
+				return strings.TrimSpace(*message.Content.ContentStr)
+			}
+			var texts []string
+			for _, block := range message.Content.ContentBlocks {
+				if block.Text != nil {
+					texts = append(texts, *block.Text)
+				}
+			}
+			return strings.TrimSpace(strings.Join(texts, "\n"))
+		}
+	case req.ResponsesRequest != nil:
+		for i := len(req.ResponsesRequest.Input) - 1; i >= 0; i-- {
+			message := req.ResponsesRequest.Input[i]
+			if message.Role == nil || *message.Role != schemas.ResponsesInputMessageRoleUser || message.Content == nil {
+				continue
+			}
+			if message.Content.ContentStr != nil {
+				return strings.TrimSpace(*message.Content.ContentStr)
+			}
+			var texts []string
+			for _, block := range message.Content.ContentBlocks {
+				if block.Text != nil {
+					texts = append(texts, *block.Text)
+				}
+			}
+			return strings.TrimSpace(strings.Join(texts, "\n"))
+		}
+	}
+	return ""
+}
+
+// inject returns a copy of req with the context block added to the system prompt or to the latest
+// user message. req is left unchanged, so fallbacks, which copy it, get the block only once.
+func inject(req *schemas.BifrostRequest, block string, injectAs string) *schemas.BifrostRequest {
+	augmented := *req
+	switch {
+	case req.ChatRequest != nil:
+		chatReq := *req.ChatRequest
+		if injectAs == InjectAsUser {
+			chatReq.Input = withChatUserContext(chatReq.Input, block)
+		} else {
+			chatReq.Input = withChatSystemContext(chatReq.Input, block)
+		}
+		augmented.ChatRequest = &chatReq
+	case req.ResponsesRequest != nil:
+		responsesReq := *req.ResponsesRequest
+		if injectAs == InjectAsUser {
+			responsesReq.Input = withResponsesUserContext(responsesReq.Input, block)
+		} else {
+			responsesReq.Input = withResponsesSystemContext(responsesReq.Input, block)
+		}
+		augmented.ResponsesRequest = &responsesReq
+	}
+	return &augmented
+}
+
+// withChatSystemContext appends block to the leading system message, or adds a system message
+// holding it.
+func withChatSystemContext(messages []schemas.ChatMessage, block string) []schemas.ChatMessage {
+	if len(messages) > 0 && (messages[0].Role == schemas.ChatMessageRoleSystem || messages[0].Role == schemas.ChatMessageRoleDeveloper) && messages[0].Content != nil {
+		result := slices.Clone(messages)
+		result[0].Content = appendChatText(*result[0].Content, block)
+		return result
+	}
+	return append([]schemas.ChatMessage{{
+		Role:    schemas.ChatMessageRoleSystem,
+		Content: &schemas.ChatMessageContent{ContentStr: &block},
+	}}, messages...)
+}
+
+// withChatUserContext prepends block to the latest user message.
+func withChatUserContext(messages []schemas.ChatMessage, block string) []schemas.ChatMessage {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role != schemas.ChatMessageRoleUser || messages[i].Content == nil {
+			continue
+		}
+		result := slices.Clone(messages)
+		content := *result[i].Content
+		if content.ContentStr != nil {
+			text := block + "\n\n" + *content.ContentStr
+			content.ContentStr = &text
+		} else {
+			content.ContentBlocks = append([]schemas.ChatContentBlock{{Type: schemas.ChatContentBlockTypeText, Text: &block}}, content.ContentBlocks...)
+		}
+		result[i].Content = &content
+		return result
+	}
+	return messages
+}
+
+func appendChatText(content schemas.ChatMessageContent, block string) *schemas.ChatMessageContent {
+	if content.ContentStr != nil {
+		text := *content.ContentStr + "\n\n" + block
+		content.ContentStr = &text
+	} else {
+		content.ContentBlocks = append(slices.Clone(content.ContentBlocks), schemas.ChatContentBlock{Type: schemas.ChatContentBlockTypeText, Text: &block})
+	}
+	return &content
+}
+
+// withResponsesSystemContext adds a system message holding block before the input.
+func withResponsesSystemContext(messages []schemas.ResponsesMessage, block string) []schemas.ResponsesMessage {
+	return append([]schemas.ResponsesMessage{{
+		Type:    schemas.Ptr(schemas.ResponsesMessageTypeMessage),
+		Role:    schemas.Ptr(schemas.ResponsesInputMessageRoleSystem),
+		Content: &schemas.ResponsesMessageContent{ContentStr: &block},
+	}}, messages...)
+}
+
+// withResponsesUserContext prepends block to the latest user message.
+func withResponsesUserContext(messages []schemas.ResponsesMessage, block string) []schemas.ResponsesMessage {
+	for i := len(messages) - 1; i >= 0; i-- {
+		if messages[i].Role == nil || *messages[i].Role != schemas.ResponsesInputMessageRoleUser || messages[i].Content == nil {
+			continue
+		}
+		result := slices.Clone(messages)
+		content := *result[i].Content
+		if content.ContentStr != nil {
+			text := block + "\n\n" + *content.ContentStr
+			content.ContentStr = &text
+		} else {
+			content.ContentBlocks = append([]schemas.ResponsesMessageContentBlock{{Type: schemas.ResponsesInputMessageContentBlockTypeText, Text: &block}}, content.ContentBlocks...)
+		}
+		result[i].Content = &content
+		return result
+	}
+	return messages
+}
@@ -245,6 +245,7 @@ const (
 	CacheThresholdKey schemas.BifrostContextKey = "semantic_cache_threshold"  // To explicitly set the threshold for a request
 	CacheTypeKey      schemas.BifrostContextKey = "semantic_cache_cache_type" // To explicitly set the cache type for a request
 	CacheNoStoreKey   schemas.BifrostContextKey = "semantic_cache_no_store"   // To explicitly disable storing the response in the cache
+	CacheNoCacheKey   schemas.BifrostContextKey = "semantic_cache_no_cache"   // To skip the cache lookup and store a fresh response
 
 	// context keys for internal usage
 	requestIDKey              schemas.BifrostContextKey = "semantic_cache_request_id"
@@ -427,6 +428,14 @@ func (plugin *Plugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.Bifro
 		}
 	}
 
+	if noCache, ok := ctx.Value(CacheNoCacheKey).(bool); ok && noCache {
+		plugin.logger.Debug(PluginLoggerPrefix + " Cache lookup is explicitly disabled for this request, preparing a fresh cache entry")
+		if err := plugin.prepareCacheEntry(ctx, req, performSemanticSearch); err != nil {
+			plugin.logger.Warn(PluginLoggerPrefix + " Failed to prepare cache entry: " + err.Error())
+		}
+		return req, nil, nil
+	}
+
 	if performDirectSearch {
 		shortCircuit, err := plugin.performDirectSearch(ctx, req, cacheKey)
 		if err != nil {
@@ -625,16 +634,14 @@ func (plugin *Plugin) PostLLMHook(ctx *schemas.BifrostContext, res *schemas.Bifr
 
 	isFinalChunk := bifrost.IsFinalChunk(ctx)
 
-	// Get the input tokens from context (can be nil if not set)
-	inputTokens, ok := ctx.Value(requestEmbeddingTokensKey).(int)
-	if ok {
-		isStreamRequest := bifrost.IsStreamRequestType(requestType)
-
-		if !isStreamRequest || (isStreamRequest && isFinalChunk) {
-			if extraFields.CacheDebug == nil {
-				extraFields.CacheDebug = &schemas.BifrostCacheDebug{}
-			}
-			extraFields.CacheDebug.CacheHit = false
+	// Report the miss, with the embedding usage when an embedding was generated
+	if !bifrost.IsStreamRequestType(requestType) || isFinalChunk {
+		if extraFields.CacheDebug == nil {
+			extraFields.CacheDebug = &schemas.BifrostCacheDebug{}
+		}
+		extraFields.CacheDebug.CacheHit = false
+		// Get the input tokens from context (can be nil if not set)
+		if inputTokens, ok := ctx.Value(requestEmbeddingTokensKey).(int); ok {
 			extraFields.CacheDebug.ProviderUsed = bifrost.Ptr(string(plugin.config.Provider))
 			extraFields.CacheDebug.ModelUsed = bifrost.Ptr(plugin.config.EmbeddingModel)
 			extraFields.CacheDebug.InputTokens = &inputTokens
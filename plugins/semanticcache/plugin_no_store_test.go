@@ -324,3 +324,57 @@ func TestCacheNoStoreReadButNoWrite(t *testing.T) {
 
 	t.Log("✅ CacheNoStoreKey allows reading but prevents writing")
 }
+
+// TestCacheNoCacheSkipsLookup tests that CacheNoCacheKey skips cache reads but still stores the response
+func TestCacheNoCacheSkipsLookup(t *testing.T) {
+	setup := NewTestSetup(t)
+	defer setup.Cleanup()
+
+	testRequest := CreateBasicChatRequest("What is the speed of light?", 0.7, 50)
+
+	// Step 1: Cache a response normally
+	ctx1 := CreateContextWithCacheKey("test-no-cache")
+	t.Log("Making normal request to populate cache...")
+	_, err1 := setup.Client.ChatCompletionRequest(ctx1, testRequest)
+	if err1 != nil {
+		return // Test will be skipped by retry function
+	}
+
+	WaitForCache(setup.Plugin)
+
+	// Step 2: Read with no-cache enabled (should skip the cached entry)
+	ctx2 := CreateContextWithCacheKeyAndNoCache("test-no-cache", true)
+	t.Log("Making request with CacheNoCacheKey=true (should not hit cache)...")
+	response2, err2 := setup.Client.ChatCompletionRequest(ctx2, testRequest)
+	if err2 != nil {
+		return // Test will be skipped by retry function
+	}
+	AssertNoCacheHit(t, &schemas.BifrostResponse{ChatResponse: response2})
+	if response2.ExtraFields.CacheDebug == nil {
+		t.Error("Expected cache_debug to report the skipped lookup as a miss")
+	}
+
+	WaitForCache(setup.Plugin)
+
+	// Step 3: A no-cache request with a new prompt stores its response for later reads
+	newRequest := CreateBasicChatRequest("What is the boiling point of water at sea level?", 0.7, 50)
+	t.Log("Making new request with CacheNoCacheKey=true (should be stored)...")
+	_, err3 := setup.Client.ChatCompletionRequest(ctx2, newRequest)
+	if err3 != nil {
+		return // Test will be skipped by retry function
+	}
+
+	WaitForCache(setup.Plugin)
+
+	response4, err4 := setup.Client.ChatCompletionRequest(ctx1, newRequest)
+	if err4 != nil {
+		if err4.Error != nil {
+			t.Fatalf("Fourth request failed: %v", err4.Error.Message)
+		} else {
+			t.Fatalf("Fourth request failed: %v", err4)
+		}
+	}
+	AssertCacheHit(t, &schemas.BifrostResponse{ChatResponse: response4}, "direct")
+
+	t.Log("✅ CacheNoCacheKey skips reading but still writes")
+}
@@ -107,6 +107,35 @@ func (plugin *Plugin) generateEmbeddingsForStorage(ctx *schemas.BifrostContext,
 	return nil
 }
 
+// prepareCacheEntry stores the request hash and, when semantic caching applies, the embedding of a
+// request in context for PostHook storage, without searching the cache. It is used for requests
+// that skip the cache lookup but still refresh the cache with their response.
+func (plugin *Plugin) prepareCacheEntry(ctx *schemas.BifrostContext, req *schemas.BifrostRequest, semantic bool) error {
+	hash, err := plugin.generateRequestHash(req)
+	if err != nil {
+		return fmt.Errorf("failed to generate request hash: %w", err)
+	}
+	_, paramsHash, err := plugin.extractTextForEmbedding(req)
+	if err != nil {
+		return fmt.Errorf("failed to extract metadata for filtering: %w", err)
+	}
+	ctx.SetValue(requestHashKey, hash)
+	ctx.SetValue(requestParamsHashKey, paramsHash)
+
+	if req.EmbeddingRequest != nil || req.TranscriptionRequest != nil || (!semantic && plugin.store.RequiresVectors()) {
+		// Zero vector placeholder for stores that require vectors, keeping direct-only entries out of
+		// semantic search results
+		if plugin.store.RequiresVectors() && plugin.config.Dimension > 0 {
+			ctx.SetValue(requestEmbeddingKey, make([]float32, plugin.config.Dimension))
+		}
+		return nil
+	}
+	if semantic && plugin.client != nil {
+		return plugin.generateEmbeddingsForStorage(ctx, req)
+	}
+	return nil
+}
+
 // performSemanticSearch performs semantic similarity search and returns matching response if found.
 func (plugin *Plugin) performSemanticSearch(ctx *schemas.BifrostContext, req *schemas.BifrostRequest, cacheKey string) (*schemas.LLMPluginShortCircuit, error) {
 	// Extract text and metadata for embedding
@@ -639,6 +639,11 @@ func CreateContextWithCacheKeyAndNoStore(value string, noStore bool) *schemas.Bi
 	return schemas.NewBifrostContext(context.Background(), schemas.NoDeadline).WithValue(CacheKey, value).WithValue(CacheNoStoreKey, noStore)
 }
 
+// CreateContextWithCacheKeyAndNoCache creates a context with cache key and no-cache flag
+func CreateContextWithCacheKeyAndNoCache(value string, noCache bool) *schemas.BifrostContext {
+	return schemas.NewBifrostContext(context.Background(), schemas.NoDeadline).WithValue(CacheKey, value).WithValue(CacheNoCacheKey, noCache)
+}
+
 // CreateTestSetupWithConversationThreshold creates a test setup with custom conversation history threshold
 func CreateTestSetupWithConversationThreshold(t *testing.T, threshold int) *TestSetup {
 	config := &Config{
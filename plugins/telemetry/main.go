@@ -84,6 +84,7 @@ type PrometheusPlugin struct {
 	CostTotal                      *prometheus.CounterVec
 	StreamInterTokenLatencySeconds *prometheus.HistogramVec
 	StreamFirstTokenLatencySeconds *prometheus.HistogramVec
+	SchemaDriftTotal               *prometheus.CounterVec
 	customLabels                   []string
 
 	defaultHTTPLabels    []string
@@ -288,6 +289,15 @@ func Init(config *Config, pricingManager *modelcatalog.ModelCatalog, logger sche
 		append(defaultBifrostLabels, filteredCustomLabels...),
 	)
 
+	// Provider response fields that differ from the types Bifrost decodes them into
+	bifrostSchemaDriftTotal := factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "bifrost_provider_schema_drift_total",
+			Help: "Total number of unknown or missing fields found in upstream provider responses, by the type they were decoded into.",
+		},
+		[]string{"schema", "kind", "field"},
+	)
+
 	plugin := &PrometheusPlugin{
 		logger:                         logger,
 		pricingManager:                 pricingManager,
@@ -308,6 +318,7 @@ func Init(config *Config, pricingManager *modelcatalog.ModelCatalog, logger sche
 		CostTotal:                      bifrostCostTotal,
 		StreamInterTokenLatencySeconds: bifrostStreamInterTokenLatencySeconds,
 		StreamFirstTokenLatencySeconds: bifrostStreamFirstTokenLatencySeconds,
+		SchemaDriftTotal:               bifrostSchemaDriftTotal,
 		customLabels:                   filteredCustomLabels,
 		defaultHTTPLabels:              defaultHTTPLabels,
 		defaultBifrostLabels:           defaultBifrostLabels,
@@ -409,13 +420,19 @@ func (p *PrometheusPlugin) PostLLMHook(ctx *schemas.BifrostContext, result *sche
 		"customer_name":       customerName,
 	}
 
-	// Get all custom prometheus labels from context BEFORE the goroutine
+	// Get all custom prometheus labels from context BEFORE the goroutine,
+	// falling back to the request tag of the same name
+	requestTags := schemas.GetRequestTags(ctx)
 	for _, key := range p.customLabels {
 		if value := ctx.Value(schemas.BifrostContextKey(key)); value != nil {
 			if strValue, ok := value.(string); ok {
 				labelValues[key] = strValue
+				continue
 			}
 		}
+		if tagValue, ok := requestTags[key]; ok {
+			labelValues[key] = tagValue
+		}
 	}
 
 	// Get label values in the correct order (cache_type will be handled separately for cache hits)
@@ -708,6 +725,11 @@ func (p *PrometheusPlugin) doPush() {
 	}
 }
 
+// RecordSchemaDrift counts a field of a provider response that differs from its expected shape.
+func (p *PrometheusPlugin) RecordSchemaDrift(drift schemas.SchemaDrift) {
+	p.SchemaDriftTotal.WithLabelValues(drift.Schema, string(drift.Kind), drift.Field).Inc()
+}
+
 func (p *PrometheusPlugin) Cleanup() error {
 	p.DisablePushGateway()
 	return nil
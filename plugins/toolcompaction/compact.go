@@ -0,0 +1,178 @@
+package toolcompaction
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// compactionStats describes the tool outputs compacted in a request
+type compactionStats struct {
+	Outputs      int // Number of tool outputs compacted
+	Turns        int // Number of tool-call turns the compacted outputs belong to
+	CharsRemoved int // Number of characters removed from tool outputs
+}
+
+// String returns a human-readable description of the compaction
+func (s compactionStats) String() string {
+	return fmt.Sprintf("compacted %d tool output(s) from %d earlier tool-call turn(s), removing %d characters", s.Outputs, s.Turns, s.CharsRemoved)
+}
+
+// add records a compacted output of the given turn. Turns are visited in order, so a new turn is
+// counted whenever the turn differs from the previous compacted one.
+func (s *compactionStats) add(turn int, lastTurn *int, charsRemoved int) {
+	s.Outputs++
+	s.CharsRemoved += charsRemoved
+	if turn != *lastTurn {
+		s.Turns++
+		*lastTurn = turn
+	}
+}
+
+// compactText returns a preview of text followed by a note on how much was omitted.
+// ok is false if text is short enough to keep as is.
+func compactText(text string, config Config) (compacted string, charsRemoved int, ok bool) {
+	length := utf8.RuneCountInString(text)
+	if length <= config.MaxToolOutputChars {
+		return text, 0, false
+	}
+	preview := []rune(text)[:config.PreviewChars]
+	omitted := length - config.PreviewChars
+	note := fmt.Sprintf("[tool output compacted: %d of %d characters omitted]", omitted, length)
+	if len(preview) == 0 {
+		return note, length - utf8.RuneCountInString(note), true
+	}
+	compacted = string(preview) + "\n...\n" + note
+	return compacted, length - utf8.RuneCountInString(compacted), true
+}
+
+// textOfBlocks concatenates the text of content blocks. ok is false if any block is not text.
+func textOfBlocks[T any](blocks []T, text func(T) *string) (string, bool) {
+	var builder strings.Builder
+	for i, block := range blocks {
+		blockText := text(block)
+		if blockText == nil {
+			return "", false
+		}
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(*blockText)
+	}
+	return builder.String(), true
+}
+
+// compactChatMessages compacts the tool messages of all but the most recent config.KeepRecentTurns
+// tool-call turns. A turn starts at each assistant message with tool calls. The input slice and its
+// messages are not modified; a new slice is returned if anything was compacted.
+func compactChatMessages(messages []schemas.ChatMessage, config Config) ([]schemas.ChatMessage, compactionStats) {
+	var stats compactionStats
+	turns := make([]int, len(messages)) // turn of each message, 0 before the first tool call
+	totalTurns := 0
+	for i, message := range messages {
+		if message.ChatAssistantMessage != nil && len(message.ChatAssistantMessage.ToolCalls) > 0 {
+			totalTurns++
+		}
+		turns[i] = totalTurns
+	}
+	cutoff := totalTurns - config.KeepRecentTurns
+	if cutoff <= 0 {
+		return messages, stats
+	}
+
+	var compacted []schemas.ChatMessage
+	lastTurn := 0
+	for i, message := range messages {
+		if turns[i] > cutoff || message.Role != schemas.ChatMessageRoleTool || message.Content == nil {
+			continue
+		}
+		text, ok := "", false
+		if message.Content.ContentStr != nil {
+			text, ok = *message.Content.ContentStr, true
+		} else if len(message.Content.ContentBlocks) > 0 {
+			text, ok = textOfBlocks(message.Content.ContentBlocks, func(block schemas.ChatContentBlock) *string { return block.Text })
+		}
+		if !ok {
+			continue
+		}
+		summary, charsRemoved, ok := compactText(text, config)
+		if !ok {
+			continue
+		}
+		if compacted == nil {
+			compacted = make([]schemas.ChatMessage, len(messages))
+			copy(compacted, messages)
+		}
+		compacted[i].Content = &schemas.ChatMessageContent{ContentStr: schemas.Ptr(summary)}
+		stats.add(turns[i], &lastTurn, charsRemoved)
+	}
+	if compacted == nil {
+		return messages, stats
+	}
+	return compacted, stats
+}
+
+// isResponsesToolCall reports whether message is a tool call made by the model
+func isResponsesToolCall(message schemas.ResponsesMessage) bool {
+	return message.Type != nil && (*message.Type == schemas.ResponsesMessageTypeFunctionCall || *message.Type == schemas.ResponsesMessageTypeCustomToolCall)
+}
+
+// isResponsesToolOutput reports whether message is the output of a tool call
+func isResponsesToolOutput(message schemas.ResponsesMessage) bool {
+	return message.Type != nil && (*message.Type == schemas.ResponsesMessageTypeFunctionCallOutput || *message.Type == schemas.ResponsesMessageTypeCustomToolCallOutput)
+}
+
+// compactResponsesMessages compacts the tool outputs of all but the most recent config.KeepRecentTurns
+// tool-call turns. A turn starts at each run of consecutive tool calls. The input slice and its
+// messages are not modified; a new slice is returned if anything was compacted.
+func compactResponsesMessages(messages []schemas.ResponsesMessage, config Config) ([]schemas.ResponsesMessage, compactionStats) {
+	var stats compactionStats
+	turns := make([]int, len(messages))
+	totalTurns := 0
+	for i, message := range messages {
+		if isResponsesToolCall(message) && (i == 0 || !isResponsesToolCall(messages[i-1])) {
+			totalTurns++
+		}
+		turns[i] = totalTurns
+	}
+	cutoff := totalTurns - config.KeepRecentTurns
+	if cutoff <= 0 {
+		return messages, stats
+	}
+
+	var compacted []schemas.ResponsesMessage
+	lastTurn := 0
+	for i, message := range messages {
+		if turns[i] > cutoff || !isResponsesToolOutput(message) || message.ResponsesToolMessage == nil || message.ResponsesToolMessage.Output == nil {
+			continue
+		}
+		output := message.ResponsesToolMessage.Output
+		text, ok := "", false
+		if output.ResponsesToolCallOutputStr != nil {
+			text, ok = *output.ResponsesToolCallOutputStr, true
+		} else if len(output.ResponsesFunctionToolCallOutputBlocks) > 0 {
+			text, ok = textOfBlocks(output.ResponsesFunctionToolCallOutputBlocks, func(block schemas.ResponsesMessageContentBlock) *string { return block.Text })
+		}
+		if !ok {
+			continue
+		}
+		summary, charsRemoved, ok := compactText(text, config)
+		if !ok {
+			continue
+		}
+		if compacted == nil {
+			compacted = make([]schemas.ResponsesMessage, len(messages))
+			copy(compacted, messages)
+		}
+		toolMessage := *message.ResponsesToolMessage
+		toolMessage.Output = &schemas.ResponsesToolMessageOutputStruct{ResponsesToolCallOutputStr: schemas.Ptr(summary)}
+		compacted[i].ResponsesToolMessage = &toolMessage
+		stats.add(turns[i], &lastTurn, charsRemoved)
+	}
+	if compacted == nil {
+		return messages, stats
+	}
+	return compacted, stats
+}
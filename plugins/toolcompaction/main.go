@@ -0,0 +1,127 @@
+// Package toolcompaction provides a plugin that compacts long tool-call histories before
+// requests are translated for a provider, keeping agent loops within the model's context window.
+//
+// Tool outputs from all but the most recent tool-call turns are replaced with a short preview
+// of the original output. A tool-call turn is an assistant message that calls one or more tools
+// together with the tool results for those calls. Each compacted request gets a warning in the
+// response's extra fields describing what was compacted.
+package toolcompaction
+
+import (
+	"fmt"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+const (
+	PluginName = "tool-compaction"
+)
+
+// Default configuration values
+const (
+	DefaultKeepRecentTurns    = 4
+	DefaultMaxToolOutputChars = 1024
+	DefaultPreviewChars       = 256
+)
+
+// compactionStatsKey stores the compactionStats of the current request in the context
+const compactionStatsKey schemas.BifrostContextKey = "tool-compaction-stats"
+
+// Config holds configuration options for the tool compaction plugin
+type Config struct {
+	KeepRecentTurns    int `json:"keep_recent_turns"`     // Number of most recent tool-call turns kept verbatim
+	MaxToolOutputChars int `json:"max_tool_output_chars"` // Tool outputs up to this many characters are never compacted
+	PreviewChars       int `json:"preview_chars"`         // Number of leading characters of a compacted output that are kept
+}
+
+// ToolCompactionPlugin compacts tool outputs of older tool-call turns in chat and responses requests
+type ToolCompactionPlugin struct {
+	config Config
+	logger schemas.Logger
+}
+
+// Init creates a new tool compaction plugin instance. Zero config values are replaced with defaults.
+func Init(config Config, logger schemas.Logger) (*ToolCompactionPlugin, error) {
+	if config.KeepRecentTurns == 0 {
+		config.KeepRecentTurns = DefaultKeepRecentTurns
+	}
+	if config.MaxToolOutputChars == 0 {
+		config.MaxToolOutputChars = DefaultMaxToolOutputChars
+	}
+	if config.PreviewChars == 0 {
+		config.PreviewChars = min(DefaultPreviewChars, config.MaxToolOutputChars)
+	}
+	if config.KeepRecentTurns < 0 || config.MaxToolOutputChars < 0 || config.PreviewChars < 0 {
+		return nil, fmt.Errorf("tool compaction config values must not be negative")
+	}
+	if config.PreviewChars > config.MaxToolOutputChars {
+		return nil, fmt.Errorf("preview_chars (%d) must not exceed max_tool_output_chars (%d)", config.PreviewChars, config.MaxToolOutputChars)
+	}
+	return &ToolCompactionPlugin{
+		config: config,
+		logger: logger,
+	}, nil
+}
+
+// GetName returns the plugin name
+func (p *ToolCompactionPlugin) GetName() string {
+	return PluginName
+}
+
+// HTTPTransportPreHook is not used for this plugin
+func (p *ToolCompactionPlugin) HTTPTransportPreHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest) (*schemas.HTTPResponse, error) {
+	return nil, nil
+}
+
+// HTTPTransportPostHook is not used for this plugin
+func (p *ToolCompactionPlugin) HTTPTransportPostHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest, resp *schemas.HTTPResponse) error {
+	return nil
+}
+
+// HTTPTransportStreamChunkHook passes through streaming chunks unchanged
+func (p *ToolCompactionPlugin) HTTPTransportStreamChunkHook(ctx *schemas.BifrostContext, req *schemas.HTTPRequest, chunk *schemas.BifrostStreamChunk) (*schemas.BifrostStreamChunk, error) {
+	return chunk, nil
+}
+
+// PreLLMHook compacts the tool-call history of chat and responses requests.
+// The caller's messages are never modified; compacted messages are copies.
+func (p *ToolCompactionPlugin) PreLLMHook(ctx *schemas.BifrostContext, req *schemas.BifrostRequest) (*schemas.BifrostRequest, *schemas.LLMPluginShortCircuit, error) {
+	var stats compactionStats
+	switch {
+	case req.ChatRequest != nil:
+		req.ChatRequest.Input, stats = compactChatMessages(req.ChatRequest.Input, p.config)
+	case req.ResponsesRequest != nil:
+		req.ResponsesRequest.Input, stats = compactResponsesMessages(req.ResponsesRequest.Input, p.config)
+	}
+	if stats.Outputs > 0 {
+		ctx.SetValue(compactionStatsKey, stats)
+		if p.logger != nil {
+			p.logger.Debug("%s: %s", PluginName, stats.String())
+		}
+	}
+	return req, nil, nil
+}
+
+// PostLLMHook records the compaction in the response warnings.
+// For streams, the warning is added to the final chunk only.
+func (p *ToolCompactionPlugin) PostLLMHook(ctx *schemas.BifrostContext, result *schemas.BifrostResponse, bifrostErr *schemas.BifrostError) (*schemas.BifrostResponse, *schemas.BifrostError, error) {
+	stats, ok := ctx.Value(compactionStatsKey).(compactionStats)
+	if !ok || result == nil {
+		return result, bifrostErr, nil
+	}
+	extraFields := result.GetExtraFields()
+	if extraFields == nil {
+		return result, bifrostErr, nil
+	}
+	if bifrost.IsStreamRequestType(extraFields.RequestType) && !bifrost.IsFinalChunk(ctx) {
+		return result, bifrostErr, nil
+	}
+	extraFields.Warnings = append(extraFields.Warnings, fmt.Sprintf("%s: %s", PluginName, stats.String()))
+	return result, bifrostErr, nil
+}
+
+// Cleanup performs plugin cleanup
+func (p *ToolCompactionPlugin) Cleanup() error {
+	return nil
+}
@@ -0,0 +1,192 @@
+package toolcompaction
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// chatToolTurn returns an assistant message calling a tool followed by the tool's result
+func chatToolTurn(callID string, output string) []schemas.ChatMessage {
+	return []schemas.ChatMessage{
+		{
+			Role: schemas.ChatMessageRoleAssistant,
+			ChatAssistantMessage: &schemas.ChatAssistantMessage{
+				ToolCalls: []schemas.ChatAssistantMessageToolCall{{
+					ID:       schemas.Ptr(callID),
+					Function: schemas.ChatAssistantMessageToolCallFunction{Name: schemas.Ptr("search")},
+				}},
+			},
+		},
+		{
+			Role:            schemas.ChatMessageRoleTool,
+			Content:         &schemas.ChatMessageContent{ContentStr: schemas.Ptr(output)},
+			ChatToolMessage: &schemas.ChatToolMessage{ToolCallID: schemas.Ptr(callID)},
+		},
+	}
+}
+
+// responsesToolTurn returns a function call item followed by its output item
+func responsesToolTurn(callID string, output string) []schemas.ResponsesMessage {
+	return []schemas.ResponsesMessage{
+		{
+			Type: schemas.Ptr(schemas.ResponsesMessageTypeFunctionCall),
+			ResponsesToolMessage: &schemas.ResponsesToolMessage{
+				CallID: schemas.Ptr(callID),
+				Name:   schemas.Ptr("search"),
+			},
+		},
+		{
+			Type: schemas.Ptr(schemas.ResponsesMessageTypeFunctionCallOutput),
+			ResponsesToolMessage: &schemas.ResponsesToolMessage{
+				CallID: schemas.Ptr(callID),
+				Output: &schemas.ResponsesToolMessageOutputStruct{ResponsesToolCallOutputStr: schemas.Ptr(output)},
+			},
+		},
+	}
+}
+
+func TestInit(t *testing.T) {
+	plugin, err := Init(Config{}, nil)
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	if plugin.config.KeepRecentTurns != DefaultKeepRecentTurns || plugin.config.MaxToolOutputChars != DefaultMaxToolOutputChars || plugin.config.PreviewChars != DefaultPreviewChars {
+		t.Errorf("Init() config = %+v, want defaults", plugin.config)
+	}
+
+	plugin, err = Init(Config{MaxToolOutputChars: 100}, nil)
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	if plugin.config.PreviewChars != 100 {
+		t.Errorf("PreviewChars = %d, want it capped at MaxToolOutputChars", plugin.config.PreviewChars)
+	}
+
+	for _, config := range []Config{{KeepRecentTurns: -1}, {MaxToolOutputChars: 10, PreviewChars: 20}} {
+		if _, err := Init(config, nil); err == nil {
+			t.Errorf("Init(%+v) expected error", config)
+		}
+	}
+}
+
+func TestCompactChatMessages(t *testing.T) {
+	config := Config{KeepRecentTurns: 1, MaxToolOutputChars: 10, PreviewChars: 4}
+	long := strings.Repeat("é", 50)
+
+	messages := []schemas.ChatMessage{{Role: schemas.ChatMessageRoleUser, Content: &schemas.ChatMessageContent{ContentStr: schemas.Ptr(long)}}}
+	messages = append(messages, chatToolTurn("call_1", long)...)
+	messages = append(messages, chatToolTurn("call_2", "short")...)
+	messages = append(messages, chatToolTurn("call_3", long)...)
+
+	compacted, stats := compactChatMessages(messages, config)
+	if stats.Outputs != 1 || stats.Turns != 1 {
+		t.Fatalf("stats = %+v, want 1 output from 1 turn", stats)
+	}
+	if *messages[2].Content.ContentStr != long {
+		t.Error("input messages were modified")
+	}
+
+	first := *compacted[2].Content.ContentStr
+	if !strings.HasPrefix(first, "éééé\n") || !strings.Contains(first, "46 of 50 characters omitted") {
+		t.Errorf("compacted output = %q", first)
+	}
+	if compacted[2].ChatToolMessage == nil || *compacted[2].ChatToolMessage.ToolCallID != "call_1" {
+		t.Error("compacted message lost its tool call ID")
+	}
+	if *compacted[0].Content.ContentStr != long {
+		t.Error("non-tool messages should not be compacted")
+	}
+	if *compacted[4].Content.ContentStr != "short" {
+		t.Error("short tool outputs should not be compacted")
+	}
+	if *compacted[6].Content.ContentStr != long {
+		t.Error("tool outputs of recent turns should not be compacted")
+	}
+
+	// Nothing to compact when all turns are recent
+	unchanged, stats := compactChatMessages(chatToolTurn("call_1", long), config)
+	if stats.Outputs != 0 || *unchanged[1].Content.ContentStr != long {
+		t.Errorf("stats = %+v, want nothing compacted", stats)
+	}
+}
+
+func TestCompactResponsesMessages(t *testing.T) {
+	config := Config{KeepRecentTurns: 1, MaxToolOutputChars: 10, PreviewChars: 0}
+	long := strings.Repeat("x", 50)
+
+	// Parallel calls followed by their outputs form a single turn
+	messages := []schemas.ResponsesMessage{}
+	first, second := responsesToolTurn("call_1", long), responsesToolTurn("call_2", long)
+	messages = append(messages, first[0], second[0], first[1], second[1])
+	messages = append(messages, responsesToolTurn("call_3", long)...)
+
+	compacted, stats := compactResponsesMessages(messages, config)
+	if stats.Outputs != 2 || stats.Turns != 1 {
+		t.Fatalf("stats = %+v, want 2 outputs from 1 turn", stats)
+	}
+	if *messages[2].ResponsesToolMessage.Output.ResponsesToolCallOutputStr != long {
+		t.Error("input messages were modified")
+	}
+	for _, i := range []int{2, 3} {
+		toolMessage := compacted[i].ResponsesToolMessage
+		if output := *toolMessage.Output.ResponsesToolCallOutputStr; output != "[tool output compacted: 50 of 50 characters omitted]" {
+			t.Errorf("compacted output %d = %q", i, output)
+		}
+		if toolMessage.CallID == nil {
+			t.Errorf("compacted output %d lost its call ID", i)
+		}
+	}
+	if *compacted[5].ResponsesToolMessage.Output.ResponsesToolCallOutputStr != long {
+		t.Error("tool outputs of recent turns should not be compacted")
+	}
+}
+
+func TestHooksRecordWarning(t *testing.T) {
+	plugin, err := Init(Config{KeepRecentTurns: 1, MaxToolOutputChars: 10, PreviewChars: 4}, nil)
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	long := strings.Repeat("x", 50)
+	input := append(chatToolTurn("call_1", long), chatToolTurn("call_2", long)...)
+
+	ctx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	req, shortCircuit, err := plugin.PreLLMHook(ctx, &schemas.BifrostRequest{
+		RequestType: schemas.ChatCompletionRequest,
+		ChatRequest: &schemas.BifrostChatRequest{Provider: schemas.OpenAI, Model: "gpt-4o-mini", Input: input},
+	})
+	if err != nil || shortCircuit != nil {
+		t.Fatalf("PreLLMHook() = %v, %v", shortCircuit, err)
+	}
+	if *req.ChatRequest.Input[1].Content.ContentStr == long {
+		t.Fatal("PreLLMHook() did not compact the earlier tool output")
+	}
+
+	result := &schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{
+		ExtraFields: schemas.BifrostResponseExtraFields{RequestType: schemas.ChatCompletionRequest},
+	}}
+	result, _, err = plugin.PostLLMHook(ctx, result, nil)
+	if err != nil {
+		t.Fatalf("PostLLMHook() error = %v", err)
+	}
+	warnings := result.ChatResponse.ExtraFields.Warnings
+	if len(warnings) != 1 || !strings.HasPrefix(warnings[0], PluginName+": compacted 1 tool output(s)") {
+		t.Errorf("warnings = %v", warnings)
+	}
+
+	// Stream chunks only get the warning on the final chunk
+	chunk := &schemas.BifrostResponse{ChatResponse: &schemas.BifrostChatResponse{
+		ExtraFields: schemas.BifrostResponseExtraFields{RequestType: schemas.ChatCompletionStreamRequest},
+	}}
+	chunk, _, _ = plugin.PostLLMHook(ctx, chunk, nil)
+	if len(chunk.ChatResponse.ExtraFields.Warnings) != 0 {
+		t.Error("non-final stream chunk should not get a warning")
+	}
+	ctx.SetValue(schemas.BifrostContextKeyStreamEndIndicator, true)
+	chunk, _, _ = plugin.PostLLMHook(ctx, chunk, nil)
+	if len(chunk.ChatResponse.ExtraFields.Warnings) != 1 {
+		t.Error("final stream chunk should get the warning")
+	}
+}
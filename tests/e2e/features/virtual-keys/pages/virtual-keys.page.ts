@@ -96,6 +96,10 @@ export class VirtualKeysPage extends BasePage {
   readonly saveBtn: Locator
   readonly cancelBtn: Locator
 
+  // Dialog showing the full key once after creation
+  readonly createdDialog: Locator
+  readonly createdValue: Locator
+
   constructor(page: Page) {
     super(page)
 
@@ -111,6 +115,9 @@ export class VirtualKeysPage extends BasePage {
     this.providerSelect = page.getByTestId('vk-provider-select')
     this.saveBtn = page.getByTestId('vk-save-btn')
     this.cancelBtn = page.getByTestId('vk-cancel-btn')
+
+    this.createdDialog = page.getByTestId('vk-created-dialog')
+    this.createdValue = page.getByTestId('vk-created-value')
   }
 
   /**
@@ -139,9 +146,9 @@ export class VirtualKeysPage extends BasePage {
   }
 
   /**
-   * Create a new virtual key
+   * Create a new virtual key and return its full value, which is shown only once
    */
-  async createVirtualKey(config: VirtualKeyConfig): Promise<void> {
+  async createVirtualKey(config: VirtualKeyConfig): Promise<string> {
     // Click create button
     await this.createBtn.click()
 
@@ -198,10 +205,17 @@ export class VirtualKeysPage extends BasePage {
     // Wait for sheet to close
     await expect(this.sheet).not.toBeVisible({ timeout: 5000 })
 
+    // Read the full key from the dialog and close it
+    await expect(this.createdDialog).toBeVisible({ timeout: 5000 })
+    const value = (await this.createdValue.textContent())?.trim() ?? ''
+    await this.createdDialog.getByRole('button', { name: 'Done' }).click()
+    await expect(this.createdDialog).not.toBeVisible({ timeout: 5000 })
+
     // Wait for the new row to appear in the table (ensures table has refreshed)
     const row = this.getVirtualKeyRow(config.name)
     await row.waitFor({ state: 'attached', timeout: 10000 })
     await row.scrollIntoViewIfNeeded()
+    return value
   }
 
   /**
@@ -500,27 +514,12 @@ export class VirtualKeysPage extends BasePage {
   }
 
   /**
-   * Copy virtual key value to clipboard
-   */
-  async copyVirtualKeyValue(name: string): Promise<void> {
-    // Find and click the copy button using data-testid
-    const copyBtn = this.page.getByTestId(`vk-copy-btn-${name}`)
-    await copyBtn.waitFor({ state: 'attached', timeout: 10000 })
-    await copyBtn.scrollIntoViewIfNeeded()
-    await copyBtn.click()
-
-    await this.waitForSuccessToast('Copied')
-  }
-
-  /**
-   * Toggle key visibility (show/hide)
+   * Get the key shown in the table, a masked hint of the stored value
    */
-  async toggleKeyVisibility(name: string): Promise<void> {
-    // Find and click the visibility toggle button using data-testid
-    const toggleBtn = this.page.getByTestId(`vk-visibility-btn-${name}`)
-    await toggleBtn.waitFor({ state: 'attached', timeout: 10000 })
-    await toggleBtn.scrollIntoViewIfNeeded()
-    await toggleBtn.click()
+  async getDisplayedKey(name: string): Promise<string> {
+    const value = this.page.getByTestId(`vk-value-${name}`)
+    await value.waitFor({ state: 'attached', timeout: 10000 })
+    return (await value.textContent())?.trim() ?? ''
   }
 
   /**
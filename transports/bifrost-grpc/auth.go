@@ -0,0 +1,43 @@
+package bifrostgrpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+)
+
+// Authenticator authenticates a call from its incoming metadata and returns the context to serve it
+// with. It returns a gRPC status error (usually codes.Unauthenticated) to reject the call.
+type Authenticator func(ctx context.Context) (context.Context, error)
+
+// UnaryAuthInterceptor rejects unary calls that authenticate does not accept.
+func UnaryAuthInterceptor(authenticate Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor rejects streaming calls that authenticate does not accept.
+func StreamAuthInterceptor(authenticate Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticate(stream.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+// authenticatedStream is a server stream served with the context returned by the authenticator.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,346 @@
+package bifrostgrpc
+
+import (
+	"github.com/capsohq/bifrost/core/schemas"
+	bifrostv1 "github.com/capsohq/bifrost/transports/bifrost-grpc/gen/bifrost/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// chatRequestFromProto converts a ChatRequest to a BifrostChatRequest.
+func chatRequestFromProto(req *bifrostv1.ChatRequest) (*schemas.BifrostChatRequest, error) {
+	if req.GetProvider() == "" || req.GetModel() == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider and model are required")
+	}
+	if len(req.GetMessages()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "messages cannot be empty")
+	}
+	bifrostReq := &schemas.BifrostChatRequest{
+		Provider:  schemas.ModelProvider(req.GetProvider()),
+		Model:     req.GetModel(),
+		Input:     make([]schemas.ChatMessage, 0, len(req.GetMessages())),
+		Fallbacks: fallbacksFromProto(req.GetFallbacks()),
+	}
+	for _, message := range req.GetMessages() {
+		bifrostReq.Input = append(bifrostReq.Input, chatMessageFromProto(message))
+	}
+	if req.GetParams() != nil {
+		params, err := chatParametersFromProto(req.GetParams())
+		if err != nil {
+			return nil, err
+		}
+		bifrostReq.Params = params
+	}
+	return bifrostReq, nil
+}
+
+func fallbacksFromProto(fallbacks []*bifrostv1.Fallback) []schemas.Fallback {
+	if len(fallbacks) == 0 {
+		return nil
+	}
+	result := make([]schemas.Fallback, 0, len(fallbacks))
+	for _, fallback := range fallbacks {
+		result = append(result, schemas.Fallback{Provider: schemas.ModelProvider(fallback.GetProvider()), Model: fallback.GetModel()})
+	}
+	return result
+}
+
+func chatMessageFromProto(message *bifrostv1.ChatMessage) schemas.ChatMessage {
+	result := schemas.ChatMessage{
+		Role: schemas.ChatMessageRole(message.GetRole()),
+		Name: message.Name,
+	}
+	if message.Content != nil {
+		result.Content = &schemas.ChatMessageContent{ContentStr: message.Content}
+	} else if len(message.GetContentBlocks()) > 0 {
+		blocks := make([]schemas.ChatContentBlock, 0, len(message.GetContentBlocks()))
+		for _, block := range message.GetContentBlocks() {
+			blocks = append(blocks, chatContentBlockFromProto(block))
+		}
+		result.Content = &schemas.ChatMessageContent{ContentBlocks: blocks}
+	}
+	if message.ToolCallId != nil {
+		result.ChatToolMessage = &schemas.ChatToolMessage{ToolCallID: message.ToolCallId}
+	}
+	if len(message.GetToolCalls()) > 0 || message.Refusal != nil || message.Reasoning != nil {
+		result.ChatAssistantMessage = &schemas.ChatAssistantMessage{
+			Refusal:   message.Refusal,
+			Reasoning: message.Reasoning,
+			ToolCalls: toolCallsFromProto(message.GetToolCalls()),
+		}
+	}
+	return result
+}
+
+func chatContentBlockFromProto(block *bifrostv1.ChatContentBlock) schemas.ChatContentBlock {
+	result := schemas.ChatContentBlock{
+		Type: schemas.ChatContentBlockType(block.GetType()),
+		Text: block.Text,
+	}
+	if image := block.GetImageUrl(); image != nil {
+		result.ImageURLStruct = &schemas.ChatInputImage{URL: image.GetUrl(), Detail: image.Detail}
+	}
+	if audio := block.GetInputAudio(); audio != nil {
+		result.InputAudio = &schemas.ChatInputAudio{Data: audio.GetData(), Format: audio.Format}
+	}
+	if file := block.GetFile(); file != nil {
+		result.File = &schemas.ChatInputFile{FileData: file.FileData, FileID: file.FileId, Filename: file.Filename}
+	}
+	return result
+}
+
+func toolCallsFromProto(toolCalls []*bifrostv1.ChatToolCall) []schemas.ChatAssistantMessageToolCall {
+	if len(toolCalls) == 0 {
+		return nil
+	}
+	result := make([]schemas.ChatAssistantMessageToolCall, 0, len(toolCalls))
+	for _, toolCall := range toolCalls {
+		result = append(result, schemas.ChatAssistantMessageToolCall{
+			Index: uint16(toolCall.GetIndex()),
+			Type:  toolCall.Type,
+			ID:    toolCall.Id,
+			Function: schemas.ChatAssistantMessageToolCallFunction{
+				Name:      toolCall.Name,
+				Arguments: toolCall.GetArguments(),
+			},
+		})
+	}
+	return result
+}
+
+func chatParametersFromProto(params *bifrostv1.ChatParameters) (*schemas.ChatParameters, error) {
+	result := &schemas.ChatParameters{
+		Temperature:       params.Temperature,
+		TopP:              params.TopP,
+		Stop:              params.GetStop(),
+		FrequencyPenalty:  params.FrequencyPenalty,
+		PresencePenalty:   params.PresencePenalty,
+		User:              params.User,
+		ParallelToolCalls: params.ParallelToolCalls,
+	}
+	if params.MaxCompletionTokens != nil {
+		maxTokens := int(params.GetMaxCompletionTokens())
+		result.MaxCompletionTokens = &maxTokens
+	}
+	if params.Seed != nil {
+		seed := int(params.GetSeed())
+		result.Seed = &seed
+	}
+	for _, tool := range params.GetTools() {
+		chatTool, err := chatToolFromProto(tool)
+		if err != nil {
+			return nil, err
+		}
+		result.Tools = append(result.Tools, chatTool)
+	}
+	if toolChoice := params.GetToolChoice(); toolChoice != nil {
+		switch choice := toolChoice.GetChoice().(type) {
+		case *bifrostv1.ChatToolChoice_Mode:
+			result.ToolChoice = &schemas.ChatToolChoice{ChatToolChoiceStr: &choice.Mode}
+		case *bifrostv1.ChatToolChoice_FunctionName:
+			result.ToolChoice = &schemas.ChatToolChoice{ChatToolChoiceStruct: &schemas.ChatToolChoiceStruct{
+				Type:     schemas.ChatToolChoiceTypeFunction,
+				Function: &schemas.ChatToolChoiceFunction{Name: choice.FunctionName},
+			}}
+		}
+	}
+	if params.GetResponseFormat() != nil {
+		var responseFormat interface{} = params.GetResponseFormat().AsMap()
+		result.ResponseFormat = &responseFormat
+	}
+	if reasoning := params.GetReasoning(); reasoning != nil {
+		result.Reasoning = &schemas.ChatReasoning{Effort: reasoning.Effort}
+		if reasoning.MaxTokens != nil {
+			maxTokens := int(reasoning.GetMaxTokens())
+			result.Reasoning.MaxTokens = &maxTokens
+		}
+	}
+	if params.GetExtraParams() != nil {
+		result.ExtraParams = params.GetExtraParams().AsMap()
+	}
+	return result, nil
+}
+
+func chatToolFromProto(tool *bifrostv1.ChatTool) (schemas.ChatTool, error) {
+	function := tool.GetFunction()
+	if function == nil || function.GetName() == "" {
+		return schemas.ChatTool{}, status.Error(codes.InvalidArgument, "tools must have a function with a name")
+	}
+	result := schemas.ChatTool{
+		Type: schemas.ChatToolTypeFunction,
+		Function: &schemas.ChatToolFunction{
+			Name:        function.GetName(),
+			Description: function.Description,
+			Strict:      function.Strict,
+		},
+	}
+	if function.GetParameters() != nil {
+		// Round trip through JSON so the parameters keep the key order handling of ToolFunctionParameters
+		data, err := function.GetParameters().MarshalJSON()
+		if err != nil {
+			return schemas.ChatTool{}, status.Errorf(codes.InvalidArgument, "invalid parameters of tool %s: %v", function.GetName(), err)
+		}
+		var parameters schemas.ToolFunctionParameters
+		if err := schemas.Unmarshal(data, &parameters); err != nil {
+			return schemas.ChatTool{}, status.Errorf(codes.InvalidArgument, "invalid parameters of tool %s: %v", function.GetName(), err)
+		}
+		result.Function.Parameters = &parameters
+	}
+	return result, nil
+}
+
+// chatResponseToProto converts a BifrostChatResponse, or a chunk of a chat stream, to a ChatResponse.
+func chatResponseToProto(resp *schemas.BifrostChatResponse) *bifrostv1.ChatResponse {
+	result := &bifrostv1.ChatResponse{
+		Id:                resp.ID,
+		Created:           int64(resp.Created),
+		Model:             resp.Model,
+		Object:            resp.Object,
+		ServiceTier:       resp.ServiceTier,
+		SystemFingerprint: resp.SystemFingerprint,
+		Usage:             usageToProto(resp.Usage),
+		ExtraFields:       extraFieldsToProto(&resp.ExtraFields),
+	}
+	for _, choice := range resp.Choices {
+		protoChoice := &bifrostv1.ChatChoice{
+			Index:        int32(choice.Index),
+			FinishReason: choice.FinishReason,
+		}
+		if choice.ChatNonStreamResponseChoice != nil && choice.Message != nil {
+			protoChoice.Message = chatMessageToProto(choice.Message)
+		}
+		if choice.ChatStreamResponseChoice != nil && choice.Delta != nil {
+			delta := choice.Delta
+			protoChoice.Delta = &bifrostv1.ChatDelta{
+				Role:      delta.Role,
+				Content:   delta.Content,
+				Refusal:   delta.Refusal,
+				Reasoning: delta.Reasoning,
+				ToolCalls: toolCallsToProto(delta.ToolCalls),
+			}
+		}
+		result.Choices = append(result.Choices, protoChoice)
+	}
+	return result
+}
+
+func chatMessageToProto(message *schemas.ChatMessage) *bifrostv1.ChatMessage {
+	result := &bifrostv1.ChatMessage{
+		Role: string(message.Role),
+		Name: message.Name,
+	}
+	if message.Content != nil {
+		if message.Content.ContentStr != nil {
+			result.Content = message.Content.ContentStr
+		}
+		for _, block := range message.Content.ContentBlocks {
+			protoBlock := &bifrostv1.ChatContentBlock{Type: string(block.Type), Text: block.Text}
+			if block.ImageURLStruct != nil {
+				protoBlock.ImageUrl = &bifrostv1.ChatImageURL{Url: block.ImageURLStruct.URL, Detail: block.ImageURLStruct.Detail}
+			}
+			result.ContentBlocks = append(result.ContentBlocks, protoBlock)
+		}
+	}
+	if message.ChatToolMessage != nil {
+		result.ToolCallId = message.ToolCallID
+	}
+	if message.ChatAssistantMessage != nil {
+		result.Refusal = message.Refusal
+		result.Reasoning = message.Reasoning
+		result.ToolCalls = toolCallsToProto(message.ToolCalls)
+	}
+	return result
+}
+
+func toolCallsToProto(toolCalls []schemas.ChatAssistantMessageToolCall) []*bifrostv1.ChatToolCall {
+	if len(toolCalls) == 0 {
+		return nil
+	}
+	result := make([]*bifrostv1.ChatToolCall, 0, len(toolCalls))
+	for _, toolCall := range toolCalls {
+		result = append(result, &bifrostv1.ChatToolCall{
+			Index:     uint32(toolCall.Index),
+			Type:      toolCall.Type,
+			Id:        toolCall.ID,
+			Name:      toolCall.Function.Name,
+			Arguments: toolCall.Function.Arguments,
+		})
+	}
+	return result
+}
+
+func usageToProto(usage *schemas.BifrostLLMUsage) *bifrostv1.Usage {
+	if usage == nil {
+		return nil
+	}
+	result := &bifrostv1.Usage{
+		PromptTokens:     int32(usage.PromptTokens),
+		CompletionTokens: int32(usage.CompletionTokens),
+		TotalTokens:      int32(usage.TotalTokens),
+	}
+	if usage.PromptTokensDetails != nil {
+		result.CachedReadTokens = int32(usage.PromptTokensDetails.CachedReadTokens)
+		result.CachedWriteTokens = int32(usage.PromptTokensDetails.CachedWriteTokens)
+	}
+	if usage.CompletionTokensDetails != nil {
+		result.ReasoningTokens = int32(usage.CompletionTokensDetails.ReasoningTokens)
+	}
+	if usage.Cost != nil {
+		result.Cost = &usage.Cost.TotalCost
+	}
+	return result
+}
+
+func extraFieldsToProto(extraFields *schemas.BifrostResponseExtraFields) *bifrostv1.ResponseExtraFields {
+	return &bifrostv1.ResponseExtraFields{
+		Provider:       string(extraFields.Provider),
+		ModelRequested: extraFields.ModelRequested,
+		LatencyMs:      extraFields.Latency,
+		ChunkIndex:     int32(extraFields.ChunkIndex),
+		Warnings:       extraFields.Warnings,
+	}
+}
+
+// embeddingRequestFromProto converts an EmbeddingRequest to a BifrostEmbeddingRequest.
+func embeddingRequestFromProto(req *bifrostv1.EmbeddingRequest) (*schemas.BifrostEmbeddingRequest, error) {
+	if req.GetProvider() == "" || req.GetModel() == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider and model are required")
+	}
+	if len(req.GetTexts()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "texts cannot be empty")
+	}
+	bifrostReq := &schemas.BifrostEmbeddingRequest{
+		Provider:  schemas.ModelProvider(req.GetProvider()),
+		Model:     req.GetModel(),
+		Input:     &schemas.EmbeddingInput{Texts: req.GetTexts()},
+		Fallbacks: fallbacksFromProto(req.GetFallbacks()),
+	}
+	if params := req.GetParams(); params != nil {
+		bifrostReq.Params = &schemas.EmbeddingParameters{}
+		if params.Dimensions != nil {
+			dimensions := int(params.GetDimensions())
+			bifrostReq.Params.Dimensions = &dimensions
+		}
+		if params.GetExtraParams() != nil {
+			bifrostReq.Params.ExtraParams = params.GetExtraParams().AsMap()
+		}
+	}
+	return bifrostReq, nil
+}
+
+// embeddingResponseToProto converts a BifrostEmbeddingResponse to an EmbeddingResponse.
+// Embeddings are always requested as floats, so only float vectors are returned.
+func embeddingResponseToProto(resp *schemas.BifrostEmbeddingResponse) *bifrostv1.EmbeddingResponse {
+	result := &bifrostv1.EmbeddingResponse{
+		Model:       resp.Model,
+		Usage:       usageToProto(resp.Usage),
+		ExtraFields: extraFieldsToProto(&resp.ExtraFields),
+	}
+	for _, data := range resp.Data {
+		result.Data = append(result.Data, &bifrostv1.Embedding{
+			Index:  int32(data.Index),
+			Values: data.Embedding.EmbeddingArray,
+		})
+	}
+	return result
+}
@@ -0,0 +1,144 @@
+package bifrostgrpc
+
+import (
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	bifrostv1 "github.com/capsohq/bifrost/transports/bifrost-grpc/gen/bifrost/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestChatRequestFromProto(t *testing.T) {
+	parameters, err := structpb.NewStruct(map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"city": map[string]interface{}{"type": "string"}},
+		"required":   []interface{}{"city"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &bifrostv1.ChatRequest{
+		Provider: "openai",
+		Model:    "gpt-4o",
+		Messages: []*bifrostv1.ChatMessage{
+			{Role: "user", Content: proto.String("What is the weather in Paris?")},
+			{Role: "assistant", ToolCalls: []*bifrostv1.ChatToolCall{{Id: proto.String("call_1"), Type: proto.String("function"), Name: proto.String("get_weather"), Arguments: `{"city":"Paris"}`}}},
+			{Role: "tool", ToolCallId: proto.String("call_1"), Content: proto.String("Sunny")},
+		},
+		Params: &bifrostv1.ChatParameters{
+			Temperature:         proto.Float64(0.2),
+			MaxCompletionTokens: proto.Int32(256),
+			Tools:               []*bifrostv1.ChatTool{{Function: &bifrostv1.ChatToolFunction{Name: "get_weather", Parameters: parameters}}},
+			ToolChoice:          &bifrostv1.ChatToolChoice{Choice: &bifrostv1.ChatToolChoice_FunctionName{FunctionName: "get_weather"}},
+		},
+		Fallbacks: []*bifrostv1.Fallback{{Provider: "anthropic", Model: "claude-sonnet-4"}},
+	}
+
+	bifrostReq, err := chatRequestFromProto(req)
+	if err != nil {
+		t.Fatalf("chatRequestFromProto() error = %v", err)
+	}
+	if bifrostReq.Provider != schemas.OpenAI || bifrostReq.Model != "gpt-4o" || len(bifrostReq.Input) != 3 {
+		t.Fatalf("unexpected request %+v", bifrostReq)
+	}
+	if content := bifrostReq.Input[0].Content; content == nil || content.ContentStr == nil || *content.ContentStr != "What is the weather in Paris?" {
+		t.Errorf("unexpected user message content %+v", content)
+	}
+	assistant := bifrostReq.Input[1].ChatAssistantMessage
+	if assistant == nil || len(assistant.ToolCalls) != 1 || assistant.ToolCalls[0].Function.Arguments != `{"city":"Paris"}` {
+		t.Errorf("unexpected assistant message %+v", assistant)
+	}
+	if tool := bifrostReq.Input[2].ChatToolMessage; tool == nil || tool.ToolCallID == nil || *tool.ToolCallID != "call_1" {
+		t.Errorf("unexpected tool message %+v", tool)
+	}
+	params := bifrostReq.Params
+	if params.MaxCompletionTokens == nil || *params.MaxCompletionTokens != 256 {
+		t.Errorf("unexpected max completion tokens %v", params.MaxCompletionTokens)
+	}
+	if len(params.Tools) != 1 || params.Tools[0].Function.Parameters == nil || params.Tools[0].Function.Parameters.Type != "object" {
+		t.Errorf("unexpected tools %+v", params.Tools)
+	}
+	if params.ToolChoice == nil || params.ToolChoice.ChatToolChoiceStruct == nil || params.ToolChoice.ChatToolChoiceStruct.Function.Name != "get_weather" {
+		t.Errorf("unexpected tool choice %+v", params.ToolChoice)
+	}
+	if len(bifrostReq.Fallbacks) != 1 || bifrostReq.Fallbacks[0].Provider != schemas.Anthropic {
+		t.Errorf("unexpected fallbacks %+v", bifrostReq.Fallbacks)
+	}
+}
+
+func TestChatRequestFromProtoValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		req  *bifrostv1.ChatRequest
+	}{
+		{"missing provider", &bifrostv1.ChatRequest{Model: "gpt-4o", Messages: []*bifrostv1.ChatMessage{{Role: "user"}}}},
+		{"missing messages", &bifrostv1.ChatRequest{Provider: "openai", Model: "gpt-4o"}},
+		{"unnamed tool", &bifrostv1.ChatRequest{Provider: "openai", Model: "gpt-4o", Messages: []*bifrostv1.ChatMessage{{Role: "user"}}, Params: &bifrostv1.ChatParameters{Tools: []*bifrostv1.ChatTool{{}}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := chatRequestFromProto(tt.req)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("expected InvalidArgument, got %v", err)
+			}
+		})
+	}
+}
+
+func TestChatResponseToProto(t *testing.T) {
+	finishReason := "stop"
+	content := "Sunny"
+	resp := &schemas.BifrostChatResponse{
+		ID:    "chatcmpl-1",
+		Model: "gpt-4o",
+		Choices: []schemas.BifrostResponseChoice{
+			{
+				Index:                       0,
+				FinishReason:                &finishReason,
+				ChatNonStreamResponseChoice: &schemas.ChatNonStreamResponseChoice{Message: &schemas.ChatMessage{Role: schemas.ChatMessageRoleAssistant, Content: &schemas.ChatMessageContent{ContentStr: &content}}},
+			},
+		},
+		Usage: &schemas.BifrostLLMUsage{PromptTokens: 10, CompletionTokens: 2, TotalTokens: 12},
+		ExtraFields: schemas.BifrostResponseExtraFields{
+			Provider: schemas.OpenAI,
+			Latency:  42,
+		},
+	}
+
+	protoResp := chatResponseToProto(resp)
+	if protoResp.GetId() != "chatcmpl-1" || len(protoResp.GetChoices()) != 1 {
+		t.Fatalf("unexpected response %v", protoResp)
+	}
+	if message := protoResp.GetChoices()[0].GetMessage(); message.GetRole() != "assistant" || message.GetContent() != "Sunny" {
+		t.Errorf("unexpected message %v", message)
+	}
+	if protoResp.GetUsage().GetTotalTokens() != 12 || protoResp.GetExtraFields().GetProvider() != "openai" || protoResp.GetExtraFields().GetLatencyMs() != 42 {
+		t.Errorf("unexpected usage or extra fields %v", protoResp)
+	}
+}
+
+func TestStatusFromBifrostError(t *testing.T) {
+	statusCode := func(code int) *int { return &code }
+	tests := []struct {
+		name string
+		err  *schemas.BifrostError
+		want codes.Code
+	}{
+		{"request error", &schemas.BifrostError{Error: &schemas.ErrorField{Message: "invalid"}}, codes.InvalidArgument},
+		{"bifrost error", &schemas.BifrostError{IsBifrostError: true, Error: &schemas.ErrorField{Message: "failed"}}, codes.Internal},
+		{"unauthorized", &schemas.BifrostError{StatusCode: statusCode(401)}, codes.Unauthenticated},
+		{"rate limited", &schemas.BifrostError{StatusCode: statusCode(429)}, codes.ResourceExhausted},
+		{"provider down", &schemas.BifrostError{StatusCode: statusCode(503)}, codes.Unavailable},
+		{"timeout", &schemas.BifrostError{StatusCode: statusCode(504)}, codes.DeadlineExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := status.Code(statusFromBifrostError(tt.err)); got != tt.want {
+				t.Errorf("code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
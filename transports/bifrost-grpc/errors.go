@@ -0,0 +1,55 @@
+package bifrostgrpc
+
+import (
+	"net/http"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusFromBifrostError converts a Bifrost error to a gRPC status error. The code follows the
+// HTTP status the HTTP transport would send for the same error.
+func statusFromBifrostError(bifrostErr *schemas.BifrostError) error {
+	message := "unknown error"
+	if bifrostErr.Error != nil && bifrostErr.Error.Message != "" {
+		message = bifrostErr.Error.Message
+	}
+	return status.Error(codeFromBifrostError(bifrostErr), message)
+}
+
+func codeFromBifrostError(bifrostErr *schemas.BifrostError) codes.Code {
+	if bifrostErr.StatusCode == nil {
+		// Errors without a status code are request errors unless Bifrost raised them itself
+		if !bifrostErr.IsBifrostError {
+			return codes.InvalidArgument
+		}
+		return codes.Internal
+	}
+	switch statusCode := *bifrostErr.StatusCode; {
+	case statusCode == http.StatusBadRequest, statusCode == http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case statusCode == http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case statusCode == http.StatusPaymentRequired, statusCode == http.StatusForbidden:
+		return codes.PermissionDenied
+	case statusCode == http.StatusNotFound:
+		return codes.NotFound
+	case statusCode == http.StatusRequestTimeout, statusCode == http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	case statusCode == http.StatusConflict:
+		return codes.Aborted
+	case statusCode == http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case statusCode == 499: // Client closed request
+		return codes.Canceled
+	case statusCode == http.StatusNotImplemented:
+		return codes.Unimplemented
+	case statusCode == http.StatusBadGateway, statusCode == http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case statusCode >= 400 && statusCode < 500:
+		return codes.FailedPrecondition
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,136 @@
+// Package bifrostgrpc serves the Bifrost inference APIs over gRPC, for service-to-service callers
+// that want contract-typed APIs instead of HTTP/JSON. The protobuf schemas live in
+// proto/bifrost/v1 and the generated code in gen/bifrost/v1 (regenerate with `buf generate`).
+//
+// The gRPC server is started by the HTTP transport when a gRPC port is configured, and sends
+// requests through the same Bifrost client, so plugins, governance, fallbacks and logging apply.
+package bifrostgrpc
+
+import (
+	"context"
+	"strings"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/plugins/governance"
+	bifrostv1 "github.com/capsohq/bifrost/transports/bifrost-grpc/gen/bifrost/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// Server implements the BifrostService gRPC API on top of a Bifrost client.
+type Server struct {
+	bifrostv1.UnimplementedBifrostServiceServer
+
+	client *bifrost.Bifrost
+	logger schemas.Logger
+}
+
+// NewServer creates a BifrostService implementation sending requests through client.
+func NewServer(client *bifrost.Bifrost, logger schemas.Logger) *Server {
+	return &Server{client: client, logger: logger}
+}
+
+// Register registers the BifrostService on a gRPC server.
+func (s *Server) Register(registrar grpc.ServiceRegistrar) {
+	bifrostv1.RegisterBifrostServiceServer(registrar, s)
+}
+
+// ChatCompletion sends a chat completion request and returns the full response.
+func (s *Server) ChatCompletion(ctx context.Context, req *bifrostv1.ChatRequest) (*bifrostv1.ChatResponse, error) {
+	bifrostReq, err := chatRequestFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	bifrostCtx, cancel := newBifrostContext(ctx)
+	defer cancel()
+
+	resp, bifrostErr := s.client.ChatCompletionRequest(bifrostCtx, bifrostReq)
+	if bifrostErr != nil {
+		return nil, statusFromBifrostError(bifrostErr)
+	}
+	return chatResponseToProto(resp), nil
+}
+
+// ChatCompletionStream sends a chat completion request and streams the response chunks.
+// When the client goes away, the stream context is cancelled, which cancels the provider request.
+func (s *Server) ChatCompletionStream(req *bifrostv1.ChatRequest, stream grpc.ServerStreamingServer[bifrostv1.ChatResponse]) error {
+	bifrostReq, err := chatRequestFromProto(req)
+	if err != nil {
+		return err
+	}
+	bifrostCtx, cancel := newBifrostContext(stream.Context())
+	defer cancel()
+
+	chunks, bifrostErr := s.client.ChatCompletionStreamRequest(bifrostCtx, bifrostReq)
+	if bifrostErr != nil {
+		return statusFromBifrostError(bifrostErr)
+	}
+	for chunk := range chunks {
+		if chunk == nil {
+			continue
+		}
+		if chunk.BifrostError != nil {
+			return statusFromBifrostError(chunk.BifrostError)
+		}
+		if chunk.BifrostChatResponse == nil {
+			continue
+		}
+		if err := stream.Send(chatResponseToProto(chunk.BifrostChatResponse)); err != nil {
+			s.logger.Debug("failed to send gRPC stream chunk, client disconnected: %v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// Embedding creates embeddings for the input texts.
+func (s *Server) Embedding(ctx context.Context, req *bifrostv1.EmbeddingRequest) (*bifrostv1.EmbeddingResponse, error) {
+	bifrostReq, err := embeddingRequestFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+	bifrostCtx, cancel := newBifrostContext(ctx)
+	defer cancel()
+
+	resp, bifrostErr := s.client.EmbeddingRequest(bifrostCtx, bifrostReq)
+	if bifrostErr != nil {
+		return nil, statusFromBifrostError(bifrostErr)
+	}
+	return embeddingResponseToProto(resp), nil
+}
+
+// newBifrostContext creates the Bifrost context of a call from its metadata: the request ID
+// (x-request-id) and the virtual key (x-bf-vk, or an sk-bf- bearer token in authorization).
+func newBifrostContext(ctx context.Context) (*schemas.BifrostContext, context.CancelFunc) {
+	bifrostCtx, cancel := schemas.NewBifrostContextWithCancel(ctx)
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	requestID := firstMetadataValue(md, "x-request-id")
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+	bifrostCtx.SetValue(schemas.BifrostContextKeyRequestID, requestID)
+
+	if virtualKey := firstMetadataValue(md, string(schemas.BifrostContextKeyVirtualKey)); virtualKey != "" {
+		bifrostCtx.SetValue(schemas.BifrostContextKeyVirtualKey, virtualKey)
+	} else if authorization := firstMetadataValue(md, "authorization"); authorization != "" {
+		token := strings.TrimSpace(authorization)
+		if len(token) > 7 && strings.EqualFold(token[:7], "bearer ") {
+			token = strings.TrimSpace(token[7:])
+		}
+		if strings.HasPrefix(strings.ToLower(token), governance.VirtualKeyPrefix) {
+			bifrostCtx.SetValue(schemas.BifrostContextKeyVirtualKey, token)
+		}
+	}
+	return bifrostCtx, cancel
+}
+
+// firstMetadataValue returns the first value of a metadata key, or "" when it is not set.
+func firstMetadataValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
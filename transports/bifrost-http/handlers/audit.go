@@ -0,0 +1,356 @@
+package handlers
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/fasthttp/router"
+	"github.com/valyala/fasthttp"
+)
+
+// auditContextKey is the type of the request user values set for the audit log
+type auditContextKey string
+
+// auditActorKey holds the principal making a management API request, set by the AuthMiddleware
+const auditActorKey auditContextKey = "audit-actor"
+
+// auditAnonymousActor is recorded when the management API is called without authentication
+const auditAnonymousActor = "anonymous"
+
+// auditRedactedValue replaces secrets in audited values
+const auditRedactedValue = "<redacted>"
+
+// auditedPrefixes are the management routes whose mutations are recorded in the audit log
+var auditedPrefixes = []string{
+	"/api/providers",
+	"/api/governance/",
+	"/api/config",
+	"/api/proxy-config",
+	"/api/plugins",
+	"/api/mcp/client",
+	"/api/presets",
+	"/api/oauth/config",
+}
+
+// auditSecretFields are the field names whose values are redacted from audited values
+var auditSecretFields = map[string]bool{
+	"value":         true,
+	"password":      true,
+	"secret":        true,
+	"token":         true,
+	"authorization": true,
+	"api_key":       true,
+	"private_key":   true,
+	"secret_key":    true,
+	"access_key":    true,
+}
+
+// auditSecretSuffixes are the field name suffixes whose values are redacted from audited values
+var auditSecretSuffixes = []string{"_secret", "_password", "_token", "_api_key", "_private_key", "_secret_key", "_access_key"}
+
+// AuditMiddleware records mutations of the gateway configuration made through the management API
+type AuditMiddleware struct {
+	store   configstore.ConfigStore
+	handler func() fasthttp.RequestHandler
+}
+
+// NewAuditMiddleware creates a new audit middleware. handler returns the router handler used to
+// read the state of a resource before and after it is changed.
+func NewAuditMiddleware(store configstore.ConfigStore, handler func() fasthttp.RequestHandler) *AuditMiddleware {
+	return &AuditMiddleware{
+		store:   store,
+		handler: handler,
+	}
+}
+
+// Middleware returns the audit middleware
+func (m *AuditMiddleware) Middleware() schemas.BifrostHTTPMiddleware {
+	return func(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+		return func(ctx *fasthttp.RequestCtx) {
+			method := string(ctx.Method())
+			path := string(ctx.Path())
+			if m.store == nil || isReadMethod(method) || !isAuditedPath(path) {
+				next(ctx)
+				return
+			}
+			resourceType, resourceID, ok := parseAuditResource(path)
+			if !ok {
+				next(ctx)
+				return
+			}
+			action := configstoreTables.AuditActionUpdate
+			switch {
+			case method == fasthttp.MethodDelete:
+				action = configstoreTables.AuditActionDelete
+			case method == fasthttp.MethodPost && resourceID == "":
+				action = configstoreTables.AuditActionCreate
+			}
+			var oldValue any
+			if action != configstoreTables.AuditActionCreate {
+				oldValue = m.snapshot(ctx, path)
+			}
+
+			next(ctx)
+
+			statusCode := ctx.Response.StatusCode()
+			if statusCode >= fasthttp.StatusBadRequest {
+				return
+			}
+			var newValue any
+			if action != configstoreTables.AuditActionDelete {
+				if action != configstoreTables.AuditActionCreate {
+					newValue = m.snapshot(ctx, path)
+				}
+				if newValue == nil {
+					newValue = parseAuditValue(ctx.Response.Body())
+				}
+			}
+			m.record(ctx, &configstoreTables.TableAuditLog{
+				Actor:        auditActor(ctx),
+				Action:       action,
+				ResourceType: resourceType,
+				ResourceID:   resourceID,
+				Method:       method,
+				Path:         path,
+				StatusCode:   statusCode,
+				OldValue:     oldValue,
+				NewValue:     newValue,
+			})
+		}
+	}
+}
+
+// snapshot reads the current state of the resource at path with an internal GET request carrying the caller's credentials.
+// It returns nil when the resource cannot be read.
+func (m *AuditMiddleware) snapshot(ctx *fasthttp.RequestCtx, path string) any {
+	if m.handler == nil {
+		return nil
+	}
+	handler := m.handler()
+	if handler == nil {
+		return nil
+	}
+	var req fasthttp.Request
+	ctx.Request.Header.CopyTo(&req.Header)
+	req.Header.SetMethod(fasthttp.MethodGet)
+	req.Header.Del("Content-Encoding")
+	req.Header.SetContentLength(0)
+	req.SetRequestURI(path)
+	var snapshotCtx fasthttp.RequestCtx
+	snapshotCtx.Init(&req, ctx.RemoteAddr(), nil)
+	handler(&snapshotCtx)
+	if snapshotCtx.Response.StatusCode() != fasthttp.StatusOK {
+		return nil
+	}
+	return parseAuditValue(snapshotCtx.Response.Body())
+}
+
+// record writes an audit log entry, logging failures instead of failing the request
+func (m *AuditMiddleware) record(ctx context.Context, entry *configstoreTables.TableAuditLog) {
+	if err := m.store.CreateAuditLog(ctx, entry); err != nil {
+		logger.Error("failed to write audit log for %s %s: %v", entry.Method, entry.Path, err)
+	}
+}
+
+// recordAccessDenied writes an audit log entry for a management API request rejected by RBAC
+func recordAccessDenied(ctx *fasthttp.RequestCtx, store configstore.ConfigStore, caller *managementCaller) {
+	logger.Warn("rbac: denied %s %s for %s with role %q", string(ctx.Method()), string(ctx.Path()), caller.principal, caller.role)
+	if store == nil {
+		return
+	}
+	path := string(ctx.Path())
+	resourceType, resourceID, _ := parseAuditResource(path)
+	entry := &configstoreTables.TableAuditLog{
+		Actor:        caller.principal,
+		Action:       configstoreTables.AuditActionDenied,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Method:       string(ctx.Method()),
+		Path:         path,
+		StatusCode:   fasthttp.StatusForbidden,
+	}
+	if err := store.CreateAuditLog(ctx, entry); err != nil {
+		logger.Error("failed to write audit log for %s %s: %v", entry.Method, entry.Path, err)
+	}
+}
+
+// auditActor returns the principal recorded for the request
+func auditActor(ctx *fasthttp.RequestCtx) string {
+	if actor, ok := ctx.UserValue(auditActorKey).(string); ok && actor != "" {
+		return actor
+	}
+	return auditAnonymousActor
+}
+
+// adminAuditActor returns the principal of a caller authenticated with the admin credentials or a dashboard session
+func adminAuditActor(authConfig *configstore.AuthConfig) string {
+	if authConfig == nil || authConfig.AdminUserName == nil || authConfig.AdminUserName.GetValue() == "" {
+		return auditAnonymousActor
+	}
+	return "admin:" + authConfig.AdminUserName.GetValue()
+}
+
+// isAuditedPath reports whether mutations of the management route are audited
+func isAuditedPath(path string) bool {
+	for _, prefix := range auditedPrefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAuditResource extracts the resource type and ID from a management route, e.g.
+// /api/providers/openai is ("providers", "openai") and /api/governance/virtual-keys/vk-1 is ("governance/virtual-keys", "vk-1").
+// ok is false for action routes below a resource such as /api/mcp/client/{id}/reconnect.
+func parseAuditResource(path string) (resourceType string, resourceID string, ok bool) {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(path, "/api/"), "/"), "/")
+	typeSegments := 1
+	if len(segments) > 1 && (segments[0] == "governance" || segments[0] == "mcp" || segments[0] == "oauth") {
+		typeSegments = 2
+	}
+	if len(segments) < typeSegments || len(segments) > typeSegments+1 {
+		return strings.Join(segments[:min(typeSegments, len(segments))], "/"), "", false
+	}
+	resourceType = strings.Join(segments[:typeSegments], "/")
+	if len(segments) > typeSegments {
+		resourceID = segments[typeSegments]
+	}
+	return resourceType, resourceID, true
+}
+
+// parseAuditValue decodes a JSON body with its secrets redacted, returning nil for empty or non-JSON bodies
+func parseAuditValue(body []byte) any {
+	if len(body) == 0 {
+		return nil
+	}
+	var value any
+	if err := sonic.Unmarshal(body, &value); err != nil {
+		return nil
+	}
+	return redactAuditValue(value)
+}
+
+// redactAuditValue replaces the string values of secret fields, recursing into objects and arrays
+func redactAuditValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if s, ok := field.(string); ok {
+				if s != "" && isAuditSecretField(key) {
+					v[key] = auditRedactedValue
+				}
+				continue
+			}
+			v[key] = redactAuditValue(field)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactAuditValue(item)
+		}
+		return v
+	}
+	return value
+}
+
+// isAuditSecretField reports whether a field holds a secret
+func isAuditSecretField(name string) bool {
+	name = strings.ToLower(name)
+	if auditSecretFields[name] {
+		return true
+	}
+	for _, suffix := range auditSecretSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuditHandler serves the audit log of management API changes
+type AuditHandler struct {
+	configStore configstore.ConfigStore
+}
+
+// NewAuditHandler creates a new AuditHandler
+func NewAuditHandler(configStore configstore.ConfigStore) *AuditHandler {
+	return &AuditHandler{
+		configStore: configStore,
+	}
+}
+
+// RegisterRoutes registers the routes for the AuditHandler
+func (h *AuditHandler) RegisterRoutes(r *router.Router, middlewares ...schemas.BifrostHTTPMiddleware) {
+	r.GET("/api/audit-logs", lib.ChainMiddlewares(h.getAuditLogs, middlewares...))
+}
+
+// getAuditLogs handles GET /api/audit-logs - Get audit log entries with filtering and pagination
+func (h *AuditHandler) getAuditLogs(ctx *fasthttp.RequestCtx) {
+	filters := configstore.AuditLogFilters{
+		Actor:        string(ctx.QueryArgs().Peek("actor")),
+		Action:       string(ctx.QueryArgs().Peek("action")),
+		ResourceType: string(ctx.QueryArgs().Peek("resource_type")),
+		ResourceID:   string(ctx.QueryArgs().Peek("resource_id")),
+	}
+	if startTime := string(ctx.QueryArgs().Peek("start_time")); startTime != "" {
+		if t, err := time.Parse(time.RFC3339, startTime); err == nil {
+			filters.StartTime = &t
+		}
+	}
+	if endTime := string(ctx.QueryArgs().Peek("end_time")); endTime != "" {
+		if t, err := time.Parse(time.RFC3339, endTime); err == nil {
+			filters.EndTime = &t
+		}
+	}
+
+	limit := 50 // Default limit
+	if limitArg := string(ctx.QueryArgs().Peek("limit")); limitArg != "" {
+		if i, err := strconv.Atoi(limitArg); err == nil {
+			if i <= 0 {
+				SendError(ctx, fasthttp.StatusBadRequest, "limit must be greater than 0")
+				return
+			}
+			if i > 1000 {
+				SendError(ctx, fasthttp.StatusBadRequest, "limit cannot exceed 1000")
+				return
+			}
+			limit = i
+		}
+	}
+	offset := 0 // Default offset
+	if offsetArg := string(ctx.QueryArgs().Peek("offset")); offsetArg != "" {
+		if i, err := strconv.Atoi(offsetArg); err == nil {
+			if i < 0 {
+				SendError(ctx, fasthttp.StatusBadRequest, "offset cannot be negative")
+				return
+			}
+			offset = i
+		}
+	}
+
+	entries, total, err := h.configStore.GetAuditLogs(ctx, filters, limit, offset)
+	if err != nil {
+		logger.Error("failed to get audit logs: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to get audit logs")
+		return
+	}
+	if entries == nil {
+		entries = []configstoreTables.TableAuditLog{}
+	}
+	SendJSON(ctx, map[string]any{
+		"audit_logs": entries,
+		"pagination": map[string]any{
+			"limit":       limit,
+			"offset":      offset,
+			"total_count": total,
+		},
+	})
+}
@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/capsohq/bifrost/framework/configstore"
+	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/valyala/fasthttp"
+)
+
+// auditRecorder is a config store that keeps the audit log entries written to it
+type auditRecorder struct {
+	configstore.ConfigStore
+	entries []*configstoreTables.TableAuditLog
+}
+
+func (r *auditRecorder) CreateAuditLog(ctx context.Context, entry *configstoreTables.TableAuditLog) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+// TestParseAuditResource tests the resource type and ID extracted from management routes
+func TestParseAuditResource(t *testing.T) {
+	tests := []struct {
+		path         string
+		resourceType string
+		resourceID   string
+		ok           bool
+	}{
+		{"/api/providers", "providers", "", true},
+		{"/api/providers/openai", "providers", "openai", true},
+		{"/api/config", "config", "", true},
+		{"/api/governance/virtual-keys", "governance/virtual-keys", "", true},
+		{"/api/governance/budgets/budget-1", "governance/budgets", "budget-1", true},
+		{"/api/mcp/client/client-1/reconnect", "mcp/client", "", false},
+	}
+	for _, tt := range tests {
+		resourceType, resourceID, ok := parseAuditResource(tt.path)
+		if resourceType != tt.resourceType || resourceID != tt.resourceID || ok != tt.ok {
+			t.Errorf("parseAuditResource(%s) = (%q, %q, %v), want (%q, %q, %v)", tt.path, resourceType, resourceID, ok, tt.resourceType, tt.resourceID, tt.ok)
+		}
+	}
+}
+
+// TestParseAuditValue tests that secrets are redacted from audited values
+func TestParseAuditValue(t *testing.T) {
+	value := parseAuditValue([]byte(`{
+		"name": "openai",
+		"keys": [{"name": "primary", "value": {"value": "sk-secret", "env_var": "", "from_env": false}}],
+		"proxy_config": {"username": "proxy", "password": "hunter2"},
+		"jwt": {"hmac_secret": "jwt-secret", "issuer": "https://issuer.example.com"},
+		"rate_limit": {"token_max_limit": 1000},
+		"empty_secret": ""
+	}`)).(map[string]any)
+
+	key := value["keys"].([]any)[0].(map[string]any)
+	if got := key["value"].(map[string]any)["value"]; got != auditRedactedValue {
+		t.Errorf("Expected key value to be redacted, got %v", got)
+	}
+	if got := key["name"]; got != "primary" {
+		t.Errorf("Expected key name to be kept, got %v", got)
+	}
+	if got := value["proxy_config"].(map[string]any)["password"]; got != auditRedactedValue {
+		t.Errorf("Expected proxy password to be redacted, got %v", got)
+	}
+	jwt := value["jwt"].(map[string]any)
+	if jwt["hmac_secret"] != auditRedactedValue || jwt["issuer"] != "https://issuer.example.com" {
+		t.Errorf("Unexpected jwt config %v", jwt)
+	}
+	if got := value["rate_limit"].(map[string]any)["token_max_limit"]; got != float64(1000) {
+		t.Errorf("Expected token_max_limit to be kept, got %v", got)
+	}
+	if got := value["empty_secret"]; got != "" {
+		t.Errorf("Expected empty secret to be kept empty, got %v", got)
+	}
+	if parseAuditValue([]byte("not json")) != nil {
+		t.Error("Expected nil for a non-JSON body")
+	}
+}
+
+// TestAuditMiddleware tests that successful mutations are recorded with the caller and the old and new values
+func TestAuditMiddleware(t *testing.T) {
+	SetLogger(&mockLogger{})
+
+	state := `{"name": "openai", "api_key": "sk-old"}`
+	router := func(ctx *fasthttp.RequestCtx) {
+		switch string(ctx.Method()) {
+		case fasthttp.MethodGet:
+			ctx.SetStatusCode(fasthttp.StatusOK)
+			ctx.SetBodyString(state)
+		case fasthttp.MethodPut:
+			state = `{"name": "openai", "api_key": "sk-new"}`
+			SendJSON(ctx, map[string]string{"status": "success"})
+		default:
+			SendError(ctx, fasthttp.StatusBadRequest, "invalid request")
+		}
+	}
+	store := &auditRecorder{}
+	middleware := NewAuditMiddleware(store, func() fasthttp.RequestHandler { return router }).Middleware()
+
+	newRequest := func(method, path string) *fasthttp.RequestCtx {
+		var req fasthttp.Request
+		req.Header.SetMethod(method)
+		req.SetRequestURI(path)
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Init(&req, &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}, nil)
+		return ctx
+	}
+
+	ctx := newRequest(fasthttp.MethodPut, "/api/providers/openai")
+	ctx.SetUserValue(auditActorKey, "user:user-1")
+	middleware(router)(ctx)
+
+	if len(store.entries) != 1 {
+		t.Fatalf("Expected 1 audit log entry, got %d", len(store.entries))
+	}
+	entry := store.entries[0]
+	if entry.Actor != "user:user-1" || entry.Action != configstoreTables.AuditActionUpdate || entry.ResourceType != "providers" || entry.ResourceID != "openai" {
+		t.Errorf("Unexpected audit log entry %+v", entry)
+	}
+	oldValue, _ := entry.OldValue.(map[string]any)
+	newValue, _ := entry.NewValue.(map[string]any)
+	if oldValue["api_key"] != auditRedactedValue || newValue["api_key"] != auditRedactedValue || newValue["name"] != "openai" {
+		t.Errorf("Expected redacted snapshots, got old %v new %v", entry.OldValue, entry.NewValue)
+	}
+
+	// Reads, failed mutations and unaudited routes are not recorded
+	middleware(router)(newRequest(fasthttp.MethodGet, "/api/providers/openai"))
+	middleware(router)(newRequest(fasthttp.MethodPost, "/api/providers"))
+	middleware(router)(newRequest(fasthttp.MethodPut, "/api/session/logout"))
+	if len(store.entries) != 1 {
+		t.Errorf("Expected only the successful mutation to be recorded, got %d entries", len(store.entries))
+	}
+}
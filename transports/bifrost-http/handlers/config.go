@@ -47,6 +47,17 @@ type ConfigManager interface {
 	ReloadPricingManager(ctx context.Context) error
 	ForceReloadPricing(ctx context.Context) error
 	UpdateDropExcessRequests(ctx context.Context, value bool)
+	UpdateLoadSheddingConfig(ctx context.Context, config *schemas.LoadSheddingConfig) error
+	UpdateSchemaDriftConfig(ctx context.Context, config *schemas.SchemaDriftConfig) error
+	UpdateCircuitBreakerConfig(ctx context.Context, config *schemas.CircuitBreakerConfig) error
+	UpdateLatencyRoutingConfig(ctx context.Context, config *schemas.LatencyRoutingConfig) error
+	UpdateCostRoutingConfig(ctx context.Context, config *schemas.CostRoutingConfig) error
+	UpdateVirtualModels(ctx context.Context, models []schemas.VirtualModel) error
+	UpdateTrafficSplits(ctx context.Context, splits []schemas.TrafficSplit) error
+	UpdateSingleFlightConfig(ctx context.Context, config *schemas.SingleFlightConfig) error
+	UpdateToolExecutionConfig(ctx context.Context, config *schemas.ToolExecutionConfig) error
+	UpdateStreamUsageConfig(ctx context.Context, config *schemas.StreamUsageConfig)
+	UpdateHeaderPolicyConfig(ctx context.Context, config *schemas.HeaderPolicyConfig) error
 	UpdateMCPToolManagerConfig(ctx context.Context, maxAgentDepth int, toolExecutionTimeoutInSeconds int, codeModeBindingLevel string) error
 	ReloadPlugin(ctx context.Context, name string, path *string, pluginConfig any) error
 	RemovePlugin(ctx context.Context, name string) error
@@ -190,6 +201,7 @@ func (h *ConfigHandler) getConfig(ctx *fasthttp.RequestCtx) {
 				"admin_password":            passwordEnvVar,
 				"is_enabled":                authConfig.IsEnabled,
 				"disable_auth_on_inference": authConfig.DisableAuthOnInference,
+				"jwt":                       authConfig.JWT.Redacted(),
 			}
 		} else {
 			// No auth config exists yet, return default empty EnvVar values
@@ -415,12 +427,157 @@ func (h *ConfigHandler) updateConfig(ctx *fasthttp.RequestCtx) {
 		updatedConfig.AsyncJobResultTTL = payload.ClientConfig.AsyncJobResultTTL
 	}
 
+	// Handle SSEKeepAliveInterval changes (no restart needed - applies to streams started afterwards)
+	// Only update if explicitly provided (non-zero); a negative value disables keep-alives
+	if payload.ClientConfig.SSEKeepAliveInterval != 0 {
+		updatedConfig.SSEKeepAliveInterval = payload.ClientConfig.SSEKeepAliveInterval
+	}
+
 	// Handle RequiredHeaders changes (no restart needed - governance plugin reads via pointer)
 	updatedConfig.RequiredHeaders = payload.ClientConfig.RequiredHeaders
 
 	// Handle LoggingHeaders changes (no restart needed - logging plugin reads via pointer)
 	updatedConfig.LoggingHeaders = payload.ClientConfig.LoggingHeaders
 
+	// Handle SSEOutputDialects changes (no restart needed - stream handlers resolve the dialect per request)
+	// Only update if provided; an empty object clears all overrides
+	if payload.ClientConfig.SSEOutputDialects != nil {
+		if err := lib.ValidateSSEOutputDialects(payload.ClientConfig.SSEOutputDialects); err != nil {
+			logger.Warn("invalid sse output dialects: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+			return
+		}
+		updatedConfig.SSEOutputDialects = payload.ClientConfig.SSEOutputDialects
+	}
+
+	// Handle ResponseEnvelopes changes (no restart needed - inference handlers resolve the envelope per request)
+	// Only update if provided; an empty object clears all overrides
+	if payload.ClientConfig.ResponseEnvelopes != nil {
+		if err := lib.ValidateResponseEnvelopes(payload.ClientConfig.ResponseEnvelopes); err != nil {
+			logger.Warn("invalid response envelopes: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+			return
+		}
+		updatedConfig.ResponseEnvelopes = payload.ClientConfig.ResponseEnvelopes
+	}
+
+	// Handle LoadShedding changes (no restart needed - the core checks it before queueing each request)
+	// Only update if provided; set enabled to false to turn it off
+	if payload.ClientConfig.LoadShedding != nil {
+		if err := h.configManager.UpdateLoadSheddingConfig(ctx, payload.ClientConfig.LoadShedding); err != nil {
+			logger.Warn("invalid load shedding config: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid load_shedding: %v", err))
+			return
+		}
+		updatedConfig.LoadShedding = payload.ClientConfig.LoadShedding
+	}
+
+	// Handle SchemaDrift changes (no restart needed - provider responses are checked against the current config)
+	// Only update if provided; set enabled to false to turn it off
+	if payload.ClientConfig.SchemaDrift != nil {
+		if err := h.configManager.UpdateSchemaDriftConfig(ctx, payload.ClientConfig.SchemaDrift); err != nil {
+			logger.Warn("invalid schema drift config: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid schema_drift: %v", err))
+			return
+		}
+		updatedConfig.SchemaDrift = payload.ClientConfig.SchemaDrift
+	}
+
+	// Handle CircuitBreaker changes (no restart needed - the core checks circuits when routing each request)
+	// Only update if provided; set enabled to false to turn it off and close all circuits
+	if payload.ClientConfig.CircuitBreaker != nil {
+		if err := h.configManager.UpdateCircuitBreakerConfig(ctx, payload.ClientConfig.CircuitBreaker); err != nil {
+			logger.Warn("invalid circuit breaker config: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid circuit_breaker: %v", err))
+			return
+		}
+		updatedConfig.CircuitBreaker = payload.ClientConfig.CircuitBreaker
+	}
+
+	// Handle LatencyRouting changes (no restart needed - aliases are resolved when each request is routed)
+	// Only update if provided; send an empty aliases map to remove all aliases
+	if payload.ClientConfig.LatencyRouting != nil {
+		if err := h.configManager.UpdateLatencyRoutingConfig(ctx, payload.ClientConfig.LatencyRouting); err != nil {
+			logger.Warn("invalid latency routing config: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid latency_routing: %v", err))
+			return
+		}
+		updatedConfig.LatencyRouting = payload.ClientConfig.LatencyRouting
+	}
+
+	// Handle CostRouting changes (no restart needed - aliases are resolved when each request is routed)
+	// Only update if provided; send an empty aliases map to remove all aliases
+	if payload.ClientConfig.CostRouting != nil {
+		if err := h.configManager.UpdateCostRoutingConfig(ctx, payload.ClientConfig.CostRouting); err != nil {
+			logger.Warn("invalid cost routing config: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid cost_routing: %v", err))
+			return
+		}
+		updatedConfig.CostRouting = payload.ClientConfig.CostRouting
+	}
+
+	// Handle VirtualModels changes (no restart needed - virtual models are resolved when each request is routed)
+	// Only update if provided; send an empty list to remove all virtual models
+	if payload.ClientConfig.VirtualModels != nil {
+		if err := h.configManager.UpdateVirtualModels(ctx, payload.ClientConfig.VirtualModels); err != nil {
+			logger.Warn("invalid virtual models: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid virtual_models: %v", err))
+			return
+		}
+		updatedConfig.VirtualModels = payload.ClientConfig.VirtualModels
+	}
+
+	// Handle TrafficSplits changes (no restart needed - splits are resolved when each request is routed)
+	// Only update if provided; send an empty list to remove all traffic splits
+	if payload.ClientConfig.TrafficSplits != nil {
+		if err := h.configManager.UpdateTrafficSplits(ctx, payload.ClientConfig.TrafficSplits); err != nil {
+			logger.Warn("invalid traffic splits: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid traffic_splits: %v", err))
+			return
+		}
+		updatedConfig.TrafficSplits = payload.ClientConfig.TrafficSplits
+	}
+
+	// Handle SingleFlight changes (no restart needed - requests are deduplicated when they are sent)
+	// Only update if provided; send {"enabled": false} to disable
+	if payload.ClientConfig.SingleFlight != nil {
+		if err := h.configManager.UpdateSingleFlightConfig(ctx, payload.ClientConfig.SingleFlight); err != nil {
+			logger.Warn("invalid single flight config: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid single_flight: %v", err))
+			return
+		}
+		updatedConfig.SingleFlight = payload.ClientConfig.SingleFlight
+	}
+
+	// Handle ToolExecution changes (no restart needed - tools are looked up when a response has tool calls)
+	// Only update if provided; send {} to remove every webhook
+	if payload.ClientConfig.ToolExecution != nil {
+		if err := h.configManager.UpdateToolExecutionConfig(ctx, payload.ClientConfig.ToolExecution); err != nil {
+			logger.Warn("invalid tool execution config: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid tool_execution: %v", err))
+			return
+		}
+		updatedConfig.ToolExecution = payload.ClientConfig.ToolExecution
+	}
+
+	// Handle StreamUsage changes (no restart needed - applies to streams started afterwards)
+	// Only update if provided; send {"enabled": false} to disable
+	if payload.ClientConfig.StreamUsage != nil {
+		h.configManager.UpdateStreamUsageConfig(ctx, payload.ClientConfig.StreamUsage)
+		updatedConfig.StreamUsage = payload.ClientConfig.StreamUsage
+	}
+
+	// Handle HeaderPolicy changes (no restart needed - the policy is applied when a request is sent to a provider)
+	// Only update if provided; send {} to remove every rule
+	if payload.ClientConfig.HeaderPolicy != nil {
+		if err := h.configManager.UpdateHeaderPolicyConfig(ctx, payload.ClientConfig.HeaderPolicy); err != nil {
+			logger.Warn("invalid header policy config: %v", err)
+			SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid header_policy: %v", err))
+			return
+		}
+		updatedConfig.HeaderPolicy = payload.ClientConfig.HeaderPolicy
+	}
+
 	// Toggle whether deleted virtual keys should appear in logs filter data.
 	updatedConfig.HideDeletedVirtualKeysInFilters = payload.ClientConfig.HideDeletedVirtualKeysInFilters
 
@@ -579,6 +736,13 @@ func (h *ConfigHandler) updateConfig(ctx *fasthttp.RequestCtx) {
 			}
 		}
 
+		// Keep the stored JWT HMAC secret when the payload echoes back its redacted form
+		if payload.AuthConfig.JWT != nil && payload.AuthConfig.JWT.HMACSecret != nil && payload.AuthConfig.JWT.HMACSecret.IsRedacted() &&
+			authConfig != nil && authConfig.JWT != nil && authConfig.JWT.HMACSecret != nil &&
+			payload.AuthConfig.JWT.HMACSecret.Equals(authConfig.JWT.HMACSecret.Redacted()) {
+			payload.AuthConfig.JWT.HMACSecret = authConfig.JWT.HMACSecret
+		}
+
 		if payload.AuthConfig.IsEnabled {
 			// Initialize nil pointers to empty EnvVar to prevent nil-pointer dereference
 			if payload.AuthConfig.AdminUserName == nil {
@@ -635,12 +799,13 @@ func (h *ConfigHandler) updateConfig(ctx *fasthttp.RequestCtx) {
 				SendError(ctx, fasthttp.StatusInternalServerError, fmt.Sprintf("failed to update auth config: %v", err))
 				return
 			}
-		} else if authConfig != nil {
+		} else if authConfig != nil || payload.AuthConfig.JWT != nil {
 			// Auth is being disabled but there's an existing config - preserve credentials and update disabled state
-			if payload.AuthConfig.AdminPassword == nil || payload.AuthConfig.AdminPassword.IsRedacted() || payload.AuthConfig.AdminPassword.GetValue() == "" {
+			// JWT auth on inference is configured independently of dashboard auth, so it is saved either way
+			if authConfig != nil && (payload.AuthConfig.AdminPassword == nil || payload.AuthConfig.AdminPassword.IsRedacted() || payload.AuthConfig.AdminPassword.GetValue() == "") {
 				payload.AuthConfig.AdminPassword = authConfig.AdminPassword
 			}
-			if payload.AuthConfig.AdminUserName == nil || payload.AuthConfig.AdminUserName.GetValue() == "" {
+			if authConfig != nil && (payload.AuthConfig.AdminUserName == nil || payload.AuthConfig.AdminUserName.GetValue() == "") {
 				payload.AuthConfig.AdminUserName = authConfig.AdminUserName
 			}
 			err = h.configManager.UpdateAuthConfig(ctx, payload.AuthConfig)
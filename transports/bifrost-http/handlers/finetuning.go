@@ -0,0 +1,271 @@
+// Package handlers provides HTTP request handlers for the Bifrost HTTP transport.
+// This file contains the fine-tuning jobs API handlers.
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/valyala/fasthttp"
+)
+
+// FineTuningJobCreateRequest is a bifrost fine-tuning job create request
+type FineTuningJobCreateRequest struct {
+	Model           string                             `json:"model"`                     // Base model in "provider/model" format
+	TrainingFile    string                             `json:"training_file"`             // ID of an uploaded file with the training data
+	ValidationFile  *string                            `json:"validation_file,omitempty"` // ID of an uploaded file with the validation data
+	Hyperparameters *schemas.FineTuningHyperparameters `json:"hyperparameters,omitempty"`
+	Method          *schemas.FineTuningMethod          `json:"method,omitempty"`
+	Suffix          *string                            `json:"suffix,omitempty"` // Added to the name of the fine-tuned model
+	Seed            *int                               `json:"seed,omitempty"`
+	Metadata        map[string]string                  `json:"metadata,omitempty"`
+}
+
+var fineTuningJobCreateParamsKnownFields = map[string]bool{
+	"model":           true,
+	"training_file":   true,
+	"validation_file": true,
+	"hyperparameters": true,
+	"method":          true,
+	"suffix":          true,
+	"seed":            true,
+	"metadata":        true,
+}
+
+// fineTuningJobCreate handles POST /v1/fine_tuning/jobs - Create a fine-tuning job
+func (h *CompletionHandler) fineTuningJobCreate(ctx *fasthttp.RequestCtx) {
+	var req FineTuningJobCreateRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+
+	provider, modelName := schemas.ParseModelString(req.Model, "")
+	if provider == "" || modelName == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
+		return
+	}
+
+	if req.TrainingFile == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "training_file is required")
+		return
+	}
+
+	// Extract extra params
+	extraParams, err := extractExtraParams(ctx.PostBody(), fineTuningJobCreateParamsKnownFields)
+	if err != nil {
+		logger.Warn("Failed to extract extra params: %v", err)
+	}
+
+	bifrostFineTuningReq := &schemas.BifrostFineTuningJobCreateRequest{
+		Provider:        schemas.ModelProvider(provider),
+		Model:           modelName,
+		TrainingFile:    req.TrainingFile,
+		ValidationFile:  req.ValidationFile,
+		Hyperparameters: req.Hyperparameters,
+		Method:          req.Method,
+		Suffix:          req.Suffix,
+		Seed:            req.Seed,
+		Metadata:        req.Metadata,
+		ExtraParams:     extraParams,
+	}
+
+	// Convert context
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.FineTuningJobCreateRequest(bifrostCtx, bifrostFineTuningReq)
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// fineTuningJobList handles GET /v1/fine_tuning/jobs - List fine-tuning jobs
+func (h *CompletionHandler) fineTuningJobList(ctx *fasthttp.RequestCtx) {
+	provider := string(ctx.QueryArgs().Peek("provider"))
+	if provider == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "provider query parameter is required")
+		return
+	}
+
+	limit, after := parseCursorPagination(ctx)
+	bifrostFineTuningReq := &schemas.BifrostFineTuningJobListRequest{
+		Provider: schemas.ModelProvider(provider),
+		Limit:    limit,
+		After:    after,
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.FineTuningJobListRequest(bifrostCtx, bifrostFineTuningReq)
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// fineTuningJobRetrieve handles GET /v1/fine_tuning/jobs/{fine_tuning_job_id} - Retrieve a fine-tuning job
+func (h *CompletionHandler) fineTuningJobRetrieve(ctx *fasthttp.RequestCtx) {
+	provider, jobID, ok := parseFineTuningJobParams(ctx)
+	if !ok {
+		return
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.FineTuningJobRetrieveRequest(bifrostCtx, &schemas.BifrostFineTuningJobRetrieveRequest{
+		Provider: provider,
+		JobID:    jobID,
+	})
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// fineTuningJobCancel handles POST /v1/fine_tuning/jobs/{fine_tuning_job_id}/cancel - Cancel a fine-tuning job
+func (h *CompletionHandler) fineTuningJobCancel(ctx *fasthttp.RequestCtx) {
+	provider, jobID, ok := parseFineTuningJobParams(ctx)
+	if !ok {
+		return
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.FineTuningJobCancelRequest(bifrostCtx, &schemas.BifrostFineTuningJobCancelRequest{
+		Provider: provider,
+		JobID:    jobID,
+	})
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// fineTuningJobEvents handles GET /v1/fine_tuning/jobs/{fine_tuning_job_id}/events - List the events of a fine-tuning job
+func (h *CompletionHandler) fineTuningJobEvents(ctx *fasthttp.RequestCtx) {
+	provider, jobID, ok := parseFineTuningJobParams(ctx)
+	if !ok {
+		return
+	}
+
+	limit, after := parseCursorPagination(ctx)
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.FineTuningJobEventsRequest(bifrostCtx, &schemas.BifrostFineTuningJobEventsRequest{
+		Provider: provider,
+		JobID:    jobID,
+		Limit:    limit,
+		After:    after,
+	})
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// fineTuningJobCheckpoints handles GET /v1/fine_tuning/jobs/{fine_tuning_job_id}/checkpoints - List the checkpoints of a fine-tuning job
+func (h *CompletionHandler) fineTuningJobCheckpoints(ctx *fasthttp.RequestCtx) {
+	provider, jobID, ok := parseFineTuningJobParams(ctx)
+	if !ok {
+		return
+	}
+
+	limit, after := parseCursorPagination(ctx)
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.FineTuningJobCheckpointsRequest(bifrostCtx, &schemas.BifrostFineTuningJobCheckpointsRequest{
+		Provider: provider,
+		JobID:    jobID,
+		Limit:    limit,
+		After:    after,
+	})
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// parseFineTuningJobParams reads the job ID from the URL and the provider from the query
+// parameters, sending a bad request error if either is missing
+func parseFineTuningJobParams(ctx *fasthttp.RequestCtx) (schemas.ModelProvider, string, bool) {
+	jobID, ok := ctx.UserValue("fine_tuning_job_id").(string)
+	if !ok || jobID == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "fine_tuning_job_id is required")
+		return "", "", false
+	}
+	provider := string(ctx.QueryArgs().Peek("provider"))
+	if provider == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "provider query parameter is required")
+		return "", "", false
+	}
+	return schemas.ModelProvider(provider), jobID, true
+}
@@ -26,6 +26,7 @@ import (
 // GovernanceManager is the interface for the governance manager
 type GovernanceManager interface {
 	GetGovernanceData() *governance.GovernanceData
+	GetTagUsage() map[string]governance.TagUsage
 	ReloadVirtualKey(ctx context.Context, id string) (*configstoreTables.TableVirtualKey, error)
 	RemoveVirtualKey(ctx context.Context, id string) error
 	ReloadTeam(ctx context.Context, id string) (*configstoreTables.TableTeam, error)
@@ -81,6 +82,7 @@ type CreateVirtualKeyRequest struct {
 	Budget     *CreateBudgetRequest    `json:"budget,omitempty"`
 	RateLimit  *CreateRateLimitRequest `json:"rate_limit,omitempty"`
 	IsActive   *bool                   `json:"is_active,omitempty"`
+	Role       *string                 `json:"role,omitempty"` // Management API role (admin, operator or viewer), nil means inference only
 }
 
 // UpdateVirtualKeyRequest represents the request body for updating a virtual key
@@ -106,18 +108,43 @@ type UpdateVirtualKeyRequest struct {
 	Budget     *UpdateBudgetRequest    `json:"budget,omitempty"`
 	RateLimit  *UpdateRateLimitRequest `json:"rate_limit,omitempty"`
 	IsActive   *bool                   `json:"is_active,omitempty"`
+	Role       *string                 `json:"role,omitempty"` // Empty string removes the role
 }
 
 // CreateBudgetRequest represents the request body for creating a budget
 type CreateBudgetRequest struct {
-	MaxLimit      float64 `json:"max_limit" validate:"required"`      // Maximum budget in dollars
-	ResetDuration string  `json:"reset_duration" validate:"required"` // e.g., "30s", "5m", "1h", "1d", "1w", "1M"
+	MaxLimit       float64 `json:"max_limit" validate:"required"`      // Maximum budget in dollars
+	ResetDuration  string  `json:"reset_duration" validate:"required"` // e.g., "30s", "5m", "1h", "1d", "1w", "1M"
+	SlidingWindow  bool    `json:"sliding_window,omitempty"`           // Weigh in the previous window's usage instead of resetting to zero
+	DowngradeModel *string `json:"downgrade_model,omitempty"`          // Model to send requests to once the budget is exceeded
+}
+
+// applyOptions sets the window and downgrade options of the request on a budget.
+func (r *CreateBudgetRequest) applyOptions(budget *configstoreTables.TableBudget) {
+	budget.SlidingWindow = r.SlidingWindow
+	budget.DowngradeModel = r.DowngradeModel
 }
 
 // UpdateBudgetRequest represents the request body for updating a budget
 type UpdateBudgetRequest struct {
-	MaxLimit      *float64 `json:"max_limit,omitempty"`
-	ResetDuration *string  `json:"reset_duration,omitempty"`
+	MaxLimit       *float64 `json:"max_limit,omitempty"`
+	ResetDuration  *string  `json:"reset_duration,omitempty"`
+	SlidingWindow  *bool    `json:"sliding_window,omitempty"`
+	DowngradeModel *string  `json:"downgrade_model,omitempty"` // An empty string removes the downgrade model
+}
+
+// applyOptions sets the window and downgrade options present in the request on a budget.
+func (r *UpdateBudgetRequest) applyOptions(budget *configstoreTables.TableBudget) {
+	if r.SlidingWindow != nil {
+		budget.SlidingWindow = *r.SlidingWindow
+	}
+	if r.DowngradeModel != nil {
+		if *r.DowngradeModel == "" {
+			budget.DowngradeModel = nil
+		} else {
+			budget.DowngradeModel = r.DowngradeModel
+		}
+	}
 }
 
 // CreateRoutingRuleRequest represents the request body for creating a routing rule
@@ -244,6 +271,7 @@ func (h *GovernanceHandler) RegisterRoutes(r *router.Router, middlewares ...sche
 	// Budget and Rate Limit GET operations
 	r.GET("/api/governance/budgets", lib.ChainMiddlewares(h.getBudgets, middlewares...))
 	r.GET("/api/governance/rate-limits", lib.ChainMiddlewares(h.getRateLimits, middlewares...))
+	r.GET("/api/governance/usage/tags", lib.ChainMiddlewares(h.getTagUsage, middlewares...))
 
 	// Routing Rules CRUD operations
 	r.GET("/api/governance/routing-rules", lib.ChainMiddlewares(h.getRoutingRules, middlewares...))
@@ -304,6 +332,19 @@ func (h *GovernanceHandler) getVirtualKeys(ctx *fasthttp.RequestCtx) {
 	})
 }
 
+// parseVirtualKeyRole validates the management API role of a virtual key, treating an empty role as none
+func parseVirtualKeyRole(value *string) (*string, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	role, err := configstore.ParseRole(*value)
+	if err != nil {
+		return nil, err
+	}
+	roleName := string(role)
+	return &roleName, nil
+}
+
 // createVirtualKey handles POST /api/governance/virtual-keys - Create a new virtual key
 func (h *GovernanceHandler) createVirtualKey(ctx *fasthttp.RequestCtx) {
 	var req CreateVirtualKeyRequest
@@ -321,6 +362,11 @@ func (h *GovernanceHandler) createVirtualKey(ctx *fasthttp.RequestCtx) {
 		SendError(ctx, 400, "VirtualKey cannot be attached to both Team and Customer")
 		return
 	}
+	role, err := parseVirtualKeyRole(req.Role)
+	if err != nil {
+		SendError(ctx, 400, err.Error())
+		return
+	}
 	// Validate budget if provided
 	if req.Budget != nil {
 		if req.Budget.MaxLimit < 0 {
@@ -338,16 +384,19 @@ func (h *GovernanceHandler) createVirtualKey(ctx *fasthttp.RequestCtx) {
 	if req.IsActive != nil {
 		isActive = *req.IsActive
 	}
+	// Only a hash of the value is stored, so this is the only time it can be returned
+	value := governance.GenerateVirtualKey()
 	var vk configstoreTables.TableVirtualKey
 	if err := h.configStore.ExecuteTransaction(ctx, func(tx *gorm.DB) error {
 		vk = configstoreTables.TableVirtualKey{
 			ID:          uuid.NewString(),
 			Name:        req.Name,
-			Value:       governance.GenerateVirtualKey(),
+			Value:       value,
 			Description: req.Description,
 			TeamID:      req.TeamID,
 			CustomerID:  req.CustomerID,
 			IsActive:    isActive,
+			Role:        role,
 		}
 		if req.Budget != nil {
 			budget := configstoreTables.TableBudget{
@@ -357,6 +406,7 @@ func (h *GovernanceHandler) createVirtualKey(ctx *fasthttp.RequestCtx) {
 				LastReset:     time.Now(),
 				CurrentUsage:  0,
 			}
+			req.Budget.applyOptions(&budget)
 			if err := validateBudget(&budget); err != nil {
 				return err
 			}
@@ -429,6 +479,7 @@ func (h *GovernanceHandler) createVirtualKey(ctx *fasthttp.RequestCtx) {
 						LastReset:     time.Now(),
 						CurrentUsage:  0,
 					}
+					pc.Budget.applyOptions(&budget)
 					if err := validateBudget(&budget); err != nil {
 						return err
 					}
@@ -501,10 +552,12 @@ func (h *GovernanceHandler) createVirtualKey(ctx *fasthttp.RequestCtx) {
 		logger.Error("failed to reload virtual key: %v", err)
 		preloadedVk = &vk
 	}
+	created := *preloadedVk
+	created.Value = value
 
 	SendJSON(ctx, map[string]any{
 		"message":     "Virtual key created successfully",
-		"virtual_key": preloadedVk,
+		"virtual_key": &created,
 	})
 }
 
@@ -558,6 +611,11 @@ func (h *GovernanceHandler) updateVirtualKey(ctx *fasthttp.RequestCtx) {
 		SendError(ctx, 400, "VirtualKey cannot be attached to both Team and Customer")
 		return
 	}
+	role, err := parseVirtualKeyRole(req.Role)
+	if err != nil {
+		SendError(ctx, 400, err.Error())
+		return
+	}
 	vk, err := h.configStore.GetVirtualKey(ctx, vkID)
 	if err != nil {
 		if errors.Is(err, configstore.ErrNotFound) {
@@ -591,6 +649,10 @@ func (h *GovernanceHandler) updateVirtualKey(ctx *fasthttp.RequestCtx) {
 		if req.IsActive != nil {
 			vk.IsActive = *req.IsActive
 		}
+		// An empty role removes the key's management access
+		if req.Role != nil {
+			vk.Role = role
+		}
 		// Handle budget updates
 		if req.Budget != nil {
 			if vk.BudgetID != nil {
@@ -606,6 +668,7 @@ func (h *GovernanceHandler) updateVirtualKey(ctx *fasthttp.RequestCtx) {
 				if req.Budget.ResetDuration != nil {
 					budget.ResetDuration = *req.Budget.ResetDuration
 				}
+				req.Budget.applyOptions(&budget)
 				if err := validateBudget(&budget); err != nil {
 					return err
 				}
@@ -632,6 +695,7 @@ func (h *GovernanceHandler) updateVirtualKey(ctx *fasthttp.RequestCtx) {
 					LastReset:     time.Now(),
 					CurrentUsage:  0,
 				}
+				req.Budget.applyOptions(&budget)
 				if err := validateBudget(&budget); err != nil {
 					return err
 				}
@@ -751,6 +815,7 @@ func (h *GovernanceHandler) updateVirtualKey(ctx *fasthttp.RequestCtx) {
 							LastReset:     time.Now(),
 							CurrentUsage:  0,
 						}
+						pc.Budget.applyOptions(&budget)
 						if err := validateBudget(&budget); err != nil {
 							return err
 						}
@@ -820,6 +885,7 @@ func (h *GovernanceHandler) updateVirtualKey(ctx *fasthttp.RequestCtx) {
 							if pc.Budget.ResetDuration != nil {
 								budget.ResetDuration = *pc.Budget.ResetDuration
 							}
+							pc.Budget.applyOptions(&budget)
 							if err := validateBudget(&budget); err != nil {
 								return err
 							}
@@ -844,6 +910,7 @@ func (h *GovernanceHandler) updateVirtualKey(ctx *fasthttp.RequestCtx) {
 								LastReset:     time.Now(),
 								CurrentUsage:  0,
 							}
+							pc.Budget.applyOptions(&budget)
 							if err := validateBudget(&budget); err != nil {
 								return err
 							}
@@ -1258,6 +1325,7 @@ func (h *GovernanceHandler) updateTeam(ctx *fasthttp.RequestCtx) {
 				}
 				budget.MaxLimit = *req.Budget.MaxLimit
 				budget.ResetDuration = *req.Budget.ResetDuration
+				req.Budget.applyOptions(&budget)
 				if err := validateBudget(&budget); err != nil {
 					return err
 				}
@@ -1283,6 +1351,7 @@ func (h *GovernanceHandler) updateTeam(ctx *fasthttp.RequestCtx) {
 					LastReset:     time.Now(),
 					CurrentUsage:  0,
 				}
+				req.Budget.applyOptions(&budget)
 				if err := validateBudget(&budget); err != nil {
 					return err
 				}
@@ -1611,6 +1680,7 @@ func (h *GovernanceHandler) updateCustomer(ctx *fasthttp.RequestCtx) {
 				}
 				budget.MaxLimit = *req.Budget.MaxLimit
 				budget.ResetDuration = *req.Budget.ResetDuration
+				req.Budget.applyOptions(&budget)
 				if err := validateBudget(&budget); err != nil {
 					return err
 				}
@@ -1636,6 +1706,7 @@ func (h *GovernanceHandler) updateCustomer(ctx *fasthttp.RequestCtx) {
 					LastReset:     time.Now(),
 					CurrentUsage:  0,
 				}
+				req.Budget.applyOptions(&budget)
 				if err := validateBudget(&budget); err != nil {
 					return err
 				}
@@ -1790,6 +1861,19 @@ func (h *GovernanceHandler) getBudgets(ctx *fasthttp.RequestCtx) {
 	})
 }
 
+// getTagUsage handles GET /api/governance/usage/tags - Get the in-memory usage attributed to each request tag
+func (h *GovernanceHandler) getTagUsage(ctx *fasthttp.RequestCtx) {
+	usage := h.governanceManager.GetTagUsage()
+	if usage == nil {
+		SendError(ctx, 500, "Tag usage is not available")
+		return
+	}
+	SendJSON(ctx, map[string]interface{}{
+		"tags":  usage,
+		"count": len(usage),
+	})
+}
+
 // getRateLimits handles GET /api/governance/rate-limits - Get all rate limits
 func (h *GovernanceHandler) getRateLimits(ctx *fasthttp.RequestCtx) {
 	// Check if "from_memory" query parameter is set to true
@@ -1963,6 +2047,7 @@ func (h *GovernanceHandler) createModelConfig(ctx *fasthttp.RequestCtx) {
 				LastReset:     time.Now(),
 				CurrentUsage:  0,
 			}
+			req.Budget.applyOptions(&budget)
 			if err := validateBudget(&budget); err != nil {
 				return err
 			}
@@ -2066,6 +2151,7 @@ func (h *GovernanceHandler) updateModelConfig(ctx *fasthttp.RequestCtx) {
 				// Set all fields from request
 				budget.MaxLimit = *req.Budget.MaxLimit
 				budget.ResetDuration = *req.Budget.ResetDuration
+				req.Budget.applyOptions(&budget)
 				if err := validateBudget(&budget); err != nil {
 					return err
 				}
@@ -2091,6 +2177,7 @@ func (h *GovernanceHandler) updateModelConfig(ctx *fasthttp.RequestCtx) {
 					LastReset:     time.Now(),
 					CurrentUsage:  0,
 				}
+				req.Budget.applyOptions(&budget)
 				if err := validateBudget(&budget); err != nil {
 					return err
 				}
@@ -2329,6 +2416,7 @@ func (h *GovernanceHandler) updateProviderGovernance(ctx *fasthttp.RequestCtx) {
 				// Set all fields from request
 				budget.MaxLimit = *req.Budget.MaxLimit
 				budget.ResetDuration = *req.Budget.ResetDuration
+				req.Budget.applyOptions(&budget)
 				if err := validateBudget(&budget); err != nil {
 					return err
 				}
@@ -2348,6 +2436,7 @@ func (h *GovernanceHandler) updateProviderGovernance(ctx *fasthttp.RequestCtx) {
 					LastReset:     time.Now(),
 					CurrentUsage:  0,
 				}
+				req.Budget.applyOptions(&budget)
 				if err := validateBudget(&budget); err != nil {
 					return err
 				}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcIdentityKeys are the governance values a JWT caller is identified by, carried over to gRPC calls
+var grpcIdentityKeys = []schemas.BifrostContextKey{
+	schemas.BifrostContextKeyGovernanceUserID,
+	schemas.BifrostContextKeyGovernanceTeamID,
+	schemas.BifrostContextKeyGovernanceCustomerID,
+	schemas.BifrostContextKeyGovernanceRoles,
+}
+
+// AuthenticateGRPC authenticates a gRPC call the way InferenceMiddleware authenticates HTTP inference
+// requests (basic auth, bearer sessions and JWTs), from the authorization metadata of the call.
+// It returns the context carrying the governance identity of a JWT caller, or an Unauthenticated error.
+func (m *AuthMiddleware) AuthenticateGRPC(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	reqCtx := &fasthttp.RequestCtx{}
+	reqCtx.Request.Header.SetMethod(fasthttp.MethodPost)
+	reqCtx.Request.SetRequestURI("/grpc")
+	if values := md.Get("authorization"); len(values) > 0 {
+		reqCtx.Request.Header.Set("Authorization", values[0])
+	}
+
+	authenticated := false
+	m.InferenceMiddleware()(func(*fasthttp.RequestCtx) {
+		authenticated = true
+	})(reqCtx)
+	if !authenticated {
+		return nil, status.Error(codes.Unauthenticated, "unauthenticated")
+	}
+	for _, key := range grpcIdentityKeys {
+		if value := reqCtx.UserValue(key); value != nil {
+			ctx = context.WithValue(ctx, key, value)
+		}
+	}
+	return ctx, nil
+}
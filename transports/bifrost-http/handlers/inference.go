@@ -20,6 +20,7 @@ import (
 	bifrost "github.com/capsohq/bifrost/core"
 	"github.com/fasthttp/router"
 
+	"github.com/capsohq/bifrost/core/providers/anthropic"
 	"github.com/capsohq/bifrost/core/schemas"
 	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
 	"github.com/valyala/fasthttp"
@@ -61,6 +62,7 @@ var textParamsKnownFields = map[string]bool{
 	"prompt":            true,
 	"model":             true,
 	"fallbacks":         true,
+	"tags":              true,
 	"best_of":           true,
 	"echo":              true,
 	"frequency_penalty": true,
@@ -82,7 +84,9 @@ var chatParamsKnownFields = map[string]bool{
 	"model":                 true,
 	"messages":              true,
 	"fallbacks":             true,
+	"tags":                  true,
 	"stream":                true,
+	"context_id":            true,
 	"frequency_penalty":     true,
 	"logit_bias":            true,
 	"logprobs":              true,
@@ -110,6 +114,7 @@ var responsesParamsKnownFields = map[string]bool{
 	"model":                true,
 	"input":                true,
 	"fallbacks":            true,
+	"tags":                 true,
 	"stream":               true,
 	"background":           true,
 	"conversation":         true,
@@ -139,6 +144,7 @@ var embeddingParamsKnownFields = map[string]bool{
 	"model":            true,
 	"input":            true,
 	"fallbacks":        true,
+	"tags":             true,
 	"encoding_format":  true,
 	"dimensions":       true,
 	"instructions":     true,
@@ -150,16 +156,26 @@ var rerankParamsKnownFields = map[string]bool{
 	"query":              true,
 	"documents":          true,
 	"fallbacks":          true,
+	"tags":               true,
 	"top_n":              true,
 	"max_tokens_per_doc": true,
 	"priority":           true,
 	"return_documents":   true,
 }
 
+// moderationParamsKnownFields contains known fields for moderation requests
+var moderationParamsKnownFields = map[string]bool{
+	"model":     true,
+	"input":     true,
+	"fallbacks": true,
+	"tags":      true,
+}
+
 var speechParamsKnownFields = map[string]bool{
 	"model":           true,
 	"input":           true,
 	"fallbacks":       true,
+	"tags":            true,
 	"stream_format":   true,
 	"voice":           true,
 	"instructions":    true,
@@ -173,6 +189,7 @@ var imageGenerationParamsKnownFields = map[string]bool{
 	"model":               true,
 	"prompt":              true,
 	"fallbacks":           true,
+	"tags":                true,
 	"stream":              true,
 	"n":                   true,
 	"background":          true,
@@ -196,6 +213,7 @@ var imageEditParamsKnownFields = map[string]bool{
 	"model":               true,
 	"prompt":              true,
 	"fallbacks":           true,
+	"tags":                true,
 	"image":               true,
 	"image[]":             true,
 	"mask":                true,
@@ -221,6 +239,7 @@ var imageEditParamsKnownFields = map[string]bool{
 var imageVariationParamsKnownFields = map[string]bool{
 	"model":           true,
 	"fallbacks":       true,
+	"tags":            true,
 	"image":           true,
 	"image[]":         true,
 	"n":               true,
@@ -242,17 +261,48 @@ var videoGenerationParamsKnownFields = map[string]bool{
 	"video_uri":       true,
 	"audio":           true,
 	"fallbacks":       true,
+	"tags":            true,
+}
+
+// musicGenerationParamsKnownFields contains known fields for music generation requests
+// Based on MusicGenerationInput and MusicGenerationParameters structs
+var musicGenerationParamsKnownFields = map[string]bool{
+	"model":           true,
+	"prompt":          true,
+	"lyrics":          true,
+	"reference_audio": true,
+	"duration":        true,
+	"format":          true,
+	"sample_rate":     true,
+	"bitrate":         true,
+	"response_format": true,
+	"fallbacks":       true,
+	"tags":            true,
+}
+
+// contextCacheCreateParamsKnownFields contains known fields for context cache create requests
+// Based on ContextCacheParameters struct
+var contextCacheCreateParamsKnownFields = map[string]bool{
+	"model":               true,
+	"messages":            true,
+	"mode":                true,
+	"ttl":                 true,
+	"truncation_strategy": true,
+	"fallbacks":           true,
+	"tags":                true,
 }
 
 var videoRemixParamsKnownFields = map[string]bool{
 	"prompt":    true,
 	"fallbacks": true,
+	"tags":      true,
 }
 
 var transcriptionParamsKnownFields = map[string]bool{
 	"model":           true,
 	"file":            true,
 	"fallbacks":       true,
+	"tags":            true,
 	"stream":          true,
 	"language":        true,
 	"prompt":          true,
@@ -260,10 +310,21 @@ var transcriptionParamsKnownFields = map[string]bool{
 	"file_format":     true,
 }
 
+var translationParamsKnownFields = map[string]bool{
+	"model":           true,
+	"file":            true,
+	"fallbacks":       true,
+	"tags":            true,
+	"prompt":          true,
+	"response_format": true,
+	"temperature":     true,
+}
+
 var countTokensParamsKnownFields = map[string]bool{
 	"model":        true,
 	"messages":     true,
 	"fallbacks":    true,
+	"tags":         true,
 	"tools":        true,
 	"instructions": true,
 	"text":         true,
@@ -288,10 +349,11 @@ var containerCreateParamsKnownFields = map[string]bool{
 }
 
 type BifrostParams struct {
-	Model        string   `json:"model"`                   // Model to use in "provider/model" format
-	Fallbacks    []string `json:"fallbacks"`               // Fallback providers and models in "provider/model" format
-	Stream       *bool    `json:"stream"`                  // Whether to stream the response
-	StreamFormat *string  `json:"stream_format,omitempty"` // For speech
+	Model        string            `json:"model"`                   // Model to use in "provider/model" format
+	Fallbacks    []string          `json:"fallbacks"`               // Fallback providers and models in "provider/model" format
+	Tags         map[string]string `json:"tags,omitempty"`          // Tags for cost attribution, see schemas.ValidateRequestTags
+	Stream       *bool             `json:"stream"`                  // Whether to stream the response
+	StreamFormat *string           `json:"stream_format,omitempty"` // For speech
 }
 
 type TextRequest struct {
@@ -439,6 +501,12 @@ type RerankRequest struct {
 	*schemas.RerankParameters
 }
 
+// ModerationRequest is a bifrost moderation request
+type ModerationRequest struct {
+	Input *schemas.ModerationInput `json:"input"`
+	BifrostParams
+}
+
 type SpeechRequest struct {
 	*schemas.SpeechInput
 	BifrostParams
@@ -451,6 +519,18 @@ type TranscriptionRequest struct {
 	*schemas.TranscriptionParameters
 }
 
+type ContextCacheCreateRequest struct {
+	Messages []schemas.ChatMessage `json:"messages"`
+	BifrostParams
+	*schemas.ContextCacheParameters
+}
+
+type MusicGenerationRequest struct {
+	*schemas.MusicGenerationInput
+	BifrostParams
+	*schemas.MusicGenerationParameters
+}
+
 type VideoGenerationRequest struct {
 	*schemas.VideoGenerationInput
 	BifrostParams
@@ -501,6 +581,37 @@ func enableRawRequestResponseForContainer(bifrostCtx *schemas.BifrostContext) {
 	bifrostCtx.SetValue(schemas.BifrostContextKeyRawRequestResponseForLogging, true)
 }
 
+// pinnedProvider returns the provider of the x-bf-provider header, which is also the provider
+// of models sent without a "provider/" prefix.
+func pinnedProvider(ctx *fasthttp.RequestCtx) schemas.ModelProvider {
+	return schemas.ModelProvider(strings.TrimSpace(string(ctx.Request.Header.Peek("x-bf-provider"))))
+}
+
+// setRequestTags validates the tags of a request body and keeps them on the request context,
+// from which ConvertToBifrostContext copies them into the Bifrost context.
+func setRequestTags(ctx *fasthttp.RequestCtx, tags map[string]string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+	if err := schemas.ValidateRequestTags(tags); err != nil {
+		return fmt.Errorf("invalid tags: %v", err)
+	}
+	ctx.SetUserValue(schemas.BifrostContextKeyRequestTags, tags)
+	return nil
+}
+
+// setFormRequestTags is setRequestTags for multipart forms, whose tags field uses the "key=value,key=value" format of x-bf-tags.
+func setFormRequestTags(ctx *fasthttp.RequestCtx, values []string) error {
+	if len(values) == 0 || values[0] == "" {
+		return nil
+	}
+	tags, err := schemas.ParseRequestTags(values[0])
+	if err != nil {
+		return fmt.Errorf("invalid tags: %v", err)
+	}
+	return setRequestTags(ctx, tags)
+}
+
 // parseFallbacks extracts fallbacks from string array and converts to Fallback structs
 func parseFallbacks(fallbackStrings []string) ([]schemas.Fallback, error) {
 	fallbacks := make([]schemas.Fallback, 0, len(fallbackStrings))
@@ -562,12 +673,18 @@ var PathToTypeMapping = map[string]schemas.RequestType{
 	"/v1/responses":              schemas.ResponsesRequest,
 	"/v1/embeddings":             schemas.EmbeddingRequest,
 	"/v1/rerank":                 schemas.RerankRequest,
+	"/v1/moderations":            schemas.ModerationRequest,
+	"/v1/realtime":               schemas.RealtimeRequest,
 	"/v1/audio/speech":           schemas.SpeechRequest,
 	"/v1/audio/transcriptions":   schemas.TranscriptionRequest,
+	"/v1/audio/translations":     schemas.TranslationRequest,
 	"/v1/images/generations":     schemas.ImageGenerationRequest,
 	"/v1/responses/input_tokens": schemas.CountTokensRequest,
+	"/v1/messages/count_tokens":  schemas.CountTokensRequest,
 	"/v1/images/edits":           schemas.ImageEditRequest,
 	"/v1/images/variations":      schemas.ImageVariationRequest,
+	"/v1/music/generations":      schemas.MusicGenerationRequest,
+	"/v1/context/create":         schemas.ContextCacheCreateRequest,
 	"/v1/models":                 schemas.ListModelsRequest,
 }
 
@@ -606,12 +723,18 @@ func (h *CompletionHandler) RegisterRoutes(r *router.Router, middlewares ...sche
 	r.POST("/v1/responses", lib.ChainMiddlewares(h.responses, baseMiddlewares...))
 	r.POST("/v1/embeddings", lib.ChainMiddlewares(h.embeddings, baseMiddlewares...))
 	r.POST("/v1/rerank", lib.ChainMiddlewares(h.rerank, baseMiddlewares...))
+	r.POST("/v1/moderations", lib.ChainMiddlewares(h.moderation, baseMiddlewares...))
+	r.GET("/v1/realtime", lib.ChainMiddlewares(h.realtime, baseMiddlewares...))
 	r.POST("/v1/audio/speech", lib.ChainMiddlewares(h.speech, baseMiddlewares...))
 	r.POST("/v1/audio/transcriptions", lib.ChainMiddlewares(h.transcription, baseMiddlewares...))
+	r.POST("/v1/audio/translations", lib.ChainMiddlewares(h.translation, baseMiddlewares...))
 	r.POST("/v1/images/generations", lib.ChainMiddlewares(h.imageGeneration, baseMiddlewares...))
 	r.POST("/v1/responses/input_tokens", lib.ChainMiddlewares(h.countTokens, baseMiddlewares...))
+	r.POST("/v1/messages/count_tokens", lib.ChainMiddlewares(h.messagesCountTokens, baseMiddlewares...))
 	r.POST("/v1/images/edits", lib.ChainMiddlewares(h.imageEdit, baseMiddlewares...))
 	r.POST("/v1/images/variations", lib.ChainMiddlewares(h.imageVariation, baseMiddlewares...))
+	r.POST("/v1/music/generations", lib.ChainMiddlewares(h.musicGeneration, baseMiddlewares...))
+	r.POST("/v1/context/create", lib.ChainMiddlewares(h.contextCacheCreate, baseMiddlewares...))
 	r.POST("/v1/videos", lib.ChainMiddlewares(h.videoGeneration, baseMiddlewares...))
 
 	// Video API endpoints (parameterized routes need explicit request type middleware)
@@ -675,6 +798,37 @@ func (h *CompletionHandler) RegisterRoutes(r *router.Router, middlewares ...sche
 	r.GET("/v1/containers/{container_id}/files/{file_id}", lib.ChainMiddlewares(h.containerFileRetrieve, containerFileRetrieveMW...))
 	r.GET("/v1/containers/{container_id}/files/{file_id}/content", lib.ChainMiddlewares(h.containerFileContent, containerFileContentMW...))
 	r.DELETE("/v1/containers/{container_id}/files/{file_id}", lib.ChainMiddlewares(h.containerFileDelete, containerFileDeleteMW...))
+
+	// Fine-tuning API endpoints (parameterized routes need explicit request type middleware)
+	fineTuningJobCreateMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.FineTuningJobCreateRequest)}, middlewares...)
+	fineTuningJobListMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.FineTuningJobListRequest)}, middlewares...)
+	fineTuningJobRetrieveMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.FineTuningJobRetrieveRequest)}, middlewares...)
+	fineTuningJobCancelMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.FineTuningJobCancelRequest)}, middlewares...)
+	fineTuningJobEventsMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.FineTuningJobEventsRequest)}, middlewares...)
+	fineTuningJobCheckpointsMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.FineTuningJobCheckpointsRequest)}, middlewares...)
+
+	r.POST("/v1/fine_tuning/jobs", lib.ChainMiddlewares(h.fineTuningJobCreate, fineTuningJobCreateMW...))
+	r.GET("/v1/fine_tuning/jobs", lib.ChainMiddlewares(h.fineTuningJobList, fineTuningJobListMW...))
+	r.GET("/v1/fine_tuning/jobs/{fine_tuning_job_id}", lib.ChainMiddlewares(h.fineTuningJobRetrieve, fineTuningJobRetrieveMW...))
+	r.POST("/v1/fine_tuning/jobs/{fine_tuning_job_id}/cancel", lib.ChainMiddlewares(h.fineTuningJobCancel, fineTuningJobCancelMW...))
+	r.GET("/v1/fine_tuning/jobs/{fine_tuning_job_id}/events", lib.ChainMiddlewares(h.fineTuningJobEvents, fineTuningJobEventsMW...))
+	r.GET("/v1/fine_tuning/jobs/{fine_tuning_job_id}/checkpoints", lib.ChainMiddlewares(h.fineTuningJobCheckpoints, fineTuningJobCheckpointsMW...))
+
+	// Vector Stores API endpoints (parameterized routes need explicit request type middleware)
+	vectorStoreCreateMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.VectorStoreCreateRequest)}, middlewares...)
+	vectorStoreListMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.VectorStoreListRequest)}, middlewares...)
+	vectorStoreSearchMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.VectorStoreSearchRequest)}, middlewares...)
+	vectorStoreFileCreateMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.VectorStoreFileCreateRequest)}, middlewares...)
+	vectorStoreFileListMW := append([]schemas.BifrostHTTPMiddleware{createRequestTypeMiddleware(schemas.VectorStoreFileListRequest)}, middlewares...)
+
+	r.POST("/v1/vector_stores", lib.ChainMiddlewares(h.vectorStoreCreate, vectorStoreCreateMW...))
+	r.GET("/v1/vector_stores", lib.ChainMiddlewares(h.vectorStoreList, vectorStoreListMW...))
+	r.POST("/v1/vector_stores/{vector_store_id}/search", lib.ChainMiddlewares(h.vectorStoreSearch, vectorStoreSearchMW...))
+	r.POST("/v1/vector_stores/{vector_store_id}/files", lib.ChainMiddlewares(h.vectorStoreFileCreate, vectorStoreFileCreateMW...))
+	r.GET("/v1/vector_stores/{vector_store_id}/files", lib.ChainMiddlewares(h.vectorStoreFileList, vectorStoreFileListMW...))
+
+	// Stream cancellation endpoint, for aborting in-flight streams from outside their connection
+	r.DELETE("/v1/streams/{request_id}", lib.ChainMiddlewares(h.streamCancel, middlewares...))
 }
 
 // listModels handles GET /v1/models - Process list models requests
@@ -761,7 +915,7 @@ func (h *CompletionHandler) listModels(ctx *fasthttp.RequestCtx) {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
 	// Send successful response
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // prepareTextCompletionRequest prepares a BifrostTextCompletionRequest from the HTTP request body
@@ -770,7 +924,7 @@ func prepareTextCompletionRequest(ctx *fasthttp.RequestCtx) (*TextRequest, *sche
 	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
 		return nil, nil, fmt.Errorf("invalid request format: %v", err)
 	}
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		return nil, nil, fmt.Errorf("model should be in provider/model format")
 	}
@@ -778,6 +932,9 @@ func prepareTextCompletionRequest(ctx *fasthttp.RequestCtx) (*TextRequest, *sche
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		return nil, nil, err
+	}
 	if req.Prompt == nil || (req.Prompt.PromptStr == nil && req.Prompt.PromptArray == nil) {
 		return nil, nil, fmt.Errorf("prompt is required for text completion")
 	}
@@ -833,7 +990,7 @@ func (h *CompletionHandler) textCompletion(ctx *fasthttp.RequestCtx) {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
 	// Send successful response
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // prepareChatCompletionRequest prepares a BifrostChatRequest from a ChatRequest
@@ -846,7 +1003,7 @@ func prepareChatCompletionRequest(ctx *fasthttp.RequestCtx) (*ChatRequest, *sche
 	}
 
 	// Create BifrostChatRequest directly using segregated structure
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		return nil, nil, fmt.Errorf("model should be in provider/model format")
 	}
@@ -856,6 +1013,9 @@ func prepareChatCompletionRequest(ctx *fasthttp.RequestCtx) (*ChatRequest, *sche
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to parse fallbacks: %v", err)
 	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		return nil, nil, err
+	}
 
 	if len(req.Messages) == 0 {
 		return nil, nil, fmt.Errorf("messages is required for chat completion")
@@ -935,7 +1095,7 @@ func (h *CompletionHandler) chatCompletion(ctx *fasthttp.RequestCtx) {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
 	// Send successful response
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // prepareResponsesRequest prepares a BifrostResponsesRequest from a ResponsesRequest
@@ -946,7 +1106,7 @@ func prepareResponsesRequest(ctx *fasthttp.RequestCtx) (*ResponsesRequest, *sche
 	}
 
 	// Create BifrostResponsesRequest directly using segregated structure
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		return nil, nil, fmt.Errorf("model should be in provider/model format")
 	}
@@ -956,6 +1116,9 @@ func prepareResponsesRequest(ctx *fasthttp.RequestCtx) (*ResponsesRequest, *sche
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to parse fallbacks: %v", err)
 	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		return nil, nil, err
+	}
 
 	if len(req.Input.ResponsesRequestInputArray) == 0 && req.Input.ResponsesRequestInputStr == nil {
 		return nil, nil, fmt.Errorf("input is required for responses")
@@ -1028,7 +1191,7 @@ func (h *CompletionHandler) responses(ctx *fasthttp.RequestCtx) {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
 	// Send successful response
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // prepareEmbeddingRequest prepares a BifrostEmbeddingRequest from the HTTP request body
@@ -1037,7 +1200,7 @@ func prepareEmbeddingRequest(ctx *fasthttp.RequestCtx) (*EmbeddingRequest, *sche
 	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
 		return nil, nil, fmt.Errorf("invalid request format: %v", err)
 	}
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		return nil, nil, fmt.Errorf("model should be in provider/model format")
 	}
@@ -1045,6 +1208,9 @@ func prepareEmbeddingRequest(ctx *fasthttp.RequestCtx) (*EmbeddingRequest, *sche
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		return nil, nil, err
+	}
 	if req.Input == nil || (req.Input.Text == nil && req.Input.Texts == nil && req.Input.Embedding == nil && req.Input.Embeddings == nil && req.Input.MultiModalInputs == nil) {
 		return nil, nil, fmt.Errorf("input is required for embeddings")
 	}
@@ -1093,7 +1259,7 @@ func (h *CompletionHandler) embeddings(ctx *fasthttp.RequestCtx) {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
 	// Send successful response
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // prepareRerankRequest prepares a BifrostRerankRequest from the HTTP request body
@@ -1104,7 +1270,7 @@ func prepareRerankRequest(ctx *fasthttp.RequestCtx) (*RerankRequest, *schemas.Bi
 	}
 
 	// Parse model
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		return nil, nil, fmt.Errorf("model should be in provider/model format")
 	}
@@ -1114,6 +1280,9 @@ func prepareRerankRequest(ctx *fasthttp.RequestCtx) (*RerankRequest, *schemas.Bi
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to parse fallbacks: %v", err)
 	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		return nil, nil, err
+	}
 
 	if strings.TrimSpace(req.Query) == "" {
 		return nil, nil, fmt.Errorf("query is required for rerank")
@@ -1184,7 +1353,72 @@ func (h *CompletionHandler) rerank(ctx *fasthttp.RequestCtx) {
 	}
 
 	// Send successful response
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// moderation handles POST /v1/moderations - Processes content moderation requests
+func (h *CompletionHandler) moderation(ctx *fasthttp.RequestCtx) {
+	var req ModerationRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
+	if provider == "" || modelName == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
+		return
+	}
+
+	fallbacks, err := parseFallbacks(req.Fallbacks)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return
+	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.Input.IsEmpty() {
+		SendError(ctx, fasthttp.StatusBadRequest, "input is required for moderation")
+		return
+	}
+
+	params := &schemas.ModerationParameters{}
+	extraParams, err := extractExtraParams(ctx.PostBody(), moderationParamsKnownFields)
+	if err != nil {
+		logger.Warn("Failed to extract extra params: %v", err)
+	} else {
+		params.ExtraParams = extraParams
+	}
+
+	bifrostReq := &schemas.BifrostModerationRequest{
+		Provider:  schemas.ModelProvider(provider),
+		Model:     modelName,
+		Input:     req.Input,
+		Params:    params,
+		Fallbacks: fallbacks,
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.ModerationRequest(bifrostCtx, bifrostReq)
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // prepareSpeechRequest prepares a BifrostSpeechRequest from the HTTP request body
@@ -1193,7 +1427,7 @@ func prepareSpeechRequest(ctx *fasthttp.RequestCtx) (*SpeechRequest, *schemas.Bi
 	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
 		return nil, nil, fmt.Errorf("invalid request format: %v", err)
 	}
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		return nil, nil, fmt.Errorf("model should be in provider/model format")
 	}
@@ -1201,6 +1435,9 @@ func prepareSpeechRequest(ctx *fasthttp.RequestCtx) (*SpeechRequest, *schemas.Bi
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		return nil, nil, err
+	}
 	if req.SpeechInput == nil || req.SpeechInput.Input == "" {
 		return nil, nil, fmt.Errorf("input is required for speech completion")
 	}
@@ -1264,7 +1501,7 @@ func (h *CompletionHandler) speech(ctx *fasthttp.RequestCtx) {
 
 	if bifrostSpeechReq.Provider == schemas.Elevenlabs && hasTimestamps {
 		ctx.Response.Header.Set("Content-Type", "application/json")
-		SendJSON(ctx, resp)
+		h.sendResponse(ctx, bifrostCtx, resp)
 		return
 	}
 
@@ -1290,7 +1527,7 @@ func prepareTranscriptionRequest(ctx *fasthttp.RequestCtx) (*schemas.BifrostTran
 	if len(modelValues) == 0 || modelValues[0] == "" {
 		return nil, false, fmt.Errorf("model is required")
 	}
-	provider, modelName := schemas.ParseModelString(modelValues[0], "")
+	provider, modelName := schemas.ParseModelString(modelValues[0], pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		return nil, false, fmt.Errorf("model should be in provider/model format")
 	}
@@ -1330,6 +1567,9 @@ func prepareTranscriptionRequest(ctx *fasthttp.RequestCtx) (*schemas.BifrostTran
 			transcriptionParams.ExtraParams[key] = value[0]
 		}
 	}
+	if err := setFormRequestTags(ctx, form.Value["tags"]); err != nil {
+		return nil, false, err
+	}
 	stream := false
 	if streamValues := form.Value["stream"]; len(streamValues) > 0 && streamValues[0] == "true" {
 		stream = true
@@ -1377,7 +1617,103 @@ func (h *CompletionHandler) transcription(ctx *fasthttp.RequestCtx) {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
 	// Send successful response
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// prepareTranslationRequest prepares a BifrostTranslationRequest from a multipart form.
+func prepareTranslationRequest(ctx *fasthttp.RequestCtx) (*schemas.BifrostTranslationRequest, error) {
+	form, err := ctx.MultipartForm()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse multipart form: %v", err)
+	}
+	modelValues := form.Value["model"]
+	if len(modelValues) == 0 || modelValues[0] == "" {
+		return nil, fmt.Errorf("model is required")
+	}
+	provider, modelName := schemas.ParseModelString(modelValues[0], pinnedProvider(ctx))
+	if provider == "" || modelName == "" {
+		return nil, fmt.Errorf("model should be in provider/model format")
+	}
+	fileHeaders := form.File["file"]
+	if len(fileHeaders) == 0 {
+		return nil, fmt.Errorf("file is required")
+	}
+	fileHeader := fileHeaders[0]
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open uploaded file: %v", err)
+	}
+	defer file.Close()
+	fileData, err := io.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read uploaded file: %v", err)
+	}
+	translationParams := &schemas.TranslationParameters{
+		ExtraParams: make(map[string]interface{}),
+	}
+	if promptValues := form.Value["prompt"]; len(promptValues) > 0 && promptValues[0] != "" {
+		translationParams.Prompt = &promptValues[0]
+	}
+	if responseFormatValues := form.Value["response_format"]; len(responseFormatValues) > 0 && responseFormatValues[0] != "" {
+		translationParams.ResponseFormat = &responseFormatValues[0]
+	}
+	if temperatureValues := form.Value["temperature"]; len(temperatureValues) > 0 && temperatureValues[0] != "" {
+		temperature, err := strconv.ParseFloat(temperatureValues[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid temperature: %v", err)
+		}
+		translationParams.Temperature = &temperature
+	}
+	for key, value := range form.Value {
+		if len(value) > 0 && value[0] != "" && !translationParamsKnownFields[key] {
+			translationParams.ExtraParams[key] = value[0]
+		}
+	}
+	fallbacks, err := parseFallbacks(form.Value["fallbacks"])
+	if err != nil {
+		return nil, err
+	}
+	if err := setFormRequestTags(ctx, form.Value["tags"]); err != nil {
+		return nil, err
+	}
+	return &schemas.BifrostTranslationRequest{
+		Model:    modelName,
+		Provider: schemas.ModelProvider(provider),
+		Input: &schemas.TranscriptionInput{
+			File:     fileData,
+			Filename: fileHeader.Filename,
+		},
+		Params:    translationParams,
+		Fallbacks: fallbacks,
+	}, nil
+}
+
+// translation handles POST /v1/audio/translations - Translates audio into English text
+func (h *CompletionHandler) translation(ctx *fasthttp.RequestCtx) {
+	bifrostTranslationReq, err := prepareTranslationRequest(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.TranslationRequest(bifrostCtx, bifrostTranslationReq)
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // countTokens handles POST /v1/responses/input_tokens - Process count tokens requests
@@ -1404,7 +1740,43 @@ func (h *CompletionHandler) countTokens(ctx *fasthttp.RequestCtx) {
 
 	forwardProviderHeaders(ctx, response.ExtraFields.ProviderResponseHeaders)
 	// Send successful response
-	SendJSON(ctx, response)
+	h.sendResponse(ctx, bifrostCtx, response)
+}
+
+// messagesCountTokens handles POST /v1/messages/count_tokens - Counts the input tokens of an Anthropic
+// Messages request. Providers without a count tokens API return a local estimate marked as approximate.
+func (h *CompletionHandler) messagesCountTokens(ctx *fasthttp.RequestCtx) {
+	var req anthropic.AnthropicMessageRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+	if len(req.Messages) == 0 {
+		SendError(ctx, fasthttp.StatusBadRequest, "messages cannot be empty")
+		return
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+	defer cancel()
+
+	bifrostCountTokensReq := req.ToBifrostResponsesRequest(bifrostCtx)
+	if bifrostCountTokensReq.Provider == "" || bifrostCountTokensReq.Model == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
+		return
+	}
+
+	response, bifrostErr := h.client.CountTokensRequest(bifrostCtx, bifrostCountTokensReq)
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+	forwardProviderHeaders(ctx, response.ExtraFields.ProviderResponseHeaders)
+	h.sendResponse(ctx, bifrostCtx, response)
 }
 
 // handleStreamingTextCompletion handles streaming text completion requests using Server-Sent Events (SSE)
@@ -1467,20 +1839,89 @@ func (h *CompletionHandler) handleStreamingTranscriptionRequest(ctx *fasthttp.Re
 	h.handleStreamingResponse(ctx, bifrostCtx, getStream, cancel)
 }
 
+// sendResponse sends a response of a Bifrost-native route, placing Bifrost's metadata according to the
+// response envelope of the route (or the request's x-bf-response-envelope header).
+func (h *CompletionHandler) sendResponse(ctx *fasthttp.RequestCtx, bifrostCtx *schemas.BifrostContext, resp interface{}) {
+	envelope := h.config.GetResponseEnvelope(string(ctx.Path()), string(ctx.Request.Header.Peek(lib.ResponseEnvelopeHeader)))
+	if envelope == lib.ResponseEnvelopeInline {
+		SendJSON(ctx, resp)
+		return
+	}
+	body, err := sonic.Marshal(resp)
+	if err != nil {
+		logger.Warn("Failed to encode JSON response: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, fmt.Sprintf("Failed to encode response: %v", err))
+		return
+	}
+	var cost *float64
+	if envelope == lib.ResponseEnvelopeBifrost {
+		cost = h.responseCost(resp)
+	}
+	body, err = envelope.Apply(body, bifrostCtx, cost)
+	if err != nil {
+		logger.Warn("Failed to apply response envelope: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, fmt.Sprintf("Failed to encode response: %v", err))
+		return
+	}
+	ctx.SetContentType("application/json")
+	ctx.SetBody(body)
+}
+
+// responseCost prices a response with the model catalog. It returns nil for response types that aren't
+// priced per request or when no model catalog is configured.
+func (h *CompletionHandler) responseCost(resp interface{}) *float64 {
+	if h.config.ModelCatalog == nil {
+		return nil
+	}
+	result := &schemas.BifrostResponse{}
+	switch r := resp.(type) {
+	case *schemas.BifrostTextCompletionResponse:
+		result.TextCompletionResponse = r
+	case *schemas.BifrostChatResponse:
+		result.ChatResponse = r
+	case *schemas.BifrostResponsesResponse:
+		result.ResponsesResponse = r
+	case *schemas.BifrostEmbeddingResponse:
+		result.EmbeddingResponse = r
+	case *schemas.BifrostRerankResponse:
+		result.RerankResponse = r
+	case *schemas.BifrostSpeechResponse:
+		result.SpeechResponse = r
+	case *schemas.BifrostTranscriptionResponse:
+		result.TranscriptionResponse = r
+	case *schemas.BifrostTranslationResponse:
+		result.TranslationResponse = r
+	case *schemas.BifrostImageGenerationResponse:
+		result.ImageGenerationResponse = r
+	default:
+		return nil
+	}
+	if costUSD := result.GetExtraFields().CostUSD; costUSD != nil {
+		return &costUSD.Total
+	}
+	cost := h.config.ModelCatalog.CalculateCostWithCacheDebug(result)
+	return &cost
+}
+
 // handleStreamingResponse is a generic function to handle streaming responses using Server-Sent Events (SSE)
 // The cancel function is called ONLY when client disconnects are detected via write errors.
 // Bifrost handles cleanup internally for normal completion and errors, so we only cancel
 // upstream streams when write errors indicate the client has disconnected.
+// Keep-alive comments are written while the provider is silent, so disconnects are noticed then too.
 func (h *CompletionHandler) handleStreamingResponse(ctx *fasthttp.RequestCtx, bifrostCtx *schemas.BifrostContext, getStream func() (chan *schemas.BifrostStreamChunk, *schemas.BifrostError), cancel context.CancelFunc) {
 	// Set SSE headers
 	ctx.SetContentType("text/event-stream")
 	ctx.Response.Header.Set("Cache-Control", "no-cache")
 	ctx.Response.Header.Set("Connection", "keep-alive")
 
+	// Make the stream cancellable by request ID before it starts, so it can be aborted while connecting too
+	untrack := lib.TrackStream(ctx, h.config.GetStreamRegistry(), bifrostCtx, cancel)
+
 	// Get the streaming channel
 	stream, bifrostErr := getStream()
 	if bifrostErr != nil {
 		// Cancel stream context since we're not proceeding
+		untrack()
 		cancel()
 		forwardProviderHeadersFromContext(ctx, bifrostCtx)
 		SendBifrostError(ctx, bifrostErr)
@@ -1505,10 +1946,20 @@ func (h *CompletionHandler) handleStreamingResponse(ctx *fasthttp.RequestCtx, bi
 	if interceptor != nil {
 		httpReq = lib.BuildHTTPRequestFromFastHTTP(ctx)
 	}
+	// SSE format configured for this route (OpenAI or Anthropic style); default keeps native framing
+	dialect := h.config.GetSSEDialect(string(ctx.Path()))
+	var anthropicStream *lib.AnthropicStreamConverter
+	if dialect == lib.SSEDialectAnthropic {
+		anthropicStream = lib.NewAnthropicStreamConverter(bifrostCtx)
+	}
+	// Placement of Bifrost metadata in each chunk, as for non-streaming responses
+	envelope := h.config.GetResponseEnvelope(string(ctx.Path()), string(ctx.Request.Header.Peek(lib.ResponseEnvelopeHeader)))
+	keepAliveInterval := h.config.GetSSEKeepAliveInterval()
 	var includeEventType bool
 	// Use streaming response writer
 	ctx.Response.SetBodyStreamWriter(func(w *bufio.Writer) {
 		defer func() {
+			untrack()
 			schemas.ReleaseHTTPRequest(httpReq)
 			w.Flush()
 			// Complete the trace after streaming finishes
@@ -1520,8 +1971,19 @@ func (h *CompletionHandler) handleStreamingResponse(ctx *fasthttp.RequestCtx, bi
 
 		var skipDoneMarker bool
 
+		keepAlive := lib.NewSSEKeepAlive(keepAliveInterval)
+		defer keepAlive.Stop()
+
 		// Process streaming responses
-		for chunk := range stream {
+		for {
+			chunk, ok, err := keepAlive.Next(w, stream)
+			if err != nil {
+				cancel() // Client disconnected (keep-alive write error), cancel upstream stream
+				return
+			}
+			if !ok {
+				break
+			}
 			if chunk == nil {
 				continue
 			}
@@ -1550,7 +2012,13 @@ func (h *CompletionHandler) handleStreamingResponse(ctx *fasthttp.RequestCtx, bi
 							return
 						}
 						// Return error event and stopping the streaming
-						if _, err := fmt.Fprintf(w, "event: error\ndata: %s\n\n", errorJSON); err != nil {
+						var writeErr error
+						if anthropicStream != nil {
+							writeErr = lib.WriteAnthropicEvents(w, []*anthropic.AnthropicStreamEvent{lib.AnthropicErrorEvent(&schemas.BifrostError{Error: &schemas.ErrorField{Message: err.Error()}})})
+						} else {
+							writeErr = dialect.WriteEvent(w, "error", errorJSON)
+						}
+						if writeErr != nil {
 							cancel() // Client disconnected (write error), cancel upstream stream
 							return
 						}
@@ -1567,17 +2035,39 @@ func (h *CompletionHandler) handleStreamingResponse(ctx *fasthttp.RequestCtx, bi
 				}
 			}
 
+			// Chat and Responses chunks become Anthropic Messages events; the response envelope
+			// does not apply to them
+			if anthropicStream != nil {
+				if events, ok := anthropicStream.Convert(chunk); ok {
+					if err := lib.WriteAnthropicEvents(w, events); err != nil {
+						cancel() // Client disconnected (write error), cancel upstream stream
+						return
+					}
+					if err := w.Flush(); err != nil {
+						cancel() // Client disconnected (write error), cancel upstream stream
+						return
+					}
+					continue
+				}
+			}
+
 			// Convert response to JSON
 			chunkJSON, err := sonic.Marshal(chunk)
 			if err != nil {
 				logger.Warn("Failed to marshal streaming response: %v", err)
 				continue
 			}
+			if chunk.BifrostError == nil {
+				if chunkJSON, err = envelope.Apply(chunkJSON, bifrostCtx, nil); err != nil {
+					logger.Warn("Failed to apply response envelope to streaming response: %v", err)
+					continue
+				}
+			}
 
 			// Send as SSE data
+			eventType := ""
 			if includeEventType {
 				// For responses and image gen API, use OpenAI-compatible format with event line
-				eventType := ""
 				if chunk.BifrostResponsesStreamResponse != nil {
 					eventType = string(chunk.BifrostResponsesStreamResponse.Type)
 				} else if chunk.BifrostImageGenerationStreamResponse != nil {
@@ -1585,22 +2075,11 @@ func (h *CompletionHandler) handleStreamingResponse(ctx *fasthttp.RequestCtx, bi
 				} else if chunk.BifrostError != nil {
 					eventType = string(schemas.ResponsesStreamResponseTypeError)
 				}
-				if eventType != "" {
-					if _, err := fmt.Fprintf(w, "event: %s\n", eventType); err != nil {
-						cancel() // Client disconnected (write error), cancel upstream stream
-						return
-					}
-				}
-				if _, err := fmt.Fprintf(w, "data: %s\n\n", chunkJSON); err != nil {
-					cancel() // Client disconnected (write error), cancel upstream stream
-					return
-				}
-			} else {
-				// For other APIs, use standard format
-				if _, err := fmt.Fprintf(w, "data: %s\n\n", chunkJSON); err != nil {
-					cancel() // Client disconnected (write error), cancel upstream stream
-					return
-				}
+			}
+			// For other APIs, the default dialect uses the standard data-only format
+			if err := dialect.WriteEvent(w, eventType, chunkJSON); err != nil {
+				cancel() // Client disconnected (write error), cancel upstream stream
+				return
 			}
 
 			// Flush immediately to send the chunk
@@ -1610,9 +2089,17 @@ func (h *CompletionHandler) handleStreamingResponse(ctx *fasthttp.RequestCtx, bi
 			}
 		}
 
-		if !includeEventType && !skipDoneMarker {
-			// Send the [DONE] marker to indicate the end of the stream (only for non-responses/image-gen APIs)
-			if _, err := fmt.Fprint(w, "data: [DONE]\n\n"); err != nil {
+		if anthropicStream != nil {
+			// End the message with message_delta and message_stop
+			if err := lib.WriteAnthropicEvents(w, anthropicStream.Finish()); err != nil {
+				logger.Warn("Failed to write Anthropic stream stop events: %v", err)
+				cancel() // Client disconnected (write error), cancel upstream stream
+				return
+			}
+		}
+		if dialect.SendsDoneMarker(!includeEventType && !skipDoneMarker) {
+			// Send the [DONE] marker to indicate the end of the stream (by default only for non-responses/image-gen APIs)
+			if err := lib.WriteDoneMarker(w); err != nil {
 				logger.Warn("Failed to write SSE [DONE] marker: %v", err)
 				cancel() // Client disconnected (write error), cancel upstream stream
 				return
@@ -1707,7 +2194,7 @@ func prepareImageGenerationRequest(ctx *fasthttp.RequestCtx) (*ImageGenerationHT
 	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
 		return nil, nil, fmt.Errorf("invalid request format: %v", err)
 	}
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		return nil, nil, fmt.Errorf("model should be in provider/model format")
 	}
@@ -1727,6 +2214,9 @@ func prepareImageGenerationRequest(ctx *fasthttp.RequestCtx) (*ImageGenerationHT
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		return nil, nil, err
+	}
 	bifrostReq := &schemas.BifrostImageGenerationRequest{
 		Provider:  schemas.ModelProvider(provider),
 		Model:     modelName,
@@ -1770,7 +2260,7 @@ func (h *CompletionHandler) imageGeneration(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // handleStreamingImageGeneration handles streaming image generation requests using Server-Sent Events (SSE)
@@ -1798,7 +2288,7 @@ func prepareImageEditRequest(ctx *fasthttp.RequestCtx) (*ImageEditHTTPRequest, *
 		return nil, nil, fmt.Errorf("model is required")
 	}
 	req.Model = modelValues[0]
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		return nil, nil, fmt.Errorf("model should be in provider/model format")
 	}
@@ -1937,6 +2427,9 @@ func prepareImageEditRequest(ctx *fasthttp.RequestCtx) (*ImageEditHTTPRequest, *
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := setFormRequestTags(ctx, form.Value["tags"]); err != nil {
+		return nil, nil, err
+	}
 	bifrostReq := &schemas.BifrostImageEditRequest{
 		Provider:  schemas.ModelProvider(provider),
 		Model:     modelName,
@@ -1979,7 +2472,7 @@ func (h *CompletionHandler) imageEdit(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // handleStreamingImageEditRequest handles streaming image edit requests using Server-Sent Events (SSE)
@@ -2005,7 +2498,7 @@ func prepareImageVariationRequest(ctx *fasthttp.RequestCtx) (*schemas.BifrostIma
 	if len(modelValues) == 0 || modelValues[0] == "" {
 		return nil, fmt.Errorf("model is required")
 	}
-	provider, modelName := schemas.ParseModelString(modelValues[0], "")
+	provider, modelName := schemas.ParseModelString(modelValues[0], pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		return nil, fmt.Errorf("model should be in provider/model format")
 	}
@@ -2064,6 +2557,9 @@ func prepareImageVariationRequest(ctx *fasthttp.RequestCtx) (*schemas.BifrostIma
 			variationParams.ExtraParams[key] = value[0]
 		}
 	}
+	if err := setFormRequestTags(ctx, form.Value["tags"]); err != nil {
+		return nil, err
+	}
 	if fallbackValues := form.Value["fallbacks"]; len(fallbackValues) > 0 {
 		fallbacks, err := parseFallbacks(fallbackValues)
 		if err != nil {
@@ -2113,7 +2609,146 @@ func (h *CompletionHandler) imageVariation(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// musicGeneration handles POST /v1/music/generations - Processes music generation requests
+func (h *CompletionHandler) musicGeneration(ctx *fasthttp.RequestCtx) {
+	var req MusicGenerationRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
+	if provider == "" || modelName == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
+		return
+	}
+
+	fallbacks, err := parseFallbacks(req.Fallbacks)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return
+	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.MusicGenerationInput == nil || req.Prompt == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "prompt cannot be empty")
+		return
+	}
+
+	if req.MusicGenerationParameters == nil {
+		req.MusicGenerationParameters = &schemas.MusicGenerationParameters{}
+	}
+
+	extraParams, err := extractExtraParams(ctx.PostBody(), musicGenerationParamsKnownFields)
+	if err != nil {
+		logger.Warn("Failed to extract extra params: %v", err)
+	} else {
+		req.MusicGenerationParameters.ExtraParams = extraParams
+	}
+
+	bifrostReq := &schemas.BifrostMusicGenerationRequest{
+		Provider:  schemas.ModelProvider(provider),
+		Model:     modelName,
+		Input:     req.MusicGenerationInput,
+		Params:    req.MusicGenerationParameters,
+		Fallbacks: fallbacks,
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	if bifrostCtx == nil {
+		cancel()
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+	defer cancel()
+
+	resp, bifrostErr := h.client.MusicGenerationRequest(bifrostCtx, bifrostReq)
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// contextCacheCreate handles POST /v1/context/create - Caches a message prefix on the provider
+// for reuse by later chat requests through context_id
+func (h *CompletionHandler) contextCacheCreate(ctx *fasthttp.RequestCtx) {
+	var req ContextCacheCreateRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
+	if provider == "" || modelName == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
+		return
+	}
+
+	fallbacks, err := parseFallbacks(req.Fallbacks)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return
+	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return
+	}
+
+	if len(req.Messages) == 0 {
+		SendError(ctx, fasthttp.StatusBadRequest, "messages cannot be empty")
+		return
+	}
+
+	if req.ContextCacheParameters == nil {
+		req.ContextCacheParameters = &schemas.ContextCacheParameters{}
+	}
+
+	extraParams, err := extractExtraParams(ctx.PostBody(), contextCacheCreateParamsKnownFields)
+	if err != nil {
+		logger.Warn("Failed to extract extra params: %v", err)
+	} else {
+		req.ContextCacheParameters.ExtraParams = extraParams
+	}
+
+	bifrostReq := &schemas.BifrostContextCacheCreateRequest{
+		Provider:  schemas.ModelProvider(provider),
+		Model:     modelName,
+		Input:     req.Messages,
+		Params:    req.ContextCacheParameters,
+		Fallbacks: fallbacks,
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	if bifrostCtx == nil {
+		cancel()
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+	defer cancel()
+
+	resp, bifrostErr := h.client.ContextCacheCreateRequest(bifrostCtx, bifrostReq)
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // videoGeneration handles POST /v1/videos - Processes video generation requests
@@ -2125,7 +2760,7 @@ func (h *CompletionHandler) videoGeneration(ctx *fasthttp.RequestCtx) {
 	}
 
 	// Create BifrostVideoGenerationRequest directly using segregated structure
-	provider, modelName := schemas.ParseModelString(req.Model, "")
+	provider, modelName := schemas.ParseModelString(req.Model, pinnedProvider(ctx))
 	if provider == "" || modelName == "" {
 		SendError(ctx, fasthttp.StatusBadRequest, "model should be in provider/model format")
 		return
@@ -2136,6 +2771,10 @@ func (h *CompletionHandler) videoGeneration(ctx *fasthttp.RequestCtx) {
 		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
 		return
 	}
+	if err := setRequestTags(ctx, req.Tags); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return
+	}
 
 	if req.VideoGenerationInput == nil || req.Prompt == "" {
 		SendError(ctx, fasthttp.StatusBadRequest, "prompt cannot be empty")
@@ -2179,7 +2818,7 @@ func (h *CompletionHandler) videoGeneration(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // videoRetrieve handles GET /v1/videos/{video_id} - Retrieve a video generation job
@@ -2229,7 +2868,7 @@ func (h *CompletionHandler) videoRetrieve(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // videoDownload handles GET /v1/videos/{video_id}/content - Download video content
@@ -2343,7 +2982,7 @@ func (h *CompletionHandler) videoList(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // videoDelete handles DELETE /v1/videos/{video_id} - Delete a video generation job
@@ -2391,7 +3030,7 @@ func (h *CompletionHandler) videoDelete(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // videoRemix handles POST /v1/videos/{video_id}/remix - Remix an existing video
@@ -2465,7 +3104,7 @@ func (h *CompletionHandler) videoRemix(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // batchCreate handles POST /v1/batches - Create a new batch job
@@ -2530,7 +3169,7 @@ func (h *CompletionHandler) batchCreate(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // batchList handles GET /v1/batches - List batch jobs
@@ -2587,7 +3226,7 @@ func (h *CompletionHandler) batchList(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // batchRetrieve handles GET /v1/batches/{batch_id} - Retrieve a batch job
@@ -2630,7 +3269,7 @@ func (h *CompletionHandler) batchRetrieve(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // batchCancel handles POST /v1/batches/{batch_id}/cancel - Cancel a batch job
@@ -2673,7 +3312,7 @@ func (h *CompletionHandler) batchCancel(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // batchResults handles GET /v1/batches/{batch_id}/results - Get batch results
@@ -2716,7 +3355,7 @@ func (h *CompletionHandler) batchResults(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // fileUpload handles POST /v1/files - Upload a file
@@ -2802,7 +3441,7 @@ func (h *CompletionHandler) fileUpload(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // fileList handles GET /v1/files - List files
@@ -2865,7 +3504,7 @@ func (h *CompletionHandler) fileList(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // fileRetrieve handles GET /v1/files/{file_id} - Retrieve file metadata
@@ -2908,7 +3547,7 @@ func (h *CompletionHandler) fileRetrieve(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // fileDelete handles DELETE /v1/files/{file_id} - Delete a file
@@ -2951,7 +3590,7 @@ func (h *CompletionHandler) fileDelete(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // fileContent handles GET /v1/files/{file_id}/content - Download file content
@@ -3052,7 +3691,7 @@ func (h *CompletionHandler) containerCreate(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // containerList handles GET /v1/containers - List containers
@@ -3108,7 +3747,7 @@ func (h *CompletionHandler) containerList(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // containerRetrieve handles GET /v1/containers/{container_id} - Retrieve a container
@@ -3152,7 +3791,7 @@ func (h *CompletionHandler) containerRetrieve(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // containerDelete handles DELETE /v1/containers/{container_id} - Delete a container
@@ -3196,7 +3835,7 @@ func (h *CompletionHandler) containerDelete(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // =============================================================================
@@ -3290,7 +3929,7 @@ func (h *CompletionHandler) containerFileCreate(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // containerFileList handles GET /v1/containers/{container_id}/files - List files in a container
@@ -3347,7 +3986,7 @@ func (h *CompletionHandler) containerFileList(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // containerFileRetrieve handles GET /v1/containers/{container_id}/files/{file_id} - Retrieve a file from a container
@@ -3399,7 +4038,7 @@ func (h *CompletionHandler) containerFileRetrieve(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
 }
 
 // containerFileContent handles GET /v1/containers/{container_id}/files/{file_id}/content - Retrieve file content from a container
@@ -3502,5 +4141,42 @@ func (h *CompletionHandler) containerFileDelete(ctx *fasthttp.RequestCtx) {
 	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
 		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
 	}
-	SendJSON(ctx, resp)
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// StreamCancelResponse is the response of DELETE /v1/streams/{request_id}.
+type StreamCancelResponse struct {
+	ID        string `json:"id"`
+	Object    string `json:"object"`
+	Cancelled bool   `json:"cancelled"`
+}
+
+// streamCancel handles DELETE /v1/streams/{request_id} - Abort the in-flight streams served by this node under a
+// request ID (the x-bf-stream-id response header of the stream). Streams started by an authenticated caller (a
+// virtual key, a JWT user or a selected key ID) can only be cancelled by the same caller.
+func (h *CompletionHandler) streamCancel(ctx *fasthttp.RequestCtx) {
+	requestID, ok := ctx.UserValue("request_id").(string)
+	if !ok || requestID == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "request_id is required")
+		return
+	}
+	decodedID, err := url.PathUnescape(requestID)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "invalid request_id encoding")
+		return
+	}
+
+	// Convert context to resolve the caller
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	if _, err := h.config.GetStreamRegistry().Cancel(decodedID, lib.StreamPrincipal(bifrostCtx)); err != nil {
+		SendError(ctx, fasthttp.StatusNotFound, err.Error())
+		return
+	}
+	SendJSON(ctx, StreamCancelResponse{ID: decodedID, Object: "stream", Cancelled: true})
 }
@@ -0,0 +1,392 @@
+package handlers
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	"github.com/capsohq/bifrost/plugins/governance"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/valyala/fasthttp"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// defaultJWTUserIDClaim is the claim used as the governance user ID when none is configured
+	defaultJWTUserIDClaim = "sub"
+	// jwtClockSkew is the leeway allowed when checking exp, nbf and iat
+	jwtClockSkew = 30 * time.Second
+	// jwksRefreshInterval is how long fetched JWKS keys are used before they are fetched again
+	jwksRefreshInterval = time.Hour
+	// jwksMinRefreshInterval limits refetches triggered by tokens signed with an unknown key
+	jwksMinRefreshInterval = time.Minute
+	// jwksFetchTimeout bounds a single JWKS fetch
+	jwksFetchTimeout = 10 * time.Second
+)
+
+var (
+	jwtHMACMethods = []string{"HS256", "HS384", "HS512"}
+	jwtJWKSMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+)
+
+// jwtIdentity is the caller identity mapped from the claims of a validated JWT.
+type jwtIdentity struct {
+	UserID     string
+	TeamID     string
+	CustomerID string
+	Roles      []string
+}
+
+// apply sets the identity on the request so it reaches governance through the Bifrost context.
+func (i *jwtIdentity) apply(ctx *fasthttp.RequestCtx) {
+	ctx.SetUserValue(schemas.BifrostContextKeyGovernanceUserID, i.UserID)
+	if i.TeamID != "" {
+		ctx.SetUserValue(schemas.BifrostContextKeyGovernanceTeamID, i.TeamID)
+	}
+	if i.CustomerID != "" {
+		ctx.SetUserValue(schemas.BifrostContextKeyGovernanceCustomerID, i.CustomerID)
+	}
+	if len(i.Roles) > 0 {
+		ctx.SetUserValue(schemas.BifrostContextKeyGovernanceRoles, i.Roles)
+	}
+}
+
+// jwtValidator validates JWT bearer tokens against a JWTAuthConfig.
+type jwtValidator struct {
+	config  *configstore.JWTAuthConfig
+	secret  []byte
+	jwks    *jwksCache
+	methods []string
+}
+
+// newJWTValidator creates a validator for the config, or returns nil if JWT auth is disabled.
+// The JWKS cache of the previous validator is reused when the JWKS URL is unchanged.
+func newJWTValidator(config *configstore.JWTAuthConfig, previous *jwtValidator) *jwtValidator {
+	if config == nil || !config.Enabled {
+		return nil
+	}
+	v := &jwtValidator{config: config}
+	if secret := config.HMACSecret.GetValue(); secret != "" {
+		v.secret = []byte(secret)
+		v.methods = append(v.methods, jwtHMACMethods...)
+	}
+	if config.JWKSURL != "" {
+		if previous != nil && previous.jwks != nil && previous.jwks.url == config.JWKSURL {
+			v.jwks = previous.jwks
+		} else {
+			v.jwks = newJWKSCache(config.JWKSURL)
+		}
+		v.methods = append(v.methods, jwtJWKSMethods...)
+	}
+	if len(v.methods) == 0 {
+		logger.Warn("jwt auth is enabled but neither a jwks url nor an hmac secret is configured, jwt auth is disabled")
+		return nil
+	}
+	return v
+}
+
+// validate verifies the token signature and registered claims, and maps its claims to an identity.
+func (v *jwtValidator) validate(ctx context.Context, token string) (*jwtIdentity, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(v.methods),
+		jwt.WithLeeway(jwtClockSkew),
+		jwt.WithIssuedAt(),
+	}
+	if v.config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(v.config.Issuer))
+	}
+	if v.config.Audience != "" {
+		options = append(options, jwt.WithAudience(v.config.Audience))
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); ok {
+			return v.secret, nil
+		}
+		kid, _ := t.Header["kid"].(string)
+		return v.jwks.key(ctx, kid)
+	}, options...); err != nil {
+		return nil, err
+	}
+
+	userIDClaim := v.config.UserIDClaim
+	if userIDClaim == "" {
+		userIDClaim = defaultJWTUserIDClaim
+	}
+	identity := &jwtIdentity{
+		UserID:     claimString(claims, userIDClaim),
+		TeamID:     claimString(claims, v.config.TeamIDClaim),
+		CustomerID: claimString(claims, v.config.CustomerIDClaim),
+		Roles:      claimStrings(claims, v.config.RolesClaim),
+	}
+	if identity.UserID == "" {
+		return nil, fmt.Errorf("token has no %s claim", userIDClaim)
+	}
+	return identity, nil
+}
+
+// lookupClaim resolves a claim by name, following dots into nested objects (e.g. "realm_access.roles").
+func lookupClaim(claims jwt.MapClaims, name string) (any, bool) {
+	if name == "" {
+		return nil, false
+	}
+	if value, ok := claims[name]; ok {
+		return value, true
+	}
+	var current any = map[string]any(claims)
+	for part := range strings.SplitSeq(name, ".") {
+		object, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = object[part]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// claimString returns a string or numeric claim as a string.
+func claimString(claims jwt.MapClaims, name string) string {
+	value, ok := lookupClaim(claims, name)
+	if !ok {
+		return ""
+	}
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%v", v)
+	}
+	return ""
+}
+
+// claimStrings returns a list claim, or a space separated string claim (e.g. "scope"), as strings.
+func claimStrings(claims jwt.MapClaims, name string) []string {
+	value, ok := lookupClaim(claims, name)
+	if !ok {
+		return nil
+	}
+	switch v := value.(type) {
+	case string:
+		return strings.Fields(v)
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+		return values
+	}
+	return nil
+}
+
+// bearerJWT returns the bearer token of the request if it has the shape of a JWT.
+// Virtual keys and provider keys sent as bearer tokens are left to the other auth flows.
+func bearerJWT(ctx *fasthttp.RequestCtx) (string, bool) {
+	scheme, token, ok := strings.Cut(string(ctx.Request.Header.Peek("Authorization")), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	if strings.HasPrefix(strings.ToLower(token), governance.VirtualKeyPrefix) || strings.Count(token, ".") != 2 {
+		return "", false
+	}
+	return token, true
+}
+
+// jwksCache fetches and caches the public keys published at a JWKS URL. Lookups only take a read
+// lock; concurrent refreshes share a single fetch, which runs outside the lock.
+type jwksCache struct {
+	url       string
+	client    *http.Client
+	fetches   singleflight.Group
+	mu        sync.RWMutex
+	keys      map[string]any
+	fetchedAt time.Time
+}
+
+func newJWKSCache(url string) *jwksCache {
+	return &jwksCache{
+		url:    url,
+		client: &http.Client{Timeout: jwksFetchTimeout},
+	}
+}
+
+// key returns the public key with the given key ID. Keys are refetched when stale, and when the
+// token is signed with an unknown key (key rotation), at most once per jwksMinRefreshInterval.
+func (c *jwksCache) key(ctx context.Context, kid string) (any, error) {
+	key, found, fetchedAt := c.cached(kid)
+	if found && time.Since(fetchedAt) < jwksRefreshInterval {
+		return key, nil
+	}
+	// Callers arriving during a fetch wait for it. The fetch is shared, so one caller's cancellation
+	// must not fail the others.
+	_, err, _ := c.fetches.Do(c.url, func() (any, error) {
+		return nil, c.refresh(context.WithoutCancel(ctx))
+	})
+	if err != nil {
+		if found {
+			logger.Warn("failed to refresh jwks from %s, using cached keys: %v", c.url, err)
+			return key, nil
+		}
+		return nil, err
+	}
+	key, found, _ = c.cached(kid)
+	if !found {
+		return nil, fmt.Errorf("no jwks key found for kid %q", kid)
+	}
+	return key, nil
+}
+
+// cached returns the cached key with the given ID and when the keys were last fetched.
+func (c *jwksCache) cached(kid string) (any, bool, time.Time) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	key, found := c.lookup(kid)
+	return key, found, c.fetchedAt
+}
+
+// lookup finds a cached key by ID. Tokens without a key ID match a JWKS holding a single key.
+// The caller must hold mu.
+func (c *jwksCache) lookup(kid string) (any, bool) {
+	if kid == "" && len(c.keys) == 1 {
+		for _, key := range c.keys {
+			return key, true
+		}
+	}
+	key, ok := c.keys[kid]
+	return key, ok
+}
+
+// refresh fetches the JWKS and replaces the cached keys, unless they were fetched less than
+// jwksMinRefreshInterval ago. The attempt is recorded before fetching, so failed fetches are limited too.
+func (c *jwksCache) refresh(ctx context.Context) error {
+	c.mu.Lock()
+	if time.Since(c.fetchedAt) < jwksMinRefreshInterval {
+		c.mu.Unlock()
+		return nil
+	}
+	c.fetchedAt = time.Now()
+	c.mu.Unlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create jwks request: %w", err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch jwks: unexpected status code %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("failed to read jwks: %w", err)
+	}
+	keys, err := parseJWKS(body)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.keys = keys
+	c.mu.Unlock()
+	return nil
+}
+
+// jsonWebKey is a public key of a JWKS document (RFC 7517).
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// parseJWKS parses the RSA and EC signing keys of a JWKS document, keyed by key ID.
+func parseJWKS(data []byte) (map[string]any, error) {
+	var document struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := sonic.Unmarshal(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse jwks: %w", err)
+	}
+	keys := make(map[string]any, len(document.Keys))
+	for _, jwk := range document.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			logger.Warn("skipping jwks key %q: %v", jwk.Kid, err)
+			continue
+		}
+		if key != nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	return keys, nil
+}
+
+// publicKey decodes the key, returning nil for key types that cannot verify JWT signatures.
+func (k *jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exponent: %w", err)
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("invalid x coordinate: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("invalid y coordinate: %w", err)
+		}
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,71 @@
+// Package handlers provides HTTP request handlers for the Bifrost HTTP transport.
+// This file contains the key health view and the release of quarantined keys.
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/keyhealth"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/fasthttp/router"
+	"github.com/valyala/fasthttp"
+)
+
+// keyHealthCheckTimeout bounds an on-demand check of every key
+const keyHealthCheckTimeout = 2 * time.Minute
+
+// KeyHealthResponse represents the health of the configured keys
+type KeyHealthResponse struct {
+	Keys []keyhealth.KeyHealth `json:"keys"`
+}
+
+// KeyHealthHandler serves the health of provider keys.
+type KeyHealthHandler struct {
+	checker *keyhealth.Checker
+}
+
+// NewKeyHealthHandler creates a new key health handler instance.
+func NewKeyHealthHandler(checker *keyhealth.Checker) *KeyHealthHandler {
+	return &KeyHealthHandler{
+		checker: checker,
+	}
+}
+
+// RegisterRoutes registers the key health routes.
+func (h *KeyHealthHandler) RegisterRoutes(r *router.Router, middlewares ...schemas.BifrostHTTPMiddleware) {
+	r.GET("/api/keys/health", lib.ChainMiddlewares(h.getKeyHealth, middlewares...))
+	r.POST("/api/keys/health/check", lib.ChainMiddlewares(h.checkKeyHealth, middlewares...))
+	r.POST("/api/keys/{key_id}/release", lib.ChainMiddlewares(h.releaseKey, middlewares...))
+}
+
+// getKeyHealth handles GET /api/keys/health - Get the health of every configured key
+func (h *KeyHealthHandler) getKeyHealth(ctx *fasthttp.RequestCtx) {
+	SendJSON(ctx, KeyHealthResponse{Keys: h.checker.Health()})
+}
+
+// checkKeyHealth handles POST /api/keys/health/check - Check every key now and return their health
+func (h *KeyHealthHandler) checkKeyHealth(ctx *fasthttp.RequestCtx) {
+	checkCtx, cancel := context.WithTimeout(ctx, keyHealthCheckTimeout)
+	defer cancel()
+	SendJSON(ctx, KeyHealthResponse{Keys: h.checker.Run(checkCtx)})
+}
+
+// releaseKey handles POST /api/keys/{key_id}/release - Put a quarantined key back into rotation
+func (h *KeyHealthHandler) releaseKey(ctx *fasthttp.RequestCtx) {
+	keyID, err := getKeyIDFromCtx(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid key ID: %v", err))
+		return
+	}
+	if !h.checker.Release(keyID) {
+		SendError(ctx, fasthttp.StatusNotFound, fmt.Sprintf("Key is not quarantined: %s", keyID))
+		return
+	}
+	SendJSON(ctx, map[string]any{
+		"status":  "success",
+		"message": "Key released from quarantine",
+	})
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	providerUtils "github.com/capsohq/bifrost/core/providers/utils"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/keyhealth"
+	"github.com/fasthttp/router"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestKeyHealthRoutes(t *testing.T) {
+	keys := map[schemas.ModelProvider][]schemas.Key{
+		schemas.OpenAI: {{ID: "keyhealth-handler-a", Name: "openai-prod"}, {ID: "keyhealth-handler-b", Name: "openai-dev"}},
+	}
+	checker := keyhealth.NewChecker(nil, func() map[schemas.ModelProvider][]schemas.Key { return keys }, keyhealth.Config{}, logger)
+	providerUtils.QuarantineKey("keyhealth-handler-a", http.StatusUnauthorized, "invalid api key")
+	defer providerUtils.ReleaseKey("keyhealth-handler-a")
+
+	r := router.New()
+	r.GET("/api/keys/{key_id}", func(ctx *fasthttp.RequestCtx) { ctx.SetStatusCode(fasthttp.StatusTeapot) })
+	NewKeyHealthHandler(checker).RegisterRoutes(r)
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Request.Header.SetMethod(fasthttp.MethodGet)
+	ctx.Request.SetRequestURI("/api/keys/health")
+	r.Handler(ctx)
+	require.Equal(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+	var response KeyHealthResponse
+	require.NoError(t, json.Unmarshal(ctx.Response.Body(), &response))
+	require.Len(t, response.Keys, 2)
+	assert.Equal(t, keyhealth.StatusUnchecked, response.Keys[0].Status)
+	assert.Equal(t, keyhealth.StatusQuarantined, response.Keys[1].Status)
+	require.NotNil(t, response.Keys[1].Quarantine)
+	assert.Equal(t, http.StatusUnauthorized, response.Keys[1].Quarantine.StatusCode)
+
+	release := func(keyID string) int {
+		ctx := &fasthttp.RequestCtx{}
+		ctx.Request.Header.SetMethod(fasthttp.MethodPost)
+		ctx.Request.SetRequestURI("/api/keys/" + keyID + "/release")
+		r.Handler(ctx)
+		return ctx.Response.StatusCode()
+	}
+	assert.Equal(t, fasthttp.StatusOK, release("keyhealth-handler-a"))
+	assert.Equal(t, fasthttp.StatusNotFound, release("keyhealth-handler-a"))
+	_, quarantined := providerUtils.GetKeyQuarantine("keyhealth-handler-a")
+	assert.False(t, quarantined)
+}
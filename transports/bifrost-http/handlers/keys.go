@@ -0,0 +1,334 @@
+// Package handlers provides HTTP request handlers for the Bifrost HTTP transport.
+// This file contains provider key management, including CRUD operations on single keys and connection tests.
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/bytedance/sonic"
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/google/uuid"
+	"github.com/valyala/fasthttp"
+)
+
+// connectionTestTimeout bounds the list models request of a connection test
+const connectionTestTimeout = 15 * time.Second
+
+// KeyResponse represents a single provider key, with its secrets redacted
+type KeyResponse struct {
+	Provider schemas.ModelProvider `json:"provider"`
+	Key      schemas.Key           `json:"key"`
+}
+
+// ConnectionTestResponse represents the result of a provider or key connection test
+type ConnectionTestResponse struct {
+	Provider    schemas.ModelProvider `json:"provider"`
+	KeyID       string                `json:"key_id,omitempty"`
+	Success     bool                  `json:"success"`
+	LatencyMs   int64                 `json:"latency_ms"`
+	ModelCount  int                   `json:"model_count"`
+	KeyStatuses []schemas.KeyStatus   `json:"key_statuses,omitempty"`
+	Error       string                `json:"error,omitempty"`
+}
+
+// getKey handles GET /api/keys/{key_id} - Get a specific key
+func (h *ProviderHandler) getKey(ctx *fasthttp.RequestCtx) {
+	keyID, err := getKeyIDFromCtx(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid key ID: %v", err))
+		return
+	}
+	providers, err := h.inMemoryStore.GetAllProviders()
+	if err != nil {
+		SendError(ctx, fasthttp.StatusInternalServerError, fmt.Sprintf("Failed to get providers: %v", err))
+		return
+	}
+	for _, provider := range providers {
+		redactedConfig, err := h.inMemoryStore.GetProviderConfigRedacted(provider)
+		if err != nil {
+			continue
+		}
+		if index := slices.IndexFunc(redactedConfig.Keys, func(k schemas.Key) bool { return k.ID == keyID }); index >= 0 {
+			SendJSON(ctx, KeyResponse{Provider: provider, Key: redactedConfig.Keys[index]})
+			return
+		}
+	}
+	SendError(ctx, fasthttp.StatusNotFound, fmt.Sprintf("Key not found: %s", keyID))
+}
+
+// addKey handles POST /api/providers/{provider}/keys - Add a key to a provider
+func (h *ProviderHandler) addKey(ctx *fasthttp.RequestCtx) {
+	provider, err := getProviderFromCtx(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid provider: %v", err))
+		return
+	}
+	var key schemas.Key
+	if err := sonic.Unmarshal(ctx.PostBody(), &key); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid JSON: %v", err))
+		return
+	}
+	if key.Name == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "Key name is required")
+		return
+	}
+	oldConfigRaw, ok := h.getProviderConfigRawOrSendError(ctx, provider)
+	if !ok {
+		return
+	}
+	// Key names and IDs are unique across providers
+	allKeys, err := h.inMemoryStore.GetAllKeys()
+	if err != nil {
+		SendError(ctx, fasthttp.StatusInternalServerError, fmt.Sprintf("Failed to get keys: %v", err))
+		return
+	}
+	for _, existing := range allKeys {
+		if existing.Name == key.Name {
+			SendError(ctx, fasthttp.StatusConflict, fmt.Sprintf("Key with name %s already exists", key.Name))
+			return
+		}
+		if key.ID != "" && existing.KeyID == key.ID {
+			SendError(ctx, fasthttp.StatusConflict, fmt.Sprintf("Key with ID %s already exists", key.ID))
+			return
+		}
+	}
+	if key.ID == "" {
+		key.ID = uuid.NewString()
+	}
+	// By default new keys are enabled
+	if key.Enabled == nil {
+		key.Enabled = bifrost.Ptr(true)
+	}
+	key.Status = ""
+	key.Description = ""
+	key.ConfigHash = ""
+
+	config := *oldConfigRaw
+	config.Keys = append(slices.Clone(oldConfigRaw.Keys), key)
+	if !h.updateProviderKeys(ctx, provider, config) {
+		return
+	}
+	h.sendKeyResponse(ctx, provider, key.ID)
+}
+
+// updateKey handles PUT /api/providers/{provider}/keys/{key_id} - Update a key of a provider
+// NOTE: Like provider updates, the complete key is expected. Redacted values that are sent back
+// unchanged keep their current value.
+func (h *ProviderHandler) updateKey(ctx *fasthttp.RequestCtx) {
+	provider, err := getProviderFromCtx(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid provider: %v", err))
+		return
+	}
+	keyID, err := getKeyIDFromCtx(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid key ID: %v", err))
+		return
+	}
+	var key schemas.Key
+	if err := sonic.Unmarshal(ctx.PostBody(), &key); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid JSON: %v", err))
+		return
+	}
+	if key.ID != "" && key.ID != keyID {
+		SendError(ctx, fasthttp.StatusBadRequest, "Key ID in the body does not match the URL")
+		return
+	}
+	key.ID = keyID
+	oldConfigRaw, ok := h.getProviderConfigRawOrSendError(ctx, provider)
+	if !ok {
+		return
+	}
+	index := slices.IndexFunc(oldConfigRaw.Keys, func(k schemas.Key) bool { return k.ID == keyID })
+	if index < 0 {
+		SendError(ctx, fasthttp.StatusNotFound, fmt.Sprintf("Key %s not found for provider %s", keyID, provider))
+		return
+	}
+	if key.Name == "" {
+		key.Name = oldConfigRaw.Keys[index].Name
+	}
+	if key.Enabled == nil {
+		key.Enabled = oldConfigRaw.Keys[index].Enabled
+	}
+	oldConfigRedacted, err := h.inMemoryStore.GetProviderConfigRedacted(provider)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusInternalServerError, fmt.Sprintf("Failed to get provider config: %v", err))
+		return
+	}
+	keys, err := h.mergeKeys(oldConfigRaw.Keys, oldConfigRedacted.Keys, nil, nil, []schemas.Key{key})
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid key: %v", err))
+		return
+	}
+	config := *oldConfigRaw
+	config.Keys = keys
+	if !h.updateProviderKeys(ctx, provider, config) {
+		return
+	}
+	h.sendKeyResponse(ctx, provider, keyID)
+}
+
+// deleteKey handles DELETE /api/providers/{provider}/keys/{key_id} - Remove a key from a provider
+func (h *ProviderHandler) deleteKey(ctx *fasthttp.RequestCtx) {
+	provider, err := getProviderFromCtx(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid provider: %v", err))
+		return
+	}
+	keyID, err := getKeyIDFromCtx(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid key ID: %v", err))
+		return
+	}
+	oldConfigRaw, ok := h.getProviderConfigRawOrSendError(ctx, provider)
+	if !ok {
+		return
+	}
+	if !slices.ContainsFunc(oldConfigRaw.Keys, func(k schemas.Key) bool { return k.ID == keyID }) {
+		SendError(ctx, fasthttp.StatusNotFound, fmt.Sprintf("Key %s not found for provider %s", keyID, provider))
+		return
+	}
+	config := *oldConfigRaw
+	config.Keys = slices.DeleteFunc(slices.Clone(oldConfigRaw.Keys), func(k schemas.Key) bool { return k.ID == keyID })
+	if !h.updateProviderKeys(ctx, provider, config) {
+		return
+	}
+	SendJSON(ctx, map[string]string{"status": "success", "message": "Key deleted successfully"})
+}
+
+// testProvider handles POST /api/providers/{provider}/test - Test the connection of a provider
+// by listing its models with every key
+func (h *ProviderHandler) testProvider(ctx *fasthttp.RequestCtx) {
+	provider, err := getProviderFromCtx(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid provider: %v", err))
+		return
+	}
+	if _, ok := h.getProviderConfigRawOrSendError(ctx, provider); !ok {
+		return
+	}
+	SendJSON(ctx, h.testConnection(ctx, provider, nil))
+}
+
+// testKey handles POST /api/providers/{provider}/keys/{key_id}/test - Test the connection of a
+// single key by listing the models of its provider with it
+func (h *ProviderHandler) testKey(ctx *fasthttp.RequestCtx) {
+	provider, err := getProviderFromCtx(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid provider: %v", err))
+		return
+	}
+	keyID, err := getKeyIDFromCtx(ctx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid key ID: %v", err))
+		return
+	}
+	config, ok := h.getProviderConfigRawOrSendError(ctx, provider)
+	if !ok {
+		return
+	}
+	index := slices.IndexFunc(config.Keys, func(k schemas.Key) bool { return k.ID == keyID })
+	if index < 0 {
+		SendError(ctx, fasthttp.StatusNotFound, fmt.Sprintf("Key %s not found for provider %s", keyID, provider))
+		return
+	}
+	SendJSON(ctx, h.testConnection(ctx, provider, &config.Keys[index]))
+}
+
+// testConnection lists the models of a provider, with a single key when key is set. The plugin
+// pipeline is skipped, so that governance does not reject the test and it is not logged.
+func (h *ProviderHandler) testConnection(ctx *fasthttp.RequestCtx, provider schemas.ModelProvider, key *schemas.Key) ConnectionTestResponse {
+	bfCtx := schemas.NewBifrostContext(ctx, time.Now().Add(connectionTestTimeout))
+	defer bfCtx.Cancel()
+	bfCtx.SetValue(schemas.BifrostContextKeySkipPluginPipeline, true)
+	bfCtx.SetValue(schemas.BifrostContextKeyValidateKeys, true)
+	response := ConnectionTestResponse{Provider: provider}
+	if key != nil {
+		bfCtx.SetValue(schemas.BifrostContextKeyDirectKey, *key)
+		response.KeyID = key.ID
+	}
+
+	start := time.Now()
+	resp, bifrostErr := h.client.ListModelsRequest(bfCtx, &schemas.BifrostListModelsRequest{Provider: provider})
+	response.LatencyMs = time.Since(start).Milliseconds()
+	if bifrostErr != nil {
+		if bifrostErr.Error != nil && bifrostErr.Error.Message != "" {
+			response.Error = bifrostErr.Error.Message
+		} else {
+			response.Error = bifrost.GetErrorMessage(bifrostErr)
+		}
+		response.KeyStatuses = bifrostErr.ExtraFields.KeyStatuses
+		return response
+	}
+	response.Success = true
+	response.ModelCount = len(resp.Data)
+	response.KeyStatuses = resp.KeyStatuses
+	for _, status := range resp.KeyStatuses {
+		if status.Status != schemas.KeyStatusSuccess {
+			response.Success = false
+		}
+	}
+	return response
+}
+
+// getProviderConfigRawOrSendError returns the raw config of a provider, sending a 404 when the
+// provider is not configured
+func (h *ProviderHandler) getProviderConfigRawOrSendError(ctx *fasthttp.RequestCtx, provider schemas.ModelProvider) (*configstore.ProviderConfig, bool) {
+	config, err := h.inMemoryStore.GetProviderConfigRaw(provider)
+	if err != nil {
+		if errors.Is(err, lib.ErrNotFound) {
+			SendError(ctx, fasthttp.StatusNotFound, fmt.Sprintf("Provider not found: %s", provider))
+			return nil, false
+		}
+		SendError(ctx, fasthttp.StatusInternalServerError, fmt.Sprintf("Failed to get provider config: %v", err))
+		return nil, false
+	}
+	return config, true
+}
+
+// updateProviderKeys persists a provider config with changed keys and refreshes its models
+func (h *ProviderHandler) updateProviderKeys(ctx *fasthttp.RequestCtx, provider schemas.ModelProvider, config configstore.ProviderConfig) bool {
+	if err := h.inMemoryStore.UpdateProviderConfig(ctx, provider, config); err != nil {
+		logger.Warn("Failed to update keys of provider %s: %v", provider, err)
+		SendError(ctx, fasthttp.StatusInternalServerError, fmt.Sprintf("Failed to update provider keys: %v", err))
+		return false
+	}
+	if err := h.attemptModelDiscovery(ctx, provider, config.CustomProviderConfig); err != nil {
+		logger.Warn("Model discovery failed for provider %s: %v", provider, err)
+	}
+	return true
+}
+
+// sendKeyResponse sends the redacted key of a provider
+func (h *ProviderHandler) sendKeyResponse(ctx *fasthttp.RequestCtx, provider schemas.ModelProvider, keyID string) {
+	redactedConfig, err := h.inMemoryStore.GetProviderConfigRedacted(provider)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusInternalServerError, fmt.Sprintf("Failed to get provider config: %v", err))
+		return
+	}
+	index := slices.IndexFunc(redactedConfig.Keys, func(k schemas.Key) bool { return k.ID == keyID })
+	if index < 0 {
+		SendError(ctx, fasthttp.StatusNotFound, fmt.Sprintf("Key %s not found for provider %s", keyID, provider))
+		return
+	}
+	SendJSON(ctx, KeyResponse{Provider: provider, Key: redactedConfig.Keys[index]})
+}
+
+func getKeyIDFromCtx(ctx *fasthttp.RequestCtx) (string, error) {
+	keyID, ok := ctx.UserValue("key_id").(string)
+	if !ok || keyID == "" {
+		return "", fmt.Errorf("missing key_id parameter")
+	}
+	decoded, err := url.PathUnescape(keyID)
+	if err != nil {
+		return "", fmt.Errorf("invalid key_id parameter encoding: %v", err)
+	}
+	return decoded, nil
+}
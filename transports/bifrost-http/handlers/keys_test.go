@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func newTestKeysHandler() *ProviderHandler {
+	return &ProviderHandler{inMemoryStore: &lib.Config{
+		Providers: map[schemas.ModelProvider]configstore.ProviderConfig{
+			schemas.OpenAI: {Keys: []schemas.Key{{ID: "key-1", Name: "openai-prod", Value: *schemas.NewEnvVar("sk-secret-value-1234")}}},
+		},
+	}}
+}
+
+func TestGetKeyRedactsSecrets(t *testing.T) {
+	handler := newTestKeysHandler()
+	ctx := &fasthttp.RequestCtx{}
+	ctx.SetUserValue("key_id", "key-1")
+
+	handler.getKey(ctx)
+
+	require.Equal(t, fasthttp.StatusOK, ctx.Response.StatusCode())
+	var response KeyResponse
+	require.NoError(t, json.Unmarshal(ctx.Response.Body(), &response))
+	assert.Equal(t, schemas.OpenAI, response.Provider)
+	assert.Equal(t, "openai-prod", response.Key.Name)
+	assert.NotContains(t, string(ctx.Response.Body()), "sk-secret-value-1234")
+}
+
+func TestGetKeyNotFound(t *testing.T) {
+	handler := newTestKeysHandler()
+	ctx := &fasthttp.RequestCtx{}
+	ctx.SetUserValue("key_id", "missing")
+
+	handler.getKey(ctx)
+
+	assert.Equal(t, fasthttp.StatusNotFound, ctx.Response.StatusCode())
+}
+
+func TestAddKeyValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		provider   string
+		body       string
+		wantStatus int
+	}{
+		{"missing name", "openai", `{"value": "sk-new"}`, fasthttp.StatusBadRequest},
+		{"unknown provider", "anthropic", `{"name": "anthropic-prod", "value": "sk-new"}`, fasthttp.StatusNotFound},
+		{"duplicate name", "openai", `{"name": "openai-prod", "value": "sk-new"}`, fasthttp.StatusConflict},
+		{"duplicate id", "openai", `{"id": "key-1", "name": "openai-dev", "value": "sk-new"}`, fasthttp.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := newTestKeysHandler()
+			ctx := &fasthttp.RequestCtx{}
+			ctx.SetUserValue("provider", tt.provider)
+			ctx.Request.SetBodyString(tt.body)
+
+			handler.addKey(ctx)
+
+			assert.Equal(t, tt.wantStatus, ctx.Response.StatusCode())
+		})
+	}
+}
+
+func TestUpdateKeyRejectsMismatchedID(t *testing.T) {
+	handler := newTestKeysHandler()
+	ctx := &fasthttp.RequestCtx{}
+	ctx.SetUserValue("provider", "openai")
+	ctx.SetUserValue("key_id", "key-1")
+	ctx.Request.SetBodyString(`{"id": "key-2", "name": "openai-prod"}`)
+
+	handler.updateKey(ctx)
+
+	assert.Equal(t, fasthttp.StatusBadRequest, ctx.Response.StatusCode())
+}
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// ListenerRole selects which routes a listener serves.
+type ListenerRole string
+
+const (
+	ListenerRoleAll       ListenerRole = "all"       // Every route
+	ListenerRoleInference ListenerRole = "inference" // Inference, integration and MCP server routes
+	ListenerRoleAdmin     ListenerRole = "admin"     // Management API, UI, websocket and metrics routes
+)
+
+// listenerRoleUserValue is the user value holding the role of the listener a request came in on
+const listenerRoleUserValue = "bifrost-listener-role"
+
+// ParseListenerRole parses a listener role, returning an error for unknown roles.
+func ParseListenerRole(value string) (ListenerRole, error) {
+	switch role := ListenerRole(value); role {
+	case ListenerRoleAll, ListenerRoleInference, ListenerRoleAdmin:
+		return role, nil
+	default:
+		return "", fmt.Errorf("unknown listener role %q, expected all, inference or admin", value)
+	}
+}
+
+// ListenerRoleHandler marks the requests of a listener with its role, so that RouteRoleMiddleware
+// can reject the routes the listener does not serve.
+func ListenerRoleHandler(role ListenerRole, next fasthttp.RequestHandler) fasthttp.RequestHandler {
+	if role == ListenerRoleAll {
+		return next
+	}
+	return func(ctx *fasthttp.RequestCtx) {
+		ctx.SetUserValue(listenerRoleUserValue, role)
+		next(ctx)
+	}
+}
+
+// RouteRoleMiddleware answers 404 for the routes of role when the request came in on a listener
+// serving another role. /health is served on every listener, for probes.
+func RouteRoleMiddleware(role ListenerRole) schemas.BifrostHTTPMiddleware {
+	return func(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+		return func(ctx *fasthttp.RequestCtx) {
+			listenerRole, ok := ctx.UserValue(listenerRoleUserValue).(ListenerRole)
+			if ok && listenerRole != role && string(ctx.Path()) != "/health" {
+				SendError(ctx, fasthttp.StatusNotFound, "Route not found: "+string(ctx.Path()))
+				return
+			}
+			next(ctx)
+		}
+	}
+}
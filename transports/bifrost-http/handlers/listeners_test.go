@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/valyala/fasthttp"
+)
+
+func TestRouteRoleMiddleware(t *testing.T) {
+	tests := []struct {
+		name         string
+		listenerRole ListenerRole
+		routeRole    ListenerRole
+		path         string
+		wantStatus   int
+	}{
+		{"all serves admin routes", ListenerRoleAll, ListenerRoleAdmin, "/api/providers", fasthttp.StatusOK},
+		{"all serves inference routes", ListenerRoleAll, ListenerRoleInference, "/v1/chat/completions", fasthttp.StatusOK},
+		{"inference serves inference routes", ListenerRoleInference, ListenerRoleInference, "/v1/chat/completions", fasthttp.StatusOK},
+		{"inference rejects admin routes", ListenerRoleInference, ListenerRoleAdmin, "/api/providers", fasthttp.StatusNotFound},
+		{"admin rejects inference routes", ListenerRoleAdmin, ListenerRoleInference, "/v1/chat/completions", fasthttp.StatusNotFound},
+		{"inference serves health", ListenerRoleInference, ListenerRoleAdmin, "/health", fasthttp.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := RouteRoleMiddleware(tt.routeRole)(func(ctx *fasthttp.RequestCtx) {
+				ctx.SetStatusCode(fasthttp.StatusOK)
+			})
+			ctx := &fasthttp.RequestCtx{}
+			ctx.Request.SetRequestURI(tt.path)
+			ListenerRoleHandler(tt.listenerRole, route)(ctx)
+			if got := ctx.Response.StatusCode(); got != tt.wantStatus {
+				t.Errorf("status = %d, want %d", got, tt.wantStatus)
+			}
+		})
+	}
+}
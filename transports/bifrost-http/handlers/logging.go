@@ -108,6 +108,12 @@ func (h *LoggingHandler) getLogs(ctx *fasthttp.RequestCtx) {
 	if routingEngines := string(ctx.QueryArgs().Peek("routing_engine_used")); routingEngines != "" {
 		filters.RoutingEngineUsed = parseCommaSeparated(routingEngines)
 	}
+	if traceIDs := string(ctx.QueryArgs().Peek("trace_ids")); traceIDs != "" {
+		filters.TraceIDs = parseCommaSeparated(traceIDs)
+	}
+	if tags := string(ctx.QueryArgs().Peek("tags")); tags != "" {
+		filters.Tags = parseCommaSeparated(tags)
+	}
 	if startTime := string(ctx.QueryArgs().Peek("start_time")); startTime != "" {
 		if t, err := time.Parse(time.RFC3339, startTime); err == nil {
 			filters.StartTime = &t
@@ -184,6 +190,9 @@ func (h *LoggingHandler) getLogs(ctx *fasthttp.RequestCtx) {
 		}
 	}
 
+	// Cursor from the next_cursor of a previous page, takes precedence over offset
+	pagination.Cursor = string(ctx.QueryArgs().Peek("cursor"))
+
 	// Sort parameters
 	pagination.SortBy = "timestamp" // Default sort field
 	if sortBy := string(ctx.QueryArgs().Peek("sort_by")); sortBy != "" {
@@ -199,7 +208,16 @@ func (h *LoggingHandler) getLogs(ctx *fasthttp.RequestCtx) {
 		}
 	}
 
+	if pagination.Cursor != "" && pagination.SortBy != "timestamp" {
+		SendError(ctx, fasthttp.StatusBadRequest, "cursor pagination requires sort_by=timestamp")
+		return
+	}
+
 	result, err := h.logManager.Search(ctx, filters, pagination)
+	if errors.Is(err, logstore.ErrInvalidCursor) {
+		SendError(ctx, fasthttp.StatusBadRequest, "invalid cursor")
+		return
+	}
 	if err != nil {
 		logger.Error("failed to search logs: %v", err)
 		SendError(ctx, fasthttp.StatusInternalServerError, fmt.Sprintf("Search failed: %v", err))
@@ -303,6 +321,12 @@ func (h *LoggingHandler) getLogsStats(ctx *fasthttp.RequestCtx) {
 	if routingEngines := string(ctx.QueryArgs().Peek("routing_engine_used")); routingEngines != "" {
 		filters.RoutingEngineUsed = parseCommaSeparated(routingEngines)
 	}
+	if traceIDs := string(ctx.QueryArgs().Peek("trace_ids")); traceIDs != "" {
+		filters.TraceIDs = parseCommaSeparated(traceIDs)
+	}
+	if tags := string(ctx.QueryArgs().Peek("tags")); tags != "" {
+		filters.Tags = parseCommaSeparated(tags)
+	}
 	if startTime := string(ctx.QueryArgs().Peek("start_time")); startTime != "" {
 		if t, err := time.Parse(time.RFC3339, startTime); err == nil {
 			filters.StartTime = &t
@@ -431,6 +455,12 @@ func parseHistogramFilters(ctx *fasthttp.RequestCtx) *logstore.SearchFilters {
 	if routingEngines := string(ctx.QueryArgs().Peek("routing_engine_used")); routingEngines != "" {
 		filters.RoutingEngineUsed = parseCommaSeparated(routingEngines)
 	}
+	if traceIDs := string(ctx.QueryArgs().Peek("trace_ids")); traceIDs != "" {
+		filters.TraceIDs = parseCommaSeparated(traceIDs)
+	}
+	if tags := string(ctx.QueryArgs().Peek("tags")); tags != "" {
+		filters.Tags = parseCommaSeparated(tags)
+	}
 	if startTime := string(ctx.QueryArgs().Peek("start_time")); startTime != "" {
 		if t, err := time.Parse(time.RFC3339, startTime); err == nil {
 			filters.StartTime = &t
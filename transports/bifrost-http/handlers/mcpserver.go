@@ -9,6 +9,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/bytedance/sonic"
 	bifrost "github.com/capsohq/bifrost/core"
@@ -34,7 +35,7 @@ type MCPToolManager interface {
 type MCPServerHandler struct {
 	toolManager     MCPToolManager
 	globalMCPServer *server.MCPServer
-	vkMCPServers    map[string]*server.MCPServer // Map of vk value -> mcp server
+	vkMCPServers    map[string]*server.MCPServer // Map of vk value hash -> mcp server
 	config          *lib.Config
 	mu              sync.RWMutex
 }
@@ -126,6 +127,13 @@ func (h *MCPServerHandler) handleMCPServerSSE(ctx *fasthttp.RequestCtx) {
 	// Convert context
 	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, false, h.config.GetHeaderFilterConfig())
 
+	// The connection carries no other traffic, so keep-alives are the only way to notice the client left:
+	// they are sent at the default interval even when disabled for inference streams
+	keepAliveInterval := h.config.GetSSEKeepAliveInterval()
+	if keepAliveInterval <= 0 {
+		keepAliveInterval = lib.DefaultSSEKeepAliveInterval
+	}
+
 	// Use streaming response writer
 	ctx.Response.SetBodyStreamWriter(func(w *bufio.Writer) {
 		defer func() {
@@ -143,8 +151,20 @@ func (h *MCPServerHandler) handleMCPServerSSE(ctx *fasthttp.RequestCtx) {
 			w.Flush()
 		}
 
-		// Wait for context cancellation (client disconnect or server-side cancel)
-		<-(*bifrostCtx).Done()
+		// Wait for context cancellation (server-side cancel) or a client disconnect, which fasthttp
+		// only reports as a write error
+		ticker := time.NewTicker(keepAliveInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-(*bifrostCtx).Done():
+				return
+			case <-ticker.C:
+				if err := lib.WriteKeepAlive(w); err != nil {
+					return
+				}
+			}
+		}
 	})
 }
 
@@ -166,13 +186,13 @@ func (h *MCPServerHandler) SyncAllMCPServers(ctx context.Context) error {
 		h.vkMCPServers = make(map[string]*server.MCPServer)
 		for i := range virtualKeys {
 			vk := &virtualKeys[i]
-			h.vkMCPServers[vk.Value] = server.NewMCPServer(
+			h.vkMCPServers[vk.LookupHash()] = server.NewMCPServer(
 				vk.Name,
 				version,
 				server.WithToolCapabilities(true),
 			)
 			availableTools, toolFilter := h.fetchToolsForVK(vk)
-			h.syncServer(h.vkMCPServers[vk.Value], availableTools, toolFilter)
+			h.syncServer(h.vkMCPServers[vk.LookupHash()], availableTools, toolFilter)
 			logger.Debug("Synced MCP server for virtual key '%s' with %d tools", vk.Name, len(availableTools))
 		}
 	}
@@ -182,7 +202,8 @@ func (h *MCPServerHandler) SyncAllMCPServers(ctx context.Context) error {
 func (h *MCPServerHandler) SyncVKMCPServer(vk *tables.TableVirtualKey) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
-	vkServer, ok := h.vkMCPServers[vk.Value]
+	vkHash := vk.LookupHash()
+	vkServer, ok := h.vkMCPServers[vkHash]
 	if !ok {
 		// Add new server
 		vkServer = server.NewMCPServer(
@@ -190,18 +211,18 @@ func (h *MCPServerHandler) SyncVKMCPServer(vk *tables.TableVirtualKey) {
 			version,
 			server.WithToolCapabilities(true),
 		)
-		h.vkMCPServers[vk.Value] = vkServer
+		h.vkMCPServers[vkHash] = vkServer
 	}
 	availableTools, toolFilter := h.fetchToolsForVK(vk)
 	h.syncServer(vkServer, availableTools, toolFilter)
-	h.vkMCPServers[vk.Value] = vkServer
+	h.vkMCPServers[vkHash] = vkServer
 	logger.Debug("Synced MCP server for virtual key '%s' with %d tools", vk.Name, len(availableTools))
 }
 
-func (h *MCPServerHandler) DeleteVKMCPServer(vkValue string) {
+func (h *MCPServerHandler) DeleteVKMCPServer(vk *tables.TableVirtualKey) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
-	delete(h.vkMCPServers, vkValue)
+	delete(h.vkMCPServers, vk.LookupHash())
 }
 
 func (h *MCPServerHandler) syncServer(server *server.MCPServer, availableTools []schemas.ChatTool, toolFilter []string) {
@@ -367,7 +388,7 @@ func (h *MCPServerHandler) getMCPServerForRequest(ctx *fasthttp.RequestCtx) (*se
 	}
 
 	// Check if vk exists in the map
-	vkServer, ok := h.vkMCPServers[vk]
+	vkServer, ok := h.vkMCPServers[tables.HashVirtualKeyValue(vk)]
 	if !ok {
 		return nil, fmt.Errorf("virtual key not found.")
 	}
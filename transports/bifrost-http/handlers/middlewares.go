@@ -1,15 +1,21 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
 
+	"github.com/bytedance/sonic"
+	"github.com/bytedance/sonic/ast"
+	bifrost "github.com/capsohq/bifrost/core"
 	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/core/tokenizer"
 	"github.com/capsohq/bifrost/framework/configstore"
 	"github.com/capsohq/bifrost/framework/encrypt"
 	"github.com/capsohq/bifrost/framework/tracing"
@@ -144,6 +150,110 @@ func RequestDecompressionMiddleware(config *lib.Config) schemas.BifrostHTTPMiddl
 	}
 }
 
+// aliasRequestTypes maps inference path suffixes to the request type alias targets are ranked
+// for. Other paths are ranked as chat completions.
+var aliasRequestTypes = []struct {
+	suffix      string
+	requestType schemas.RequestType
+}{
+	{"/embeddings", schemas.EmbeddingRequest},
+	{"/rerank", schemas.RerankRequest},
+	{"/audio/speech", schemas.SpeechRequest},
+	{"/images/generations", schemas.ImageGenerationRequest},
+	{"/responses", schemas.ResponsesRequest},
+	{"/chat/completions", schemas.ChatCompletionRequest},
+	{"/completions", schemas.TextCompletionRequest},
+}
+
+// ModelAliasMiddleware resolves latency-routed and cost-routed model aliases, virtual models and
+// traffic splits in JSON request bodies. A model without a provider prefix that names an alias is
+// rewritten to the alias' best target in "provider/model" form, and the other targets are added as
+// fallbacks unless the body has its own. Traffic splits bucket requests by their x-bf-split-key
+// header and record the assigned variant for the core to tag the response with. It runs before the plugin transport interceptors, so they see the routed model.
+func ModelAliasMiddleware(client *bifrost.Bifrost) schemas.BifrostHTTPMiddleware {
+	return func(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+		return func(ctx *fasthttp.RequestCtx) {
+			if client != nil && client.HasModelAliases() {
+				routeModelAlias(ctx, client)
+			}
+			next(ctx)
+		}
+	}
+}
+
+func routeModelAlias(ctx *fasthttp.RequestCtx, client *bifrost.Bifrost) {
+	body := ctx.Request.Body()
+	if !bytes.HasPrefix(bytes.TrimSpace(body), []byte("{")) {
+		return
+	}
+	root, err := sonic.Get(body)
+	if err != nil {
+		return
+	}
+	model, err := root.Get("model").String()
+	if err != nil || !client.IsModelAlias(model) {
+		return
+	}
+	requestType := schemas.ChatCompletionRequest
+	path := string(ctx.Path())
+	for _, entry := range aliasRequestTypes {
+		if strings.HasSuffix(path, entry.suffix) {
+			requestType = entry.requestType
+			break
+		}
+	}
+	split, targets, ok := client.SplitTraffic(model, strings.TrimSpace(string(ctx.Request.Header.Peek("x-bf-split-key"))))
+	if ok {
+		ctx.SetUserValue(schemas.BifrostContextKeyTrafficSplit, split)
+	} else {
+		var promptTokens, maxOutputTokens int
+		if config := client.GetCostRoutingConfig(); config != nil {
+			if _, ok := config.Aliases[model]; ok {
+				promptTokens, maxOutputTokens = estimateBodyTokens(&root)
+			}
+		}
+		if targets, ok = client.RouteModelAlias(model, requestType, promptTokens, maxOutputTokens); !ok {
+			return
+		}
+	}
+	if _, err := root.Set("model", ast.NewString(string(targets[0].Provider)+"/"+targets[0].Model)); err != nil {
+		return
+	}
+	if !root.Get("fallbacks").Exists() && len(targets) > 1 {
+		fallbacks := make([]ast.Node, 0, len(targets)-1)
+		for _, target := range targets[1:] {
+			fallbacks = append(fallbacks, ast.NewString(string(target.Provider)+"/"+target.Model))
+		}
+		if _, err := root.Set("fallbacks", ast.NewArray(fallbacks)); err != nil {
+			return
+		}
+	}
+	routed, err := root.MarshalJSON()
+	if err != nil {
+		logger.Warn("failed to route model alias %s: %v", model, err)
+		return
+	}
+	ctx.Request.SetBody(routed)
+	logger.Debug("routed model alias %s to %s/%s", model, targets[0].Provider, targets[0].Model)
+}
+
+// estimateBodyTokens estimates the prompt tokens of a JSON request body from its messages, input,
+// prompt and instructions, and reads its output token limit (0 if it sets none).
+func estimateBodyTokens(root *ast.Node) (promptTokens, maxOutputTokens int) {
+	counter := tokenizer.DefaultRegistry.Lookup("", "")
+	for _, field := range []string{"messages", "input", "prompt", "instructions", "system"} {
+		if raw, err := root.Get(field).Raw(); err == nil {
+			promptTokens += counter.CountTokens(raw)
+		}
+	}
+	for _, field := range []string{"max_tokens", "max_completion_tokens", "max_output_tokens"} {
+		if limit, err := root.Get(field).Int64(); err == nil && limit > 0 {
+			return promptTokens, int(limit)
+		}
+	}
+	return promptTokens, 0
+}
+
 // TransportInterceptorMiddleware runs all plugin HTTP transport interceptors.
 // It converts the fasthttp request to a serializable HTTPRequest, runs all plugin interceptors,
 // and applies any modifications back to the fasthttp context.
@@ -322,6 +432,7 @@ func validateSession(_ *fasthttp.RequestCtx, store configstore.ConfigStore, toke
 type AuthMiddleware struct {
 	store         configstore.ConfigStore
 	authConfig    atomic.Pointer[configstore.AuthConfig]
+	jwtValidator  atomic.Pointer[jwtValidator]
 	wsTicketStore *WSTicketStore
 }
 
@@ -335,22 +446,53 @@ func InitAuthMiddleware(store configstore.ConfigStore, wsTicketStore *WSTicketSt
 	}
 	am := &AuthMiddleware{
 		store:         store,
-		authConfig:    atomic.Pointer[configstore.AuthConfig]{},
 		wsTicketStore: wsTicketStore,
 	}
-	am.authConfig.Store(authConfig)
+	am.UpdateAuthConfig(authConfig)
 	return am, nil
 }
 
 func (m *AuthMiddleware) UpdateAuthConfig(authConfig *configstore.AuthConfig) {
 	m.authConfig.Store(authConfig)
+	var jwtConfig *configstore.JWTAuthConfig
+	if authConfig != nil {
+		jwtConfig = authConfig.JWT
+	}
+	m.jwtValidator.Store(newJWTValidator(jwtConfig, m.jwtValidator.Load()))
 }
 
 // InferenceMiddleware is for inference requests (including MCP routes) if authConfig is set, it will skip authentication if disableAuthOnInference is true.
+// When JWT auth is enabled, a bearer JWT authenticates the request on its own and its claims are mapped
+// to the governance user, team, customer and roles, so no virtual key is needed.
 func (m *AuthMiddleware) InferenceMiddleware() schemas.BifrostHTTPMiddleware {
-	return m.middleware(func(authConfig *configstore.AuthConfig, url string) bool {
+	authenticate := m.middleware(func(authConfig *configstore.AuthConfig, url string) bool {
 		return authConfig.DisableAuthOnInference
 	})
+	return func(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+		authenticated := authenticate(next)
+		return func(ctx *fasthttp.RequestCtx) {
+			validator := m.jwtValidator.Load()
+			if validator == nil {
+				authenticated(ctx)
+				return
+			}
+			token, ok := bearerJWT(ctx)
+			if !ok {
+				authenticated(ctx)
+				return
+			}
+			identity, err := validator.validate(ctx, token)
+			if err != nil {
+				logger.Debug("jwt validation failed: %v", err)
+				SendError(ctx, fasthttp.StatusUnauthorized, "Unauthorized")
+				return
+			}
+			identity.apply(ctx)
+			// The token authenticated the caller to Bifrost, it must not be used as a provider key
+			ctx.Request.Header.Del("Authorization")
+			next(ctx)
+		}
+	}
 }
 
 // APIMiddleware is for API requests if authConfig is set, it will verify authentication based on the request type.
@@ -361,6 +503,9 @@ func (m *AuthMiddleware) InferenceMiddleware() schemas.BifrostHTTPMiddleware {
 //
 // Basic auth may be acceptable for limited use cases, while Bearer and WebSocket flows provide
 // session-based authentication suitable for production environments.
+//
+// These callers act as admins. Virtual keys and JWTs sent as bearer tokens are authorized by role instead:
+// the virtual key's role or the JWT's roles claim grants admin, operator or viewer access (see roleAllows).
 func (m *AuthMiddleware) APIMiddleware() schemas.BifrostHTTPMiddleware {
 	whitelistedRoutes := []string{
 		"/api/session/is-auth-enabled",
@@ -371,7 +516,7 @@ func (m *AuthMiddleware) APIMiddleware() schemas.BifrostHTTPMiddleware {
 	whitelistedPrefixes := []string{
 		"/api/oauth/callback",
 	}
-	return m.middleware(func(authConfig *configstore.AuthConfig, url string) bool {
+	shouldSkip := func(authConfig *configstore.AuthConfig, url string) bool {
 		if slices.Contains(whitelistedRoutes, url) ||
 			slices.IndexFunc(whitelistedPrefixes, func(prefix string) bool {
 				return strings.HasPrefix(url, prefix)
@@ -379,7 +524,40 @@ func (m *AuthMiddleware) APIMiddleware() schemas.BifrostHTTPMiddleware {
 			return true
 		}
 		return false
-	})
+	}
+	authenticate := m.middleware(shouldSkip)
+	return func(next fasthttp.RequestHandler) fasthttp.RequestHandler {
+		unauthenticated := authenticate(next)
+		// Sessions and the admin credentials belong to the admin user
+		authenticated := authenticate(func(ctx *fasthttp.RequestCtx) {
+			ctx.SetUserValue(auditActorKey, adminAuditActor(m.authConfig.Load()))
+			next(ctx)
+		})
+		return func(ctx *fasthttp.RequestCtx) {
+			authConfig := m.authConfig.Load()
+			if authConfig == nil || !authConfig.IsEnabled || shouldSkip(authConfig, string(ctx.Request.URI().RequestURI())) {
+				unauthenticated(ctx)
+				return
+			}
+			caller, err := m.resolveManagementCaller(ctx)
+			if errors.Is(err, errNoManagementCaller) {
+				authenticated(ctx)
+				return
+			}
+			if err != nil {
+				logger.Debug("management api authentication failed: %v", err)
+				SendError(ctx, fasthttp.StatusUnauthorized, "Unauthorized")
+				return
+			}
+			if !roleAllows(caller.role, string(ctx.Method()), string(ctx.Path())) {
+				recordAccessDenied(ctx, m.store, caller)
+				SendError(ctx, fasthttp.StatusForbidden, "Forbidden")
+				return
+			}
+			ctx.SetUserValue(auditActorKey, caller.principal)
+			next(ctx)
+		}
+	}
 }
 
 // middleware is the core authentication middleware that checks if the request should be authenticated or not.
@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"context"
+	"fmt"
 	"testing"
 
+	bifrost "github.com/capsohq/bifrost/core"
 	"github.com/capsohq/bifrost/core/schemas"
 	"github.com/capsohq/bifrost/framework/configstore"
 	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
@@ -1141,3 +1144,74 @@ func TestFasthttpToHTTPRequest_PathParams(t *testing.T) {
 		t.Errorf("CaseInsensitivePathParamLookup should be case-insensitive: expected 'file-abc123', got '%s'", fileID)
 	}
 }
+
+// emptyAccount is an account without configured providers.
+type emptyAccount struct{}
+
+func (emptyAccount) GetConfiguredProviders() ([]schemas.ModelProvider, error) { return nil, nil }
+func (emptyAccount) GetKeysForProvider(ctx context.Context, provider schemas.ModelProvider) ([]schemas.Key, error) {
+	return nil, nil
+}
+func (emptyAccount) GetConfigForProvider(provider schemas.ModelProvider) (*schemas.ProviderConfig, error) {
+	return nil, fmt.Errorf("provider %s is not configured", provider)
+}
+
+func TestModelAliasMiddleware(t *testing.T) {
+	SetLogger(&mockLogger{})
+	client, err := bifrost.Init(context.Background(), schemas.BifrostConfig{
+		Account: emptyAccount{},
+		Logger:  &mockLogger{},
+		LatencyRouting: &schemas.LatencyRoutingConfig{Aliases: map[string][]schemas.Fallback{
+			"chat":  {{Provider: schemas.OpenAI, Model: "gpt-4o"}, {Provider: schemas.Groq, Model: "llama-3.3-70b"}},
+			"embed": {{Provider: schemas.Groq, Model: "llama-3.3-70b"}, {Provider: schemas.OpenAI, Model: "text-embedding-3-small"}},
+		}},
+		CostRouting: &schemas.CostRoutingConfig{Aliases: map[string][]schemas.CostRoutingTarget{
+			"cheap": {{Provider: schemas.OpenAI, Model: "gpt-4o"}, {Provider: schemas.OpenAI, Model: "gpt-4o-mini"}},
+		}},
+		VirtualModels: []schemas.VirtualModel{{Name: "fast-chat", Targets: []schemas.Fallback{{Provider: schemas.Groq, Model: "llama-3.1-8b"}, {Provider: schemas.OpenAI, Model: "gpt-4o-mini"}}}},
+		TrafficSplits: []schemas.TrafficSplit{{Name: "migrating", Primary: schemas.Fallback{Provider: schemas.OpenAI, Model: "gpt-4o"}, Candidate: schemas.Fallback{Provider: schemas.OpenAI, Model: "gpt-4o-mini"}, CandidatePercent: 100}},
+		ModelPricing: func(provider schemas.ModelProvider, model string, requestType schemas.RequestType) (float64, float64, bool) {
+			if model == "gpt-4o-mini" {
+				return 0.15e-6, 0.6e-6, true
+			}
+			return 2.5e-6, 10e-6, true
+		},
+	})
+	if err != nil {
+		t.Fatalf("Init() error = %v", err)
+	}
+	defer client.Shutdown()
+
+	tests := []struct {
+		name string
+		path string
+		body string
+		want string
+	}{
+		{"alias", "/v1/chat/completions", `{"model":"chat","messages":[]}`, `{"model":"openai/gpt-4o","messages":[],"fallbacks":["groq/llama-3.3-70b"]}`},
+		{"alias with fallbacks", "/v1/chat/completions", `{"model":"chat","fallbacks":["anthropic/claude"]}`, `{"model":"openai/gpt-4o","fallbacks":["anthropic/claude"]}`},
+		{"unsupported target ranked last", "/v1/embeddings", `{"model":"embed"}`, `{"model":"openai/text-embedding-3-small","fallbacks":["groq/llama-3.3-70b"]}`},
+		{"cost alias", "/v1/chat/completions", `{"model":"cheap","max_tokens":10}`, `{"model":"openai/gpt-4o-mini","max_tokens":10,"fallbacks":["openai/gpt-4o"]}`},
+		{"virtual model", "/v1/chat/completions", `{"model":"fast-chat"}`, `{"model":"groq/llama-3.1-8b","fallbacks":["openai/gpt-4o-mini"]}`},
+		{"traffic split", "/v1/chat/completions", `{"model":"migrating"}`, `{"model":"openai/gpt-4o-mini","fallbacks":["openai/gpt-4o"]}`},
+		{"provider prefix", "/v1/chat/completions", `{"model":"openai/chat"}`, `{"model":"openai/chat"}`},
+		{"not an alias", "/v1/chat/completions", `{"model":"gpt-4o"}`, `{"model":"gpt-4o"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &fasthttp.RequestCtx{}
+			ctx.Request.SetRequestURI(tt.path)
+			ctx.Request.SetBodyString(tt.body)
+			var got string
+			ModelAliasMiddleware(client)(func(ctx *fasthttp.RequestCtx) {
+				got = string(ctx.Request.Body())
+			})(ctx)
+			if got != tt.want {
+				t.Errorf("body = %s, want %s", got, tt.want)
+			}
+			if split, _ := ctx.UserValue(schemas.BifrostContextKeyTrafficSplit).(*schemas.TrafficSplitResult); (split != nil) != (tt.name == "traffic split") {
+				t.Errorf("traffic split = %+v", split)
+			}
+		})
+	}
+}
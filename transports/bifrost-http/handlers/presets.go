@@ -0,0 +1,274 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/bytedance/sonic"
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/fasthttp/router"
+	"github.com/valyala/fasthttp"
+)
+
+// PresetsHandler manages named inference parameter presets
+type PresetsHandler struct {
+	client      *bifrost.Bifrost
+	configStore configstore.ConfigStore
+}
+
+// NewPresetsHandler creates a new PresetsHandler
+func NewPresetsHandler(client *bifrost.Bifrost, configStore configstore.ConfigStore) *PresetsHandler {
+	return &PresetsHandler{
+		client:      client,
+		configStore: configStore,
+	}
+}
+
+// UpsertPresetRequest is the request body for creating or updating a parameter preset
+type UpsertPresetRequest struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Aliases     []string               `json:"aliases"`
+	Params      map[string]interface{} `json:"params"`
+}
+
+// PresetResponse is a parameter preset as returned by the API
+type PresetResponse struct {
+	schemas.ParameterPreset
+	Builtin bool `json:"builtin"`
+}
+
+// RegisterRoutes registers the routes for the PresetsHandler
+func (h *PresetsHandler) RegisterRoutes(r *router.Router, middlewares ...schemas.BifrostHTTPMiddleware) {
+	r.GET("/api/presets", lib.ChainMiddlewares(h.getPresets, middlewares...))
+	r.GET("/api/presets/{name}", lib.ChainMiddlewares(h.getPreset, middlewares...))
+	r.POST("/api/presets", lib.ChainMiddlewares(h.createPreset, middlewares...))
+	r.PUT("/api/presets/{name}", lib.ChainMiddlewares(h.updatePreset, middlewares...))
+	r.DELETE("/api/presets/{name}", lib.ChainMiddlewares(h.deletePreset, middlewares...))
+}
+
+// LoadParameterPresets loads the stored parameter presets into the bifrost client
+func LoadParameterPresets(ctx context.Context, client *bifrost.Bifrost, configStore configstore.ConfigStore) error {
+	presets, err := storedParameterPresets(ctx, configStore)
+	if err != nil {
+		return err
+	}
+	return client.UpdateParameterPresets(presets)
+}
+
+// storedParameterPresets returns all presets in the config store in core form
+func storedParameterPresets(ctx context.Context, configStore configstore.ConfigStore) ([]schemas.ParameterPreset, error) {
+	rows, err := configStore.GetParameterPresets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	presets := make([]schemas.ParameterPreset, 0, len(rows))
+	for i := range rows {
+		presets = append(presets, rows[i].ToSchema())
+	}
+	return presets, nil
+}
+
+// getPresets lists built-in and stored parameter presets
+func (h *PresetsHandler) getPresets(ctx *fasthttp.RequestCtx) {
+	stored, err := storedParameterPresets(ctx, h.configStore)
+	if err != nil {
+		logger.Error("failed to get parameter presets: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to retrieve parameter presets")
+		return
+	}
+	overridden := make(map[string]bool, len(stored))
+	presets := make([]PresetResponse, 0, len(stored))
+	for _, preset := range stored {
+		overridden[preset.Name] = true
+		presets = append(presets, PresetResponse{ParameterPreset: preset})
+	}
+	for _, preset := range schemas.BuiltinParameterPresets() {
+		if !overridden[preset.Name] {
+			presets = append(presets, PresetResponse{ParameterPreset: preset, Builtin: true})
+		}
+	}
+	sort.Slice(presets, func(i, j int) bool { return presets[i].Name < presets[j].Name })
+	SendJSON(ctx, map[string]any{
+		"presets": presets,
+		"count":   len(presets),
+	})
+}
+
+// getPreset gets a parameter preset by name or alias
+func (h *PresetsHandler) getPreset(ctx *fasthttp.RequestCtx) {
+	name, ok := presetNameParam(ctx)
+	if !ok {
+		return
+	}
+	preset, found := h.client.GetParameterPreset(name)
+	if !found {
+		SendError(ctx, fasthttp.StatusNotFound, "Parameter preset not found")
+		return
+	}
+	_, err := h.configStore.GetParameterPreset(ctx, preset.Name)
+	if err != nil && !errors.Is(err, configstore.ErrNotFound) {
+		logger.Error("failed to get parameter preset: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to retrieve parameter preset")
+		return
+	}
+	SendJSON(ctx, PresetResponse{ParameterPreset: *preset, Builtin: err != nil})
+}
+
+// createPreset creates a new parameter preset
+func (h *PresetsHandler) createPreset(ctx *fasthttp.RequestCtx) {
+	var request UpsertPresetRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &request); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+	if _, err := h.configStore.GetParameterPreset(ctx, request.Name); err == nil {
+		SendError(ctx, fasthttp.StatusConflict, "Parameter preset already exists")
+		return
+	}
+	preset := &configstoreTables.TableParameterPreset{
+		Name:        request.Name,
+		Description: request.Description,
+		Aliases:     request.Aliases,
+		Params:      request.Params,
+	}
+	if !h.applyChange(ctx, preset, "") {
+		return
+	}
+	if err := h.configStore.CreateParameterPreset(ctx, preset); err != nil {
+		h.restorePresets(ctx)
+		logger.Error("failed to create parameter preset: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to create parameter preset")
+		return
+	}
+	ctx.SetStatusCode(fasthttp.StatusCreated)
+	SendJSON(ctx, map[string]any{
+		"message": "Parameter preset created successfully",
+		"preset":  preset,
+	})
+}
+
+// updatePreset updates (or creates) a stored parameter preset
+func (h *PresetsHandler) updatePreset(ctx *fasthttp.RequestCtx) {
+	name, ok := presetNameParam(ctx)
+	if !ok {
+		return
+	}
+	var request UpsertPresetRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &request); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+	existing, err := h.configStore.GetParameterPreset(ctx, name)
+	if err != nil && !errors.Is(err, configstore.ErrNotFound) {
+		logger.Error("failed to get parameter preset: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to update parameter preset")
+		return
+	}
+	preset := &configstoreTables.TableParameterPreset{
+		Name:        name,
+		Description: request.Description,
+		Aliases:     request.Aliases,
+		Params:      request.Params,
+	}
+	if existing != nil {
+		preset.CreatedAt = existing.CreatedAt
+	}
+	if !h.applyChange(ctx, preset, "") {
+		return
+	}
+	if err := h.configStore.UpdateParameterPreset(ctx, preset); err != nil {
+		h.restorePresets(ctx)
+		logger.Error("failed to update parameter preset: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to update parameter preset")
+		return
+	}
+	SendJSON(ctx, map[string]any{
+		"message": "Parameter preset updated successfully",
+		"preset":  preset,
+	})
+}
+
+// deletePreset deletes a stored parameter preset. Deleting an override restores the built-in preset.
+func (h *PresetsHandler) deletePreset(ctx *fasthttp.RequestCtx) {
+	name, ok := presetNameParam(ctx)
+	if !ok {
+		return
+	}
+	if _, err := h.configStore.GetParameterPreset(ctx, name); err != nil {
+		if errors.Is(err, configstore.ErrNotFound) {
+			SendError(ctx, fasthttp.StatusNotFound, "Parameter preset not found")
+			return
+		}
+		logger.Error("failed to get parameter preset: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to delete parameter preset")
+		return
+	}
+	if !h.applyChange(ctx, nil, name) {
+		return
+	}
+	if err := h.configStore.DeleteParameterPreset(ctx, name); err != nil {
+		h.restorePresets(ctx)
+		logger.Error("failed to delete parameter preset: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to delete parameter preset")
+		return
+	}
+	SendJSON(ctx, map[string]any{
+		"message": "Parameter preset deleted successfully",
+	})
+}
+
+// applyChange loads the stored presets, with upsert added and the preset named removed dropped, into
+// the client so conflicts are caught before anything is persisted. It writes the error response and
+// returns false if the change is rejected.
+func (h *PresetsHandler) applyChange(ctx *fasthttp.RequestCtx, upsert *configstoreTables.TableParameterPreset, removed string) bool {
+	presets, err := storedParameterPresets(ctx, h.configStore)
+	if err != nil {
+		logger.Error("failed to get parameter presets: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to retrieve parameter presets")
+		return false
+	}
+	next := make([]schemas.ParameterPreset, 0, len(presets)+1)
+	for _, preset := range presets {
+		if preset.Name == removed || (upsert != nil && preset.Name == upsert.Name) {
+			continue
+		}
+		next = append(next, preset)
+	}
+	if upsert != nil {
+		preset := upsert.ToSchema()
+		if err := preset.Validate(); err != nil {
+			SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+			return false
+		}
+		next = append(next, preset)
+	}
+	if err := h.client.UpdateParameterPresets(next); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+// restorePresets reloads the client presets from the config store after a failed write
+func (h *PresetsHandler) restorePresets(ctx *fasthttp.RequestCtx) {
+	if err := LoadParameterPresets(ctx, h.client, h.configStore); err != nil {
+		logger.Error("failed to restore parameter presets: %v", err)
+	}
+}
+
+// presetNameParam extracts the "name" path parameter, writing a 400 response if it is missing
+func presetNameParam(ctx *fasthttp.RequestCtx) (string, bool) {
+	name, ok := ctx.UserValue("name").(string)
+	if !ok || name == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "Missing required 'name' parameter")
+		return "", false
+	}
+	return name, true
+}
@@ -0,0 +1,306 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/capsohq/bifrost/framework/modelcatalog"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/fasthttp/router"
+	"github.com/google/uuid"
+	"github.com/valyala/fasthttp"
+)
+
+// PricingOverridesHandler manages custom pricing overrides layered over the pricing catalog
+type PricingOverridesHandler struct {
+	modelCatalog *modelcatalog.ModelCatalog
+	configStore  configstore.ConfigStore
+}
+
+// NewPricingOverridesHandler creates a new PricingOverridesHandler
+func NewPricingOverridesHandler(modelCatalog *modelcatalog.ModelCatalog, configStore configstore.ConfigStore) *PricingOverridesHandler {
+	return &PricingOverridesHandler{
+		modelCatalog: modelCatalog,
+		configStore:  configStore,
+	}
+}
+
+// UpsertPricingOverrideRequest is the request body for creating or updating a custom pricing override
+type UpsertPricingOverrideRequest struct {
+	Name        string                `json:"name"`
+	Description string                `json:"description"`
+	Provider    schemas.ModelProvider `json:"provider"`
+	schemas.ProviderPricingOverride
+}
+
+// ResolvedPricingResponse is the effective pricing of a model as returned by the API
+type ResolvedPricingResponse struct {
+	Provider    schemas.ModelProvider `json:"provider"`
+	Model       string                `json:"model"`
+	RequestType schemas.RequestType   `json:"request_type"`
+	modelcatalog.PricingResolution
+	Pricing *modelcatalog.PricingEntry `json:"pricing"`
+}
+
+// RegisterRoutes registers the routes for the PricingOverridesHandler
+func (h *PricingOverridesHandler) RegisterRoutes(r *router.Router, middlewares ...schemas.BifrostHTTPMiddleware) {
+	r.GET("/api/pricing/overrides", lib.ChainMiddlewares(h.getPricingOverrides, middlewares...))
+	r.GET("/api/pricing/overrides/{id}", lib.ChainMiddlewares(h.getPricingOverride, middlewares...))
+	r.POST("/api/pricing/overrides", lib.ChainMiddlewares(h.createPricingOverride, middlewares...))
+	r.PUT("/api/pricing/overrides/{id}", lib.ChainMiddlewares(h.updatePricingOverride, middlewares...))
+	r.DELETE("/api/pricing/overrides/{id}", lib.ChainMiddlewares(h.deletePricingOverride, middlewares...))
+	r.GET("/api/pricing/resolve", lib.ChainMiddlewares(h.resolvePricing, middlewares...))
+}
+
+// LoadPricingOverrides loads the stored custom pricing overrides into the model catalog
+func LoadPricingOverrides(ctx context.Context, modelCatalog *modelcatalog.ModelCatalog, configStore configstore.ConfigStore) error {
+	overrides, err := configStore.GetPricingOverrides(ctx)
+	if err != nil {
+		return err
+	}
+	return modelCatalog.SetCustomPricingOverrides(overrides)
+}
+
+// getPricingOverrides lists the custom pricing overrides
+func (h *PricingOverridesHandler) getPricingOverrides(ctx *fasthttp.RequestCtx) {
+	overrides, err := h.configStore.GetPricingOverrides(ctx)
+	if err != nil {
+		logger.Error("failed to get pricing overrides: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to retrieve pricing overrides")
+		return
+	}
+	if provider := string(ctx.QueryArgs().Peek("provider")); provider != "" {
+		filtered := make([]configstoreTables.TablePricingOverride, 0, len(overrides))
+		for _, override := range overrides {
+			if override.Provider == provider {
+				filtered = append(filtered, override)
+			}
+		}
+		overrides = filtered
+	}
+	SendJSON(ctx, map[string]any{
+		"overrides": overrides,
+		"count":     len(overrides),
+	})
+}
+
+// getPricingOverride gets a custom pricing override by ID
+func (h *PricingOverridesHandler) getPricingOverride(ctx *fasthttp.RequestCtx) {
+	id, ok := pricingOverrideIDParam(ctx)
+	if !ok {
+		return
+	}
+	override, err := h.configStore.GetPricingOverride(ctx, id)
+	if err != nil {
+		if errors.Is(err, configstore.ErrNotFound) {
+			SendError(ctx, fasthttp.StatusNotFound, "Pricing override not found")
+			return
+		}
+		logger.Error("failed to get pricing override: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to retrieve pricing override")
+		return
+	}
+	SendJSON(ctx, override)
+}
+
+// createPricingOverride creates a new custom pricing override
+func (h *PricingOverridesHandler) createPricingOverride(ctx *fasthttp.RequestCtx) {
+	var request UpsertPricingOverrideRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &request); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+	override := &configstoreTables.TablePricingOverride{
+		ID:                      uuid.NewString(),
+		Name:                    request.Name,
+		Description:             request.Description,
+		Provider:                string(request.Provider),
+		ProviderPricingOverride: request.ProviderPricingOverride,
+	}
+	if !h.applyChange(ctx, override, "") {
+		return
+	}
+	if err := h.configStore.CreatePricingOverride(ctx, override); err != nil {
+		h.restorePricingOverrides(ctx)
+		logger.Error("failed to create pricing override: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to create pricing override")
+		return
+	}
+	ctx.SetStatusCode(fasthttp.StatusCreated)
+	SendJSON(ctx, map[string]any{
+		"message":  "Pricing override created successfully",
+		"override": override,
+	})
+}
+
+// updatePricingOverride replaces an existing custom pricing override
+func (h *PricingOverridesHandler) updatePricingOverride(ctx *fasthttp.RequestCtx) {
+	id, ok := pricingOverrideIDParam(ctx)
+	if !ok {
+		return
+	}
+	var request UpsertPricingOverrideRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &request); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+	existing, err := h.configStore.GetPricingOverride(ctx, id)
+	if err != nil {
+		if errors.Is(err, configstore.ErrNotFound) {
+			SendError(ctx, fasthttp.StatusNotFound, "Pricing override not found")
+			return
+		}
+		logger.Error("failed to get pricing override: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to update pricing override")
+		return
+	}
+	override := &configstoreTables.TablePricingOverride{
+		ID:                      id,
+		Name:                    request.Name,
+		Description:             request.Description,
+		Provider:                string(request.Provider),
+		ProviderPricingOverride: request.ProviderPricingOverride,
+		CreatedAt:               existing.CreatedAt,
+	}
+	if !h.applyChange(ctx, override, "") {
+		return
+	}
+	if err := h.configStore.UpdatePricingOverride(ctx, override); err != nil {
+		h.restorePricingOverrides(ctx)
+		logger.Error("failed to update pricing override: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to update pricing override")
+		return
+	}
+	SendJSON(ctx, map[string]any{
+		"message":  "Pricing override updated successfully",
+		"override": override,
+	})
+}
+
+// deletePricingOverride deletes a custom pricing override, restoring the provider-level or catalog pricing it shadowed
+func (h *PricingOverridesHandler) deletePricingOverride(ctx *fasthttp.RequestCtx) {
+	id, ok := pricingOverrideIDParam(ctx)
+	if !ok {
+		return
+	}
+	if _, err := h.configStore.GetPricingOverride(ctx, id); err != nil {
+		if errors.Is(err, configstore.ErrNotFound) {
+			SendError(ctx, fasthttp.StatusNotFound, "Pricing override not found")
+			return
+		}
+		logger.Error("failed to get pricing override: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to delete pricing override")
+		return
+	}
+	if !h.applyChange(ctx, nil, id) {
+		return
+	}
+	if err := h.configStore.DeletePricingOverride(ctx, id); err != nil {
+		h.restorePricingOverrides(ctx)
+		logger.Error("failed to delete pricing override: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to delete pricing override")
+		return
+	}
+	SendJSON(ctx, map[string]any{
+		"message": "Pricing override deleted successfully",
+	})
+}
+
+// resolvePricing returns the effective pricing of a model and the layer it comes from
+func (h *PricingOverridesHandler) resolvePricing(ctx *fasthttp.RequestCtx) {
+	provider := schemas.ModelProvider(ctx.QueryArgs().Peek("provider"))
+	model := string(ctx.QueryArgs().Peek("model"))
+	if provider == "" || model == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "Missing required 'provider' and 'model' query parameters")
+		return
+	}
+	requestType := schemas.ChatCompletionRequest
+	if value := string(ctx.QueryArgs().Peek("request_type")); value != "" {
+		requestType = schemas.RequestType(value)
+	}
+	pricing, resolution, found := h.modelCatalog.ResolvePricing(provider, model, requestType)
+	if !found {
+		SendError(ctx, fasthttp.StatusNotFound, "No pricing found for model")
+		return
+	}
+	SendJSON(ctx, ResolvedPricingResponse{
+		Provider:          provider,
+		Model:             model,
+		RequestType:       requestType,
+		PricingResolution: resolution,
+		Pricing:           pricing,
+	})
+}
+
+// applyChange loads the stored overrides, with upsert added and the override with ID removed dropped,
+// into the model catalog so invalid overrides are caught before anything is persisted. It writes the
+// error response and returns false if the change is rejected.
+func (h *PricingOverridesHandler) applyChange(ctx *fasthttp.RequestCtx, upsert *configstoreTables.TablePricingOverride, removed string) bool {
+	if upsert != nil {
+		if err := validatePricingOverrideRequest(upsert); err != nil {
+			SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+			return false
+		}
+	}
+	overrides, err := h.configStore.GetPricingOverrides(ctx)
+	if err != nil {
+		logger.Error("failed to get pricing overrides: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to retrieve pricing overrides")
+		return false
+	}
+	next := make([]configstoreTables.TablePricingOverride, 0, len(overrides)+1)
+	replaced := false
+	for _, override := range overrides {
+		if override.ID == removed {
+			continue
+		}
+		if upsert != nil && override.ID == upsert.ID {
+			next = append(next, *upsert)
+			replaced = true
+			continue
+		}
+		next = append(next, override)
+	}
+	if upsert != nil && !replaced {
+		next = append(next, *upsert)
+	}
+	if err := h.modelCatalog.SetCustomPricingOverrides(next); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+// restorePricingOverrides reloads the model catalog overrides from the config store after a failed write
+func (h *PricingOverridesHandler) restorePricingOverrides(ctx *fasthttp.RequestCtx) {
+	if err := LoadPricingOverrides(ctx, h.modelCatalog, h.configStore); err != nil {
+		logger.Error("failed to restore pricing overrides: %v", err)
+	}
+}
+
+// validatePricingOverrideRequest validates the fields of a custom pricing override
+func validatePricingOverrideRequest(override *configstoreTables.TablePricingOverride) error {
+	if strings.TrimSpace(override.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if strings.TrimSpace(override.Provider) == "" {
+		return fmt.Errorf("provider is required")
+	}
+	return validatePricingOverrides([]schemas.ProviderPricingOverride{override.ProviderPricingOverride})
+}
+
+// pricingOverrideIDParam extracts the "id" path parameter, writing a 400 response if it is missing
+func pricingOverrideIDParam(ctx *fasthttp.RequestCtx) (string, bool) {
+	id, ok := ctx.UserValue("id").(string)
+	if !ok || id == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "Missing required 'id' parameter")
+		return "", false
+	}
+	return id, true
+}
@@ -70,6 +70,7 @@ type ProviderResponse struct {
 	CustomProviderConfig     *schemas.CustomProviderConfig     `json:"custom_provider_config,omitempty"` // Custom provider configuration
 	PricingOverrides         []schemas.ProviderPricingOverride `json:"pricing_overrides,omitempty"`      // Provider-level pricing overrides
 	ProviderStatus           ProviderStatus                    `json:"provider_status"`                  // Health/initialization status of the provider
+	Capabilities             []schemas.RequestType             `json:"capabilities,omitempty"`           // Request types the provider (or its base provider) supports
 	Status                   string                            `json:"status,omitempty"`                 // Operational status (e.g., list_models_failed)
 	Description              string                            `json:"description,omitempty"`            // Error/status description
 	ConfigHash               string                            `json:"config_hash,omitempty"`            // Hash of config.json version, used for change detection
@@ -87,19 +88,41 @@ type ErrorResponse struct {
 	Message string `json:"message,omitempty"`
 }
 
+// ProviderCapabilitiesResponse represents the capability matrix of all known providers
+type ProviderCapabilitiesResponse struct {
+	Providers map[schemas.ModelProvider][]schemas.RequestType `json:"providers"`
+}
+
 // RegisterRoutes registers all provider management routes
 func (h *ProviderHandler) RegisterRoutes(r *router.Router, middlewares ...schemas.BifrostHTTPMiddleware) {
 	// Provider CRUD operations
 	r.GET("/api/providers", lib.ChainMiddlewares(h.listProviders, middlewares...))
+	r.GET("/api/providers/capabilities", lib.ChainMiddlewares(h.listProviderCapabilities, middlewares...))
 	r.GET("/api/providers/{provider}", lib.ChainMiddlewares(h.getProvider, middlewares...))
 	r.POST("/api/providers", lib.ChainMiddlewares(h.addProvider, middlewares...))
 	r.PUT("/api/providers/{provider}", lib.ChainMiddlewares(h.updateProvider, middlewares...))
 	r.DELETE("/api/providers/{provider}", lib.ChainMiddlewares(h.deleteProvider, middlewares...))
+	r.POST("/api/providers/{provider}/test", lib.ChainMiddlewares(h.testProvider, middlewares...))
+	// Key CRUD operations
 	r.GET("/api/keys", lib.ChainMiddlewares(h.listKeys, middlewares...))
+	r.GET("/api/keys/{key_id}", lib.ChainMiddlewares(h.getKey, middlewares...))
+	r.POST("/api/providers/{provider}/keys", lib.ChainMiddlewares(h.addKey, middlewares...))
+	r.PUT("/api/providers/{provider}/keys/{key_id}", lib.ChainMiddlewares(h.updateKey, middlewares...))
+	r.DELETE("/api/providers/{provider}/keys/{key_id}", lib.ChainMiddlewares(h.deleteKey, middlewares...))
+	r.POST("/api/providers/{provider}/keys/{key_id}/test", lib.ChainMiddlewares(h.testKey, middlewares...))
 	r.GET("/api/models", lib.ChainMiddlewares(h.listModels, middlewares...))
 	r.GET("/api/models/base", lib.ChainMiddlewares(h.listBaseModels, middlewares...))
 }
 
+// listProviderCapabilities handles GET /api/providers/capabilities - List the request types each provider supports
+func (h *ProviderHandler) listProviderCapabilities(ctx *fasthttp.RequestCtx) {
+	response := ProviderCapabilitiesResponse{Providers: map[schemas.ModelProvider][]schemas.RequestType{}}
+	for provider, capabilities := range schemas.GetAllProviderCapabilities() {
+		response.Providers[provider] = capabilities.RequestTypes()
+	}
+	SendJSON(ctx, response)
+}
+
 // listProviders handles GET /api/providers - List all providers
 func (h *ProviderHandler) listProviders(ctx *fasthttp.RequestCtx) {
 	// Fetching providers from database
@@ -224,6 +247,10 @@ func (h *ProviderHandler) addProvider(ctx *fasthttp.RequestCtx) {
 			SendError(ctx, fasthttp.StatusBadRequest, "Concurrency must be less than or equal to buffer size")
 			return
 		}
+		if err := payload.ConcurrencyAndBufferSize.Scheduler.Validate(); err != nil {
+			SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+			return
+		}
 	}
 	if err := validatePricingOverrides(payload.PricingOverrides); err != nil {
 		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("invalid pricing overrides: %v", err))
@@ -430,6 +457,10 @@ func (h *ProviderHandler) updateProvider(ctx *fasthttp.RequestCtx) {
 		SendError(ctx, fasthttp.StatusBadRequest, "Concurrency must be less than or equal to buffer size")
 		return
 	}
+	if err := payload.ConcurrencyAndBufferSize.Scheduler.Validate(); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, err.Error())
+		return
+	}
 
 	// Build a prospective config with the requested CustomProviderConfig (including nil)
 	prospective := config
@@ -979,6 +1010,18 @@ func (h *ProviderHandler) mergeKeys(oldRawKeys []schemas.Key, oldRedactedKeys []
 				}
 			}
 
+			// Handle Hunyuan config redacted values
+			if updateKey.HunyuanKeyConfig != nil && oldRedactedKey.HunyuanKeyConfig != nil && oldRawKey.HunyuanKeyConfig != nil {
+				if updateKey.HunyuanKeyConfig.SecretID.IsRedacted() &&
+					updateKey.HunyuanKeyConfig.SecretID.Equals(&oldRedactedKey.HunyuanKeyConfig.SecretID) {
+					mergedKey.HunyuanKeyConfig.SecretID = oldRawKey.HunyuanKeyConfig.SecretID
+				}
+				if updateKey.HunyuanKeyConfig.SecretKey.IsRedacted() &&
+					updateKey.HunyuanKeyConfig.SecretKey.Equals(&oldRedactedKey.HunyuanKeyConfig.SecretKey) {
+					mergedKey.HunyuanKeyConfig.SecretKey = oldRawKey.HunyuanKeyConfig.SecretKey
+				}
+			}
+
 			// Preserve ConfigHash from old key (UI doesn't send it back)
 			mergedKey.ConfigHash = oldRawKey.ConfigHash
 
@@ -1041,12 +1084,26 @@ func (h *ProviderHandler) getProviderResponseFromConfig(provider schemas.ModelPr
 		CustomProviderConfig:     config.CustomProviderConfig,
 		PricingOverrides:         config.PricingOverrides,
 		ProviderStatus:           status,
+		Capabilities:             providerCapabilities(provider, config.CustomProviderConfig),
 		Status:                   config.Status,
 		Description:              config.Description,
 		ConfigHash:               config.ConfigHash,
 	}
 }
 
+// providerCapabilities returns the request types a provider supports; custom providers
+// inherit the capabilities of their base provider.
+func providerCapabilities(provider schemas.ModelProvider, customConfig *schemas.CustomProviderConfig) []schemas.RequestType {
+	if customConfig != nil && customConfig.BaseProviderType != "" {
+		provider = customConfig.BaseProviderType
+	}
+	capabilities, ok := schemas.GetProviderCapabilities(provider)
+	if !ok {
+		return nil
+	}
+	return capabilities.RequestTypes()
+}
+
 func validatePricingOverrides(overrides []schemas.ProviderPricingOverride) error {
 	for i, override := range overrides {
 		if strings.TrimSpace(override.ModelPattern) == "" {
@@ -1186,6 +1243,9 @@ func validateRetryBackoff(networkConfig *schemas.NetworkConfig) error {
 				return fmt.Errorf("retry backoff initial must be less than or equal to retry backoff max")
 			}
 		}
+		if err := networkConfig.RetryPolicy.Validate(); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/capsohq/bifrost/framework/configstore"
+	"github.com/capsohq/bifrost/plugins/governance"
+	"github.com/valyala/fasthttp"
+)
+
+// operatorWritablePrefixes are the management routes operators may modify, everything else is read-only for them
+var operatorWritablePrefixes = []string{
+	"/api/providers",
+	"/api/keys",
+	"/api/presets",
+	"/api/cache/",
+	"/api/oauth/",
+	"/api/pricing/force-sync",
+	"/api/webhooks/",
+}
+
+// isOperatorMCPToggle reports whether the route reconnects an existing MCP client. Operators may not
+// create, update or delete MCP clients, as a stdio client runs an arbitrary command on the host.
+func isOperatorMCPToggle(method, path string) bool {
+	return method == fasthttp.MethodPost && strings.HasPrefix(path, "/api/mcp/client/") && strings.HasSuffix(path, "/reconnect")
+}
+
+// errNoManagementCaller is returned when the request does not carry a JWT or virtual key credential
+var errNoManagementCaller = errors.New("no jwt or virtual key credential")
+
+// managementCaller is a caller of the management API authenticated by a JWT or a virtual key.
+type managementCaller struct {
+	principal string
+	role      configstore.Role // empty when the caller has no management access
+}
+
+// isReadMethod reports whether the method only reads state.
+func isReadMethod(method string) bool {
+	return method == fasthttp.MethodGet || method == fasthttp.MethodHead || method == fasthttp.MethodOptions
+}
+
+// roleAllows reports whether the role may call the management route.
+func roleAllows(role configstore.Role, method, path string) bool {
+	switch role {
+	case configstore.RoleAdmin:
+		return true
+	case configstore.RoleOperator:
+		if isReadMethod(method) {
+			return true
+		}
+		return isOperatorMCPToggle(method, path) || slices.IndexFunc(operatorWritablePrefixes, func(prefix string) bool {
+			return strings.HasPrefix(path, prefix)
+		}) != -1
+	case configstore.RoleViewer:
+		return isReadMethod(method)
+	}
+	return false
+}
+
+// role maps the roles of a JWT identity through the configured role mappings and returns the most privileged one.
+func (v *jwtValidator) role(identity *jwtIdentity) configstore.Role {
+	roles := make([]string, 0, len(identity.Roles))
+	for _, role := range identity.Roles {
+		if mapped, ok := v.config.RoleMappings[role]; ok {
+			role = mapped
+		}
+		roles = append(roles, role)
+	}
+	role, _ := configstore.HighestRole(roles)
+	return role
+}
+
+// resolveManagementCaller authenticates a management API request carrying a virtual key or a JWT as bearer token.
+// It returns errNoManagementCaller for other credentials, which are handled by the session and admin credential flows.
+func (m *AuthMiddleware) resolveManagementCaller(ctx *fasthttp.RequestCtx) (*managementCaller, error) {
+	scheme, token, ok := strings.Cut(string(ctx.Request.Header.Peek("Authorization")), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, errNoManagementCaller
+	}
+	token = strings.TrimSpace(token)
+	if strings.HasPrefix(strings.ToLower(token), governance.VirtualKeyPrefix) {
+		if m.store == nil {
+			return nil, errNoManagementCaller
+		}
+		vk, err := m.store.GetVirtualKeyByValue(ctx, token)
+		if err != nil || vk == nil {
+			return nil, fmt.Errorf("virtual key not found")
+		}
+		if !vk.IsActive {
+			return nil, fmt.Errorf("virtual key %s is inactive", vk.Name)
+		}
+		caller := &managementCaller{principal: "virtual_key:" + vk.Name}
+		if vk.Role != nil {
+			caller.role = configstore.Role(*vk.Role)
+		}
+		return caller, nil
+	}
+	validator := m.jwtValidator.Load()
+	if validator == nil {
+		return nil, errNoManagementCaller
+	}
+	if _, ok := bearerJWT(ctx); !ok {
+		return nil, errNoManagementCaller
+	}
+	identity, err := validator.validate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return &managementCaller{
+		principal: "user:" + identity.UserID,
+		role:      validator.role(identity),
+	}, nil
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/valyala/fasthttp"
+)
+
+// TestRoleAllows tests the management routes each role may call
+func TestRoleAllows(t *testing.T) {
+	tests := []struct {
+		role    configstore.Role
+		method  string
+		path    string
+		allowed bool
+	}{
+		{configstore.RoleViewer, fasthttp.MethodGet, "/api/config", true},
+		{configstore.RoleViewer, fasthttp.MethodPut, "/api/providers/openai", false},
+		{configstore.RoleOperator, fasthttp.MethodPut, "/api/providers/openai", true},
+		{configstore.RoleOperator, fasthttp.MethodPost, "/api/mcp/client", false},
+		{configstore.RoleOperator, fasthttp.MethodPut, "/api/mcp/client/mcp-1", false},
+		{configstore.RoleOperator, fasthttp.MethodPost, "/api/mcp/client/mcp-1/reconnect", true},
+		{configstore.RoleOperator, fasthttp.MethodPost, "/api/plugins", false},
+		{configstore.RoleOperator, fasthttp.MethodPut, "/api/config", false},
+		{configstore.RoleOperator, fasthttp.MethodPost, "/api/governance/virtual-keys", false},
+		{configstore.RoleAdmin, fasthttp.MethodPut, "/api/config", true},
+		{"", fasthttp.MethodGet, "/api/config", false},
+	}
+	for _, tt := range tests {
+		if got := roleAllows(tt.role, tt.method, tt.path); got != tt.allowed {
+			t.Errorf("roleAllows(%q, %s, %s) = %v, want %v", tt.role, tt.method, tt.path, got, tt.allowed)
+		}
+	}
+}
+
+// TestAuthMiddleware_APIRoles tests that JWT callers of the management API are authorized by their mapped role
+func TestAuthMiddleware_APIRoles(t *testing.T) {
+	SetLogger(&mockLogger{})
+
+	am := newJWTAuthMiddleware(&configstore.JWTAuthConfig{
+		Enabled:      true,
+		HMACSecret:   schemas.NewEnvVar(testJWTSecret),
+		RolesClaim:   "groups",
+		RoleMappings: map[string]string{"platform-team": "operator"},
+	})
+	tokenWithGroups := func(groups ...string) string {
+		return signHMACToken(t, jwt.MapClaims{
+			"sub":    "user-1",
+			"exp":    time.Now().Add(time.Hour).Unix(),
+			"groups": groups,
+		})
+	}
+
+	tests := map[string]struct {
+		token      string
+		method     string
+		path       string
+		wantStatus int
+	}{
+		"viewer reads config":         {tokenWithGroups("viewer"), fasthttp.MethodGet, "/api/config", fasthttp.StatusOK},
+		"viewer updates provider":     {tokenWithGroups("viewer"), fasthttp.MethodPut, "/api/providers/openai", fasthttp.StatusForbidden},
+		"mapped operator updates key": {tokenWithGroups("engineering", "platform-team"), fasthttp.MethodPut, "/api/providers/openai", fasthttp.StatusOK},
+		"operator updates config":     {tokenWithGroups("platform-team"), fasthttp.MethodPut, "/api/config", fasthttp.StatusForbidden},
+		"operator adds mcp client":    {tokenWithGroups("platform-team"), fasthttp.MethodPost, "/api/mcp/client", fasthttp.StatusForbidden},
+		"operator adds plugin":        {tokenWithGroups("platform-team"), fasthttp.MethodPost, "/api/plugins", fasthttp.StatusForbidden},
+		"admin updates config":        {tokenWithGroups("viewer", "admin"), fasthttp.MethodPut, "/api/config", fasthttp.StatusOK},
+		"no role":                     {tokenWithGroups("engineering"), fasthttp.MethodGet, "/api/config", fasthttp.StatusForbidden},
+		"invalid token":               {tokenWithGroups("admin") + "x", fasthttp.MethodGet, "/api/config", fasthttp.StatusUnauthorized},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := &fasthttp.RequestCtx{}
+			ctx.Request.Header.SetMethod(tt.method)
+			ctx.Request.SetRequestURI(tt.path)
+			ctx.Request.Header.Set("Authorization", "Bearer "+tt.token)
+
+			am.APIMiddleware()(func(ctx *fasthttp.RequestCtx) {
+				ctx.SetStatusCode(fasthttp.StatusOK)
+			})(ctx)
+
+			if ctx.Response.StatusCode() != tt.wantStatus {
+				t.Errorf("Expected status code %d, got %d", tt.wantStatus, ctx.Response.StatusCode())
+			}
+		})
+	}
+}
@@ -0,0 +1,134 @@
+// Package handlers provides HTTP request handlers for the Bifrost HTTP transport.
+// This file contains the WebSocket handler for realtime sessions.
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/fasthttp/websocket"
+	"github.com/valyala/fasthttp"
+)
+
+// realtimeForwardedHeaders are the client headers sent on to the provider when a session is opened
+var realtimeForwardedHeaders = []string{"OpenAI-Beta"}
+
+// realtimeReadLimit is the largest message accepted from a realtime client (audio chunks are base64 encoded)
+const realtimeReadLimit = 16 * 1024 * 1024
+
+// realtime handles GET /v1/realtime - Upgrades the connection to WebSocket and relays it to a
+// realtime session of the provider until either side closes the connection
+func (h *CompletionHandler) realtime(ctx *fasthttp.RequestCtx) {
+	if !websocket.FastHTTPIsWebSocketUpgrade(ctx) {
+		SendError(ctx, fasthttp.StatusBadRequest, "realtime sessions require a WebSocket connection")
+		return
+	}
+
+	provider, modelName := schemas.ParseModelString(string(ctx.QueryArgs().Peek("model")), "")
+	if provider == "" || modelName == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "model query parameter should be in provider/model format")
+		return
+	}
+
+	headers := make(map[string]string)
+	for _, name := range realtimeForwardedHeaders {
+		if value := ctx.Request.Header.Peek(name); len(value) > 0 {
+			headers[name] = string(value)
+		}
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	if bifrostCtx == nil {
+		cancel()
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	// The session outlives this handler, so the trace is completed once it has ended
+	ctx.SetUserValue(schemas.BifrostContextKeyDeferTraceCompletion, true)
+	traceCompleter, _ := ctx.UserValue(schemas.BifrostContextKeyTraceCompleter).(func())
+	done := func() {
+		cancel()
+		if traceCompleter != nil {
+			traceCompleter()
+		}
+	}
+
+	upgrader := websocket.FastHTTPUpgrader{
+		ReadBufferSize:  4096,
+		WriteBufferSize: 4096,
+		CheckOrigin: func(ctx *fasthttp.RequestCtx) bool {
+			// Non-browser clients do not send an Origin header and authenticate like any other request
+			origin := string(ctx.Request.Header.Peek("Origin"))
+			return origin == "" || IsOriginAllowed(origin, h.config.ClientConfig.AllowedOrigins)
+		},
+	}
+
+	// The request context must not be used in the callback, which runs after this handler has returned
+	err := upgrader.Upgrade(ctx, func(conn *websocket.Conn) {
+		defer done()
+		conn.SetReadLimit(realtimeReadLimit)
+
+		_, bifrostErr := h.client.RealtimeRequest(bifrostCtx, &schemas.BifrostRealtimeRequest{
+			Provider: schemas.ModelProvider(provider),
+			Model:    modelName,
+			Headers:  headers,
+			Client:   conn,
+			Dial:     dialRealtime,
+		})
+		if bifrostErr != nil {
+			sendRealtimeError(conn, bifrostErr)
+			conn.Close()
+		}
+	})
+	if err != nil {
+		// The upgrader has already written the error response
+		logger.Debug("realtime websocket upgrade failed: %v", err)
+		done()
+	}
+}
+
+// dialRealtime opens the WebSocket connection to a provider's realtime endpoint
+func dialRealtime(ctx context.Context, url string, headers map[string]string) (schemas.RealtimeConn, error) {
+	header := make(http.Header, len(headers))
+	for name, value := range headers {
+		header.Set(name, value)
+	}
+	conn, resp, err := websocket.DefaultDialer.DialContext(ctx, url, header)
+	if err != nil {
+		if resp != nil {
+			return nil, fmt.Errorf("%w (status %d)", err, resp.StatusCode)
+		}
+		return nil, err
+	}
+	conn.SetReadLimit(realtimeReadLimit)
+	return conn, nil
+}
+
+// sendRealtimeError sends an error event in the OpenAI Realtime API format before the connection
+// is closed, so that clients can tell why the session did not start
+func sendRealtimeError(conn *websocket.Conn, bifrostErr *schemas.BifrostError) {
+	errorType := "server_error"
+	if bifrostErr.Error != nil && bifrostErr.Error.Type != nil {
+		errorType = *bifrostErr.Error.Type
+	} else if bifrostErr.StatusCode != nil && *bifrostErr.StatusCode < fasthttp.StatusInternalServerError {
+		errorType = "invalid_request_error"
+	}
+	message := "realtime session failed"
+	if bifrostErr.Error != nil && bifrostErr.Error.Message != "" {
+		message = bifrostErr.Error.Message
+	}
+	event := map[string]interface{}{
+		"type": "error",
+		"error": map[string]interface{}{
+			"type":    errorType,
+			"message": message,
+		},
+	}
+	if data, err := schemas.Marshal(event); err == nil {
+		conn.WriteMessage(websocket.TextMessage, data)
+	}
+}
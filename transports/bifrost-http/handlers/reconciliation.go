@@ -0,0 +1,52 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/reconciliation"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/fasthttp/router"
+	"github.com/valyala/fasthttp"
+)
+
+// UsageReconciliationHandler serves the provider usage reconciliation report.
+type UsageReconciliationHandler struct {
+	reconciler *reconciliation.Reconciler
+}
+
+// NewUsageReconciliationHandler creates a new usage reconciliation handler instance.
+func NewUsageReconciliationHandler(reconciler *reconciliation.Reconciler) *UsageReconciliationHandler {
+	return &UsageReconciliationHandler{
+		reconciler: reconciler,
+	}
+}
+
+// RegisterRoutes registers the usage reconciliation routes.
+func (h *UsageReconciliationHandler) RegisterRoutes(r *router.Router, middlewares ...schemas.BifrostHTTPMiddleware) {
+	r.GET("/api/usage/reconciliation", lib.ChainMiddlewares(h.getReport, middlewares...))
+	r.POST("/api/usage/reconciliation/run", lib.ChainMiddlewares(h.runReconciliation, middlewares...))
+}
+
+// getReport handles GET /api/usage/reconciliation - Get the report of the latest reconciliation run.
+func (h *UsageReconciliationHandler) getReport(ctx *fasthttp.RequestCtx) {
+	report := h.reconciler.LatestReport()
+	if report == nil {
+		SendError(ctx, fasthttp.StatusNotFound, "No usage reconciliation report available yet")
+		return
+	}
+	SendJSON(ctx, report)
+}
+
+// runReconciliation handles POST /api/usage/reconciliation/run - Run a reconciliation now and return its report.
+func (h *UsageReconciliationHandler) runReconciliation(ctx *fasthttp.RequestCtx) {
+	runCtx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+	report, err := h.reconciler.Run(runCtx)
+	if err != nil {
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to run usage reconciliation: "+err.Error())
+		return
+	}
+	SendJSON(ctx, report)
+}
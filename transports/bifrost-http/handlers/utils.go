@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
+	bifrost "github.com/capsohq/bifrost/core"
 	"github.com/capsohq/bifrost/core/schemas"
 	"github.com/valyala/fasthttp"
 )
@@ -59,6 +61,9 @@ func SendBifrostError(ctx *fasthttp.RequestCtx, bifrostErr *schemas.BifrostError
 	} else {
 		ctx.SetStatusCode(fasthttp.StatusInternalServerError)
 	}
+	if bifrostErr.RetryAfter != nil {
+		ctx.Response.Header.Set("Retry-After", strconv.Itoa(*bifrostErr.RetryAfter))
+	}
 
 	ctx.SetContentType("application/json")
 	if encodeErr := json.NewEncoder(ctx).Encode(bifrostErr); encodeErr != nil {
@@ -187,3 +192,22 @@ func fuzzyMatch(text, query string) bool {
 
 	return queryIndex == len(queryRunes)
 }
+
+// parseCursorPagination reads the limit and after query parameters of a cursor paginated list request
+func parseCursorPagination(ctx *fasthttp.RequestCtx) (int, *string) {
+	limit := 0
+	if limitStr := ctx.QueryArgs().Peek("limit"); len(limitStr) > 0 {
+		if n, err := strconv.Atoi(string(limitStr)); err == nil && n > 0 {
+			limit = n
+		}
+	}
+	return limit, optionalQueryArg(ctx, "after")
+}
+
+// optionalQueryArg returns the value of a query parameter, or nil if it is not set
+func optionalQueryArg(ctx *fasthttp.RequestCtx, name string) *string {
+	if value := ctx.QueryArgs().Peek(name); len(value) > 0 {
+		return bifrost.Ptr(string(value))
+	}
+	return nil
+}
@@ -0,0 +1,302 @@
+// Package handlers provides HTTP request handlers for the Bifrost HTTP transport.
+// This file contains the vector stores API handlers.
+package handlers
+
+import (
+	"fmt"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/valyala/fasthttp"
+)
+
+// VectorStoreCreateRequest is a bifrost vector store create request
+type VectorStoreCreateRequest struct {
+	Provider         string                               `json:"provider"` // Provider name
+	Name             *string                              `json:"name,omitempty"`
+	Description      *string                              `json:"description,omitempty"`
+	FileIDs          []string                             `json:"file_ids,omitempty"` // IDs of uploaded files to add to the store
+	ExpiresAfter     *schemas.VectorStoreExpiresAfter     `json:"expires_after,omitempty"`
+	ChunkingStrategy *schemas.VectorStoreChunkingStrategy `json:"chunking_strategy,omitempty"`
+	Metadata         map[string]string                    `json:"metadata,omitempty"`
+}
+
+var vectorStoreCreateParamsKnownFields = map[string]bool{
+	"provider":          true,
+	"name":              true,
+	"description":       true,
+	"file_ids":          true,
+	"expires_after":     true,
+	"chunking_strategy": true,
+	"metadata":          true,
+}
+
+// VectorStoreSearchRequest is a bifrost vector store search request
+type VectorStoreSearchRequest struct {
+	Query          interface{}            `json:"query"` // A string or an array of strings
+	MaxNumResults  *int                   `json:"max_num_results,omitempty"`
+	RewriteQuery   *bool                  `json:"rewrite_query,omitempty"`
+	Filters        map[string]interface{} `json:"filters,omitempty"`
+	RankingOptions map[string]interface{} `json:"ranking_options,omitempty"`
+}
+
+var vectorStoreSearchParamsKnownFields = map[string]bool{
+	"query":           true,
+	"max_num_results": true,
+	"rewrite_query":   true,
+	"filters":         true,
+	"ranking_options": true,
+}
+
+// VectorStoreFileCreateRequest is a bifrost vector store file create request
+type VectorStoreFileCreateRequest struct {
+	FileID           string                               `json:"file_id"` // ID of an uploaded file
+	Attributes       map[string]interface{}               `json:"attributes,omitempty"`
+	ChunkingStrategy *schemas.VectorStoreChunkingStrategy `json:"chunking_strategy,omitempty"`
+}
+
+var vectorStoreFileCreateParamsKnownFields = map[string]bool{
+	"file_id":           true,
+	"attributes":        true,
+	"chunking_strategy": true,
+}
+
+// vectorStoreCreate handles POST /v1/vector_stores - Create a vector store
+func (h *CompletionHandler) vectorStoreCreate(ctx *fasthttp.RequestCtx) {
+	var req VectorStoreCreateRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+
+	if req.Provider == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "provider is required")
+		return
+	}
+
+	// Extract extra params
+	extraParams, err := extractExtraParams(ctx.PostBody(), vectorStoreCreateParamsKnownFields)
+	if err != nil {
+		logger.Warn("Failed to extract extra params: %v", err)
+	}
+
+	bifrostVectorStoreReq := &schemas.BifrostVectorStoreCreateRequest{
+		Provider:         schemas.ModelProvider(req.Provider),
+		Name:             req.Name,
+		Description:      req.Description,
+		FileIDs:          req.FileIDs,
+		ExpiresAfter:     req.ExpiresAfter,
+		ChunkingStrategy: req.ChunkingStrategy,
+		Metadata:         req.Metadata,
+		ExtraParams:      extraParams,
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.VectorStoreCreateRequest(bifrostCtx, bifrostVectorStoreReq)
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// vectorStoreList handles GET /v1/vector_stores - List vector stores
+func (h *CompletionHandler) vectorStoreList(ctx *fasthttp.RequestCtx) {
+	provider := string(ctx.QueryArgs().Peek("provider"))
+	if provider == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "provider query parameter is required")
+		return
+	}
+
+	limit, after := parseCursorPagination(ctx)
+	bifrostVectorStoreReq := &schemas.BifrostVectorStoreListRequest{
+		Provider: schemas.ModelProvider(provider),
+		Limit:    limit,
+		After:    after,
+		Order:    optionalQueryArg(ctx, "order"),
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.VectorStoreListRequest(bifrostCtx, bifrostVectorStoreReq)
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// vectorStoreSearch handles POST /v1/vector_stores/{vector_store_id}/search - Search a vector store
+func (h *CompletionHandler) vectorStoreSearch(ctx *fasthttp.RequestCtx) {
+	provider, vectorStoreID, ok := parseVectorStoreParams(ctx)
+	if !ok {
+		return
+	}
+
+	var req VectorStoreSearchRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+	if req.Query == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "query is required")
+		return
+	}
+
+	// Extract extra params
+	extraParams, err := extractExtraParams(ctx.PostBody(), vectorStoreSearchParamsKnownFields)
+	if err != nil {
+		logger.Warn("Failed to extract extra params: %v", err)
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.VectorStoreSearchRequest(bifrostCtx, &schemas.BifrostVectorStoreSearchRequest{
+		Provider:       provider,
+		VectorStoreID:  vectorStoreID,
+		Query:          req.Query,
+		MaxNumResults:  req.MaxNumResults,
+		RewriteQuery:   req.RewriteQuery,
+		Filters:        req.Filters,
+		RankingOptions: req.RankingOptions,
+		ExtraParams:    extraParams,
+	})
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// vectorStoreFileCreate handles POST /v1/vector_stores/{vector_store_id}/files - Add a file to a vector store
+func (h *CompletionHandler) vectorStoreFileCreate(ctx *fasthttp.RequestCtx) {
+	provider, vectorStoreID, ok := parseVectorStoreParams(ctx)
+	if !ok {
+		return
+	}
+
+	var req VectorStoreFileCreateRequest
+	if err := sonic.Unmarshal(ctx.PostBody(), &req); err != nil {
+		SendError(ctx, fasthttp.StatusBadRequest, fmt.Sprintf("Invalid request format: %v", err))
+		return
+	}
+	if req.FileID == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "file_id is required")
+		return
+	}
+
+	// Extract extra params
+	extraParams, err := extractExtraParams(ctx.PostBody(), vectorStoreFileCreateParamsKnownFields)
+	if err != nil {
+		logger.Warn("Failed to extract extra params: %v", err)
+	}
+
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.VectorStoreFileCreateRequest(bifrostCtx, &schemas.BifrostVectorStoreFileCreateRequest{
+		Provider:         provider,
+		VectorStoreID:    vectorStoreID,
+		FileID:           req.FileID,
+		Attributes:       req.Attributes,
+		ChunkingStrategy: req.ChunkingStrategy,
+		ExtraParams:      extraParams,
+	})
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// vectorStoreFileList handles GET /v1/vector_stores/{vector_store_id}/files - List the files of a vector store
+func (h *CompletionHandler) vectorStoreFileList(ctx *fasthttp.RequestCtx) {
+	provider, vectorStoreID, ok := parseVectorStoreParams(ctx)
+	if !ok {
+		return
+	}
+
+	limit, after := parseCursorPagination(ctx)
+	bifrostCtx, cancel := lib.ConvertToBifrostContext(ctx, h.handlerStore.ShouldAllowDirectKeys(), h.config.GetHeaderFilterConfig())
+	defer cancel()
+	if bifrostCtx == nil {
+		SendError(ctx, fasthttp.StatusBadRequest, "Failed to convert context")
+		return
+	}
+
+	resp, bifrostErr := h.client.VectorStoreFileListRequest(bifrostCtx, &schemas.BifrostVectorStoreFileListRequest{
+		Provider:      provider,
+		VectorStoreID: vectorStoreID,
+		Limit:         limit,
+		After:         after,
+		Order:         optionalQueryArg(ctx, "order"),
+		Filter:        optionalQueryArg(ctx, "filter"),
+	})
+	if bifrostErr != nil {
+		forwardProviderHeadersFromContext(ctx, bifrostCtx)
+		SendBifrostError(ctx, bifrostErr)
+		return
+	}
+
+	if resp != nil && resp.ExtraFields.ProviderResponseHeaders != nil {
+		forwardProviderHeaders(ctx, resp.ExtraFields.ProviderResponseHeaders)
+	}
+	h.sendResponse(ctx, bifrostCtx, resp)
+}
+
+// parseVectorStoreParams reads the vector store ID from the URL and the provider from the query
+// parameters, sending a bad request error if either is missing
+func parseVectorStoreParams(ctx *fasthttp.RequestCtx) (schemas.ModelProvider, string, bool) {
+	vectorStoreID, ok := ctx.UserValue("vector_store_id").(string)
+	if !ok || vectorStoreID == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "vector_store_id is required")
+		return "", "", false
+	}
+	provider := string(ctx.QueryArgs().Peek("provider"))
+	if provider == "" {
+		SendError(ctx, fasthttp.StatusBadRequest, "provider query parameter is required")
+		return "", "", false
+	}
+	return schemas.ModelProvider(provider), vectorStoreID, true
+}
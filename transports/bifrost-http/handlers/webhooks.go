@@ -0,0 +1,113 @@
+package handlers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	configstoreTables "github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/capsohq/bifrost/framework/webhooks"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	"github.com/fasthttp/router"
+	"github.com/valyala/fasthttp"
+)
+
+// WebhooksHandler serves the webhook events that could not be delivered.
+type WebhooksHandler struct {
+	dispatcher  *webhooks.Dispatcher
+	configStore configstore.ConfigStore
+}
+
+// NewWebhooksHandler creates a new webhooks handler instance.
+func NewWebhooksHandler(dispatcher *webhooks.Dispatcher, configStore configstore.ConfigStore) *WebhooksHandler {
+	return &WebhooksHandler{
+		dispatcher:  dispatcher,
+		configStore: configStore,
+	}
+}
+
+// RegisterRoutes registers the webhook dead letter routes.
+func (h *WebhooksHandler) RegisterRoutes(r *router.Router, middlewares ...schemas.BifrostHTTPMiddleware) {
+	r.GET("/api/webhooks/dead-letters", lib.ChainMiddlewares(h.getDeadLetters, middlewares...))
+	r.POST("/api/webhooks/dead-letters/{id}/redeliver", lib.ChainMiddlewares(h.redeliverDeadLetter, middlewares...))
+	r.DELETE("/api/webhooks/dead-letters/{id}", lib.ChainMiddlewares(h.deleteDeadLetter, middlewares...))
+}
+
+// getDeadLetters handles GET /api/webhooks/dead-letters - Get the undelivered webhook events with pagination
+func (h *WebhooksHandler) getDeadLetters(ctx *fasthttp.RequestCtx) {
+	limit := 50 // Default limit
+	if limitArg := string(ctx.QueryArgs().Peek("limit")); limitArg != "" {
+		if i, err := strconv.Atoi(limitArg); err == nil {
+			if i <= 0 {
+				SendError(ctx, fasthttp.StatusBadRequest, "limit must be greater than 0")
+				return
+			}
+			if i > 1000 {
+				SendError(ctx, fasthttp.StatusBadRequest, "limit cannot exceed 1000")
+				return
+			}
+			limit = i
+		}
+	}
+	offset := 0 // Default offset
+	if offsetArg := string(ctx.QueryArgs().Peek("offset")); offsetArg != "" {
+		if i, err := strconv.Atoi(offsetArg); err == nil {
+			if i < 0 {
+				SendError(ctx, fasthttp.StatusBadRequest, "offset cannot be negative")
+				return
+			}
+			offset = i
+		}
+	}
+
+	deadLetters, total, err := h.configStore.GetWebhookDeadLetters(ctx, limit, offset)
+	if err != nil {
+		logger.Error("failed to get webhook dead letters: %v", err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to get webhook dead letters")
+		return
+	}
+	if deadLetters == nil {
+		deadLetters = []configstoreTables.TableWebhookDeadLetter{}
+	}
+	SendJSON(ctx, map[string]any{
+		"dead_letters": deadLetters,
+		"pagination": map[string]any{
+			"limit":       limit,
+			"offset":      offset,
+			"total_count": total,
+		},
+	})
+}
+
+// redeliverDeadLetter handles POST /api/webhooks/dead-letters/{id}/redeliver - Send an undelivered webhook event again
+func (h *WebhooksHandler) redeliverDeadLetter(ctx *fasthttp.RequestCtx) {
+	id, _ := ctx.UserValue("id").(string)
+	if err := h.dispatcher.Redeliver(ctx, id); err != nil {
+		switch {
+		case errors.Is(err, configstore.ErrNotFound):
+			SendError(ctx, fasthttp.StatusNotFound, "Webhook dead letter not found")
+		case errors.Is(err, webhooks.ErrEndpointNotFound):
+			SendError(ctx, fasthttp.StatusConflict, "The webhook endpoint of this event is no longer configured")
+		default:
+			SendError(ctx, fasthttp.StatusBadGateway, "Failed to redeliver webhook event: "+err.Error())
+		}
+		return
+	}
+	SendJSON(ctx, map[string]string{"status": "success", "message": "Webhook event redelivered"})
+}
+
+// deleteDeadLetter handles DELETE /api/webhooks/dead-letters/{id} - Discard an undelivered webhook event
+func (h *WebhooksHandler) deleteDeadLetter(ctx *fasthttp.RequestCtx) {
+	id, _ := ctx.UserValue("id").(string)
+	if err := h.configStore.DeleteWebhookDeadLetter(ctx, id); err != nil {
+		if errors.Is(err, configstore.ErrNotFound) {
+			SendError(ctx, fasthttp.StatusNotFound, "Webhook dead letter not found")
+			return
+		}
+		logger.Error("failed to delete webhook dead letter %s: %v", id, err)
+		SendError(ctx, fasthttp.StatusInternalServerError, "Failed to delete webhook dead letter")
+		return
+	}
+	SendJSON(ctx, map[string]string{"status": "success", "message": "Webhook dead letter deleted"})
+}
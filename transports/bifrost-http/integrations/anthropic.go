@@ -58,99 +58,100 @@ func createAnthropicCompleteRouteConfig(pathPrefix string) RouteConfig {
 
 // createAnthropicMessagesRouteConfig creates a route configuration for the `/v1/messages` endpoint.
 func createAnthropicMessagesRouteConfig(pathPrefix string, logger schemas.Logger) []RouteConfig {
-	var routes []RouteConfig
-	for _, path := range []string{
-		"/v1/messages",
-		"/v1/messages/{path:*}",
-	} {
-		routes = append(routes, RouteConfig{
-			Type:   RouteConfigTypeAnthropic,
-			Path:   pathPrefix + path,
-			Method: "POST",
-			GetHTTPRequestType: func(ctx *fasthttp.RequestCtx) schemas.RequestType {
-				return schemas.ResponsesRequest
-			},
-			GetRequestTypeInstance: func(ctx context.Context) interface{} {
-				return &anthropic.AnthropicMessageRequest{}
-			},
-			RequestConverter: func(ctx *schemas.BifrostContext, req interface{}) (*schemas.BifrostRequest, error) {
-				if anthropicReq, ok := req.(*anthropic.AnthropicMessageRequest); ok {
-					return &schemas.BifrostRequest{
-						ResponsesRequest: anthropicReq.ToBifrostResponsesRequest(ctx),
-					}, nil
+	return []RouteConfig{
+		createAnthropicMessagesRoute(pathPrefix+"/v1/messages", logger),
+		createAnthropicMessagesRoute(pathPrefix+"/v1/messages/{path:*}", logger),
+	}
+}
+
+// createAnthropicMessagesRoute creates the route configuration of a Messages API path.
+func createAnthropicMessagesRoute(path string, logger schemas.Logger) RouteConfig {
+	return RouteConfig{
+		Type:   RouteConfigTypeAnthropic,
+		Path:   path,
+		Method: "POST",
+		GetHTTPRequestType: func(ctx *fasthttp.RequestCtx) schemas.RequestType {
+			return schemas.ResponsesRequest
+		},
+		GetRequestTypeInstance: func(ctx context.Context) interface{} {
+			return &anthropic.AnthropicMessageRequest{}
+		},
+		RequestConverter: func(ctx *schemas.BifrostContext, req interface{}) (*schemas.BifrostRequest, error) {
+			if anthropicReq, ok := req.(*anthropic.AnthropicMessageRequest); ok {
+				return &schemas.BifrostRequest{
+					ResponsesRequest: anthropicReq.ToBifrostResponsesRequest(ctx),
+				}, nil
+			}
+			return nil, errors.New("invalid request type")
+		},
+		ResponsesResponseConverter: func(ctx *schemas.BifrostContext, resp *schemas.BifrostResponsesResponse) (interface{}, error) {
+			if isClaudeModel(resp.ExtraFields.ModelRequested, resp.ExtraFields.ModelDeployment, string(resp.ExtraFields.Provider)) {
+				if resp.ExtraFields.RawResponse != nil {
+					return resp.ExtraFields.RawResponse, nil
 				}
-				return nil, errors.New("invalid request type")
-			},
-			ResponsesResponseConverter: func(ctx *schemas.BifrostContext, resp *schemas.BifrostResponsesResponse) (interface{}, error) {
-				if isClaudeModel(resp.ExtraFields.ModelRequested, resp.ExtraFields.ModelDeployment, string(resp.ExtraFields.Provider)) {
+			}
+			return anthropic.ToAnthropicResponsesResponse(ctx, resp), nil
+		},
+		AsyncResponsesResponseConverter: func(ctx *schemas.BifrostContext, resp *schemas.AsyncJobResponse, responsesResponseConverter ResponsesResponseConverter) (interface{}, map[string]string, error) {
+			if resp.Status == schemas.AsyncJobStatusCompleted {
+				responsesResp, ok := resp.Result.(*schemas.BifrostResponsesResponse)
+				if !ok {
+					return nil, nil, errors.New("invalid responses response type")
+				}
+				response, err := responsesResponseConverter(ctx, responsesResp)
+				if err != nil {
+					return nil, nil, err
+				}
+				return response, nil, nil
+			}
+			return &anthropic.AnthropicMessageResponse{
+				ID: resp.ID,
+			}, nil, nil
+		},
+		ErrorConverter: func(ctx *schemas.BifrostContext, err *schemas.BifrostError) interface{} {
+			return anthropic.ToAnthropicChatCompletionError(err)
+		},
+		StreamConfig: &StreamConfig{
+			ResponsesStreamResponseConverter: func(ctx *schemas.BifrostContext, resp *schemas.BifrostResponsesStreamResponse) (string, interface{}, error) {
+				if shouldUsePassthrough(ctx, resp.ExtraFields.Provider, resp.ExtraFields.ModelRequested, resp.ExtraFields.ModelDeployment) {
 					if resp.ExtraFields.RawResponse != nil {
-						return resp.ExtraFields.RawResponse, nil
+						raw, ok := resp.ExtraFields.RawResponse.(string)
+						if !ok {
+							return "", nil, fmt.Errorf("expected RawResponse string, got %T", resp.ExtraFields.RawResponse)
+						}
+						var rawResponseJSON anthropic.AnthropicStreamEvent
+						if err := sonic.Unmarshal([]byte(raw), &rawResponseJSON); err == nil {
+							return string(rawResponseJSON.Type), raw, nil
+						}
 					}
+					// Fallback: if RawResponse is not available, use bifrost-to-anthropic conversion
+					// instead of silently dropping all events
 				}
-				return anthropic.ToAnthropicResponsesResponse(ctx, resp), nil
-			},
-			AsyncResponsesResponseConverter: func(ctx *schemas.BifrostContext, resp *schemas.AsyncJobResponse, responsesResponseConverter ResponsesResponseConverter) (interface{}, map[string]string, error) {
-				if resp.Status == schemas.AsyncJobStatusCompleted {
-					responsesResp, ok := resp.Result.(*schemas.BifrostResponsesResponse)
-					if !ok {
-						return nil, nil, errors.New("invalid responses response type")
-					}
-					response, err := responsesResponseConverter(ctx, responsesResp)
-					if err != nil {
-						return nil, nil, err
+				anthropicResponse := anthropic.ToAnthropicResponsesStreamResponse(ctx, resp)
+				// Can happen for openai lifecycle events
+				if len(anthropicResponse) == 0 {
+					return "", nil, nil
+				}
+				if len(anthropicResponse) > 1 {
+					var combinedContent strings.Builder
+					for _, event := range anthropicResponse {
+						responseJSON, err := sonic.Marshal(event)
+						if err != nil {
+							logger.Error("failed to marshal anthropic streaming message: %v", err)
+							continue
+						}
+						fmt.Fprintf(&combinedContent, "event: %s\ndata: %s\n\n", event.Type, responseJSON)
 					}
-					return response, nil, nil
+					return "", combinedContent.String(), nil
 				}
-				return &anthropic.AnthropicMessageResponse{
-					ID: resp.ID,
-				}, nil, nil
+				return string(anthropicResponse[0].Type), anthropicResponse[0], nil
 			},
 			ErrorConverter: func(ctx *schemas.BifrostContext, err *schemas.BifrostError) interface{} {
-				return anthropic.ToAnthropicChatCompletionError(err)
+				return anthropic.ToAnthropicResponsesStreamError(err)
 			},
-			StreamConfig: &StreamConfig{
-				ResponsesStreamResponseConverter: func(ctx *schemas.BifrostContext, resp *schemas.BifrostResponsesStreamResponse) (string, interface{}, error) {
-					if shouldUsePassthrough(ctx, resp.ExtraFields.Provider, resp.ExtraFields.ModelRequested, resp.ExtraFields.ModelDeployment) {
-						if resp.ExtraFields.RawResponse != nil {
-							raw, ok := resp.ExtraFields.RawResponse.(string)
-							if !ok {
-								return "", nil, fmt.Errorf("expected RawResponse string, got %T", resp.ExtraFields.RawResponse)
-							}
-							var rawResponseJSON anthropic.AnthropicStreamEvent
-							if err := sonic.Unmarshal([]byte(raw), &rawResponseJSON); err == nil {
-								return string(rawResponseJSON.Type), raw, nil
-							}
-						}
-						// Fallback: if RawResponse is not available, use bifrost-to-anthropic conversion
-						// instead of silently dropping all events
-					}
-					anthropicResponse := anthropic.ToAnthropicResponsesStreamResponse(ctx, resp)
-					// Can happen for openai lifecycle events
-					if len(anthropicResponse) == 0 {
-						return "", nil, nil
-					}
-					if len(anthropicResponse) > 1 {
-						var combinedContent strings.Builder
-						for _, event := range anthropicResponse {
-							responseJSON, err := sonic.Marshal(event)
-							if err != nil {
-								logger.Error("failed to marshal anthropic streaming message: %v", err)
-								continue
-							}
-							fmt.Fprintf(&combinedContent, "event: %s\ndata: %s\n\n", event.Type, responseJSON)
-						}
-						return "", combinedContent.String(), nil
-					}
-					return string(anthropicResponse[0].Type), anthropicResponse[0], nil
-				},
-				ErrorConverter: func(ctx *schemas.BifrostContext, err *schemas.BifrostError) interface{} {
-					return anthropic.ToAnthropicResponsesStreamError(err)
-				},
-			},
-			PreCallback: checkAnthropicPassthrough,
-		})
+		},
+		PreCallback: checkAnthropicPassthrough,
 	}
-	return routes
 }
 
 // CreateAnthropicRouteConfigs creates route configurations for Anthropic endpoints.
@@ -1034,6 +1035,8 @@ func NewAnthropicRouter(client *bifrost.Bifrost, handlerStore lib.HandlerStore,
 	routes = append(routes, CreateAnthropicCountTokensRouteConfigs("/anthropic", handlerStore)...)
 	routes = append(routes, CreateAnthropicBatchRouteConfigs("/anthropic", handlerStore)...)
 	routes = append(routes, CreateAnthropicFilesRouteConfigs("/anthropic", handlerStore)...)
+	// Anthropic SDKs pointed at Bifrost's base URL send messages to the root path
+	routes = append(routes, createAnthropicMessagesRoute("/v1/messages", logger))
 
 	return &AnthropicRouter{
 		GenericRouter: NewGenericRouter(client, handlerStore, routes, logger),
@@ -3,9 +3,24 @@ package integrations
 import (
 	"testing"
 
+	"github.com/fasthttp/router"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
 )
 
+func TestAnthropicMessagesRootRoute(t *testing.T) {
+	r := router.New()
+	NewAnthropicRouter(nil, &mockHandlerStore{}, &testLogger{}).RegisterRoutes(r)
+	// Registered by the inference handler next to the root messages route
+	r.POST("/v1/messages/count_tokens", func(ctx *fasthttp.RequestCtx) {})
+
+	for _, path := range []string{"/v1/messages", "/anthropic/v1/messages", "/v1/messages/count_tokens"} {
+		handler, _ := r.Lookup(fasthttp.MethodPost, path, &fasthttp.RequestCtx{})
+		require.NotNil(t, handler, path)
+	}
+}
+
 func TestFilterVertexUnsupportedBetaHeaders(t *testing.T) {
 	t.Run("filters known exact header values", func(t *testing.T) {
 		headers := map[string][]string{
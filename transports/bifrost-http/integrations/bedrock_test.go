@@ -3,6 +3,7 @@ package integrations
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/capsohq/bifrost/core/providers/bedrock"
 	"github.com/capsohq/bifrost/core/schemas"
@@ -45,6 +46,18 @@ func (m *mockHandlerStore) GetAsyncJobResultTTL() int {
 	return 3600
 }
 
+func (m *mockHandlerStore) GetSSEDialect(path string) lib.SSEDialect {
+	return lib.SSEDialectDefault
+}
+
+func (m *mockHandlerStore) GetSSEKeepAliveInterval() time.Duration {
+	return 0
+}
+
+func (m *mockHandlerStore) GetStreamRegistry() *lib.StreamRegistry {
+	return nil
+}
+
 // Ensure mockHandlerStore implements lib.HandlerStore
 var _ lib.HandlerStore = (*mockHandlerStore)(nil)
 
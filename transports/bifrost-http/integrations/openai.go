@@ -670,6 +670,43 @@ func CreateOpenAIRouteConfigs(pathPrefix string, handlerStore lib.HandlerStore)
 		})
 	}
 
+	// Moderation endpoint
+	for _, path := range []string{
+		"/v1/moderations",
+		"/moderations",
+	} {
+		routes = append(routes, RouteConfig{
+			Type:   RouteConfigTypeOpenAI,
+			Path:   pathPrefix + path,
+			Method: "POST",
+			GetHTTPRequestType: func(ctx *fasthttp.RequestCtx) schemas.RequestType {
+				return schemas.ModerationRequest
+			},
+			GetRequestTypeInstance: func(ctx context.Context) interface{} {
+				return &openai.OpenAIModerationRequest{}
+			},
+			RequestConverter: func(ctx *schemas.BifrostContext, req interface{}) (*schemas.BifrostRequest, error) {
+				if moderationReq, ok := req.(*openai.OpenAIModerationRequest); ok {
+					return &schemas.BifrostRequest{
+						ModerationRequest: moderationReq.ToBifrostModerationRequest(ctx),
+					}, nil
+				}
+				return nil, errors.New("invalid moderation request type")
+			},
+			ModerationResponseConverter: func(ctx *schemas.BifrostContext, resp *schemas.BifrostModerationResponse) (interface{}, error) {
+				if resp.ExtraFields.Provider == schemas.OpenAI {
+					if resp.ExtraFields.RawResponse != nil {
+						return resp.ExtraFields.RawResponse, nil
+					}
+				}
+				return resp, nil
+			},
+			ErrorConverter: func(ctx *schemas.BifrostContext, err *schemas.BifrostError) interface{} {
+				return err
+			},
+		})
+	}
+
 	// Speech synthesis endpoint
 	for _, path := range []string{
 		"/v1/audio/speech",
@@ -763,6 +800,44 @@ func CreateOpenAIRouteConfigs(pathPrefix string, handlerStore lib.HandlerStore)
 		})
 	}
 
+	// Audio translation endpoint
+	for _, path := range []string{
+		"/v1/audio/translations",
+		"/audio/translations",
+	} {
+		routes = append(routes, RouteConfig{
+			Type:   RouteConfigTypeOpenAI,
+			Path:   pathPrefix + path,
+			Method: "POST",
+			GetHTTPRequestType: func(ctx *fasthttp.RequestCtx) schemas.RequestType {
+				return schemas.TranslationRequest
+			},
+			GetRequestTypeInstance: func(ctx context.Context) interface{} {
+				return &openai.OpenAITranslationRequest{}
+			},
+			RequestParser: parseTranslationMultipartRequest, // Handle multipart form parsing
+			RequestConverter: func(ctx *schemas.BifrostContext, req interface{}) (*schemas.BifrostRequest, error) {
+				if translationReq, ok := req.(*openai.OpenAITranslationRequest); ok {
+					return &schemas.BifrostRequest{
+						TranslationRequest: translationReq.ToBifrostTranslationRequest(ctx),
+					}, nil
+				}
+				return nil, errors.New("invalid translation request type")
+			},
+			TranslationResponseConverter: func(ctx *schemas.BifrostContext, resp *schemas.BifrostTranslationResponse) (interface{}, error) {
+				if resp.ExtraFields.Provider == schemas.OpenAI {
+					if resp.ExtraFields.RawResponse != nil {
+						return resp.ExtraFields.RawResponse, nil
+					}
+				}
+				return resp, nil
+			},
+			ErrorConverter: func(ctx *schemas.BifrostContext, err *schemas.BifrostError) interface{} {
+				return err
+			},
+		})
+	}
+
 	// Image Generation endpoint
 	for _, path := range []string{
 		"/v1/images/generations",
@@ -2707,6 +2782,71 @@ func parseTranscriptionMultipartRequest(ctx *fasthttp.RequestCtx, req interface{
 	return nil
 }
 
+// parseTranslationMultipartRequest is a RequestParser that handles multipart/form-data for translation requests
+func parseTranslationMultipartRequest(ctx *fasthttp.RequestCtx, req interface{}) error {
+	translationReq, ok := req.(*openai.OpenAITranslationRequest)
+	if !ok {
+		return errors.New("invalid request type for translation")
+	}
+
+	// Parse multipart form
+	form, err := ctx.MultipartForm()
+	if err != nil {
+		return err
+	}
+
+	// Extract model (required)
+	modelValues := form.Value["model"]
+	if len(modelValues) == 0 || modelValues[0] == "" {
+		return errors.New("model field is required")
+	}
+	translationReq.Model = modelValues[0]
+
+	// Extract file (required)
+	fileHeaders := form.File["file"]
+	if len(fileHeaders) == 0 {
+		return errors.New("file field is required")
+	}
+
+	fileHeader := fileHeaders[0]
+	file, err := fileHeader.Open()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Read file data
+	fileData, err := io.ReadAll(file)
+	if err != nil {
+		return err
+	}
+	translationReq.File = fileData
+	translationReq.Filename = fileHeader.Filename
+
+	// Extract optional parameters
+	if promptValues := form.Value["prompt"]; len(promptValues) > 0 && promptValues[0] != "" {
+		prompt := promptValues[0]
+		translationReq.TranslationParameters.Prompt = &prompt
+	}
+
+	if responseFormatValues := form.Value["response_format"]; len(responseFormatValues) > 0 && responseFormatValues[0] != "" {
+		responseFormat := responseFormatValues[0]
+		translationReq.TranslationParameters.ResponseFormat = &responseFormat
+	}
+
+	if temperatureValues := form.Value["temperature"]; len(temperatureValues) > 0 && temperatureValues[0] != "" {
+		temperature, err := strconv.ParseFloat(temperatureValues[0], 64)
+		if err != nil {
+			return errors.New("invalid temperature value")
+		}
+		translationReq.TranslationParameters.Temperature = &temperature
+	}
+
+	translationReq.Fallbacks = form.Value["fallbacks"]
+
+	return nil
+}
+
 // parseOpenAIImageEditMultipartRequest is a RequestParser that handles multipart/form-data for image edit requests
 func parseOpenAIImageEditMultipartRequest(ctx *fasthttp.RequestCtx, req interface{}) error {
 	imageEditReq, ok := req.(*openai.OpenAIImageEditRequest)
@@ -0,0 +1,55 @@
+package integrations
+
+import (
+	"bytes"
+	"mime/multipart"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/providers/openai"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/valyala/fasthttp"
+)
+
+func TestParseTranslationMultipartRequest(t *testing.T) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	require.NoError(t, writer.WriteField("model", "groq/whisper-large-v3"))
+	require.NoError(t, writer.WriteField("response_format", "text"))
+	require.NoError(t, writer.WriteField("temperature", "0.2"))
+	part, err := writer.CreateFormFile("file", "speech.mp3")
+	require.NoError(t, err)
+	_, err = part.Write([]byte("audio"))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	ctx := &fasthttp.RequestCtx{}
+	ctx.Request.Header.SetMethod(fasthttp.MethodPost)
+	ctx.Request.Header.SetContentType(writer.FormDataContentType())
+	ctx.Request.SetBody(body.Bytes())
+
+	req := &openai.OpenAITranslationRequest{}
+	require.NoError(t, parseTranslationMultipartRequest(ctx, req))
+
+	bifrostReq := req.ToBifrostTranslationRequest(newTestBifrostContext())
+	assert.Equal(t, schemas.Groq, bifrostReq.Provider)
+	assert.Equal(t, "whisper-large-v3", bifrostReq.Model)
+	assert.Equal(t, []byte("audio"), bifrostReq.Input.File)
+	assert.Equal(t, "speech.mp3", bifrostReq.Input.Filename)
+	require.NotNil(t, bifrostReq.Params.ResponseFormat)
+	assert.Equal(t, "text", *bifrostReq.Params.ResponseFormat)
+	require.NotNil(t, bifrostReq.Params.Temperature)
+	assert.Equal(t, 0.2, *bifrostReq.Params.Temperature)
+}
+
+func TestOpenAIRouteConfigsCoverModerationAndTranslation(t *testing.T) {
+	requestTypes := map[string]schemas.RequestType{}
+	for _, route := range CreateOpenAIRouteConfigs("/openai", &mockHandlerStore{}) {
+		if route.GetHTTPRequestType != nil {
+			requestTypes[route.Method+" "+route.Path] = route.GetHTTPRequestType(&fasthttp.RequestCtx{})
+		}
+	}
+	assert.Equal(t, schemas.ModerationRequest, requestTypes["POST /openai/v1/moderations"])
+	assert.Equal(t, schemas.TranslationRequest, requestTypes["POST /openai/v1/audio/translations"])
+}
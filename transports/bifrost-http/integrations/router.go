@@ -172,6 +172,10 @@ type EmbeddingResponseConverter func(ctx *schemas.BifrostContext, resp *schemas.
 // It takes a BifrostRerankResponse and returns the format expected by the specific integration.
 type RerankResponseConverter func(ctx *schemas.BifrostContext, resp *schemas.BifrostRerankResponse) (interface{}, error)
 
+// ModerationResponseConverter is a function that converts BifrostModerationResponse to integration-specific format.
+// It takes a BifrostModerationResponse and returns the format expected by the specific integration.
+type ModerationResponseConverter func(ctx *schemas.BifrostContext, resp *schemas.BifrostModerationResponse) (interface{}, error)
+
 // SpeechResponseConverter is a function that converts BifrostSpeechResponse to integration-specific format.
 // It takes a BifrostSpeechResponse and returns the format expected by the specific integration.
 type SpeechResponseConverter func(ctx *schemas.BifrostContext, resp *schemas.BifrostSpeechResponse) (interface{}, error)
@@ -180,6 +184,10 @@ type SpeechResponseConverter func(ctx *schemas.BifrostContext, resp *schemas.Bif
 // It takes a BifrostTranscriptionResponse and returns the format expected by the specific integration.
 type TranscriptionResponseConverter func(ctx *schemas.BifrostContext, resp *schemas.BifrostTranscriptionResponse) (interface{}, error)
 
+// TranslationResponseConverter is a function that converts BifrostTranslationResponse to integration-specific format.
+// It takes a BifrostTranslationResponse and returns the format expected by the specific integration.
+type TranslationResponseConverter func(ctx *schemas.BifrostContext, resp *schemas.BifrostTranslationResponse) (interface{}, error)
+
 // BatchCreateResponseConverter is a function that converts BifrostBatchCreateResponse to integration-specific format.
 // It takes a BifrostBatchCreateResponse and returns the format expected by the specific integration.
 type BatchCreateResponseConverter func(ctx *schemas.BifrostContext, resp *schemas.BifrostBatchCreateResponse) (interface{}, error)
@@ -404,8 +412,10 @@ type RouteConfig struct {
 	AsyncResponsesResponseConverter        AsyncResponsesResponseConverter        // Function to convert AsyncJobResponse to integration format (SHOULD NOT BE NIL)
 	EmbeddingResponseConverter             EmbeddingResponseConverter             // Function to convert BifrostEmbeddingResponse to integration format (SHOULD NOT BE NIL)
 	RerankResponseConverter                RerankResponseConverter                // Function to convert BifrostRerankResponse to integration format
+	ModerationResponseConverter            ModerationResponseConverter            // Function to convert BifrostModerationResponse to integration format
 	SpeechResponseConverter                SpeechResponseConverter                // Function to convert BifrostSpeechResponse to integration format (SHOULD NOT BE NIL)
 	TranscriptionResponseConverter         TranscriptionResponseConverter         // Function to convert BifrostTranscriptionResponse to integration format (SHOULD NOT BE NIL)
+	TranslationResponseConverter           TranslationResponseConverter           // Function to convert BifrostTranslationResponse to integration format
 	ImageGenerationResponseConverter       ImageGenerationResponseConverter       // Function to convert BifrostImageGenerationResponse to integration format (SHOULD NOT BE NIL)
 	VideoGenerationResponseConverter       VideoGenerationResponseConverter       // Function to convert BifrostVideoGenerationResponse to integration format (SHOULD NOT BE NIL)
 	VideoDownloadResponseConverter         VideoDownloadResponseConverter         // Function to convert BifrostVideoDownloadResponse to integration format (SHOULD NOT BE NIL)
@@ -883,8 +893,29 @@ func (g *GenericRouter) handleNonStreamingRequest(ctx *fasthttp.RequestCtx, conf
 			response, err = config.RerankResponseConverter(bifrostCtx, rerankResponse)
 		} else {
 			response = rerankResponse
-		}		
-		
+		}
+	case bifrostReq.ModerationRequest != nil:
+		moderationResponse, bifrostErr := g.client.ModerationRequest(bifrostCtx, bifrostReq.ModerationRequest)
+		if bifrostErr != nil {
+			g.sendError(ctx, bifrostCtx, config.ErrorConverter, bifrostErr)
+			return
+		}
+		if config.PostCallback != nil {
+			if err := config.PostCallback(ctx, req, moderationResponse); err != nil {
+				g.sendError(ctx, bifrostCtx, config.ErrorConverter, newBifrostError(err, "failed to execute post-request callback"))
+				return
+			}
+		}
+		if moderationResponse == nil {
+			g.sendError(ctx, bifrostCtx, config.ErrorConverter, newBifrostError(nil, "Bifrost response is nil after post-request callback"))
+			return
+		}
+		providerResponseHeaders = moderationResponse.ExtraFields.ProviderResponseHeaders
+		if config.ModerationResponseConverter != nil {
+			response, err = config.ModerationResponseConverter(bifrostCtx, moderationResponse)
+		} else {
+			response = moderationResponse
+		}
 	case bifrostReq.SpeechRequest != nil:
 		speechResponse, bifrostErr := g.client.SpeechRequest(bifrostCtx, bifrostReq.SpeechRequest)
 		if bifrostErr != nil {
@@ -945,6 +976,28 @@ func (g *GenericRouter) handleNonStreamingRequest(ctx *fasthttp.RequestCtx, conf
 		// Convert Bifrost response to integration-specific format and send
 		response, err = config.TranscriptionResponseConverter(bifrostCtx, transcriptionResponse)
 		providerResponseHeaders = transcriptionResponse.ExtraFields.ProviderResponseHeaders
+	case bifrostReq.TranslationRequest != nil:
+		translationResponse, bifrostErr := g.client.TranslationRequest(bifrostCtx, bifrostReq.TranslationRequest)
+		if bifrostErr != nil {
+			g.sendError(ctx, bifrostCtx, config.ErrorConverter, bifrostErr)
+			return
+		}
+		if config.PostCallback != nil {
+			if err := config.PostCallback(ctx, req, translationResponse); err != nil {
+				g.sendError(ctx, bifrostCtx, config.ErrorConverter, newBifrostError(err, "failed to execute post-request callback"))
+				return
+			}
+		}
+		if translationResponse == nil {
+			g.sendError(ctx, bifrostCtx, config.ErrorConverter, newBifrostError(nil, "Bifrost response is nil after post-request callback"))
+			return
+		}
+		providerResponseHeaders = translationResponse.ExtraFields.ProviderResponseHeaders
+		if config.TranslationResponseConverter != nil {
+			response, err = config.TranslationResponseConverter(bifrostCtx, translationResponse)
+		} else {
+			response = translationResponse
+		}
 	case bifrostReq.ImageGenerationRequest != nil:
 		imageGenerationResponse, bifrostErr := g.client.ImageGenerationRequest(bifrostCtx, bifrostReq.ImageGenerationRequest)
 		if bifrostErr != nil {
@@ -1990,6 +2043,9 @@ func (g *GenericRouter) handleStreamingRequest(ctx *fasthttp.RequestCtx, config
 	var stream chan *schemas.BifrostStreamChunk
 	var bifrostErr *schemas.BifrostError
 
+	// Make the stream cancellable by request ID before it starts, so it can be aborted while connecting too
+	untrack := lib.TrackStream(ctx, g.handlerStore.GetStreamRegistry(), bifrostCtx, cancel)
+
 	// Handle different request types
 	if bifrostReq.TextCompletionRequest != nil {
 		stream, bifrostErr = g.client.TextCompletionStreamRequest(bifrostCtx, bifrostReq.TextCompletionRequest)
@@ -2010,6 +2066,7 @@ func (g *GenericRouter) handleStreamingRequest(ctx *fasthttp.RequestCtx, config
 	// Get the streaming channel from Bifrost
 	if bifrostErr != nil {
 		// Send error in SSE format and cancel stream context since we're not proceeding
+		untrack()
 		cancel()
 		g.sendStreamError(ctx, bifrostCtx, config, bifrostErr)
 		return
@@ -2025,6 +2082,7 @@ func (g *GenericRouter) handleStreamingRequest(ctx *fasthttp.RequestCtx, config
 	// Check if streaming is configured for this route
 	if config.StreamConfig == nil {
 		// Cancel stream context since we're not proceeding, and close the stream channel to prevent goroutine leaks
+		untrack()
 		cancel()
 		// Drain the stream channel to prevent goroutine leaks
 		go func() {
@@ -2037,7 +2095,7 @@ func (g *GenericRouter) handleStreamingRequest(ctx *fasthttp.RequestCtx, config
 
 	// Handle streaming using the centralized approach
 	// Pass cancel function so it can be called when the writer exits (errors, completion, etc.)
-	g.handleStreaming(ctx, bifrostCtx, config, stream, cancel)
+	g.handleStreaming(ctx, bifrostCtx, config, stream, cancel, untrack)
 }
 
 // handleStreaming processes a stream of BifrostResponse objects and sends them as Server-Sent Events (SSE).
@@ -2090,7 +2148,9 @@ func (g *GenericRouter) handleStreamingRequest(ctx *fasthttp.RequestCtx, config
 // The cancel function is called ONLY when client disconnects are detected via write errors.
 // Bifrost handles cleanup internally for normal completion and errors, so we only cancel
 // upstream streams when write errors indicate the client has disconnected.
-func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *schemas.BifrostContext, config RouteConfig, streamChan chan *schemas.BifrostStreamChunk, cancel context.CancelFunc) {
+// SSE keep-alive comments are written while the provider is silent, so disconnects are noticed then too.
+// untrack removes the stream from the stream registry once the writer exits.
+func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *schemas.BifrostContext, config RouteConfig, streamChan chan *schemas.BifrostStreamChunk, cancel context.CancelFunc, untrack func()) {
 	// Signal to tracing middleware that trace completion should be deferred
 	// The streaming callback will complete the trace after the stream ends
 	ctx.SetUserValue(schemas.BifrostContextKeyDeferTraceCompletion, true)
@@ -2105,9 +2165,21 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 		httpReq = lib.BuildHTTPRequestFromFastHTTP(ctx)
 	}
 
+	// SSE framing configured for this route; the default dialect keeps the integration's native framing.
+	// Bedrock uses AWS Event Stream encoding and is not affected.
+	dialect := g.handlerStore.GetSSEDialect(string(ctx.Path()))
+	// Routes other than the Anthropic ones need their chat and Responses chunks converted into
+	// Anthropic Messages events for the anthropic dialect
+	var anthropicStream *lib.AnthropicStreamConverter
+	if dialect == lib.SSEDialectAnthropic && config.Type != RouteConfigTypeAnthropic && config.Type != RouteConfigTypeBedrock {
+		anthropicStream = lib.NewAnthropicStreamConverter(bifrostCtx)
+	}
+	keepAliveInterval := g.handlerStore.GetSSEKeepAliveInterval()
+
 	// Use streaming response writer
 	ctx.Response.SetBodyStreamWriter(func(w *bufio.Writer) {
 		defer func() {
+			untrack()
 			schemas.ReleaseHTTPRequest(httpReq)
 			w.Flush()
 			// Complete the trace after streaming finishes
@@ -2121,7 +2193,11 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 		var eventStreamEncoder *eventstream.Encoder
 		if config.Type == RouteConfigTypeBedrock {
 			eventStreamEncoder = eventstream.NewEncoder()
+			// SSE comments are not valid in AWS Event Stream framing
+			keepAliveInterval = 0
 		}
+		keepAlive := lib.NewSSEKeepAlive(keepAliveInterval)
+		defer keepAlive.Stop()
 
 		shouldSendDoneMarker := true
 		if config.Type == RouteConfigTypeAnthropic || strings.Contains(config.Path, "/responses") || strings.Contains(config.Path, "/images/generations") {
@@ -2129,7 +2205,15 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 		}
 
 		// Process streaming responses
-		for chunk := range streamChan {
+		for {
+			chunk, ok, err := keepAlive.Next(w, streamChan)
+			if err != nil {
+				cancel() // Client disconnected (keep-alive write error), cancel upstream stream
+				return
+			}
+			if !ok {
+				break
+			}
 			if chunk == nil {
 				continue
 			}
@@ -2139,7 +2223,15 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 			// Client disconnects are detected via write errors, which trigger the defer cancel() above.
 
 			// Handle errors
-			if chunk.BifrostError != nil {
+			if chunk.BifrostError != nil && anthropicStream != nil {
+				events, _ := anthropicStream.Convert(chunk)
+				if err := lib.WriteAnthropicEvents(w, events); err != nil {
+					cancel() // Client disconnected (write error), cancel upstream stream
+					return
+				}
+				_ = w.Flush()
+				return // End stream on error, Bifrost handles cleanup internally
+			} else if chunk.BifrostError != nil {
 				var errorResponse interface{}
 				var errorJSON []byte
 				var err error
@@ -2164,7 +2256,7 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 					// CUSTOM SSE FORMAT: The converter returned a complete SSE string
 					// This is used by providers like Anthropic that need custom event types
 					// Example: "event: error\ndata: {...}\n\n"
-					if _, err := fmt.Fprint(w, sseErrorString); err != nil {
+					if _, err := fmt.Fprint(w, dialect.Reframe(sseErrorString)); err != nil {
 						cancel() // Client disconnected (write error), cancel upstream stream
 						return
 					}
@@ -2187,8 +2279,12 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 						}
 					}
 
-					// Send error as SSE data
-					if _, err := fmt.Fprintf(w, "data: %s\n\n", errorJSON); err != nil {
+					// Send error as SSE data (Anthropic-style clients expect an explicit error event)
+					errorEventType := ""
+					if dialect == lib.SSEDialectAnthropic {
+						errorEventType = "error"
+					}
+					if err := dialect.WriteEvent(w, errorEventType, errorJSON); err != nil {
 						cancel() // Client disconnected (write error), cancel upstream stream
 						return
 					}
@@ -2213,7 +2309,7 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 								return
 							}
 							// Return error event and stopping the streaming
-							if _, err := fmt.Fprintf(w, "event: error\ndata: %s\n\n", errorJSON); err != nil {
+							if err := dialect.WriteEvent(w, "error", errorJSON); err != nil {
 								cancel()
 								return
 							}
@@ -2229,6 +2325,19 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 						continue
 					}
 				}
+				if anthropicStream != nil {
+					if events, ok := anthropicStream.Convert(chunk); ok {
+						if err := lib.WriteAnthropicEvents(w, events); err != nil {
+							cancel() // Client disconnected (write error), cancel upstream stream
+							return
+						}
+						if err := w.Flush(); err != nil {
+							cancel() // Client disconnected (write error), cancel upstream stream
+							return
+						}
+						continue
+					}
+				}
 				// Handle successful responses
 				// Convert response to integration-specific streaming format
 				var eventType string
@@ -2264,13 +2373,6 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 					continue
 				}
 
-				if eventType != "" {
-					// OPENAI RESPONSES FORMAT: Use event: and data: lines for OpenAI responses API compatibility
-					if _, err := fmt.Fprintf(w, "event: %s\n", eventType); err != nil {
-						cancel() // Client disconnected (write error), cancel upstream stream
-						return
-					}
-				}
 				// Handle Bedrock Event Stream format
 				if config.Type == RouteConfigTypeBedrock && eventStreamEncoder != nil {
 					// We need to cast to BedrockStreamEvent to determine event type and structure
@@ -2330,7 +2432,11 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 					if !strings.HasPrefix(sseString, "data: ") && !strings.HasPrefix(sseString, "event: ") {
 						sseString = fmt.Sprintf("data: %s\n\n", sseString)
 					}
-					if _, err := fmt.Fprint(w, sseString); err != nil {
+					if eventType != "" {
+						// OPENAI RESPONSES FORMAT: Use event: and data: lines for OpenAI responses API compatibility
+						sseString = fmt.Sprintf("event: %s\n%s", eventType, sseString)
+					}
+					if _, err := fmt.Fprint(w, dialect.Reframe(sseString)); err != nil {
 						cancel() // Client disconnected (write error), cancel upstream stream
 						return
 					}
@@ -2345,8 +2451,8 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 						continue
 					}
 
-					// Send as SSE data
-					if _, err := fmt.Fprintf(w, "data: %s\n\n", responseJSON); err != nil {
+					// Send as SSE data, with an event: line when the route (or configured dialect) uses one
+					if err := dialect.WriteEvent(w, eventType, responseJSON); err != nil {
 						cancel() // Client disconnected (write error), cancel upstream stream
 						return
 					}
@@ -2365,8 +2471,17 @@ func (g *GenericRouter) handleStreaming(ctx *fasthttp.RequestCtx, bifrostCtx *sc
 		//   - OpenAI "responses" API and Anthropic messages API: they signal completion by simply closing the stream, not sending [DONE].
 		//   - Bedrock: uses AWS Event Stream format rather than SSE with [DONE].
 		// Bifrost handles any additional cleanup internally on normal stream completion.
-		if shouldSendDoneMarker && config.Type != RouteConfigTypeGenAI && config.Type != RouteConfigTypeBedrock {
-			if _, err := fmt.Fprint(w, "data: [DONE]\n\n"); err != nil {
+		// A configured SSE output dialect overrides the route's native choice.
+		if anthropicStream != nil {
+			// End the message with message_delta and message_stop
+			if err := lib.WriteAnthropicEvents(w, anthropicStream.Finish()); err != nil {
+				g.logger.Warn("Failed to write Anthropic stream stop events: %v", err)
+				cancel()
+				return
+			}
+		}
+		if dialect.SendsDoneMarker(shouldSendDoneMarker && config.Type != RouteConfigTypeGenAI) && config.Type != RouteConfigTypeBedrock {
+			if err := lib.WriteDoneMarker(w); err != nil {
 				g.logger.Warn("Failed to write SSE done marker: %v", err)
 				cancel()
 				return // End stream on error, Bifrost handles cleanup internally
@@ -195,6 +195,9 @@ func (g *GenericRouter) sendError(ctx *fasthttp.RequestCtx, bifrostCtx *schemas.
 	} else {
 		ctx.SetStatusCode(fasthttp.StatusInternalServerError)
 	}
+	if bifrostErr.RetryAfter != nil {
+		ctx.Response.Header.Set("Retry-After", strconv.Itoa(*bifrostErr.RetryAfter))
+	}
 	ctx.SetContentType("application/json")
 
 	// Marshal the error for response and log the error for diagnostics
@@ -30,6 +30,7 @@ import (
 	"github.com/capsohq/bifrost/framework/modelcatalog"
 	"github.com/capsohq/bifrost/framework/oauth2"
 	plugins "github.com/capsohq/bifrost/framework/plugins"
+	"github.com/capsohq/bifrost/framework/secrets"
 	"github.com/capsohq/bifrost/framework/vectorstore"
 	"github.com/capsohq/bifrost/plugins/governance"
 	"github.com/capsohq/bifrost/plugins/litellmcompat"
@@ -69,6 +70,13 @@ type HandlerStore interface {
 	GetAsyncJobExecutor() *logstore.AsyncJobExecutor
 	// GetAsyncJobResultTTL returns the default TTL for async job results in seconds.
 	GetAsyncJobResultTTL() int
+	// GetSSEDialect returns the SSE output dialect configured for the given route path.
+	GetSSEDialect(path string) SSEDialect
+	// GetSSEKeepAliveInterval returns the stream silence after which an SSE keep-alive comment is sent.
+	// Returns 0 if keep-alives are disabled.
+	GetSSEKeepAliveInterval() time.Duration
+	// GetStreamRegistry returns the registry of in-flight streams, used to cancel them by request ID.
+	GetStreamRegistry() *StreamRegistry
 }
 
 // Retry backoff constants for validation
@@ -106,8 +114,9 @@ func IsBuiltinPlugin(name string) bool {
 // It contains the client configuration, provider configurations, MCP configuration,
 // vector store configuration, config store configuration, and logs store configuration.
 type ConfigData struct {
-	Client        *configstore.ClientConfig `json:"client"`
-	EncryptionKey *schemas.EnvVar           `json:"encryption_key"`
+	Client             *configstore.ClientConfig `json:"client"`
+	EncryptionKey      *schemas.EnvVar           `json:"encryption_key"`
+	EncryptionKMSKeyID *schemas.EnvVar           `json:"encryption_kms_key_id,omitempty"`
 	// Deprecated: Use GovernanceConfig.AuthConfig instead
 	AuthConfig        *configstore.AuthConfig               `json:"auth_config,omitempty"`
 	Providers         map[string]configstore.ProviderConfig `json:"providers"`
@@ -126,17 +135,18 @@ type ConfigData struct {
 func (cd *ConfigData) UnmarshalJSON(data []byte) error {
 	// First, unmarshal into a temporary struct to get all fields except the complex configs
 	type TempConfigData struct {
-		FrameworkConfig   json.RawMessage                       `json:"framework,omitempty"`
-		Client            *configstore.ClientConfig             `json:"client"`
-		EncryptionKey     *schemas.EnvVar                       `json:"encryption_key"`
-		AuthConfig        *configstore.AuthConfig               `json:"auth_config,omitempty"`
-		Providers         map[string]configstore.ProviderConfig `json:"providers"`
-		MCP               *schemas.MCPConfig                    `json:"mcp,omitempty"`
-		Governance        *configstore.GovernanceConfig         `json:"governance,omitempty"`
-		VectorStoreConfig json.RawMessage                       `json:"vector_store,omitempty"`
-		ConfigStoreConfig json.RawMessage                       `json:"config_store,omitempty"`
-		LogsStoreConfig   json.RawMessage                       `json:"logs_store,omitempty"`
-		Plugins           []*schemas.PluginConfig               `json:"plugins,omitempty"`
+		FrameworkConfig    json.RawMessage                       `json:"framework,omitempty"`
+		Client             *configstore.ClientConfig             `json:"client"`
+		EncryptionKey      *schemas.EnvVar                       `json:"encryption_key"`
+		EncryptionKMSKeyID *schemas.EnvVar                       `json:"encryption_kms_key_id,omitempty"`
+		AuthConfig         *configstore.AuthConfig               `json:"auth_config,omitempty"`
+		Providers          map[string]configstore.ProviderConfig `json:"providers"`
+		MCP                *schemas.MCPConfig                    `json:"mcp,omitempty"`
+		Governance         *configstore.GovernanceConfig         `json:"governance,omitempty"`
+		VectorStoreConfig  json.RawMessage                       `json:"vector_store,omitempty"`
+		ConfigStoreConfig  json.RawMessage                       `json:"config_store,omitempty"`
+		LogsStoreConfig    json.RawMessage                       `json:"logs_store,omitempty"`
+		Plugins            []*schemas.PluginConfig               `json:"plugins,omitempty"`
 	}
 
 	var temp TempConfigData
@@ -147,6 +157,7 @@ func (cd *ConfigData) UnmarshalJSON(data []byte) error {
 	// Set simple fields
 	cd.Client = temp.Client
 	cd.EncryptionKey = temp.EncryptionKey
+	cd.EncryptionKMSKeyID = temp.EncryptionKMSKeyID
 	cd.AuthConfig = temp.AuthConfig
 	cd.Providers = temp.Providers
 	cd.MCP = temp.MCP
@@ -296,6 +307,9 @@ type Config struct {
 	// Async job executor (initialized during setup if LogsStore + governance are available)
 	AsyncJobExecutor *logstore.AsyncJobExecutor
 
+	// In-flight streams, cancellable by request ID
+	streams StreamRegistry
+
 	// Catalog managers
 	ModelCatalog *modelcatalog.ModelCatalog
 	MCPCatalog   *mcpcatalog.MCPCatalog
@@ -431,7 +445,7 @@ func loadConfigFromFile(ctx context.Context, config *Config, data []byte) (*Conf
 	}
 	var err error
 	// Initialize encryption before stores so BeforeSave hooks and EncryptPlaintextRows work correctly
-	if err = initEncryptionFromFile(&configData); err != nil {
+	if err = initEncryptionFromFile(ctx, &configData); err != nil {
 		return nil, err
 	}
 	// Initialize stores from config file
@@ -1089,8 +1103,10 @@ func mergeGovernanceConfig(ctx context.Context, config *Config, configData *Conf
 					logger.Debug("config hash mismatch for virtual key %s, syncing from config file", newVirtualKey.ID)
 					configData.Governance.VirtualKeys[i].ConfigHash = fileVKHash
 					// This is added for backward compatibility with existing configs
+					// Only the hash of the stored value is known, so it is kept along with its masked hint
 					if configData.Governance.VirtualKeys[i].Value == "" && existingVirtualKey.Value != "" {
 						configData.Governance.VirtualKeys[i].Value = existingVirtualKey.Value
+						configData.Governance.VirtualKeys[i].ValueHash = existingVirtualKey.ValueHash
 					}
 					// Process environment variable for virtual key value
 					if strings.HasPrefix(configData.Governance.VirtualKeys[i].Value, "env.") {
@@ -1103,7 +1119,7 @@ func mergeGovernanceConfig(ctx context.Context, config *Config, configData *Conf
 						configData.Governance.VirtualKeys[i].Value = envValue
 					}
 					// If the virtual key value is not a valid virtual key, we will generate a new one
-					if !strings.HasPrefix(configData.Governance.VirtualKeys[i].Value, governance.VirtualKeyPrefix) {
+					if configData.Governance.VirtualKeys[i].ValueHash == "" && !strings.HasPrefix(configData.Governance.VirtualKeys[i].Value, governance.VirtualKeyPrefix) {
 						if configData.Governance.VirtualKeys[i].Value != "" {
 							logger.Warn("virtual key %s has a value in the config file that does not have %s prefix. We are generating a new one for you.", newVirtualKey.ID, governance.VirtualKeyPrefix)
 						}
@@ -1414,7 +1430,7 @@ func createGovernanceConfigInStore(ctx context.Context, config *Config) {
 
 		for i := range config.GovernanceConfig.VirtualKeys {
 			virtualKey := &config.GovernanceConfig.VirtualKeys[i]
-			logger.Debug("creating virtual key: id=%s, name=%s, value=%s", virtualKey.ID, virtualKey.Name, virtualKey.Value)
+			logger.Debug("creating virtual key: id=%s, name=%s", virtualKey.ID, virtualKey.Name)
 			vkHash, err := configstore.GenerateVirtualKeyHash(*virtualKey)
 			if err != nil {
 				logger.Warn("failed to generate virtual key hash for %s: %v", virtualKey.ID, err)
@@ -1531,7 +1547,22 @@ func loadAuthConfigFromFile(ctx context.Context, config *Config, configData *Con
 		logger.Warn("password set with env var but value is empty: %s", authConfig.AdminPassword.EnvVar)
 	}
 	if authConfig.AdminPassword == nil || authConfig.AdminUserName == nil {
-		logger.Warn("auth config is missing admin_username or admin_password, skipping auth config processing")
+		if authConfig.JWT == nil {
+			logger.Warn("auth config is missing admin_username or admin_password, skipping auth config processing")
+			return
+		}
+		// JWT auth on inference does not need dashboard credentials, keep the stored ones
+		jwtOnlyConfig := &configstore.AuthConfig{}
+		if dbAuthConfig != nil {
+			*jwtOnlyConfig = *dbAuthConfig
+		}
+		jwtOnlyConfig.JWT = authConfig.JWT
+		config.GovernanceConfig.AuthConfig = jwtOnlyConfig
+		if dbAuthConfig == nil || !reflect.DeepEqual(dbAuthConfig.JWT, authConfig.JWT) {
+			if err := config.ConfigStore.UpdateAuthConfig(ctx, jwtOnlyConfig); err != nil {
+				logger.Warn("failed to update auth config: %v", err)
+			}
+		}
 		return
 	}
 	filePassword := authConfig.AdminPassword.GetValue()
@@ -1540,6 +1571,7 @@ func loadAuthConfigFromFile(ctx context.Context, config *Config, configData *Con
 		usernameMatch := dbAuthConfig.AdminUserName.GetValue() == authConfig.AdminUserName.GetValue()
 		boolsMatch := dbAuthConfig.IsEnabled == authConfig.IsEnabled &&
 			dbAuthConfig.DisableAuthOnInference == authConfig.DisableAuthOnInference
+		jwtMatch := reflect.DeepEqual(dbAuthConfig.JWT, authConfig.JWT)
 		var passwordMatch bool
 		if filePassword == "" {
 			passwordMatch = dbAuthConfig.AdminPassword.GetValue() == ""
@@ -1548,13 +1580,14 @@ func loadAuthConfigFromFile(ctx context.Context, config *Config, configData *Con
 		} else {
 			passwordMatch, _ = encrypt.CompareHash(dbAuthConfig.AdminPassword.GetValue(), filePassword)
 		}
-		if usernameMatch && passwordMatch && boolsMatch {
+		if usernameMatch && passwordMatch && boolsMatch && jwtMatch {
 			// DB matches file -- use DB hash but preserve file env var references
 			config.GovernanceConfig.AuthConfig = &configstore.AuthConfig{
 				AdminUserName:          authConfig.AdminUserName,
 				AdminPassword:          preserveEnvVar(authConfig.AdminPassword, dbAuthConfig.AdminPassword.GetValue()),
 				IsEnabled:              authConfig.IsEnabled,
 				DisableAuthOnInference: authConfig.DisableAuthOnInference,
+				JWT:                    authConfig.JWT,
 			}
 			return
 		}
@@ -1585,6 +1618,7 @@ func loadAuthConfigFromFile(ctx context.Context, config *Config, configData *Con
 		AdminPassword:          preserveEnvVar(authConfig.AdminPassword, hashedPassword),
 		IsEnabled:              authConfig.IsEnabled,
 		DisableAuthOnInference: authConfig.DisableAuthOnInference,
+		JWT:                    authConfig.JWT,
 	}
 	// Persist to config store
 	if err := config.ConfigStore.UpdateAuthConfig(ctx, config.GovernanceConfig.AuthConfig); err != nil {
@@ -1812,6 +1846,16 @@ func initFrameworkConfigFromFile(ctx context.Context, config *Config, configData
 	config.FrameworkConfig = &framework.FrameworkConfig{
 		Pricing: pricingConfig,
 	}
+	// Usage reconciliation, the shared rate limiter, webhooks, key health checks, secret managers, cluster sync and leader election are only configurable from the config file
+	if configData.FrameworkConfig != nil {
+		config.FrameworkConfig.UsageReconciliation = configData.FrameworkConfig.UsageReconciliation
+		config.FrameworkConfig.RateLimiter = configData.FrameworkConfig.RateLimiter
+		config.FrameworkConfig.Webhooks = configData.FrameworkConfig.Webhooks
+		config.FrameworkConfig.KeyHealthCheck = configData.FrameworkConfig.KeyHealthCheck
+		config.FrameworkConfig.Secrets = configData.FrameworkConfig.Secrets
+		config.FrameworkConfig.ClusterSync = configData.FrameworkConfig.ClusterSync
+		config.FrameworkConfig.LeaderElection = configData.FrameworkConfig.LeaderElection
+	}
 
 	var pricingManager *modelcatalog.ModelCatalog
 	var err error
@@ -1836,16 +1880,36 @@ func initFrameworkConfigFromFile(ctx context.Context, config *Config, configData
 }
 
 // initEncryptionFromFile initializes encryption from config file
-func initEncryptionFromFile(configData *ConfigData) error {
+func initEncryptionFromFile(ctx context.Context, configData *ConfigData) error {
 	if configData.EncryptionKey == nil || configData.EncryptionKey.GetValue() == "" {
 		// Checking if BIFROST_ENCRYPTION_KEY environment variable is set
 		if os.Getenv("BIFROST_ENCRYPTION_KEY") != "" {
 			configData.EncryptionKey = schemas.NewEnvVar("env.BIFROST_ENCRYPTION_KEY")
 		}
 	}
+	if configData.EncryptionKMSKeyID == nil || configData.EncryptionKMSKeyID.GetValue() == "" {
+		configData.EncryptionKMSKeyID = schemas.NewEnvVar("env.BIFROST_ENCRYPTION_KMS_KEY_ID")
+	}
+	return initEncryption(ctx, configData.EncryptionKey, configData.EncryptionKMSKeyID)
+}
+
+// initEncryption initializes the encryption passphrase and, when a KMS key is configured, the KMS
+// master key that wraps the data keys instead. A previous passphrase in
+// BIFROST_PREVIOUS_ENCRYPTION_KEY keeps the data it encrypted readable after a passphrase change.
+func initEncryption(ctx context.Context, encryptionKey *schemas.EnvVar, kmsKeyID *schemas.EnvVar) error {
 	// Checking if encryption key is set
-	if configData.EncryptionKey != nil && configData.EncryptionKey.GetValue() != "" {
-		encrypt.Init(configData.EncryptionKey.GetValue(), logger)
+	if encryptionKey != nil && encryptionKey.GetValue() != "" {
+		encrypt.Init(encryptionKey.GetValue(), logger)
+	}
+	if previousKey := os.Getenv("BIFROST_PREVIOUS_ENCRYPTION_KEY"); previousKey != "" {
+		encrypt.AddPreviousKey(previousKey)
+	}
+	if kmsKeyID != nil && kmsKeyID.GetValue() != "" {
+		masterKey, err := encrypt.NewAWSKMSMasterKey(ctx, kmsKeyID.GetValue())
+		if err != nil {
+			return fmt.Errorf("failed to initialize kms encryption key: %w", err)
+		}
+		encrypt.SetMasterKey(masterKey)
 	}
 	return nil
 }
@@ -1866,9 +1930,8 @@ func syncEncryption(ctx context.Context, config *Config) {
 func loadConfigFromDefaults(ctx context.Context, config *Config, configDBPath, logsDBPath string) (*Config, error) {
 	var err error
 	// Initialize encryption before stores so BeforeSave hooks and EncryptPlaintextRows work correctly
-	encryptionKey := schemas.NewEnvVar("env.BIFROST_ENCRYPTION_KEY")
-	if encryptionKey != nil && encryptionKey.GetValue() != "" {
-		encrypt.Init(encryptionKey.GetValue(), logger)
+	if err = initEncryption(ctx, schemas.NewEnvVar("env.BIFROST_ENCRYPTION_KEY"), schemas.NewEnvVar("env.BIFROST_ENCRYPTION_KMS_KEY_ID")); err != nil {
+		return nil, err
 	}
 	// Initialize default config store
 	if err = initDefaultConfigStore(ctx, config, configDBPath); err != nil {
@@ -2451,6 +2514,29 @@ func (c *Config) GetHeaderFilterConfig() *configstoreTables.GlobalHeaderFilterCo
 	return c.ClientConfig.HeaderFilterConfig
 }
 
+// GetSSEDialect returns the SSE output dialect configured for the given route path.
+// Like GetHeaderFilterConfig, this is lock-free and may briefly observe stale data during config updates.
+func (c *Config) GetSSEDialect(path string) SSEDialect {
+	return ResolveSSEDialect(c.ClientConfig.SSEOutputDialects, path)
+}
+
+// GetSSEKeepAliveInterval returns the stream silence after which an SSE keep-alive comment is sent.
+// Returns 0 if keep-alives are disabled.
+func (c *Config) GetSSEKeepAliveInterval() time.Duration {
+	return ResolveSSEKeepAliveInterval(c.ClientConfig.SSEKeepAliveInterval)
+}
+
+// GetResponseEnvelope returns the response envelope for a request to the given route path. override is the
+// request's ResponseEnvelopeHeader value, which takes precedence over the configured route envelopes.
+func (c *Config) GetResponseEnvelope(path string, override string) ResponseEnvelope {
+	return ResolveResponseEnvelope(c.ClientConfig.ResponseEnvelopes, path, override)
+}
+
+// GetStreamRegistry returns the registry of in-flight streams served by this node.
+func (c *Config) GetStreamRegistry() *StreamRegistry {
+	return &c.streams
+}
+
 // GetLoadedLLMPlugins returns the current snapshot of loaded LLM plugins.
 // This method is lock-free and safe for concurrent access from hot paths.
 // It returns the plugin slice from the atomic pointer, which is safe to iterate
@@ -2847,6 +2933,27 @@ func (c *Config) GetProviderConfigRedacted(provider schemas.ModelProvider) (*con
 	return config.Redacted(), nil
 }
 
+// GetAllProviderKeys returns the keys of every configured provider, with their values resolved.
+func (c *Config) GetAllProviderKeys() map[schemas.ModelProvider][]schemas.Key {
+	c.Mu.RLock()
+	defer c.Mu.RUnlock()
+
+	keys := make(map[schemas.ModelProvider][]schemas.Key, len(c.Providers))
+	for provider, config := range c.Providers {
+		keys[provider] = slices.Clone(config.Keys)
+	}
+
+	return keys
+}
+
+// GetSecretReferences returns the secret manager references held by the provider configurations.
+func (c *Config) GetSecretReferences() []string {
+	c.Mu.RLock()
+	defer c.Mu.RUnlock()
+
+	return secrets.References(c.Providers)
+}
+
 // GetAllProviders returns all configured provider names.
 func (c *Config) GetAllProviders() ([]schemas.ModelProvider, error) {
 	c.Mu.RLock()
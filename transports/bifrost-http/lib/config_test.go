@@ -349,6 +349,8 @@ import (
 	"github.com/capsohq/bifrost/framework/logstore"
 	"github.com/capsohq/bifrost/framework/migrator"
 	"github.com/capsohq/bifrost/framework/modelcatalog"
+	"github.com/capsohq/bifrost/framework/ratelimit"
+	"github.com/capsohq/bifrost/framework/reconciliation"
 	"github.com/capsohq/bifrost/framework/vectorstore"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -396,6 +398,7 @@ func NewMockConfigStore() *MockConfigStore {
 // Implement ConfigStore interface methods
 func (m *MockConfigStore) Ping(ctx context.Context) error                 { return nil }
 func (m *MockConfigStore) EncryptPlaintextRows(ctx context.Context) error { return nil }
+func (m *MockConfigStore) RotateEncryptionKey(ctx context.Context) (int, error) { return 0, nil }
 func (m *MockConfigStore) Close(ctx context.Context) error                { return nil }
 func (m *MockConfigStore) DB() *gorm.DB                                   { return nil }
 func (m *MockConfigStore) ExecuteTransaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
@@ -872,6 +875,32 @@ func (m *MockConfigStore) ClearRestartRequiredConfig(ctx context.Context) error
 	return nil
 }
 
+// Audit log
+func (m *MockConfigStore) CreateAuditLog(ctx context.Context, entry *tables.TableAuditLog) error {
+	return nil
+}
+
+func (m *MockConfigStore) GetAuditLogs(ctx context.Context, filters configstore.AuditLogFilters, limit int, offset int) ([]tables.TableAuditLog, int64, error) {
+	return nil, 0, nil
+}
+
+// Webhook dead letters
+func (m *MockConfigStore) CreateWebhookDeadLetter(ctx context.Context, deadLetter *tables.TableWebhookDeadLetter) error {
+	return nil
+}
+
+func (m *MockConfigStore) GetWebhookDeadLetters(ctx context.Context, limit int, offset int) ([]tables.TableWebhookDeadLetter, int64, error) {
+	return nil, 0, nil
+}
+
+func (m *MockConfigStore) GetWebhookDeadLetter(ctx context.Context, id string) (*tables.TableWebhookDeadLetter, error) {
+	return nil, configstore.ErrNotFound
+}
+
+func (m *MockConfigStore) DeleteWebhookDeadLetter(ctx context.Context, id string) error {
+	return nil
+}
+
 // Model config
 func (m *MockConfigStore) GetModelConfigs(ctx context.Context) ([]tables.TableModelConfig, error) {
 	return nil, nil
@@ -1022,6 +1051,48 @@ func (m *MockConfigStore) DeleteRoutingRule(ctx context.Context, id string, tx .
 	return nil
 }
 
+// Parameter presets
+func (m *MockConfigStore) GetParameterPresets(ctx context.Context) ([]tables.TableParameterPreset, error) {
+	return nil, nil
+}
+
+func (m *MockConfigStore) GetParameterPreset(ctx context.Context, name string) (*tables.TableParameterPreset, error) {
+	return nil, nil
+}
+
+func (m *MockConfigStore) CreateParameterPreset(ctx context.Context, preset *tables.TableParameterPreset, tx ...*gorm.DB) error {
+	return nil
+}
+
+func (m *MockConfigStore) UpdateParameterPreset(ctx context.Context, preset *tables.TableParameterPreset, tx ...*gorm.DB) error {
+	return nil
+}
+
+func (m *MockConfigStore) DeleteParameterPreset(ctx context.Context, name string, tx ...*gorm.DB) error {
+	return nil
+}
+
+// Custom pricing overrides
+func (m *MockConfigStore) GetPricingOverrides(ctx context.Context) ([]tables.TablePricingOverride, error) {
+	return nil, nil
+}
+
+func (m *MockConfigStore) GetPricingOverride(ctx context.Context, id string) (*tables.TablePricingOverride, error) {
+	return nil, nil
+}
+
+func (m *MockConfigStore) CreatePricingOverride(ctx context.Context, override *tables.TablePricingOverride, tx ...*gorm.DB) error {
+	return nil
+}
+
+func (m *MockConfigStore) UpdatePricingOverride(ctx context.Context, override *tables.TablePricingOverride, tx ...*gorm.DB) error {
+	return nil
+}
+
+func (m *MockConfigStore) DeletePricingOverride(ctx context.Context, id string, tx ...*gorm.DB) error {
+	return nil
+}
+
 // Helper functions for tests
 
 // createTempDir creates a temporary directory for test files
@@ -14904,6 +14975,16 @@ func getSchemaTypeMappings() []schemaTypeMapping {
 		// Client config
 		{"client", reflect.TypeOf(configstore.ClientConfig{}), false},
 		{"client.header_filter_config", reflect.TypeOf(tables.GlobalHeaderFilterConfig{}), false},
+		{"client.load_shedding", reflect.TypeOf(schemas.LoadSheddingConfig{}), false},
+		{"client.schema_drift", reflect.TypeOf(schemas.SchemaDriftConfig{}), false},
+		{"client.circuit_breaker", reflect.TypeOf(schemas.CircuitBreakerConfig{}), false},
+		{"client.latency_routing", reflect.TypeOf(schemas.LatencyRoutingConfig{}), false},
+		{"client.cost_routing", reflect.TypeOf(schemas.CostRoutingConfig{}), false},
+		{"client.virtual_models", reflect.TypeOf(schemas.VirtualModel{}), true},
+		{"client.traffic_splits", reflect.TypeOf(schemas.TrafficSplit{}), true},
+		{"client.single_flight", reflect.TypeOf(schemas.SingleFlightConfig{}), false},
+		{"client.tool_execution", reflect.TypeOf(schemas.ToolExecutionConfig{}), false},
+		{"client.stream_usage", reflect.TypeOf(schemas.StreamUsageConfig{}), false},
 
 		// Auth config (top-level)
 		{"auth_config", reflect.TypeOf(configstore.AuthConfig{}), false},
@@ -14911,6 +14992,9 @@ func getSchemaTypeMappings() []schemaTypeMapping {
 		// Framework config
 		{"framework", reflect.TypeOf(framework.FrameworkConfig{}), false},
 		{"framework.pricing", reflect.TypeOf(modelcatalog.Config{}), false},
+		{"framework.usage_reconciliation", reflect.TypeOf(reconciliation.Config{}), false},
+		{"framework.usage_reconciliation.sources", reflect.TypeOf(reconciliation.SourceConfig{}), true},
+		{"framework.rate_limiter", reflect.TypeOf(ratelimit.Config{}), false},
 
 		// MCP config
 		{"mcp", reflect.TypeOf(schemas.MCPConfig{}), false},
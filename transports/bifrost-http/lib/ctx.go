@@ -49,15 +49,24 @@ import (
 //   - x-api-key: Direct API key value - Anthropic style
 //   - x-goog-api-key: Direct API key value - Google Gemini style
 // 	 - x-bf-api-key references a stored API key name rather than the raw secret.
+//   - x-bf-key-id pins the request to a stored API key by its ID.
 //   - Keys are extracted and stored in the context using schemas.BifrostContextKey
 //   - This enables explicit key usage for requests via headers
 //
-// 6. Cancellable Context:
+// 6. Provider Pinning Headers:
+//   - x-bf-provider: Pins the request to a provider, keeping the requested model
+//   - x-bf-fallbacks: Replaces the fallbacks of the request ("provider/model" list separated by commas, "none" for no fallbacks)
+//
+// 7. Request Tags (x-bf-tags):
+//   - Comma-separated key=value pairs stored under schemas.BifrostContextKeyRequestTags for cost attribution
+//   - Merged with the tags of the request body, the header wins for duplicate keys
+//
+// 8. Cancellable Context:
 //   - Creates a cancellable context that can be used to cancel upstream requests when clients disconnect
 //   - This is critical for streaming requests where write errors indicate client disconnects
 //   - Also useful for non-streaming requests to allow provider-level cancellation
 //
-// 7. Extra Headers (x-bf-eh-*):
+// 9. Extra Headers (x-bf-eh-*):
 //   - Any header starting with 'x-bf-eh-' is collected and added to the map stored under schemas.BifrostContextKeyExtraHeaders
 //   - The prefix is stripped, the remainder is lower-cased, and duplicate names append values
 //   - This allows callers to send arbitrary context metadata without needing to extend the public schema
@@ -111,6 +120,7 @@ func ConvertToBifrostContext(ctx *fasthttp.RequestCtx, allowDirectKeys bool, hea
 		"x-api-key":      true,
 		"x-goog-api-key": true,
 		"x-bf-api-key":   true,
+		"x-bf-key-id":    true,
 		"x-bf-vk":        true,
 	}
 
@@ -236,6 +246,25 @@ func ConvertToBifrostContext(ctx *fasthttp.RequestCtx, allowDirectKeys bool, hea
 			}
 			return true
 		}
+		if keyStr == "x-bf-key-id" {
+			if keyID := strings.TrimSpace(string(value)); keyID != "" {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyAPIKeyID, keyID)
+			}
+			return true
+		}
+		// Provider pinning and fallback override headers (applied by the core, governance still validates them)
+		if keyStr == "x-bf-provider" {
+			if provider := strings.TrimSpace(string(value)); provider != "" {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyProviderOverride, schemas.ModelProvider(provider))
+			}
+			return true
+		}
+		if keyStr == "x-bf-fallbacks" {
+			if fallbacks, ok := parseFallbacksHeader(string(value)); ok {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyFallbacksOverride, fallbacks)
+			}
+			return true
+		}
 		// Handle cache key header (x-bf-cache-key)
 		if keyStr == "x-bf-cache-key" {
 			bifrostCtx.SetValue(semanticcache.CacheKey, string(value))
@@ -289,6 +318,13 @@ func ConvertToBifrostContext(ctx *fasthttp.RequestCtx, allowDirectKeys bool, hea
 			}
 			return true
 		}
+		// Cache no cache header
+		if keyStr == "x-bf-cache-no-cache" {
+			if valueStr := string(value); valueStr == "true" {
+				bifrostCtx.SetValue(semanticcache.CacheNoCacheKey, true)
+			}
+			return true
+		}
 		if labelName, ok := strings.CutPrefix(keyStr, "x-bf-eh-"); ok {
 			// Skip empty header names after prefix removal
 			if labelName == "" {
@@ -358,6 +394,79 @@ func ConvertToBifrostContext(ctx *fasthttp.RequestCtx, allowDirectKeys bool, hea
 			}
 			return true
 		}
+		// Inline image URLs header (download URL image results and return them as base64)
+		if keyStr == "x-bf-inline-images" {
+			if valueStr := string(value); valueStr == "true" {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyInlineImageURLs, true)
+			}
+			return true
+		}
+		// Strip reasoning header (remove reasoning from chat responses before they are returned)
+		if keyStr == "x-bf-strip-reasoning" {
+			if valueStr := string(value); valueStr == "true" {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyStripReasoning, true)
+			}
+			return true
+		}
+		// Parameter preset header (expanded into request params by the core)
+		if keyStr == "x-bf-preset" {
+			if valueStr := strings.TrimSpace(string(value)); valueStr != "" {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyParameterPreset, valueStr)
+			}
+			return true
+		}
+		// Request priority header (used by load shedding, unknown values fall back to normal)
+		if keyStr == "x-bf-priority" {
+			if priority, ok := schemas.ParseRequestPriority(strings.ToLower(strings.TrimSpace(string(value)))); ok {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyRequestPriority, priority)
+			}
+			return true
+		}
+		// Hedge delay header: a duration ("250ms") or plain milliseconds; invalid values leave hedging off
+		if keyStr == "x-bf-hedge-delay" {
+			valueStr := strings.TrimSpace(string(value))
+			delay, err := time.ParseDuration(valueStr)
+			if err != nil {
+				if ms, parseErr := strconv.Atoi(valueStr); parseErr == nil {
+					delay, err = time.Duration(ms)*time.Millisecond, nil
+				}
+			}
+			if err == nil && delay > 0 {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyHedgeDelay, delay)
+			}
+			return true
+		}
+		// Tool execution headers (execute calls of the configured tool webhooks and continue the conversation)
+		if keyStr == "x-bf-execute-tools" {
+			if valueStr := string(value); valueStr == "true" {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyExecuteTools, true)
+			}
+			return true
+		}
+		if keyStr == "x-bf-max-tool-iterations" {
+			if iterations, err := strconv.Atoi(strings.TrimSpace(string(value))); err == nil && iterations > 0 {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyMaxToolIterations, iterations)
+			}
+			return true
+		}
+		// Traffic split key header (requests with the same key get the same traffic split variant)
+		if keyStr == "x-bf-split-key" {
+			if valueStr := strings.TrimSpace(string(value)); valueStr != "" {
+				bifrostCtx.SetValue(schemas.BifrostContextKeyTrafficSplitKey, valueStr)
+			}
+			return true
+		}
+		// Request tags header (key=value pairs for cost attribution, merged with the tags of the request body)
+		if keyStr == "x-bf-tags" {
+			tags, err := schemas.ParseRequestTags(string(value))
+			if err == nil {
+				err = schemas.MergeRequestTags(bifrostCtx, tags)
+			}
+			if err != nil {
+				logger.Warn("ignoring invalid x-bf-tags header: %v", err)
+			}
+			return true
+		}
 		return true
 	})
 
@@ -427,6 +536,24 @@ func ConvertToBifrostContext(ctx *fasthttp.RequestCtx, allowDirectKeys bool, hea
 	return bifrostCtx, cancel
 }
 
+// parseFallbacksHeader parses the x-bf-fallbacks header: "provider/model" entries separated by commas,
+// or "none" to disable fallbacks. Entries without a known provider are ignored; it reports false when
+// the header has no valid entry, so that the fallbacks of the request are kept.
+func parseFallbacksHeader(value string) ([]schemas.Fallback, bool) {
+	value = strings.TrimSpace(value)
+	if strings.EqualFold(value, "none") {
+		return []schemas.Fallback{}, true
+	}
+	var fallbacks []schemas.Fallback
+	for entry := range strings.SplitSeq(value, ",") {
+		provider, model := schemas.ParseModelString(strings.TrimSpace(entry), "")
+		if provider != "" && model != "" {
+			fallbacks = append(fallbacks, schemas.Fallback{Provider: provider, Model: model})
+		}
+	}
+	return fallbacks, len(fallbacks) > 0
+}
+
 // BuildHTTPRequestFromFastHTTP creates an HTTPRequest from fasthttp context for streaming handlers.
 // The returned request should be released with schemas.ReleaseHTTPRequest when done.
 // Note: Body is not copied for streaming (body was already consumed for the request).
@@ -0,0 +1,57 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	"github.com/capsohq/bifrost/framework/encrypt"
+)
+
+// RotateEncryptionKey opens the config store of configDirPath with the encryption settings of its
+// config.json (or the environment when there is none), creates a new data key and re-encrypts
+// every stored secret with it. Opening the store also migrates rows encrypted before envelope
+// encryption and re-wraps the data keys after a master key change. Returns the number of
+// re-encrypted rows.
+func RotateEncryptionKey(ctx context.Context, configDirPath string) (int, error) {
+	storeConfig := &configstore.Config{
+		Enabled: true,
+		Type:    configstore.ConfigStoreTypeSQLite,
+		Config:  &configstore.SQLiteConfig{Path: filepath.Join(configDirPath, "config.db")},
+	}
+	data, err := os.ReadFile(filepath.Join(configDirPath, "config.json"))
+	switch {
+	case err == nil:
+		var configData ConfigData
+		if err := json.Unmarshal(data, &configData); err != nil {
+			return 0, fmt.Errorf("failed to unmarshal config: %w", err)
+		}
+		if err := initEncryptionFromFile(ctx, &configData); err != nil {
+			return 0, err
+		}
+		if configData.ConfigStoreConfig == nil || !configData.ConfigStoreConfig.Enabled {
+			return 0, fmt.Errorf("config store is not enabled, there are no stored secrets to rotate")
+		}
+		storeConfig = configData.ConfigStoreConfig
+	case os.IsNotExist(err):
+		if err := initEncryption(ctx, schemas.NewEnvVar("env.BIFROST_ENCRYPTION_KEY"), schemas.NewEnvVar("env.BIFROST_ENCRYPTION_KMS_KEY_ID")); err != nil {
+			return 0, err
+		}
+	default:
+		return 0, fmt.Errorf("failed to read config file: %w", err)
+	}
+	if !encrypt.IsEnabled() {
+		return 0, fmt.Errorf("encryption is not enabled: set encryption_key or encryption_kms_key_id")
+	}
+
+	store, err := configstore.NewConfigStore(ctx, storeConfig, logger)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open config store: %w", err)
+	}
+	defer store.Close(ctx)
+	return store.RotateEncryptionKey(ctx)
+}
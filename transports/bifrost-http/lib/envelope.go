@@ -0,0 +1,169 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/bytedance/sonic/ast"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// ResponseEnvelope selects where Bifrost's own metadata (the extra_fields object) goes in responses of the
+// Bifrost-native /v1 routes. Strict OpenAI SDKs reject unknown fields, while other clients want the
+// metadata in one predictable place.
+type ResponseEnvelope string
+
+const (
+	// ResponseEnvelopeInline keeps extra_fields inside the response body.
+	ResponseEnvelopeInline ResponseEnvelope = ""
+	// ResponseEnvelopeStrip removes extra_fields, leaving the plain OpenAI-compatible body.
+	ResponseEnvelopeStrip ResponseEnvelope = "strip"
+	// ResponseEnvelopeBifrost wraps the stripped body as {"data": ..., "bifrost": ...}.
+	ResponseEnvelopeBifrost ResponseEnvelope = "envelope"
+)
+
+// ResponseEnvelopeHeader overrides the configured envelope of a route for a single request.
+const ResponseEnvelopeHeader = "x-bf-response-envelope"
+
+// ParseResponseEnvelope parses a configured envelope name. An empty string and "inline" map to ResponseEnvelopeInline.
+func ParseResponseEnvelope(value string) (ResponseEnvelope, error) {
+	switch envelope := ResponseEnvelope(strings.ToLower(strings.TrimSpace(value))); envelope {
+	case ResponseEnvelopeInline, "inline":
+		return ResponseEnvelopeInline, nil
+	case ResponseEnvelopeStrip, ResponseEnvelopeBifrost:
+		return envelope, nil
+	default:
+		return ResponseEnvelopeInline, fmt.Errorf("unsupported response envelope %q (expected %q, %q or %q)", value, "inline", ResponseEnvelopeStrip, ResponseEnvelopeBifrost)
+	}
+}
+
+// ValidateResponseEnvelopes checks a route -> envelope map from the client config.
+func ValidateResponseEnvelopes(envelopes map[string]string) error {
+	for route, value := range envelopes {
+		if !strings.HasPrefix(route, "/") {
+			return fmt.Errorf("response_envelopes: route %q must start with '/'", route)
+		}
+		if _, err := ParseResponseEnvelope(value); err != nil {
+			return fmt.Errorf("response_envelopes: route %q: %w", route, err)
+		}
+	}
+	return nil
+}
+
+// ResolveResponseEnvelope returns the envelope for a request to path. A valid override (the
+// ResponseEnvelopeHeader value) wins; otherwise routes match like ResolveSSEDialect.
+func ResolveResponseEnvelope(envelopes map[string]string, path string, override string) ResponseEnvelope {
+	if override != "" {
+		if envelope, err := ParseResponseEnvelope(override); err == nil {
+			return envelope
+		}
+	}
+	if len(envelopes) == 0 {
+		return ResponseEnvelopeInline
+	}
+	envelope, err := ParseResponseEnvelope(matchRoute(envelopes, path))
+	if err != nil {
+		return ResponseEnvelopeInline
+	}
+	return envelope
+}
+
+// ResponseEnvelopeMetadata is the "bifrost" object of an enveloped response.
+type ResponseEnvelopeMetadata struct {
+	RequestID       string                     `json:"request_id,omitempty"`
+	RequestType     schemas.RequestType        `json:"request_type,omitempty"`
+	Provider        schemas.ModelProvider      `json:"provider,omitempty"`
+	ModelRequested  string                     `json:"model_requested,omitempty"`
+	ModelDeployment string                     `json:"model_deployment,omitempty"`
+	Latency         int64                      `json:"latency"` // in milliseconds
+	Routing         ResponseEnvelopeRouting    `json:"routing"`
+	Cost            *float64                   `json:"cost,omitempty"` // in USD, when pricing is known for the model
+	Cache           *schemas.BifrostCacheDebug `json:"cache,omitempty"`
+	Warnings        []string                   `json:"warnings,omitempty"`
+}
+
+// ResponseEnvelopeRouting describes how a request was routed to the provider that answered it.
+type ResponseEnvelopeRouting struct {
+	FallbackIndex  int      `json:"fallback_index"` // 0 for the primary provider, 1 for the first fallback, etc.
+	Retries        int      `json:"retries"`
+	KeyName        string   `json:"key_name,omitempty"`
+	VirtualKeyName string   `json:"virtual_key_name,omitempty"`
+	RoutingRule    string   `json:"routing_rule,omitempty"`
+	Engines        []string `json:"engines,omitempty"`
+	Logs           []string `json:"logs,omitempty"`
+}
+
+// NewResponseEnvelopeMetadata collects the metadata of a response from its extra fields and the request context.
+func NewResponseEnvelopeMetadata(bifrostCtx *schemas.BifrostContext, extraFields *schemas.BifrostResponseExtraFields, cost *float64) *ResponseEnvelopeMetadata {
+	metadata := &ResponseEnvelopeMetadata{
+		RequestType:     extraFields.RequestType,
+		Provider:        extraFields.Provider,
+		ModelRequested:  extraFields.ModelRequested,
+		ModelDeployment: extraFields.ModelDeployment,
+		Latency:         extraFields.Latency,
+		Cost:            cost,
+		Cache:           extraFields.CacheDebug,
+		Warnings:        extraFields.Warnings,
+	}
+	if bifrostCtx == nil {
+		return metadata
+	}
+	metadata.RequestID, _ = bifrostCtx.Value(schemas.BifrostContextKeyRequestID).(string)
+	metadata.Routing.FallbackIndex, _ = bifrostCtx.Value(schemas.BifrostContextKeyFallbackIndex).(int)
+	metadata.Routing.Retries, _ = bifrostCtx.Value(schemas.BifrostContextKeyNumberOfRetries).(int)
+	metadata.Routing.KeyName, _ = bifrostCtx.Value(schemas.BifrostContextKeySelectedKeyName).(string)
+	metadata.Routing.VirtualKeyName, _ = bifrostCtx.Value(schemas.BifrostContextKeyGovernanceVirtualKeyName).(string)
+	metadata.Routing.RoutingRule, _ = bifrostCtx.Value(schemas.BifrostContextKeyGovernanceRoutingRuleName).(string)
+	metadata.Routing.Engines, _ = bifrostCtx.Value(schemas.BifrostContextKeyRoutingEnginesUsed).([]string)
+	if logs, ok := bifrostCtx.Value(schemas.BifrostContextKeyRoutingEngineLogs).([]schemas.RoutingEngineLogEntry); ok {
+		for _, entry := range logs {
+			metadata.Routing.Logs = append(metadata.Routing.Logs, fmt.Sprintf("[%s] %s", entry.Engine, entry.Message))
+		}
+	}
+	return metadata
+}
+
+// Apply rewrites a marshalled response body for the envelope. Bodies that are not JSON objects are only
+// wrapped, never modified. cost is reported in the envelope metadata and may be nil.
+func (e ResponseEnvelope) Apply(body []byte, bifrostCtx *schemas.BifrostContext, cost *float64) ([]byte, error) {
+	if e == ResponseEnvelopeInline {
+		return body, nil
+	}
+
+	var extraFields schemas.BifrostResponseExtraFields
+	stripped := body
+	root, err := sonic.Get(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
+	if root.TypeSafe() == ast.V_OBJECT {
+		if node := root.Get("extra_fields"); node.Exists() {
+			raw, err := node.Raw()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read extra_fields: %w", err)
+			}
+			if err := sonic.UnmarshalString(raw, &extraFields); err != nil {
+				return nil, fmt.Errorf("failed to decode extra_fields: %w", err)
+			}
+			if _, err := root.Unset("extra_fields"); err != nil {
+				return nil, fmt.Errorf("failed to strip extra_fields: %w", err)
+			}
+			if stripped, err = root.MarshalJSON(); err != nil {
+				return nil, fmt.Errorf("failed to encode response: %w", err)
+			}
+		}
+	}
+
+	if e == ResponseEnvelopeStrip {
+		return stripped, nil
+	}
+	return sonic.Marshal(struct {
+		Data    json.RawMessage           `json:"data"`
+		Bifrost *ResponseEnvelopeMetadata `json:"bifrost"`
+	}{
+		Data:    stripped,
+		Bifrost: NewResponseEnvelopeMetadata(bifrostCtx, &extraFields, cost),
+	})
+}
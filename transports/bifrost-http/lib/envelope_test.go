@@ -0,0 +1,100 @@
+package lib
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestResolveResponseEnvelope(t *testing.T) {
+	envelopes := map[string]string{
+		"/v1/chat/completions": "strip",
+		"/v1/*":                "envelope",
+		"/v1/embeddings":       "bogus",
+	}
+	tests := []struct {
+		path     string
+		override string
+		want     ResponseEnvelope
+	}{
+		{path: "/v1/chat/completions", want: ResponseEnvelopeStrip},
+		{path: "/v1/responses", want: ResponseEnvelopeBifrost},
+		{path: "/v1/embeddings", want: ResponseEnvelopeInline},
+		{path: "/openai/v1/chat/completions", want: ResponseEnvelopeInline},
+		{path: "/v1/chat/completions", override: "envelope", want: ResponseEnvelopeBifrost},
+		{path: "/v1/responses", override: "inline", want: ResponseEnvelopeInline},
+		{path: "/v1/responses", override: "bogus", want: ResponseEnvelopeBifrost},
+	}
+	for _, tt := range tests {
+		if got := ResolveResponseEnvelope(envelopes, tt.path, tt.override); got != tt.want {
+			t.Errorf("ResolveResponseEnvelope(%q, %q) = %q, want %q", tt.path, tt.override, got, tt.want)
+		}
+	}
+
+	if err := ValidateResponseEnvelopes(map[string]string{"/v1/*": "envelope", "/v1/responses": "inline"}); err != nil {
+		t.Errorf("ValidateResponseEnvelopes() error = %v", err)
+	}
+	if err := ValidateResponseEnvelopes(map[string]string{"v1/*": "strip"}); err == nil {
+		t.Error("expected error for a route without a leading '/'")
+	}
+	if err := ValidateResponseEnvelopes(map[string]string{"/v1/*": "wrapped"}); err == nil {
+		t.Error("expected error for an unknown envelope")
+	}
+}
+
+func TestResponseEnvelope_Apply(t *testing.T) {
+	body := []byte(`{"id":"chatcmpl-1","object":"chat.completion","extra_fields":{"request_type":"chat_completion","provider":"openai","model_requested":"gpt-4o","latency":42,"cache_debug":{"cache_hit":true},"warnings":["history compacted"]},"usage":{"total_tokens":3}}`)
+
+	inline, err := ResponseEnvelopeInline.Apply(body, nil, nil)
+	if err != nil || string(inline) != string(body) {
+		t.Errorf("inline Apply() = %s, %v; want the body unchanged", inline, err)
+	}
+
+	stripped, err := ResponseEnvelopeStrip.Apply(body, nil, nil)
+	if err != nil {
+		t.Fatalf("strip Apply() error = %v", err)
+	}
+	if strings.Contains(string(stripped), "extra_fields") || !strings.HasPrefix(string(stripped), `{"id":"chatcmpl-1","object":"chat.completion"`) {
+		t.Errorf("strip Apply() = %s, want extra_fields removed and field order kept", stripped)
+	}
+
+	bifrostCtx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+	bifrostCtx.SetValue(schemas.BifrostContextKeyRequestID, "req-1")
+	bifrostCtx.SetValue(schemas.BifrostContextKeyFallbackIndex, 1)
+	bifrostCtx.SetValue(schemas.BifrostContextKeySelectedKeyName, "openai-backup")
+	bifrostCtx.SetValue(schemas.BifrostContextKeyRoutingEngineLogs, []schemas.RoutingEngineLogEntry{{Engine: "governance", Message: "selected openai"}})
+	cost := 0.25
+	enveloped, err := ResponseEnvelopeBifrost.Apply(body, bifrostCtx, &cost)
+	if err != nil {
+		t.Fatalf("envelope Apply() error = %v", err)
+	}
+	var decoded struct {
+		Data    map[string]any           `json:"data"`
+		Bifrost ResponseEnvelopeMetadata `json:"bifrost"`
+	}
+	if err := sonic.Unmarshal(enveloped, &decoded); err != nil {
+		t.Fatalf("failed to decode envelope %s: %v", enveloped, err)
+	}
+	if decoded.Data["id"] != "chatcmpl-1" || decoded.Data["extra_fields"] != nil {
+		t.Errorf("envelope data = %v, want the stripped response", decoded.Data)
+	}
+	meta := decoded.Bifrost
+	if meta.RequestID != "req-1" || meta.Provider != schemas.OpenAI || meta.ModelRequested != "gpt-4o" || meta.Latency != 42 {
+		t.Errorf("envelope metadata = %+v", meta)
+	}
+	if meta.Routing.FallbackIndex != 1 || meta.Routing.KeyName != "openai-backup" || len(meta.Routing.Logs) != 1 || meta.Routing.Logs[0] != "[governance] selected openai" {
+		t.Errorf("envelope routing = %+v", meta.Routing)
+	}
+	if meta.Cost == nil || *meta.Cost != 0.25 || meta.Cache == nil || !meta.Cache.CacheHit || len(meta.Warnings) != 1 {
+		t.Errorf("envelope cost, cache or warnings = %v, %+v, %v", meta.Cost, meta.Cache, meta.Warnings)
+	}
+
+	// Non-object bodies are wrapped as they are
+	enveloped, err = ResponseEnvelopeBifrost.Apply([]byte(`[1,2]`), nil, nil)
+	if err != nil || !strings.HasPrefix(string(enveloped), `{"data":[1,2],"bifrost":`) {
+		t.Errorf("envelope Apply() of an array = %s, %v", enveloped, err)
+	}
+}
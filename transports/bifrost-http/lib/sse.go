@@ -0,0 +1,253 @@
+package lib
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// SSEDialect selects the Server-Sent Events format used when relaying a stream to a client.
+// Client SDKs differ in what they expect: OpenAI SDKs read data-only events and stop at
+// "data: [DONE]", while Anthropic SDKs dispatch on Messages events (message_start,
+// content_block_delta, ...) named on the "event:" line and stop at message_stop.
+type SSEDialect string
+
+const (
+	// SSEDialectDefault keeps the route's native framing.
+	SSEDialectDefault SSEDialect = ""
+	// SSEDialectOpenAI emits data-only events and terminates the stream with "data: [DONE]".
+	SSEDialectOpenAI SSEDialect = "openai"
+	// SSEDialectAnthropic converts chat completion and Responses streams into Anthropic Messages
+	// events (see AnthropicStreamConverter) and sends no [DONE] terminator. Other streams keep their
+	// native payloads.
+	SSEDialectAnthropic SSEDialect = "anthropic"
+)
+
+// sseDoneMarker is the OpenAI-style stream terminator.
+const sseDoneMarker = "data: [DONE]\n\n"
+
+// sseKeepAliveComment is an SSE comment line. Clients ignore comments, so it keeps idle connections
+// open through proxies and load balancers without producing an event.
+const sseKeepAliveComment = ": keep-alive\n\n"
+
+// DefaultSSEKeepAliveInterval is the silence after which a keep-alive comment is written to a stream.
+const DefaultSSEKeepAliveInterval = 15 * time.Second
+
+// ParseSSEDialect parses a configured dialect name. An empty string maps to SSEDialectDefault.
+func ParseSSEDialect(value string) (SSEDialect, error) {
+	switch dialect := SSEDialect(strings.ToLower(strings.TrimSpace(value))); dialect {
+	case SSEDialectDefault, SSEDialectOpenAI, SSEDialectAnthropic:
+		return dialect, nil
+	default:
+		return SSEDialectDefault, fmt.Errorf("unsupported SSE output dialect %q (expected %q or %q)", value, SSEDialectOpenAI, SSEDialectAnthropic)
+	}
+}
+
+// ValidateSSEOutputDialects checks a route -> dialect map from the client config.
+func ValidateSSEOutputDialects(dialects map[string]string) error {
+	for route, value := range dialects {
+		if !strings.HasPrefix(route, "/") {
+			return fmt.Errorf("sse_output_dialects: route %q must start with '/'", route)
+		}
+		if _, err := ParseSSEDialect(value); err != nil {
+			return fmt.Errorf("sse_output_dialects: route %q: %w", route, err)
+		}
+	}
+	return nil
+}
+
+// ResolveSSEDialect returns the dialect configured for path. Routes match exactly, or by prefix
+// when the configured route ends with "*" (e.g. "/anthropic/*"); the longest prefix wins.
+// Unknown or unconfigured routes resolve to SSEDialectDefault.
+func ResolveSSEDialect(dialects map[string]string, path string) SSEDialect {
+	if len(dialects) == 0 {
+		return SSEDialectDefault
+	}
+	dialect, err := ParseSSEDialect(matchRoute(dialects, path))
+	if err != nil {
+		return SSEDialectDefault
+	}
+	return dialect
+}
+
+// matchRoute returns the value configured for path in a route -> value map. Routes match exactly, or by
+// prefix when the configured route ends with "*"; the longest prefix wins. It returns "" when nothing matches.
+func matchRoute(routes map[string]string, path string) string {
+	if value, ok := routes[path]; ok {
+		return value
+	}
+	var value string
+	longest := -1
+	for route, candidate := range routes {
+		prefix, isPrefix := strings.CutSuffix(route, "*")
+		if isPrefix && len(prefix) > longest && strings.HasPrefix(path, prefix) {
+			value, longest = candidate, len(prefix)
+		}
+	}
+	return value
+}
+
+// EventLine returns the event name to write before a data line, or "" to omit the "event:" line.
+// nativeEventType is the name the route would use on its own; data is the event payload.
+func (d SSEDialect) EventLine(nativeEventType string, data []byte) string {
+	switch d {
+	case SSEDialectOpenAI:
+		return ""
+	case SSEDialectAnthropic:
+		if nativeEventType != "" {
+			return nativeEventType
+		}
+		// Anthropic payloads carry their event name in "type"; others are left unnamed
+		if node, err := sonic.Get(data, "type"); err == nil {
+			if eventType, err := node.String(); err == nil && eventType != "" {
+				return eventType
+			}
+		}
+		return ""
+	default:
+		return nativeEventType
+	}
+}
+
+// WriteEvent writes a single SSE event framed for the dialect.
+func (d SSEDialect) WriteEvent(w io.Writer, nativeEventType string, data []byte) error {
+	if eventType := d.EventLine(nativeEventType, data); eventType != "" {
+		if _, err := fmt.Fprintf(w, "event: %s\n", eventType); err != nil {
+			return err
+		}
+	}
+	if bytes.IndexByte(data, '\n') >= 0 {
+		// Multi-line payloads need one "data:" line per line
+		data = bytes.ReplaceAll(data, []byte("\n"), []byte("\ndata: "))
+	}
+	_, err := fmt.Fprintf(w, "data: %s\n\n", data)
+	return err
+}
+
+// Reframe rewrites a pre-formatted SSE payload (one or more "event:"/"data:" blocks) for the dialect.
+// The default dialect returns the payload unchanged.
+func (d SSEDialect) Reframe(payload string) string {
+	if d == SSEDialectDefault {
+		return payload
+	}
+	var sb strings.Builder
+	for _, block := range strings.Split(payload, "\n\n") {
+		var eventType string
+		var dataLines []string
+		for _, line := range strings.Split(block, "\n") {
+			if value, ok := strings.CutPrefix(line, "event:"); ok {
+				eventType = strings.TrimSpace(value)
+			} else if value, ok := strings.CutPrefix(line, "data:"); ok {
+				dataLines = append(dataLines, strings.TrimPrefix(value, " "))
+			}
+		}
+		if len(dataLines) == 0 {
+			continue
+		}
+		if len(dataLines) == 1 && dataLines[0] == "[DONE]" {
+			// Terminators are governed by SendsDoneMarker, not relayed as events
+			if d == SSEDialectOpenAI {
+				sb.WriteString(sseDoneMarker)
+			}
+			continue
+		}
+		// Writes to a strings.Builder cannot fail
+		_ = d.WriteEvent(&sb, eventType, []byte(strings.Join(dataLines, "\n")))
+	}
+	return sb.String()
+}
+
+// SendsDoneMarker reports whether the stream should end with "data: [DONE]".
+// routeDefault is what the route does natively.
+func (d SSEDialect) SendsDoneMarker(routeDefault bool) bool {
+	switch d {
+	case SSEDialectOpenAI:
+		return true
+	case SSEDialectAnthropic:
+		return false
+	default:
+		return routeDefault
+	}
+}
+
+// WriteDoneMarker writes the OpenAI-style "data: [DONE]" terminator.
+func WriteDoneMarker(w io.Writer) error {
+	_, err := fmt.Fprint(w, sseDoneMarker)
+	return err
+}
+
+// ResolveSSEKeepAliveInterval converts the sse_keep_alive_interval client config value in seconds.
+// Zero uses DefaultSSEKeepAliveInterval and a negative value disables keep-alives.
+func ResolveSSEKeepAliveInterval(seconds int) time.Duration {
+	switch {
+	case seconds < 0:
+		return 0
+	case seconds == 0:
+		return DefaultSSEKeepAliveInterval
+	default:
+		return time.Duration(seconds) * time.Second
+	}
+}
+
+// WriteKeepAlive writes and flushes an SSE keep-alive comment. An error means the client disconnected.
+func WriteKeepAlive(w *bufio.Writer) error {
+	if _, err := w.WriteString(sseKeepAliveComment); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// SSEKeepAlive writes keep-alive comments while a stream waits for its next chunk. Besides keeping
+// idle connections open, the writes are how a client disconnect is noticed while the provider is
+// silent: fasthttp only reports a disconnect as a write error.
+// A nil SSEKeepAlive waits for chunks without writing keep-alives.
+type SSEKeepAlive struct {
+	interval time.Duration
+	timer    *time.Timer
+}
+
+// NewSSEKeepAlive creates a keep-alive writing a comment after each interval of silence.
+// It returns nil when interval is not positive.
+func NewSSEKeepAlive(interval time.Duration) *SSEKeepAlive {
+	if interval <= 0 {
+		return nil
+	}
+	return &SSEKeepAlive{interval: interval, timer: time.NewTimer(interval)}
+}
+
+// Next waits for the next chunk of stream, writing a keep-alive comment to w whenever the stream
+// has been silent for the interval. ok is false once the stream is closed. A non-nil error means a
+// keep-alive could not be written because the client disconnected; the caller should cancel the
+// upstream request.
+func (k *SSEKeepAlive) Next(w *bufio.Writer, stream <-chan *schemas.BifrostStreamChunk) (chunk *schemas.BifrostStreamChunk, ok bool, err error) {
+	if k == nil {
+		chunk, ok = <-stream
+		return chunk, ok, nil
+	}
+	for {
+		select {
+		case chunk, ok = <-stream:
+			// Restart the silence interval
+			k.timer.Reset(k.interval)
+			return chunk, ok, nil
+		case <-k.timer.C:
+			if err := WriteKeepAlive(w); err != nil {
+				return nil, false, err
+			}
+			k.timer.Reset(k.interval)
+		}
+	}
+}
+
+// Stop releases the timer of the keep-alive.
+func (k *SSEKeepAlive) Stop() {
+	if k != nil {
+		k.timer.Stop()
+	}
+}
@@ -0,0 +1,243 @@
+package lib
+
+import (
+	"io"
+
+	"github.com/bytedance/sonic"
+	"github.com/capsohq/bifrost/core/providers/anthropic"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+// AnthropicStreamConverter converts the chunks of one stream into Anthropic Messages stream events
+// for SSEDialectAnthropic: message_start, content_block_start, content_block_delta,
+// content_block_stop, message_delta and message_stop. Chat completion chunks are converted
+// incrementally and Responses stream chunks go through the converter of the /anthropic routes.
+// A converter holds the state of a single stream and must not be shared.
+type AnthropicStreamConverter struct {
+	ctx *schemas.BifrostContext
+
+	started bool
+	// stopped is set once message_stop was emitted, which Responses streams do themselves
+	stopped bool
+
+	// Content block currently open; blockType is "" when none is
+	blockIndex int
+	blockType  anthropic.AnthropicContentBlockType
+	// Chat tool call index of the open tool_use block
+	toolCallIndex uint16
+
+	stopReason   anthropic.AnthropicStopReason
+	inputTokens  int
+	outputTokens int
+}
+
+// NewAnthropicStreamConverter creates a converter for one stream. ctx is the request context,
+// which the Responses stream conversion reads.
+func NewAnthropicStreamConverter(ctx *schemas.BifrostContext) *AnthropicStreamConverter {
+	return &AnthropicStreamConverter{ctx: ctx, blockIndex: -1}
+}
+
+// Convert returns the Anthropic events for chunk. ok is false for chunks of streams without an
+// Anthropic equivalent (text completions, speech, transcriptions, images), which are relayed with
+// their native payloads.
+func (c *AnthropicStreamConverter) Convert(chunk *schemas.BifrostStreamChunk) (events []*anthropic.AnthropicStreamEvent, ok bool) {
+	switch {
+	case chunk == nil:
+		return nil, false
+	case chunk.BifrostError != nil:
+		// An error ends the stream, so the message is not stopped afterwards
+		c.stopped = true
+		return []*anthropic.AnthropicStreamEvent{AnthropicErrorEvent(chunk.BifrostError)}, true
+	case chunk.BifrostChatResponse != nil:
+		return c.convertChat(chunk.BifrostChatResponse), true
+	case chunk.BifrostResponsesStreamResponse != nil:
+		events = anthropic.ToAnthropicResponsesStreamResponse(c.ctx, chunk.BifrostResponsesStreamResponse)
+		for _, event := range events {
+			switch event.Type {
+			case anthropic.AnthropicStreamEventTypeMessageStart:
+				c.started = true
+			case anthropic.AnthropicStreamEventTypeMessageStop:
+				c.stopped = true
+			}
+		}
+		return events, true
+	default:
+		return nil, false
+	}
+}
+
+// Finish returns the events that end the message once the stream is over: closing the open content
+// block, message_delta with the stop reason and usage, and message_stop. It returns nothing when no
+// message was started or the stream already stopped it.
+func (c *AnthropicStreamConverter) Finish() []*anthropic.AnthropicStreamEvent {
+	if !c.started || c.stopped {
+		return nil
+	}
+	c.stopped = true
+	events := c.closeBlock()
+	stopReason := c.stopReason
+	if stopReason == "" {
+		stopReason = anthropic.AnthropicStopReasonEndTurn
+	}
+	return append(events,
+		&anthropic.AnthropicStreamEvent{
+			Type:  anthropic.AnthropicStreamEventTypeMessageDelta,
+			Delta: &anthropic.AnthropicStreamDelta{StopReason: &stopReason},
+			Usage: &anthropic.AnthropicUsage{InputTokens: c.inputTokens, OutputTokens: c.outputTokens},
+		},
+		&anthropic.AnthropicStreamEvent{Type: anthropic.AnthropicStreamEventTypeMessageStop},
+	)
+}
+
+// convertChat converts one chat completion chunk. Anthropic messages have a single choice, so only
+// the first choice is relayed.
+func (c *AnthropicStreamConverter) convertChat(resp *schemas.BifrostChatResponse) []*anthropic.AnthropicStreamEvent {
+	var events []*anthropic.AnthropicStreamEvent
+	if resp.Usage != nil {
+		c.inputTokens = resp.Usage.PromptTokens
+		c.outputTokens = resp.Usage.CompletionTokens
+	}
+	if !c.started {
+		c.started = true
+		events = append(events, &anthropic.AnthropicStreamEvent{
+			Type: anthropic.AnthropicStreamEventTypeMessageStart,
+			Message: &anthropic.AnthropicMessageResponse{
+				ID:      resp.ID,
+				Type:    "message",
+				Role:    string(schemas.ChatMessageRoleAssistant),
+				Content: []anthropic.AnthropicContentBlock{},
+				Model:   resp.Model,
+				Usage:   &anthropic.AnthropicUsage{InputTokens: c.inputTokens},
+			},
+		})
+	}
+	if len(resp.Choices) == 0 {
+		return events
+	}
+	choice := resp.Choices[0]
+	if choice.FinishReason != nil && *choice.FinishReason != "" {
+		c.stopReason = anthropic.ConvertBifrostFinishReasonToAnthropic(*choice.FinishReason)
+	}
+	if choice.ChatStreamResponseChoice == nil || choice.ChatStreamResponseChoice.Delta == nil {
+		return events
+	}
+	delta := choice.ChatStreamResponseChoice.Delta
+
+	if delta.Reasoning != nil && *delta.Reasoning != "" {
+		events = append(events, c.openBlock(anthropic.AnthropicContentBlockTypeThinking, &anthropic.AnthropicContentBlock{
+			Type:     anthropic.AnthropicContentBlockTypeThinking,
+			Thinking: schemas.Ptr(""),
+		})...)
+		events = append(events, c.blockDelta(&anthropic.AnthropicStreamDelta{
+			Type:     anthropic.AnthropicStreamDeltaTypeThinking,
+			Thinking: delta.Reasoning,
+		}))
+	}
+	for _, detail := range delta.ReasoningDetails {
+		if detail.Signature != nil && *detail.Signature != "" && c.blockType == anthropic.AnthropicContentBlockTypeThinking {
+			events = append(events, c.blockDelta(&anthropic.AnthropicStreamDelta{
+				Type:      anthropic.AnthropicStreamDeltaTypeSignature,
+				Signature: detail.Signature,
+			}))
+		}
+	}
+	if delta.Content != nil && *delta.Content != "" {
+		events = append(events, c.openBlock(anthropic.AnthropicContentBlockTypeText, &anthropic.AnthropicContentBlock{
+			Type: anthropic.AnthropicContentBlockTypeText,
+			Text: schemas.Ptr(""),
+		})...)
+		events = append(events, c.blockDelta(&anthropic.AnthropicStreamDelta{
+			Type: anthropic.AnthropicStreamDeltaTypeText,
+			Text: delta.Content,
+		}))
+	}
+	for _, toolCall := range delta.ToolCalls {
+		// The first chunk of a tool call carries its ID and name, later ones only argument fragments
+		if toolCall.ID != nil || (toolCall.Function.Name != nil && *toolCall.Function.Name != "") {
+			if c.blockType != anthropic.AnthropicContentBlockTypeToolUse || c.toolCallIndex != toolCall.Index {
+				events = append(events, c.closeBlock()...)
+			}
+			events = append(events, c.openBlock(anthropic.AnthropicContentBlockTypeToolUse, &anthropic.AnthropicContentBlock{
+				Type:  anthropic.AnthropicContentBlockTypeToolUse,
+				ID:    toolCall.ID,
+				Name:  toolCall.Function.Name,
+				Input: map[string]any{},
+			})...)
+			c.toolCallIndex = toolCall.Index
+		}
+		if toolCall.Function.Arguments != "" && c.blockType == anthropic.AnthropicContentBlockTypeToolUse {
+			arguments := toolCall.Function.Arguments
+			events = append(events, c.blockDelta(&anthropic.AnthropicStreamDelta{
+				Type:        anthropic.AnthropicStreamDeltaTypeInputJSON,
+				PartialJSON: &arguments,
+			}))
+		}
+	}
+	return events
+}
+
+// openBlock starts a content block of blockType, closing the open block first. It returns no
+// events when a block of that type is already open.
+func (c *AnthropicStreamConverter) openBlock(blockType anthropic.AnthropicContentBlockType, block *anthropic.AnthropicContentBlock) []*anthropic.AnthropicStreamEvent {
+	if c.blockType == blockType {
+		return nil
+	}
+	events := c.closeBlock()
+	c.blockIndex++
+	c.blockType = blockType
+	return append(events, &anthropic.AnthropicStreamEvent{
+		Type:         anthropic.AnthropicStreamEventTypeContentBlockStart,
+		Index:        schemas.Ptr(c.blockIndex),
+		ContentBlock: block,
+	})
+}
+
+// closeBlock returns content_block_stop for the open block, or nothing when no block is open.
+func (c *AnthropicStreamConverter) closeBlock() []*anthropic.AnthropicStreamEvent {
+	if c.blockType == "" {
+		return nil
+	}
+	c.blockType = ""
+	return []*anthropic.AnthropicStreamEvent{{
+		Type:  anthropic.AnthropicStreamEventTypeContentBlockStop,
+		Index: schemas.Ptr(c.blockIndex),
+	}}
+}
+
+// blockDelta returns a content_block_delta event for the open block.
+func (c *AnthropicStreamConverter) blockDelta(delta *anthropic.AnthropicStreamDelta) *anthropic.AnthropicStreamEvent {
+	return &anthropic.AnthropicStreamEvent{
+		Type:  anthropic.AnthropicStreamEventTypeContentBlockDelta,
+		Index: schemas.Ptr(c.blockIndex),
+		Delta: delta,
+	}
+}
+
+// AnthropicErrorEvent converts a Bifrost error into an Anthropic stream error event.
+func AnthropicErrorEvent(bifrostErr *schemas.BifrostError) *anthropic.AnthropicStreamEvent {
+	errorType, message := "api_error", ""
+	if bifrostErr != nil && bifrostErr.Error != nil {
+		if bifrostErr.Error.Type != nil && *bifrostErr.Error.Type != "" {
+			errorType = *bifrostErr.Error.Type
+		}
+		message = bifrostErr.Error.Message
+	}
+	return &anthropic.AnthropicStreamEvent{
+		Type:  anthropic.AnthropicStreamEventTypeError,
+		Error: &anthropic.AnthropicStreamError{Type: errorType, Message: message},
+	}
+}
+
+// WriteAnthropicEvents writes events as named SSE events.
+func WriteAnthropicEvents(w io.Writer, events []*anthropic.AnthropicStreamEvent) error {
+	for _, event := range events {
+		data, err := sonic.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if err := SSEDialectAnthropic.WriteEvent(w, string(event.Type), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/providers/anthropic"
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func chatStreamChunk(delta schemas.ChatStreamResponseChoiceDelta, finishReason *string, usage *schemas.BifrostLLMUsage) *schemas.BifrostStreamChunk {
+	return &schemas.BifrostStreamChunk{BifrostChatResponse: &schemas.BifrostChatResponse{
+		ID:    "chatcmpl-1",
+		Model: "gpt-4o",
+		Choices: []schemas.BifrostResponseChoice{{
+			FinishReason:             finishReason,
+			ChatStreamResponseChoice: &schemas.ChatStreamResponseChoice{Delta: &delta},
+		}},
+		Usage: usage,
+	}}
+}
+
+func eventTypes(events []*anthropic.AnthropicStreamEvent) []string {
+	types := make([]string, len(events))
+	for i, event := range events {
+		types[i] = string(event.Type)
+	}
+	return types
+}
+
+func TestAnthropicStreamConverter_ChatStream(t *testing.T) {
+	converter := NewAnthropicStreamConverter(schemas.NewBifrostContext(t.Context(), schemas.NoDeadline))
+	chunks := []*schemas.BifrostStreamChunk{
+		chatStreamChunk(schemas.ChatStreamResponseChoiceDelta{Reasoning: schemas.Ptr("Thinking")}, nil, nil),
+		chatStreamChunk(schemas.ChatStreamResponseChoiceDelta{Content: schemas.Ptr("Hello")}, nil, nil),
+		chatStreamChunk(schemas.ChatStreamResponseChoiceDelta{Content: schemas.Ptr(" world")}, nil, nil),
+		chatStreamChunk(schemas.ChatStreamResponseChoiceDelta{ToolCalls: []schemas.ChatAssistantMessageToolCall{{
+			ID:       schemas.Ptr("call_1"),
+			Function: schemas.ChatAssistantMessageToolCallFunction{Name: schemas.Ptr("get_weather")},
+		}}}, nil, nil),
+		chatStreamChunk(schemas.ChatStreamResponseChoiceDelta{ToolCalls: []schemas.ChatAssistantMessageToolCall{{
+			Function: schemas.ChatAssistantMessageToolCallFunction{Arguments: `{"city":"Paris"}`},
+		}}}, nil, nil),
+		chatStreamChunk(schemas.ChatStreamResponseChoiceDelta{}, schemas.Ptr("tool_calls"), &schemas.BifrostLLMUsage{PromptTokens: 10, CompletionTokens: 5}),
+	}
+
+	var events []*anthropic.AnthropicStreamEvent
+	for _, chunk := range chunks {
+		converted, ok := converter.Convert(chunk)
+		if !ok {
+			t.Fatalf("Convert() ok = false for a chat chunk")
+		}
+		events = append(events, converted...)
+	}
+	events = append(events, converter.Finish()...)
+
+	want := []string{
+		"message_start",
+		"content_block_start", "content_block_delta", "content_block_stop",
+		"content_block_start", "content_block_delta", "content_block_delta", "content_block_stop",
+		"content_block_start", "content_block_delta", "content_block_stop",
+		"message_delta", "message_stop",
+	}
+	if got := eventTypes(events); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("event types = %v, want %v", got, want)
+	}
+	if events[0].Message == nil || events[0].Message.ID != "chatcmpl-1" || events[0].Message.Role != "assistant" {
+		t.Errorf("message_start message = %+v", events[0].Message)
+	}
+	if block := events[8].ContentBlock; block == nil || block.Type != anthropic.AnthropicContentBlockTypeToolUse || *block.Name != "get_weather" || *events[8].Index != 2 {
+		t.Errorf("tool_use content_block_start = %+v", events[8])
+	}
+	if delta := events[9].Delta; delta.Type != anthropic.AnthropicStreamDeltaTypeInputJSON || *delta.PartialJSON != `{"city":"Paris"}` {
+		t.Errorf("input_json_delta = %+v", delta)
+	}
+	messageDelta := events[11]
+	if *messageDelta.Delta.StopReason != anthropic.AnthropicStopReasonToolUse || messageDelta.Usage.OutputTokens != 5 || messageDelta.Usage.InputTokens != 10 {
+		t.Errorf("message_delta = %+v, usage %+v", messageDelta.Delta, messageDelta.Usage)
+	}
+
+	var sb strings.Builder
+	if err := WriteAnthropicEvents(&sb, events[:1]); err != nil {
+		t.Fatalf("WriteAnthropicEvents() error = %v", err)
+	}
+	if !strings.HasPrefix(sb.String(), "event: message_start\ndata: {") {
+		t.Errorf("WriteAnthropicEvents() = %q", sb.String())
+	}
+}
+
+func TestAnthropicStreamConverter_ErrorEndsStream(t *testing.T) {
+	converter := NewAnthropicStreamConverter(schemas.NewBifrostContext(t.Context(), schemas.NoDeadline))
+	if _, ok := converter.Convert(chatStreamChunk(schemas.ChatStreamResponseChoiceDelta{Content: schemas.Ptr("Hi")}, nil, nil)); !ok {
+		t.Fatalf("Convert() ok = false for a chat chunk")
+	}
+	events, ok := converter.Convert(&schemas.BifrostStreamChunk{BifrostError: &schemas.BifrostError{
+		Error: &schemas.ErrorField{Type: schemas.Ptr("overloaded_error"), Message: "Overloaded"},
+	}})
+	if !ok || len(events) != 1 || events[0].Type != anthropic.AnthropicStreamEventTypeError {
+		t.Fatalf("error events = %v", eventTypes(events))
+	}
+	if events[0].Error.Type != "overloaded_error" || events[0].Error.Message != "Overloaded" {
+		t.Errorf("error = %+v", events[0].Error)
+	}
+	if finish := converter.Finish(); len(finish) != 0 {
+		t.Errorf("Finish() after an error = %v, want none", eventTypes(finish))
+	}
+}
+
+func TestAnthropicStreamConverter_OtherStreamsKeepNativePayloads(t *testing.T) {
+	converter := NewAnthropicStreamConverter(schemas.NewBifrostContext(t.Context(), schemas.NoDeadline))
+	if _, ok := converter.Convert(&schemas.BifrostStreamChunk{BifrostTextCompletionResponse: &schemas.BifrostTextCompletionResponse{}}); ok {
+		t.Errorf("Convert() ok = true for a text completion chunk")
+	}
+	if finish := converter.Finish(); len(finish) != 0 {
+		t.Errorf("Finish() without a message = %v, want none", eventTypes(finish))
+	}
+}
@@ -0,0 +1,175 @@
+package lib
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+)
+
+func TestResolveSSEDialect(t *testing.T) {
+	dialects := map[string]string{
+		"/v1/chat/completions": "anthropic",
+		"/openai/*":            "openai",
+		"/openai/v1/messages*": "Anthropic",
+		"/broken":              "xml",
+	}
+
+	tests := []struct {
+		path string
+		want SSEDialect
+	}{
+		{"/v1/chat/completions", SSEDialectAnthropic},
+		{"/openai/v1/chat/completions", SSEDialectOpenAI},
+		{"/openai/v1/messages", SSEDialectAnthropic}, // longest prefix wins
+		{"/v1/responses", SSEDialectDefault},
+		{"/broken", SSEDialectDefault}, // invalid values fall back to native framing
+	}
+	for _, tt := range tests {
+		if got := ResolveSSEDialect(dialects, tt.path); got != tt.want {
+			t.Errorf("ResolveSSEDialect(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+
+	if got := ResolveSSEDialect(nil, "/v1/chat/completions"); got != SSEDialectDefault {
+		t.Errorf("ResolveSSEDialect(nil) = %q, want default", got)
+	}
+}
+
+func TestValidateSSEOutputDialects(t *testing.T) {
+	if err := ValidateSSEOutputDialects(map[string]string{"/v1/chat/completions": "openai", "/anthropic/*": "anthropic"}); err != nil {
+		t.Errorf("unexpected error for valid dialects: %v", err)
+	}
+	if err := ValidateSSEOutputDialects(map[string]string{"/v1/chat/completions": "xml"}); err == nil {
+		t.Error("expected error for unsupported dialect")
+	}
+	if err := ValidateSSEOutputDialects(map[string]string{"v1/chat/completions": "openai"}); err == nil {
+		t.Error("expected error for route without leading slash")
+	}
+}
+
+func TestSSEDialect_WriteEvent(t *testing.T) {
+	data := []byte(`{"type":"content_block_delta","index":0}`)
+
+	tests := []struct {
+		name      string
+		dialect   SSEDialect
+		eventType string
+		payload   []byte
+		want      string
+	}{
+		{"default without event", SSEDialectDefault, "", data, "data: " + string(data) + "\n\n"},
+		{"default with event", SSEDialectDefault, "response.created", data, "event: response.created\ndata: " + string(data) + "\n\n"},
+		{"openai drops event", SSEDialectOpenAI, "response.created", data, "data: " + string(data) + "\n\n"},
+		{"anthropic keeps event", SSEDialectAnthropic, "error", data, "event: error\ndata: " + string(data) + "\n\n"},
+		{"anthropic derives event from type", SSEDialectAnthropic, "", data, "event: content_block_delta\ndata: " + string(data) + "\n\n"},
+		{"anthropic leaves non-anthropic payloads unnamed", SSEDialectAnthropic, "", []byte(`{"object":"transcript.text.delta"}`), "data: {\"object\":\"transcript.text.delta\"}\n\n"},
+		{"multi-line payload", SSEDialectOpenAI, "", []byte("a\nb"), "data: a\ndata: b\n\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sb strings.Builder
+			if err := tt.dialect.WriteEvent(&sb, tt.eventType, tt.payload); err != nil {
+				t.Fatalf("WriteEvent() error = %v", err)
+			}
+			if sb.String() != tt.want {
+				t.Errorf("WriteEvent() = %q, want %q", sb.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestSSEDialect_Reframe(t *testing.T) {
+	payload := "event: message_start\ndata: {\"type\":\"message_start\"}\n\nevent: ping\ndata: {\"type\":\"ping\"}\n\n"
+
+	if got := SSEDialectDefault.Reframe(payload); got != payload {
+		t.Errorf("default Reframe() changed payload: %q", got)
+	}
+	if got, want := SSEDialectOpenAI.Reframe(payload), "data: {\"type\":\"message_start\"}\n\ndata: {\"type\":\"ping\"}\n\n"; got != want {
+		t.Errorf("openai Reframe() = %q, want %q", got, want)
+	}
+	if got, want := SSEDialectAnthropic.Reframe("data: {\"type\":\"ping\"}\n\ndata: [DONE]\n\n"), "event: ping\ndata: {\"type\":\"ping\"}\n\n"; got != want {
+		t.Errorf("anthropic Reframe() = %q, want %q", got, want)
+	}
+}
+
+func TestSSEDialect_SendsDoneMarker(t *testing.T) {
+	for _, routeDefault := range []bool{true, false} {
+		if got := SSEDialectDefault.SendsDoneMarker(routeDefault); got != routeDefault {
+			t.Errorf("default SendsDoneMarker(%v) = %v", routeDefault, got)
+		}
+		if !SSEDialectOpenAI.SendsDoneMarker(routeDefault) {
+			t.Errorf("openai SendsDoneMarker(%v) = false, want true", routeDefault)
+		}
+		if SSEDialectAnthropic.SendsDoneMarker(routeDefault) {
+			t.Errorf("anthropic SendsDoneMarker(%v) = true, want false", routeDefault)
+		}
+	}
+}
+
+func TestResolveSSEKeepAliveInterval(t *testing.T) {
+	if got := ResolveSSEKeepAliveInterval(0); got != DefaultSSEKeepAliveInterval {
+		t.Errorf("ResolveSSEKeepAliveInterval(0) = %v, want default", got)
+	}
+	if got := ResolveSSEKeepAliveInterval(30); got != 30*time.Second {
+		t.Errorf("ResolveSSEKeepAliveInterval(30) = %v, want 30s", got)
+	}
+	if got := ResolveSSEKeepAliveInterval(-1); got != 0 {
+		t.Errorf("ResolveSSEKeepAliveInterval(-1) = %v, want disabled", got)
+	}
+	if NewSSEKeepAlive(0) != nil {
+		t.Error("NewSSEKeepAlive(0) should return nil")
+	}
+}
+
+func TestSSEKeepAlive_Next(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	stream := make(chan *schemas.BifrostStreamChunk, 1)
+	keepAlive := NewSSEKeepAlive(10 * time.Millisecond)
+	defer keepAlive.Stop()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		stream <- &schemas.BifrostStreamChunk{}
+		close(stream)
+	}()
+
+	chunk, ok, err := keepAlive.Next(w, stream)
+	if err != nil || !ok || chunk == nil {
+		t.Fatalf("Next() = %v, %v, %v, want a chunk", chunk, ok, err)
+	}
+	if !strings.HasPrefix(buf.String(), sseKeepAliveComment) {
+		t.Errorf("expected keep-alive comments while the stream was silent, got %q", buf.String())
+	}
+	if _, ok, err := keepAlive.Next(w, stream); ok || err != nil {
+		t.Errorf("Next() on a closed stream = %v, %v, want not ok", ok, err)
+	}
+
+	// A nil keep-alive only waits for chunks
+	var disabled *SSEKeepAlive
+	closed := make(chan *schemas.BifrostStreamChunk)
+	close(closed)
+	if _, ok, err := disabled.Next(w, closed); ok || err != nil {
+		t.Errorf("nil Next() = %v, %v, want not ok", ok, err)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("broken pipe") }
+
+func TestSSEKeepAlive_NextClientDisconnected(t *testing.T) {
+	keepAlive := NewSSEKeepAlive(10 * time.Millisecond)
+	defer keepAlive.Stop()
+
+	// The stream stays silent, so the failed keep-alive write is what ends the wait
+	stream := make(chan *schemas.BifrostStreamChunk)
+	if _, _, err := keepAlive.Next(bufio.NewWriter(failingWriter{}), stream); err == nil {
+		t.Error("expected an error when the keep-alive cannot be written")
+	}
+}
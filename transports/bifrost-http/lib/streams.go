@@ -0,0 +1,135 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+// StreamIDHeader is the response header carrying the ID a stream can be cancelled with via DELETE /v1/streams/{id}.
+// The ID is the request ID, i.e. the x-request-id header when the caller sent one.
+const StreamIDHeader = "x-bf-stream-id"
+
+// ErrStreamNotFound is returned when no in-flight stream matches a cancellation request.
+var ErrStreamNotFound = errors.New("stream not found or already finished")
+
+// StreamRegistry tracks the in-flight streams of this node by request ID, so that a stream can be aborted
+// server-side by a component other than the one holding its connection. The zero value is ready to use.
+type StreamRegistry struct {
+	mu      sync.Mutex
+	streams map[string][]*activeStream
+}
+
+// activeStream is a registered stream. principal is the caller the stream was started by (see StreamPrincipal),
+// if any; only the same caller can cancel it.
+type activeStream struct {
+	cancel    context.CancelFunc
+	principal string
+}
+
+// Register records an in-flight stream and returns a function that removes it again, which must be called
+// once the stream ends. Several streams may share a request ID when callers reuse x-request-id; they are
+// cancelled together.
+func (r *StreamRegistry) Register(requestID string, principal string, cancel context.CancelFunc) func() {
+	if r == nil || requestID == "" {
+		return func() {}
+	}
+	stream := &activeStream{cancel: cancel, principal: principal}
+
+	r.mu.Lock()
+	if r.streams == nil {
+		r.streams = make(map[string][]*activeStream)
+	}
+	r.streams[requestID] = append(r.streams[requestID], stream)
+	r.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			streams := r.streams[requestID]
+			for i, s := range streams {
+				if s == stream {
+					streams = append(streams[:i], streams[i+1:]...)
+					break
+				}
+			}
+			if len(streams) == 0 {
+				delete(r.streams, requestID)
+			} else {
+				r.streams[requestID] = streams
+			}
+		})
+	}
+}
+
+// Cancel aborts the streams registered under requestID that the given principal may access and returns how
+// many were cancelled. Streams started by an authenticated caller are invisible to other callers, so
+// ErrStreamNotFound does not reveal whether another tenant's stream exists.
+func (r *StreamRegistry) Cancel(requestID string, principal string) (int, error) {
+	if r == nil {
+		return 0, ErrStreamNotFound
+	}
+	var cancels []context.CancelFunc
+	r.mu.Lock()
+	for _, s := range r.streams[requestID] {
+		if s.principal != "" && s.principal != principal {
+			continue
+		}
+		cancels = append(cancels, s.cancel)
+	}
+	r.mu.Unlock()
+
+	if len(cancels) == 0 {
+		return 0, ErrStreamNotFound
+	}
+	// Cancel outside the lock: the stream writers unregister themselves as they unwind
+	for _, cancel := range cancels {
+		cancel()
+	}
+	return len(cancels), nil
+}
+
+// TrackStream registers the stream served on ctx under its request ID and advertises the ID in the
+// StreamIDHeader response header. It returns the function that unregisters the stream.
+func TrackStream(ctx *fasthttp.RequestCtx, registry *StreamRegistry, bifrostCtx *schemas.BifrostContext, cancel context.CancelFunc) func() {
+	requestID, _ := bifrostCtx.Value(schemas.BifrostContextKeyRequestID).(string)
+	if registry == nil || requestID == "" {
+		return func() {}
+	}
+	ctx.Response.Header.Set(StreamIDHeader, requestID)
+	return registry.Register(requestID, StreamPrincipal(bifrostCtx), cancel)
+}
+
+// StreamPrincipal returns the caller a request is attributed to for stream ownership: its virtual key, else the
+// user of its validated JWT, else the provider key it selected by ID. It returns "" for anonymous requests.
+func StreamPrincipal(bifrostCtx *schemas.BifrostContext) string {
+	if value, ok := bifrostCtx.Value(schemas.BifrostContextKeyVirtualKey).(string); ok && value != "" {
+		return "virtual_key:" + value
+	}
+	if value, ok := bifrostCtx.Value(schemas.BifrostContextKeyGovernanceUserID).(string); ok && value != "" {
+		return "user:" + value
+	}
+	if value, ok := bifrostCtx.Value(schemas.BifrostContextKeyAPIKeyID).(string); ok && value != "" {
+		return "api_key:" + value
+	}
+	return ""
+}
+
+// Len returns the number of in-flight streams.
+func (r *StreamRegistry) Len() int {
+	if r == nil {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	count := 0
+	for _, streams := range r.streams {
+		count += len(streams)
+	}
+	return count
+}
@@ -0,0 +1,118 @@
+package lib
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/valyala/fasthttp"
+)
+
+func TestStreamRegistry_Cancel(t *testing.T) {
+	var registry StreamRegistry
+	ctxA, cancelA := context.WithCancel(context.Background())
+	ctxB, cancelB := context.WithCancel(context.Background())
+	defer cancelA()
+	defer cancelB()
+
+	untrackA := registry.Register("req-1", "", cancelA)
+	untrackB := registry.Register("req-1", "", cancelB)
+	if registry.Len() != 2 {
+		t.Fatalf("Len() = %d, want 2", registry.Len())
+	}
+
+	count, err := registry.Cancel("req-1", "")
+	if err != nil || count != 2 {
+		t.Fatalf("Cancel() = %d, %v; want both streams sharing the request ID cancelled", count, err)
+	}
+	if ctxA.Err() == nil || ctxB.Err() == nil {
+		t.Error("expected both stream contexts to be cancelled")
+	}
+
+	untrackA()
+	untrackA()
+	untrackB()
+	if registry.Len() != 0 {
+		t.Errorf("Len() = %d after unregistering, want 0", registry.Len())
+	}
+	if _, err := registry.Cancel("req-1", ""); !errors.Is(err, ErrStreamNotFound) {
+		t.Errorf("Cancel() of a finished stream error = %v, want ErrStreamNotFound", err)
+	}
+}
+
+func TestStreamRegistry_PrincipalScope(t *testing.T) {
+	var registry StreamRegistry
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	owner, other := "user:alice", "user:bob"
+	defer registry.Register("req-1", owner, cancel)()
+
+	if _, err := registry.Cancel("req-1", ""); !errors.Is(err, ErrStreamNotFound) {
+		t.Errorf("Cancel() without a principal error = %v, want ErrStreamNotFound", err)
+	}
+	if _, err := registry.Cancel("req-1", other); !errors.Is(err, ErrStreamNotFound) {
+		t.Errorf("Cancel() by another principal error = %v, want ErrStreamNotFound", err)
+	}
+	if streamCtx.Err() != nil {
+		t.Fatal("stream cancelled by a caller other than its owner")
+	}
+	if _, err := registry.Cancel("req-1", owner); err != nil {
+		t.Fatalf("Cancel() by the owning principal error = %v", err)
+	}
+	if streamCtx.Err() == nil {
+		t.Error("expected the stream context to be cancelled")
+	}
+}
+
+func TestStreamPrincipal(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[schemas.BifrostContextKey]string
+		want   string
+	}{
+		{name: "anonymous", want: ""},
+		{name: "virtual key", values: map[schemas.BifrostContextKey]string{schemas.BifrostContextKeyVirtualKey: "sk-bf-owner", schemas.BifrostContextKeyGovernanceUserID: "alice"}, want: "virtual_key:sk-bf-owner"},
+		{name: "jwt user", values: map[schemas.BifrostContextKey]string{schemas.BifrostContextKeyGovernanceUserID: "alice", schemas.BifrostContextKeyAPIKeyID: "key-1"}, want: "user:alice"},
+		{name: "selected key", values: map[schemas.BifrostContextKey]string{schemas.BifrostContextKeyAPIKeyID: "key-1"}, want: "api_key:key-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bifrostCtx := schemas.NewBifrostContext(context.Background(), schemas.NoDeadline)
+			for key, value := range tt.values {
+				bifrostCtx.SetValue(key, value)
+			}
+			if got := StreamPrincipal(bifrostCtx); got != tt.want {
+				t.Errorf("StreamPrincipal() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrackStream(t *testing.T) {
+	var registry StreamRegistry
+	ctx := &fasthttp.RequestCtx{}
+	bifrostCtx, cancel := schemas.NewBifrostContextWithCancel(context.Background())
+	defer cancel()
+	bifrostCtx.SetValue(schemas.BifrostContextKeyRequestID, "req-1")
+
+	untrack := TrackStream(ctx, &registry, bifrostCtx, cancel)
+	if got := string(ctx.Response.Header.Peek(StreamIDHeader)); got != "req-1" {
+		t.Errorf("%s header = %q, want req-1", StreamIDHeader, got)
+	}
+	if _, err := registry.Cancel("req-1", ""); err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if bifrostCtx.Err() == nil {
+		t.Error("expected the request context to be cancelled")
+	}
+	untrack()
+
+	// Without a registry the stream is served as before, without an ID
+	ctx = &fasthttp.RequestCtx{}
+	TrackStream(ctx, nil, bifrostCtx, cancel)()
+	if got := ctx.Response.Header.Peek(StreamIDHeader); len(got) != 0 {
+		t.Errorf("%s header = %q without a registry, want none", StreamIDHeader, got)
+	}
+}
@@ -0,0 +1,459 @@
+// Package lib provides core functionality for the Bifrost HTTP service.
+// This file contains offline validation of config files, used by the validate subcommand.
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/configstore"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// ValidationSeverity is the severity of a validation finding.
+type ValidationSeverity string
+
+const (
+	ValidationSeverityError   ValidationSeverity = "error"
+	ValidationSeverityWarning ValidationSeverity = "warning"
+)
+
+// DefaultProbeTimeout bounds the live key probe of a single provider.
+const DefaultProbeTimeout = 30 * time.Second
+
+// ValidationFinding is a single problem found in a config file.
+// Path points at the offending value, e.g. providers.openai.keys[0].value.
+type ValidationFinding struct {
+	Severity ValidationSeverity `json:"severity"`
+	Path     string             `json:"path"`
+	Message  string             `json:"message"`
+}
+
+// ValidationReport is the result of validating a config file.
+type ValidationReport struct {
+	ConfigPath  string              `json:"config_path,omitempty"`
+	Valid       bool                `json:"valid"`
+	Errors      int                 `json:"errors"`
+	Warnings    int                 `json:"warnings"`
+	ProbedKeys  int                 `json:"probed_keys,omitempty"`
+	Findings    []ValidationFinding `json:"findings"`
+	ValidatedAt time.Time           `json:"validated_at"`
+}
+
+// ValidateOptions controls which checks ValidateConfig runs.
+type ValidateOptions struct {
+	// Schema is a local copy of config.schema.json. The published schema is fetched when empty.
+	Schema []byte
+	// Probe sends a list models request per provider to check that each key is accepted.
+	Probe bool
+	// ProbeTimeout bounds the probe of each provider. Defaults to DefaultProbeTimeout.
+	ProbeTimeout time.Duration
+}
+
+// configValidator accumulates findings while a config file is checked.
+type configValidator struct {
+	findings []ValidationFinding
+}
+
+func (v *configValidator) add(severity ValidationSeverity, path string, format string, args ...any) {
+	v.findings = append(v.findings, ValidationFinding{Severity: severity, Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// ValidateConfig checks config.json data without starting the server or touching any store.
+// Static checks cover the schema, unset environment variables, providers, keys, deployment
+// aliases and plugins. Live key probes only run when requested and the static checks pass,
+// since they send real requests to the providers.
+func ValidateConfig(ctx context.Context, data []byte, opts ValidateOptions) *ValidationReport {
+	v := &configValidator{}
+	configData := v.validateStatic(data, opts.Schema)
+
+	report := &ValidationReport{ValidatedAt: time.Now().UTC()}
+	if opts.Probe && configData != nil {
+		if countFindings(v.findings, ValidationSeverityError) > 0 {
+			v.add(ValidationSeverityWarning, "", "skipped live key probes because of the errors above")
+		} else {
+			timeout := opts.ProbeTimeout
+			if timeout <= 0 {
+				timeout = DefaultProbeTimeout
+			}
+			report.ProbedKeys = v.probeKeys(ctx, configData, timeout)
+		}
+	}
+
+	report.Findings = v.findings
+	if report.Findings == nil {
+		report.Findings = []ValidationFinding{}
+	}
+	report.Errors = countFindings(report.Findings, ValidationSeverityError)
+	report.Warnings = countFindings(report.Findings, ValidationSeverityWarning)
+	report.Valid = report.Errors == 0
+	return report
+}
+
+func countFindings(findings []ValidationFinding, severity ValidationSeverity) int {
+	count := 0
+	for _, finding := range findings {
+		if finding.Severity == severity {
+			count++
+		}
+	}
+	return count
+}
+
+// validateStatic runs every check that does not need the network (apart from fetching the schema).
+// It returns the parsed config, or nil if the data could not be parsed.
+func (v *configValidator) validateStatic(data []byte, schema []byte) *ConfigData {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		v.add(ValidationSeverityError, "", "invalid JSON: %v", err)
+		return nil
+	}
+
+	v.validateSchema(raw, schema)
+	v.validateEnvReferences("", raw)
+
+	var configData ConfigData
+	if err := json.Unmarshal(data, &configData); err != nil {
+		v.add(ValidationSeverityError, "", "failed to parse config: %v", err)
+		return nil
+	}
+	v.validateProviders(configData.Providers)
+	v.validatePlugins(configData.Plugins)
+	return &configData
+}
+
+// validateSchema reports every leaf schema violation as its own finding.
+func (v *configValidator) validateSchema(raw any, schema []byte) {
+	compiledSchema, err := compileConfigSchema(schema)
+	if err != nil {
+		v.add(ValidationSeverityWarning, "", "skipped schema validation: %v", err)
+		return
+	}
+	if compiledSchema == nil {
+		v.add(ValidationSeverityWarning, "", "skipped schema validation: config schema could not be downloaded")
+		return
+	}
+	err = compiledSchema.Validate(raw)
+	if err == nil {
+		return
+	}
+	validationErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		v.add(ValidationSeverityError, "", "schema validation failed: %v", err)
+		return
+	}
+	seen := make(map[ValidationFinding]bool)
+	for _, leaf := range schemaLeafErrors(validationErr) {
+		finding := ValidationFinding{
+			Severity: ValidationSeverityError,
+			Path:     formatValidationPath(leaf.InstanceLocation),
+			Message:  leaf.BasicOutput().Error.String(),
+		}
+		if !seen[finding] {
+			seen[finding] = true
+			v.findings = append(v.findings, finding)
+		}
+	}
+}
+
+// schemaLeafErrors flattens a validation error tree into the errors that have no causes.
+func schemaLeafErrors(err *jsonschema.ValidationError) []*jsonschema.ValidationError {
+	if len(err.Causes) == 0 {
+		return []*jsonschema.ValidationError{err}
+	}
+	var leaves []*jsonschema.ValidationError
+	for _, cause := range err.Causes {
+		leaves = append(leaves, schemaLeafErrors(cause)...)
+	}
+	return leaves
+}
+
+// formatValidationPath renders a JSON pointer token list as providers.openai.keys[0].value.
+func formatValidationPath(tokens []string) string {
+	var sb strings.Builder
+	for _, token := range tokens {
+		if _, err := strconv.Atoi(token); err == nil {
+			sb.WriteString("[" + token + "]")
+			continue
+		}
+		if sb.Len() > 0 {
+			sb.WriteByte('.')
+		}
+		sb.WriteString(token)
+	}
+	return sb.String()
+}
+
+// validateEnvReferences reports env.* references whose environment variable is not set.
+// Such values silently resolve to an empty string when the server starts.
+func (v *configValidator) validateEnvReferences(path string, value any) {
+	switch value := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			v.validateEnvReferences(childPath, value[key])
+		}
+	case []any:
+		for i, item := range value {
+			v.validateEnvReferences(fmt.Sprintf("%s[%d]", path, i), item)
+		}
+	case string:
+		if envKey, ok := strings.CutPrefix(value, "env."); ok && envKey != "" {
+			if _, set := os.LookupEnv(envKey); !set {
+				v.add(ValidationSeverityError, path, "environment variable %s is not set", envKey)
+			}
+		}
+	}
+}
+
+// validateProviders checks provider names, custom provider configs, keys and deployment aliases.
+func (v *configValidator) validateProviders(providers map[string]configstore.ProviderConfig) {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		config := providers[name]
+		path := "providers." + name
+		provider := schemas.ModelProvider(strings.ToLower(name))
+
+		if !bifrost.IsStandardProvider(provider) && config.CustomProviderConfig == nil {
+			v.add(ValidationSeverityError, path, "unknown provider %s; custom providers need a custom_provider_config", name)
+			continue
+		}
+		if err := ValidateCustomProvider(config, provider); err != nil {
+			v.add(ValidationSeverityError, path+".custom_provider_config", "%v", err)
+			continue
+		}
+
+		if config.NetworkConfig != nil && config.NetworkConfig.BaseURL != "" {
+			if parsed, err := url.Parse(config.NetworkConfig.BaseURL); err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				v.add(ValidationSeverityError, path+".network_config.base_url", "base_url %q must be an absolute http or https URL", config.NetworkConfig.BaseURL)
+			}
+		}
+
+		baseProvider := provider
+		keyless := bifrost.IsKeylessProvider(provider)
+		if config.CustomProviderConfig != nil {
+			baseProvider = config.CustomProviderConfig.BaseProviderType
+			keyless = config.CustomProviderConfig.IsKeyLess || bifrost.IsKeylessProvider(baseProvider)
+		}
+		if len(config.Keys) == 0 && !keyless {
+			v.add(ValidationSeverityWarning, path+".keys", "no keys configured; requests to %s will fail until a key is added", name)
+		}
+		v.validateKeys(path, baseProvider, keyless, config.Keys)
+	}
+}
+
+// validateKeys checks key values, identifiers, weights and provider-specific key configs.
+func (v *configValidator) validateKeys(providerPath string, baseProvider schemas.ModelProvider, keyless bool, keys []schemas.Key) {
+	ids := make(map[string]int)
+	names := make(map[string]int)
+	for i, key := range keys {
+		path := fmt.Sprintf("%s.keys[%d]", providerPath, i)
+
+		if key.ID != "" {
+			if first, ok := ids[key.ID]; ok {
+				v.add(ValidationSeverityError, path+".id", "duplicate key id %q (also used by keys[%d])", key.ID, first)
+			} else {
+				ids[key.ID] = i
+			}
+		}
+		if key.Name != "" {
+			if first, ok := names[key.Name]; ok {
+				v.add(ValidationSeverityError, path+".name", "duplicate key name %q (also used by keys[%d])", key.Name, first)
+			} else {
+				names[key.Name] = i
+			}
+		}
+		if key.Weight < 0 {
+			v.add(ValidationSeverityError, path+".weight", "weight must not be negative")
+		}
+		// Unset environment variables are already reported by validateEnvReferences.
+		if !keyless && !bifrost.CanProviderKeyValueBeEmpty(baseProvider) && key.Value.GetValue() == "" && !key.Value.IsFromEnv() {
+			v.add(ValidationSeverityError, path+".value", "key value is required for %s", baseProvider)
+		}
+
+		if key.AzureKeyConfig != nil {
+			if key.AzureKeyConfig.Endpoint.GetValue() == "" && !key.AzureKeyConfig.Endpoint.IsFromEnv() {
+				v.add(ValidationSeverityError, path+".azure_key_config.endpoint", "azure endpoint is required")
+			}
+			v.validateDeployments(path+".azure_key_config.deployments", key, key.AzureKeyConfig.Deployments)
+		}
+		if key.VertexKeyConfig != nil {
+			if key.VertexKeyConfig.ProjectID.GetValue() == "" && !key.VertexKeyConfig.ProjectID.IsFromEnv() {
+				v.add(ValidationSeverityError, path+".vertex_key_config.project_id", "vertex project_id is required")
+			}
+			if key.VertexKeyConfig.Region.GetValue() == "" && !key.VertexKeyConfig.Region.IsFromEnv() {
+				v.add(ValidationSeverityError, path+".vertex_key_config.region", "vertex region is required")
+			}
+			v.validateDeployments(path+".vertex_key_config.deployments", key, key.VertexKeyConfig.Deployments)
+		}
+		if key.BedrockKeyConfig != nil {
+			v.validateDeployments(path+".bedrock_key_config.deployments", key, key.BedrockKeyConfig.Deployments)
+		}
+		if key.HuggingFaceKeyConfig != nil {
+			v.validateDeployments(path+".huggingface_key_config.deployments", key, key.HuggingFaceKeyConfig.Deployments)
+		}
+		if key.ReplicateKeyConfig != nil {
+			v.validateDeployments(path+".replicate_key_config.deployments", key, key.ReplicateKeyConfig.Deployments)
+		}
+	}
+}
+
+// validateDeployments checks the model alias to deployment mapping of a key. An alias that is not
+// in the key's model allow-list is never routed to the key, which is almost always a typo.
+func (v *configValidator) validateDeployments(path string, key schemas.Key, deployments map[string]string) {
+	aliases := make([]string, 0, len(deployments))
+	for alias := range deployments {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	for _, alias := range aliases {
+		if strings.TrimSpace(alias) == "" {
+			v.add(ValidationSeverityError, path, "deployment alias must not be empty")
+			continue
+		}
+		if strings.TrimSpace(deployments[alias]) == "" {
+			v.add(ValidationSeverityError, path+"."+alias, "deployment for alias %s must not be empty", alias)
+			continue
+		}
+		if len(key.Models) > 0 && !slices.Contains(key.Models, alias) {
+			v.add(ValidationSeverityWarning, path+"."+alias, "alias %s is not listed in the key's models, so it will never be routed to this key", alias)
+		}
+	}
+}
+
+// validatePlugins checks plugin names and custom plugin paths. Problems with disabled plugins
+// are reported as warnings since they do not affect startup.
+func (v *configValidator) validatePlugins(plugins []*schemas.PluginConfig) {
+	names := make(map[string]int)
+	for i, plugin := range plugins {
+		path := fmt.Sprintf("plugins[%d]", i)
+		if plugin == nil {
+			v.add(ValidationSeverityError, path, "plugin entry must not be null")
+			continue
+		}
+		severity := ValidationSeverityError
+		if !plugin.Enabled {
+			severity = ValidationSeverityWarning
+		}
+		if strings.TrimSpace(plugin.Name) == "" {
+			v.add(ValidationSeverityError, path+".name", "plugin name is required")
+			continue
+		}
+		if first, ok := names[plugin.Name]; ok {
+			v.add(ValidationSeverityError, path+".name", "duplicate plugin %q (also configured in plugins[%d])", plugin.Name, first)
+		} else {
+			names[plugin.Name] = i
+		}
+		if plugin.Path == nil {
+			if !IsBuiltinPlugin(plugin.Name) {
+				v.add(severity, path+".name", "unknown built-in plugin %q; custom plugins need a path", plugin.Name)
+			}
+			continue
+		}
+		if info, err := os.Stat(*plugin.Path); err != nil {
+			v.add(severity, path+".path", "plugin file is not readable: %v", err)
+		} else if info.IsDir() {
+			v.add(severity, path+".path", "plugin path %s is a directory", *plugin.Path)
+		}
+	}
+}
+
+// probeKeys sends a list models request to every configured provider and reports the keys the
+// provider rejected. It returns the number of keys probed.
+func (v *configValidator) probeKeys(ctx context.Context, configData *ConfigData, timeout time.Duration) int {
+	config := &Config{Providers: make(map[schemas.ModelProvider]configstore.ProviderConfig, len(configData.Providers))}
+	names := make(map[schemas.ModelProvider]string, len(configData.Providers))
+	probed := 0
+	for name, providerConfig := range configData.Providers {
+		provider := schemas.ModelProvider(strings.ToLower(name))
+		// Key IDs are rewritten to their index so statuses can be mapped back to the config.
+		keys := make([]schemas.Key, len(providerConfig.Keys))
+		for i, key := range providerConfig.Keys {
+			key.ID = strconv.Itoa(i)
+			keys[i] = key
+			if key.Enabled == nil || *key.Enabled {
+				probed++
+			}
+		}
+		providerConfig.Keys = keys
+		config.Providers[provider] = providerConfig
+		names[provider] = name
+	}
+	if len(config.Providers) == 0 {
+		return 0
+	}
+
+	client, err := bifrost.Init(ctx, schemas.BifrostConfig{
+		Account:         NewBaseAccount(config),
+		Logger:          logger,
+		InitialPoolSize: 10,
+	})
+	if err != nil {
+		v.add(ValidationSeverityError, "providers", "failed to initialize providers for key probes: %v", err)
+		return 0
+	}
+	defer client.Shutdown()
+
+	providers := make([]schemas.ModelProvider, 0, len(config.Providers))
+	for provider := range config.Providers {
+		providers = append(providers, provider)
+	}
+	sort.Slice(providers, func(i, j int) bool { return providers[i] < providers[j] })
+
+	for _, provider := range providers {
+		path := "providers." + names[provider]
+		bfCtx := schemas.NewBifrostContext(ctx, time.Now().Add(timeout))
+		bfCtx.SetValue(schemas.BifrostContextKeySkipPluginPipeline, true)
+		bfCtx.SetValue(schemas.BifrostContextKeyValidateKeys, true)
+		resp, bifrostErr := client.ListModelsRequest(bfCtx, &schemas.BifrostListModelsRequest{Provider: provider})
+		bfCtx.Cancel()
+
+		var statuses []schemas.KeyStatus
+		if resp != nil {
+			statuses = resp.KeyStatuses
+		}
+		if bifrostErr != nil && len(bifrostErr.ExtraFields.KeyStatuses) > 0 {
+			statuses = bifrostErr.ExtraFields.KeyStatuses
+		}
+		reported := false
+		for _, status := range statuses {
+			if status.Status == schemas.KeyStatusSuccess {
+				continue
+			}
+			reported = true
+			keyPath := path
+			if status.KeyID != "" {
+				keyPath = fmt.Sprintf("%s.keys[%s]", path, status.KeyID)
+			}
+			v.add(ValidationSeverityError, keyPath, "key probe failed: %s", bifrost.GetErrorMessage(status.Error))
+		}
+		if bifrostErr != nil && !reported {
+			v.add(ValidationSeverityError, path, "key probe failed: %s", bifrost.GetErrorMessage(bifrostErr))
+		}
+	}
+	return probed
+}
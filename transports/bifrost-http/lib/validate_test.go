@@ -0,0 +1,164 @@
+package lib
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func findingsByPath(report *ValidationReport) map[string]ValidationFinding {
+	findings := make(map[string]ValidationFinding, len(report.Findings))
+	for _, finding := range report.Findings {
+		findings[finding.Path] = finding
+	}
+	return findings
+}
+
+func TestValidateConfig_ValidConfig(t *testing.T) {
+	t.Setenv("BIFROST_VALIDATE_TEST_KEY", "sk-test")
+	config := `{
+		"providers": {
+			"openai": {"keys": [{"name": "default", "value": "env.BIFROST_VALIDATE_TEST_KEY", "weight": 1, "models": ["gpt-4o"]}]},
+			"azure": {
+				"keys": [{
+					"name": "azure",
+					"value": "azure-key",
+					"weight": 1,
+					"models": ["gpt-4o"],
+					"azure_key_config": {"endpoint": "https://example.openai.azure.com", "api_version": "2024-10-21", "deployments": {"gpt-4o": "gpt-4o-prod"}}
+				}]
+			}
+		},
+		"plugins": [{"enabled": true, "name": "logging", "config": {}}]
+	}`
+	report := ValidateConfig(context.Background(), []byte(config), ValidateOptions{Schema: loadLocalSchema(t)})
+	if !report.Valid || len(report.Findings) != 0 {
+		t.Errorf("expected a valid config without findings, got %+v", report.Findings)
+	}
+}
+
+func TestValidateConfig_InvalidJSON(t *testing.T) {
+	report := ValidateConfig(context.Background(), []byte(`{"providers":`), ValidateOptions{Schema: loadLocalSchema(t)})
+	if report.Valid || report.Errors != 1 || !strings.Contains(report.Findings[0].Message, "invalid JSON") {
+		t.Errorf("expected a single invalid JSON error, got %+v", report.Findings)
+	}
+}
+
+func TestValidateConfig_StaticFindings(t *testing.T) {
+	pluginPath := filepath.Join(t.TempDir(), "missing.so")
+	config := `{
+		"client": {"initial_pool_size": 0},
+		"providers": {
+			"openai": {
+				"keys": [
+					{"id": "k1", "name": "primary", "value": "", "weight": 1, "models": []},
+					{"id": "k1", "name": "primary", "value": "env.BIFROST_VALIDATE_UNSET_KEY", "weight": 1, "models": []}
+				],
+				"network_config": {"base_url": "localhost:8080"}
+			},
+			"azure": {
+				"keys": [{
+					"name": "azure",
+					"value": "azure-key",
+					"weight": 1,
+					"models": ["gpt-4o"],
+					"azure_key_config": {"endpoint": "", "deployments": {"gpt-4o": "gpt-4o-prod", "gpt-4o-mini": "mini-prod"}}
+				}]
+			},
+			"my-openai": {"keys": [{"name": "custom", "value": "sk", "weight": 1, "models": []}]}
+		},
+		"plugins": [
+			{"enabled": true, "name": "not-a-plugin"},
+			{"enabled": true, "name": "custom", "path": "` + pluginPath + `"},
+			{"enabled": false, "name": "logging"},
+			{"enabled": true, "name": "logging"}
+		]
+	}`
+	report := ValidateConfig(context.Background(), []byte(config), ValidateOptions{Schema: loadLocalSchema(t)})
+	if report.Valid {
+		t.Fatal("expected the config to be invalid")
+	}
+
+	findings := findingsByPath(report)
+	want := map[string]ValidationSeverity{
+		"client.initial_pool_size":                                         ValidationSeverityError,
+		"providers.openai.keys[0].value":                                   ValidationSeverityError,
+		"providers.openai.keys[1].id":                                      ValidationSeverityError,
+		"providers.openai.keys[1].name":                                    ValidationSeverityError,
+		"providers.openai.keys[1].value":                                   ValidationSeverityError,
+		"providers.openai.network_config.base_url":                         ValidationSeverityError,
+		"providers.azure.keys[0].azure_key_config.endpoint":                ValidationSeverityError,
+		"providers.azure.keys[0].azure_key_config.deployments.gpt-4o-mini": ValidationSeverityWarning,
+		"providers.my-openai":                                              ValidationSeverityError,
+		"plugins[0].name":                                                  ValidationSeverityError,
+		"plugins[1].path":                                                  ValidationSeverityError,
+		"plugins[3].name":                                                  ValidationSeverityError,
+	}
+	for path, severity := range want {
+		finding, ok := findings[path]
+		if !ok {
+			t.Errorf("missing finding for %s", path)
+			continue
+		}
+		if finding.Severity != severity {
+			t.Errorf("%s: severity = %s, want %s (%s)", path, finding.Severity, severity, finding.Message)
+		}
+	}
+	if finding := findings["providers.openai.keys[1].value"]; !strings.Contains(finding.Message, "BIFROST_VALIDATE_UNSET_KEY") {
+		t.Errorf("unset env var finding should name the variable, got %q", finding.Message)
+	}
+	if _, ok := findings["providers.azure.keys[0].azure_key_config.deployments.gpt-4o"]; ok {
+		t.Error("an alias listed in the key's models should not be flagged")
+	}
+}
+
+func TestValidateConfig_ProbeKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "Bearer sk-good" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"message":"Incorrect API key provided","type":"invalid_request_error","code":"invalid_api_key"}}`))
+			return
+		}
+		w.Write([]byte(`{"object":"list","data":[{"id":"gpt-4o","object":"model","created":1715367049,"owned_by":"system"}]}`))
+	}))
+	defer server.Close()
+
+	config := `{
+		"providers": {
+			"openai": {
+				"keys": [
+					{"name": "good", "value": "sk-good", "weight": 1, "models": []},
+					{"name": "bad", "value": "sk-bad", "weight": 1, "models": []}
+				],
+				"network_config": {"base_url": "` + server.URL + `", "max_retries": 0}
+			}
+		}
+	}`
+	report := ValidateConfig(context.Background(), []byte(config), ValidateOptions{Schema: loadLocalSchema(t), Probe: true})
+	if report.ProbedKeys != 2 {
+		t.Errorf("probed keys = %d, want 2", report.ProbedKeys)
+	}
+	findings := findingsByPath(report)
+	if report.Valid || len(findings) != 1 {
+		t.Fatalf("expected a single failed probe, got %+v", report.Findings)
+	}
+	if _, ok := findings["providers.openai.keys[1]"]; !ok {
+		t.Errorf("expected the rejected key to be reported, got %+v", report.Findings)
+	}
+}
+
+func TestValidateConfig_ProbeSkippedOnErrors(t *testing.T) {
+	config := `{"providers": {"openai": {"keys": [{"name": "empty", "value": "", "weight": 1, "models": []}]}}}`
+	report := ValidateConfig(context.Background(), []byte(config), ValidateOptions{Schema: loadLocalSchema(t), Probe: true})
+	if report.ProbedKeys != 0 || report.Warnings != 1 {
+		t.Errorf("expected probes to be skipped with a warning, got %+v", report)
+	}
+	if _, err := os.Stat("config.db"); err == nil {
+		t.Error("validation must not create a config store")
+	}
+}
@@ -16,6 +16,28 @@ import (
 // Returns nil if valid, or a formatted error describing all validation failures.
 // An optional schemaOverride can be provided to use a local schema instead of fetching from the remote URL.
 func ValidateConfigSchema(data []byte, schemaOverride ...[]byte) error {
+	compiledSchema, err := compileConfigSchema(schemaOverride...)
+	if err != nil {
+		return err
+	}
+	if compiledSchema == nil {
+		return nil
+	}
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("invalid JSON: %w", err)
+	}
+	err = compiledSchema.Validate(v)
+	if err == nil {
+		return nil
+	}
+	// Format validation errors for better readability
+	return formatValidationError(err)
+}
+
+// compileConfigSchema compiles the config schema, fetching it from the remote URL unless a
+// schemaOverride is given. Returns a nil schema without an error if the download could not be read.
+func compileConfigSchema(schemaOverride ...[]byte) (*jsonschema.Schema, error) {
 	var configSchemaJSONBytes []byte
 	if len(schemaOverride) > 0 && len(schemaOverride[0]) > 0 {
 		configSchemaJSONBytes = schemaOverride[0]
@@ -23,40 +45,31 @@ func ValidateConfigSchema(data []byte, schemaOverride ...[]byte) error {
 		// Pulling config.schema from https://www.getbifrost.ai/schema
 		configSchemaJSON, err := http.Get("https://www.getbifrost.ai/schema")
 		if err != nil {
-			return fmt.Errorf("failed to get config schema: %w", err)
+			return nil, fmt.Errorf("failed to get config schema: %w", err)
 		}
 		defer configSchemaJSON.Body.Close()
 		var readErr error
 		configSchemaJSONBytes, readErr = io.ReadAll(configSchemaJSON.Body)
 		if readErr != nil {
 			logger.Warn("failed to download config schema: %v. running without config.json schema validation", readErr)
-			return nil
+			return nil, nil
 		}
 	}
 	// Parse the schema JSON
 	schemaDoc, err := jsonschema.UnmarshalJSON(bytes.NewReader(configSchemaJSONBytes))
 	if err != nil {
-		return fmt.Errorf("failed to parse config schema JSON: %w", err)
+		return nil, fmt.Errorf("failed to parse config schema JSON: %w", err)
 	}
 	c := jsonschema.NewCompiler()
 	if err := c.AddResource("config.schema.json", schemaDoc); err != nil {
-		return fmt.Errorf("failed to add config schema resource: %w", err)
+		return nil, fmt.Errorf("failed to add config schema resource: %w", err)
 	}
 	// Compile the schema
 	compiledSchema, err := c.Compile("config.schema.json")
 	if err != nil {
-		return fmt.Errorf("failed to compile config schema: %w", err)
-	}
-	var v any
-	if err := json.Unmarshal(data, &v); err != nil {
-		return fmt.Errorf("invalid JSON: %w", err)
+		return nil, fmt.Errorf("failed to compile config schema: %w", err)
 	}
-	err = compiledSchema.Validate(v)
-	if err == nil {
-		return nil
-	}
-	// Format validation errors for better readability
-	return formatValidationError(err)
+	return compiledSchema, nil
 }
 
 // formatValidationError converts jsonschema validation errors into user-friendly messages
@@ -34,6 +34,14 @@
 //
 //	To bind to all interfaces for container usage, set BIFROST_HOST=0.0.0.0 or use -host 0.0.0.0
 //
+//	To check a config file in a deployment pipeline without starting the server:
+//
+//	go run . validate -app-dir ./data -probe
+//
+//	To re-encrypt the stored secrets with a new data key:
+//
+//	go run . encryption rotate -app-dir ./data
+//
 // Integration Support:
 // Bifrost supports multiple AI provider integrations through dedicated HTTP endpoints.
 // Each integration exposes API-compatible endpoints that accept the provider's native request format,
@@ -81,6 +89,10 @@ var server *bifrostServer.BifrostHTTPServer
 // It sets up the following flags:
 //   - host: Host to bind the server to (default: localhost, can be overridden with BIFROST_HOST env var)
 //   - port: Server port (default: 8080)
+//   - listen: Listener as [role=]address, repeatable (default: host:port, can be set with BIFROST_LISTEN env var)
+//   - tls-cert, tls-key: TLS certificate and key files, reloaded on change (can be set with BIFROST_TLS_CERT and BIFROST_TLS_KEY env vars)
+//   - tls-acme-domains, tls-acme-email: Domains to obtain TLS certificates for with ACME (can be set with BIFROST_TLS_ACME_DOMAINS and BIFROST_TLS_ACME_EMAIL env vars)
+//   - grpc-port: gRPC server port (default: disabled, can be set with BIFROST_GRPC_PORT env var)
 //   - app-dir: Application data directory (default: current directory)
 //   - log-level: Logger level (debug, info, warn, error). Default is info.
 //   - log-style: Logger output type (json or pretty). Default is JSON.
@@ -108,6 +120,12 @@ func init() {
 	// Updating server properties from flags
 	flag.StringVar(&server.Port, "port", bifrostServer.DefaultPort, "Port to run the server on")
 	flag.StringVar(&server.Host, "host", defaultHost, "Host to bind the server to (default: localhost, override with BIFROST_HOST env var)")
+	flag.Var(&server.Listeners, "listen", "Listener as [role=]address, where address is host:port or unix:/path/to/socket and role is all, inference or admin. Repeatable, replaces -host/-port (override with BIFROST_LISTEN env var)")
+	flag.StringVar(&server.TLS.CertFile, "tls-cert", os.Getenv("BIFROST_TLS_CERT"), "TLS certificate file, enables TLS on TCP listeners and is reloaded on change (override with BIFROST_TLS_CERT env var)")
+	flag.StringVar(&server.TLS.KeyFile, "tls-key", os.Getenv("BIFROST_TLS_KEY"), "TLS private key file of -tls-cert (override with BIFROST_TLS_KEY env var)")
+	flag.StringVar(&server.TLS.ACMEDomains, "tls-acme-domains", os.Getenv("BIFROST_TLS_ACME_DOMAINS"), "Comma separated domains to obtain TLS certificates for with ACME (override with BIFROST_TLS_ACME_DOMAINS env var)")
+	flag.StringVar(&server.TLS.ACMEEmail, "tls-acme-email", os.Getenv("BIFROST_TLS_ACME_EMAIL"), "Contact email of the ACME account (override with BIFROST_TLS_ACME_EMAIL env var)")
+	flag.StringVar(&server.GRPCPort, "grpc-port", os.Getenv("BIFROST_GRPC_PORT"), "Port to run the gRPC server on (disabled when empty, override with BIFROST_GRPC_PORT env var)")
 	flag.StringVar(&server.AppDir, "app-dir", bifrostServer.DefaultAppDir, "Application data directory (contains config.json and logs)")
 	flag.StringVar(&server.LogLevel, "log-level", defaultLogLevel, "Logger level (debug, info, warn, error). Default is info.")
 	flag.StringVar(&server.LogOutputStyle, "log-style", bifrostServer.DefaultLogOutputStyle, "Logger output type (json or pretty). Default is JSON.")
@@ -115,8 +133,24 @@ func init() {
 
 // main is the entry point of the application.
 func main() {
+	// The validate subcommand checks config.json and exits without starting the server
+	if len(os.Args) > 1 && os.Args[1] == "validate" {
+		os.Exit(runValidate(os.Args[2:], os.Stdout, os.Stderr))
+	}
+	// The encryption subcommand rotates the data key of the config store and exits
+	if len(os.Args) > 1 && os.Args[1] == "encryption" {
+		os.Exit(runEncryption(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	// Parse command line flags
 	flag.Parse()
+	// Listeners from the environment apply when none are given on the command line
+	if envListeners := os.Getenv("BIFROST_LISTEN"); envListeners != "" && len(server.Listeners) == 0 {
+		if err := server.Listeners.Set(envListeners); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid BIFROST_LISTEN: %v\n", err)
+			os.Exit(2)
+		}
+	}
 
 	// Printing version
 	versionLine := fmt.Sprintf("║%s%s%s║", strings.Repeat(" ", (61-2-len(Version))/2), Version, strings.Repeat(" ", (61-2-len(Version)+1)/2))
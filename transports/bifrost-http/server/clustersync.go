@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/clustersync"
+	"github.com/capsohq/bifrost/framework/configstore"
+	"github.com/capsohq/bifrost/framework/modelcatalog"
+	dynamicPlugins "github.com/capsohq/bifrost/framework/plugins"
+	"github.com/capsohq/bifrost/plugins/litellmcompat"
+	"github.com/capsohq/bifrost/transports/bifrost-http/handlers"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+)
+
+// startClusterSync connects to the cluster sync backend, if enabled, and applies the config changes
+// of the other replicas from then on.
+func (s *BifrostHTTPServer) startClusterSync(ctx context.Context) {
+	if s.Config.FrameworkConfig == nil || s.Config.FrameworkConfig.ClusterSync == nil || !s.Config.FrameworkConfig.ClusterSync.Enabled {
+		return
+	}
+	if s.Config.ConfigStore == nil {
+		logger.Error("cluster sync requires a config store, config changes will not be synchronized")
+		return
+	}
+	syncer, err := clustersync.New(ctx, *s.Config.FrameworkConfig.ClusterSync, s.Config.ConfigStore.DB(), logger)
+	if err != nil {
+		logger.Error("failed to initialize cluster sync, config changes will not be synchronized: %v", err)
+		return
+	}
+	s.ClusterSync = syncer
+	s.ClusterSync.Start(ctx, s.applyClusterEvent)
+	logger.Info("cluster sync initialized over %s as node %s", s.Config.FrameworkConfig.ClusterSync.Backend, s.ClusterSync.NodeID())
+}
+
+// publishConfigChange tells the other replicas a config changed. Changes applied from another
+// replica are not published again.
+func (s *BifrostHTTPServer) publishConfigChange(ctx context.Context, kind clustersync.Kind, op clustersync.Op, id string) {
+	if s.ClusterSync == nil || clustersync.IsRemoteEvent(ctx) {
+		return
+	}
+	if err := s.ClusterSync.Publish(ctx, kind, op, id); err != nil {
+		logger.Warn("failed to publish config change to the other replicas: %v", err)
+	}
+}
+
+// applyClusterEvent reloads the config an event of another replica is about from the config store.
+func (s *BifrostHTTPServer) applyClusterEvent(ctx context.Context, event clustersync.Event) {
+	logger.Debug("applying %s %s %s from node %s", event.Kind, event.Op, event.ID, event.NodeID)
+	var err error
+	switch event.Kind {
+	case clustersync.KindProvider:
+		err = s.syncProviderFromStore(ctx, schemas.ModelProvider(event.ID))
+	case clustersync.KindVirtualKey:
+		if event.Op == clustersync.OpRemove {
+			err = s.RemoveVirtualKey(ctx, event.ID)
+		} else {
+			_, err = s.ReloadVirtualKey(ctx, event.ID)
+		}
+	case clustersync.KindTeam:
+		if event.Op == clustersync.OpRemove {
+			err = s.RemoveTeam(ctx, event.ID)
+		} else {
+			_, err = s.ReloadTeam(ctx, event.ID)
+		}
+	case clustersync.KindCustomer:
+		if event.Op == clustersync.OpRemove {
+			err = s.RemoveCustomer(ctx, event.ID)
+		} else {
+			_, err = s.ReloadCustomer(ctx, event.ID)
+		}
+	case clustersync.KindModelConfig:
+		if event.Op == clustersync.OpRemove {
+			err = s.RemoveModelConfig(ctx, event.ID)
+		} else {
+			_, err = s.ReloadModelConfig(ctx, event.ID)
+		}
+	case clustersync.KindRoutingRule:
+		if event.Op == clustersync.OpRemove {
+			err = s.RemoveRoutingRule(ctx, event.ID)
+		} else {
+			err = s.ReloadRoutingRule(ctx, event.ID)
+		}
+	case clustersync.KindMCPClient:
+		err = s.syncMCPClientFromStore(ctx, event.ID)
+	case clustersync.KindPlugin:
+		err = s.syncPluginFromStore(ctx, event.ID, event.Op)
+	case clustersync.KindClientConfig:
+		err = s.syncClientConfigFromStore(ctx)
+	case clustersync.KindAuthConfig:
+		err = s.syncAuthConfigFromStore(ctx)
+	case clustersync.KindProxyConfig:
+		err = s.syncProxyConfigFromStore(ctx)
+	case clustersync.KindPricing:
+		err = s.syncPricingConfigFromStore(ctx)
+	case clustersync.KindModelCatalog:
+		err = s.syncModelCatalogFromStore(ctx)
+	default:
+		logger.Debug("ignoring unknown cluster sync event kind %s", event.Kind)
+	}
+	if err != nil {
+		logger.Warn("failed to apply %s %s %s from node %s: %v", event.Kind, event.Op, event.ID, event.NodeID, err)
+	}
+}
+
+// syncProviderFromStore loads a provider and its keys from the config store into memory, or
+// removes it when it was deleted.
+func (s *BifrostHTTPServer) syncProviderFromStore(ctx context.Context, provider schemas.ModelProvider) error {
+	// The config store already holds the change
+	skipDBCtx := context.WithValue(ctx, schemas.BifrostContextKeySkipDBUpdate, true)
+	providerConfig, err := s.Config.ConfigStore.GetProviderConfig(ctx, provider)
+	if err != nil && !errors.Is(err, configstore.ErrNotFound) {
+		return err
+	}
+	if providerConfig == nil {
+		return s.RemoveProvider(skipDBCtx, provider)
+	}
+	if _, err := s.Config.GetProviderConfigRaw(provider); err != nil {
+		if !errors.Is(err, lib.ErrNotFound) {
+			return err
+		}
+		if err := s.Config.AddProvider(skipDBCtx, provider, *providerConfig); err != nil && !errors.Is(err, lib.ErrAlreadyExists) {
+			return err
+		}
+	} else if err := s.Config.UpdateProviderConfig(skipDBCtx, provider, *providerConfig); err != nil {
+		return err
+	}
+	_, err = s.ReloadProvider(ctx, provider)
+	return err
+}
+
+// syncMCPClientFromStore connects, updates or removes an MCP client after its config store entry.
+func (s *BifrostHTTPServer) syncMCPClientFromStore(ctx context.Context, id string) error {
+	mcpConfig, err := s.Config.ConfigStore.GetMCPConfig(ctx)
+	if err != nil {
+		return err
+	}
+	var clientConfig *schemas.MCPClientConfig
+	if mcpConfig != nil {
+		for _, config := range mcpConfig.ClientConfigs {
+			if config != nil && config.ID == id {
+				clientConfig = config
+				break
+			}
+		}
+	}
+	if clientConfig == nil {
+		return s.RemoveMCPClient(ctx, id)
+	}
+	if _, err := s.Config.GetMCPClient(id); err == nil {
+		return s.UpdateMCPClient(ctx, id, clientConfig)
+	}
+	return s.AddMCPClient(ctx, clientConfig)
+}
+
+// syncPluginFromStore loads, reloads or stops a plugin after its config store entry. Plugins
+// without an entry, like the ones toggled by the client config, are only stopped when removed.
+func (s *BifrostHTTPServer) syncPluginFromStore(ctx context.Context, name string, op clustersync.Op) error {
+	plugin, err := s.Config.ConfigStore.GetPlugin(ctx, name)
+	if err != nil && !errors.Is(err, configstore.ErrNotFound) {
+		return err
+	}
+	switch {
+	case plugin != nil && plugin.Enabled:
+		return s.ReloadPlugin(ctx, name, plugin.Path, plugin.Config)
+	case plugin != nil || op == clustersync.OpRemove:
+		if plugin != nil {
+			ctx = context.WithValue(ctx, handlers.PluginDisabledKey, true)
+		}
+		if err := s.RemovePlugin(ctx, name); err != nil && !errors.Is(err, dynamicPlugins.ErrPluginNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncClientConfigFromStore reloads the client config and applies the settings that are not part
+// of the client reload.
+func (s *BifrostHTTPServer) syncClientConfigFromStore(ctx context.Context) error {
+	litellmFallbacksEnabled := s.Config.ClientConfig.EnableLiteLLMFallbacks
+	if err := s.ReloadClientConfigFromConfigStore(ctx); err != nil {
+		return err
+	}
+	clientConfig := s.Config.ClientConfig
+	if clientConfig.MCPAgentDepth > 0 || clientConfig.MCPToolExecutionTimeout > 0 {
+		if err := s.UpdateMCPToolManagerConfig(ctx, clientConfig.MCPAgentDepth, clientConfig.MCPToolExecutionTimeout, clientConfig.MCPCodeModeBindingLevel); err != nil {
+			logger.Warn("failed to update MCP tool manager config: %v", err)
+		}
+	}
+	if clientConfig.EnableLiteLLMFallbacks != litellmFallbacksEnabled {
+		if clientConfig.EnableLiteLLMFallbacks {
+			return s.ReloadPlugin(ctx, litellmcompat.PluginName, nil, &litellmcompat.Config{Enabled: true})
+		}
+		if err := s.RemovePlugin(context.WithValue(ctx, handlers.PluginDisabledKey, true), litellmcompat.PluginName); err != nil && !errors.Is(err, dynamicPlugins.ErrPluginNotFound) {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncAuthConfigFromStore updates the admin auth of this replica from the config store.
+func (s *BifrostHTTPServer) syncAuthConfigFromStore(ctx context.Context) error {
+	if s.AuthMiddleware == nil {
+		return nil
+	}
+	authConfig, err := s.Config.ConfigStore.GetAuthConfig(ctx)
+	if err != nil {
+		return err
+	}
+	s.AuthMiddleware.UpdateAuthConfig(authConfig)
+	return nil
+}
+
+// syncProxyConfigFromStore reloads the global proxy config from the config store.
+func (s *BifrostHTTPServer) syncProxyConfigFromStore(ctx context.Context) error {
+	proxyConfig, err := s.Config.ConfigStore.GetProxyConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if proxyConfig == nil {
+		return fmt.Errorf("proxy config not found")
+	}
+	return s.ReloadProxyConfig(ctx, proxyConfig)
+}
+
+// syncPricingConfigFromStore restarts the pricing sync with the settings of the config store.
+func (s *BifrostHTTPServer) syncPricingConfigFromStore(ctx context.Context) error {
+	frameworkConfig, err := s.Config.ConfigStore.GetFrameworkConfig(ctx)
+	if err != nil {
+		return err
+	}
+	pricingConfig := &modelcatalog.Config{}
+	if frameworkConfig != nil {
+		pricingConfig.PricingURL = frameworkConfig.PricingURL
+		if frameworkConfig.PricingSyncInterval != nil && *frameworkConfig.PricingSyncInterval > 0 {
+			syncDuration := time.Duration(*frameworkConfig.PricingSyncInterval) * time.Second
+			pricingConfig.PricingSyncInterval = &syncDuration
+		}
+		if frameworkConfig.ProviderModelHealthPersistDebounce != nil && *frameworkConfig.ProviderModelHealthPersistDebounce > 0 {
+			debounceDuration := time.Duration(*frameworkConfig.ProviderModelHealthPersistDebounce) * time.Millisecond
+			pricingConfig.ProviderModelHealthPersistDebounce = &debounceDuration
+		}
+	}
+	if s.Config.FrameworkConfig != nil {
+		s.Config.FrameworkConfig.Pricing = pricingConfig
+	}
+	return s.ReloadPricingManager(ctx)
+}
+
+// syncModelCatalogFromStore reloads the pricing data and provider model snapshots another replica
+// synced, and narrows the model pool of each provider to the models its keys allow.
+func (s *BifrostHTTPServer) syncModelCatalogFromStore(ctx context.Context) error {
+	if s.Config.ModelCatalog == nil {
+		return nil
+	}
+	if err := s.Config.ModelCatalog.ReloadFromStore(ctx); err != nil {
+		return err
+	}
+	emptyModelData := &schemas.BifrostListModelsResponse{Data: []schemas.Model{}}
+	providers, err := s.Config.GetAllProviders()
+	if err != nil {
+		return err
+	}
+	for _, provider := range providers {
+		providerConfig, err := s.Config.GetProviderConfigRaw(provider)
+		if err != nil {
+			continue
+		}
+		allowedModels := make([]schemas.Model, 0)
+		for _, key := range providerConfig.Keys {
+			for _, model := range key.Models {
+				allowedModels = append(allowedModels, schemas.Model{
+					ID: string(provider) + "/" + model,
+				})
+			}
+		}
+		s.Config.ModelCatalog.UpsertModelDataForProvider(provider, emptyModelData, allowedModels)
+		s.Config.ModelCatalog.UpsertUnfilteredModelDataForProvider(provider, emptyModelData)
+	}
+	return nil
+}
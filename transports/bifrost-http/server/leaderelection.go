@@ -0,0 +1,37 @@
+package server
+
+import (
+	"context"
+
+	"github.com/capsohq/bifrost/framework/leaderelection"
+	"github.com/capsohq/bifrost/framework/modelcatalog"
+)
+
+// startLeaderElection campaigns for the leader lease, if enabled, so that only the leader runs the
+// background workers writing shared state to the config store.
+func (s *BifrostHTTPServer) startLeaderElection(ctx context.Context) {
+	if s.Config.FrameworkConfig == nil || s.Config.FrameworkConfig.LeaderElection == nil || !s.Config.FrameworkConfig.LeaderElection.Enabled {
+		return
+	}
+	if s.Config.ConfigStore == nil {
+		logger.Error("leader election requires a config store, every replica will run the background workers")
+		return
+	}
+	elector, err := leaderelection.New(ctx, *s.Config.FrameworkConfig.LeaderElection, s.Config.ConfigStore, logger)
+	if err != nil {
+		logger.Error("failed to initialize leader election, every replica will run the background workers: %v", err)
+		return
+	}
+	s.LeaderElector = elector
+	s.LeaderElector.Start(ctx)
+	s.applyLeaderElection(s.Config.ModelCatalog)
+	logger.Info("leader election initialized over %s, leader: %t", s.Config.FrameworkConfig.LeaderElection.Backend, s.LeaderElector.IsLeader())
+}
+
+// applyLeaderElection restricts the background workers of a model catalog to the leader.
+func (s *BifrostHTTPServer) applyLeaderElection(modelCatalog *modelcatalog.ModelCatalog) {
+	if s.LeaderElector == nil || modelCatalog == nil {
+		return
+	}
+	modelCatalog.SetIsLeaderFunc(s.LeaderElector.IsLeader)
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/capsohq/bifrost/transports/bifrost-http/handlers"
+	"github.com/valyala/fasthttp"
+)
+
+// unixAddressPrefix marks the address of a Unix domain socket listener
+const unixAddressPrefix = "unix:"
+
+// ListenerConfig is an address the HTTP server listens on, with the routes it serves.
+type ListenerConfig struct {
+	Network string                // "tcp" or "unix"
+	Address string                // host:port for tcp, socket path for unix
+	Role    handlers.ListenerRole // Routes served on this listener
+}
+
+// String returns the listener in the format accepted by ParseListener.
+func (l ListenerConfig) String() string {
+	address := l.Address
+	if l.Network == "unix" {
+		address = unixAddressPrefix + address
+	}
+	if l.Role == "" || l.Role == handlers.ListenerRoleAll {
+		return address
+	}
+	return string(l.Role) + "=" + address
+}
+
+// ParseListener parses a listener of the form [role=]address, where the address is host:port or
+// unix:/path/to/socket and the role is all (default), inference or admin.
+func ParseListener(value string) (ListenerConfig, error) {
+	listener := ListenerConfig{Network: "tcp", Role: handlers.ListenerRoleAll}
+	address := strings.TrimSpace(value)
+	if role, rest, ok := strings.Cut(address, "="); ok {
+		parsedRole, err := handlers.ParseListenerRole(role)
+		if err != nil {
+			return ListenerConfig{}, err
+		}
+		listener.Role = parsedRole
+		address = rest
+	}
+	if path, ok := strings.CutPrefix(address, unixAddressPrefix); ok {
+		path = strings.TrimPrefix(path, "//")
+		if path == "" {
+			return ListenerConfig{}, fmt.Errorf("listener %q has an empty socket path", value)
+		}
+		listener.Network = "unix"
+		listener.Address = path
+		return listener, nil
+	}
+	if _, port, err := net.SplitHostPort(address); err != nil || port == "" {
+		return ListenerConfig{}, fmt.Errorf("listener %q must be host:port or unix:/path/to/socket", value)
+	}
+	listener.Address = address
+	return listener, nil
+}
+
+// Listeners is a list of listeners, settable from a repeated command line flag.
+type Listeners []ListenerConfig
+
+// String implements flag.Value.
+func (l *Listeners) String() string {
+	values := make([]string, 0, len(*l))
+	for _, listener := range *l {
+		values = append(values, listener.String())
+	}
+	return strings.Join(values, ",")
+}
+
+// Set implements flag.Value, accepting one listener or a comma separated list.
+func (l *Listeners) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		listener, err := ParseListener(item)
+		if err != nil {
+			return err
+		}
+		*l = append(*l, listener)
+	}
+	return nil
+}
+
+// listen opens a listener, removing the stale socket file a previous run may have left behind.
+func listen(listener ListenerConfig) (net.Listener, error) {
+	if listener.Network == "unix" {
+		info, err := os.Lstat(listener.Address)
+		if err == nil && info.Mode()&fs.ModeSocket != 0 {
+			if err := os.Remove(listener.Address); err != nil {
+				return nil, fmt.Errorf("failed to remove stale socket %s: %v", listener.Address, err)
+			}
+		} else if err == nil {
+			return nil, fmt.Errorf("%s exists and is not a socket", listener.Address)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return net.Listen(listener.Network, listener.Address)
+}
+
+// newListenerServer creates the fasthttp server of a listener, serving the routes of its role
+// with the settings of s.Server.
+func (s *BifrostHTTPServer) newListenerServer(role handlers.ListenerRole) *fasthttp.Server {
+	return &fasthttp.Server{
+		Handler:            handlers.ListenerRoleHandler(role, s.Server.Handler),
+		MaxRequestBodySize: s.Server.MaxRequestBodySize,
+		ReadBufferSize:     s.Server.ReadBufferSize,
+	}
+}
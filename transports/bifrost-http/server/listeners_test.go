@@ -0,0 +1,76 @@
+package server
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/capsohq/bifrost/transports/bifrost-http/handlers"
+)
+
+func TestParseListener(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    ListenerConfig
+		wantErr bool
+	}{
+		{value: "0.0.0.0:8080", want: ListenerConfig{Network: "tcp", Address: "0.0.0.0:8080", Role: handlers.ListenerRoleAll}},
+		{value: "admin=127.0.0.1:8081", want: ListenerConfig{Network: "tcp", Address: "127.0.0.1:8081", Role: handlers.ListenerRoleAdmin}},
+		{value: "inference=unix:/var/run/bifrost.sock", want: ListenerConfig{Network: "unix", Address: "/var/run/bifrost.sock", Role: handlers.ListenerRoleInference}},
+		{value: "unix:///var/run/bifrost.sock", want: ListenerConfig{Network: "unix", Address: "/var/run/bifrost.sock", Role: handlers.ListenerRoleAll}},
+		{value: "8080", wantErr: true},
+		{value: "unix:", wantErr: true},
+		{value: "public=:8080", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseListener(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseListener() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseListener() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListenersSet(t *testing.T) {
+	var listeners Listeners
+	if err := listeners.Set("inference=unix:/tmp/bifrost.sock, admin=127.0.0.1:8081"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if err := listeners.Set(":8080"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if got, want := listeners.String(), "inference=unix:/tmp/bifrost.sock,admin=127.0.0.1:8081,:8080"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestListenRemovesStaleSocket(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "bifrost.sock")
+	listener := ListenerConfig{Network: "unix", Address: path, Role: handlers.ListenerRoleInference}
+
+	first, err := listen(listener)
+	if err != nil {
+		t.Fatalf("listen() error = %v", err)
+	}
+	// Simulate a crash leaving the socket file behind
+	first.(*net.UnixListener).SetUnlinkOnClose(false)
+	first.Close()
+
+	second, err := listen(listener)
+	if err != nil {
+		t.Fatalf("listen() on a stale socket error = %v", err)
+	}
+	second.Close()
+
+	if err := os.WriteFile(path, []byte("data"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := listen(listener); err == nil {
+		t.Error("expected listen() to refuse to replace a regular file")
+	}
+}
@@ -171,6 +171,12 @@ func (s *BifrostHTTPServer) loadBuiltinPlugins(ctx context.Context) error {
 			IsVkMandatory:   &s.Config.ClientConfig.EnforceAuthOnInference,
 			RequiredHeaders: &s.Config.ClientConfig.RequiredHeaders,
 		}
+		if s.RateLimiter != nil {
+			config.RateLimiter = s.RateLimiter
+		}
+		if s.Webhooks != nil {
+			config.OnBudgetExceeded = s.Webhooks.ObserveBudgetExceeded
+		}
 		s.registerPluginWithStatus(ctx, governance.PluginName, nil, config, false)
 	} else {
 		s.markPluginDisabled(governance.PluginName)
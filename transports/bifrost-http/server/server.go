@@ -3,6 +3,7 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"embed"
 	"errors"
 	"fmt"
@@ -15,16 +16,24 @@ import (
 
 	bifrost "github.com/capsohq/bifrost/core"
 	"github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/framework/clustersync"
 	"github.com/capsohq/bifrost/framework/configstore"
 	"github.com/capsohq/bifrost/framework/configstore/tables"
+	"github.com/capsohq/bifrost/framework/keyhealth"
+	"github.com/capsohq/bifrost/framework/leaderelection"
 	"github.com/capsohq/bifrost/framework/logstore"
 	"github.com/capsohq/bifrost/framework/modelcatalog"
 	dynamicPlugins "github.com/capsohq/bifrost/framework/plugins"
+	"github.com/capsohq/bifrost/framework/ratelimit"
+	"github.com/capsohq/bifrost/framework/reconciliation"
+	"github.com/capsohq/bifrost/framework/secrets"
 	"github.com/capsohq/bifrost/framework/tracing"
+	"github.com/capsohq/bifrost/framework/webhooks"
 	"github.com/capsohq/bifrost/plugins/governance"
 	"github.com/capsohq/bifrost/plugins/logging"
 	"github.com/capsohq/bifrost/plugins/semanticcache"
 	"github.com/capsohq/bifrost/plugins/telemetry"
+	bifrostgrpc "github.com/capsohq/bifrost/transports/bifrost-grpc"
 	"github.com/capsohq/bifrost/transports/bifrost-http/handlers"
 	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
 	"github.com/fasthttp/router"
@@ -32,6 +41,8 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/valyala/fasthttp"
 	"github.com/valyala/fasthttp/fasthttpadaptor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 // Constants
@@ -64,8 +75,20 @@ type ServerCallbacks interface {
 	// Client config related callbacks
 	ReloadHeaderFilterConfig(ctx context.Context, config *tables.GlobalHeaderFilterConfig) error
 	UpdateDropExcessRequests(ctx context.Context, value bool)
+	UpdateLoadSheddingConfig(ctx context.Context, config *schemas.LoadSheddingConfig) error
+	UpdateSchemaDriftConfig(ctx context.Context, config *schemas.SchemaDriftConfig) error
+	UpdateCircuitBreakerConfig(ctx context.Context, config *schemas.CircuitBreakerConfig) error
+	UpdateLatencyRoutingConfig(ctx context.Context, config *schemas.LatencyRoutingConfig) error
+	UpdateCostRoutingConfig(ctx context.Context, config *schemas.CostRoutingConfig) error
+	UpdateVirtualModels(ctx context.Context, models []schemas.VirtualModel) error
+	UpdateTrafficSplits(ctx context.Context, splits []schemas.TrafficSplit) error
+	UpdateSingleFlightConfig(ctx context.Context, config *schemas.SingleFlightConfig) error
+	UpdateToolExecutionConfig(ctx context.Context, config *schemas.ToolExecutionConfig) error
+	UpdateStreamUsageConfig(ctx context.Context, config *schemas.StreamUsageConfig)
+	UpdateHeaderPolicyConfig(ctx context.Context, config *schemas.HeaderPolicyConfig) error
 	// Governance related callbacks
 	GetGovernanceData() *governance.GovernanceData
+	GetTagUsage() map[string]governance.TagUsage
 	ReloadTeam(ctx context.Context, id string) (*tables.TableTeam, error)
 	RemoveTeam(ctx context.Context, id string) error
 	ReloadCustomer(ctx context.Context, id string) (*tables.TableCustomer, error)
@@ -100,20 +123,36 @@ type BifrostHTTPServer struct {
 	Version   string
 	UIContent embed.FS
 
-	Port   string
-	Host   string
-	AppDir string
-
-	LogLevel        string
-	LogOutputStyle  string
-	LogsCleaner     *logstore.LogsCleaner
-	AsyncJobCleaner *logstore.AsyncJobCleaner
+	Port     string
+	Host     string
+	AppDir   string
+	GRPCPort string // Port of the gRPC server, which is not started when empty
+	// Listeners replace the Host:Port listener when set, e.g. to serve inference on a Unix socket
+	// and the management API on a private port
+	Listeners Listeners
+	TLS       TLSConfig // TLS termination on the TCP listeners, disabled when no certificate is configured
+
+	LogLevel         string
+	LogOutputStyle   string
+	LogsCleaner      *logstore.LogsCleaner
+	AsyncJobCleaner  *logstore.AsyncJobCleaner
+	UsageReconciler  *reconciliation.Reconciler
+	KeyHealthChecker *keyhealth.Checker
+	SecretRotator    *secrets.Rotator
+	RateLimiter      *ratelimit.RedisRateLimiter
+	Webhooks         *webhooks.Dispatcher
+	ClusterSync      *clustersync.Syncer
+	LeaderElector    *leaderelection.Elector
 
 	Client *bifrost.Bifrost
 	Config *lib.Config
 
-	Server *fasthttp.Server
-	Router *router.Router
+	Server     *fasthttp.Server
+	Router     *router.Router
+	GRPCServer *grpc.Server
+
+	listenerServers []*fasthttp.Server
+	certReloader    *certReloader
 
 	WebSocketHandler *handlers.WebSocketHandler
 	MCPServerHandler *handlers.MCPServerHandler
@@ -122,6 +161,8 @@ type BifrostHTTPServer struct {
 	AuthMiddleware    *handlers.AuthMiddleware
 	TracingMiddleware *handlers.TracingMiddleware
 	WSTicketStore     *handlers.WSTicketStore
+	// grpcAuthenticator authenticates gRPC calls like the inference middleware authenticates HTTP requests
+	grpcAuthenticator bifrostgrpc.Authenticator
 }
 
 var logger schemas.Logger
@@ -163,6 +204,7 @@ func (s *BifrostHTTPServer) AddMCPClient(ctx context.Context, clientConfig *sche
 	if err := s.MCPServerHandler.SyncAllMCPServers(ctx); err != nil {
 		logger.Warn("failed to sync MCP servers after adding client: %v", err)
 	}
+	s.publishConfigChange(ctx, clustersync.KindMCPClient, clustersync.OpReload, clientConfig.ID)
 	return nil
 }
 
@@ -201,6 +243,7 @@ func (s *BifrostHTTPServer) UpdateMCPClient(ctx context.Context, id string, upda
 	if err := s.MCPServerHandler.SyncAllMCPServers(ctx); err != nil {
 		logger.Warn("failed to sync MCP servers after editing client: %v", err)
 	}
+	s.publishConfigChange(ctx, clustersync.KindMCPClient, clustersync.OpReload, id)
 	return nil
 }
 
@@ -221,6 +264,7 @@ func (s *BifrostHTTPServer) RemoveMCPClient(ctx context.Context, id string) erro
 	if err := s.MCPServerHandler.SyncAllMCPServers(ctx); err != nil {
 		logger.Warn("failed to sync MCP servers after removing client: %v", err)
 	}
+	s.publishConfigChange(ctx, clustersync.KindMCPClient, clustersync.OpRemove, id)
 	return nil
 }
 
@@ -291,6 +335,7 @@ func (s *BifrostHTTPServer) ReloadVirtualKey(ctx context.Context, id string) (*t
 	}
 	governancePlugin.GetGovernanceStore().UpdateVirtualKeyInMemory(virtualKey, nil, nil, nil)
 	s.MCPServerHandler.SyncVKMCPServer(virtualKey)
+	s.publishConfigChange(ctx, clustersync.KindVirtualKey, clustersync.OpReload, id)
 	return virtualKey, nil
 }
 
@@ -309,10 +354,12 @@ func (s *BifrostHTTPServer) RemoveVirtualKey(ctx context.Context, id string) err
 	if preloadedVk == nil {
 		// This could be broadcast message from other server, so we will just clean up in-memory store
 		governancePlugin.GetGovernanceStore().DeleteVirtualKeyInMemory(id)
+		s.publishConfigChange(ctx, clustersync.KindVirtualKey, clustersync.OpRemove, id)
 		return nil
 	}
 	governancePlugin.GetGovernanceStore().DeleteVirtualKeyInMemory(id)
-	s.MCPServerHandler.DeleteVKMCPServer(preloadedVk.Value)
+	s.MCPServerHandler.DeleteVKMCPServer(preloadedVk)
+	s.publishConfigChange(ctx, clustersync.KindVirtualKey, clustersync.OpRemove, id)
 	return nil
 }
 
@@ -330,6 +377,7 @@ func (s *BifrostHTTPServer) ReloadTeam(ctx context.Context, id string) (*tables.
 	}
 	// Add to in-memory store
 	governancePlugin.GetGovernanceStore().UpdateTeamInMemory(preloadedTeam, nil)
+	s.publishConfigChange(ctx, clustersync.KindTeam, clustersync.OpReload, id)
 	return preloadedTeam, nil
 }
 
@@ -348,9 +396,11 @@ func (s *BifrostHTTPServer) RemoveTeam(ctx context.Context, id string) error {
 	if preloadedTeam == nil {
 		// At-least deleting from in-memory store to avoid conflicts
 		governancePlugin.GetGovernanceStore().DeleteTeamInMemory(id)
+		s.publishConfigChange(ctx, clustersync.KindTeam, clustersync.OpRemove, id)
 		return nil
 	}
 	governancePlugin.GetGovernanceStore().DeleteTeamInMemory(id)
+	s.publishConfigChange(ctx, clustersync.KindTeam, clustersync.OpRemove, id)
 	return nil
 }
 
@@ -366,6 +416,7 @@ func (s *BifrostHTTPServer) ReloadCustomer(ctx context.Context, id string) (*tab
 	}
 	// Add to in-memory store
 	governancePlugin.GetGovernanceStore().UpdateCustomerInMemory(preloadedCustomer, nil)
+	s.publishConfigChange(ctx, clustersync.KindCustomer, clustersync.OpReload, id)
 	return preloadedCustomer, nil
 }
 
@@ -384,9 +435,11 @@ func (s *BifrostHTTPServer) RemoveCustomer(ctx context.Context, id string) error
 	if preloadedCustomer == nil {
 		// At-least deleting from in-memory store to avoid conflicts
 		governancePlugin.GetGovernanceStore().DeleteCustomerInMemory(id)
+		s.publishConfigChange(ctx, clustersync.KindCustomer, clustersync.OpRemove, id)
 		return nil
 	}
 	governancePlugin.GetGovernanceStore().DeleteCustomerInMemory(id)
+	s.publishConfigChange(ctx, clustersync.KindCustomer, clustersync.OpRemove, id)
 	return nil
 }
 
@@ -405,6 +458,7 @@ func (s *BifrostHTTPServer) ReloadModelConfig(ctx context.Context, id string) (*
 	// Update in memory and get back the potentially modified model config
 	updatedMC := governancePlugin.GetGovernanceStore().UpdateModelConfigInMemory(preloadedMC)
 	if updatedMC == nil {
+		s.publishConfigChange(ctx, clustersync.KindModelConfig, clustersync.OpReload, id)
 		return preloadedMC, nil
 	}
 
@@ -426,6 +480,7 @@ func (s *BifrostHTTPServer) ReloadModelConfig(ctx context.Context, id string) (*
 		}
 	}
 
+	s.publishConfigChange(ctx, clustersync.KindModelConfig, clustersync.OpReload, id)
 	return updatedMC, nil
 }
 
@@ -436,6 +491,7 @@ func (s *BifrostHTTPServer) RemoveModelConfig(ctx context.Context, id string) er
 		return err
 	}
 	governancePlugin.GetGovernanceStore().DeleteModelConfigInMemory(id)
+	s.publishConfigChange(ctx, clustersync.KindModelConfig, clustersync.OpRemove, id)
 	return nil
 }
 
@@ -497,6 +553,13 @@ func (s *BifrostHTTPServer) ReloadProvider(ctx context.Context, provider schemas
 		logger.Warn("failed to refresh pricing overrides for provider %s: %v", provider, err)
 	}
 
+	// Resolve the secret manager references of added or updated keys before they are validated
+	if s.SecretRotator != nil {
+		if _, err := s.SecretRotator.ResolvePending(ctx); err != nil {
+			logger.Warn("failed to resolve secret references of provider %s: %v", provider, err)
+		}
+	}
+
 	bfCtx := schemas.NewBifrostContext(ctx, time.Now().Add(15*time.Second))
 	bfCtx.SetValue(schemas.BifrostContextKeySkipPluginPipeline, true)
 	bfCtx.SetValue(schemas.BifrostContextKeyValidateKeys, true) // Validate keys during provider add/update
@@ -544,6 +607,7 @@ func (s *BifrostHTTPServer) ReloadProvider(ctx context.Context, provider schemas
 	} else {
 		s.Config.ModelCatalog.UpsertUnfilteredModelDataForProvider(provider, unfilteredModelData)
 	}
+	s.publishConfigChange(ctx, clustersync.KindProvider, clustersync.OpReload, string(provider))
 	return updatedProvider, nil
 }
 
@@ -569,6 +633,7 @@ func (s *BifrostHTTPServer) RemoveProvider(ctx context.Context, provider schemas
 	}
 	s.Config.ModelCatalog.DeleteModelDataForProvider(provider)
 	s.Config.ModelCatalog.DeleteProviderPricingOverrides(provider)
+	s.publishConfigChange(ctx, clustersync.KindProvider, clustersync.OpRemove, string(provider))
 
 	return nil
 }
@@ -584,6 +649,17 @@ func (s *BifrostHTTPServer) GetGovernanceData() *governance.GovernanceData {
 	return governancePlugin.GetGovernanceStore().GetGovernanceData()
 }
 
+// GetTagUsage returns the usage attributed to each request tag by the governance plugin
+func (s *BifrostHTTPServer) GetTagUsage() map[string]governance.TagUsage {
+	governancePlugin, err := lib.FindPluginAs[interface {
+		GetTagUsage() map[string]governance.TagUsage
+	}](s.Config, s.getGovernancePluginName())
+	if err != nil {
+		return nil
+	}
+	return governancePlugin.GetTagUsage()
+}
+
 // ReloadRoutingRule reloads a routing rule from the database into the governance store
 func (s *BifrostHTTPServer) ReloadRoutingRule(ctx context.Context, id string) error {
 	governancePluginName := governance.PluginName
@@ -604,6 +680,7 @@ func (s *BifrostHTTPServer) ReloadRoutingRule(ctx context.Context, id string) er
 	if err := store.UpdateRoutingRuleInMemory(rule); err != nil {
 		return fmt.Errorf("failed to update routing rule in store: %w", err)
 	}
+	s.publishConfigChange(ctx, clustersync.KindRoutingRule, clustersync.OpReload, id)
 	return nil
 }
 
@@ -623,6 +700,7 @@ func (s *BifrostHTTPServer) RemoveRoutingRule(ctx context.Context, id string) er
 	if err := store.DeleteRoutingRuleInMemory(id); err != nil {
 		return fmt.Errorf("failed to delete routing rule from store: %w", err)
 	}
+	s.publishConfigChange(ctx, clustersync.KindRoutingRule, clustersync.OpRemove, id)
 	return nil
 }
 
@@ -647,12 +725,24 @@ func (s *BifrostHTTPServer) ReloadClientConfigFromConfigStore(ctx context.Contex
 			Account:            account,
 			InitialPoolSize:    s.Config.ClientConfig.InitialPoolSize,
 			DropExcessRequests: s.Config.ClientConfig.DropExcessRequests,
+			LoadShedding:       s.Config.ClientConfig.LoadShedding,
+			SchemaDrift:        s.Config.ClientConfig.SchemaDrift,
+			CircuitBreaker:     s.Config.ClientConfig.CircuitBreaker,
+			LatencyRouting:     s.Config.ClientConfig.LatencyRouting,
+			CostRouting:        s.Config.ClientConfig.CostRouting,
+			VirtualModels:      s.Config.ClientConfig.VirtualModels,
+			TrafficSplits:      s.Config.ClientConfig.TrafficSplits,
+			SingleFlight:       s.Config.ClientConfig.SingleFlight,
+			ToolExecution:      s.Config.ClientConfig.ToolExecution,
+			StreamUsage:        s.Config.ClientConfig.StreamUsage,
+			HeaderPolicy:       s.Config.ClientConfig.HeaderPolicy,
 			LLMPlugins:         s.Config.GetLoadedLLMPlugins(),
 			MCPPlugins:         s.Config.GetLoadedMCPPlugins(),
 			MCPConfig:          mcpConfig,
 			Logger:             logger,
 		})
 	}
+	s.publishConfigChange(ctx, clustersync.KindClientConfig, clustersync.OpReload, "")
 	return nil
 }
 
@@ -684,6 +774,7 @@ func (s *BifrostHTTPServer) UpdateAuthConfig(ctx context.Context, authConfig *co
 			s.AuthMiddleware.UpdateAuthConfig(updatedAuthConfig)
 		}
 	}
+	s.publishConfigChange(ctx, clustersync.KindAuthConfig, clustersync.OpReload, "")
 	return nil
 }
 
@@ -695,6 +786,167 @@ func (s *BifrostHTTPServer) UpdateDropExcessRequests(ctx context.Context, value
 	s.Client.UpdateDropExcessRequests(value)
 }
 
+// UpdateLoadSheddingConfig updates the load shedding config of the bifrost client
+func (s *BifrostHTTPServer) UpdateLoadSheddingConfig(ctx context.Context, config *schemas.LoadSheddingConfig) error {
+	if s.Client == nil {
+		return config.Validate()
+	}
+	return s.Client.UpdateLoadSheddingConfig(config)
+}
+
+// UpdateSchemaDriftConfig updates the provider response schema drift checks of the bifrost client
+func (s *BifrostHTTPServer) UpdateSchemaDriftConfig(ctx context.Context, config *schemas.SchemaDriftConfig) error {
+	if s.Client == nil {
+		return config.Validate()
+	}
+	return s.Client.UpdateSchemaDriftConfig(config)
+}
+
+// UpdateCircuitBreakerConfig updates the per provider and model circuit breakers of the bifrost client
+func (s *BifrostHTTPServer) UpdateCircuitBreakerConfig(ctx context.Context, config *schemas.CircuitBreakerConfig) error {
+	if s.Client == nil {
+		return config.Validate()
+	}
+	return s.Client.UpdateCircuitBreakerConfig(config)
+}
+
+// UpdateLatencyRoutingConfig updates the latency-routed model aliases of the bifrost client
+func (s *BifrostHTTPServer) UpdateLatencyRoutingConfig(ctx context.Context, config *schemas.LatencyRoutingConfig) error {
+	if s.Client == nil {
+		return config.Validate()
+	}
+	return s.Client.UpdateLatencyRoutingConfig(config)
+}
+
+// UpdateCostRoutingConfig updates the cost-routed model aliases of the bifrost client
+func (s *BifrostHTTPServer) UpdateCostRoutingConfig(ctx context.Context, config *schemas.CostRoutingConfig) error {
+	if s.Client == nil {
+		return config.Validate()
+	}
+	return s.Client.UpdateCostRoutingConfig(config)
+}
+
+// UpdateVirtualModels updates the virtual models of the bifrost client
+func (s *BifrostHTTPServer) UpdateVirtualModels(ctx context.Context, models []schemas.VirtualModel) error {
+	if s.Client == nil {
+		for _, model := range models {
+			if err := model.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return s.Client.UpdateVirtualModels(models)
+}
+
+// UpdateTrafficSplits updates the traffic splits of the bifrost client
+func (s *BifrostHTTPServer) UpdateTrafficSplits(ctx context.Context, splits []schemas.TrafficSplit) error {
+	if s.Client == nil {
+		for _, split := range splits {
+			if err := split.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return s.Client.UpdateTrafficSplits(splits)
+}
+
+// UpdateSingleFlightConfig updates the deduplication of identical in-flight requests of the bifrost client
+func (s *BifrostHTTPServer) UpdateSingleFlightConfig(ctx context.Context, config *schemas.SingleFlightConfig) error {
+	if s.Client == nil {
+		return config.Validate()
+	}
+	return s.Client.UpdateSingleFlightConfig(config)
+}
+
+// UpdateToolExecutionConfig updates the tool webhooks the bifrost client executes
+func (s *BifrostHTTPServer) UpdateToolExecutionConfig(ctx context.Context, config *schemas.ToolExecutionConfig) error {
+	if s.Client == nil {
+		return config.Validate()
+	}
+	return s.Client.UpdateToolExecutionConfig(config)
+}
+
+// UpdateStreamUsageConfig updates the usage estimation of streams of the bifrost client
+func (s *BifrostHTTPServer) UpdateStreamUsageConfig(ctx context.Context, config *schemas.StreamUsageConfig) {
+	if s.Client != nil {
+		s.Client.UpdateStreamUsageConfig(config)
+	}
+}
+
+// UpdateHeaderPolicyConfig updates the headers the bifrost client forwards, strips and injects on provider requests
+func (s *BifrostHTTPServer) UpdateHeaderPolicyConfig(ctx context.Context, config *schemas.HeaderPolicyConfig) error {
+	if s.Client == nil {
+		return config.Validate()
+	}
+	return s.Client.UpdateHeaderPolicyConfig(config)
+}
+
+// lookupModelPricing returns the per-token prices of a model from the model catalog, if it is loaded
+func (s *BifrostHTTPServer) lookupModelPricing(provider schemas.ModelProvider, model string, requestType schemas.RequestType) (float64, float64, bool) {
+	if s.Config == nil || s.Config.ModelCatalog == nil {
+		return 0, 0, false
+	}
+	return s.Config.ModelCatalog.GetTokenPricing(provider, model, requestType)
+}
+
+// calculateResponseCost prices a response with the model catalog, if it is loaded
+func (s *BifrostHTTPServer) calculateResponseCost(result *schemas.BifrostResponse) *schemas.BifrostResponseCost {
+	if s.Config == nil || s.Config.ModelCatalog == nil {
+		return nil
+	}
+	return s.Config.ModelCatalog.CalculateResponseCost(result)
+}
+
+// recordSchemaDrift counts provider response schema drift in the telemetry plugin, if it is loaded
+func (s *BifrostHTTPServer) recordSchemaDrift(drift schemas.SchemaDrift) {
+	prometheusPlugin, err := lib.FindPluginAs[*telemetry.PrometheusPlugin](s.Config, telemetry.PluginName)
+	if err != nil {
+		return
+	}
+	prometheusPlugin.RecordSchemaDrift(drift)
+}
+
+// notifyCircuitBreaker sends a webhook when a circuit opens
+func (s *BifrostHTTPServer) notifyCircuitBreaker(event schemas.CircuitBreakerEvent) {
+	if s.Webhooks != nil {
+		s.Webhooks.ObserveCircuitBreaker(event)
+	}
+}
+
+// notifyBatch sends a webhook when a retrieved batch has finished
+func (s *BifrostHTTPServer) notifyBatch(batch *schemas.BifrostBatchRetrieveResponse) {
+	if s.Webhooks != nil {
+		s.Webhooks.ObserveBatch(batch)
+	}
+}
+
+// watchBatch polls a created batch so a webhook is sent once it has finished
+func (s *BifrostHTTPServer) watchBatch(batch *schemas.BifrostBatchCreateResponse) {
+	if s.Webhooks != nil {
+		s.Webhooks.ObserveBatchCreated(batch)
+	}
+}
+
+// retrieveBatch retrieves a batch for the webhook batch poller
+func (s *BifrostHTTPServer) retrieveBatch(ctx context.Context, provider schemas.ModelProvider, batchID string) (*schemas.BifrostBatchRetrieveResponse, error) {
+	if s.Client == nil {
+		return nil, fmt.Errorf("bifrost client not initialized")
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = schemas.NoDeadline
+	}
+	bfCtx := schemas.NewBifrostContext(ctx, deadline)
+	bfCtx.SetValue(schemas.BifrostContextKeySkipPluginPipeline, true)
+	batch, bifrostErr := s.Client.BatchRetrieveRequest(bfCtx, &schemas.BifrostBatchRetrieveRequest{Provider: provider, BatchID: batchID})
+	if bifrostErr != nil {
+		return nil, errors.New(bifrost.GetErrorMessage(bifrostErr))
+	}
+	return batch, nil
+}
+
 // UpdateMCPToolManagerConfig updates the MCP tool manager config
 func (s *BifrostHTTPServer) UpdateMCPToolManagerConfig(ctx context.Context, maxAgentDepth int, toolExecutionTimeoutInSeconds int, codeModeBindingLevel string) error {
 	if s.Config == nil {
@@ -718,7 +970,11 @@ func (s *BifrostHTTPServer) ReloadPricingManager(ctx context.Context) error {
 	if s.Config.FrameworkConfig == nil || s.Config.FrameworkConfig.Pricing == nil {
 		return fmt.Errorf("framework config not found")
 	}
-	return s.Config.ModelCatalog.ReloadPricing(ctx, s.Config.FrameworkConfig.Pricing)
+	if err := s.Config.ModelCatalog.ReloadPricing(ctx, s.Config.FrameworkConfig.Pricing); err != nil {
+		return err
+	}
+	s.publishConfigChange(ctx, clustersync.KindPricing, clustersync.OpReload, "")
+	return nil
 }
 
 // ForceReloadPricing triggers an immediate pricing sync and resets the sync timer
@@ -736,6 +992,7 @@ func (s *BifrostHTTPServer) ForceReloadPricing(ctx context.Context) error {
 			return fmt.Errorf("failed to initialize new model catalog: %w", err)
 		}
 		s.Config.ModelCatalog = modelCatalog
+		s.applyLeaderElection(modelCatalog)
 		for provider, providerConfig := range s.Config.Providers {
 			if err := s.Config.ModelCatalog.SetProviderPricingOverrides(provider, providerConfig.PricingOverrides); err != nil {
 				logger.Warn("failed to seed pricing overrides for provider %s: %v", provider, err)
@@ -779,6 +1036,7 @@ func (s *BifrostHTTPServer) ForceReloadPricing(ctx context.Context) error {
 			bfCtx.Cancel()
 		}
 	}
+	s.publishConfigChange(ctx, clustersync.KindModelCatalog, clustersync.OpReload, "")
 	return nil
 }
 
@@ -790,6 +1048,7 @@ func (s *BifrostHTTPServer) ReloadProxyConfig(ctx context.Context, config *table
 	// Store the proxy config in memory for use by components that need it
 	s.Config.ProxyConfig = config
 	logger.Info("proxy configuration reloaded: enabled=%t, type=%s", config.Enabled, config.Type)
+	s.publishConfigChange(ctx, clustersync.KindProxyConfig, clustersync.OpReload, "")
 	return nil
 }
 
@@ -872,7 +1131,11 @@ func (s *BifrostHTTPServer) ReloadPlugin(ctx context.Context, name string, path
 	if err != nil {
 		return s.updatePluginErrorStatus(name, "loading", err)
 	}
-	return s.SyncLoadedPlugin(ctx, name, plugin)
+	if err := s.SyncLoadedPlugin(ctx, name, plugin); err != nil {
+		return err
+	}
+	s.publishConfigChange(ctx, clustersync.KindPlugin, clustersync.OpReload, name)
+	return nil
 }
 
 // RemovePlugin removes a plugin from the server.
@@ -913,6 +1176,7 @@ func (s *BifrostHTTPServer) RemovePlugin(ctx context.Context, displayName string
 	} else {
 		s.Config.DeletePluginOverallStatus(name)
 	}
+	s.publishConfigChange(ctx, clustersync.KindPlugin, clustersync.OpRemove, displayName)
 
 	return nil
 }
@@ -988,6 +1252,16 @@ func (s *BifrostHTTPServer) RegisterAPIRoutes(ctx context.Context, callbacks Ser
 	configHandler := handlers.NewConfigHandler(callbacks, s.Config)
 	pluginsHandler := handlers.NewPluginsHandler(callbacks, s.Config.ConfigStore)
 	sessionHandler := handlers.NewSessionHandler(s.Config.ConfigStore, s.WSTicketStore)
+	var presetsHandler *handlers.PresetsHandler
+	var auditHandler *handlers.AuditHandler
+	if s.Config.ConfigStore != nil {
+		presetsHandler = handlers.NewPresetsHandler(s.Client, s.Config.ConfigStore)
+		auditHandler = handlers.NewAuditHandler(s.Config.ConfigStore)
+	}
+	var pricingOverridesHandler *handlers.PricingOverridesHandler
+	if s.Config.ConfigStore != nil && s.Config.ModelCatalog != nil {
+		pricingOverridesHandler = handlers.NewPricingOverridesHandler(s.Config.ModelCatalog, s.Config.ConfigStore)
+	}
 	// Going ahead with API handlers
 	healthHandler.RegisterRoutes(s.Router, middlewares...)
 	providerHandler.RegisterRoutes(s.Router, middlewares...)
@@ -1000,12 +1274,30 @@ func (s *BifrostHTTPServer) RegisterAPIRoutes(ctx context.Context, callbacks Ser
 	if sessionHandler != nil {
 		sessionHandler.RegisterRoutes(s.Router, middlewares...)
 	}
+	if presetsHandler != nil {
+		presetsHandler.RegisterRoutes(s.Router, middlewares...)
+	}
+	if pricingOverridesHandler != nil {
+		pricingOverridesHandler.RegisterRoutes(s.Router, middlewares...)
+	}
+	if auditHandler != nil {
+		auditHandler.RegisterRoutes(s.Router, middlewares...)
+	}
 	if cacheHandler != nil {
 		cacheHandler.RegisterRoutes(s.Router, middlewares...)
 	}
 	if governanceHandler != nil {
 		governanceHandler.RegisterRoutes(s.Router, middlewares...)
 	}
+	if s.UsageReconciler != nil {
+		handlers.NewUsageReconciliationHandler(s.UsageReconciler).RegisterRoutes(s.Router, middlewares...)
+	}
+	if s.KeyHealthChecker != nil {
+		handlers.NewKeyHealthHandler(s.KeyHealthChecker).RegisterRoutes(s.Router, middlewares...)
+	}
+	if s.Webhooks != nil && s.Config.ConfigStore != nil {
+		handlers.NewWebhooksHandler(s.Webhooks, s.Config.ConfigStore).RegisterRoutes(s.Router, middlewares...)
+	}
 	if loggingHandler != nil {
 		loggingHandler.RegisterRoutes(s.Router, middlewares...)
 	}
@@ -1119,12 +1411,29 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to load config %v", err)
 	}
+	// Elect the replica running the background workers before they first write shared state
+	s.startLeaderElection(s.Ctx)
+	// Resolve the values referencing secret managers before they are first used, then re-resolve them on schedule
+	secretsConfig := secrets.Config{}
+	if s.Config.FrameworkConfig != nil && s.Config.FrameworkConfig.Secrets != nil {
+		secretsConfig = *s.Config.FrameworkConfig.Secrets
+	}
+	secretSources, err := secrets.NewSources(ctx, secretsConfig, nil)
+	if err != nil {
+		logger.Error("failed to initialize secret managers, values referencing them will not be resolved: %v", err)
+		secretSources = nil
+	}
+	s.SecretRotator = secrets.NewRotator(secretSources, s.Config.GetSecretReferences, secretsConfig, logger)
+	if _, err := s.SecretRotator.Run(ctx); err != nil {
+		logger.Warn("failed to resolve secret references: %v", err)
+	}
+	s.SecretRotator.Start()
 	// Initialize WebSocket handler early so plugins can wire event broadcasters during Init.
 	// Log callbacks are registered later in RegisterAPIRoutes when logging plugin is available.
 	s.WebSocketHandler = handlers.NewWebSocketHandler(s.Ctx, s.Config.ClientConfig.AllowedOrigins)
 	s.Config.EventBroadcaster = s.WebSocketHandler.BroadcastEvent
 	// Initializing plugin loader
-	s.Config.PluginLoader = &dynamicPlugins.SharedObjectPluginLoader{}
+	s.Config.PluginLoader = dynamicPlugins.NewMultiPluginLoader()
 	// Initialize log retention cleaner if log store is configured
 	if s.Config.LogsStore != nil {
 		// If log retention days remains 0, then we wont be initializing the log retention cleaner
@@ -1162,6 +1471,41 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 		s.AsyncJobCleaner = logstore.NewAsyncJobCleaner(s.Config.LogsStore, logger)
 		s.AsyncJobCleaner.StartCleanupRoutine()
 	}
+	// Initialize provider usage reconciliation if enabled in the framework config
+	if s.Config.LogsStore != nil && s.Config.FrameworkConfig != nil &&
+		s.Config.FrameworkConfig.UsageReconciliation != nil && s.Config.FrameworkConfig.UsageReconciliation.Enabled {
+		s.UsageReconciler = reconciliation.NewReconciler(s.Config.LogsStore, *s.Config.FrameworkConfig.UsageReconciliation, logger)
+		s.UsageReconciler.Start()
+		logger.Info("usage reconciliation job initialized")
+	}
+	// Initialize the shared rate limiter governance enforces rate limits with, if enabled
+	if s.Config.FrameworkConfig != nil && s.Config.FrameworkConfig.RateLimiter != nil && s.Config.FrameworkConfig.RateLimiter.Enabled {
+		rateLimiter, err := ratelimit.NewRedisRateLimiter(ctx, *s.Config.FrameworkConfig.RateLimiter, logger)
+		if err != nil {
+			logger.Error("failed to initialize shared rate limiter, rate limits will be enforced per instance: %v", err)
+		} else {
+			s.RateLimiter = rateLimiter
+		}
+	}
+	// Initialize webhook notifications of gateway events, if enabled
+	if s.Config.FrameworkConfig != nil && s.Config.FrameworkConfig.Webhooks != nil && s.Config.FrameworkConfig.Webhooks.Enabled {
+		var deadLetterStore webhooks.DeadLetterStore
+		if s.Config.ConfigStore != nil {
+			deadLetterStore = s.Config.ConfigStore
+		}
+		dispatcher, err := webhooks.NewDispatcher(*s.Config.FrameworkConfig.Webhooks, deadLetterStore, logger)
+		if err != nil {
+			logger.Error("failed to initialize webhooks, events will not be sent: %v", err)
+		} else {
+			s.Webhooks = dispatcher
+			s.Webhooks.SetBatchRetriever(s.retrieveBatch)
+			s.Webhooks.Start()
+			if s.Config.TokenRefreshWorker != nil {
+				s.Config.TokenRefreshWorker.SetExpiringTokenObserver(s.Webhooks.ObserveExpiringToken)
+			}
+			logger.Info("webhooks initialized with %d endpoints", len(s.Config.FrameworkConfig.Webhooks.Endpoints))
+		}
+	}
 	// Load all plugins
 	if err := s.LoadPlugins(ctx); err != nil {
 		return fmt.Errorf("failed to instantiate plugins: %v", err)
@@ -1194,16 +1538,44 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 		Account:            account,
 		InitialPoolSize:    s.Config.ClientConfig.InitialPoolSize,
 		DropExcessRequests: s.Config.ClientConfig.DropExcessRequests,
+		LoadShedding:       s.Config.ClientConfig.LoadShedding,
+		SchemaDrift:        s.Config.ClientConfig.SchemaDrift,
+		CircuitBreaker:     s.Config.ClientConfig.CircuitBreaker,
+		LatencyRouting:     s.Config.ClientConfig.LatencyRouting,
+		CostRouting:        s.Config.ClientConfig.CostRouting,
+		VirtualModels:      s.Config.ClientConfig.VirtualModels,
+		TrafficSplits:      s.Config.ClientConfig.TrafficSplits,
+		SingleFlight:       s.Config.ClientConfig.SingleFlight,
+		ToolExecution:      s.Config.ClientConfig.ToolExecution,
+		StreamUsage:        s.Config.ClientConfig.StreamUsage,
+		HeaderPolicy:       s.Config.ClientConfig.HeaderPolicy,
 		LLMPlugins:         s.Config.GetLoadedLLMPlugins(),
 		MCPPlugins:         s.Config.GetLoadedMCPPlugins(),
 		MCPConfig:          mcpConfig,
 		OAuth2Provider:     s.Config.OAuthProvider,
 		Logger:             logger,
+
+		ModelPricing:           s.lookupModelPricing,
+		CostCalculator:         s.calculateResponseCost,
+		SchemaDriftObserver:    s.recordSchemaDrift,
+		CircuitBreakerObserver: s.notifyCircuitBreaker,
+		BatchObserver:          s.notifyBatch,
+		BatchCreateObserver:    s.watchBatch,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to initialize bifrost: %v", err)
 	}
 	logger.Info("bifrost client initialized")
+	if s.Config.ConfigStore != nil {
+		if err := handlers.LoadParameterPresets(ctx, s.Client, s.Config.ConfigStore); err != nil {
+			logger.Warn("failed to load parameter presets: %v", err)
+		}
+		if s.Config.ModelCatalog != nil {
+			if err := handlers.LoadPricingOverrides(ctx, s.Config.ModelCatalog, s.Config.ConfigStore); err != nil {
+				logger.Warn("failed to load pricing overrides: %v", err)
+			}
+		}
+	}
 	// List all models and add to model catalog with per-provider status tracking
 	logger.Info("listing all models and adding to model catalog")
 	if s.Config.ModelCatalog != nil {
@@ -1251,6 +1623,16 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 
 	logger.Info("models added to catalog")
 	s.Config.SetBifrostClient(s.Client)
+	// The key health view is always served since requests quarantine invalid keys too, periodic checks are opt-in
+	keyHealthConfig := keyhealth.Config{}
+	if s.Config.FrameworkConfig != nil && s.Config.FrameworkConfig.KeyHealthCheck != nil {
+		keyHealthConfig = *s.Config.FrameworkConfig.KeyHealthCheck
+	}
+	s.KeyHealthChecker = keyhealth.NewChecker(s.Client, s.Config.GetAllProviderKeys, keyHealthConfig, logger)
+	if keyHealthConfig.Enabled {
+		s.KeyHealthChecker.Start()
+		logger.Info("key health check job initialized")
+	}
 	// Initialize routes
 	s.Router = router.New()
 	commonMiddlewares := s.PrepareCommonMiddlewares()
@@ -1269,7 +1651,13 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 		if ctx.Value(schemas.BifrostContextKeyIsEnterprise) == nil {
 			apiMiddlewares = append(apiMiddlewares, s.AuthMiddleware.APIMiddleware())
 		}
+		// Audit runs after authentication so that the caller is known
+		apiMiddlewares = append(apiMiddlewares, handlers.NewAuditMiddleware(s.Config.ConfigStore, func() fasthttp.RequestHandler {
+			return s.Router.Handler
+		}).Middleware())
 	}
+	// Listeners serving only inference routes reject the API routes first
+	apiMiddlewares = append([]schemas.BifrostHTTPMiddleware{handlers.RouteRoleMiddleware(handlers.ListenerRoleAdmin)}, apiMiddlewares...)
 	// Register routes
 	err = s.RegisterAPIRoutes(s.Ctx, s, apiMiddlewares...)
 	if err != nil {
@@ -1282,9 +1670,12 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 	// Registering inference routes
 	if ctx.Value(schemas.BifrostContextKeyIsEnterprise) == nil && s.AuthMiddleware != nil {
 		inferenceMiddlewares = append(inferenceMiddlewares, s.AuthMiddleware.InferenceMiddleware())
+		s.grpcAuthenticator = s.AuthMiddleware.AuthenticateGRPC
 	}
 	// Registering inference middlewares
 	inferenceMiddlewares = append([]schemas.BifrostHTTPMiddleware{handlers.TransportInterceptorMiddleware(s.Config)}, inferenceMiddlewares...)
+	// Model aliases are resolved before the plugin interceptors, so governance routing sees the routed model
+	inferenceMiddlewares = append([]schemas.BifrostHTTPMiddleware{handlers.ModelAliasMiddleware(s.Client)}, inferenceMiddlewares...)
 	// Curating observability plugins
 	observabilityPlugins := s.CollectObservabilityPlugins()
 	// This enables the central streaming accumulator for both use cases
@@ -1296,6 +1687,7 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 	// The observability plugins are optional (can be empty if only logging is enabled)
 	s.TracingMiddleware = handlers.NewTracingMiddleware(tracer, observabilityPlugins)
 	inferenceMiddlewares = append([]schemas.BifrostHTTPMiddleware{s.TracingMiddleware.Middleware()}, inferenceMiddlewares...)
+	inferenceMiddlewares = append([]schemas.BifrostHTTPMiddleware{handlers.RouteRoleMiddleware(handlers.ListenerRoleInference)}, inferenceMiddlewares...)
 	err = s.RegisterInferenceRoutes(s.Ctx, inferenceMiddlewares...)
 	if err != nil {
 		if s.WSTicketStore != nil {
@@ -1305,7 +1697,9 @@ func (s *BifrostHTTPServer) Bootstrap(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize inference routes: %v", err)
 	}
 	// Register UI handler
-	s.RegisterUIRoutes()
+	s.RegisterUIRoutes(handlers.RouteRoleMiddleware(handlers.ListenerRoleAdmin))
+	// Apply the config changes of the other replicas, if enabled
+	s.startClusterSync(s.Ctx)
 	// Create fasthttp server instance
 	s.Server = &fasthttp.Server{
 		Handler:            handlers.SecurityHeadersMiddleware()(handlers.CorsMiddleware(s.Config)(handlers.RequestDecompressionMiddleware(s.Config)(s.Router.Handler))),
@@ -1324,21 +1718,74 @@ func (s *BifrostHTTPServer) Start() error {
 	}
 	// Create channels for signal and error handling
 	sigChan := make(chan os.Signal, 1)
-	errChan := make(chan error, 1)
+	listeners := s.Listeners
+	if len(listeners) == 0 {
+		listeners = Listeners{{Network: "tcp", Address: net.JoinHostPort(s.Host, s.Port), Role: handlers.ListenerRoleAll}}
+	}
+	errChan := make(chan error, len(listeners)+1)
 	// Watching for signals
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-	// Start server in a goroutine
-	serverAddr := net.JoinHostPort(s.Host, s.Port)
-	ln, err := net.Listen("tcp", serverAddr)
+	tlsConfig, err := s.setupTLS()
 	if err != nil {
-		return fmt.Errorf("failed to create listener on %s: %v", serverAddr, err)
+		return fmt.Errorf("failed to set up TLS: %v", err)
+	}
+	scheme := "http"
+	if tlsConfig != nil {
+		scheme = "https"
 	}
-	go func() {
-		logger.Info("successfully started bifrost, serving UI on http://%s:%s", s.Host, s.Port)
-		if err := s.Server.Serve(ln); err != nil {
-			errChan <- err
+	// Start a server per listener, each in a goroutine
+	for _, listener := range listeners {
+		ln, err := listen(listener)
+		if err != nil {
+			s.shutdownListenerServers()
+			return fmt.Errorf("failed to create listener on %s: %v", listener.Address, err)
+		}
+		// TLS is terminated on TCP listeners only, Unix sockets are local to the host
+		if tlsConfig != nil && listener.Network == "tcp" {
+			ln = tls.NewListener(ln, tlsConfig)
+		}
+		listenerServer := s.newListenerServer(listener.Role)
+		s.listenerServers = append(s.listenerServers, listenerServer)
+		go func() {
+			if listener.Network == "unix" {
+				logger.Info("successfully started bifrost, serving %s routes on unix socket %s", listener.Role, listener.Address)
+			} else if listener.Role == handlers.ListenerRoleInference {
+				logger.Info("successfully started bifrost, serving inference routes on %s://%s", scheme, listener.Address)
+			} else {
+				logger.Info("successfully started bifrost, serving UI on %s://%s", scheme, listener.Address)
+			}
+			if err := listenerServer.Serve(ln); err != nil {
+				errChan <- err
+			}
+		}()
+	}
+	// Start the gRPC server on its own port, sharing the Bifrost client with the HTTP server
+	if s.GRPCPort != "" {
+		grpcAddr := net.JoinHostPort(s.Host, s.GRPCPort)
+		grpcLn, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			s.shutdownListenerServers()
+			return fmt.Errorf("failed to create gRPC listener on %s: %v", grpcAddr, err)
+		}
+		var grpcOptions []grpc.ServerOption
+		if tlsConfig != nil {
+			grpcOptions = append(grpcOptions, grpc.Creds(credentials.NewTLS(tlsConfig.Clone())))
+		}
+		if s.grpcAuthenticator != nil {
+			grpcOptions = append(grpcOptions,
+				grpc.ChainUnaryInterceptor(bifrostgrpc.UnaryAuthInterceptor(s.grpcAuthenticator)),
+				grpc.ChainStreamInterceptor(bifrostgrpc.StreamAuthInterceptor(s.grpcAuthenticator)),
+			)
 		}
-	}()
+		s.GRPCServer = grpc.NewServer(grpcOptions...)
+		bifrostgrpc.NewServer(s.Client, logger).Register(s.GRPCServer)
+		go func() {
+			logger.Info("serving gRPC on %s", grpcAddr)
+			if err := s.GRPCServer.Serve(grpcLn); err != nil {
+				errChan <- err
+			}
+		}()
+	}
 	// Wait for either termination signal or server error
 	select {
 	case sig := <-sigChan:
@@ -1347,10 +1794,10 @@ func (s *BifrostHTTPServer) Start() error {
 		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
 		// Perform graceful shutdown
-		if err := s.Server.Shutdown(); err != nil {
-			logger.Error("error during graceful shutdown: %v", err)
-		} else {
-			logger.Info("server gracefully shutdown")
+		s.shutdownListenerServers()
+		if s.GRPCServer != nil {
+			s.GRPCServer.GracefulStop()
+			logger.Info("gRPC server gracefully shutdown")
 		}
 		// Cancelling main context
 		if s.cancel != nil {
@@ -1379,6 +1826,33 @@ func (s *BifrostHTTPServer) Start() error {
 				logger.Info("stopping async job cleaner...")
 				s.AsyncJobCleaner.StopCleanupRoutine()
 			}
+			if s.UsageReconciler != nil {
+				logger.Info("stopping usage reconciliation job...")
+				s.UsageReconciler.Stop()
+			}
+			if s.KeyHealthChecker != nil {
+				logger.Info("stopping key health check job...")
+				s.KeyHealthChecker.Stop()
+			}
+			if s.SecretRotator != nil {
+				logger.Info("stopping secret rotation...")
+				s.SecretRotator.Stop()
+			}
+			if s.RateLimiter != nil {
+				s.RateLimiter.Close()
+			}
+			if s.Webhooks != nil {
+				logger.Info("stopping webhooks...")
+				s.Webhooks.Stop()
+			}
+			if s.ClusterSync != nil {
+				logger.Info("stopping cluster sync...")
+				s.ClusterSync.Close()
+			}
+			if s.LeaderElector != nil {
+				logger.Info("releasing leader lease...")
+				s.LeaderElector.Close()
+			}
 			if s.Config != nil && s.Config.TokenRefreshWorker != nil {
 				logger.Info("stopping token refresh worker...")
 				s.Config.TokenRefreshWorker.Stop()
@@ -1411,3 +1885,21 @@ func (s *BifrostHTTPServer) Start() error {
 	}
 	return nil
 }
+
+// shutdownListenerServers gracefully shuts down the servers of all listeners and stops reloading
+// their certificate
+func (s *BifrostHTTPServer) shutdownListenerServers() {
+	for _, listenerServer := range s.listenerServers {
+		if err := listenerServer.Shutdown(); err != nil {
+			logger.Error("error during graceful shutdown: %v", err)
+		}
+	}
+	if len(s.listenerServers) > 0 {
+		logger.Info("server gracefully shutdown")
+	}
+	s.listenerServers = nil
+	if s.certReloader != nil {
+		s.certReloader.stop()
+		s.certReloader = nil
+	}
+}
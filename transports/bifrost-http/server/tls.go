@@ -0,0 +1,177 @@
+package server
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// DefaultTLSReloadInterval is how often the certificate files are checked for changes
+const DefaultTLSReloadInterval = 30 * time.Second
+
+// TLSConfig configures TLS termination on the TCP listeners, from certificate files or ACME.
+type TLSConfig struct {
+	CertFile       string        // PEM certificate chain, reloaded when it changes
+	KeyFile        string        // PEM private key of the certificate, reloaded when it changes
+	ACMEDomains    string        // Comma separated domains to obtain certificates for with ACME
+	ACMEEmail      string        // Optional contact email of the ACME account
+	ReloadInterval time.Duration // How often the certificate files are checked (default: 30s)
+}
+
+// Enabled returns true if any TLS option is set.
+func (c *TLSConfig) Enabled() bool {
+	return c.CertFile != "" || c.KeyFile != "" || c.ACMEDomains != "" || c.ACMEEmail != ""
+}
+
+// Validate checks that either a certificate and key pair or ACME domains are configured.
+func (c *TLSConfig) Validate() error {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return errors.New("both -tls-cert and -tls-key must be set")
+	}
+	if c.CertFile != "" && c.ACMEDomains != "" {
+		return errors.New("-tls-cert/-tls-key and -tls-acme-domains cannot be used together")
+	}
+	if c.ACMEEmail != "" && c.ACMEDomains == "" {
+		return errors.New("-tls-acme-email requires -tls-acme-domains")
+	}
+	return nil
+}
+
+// setupTLS creates the TLS config of the TCP listeners, starting the certificate reloader when
+// certificates are loaded from files. It returns nil when TLS is not configured.
+func (s *BifrostHTTPServer) setupTLS() (*tls.Config, error) {
+	if !s.TLS.Enabled() {
+		return nil, nil
+	}
+	if err := s.TLS.Validate(); err != nil {
+		return nil, err
+	}
+	if s.TLS.ACMEDomains != "" {
+		var domains []string
+		for _, domain := range strings.Split(s.TLS.ACMEDomains, ",") {
+			if domain = strings.TrimSpace(domain); domain != "" {
+				domains = append(domains, domain)
+			}
+		}
+		manager := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(domains...),
+			Cache:      autocert.DirCache(filepath.Join(GetDefaultConfigDir(s.AppDir), "acme")),
+			Email:      s.TLS.ACMEEmail,
+		}
+		logger.Info("obtaining TLS certificates with ACME for %s", strings.Join(domains, ", "))
+		return &tls.Config{
+			MinVersion:     tls.VersionTLS12,
+			GetCertificate: manager.GetCertificate,
+			// acme-tls/1 answers the TLS-ALPN-01 challenges on the listener itself
+			NextProtos: []string{"http/1.1", acme.ALPNProto},
+		}, nil
+	}
+	reloader, err := newCertReloader(s.TLS.CertFile, s.TLS.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+	interval := s.TLS.ReloadInterval
+	if interval <= 0 {
+		interval = DefaultTLSReloadInterval
+	}
+	reloader.start(interval)
+	s.certReloader = reloader
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+		NextProtos:     []string{"http/1.1"},
+	}, nil
+}
+
+// certReloader serves a certificate loaded from files and reloads it when the files change,
+// so that rotated certificates are picked up without a restart.
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	cert    atomic.Pointer[tls.Certificate]
+	version string // Modification times and sizes of the loaded files
+	done    chan struct{}
+}
+
+// newCertReloader loads the certificate, failing when the files cannot be loaded.
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile, done: make(chan struct{})}
+	if _, err := r.reloadIfChanged(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the current certificate, for tls.Config.GetCertificate.
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.cert.Load(), nil
+}
+
+// reloadIfChanged loads the certificate if the files changed since the last load. The current
+// certificate is kept when the new files cannot be loaded, e.g. when only one of them is updated.
+func (r *certReloader) reloadIfChanged() (bool, error) {
+	version, err := fileVersion(r.certFile, r.keyFile)
+	if err != nil {
+		return false, err
+	}
+	if version == r.version {
+		return false, nil
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("failed to load TLS certificate %s: %v", r.certFile, err)
+	}
+	r.cert.Store(&cert)
+	r.version = version
+	return true, nil
+}
+
+// start checks the files for changes every interval until stop is called.
+func (r *certReloader) start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.done:
+				return
+			case <-ticker.C:
+				reloaded, err := r.reloadIfChanged()
+				if err != nil {
+					logger.Error("failed to reload TLS certificate, keeping the current one: %v", err)
+				} else if reloaded {
+					logger.Info("reloaded TLS certificate %s", r.certFile)
+				}
+			}
+		}
+	}()
+}
+
+// stop stops checking the files for changes.
+func (r *certReloader) stop() {
+	close(r.done)
+}
+
+// fileVersion identifies the contents of files by their modification times and sizes.
+// Symlinks are followed, so that Kubernetes secret volume updates are detected.
+func fileVersion(paths ...string) (string, error) {
+	var version strings.Builder
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&version, "%s:%d:%d;", path, info.ModTime().UnixNano(), info.Size())
+	}
+	return version.String(), nil
+}
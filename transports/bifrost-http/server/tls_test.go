@@ -0,0 +1,112 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeTestCertificate writes a self-signed certificate for commonName and its key
+func writeTestCertificate(t *testing.T, certFile, keyFile, commonName string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func commonNameOf(t *testing.T, r *certReloader) string {
+	t.Helper()
+	cert, err := r.GetCertificate(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return leaf.Subject.CommonName
+}
+
+func TestCertReloaderReloadsChangedFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	writeTestCertificate(t, certFile, keyFile, "first")
+
+	r, err := newCertReloader(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("newCertReloader() error = %v", err)
+	}
+	if reloaded, err := r.reloadIfChanged(); reloaded || err != nil {
+		t.Errorf("expected no reload of unchanged files, got %v, %v", reloaded, err)
+	}
+
+	writeTestCertificate(t, certFile, keyFile, "second")
+	// Make sure the modification time changes on filesystems with coarse timestamps
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(certFile, later, later)
+	if reloaded, err := r.reloadIfChanged(); !reloaded || err != nil {
+		t.Fatalf("expected a reload, got %v, %v", reloaded, err)
+	}
+	if got := commonNameOf(t, r); got != "second" {
+		t.Errorf("certificate = %q, want second", got)
+	}
+
+	// A broken update keeps the current certificate
+	os.WriteFile(keyFile, []byte("not a key"), 0o600)
+	if _, err := r.reloadIfChanged(); err == nil {
+		t.Error("expected an error loading a broken key")
+	}
+	if got := commonNameOf(t, r); got != "second" {
+		t.Errorf("certificate = %q after a broken update, want second", got)
+	}
+}
+
+func TestTLSConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  TLSConfig
+		wantErr bool
+	}{
+		{"cert and key", TLSConfig{CertFile: "tls.crt", KeyFile: "tls.key"}, false},
+		{"acme", TLSConfig{ACMEDomains: "bifrost.example.com", ACMEEmail: "ops@example.com"}, false},
+		{"cert without key", TLSConfig{CertFile: "tls.crt"}, true},
+		{"cert and acme", TLSConfig{CertFile: "tls.crt", KeyFile: "tls.key", ACMEDomains: "bifrost.example.com"}, true},
+		{"email without acme", TLSConfig{ACMEEmail: "ops@example.com"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	bifrost "github.com/capsohq/bifrost/core"
+	schemas "github.com/capsohq/bifrost/core/schemas"
+	"github.com/capsohq/bifrost/transports/bifrost-http/lib"
+	bifrostServer "github.com/capsohq/bifrost/transports/bifrost-http/server"
+)
+
+// Exit codes of the validate subcommand.
+const (
+	validateExitOK       = 0 // config is valid
+	validateExitFindings = 1 // config has errors (or warnings with -strict)
+	validateExitUsage    = 2 // bad flags or the config file could not be read
+)
+
+// runValidate implements `bifrost validate`. It loads config.json from the app directory (or the
+// file given with -config), runs the static checks and, with -probe, live key probes, prints the
+// findings and returns the process exit code. Nothing is written to the config or logs stores.
+func runValidate(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	appDir := fs.String("app-dir", bifrostServer.DefaultAppDir, "Application data directory containing config.json")
+	configPath := fs.String("config", "", "Path to the config file (overrides -app-dir)")
+	schemaPath := fs.String("schema", "", "Path to a local config.schema.json (default: fetch the published schema)")
+	probe := fs.Bool("probe", false, "Send a list models request per provider to check that each key is accepted")
+	probeTimeout := fs.Duration("probe-timeout", lib.DefaultProbeTimeout, "Timeout for the key probes of each provider")
+	format := fs.String("format", "text", "Output format (text or json)")
+	strict := fs.Bool("strict", false, "Exit non-zero on warnings as well as errors")
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: bifrost validate [flags]\n\nValidates config.json without starting the server.\n\nFlags:\n")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return validateExitOK
+		}
+		return validateExitUsage
+	}
+	if *format != "text" && *format != "json" {
+		fmt.Fprintf(stderr, "invalid -format %q (expected text or json)\n", *format)
+		return validateExitUsage
+	}
+
+	path := *configPath
+	if path == "" {
+		path = filepath.Join(bifrostServer.GetDefaultConfigDir(*appDir), "config.json")
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Fprintf(stderr, "failed to read config file: %v\n", err)
+		return validateExitUsage
+	}
+	var schema []byte
+	if *schemaPath != "" {
+		if schema, err = os.ReadFile(*schemaPath); err != nil {
+			fmt.Fprintf(stderr, "failed to read schema file: %v\n", err)
+			return validateExitUsage
+		}
+	}
+
+	// Probes run a short-lived Bifrost client, so keep its logs out of the report.
+	lib.SetLogger(bifrost.NewDefaultLogger(schemas.LogLevelError))
+
+	report := lib.ValidateConfig(context.Background(), data, lib.ValidateOptions{
+		Schema:       schema,
+		Probe:        *probe,
+		ProbeTimeout: *probeTimeout,
+	})
+	if absPath, err := filepath.Abs(path); err == nil {
+		path = absPath
+	}
+	report.ConfigPath = path
+
+	if *format == "json" {
+		encoder := json.NewEncoder(stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			fmt.Fprintf(stderr, "failed to encode report: %v\n", err)
+			return validateExitUsage
+		}
+	} else {
+		printValidationReport(stdout, report)
+	}
+
+	if !report.Valid || (*strict && report.Warnings > 0) {
+		return validateExitFindings
+	}
+	return validateExitOK
+}
+
+// printValidationReport writes a human readable report, one finding per line.
+func printValidationReport(w io.Writer, report *lib.ValidationReport) {
+	fmt.Fprintf(w, "Validating %s\n", report.ConfigPath)
+	for _, finding := range report.Findings {
+		severity := "ERROR"
+		if finding.Severity == lib.ValidationSeverityWarning {
+			severity = "WARN "
+		}
+		if finding.Path != "" {
+			fmt.Fprintf(w, "  %s %s: %s\n", severity, finding.Path, finding.Message)
+		} else {
+			fmt.Fprintf(w, "  %s %s\n", severity, finding.Message)
+		}
+	}
+	if report.ProbedKeys > 0 {
+		fmt.Fprintf(w, "Probed %d keys\n", report.ProbedKeys)
+	}
+	status := "valid"
+	if !report.Valid {
+		status = "invalid"
+	}
+	fmt.Fprintf(w, "Config is %s: %d errors, %d warnings\n", status, report.Errors, report.Warnings)
+}
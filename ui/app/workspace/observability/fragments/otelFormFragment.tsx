@@ -8,7 +8,7 @@ import { Input } from "@/components/ui/input";
 import { Select, SelectContent, SelectItem, SelectTrigger, SelectValue } from "@/components/ui/select";
 import { Switch } from "@/components/ui/switch";
 import { Tooltip, TooltipContent, TooltipProvider, TooltipTrigger } from "@/components/ui/tooltip";
-import { otelFormSchema, type OtelFormSchema } from "@/lib/types/schemas";
+import { otelFormSchema, type OtelExporterConfigSchema, type OtelFormSchema } from "@/lib/types/schemas";
 import { RbacOperation, RbacResource, useRbac } from "@enterprise/lib";
 import { zodResolver } from "@hookform/resolvers/zod";
 import { Trash2 } from "lucide-react";
@@ -30,6 +30,9 @@ interface OtelFormFragmentProps {
 		metrics_enabled?: boolean;
 		metrics_endpoint?: string;
 		metrics_push_interval?: number;
+		// Content and additional exporters, kept as configured in config.json
+		disable_content?: boolean;
+		exporters?: OtelExporterConfigSchema[];
 	};
 	onSave: (config: OtelFormSchema) => Promise<void>;
 	onDelete?: () => void;
@@ -57,6 +60,8 @@ export function OtelFormFragment({ currentConfig: initialConfig, onSave, onDelet
 				metrics_enabled: initialConfig?.metrics_enabled ?? false,
 				metrics_endpoint: initialConfig?.metrics_endpoint ?? "",
 				metrics_push_interval: initialConfig?.metrics_push_interval ?? 15,
+				disable_content: initialConfig?.disable_content,
+				exporters: initialConfig?.exporters,
 			},
 		},
 	});
@@ -101,6 +106,8 @@ export function OtelFormFragment({ currentConfig: initialConfig, onSave, onDelet
 				metrics_enabled: initialConfig?.metrics_enabled ?? false,
 				metrics_endpoint: initialConfig?.metrics_endpoint ?? "",
 				metrics_push_interval: initialConfig?.metrics_push_interval ?? 15,
+				disable_content: initialConfig?.disable_content,
+				exporters: initialConfig?.exporters,
 			},
 		});
 	}, [form, initialConfig]);
@@ -45,7 +45,8 @@ interface VirtualKeySheetProps {
 	virtualKey?: VirtualKey | null;
 	teams: Team[];
 	customers: Customer[];
-	onSave: () => void;
+	// Receives the created virtual key, the only response that carries its full value
+	onSave: (createdVirtualKey?: VirtualKey) => void;
 	onCancel: () => void;
 }
 
@@ -413,8 +414,10 @@ export default function VirtualKeySheet({ virtualKey, teams, customers, onSave,
 					};
 				}
 
-				await createVirtualKey(createData).unwrap();
+				const response = await createVirtualKey(createData).unwrap();
 				toast.success("Virtual key created successfully");
+				onSave(response.virtual_key);
+				return;
 			}
 
 			onSave();
@@ -19,7 +19,7 @@ import { Customer, Team, VirtualKey } from "@/lib/types/governance"
 import { cn } from "@/lib/utils"
 import { formatCurrency } from "@/lib/utils/governance"
 import { RbacOperation, RbacResource, useRbac } from "@enterprise/lib"
-import { Copy, Edit, Eye, EyeOff, Plus, Trash2 } from "lucide-react"
+import { Copy, Edit, Plus, Trash2 } from "lucide-react"
 import { useMemo, useState } from "react"
 import { toast } from "sonner"
 import VirtualKeyDetailSheet from "./virtualKeyDetailsSheet"
@@ -35,7 +35,8 @@ interface VirtualKeysTableProps {
 export default function VirtualKeysTable({ virtualKeys, teams, customers }: VirtualKeysTableProps) {
   const [showVirtualKeySheet, setShowVirtualKeySheet] = useState(false)
   const [editingVirtualKeyId, setEditingVirtualKeyId] = useState<string | null>(null)
-  const [revealedKeys, setRevealedKeys] = useState<Set<string>>(new Set())
+  // Only a hash of the value is stored, so the full key is shown once, right after it is created
+  const [createdVirtualKey, setCreatedVirtualKey] = useState<VirtualKey | null>(null)
   const [selectedVirtualKeyId, setSelectedVirtualKeyId] = useState<string | null>(null)
   const [showDetailSheet, setShowDetailSheet] = useState(false)
 
@@ -75,9 +76,12 @@ export default function VirtualKeysTable({ virtualKeys, teams, customers }: Virt
 		setShowVirtualKeySheet(true);
 	};
 
-	const handleVirtualKeySaved = () => {
+	const handleVirtualKeySaved = (created?: VirtualKey) => {
 		setShowVirtualKeySheet(false);
 		setEditingVirtualKeyId(null);
+		if (created) {
+			setCreatedVirtualKey(created);
+		}
 	};
 
 	const handleRowClick = (vk: VirtualKey) => {
@@ -90,26 +94,40 @@ export default function VirtualKeysTable({ virtualKeys, teams, customers }: Virt
 		setSelectedVirtualKeyId(null);
 	};
 
-	const toggleKeyVisibility = (vkId: string) => {
-		const newRevealed = new Set(revealedKeys);
-		if (newRevealed.has(vkId)) {
-			newRevealed.delete(vkId);
-		} else {
-			newRevealed.add(vkId);
-		}
-		setRevealedKeys(newRevealed);
-	};
-
-	const maskKey = (key: string, revealed: boolean) => {
-		if (revealed) return key;
-		return key.substring(0, 8) + "•".repeat(Math.max(0, key.length - 8));
-	};
-
 	const copyToClipboard = (key: string) => {
 		navigator.clipboard.writeText(key);
 		toast.success("Copied to clipboard");
 	};
 
+	const createdVirtualKeyDialog = (
+		<AlertDialog open={createdVirtualKey !== null} onOpenChange={(open) => !open && setCreatedVirtualKey(null)}>
+			<AlertDialogContent data-testid="vk-created-dialog">
+				<AlertDialogHeader>
+					<AlertDialogTitle>Copy your virtual key</AlertDialogTitle>
+					<AlertDialogDescription>
+						This is the only time the key is shown. Store it somewhere safe; if it is lost, create a new virtual key.
+					</AlertDialogDescription>
+				</AlertDialogHeader>
+				<div className="flex items-center gap-2">
+					<code className="bg-muted flex-1 truncate rounded-sm px-2 py-1 font-mono text-sm" data-testid="vk-created-value">
+						{createdVirtualKey?.value}
+					</code>
+					<Button
+						variant="ghost"
+						size="sm"
+						onClick={() => createdVirtualKey && copyToClipboard(createdVirtualKey.value)}
+						data-testid="vk-created-copy-btn"
+					>
+						<Copy className="h-4 w-4" />
+					</Button>
+				</div>
+				<AlertDialogFooter>
+					<AlertDialogAction onClick={() => setCreatedVirtualKey(null)}>Done</AlertDialogAction>
+				</AlertDialogFooter>
+			</AlertDialogContent>
+		</AlertDialog>
+	);
+
 	// Empty state when user has no virtual keys (same pattern as Plugins)
 	if (virtualKeys?.length === 0) {
 		return (
@@ -123,6 +141,7 @@ export default function VirtualKeysTable({ virtualKeys, teams, customers }: Virt
 						onCancel={() => setShowVirtualKeySheet(false)}
 					/>
 				)}
+				{createdVirtualKeyDialog}
 				<VirtualKeysEmptyState onAddClick={handleAddVirtualKey} canCreate={hasCreateAccess} />
 			</>
 		);
@@ -142,6 +161,8 @@ export default function VirtualKeysTable({ virtualKeys, teams, customers }: Virt
 
 			{showDetailSheet && selectedVirtualKey && <VirtualKeyDetailSheet virtualKey={selectedVirtualKey} onClose={handleDetailSheetClose} />}
 
+			{createdVirtualKeyDialog}
+
 			<div className="space-y-4">
 				<div className="flex items-center justify-between">
 					<div>
@@ -167,7 +188,6 @@ export default function VirtualKeysTable({ virtualKeys, teams, customers }: Virt
 						</TableHeader>
 						<TableBody>
 							{virtualKeys?.map((vk) => {
-									const isRevealed = revealedKeys.has(vk.id);
 									const isExhausted =
 										(vk.budget?.current_usage && vk.budget?.max_limit && vk.budget.current_usage >= vk.budget.max_limit) ||
 										(vk.rate_limit?.token_current_usage &&
@@ -188,25 +208,9 @@ export default function VirtualKeysTable({ virtualKeys, teams, customers }: Virt
 												<div className="truncate font-medium">{vk.name}</div>
 											</TableCell>
 											<TableCell onClick={(e) => e.stopPropagation()}>
-												<div className="flex items-center gap-2">
-													<code className="cursor-default px-2 py-1 font-mono text-sm">{maskKey(vk.value, isRevealed)}</code>
-													<Button
-														variant="ghost"
-														size="sm"
-														onClick={() => toggleKeyVisibility(vk.id)}
-														data-testid={`vk-visibility-btn-${vk.name}`}
-													>
-														{isRevealed ? <EyeOff className="h-4 w-4" /> : <Eye className="h-4 w-4" />}
-													</Button>
-													<Button
-														variant="ghost"
-														size="sm"
-														onClick={() => copyToClipboard(vk.value)}
-														data-testid={`vk-copy-btn-${vk.name}`}
-													>
-														<Copy className="h-4 w-4" />
-													</Button>
-												</div>
+												<code className="cursor-default px-2 py-1 font-mono text-sm" data-testid={`vk-value-${vk.name}`}>
+													{vk.value}
+												</code>
 											</TableCell>
 											<TableCell>
 												{vk.budget ? (
@@ -35,8 +35,9 @@ export const ModelPlaceholders = {
 	gemini: "e.g. gemini-1.5-pro, gemini-1.5-flash",
 	glm: "e.g. glm-5, glm-4.7, glm-4.7-flashx, glm-4.6, glm-4.5-air",
 	groq: "e.g. llama3-70b-8192, mixtral-8x7b-32768",
+	hunyuan: "e.g. hunyuan-turbos-latest, hunyuan-t1-latest, hunyuan-vision, hunyuan-embedding",
 	huggingface: "e.g. sambanova/meta-llama/Llama-3.1-8B-Instruct, nebius/Qwen/Qwen3-Embedding-8B",
-	minimax: "e.g. MiniMax-M2.5, MiniMax-M2.5-highspeed, M2-her, image-01, music-2.5",
+	minimax: "e.g. MiniMax-M2.5, MiniMax-M2.5-highspeed, M2-her, image-01, music-2.5, embo-01",
 	mistral: "e.g. mistral-7b-instruct, mixtral-8x7b",
 	moonshot: "e.g. kimi-k2.5, kimi-k2-thinking, kimi-k2-thinking-turbo, kimi-k2-0905-preview",
 	modelark: "e.g. doubao-seed-1-6-250615, doubao-seed-1-6-thinking-250615, doubao-1.5-vision-pro-250328, doubao-seedream-4-5-251128",
@@ -50,7 +51,10 @@ export const ModelPlaceholders = {
 	vertex: "e.g. gemini-1.5-pro, text-bison, chat-bison",
 	nebius: "e.g. openai/gpt-oss-120b, google/gemma-2-9b-it-fast, Qwen/Qwen2.5-VL-72B-Instruct",
 	qwen: "e.g. qwen-plus-latest, qwen3-max-preview, qwen3-coder-plus",
+	stepfun: "e.g. step-2-16k, step-1-256k, step-1v-8k, step-tts-mini, step-asr",
 	xai: "e.g. grok-4-0709, grok-3-mini, grok-3, grok-2-vision-1212",
+	yi: "e.g. yi-lightning, yi-large, yi-medium-200k, yi-vision-v2",
+	spark: "e.g. 4.0Ultra, max-32k, generalv3.5, lite",
 	replicate: "e.g. meta/llama3-1-8b-instruct, black-forest-labs/flux-dev",
 	vllm: "e.g. Qwen/Qwen3-0.6B, Qwen/Qwen3-1.5B",
 	runway: "e.g. gen4_turbo_image_to_video, gen3a_turbo_image_to_video",
@@ -68,12 +72,14 @@ export const isKeyRequiredByProvider: Record<ProviderName, boolean> = {
 	glm: true,
 	groq: true,
 	huggingface: true,
+	hunyuan: true,
 	minimax: true,
 	mistral: true,
 	modelark: true,
 	moonshot: true,
 	openrouter: true,
 	sgl: false,
+	stepfun: true,
 	parasail: true,
 	elevenlabs: true,
 	ollama: false,
@@ -87,6 +93,8 @@ export const isKeyRequiredByProvider: Record<ProviderName, boolean> = {
 	runway: true,
 	vllm: false,
 	volcengine: true,
+	yi: true,
+	spark: true,
 };
 
 export const DefaultNetworkConfig = {
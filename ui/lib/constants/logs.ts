@@ -10,6 +10,7 @@ export const KnownProvidersNames = [
 	"glm",
 	"groq",
 	"huggingface",
+	"hunyuan",
 	"minimax",
 	"mistral",
 	"modelark",
@@ -22,6 +23,7 @@ export const KnownProvidersNames = [
 	"perplexity",
 	"qwen",
 	"sgl",
+	"stepfun",
 	"vertex",
 	"volcengine",
 	"nebius",
@@ -29,6 +31,8 @@ export const KnownProvidersNames = [
 	"replicate",
 	"vllm",
 	"runway",
+	"yi",
+	"spark",
 ] as const;
 
 // Local Provider type derived from KNOWN_PROVIDERS constant
@@ -48,15 +52,20 @@ export const RequestTypes = [
 	"responses_stream",
 	"embedding",
 	"rerank",
+	"moderation",
+	"realtime",
 	"speech",
 	"speech_stream",
 	"transcription",
 	"transcription_stream",
+	"translation",
 	"image_generation",
 	"image_generation_stream",
 	"image_edit",
 	"image_edit_stream",
 	"image_variation",
+	"music_generation",
+	"context_cache_create",
 	"video_generation",
 	"video_retrieve",
 	"video_download",
@@ -75,6 +84,19 @@ export const RequestTypes = [
 	"container_file_retrieve",
 	"container_file_content",
 	"container_file_delete",
+	// Fine-tuning job operations
+	"fine_tuning_job_create",
+	"fine_tuning_job_list",
+	"fine_tuning_job_retrieve",
+	"fine_tuning_job_cancel",
+	"fine_tuning_job_events",
+	"fine_tuning_job_checkpoints",
+	// Vector store operations
+	"vector_store_create",
+	"vector_store_list",
+	"vector_store_search",
+	"vector_store_file_create",
+	"vector_store_file_list",
 ] as const;
 
 export const ProviderLabels: Record<ProviderName, string> = {
@@ -102,11 +124,15 @@ export const ProviderLabels: Record<ProviderName, string> = {
 	modelark: "ModelArk",
 	nebius: "Nebius Token Factory",
 	moonshot: "Moonshot",
+	hunyuan: "Tencent Hunyuan",
+	stepfun: "StepFun",
 	volcengine: "Volcengine",
 	xai: "xAI",
 	replicate: "Replicate",
 	vllm: "vLLM",
 	runway: "Runway",
+	yi: "01.AI (Yi)",
+	spark: "iFlytek Spark",
 } as const;
 
 // Helper function to get provider label, supporting custom providers
@@ -158,18 +184,23 @@ export const RequestTypeLabels = {
 
 	embedding: "Embedding",
 	rerank: "Rerank",
+	moderation: "Moderation",
+	realtime: "Realtime",
 
 	speech: "Speech",
 	speech_stream: "Speech Stream",
 
 	transcription: "Transcription",
 	transcription_stream: "Transcription Stream",
+	translation: "Translation",
 
 	image_generation: "Image Generation",
 	image_generation_stream: "Image Generation Stream",
 	image_edit: "Image Edit",
 	image_edit_stream: "Image Edit Stream",
 	image_variation: "Image Variation",
+	music_generation: "Music Generation",
+	context_cache_create: "Context Cache Create",
 	video_generation: "Video Generation",
 	video_retrieve: "Video Retrieve",
 	video_download: "Video Download",
@@ -202,6 +233,20 @@ export const RequestTypeLabels = {
 	container_file_retrieve: "Container File Retrieve",
 	container_file_content: "Container File Content",
 	container_file_delete: "Container File Delete",
+
+	// Fine-tuning job operations
+	fine_tuning_job_create: "Fine-tuning Job Create",
+	fine_tuning_job_list: "Fine-tuning Job List",
+	fine_tuning_job_retrieve: "Fine-tuning Job Retrieve",
+	fine_tuning_job_cancel: "Fine-tuning Job Cancel",
+	fine_tuning_job_events: "Fine-tuning Job Events",
+	fine_tuning_job_checkpoints: "Fine-tuning Job Checkpoints",
+	// Vector store operations
+	vector_store_create: "Vector Store Create",
+	vector_store_list: "Vector Store List",
+	vector_store_search: "Vector Store Search",
+	vector_store_file_create: "Vector Store File Create",
+	vector_store_file_list: "Vector Store File List",
 } as const;
 
 export const RequestTypeColors = {
@@ -230,18 +275,23 @@ export const RequestTypeColors = {
 
 	embedding: "bg-red-100 text-red-800",
 	rerank: "bg-fuchsia-100 text-fuchsia-800",
+	moderation: "bg-rose-100 text-rose-800",
+	realtime: "bg-sky-100 text-sky-800",
 
 	speech: "bg-purple-100 text-purple-800",
 	speech_stream: "bg-pink-100 text-pink-800",
 
 	transcription: "bg-orange-100 text-orange-800",
 	transcription_stream: "bg-lime-100 text-lime-800",
+	translation: "bg-amber-100 text-amber-800",
 
 	image_generation: "bg-indigo-100 text-indigo-800",
 	image_generation_stream: "bg-sky-100 text-sky-800",
 	image_edit: "bg-emerald-100 text-emerald-800",
 	image_edit_stream: "bg-teal-100 text-teal-800",
 	image_variation: "bg-violet-100 text-violet-800",
+	music_generation: "bg-amber-100 text-amber-800",
+	context_cache_create: "bg-lime-100 text-lime-800",
 	video_generation: "bg-fuchsia-100 text-fuchsia-800",
 	video_retrieve: "bg-blue-100 text-blue-800",
 	video_download: "bg-purple-100 text-purple-800",
@@ -263,6 +313,20 @@ export const RequestTypeColors = {
 	container_file_content: "bg-sky-100 text-sky-800",
 	container_file_delete: "bg-rose-100 text-rose-800",
 
+	// Fine-tuning job operations
+	fine_tuning_job_create: "bg-emerald-100 text-emerald-800",
+	fine_tuning_job_list: "bg-teal-100 text-teal-800",
+	fine_tuning_job_retrieve: "bg-cyan-100 text-cyan-800",
+	fine_tuning_job_cancel: "bg-yellow-100 text-yellow-800",
+	fine_tuning_job_events: "bg-indigo-100 text-indigo-800",
+	fine_tuning_job_checkpoints: "bg-violet-100 text-violet-800",
+	// Vector store operations
+	vector_store_create: "bg-emerald-100 text-emerald-800",
+	vector_store_list: "bg-teal-100 text-teal-800",
+	vector_store_search: "bg-sky-100 text-sky-800",
+	vector_store_file_create: "bg-lime-100 text-lime-800",
+	vector_store_file_list: "bg-cyan-100 text-cyan-800",
+
 	batch_create: "bg-green-100 text-green-800",
 	batch_list: "bg-blue-100 text-blue-800",
 	batch_retrieve: "bg-red-100 text-red-800",
@@ -0,0 +1,35 @@
+// Audit log types that match the Go backend structures
+
+export type AuditAction = "create" | "update" | "delete" | "denied";
+
+export interface AuditLog {
+	id: string;
+	timestamp: string;
+	actor: string;
+	action: AuditAction;
+	resource_type: string;
+	resource_id?: string;
+	method: string;
+	path: string;
+	status_code: number;
+	old_value?: any;
+	new_value?: any;
+}
+
+export interface AuditLogFilters {
+	actor?: string;
+	action?: AuditAction;
+	resource_type?: string;
+	resource_id?: string;
+	start_time?: string;
+	end_time?: string;
+}
+
+export interface AuditLogsResponse {
+	audit_logs: AuditLog[];
+	pagination: {
+		limit: number;
+		offset: number;
+		total_count: number;
+	};
+}
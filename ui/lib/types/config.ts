@@ -112,6 +112,29 @@ export const DefaultVLLMKeyConfig: VLLMKeyConfig = {
 	model_name: "",
 } as const satisfies Required<VLLMKeyConfig>;
 
+// HunyuanKeyConfig matching Go's schemas.HunyuanKeyConfig
+export interface HunyuanKeyConfig {
+	secret_id: EnvVar;
+	secret_key: EnvVar;
+	region?: EnvVar;
+}
+
+// GLMWebSearchConfig matching Go's schemas.GLMWebSearchConfig
+export interface GLMWebSearchConfig {
+	enabled: boolean;
+	search_engine?: string;
+	count?: number;
+	search_domain_filter?: string;
+	search_recency_filter?: "oneDay" | "oneWeek" | "oneMonth" | "oneYear" | "noLimit";
+	content_size?: "medium" | "high";
+	search_prompt?: string;
+}
+
+// GLMKeyConfig matching Go's schemas.GLMKeyConfig
+export interface GLMKeyConfig {
+	web_search?: GLMWebSearchConfig;
+}
+
 // Key structure matching Go's schemas.Key
 export interface ModelProviderKey {
 	id: string;
@@ -126,6 +149,8 @@ export interface ModelProviderKey {
 	bedrock_key_config?: BedrockKeyConfig;
 	replicate_key_config?: ReplicateKeyConfig;
 	vllm_key_config?: VLLMKeyConfig;
+	hunyuan_key_config?: HunyuanKeyConfig;
+	glm_key_config?: GLMKeyConfig;
 	config_hash?: string; // Present when config is synced from config.json
 	status?: "unknown" | "success" | "list_models_failed";
 	description?: string;
@@ -154,12 +179,34 @@ export interface NetworkConfig {
 	max_retries: number;
 	retry_backoff_initial: number; // Duration in milliseconds
 	retry_backoff_max: number; // Duration in milliseconds
+	health_check_interval_in_seconds?: number;
+	allowed_path_overrides?: string[];
+	endpoint_region?: "auto" | "international" | "china";
+	retry_policy?: RetryPolicy;
+}
+
+// RetryPolicy matching Go's schemas.RetryPolicy
+export interface RetryPolicy {
+	max_attempts: number;
+	backoff_base_ms?: number;
+	backoff_max_ms?: number;
+	jitter?: number;
+	retryable_status_codes?: number[];
+	respect_retry_after?: boolean;
 }
 
 // ConcurrencyAndBufferSize matching Go's schemas.ConcurrencyAndBufferSize
 export interface ConcurrencyAndBufferSize {
 	concurrency: number;
 	buffer_size: number;
+	scheduler?: SchedulerConfig;
+}
+
+// SchedulerConfig matching Go's schemas.SchedulerConfig
+export interface SchedulerConfig {
+	max_in_flight: number;
+	max_queue_depth?: number;
+	queue_timeout_ms?: number;
 }
 
 // Proxy types matching Go's schemas.ProxyType
@@ -293,6 +340,19 @@ export interface ProviderPricingOverride {
 	cache_read_input_image_token_cost?: number;
 }
 
+// PricingOverride matching Go's TablePricingOverride, a custom override managed via /api/pricing/overrides
+export interface PricingOverride extends ProviderPricingOverride {
+	id: string;
+	name: string;
+	description?: string;
+	provider: ModelProviderName;
+	created_at: string;
+	updated_at: string;
+}
+
+// UpsertPricingOverrideRequest matching Go's UpsertPricingOverrideRequest
+export type UpsertPricingOverrideRequest = Omit<PricingOverride, "id" | "created_at" | "updated_at">;
+
 // ProviderConfig matching Go's lib.ProviderConfig
 export interface ModelProviderConfig {
 	keys: ModelProviderKey[];
@@ -377,6 +437,21 @@ export interface AuthConfig {
 	admin_password: EnvVar;
 	is_enabled: boolean;
 	disable_auth_on_inference?: boolean;
+	jwt?: JWTAuthConfig;
+}
+
+// JWT auth config for inference requests
+export interface JWTAuthConfig {
+	enabled: boolean;
+	jwks_url?: string;
+	hmac_secret?: EnvVar;
+	issuer?: string;
+	audience?: string;
+	user_id_claim?: string;
+	team_id_claim?: string;
+	customer_id_claim?: string;
+	roles_claim?: string;
+	role_mappings?: Record<string, "admin" | "operator" | "viewer">;
 }
 
 // Global proxy type (for global proxy configuration, not per-provider)
@@ -446,6 +521,92 @@ export interface BifrostConfig {
 }
 
 // Core Bifrost configuration types
+// Priority-based load shedding configuration
+export interface LoadSheddingConfig {
+	enabled: boolean;
+	normal_threshold?: number;
+	background_threshold?: number;
+	max_retry_after_seconds?: number;
+}
+
+// Upstream response schema drift detection configuration
+export interface SchemaDriftConfig {
+	enabled: boolean;
+	sample_rate?: number;
+}
+
+// Per provider and model circuit breaker configuration
+export interface CircuitBreakerConfig {
+	enabled: boolean;
+	error_rate_threshold?: number;
+	min_requests?: number;
+	window_seconds?: number;
+	probe_interval_seconds?: number;
+}
+
+// Model aliases routed to their fastest healthy provider and model
+export interface LatencyRoutingConfig {
+	aliases: Record<string, { provider: string; model: string }[]>;
+	percentile?: "p50" | "p95";
+	min_samples?: number;
+	window_seconds?: number;
+}
+
+// Model aliases routed to their cheapest provider and model above a quality floor
+export interface CostRoutingConfig {
+	aliases: Record<string, { provider: string; model: string; quality?: number }[]>;
+	quality_floor?: number;
+	expected_output_tokens?: number;
+}
+
+// Model name mapped to provider and model targets in priority order
+export interface VirtualModel {
+	name: string;
+	description?: string;
+	targets: { provider: string; model: string }[];
+}
+
+// Logical model sending a percentage of its traffic to a candidate model
+export interface TrafficSplit {
+	name: string;
+	primary: { provider: string; model: string };
+	candidate: { provider: string; model: string };
+	candidate_percent: number;
+}
+
+export interface SingleFlightConfig {
+	enabled: boolean;
+	request_types?: ("text_completion" | "chat_completion" | "responses" | "embedding")[];
+}
+
+export interface ToolWebhook {
+	url: string;
+	headers?: Record<string, string>;
+	timeout_in_seconds?: number;
+}
+
+export interface ToolExecutionConfig {
+	webhooks?: Record<string, ToolWebhook>;
+	max_iterations?: number;
+}
+
+export interface StreamUsageConfig {
+	enabled: boolean;
+}
+
+export interface HeaderInjection {
+	providers?: string[];
+	teams?: string[];
+	headers: Record<string, string>;
+	override?: boolean;
+}
+
+export interface HeaderPolicyConfig {
+	forward?: string[];
+	strip?: string[];
+	inject?: HeaderInjection[];
+}
+
 export interface CoreConfig {
 	drop_excess_requests: boolean;
 	initial_pool_size: number;
@@ -465,8 +626,22 @@ export interface CoreConfig {
 	mcp_code_mode_binding_level?: string;
 	mcp_tool_sync_interval: number;
 	async_job_result_ttl: number;
+	sse_keep_alive_interval?: number;
 	required_headers: string[];
 	logging_headers: string[];
+	sse_output_dialects?: Record<string, "openai" | "anthropic">;
+	response_envelopes?: Record<string, "inline" | "strip" | "envelope">;
+	load_shedding?: LoadSheddingConfig;
+	schema_drift?: SchemaDriftConfig;
+	circuit_breaker?: CircuitBreakerConfig;
+	latency_routing?: LatencyRoutingConfig;
+	cost_routing?: CostRoutingConfig;
+	virtual_models?: VirtualModel[];
+	traffic_splits?: TrafficSplit[];
+	single_flight?: SingleFlightConfig;
+	tool_execution?: ToolExecutionConfig;
+	stream_usage?: StreamUsageConfig;
+	header_policy?: HeaderPolicyConfig;
 	hide_deleted_virtual_keys_in_filters: boolean;
 	header_filter_config?: GlobalHeaderFilterConfig;
 }
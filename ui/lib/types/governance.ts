@@ -8,6 +8,9 @@ export interface Budget {
 	reset_duration: string; // e.g., "30s", "5m", "1h", "1d", "1w", "1M"
 	current_usage: number; // In dollars
 	last_reset: string; // ISO timestamp
+	sliding_window?: boolean; // Count the previous window's usage, weighted by its overlap
+	previous_usage?: number; // In dollars, sliding windows only
+	downgrade_model?: string; // "provider/model" or "model" requests go to once the budget is exceeded
 }
 
 export interface RateLimit {
@@ -62,6 +65,9 @@ export interface RedactedDBKey {
 	weight: number;
 }
 
+// Management API roles, granted to virtual keys and JWT callers
+export type ManagementRole = "admin" | "operator" | "viewer";
+
 export interface VirtualKey {
 	id: string;
 	name: string;
@@ -73,6 +79,7 @@ export interface VirtualKey {
 	customer_id?: string;
 	budget_id?: string;
 	rate_limit_id?: string;
+	role?: ManagementRole; // Management API role, absent for inference-only keys
 	is_active: boolean;
 	created_at: string;
 	updated_at: string;
@@ -158,6 +165,7 @@ export interface CreateVirtualKeyRequest {
 	budget?: CreateBudgetRequest;
 	rate_limit?: CreateRateLimitRequest;
 	is_active?: boolean;
+	role?: ManagementRole;
 }
 
 export interface UpdateVirtualKeyRequest {
@@ -170,6 +178,7 @@ export interface UpdateVirtualKeyRequest {
 	budget?: UpdateBudgetRequest;
 	rate_limit?: UpdateRateLimitRequest;
 	is_active?: boolean;
+	role?: ManagementRole | ""; // Empty string removes the role
 }
 
 export interface CreateTeamRequest {
@@ -201,11 +210,15 @@ export interface UpdateCustomerRequest {
 export interface CreateBudgetRequest {
 	max_limit: number; // In dollars
 	reset_duration: string; // e.g., "30s", "5m", "1h", "1d", "1w", "1M"
+	sliding_window?: boolean;
+	downgrade_model?: string;
 }
 
 export interface UpdateBudgetRequest {
 	max_limit?: number;
 	reset_duration?: string;
+	sliding_window?: boolean;
+	downgrade_model?: string; // Empty string to clear
 }
 
 export interface CreateRateLimitRequest {
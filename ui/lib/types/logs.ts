@@ -210,10 +210,18 @@ export interface RerankResult {
 	document?: RerankDocument;
 }
 
+export interface ImageSafetyAnnotation {
+	flagged: boolean;
+	reason?: string;
+}
+
 export interface BifrostImageGenerationData {
 	url?: string;
 	b64_json?: string;
+	mime_type?: string;
 	revised_prompt?: string;
+	seed?: number;
+	safety?: ImageSafetyAnnotation;
 	index?: number;
 }
 
@@ -425,6 +433,8 @@ export interface Annotation {
 // Main LogEntry interface matching backend
 export interface LogEntry {
 	id: string;
+	trace_id?: string;
+	tags?: Record<string, string>;
 	object: string; // text.completion, chat.completion, embedding, audio.speech, audio.transcription
 	timestamp: string; // ISO string format from Go time.Time
 	provider: string;
@@ -481,6 +491,8 @@ export interface LogFilters {
 	virtual_key_ids?: string[];
 	routing_rule_ids?: string[];
 	routing_engine_used?: string[]; // For filtering by routing engine (routing-rule, governance, loadbalancing)
+	trace_ids?: string[];
+	tags?: string[]; // Request tags as key=value, logs must have all of them
 	status?: string[];
 	objects?: string[]; // For filtering by request type (chat.completion, text.completion, embedding)
 	start_time?: string; // RFC3339 format
@@ -496,6 +508,8 @@ export interface LogFilters {
 export interface Pagination {
 	limit: number;
 	offset: number;
+	cursor?: string; // from next_cursor, takes precedence over offset (timestamp sort only)
+	next_cursor?: string;
 	sort_by: "timestamp" | "latency" | "tokens" | "cost";
 	order: "asc" | "desc";
 }
@@ -164,6 +164,32 @@ export const vllmKeyConfigSchema = z.object({
 	model_name: z.string().trim().min(1, "Model name is required"),
 });
 
+// Hunyuan key config schema
+export const hunyuanKeyConfigSchema = z.object({
+	secret_id: envVarSchema.refine((v) => !!v.value?.trim() || !!v.env_var?.trim(), {
+		message: "SecretId is required",
+	}),
+	secret_key: envVarSchema.refine((v) => !!v.value?.trim() || !!v.env_var?.trim(), {
+		message: "SecretKey is required",
+	}),
+	region: envVarSchema.optional(),
+});
+
+// GLM key config schema
+export const glmKeyConfigSchema = z.object({
+	web_search: z
+		.object({
+			enabled: z.boolean(),
+			search_engine: z.string().optional(),
+			count: z.number().int().min(1).max(50).optional(),
+			search_domain_filter: z.string().optional(),
+			search_recency_filter: z.enum(["oneDay", "oneWeek", "oneMonth", "oneYear", "noLimit"]).optional(),
+			content_size: z.enum(["medium", "high"]).optional(),
+			search_prompt: z.string().optional(),
+		})
+		.optional(),
+});
+
 // Model provider key schema
 export const modelProviderKeySchema = z
 	.object({
@@ -196,12 +222,14 @@ export const modelProviderKeySchema = z
 		bedrock_key_config: bedrockKeyConfigSchema.optional(),
 		replicate_key_config: replicateKeyConfigSchema.optional(),
 		vllm_key_config: vllmKeyConfigSchema.optional(),
+		hunyuan_key_config: hunyuanKeyConfigSchema.optional(),
+		glm_key_config: glmKeyConfigSchema.optional(),
 		use_for_batch_api: z.boolean().optional(),
 	})
 	.refine(
 		(data) => {
-			// If bedrock_key_config, azure_key_config, vertex_key_config, or vllm_key_config is present, value is not required
-			if (data.bedrock_key_config || data.azure_key_config || data.vertex_key_config || data.vllm_key_config) {
+			// If bedrock_key_config, azure_key_config, vertex_key_config, vllm_key_config, or hunyuan_key_config is present, value is not required
+			if (data.bedrock_key_config || data.azure_key_config || data.vertex_key_config || data.vllm_key_config || data.hunyuan_key_config) {
 				return true;
 			}
 			// Otherwise, value is required
@@ -642,6 +670,22 @@ export const debuggingFormSchema = z.object({
 
 export type DebuggingFormSchema = z.infer<typeof debuggingFormSchema>;
 
+// OTEL additional exporter (Langfuse, Arize Phoenix or a generic OTLP collector)
+export const otelExporterConfigSchema = z.object({
+	name: z.string().optional(),
+	platform: z.enum(["otlp", "langfuse", "phoenix"]).optional(),
+	collector_url: z.string().optional(),
+	protocol: z.enum(["http", "grpc"]).optional(),
+	headers: z.record(z.string(), z.string()).optional(),
+	tls_ca_cert: z.string().optional(),
+	insecure: z.boolean().optional(),
+	public_key: z.string().optional(),
+	secret_key: z.string().optional(),
+	api_key: z.string().optional(),
+	team_ids: z.array(z.string()).optional(),
+	disable_content: z.boolean().optional(),
+});
+
 // OTEL Configuration Schema
 export const otelConfigSchema = z
 	.object({
@@ -665,6 +709,9 @@ export const otelConfigSchema = z
 		metrics_enabled: z.boolean().default(false),
 		metrics_endpoint: z.string().optional(),
 		metrics_push_interval: z.number().int().min(1).max(300).default(15),
+		// Content and additional exporters (config.json only)
+		disable_content: z.boolean().optional(),
+		exporters: z.array(otelExporterConfigSchema).optional(),
 	})
 	.superRefine((data, ctx) => {
 		const protocol = data.protocol;
@@ -1010,6 +1057,7 @@ export type ProxyFormConfigSchema = z.infer<typeof proxyFormConfigSchema>;
 export type NetworkAndProxyFormSchema = z.infer<typeof networkAndProxyFormSchema>;
 export type ProxyOnlyFormSchema = z.infer<typeof proxyOnlyFormSchema>;
 export type OtelConfigSchema = z.infer<typeof otelConfigSchema>;
+export type OtelExporterConfigSchema = z.infer<typeof otelExporterConfigSchema>;
 export type OtelFormSchema = z.infer<typeof otelFormSchema>;
 export type MaximConfigSchema = z.infer<typeof maximConfigSchema>;
 export type MaximFormSchema = z.infer<typeof maximFormSchema>;
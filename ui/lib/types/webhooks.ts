@@ -0,0 +1,25 @@
+// Webhook types that match the Go backend structures
+
+export type WebhookEventType = "budget.exceeded" | "provider.circuit_opened" | "oauth.token_expiring" | "batch.completed";
+
+export interface WebhookDeadLetter {
+	id: string;
+	event_id: string;
+	event_type: WebhookEventType;
+	endpoint: string;
+	url: string;
+	payload: string;
+	attempts: number;
+	last_status_code?: number;
+	last_error: string;
+	created_at: string;
+}
+
+export interface WebhookDeadLettersResponse {
+	dead_letters: WebhookDeadLetter[];
+	pagination: {
+		limit: number;
+		offset: number;
+		total_count: number;
+	};
+}